@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"io"
+	"time"
+)
+
+// BankCSVFormat は銀行CSVの書式
+type BankCSVFormat string
+
+const (
+	// BankCSVFormatMizuho はみずほ銀行の入出金明細CSV
+	BankCSVFormatMizuho BankCSVFormat = "mizuho"
+	// BankCSVFormatMUFG は三菱UFJ銀行の入出金明細CSV
+	BankCSVFormatMUFG BankCSVFormat = "mufg"
+)
+
+// BankTransaction は銀行の入出金明細の1行を表す
+type BankTransaction struct {
+	Date        time.Time `json:"date"`
+	Description string    `json:"description"` // 全角・半角を正規化した摘要
+	Withdrawal  float64   `json:"withdrawal"`  // 出金金額
+	Deposit     float64   `json:"deposit"`     // 入金金額
+	Balance     float64   `json:"balance"`     // 残高
+}
+
+// IsWithdrawal は出金の明細かを返す
+func (t BankTransaction) IsWithdrawal() bool {
+	return t.Withdrawal > 0
+}
+
+// BankCSVParser は銀行の入出金明細CSVを解析するインタフェース
+type BankCSVParser interface {
+	// Parse はCSVを読み込み、入出金明細の一覧を返す
+	Parse(r io.Reader) ([]BankTransaction, error)
+}
@@ -0,0 +1,9 @@
+package ports
+
+// FeatureFlags は機能フラグの状態を参照するインタフェース
+// ユースケースやコントローラーは環境変数を直接読まず、このインタフェースを通じて機能の有効・無効を判定する
+type FeatureFlags interface {
+	IsEnabled(name string) bool
+	// Public はフロントエンドに公開してよい機能フラグの状態を返す
+	Public() map[string]bool
+}
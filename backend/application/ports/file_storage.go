@@ -0,0 +1,14 @@
+package ports
+
+import "context"
+
+// FileStorage は目標の画像などユーザーがアップロードしたファイルを保存する外部ストレージのインタフェース
+// 保存先はURLで参照し、エンティティにはURLのみを保持する
+type FileStorage interface {
+	// Save はファイルを key で保存し、参照用のURLを返す
+	Save(ctx context.Context, key string, contentType string, data []byte) (string, error)
+
+	// Delete は Save が返したURLのファイルを削除する
+	// このストレージが管理していないURL（外部の画像URLなど）や削除済みのファイルの場合は何もしない
+	Delete(ctx context.Context, url string) error
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// FinancialAdvisoryService はユーザーの財務状況に応じたアドバイスを返すインタフェース
+type FinancialAdvisoryService interface {
+	// GetAdvice はユーザーの相談内容に対するアドバイスの本文を返す
+	GetAdvice(ctx context.Context, userID, message string) (string, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import "github.com/google/uuid"
+
+// IDGenerator は新しく作成するエンティティのIDを採番するインタフェース
+// ユースケースは uuid.New() を直接呼ばず、このインタフェースを通じて採番する（テストで採番結果を固定できるようにするため）
+type IDGenerator interface {
+	NewID() string
+}
+
+// UUIDGenerator はUUID v4で採番するIDGeneratorの既定の実装
+type UUIDGenerator struct{}
+
+// NewID は新しいUUID v4を返す
+func (UUIDGenerator) NewID() string {
+	return uuid.New().String()
+}
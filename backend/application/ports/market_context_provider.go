@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// MarketContext はレポートに添える国内市場の概況を表す
+type MarketContext struct {
+	NikkeiReturn30Day float64   `json:"nikkei_return_30_day"` // 日経平均の直近30日の騰落率（%）
+	JGBYield10Year    float64   `json:"jgb_yield_10_year"`    // 10年国債利回り（%）
+	CPI               float64   `json:"cpi"`                  // 消費者物価指数の前年同月比（%）
+	FetchedAt         time.Time `json:"fetched_at"`
+}
+
+// MarketContextProvider は最新の市場概況を取得するインタフェース
+type MarketContextProvider interface {
+	GetLatestContext(ctx context.Context) (*MarketContext, error)
+}
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// Notification は配信する通知の内容を表す
+type Notification struct {
+	UserID     entities.UserID                `json:"user_id"`
+	EventType  entities.NotificationEventType `json:"event_type"`
+	Title      string                         `json:"title"`
+	Message    string                         `json:"message"`
+	Value      float64                        `json:"value"` // 閾値判定に使う測定値（残り日数・進捗率など）
+	GoalID     string                         `json:"goal_id,omitempty"`
+	OccurredAt time.Time                      `json:"occurred_at"`
+}
+
+// DispatchResult は通知配信の結果を表す
+type DispatchResult struct {
+	Delivered []entities.NotificationChannelType `json:"delivered"`
+	Queued    []entities.NotificationChannelType `json:"queued"`
+}
+
+// NotificationDispatcher は通知設定に応じて通知を各チャネルへ配信するインタフェース
+type NotificationDispatcher interface {
+	// Dispatch はユーザーの通知設定に従って通知を配信する（失敗した配信はリトライキューに入る）
+	Dispatch(ctx context.Context, n Notification) (*DispatchResult, error)
+
+	// RetryFailed はリトライ時刻に達した配信を再送し、成功件数を返す
+	RetryFailed(ctx context.Context) int
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"time"
+)
+
+// TokenBlacklist は失効させたアクセストークンを有効期限まで保持するストアのインタフェース
+// 有効期限を過ぎたエントリはトークン自体が検証で弾かれるため、実装が自動的に削除する（Redis の場合はキーの TTL）
+type TokenBlacklist interface {
+	// Add は jti のトークンを expiresAt（トークンの有効期限）まで失効済みとして登録する
+	Add(ctx context.Context, jti string, expiresAt time.Time) error
+
+	// Contains は jti のトークンが失効済みかを返す
+	Contains(ctx context.Context, jti string) (bool, error)
+
+	// RevokeUserTokens はユーザーに issuedBefore より前に発行したトークンを expiresAt まで失効させる（パスワード変更時に使用）
+	RevokeUserTokens(ctx context.Context, userID string, issuedBefore, expiresAt time.Time) error
+
+	// UserTokensRevokedBefore はユーザーのトークンを失効させた発行日時の境界を返す（失効させていない場合はゼロ値）
+	UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error)
+}
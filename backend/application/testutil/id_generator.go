@@ -0,0 +1,33 @@
+// Package testutil はユースケースのテストで共有するヘルパーを提供する
+package testutil
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SequentialIDGenerator は 1 から順に番号を振ったUUID v4形式のIDを採番するテスト用のIDGenerator
+// 採番されるIDは SequentialID(1), SequentialID(2), ... の順になるため、テストで正確なIDを検証できる
+type SequentialIDGenerator struct {
+	mu   sync.Mutex
+	next int
+}
+
+// NewSequentialIDGenerator は SequentialID(1) から採番するSequentialIDGeneratorを作成する
+func NewSequentialIDGenerator() *SequentialIDGenerator {
+	return &SequentialIDGenerator{}
+}
+
+// NewID は次の番号のIDを返す
+func (g *SequentialIDGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.next++
+	return SequentialID(g.next)
+}
+
+// SequentialID は n 番目に採番されるID（例: 00000000-0000-4000-8000-000000000001）を返す
+// ユーザーIDの形式チェックを通過するよう、UUID v4のバージョン・バリアントのビットを立てている
+func SequentialID(n int) string {
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", n)
+}
@@ -0,0 +1,22 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+func TestSequentialIDGenerator(t *testing.T) {
+	gen := NewSequentialIDGenerator()
+
+	for i := 1; i <= 3; i++ {
+		id := gen.NewID()
+		if id != SequentialID(i) {
+			t.Errorf("%d 番目のIDが期待値と異なります: got %s, want %s", i, id, SequentialID(i))
+		}
+		// ユーザーIDとしても利用できる形式であること
+		if _, err := entities.NewUserID(id); err != nil {
+			t.Errorf("UUID v4形式として扱えるべきです: %s: %v", id, err)
+		}
+	}
+}
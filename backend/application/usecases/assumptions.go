@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// CompoundingFrequencyMonthly は月次複利（年率と同値の月利で毎月運用益を計上する）を表す
+// 資産推移・退職資金・到達期間などの計算はすべて月次複利で行う
+const CompoundingFrequencyMonthly = "monthly"
+
+// Assumptions は計算結果がどの前提で算出されたかを示す（全計算・レポートの出力で共通）
+// 同じユーザーでも設定を変更すると結果が変わるため、結果と一緒に前提を返して違いを説明できるようにする
+// 値は生成時点の財務プロファイルから写し取るため、後でプロファイルを変更しても保存・エクスポート済みの結果の前提は変わらない
+// MonthlyIncome は計算に使う手取り月収、MonthlyExpensesTotal は支出に計上されていない負債の返済額を含む月間支出の合計
+type Assumptions struct {
+	InvestmentReturn     float64 `json:"investment_return"`          // 投資利回り（年率%）
+	InflationRate        float64 `json:"inflation_rate"`             // インフレ率（年率%）
+	ProjectionYears      int     `json:"projection_years,omitempty"` // 計算期間（年）。期間を指定しない計算では省略
+	CompoundingFrequency string  `json:"compounding_frequency"`      // 複利頻度
+	MonthlyIncome        float64 `json:"monthly_income"`
+	MonthlyExpensesTotal float64 `json:"monthly_expenses_total"`
+	NetSavings           float64 `json:"net_savings"`
+	// ContributionTiming は積立を各月の期初・期末のどちらに行ったか（資産推移の計算に使う）
+	ContributionTiming valueobjects.ContributionTiming `json:"contribution_timing"`
+	// TaxConsidered は月収から税・社会保険料を控除して計算したか（額面入力の場合のみ true）
+	// 運用益への課税は考慮しない
+	TaxConsidered      bool      `json:"tax_considered"`
+	CalculationVersion int       `json:"calculation_version"`
+	CalculatedAt       time.Time `json:"calculated_at"`
+}
+
+// newAssumptions は財務プロファイルの設定から計算前提を作成する
+// 支出合計・純貯蓄額を計算できない場合は0のままにする（計算本体で同じエラーが返る）
+func newAssumptions(profile *entities.FinancialProfile, projectionYears int, calculatedAt time.Time) Assumptions {
+	assumptions := Assumptions{
+		InvestmentReturn:     profile.InvestmentReturn().AsPercentage(),
+		InflationRate:        profile.InflationRate().AsPercentage(),
+		ProjectionYears:      projectionYears,
+		CompoundingFrequency: CompoundingFrequencyMonthly,
+		ContributionTiming:   profile.ContributionTiming(),
+		MonthlyIncome:        profile.NetMonthlyIncome().Amount(),
+		TaxConsidered:        profile.IncomeType() == entities.IncomeTypeGross,
+		CalculationVersion:   services.CalculationVersion,
+		CalculatedAt:         calculatedAt,
+	}
+
+	if expenses, err := profile.EffectiveMonthlyExpenses(); err == nil {
+		if total, err := expenses.Total(); err == nil {
+			assumptions.MonthlyExpensesTotal = total.Amount()
+		}
+	}
+	if netSavings, err := profile.CalculateNetSavings(); err == nil {
+		assumptions.NetSavings = netSavings.Amount()
+	}
+
+	return assumptions
+}
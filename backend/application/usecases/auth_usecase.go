@@ -8,12 +8,13 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"log/slog"
 	"strings"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/pquerna/otp"
@@ -38,6 +39,9 @@ type AuthUseCase interface {
 	// RevokeRefreshToken はリフレッシュトークンを失効させる（ログアウト時に使用）
 	RevokeRefreshToken(ctx context.Context, userID string) error
 
+	// RevokeAccessToken はアクセストークンをブラックリストに登録し、有効期限を待たずに失効させる（ログアウト時に使用）
+	RevokeAccessToken(ctx context.Context, tokenString string) error
+
 	// GitHubOAuthLogin はGitHubからのユーザー情報でログイン/登録を行う（Issue: #67）
 	GitHubOAuthLogin(ctx context.Context, input GitHubOAuthInput) (*LoginOutput, error)
 
@@ -116,11 +120,14 @@ type RefreshOutput struct {
 }
 
 // TokenClaims はJWTトークンのクレーム
+// jti（RegisteredClaims.ID）はトークンごとに一意なIDで、ブラックリストによる失効に使う
 type TokenClaims struct {
 	UserID          string `json:"user_id"`
 	Email           string `json:"email"`
-	Requires2FA     bool   `json:"requires_2fa,omitempty"`     // 2FA検証が必要かどうか
+	Requires2FA     bool   `json:"requires_2fa,omitempty"`      // 2FA検証が必要かどうか
 	TwoFactorVerify bool   `json:"two_factor_verify,omitempty"` // 2FA検証用の仮トークンかどうか
+	ImpersonatorID  string `json:"impersonator_id,omitempty"`   // なりすましを行っている管理者のユーザーID
+	Impersonated    bool   `json:"impersonated,omitempty"`      // 管理者によるなりすましのトークンかどうか（jti はセッションID）
 	jwt.RegisteredClaims
 }
 
@@ -182,6 +189,9 @@ type authUseCase struct {
 	jwtSecret              string
 	jwtExpiration          time.Duration
 	refreshTokenExpiration time.Duration
+	twoFactorPolicy        TwoFactorPolicyUseCase
+	idGenerator            ports.IDGenerator
+	tokenBlacklist         ports.TokenBlacklist
 }
 
 // NewAuthUseCase は新しい認証ユースケースを作成する
@@ -202,12 +212,65 @@ func NewAuthUseCase(
 		jwtSecret:              jwtSecret,
 		jwtExpiration:          jwtExpiration,
 		refreshTokenExpiration: refreshTokenExpiration,
+		idGenerator:            ports.UUIDGenerator{},
 	}
 }
 
+// NewAuthUseCaseWithIDGenerator は新規ユーザーのIDを idGenerator で採番する認証ユースケースを作成する
+// テストで採番結果を固定する場合に使用する
+func NewAuthUseCaseWithIDGenerator(
+	userRepo repositories.UserRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	passwordResetTokenRepo repositories.PasswordResetTokenRepository,
+	emailService emailSender,
+	jwtSecret string,
+	jwtExpiration time.Duration,
+	refreshTokenExpiration time.Duration,
+	idGenerator ports.IDGenerator,
+) AuthUseCase {
+	uc := NewAuthUseCase(userRepo, refreshTokenRepo, passwordResetTokenRepo, emailService, jwtSecret, jwtExpiration, refreshTokenExpiration).(*authUseCase)
+	uc.idGenerator = idGenerator
+	return uc
+}
+
+// NewAuthUseCaseWithTwoFactorPolicy は2段階認証の必須化ポリシーを適用する認証ユースケースを作成する
+// ポリシーの対象ユーザーは2段階認証を無効化できない
+func NewAuthUseCaseWithTwoFactorPolicy(
+	userRepo repositories.UserRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	passwordResetTokenRepo repositories.PasswordResetTokenRepository,
+	emailService emailSender,
+	jwtSecret string,
+	jwtExpiration time.Duration,
+	refreshTokenExpiration time.Duration,
+	twoFactorPolicy TwoFactorPolicyUseCase,
+) AuthUseCase {
+	uc := NewAuthUseCase(userRepo, refreshTokenRepo, passwordResetTokenRepo, emailService, jwtSecret, jwtExpiration, refreshTokenExpiration).(*authUseCase)
+	uc.twoFactorPolicy = twoFactorPolicy
+	return uc
+}
+
+// NewAuthUseCaseWithTokenBlacklist は2段階認証の必須化ポリシーに加えて、アクセストークンのブラックリストを照合する認証ユースケースを作成する
+// ログアウトしたトークンやパスワードの変更前に発行したトークンは有効期限内でも検証に失敗する
+func NewAuthUseCaseWithTokenBlacklist(
+	userRepo repositories.UserRepository,
+	refreshTokenRepo repositories.RefreshTokenRepository,
+	passwordResetTokenRepo repositories.PasswordResetTokenRepository,
+	emailService emailSender,
+	jwtSecret string,
+	jwtExpiration time.Duration,
+	refreshTokenExpiration time.Duration,
+	twoFactorPolicy TwoFactorPolicyUseCase,
+	tokenBlacklist ports.TokenBlacklist,
+) AuthUseCase {
+	uc := NewAuthUseCaseWithTwoFactorPolicy(userRepo, refreshTokenRepo, passwordResetTokenRepo, emailService, jwtSecret, jwtExpiration, refreshTokenExpiration, twoFactorPolicy).(*authUseCase)
+	uc.tokenBlacklist = tokenBlacklist
+	return uc
+}
+
 // Register は新しいユーザーを登録する
 func (uc *authUseCase) Register(ctx context.Context, input RegisterInput) (*RegisterOutput, error) {
-	logger := slog.With("usecase", "Register", "email", input.Email)
+	logger := log.ForUseCase(ctx, "Register").With(log.Email(input.Email))
 	logger.InfoContext(ctx, "ユーザー登録を開始します")
 
 	// バリデーション
@@ -234,7 +297,7 @@ func (uc *authUseCase) Register(ctx context.Context, input RegisterInput) (*Regi
 	}
 
 	// ユーザーエンティティを作成
-	userID := uuid.New().String()
+	userID := uc.idGenerator.NewID()
 	user, err := entities.NewUser(userID, input.Email, input.Password)
 	if err != nil {
 		logger.ErrorContext(ctx, "ユーザーエンティティの作成に失敗しました", "error", err)
@@ -261,7 +324,7 @@ func (uc *authUseCase) Register(ctx context.Context, input RegisterInput) (*Regi
 		return nil, fmt.Errorf("リフレッシュトークンの生成に失敗しました: %w", err)
 	}
 
-	logger.InfoContext(ctx, "ユーザー登録が完了しました", "user_id", userID)
+	logger.InfoContext(ctx, "ユーザー登録が完了しました", log.UserID(userID))
 
 	return &RegisterOutput{
 		UserID:       userID,
@@ -274,7 +337,7 @@ func (uc *authUseCase) Register(ctx context.Context, input RegisterInput) (*Regi
 
 // Login はユーザー認証を行い、JWTトークンを発行する
 func (uc *authUseCase) Login(ctx context.Context, input LoginInput) (*LoginOutput, error) {
-	logger := slog.With("usecase", "Login", "email", input.Email)
+	logger := log.ForUseCase(ctx, "Login").With(log.Email(input.Email))
 	logger.InfoContext(ctx, "ログインを開始します")
 
 	// バリデーション
@@ -305,7 +368,7 @@ func (uc *authUseCase) Login(ctx context.Context, input LoginInput) (*LoginOutpu
 
 	// 2FAが有効な場合は仮トークンを返す
 	if user.TwoFactorEnabled() {
-		logger.InfoContext(ctx, "2FAが有効なため仮トークンを発行します", "user_id", user.ID())
+		logger.InfoContext(ctx, "2FAが有効なため仮トークンを発行します", log.UserID(string(user.ID())))
 		
 		// 2FA検証用の短時間有効な仮トークンを生成（5分間）
 		tempToken, tempExpiresAt, err := uc.generateTempTokenFor2FA(user)
@@ -324,12 +387,30 @@ func (uc *authUseCase) Login(ctx context.Context, input LoginInput) (*LoginOutpu
 	}
 
 	// 2FAが無効な場合は通常のトークンを発行
-	logger.InfoContext(ctx, "通常のトークンを発行します", "user_id", user.ID())
+	logger.InfoContext(ctx, "通常のトークンを発行します", log.UserID(string(user.ID())))
 	return uc.generateAuthTokens(ctx, user)
 }
 
+// ErrTokenRevoked はブラックリストにより失効したトークンのエラー
+var ErrTokenRevoked = errors.New("トークンは失効しています")
+
 // VerifyToken はJWTトークンを検証する
+// ブラックリストを設定している場合は、失効させたトークンも無効とする
 func (uc *authUseCase) VerifyToken(ctx context.Context, tokenString string) (*TokenClaims, error) {
+	claims, err := uc.parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkRevoked(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// parseToken はJWTトークンの署名と有効期限を検証してクレームを返す
+func (uc *authUseCase) parseToken(tokenString string) (*TokenClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
 		// 署名アルゴリズムの確認
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -349,6 +430,76 @@ func (uc *authUseCase) VerifyToken(ctx context.Context, tokenString string) (*To
 	return nil, errors.New("無効なトークンです")
 }
 
+// checkRevoked はトークンがブラックリストで失効していないかを確認する
+// ブラックリストを照合できない場合は、失効したトークンを通さないよう検証失敗として扱う
+func (uc *authUseCase) checkRevoked(ctx context.Context, claims *TokenClaims) error {
+	if uc.tokenBlacklist == nil {
+		return nil
+	}
+
+	if claims.ID != "" {
+		revoked, err := uc.tokenBlacklist.Contains(ctx, claims.ID)
+		if err != nil {
+			return fmt.Errorf("トークンの失効状態を確認できませんでした: %w", err)
+		}
+		if revoked {
+			return ErrTokenRevoked
+		}
+	}
+
+	revokedBefore, err := uc.tokenBlacklist.UserTokensRevokedBefore(ctx, claims.UserID)
+	if err != nil {
+		return fmt.Errorf("トークンの失効状態を確認できませんでした: %w", err)
+	}
+	if !revokedBefore.IsZero() && (claims.IssuedAt == nil || claims.IssuedAt.Before(revokedBefore)) {
+		return ErrTokenRevoked
+	}
+
+	return nil
+}
+
+// RevokeAccessToken はアクセストークンの jti をトークンの有効期限までブラックリストに登録する
+// 期限切れのトークンや jti を持たない（導入前に発行した）トークンは登録せずに成功とする
+func (uc *authUseCase) RevokeAccessToken(ctx context.Context, tokenString string) error {
+	if uc.tokenBlacklist == nil {
+		return nil
+	}
+
+	claims, err := uc.parseToken(tokenString)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil
+		}
+		return err
+	}
+	if claims.ID == "" || claims.ExpiresAt == nil {
+		log.ForUseCase(ctx, "RevokeAccessToken").WarnContext(ctx, "jti のないトークンは失効させられません", log.UserID(claims.UserID))
+		return nil
+	}
+
+	if err := uc.tokenBlacklist.Add(ctx, claims.ID, claims.ExpiresAt.Time); err != nil {
+		return fmt.Errorf("トークンの失効に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// revokeUserTokens はユーザーに現在までに発行したアクセストークンとリフレッシュトークンをすべて失効させる
+// JWTの発行日時は秒単位のため、境界は秒に切り捨てる（同じ秒に発行したトークンは失効しない）
+func (uc *authUseCase) revokeUserTokens(ctx context.Context, userID entities.UserID) error {
+	if err := uc.refreshTokenRepo.RevokeByUserID(ctx, userID); err != nil {
+		return fmt.Errorf("リフレッシュトークンの失効に失敗しました: %w", err)
+	}
+	if uc.tokenBlacklist == nil {
+		return nil
+	}
+
+	now := time.Now()
+	if err := uc.tokenBlacklist.RevokeUserTokens(ctx, userID.String(), now.Truncate(time.Second), now.Add(uc.jwtExpiration)); err != nil {
+		return fmt.Errorf("アクセストークンの失効に失敗しました: %w", err)
+	}
+	return nil
+}
+
 // generateToken はユーザー情報からJWTトークンを生成する
 func (uc *authUseCase) generateToken(user *entities.User) (string, time.Time, error) {
 	expiresAt := time.Now().Add(uc.jwtExpiration)
@@ -357,6 +508,7 @@ func (uc *authUseCase) generateToken(user *entities.User) (string, time.Time, er
 		UserID: user.ID().String(),
 		Email:  user.Email().String(),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
@@ -383,6 +535,7 @@ func (uc *authUseCase) generateTempTokenFor2FA(user *entities.User) (string, tim
 		Requires2FA:     true,
 		TwoFactorVerify: true,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
@@ -416,7 +569,7 @@ func (uc *authUseCase) generateRefreshToken(ctx context.Context, userID entities
 
 // RefreshAccessToken はリフレッシュトークンを使用して新しいアクセストークンを発行する
 func (uc *authUseCase) RefreshAccessToken(ctx context.Context, refreshTokenString string) (*RefreshOutput, error) {
-	logger := slog.With("usecase", "RefreshAccessToken")
+	logger := log.ForUseCase(ctx, "RefreshAccessToken")
 	logger.InfoContext(ctx, "トークンリフレッシュを開始します")
 
 	// リフレッシュトークンをハッシュ化して検索
@@ -459,7 +612,7 @@ func (uc *authUseCase) RefreshAccessToken(ctx context.Context, refreshTokenStrin
 		// エラーをログに記録するが、処理は続行
 	}
 
-	logger.InfoContext(ctx, "トークンリフレッシュが完了しました", "user_id", user.ID())
+	logger.InfoContext(ctx, "トークンリフレッシュが完了しました", log.UserID(string(user.ID())))
 
 	return &RefreshOutput{
 		Token:     token,
@@ -469,7 +622,7 @@ func (uc *authUseCase) RefreshAccessToken(ctx context.Context, refreshTokenStrin
 
 // RevokeRefreshToken はリフレッシュトークンを失効させる（ログアウト時に使用）
 func (uc *authUseCase) RevokeRefreshToken(ctx context.Context, userID string) error {
-	logger := slog.With("usecase", "RevokeRefreshToken", "user_id", userID)
+	logger := log.ForUseCase(ctx, "RevokeRefreshToken").With(log.UserID(userID))
 	logger.InfoContext(ctx, "リフレッシュトークンの失効を開始します")
 
 	uid, err := entities.NewUserID(userID)
@@ -494,7 +647,7 @@ func hashRefreshToken(token string) string {
 
 // GitHubOAuthLogin はGitHubからのユーザー情報でログイン/登録を行う（Issue: #67）
 func (uc *authUseCase) GitHubOAuthLogin(ctx context.Context, input GitHubOAuthInput) (*LoginOutput, error) {
-	logger := slog.With("usecase", "GitHubOAuthLogin", "github_user_id", input.GitHubUserID, "email", input.Email)
+	logger := log.ForUseCase(ctx, "GitHubOAuthLogin").With("github_user_id", input.GitHubUserID, log.Email(input.Email))
 	logger.InfoContext(ctx, "GitHub OAuthログインを開始します")
 
 	// バリデーション
@@ -509,7 +662,7 @@ func (uc *authUseCase) GitHubOAuthLogin(ctx context.Context, input GitHubOAuthIn
 	existingUser, err := uc.userRepo.FindByProviderUserID(ctx, entities.AuthProviderGitHub, input.GitHubUserID)
 	if err == nil {
 		// 既存のGitHubユーザーが見つかった - ログイン処理
-		logger.InfoContext(ctx, "既存のGitHubユーザーでログインします", "user_id", existingUser.ID())
+		logger.InfoContext(ctx, "既存のGitHubユーザーでログインします", log.UserID(string(existingUser.ID())))
 		return uc.generateAuthTokens(ctx, existingUser)
 	}
 
@@ -531,7 +684,7 @@ func (uc *authUseCase) GitHubOAuthLogin(ctx context.Context, input GitHubOAuthIn
 	}
 
 	// 新規ユーザーを作成
-	userID := uuid.New().String()
+	userID := uc.idGenerator.NewID()
 	newUser, err := entities.NewOAuthUser(
 		userID,
 		input.Email,
@@ -551,7 +704,7 @@ func (uc *authUseCase) GitHubOAuthLogin(ctx context.Context, input GitHubOAuthIn
 		return nil, fmt.Errorf("ユーザーの保存に失敗しました: %w", err)
 	}
 
-	logger.InfoContext(ctx, "新規GitHubユーザーを作成しました", "user_id", newUser.ID())
+	logger.InfoContext(ctx, "新規GitHubユーザーを作成しました", log.UserID(string(newUser.ID())))
 
 	// トークンを生成して返す
 	return uc.generateAuthTokens(ctx, newUser)
@@ -582,7 +735,7 @@ func (uc *authUseCase) generateAuthTokens(ctx context.Context, user *entities.Us
 
 // Setup2FA は2段階認証のセットアップを開始する（QRコード生成用）
 func (uc *authUseCase) Setup2FA(ctx context.Context, userID string) (*Setup2FAOutput, error) {
-	logger := slog.With("usecase", "Setup2FA", "user_id", userID)
+	logger := log.ForUseCase(ctx, "Setup2FA").With(log.UserID(userID))
 	logger.InfoContext(ctx, "2FA設定を開始します")
 
 	// ユーザーを取得
@@ -631,7 +784,7 @@ func (uc *authUseCase) Setup2FA(ctx context.Context, userID string) (*Setup2FAOu
 
 // Enable2FA は2段階認証を有効化する（初回コード検証）
 func (uc *authUseCase) Enable2FA(ctx context.Context, input Enable2FAInput) error {
-	logger := slog.With("usecase", "Enable2FA", "user_id", input.UserID)
+	logger := log.ForUseCase(ctx, "Enable2FA").With(log.UserID(input.UserID))
 	logger.InfoContext(ctx, "2FA有効化を開始します")
 
 	// バリデーション
@@ -707,7 +860,7 @@ func (uc *authUseCase) Enable2FA(ctx context.Context, input Enable2FAInput) erro
 
 // Verify2FA はログイン時の2FAコード検証を行う
 func (uc *authUseCase) Verify2FA(ctx context.Context, input Verify2FAInput) (*LoginOutput, error) {
-	logger := slog.With("usecase", "Verify2FA", "user_id", input.UserID)
+	logger := log.ForUseCase(ctx, "Verify2FA").With(log.UserID(input.UserID))
 	logger.InfoContext(ctx, "2FA検証を開始します")
 
 	// バリデーション
@@ -772,7 +925,7 @@ func (uc *authUseCase) Verify2FA(ctx context.Context, input Verify2FAInput) (*Lo
 
 // Disable2FA は2段階認証を無効化する
 func (uc *authUseCase) Disable2FA(ctx context.Context, input Disable2FAInput) error {
-	logger := slog.With("usecase", "Disable2FA", "user_id", input.UserID)
+	logger := log.ForUseCase(ctx, "Disable2FA").With(log.UserID(input.UserID))
 	logger.InfoContext(ctx, "2FA無効化を開始します")
 
 	// バリデーション
@@ -808,6 +961,19 @@ func (uc *authUseCase) Disable2FA(ctx context.Context, input Disable2FAInput) er
 		return errors.New("2段階認証は有効になっていません")
 	}
 
+	// 必須化ポリシーの対象ユーザーは無効化できない
+	if uc.twoFactorPolicy != nil {
+		requirement, err := uc.twoFactorPolicy.GetRequirement(ctx, uid)
+		if err != nil {
+			logger.ErrorContext(ctx, "2段階認証の必須化状況の確認に失敗しました", "error", err)
+			return fmt.Errorf("2段階認証の必須化状況の確認に失敗しました: %w", err)
+		}
+		if requirement.Required {
+			logger.WarnContext(ctx, "必須化ポリシーの対象のため2FAの無効化を拒否しました", "reason", string(requirement.Reason))
+			return ErrTwoFactorRequiredByPolicy
+		}
+	}
+
 	// 2FAを無効化
 	user.DisableTwoFactor()
 
@@ -823,7 +989,7 @@ func (uc *authUseCase) Disable2FA(ctx context.Context, input Disable2FAInput) er
 
 // RegenerateBackupCodes はバックアップコードを再生成する
 func (uc *authUseCase) RegenerateBackupCodes(ctx context.Context, userID string) (*RegenerateBackupCodesOutput, error) {
-	logger := slog.With("usecase", "RegenerateBackupCodes", "user_id", userID)
+	logger := log.ForUseCase(ctx, "RegenerateBackupCodes").With(log.UserID(userID))
 	logger.InfoContext(ctx, "バックアップコード再生成を開始します")
 
 	// ユーザーを取得
@@ -908,7 +1074,7 @@ func hashBackupCodes(codes []string) ([]string, error) {
 
 // Get2FAStatus は2FAの有効状態を取得する
 func (uc *authUseCase) Get2FAStatus(ctx context.Context, userID string) (*Get2FAStatusOutput, error) {
-	logger := slog.With("usecase", "Get2FAStatus", "user_id", userID)
+	logger := log.ForUseCase(ctx, "Get2FAStatus").With(log.UserID(userID))
 	logger.InfoContext(ctx, "2FAステータス取得を開始します")
 
 	// ユーザーを取得
@@ -931,7 +1097,7 @@ func (uc *authUseCase) Get2FAStatus(ctx context.Context, userID string) (*Get2FA
 // ForgotPassword はパスワードリセットメールを送信する
 // 存在しないメールアドレスでも同じレスポンスを返す（ユーザー列挙対策）
 func (uc *authUseCase) ForgotPassword(ctx context.Context, input ForgotPasswordInput) error {
-	logger := slog.With("usecase", "ForgotPassword")
+	logger := log.ForUseCase(ctx, "ForgotPassword")
 	logger.InfoContext(ctx, "パスワードリセットリクエストを受け付けました")
 
 	email, err := entities.NewEmail(input.Email)
@@ -976,7 +1142,7 @@ func (uc *authUseCase) ForgotPassword(ctx context.Context, input ForgotPasswordI
 
 // ResetPassword はトークンを使ってパスワードをリセットする
 func (uc *authUseCase) ResetPassword(ctx context.Context, input ResetPasswordInput) error {
-	logger := slog.With("usecase", "ResetPassword")
+	logger := log.ForUseCase(ctx, "ResetPassword")
 	logger.InfoContext(ctx, "パスワードリセットを開始します")
 
 	if input.Token == "" {
@@ -1014,6 +1180,12 @@ func (uc *authUseCase) ResetPassword(ctx context.Context, input ResetPasswordInp
 		return fmt.Errorf("パスワードの保存に失敗しました: %w", err)
 	}
 
+	// 漏洩したトークンで引き続きアクセスされないよう、リセット前に発行したトークンを失効させる
+	if err := uc.revokeUserTokens(ctx, user.ID()); err != nil {
+		logger.ErrorContext(ctx, "トークンの失効に失敗しました", "error", err)
+		// パスワードは既に更新済みのためエラーは返さない
+	}
+
 	// トークンを使用済みにする
 	resetToken.Use()
 	if err := uc.passwordResetTokenRepo.Update(ctx, resetToken); err != nil {
@@ -1021,7 +1193,7 @@ func (uc *authUseCase) ResetPassword(ctx context.Context, input ResetPasswordInp
 		// パスワードは既に更新済みのためエラーは返さない
 	}
 
-	logger.InfoContext(ctx, "パスワードリセット完了", "user_id", string(resetToken.UserID()))
+	logger.InfoContext(ctx, "パスワードリセット完了", log.UserID(string(resetToken.UserID())))
 	return nil
 }
 
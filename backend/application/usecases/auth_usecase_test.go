@@ -6,8 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/application/testutil"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +26,20 @@ func newTestAuthUseCase(userRepo *MockUserRepository, tokenRepo *MockRefreshToke
 	return NewAuthUseCase(userRepo, tokenRepo, passwordResetRepo, emailService, testJWTSecret, testJWTExpiration, testRefreshTokenExpiration)
 }
 
+// newTestAuthUseCaseWithSequentialIDs は新規ユーザーのIDを testutil.SequentialID(1) から採番する認証ユースケースを作成するヘルパー
+func newTestAuthUseCaseWithSequentialIDs(userRepo *MockUserRepository, tokenRepo *MockRefreshTokenRepository) AuthUseCase {
+	passwordResetRepo := new(MockPasswordResetTokenRepository)
+	emailService := new(MockEmailService)
+	return NewAuthUseCaseWithIDGenerator(userRepo, tokenRepo, passwordResetRepo, emailService, testJWTSecret, testJWTExpiration, testRefreshTokenExpiration, testutil.NewSequentialIDGenerator())
+}
+
+// userWithID はSaveされるユーザーのIDを検証するマッチャー
+func userWithID(id string) interface{} {
+	return mock.MatchedBy(func(user *entities.User) bool {
+		return user.ID().String() == id
+	})
+}
+
 // ===========================
 // Register Tests
 // ===========================
@@ -35,17 +52,17 @@ func TestAuthUseCase_Register(t *testing.T) {
 		mockTokenRepo := new(MockRefreshTokenRepository)
 		email, _ := entities.NewEmail("test@example.com")
 		mockUserRepo.On("ExistsByEmail", mock_anything(), email).Return(false, nil)
-		mockUserRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+		mockUserRepo.On("Save", mock_anything(), userWithID(testutil.SequentialID(1))).Return(nil)
 		mockTokenRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
 
-		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
+		uc := newTestAuthUseCaseWithSequentialIDs(mockUserRepo, mockTokenRepo)
 		output, err := uc.Register(ctx, RegisterInput{
 			Email:    "test@example.com",
 			Password: "password123",
 		})
 
 		require.NoError(t, err)
-		assert.NotEmpty(t, output.UserID)
+		assert.Equal(t, testutil.SequentialID(1), output.UserID)
 		assert.Equal(t, "test@example.com", output.Email)
 		assert.NotEmpty(t, output.Token)
 		assert.NotEmpty(t, output.RefreshToken)
@@ -189,10 +206,10 @@ func TestAuthUseCase_RevokeRefreshToken(t *testing.T) {
 	t.Run("正常系: リフレッシュトークンを失効できる", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockTokenRepo.On("RevokeByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		mockTokenRepo.On("RevokeByUserID", mock_anything(), entities.UserID(testAuthUserID)).Return(nil)
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		err := uc.RevokeRefreshToken(ctx, "user-001")
+		err := uc.RevokeRefreshToken(ctx, testAuthUserID)
 
 		require.NoError(t, err)
 		mockTokenRepo.AssertExpectations(t)
@@ -201,10 +218,10 @@ func TestAuthUseCase_RevokeRefreshToken(t *testing.T) {
 	t.Run("異常系: リポジトリエラーの場合はエラーを返す", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockTokenRepo.On("RevokeByUserID", mock_anything(), entities.UserID("user-001")).Return(errors.New("db error"))
+		mockTokenRepo.On("RevokeByUserID", mock_anything(), entities.UserID(testAuthUserID)).Return(errors.New("db error"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		err := uc.RevokeRefreshToken(ctx, "user-001")
+		err := uc.RevokeRefreshToken(ctx, testAuthUserID)
 
 		require.Error(t, err)
 		mockTokenRepo.AssertExpectations(t)
@@ -221,11 +238,11 @@ func TestAuthUseCase_Get2FAStatus(t *testing.T) {
 	t.Run("正常系: 2FAが無効なユーザーのステータスを取得できる", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		user := newTestUser("user-001", "test@example.com")
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(user, nil)
+		user := newTestUser(testAuthUserID, "test@example.com")
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		output, err := uc.Get2FAStatus(ctx, "user-001")
+		output, err := uc.Get2FAStatus(ctx, testAuthUserID)
 
 		require.NoError(t, err)
 		assert.NotNil(t, output)
@@ -236,10 +253,10 @@ func TestAuthUseCase_Get2FAStatus(t *testing.T) {
 	t.Run("異常系: ユーザーが存在しない場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testUnknownUserID)).Return(nil, errors.New("not found"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		_, err := uc.Get2FAStatus(ctx, "user-999")
+		_, err := uc.Get2FAStatus(ctx, testUnknownUserID)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "ユーザーが見つかりません")
@@ -297,7 +314,7 @@ func TestAuthUseCase_GitHubOAuthLogin(t *testing.T) {
 	t.Run("正常系: 既存のGitHubユーザーでログインできる", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		user := newTestUser("user-001", "github@example.com")
+		user := newTestUser(testAuthUserID, "github@example.com")
 		mockUserRepo.On("FindByProviderUserID", mock_anything(), entities.AuthProviderGitHub, "github-123").Return(user, nil)
 		mockTokenRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
 
@@ -318,7 +335,7 @@ func TestAuthUseCase_GitHubOAuthLogin(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
 		email, _ := entities.NewEmail("existing@example.com")
-		existingUser := newTestUser("user-existing", "existing@example.com")
+		existingUser := newTestUser(testExistingUserID, "existing@example.com")
 		mockUserRepo.On("FindByProviderUserID", mock_anything(), entities.AuthProviderGitHub, "github-new").Return(nil, errors.New("not found"))
 		mockUserRepo.On("FindByEmail", mock_anything(), email).Return(existingUser, nil)
 
@@ -340,10 +357,10 @@ func TestAuthUseCase_GitHubOAuthLogin(t *testing.T) {
 		email, _ := entities.NewEmail("new@example.com")
 		mockUserRepo.On("FindByProviderUserID", mock_anything(), entities.AuthProviderGitHub, "github-brand-new").Return(nil, errors.New("not found"))
 		mockUserRepo.On("FindByEmail", mock_anything(), email).Return(nil, errors.New("not found"))
-		mockUserRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+		mockUserRepo.On("Save", mock_anything(), userWithID(testutil.SequentialID(1))).Return(nil)
 		mockTokenRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
 
-		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
+		uc := newTestAuthUseCaseWithSequentialIDs(mockUserRepo, mockTokenRepo)
 		output, err := uc.GitHubOAuthLogin(ctx, GitHubOAuthInput{
 			GitHubUserID: "github-brand-new",
 			Email:        "new@example.com",
@@ -351,6 +368,7 @@ func TestAuthUseCase_GitHubOAuthLogin(t *testing.T) {
 		})
 
 		require.NoError(t, err)
+		assert.Equal(t, testutil.SequentialID(1), output.UserID)
 		assert.NotEmpty(t, output.Token)
 		mockUserRepo.AssertExpectations(t)
 		mockTokenRepo.AssertExpectations(t)
@@ -387,11 +405,11 @@ func TestAuthUseCase_Setup2FA(t *testing.T) {
 	t.Run("正常系: 2FAセットアップデータを取得できる", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		user := newTestUser("user-001", "test@example.com")
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(user, nil)
+		user := newTestUser(testAuthUserID, "test@example.com")
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		output, err := uc.Setup2FA(ctx, "user-001")
+		output, err := uc.Setup2FA(ctx, testAuthUserID)
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, output.Secret)
@@ -403,10 +421,10 @@ func TestAuthUseCase_Setup2FA(t *testing.T) {
 	t.Run("異常系: ユーザーが存在しない場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testUnknownUserID)).Return(nil, errors.New("not found"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		_, err := uc.Setup2FA(ctx, "user-999")
+		_, err := uc.Setup2FA(ctx, testUnknownUserID)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "ユーザーが見つかりません")
@@ -453,7 +471,7 @@ func TestAuthUseCase_Enable2FA(t *testing.T) {
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		err := uc.Enable2FA(ctx, Enable2FAInput{
-			UserID: "user-001",
+			UserID: testAuthUserID,
 			Code:   "",
 			Secret: "TESTSECRET",
 		})
@@ -468,7 +486,7 @@ func TestAuthUseCase_Enable2FA(t *testing.T) {
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		err := uc.Enable2FA(ctx, Enable2FAInput{
-			UserID: "user-001",
+			UserID: testAuthUserID,
 			Code:   "123456",
 			Secret: "",
 		})
@@ -480,11 +498,11 @@ func TestAuthUseCase_Enable2FA(t *testing.T) {
 	t.Run("異常系: ユーザーが存在しない場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testUnknownUserID)).Return(nil, errors.New("not found"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		err := uc.Enable2FA(ctx, Enable2FAInput{
-			UserID: "user-999",
+			UserID: testUnknownUserID,
 			Code:   "123456",
 			Secret: "TESTSECRET",
 		})
@@ -497,12 +515,12 @@ func TestAuthUseCase_Enable2FA(t *testing.T) {
 	t.Run("異常系: 無効なTOTPコードの場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		user := newTestUser("user-001", "test@example.com")
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(user, nil)
+		user := newTestUser(testAuthUserID, "test@example.com")
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		err := uc.Enable2FA(ctx, Enable2FAInput{
-			UserID: "user-001",
+			UserID: testAuthUserID,
 			Code:   "000000",     // 無効なコード
 			Secret: "TESTSECRET", // 無効なシークレット
 		})
@@ -540,7 +558,7 @@ func TestAuthUseCase_Verify2FA(t *testing.T) {
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		_, err := uc.Verify2FA(ctx, Verify2FAInput{
-			UserID: "user-001",
+			UserID: testAuthUserID,
 			Code:   "",
 		})
 
@@ -551,11 +569,11 @@ func TestAuthUseCase_Verify2FA(t *testing.T) {
 	t.Run("異常系: ユーザーが存在しない場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testUnknownUserID)).Return(nil, errors.New("not found"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		_, err := uc.Verify2FA(ctx, Verify2FAInput{
-			UserID: "user-999",
+			UserID: testUnknownUserID,
 			Code:   "123456",
 		})
 
@@ -592,7 +610,7 @@ func TestAuthUseCase_Disable2FA(t *testing.T) {
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		err := uc.Disable2FA(ctx, Disable2FAInput{
-			UserID:   "user-001",
+			UserID:   testAuthUserID,
 			Password: "",
 		})
 
@@ -603,11 +621,11 @@ func TestAuthUseCase_Disable2FA(t *testing.T) {
 	t.Run("異常系: ユーザーが存在しない場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testUnknownUserID)).Return(nil, errors.New("not found"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
 		err := uc.Disable2FA(ctx, Disable2FAInput{
-			UserID:   "user-999",
+			UserID:   testUnknownUserID,
 			Password: "password123",
 		})
 
@@ -638,10 +656,10 @@ func TestAuthUseCase_RegenerateBackupCodes(t *testing.T) {
 	t.Run("異常系: ユーザーが存在しない場合はエラー", func(t *testing.T) {
 		mockUserRepo := new(MockUserRepository)
 		mockTokenRepo := new(MockRefreshTokenRepository)
-		mockUserRepo.On("FindByID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testUnknownUserID)).Return(nil, errors.New("not found"))
 
 		uc := newTestAuthUseCase(mockUserRepo, mockTokenRepo)
-		_, err := uc.RegenerateBackupCodes(ctx, "user-999")
+		_, err := uc.RegenerateBackupCodes(ctx, testUnknownUserID)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "ユーザーが見つかりません")
@@ -675,4 +693,170 @@ func TestAuthUseCase_VerifyToken_Invalid(t *testing.T) {
 
 		require.Error(t, err)
 	})
-}
\ No newline at end of file
+}
+// ===========================
+// Token Blacklist Tests
+// ===========================
+
+// fakeTokenBlacklist はテスト用の ports.TokenBlacklist の実装
+type fakeTokenBlacklist struct {
+	jtis          map[string]time.Time
+	revokedBefore map[string]time.Time
+	err           error
+}
+
+func newFakeTokenBlacklist() *fakeTokenBlacklist {
+	return &fakeTokenBlacklist{jtis: map[string]time.Time{}, revokedBefore: map[string]time.Time{}}
+}
+
+func (f *fakeTokenBlacklist) Add(ctx context.Context, jti string, expiresAt time.Time) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.jtis[jti] = expiresAt
+	return nil
+}
+
+func (f *fakeTokenBlacklist) Contains(ctx context.Context, jti string) (bool, error) {
+	_, ok := f.jtis[jti]
+	return ok, f.err
+}
+
+func (f *fakeTokenBlacklist) RevokeUserTokens(ctx context.Context, userID string, issuedBefore, expiresAt time.Time) error {
+	f.revokedBefore[userID] = issuedBefore
+	return f.err
+}
+
+func (f *fakeTokenBlacklist) UserTokensRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	return f.revokedBefore[userID], f.err
+}
+
+// newTestAuthUseCaseWithBlacklist はブラックリストを照合する認証ユースケースを作成するヘルパー
+func newTestAuthUseCaseWithBlacklist(userRepo *MockUserRepository, tokenRepo *MockRefreshTokenRepository, resetRepo *MockPasswordResetTokenRepository, blacklist *fakeTokenBlacklist) *authUseCase {
+	return NewAuthUseCaseWithTokenBlacklist(userRepo, tokenRepo, resetRepo, new(MockEmailService), testJWTSecret, testJWTExpiration, testRefreshTokenExpiration, nil, blacklist).(*authUseCase)
+}
+
+// signTestAccessToken は指定した発行日時・jti のアクセストークンを署名するヘルパー
+func signTestAccessToken(t *testing.T, jti string, issuedAt time.Time) string {
+	t.Helper()
+	claims := TokenClaims{
+		UserID: testAuthUserID,
+		Email:  "test@example.com",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(issuedAt.Add(testJWTExpiration)),
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testJWTSecret))
+	require.NoError(t, err)
+	return token
+}
+
+func TestAuthUseCase_RevokeAccessToken(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 失効させたトークンは有効期限内でも検証に失敗する", func(t *testing.T) {
+		blacklist := newFakeTokenBlacklist()
+		uc := newTestAuthUseCaseWithBlacklist(new(MockUserRepository), new(MockRefreshTokenRepository), new(MockPasswordResetTokenRepository), blacklist)
+		user, err := entities.NewUser(testAuthUserID, "test@example.com", "password123")
+		require.NoError(t, err)
+		token, expiresAt, err := uc.generateToken(user)
+		require.NoError(t, err)
+		other, _, err := uc.generateToken(user)
+		require.NoError(t, err)
+
+		claims, err := uc.VerifyToken(ctx, token)
+		require.NoError(t, err)
+		require.NotEmpty(t, claims.ID)
+
+		require.NoError(t, uc.RevokeAccessToken(ctx, token))
+
+		_, err = uc.VerifyToken(ctx, token)
+		assert.ErrorIs(t, err, ErrTokenRevoked)
+		assert.WithinDuration(t, expiresAt, blacklist.jtis[claims.ID], time.Second)
+		_, err = uc.VerifyToken(ctx, other)
+		assert.NoError(t, err, "同じユーザーの他のトークンは失効しないべきです")
+	})
+
+	t.Run("正常系: 期限切れのトークンは登録しない", func(t *testing.T) {
+		blacklist := newFakeTokenBlacklist()
+		uc := newTestAuthUseCaseWithBlacklist(new(MockUserRepository), new(MockRefreshTokenRepository), new(MockPasswordResetTokenRepository), blacklist)
+
+		err := uc.RevokeAccessToken(ctx, signTestAccessToken(t, "expired-jti", time.Now().Add(-time.Hour)))
+
+		require.NoError(t, err)
+		assert.Empty(t, blacklist.jtis)
+	})
+
+	t.Run("異常系: 不正なトークンの場合はエラー", func(t *testing.T) {
+		uc := newTestAuthUseCaseWithBlacklist(new(MockUserRepository), new(MockRefreshTokenRepository), new(MockPasswordResetTokenRepository), newFakeTokenBlacklist())
+
+		err := uc.RevokeAccessToken(ctx, "invalid.jwt.token")
+
+		require.Error(t, err)
+	})
+
+	t.Run("異常系: ブラックリストに保存できない場合はエラー", func(t *testing.T) {
+		blacklist := newFakeTokenBlacklist()
+		uc := newTestAuthUseCaseWithBlacklist(new(MockUserRepository), new(MockRefreshTokenRepository), new(MockPasswordResetTokenRepository), blacklist)
+		token := signTestAccessToken(t, "jti-1", time.Now())
+		blacklist.err = errors.New("store unavailable")
+
+		err := uc.RevokeAccessToken(ctx, token)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "トークンの失効に失敗しました")
+	})
+
+	t.Run("異常系: ブラックリストを照合できない場合は検証に失敗する", func(t *testing.T) {
+		blacklist := newFakeTokenBlacklist()
+		uc := newTestAuthUseCaseWithBlacklist(new(MockUserRepository), new(MockRefreshTokenRepository), new(MockPasswordResetTokenRepository), blacklist)
+		token := signTestAccessToken(t, "jti-1", time.Now())
+		blacklist.err = errors.New("store unavailable")
+
+		_, err := uc.VerifyToken(ctx, token)
+
+		require.Error(t, err)
+	})
+
+	t.Run("正常系: ブラックリスト未設定の場合は何もしない", func(t *testing.T) {
+		uc := newTestAuthUseCase(new(MockUserRepository), new(MockRefreshTokenRepository))
+		token := signTestAccessToken(t, "jti-1", time.Now())
+
+		require.NoError(t, uc.RevokeAccessToken(ctx, token))
+		_, err := uc.VerifyToken(ctx, token)
+		assert.NoError(t, err)
+	})
+}
+
+func TestAuthUseCase_ResetPassword_RevokesTokens(t *testing.T) {
+	ctx := context.Background()
+
+	mockUserRepo := new(MockUserRepository)
+	mockTokenRepo := new(MockRefreshTokenRepository)
+	mockResetRepo := new(MockPasswordResetTokenRepository)
+	blacklist := newFakeTokenBlacklist()
+	uc := newTestAuthUseCaseWithBlacklist(mockUserRepo, mockTokenRepo, mockResetRepo, blacklist)
+
+	user, err := entities.NewUser(testAuthUserID, "test@example.com", "password123")
+	require.NoError(t, err)
+	resetToken, plainToken, err := entities.NewPasswordResetToken(user.ID(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	mockResetRepo.On("FindByTokenHash", mock_anything(), sha256HexToken(plainToken)).Return(resetToken, nil)
+	mockResetRepo.On("Update", mock_anything(), resetToken).Return(nil)
+	mockUserRepo.On("FindByID", mock_anything(), user.ID()).Return(user, nil)
+	mockUserRepo.On("Update", mock_anything(), user).Return(nil)
+	mockTokenRepo.On("RevokeByUserID", mock_anything(), user.ID()).Return(nil)
+
+	issuedBeforeReset := signTestAccessToken(t, "before-reset", time.Now().Add(-time.Minute))
+
+	err = uc.ResetPassword(ctx, ResetPasswordInput{Token: plainToken, NewPassword: "newPassword456"})
+
+	require.NoError(t, err)
+	_, err = uc.VerifyToken(ctx, issuedBeforeReset)
+	assert.ErrorIs(t, err, ErrTokenRevoked, "リセット前に発行したトークンは失効するべきです")
+	_, err = uc.VerifyToken(ctx, signTestAccessToken(t, "after-reset", time.Now().Add(time.Second)))
+	assert.NoError(t, err, "リセット後に発行したトークンは有効であるべきです")
+	mockTokenRepo.AssertExpectations(t)
+}
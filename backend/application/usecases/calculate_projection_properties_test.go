@@ -0,0 +1,104 @@
+package usecases
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"pgregory.net/rapid"
+)
+
+// propertyTestChecks はプロパティテストの試行回数（-rapid.checks で上書きできる）
+const propertyTestChecks = "1000"
+
+// TestFinancialCalculationService_Properties は資産推移の計算（ProjectAssets と予測サマリー）が
+// ランダムな入力に対して常に満たすべき性質を検証する
+func TestFinancialCalculationService_Properties(t *testing.T) {
+	if f := flag.Lookup("rapid.checks"); f != nil && f.Value.String() == f.DefValue {
+		if err := flag.Set("rapid.checks", propertyTestChecks); err != nil {
+			t.Fatalf("試行回数の設定に失敗しました: %v", err)
+		}
+		t.Cleanup(func() { _ = flag.Set("rapid.checks", f.DefValue) })
+	}
+
+	uc := NewCalculateProjectionUseCase(nil, nil, services.NewFinancialCalculationService(), nil).(*calculateProjectionUseCaseImpl)
+
+	rapid.Check(t, func(t *rapid.T) {
+		annualRate := rapid.Float64Range(0, 20).Draw(t, "annualRate")
+		inflationRate := rapid.Float64Range(0, 10).Draw(t, "inflationRate")
+		years := rapid.IntRange(1, 50).Draw(t, "years")
+		initialAmount := rapid.Float64Range(1_000, 10_000_000).Draw(t, "initialAmount")
+		monthlyContribution := rapid.Float64Range(1_000, 500_000).Draw(t, "monthlyContribution")
+
+		profile := newPropertyTestProfile(t, annualRate, inflationRate, initialAmount, monthlyContribution)
+		projections, err := profile.ProjectAssets(years)
+		if err != nil {
+			t.Fatalf("資産推移の計算に失敗しました: %v", err)
+		}
+
+		// (1) 予測年数と同じ件数を返す
+		if len(projections) != years {
+			t.Fatalf("予測件数が年数と一致しません: got %d, want %d", len(projections), years)
+		}
+
+		// (2) 積立が正の場合、総資産は前年を下回らない
+		for i := 1; i < len(projections); i++ {
+			if projections[i].TotalAssets.Amount() < projections[i-1].TotalAssets.Amount() {
+				t.Fatalf("%d年目の総資産が前年を下回りました: %.2f < %.2f",
+					projections[i].Year, projections[i].TotalAssets.Amount(), projections[i-1].TotalAssets.Amount())
+			}
+		}
+
+		summary := summarizeProjections(uc.calculationService, projections)
+
+		// (3) 最終金額は最終年の総資産と一致する
+		if summary.FinalAmount != projections[len(projections)-1].TotalAssets.Amount() {
+			t.Fatalf("最終金額が最終年の総資産と一致しません: got %.2f, want %.2f",
+				summary.FinalAmount, projections[len(projections)-1].TotalAssets.Amount())
+		}
+
+		// (4) 利回りがインフレ率を上回る場合、成長率は負にならない
+		if annualRate > inflationRate && summary.GrowthPercentage < 0 {
+			t.Fatalf("利回り %.2f%% > インフレ率 %.2f%% なのに成長率が負です: %.4f%%",
+				annualRate, inflationRate, summary.GrowthPercentage)
+		}
+	})
+}
+
+// newPropertyTestProfile は月間の純貯蓄額が monthlyContribution になる財務プロファイルを作成する
+func newPropertyTestProfile(t *rapid.T, annualRate, inflationRate, initialAmount, monthlyContribution float64) *entities.FinancialProfile {
+	const monthlyExpenses = 200_000
+
+	income, err := valueobjects.NewMoneyJPY(monthlyExpenses + monthlyContribution)
+	if err != nil {
+		t.Fatalf("月収の作成に失敗しました: %v", err)
+	}
+	expense, _ := valueobjects.NewMoneyJPY(monthlyExpenses)
+	savings, err := valueobjects.NewMoneyJPY(initialAmount)
+	if err != nil {
+		t.Fatalf("貯蓄額の作成に失敗しました: %v", err)
+	}
+	investmentReturn, err := valueobjects.NewRate(annualRate)
+	if err != nil {
+		t.Fatalf("利回りの作成に失敗しました: %v", err)
+	}
+	inflation, err := valueobjects.NewRate(inflationRate)
+	if err != nil {
+		t.Fatalf("インフレ率の作成に失敗しました: %v", err)
+	}
+
+	profile, err := entities.NewFinancialProfile(
+		"user-001",
+		income,
+		entities.ExpenseCollection{{Category: "生活費", Amount: expense}},
+		entities.SavingsCollection{{Type: "deposit", Amount: savings}},
+		investmentReturn,
+		inflation,
+	)
+	if err != nil {
+		t.Fatalf("財務プロファイルの作成に失敗しました: %v", err)
+	}
+	return profile
+}
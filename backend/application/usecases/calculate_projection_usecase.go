@@ -2,13 +2,19 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
 	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/financial-planning-calculator/backend/infrastructure/log"
 )
 
@@ -17,6 +23,10 @@ type CalculateProjectionUseCase interface {
 	// CalculateAssetProjection は資産推移を計算する
 	CalculateAssetProjection(ctx context.Context, input AssetProjectionInput) (*AssetProjectionOutput, error)
 
+	// StreamAssetProjection は資産推移を1年ずつ fn に渡す（全期間のスライスを保持しないストリーミング出力用）
+	// 推移より先にサマリーとリスク評価を header に渡す
+	StreamAssetProjection(ctx context.Context, input AssetProjectionInput, header func(*AssetProjectionStreamHeader) error, fn func(entities.AssetProjection) error) error
+
 	// CalculateRetirementProjection は退職資金予測を計算する
 	CalculateRetirementProjection(ctx context.Context, input RetirementProjectionInput) (*RetirementProjectionOutput, error)
 
@@ -26,20 +36,76 @@ type CalculateProjectionUseCase interface {
 	// CalculateComprehensiveProjection は包括的な財務予測を計算する
 	CalculateComprehensiveProjection(ctx context.Context, input ComprehensiveProjectionInput) (*ComprehensiveProjectionOutput, error)
 
+	// CalculateQuickProjection は1年後の資産だけを予測する軽量な計算（ダッシュボード表示用）
+	CalculateQuickProjection(ctx context.Context, input QuickProjectionInput) (*QuickProjectionOutput, error)
+
 	// CalculateGoalProjection は目標達成予測を計算する
 	CalculateGoalProjection(ctx context.Context, input GoalProjectionInput) (*GoalProjectionOutput, error)
+
+	// EstimatePension は加入年数と平均月収から公的年金の見込み額を推定する
+	EstimatePension(ctx context.Context, input PensionEstimateInput) (*PensionEstimateOutput, error)
+
+	// CalculateTimeToAmount は目標金額に到達するまでの期間を計算する
+	CalculateTimeToAmount(ctx context.Context, input TimeToAmountInput) (*TimeToAmountOutput, error)
+
+	// CalculateRequiredReturn は指定年数で目標金額に到達するために必要な年利回りを計算する
+	CalculateRequiredReturn(ctx context.Context, input RequiredReturnInput) (*RequiredReturnOutput, error)
+
+	// CalculateRequiredSavingsRate はアクティブな全目標を達成するために必要な貯蓄率を計算する
+	CalculateRequiredSavingsRate(ctx context.Context, input RequiredSavingsRateInput) (*RequiredSavingsRateOutput, error)
+
+	// CalculateAmortization は住宅ローンなどを元利均等返済した場合の返済予定を計算する
+	CalculateAmortization(ctx context.Context, input AmortizationInput) (*AmortizationOutput, error)
 }
 
 // AssetProjectionInput は資産推移計算の入力
+// GoalID を指定した場合は財務プロファイル全体ではなく、その目標の現在額と月間拠出額だけで推移を計算する
 type AssetProjectionInput struct {
-	UserID entities.UserID `json:"user_id"`
-	Years  int             `json:"years"`
+	UserID entities.UserID  `json:"user_id"`
+	Years  int              `json:"years"`
+	GoalID *entities.GoalID `json:"goal_id,omitempty"`
+	// ContributionTiming は積立を各月の期初・期末のどちらに行うか（未指定の場合は財務プロファイルの設定で、既定は期末拠出）
+	ContributionTiming valueobjects.ContributionTiming `json:"contribution_timing,omitempty"`
 }
 
 // AssetProjectionOutput は資産推移計算の出力
 type AssetProjectionOutput struct {
 	Projections []entities.AssetProjection `json:"projections"`
 	Summary     ProjectionSummary          `json:"summary"`
+	Risk        RiskAnalysis               `json:"risk"`
+	// LiabilityPayoffs は負債ごとの完済予定（目標を指定した場合や負債が未登録の場合は空）
+	LiabilityPayoffs []LiabilityPayoff `json:"liability_payoffs,omitempty"`
+	Assumptions      Assumptions       `json:"assumptions"`
+}
+
+// AssetProjectionStreamHeader は資産推移のストリーミング出力で推移より先に返すサマリー
+// 全期間の推移を保持しないため、Risk に最悪ケースの推移（WorstCaseProjections）は含まない
+type AssetProjectionStreamHeader struct {
+	Years            int               `json:"years"`
+	Summary          ProjectionSummary `json:"summary"`
+	Risk             RiskAnalysis      `json:"risk"`
+	LiabilityPayoffs []LiabilityPayoff `json:"liability_payoffs,omitempty"`
+	Assumptions      Assumptions       `json:"assumptions"`
+}
+
+// LiabilityPayoff は負債の完済予定
+type LiabilityPayoff struct {
+	LiabilityID string `json:"liability_id"`
+	Name        string `json:"name"`
+	PayoffDate  string `json:"payoff_date"` // YYYY-MM-DD
+}
+
+// RiskAnalysis はボラティリティを考慮した資産推移の評価
+type RiskAnalysis struct {
+	ExpectedReturn       float64                    `json:"expected_return"`                // 期待リターン（年率%）
+	Volatility           float64                    `json:"volatility"`                     // 想定ボラティリティ（年率%）
+	VolatilityEstimated  bool                       `json:"volatility_estimated"`           // 貯蓄の内訳から推定したボラティリティか
+	RiskAdjustedReturn   *float64                   `json:"risk_adjusted_return,omitempty"` // リスク調整後リターン（シャープレシオ）。ボラティリティが0の場合は省略
+	RiskTolerance        string                     `json:"risk_tolerance"`
+	ExceedsRiskTolerance bool                       `json:"exceeds_risk_tolerance"` // ボラティリティがリスク許容度の上限を超えているか
+	WorstCaseReturn      float64                    `json:"worst_case_return"`      // 最悪ケースの年率リターン（期待リターン - 2σ）
+	WorstCaseFinalAmount float64                    `json:"worst_case_final_amount"`
+	WorstCaseProjections []entities.AssetProjection `json:"worst_case_projections,omitempty"` // 最悪ケースのリターンが続いた場合の資産推移（ストリーミング出力では省略）
 }
 
 // ProjectionSummary は予測サマリー
@@ -49,19 +115,47 @@ type ProjectionSummary struct {
 	TotalGrowth      float64 `json:"total_growth"`
 	GrowthPercentage float64 `json:"growth_percentage"`
 	AverageReturn    float64 `json:"average_return"`
+	TargetAmount     float64 `json:"target_amount,omitempty"`  // 目標金額（目標を指定した場合のみ）
+	TargetReached    bool    `json:"target_reached,omitempty"` // 最終金額が目標金額に到達するか（目標を指定した場合のみ）
+}
+
+// MarshalJSON は金額を円単位の整数、割合を小数点以下2桁に丸めてシリアライズする
+func (s ProjectionSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		InitialAmount    yenJSON     `json:"initial_amount"`
+		FinalAmount      yenJSON     `json:"final_amount"`
+		TotalGrowth      yenJSON     `json:"total_growth"`
+		GrowthPercentage percentJSON `json:"growth_percentage"`
+		AverageReturn    percentJSON `json:"average_return"`
+		TargetAmount     yenJSON     `json:"target_amount,omitempty"`
+		TargetReached    bool        `json:"target_reached,omitempty"`
+	}{
+		InitialAmount:    yenJSON(s.InitialAmount),
+		FinalAmount:      yenJSON(s.FinalAmount),
+		TotalGrowth:      yenJSON(s.TotalGrowth),
+		GrowthPercentage: percentJSON(s.GrowthPercentage),
+		AverageReturn:    percentJSON(s.AverageReturn),
+		TargetAmount:     yenJSON(s.TargetAmount),
+		TargetReached:    s.TargetReached,
+	})
 }
 
 // RetirementProjectionInput は退職資金予測計算の入力
+// LifestyleExpenses で生活水準ごとの月間生活費を指定した場合は、目安の代わりにその金額で生活水準別シナリオを計算する
 type RetirementProjectionInput struct {
-	UserID entities.UserID `json:"user_id"`
+	UserID            entities.UserID                          `json:"user_id"`
+	LifestyleExpenses map[entities.RetirementLifestyle]float64 `json:"lifestyle_expenses,omitempty"`
 }
 
 // RetirementProjectionOutput は退職資金予測計算の出力
 type RetirementProjectionOutput struct {
-	Calculation        *entities.RetirementCalculation `json:"calculation"`
-	Recommendations    []string                        `json:"recommendations"`
-	SufficiencyLevel   string                          `json:"sufficiency_level"`
-	RequiredAdjustment *RequiredAdjustment             `json:"required_adjustment,omitempty"`
+	Calculation        *entities.RetirementCalculation           `json:"calculation"`
+	Recommendations    []string                                  `json:"recommendations"`
+	SufficiencyLevel   string                                    `json:"sufficiency_level"`
+	RequiredAdjustment *RequiredAdjustment                       `json:"required_adjustment,omitempty"`
+	DrawdownSchedule   []services.DrawdownEntry                  `json:"drawdown_schedule,omitempty"` // 充足率150%未満の場合の退職後の年ごとの資産推移
+	LifestyleScenarios []entities.LifestyleRetirementCalculation `json:"lifestyle_scenarios"`         // 生活水準（ゆとり・標準・最低限）ごとの充足度
+	Assumptions        Assumptions                               `json:"assumptions"`                 // 期間は退職までの年数
 }
 
 // RequiredAdjustment は必要な調整
@@ -83,6 +177,8 @@ type EmergencyFundProjectionOutput struct {
 	Recommendations []string                        `json:"recommendations"`
 	Priority        string                          `json:"priority"`
 	Timeline        *EmergencyFundTimeline          `json:"timeline"`
+	Pace            *aggregates.EmergencyFundPace   `json:"pace,omitempty"` // 積立履歴がある場合のみ
+	Assumptions     Assumptions                     `json:"assumptions"`
 }
 
 // EmergencyFundTimeline は緊急資金達成タイムライン
@@ -111,6 +207,28 @@ type ComprehensiveProjectionOutput struct {
 	Insights       []FinancialInsight         `json:"insights"`
 	Warnings       []FinancialWarning         `json:"warnings"`
 	Opportunities  []FinancialOpportunity     `json:"opportunities"`
+	Assumptions    Assumptions                `json:"assumptions"`
+}
+
+// QuickProjectionInput は1年後の簡易予測の入力
+type QuickProjectionInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// QuickProjectionOutput は1年後の簡易予測の出力
+// 包括的予測（ComprehensiveProjectionOutput）と異なり、1年分の資産推移だけを計算した結果を返す
+type QuickProjectionOutput struct {
+	CurrentAssets        float64 `json:"current_assets"`
+	ProjectedAssets1Year float64 `json:"projected_assets_1_year"`
+	EstimatedGrowth      float64 `json:"estimated_growth"` // 1年後の資産 - 現在の資産
+	SavingsRate          float64 `json:"savings_rate"`     // %
+	// EmergencyFundStatus は緊急資金の状況（緊急資金が未設定の場合は省略）
+	EmergencyFundStatus *QuickEmergencyFundStatus `json:"emergency_fund_status,omitempty"`
+}
+
+// QuickEmergencyFundStatus は簡易予測に含める緊急資金の状況
+type QuickEmergencyFundStatus struct {
+	MonthsOfExpensesCovered float64 `json:"months_of_expenses_covered"` // 現在の緊急資金で賄える月間支出の月数
 }
 
 // FinancialInsight は財務洞察
@@ -136,33 +254,225 @@ type FinancialOpportunity struct {
 	Title       string  `json:"title"`
 	Description string  `json:"description"`
 	Benefit     string  `json:"benefit"`
-	Effort      string  `json:"effort"` // "low", "medium", "high"
+	Effort      string  `json:"effort"`         // "low", "medium", "high"
+	Risk        string  `json:"risk,omitempty"` // 提案を実行した場合のリスク "low", "medium", "high"
 	Impact      float64 `json:"impact"`
 }
 
+// GoalProjectionGranularity は目標進捗予測のデータ点の粒度
+type GoalProjectionGranularity string
+
+const (
+	// GoalProjectionGranularityWeekly は1週間ごとにデータ点を返す
+	GoalProjectionGranularityWeekly GoalProjectionGranularity = "weekly"
+	// GoalProjectionGranularityMonthly は1ヶ月ごとにデータ点を返す
+	GoalProjectionGranularityMonthly GoalProjectionGranularity = "monthly"
+)
+
+// GoalProjectionScenarioType は月間拠出額の幅に応じた目標進捗予測のシナリオ
+type GoalProjectionScenarioType string
+
+const (
+	// GoalProjectionScenarioPessimistic は月間拠出額の最低額で拠出するシナリオ
+	GoalProjectionScenarioPessimistic GoalProjectionScenarioType = "pessimistic"
+	// GoalProjectionScenarioExpected は月間拠出額（目標額）で拠出するシナリオ
+	GoalProjectionScenarioExpected GoalProjectionScenarioType = "expected"
+	// GoalProjectionScenarioOptimistic は月間拠出額のストレッチ額で拠出するシナリオ
+	GoalProjectionScenarioOptimistic GoalProjectionScenarioType = "optimistic"
+)
+
+const (
+	// MaxGoalProjectionPoints は目標進捗予測で返すデータ点の上限
+	MaxGoalProjectionPoints = 600
+	// weeklyGoalProjectionMaxMonths は粒度未指定時に週次で予測する残り月数の上限
+	weeklyGoalProjectionMaxMonths = 6
+)
+
 // GoalProjectionInput は目標達成予測計算の入力
 type GoalProjectionInput struct {
 	UserID entities.UserID `json:"user_id"`
 	GoalID entities.GoalID `json:"goal_id"`
+	// Granularity は進捗予測の粒度。未指定の場合は残り6ヶ月以内の目標を週次、それ以外を月次で予測する
+	Granularity GoalProjectionGranularity `json:"granularity,omitempty"`
 }
 
 // GoalProjectionOutput は目標達成予測計算の出力
 type GoalProjectionOutput struct {
-	Goal            *entities.Goal                `json:"goal"`
-	Progress        entities.ProgressRate         `json:"progress"`
-	Projection      []GoalProgressProjection      `json:"projection"`
-	Recommendations []services.GoalRecommendation `json:"recommendations"`
-	Feasibility     map[string]interface{}        `json:"feasibility"`
+	Goal                    *entities.Goal                `json:"goal"`
+	Progress                entities.ProgressRate         `json:"progress"`
+	EffectiveStartDate      string                        `json:"effective_start_date"`                // 拠出を開始する日（前提目標がある場合はその完了見込み日）
+	EstimatedCompletionDate string                        `json:"estimated_completion_date,omitempty"` // 現在の月間拠出額での完了見込み日
+	Projection              []GoalProgressProjection      `json:"projection"`
+	Scenarios               []GoalProjectionScenario      `json:"scenarios"`                // 月間拠出額の幅に応じた悲観・想定・楽観の順の進捗予測（最低額・ストレッチ額が未設定の場合は想定のみ）
+	ProjectionGranularity   GoalProjectionGranularity     `json:"projection_granularity"`   // データ点の粒度（週次は目標日までの週数、月次は月数だけデータ点を返す）
+	ProjectionTruncated     bool                          `json:"projection_truncated"`     // データ点が MaxGoalProjectionPoints を超えたため目標日より前で打ち切った
+	RequiredMonthlySavings  float64                       `json:"required_monthly_savings"` // 期限までに達成するための月次必要額
+	FinalPush               bool                          `json:"final_push"`               // 残り1ヶ月以内のため月次必要額は残額全額（1回で拠出）
+	Recommendations         []services.GoalRecommendation `json:"recommendations"`
+	Feasibility             map[string]interface{}        `json:"feasibility"`
+	Assumptions             Assumptions                   `json:"assumptions"`
+}
+
+// GoalProjectionScenario は月間拠出額のシナリオごとの目標進捗予測
+type GoalProjectionScenario struct {
+	Scenario                GoalProjectionScenarioType `json:"scenario"`
+	MonthlyContribution     float64                    `json:"monthly_contribution"`
+	EstimatedCompletionDate string                     `json:"estimated_completion_date,omitempty"` // このシナリオの月間拠出額での完了見込み日
+	Projection              []GoalProgressProjection   `json:"projection"`
 }
 
 // GoalProgressProjection は目標進捗予測
+// 週次の場合は Week、月次の場合は Month に現在からの経過期間が入る
 type GoalProgressProjection struct {
-	Month           int     `json:"month"`
+	Week            int     `json:"week,omitempty"`
+	Month           int     `json:"month,omitempty"`
+	Date            string  `json:"date"` // データ点の日付（YYYY-MM-DD）
 	ProjectedAmount float64 `json:"projected_amount"`
 	ProgressRate    float64 `json:"progress_rate"`
 	OnTrack         bool    `json:"on_track"`
 }
 
+// MarshalJSON は予測金額を円単位の整数、進捗率を小数点以下2桁に丸めてシリアライズする
+func (p GoalProgressProjection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Week            int         `json:"week,omitempty"`
+		Month           int         `json:"month,omitempty"`
+		Date            string      `json:"date"`
+		ProjectedAmount yenJSON     `json:"projected_amount"`
+		ProgressRate    percentJSON `json:"progress_rate"`
+		OnTrack         bool        `json:"on_track"`
+	}{
+		Week:            p.Week,
+		Month:           p.Month,
+		Date:            p.Date,
+		ProjectedAmount: yenJSON(p.ProjectedAmount),
+		ProgressRate:    percentJSON(p.ProgressRate),
+		OnTrack:         p.OnTrack,
+	})
+}
+
+// PensionEstimateInput は年金見込み額推定の入力
+type PensionEstimateInput struct {
+	ContributionYears int     `json:"contribution_years"`
+	MonthlyIncome     float64 `json:"monthly_income"`
+	BirthYear         int     `json:"birth_year"`
+}
+
+// PensionEstimateOutput は年金見込み額推定の出力
+type PensionEstimateOutput struct {
+	EstimatedMonthlyPension float64 `json:"estimated_monthly_pension"`
+	TotalLifetimePension    float64 `json:"total_lifetime_pension"`
+}
+
+// AmortizationInput は返済予定計算の入力
+type AmortizationInput struct {
+	LoanAmount float64 `json:"loan_amount"`
+	AnnualRate float64 `json:"annual_rate"` // 年利（%）
+	TermMonths int     `json:"term_months"`
+}
+
+// AmortizationOutput は返済予定計算の出力
+type AmortizationOutput struct {
+	LoanAmount     float64                      `json:"loan_amount"`
+	AnnualRate     float64                      `json:"annual_rate"`
+	TermMonths     int                          `json:"term_months"`
+	MonthlyPayment float64                      `json:"monthly_payment"` // 毎月の返済額（最終回は端数の調整で異なる場合がある）
+	TotalPayment   float64                      `json:"total_payment"`
+	TotalInterest  float64                      `json:"total_interest"`
+	Schedule       []services.AmortizationEntry `json:"schedule"`
+}
+
+// TimeToAmountInput は目標金額到達期間計算の入力
+// MonthlyContribution・AnnualReturn が未指定の場合は財務プロファイルの純貯蓄額・投資利回りを使う
+type TimeToAmountInput struct {
+	UserID              entities.UserID `json:"user_id"`
+	TargetAmount        float64         `json:"target_amount"`
+	MonthlyContribution *float64        `json:"monthly_contribution,omitempty"`
+	AnnualReturn        *float64        `json:"annual_return,omitempty"`
+}
+
+// TimeToAmountOutput は目標金額到達期間計算の出力
+type TimeToAmountOutput struct {
+	TargetAmount        float64 `json:"target_amount"`
+	CurrentAssets       float64 `json:"current_assets"`
+	MonthlyContribution float64 `json:"monthly_contribution"`
+	AnnualReturn        float64 `json:"annual_return"`
+	Months              int     `json:"months"`
+	Years               float64 `json:"years"`
+	Reachable           bool    `json:"reachable"`
+	// Assumptions の投資利回りは AnnualReturn（指定した場合はその値）
+	Assumptions Assumptions `json:"assumptions"`
+}
+
+// RequiredReturnInput は必要利回り計算の入力
+// UnrealisticThreshold が未指定の場合は15%を閾値とする
+type RequiredReturnInput struct {
+	UserID               entities.UserID `json:"user_id"`
+	TargetAmount         float64         `json:"target_amount"`
+	Years                int             `json:"years"`
+	UnrealisticThreshold *float64        `json:"unrealistic_threshold,omitempty"`
+}
+
+// RequiredReturnOutput は必要利回り計算の出力
+type RequiredReturnOutput struct {
+	TargetAmount         float64 `json:"target_amount"`
+	Years                int     `json:"years"`
+	CurrentAssets        float64 `json:"current_assets"`
+	MonthlyContribution  float64 `json:"monthly_contribution"`
+	RequiredAnnualReturn float64 `json:"required_annual_return"`
+	Reachable            bool    `json:"reachable"`
+	Unrealistic          bool    `json:"unrealistic"`
+	UnrealisticThreshold float64 `json:"unrealistic_threshold"`
+	// Assumptions の投資利回りは比較用の現在の設定値（計算には RequiredAnnualReturn を使う）
+	Assumptions Assumptions `json:"assumptions"`
+}
+
+// RequiredSavingsRateInput は必要貯蓄率計算の入力
+type RequiredSavingsRateInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// RequiredSavingsRateOutput は必要貯蓄率計算の出力
+// 純貯蓄額で全目標を賄える場合は Surplus、賄えない場合は AdditionalMonthlySavingsNeeded と見直し候補を返す
+type RequiredSavingsRateOutput struct {
+	MonthlyIncome                  float64                  `json:"monthly_income"`
+	CurrentNetSavings              float64                  `json:"current_net_savings"`
+	CurrentSavingsRate             float64                  `json:"current_savings_rate"` // %
+	TotalRequiredMonthlySavings    float64                  `json:"total_required_monthly_savings"`
+	RequiredSavingsRate            float64                  `json:"required_savings_rate"` // %
+	Surplus                        *float64                 `json:"surplus,omitempty"`
+	AdditionalMonthlySavingsNeeded *float64                 `json:"additional_monthly_savings_needed,omitempty"`
+	Goals                          []GoalSavingsRequirement `json:"goals"`
+	DeprioritizeSuggestions        []DeprioritizeSuggestion `json:"deprioritize_suggestions,omitempty"`
+	Assumptions                    Assumptions              `json:"assumptions"`
+}
+
+// GoalSavingsRequirement は目標ごとの月間必要貯蓄額
+type GoalSavingsRequirement struct {
+	GoalID                 entities.GoalID   `json:"goal_id"`
+	Title                  string            `json:"title"`
+	GoalType               entities.GoalType `json:"goal_type"`
+	RequiredMonthlySavings float64           `json:"required_monthly_savings"`
+	DeferredUntil          string            `json:"deferred_until,omitempty"` // 前提目標の完了見込み日（この日まで拠出せず、必要額の合計にも含めない）
+}
+
+// DeprioritizeSuggestion は負担を減らすために後回しにする目標の候補
+type DeprioritizeSuggestion struct {
+	GoalID                 entities.GoalID   `json:"goal_id"`
+	Title                  string            `json:"title"`
+	GoalType               entities.GoalType `json:"goal_type"`
+	RequiredMonthlySavings float64           `json:"required_monthly_savings"`
+	RemainingGap           float64           `json:"remaining_gap"` // この目標までを後回しにした後に残る月間不足額
+	Reason                 string            `json:"reason"`
+}
+
+const (
+	// pensionStartAge は年金の受給開始年齢
+	pensionStartAge = 65
+	// defaultLifeExpectancy は生涯受給額の算出に用いる平均寿命
+	defaultLifeExpectancy = 85
+)
+
 // calculateProjectionUseCaseImpl はCalculateProjectionUseCaseの実装
 type calculateProjectionUseCaseImpl struct {
 	financialPlanRepo     repositories.FinancialPlanRepository
@@ -194,21 +504,17 @@ func (uc *calculateProjectionUseCaseImpl) CalculateAssetProjection(
 	input AssetProjectionInput,
 ) (*AssetProjectionOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "CalculateAssetProjection",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 		slog.Int("years", input.Years),
 	)
 
-	// 財務計画を取得
-	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	target, err := uc.findAssetProjectionTarget(ctx, "CalculateAssetProjection", input)
 	if err != nil {
-		uc.logger.OperationError(ctx, "CalculateAssetProjection", err,
-			slog.String("step", "find_plan"),
-		)
-		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+		return nil, err
 	}
 
 	// 資産推移を計算
-	projections, err := plan.Profile().ProjectAssets(input.Years)
+	projections, err := target.project(input.Years)
 	if err != nil {
 		uc.logger.OperationError(ctx, "CalculateAssetProjection", err,
 			slog.String("step", "project_assets"),
@@ -217,12 +523,16 @@ func (uc *calculateProjectionUseCaseImpl) CalculateAssetProjection(
 	}
 
 	// サマリーを計算
-	summary, err := uc.calculateProjectionSummary(projections)
+	summary := summarizeProjections(uc.calculationService, projections)
+	target.applyGoal(summary)
+
+	// 最悪ケースを含むリスク評価を計算
+	risk, err := calculateRiskAnalysis(target.profile, target.initialAmount, target.monthlyContribution, input.Years)
 	if err != nil {
 		uc.logger.OperationError(ctx, "CalculateAssetProjection", err,
-			slog.String("step", "calculate_summary"),
+			slog.String("step", "calculate_risk"),
 		)
-		return nil, fmt.Errorf("予測サマリーの計算に失敗しました: %w", err)
+		return nil, fmt.Errorf("リスク評価の計算に失敗しました: %w", err)
 	}
 
 	uc.logger.EndOperation(ctx, "CalculateAssetProjection",
@@ -230,18 +540,183 @@ func (uc *calculateProjectionUseCaseImpl) CalculateAssetProjection(
 	)
 
 	return &AssetProjectionOutput{
-		Projections: projections,
-		Summary:     *summary,
+		Projections:      projections,
+		Summary:          *summary,
+		Risk:             *risk,
+		LiabilityPayoffs: target.payoffs,
+		Assumptions:      newAssumptions(target.profile, input.Years, time.Now()),
 	}, nil
 }
 
+// StreamAssetProjection は資産推移を1年ずつ fn に渡す
+// サマリーには初年と最終年の値が必要なため、推移を保持せずに一度計算してから header を渡し、改めて計算しながら fn に渡す
+func (uc *calculateProjectionUseCaseImpl) StreamAssetProjection(
+	ctx context.Context,
+	input AssetProjectionInput,
+	header func(*AssetProjectionStreamHeader) error,
+	fn func(entities.AssetProjection) error,
+) error {
+	ctx = uc.logger.StartOperation(ctx, "StreamAssetProjection",
+		log.UserID(string(input.UserID)),
+		slog.Int("years", input.Years),
+	)
+
+	target, err := uc.findAssetProjectionTarget(ctx, "StreamAssetProjection", input)
+	if err != nil {
+		return err
+	}
+
+	// 1回目: 初年と最終年だけを保持してサマリーを計算する
+	var first, last entities.AssetProjection
+	err = target.stream(input.Years, func(projection entities.AssetProjection) error {
+		if projection.Year == 1 {
+			first = projection
+		}
+		last = projection
+		return nil
+	})
+	if err != nil {
+		uc.logger.OperationError(ctx, "StreamAssetProjection", err,
+			slog.String("step", "project_assets"),
+		)
+		return fmt.Errorf("資産推移の計算に失敗しました: %w", err)
+	}
+	summary := summarizeProjectionRange(uc.calculationService, first, last, input.Years)
+	target.applyGoal(summary)
+
+	// 最悪ケースも最終年の金額だけを保持する
+	var worstCaseFinal entities.AssetProjection
+	err = target.profile.ProjectWorstCaseAssetsFromStream(target.initialAmount, target.monthlyContribution, input.Years, func(projection entities.AssetProjection) error {
+		worstCaseFinal = projection
+		return nil
+	})
+	if err != nil {
+		uc.logger.OperationError(ctx, "StreamAssetProjection", err,
+			slog.String("step", "calculate_risk"),
+		)
+		return fmt.Errorf("リスク評価の計算に失敗しました: %w", err)
+	}
+	risk := newRiskAnalysis(target.profile, worstCaseFinal.TotalAssets.Amount(), nil)
+
+	if err := header(&AssetProjectionStreamHeader{
+		Years:            input.Years,
+		Summary:          *summary,
+		Risk:             *risk,
+		LiabilityPayoffs: target.payoffs,
+		Assumptions:      newAssumptions(target.profile, input.Years, time.Now()),
+	}); err != nil {
+		return err
+	}
+
+	// 2回目: 計算しながら1年ずつ渡す（クライアントが切断した場合は中断する）
+	err = target.stream(input.Years, func(projection entities.AssetProjection) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return fn(projection)
+	})
+	if err != nil {
+		uc.logger.OperationError(ctx, "StreamAssetProjection", err,
+			slog.String("step", "stream_projections"),
+		)
+		return err
+	}
+
+	uc.logger.EndOperation(ctx, "StreamAssetProjection",
+		slog.Int("projection_count", input.Years),
+	)
+	return nil
+}
+
+// assetProjectionTarget は資産推移の計算対象（財務計画全体、または指定された目標）
+type assetProjectionTarget struct {
+	profile             *entities.FinancialProfile
+	goal                *entities.Goal
+	initialAmount       valueobjects.Money
+	monthlyContribution valueobjects.Money
+	payoffs             []LiabilityPayoff
+}
+
+// findAssetProjectionTarget は財務計画と指定された目標を取得し、資産推移の計算対象を決める
+func (uc *calculateProjectionUseCaseImpl) findAssetProjectionTarget(ctx context.Context, operation string, input AssetProjectionInput) (*assetProjectionTarget, error) {
+	// 財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, operation, err,
+			slog.String("step", "find_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	// 拠出タイミングを指定した場合は今回の計算に限りプロファイルの設定を置き換える（保存はしない）
+	if input.ContributionTiming != "" {
+		if err := plan.Profile().SetContributionTiming(input.ContributionTiming); err != nil {
+			return nil, err
+		}
+	}
+
+	// 目標を指定した場合はその目標の現在額と月間拠出額だけで推移を計算する
+	target := &assetProjectionTarget{profile: plan.Profile()}
+	if input.GoalID != nil {
+		goal, err := uc.goalRepo.FindByID(ctx, *input.GoalID)
+		if err != nil {
+			uc.logger.OperationError(ctx, operation, err,
+				slog.String("step", "find_goal"),
+			)
+			return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+		}
+		if goal.UserID() != input.UserID {
+			return nil, errors.New("指定された目標にアクセスする権限がありません")
+		}
+		target.goal = goal
+		target.initialAmount, target.monthlyContribution = goal.CurrentAmount(), goal.MonthlyContribution()
+		return target, nil
+	}
+
+	// 目標を指定しない場合は負債の返済予定を考慮し、完済後の返済額を積立に回す
+	target.initialAmount, target.monthlyContribution, err = resolveAssetsAndContribution(plan, nil)
+	if err != nil {
+		uc.logger.OperationError(ctx, operation, err,
+			slog.String("step", "project_assets"),
+		)
+		return nil, fmt.Errorf("資産推移の計算に失敗しました: %w", err)
+	}
+	target.payoffs = liabilityPayoffs(plan.Profile().Liabilities(), time.Now())
+	return target, nil
+}
+
+// project は全期間の資産推移を計算する
+func (t *assetProjectionTarget) project(years int) ([]entities.AssetProjection, error) {
+	if t.goal != nil {
+		return t.profile.ProjectAssetsFrom(t.initialAmount, t.monthlyContribution, years)
+	}
+	return t.profile.ProjectAssets(years)
+}
+
+// stream は資産推移を1年ずつ fn に渡す
+func (t *assetProjectionTarget) stream(years int, fn func(entities.AssetProjection) error) error {
+	if t.goal != nil {
+		return t.profile.ProjectAssetsFromStream(t.initialAmount, t.monthlyContribution, years, fn)
+	}
+	return t.profile.ProjectAssetsStream(years, fn)
+}
+
+// applyGoal は目標を指定した場合にサマリーへ目標金額と到達可否を設定する
+func (t *assetProjectionTarget) applyGoal(summary *ProjectionSummary) {
+	if t.goal == nil {
+		return
+	}
+	summary.TargetAmount = t.goal.TargetAmount().Amount()
+	summary.TargetReached = summary.FinalAmount >= summary.TargetAmount
+}
+
 // CalculateRetirementProjection は退職資金予測を計算する
 func (uc *calculateProjectionUseCaseImpl) CalculateRetirementProjection(
 	ctx context.Context,
 	input RetirementProjectionInput,
 ) (*RetirementProjectionOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "CalculateRetirementProjection",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 	)
 
 	// 財務計画を取得
@@ -305,6 +780,24 @@ func (uc *calculateProjectionUseCaseImpl) CalculateRetirementProjection(
 		requiredAdjustment = uc.calculateRequiredRetirementAdjustment(calculation, plan)
 	}
 
+	// 余裕が少ない場合は取り崩し期間の資産推移を生成する
+	drawdownSchedule, err := uc.generateRetirementDrawdownSchedule(calculation, retirementData, plan.Profile().InvestmentReturn())
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRetirementProjection", err,
+			slog.String("step", "generate_drawdown_schedule"),
+		)
+		return nil, fmt.Errorf("取り崩し計画の生成に失敗しました: %w", err)
+	}
+
+	// 生活水準別シナリオを計算
+	lifestyleScenarios, err := calculateLifestyleScenarios(plan, input.LifestyleExpenses)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRetirementProjection", err,
+			slog.String("step", "calculate_lifestyle_scenarios"),
+		)
+		return nil, err
+	}
+
 	uc.logger.EndOperation(ctx, "CalculateRetirementProjection",
 		slog.String("sufficiency_level", sufficiencyLevel),
 	)
@@ -314,16 +807,90 @@ func (uc *calculateProjectionUseCaseImpl) CalculateRetirementProjection(
 		Recommendations:    recommendations,
 		SufficiencyLevel:   sufficiencyLevel,
 		RequiredAdjustment: requiredAdjustment,
+		DrawdownSchedule:   drawdownSchedule,
+		LifestyleScenarios: lifestyleScenarios,
+		Assumptions:        newAssumptions(plan.Profile(), retirementData.CalculateYearsUntilRetirement(), time.Now()),
 	}, nil
 }
 
+// calculateLifestyleScenarios は財務計画の貯蓄・利回り・インフレ率で生活水準ごとの退職資金の充足度を計算する
+// overrides で指定した生活水準は目安の代わりにその月額を使う
+func calculateLifestyleScenarios(
+	plan *aggregates.FinancialPlan,
+	overrides map[entities.RetirementLifestyle]float64,
+) ([]entities.LifestyleRetirementCalculation, error) {
+	currentSavings, err := plan.Profile().CurrentSavings().Total()
+	if err != nil {
+		return nil, fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
+	}
+	netSavings, err := plan.Profile().CalculateNetSavings()
+	if err != nil {
+		return nil, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
+	}
+
+	expenses := make(map[entities.RetirementLifestyle]valueobjects.Money, len(overrides))
+	for lifestyle, amount := range overrides {
+		money, err := valueobjects.NewMoneyJPY(amount)
+		if err != nil {
+			return nil, fmt.Errorf("%sの月間生活費が不正です: %w", lifestyle.Label(), err)
+		}
+		expenses[lifestyle] = money
+	}
+
+	scenarios, err := plan.RetirementData().CalculateRetirementByLifestyle(
+		currentSavings,
+		netSavings,
+		plan.Profile().InvestmentReturn(),
+		plan.Profile().InflationRate(),
+		expenses,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("生活水準別の退職資金計算に失敗しました: %w", err)
+	}
+	return scenarios, nil
+}
+
+// drawdownScheduleSufficiencyThreshold は取り崩し期間の資産推移を生成する充足率の上限（%）
+const drawdownScheduleSufficiencyThreshold = 150.0
+
+// generateRetirementDrawdownSchedule は充足率が150%未満の場合に退職後の取り崩し計画を生成する
+// RetirementCalculation.SufficiencyRate は100%で頭打ちになるため、予想達成額と必要額から充足率を算出し直す
+// 取り崩し額は必要老後資金を退職後の月数で割った月額（退職時点のインフレ調整済み）とする
+func (uc *calculateProjectionUseCaseImpl) generateRetirementDrawdownSchedule(
+	calculation *entities.RetirementCalculation,
+	retirementData *entities.RetirementData,
+	investmentReturn valueobjects.Rate,
+) ([]services.DrawdownEntry, error) {
+	retirementYears := retirementData.CalculateRetirementYears()
+	required := calculation.RequiredAmount.Amount()
+	if retirementYears <= 0 || required <= 0 {
+		return nil, nil
+	}
+
+	projected := calculation.ProjectedAmount.Amount()
+	if projected/required*100 >= drawdownScheduleSufficiencyThreshold {
+		return nil, nil
+	}
+	if projected < 0 {
+		projected = 0
+	}
+
+	monthlyWithdrawal := required / float64(retirementYears*12)
+	return uc.calculationService.GenerateDrawdownSchedule(
+		projected,
+		monthlyWithdrawal,
+		investmentReturn.AsPercentage(),
+		retirementYears,
+	)
+}
+
 // CalculateEmergencyFundProjection は緊急資金予測を計算する
 func (uc *calculateProjectionUseCaseImpl) CalculateEmergencyFundProjection(
 	ctx context.Context,
 	input EmergencyFundProjectionInput,
 ) (*EmergencyFundProjectionOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "CalculateEmergencyFundProjection",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 	)
 
 	// 財務計画を取得
@@ -335,41 +902,44 @@ func (uc *calculateProjectionUseCaseImpl) CalculateEmergencyFundProjection(
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
 
-	// 包括的予測を生成して緊急資金状況を取得
-	projection, err := plan.GenerateProjection(1) // 1年間の予測
+	// 緊急資金の状況を計算（必要額は現在の月間支出から再計算される）
+	status, err := plan.EmergencyFundStatus()
 	if err != nil {
 		uc.logger.OperationError(ctx, "CalculateEmergencyFundProjection", err,
-			slog.String("step", "generate_projection"),
+			slog.String("step", "calculate_emergency_status"),
 		)
-		return nil, fmt.Errorf("財務予測の生成に失敗しました: %w", err)
+		return nil, fmt.Errorf("緊急資金状況の計算に失敗しました: %w", err)
 	}
 
-	if projection.EmergencyFundStatus == nil {
-		err := fmt.Errorf("緊急資金状況が計算されていません")
+	// 積立履歴がある場合は達成ペースを分析
+	pace, err := plan.EmergencyFund().AnalyzePace(time.Now())
+	if err != nil {
 		uc.logger.OperationError(ctx, "CalculateEmergencyFundProjection", err,
-			slog.String("step", "check_emergency_status"),
+			slog.String("step", "analyze_pace"),
 		)
-		return nil, err
+		return nil, fmt.Errorf("緊急資金の達成ペース分析に失敗しました: %w", err)
 	}
 
 	// 推奨事項を生成
-	recommendations := uc.generateEmergencyFundRecommendations(projection.EmergencyFundStatus, plan)
+	recommendations := uc.generateEmergencyFundRecommendations(status, plan)
 
 	// 優先度を評価
-	priority := uc.evaluateEmergencyFundPriority(projection.EmergencyFundStatus)
+	priority := uc.evaluateEmergencyFundPriority(status)
 
 	// タイムラインを計算
-	timeline := uc.calculateEmergencyFundTimeline(projection.EmergencyFundStatus, plan)
+	timeline := uc.calculateEmergencyFundTimeline(status, plan)
 
 	uc.logger.EndOperation(ctx, "CalculateEmergencyFundProjection",
 		slog.String("priority", priority),
 	)
 
 	return &EmergencyFundProjectionOutput{
-		Status:          projection.EmergencyFundStatus,
+		Status:          status,
 		Recommendations: recommendations,
 		Priority:        priority,
 		Timeline:        timeline,
+		Pace:            pace,
+		Assumptions:     newAssumptions(plan.Profile(), 0, time.Now()),
 	}, nil
 }
 
@@ -379,7 +949,7 @@ func (uc *calculateProjectionUseCaseImpl) CalculateComprehensiveProjection(
 	input ComprehensiveProjectionInput,
 ) (*ComprehensiveProjectionOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "CalculateComprehensiveProjection",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 		slog.Int("years", input.Years),
 	)
 
@@ -420,9 +990,83 @@ func (uc *calculateProjectionUseCaseImpl) CalculateComprehensiveProjection(
 		Insights:       insights,
 		Warnings:       warnings,
 		Opportunities:  opportunities,
+		Assumptions:    newAssumptions(plan.Profile(), input.Years, time.Now()),
 	}, nil
 }
 
+// CalculateQuickProjection は1年後の資産だけを予測する軽量な計算（ダッシュボード表示用）
+// 洞察・警告・リスク評価は計算せず、ProjectAssets(1) の結果と貯蓄率・緊急資金の充足月数だけを返す
+func (uc *calculateProjectionUseCaseImpl) CalculateQuickProjection(
+	ctx context.Context,
+	input QuickProjectionInput,
+) (*QuickProjectionOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CalculateQuickProjection",
+		log.UserID(string(input.UserID)),
+	)
+
+	// 財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateQuickProjection", err,
+			slog.String("step", "find_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+	profile := plan.Profile()
+
+	currentAssets, err := profile.CurrentSavings().Total()
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateQuickProjection", err,
+			slog.String("step", "current_assets"),
+		)
+		return nil, fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
+	}
+
+	projections, err := profile.ProjectAssets(1)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateQuickProjection", err,
+			slog.String("step", "project_assets"),
+		)
+		return nil, fmt.Errorf("資産推移の計算に失敗しました: %w", err)
+	}
+	projected := projections[0].TotalAssets.Amount()
+
+	savingsRate, err := profile.CalculateSavingsRate()
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateQuickProjection", err,
+			slog.String("step", "savings_rate"),
+		)
+		return nil, fmt.Errorf("貯蓄率の計算に失敗しました: %w", err)
+	}
+
+	output := &QuickProjectionOutput{
+		CurrentAssets:        currentAssets.Amount(),
+		ProjectedAssets1Year: projected,
+		EstimatedGrowth:      projected - currentAssets.Amount(),
+		SavingsRate:          savingsRate,
+	}
+
+	// 緊急資金の充足月数は緊急資金の状況と同じく、負債の返済を含まない月間支出で求める
+	if emergencyFund := plan.EmergencyFund(); emergencyFund != nil {
+		monthlyExpenses, err := profile.MonthlyExpenses().Total()
+		if err != nil {
+			uc.logger.OperationError(ctx, "CalculateQuickProjection", err,
+				slog.String("step", "emergency_fund"),
+			)
+			return nil, fmt.Errorf("月間支出の計算に失敗しました: %w", err)
+		}
+		output.EmergencyFundStatus = &QuickEmergencyFundStatus{
+			MonthsOfExpensesCovered: emergencyFund.MonthsOfExpensesCovered(monthlyExpenses),
+		}
+	}
+
+	uc.logger.EndOperation(ctx, "CalculateQuickProjection",
+		slog.Float64("projected_assets_1_year", projected),
+	)
+
+	return output, nil
+}
+
 // CalculateGoalProjection は目標達成予測を計算する
 func (uc *calculateProjectionUseCaseImpl) CalculateGoalProjection(
 	ctx context.Context,
@@ -446,8 +1090,21 @@ func (uc *calculateProjectionUseCaseImpl) CalculateGoalProjection(
 		return nil, fmt.Errorf("目標進捗の計算に失敗しました: %w", err)
 	}
 
-	// 進捗予測を計算
-	projection := uc.calculateGoalProgressProjection(goal, plan.Profile())
+	// 依存関係を考慮した拠出スケジュールを算出（前提目標がある場合は完了見込み日から拠出を開始する）
+	schedule := entities.BuildGoalSchedules(append(append([]*entities.Goal{}, plan.Goals()...), goal), time.Now())[goal.ID()]
+
+	// 進捗予測を計算（目標詳細の月次必要額と同じ拠出計画を使う）
+	now := time.Now()
+	requiredPlan, err := goal.RequiredSavingsPlanFrom(now)
+	if err != nil {
+		return nil, fmt.Errorf("月間必要貯蓄額の計算に失敗しました: %w", err)
+	}
+	granularity, err := resolveGoalProjectionGranularity(input.Granularity, goal, now)
+	if err != nil {
+		return nil, err
+	}
+	projection, truncated := uc.calculateGoalProgressProjection(goal, schedule, now, granularity)
+	scenarios := uc.calculateGoalProjectionScenarios(goal, schedule, projection, now, granularity)
 
 	// 推奨事項を生成
 	recommendations, err := uc.recommendationService.SuggestGoalAdjustments(goal, plan.Profile())
@@ -461,34 +1118,474 @@ func (uc *calculateProjectionUseCaseImpl) CalculateGoalProjection(
 		return nil, fmt.Errorf("実現可能性の分析に失敗しました: %w", err)
 	}
 
-	return &GoalProjectionOutput{
-		Goal:            goal,
-		Progress:        progress,
-		Projection:      projection,
-		Recommendations: recommendations,
-		Feasibility:     feasibility,
+	output := &GoalProjectionOutput{
+		Goal:                   goal,
+		Progress:               progress,
+		EffectiveStartDate:     schedule.EffectiveStartDate.Format(time.RFC3339),
+		Projection:             projection,
+		Scenarios:              scenarios,
+		ProjectionGranularity:  granularity,
+		ProjectionTruncated:    truncated,
+		Recommendations:        recommendations,
+		RequiredMonthlySavings: requiredPlan.MonthlyAmount.Amount(),
+		FinalPush:              requiredPlan.FinalPush,
+		Feasibility:            feasibility,
+		Assumptions:            newAssumptions(plan.Profile(), 0, now),
+	}
+	if schedule.HasEstimatedCompletion() {
+		output.EstimatedCompletionDate = schedule.EstimatedCompletionDate.Format(time.RFC3339)
+	}
+
+	return output, nil
+}
+
+// EstimatePension は加入年数と平均月収から公的年金の見込み額を推定する
+func (uc *calculateProjectionUseCaseImpl) EstimatePension(
+	ctx context.Context,
+	input PensionEstimateInput,
+) (*PensionEstimateOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "EstimatePension",
+		slog.Int("contribution_years", input.ContributionYears),
+		slog.Int("birth_year", input.BirthYear),
+	)
+
+	monthlyPension := uc.calculationService.EstimateNationalPension(input.ContributionYears, input.MonthlyIncome, input.BirthYear)
+	if monthlyPension <= 0 {
+		err := fmt.Errorf("年金見込み額を推定できません")
+		uc.logger.OperationError(ctx, "EstimatePension", err,
+			slog.String("step", "estimate_pension"),
+		)
+		return nil, err
+	}
+
+	// 受給開始から平均寿命までの受給総額
+	totalLifetimePension := monthlyPension * 12 * float64(defaultLifeExpectancy-pensionStartAge)
+
+	uc.logger.EndOperation(ctx, "EstimatePension",
+		slog.Float64("estimated_monthly_pension", monthlyPension),
+	)
+
+	return &PensionEstimateOutput{
+		EstimatedMonthlyPension: monthlyPension,
+		TotalLifetimePension:    totalLifetimePension,
+	}, nil
+}
+
+// CalculateAmortization は住宅ローンなどを元利均等返済した場合の返済予定を計算する
+func (uc *calculateProjectionUseCaseImpl) CalculateAmortization(
+	ctx context.Context,
+	input AmortizationInput,
+) (*AmortizationOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CalculateAmortization",
+		slog.Float64("loan_amount", input.LoanAmount),
+		slog.Int("term_months", input.TermMonths),
+	)
+
+	schedule, err := uc.calculationService.GenerateAmortizationSchedule(input.LoanAmount, input.AnnualRate, input.TermMonths)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateAmortization", err,
+			slog.String("step", "generate_schedule"),
+		)
+		return nil, fmt.Errorf("返済予定の計算に失敗しました: %w", err)
+	}
+
+	var totalPayment, totalInterest float64
+	for _, entry := range schedule {
+		totalPayment += entry.Payment
+		totalInterest += entry.Interest
+	}
+
+	uc.logger.EndOperation(ctx, "CalculateAmortization",
+		slog.Float64("monthly_payment", schedule[0].Payment),
+	)
+
+	return &AmortizationOutput{
+		LoanAmount:     input.LoanAmount,
+		AnnualRate:     input.AnnualRate,
+		TermMonths:     input.TermMonths,
+		MonthlyPayment: schedule[0].Payment,
+		TotalPayment:   totalPayment,
+		TotalInterest:  totalInterest,
+		Schedule:       schedule,
+	}, nil
+}
+
+// CalculateTimeToAmount は目標金額に到達するまでの期間を計算する
+func (uc *calculateProjectionUseCaseImpl) CalculateTimeToAmount(
+	ctx context.Context,
+	input TimeToAmountInput,
+) (*TimeToAmountOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CalculateTimeToAmount",
+		log.UserID(string(input.UserID)),
+		slog.Float64("target_amount", input.TargetAmount),
+	)
+
+	// 財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateTimeToAmount", err,
+			slog.String("step", "find_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	currentAssets, monthlyContribution, err := resolveAssetsAndContribution(plan, input.MonthlyContribution)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateTimeToAmount", err,
+			slog.String("step", "resolve_profile"),
+		)
+		return nil, err
+	}
+
+	annualReturn := plan.Profile().InvestmentReturn()
+	if input.AnnualReturn != nil {
+		annualReturn, err = valueobjects.NewRate(*input.AnnualReturn)
+		if err != nil {
+			return nil, fmt.Errorf("投資利回りが無効です: %w", err)
+		}
+	}
+
+	targetAmount, err := valueobjects.NewMoneyJPY(input.TargetAmount)
+	if err != nil {
+		return nil, fmt.Errorf("目標金額が無効です: %w", err)
+	}
+
+	result, err := uc.calculationService.CalculateTimeToAmount(currentAssets, monthlyContribution, targetAmount, annualReturn)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateTimeToAmount", err,
+			slog.String("step", "calculate_time_to_amount"),
+		)
+		return nil, fmt.Errorf("目標到達期間の計算に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "CalculateTimeToAmount",
+		slog.Int("months", result.Months),
+		slog.Bool("reachable", result.Reachable),
+	)
+
+	assumptions := newAssumptions(plan.Profile(), 0, time.Now())
+	assumptions.InvestmentReturn = annualReturn.AsPercentage()
+
+	return &TimeToAmountOutput{
+		TargetAmount:        targetAmount.Amount(),
+		CurrentAssets:       currentAssets.Amount(),
+		MonthlyContribution: monthlyContribution.Amount(),
+		AnnualReturn:        annualReturn.AsPercentage(),
+		Months:              result.Months,
+		Years:               result.Years,
+		Reachable:           result.Reachable,
+		Assumptions:         assumptions,
+	}, nil
+}
+
+// CalculateRequiredReturn は指定年数で目標金額に到達するために必要な年利回りを計算する
+func (uc *calculateProjectionUseCaseImpl) CalculateRequiredReturn(
+	ctx context.Context,
+	input RequiredReturnInput,
+) (*RequiredReturnOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CalculateRequiredReturn",
+		log.UserID(string(input.UserID)),
+		slog.Float64("target_amount", input.TargetAmount),
+		slog.Int("years", input.Years),
+	)
+
+	// 財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRequiredReturn", err,
+			slog.String("step", "find_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	currentAssets, monthlyContribution, err := resolveAssetsAndContribution(plan, nil)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRequiredReturn", err,
+			slog.String("step", "resolve_profile"),
+		)
+		return nil, err
+	}
+
+	targetAmount, err := valueobjects.NewMoneyJPY(input.TargetAmount)
+	if err != nil {
+		return nil, fmt.Errorf("目標金額が無効です: %w", err)
+	}
+
+	threshold := services.DefaultUnrealisticReturnThreshold
+	if input.UnrealisticThreshold != nil {
+		threshold = *input.UnrealisticThreshold
+	}
+
+	result, err := uc.calculationService.CalculateRequiredReturn(currentAssets, monthlyContribution, targetAmount, input.Years, threshold)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRequiredReturn", err,
+			slog.String("step", "calculate_required_return"),
+		)
+		return nil, fmt.Errorf("必要利回りの計算に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "CalculateRequiredReturn",
+		slog.Float64("required_annual_return", result.AnnualReturn),
+		slog.Bool("unrealistic", result.Unrealistic),
+	)
+
+	return &RequiredReturnOutput{
+		TargetAmount:         targetAmount.Amount(),
+		Years:                input.Years,
+		CurrentAssets:        currentAssets.Amount(),
+		MonthlyContribution:  monthlyContribution.Amount(),
+		RequiredAnnualReturn: result.AnnualReturn,
+		Reachable:            result.Reachable,
+		Unrealistic:          result.Unrealistic,
+		UnrealisticThreshold: threshold,
+		Assumptions:          newAssumptions(plan.Profile(), input.Years, time.Now()),
 	}, nil
 }
 
-// calculateProjectionSummary は予測サマリーを計算する
-func (uc *calculateProjectionUseCaseImpl) calculateProjectionSummary(projections []entities.AssetProjection) (*ProjectionSummary, error) {
+// CalculateRequiredSavingsRate はアクティブな全目標を達成するために必要な貯蓄率を計算する
+func (uc *calculateProjectionUseCaseImpl) CalculateRequiredSavingsRate(
+	ctx context.Context,
+	input RequiredSavingsRateInput,
+) (*RequiredSavingsRateOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CalculateRequiredSavingsRate",
+		log.UserID(string(input.UserID)),
+	)
+
+	// 財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRequiredSavingsRate", err,
+			slog.String("step", "find_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	// アクティブな目標を取得
+	goals, err := uc.goalRepo.FindActiveGoalsByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRequiredSavingsRate", err,
+			slog.String("step", "find_goals"),
+		)
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	netSavings, err := plan.Profile().CalculateNetSavings()
+	if err != nil {
+		uc.logger.OperationError(ctx, "CalculateRequiredSavingsRate", err,
+			slog.String("step", "calculate_net_savings"),
+		)
+		return nil, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
+	}
+
+	currentSavingsRate, err := plan.Profile().CalculateSavingsRate()
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄率の計算に失敗しました: %w", err)
+	}
+
+	// 前提目標の完了後に拠出を始める目標は、拠出開始日からの必要額を算出し現在の必要額には含めない
+	now := time.Now()
+	schedules := entities.BuildGoalSchedules(goals, now)
+
+	requirements := make([]GoalSavingsRequirement, 0, len(goals))
+	var totalRequired float64
+	for _, goal := range goals {
+		startDate := schedules[goal.ID()].EffectiveStartDate
+		required, err := goal.CalculateRequiredMonthlySavingsFrom(startDate)
+		if err != nil {
+			uc.logger.OperationError(ctx, "CalculateRequiredSavingsRate", err,
+				slog.String("step", "calculate_required_savings"),
+				slog.String("goal_id", string(goal.ID())),
+			)
+			return nil, fmt.Errorf("目標の月間必要貯蓄額の計算に失敗しました: %w", err)
+		}
+
+		requirement := GoalSavingsRequirement{
+			GoalID:                 goal.ID(),
+			Title:                  goal.Title(),
+			GoalType:               goal.GoalType(),
+			RequiredMonthlySavings: required.Amount(),
+		}
+		if startDate.After(now) {
+			requirement.DeferredUntil = startDate.Format(time.RFC3339)
+		} else {
+			totalRequired += required.Amount()
+		}
+		requirements = append(requirements, requirement)
+	}
+
+	monthlyIncome := plan.Profile().NetMonthlyIncome().Amount()
+	var requiredSavingsRate float64
+	if monthlyIncome > 0 {
+		requiredSavingsRate = totalRequired / monthlyIncome * 100
+	}
+
+	output := &RequiredSavingsRateOutput{
+		MonthlyIncome:               monthlyIncome,
+		CurrentNetSavings:           netSavings.Amount(),
+		CurrentSavingsRate:          currentSavingsRate,
+		TotalRequiredMonthlySavings: totalRequired,
+		RequiredSavingsRate:         requiredSavingsRate,
+		Goals:                       requirements,
+		Assumptions:                 newAssumptions(plan.Profile(), 0, now),
+	}
+
+	gap := totalRequired - netSavings.Amount()
+	if gap <= 0 {
+		surplus := -gap
+		output.Surplus = &surplus
+	} else {
+		output.AdditionalMonthlySavingsNeeded = &gap
+		output.DeprioritizeSuggestions = suggestGoalsToDeprioritize(requirements, gap)
+	}
+
+	uc.logger.EndOperation(ctx, "CalculateRequiredSavingsRate",
+		slog.Int("goal_count", len(requirements)),
+		slog.Float64("required_savings_rate", requiredSavingsRate),
+	)
+
+	return output, nil
+}
+
+// suggestGoalsToDeprioritize は不足額がなくなるまで後回しにする目標を選ぶ
+// 優先度の低い目標から順に、同じ優先度では不足額を最も減らせる（月間必要額の大きい）目標を選ぶ
+func suggestGoalsToDeprioritize(requirements []GoalSavingsRequirement, gap float64) []DeprioritizeSuggestion {
+	candidates := make([]GoalSavingsRequirement, 0, len(requirements))
+	for _, requirement := range requirements {
+		if requirement.RequiredMonthlySavings > 0 && requirement.DeferredUntil == "" {
+			candidates = append(candidates, requirement)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		pi, pj := goalTypePriority(candidates[i].GoalType), goalTypePriority(candidates[j].GoalType)
+		if pi != pj {
+			return pi < pj
+		}
+		return candidates[i].RequiredMonthlySavings > candidates[j].RequiredMonthlySavings
+	})
+
+	var suggestions []DeprioritizeSuggestion
+	for _, candidate := range candidates {
+		if gap <= 0 {
+			break
+		}
+		gap = math.Max(gap-candidate.RequiredMonthlySavings, 0)
+		suggestions = append(suggestions, DeprioritizeSuggestion{
+			GoalID:                 candidate.GoalID,
+			Title:                  candidate.Title,
+			GoalType:               candidate.GoalType,
+			RequiredMonthlySavings: candidate.RequiredMonthlySavings,
+			RemainingGap:           gap,
+			Reason: fmt.Sprintf("「%s」（%s）を後回しにすると月々の必要貯蓄額が%.0f円減ります",
+				candidate.Title, candidate.GoalType.String(), candidate.RequiredMonthlySavings),
+		})
+	}
+
+	return suggestions
+}
+
+// goalTypePriority は目標タイプの優先度を返す（値が小さいほど後回しにしやすい）
+func goalTypePriority(goalType entities.GoalType) int {
+	switch goalType {
+	case entities.GoalTypeEmergency:
+		return 3
+	case entities.GoalTypeRetirement:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// resolveAssetsAndContribution は財務プロファイルから現在の資産と月間積立額を取得する
+// 月間積立額が指定されている場合はプロファイルの純貯蓄額の代わりに使う
+func resolveAssetsAndContribution(
+	plan *aggregates.FinancialPlan,
+	contributionOverride *float64,
+) (valueobjects.Money, valueobjects.Money, error) {
+	currentAssets, err := plan.Profile().CurrentSavings().Total()
+	if err != nil {
+		return valueobjects.Money{}, valueobjects.Money{}, fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
+	}
+
+	if contributionOverride != nil {
+		monthlyContribution, err := valueobjects.NewMoneyJPY(*contributionOverride)
+		if err != nil {
+			return valueobjects.Money{}, valueobjects.Money{}, fmt.Errorf("月間積立額が無効です: %w", err)
+		}
+		return currentAssets, monthlyContribution, nil
+	}
+
+	netSavings, err := plan.Profile().CalculateNetSavings()
+	if err != nil {
+		return valueobjects.Money{}, valueobjects.Money{}, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
+	}
+	return currentAssets, netSavings, nil
+}
+
+// liabilityPayoffs は負債ごとの完済予定日を返す
+func liabilityPayoffs(liabilities entities.LiabilityCollection, now time.Time) []LiabilityPayoff {
+	var payoffs []LiabilityPayoff
+	for _, liability := range liabilities {
+		payoffs = append(payoffs, LiabilityPayoff{
+			LiabilityID: string(liability.ID()),
+			Name:        liability.Name(),
+			PayoffDate:  liability.PayoffDateFrom(now).Format("2006-01-02"),
+		})
+	}
+	return payoffs
+}
+
+// calculateRiskAnalysis はプロファイルの想定ボラティリティからリスク調整後リターンと最悪ケースの資産推移を計算する
+func calculateRiskAnalysis(profile *entities.FinancialProfile, initialAmount, monthlyContribution valueobjects.Money, years int) (*RiskAnalysis, error) {
+	worstCase, err := profile.ProjectWorstCaseAssetsFrom(initialAmount, monthlyContribution, years)
+	if err != nil {
+		return nil, err
+	}
+	return newRiskAnalysis(profile, worstCase[len(worstCase)-1].TotalAssets.Amount(), worstCase), nil
+}
+
+// newRiskAnalysis は最悪ケースの最終金額と推移からリスク評価を組み立てる
+func newRiskAnalysis(profile *entities.FinancialProfile, worstCaseFinalAmount float64, worstCase []entities.AssetProjection) *RiskAnalysis {
+	_, configured := profile.ExpectedVolatility()
+	risk := &RiskAnalysis{
+		ExpectedReturn:       profile.InvestmentReturn().AsPercentage(),
+		Volatility:           profile.Volatility().AsPercentage(),
+		VolatilityEstimated:  !configured,
+		RiskTolerance:        string(profile.RiskTolerance()),
+		ExceedsRiskTolerance: profile.ExceedsRiskTolerance(),
+		WorstCaseReturn:      profile.WorstCaseReturn(),
+		WorstCaseFinalAmount: worstCaseFinalAmount,
+		WorstCaseProjections: worstCase,
+	}
+	if sharpe, ok := profile.RiskAdjustedReturn(); ok {
+		risk.RiskAdjustedReturn = &sharpe
+	}
+	return risk
+}
+
+// summarizeProjections は資産推移の予測サマリーを計算する（資産推移の計算とレポートで共通）
+// 初期額は1年目末の総資産とし、成長率は FinancialCalculationService.GrowthPercentage で求める
+// 平均リターンは成長率を予測年数で割った単純平均
+func summarizeProjections(calculationService *services.FinancialCalculationService, projections []entities.AssetProjection) *ProjectionSummary {
 	if len(projections) == 0 {
-		return &ProjectionSummary{}, nil
+		return &ProjectionSummary{}
 	}
+	return summarizeProjectionRange(calculationService, projections[0], projections[len(projections)-1], len(projections))
+}
 
-	initialAmount := projections[0].TotalAssets.Amount()
-	finalAmount := projections[len(projections)-1].TotalAssets.Amount()
-	totalGrowth := finalAmount - initialAmount
-	growthPercentage := (totalGrowth / initialAmount) * 100
-	averageReturn := growthPercentage / float64(len(projections))
+// summarizeProjectionRange は初年と最終年の予測から予測サマリーを計算する（推移を保持しないストリーミング出力用）
+func summarizeProjectionRange(calculationService *services.FinancialCalculationService, first, last entities.AssetProjection, years int) *ProjectionSummary {
+	initialAmount := first.TotalAssets.Amount()
+	finalAmount := last.TotalAssets.Amount()
+	growthPercentage := calculationService.GrowthPercentage(initialAmount, finalAmount)
 
 	return &ProjectionSummary{
 		InitialAmount:    initialAmount,
 		FinalAmount:      finalAmount,
-		TotalGrowth:      totalGrowth,
+		TotalGrowth:      finalAmount - initialAmount,
 		GrowthPercentage: growthPercentage,
-		AverageReturn:    averageReturn,
-	}, nil
+		AverageReturn:    growthPercentage / float64(years),
+	}
 }
 
 // generateRetirementRecommendations は退職資金の推奨事項を生成する
@@ -537,8 +1634,8 @@ func (uc *calculateProjectionUseCaseImpl) evaluateRetirementSufficiency(calculat
 func (uc *calculateProjectionUseCaseImpl) calculateRequiredRetirementAdjustment(calculation *entities.RetirementCalculation, plan *aggregates.FinancialPlan) *RequiredAdjustment {
 	shortfall := calculation.Shortfall.Amount()
 
-	// 月間貯蓄増加による調整
-	monthsToRetirement := 12 * 30 // 仮定：30年
+	// 月間貯蓄増加による調整（退職までの期間は設定の想定年数とする）
+	monthsToRetirement := 12 * uc.calculationService.Defaults().RetirementPlanningYears
 	requiredMonthlySavingsIncrease := shortfall / float64(monthsToRetirement)
 
 	return &RequiredAdjustment{
@@ -553,7 +1650,7 @@ func (uc *calculateProjectionUseCaseImpl) calculateRequiredRetirementAdjustment(
 func (uc *calculateProjectionUseCaseImpl) generateEmergencyFundRecommendations(status *aggregates.EmergencyFundStatus, plan *aggregates.FinancialPlan) []string {
 	var recommendations []string
 
-	if status.Shortfall.IsZero() || status.Shortfall.IsNegative() {
+	if plan.EmergencyFund().IsFullyFunded() {
 		recommendations = append(recommendations, "緊急資金は十分に確保されています")
 		return recommendations
 	}
@@ -572,6 +1669,10 @@ func (uc *calculateProjectionUseCaseImpl) generateEmergencyFundRecommendations(s
 		recommendations = append(recommendations, "安全性の高い預金商品での積立を検討してください")
 	}
 
+	if plan.EmergencyFundOvercommitted() {
+		recommendations = append(recommendations, aggregates.EmergencyFundOvercommitmentMessage+"。積立額を純貯蓄額の範囲に見直してください")
+	}
+
 	return recommendations
 }
 
@@ -597,6 +1698,11 @@ func (uc *calculateProjectionUseCaseImpl) evaluateEmergencyFundPriority(status *
 
 // calculateEmergencyFundTimeline は緊急資金のタイムラインを計算する
 func (uc *calculateProjectionUseCaseImpl) calculateEmergencyFundTimeline(status *aggregates.EmergencyFundStatus, plan *aggregates.FinancialPlan) *EmergencyFundTimeline {
+	// 積立予定額が設定されている場合は、その額で積み立てた場合のタイムラインを返す
+	if status.MonthlyContribution.IsPositive() && status.TargetReachedMonth != nil {
+		return calculateScheduledEmergencyFundTimeline(status)
+	}
+
 	if status.MonthsToTarget <= 0 {
 		return &EmergencyFundTimeline{
 			MonthsToTarget:     0,
@@ -629,6 +1735,31 @@ func (uc *calculateProjectionUseCaseImpl) calculateEmergencyFundTimeline(status
 	}
 }
 
+// calculateScheduledEmergencyFundTimeline は積立予定額で積み立てた場合の達成タイムラインを計算する
+// マイルストーンは必要額の25%刻みで、既に達成しているマイルストーンは0ヶ月目とする
+func calculateScheduledEmergencyFundTimeline(status *aggregates.EmergencyFundStatus) *EmergencyFundTimeline {
+	contribution := status.MonthlyContribution.Amount()
+	milestones := make([]Milestone, 0, 4)
+	for i := 1; i <= 4; i++ {
+		amount := status.RequiredAmount.Amount() / 4 * float64(i)
+		month := 0
+		if remaining := amount - status.CurrentAmount.Amount(); remaining > 0 {
+			month = int(math.Ceil(remaining / contribution))
+		}
+		milestones = append(milestones, Milestone{
+			Month:       month,
+			Amount:      amount,
+			Description: fmt.Sprintf("緊急資金の%d%%達成", 25*i),
+		})
+	}
+
+	return &EmergencyFundTimeline{
+		MonthsToTarget:     *status.TargetReachedMonth,
+		MonthlySavingsGoal: contribution,
+		Milestones:         milestones,
+	}
+}
+
 // generateFinancialInsights は財務洞察を生成する
 func (uc *calculateProjectionUseCaseImpl) generateFinancialInsights(projection *aggregates.PlanProjection, plan *aggregates.FinancialPlan) []FinancialInsight {
 	var insights []FinancialInsight
@@ -651,10 +1782,10 @@ func (uc *calculateProjectionUseCaseImpl) generateFinancialInsights(projection *
 	// 貯蓄率の洞察
 	netSavings, err := plan.Profile().CalculateNetSavings()
 	if err == nil {
-		monthlyIncome := plan.Profile().MonthlyIncome()
+		monthlyIncome := plan.Profile().NetMonthlyIncome()
 		savingsRate := netSavings.Amount() / monthlyIncome.Amount() * 100
 
-		if savingsRate > 20 {
+		if savingsRate > uc.calculationService.Defaults().RecommendedSavingsRate {
 			insights = append(insights, FinancialInsight{
 				Type:        "savings_rate",
 				Title:       "優秀な貯蓄率を維持しています",
@@ -671,6 +1802,18 @@ func (uc *calculateProjectionUseCaseImpl) generateFinancialInsights(projection *
 func (uc *calculateProjectionUseCaseImpl) generateFinancialWarnings(projection *aggregates.PlanProjection, plan *aggregates.FinancialPlan) []FinancialWarning {
 	var warnings []FinancialWarning
 
+	// リスク許容度を超えるボラティリティの警告
+	if profile := plan.Profile(); profile.ExceedsRiskTolerance() {
+		warnings = append(warnings, FinancialWarning{
+			Type:  "risk_tolerance",
+			Title: "運用のリスクがリスク許容度を超えています",
+			Description: fmt.Sprintf("想定ボラティリティ%.1f%%は、リスク許容度の上限%.0f%%を超えています（最悪ケースの年率リターン%.1f%%）",
+				profile.Volatility().AsPercentage(), profile.RiskTolerance().MaxVolatility(), profile.WorstCaseReturn()),
+			Severity: "medium",
+			Action:   "値動きの小さい資産への配分を増やすか、リスク許容度を見直してください",
+		})
+	}
+
 	// 緊急資金の警告
 	if projection.EmergencyFundStatus != nil && projection.EmergencyFundStatus.Shortfall.IsPositive() {
 		shortfallRatio := projection.EmergencyFundStatus.Shortfall.Amount() / projection.EmergencyFundStatus.RequiredAmount.Amount()
@@ -686,6 +1829,17 @@ func (uc *calculateProjectionUseCaseImpl) generateFinancialWarnings(projection *
 		}
 	}
 
+	// 緊急資金の積立予定額が純貯蓄額を超えている場合の警告
+	if plan.EmergencyFundOvercommitted() {
+		warnings = append(warnings, FinancialWarning{
+			Type:        "emergency_fund_overcommitment",
+			Title:       aggregates.EmergencyFundOvercommitmentMessage,
+			Description: fmt.Sprintf("緊急資金に毎月%.0f円を積み立てる予定ですが、月間純貯蓄額を超えています", plan.EmergencyFund().MonthlyContribution().Amount()),
+			Severity:    "medium",
+			Action:      "積立予定額を純貯蓄額の範囲に見直すか、支出を削減してください",
+		})
+	}
+
 	// 退職資金の警告
 	if projection.RetirementCalculation != nil {
 		sufficiencyRate := projection.RetirementCalculation.SufficiencyRate.AsPercentage()
@@ -713,20 +1867,22 @@ func (uc *calculateProjectionUseCaseImpl) generateFinancialWarnings(projection *
 func (uc *calculateProjectionUseCaseImpl) generateFinancialOpportunities(projection *aggregates.PlanProjection, plan *aggregates.FinancialPlan) []FinancialOpportunity {
 	var opportunities []FinancialOpportunity
 
-	// 投資利回り改善の機会
+	// 投資利回り改善の機会（リスク許容度に合う運用方針のみ提案する）
 	currentReturn := plan.Profile().InvestmentReturn().AsPercentage()
-	if currentReturn < 5 {
+	if portfolio, ok := suggestInvestmentPortfolio(plan.Profile().RiskTolerance(), currentReturn); ok {
 		currentSavingsTotal, err := plan.Profile().CurrentSavings().Total()
 		if err == nil {
-			potentialGain := (5 - currentReturn) / 100 * currentSavingsTotal.Amount()
+			potentialGain := (portfolio.expectedReturn - currentReturn) / 100 * currentSavingsTotal.Amount()
 
 			opportunities = append(opportunities, FinancialOpportunity{
-				Type:        "investment_optimization",
-				Title:       "投資利回りの改善機会",
-				Description: "投資ポートフォリオの見直しにより利回り向上が期待できます",
-				Benefit:     fmt.Sprintf("年間約%.0f円の追加収益が見込めます", potentialGain),
-				Effort:      "medium",
-				Impact:      potentialGain,
+				Type:  "investment_optimization",
+				Title: "投資利回りの改善機会",
+				Description: fmt.Sprintf("%s（期待リターン%.0f%%・想定ボラティリティ%.0f%%）への見直しにより利回り向上が期待できます",
+					portfolio.name, portfolio.expectedReturn, portfolio.volatility),
+				Benefit: fmt.Sprintf("年間約%.0f円の追加収益が見込めます", potentialGain),
+				Effort:  "medium",
+				Risk:    portfolio.risk,
+				Impact:  potentialGain,
 			})
 		}
 	}
@@ -734,7 +1890,7 @@ func (uc *calculateProjectionUseCaseImpl) generateFinancialOpportunities(project
 	// 支出最適化の機会
 	monthlyExpenses, err := plan.Profile().MonthlyExpenses().Total()
 	if err == nil {
-		monthlyIncome := plan.Profile().MonthlyIncome()
+		monthlyIncome := plan.Profile().NetMonthlyIncome()
 		expenseRatio := monthlyExpenses.Amount() / monthlyIncome.Amount()
 
 		if expenseRatio > 0.7 {
@@ -754,36 +1910,189 @@ func (uc *calculateProjectionUseCaseImpl) generateFinancialOpportunities(project
 	return opportunities
 }
 
+// investmentPortfolio は投資利回りの改善機会として提案する運用方針
+type investmentPortfolio struct {
+	name           string
+	risk           string  // "low", "medium", "high"
+	expectedReturn float64 // 期待リターン（年率%）
+	volatility     float64 // 想定ボラティリティ（年率%）
+}
+
+// investmentPortfolios は提案する運用方針（期待リターンの低い順）
+var investmentPortfolios = []investmentPortfolio{
+	{name: "安定型の運用", risk: "low", expectedReturn: 3, volatility: 5},
+	{name: "バランス型の運用", risk: "medium", expectedReturn: 5, volatility: 12},
+	{name: "積極型の運用", risk: "high", expectedReturn: 7, volatility: 18},
+}
+
+// suggestInvestmentPortfolio はリスク許容度の上限以内のボラティリティで、現在より期待リターンが高い運用方針のうち
+// 最もリターンが高いものを返す。該当する運用方針がない場合は false を返す
+func suggestInvestmentPortfolio(riskTolerance entities.RiskTolerance, currentReturn float64) (investmentPortfolio, bool) {
+	for i := len(investmentPortfolios) - 1; i >= 0; i-- {
+		portfolio := investmentPortfolios[i]
+		if portfolio.volatility <= riskTolerance.MaxVolatility() && portfolio.expectedReturn > currentReturn {
+			return portfolio, true
+		}
+	}
+	return investmentPortfolio{}, false
+}
+
+// resolveGoalProjectionGranularity は進捗予測の粒度を決定する
+// 未指定の場合は残り6ヶ月以内の目標を週次、それ以外を月次とする。週次のデータ点が上限を超える場合は月次に切り替える
+func resolveGoalProjectionGranularity(requested GoalProjectionGranularity, goal *entities.Goal, now time.Time) (GoalProjectionGranularity, error) {
+	switch requested {
+	case "":
+		if !goal.TargetDate().After(now.AddDate(0, weeklyGoalProjectionMaxMonths, 0)) {
+			return GoalProjectionGranularityWeekly, nil
+		}
+		return GoalProjectionGranularityMonthly, nil
+	case GoalProjectionGranularityWeekly:
+		if weeksUntil(now, goal.TargetDate()) > MaxGoalProjectionPoints {
+			return GoalProjectionGranularityMonthly, nil
+		}
+		return GoalProjectionGranularityWeekly, nil
+	case GoalProjectionGranularityMonthly:
+		return GoalProjectionGranularityMonthly, nil
+	default:
+		return "", fmt.Errorf("予測粒度は weekly または monthly を指定してください: %s", requested)
+	}
+}
+
+// weeksUntil は from から to までの週数を返す（端数の週は1週として数える）
+func weeksUntil(from, to time.Time) int {
+	if !to.After(from) {
+		return 0
+	}
+	return int(math.Ceil(to.Sub(from).Hours() / (24 * 7)))
+}
+
 // calculateGoalProgressProjection は目標進捗予測を計算する
-func (uc *calculateProjectionUseCaseImpl) calculateGoalProgressProjection(goal *entities.Goal, profile *entities.FinancialProfile) []GoalProgressProjection {
+// 拠出開始日が先の目標（前提目標の完了待ち）は拠出開始日まで金額が増えないものとして計算する
+// データ点が MaxGoalProjectionPoints を超える場合は打ち切り、第2戻り値で true を返す
+func (uc *calculateProjectionUseCaseImpl) calculateGoalProgressProjection(goal *entities.Goal, schedule entities.GoalSchedule, now time.Time, granularity GoalProjectionGranularity) ([]GoalProgressProjection, bool) {
+	return uc.calculateGoalProgressProjectionWithContribution(goal, schedule, goal.MonthlyContribution().Amount(), now, granularity)
+}
+
+// calculateGoalProgressProjectionWithContribution は指定した月間拠出額で拠出した場合の目標進捗予測を計算する
+func (uc *calculateProjectionUseCaseImpl) calculateGoalProgressProjectionWithContribution(goal *entities.Goal, schedule entities.GoalSchedule, monthlyContribution float64, now time.Time, granularity GoalProjectionGranularity) ([]GoalProgressProjection, bool) {
 	var projection []GoalProgressProjection
 
-	remainingDays := goal.GetRemainingDays()
-	if remainingDays <= 0 {
-		return projection
+	if !goal.TargetDate().After(now) {
+		return projection, false
 	}
 
-	remainingMonths := remainingDays / 30
-	if remainingMonths <= 0 {
-		remainingMonths = 1
+	if granularity == GoalProjectionGranularityWeekly {
+		return uc.calculateWeeklyGoalProgressProjection(goal, schedule, monthlyContribution, now)
+	}
+	return uc.calculateMonthlyGoalProgressProjection(goal, schedule, monthlyContribution, now)
+}
+
+// calculateGoalProjectionScenarios は月間拠出額の幅に応じた悲観・想定・楽観シナリオの進捗予測を計算する
+// 想定シナリオは expected（月間拠出額での予測）をそのまま使い、最低額・ストレッチ額が未設定のシナリオは含めない
+func (uc *calculateProjectionUseCaseImpl) calculateGoalProjectionScenarios(goal *entities.Goal, schedule entities.GoalSchedule, expected []GoalProgressProjection, now time.Time, granularity GoalProjectionGranularity) []GoalProjectionScenario {
+	startDate := schedule.EffectiveStartDate
+	if startDate.IsZero() {
+		startDate = now
+	}
+	newScenario := func(scenarioType GoalProjectionScenarioType, contribution valueobjects.Money, projection []GoalProgressProjection) GoalProjectionScenario {
+		scenario := GoalProjectionScenario{
+			Scenario:            scenarioType,
+			MonthlyContribution: contribution.Amount(),
+			Projection:          projection,
+		}
+		if completionDate, err := goal.EstimateCompletionDateFrom(startDate, contribution); err == nil {
+			scenario.EstimatedCompletionDate = completionDate.Format(time.RFC3339)
+		}
+		return scenario
+	}
+	withContribution := func(scenarioType GoalProjectionScenarioType, contribution valueobjects.Money) GoalProjectionScenario {
+		projection, _ := uc.calculateGoalProgressProjectionWithContribution(goal, schedule, contribution.Amount(), now, granularity)
+		return newScenario(scenarioType, contribution, projection)
+	}
+
+	var scenarios []GoalProjectionScenario
+	if goal.ContributionMin() != nil {
+		scenarios = append(scenarios, withContribution(GoalProjectionScenarioPessimistic, goal.MinimumMonthlyContribution()))
+	}
+	scenarios = append(scenarios, newScenario(GoalProjectionScenarioExpected, goal.MonthlyContribution(), expected))
+	if goal.ContributionStretch() != nil {
+		scenarios = append(scenarios, withContribution(GoalProjectionScenarioOptimistic, goal.StretchMonthlyContribution()))
+	}
+	return scenarios
+}
+
+// calculateMonthlyGoalProgressProjection は月次の目標進捗予測を計算する
+// 予測する月数は entities.Goal.RequiredSavingsPlanFrom の拠出回数と同じく暦上の月数で数え、残り1ヶ月以内の場合は1ヶ月とする
+func (uc *calculateProjectionUseCaseImpl) calculateMonthlyGoalProgressProjection(goal *entities.Goal, schedule entities.GoalSchedule, monthlyContribution float64, now time.Time) ([]GoalProgressProjection, bool) {
+	var projection []GoalProgressProjection
+
+	remainingMonths := max(goal.RemainingMonthsFrom(now), 1)
+
+	deferredMonths := 0
+	if !schedule.EffectiveStartDate.IsZero() {
+		deferredMonths = entities.MonthsBetween(now, schedule.EffectiveStartDate)
 	}
 
 	currentAmount := goal.CurrentAmount().Amount()
-	monthlyContribution := goal.MonthlyContribution().Amount()
 	targetAmount := goal.TargetAmount().Amount()
 
-	for month := 1; month <= remainingMonths; month++ {
-		projectedAmount := currentAmount + (monthlyContribution * float64(month))
+	points := min(remainingMonths, MaxGoalProjectionPoints)
+	for month := 1; month <= points; month++ {
+		contributingMonths := month - deferredMonths
+		if contributingMonths < 0 {
+			contributingMonths = 0
+		}
+		projectedAmount := currentAmount + (monthlyContribution * float64(contributingMonths))
 		progressRate := (projectedAmount / targetAmount) * 100
 		onTrack := progressRate >= (float64(month)/float64(remainingMonths))*100
 
 		projection = append(projection, GoalProgressProjection{
 			Month:           month,
+			Date:            now.AddDate(0, month, 0).Format("2006-01-02"),
+			ProjectedAmount: projectedAmount,
+			ProgressRate:    progressRate,
+			OnTrack:         onTrack,
+		})
+	}
+
+	return projection, remainingMonths > points
+}
+
+// calculateWeeklyGoalProgressProjection は週次の目標進捗予測を計算する
+// 月間拠出額は拠出開始日からの暦上の経過月数（端数は実際の月の日数で按分）に応じて積み上げ、最終週は目標日のデータ点とする
+func (uc *calculateProjectionUseCaseImpl) calculateWeeklyGoalProgressProjection(goal *entities.Goal, schedule entities.GoalSchedule, monthlyContribution float64, now time.Time) ([]GoalProgressProjection, bool) {
+	var projection []GoalProgressProjection
+
+	targetDate := goal.TargetDate()
+	remainingWeeks := weeksUntil(now, targetDate)
+	totalDuration := targetDate.Sub(now)
+
+	startDate := now
+	if schedule.EffectiveStartDate.After(now) {
+		startDate = schedule.EffectiveStartDate
+	}
+
+	currentAmount := goal.CurrentAmount().Amount()
+	targetAmount := goal.TargetAmount().Amount()
+
+	points := min(remainingWeeks, MaxGoalProjectionPoints)
+	for week := 1; week <= points; week++ {
+		date := now.AddDate(0, 0, 7*week)
+		if date.After(targetDate) {
+			date = targetDate
+		}
+		projectedAmount := currentAmount + monthlyContribution*entities.FractionalMonthsBetween(startDate, date)
+		progressRate := (projectedAmount / targetAmount) * 100
+		onTrack := progressRate >= (float64(date.Sub(now))/float64(totalDuration))*100
+
+		projection = append(projection, GoalProgressProjection{
+			Week:            week,
+			Date:            date.Format("2006-01-02"),
 			ProjectedAmount: projectedAmount,
 			ProgressRate:    progressRate,
 			OnTrack:         onTrack,
 		})
 	}
 
-	return projection
+	return projection, remainingWeeks > points
 }
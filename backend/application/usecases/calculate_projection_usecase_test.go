@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
@@ -17,7 +18,7 @@ import (
 func newTestFinancialPlanWithEmergencyFundData(userID entities.UserID) *aggregates.FinancialPlan {
 	plan := newTestFinancialPlan(userID)
 	currentFund, _ := valueobjects.NewMoneyJPY(300000)
-	config, _ := aggregates.NewEmergencyFundConfig(6, currentFund)
+	config, _ := aggregates.NewEmergencyFund(6, currentFund)
 	_ = plan.UpdateEmergencyFund(config)
 	return plan
 }
@@ -44,9 +45,67 @@ func TestCalculateProjectionUseCase_CalculateAssetProjection(t *testing.T) {
 		assert.NotNil(t, output)
 		assert.Len(t, output.Projections, 10)
 		assert.Greater(t, output.Summary.FinalAmount, output.Summary.InitialAmount)
+		// 計算に使った前提が結果と一緒に返される
+		assert.Equal(t, plan.Profile().InvestmentReturn().AsPercentage(), output.Assumptions.InvestmentReturn)
+		assert.Equal(t, plan.Profile().InflationRate().AsPercentage(), output.Assumptions.InflationRate)
+		assert.Equal(t, 10, output.Assumptions.ProjectionYears)
+		assert.Equal(t, CompoundingFrequencyMonthly, output.Assumptions.CompoundingFrequency)
+		assert.Equal(t, valueobjects.ContributionTimingEnd, output.Assumptions.ContributionTiming)
+		assert.Equal(t, 400000.0, output.Assumptions.MonthlyIncome)
+		assert.Equal(t, 180000.0, output.Assumptions.MonthlyExpensesTotal)
+		assert.Equal(t, 220000.0, output.Assumptions.NetSavings)
+		assert.Equal(t, services.CalculationVersion, output.Assumptions.CalculationVersion)
+		assert.False(t, output.Assumptions.CalculatedAt.IsZero())
 		mockPlanRepo.AssertExpectations(t)
 	})
 
+	t.Run("正常系: 期初拠出を指定すると積立が1か月分多く運用され前提にも記録される", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil).Once()
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil).Once()
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		end, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 10})
+		require.NoError(t, err)
+		beginning, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{
+			UserID:             "user-001",
+			Years:              10,
+			ContributionTiming: valueobjects.ContributionTimingBeginning,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, valueobjects.ContributionTimingBeginning, beginning.Assumptions.ContributionTiming)
+		assert.Greater(t, beginning.Summary.FinalAmount, end.Summary.FinalAmount)
+		assert.Equal(t, end.Projections[9].ContributedAmount, beginning.Projections[9].ContributedAmount)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 前提は計算時点のプロファイルの値で、後からプロファイルを変更しても変わらない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		before, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 10})
+		require.NoError(t, err)
+
+		lowerReturn, err := valueobjects.NewRate(3.0)
+		require.NoError(t, err)
+		require.NoError(t, plan.Profile().UpdateInvestmentReturn(lowerReturn))
+		require.NoError(t, plan.Profile().UpdateMonthlyIncome(mustNewMoney(350000)))
+
+		after, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 10})
+		require.NoError(t, err)
+
+		assert.Equal(t, 5.0, before.Assumptions.InvestmentReturn)
+		assert.Equal(t, 400000.0, before.Assumptions.MonthlyIncome)
+		assert.Equal(t, 220000.0, before.Assumptions.NetSavings)
+		assert.Equal(t, 3.0, after.Assumptions.InvestmentReturn)
+		assert.Equal(t, 350000.0, after.Assumptions.MonthlyIncome)
+		assert.Equal(t, 170000.0, after.Assumptions.NetSavings)
+	})
+
 	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo := new(MockGoalRepository)
@@ -81,6 +140,52 @@ func TestCalculateProjectionUseCase_CalculateAssetProjection(t *testing.T) {
 		}
 		mockPlanRepo.AssertExpectations(t)
 	})
+
+	t.Run("正常系: 目標を指定した場合は目標の月間拠出額で推移を計算する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		goal := newTestGoal("user-001", "")
+		goalID := goal.ID()
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindByID", mock_anything(), goalID).Return(goal, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{
+			UserID: "user-001",
+			Years:  2,
+			GoalID: &goalID,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Projections, 2)
+		// 純貯蓄額（220,000円）ではなく目標の月間拠出額（50,000円）で積み立てる
+		assert.InDelta(t, 50000.0*12, output.Projections[0].ContributedAmount.Amount(), 0.01)
+		assert.Equal(t, 1000000.0, output.Summary.TargetAmount)
+		assert.Equal(t, output.Summary.FinalAmount >= 1000000.0, output.Summary.TargetReached)
+		mockPlanRepo.AssertExpectations(t)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 他のユーザーの目標を指定した場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		goal := newTestGoal("user-002", "")
+		goalID := goal.ID()
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindByID", mock_anything(), goalID).Return(goal, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		_, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{
+			UserID: "user-001",
+			Years:  2,
+			GoalID: &goalID,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "権限がありません")
+	})
 }
 
 func TestCalculateProjectionUseCase_CalculateRetirementProjection(t *testing.T) {
@@ -122,6 +227,205 @@ func TestCalculateProjectionUseCase_CalculateGoalProjection(t *testing.T) {
 		require.Error(t, err)
 		mockGoalRepo.AssertExpectations(t)
 	})
+
+	t.Run("正常系: 前提目標の月間拠出額を減らすと依存する目標の拠出開始と完了見込みが後ろにずれる", func(t *testing.T) {
+		prerequisite, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "車の購入", mustNewMoney(1200000), time.Now().AddDate(3, 0, 0), mustNewMoney(100000))
+		require.NoError(t, err)
+		dependent, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "住宅頭金", mustNewMoney(1200000), time.Now().AddDate(5, 0, 0), mustNewMoney(100000))
+		require.NoError(t, err)
+		require.NoError(t, dependent.SetDependencies([]*entities.Goal{prerequisite}, []*entities.Goal{prerequisite, dependent}))
+
+		plan := newTestFinancialPlan("user-001")
+		require.NoError(t, plan.AddGoal(prerequisite))
+		require.NoError(t, plan.AddGoal(dependent))
+
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindByID", mock_anything(), dependent.ID()).Return(dependent, nil)
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		input := GoalProjectionInput{UserID: "user-001", GoalID: dependent.ID()}
+
+		before, err := uc.CalculateGoalProjection(ctx, input)
+		require.NoError(t, err)
+
+		// 前提目標の完了（約12ヶ月後）までは依存する目標の金額は増えない
+		require.NotEmpty(t, before.Projection)
+		assert.Equal(t, 0.0, before.Projection[0].ProjectedAmount)
+		beforeStart, err := time.Parse(time.RFC3339, before.EffectiveStartDate)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().AddDate(0, 12, 0), beforeStart, 48*time.Hour)
+
+		// 前提目標の月間拠出額を半分にすると前提目標の完了が約24ヶ月後になる
+		require.NoError(t, prerequisite.UpdateMonthlyContribution(mustNewMoney(50000)))
+
+		after, err := uc.CalculateGoalProjection(ctx, input)
+		require.NoError(t, err)
+
+		afterStart, err := time.Parse(time.RFC3339, after.EffectiveStartDate)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().AddDate(0, 24, 0), afterStart, 48*time.Hour)
+
+		beforeCompletion, err := time.Parse(time.RFC3339, before.EstimatedCompletionDate)
+		require.NoError(t, err)
+		afterCompletion, err := time.Parse(time.RFC3339, after.EstimatedCompletionDate)
+		require.NoError(t, err)
+		assert.True(t, afterCompletion.After(beforeCompletion))
+		assert.Equal(t, 0.0, after.Projection[12].ProjectedAmount)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 粒度未指定の場合は短期目標を週次、長期目標を月次で予測する", func(t *testing.T) {
+		shortTerm, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "旅行資金", mustNewMoney(300000), time.Now().AddDate(0, 0, 70), mustNewMoney(100000))
+		require.NoError(t, err)
+		longTerm, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "住宅頭金", mustNewMoney(2400000), time.Now().AddDate(2, 0, 1), mustNewMoney(100000))
+		require.NoError(t, err)
+
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockGoalRepo.On("FindByID", mock_anything(), shortTerm.ID()).Return(shortTerm, nil)
+		mockGoalRepo.On("FindByID", mock_anything(), longTerm.ID()).Return(longTerm, nil)
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+
+		weekly, err := uc.CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: shortTerm.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, GoalProjectionGranularityWeekly, weekly.ProjectionGranularity)
+		require.Len(t, weekly.Projection, 10)
+		assert.Equal(t, 1, weekly.Projection[0].Week)
+		assert.Equal(t, 0, weekly.Projection[0].Month)
+		assert.Equal(t, time.Now().AddDate(0, 0, 7).Format("2006-01-02"), weekly.Projection[0].Date)
+		// 最終週は目標日のデータ点となり、暦上の経過月数分の拠出が積み上がる
+		last := weekly.Projection[len(weekly.Projection)-1]
+		assert.Equal(t, shortTerm.TargetDate().Format("2006-01-02"), last.Date)
+		assert.InDelta(t, 100000*entities.FractionalMonthsBetween(time.Now(), shortTerm.TargetDate()), last.ProjectedAmount, 1)
+
+		monthly, err := uc.CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: longTerm.ID()})
+		require.NoError(t, err)
+		assert.Equal(t, GoalProjectionGranularityMonthly, monthly.ProjectionGranularity)
+		assert.Len(t, monthly.Projection, 24)
+		assert.False(t, monthly.ProjectionTruncated)
+
+		explicitWeekly, err := uc.CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: longTerm.ID(), Granularity: GoalProjectionGranularityWeekly})
+		require.NoError(t, err)
+		assert.Equal(t, GoalProjectionGranularityWeekly, explicitWeekly.ProjectionGranularity)
+		assert.Len(t, explicitWeekly.Projection, weeksUntil(time.Now(), longTerm.TargetDate()))
+	})
+
+	t.Run("異常系: 不正な粒度はエラー", func(t *testing.T) {
+		goal, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "旅行資金", mustNewMoney(300000), time.Now().AddDate(1, 0, 0), mustNewMoney(30000))
+		require.NoError(t, err)
+
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+
+		_, err = uc.CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: goal.ID(), Granularity: "daily"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "予測粒度")
+	})
+}
+
+func TestCalculateProjectionUseCase_CalculateGoalProjection_ContributionScenarios(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	newUseCase := func(goal *entities.Goal) CalculateProjectionUseCase {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		return NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+	}
+
+	t.Run("正常系: 最低額・ストレッチ額を設定すると悲観・想定・楽観の順に予測を返す", func(t *testing.T) {
+		goal, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "住宅頭金", mustNewMoney(3000000), time.Now().AddDate(5, 0, 0), mustNewMoney(50000))
+		require.NoError(t, err)
+		minimum, stretch := mustNewMoney(30000), mustNewMoney(70000)
+		require.NoError(t, goal.UpdateContributionPlan(&minimum, mustNewMoney(50000), &stretch))
+
+		output, err := newUseCase(goal).CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: goal.ID()})
+
+		require.NoError(t, err)
+		require.Len(t, output.Scenarios, 3)
+		pessimistic, expected, optimistic := output.Scenarios[0], output.Scenarios[1], output.Scenarios[2]
+		assert.Equal(t, GoalProjectionScenarioPessimistic, pessimistic.Scenario)
+		assert.Equal(t, GoalProjectionScenarioExpected, expected.Scenario)
+		assert.Equal(t, GoalProjectionScenarioOptimistic, optimistic.Scenario)
+		assert.Equal(t, []float64{30000, 50000, 70000}, []float64{pessimistic.MonthlyContribution, expected.MonthlyContribution, optimistic.MonthlyContribution})
+		assert.Equal(t, output.Projection, expected.Projection)
+		assert.Equal(t, output.EstimatedCompletionDate, expected.EstimatedCompletionDate)
+
+		// 各データ点で 悲観 < 想定 < 楽観 となる
+		require.Len(t, pessimistic.Projection, len(expected.Projection))
+		require.Len(t, optimistic.Projection, len(expected.Projection))
+		for i := range expected.Projection {
+			assert.Less(t, pessimistic.Projection[i].ProjectedAmount, expected.Projection[i].ProjectedAmount)
+			assert.Less(t, expected.Projection[i].ProjectedAmount, optimistic.Projection[i].ProjectedAmount)
+		}
+
+		// 完了見込み日は 楽観 < 想定 < 悲観 となる
+		completion := make([]time.Time, 0, 3)
+		for _, scenario := range output.Scenarios {
+			date, err := time.Parse(time.RFC3339, scenario.EstimatedCompletionDate)
+			require.NoError(t, err)
+			completion = append(completion, date)
+		}
+		assert.True(t, completion[2].Before(completion[1]))
+		assert.True(t, completion[1].Before(completion[0]))
+	})
+
+	t.Run("正常系: 最低額・ストレッチ額が未設定の場合は想定の予測だけを返す", func(t *testing.T) {
+		goal, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "住宅頭金", mustNewMoney(3000000), time.Now().AddDate(5, 0, 0), mustNewMoney(50000))
+		require.NoError(t, err)
+
+		output, err := newUseCase(goal).CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: goal.ID()})
+
+		require.NoError(t, err)
+		require.Len(t, output.Scenarios, 1)
+		assert.Equal(t, GoalProjectionScenarioExpected, output.Scenarios[0].Scenario)
+		assert.Equal(t, 50000.0, output.Scenarios[0].MonthlyContribution)
+		assert.Equal(t, output.Projection, output.Scenarios[0].Projection)
+	})
+
+	t.Run("正常系: 実現可能性は最低額で判定する", func(t *testing.T) {
+		// 残額300万円・5年のため月次必要額は5万円。最低額3万円では期限までに届かない
+		goal, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "住宅頭金", mustNewMoney(3000000), time.Now().AddDate(5, 0, 0), mustNewMoney(60000))
+		require.NoError(t, err)
+		minimum := mustNewMoney(30000)
+		require.NoError(t, goal.UpdateContributionPlan(&minimum, mustNewMoney(60000), nil))
+
+		output, err := newUseCase(goal).CalculateGoalProjection(ctx, GoalProjectionInput{UserID: "user-001", GoalID: goal.ID()})
+
+		require.NoError(t, err)
+		assert.Equal(t, false, output.Feasibility["achievable"])
+		assert.Equal(t, 30000.0, output.Feasibility["minimum_monthly_contribution"])
+		require.Len(t, output.Scenarios, 2)
+		assert.Equal(t, GoalProjectionScenarioPessimistic, output.Scenarios[0].Scenario)
+		assert.Equal(t, GoalProjectionScenarioExpected, output.Scenarios[1].Scenario)
+	})
+}
+
+func TestGoalProgressProjection_MaxPoints(t *testing.T) {
+	now := time.Now()
+	uc := &calculateProjectionUseCaseImpl{}
+	goal, err := entities.NewGoal("user-001", entities.GoalTypeRetirement, "老後資金", mustNewMoney(100000000), now.AddDate(60, 0, 0), mustNewMoney(100000))
+	require.NoError(t, err)
+
+	t.Run("正常系: 週次のデータ点が上限を超える場合は月次に切り替える", func(t *testing.T) {
+		granularity, err := resolveGoalProjectionGranularity(GoalProjectionGranularityWeekly, goal, now)
+		require.NoError(t, err)
+		assert.Equal(t, GoalProjectionGranularityMonthly, granularity)
+	})
+
+	t.Run("正常系: 月次のデータ点が上限を超える場合は打ち切る", func(t *testing.T) {
+		projection, truncated := uc.calculateGoalProgressProjection(goal, entities.GoalSchedule{}, now, GoalProjectionGranularityMonthly)
+		assert.Len(t, projection, MaxGoalProjectionPoints)
+		assert.True(t, truncated)
+	})
 }
 
 func TestCalculateProjectionUseCase_CalculateComprehensiveProjection(t *testing.T) {
@@ -162,6 +466,47 @@ func TestCalculateProjectionUseCase_CalculateComprehensiveProjection(t *testing.
 	})
 }
 
+func TestCalculateProjectionUseCase_CalculateQuickProjection(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 1年後の資産・貯蓄率・緊急資金の充足月数を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlanWithEmergencyFundData("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateQuickProjection(ctx, QuickProjectionInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		// 1年後の資産は資産推移の1年目と一致する（元本100万円・月22万円を年利5%で12か月運用）
+		projections, err := plan.Profile().ProjectAssets(1)
+		require.NoError(t, err)
+		assert.Equal(t, 1000000.0, output.CurrentAssets)
+		assert.Equal(t, projections[0].TotalAssets.Amount(), output.ProjectedAssets1Year)
+		assert.InDelta(t, valueobjects.FutureValue(1000000, 220000, 5, 12), output.ProjectedAssets1Year, 1)
+		assert.InDelta(t, output.ProjectedAssets1Year-1000000, output.EstimatedGrowth, 0.001)
+		assert.InDelta(t, 55.0, output.SavingsRate, 0.001)
+		// 緊急資金30万円 ÷ 月間支出18万円
+		require.NotNil(t, output.EmergencyFundStatus)
+		assert.InDelta(t, 300000.0/180000.0, output.EmergencyFundStatus.MonthsOfExpensesCovered, 0.0001)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: FindByUserIDのエラーを伝播する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		_, err := uc.CalculateQuickProjection(ctx, QuickProjectionInput{UserID: "user-999"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+		mockPlanRepo.AssertExpectations(t)
+	})
+}
+
 // ===========================
 // CalculateEmergencyFundProjection Tests
 // ===========================
@@ -199,6 +544,56 @@ func TestCalculateProjectionUseCase_CalculateEmergencyFundProjection(t *testing.
 
 		require.NoError(t, err)
 		assert.NotNil(t, output)
+		// 月間支出180,000円 × 6ヶ月 - 現在額300,000円
+		assert.Equal(t, 1080000.0, output.Status.RequiredAmount.Amount())
+		assert.Equal(t, 780000.0, output.Status.Shortfall.Amount())
+		assert.Nil(t, output.Pace)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 積立履歴がある場合は達成ペースを返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlanWithEmergencyFundData("user-001")
+		require.NoError(t, plan.AddEmergencyFundContribution(mustNewMoney(40000), time.Now()))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateEmergencyFundProjection(ctx, EmergencyFundProjectionInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Pace)
+		assert.Equal(t, 40000.0, output.Pace.AverageMonthlyContribution.Amount())
+		assert.Equal(t, 1, output.Pace.ContributionCount)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 積立予定額がある場合はその額でタイムラインを計算する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		// 必要額 1,080,000円 - 現在額 720,000円 = 不足額 360,000円
+		require.NoError(t, plan.UpdateEmergencyFundSettings(6, mustNewMoney(720000)))
+		require.NoError(t, plan.UpdateEmergencyFundMonthlyContribution(mustNewMoney(20000)))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateEmergencyFundProjection(ctx, EmergencyFundProjectionInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Status.TargetReachedMonth)
+		assert.Equal(t, 18, *output.Status.TargetReachedMonth)
+		assert.Equal(t, 18, output.Timeline.MonthsToTarget)
+		assert.Equal(t, 20000.0, output.Timeline.MonthlySavingsGoal)
+		months := make([]int, 0, len(output.Timeline.Milestones))
+		for _, milestone := range output.Timeline.Milestones {
+			months = append(months, milestone.Month)
+		}
+		// 50%までは達成済み、75%（810,000円）は90,000円の積立後
+		assert.Equal(t, []int{0, 0, 5, 18}, months)
 		mockPlanRepo.AssertExpectations(t)
 	})
 }
@@ -226,6 +621,60 @@ func TestCalculateProjectionUseCase_CalculateRetirementProjection_WithData(t *te
 		require.NoError(t, err)
 		assert.NotNil(t, output)
 		assert.NotNil(t, output.Calculation)
+		// 充足率が150%以上の場合は取り崩し計画を含めない
+		assert.Nil(t, output.DrawdownSchedule)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 生活水準別シナリオを含め、指定した月間生活費で上書きできる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlanWithRetirementData("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateRetirementProjection(ctx, RetirementProjectionInput{
+			UserID: "user-001",
+			LifestyleExpenses: map[entities.RetirementLifestyle]float64{
+				entities.RetirementLifestyleMinimum: 180000,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.LifestyleScenarios, 3)
+		assert.Equal(t, entities.RetirementLifestyleComfortable, output.LifestyleScenarios[0].Lifestyle)
+		assert.Equal(t, 379000.0, output.LifestyleScenarios[0].MonthlyExpenses.Amount())
+		assert.False(t, output.LifestyleScenarios[0].IsCustomized)
+		minimum := output.LifestyleScenarios[2]
+		assert.Equal(t, entities.RetirementLifestyleMinimum, minimum.Lifestyle)
+		assert.Equal(t, 180000.0, minimum.MonthlyExpenses.Amount())
+		assert.True(t, minimum.IsCustomized)
+		// 退職データ自体の月間退職後支出での計算結果は変わらない
+		assert.Equal(t, 200000.0, plan.RetirementData().MonthlyRetirementExpenses().Amount())
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 充足率が150%未満の場合は取り崩し計画を含める", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		retirement, err := entities.NewRetirementData("user-001", 60, 65, 95, mustNewMoney(400000), mustNewMoney(50000))
+		require.NoError(t, err)
+		require.NoError(t, plan.SetRetirementData(retirement))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateRetirementProjection(ctx, RetirementProjectionInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.DrawdownSchedule, 30)
+		first := output.DrawdownSchedule[0]
+		assert.Equal(t, 1, first.Year)
+		assert.InDelta(t, output.Calculation.ProjectedAmount.Amount(), first.StartBalance, 0.01)
+		// 予想達成額が必要額を大きく下回るため、退職後の期間中に資産が尽きる
+		assert.True(t, output.DrawdownSchedule[len(output.DrawdownSchedule)-1].Depleted)
 		mockPlanRepo.AssertExpectations(t)
 	})
 
@@ -245,3 +694,547 @@ func TestCalculateProjectionUseCase_CalculateRetirementProjection_WithData(t *te
 		mockPlanRepo.AssertExpectations(t)
 	})
 }
+
+// ===========================
+// CalculateTimeToAmount Tests
+// ===========================
+
+func TestCalculateProjectionUseCase_CalculateTimeToAmount(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 上書き値がない場合は財務プロファイルの純貯蓄額と利回りを使う", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateTimeToAmount(ctx, TimeToAmountInput{
+			UserID:       "user-001",
+			TargetAmount: 20000000,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Reachable)
+		assert.Equal(t, 1000000.0, output.CurrentAssets)
+		assert.Equal(t, 220000.0, output.MonthlyContribution)
+		assert.Equal(t, 5.0, output.AnnualReturn)
+		// 利回りがある分、単純な割り算（(2000万-100万)/22万 ≒ 87ヶ月）より早く到達する
+		assert.Less(t, output.Months, 87)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 積立額と利回りを上書きできる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		contribution := 100000.0
+		annualReturn := 0.0
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateTimeToAmount(ctx, TimeToAmountInput{
+			UserID:              "user-001",
+			TargetAmount:        2000000,
+			MonthlyContribution: &contribution,
+			AnnualReturn:        &annualReturn,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Reachable)
+		assert.Equal(t, 10, output.Months)
+		// 上書きした利回りが前提として返される
+		assert.Equal(t, 0.0, output.Assumptions.InvestmentReturn)
+	})
+
+	t.Run("正常系: 積立も運用益もない場合は到達不可", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		contribution := 0.0
+		annualReturn := 0.0
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateTimeToAmount(ctx, TimeToAmountInput{
+			UserID:              "user-001",
+			TargetAmount:        2000000,
+			MonthlyContribution: &contribution,
+			AnnualReturn:        &annualReturn,
+		})
+
+		require.NoError(t, err)
+		assert.False(t, output.Reachable)
+	})
+
+	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		_, err := uc.CalculateTimeToAmount(ctx, TimeToAmountInput{UserID: "user-999", TargetAmount: 2000000})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+	})
+}
+
+// ===========================
+// CalculateRequiredReturn Tests
+// ===========================
+
+func TestCalculateProjectionUseCase_CalculateRequiredReturn(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 積立のみで到達できる場合は0%", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateRequiredReturn(ctx, RequiredReturnInput{
+			UserID:       "user-001",
+			TargetAmount: 3000000,
+			Years:        1,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Reachable)
+		assert.Equal(t, 0.0, output.RequiredAnnualReturn)
+		assert.False(t, output.Unrealistic)
+		assert.Equal(t, services.DefaultUnrealisticReturnThreshold, output.UnrealisticThreshold)
+	})
+
+	t.Run("正常系: 閾値を超える利回りは非現実的と判定する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		threshold := 10.0
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateRequiredReturn(ctx, RequiredReturnInput{
+			UserID:               "user-001",
+			TargetAmount:         100000000,
+			Years:                10,
+			UnrealisticThreshold: &threshold,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Reachable)
+		assert.Greater(t, output.RequiredAnnualReturn, threshold)
+		assert.True(t, output.Unrealistic)
+		assert.Equal(t, threshold, output.UnrealisticThreshold)
+	})
+
+	t.Run("異常系: 年数が0以下の場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		_, err := uc.CalculateRequiredReturn(ctx, RequiredReturnInput{
+			UserID:       "user-001",
+			TargetAmount: 3000000,
+			Years:        0,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "必要利回りの計算に失敗しました")
+	})
+}
+
+// ===========================
+// CalculateRequiredSavingsRate Tests
+// ===========================
+
+func TestCalculateProjectionUseCase_CalculateAmortization(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+	uc := NewCalculateProjectionUseCase(new(MockFinancialPlanRepository), new(MockGoalRepository), calcService, recService)
+
+	t.Run("正常系: 返済予定と返済総額を返す", func(t *testing.T) {
+		output, err := uc.CalculateAmortization(ctx, AmortizationInput{
+			LoanAmount: 30000000,
+			AnnualRate: 1.5,
+			TermMonths: 420,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Schedule, 420)
+		assert.Equal(t, 91855.0, output.MonthlyPayment)
+		assert.InDelta(t, 30000000+output.TotalInterest, output.TotalPayment, 0.01)
+		assert.InDelta(t, 0, output.Schedule[419].RemainingBalance, 1)
+	})
+
+	t.Run("異常系: 返済期間が0の場合はエラー", func(t *testing.T) {
+		_, err := uc.CalculateAmortization(ctx, AmortizationInput{
+			LoanAmount: 30000000,
+			AnnualRate: 1.5,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "返済予定の計算に失敗しました")
+	})
+}
+
+func TestGoalProgressProjection_AgreesWithGoalContribution(t *testing.T) {
+	now := time.Now()
+	projectionUseCase := &calculateProjectionUseCaseImpl{}
+	goalsUseCase := &manageGoalsUseCaseImpl{}
+
+	tests := []struct {
+		name              string
+		targetDate        time.Time
+		expectedMonths    int
+		expectedFinalPush bool
+	}{
+		{"正常系: 10日後の目標は残額全額を1回で拠出", now.AddDate(0, 0, 10), 1, true},
+		{"正常系: 45日後の目標は残額全額を1回で拠出", now.AddDate(0, 0, 45), 1, true},
+		{"正常系: 13ヶ月後の目標は13ヶ月で拠出", now.AddDate(0, 13, 0), 13, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "旅行資金", mustNewMoney(1300000), tt.targetDate, mustNewMoney(50000))
+			require.NoError(t, err)
+
+			contribution := goalsUseCase.calculateGoalContribution(goal, now)
+			projection, truncated := projectionUseCase.calculateGoalProgressProjection(goal, entities.GoalSchedule{}, now, GoalProjectionGranularityMonthly)
+			plan, err := goal.RequiredSavingsPlanFrom(now)
+			require.NoError(t, err)
+
+			assert.Len(t, projection, tt.expectedMonths)
+			assert.False(t, truncated)
+			assert.Equal(t, plan.Months, len(projection))
+			assert.Equal(t, tt.expectedFinalPush, contribution.FinalPush)
+			assert.Equal(t, plan.FinalPush, contribution.FinalPush)
+			assert.InDelta(t, plan.MonthlyAmount.Amount(), contribution.RequiredMonthlyContribution, 0.01)
+			assert.InDelta(t, 1300000/float64(tt.expectedMonths), contribution.RequiredMonthlyContribution, 0.01)
+		})
+	}
+}
+
+// newTestGoalDueInOneYear は1年後が期限の目標を作成するヘルパー
+func newTestGoalDueInOneYear(t *testing.T, goalType entities.GoalType, title string, targetAmount float64) *entities.Goal {
+	t.Helper()
+	goal, err := entities.NewGoal("user-001", goalType, title, mustNewMoney(targetAmount), time.Now().AddDate(1, 0, 0), mustNewMoney(0))
+	require.NoError(t, err)
+	return goal
+}
+
+func TestCalculateProjectionUseCase_CalculateRequiredSavingsRate(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	// newTestFinancialPlan の純貯蓄額は 400,000 - 180,000 = 220,000円
+	const netSavings = 220000.0
+
+	t.Run("正常系: 3つの目標の合計が貯蓄可能額を超える場合は不足額と見直し候補を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		emergency := newTestGoalDueInOneYear(t, entities.GoalTypeEmergency, "生活防衛資金", 1200000)
+		retirement := newTestGoalDueInOneYear(t, entities.GoalTypeRetirement, "老後資金", 3600000)
+		car := newTestGoalDueInOneYear(t, entities.GoalTypeSavings, "新車購入", 1200000)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).
+			Return([]*entities.Goal{emergency, retirement, car}, nil)
+
+		var totalRequired float64
+		for _, goal := range []*entities.Goal{emergency, retirement, car} {
+			required, err := goal.CalculateRequiredMonthlySavings()
+			require.NoError(t, err)
+			totalRequired += required.Amount()
+		}
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateRequiredSavingsRate(ctx, RequiredSavingsRateInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Len(t, output.Goals, 3)
+		assert.InDelta(t, totalRequired, output.TotalRequiredMonthlySavings, 0.01)
+		assert.InDelta(t, totalRequired/400000*100, output.RequiredSavingsRate, 0.01)
+		assert.Equal(t, netSavings, output.CurrentNetSavings)
+		assert.Nil(t, output.Surplus)
+		require.NotNil(t, output.AdditionalMonthlySavingsNeeded)
+		assert.InDelta(t, totalRequired-netSavings, *output.AdditionalMonthlySavingsNeeded, 0.01)
+
+		// 優先度の低い貯蓄目標から後回しにし、それでも不足する場合は退職目標を候補にする
+		require.Len(t, output.DeprioritizeSuggestions, 2)
+		assert.Equal(t, car.ID(), output.DeprioritizeSuggestions[0].GoalID)
+		assert.Greater(t, output.DeprioritizeSuggestions[0].RemainingGap, 0.0)
+		assert.Equal(t, retirement.ID(), output.DeprioritizeSuggestions[1].GoalID)
+		assert.Equal(t, 0.0, output.DeprioritizeSuggestions[1].RemainingGap)
+		assert.NotEmpty(t, output.DeprioritizeSuggestions[0].Reason)
+	})
+
+	t.Run("正常系: 純貯蓄額で賄える場合は余剰額を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		goal := newTestGoalDueInOneYear(t, entities.GoalTypeSavings, "旅行資金", 600000)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{goal}, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateRequiredSavingsRate(ctx, RequiredSavingsRateInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Surplus)
+		assert.InDelta(t, netSavings-output.TotalRequiredMonthlySavings, *output.Surplus, 0.01)
+		assert.Nil(t, output.AdditionalMonthlySavingsNeeded)
+		assert.Empty(t, output.DeprioritizeSuggestions)
+	})
+
+	t.Run("正常系: 目標がない場合は必要貯蓄率0%", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{}, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.CalculateRequiredSavingsRate(ctx, RequiredSavingsRateInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, output.RequiredSavingsRate)
+		require.NotNil(t, output.Surplus)
+		assert.Equal(t, netSavings, *output.Surplus)
+	})
+
+	t.Run("異常系: 目標の取得に失敗した場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("db error"))
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		_, err := uc.CalculateRequiredSavingsRate(ctx, RequiredSavingsRateInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "目標の取得に失敗しました")
+	})
+}
+
+// ===========================
+// Risk Analysis Tests
+// ===========================
+
+// newTestFinancialPlanWithRisk は利回り・ボラティリティ・リスク許容度を指定したテスト用の財務計画を作成する
+func newTestFinancialPlanWithRisk(t *testing.T, investmentReturn, volatility float64, tolerance entities.RiskTolerance) *aggregates.FinancialPlan {
+	t.Helper()
+	plan := newTestFinancialPlan("user-001")
+	rate, err := valueobjects.NewRate(investmentReturn)
+	require.NoError(t, err)
+	require.NoError(t, plan.Profile().UpdateInvestmentReturn(rate))
+	volatilityRate, err := valueobjects.NewRate(volatility)
+	require.NoError(t, err)
+	plan.Profile().SetExpectedVolatility(&volatilityRate)
+	require.NoError(t, plan.Profile().SetRiskTolerance(tolerance))
+	return plan
+}
+
+func TestCalculateProjectionUseCase_CalculateAssetProjection_Risk(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 最悪ケースの資産推移とリスク調整後リターンを返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlanWithRisk(t, 5, 10, entities.RiskToleranceModerate)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 10})
+
+		require.NoError(t, err)
+		assert.Equal(t, 10.0, output.Risk.Volatility)
+		assert.False(t, output.Risk.VolatilityEstimated)
+		require.NotNil(t, output.Risk.RiskAdjustedReturn)
+		assert.InDelta(t, (5-entities.RiskFreeRate)/10, *output.Risk.RiskAdjustedReturn, 1e-9)
+		assert.InDelta(t, -15.0, output.Risk.WorstCaseReturn, 1e-9)
+		assert.Len(t, output.Risk.WorstCaseProjections, 10)
+		assert.Less(t, output.Risk.WorstCaseFinalAmount, output.Summary.FinalAmount)
+		assert.False(t, output.Risk.ExceedsRiskTolerance)
+	})
+
+	t.Run("正常系: ボラティリティ未設定の場合は貯蓄の内訳から推定する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 5})
+
+		require.NoError(t, err)
+		// 預金のみのためボラティリティ0%と推定し、最悪ケースは期待リターンと一致する
+		assert.True(t, output.Risk.VolatilityEstimated)
+		assert.Equal(t, 0.0, output.Risk.Volatility)
+		assert.Nil(t, output.Risk.RiskAdjustedReturn)
+		assert.InDelta(t, output.Summary.FinalAmount, output.Risk.WorstCaseFinalAmount, 0.01)
+	})
+}
+
+func TestCalculateProjectionUseCase_StreamAssetProjection(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: サマリーを先に渡し、一括計算と同じ推移を1年ずつ渡す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlanWithRisk(t, 5, 10, entities.RiskToleranceModerate)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		expected, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 40})
+		require.NoError(t, err)
+
+		var header *AssetProjectionStreamHeader
+		var streamed []entities.AssetProjection
+		err = uc.StreamAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 40},
+			func(h *AssetProjectionStreamHeader) error {
+				assert.Empty(t, streamed, "サマリーは推移より先に渡す")
+				header = h
+				return nil
+			},
+			func(projection entities.AssetProjection) error {
+				streamed = append(streamed, projection)
+				return nil
+			},
+		)
+
+		require.NoError(t, err)
+		require.NotNil(t, header)
+		assert.Equal(t, 40, header.Years)
+		assert.Equal(t, expected.Summary, header.Summary)
+		assert.Equal(t, expected.Risk.WorstCaseFinalAmount, header.Risk.WorstCaseFinalAmount)
+		assert.Nil(t, header.Risk.WorstCaseProjections)
+		assert.Equal(t, expected.Projections, streamed)
+	})
+
+	t.Run("正常系: 目標を指定した場合は目標金額との比較をサマリーに含める", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		goalID := goal.ID()
+		var header *AssetProjectionStreamHeader
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		err := uc.StreamAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 5, GoalID: &goalID},
+			func(h *AssetProjectionStreamHeader) error {
+				header = h
+				return nil
+			},
+			func(entities.AssetProjection) error { return nil },
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, goal.TargetAmount().Amount(), header.Summary.TargetAmount)
+	})
+
+	t.Run("異常系: 財務計画が存在しない場合はヘッダーを渡さずにエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+
+		headerCalled := false
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		err := uc.StreamAssetProjection(ctx, AssetProjectionInput{UserID: "user-999", Years: 10},
+			func(*AssetProjectionStreamHeader) error {
+				headerCalled = true
+				return nil
+			},
+			func(entities.AssetProjection) error { return nil },
+		)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+		assert.False(t, headerCalled)
+	})
+
+	t.Run("異常系: 書き込みに失敗した場合は以降の推移を計算しない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		writeErr := errors.New("broken pipe")
+		calls := 0
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		err := uc.StreamAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 100},
+			func(*AssetProjectionStreamHeader) error { return nil },
+			func(entities.AssetProjection) error {
+				calls++
+				return writeErr
+			},
+		)
+
+		assert.ErrorIs(t, err, writeErr)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestCalculateProjectionUseCase_InvestmentOpportunities_RiskTolerance(t *testing.T) {
+	uc := NewCalculateProjectionUseCase(nil, nil, nil, nil).(*calculateProjectionUseCaseImpl)
+
+	findInvestmentOpportunity := func(opportunities []FinancialOpportunity) *FinancialOpportunity {
+		for i := range opportunities {
+			if opportunities[i].Type == "investment_optimization" {
+				return &opportunities[i]
+			}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name          string
+		currentReturn float64
+		tolerance     entities.RiskTolerance
+		expectedRisk  string // 空文字は提案なし
+	}{
+		{"安定重視には低リスクの運用のみ提案する", 2, entities.RiskToleranceConservative, "low"},
+		{"バランスには中リスクの運用を提案する", 2, entities.RiskToleranceModerate, "medium"},
+		{"積極運用には高リターン・高リスクの運用を提案する", 2, entities.RiskToleranceAggressive, "high"},
+		{"リスク許容度に合う運用で利回りが上がらない場合は提案しない", 4, entities.RiskToleranceConservative, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := newTestFinancialPlanWithRisk(t, tt.currentReturn, 5, tt.tolerance)
+
+			opportunity := findInvestmentOpportunity(uc.generateFinancialOpportunities(&aggregates.PlanProjection{}, plan))
+
+			if tt.expectedRisk == "" {
+				assert.Nil(t, opportunity)
+				return
+			}
+			require.NotNil(t, opportunity)
+			assert.Equal(t, tt.expectedRisk, opportunity.Risk)
+			assert.Greater(t, opportunity.Impact, 0.0)
+		})
+	}
+}
+
+func TestCalculateProjectionUseCase_Warnings_RiskTolerance(t *testing.T) {
+	uc := NewCalculateProjectionUseCase(nil, nil, nil, nil).(*calculateProjectionUseCaseImpl)
+
+	hasRiskWarning := func(warnings []FinancialWarning) bool {
+		for _, w := range warnings {
+			if w.Type == "risk_tolerance" {
+				return true
+			}
+		}
+		return false
+	}
+
+	// 安定重視（上限5%）でボラティリティ10%の場合は警告する
+	plan := newTestFinancialPlanWithRisk(t, 5, 10, entities.RiskToleranceConservative)
+	assert.True(t, hasRiskWarning(uc.generateFinancialWarnings(&aggregates.PlanProjection{}, plan)))
+
+	// 積極運用（上限25%）の場合は警告しない
+	plan = newTestFinancialPlanWithRisk(t, 5, 10, entities.RiskToleranceAggressive)
+	assert.False(t, hasRiskWarning(uc.generateFinancialWarnings(&aggregates.PlanProjection{}, plan)))
+}
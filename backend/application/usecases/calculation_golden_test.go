@@ -0,0 +1,239 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/stretchr/testify/require"
+)
+
+// goldenScenario は golden テストで使う代表的な入力
+type goldenScenario struct {
+	name string
+	plan func(userID entities.UserID) *aggregates.FinancialPlan
+}
+
+// goldenScenarios は golden テストの代表的な入力一覧
+var goldenScenarios = []goldenScenario{
+	{name: "standard", plan: newTestFinancialPlanWithRetirementData},
+	{name: "tight_budget", plan: newTightBudgetFinancialPlan},
+}
+
+// newTightBudgetFinancialPlan は純貯蓄が少なく退職が近いテスト用財務計画を作成するヘルパー
+func newTightBudgetFinancialPlan(userID entities.UserID) *aggregates.FinancialPlan {
+	expenses := entities.ExpenseCollection{
+		{Category: "住居費", Amount: mustNewMoney(95000)},
+		{Category: "食費", Amount: mustNewMoney(70000)},
+		{Category: "その他", Amount: mustNewMoney(65000)},
+	}
+	savings := entities.SavingsCollection{
+		{Type: "deposit", Amount: mustNewMoney(300000)},
+		{Type: "investment", Amount: mustNewMoney(200000)},
+	}
+	investmentReturn, _ := valueobjects.NewRate(3.0)
+	inflationRate, _ := valueobjects.NewRate(1.0)
+
+	profile, err := entities.NewFinancialProfile(userID, mustNewMoney(250000), expenses, savings, investmentReturn, inflationRate)
+	if err != nil {
+		panic("テスト用財務プロファイルの作成に失敗: " + err.Error())
+	}
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		panic("テスト用財務計画の作成に失敗: " + err.Error())
+	}
+
+	retirement, err := entities.NewRetirementData(userID, 50, 65, 90, mustNewMoney(220000), mustNewMoney(120000))
+	if err != nil {
+		panic("テスト用退職データの作成に失敗: " + err.Error())
+	}
+	if err := plan.SetRetirementData(retirement); err != nil {
+		panic("テスト用退職データの設定に失敗: " + err.Error())
+	}
+	return plan
+}
+
+// Money は内部フィールドが非公開でJSONに出力されないため、golden 比較用に数値へ展開する
+
+type assetProjectionSnapshot struct {
+	Year              int     `json:"year"`
+	TotalAssets       float64 `json:"total_assets"`
+	RealValue         float64 `json:"real_value"`
+	ContributedAmount float64 `json:"contributed_amount"`
+	InvestmentGains   float64 `json:"investment_gains"`
+}
+
+type assetProjectionOutputSnapshot struct {
+	Projections []assetProjectionSnapshot `json:"projections"`
+	Summary     ProjectionSummary         `json:"summary"`
+	Assumptions Assumptions               `json:"assumptions"`
+}
+
+type retirementCalculationSnapshot struct {
+	RequiredAmount            float64 `json:"required_amount"`
+	ProjectedAmount           float64 `json:"projected_amount"`
+	Shortfall                 float64 `json:"shortfall"`
+	SufficiencyRate           float64 `json:"sufficiency_rate"`
+	RecommendedMonthlySavings float64 `json:"recommended_monthly_savings"`
+}
+
+type retirementProjectionOutputSnapshot struct {
+	Calculation        retirementCalculationSnapshot `json:"calculation"`
+	Recommendations    []string                      `json:"recommendations"`
+	SufficiencyLevel   string                        `json:"sufficiency_level"`
+	RequiredAdjustment *RequiredAdjustment           `json:"required_adjustment,omitempty"`
+}
+
+func newAssetProjectionOutputSnapshot(output *AssetProjectionOutput) assetProjectionOutputSnapshot {
+	projections := make([]assetProjectionSnapshot, 0, len(output.Projections))
+	for _, p := range output.Projections {
+		projections = append(projections, assetProjectionSnapshot{
+			Year:              p.Year,
+			TotalAssets:       p.TotalAssets.Amount(),
+			RealValue:         p.RealValue.Amount(),
+			ContributedAmount: p.ContributedAmount.Amount(),
+			InvestmentGains:   p.InvestmentGains.Amount(),
+		})
+	}
+	// 実行日時に依存するフィールドは比較対象から除外する
+	assumptions := output.Assumptions
+	assumptions.CalculatedAt = time.Time{}
+	return assetProjectionOutputSnapshot{
+		Projections: projections,
+		Summary:     output.Summary,
+		Assumptions: assumptions,
+	}
+}
+
+func newRetirementProjectionOutputSnapshot(output *RetirementProjectionOutput) retirementProjectionOutputSnapshot {
+	calc := output.Calculation
+	return retirementProjectionOutputSnapshot{
+		Calculation: retirementCalculationSnapshot{
+			RequiredAmount:            calc.RequiredAmount.Amount(),
+			ProjectedAmount:           calc.ProjectedAmount.Amount(),
+			Shortfall:                 calc.Shortfall.Amount(),
+			SufficiencyRate:           calc.SufficiencyRate.AsPercentage(),
+			RecommendedMonthlySavings: calc.RecommendedMonthlySavings.Amount(),
+		},
+		Recommendations:    output.Recommendations,
+		SufficiencyLevel:   output.SufficiencyLevel,
+		RequiredAdjustment: output.RequiredAdjustment,
+	}
+}
+
+// ===========================
+// Golden Tests
+// ===========================
+
+func TestGolden_AssetProjection(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	for _, scenario := range goldenScenarios {
+		for _, years := range []int{10, 30} {
+			scenario, years := scenario, years
+			name := fmt.Sprintf("asset_projection_%s_%dy", scenario.name, years)
+			t.Run(name, func(t *testing.T) {
+				mockPlanRepo := new(MockFinancialPlanRepository)
+				mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(scenario.plan("user-001"), nil)
+
+				uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+				output, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: years})
+				require.NoError(t, err)
+
+				assertGolden(t, name, newAssetProjectionOutputSnapshot(output))
+			})
+		}
+	}
+}
+
+func TestGolden_RetirementProjection(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	for _, scenario := range goldenScenarios {
+		scenario := scenario
+		t.Run(scenario.name, func(t *testing.T) {
+			mockPlanRepo := new(MockFinancialPlanRepository)
+			mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(scenario.plan("user-001"), nil)
+
+			uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+			output, err := uc.CalculateRetirementProjection(ctx, RetirementProjectionInput{UserID: "user-001"})
+			require.NoError(t, err)
+
+			assertGolden(t, "retirement_projection_"+scenario.name, newRetirementProjectionOutputSnapshot(output))
+		})
+	}
+}
+
+func TestGolden_FinancialSummaryReport(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	for _, scenario := range goldenScenarios {
+		scenario := scenario
+		t.Run(scenario.name, func(t *testing.T) {
+			mockPlanRepo := new(MockFinancialPlanRepository)
+			mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(scenario.plan("user-001"), nil)
+
+			uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+			output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+			require.NoError(t, err)
+
+			// 実行日時に依存するフィールドは比較対象から除外する
+			report := output.Report
+			report.ReportDate = ""
+			report.Assumptions.CalculatedAt = time.Time{}
+			report.DataStaleness.LastReviewedAt = time.Time{}
+
+			assertGolden(t, "financial_summary_"+scenario.name, report)
+		})
+	}
+}
+
+// calculationFormulasSnapshot は公開する計算式の代表的な入力に対する計算結果
+type calculationFormulasSnapshot struct {
+	FutureValue                 map[string]float64 `json:"future_value"`
+	RealValue                   map[string]float64 `json:"real_value"`
+	RequiredMonthlyContribution map[string]float64 `json:"required_monthly_contribution"`
+	RetirementRequirement       map[string]float64 `json:"retirement_requirement"`
+}
+
+func TestGolden_CalculationFormulas(t *testing.T) {
+	calcService := services.NewFinancialCalculationService()
+
+	snapshot := calculationFormulasSnapshot{
+		FutureValue: map[string]float64{
+			"principal_1m_5pct_10y":         calcService.FutureValue(1_000_000, 0, 5, 120),
+			"monthly_30k_5pct_30y":          calcService.FutureValue(0, 30_000, 5, 360),
+			"principal_2m_monthly_50k_3pct": calcService.FutureValue(2_000_000, 50_000, 3, 240),
+			"monthly_20k_0pct_15y":          calcService.FutureValue(500_000, 20_000, 0, 180),
+		},
+		RealValue: map[string]float64{
+			"10m_2pct_10y": calcService.RealValue(10_000_000, 2, 10),
+			"10m_0pct_10y": calcService.RealValue(10_000_000, 0, 10),
+			"50m_3pct_25y": calcService.RealValue(50_000_000, 3, 25),
+		},
+		RequiredMonthlyContribution: map[string]float64{
+			"target_20m_current_3m_4pct_25y": calcService.RequiredMonthlyContribution(20_000_000, 3_000_000, 4, 300),
+			"target_5m_current_0_0pct_5y":    calcService.RequiredMonthlyContribution(5_000_000, 0, 0, 60),
+			"already_reached":                calcService.RequiredMonthlyContribution(1_000_000, 2_000_000, 3, 120),
+			"no_time_left":                   calcService.RequiredMonthlyContribution(1_000_000, 400_000, 3, 0),
+		},
+		RetirementRequirement: map[string]float64{
+			"expenses_280k_pension_150k_2pct": calcService.RetirementRequirement(280_000, 150_000, 25, 25, 2),
+			"expenses_300k_pension_150k_0pct": calcService.RetirementRequirement(300_000, 150_000, 30, 25, 0),
+			"pension_covers_expenses":         calcService.RetirementRequirement(200_000, 220_000, 15, 20, 1),
+		},
+	}
+
+	assertGolden(t, "calculation_formulas", snapshot)
+}
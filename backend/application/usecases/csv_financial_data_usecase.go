@@ -89,12 +89,15 @@ func (uc *csvFinancialDataUseCaseImpl) ImportFinancialDataFromCSV(ctx context.Co
 
 	if exists {
 		output, err := uc.manageUseCase.UpdateFinancialProfile(ctx, UpdateFinancialProfileInput{
-			UserID:           input.UserID,
-			MonthlyIncome:    parsed.MonthlyIncome,
-			MonthlyExpenses:  expenses,
-			CurrentSavings:   savings,
-			InvestmentReturn: parsed.InvestmentReturn,
-			InflationRate:    parsed.InflationRate,
+			UserID:                   input.UserID,
+			MonthlyIncome:            parsed.MonthlyIncome,
+			IncomeType:               parsed.IncomeType,
+			MonthlyExpenses:          expenses,
+			CurrentSavings:           savings,
+			InvestmentReturn:         parsed.InvestmentReturn,
+			InflationRate:            parsed.InflationRate,
+			AcknowledgeHighReturn:    parsed.AcknowledgeHighReturn,
+			AcknowledgeHighInflation: parsed.AcknowledgeHighInflation,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("財務プロファイルの更新に失敗しました: %w", err)
@@ -104,12 +107,15 @@ func (uc *csvFinancialDataUseCaseImpl) ImportFinancialDataFromCSV(ctx context.Co
 	}
 
 	_, err = uc.manageUseCase.CreateFinancialPlan(ctx, CreateFinancialPlanInput{
-		UserID:           input.UserID,
-		MonthlyIncome:    parsed.MonthlyIncome,
-		MonthlyExpenses:  expenses,
-		CurrentSavings:   savings,
-		InvestmentReturn: parsed.InvestmentReturn,
-		InflationRate:    parsed.InflationRate,
+		UserID:                   input.UserID,
+		MonthlyIncome:            parsed.MonthlyIncome,
+		IncomeType:               parsed.IncomeType,
+		MonthlyExpenses:          expenses,
+		CurrentSavings:           savings,
+		InvestmentReturn:         parsed.InvestmentReturn,
+		InflationRate:            parsed.InflationRate,
+		AcknowledgeHighReturn:    parsed.AcknowledgeHighReturn,
+		AcknowledgeHighInflation: parsed.AcknowledgeHighInflation,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("財務計画の作成に失敗しました: %w", err)
@@ -149,12 +155,29 @@ func generateCSVBytes(profile *entities.FinancialProfile) ([]byte, error) {
 	if err := w.Write([]string{"monthly_income", strconv.FormatFloat(profile.MonthlyIncome().Amount(), 'f', -1, 64)}); err != nil {
 		return nil, err
 	}
+	// 月収の入力区分は、省略時の手取りと区別するため額面の場合のみ出力する
+	if profile.IncomeType() == entities.IncomeTypeGross {
+		if err := w.Write([]string{"income_type", string(entities.IncomeTypeGross)}); err != nil {
+			return nil, err
+		}
+	}
 	if err := w.Write([]string{"investment_return", strconv.FormatFloat(profile.InvestmentReturn().AsPercentage(), 'f', -1, 64)}); err != nil {
 		return nil, err
 	}
 	if err := w.Write([]string{"inflation_rate", strconv.FormatFloat(profile.InflationRate().AsPercentage(), 'f', -1, 64)}); err != nil {
 		return nil, err
 	}
+	// 高い想定値への同意は、再インポート時に同意を引き継ぐため同意済みの場合のみ出力する
+	if profile.HighReturnAcknowledged() {
+		if err := w.Write([]string{"acknowledge_high_return", "true"}); err != nil {
+			return nil, err
+		}
+	}
+	if profile.HighInflationAcknowledged() {
+		if err := w.Write([]string{"acknowledge_high_inflation", "true"}); err != nil {
+			return nil, err
+		}
+	}
 	w.Flush()
 	if err := w.Error(); err != nil {
 		return nil, err
@@ -212,11 +235,14 @@ type parsedSaving struct {
 }
 
 type parsedCSVData struct {
-	MonthlyIncome    float64
-	InvestmentReturn float64
-	InflationRate    float64
-	Expenses         []parsedExpense
-	Savings          []parsedSaving
+	MonthlyIncome            float64
+	IncomeType               string
+	InvestmentReturn         float64
+	InflationRate            float64
+	AcknowledgeHighReturn    bool
+	AcknowledgeHighInflation bool
+	Expenses                 []parsedExpense
+	Savings                  []parsedSaving
 }
 
 type csvSection int
@@ -277,6 +303,18 @@ func parseCSVBytes(data []byte) (*parsedCSVData, error) {
 			if len(fields) < 2 {
 				continue
 			}
+			// 同意フラグは真偽値として読み取る
+			switch strings.TrimSpace(fields[0]) {
+			case "acknowledge_high_return":
+				result.AcknowledgeHighReturn, _ = strconv.ParseBool(strings.TrimSpace(fields[1]))
+				continue
+			case "acknowledge_high_inflation":
+				result.AcknowledgeHighInflation, _ = strconv.ParseBool(strings.TrimSpace(fields[1]))
+				continue
+			case "income_type":
+				result.IncomeType = strings.TrimSpace(fields[1])
+				continue
+			}
 			val, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
 			if err != nil {
 				continue
@@ -0,0 +1,228 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// DigestUpcomingGoalDays はダイジェストメールで期限が近い目標として案内する日数
+const DigestUpcomingGoalDays = 30
+
+// DigestUseCase はダイジェストメール（財務計画の変化と期限が近い目標のまとめ）のユースケース
+type DigestUseCase interface {
+	// FindDueUsers は配信設定と前回の配信日時から、現在ダイジェストメールを配信すべきユーザーを取得する
+	FindDueUsers(ctx context.Context) ([]*entities.User, error)
+
+	// BuildDigest は1人のユーザーのダイジェストメールの内容を、財務サマリー・目標進捗レポートから組み立てる
+	BuildDigest(ctx context.Context, user *entities.User) (*Digest, error)
+
+	// RecordDigestSent はダイジェストメールを配信したことを記録する（次回の配信対象の判定と比較の基準に使う）
+	RecordDigestSent(ctx context.Context, user *entities.User, digest *Digest) error
+}
+
+// Digest はダイジェストメールの内容
+type Digest struct {
+	UserID         entities.UserID          `json:"user_id"`
+	Email          string                   `json:"email"`
+	Frequency      entities.DigestFrequency `json:"frequency"`
+	GeneratedAt    time.Time                `json:"generated_at"`
+	PreviousSentAt *time.Time               `json:"previous_sent_at,omitempty"` // 前回の配信日時（初回は nil）
+	HealthScore    DigestHealthScore        `json:"health_score"`
+	// UpcomingGoals は期限まで DigestUpcomingGoalDays 日以内の未達成の目標（期限の近い順）
+	UpcomingGoals []DigestUpcomingGoal `json:"upcoming_goals"`
+	// GoalProgress は前回の配信から積立額が増えた目標（初回は前回との比較ができないため空）
+	GoalProgress []DigestGoalProgress `json:"goal_progress"`
+	// NewWarnings は前回の配信時にはなかった警告（初回はすべての警告）
+	NewWarnings []string `json:"new_warnings"`
+	// goalAmounts は配信記録に保存する目標ごとの現在の積立額
+	goalAmounts map[entities.GoalID]float64
+	// warnings は配信記録に保存する現在の警告すべて
+	warnings []string
+}
+
+// DigestHealthScore はダイジェストメールに載せる財務健全性スコア
+type DigestHealthScore struct {
+	Score  int    `json:"score"`            // 0-100
+	Level  string `json:"level"`            // "excellent", "good", "fair", "poor"
+	Change *int   `json:"change,omitempty"` // 前回の配信時からの増減（初回は nil）
+}
+
+// DigestUpcomingGoal は期限が近い目標
+type DigestUpcomingGoal struct {
+	GoalID          entities.GoalID `json:"goal_id"`
+	Title           string          `json:"title"`
+	TargetDate      time.Time       `json:"target_date"`
+	DaysRemaining   int             `json:"days_remaining"`
+	ProgressPercent float64         `json:"progress_percent"`
+	RemainingAmount float64         `json:"remaining_amount"` // 目標金額までの残り（円）
+}
+
+// DigestGoalProgress は前回の配信からの目標の進捗
+type DigestGoalProgress struct {
+	GoalID          entities.GoalID `json:"goal_id"`
+	Title           string          `json:"title"`
+	CurrentAmount   float64         `json:"current_amount"`
+	TargetAmount    float64         `json:"target_amount"`
+	ProgressPercent float64         `json:"progress_percent"`
+	AmountIncreased float64         `json:"amount_increased"` // 前回の配信からの積立額の増加（円）
+}
+
+// digestUseCaseImpl はDigestUseCaseの実装
+type digestUseCaseImpl struct {
+	userRepo       repositories.UserRepository
+	deliveryRepo   repositories.DigestDeliveryRepository
+	reportsUseCase GenerateReportsUseCase
+	now            func() time.Time
+}
+
+// NewDigestUseCase は新しいDigestUseCaseを作成する
+func NewDigestUseCase(
+	userRepo repositories.UserRepository,
+	deliveryRepo repositories.DigestDeliveryRepository,
+	reportsUseCase GenerateReportsUseCase,
+) DigestUseCase {
+	return &digestUseCaseImpl{
+		userRepo:       userRepo,
+		deliveryRepo:   deliveryRepo,
+		reportsUseCase: reportsUseCase,
+		now:            time.Now,
+	}
+}
+
+// FindDueUsers は配信設定と前回の配信日時から、現在ダイジェストメールを配信すべきユーザーを取得する
+func (uc *digestUseCaseImpl) FindDueUsers(ctx context.Context) ([]*entities.User, error) {
+	subscribers, err := uc.userRepo.FindDigestSubscribers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ダイジェストメールの配信対象の取得に失敗しました: %w", err)
+	}
+
+	now := uc.now()
+	dueUsers := make([]*entities.User, 0, len(subscribers))
+	for _, user := range subscribers {
+		if user.IsDigestDue(now) {
+			dueUsers = append(dueUsers, user)
+		}
+	}
+	return dueUsers, nil
+}
+
+// BuildDigest は1人のユーザーのダイジェストメールの内容を、財務サマリー・目標進捗レポートから組み立てる
+// 前回の配信記録がある場合は、配信時点のスコア・警告・積立額と比較して変化を求める
+func (uc *digestUseCaseImpl) BuildDigest(ctx context.Context, user *entities.User) (*Digest, error) {
+	previous, err := uc.deliveryRepo.FindLatestByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, fmt.Errorf("前回の配信記録の取得に失敗しました: %w", err)
+	}
+
+	summary, err := uc.reportsUseCase.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: user.ID()})
+	if err != nil {
+		return nil, fmt.Errorf("財務サマリーの生成に失敗しました: %w", err)
+	}
+	goalsReport, err := uc.reportsUseCase.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{UserID: user.ID()})
+	if err != nil {
+		return nil, fmt.Errorf("目標進捗レポートの生成に失敗しました: %w", err)
+	}
+
+	now := uc.now()
+	digest := &Digest{
+		UserID:      user.ID(),
+		Email:       user.Email().String(),
+		Frequency:   user.DigestPreference().Frequency(),
+		GeneratedAt: now,
+		HealthScore: DigestHealthScore{
+			Score: summary.Report.FinancialHealth.OverallScore,
+			Level: summary.Report.FinancialHealth.ScoreLevel,
+		},
+		UpcomingGoals: []DigestUpcomingGoal{},
+		GoalProgress:  []DigestGoalProgress{},
+		NewWarnings:   []string{},
+		goalAmounts:   make(map[entities.GoalID]float64),
+		warnings:      append([]string{}, summary.Report.Warnings...),
+	}
+	if previous != nil {
+		sentAt := previous.SentAt()
+		digest.PreviousSentAt = &sentAt
+		change := digest.HealthScore.Score - previous.HealthScore()
+		digest.HealthScore.Change = &change
+	}
+
+	for _, warning := range summary.Report.Warnings {
+		if previous == nil || !previous.HasWarning(warning) {
+			digest.NewWarnings = append(digest.NewWarnings, warning)
+		}
+	}
+
+	upcomingUntil := now.AddDate(0, 0, DigestUpcomingGoalDays)
+	for _, goalProgress := range goalsReport.Report.Goals {
+		goal := goalProgress.Goal
+		if !goal.IsActive() {
+			continue
+		}
+		currentAmount := goal.CurrentAmount().Amount()
+		targetAmount := goal.TargetAmount().Amount()
+		progressPercent := goalProgress.Progress.AsPercentage()
+		digest.goalAmounts[goal.ID()] = currentAmount
+
+		if !goal.IsCompleted() && !goal.TargetDate().Before(now) && !goal.TargetDate().After(upcomingUntil) {
+			digest.UpcomingGoals = append(digest.UpcomingGoals, DigestUpcomingGoal{
+				GoalID:          goal.ID(),
+				Title:           goal.Title(),
+				TargetDate:      goal.TargetDate(),
+				DaysRemaining:   int(goal.TargetDate().Sub(now).Hours() / 24),
+				ProgressPercent: progressPercent,
+				RemainingAmount: max(targetAmount-currentAmount, 0),
+			})
+		}
+
+		if previous == nil {
+			continue
+		}
+		previousAmount, ok := previous.GoalAmount(goal.ID())
+		if ok && currentAmount > previousAmount {
+			digest.GoalProgress = append(digest.GoalProgress, DigestGoalProgress{
+				GoalID:          goal.ID(),
+				Title:           goal.Title(),
+				CurrentAmount:   currentAmount,
+				TargetAmount:    targetAmount,
+				ProgressPercent: progressPercent,
+				AmountIncreased: currentAmount - previousAmount,
+			})
+		}
+	}
+	sort.SliceStable(digest.UpcomingGoals, func(i, j int) bool {
+		return digest.UpcomingGoals[i].TargetDate.Before(digest.UpcomingGoals[j].TargetDate)
+	})
+
+	return digest, nil
+}
+
+// RecordDigestSent はダイジェストメールを配信したことを記録する（次回の配信対象の判定と比較の基準に使う）
+// 配信記録を保存してから前回の配信日時を更新するため、更新に失敗しても次回の比較は今回の配信を基準にする
+func (uc *digestUseCaseImpl) RecordDigestSent(ctx context.Context, user *entities.User, digest *Digest) error {
+	sentAt := uc.now()
+	delivery, err := entities.NewDigestDelivery(
+		user.ID(),
+		digest.Frequency,
+		digest.HealthScore.Score,
+		digest.warnings,
+		digest.goalAmounts,
+		sentAt,
+	)
+	if err != nil {
+		return fmt.Errorf("配信記録の作成に失敗しました: %w", err)
+	}
+	if err := uc.deliveryRepo.Save(ctx, delivery); err != nil {
+		return fmt.Errorf("配信記録の保存に失敗しました: %w", err)
+	}
+
+	user.MarkDigestSent(sentAt)
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return fmt.Errorf("最終配信日時の更新に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestDigestUser はダイジェストメールの配信設定と前回の配信日時を持つテスト用ユーザーを作成するヘルパー
+func newTestDigestUser(t *testing.T, frequency entities.DigestFrequency, deliveryHour int, lastSentAt *time.Time) *entities.User {
+	t.Helper()
+	id := uuid.New().String()
+	user, err := entities.NewUser(id, id+"@example.com", "Password123!")
+	require.NoError(t, err)
+	preference, err := entities.NewDigestPreference(frequency, deliveryHour)
+	require.NoError(t, err)
+	user.RestoreDigest(preference, lastSentAt)
+	return user
+}
+
+// newTestDigestUseCase は現在日時を now に固定したDigestUseCaseを作成するヘルパー
+func newTestDigestUseCase(
+	userRepo *MockUserRepository,
+	deliveryRepo *MockDigestDeliveryRepository,
+	planRepo *MockFinancialPlanRepository,
+	now time.Time,
+) *digestUseCaseImpl {
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+	reportsUseCase := NewGenerateReportsUseCase(planRepo, new(MockGoalRepository), calcService, recService)
+	uc := NewDigestUseCase(userRepo, deliveryRepo, reportsUseCase).(*digestUseCaseImpl)
+	uc.now = func() time.Time { return now }
+	return uc
+}
+
+func TestDigestUseCase_FindDueUsers(t *testing.T) {
+	ctx := context.Background()
+	// 2026-06-08 は月曜日
+	now := time.Date(2026, 6, 8, 9, 30, 0, 0, time.UTC)
+	at := func(day, hour int) *time.Time {
+		sentAt := time.Date(2026, 6, day, hour, 5, 0, 0, time.UTC)
+		return &sentAt
+	}
+
+	t.Run("正常系: 配信時刻を過ぎていて今回の配信期間に未配信のユーザーだけを選ぶ", func(t *testing.T) {
+		neverSent := newTestDigestUser(t, entities.DigestFrequencyDaily, 9, nil)
+		dailySentYesterday := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, at(7, 8))
+		dailySentToday := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, at(8, 8))
+		dailyBeforeDeliveryHour := newTestDigestUser(t, entities.DigestFrequencyDaily, 10, at(7, 10))
+		weeklySentLastWeek := newTestDigestUser(t, entities.DigestFrequencyWeekly, 8, at(1, 8))
+		weeklySentThreeDaysAgo := newTestDigestUser(t, entities.DigestFrequencyWeekly, 8, at(5, 8))
+
+		userRepo := new(MockUserRepository)
+		userRepo.On("FindDigestSubscribers", mock_anything()).Return([]*entities.User{
+			neverSent, dailySentYesterday, dailySentToday, dailyBeforeDeliveryHour, weeklySentLastWeek, weeklySentThreeDaysAgo,
+		}, nil)
+		uc := newTestDigestUseCase(userRepo, new(MockDigestDeliveryRepository), new(MockFinancialPlanRepository), now)
+
+		users, err := uc.FindDueUsers(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, []*entities.User{neverSent, dailySentYesterday, weeklySentLastWeek}, users)
+	})
+
+	t.Run("正常系: 時計が進むと前回配信したユーザーも次の配信期間で再び選ばれる", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyWeekly, 8, at(8, 8))
+		userRepo := new(MockUserRepository)
+		userRepo.On("FindDigestSubscribers", mock_anything()).Return([]*entities.User{user}, nil)
+		uc := newTestDigestUseCase(userRepo, new(MockDigestDeliveryRepository), new(MockFinancialPlanRepository), now)
+
+		for _, tc := range []struct {
+			now  time.Time
+			want int
+		}{
+			{now: now.AddDate(0, 0, 6), want: 0},
+			{now: now.AddDate(0, 0, 7).Add(-2 * time.Hour), want: 0}, // 7日後の配信時刻前
+			{now: now.AddDate(0, 0, 7), want: 1},
+		} {
+			uc.now = func() time.Time { return tc.now }
+
+			users, err := uc.FindDueUsers(ctx)
+
+			require.NoError(t, err)
+			assert.Len(t, users, tc.want, tc.now)
+		}
+	})
+
+	t.Run("異常系: 配信対象の取得に失敗した場合はエラーを返す", func(t *testing.T) {
+		userRepo := new(MockUserRepository)
+		userRepo.On("FindDigestSubscribers", mock_anything()).Return(nil, errors.New("db error"))
+		uc := newTestDigestUseCase(userRepo, new(MockDigestDeliveryRepository), new(MockFinancialPlanRepository), now)
+
+		_, err := uc.FindDueUsers(ctx)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "配信対象の取得に失敗しました")
+	})
+}
+
+func TestDigestUseCase_BuildDigest(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	// newDigestPlan は期限が10日後と1年後の目標を持つ財務計画を作成する
+	newDigestPlan := func(t *testing.T, userID entities.UserID) (*MockFinancialPlanRepository, *entities.Goal, *entities.Goal) {
+		plan := newTestFinancialPlan(userID)
+		soon, err := entities.NewGoal(userID, entities.GoalTypeSavings, "夏の旅行", mustNewMoney(300000), now.AddDate(0, 0, 10), mustNewMoney(30000))
+		require.NoError(t, err)
+		require.NoError(t, soon.UpdateCurrentAmount(mustNewMoney(240000)))
+		later, err := entities.NewGoal(userID, entities.GoalTypeSavings, "新車購入", mustNewMoney(2000000), now.AddDate(1, 0, 0), mustNewMoney(50000))
+		require.NoError(t, err)
+		require.NoError(t, later.UpdateCurrentAmount(mustNewMoney(500000)))
+		// 期限が近い目標は達成可能性の判定で追加できないため、リポジトリからの読み込みと同じく復元する
+		plan.RestoreGoals([]*entities.Goal{soon, later})
+
+		planRepo := new(MockFinancialPlanRepository)
+		planRepo.On("FindByUserID", mock_anything(), userID).Return(plan, nil)
+		return planRepo, soon, later
+	}
+
+	t.Run("正常系: 初回は期限が30日以内の目標とすべての警告を載せ、前回との比較は含めない", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyWeekly, 8, nil)
+		planRepo, soon, _ := newDigestPlan(t, user.ID())
+		deliveryRepo := new(MockDigestDeliveryRepository)
+		deliveryRepo.On("FindLatestByUserID", mock_anything(), user.ID()).Return(nil, nil)
+		uc := newTestDigestUseCase(new(MockUserRepository), deliveryRepo, planRepo, now)
+
+		digest, err := uc.BuildDigest(ctx, user)
+
+		require.NoError(t, err)
+		assert.Equal(t, user.Email().String(), digest.Email)
+		assert.Equal(t, entities.DigestFrequencyWeekly, digest.Frequency)
+		assert.Nil(t, digest.PreviousSentAt)
+		assert.Nil(t, digest.HealthScore.Change)
+		require.Len(t, digest.UpcomingGoals, 1)
+		assert.Equal(t, soon.ID(), digest.UpcomingGoals[0].GoalID)
+		assert.InDelta(t, 60000, digest.UpcomingGoals[0].RemainingAmount, 0.01)
+		assert.InDelta(t, 80, digest.UpcomingGoals[0].ProgressPercent, 0.01)
+		assert.Empty(t, digest.GoalProgress)
+		assert.Equal(t, digest.warnings, digest.NewWarnings)
+	})
+
+	t.Run("正常系: 前回の配信記録と比べてスコアの増減・積立額の増加・新しい警告を求める", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, nil)
+		planRepo, soon, later := newDigestPlan(t, user.ID())
+		previousSentAt := now.AddDate(0, 0, -1)
+		previous, err := entities.NewDigestDelivery(user.ID(), entities.DigestFrequencyDaily, 100, []string{"前回だけの警告"},
+			map[entities.GoalID]float64{soon.ID(): 200000, later.ID(): 500000}, previousSentAt)
+		require.NoError(t, err)
+		deliveryRepo := new(MockDigestDeliveryRepository)
+		deliveryRepo.On("FindLatestByUserID", mock_anything(), user.ID()).Return(previous, nil)
+		uc := newTestDigestUseCase(new(MockUserRepository), deliveryRepo, planRepo, now)
+
+		digest, err := uc.BuildDigest(ctx, user)
+
+		require.NoError(t, err)
+		require.NotNil(t, digest.PreviousSentAt)
+		assert.True(t, digest.PreviousSentAt.Equal(previousSentAt))
+		require.NotNil(t, digest.HealthScore.Change)
+		assert.Equal(t, digest.HealthScore.Score-100, *digest.HealthScore.Change)
+		// 積立額が変わっていない目標は載せない
+		require.Len(t, digest.GoalProgress, 1)
+		assert.Equal(t, soon.ID(), digest.GoalProgress[0].GoalID)
+		assert.InDelta(t, 40000, digest.GoalProgress[0].AmountIncreased, 0.01)
+		assert.NotContains(t, digest.NewWarnings, "前回だけの警告")
+	})
+
+	t.Run("正常系: 前回の配信時にもあった警告は新しい警告に含めない", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, nil)
+		planRepo, _, _ := newDigestPlan(t, user.ID())
+		deliveryRepo := new(MockDigestDeliveryRepository)
+		deliveryRepo.On("FindLatestByUserID", mock_anything(), user.ID()).Return(nil, nil).Once()
+		uc := newTestDigestUseCase(new(MockUserRepository), deliveryRepo, planRepo, now)
+		first, err := uc.BuildDigest(ctx, user)
+		require.NoError(t, err)
+		require.NotEmpty(t, first.warnings, "テスト用の財務計画には警告が含まれる前提")
+
+		previous, err := entities.NewDigestDelivery(user.ID(), entities.DigestFrequencyDaily, first.HealthScore.Score,
+			first.warnings, first.goalAmounts, now.AddDate(0, 0, -1))
+		require.NoError(t, err)
+		deliveryRepo.On("FindLatestByUserID", mock_anything(), user.ID()).Return(previous, nil)
+
+		digest, err := uc.BuildDigest(ctx, user)
+
+		require.NoError(t, err)
+		assert.Empty(t, digest.NewWarnings)
+		assert.Empty(t, digest.GoalProgress)
+		assert.Equal(t, 0, *digest.HealthScore.Change)
+	})
+
+	t.Run("異常系: 財務計画がない場合はエラーを返す", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, nil)
+		planRepo := new(MockFinancialPlanRepository)
+		planRepo.On("FindByUserID", mock_anything(), user.ID()).Return(nil, errors.New("not found"))
+		deliveryRepo := new(MockDigestDeliveryRepository)
+		deliveryRepo.On("FindLatestByUserID", mock_anything(), user.ID()).Return(nil, nil)
+		uc := newTestDigestUseCase(new(MockUserRepository), deliveryRepo, planRepo, now)
+
+		_, err := uc.BuildDigest(ctx, user)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務サマリーの生成に失敗しました")
+	})
+}
+
+func TestDigestUseCase_RecordDigestSent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 6, 8, 9, 30, 0, 0, time.UTC)
+	digest := &Digest{
+		Frequency:   entities.DigestFrequencyDaily,
+		HealthScore: DigestHealthScore{Score: 72, Level: "good"},
+		warnings:    []string{"警告A"},
+		goalAmounts: map[entities.GoalID]float64{"goal-001": 240000},
+	}
+
+	t.Run("正常系: 配信時点の内容を記録し、最終配信日時を更新する", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, nil)
+		userRepo := new(MockUserRepository)
+		userRepo.On("Update", mock_anything(), user).Return(nil)
+		deliveryRepo := new(MockDigestDeliveryRepository)
+		deliveryRepo.On("Save", mock_anything(), mock.MatchedBy(func(d *entities.DigestDelivery) bool {
+			amount, _ := d.GoalAmount("goal-001")
+			return d.UserID() == user.ID() && d.HealthScore() == 72 && d.HasWarning("警告A") && amount == 240000 && d.SentAt().Equal(now)
+		})).Return(nil)
+		uc := newTestDigestUseCase(userRepo, deliveryRepo, new(MockFinancialPlanRepository), now)
+
+		err := uc.RecordDigestSent(ctx, user, digest)
+
+		require.NoError(t, err)
+		require.NotNil(t, user.DigestLastSentAt())
+		assert.True(t, user.DigestLastSentAt().Equal(now))
+		assert.False(t, user.IsDigestDue(now.Add(time.Hour)))
+		deliveryRepo.AssertExpectations(t)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 配信記録の保存に失敗した場合は最終配信日時を更新しない", func(t *testing.T) {
+		user := newTestDigestUser(t, entities.DigestFrequencyDaily, 8, nil)
+		userRepo := new(MockUserRepository)
+		deliveryRepo := new(MockDigestDeliveryRepository)
+		deliveryRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
+		uc := newTestDigestUseCase(userRepo, deliveryRepo, new(MockFinancialPlanRepository), now)
+
+		err := uc.RecordDigestSent(ctx, user, digest)
+
+		require.Error(t, err)
+		assert.Nil(t, user.DigestLastSentAt())
+		userRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
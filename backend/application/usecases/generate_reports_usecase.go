@@ -4,16 +4,33 @@ import (
 	"bytes"
 	"context"
 	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
 	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 )
 
+// ErrRetirementDataNotSet は退職データが設定されていないため退職計画レポートを生成できない場合のエラー
+var ErrRetirementDataNotSet = errors.New("退職データが設定されていません")
+
+// ErrRequesterNotHouseholdMember はリクエストしたユーザーが世帯のメンバーに含まれていない場合のエラー
+var ErrRequesterNotHouseholdMember = errors.New("世帯のメンバーにリクエストしたユーザーが含まれていません")
+
+// maxHouseholdMembers は世帯の財務サマリーに含められるメンバーの最大数
+const maxHouseholdMembers = 10
+
 // GenerateReportsUseCase はレポート生成のユースケース
 type GenerateReportsUseCase interface {
 	// GenerateFinancialSummaryReport は財務サマリーレポートを生成する
@@ -33,11 +50,19 @@ type GenerateReportsUseCase interface {
 
 	// ExportReportToPDF はレポートをPDF形式でエクスポートする
 	ExportReportToPDF(ctx context.Context, input ExportReportInput) (*ExportReportOutput, error)
+
+	// GetHealthScoreTrend は財務健全性スコアの月ごとの推移と悪化傾向の警告を返す
+	GetHealthScoreTrend(ctx context.Context, input HealthScoreTrendInput) (*HealthScoreTrendOutput, error)
+
+	// GetHouseholdFinancialSummary は世帯のメンバーの財務状況を合算した財務健全性を返す
+	GetHouseholdFinancialSummary(ctx context.Context, input GetHouseholdSummaryInput) (*HouseholdSummaryOutput, error)
 }
 
 // FinancialSummaryReportInput は財務サマリーレポート生成の入力
 type FinancialSummaryReportInput struct {
 	UserID entities.UserID `json:"user_id"`
+	// ForceRecalculate が true の場合、保存済みの計算結果を使わずに財務健全性を再計算する
+	ForceRecalculate bool `json:"force_recalculate"`
 }
 
 // FinancialSummaryReportOutput は財務サマリーレポート生成の出力
@@ -48,13 +73,19 @@ type FinancialSummaryReportOutput struct {
 
 // FinancialSummaryReport は財務サマリーレポート
 type FinancialSummaryReport struct {
-	UserID           entities.UserID  `json:"user_id"`
-	ReportDate       string           `json:"report_date"`
-	FinancialHealth  FinancialHealth  `json:"financial_health"`
-	CurrentSituation CurrentSituation `json:"current_situation"`
-	KeyMetrics       []KeyMetric      `json:"key_metrics"`
-	Recommendations  []string         `json:"recommendations"`
-	Warnings         []string         `json:"warnings"`
+	UserID              entities.UserID                     `json:"user_id"`
+	ReportDate          string                              `json:"report_date"`
+	FinancialHealth     FinancialHealth                     `json:"financial_health"`
+	CurrentSituation    CurrentSituation                    `json:"current_situation"`
+	KeyMetrics          []KeyMetric                         `json:"key_metrics"`
+	Recommendations     []string                            `json:"recommendations"`
+	Warnings            []string                            `json:"warnings"`
+	RecommendationItems []entities.Recommendation           `json:"recommendation_items"` // ID付きの推奨事項と警告（却下APIで使用する）
+	SavingsRateTarget   *entities.SavingsRateTargetProgress `json:"savings_rate_target,omitempty"`
+	AssetAllocation     []AssetAllocation                   `json:"asset_allocation"`      // 現在の貯蓄の種類別の内訳（貯蓄がない場合は空）
+	Disclaimers         []string                            `json:"disclaimers,omitempty"` // 高い想定値に基づく計画であることの注意書き
+	Assumptions         Assumptions                         `json:"assumptions"`
+	DataStaleness       aggregates.DataStaleness            `json:"data_staleness"` // 財務データを最後に更新・確認してからの経過と鮮度
 }
 
 // FinancialHealth は財務健全性
@@ -66,14 +97,123 @@ type FinancialHealth struct {
 	EmergencyFundRatio float64 `json:"emergency_fund_ratio"` // months
 }
 
+// MarshalJSON は割合・月数を小数点以下2桁に丸めてシリアライズする
+func (h FinancialHealth) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		OverallScore       int         `json:"overall_score"`
+		ScoreLevel         string      `json:"score_level"`
+		SavingsRate        percentJSON `json:"savings_rate"`
+		DebtToIncomeRatio  percentJSON `json:"debt_to_income_ratio"`
+		EmergencyFundRatio percentJSON `json:"emergency_fund_ratio"`
+	}{
+		OverallScore:       h.OverallScore,
+		ScoreLevel:         h.ScoreLevel,
+		SavingsRate:        percentJSON(h.SavingsRate),
+		DebtToIncomeRatio:  percentJSON(h.DebtToIncomeRatio),
+		EmergencyFundRatio: percentJSON(h.EmergencyFundRatio),
+	})
+}
+
+// AssetAllocation は貯蓄の種類（預金・投資・その他）ごとの金額と総資産に占める割合
+type AssetAllocation struct {
+	Type       string  `json:"type"`  // deposit, investment, other
+	Label      string  `json:"label"` // 表示名（預金・投資・その他）
+	Amount     float64 `json:"amount"`
+	Percentage float64 `json:"percentage"` // 総資産に占める割合（%）
+}
+
+// MarshalJSON は金額を円単位の整数、割合を小数点以下2桁に丸めてシリアライズする
+func (a AssetAllocation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type       string      `json:"type"`
+		Label      string      `json:"label"`
+		Amount     yenJSON     `json:"amount"`
+		Percentage percentJSON `json:"percentage"`
+	}{
+		Type:       a.Type,
+		Label:      a.Label,
+		Amount:     yenJSON(a.Amount),
+		Percentage: percentJSON(a.Percentage),
+	})
+}
+
 // CurrentSituation は現在の状況
 type CurrentSituation struct {
 	MonthlyIncome    float64 `json:"monthly_income"`
+	IncomeType       string  `json:"income_type"`        // 月収の入力区分（gross: 額面, net: 手取り）
+	NetMonthlyIncome float64 `json:"net_monthly_income"` // 純貯蓄額の計算に使う手取り月収
 	MonthlyExpenses  float64 `json:"monthly_expenses"`
 	NetSavings       float64 `json:"net_savings"`
 	TotalAssets      float64 `json:"total_assets"`
 	InvestmentReturn float64 `json:"investment_return"`
 	InflationRate    float64 `json:"inflation_rate"`
+	// 負債（未登録の場合は0、純資産は総資産と同じ）
+	TotalLiabilities    float64 `json:"total_liabilities"`
+	NetWorth            float64 `json:"net_worth"`             // 総資産 - 負債残高
+	MonthlyDebtPayments float64 `json:"monthly_debt_payments"` // 支出項目に計上されていない月々の返済額
+}
+
+// MarshalJSON は金額を円単位の整数、利率を小数点以下2桁に丸めてシリアライズする
+func (s CurrentSituation) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		MonthlyIncome       yenJSON     `json:"monthly_income"`
+		IncomeType          string      `json:"income_type"`
+		NetMonthlyIncome    yenJSON     `json:"net_monthly_income"`
+		MonthlyExpenses     yenJSON     `json:"monthly_expenses"`
+		NetSavings          yenJSON     `json:"net_savings"`
+		TotalAssets         yenJSON     `json:"total_assets"`
+		InvestmentReturn    percentJSON `json:"investment_return"`
+		InflationRate       percentJSON `json:"inflation_rate"`
+		TotalLiabilities    yenJSON     `json:"total_liabilities"`
+		NetWorth            yenJSON     `json:"net_worth"`
+		MonthlyDebtPayments yenJSON     `json:"monthly_debt_payments"`
+	}{
+		MonthlyIncome:       yenJSON(s.MonthlyIncome),
+		IncomeType:          s.IncomeType,
+		NetMonthlyIncome:    yenJSON(s.NetMonthlyIncome),
+		MonthlyExpenses:     yenJSON(s.MonthlyExpenses),
+		NetSavings:          yenJSON(s.NetSavings),
+		TotalAssets:         yenJSON(s.TotalAssets),
+		InvestmentReturn:    percentJSON(s.InvestmentReturn),
+		InflationRate:       percentJSON(s.InflationRate),
+		TotalLiabilities:    yenJSON(s.TotalLiabilities),
+		NetWorth:            yenJSON(s.NetWorth),
+		MonthlyDebtPayments: yenJSON(s.MonthlyDebtPayments),
+	})
+}
+
+// GetHouseholdSummaryInput は世帯の財務サマリー取得の入力
+type GetHouseholdSummaryInput struct {
+	RequestingUserID entities.UserID   `json:"requesting_user_id"`
+	MemberUserIDs    []entities.UserID `json:"member_user_ids"` // 世帯のメンバー（リクエストしたユーザーを含むこと）
+}
+
+// HouseholdSummaryOutput は世帯の財務サマリーの出力
+type HouseholdSummaryOutput struct {
+	MemberUserIDs    []entities.UserID        `json:"member_user_ids"`
+	FinancialHealth  FinancialHealth          `json:"financial_health"`  // 世帯の合計から計算した財務健全性
+	CurrentSituation HouseholdSituation       `json:"current_situation"` // 世帯の合計
+	Members          []HouseholdMemberSummary `json:"members"`           // メンバーごとの内訳
+	GeneratedAt      string                   `json:"generated_at"`
+}
+
+// HouseholdSituation は世帯の現在の状況（メンバーの合計）
+type HouseholdSituation struct {
+	NetMonthlyIncome    float64 `json:"net_monthly_income"`
+	MonthlyExpenses     float64 `json:"monthly_expenses"`
+	NetSavings          float64 `json:"net_savings"`
+	TotalAssets         float64 `json:"total_assets"`
+	TotalLiabilities    float64 `json:"total_liabilities"`
+	NetWorth            float64 `json:"net_worth"`
+	MonthlyDebtPayments float64 `json:"monthly_debt_payments"`
+	InvestmentReturn    float64 `json:"investment_return"` // 総資産で加重平均した想定利回り（%）
+}
+
+// HouseholdMemberSummary はメンバーごとの財務健全性と現在の状況
+type HouseholdMemberSummary struct {
+	UserID           entities.UserID  `json:"user_id"`
+	FinancialHealth  FinancialHealth  `json:"financial_health"`
+	CurrentSituation CurrentSituation `json:"current_situation"`
 }
 
 // KeyMetric は主要指標
@@ -82,7 +222,56 @@ type KeyMetric struct {
 	Value       float64 `json:"value"`
 	Unit        string  `json:"unit"`
 	Description string  `json:"description"`
-	Trend       string  `json:"trend"` // "up", "down", "stable"
+	Trend       string  `json:"trend"` // "up", "down", "stable"（前月までの記録がない場合は "stable"）
+}
+
+// 財務健全性スコアの推移の取得期間（月数）
+const (
+	defaultHealthScoreTrendMonths = 6
+	maxHealthScoreTrendMonths     = 60
+)
+
+// healthScoreDeclineWarningMonths は悪化傾向の警告を出すスコアの連続低下回数（月単位）
+const healthScoreDeclineWarningMonths = 2
+
+// 財務健全性スコアが低下した主因
+const (
+	HealthScoreCauseSavingsRateDecline      = "savings_rate_decline"      // 貯蓄率の低下
+	HealthScoreCauseEmergencyFundDrawdown   = "emergency_fund_drawdown"   // 緊急資金の取り崩し
+	HealthScoreCauseInvestmentReturnDecline = "investment_return_decline" // 想定利回りの低下
+)
+
+// HealthScoreTrendInput は財務健全性スコアの推移取得の入力
+type HealthScoreTrendInput struct {
+	UserID entities.UserID `json:"user_id"`
+	Months int             `json:"months"` // 取得する期間（月数）。0以下の場合は6ヶ月
+}
+
+// HealthScoreTrendOutput は財務健全性スコアの推移取得の出力
+type HealthScoreTrendOutput struct {
+	UserID              entities.UserID     `json:"user_id"`
+	Months              int                 `json:"months"`
+	Points              []HealthScorePoint  `json:"points"`               // 月ごとの最後の記録（古い順）
+	Trend               string              `json:"trend"`                // 直近2ヶ月の比較（"up", "down", "stable"）
+	ConsecutiveDeclines int                 `json:"consecutive_declines"` // 直近でスコアが連続して低下した月数
+	Warning             *HealthScoreWarning `json:"warning,omitempty"`
+}
+
+// HealthScorePoint は月ごとの財務健全性スコア
+type HealthScorePoint struct {
+	Month              string  `json:"month"` // YYYY-MM
+	Score              int     `json:"score"`
+	SavingsRate        float64 `json:"savings_rate"`
+	EmergencyFundRatio float64 `json:"emergency_fund_ratio"`
+	InvestmentReturn   float64 `json:"investment_return"`
+	TotalAssets        float64 `json:"total_assets"`
+	RecordedAt         string  `json:"recorded_at"`
+}
+
+// HealthScoreWarning は財務健全性スコアの悪化傾向の警告
+type HealthScoreWarning struct {
+	Message string `json:"message"`
+	Cause   string `json:"cause"` // 主因（HealthScoreCause*）
 }
 
 // AssetProjectionReportInput は資産推移レポート生成の入力
@@ -105,6 +294,32 @@ type AssetProjectionReport struct {
 	Summary         ProjectionSummary          `json:"summary"`
 	Scenarios       []ScenarioAnalysis         `json:"scenarios"`
 	Insights        []string                   `json:"insights"`
+	Disclaimers     []string                   `json:"disclaimers,omitempty"`       // 高い想定値に基づく計画であることの注意書き
+	Assumptions     Assumptions                `json:"assumptions"`                 // シナリオ分析以外の推移の前提
+	LifeEventImpact *LifeEventImpact           `json:"life_event_impact,omitempty"` // ライフイベント未登録の場合は省略
+}
+
+// LifeEventImpact はライフイベントによる最終年の資産への影響
+type LifeEventImpact struct {
+	EventCount               int     `json:"event_count"`
+	FinalAssets              float64 `json:"final_assets"`                // ライフイベントを考慮した最終年の資産（Projections と同じ）
+	FinalAssetsWithoutEvents float64 `json:"final_assets_without_events"` // ライフイベントを考慮しない場合の最終年の資産
+	Difference               float64 `json:"difference"`                  // FinalAssets - FinalAssetsWithoutEvents（負の場合はライフイベントで資産が減る）
+}
+
+// MarshalJSON は金額を円単位の整数に丸めてシリアライズする
+func (i LifeEventImpact) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		EventCount               int     `json:"event_count"`
+		FinalAssets              yenJSON `json:"final_assets"`
+		FinalAssetsWithoutEvents yenJSON `json:"final_assets_without_events"`
+		Difference               yenJSON `json:"difference"`
+	}{
+		EventCount:               i.EventCount,
+		FinalAssets:              yenJSON(i.FinalAssets),
+		FinalAssetsWithoutEvents: yenJSON(i.FinalAssetsWithoutEvents),
+		Difference:               yenJSON(i.Difference),
+	})
 }
 
 // ScenarioAnalysis はシナリオ分析
@@ -136,16 +351,20 @@ type GoalsProgressReport struct {
 	Summary      GoalsSummary    `json:"summary"`
 	Achievements []Achievement   `json:"achievements"`
 	NextSteps    []string        `json:"next_steps"`
+	Disclaimers  []string        `json:"disclaimers,omitempty"` // 高い想定値に基づく計画であることの注意書き
+	Assumptions  Assumptions     `json:"assumptions"`
 }
 
 // GoalProgress は目標進捗
+// 推奨事項を生成できなかった目標は Recommendations を空にし、RecommendationError に理由を示す
 type GoalProgress struct {
-	Goal            *entities.Goal        `json:"goal"`
-	Progress        entities.ProgressRate `json:"progress"`
-	Status          string                `json:"status"`
-	DaysRemaining   int                   `json:"days_remaining"`
-	OnTrack         bool                  `json:"on_track"`
-	Recommendations []string              `json:"recommendations"`
+	Goal                *entities.Goal        `json:"goal"`
+	Progress            entities.ProgressRate `json:"progress"`
+	Status              string                `json:"status"`
+	DaysRemaining       int                   `json:"days_remaining"`
+	OnTrack             bool                  `json:"on_track"`
+	Recommendations     []string              `json:"recommendations"`
+	RecommendationError string                `json:"recommendation_error,omitempty"`
 }
 
 // Achievement は達成事項
@@ -158,8 +377,10 @@ type Achievement struct {
 }
 
 // RetirementPlanReportInput は退職計画レポート生成の入力
+// LifestyleExpenses で生活水準ごとの月間生活費を指定した場合は、目安の代わりにその金額で生活水準を比較する
 type RetirementPlanReportInput struct {
-	UserID entities.UserID `json:"user_id"`
+	UserID            entities.UserID                          `json:"user_id"`
+	LifestyleExpenses map[entities.RetirementLifestyle]float64 `json:"lifestyle_expenses,omitempty"`
 }
 
 // RetirementPlanReportOutput は退職計画レポート生成の出力
@@ -173,10 +394,64 @@ type RetirementPlanReport struct {
 	UserID          entities.UserID                 `json:"user_id"`
 	RetirementData  *entities.RetirementData        `json:"retirement_data"`
 	Calculation     *entities.RetirementCalculation `json:"calculation"`
+	IncomeSources   RetirementIncomeSources         `json:"income_sources"` // 退職後の月間収支の内訳
+	AfterTax        RetirementAfterTax              `json:"after_tax"`      // 税・社会保険料を差し引いた手取りベースの計算
+	Lifestyles      []RetirementLifestyleComparison `json:"lifestyles"`     // 生活水準（ゆとり・標準・最低限）ごとの比較
 	Projections     []RetirementProjection          `json:"projections"`
 	Strategies      []RetirementStrategy            `json:"strategies"`
 	Recommendations []string                        `json:"recommendations"`
 	RiskAssessment  RiskAssessment                  `json:"risk_assessment"`
+	Disclaimers     []string                        `json:"disclaimers,omitempty"` // 高い想定値に基づく計画であることの注意書き
+	Assumptions     Assumptions                     `json:"assumptions"`           // 期間は退職までの年数
+}
+
+// RetirementIncomeSources は退職後の月間収支の内訳（いずれも現在価値の月額）
+type RetirementIncomeSources struct {
+	PensionAmount              float64 `json:"pension_amount"`                // 厚生年金などの年金額
+	KokuminNenkinMonthlyAmount float64 `json:"kokumin_nenkin_monthly_amount"` // 国民年金（老齢基礎年金）
+	TotalMonthlyBenefits       float64 `json:"total_monthly_benefits"`        // 公的給付の合計
+	MonthlyRetirementExpenses  float64 `json:"monthly_retirement_expenses"`   // 月間退職後支出
+	NurseCareInsuranceMonthly  float64 `json:"nurse_care_insurance_monthly"`  // 介護保険料
+	MonthlyShortfall           float64 `json:"monthly_shortfall"`             // 公的給付で賄えない月額（貯蓄から取り崩す額）
+}
+
+// RetirementAfterTax は退職後の税・社会保険料を差し引いた手取りベースの老後資金の計算結果
+// Calculation（額面ベース）と並べて示し、手取りの年金と取り崩しで生活費を賄えるかを判定する
+// 年金からは健康保険料・所得税・住民税を、資産の取り崩し額からは運用益にかかる税を差し引く
+type RetirementAfterTax struct {
+	GrossMonthlyBenefits float64                         `json:"gross_monthly_benefits"` // 公的給付の額面月額
+	NetMonthlyBenefits   float64                         `json:"net_monthly_benefits"`   // 公的給付の手取り月額
+	MonthlyShortfall     float64                         `json:"monthly_shortfall"`      // 手取りの公的給付で賄えない月額
+	GrossRequiredAmount  float64                         `json:"gross_required_amount"`  // 額面ベースの必要老後資金
+	RequiredAmount       float64                         `json:"required_amount"`        // 手取りベースの必要老後資金
+	ProjectedAmount      float64                         `json:"projected_amount"`
+	Shortfall            float64                         `json:"shortfall"`
+	SufficiencyRate      float64                         `json:"sufficiency_rate"`
+	CanCoverExpenses     bool                            `json:"can_cover_expenses"` // 手取りベースで退職後の生活費を賄えるか
+	TaxEstimate          *services.RetirementTaxEstimate `json:"tax_estimate"`       // 公的給付の年額に対する税・保険料の内訳
+	TaxRates             RetirementTaxRates              `json:"tax_rates"`
+}
+
+// RetirementTaxRates は手取りベースの計算に使った税率・保険料率の前提（いずれも%）
+type RetirementTaxRates struct {
+	TableVersion        string  `json:"table_version"`
+	HealthInsuranceRate float64 `json:"health_insurance_rate"` // 年金所得に対する健康保険料の概算割合
+	ResidentTaxRate     float64 `json:"resident_tax_rate"`
+	CapitalGainsTaxRate float64 `json:"capital_gains_tax_rate"` // 運用益にかかる税率
+	DrawdownTaxRate     float64 `json:"drawdown_tax_rate"`      // 取り崩し額に対する実効税率（運用益の割合 × 運用益の税率）
+}
+
+// RetirementLifestyleComparison は生活水準ごとの退職資金の比較
+type RetirementLifestyleComparison struct {
+	Lifestyle                entities.RetirementLifestyle `json:"lifestyle"`
+	Label                    string                       `json:"label"`
+	MonthlyExpenses          float64                      `json:"monthly_expenses"` // 想定した月間生活費
+	IsCustomized             bool                         `json:"is_customized"`    // ユーザーが月間生活費を上書きしたか
+	RequiredAmount           float64                      `json:"required_amount"`
+	ProjectedAmount          float64                      `json:"projected_amount"`
+	SufficiencyRate          float64                      `json:"sufficiency_rate"`
+	AdditionalMonthlySavings float64                      `json:"additional_monthly_savings"` // 現在の純貯蓄額に上乗せが必要な月額
+	Summary                  string                       `json:"summary"`
 }
 
 // RetirementProjection は退職予測
@@ -191,11 +466,13 @@ type RetirementProjection struct {
 
 // RetirementStrategy は退職戦略
 type RetirementStrategy struct {
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Impact      float64 `json:"impact"`
-	Effort      string  `json:"effort"` // "low", "medium", "high"
-	Timeline    string  `json:"timeline"`
+	Name               string  `json:"name"`
+	Description        string  `json:"description"`
+	Impact             float64 `json:"impact"` // 充足率の改善幅（ポイント）
+	Effort             string  `json:"effort"` // "low", "medium", "high"
+	Timeline           string  `json:"timeline"`
+	NewSufficiencyRate float64 `json:"new_sufficiency_rate"` // 戦略実施後の充足率（%）
+	ShortfallReduction float64 `json:"shortfall_reduction"`  // 戦略実施による不足額の減少額
 }
 
 // RiskAssessment はリスク評価
@@ -214,9 +491,11 @@ type RiskFactor struct {
 }
 
 // ComprehensiveReportInput は包括的レポート生成の入力
+// Sections が空の場合はすべてのセクションを生成する
 type ComprehensiveReportInput struct {
-	UserID entities.UserID `json:"user_id"`
-	Years  int             `json:"years"`
+	UserID   entities.UserID `json:"user_id"`
+	Years    int             `json:"years"`
+	Sections []ReportSection `json:"sections,omitempty"`
 }
 
 // ComprehensiveReportOutput は包括的レポート生成の出力
@@ -226,14 +505,115 @@ type ComprehensiveReportOutput struct {
 }
 
 // ComprehensiveReport は包括的レポート
+// 任意セクションの生成に失敗した場合はそのセクションを null とし、理由を SectionsFailed に含める
 type ComprehensiveReport struct {
 	UserID           entities.UserID        `json:"user_id"`
 	ExecutiveSummary ExecutiveSummary       `json:"executive_summary"`
 	FinancialSummary FinancialSummaryReport `json:"financial_summary"`
-	AssetProjection  AssetProjectionReport  `json:"asset_projection"`
-	GoalsProgress    GoalsProgressReport    `json:"goals_progress"`
-	RetirementPlan   *RetirementPlanReport  `json:"retirement_plan,omitempty"`
+	AssetProjection  *AssetProjectionReport `json:"asset_projection"`
+	GoalsProgress    *GoalsProgressReport   `json:"goals_progress"`
+	RetirementPlan   *RetirementPlanReport  `json:"retirement_plan"` // 退職データ未設定の場合は null（失敗としては扱わない）
 	ActionPlan       ActionPlan             `json:"action_plan"`
+	Disclaimers      []string               `json:"disclaimers,omitempty"`    // 高い想定値に基づく計画であることの注意書き
+	MarketContext    *MarketContextReport   `json:"market_context,omitempty"` // 市場概況（取得できない場合は省略）
+	SectionsFailed   []SectionError         `json:"sections_failed"`
+	Assumptions      Assumptions            `json:"assumptions"` // 全セクションに共通する計算前提（期間は資産推移の年数）
+
+	// sections はレスポンスに含めるセクション（nil の場合はすべて含める）
+	sections map[ReportSection]bool
+}
+
+// MarshalJSON はセクションが指定されている場合、指定されていないセクションのキーを省略して出力する
+func (r ComprehensiveReport) MarshalJSON() ([]byte, error) {
+	type report ComprehensiveReport
+	data, err := json.Marshal(report(r))
+	if err != nil || r.sections == nil {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for _, section := range ComprehensiveReportSections {
+		if !r.sections[section] {
+			delete(fields, string(section))
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// MarketContextReport は包括的レポートに添える国内市場の概況
+type MarketContextReport struct {
+	NikkeiReturn30Day float64 `json:"nikkei_return_30_day"`
+	JGBYield10Year    float64 `json:"jgb_yield_10_year"`
+	CPI               float64 `json:"cpi"`
+	FetchedAt         string  `json:"fetched_at"`
+	Summary           string  `json:"summary"`
+}
+
+// ReportSection は包括的レポートのセクション
+type ReportSection string
+
+const (
+	ReportSectionExecutiveSummary ReportSection = "executive_summary" // エグゼクティブサマリー
+	ReportSectionFinancialSummary ReportSection = "financial_summary" // 財務サマリー（必須）
+	ReportSectionAssetProjection  ReportSection = "asset_projection"  // 資産推移（任意）
+	ReportSectionGoalsProgress    ReportSection = "goals_progress"    // 目標進捗（任意）
+	ReportSectionRetirementPlan   ReportSection = "retirement_plan"   // 退職計画（任意）
+	ReportSectionActionPlan       ReportSection = "action_plan"       // アクションプラン
+)
+
+// ComprehensiveReportSections は包括的レポートで選択できるセクション（レスポンスのキーの順）
+var ComprehensiveReportSections = []ReportSection{
+	ReportSectionExecutiveSummary,
+	ReportSectionFinancialSummary,
+	ReportSectionAssetProjection,
+	ReportSectionGoalsProgress,
+	ReportSectionRetirementPlan,
+	ReportSectionActionPlan,
+}
+
+// ErrUnknownReportSection は包括的レポートに存在しないセクションが指定された場合のエラー
+var ErrUnknownReportSection = errors.New("不明なセクションが指定されました")
+
+// ParseReportSections はカンマ区切りのセクション名を解析する
+// 空文字列の場合は nil（すべてのセクション）を返す。不明なセクション名が含まれる場合は ErrUnknownReportSection を返す
+func ParseReportSections(fields string) ([]ReportSection, error) {
+	if strings.TrimSpace(fields) == "" {
+		return nil, nil
+	}
+
+	var sections []ReportSection
+	for _, name := range strings.Split(fields, ",") {
+		section := ReportSection(strings.TrimSpace(name))
+		if !section.isComprehensiveSection() {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownReportSection, name)
+		}
+		sections = append(sections, section)
+	}
+	return sections, nil
+}
+
+// isComprehensiveSection は包括的レポートで選択できるセクションかを返す
+func (s ReportSection) isComprehensiveSection() bool {
+	for _, section := range ComprehensiveReportSections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRequired は生成に失敗した場合に包括的レポート全体をエラーにする必須セクションかを返す
+func (s ReportSection) IsRequired() bool {
+	return s == ReportSectionFinancialSummary
+}
+
+// SectionError は生成に失敗したセクションとその理由
+type SectionError struct {
+	Section ReportSection `json:"section"`
+	Reason  string        `json:"reason"`
 }
 
 // ExecutiveSummary はエグゼクティブサマリー
@@ -243,6 +623,8 @@ type ExecutiveSummary struct {
 	CriticalActions      []string `json:"critical_actions"`
 	OpportunityAreas     []string `json:"opportunity_areas"`
 	FinancialHealthScore int      `json:"financial_health_score"`
+	// ConfidenceNote は財務データが見直されておらず結果の信頼性が下がっている場合の注記（fresh の場合は空）
+	ConfidenceNote string `json:"confidence_note,omitempty"`
 }
 
 // ActionPlan はアクションプラン
@@ -265,9 +647,22 @@ type ActionItem struct {
 // ExportReportInput はレポートエクスポートの入力
 type ExportReportInput struct {
 	UserID     entities.UserID `json:"user_id"`
-	ReportType string          `json:"report_type"` // "financial_summary", "comprehensive", etc.
-	Format     string          `json:"format"`      // "pdf", "excel", "csv"
-	ReportData interface{}     `json:"report_data"`
+	ReportType ReportType      `json:"report_type"`
+	Format     ExportFormat    `json:"format"`
+	// Years は資産推移・包括的レポートの予測年数（0 の場合は DefaultExportReportYears）
+	Years      int         `json:"years,omitempty"`
+	ReportData interface{} `json:"report_data"`
+}
+
+// DefaultExportReportYears はエクスポートするレポートの予測年数の既定値
+const DefaultExportReportYears = 10
+
+// years はレポートの予測年数を返す
+func (in ExportReportInput) years() int {
+	if in.Years <= 0 {
+		return DefaultExportReportYears
+	}
+	return in.Years
 }
 
 // ExportReportOutput はレポートエクスポートの出力
@@ -281,7 +676,7 @@ type ExportReportOutput struct {
 
 // ReportPDFGenerator はPDF生成のインターフェース
 type ReportPDFGenerator interface {
-	Generate(reportType string, reportData interface{}) ([]byte, error)
+	Generate(reportType ReportType, reportData interface{}) ([]byte, error)
 }
 
 // TemporaryFileStoragePort は一時ファイルストレージのインターフェース
@@ -298,6 +693,17 @@ type generateReportsUseCaseImpl struct {
 	recommendationService *services.GoalRecommendationService
 	pdfGenerator          ReportPDFGenerator
 	fileStorage           TemporaryFileStoragePort
+	savingsRateTargetRepo repositories.SavingsRateTargetRepository
+	guardrailService      *services.AssumptionGuardrailService
+	dismissalRepo         repositories.RecommendationDismissalRepository
+	taxService            *services.TaxEstimationService
+	marketContextProvider ports.MarketContextProvider
+	healthScoreRepo       repositories.HealthScoreSnapshotRepository
+	insightEngine         *services.ProjectionInsightEngine
+	snapshotRepo          repositories.CalculationSnapshotRepository
+	exportRegistry        *ReportExportRegistry
+	projectAssets         func(profile *entities.FinancialProfile, years int) ([]entities.AssetProjection, error)
+	suggestAdjustments    func(goal *entities.Goal, profile *entities.FinancialProfile) ([]services.GoalRecommendation, error)
 }
 
 // NewGenerateReportsUseCase は新しいGenerateReportsUseCaseを作成する
@@ -307,15 +713,27 @@ func NewGenerateReportsUseCase(
 	calculationService *services.FinancialCalculationService,
 	recommendationService *services.GoalRecommendationService,
 ) GenerateReportsUseCase {
-	return &generateReportsUseCaseImpl{
+	uc := &generateReportsUseCaseImpl{
 		financialPlanRepo:     financialPlanRepo,
 		goalRepo:              goalRepo,
 		calculationService:    calculationService,
 		recommendationService: recommendationService,
-	}
+		guardrailService:      services.NewDefaultAssumptionGuardrailService(),
+		taxService:            services.NewDefaultTaxEstimationService(),
+		insightEngine:         services.NewProjectionInsightEngine(),
+		projectAssets:         (*entities.FinancialProfile).ProjectAssets,
+		suggestAdjustments:    recommendationService.SuggestGoalAdjustments,
+	}
+	uc.exportRegistry = uc.newReportExportRegistry()
+	return uc
 }
 
 // NewGenerateReportsUseCaseWithPDF はPDF生成・ストレージ機能付きのGenerateReportsUseCaseを作成する
+// guardrailService が nil の場合、デフォルトの上限で注意書きと現実的シナリオを判定する
+// dismissalRepo が nil の場合、推奨事項の却下状態は考慮しない
+// marketContextProvider が nil の場合、包括的レポートに市場概況を含めない
+// healthScoreRepo が nil の場合、財務健全性スコアを記録せず、主要指標のトレンドは "stable" とする
+// snapshotRepo が nil の場合、財務健全性の計算結果を保存せず、毎回計算する
 func NewGenerateReportsUseCaseWithPDF(
 	financialPlanRepo repositories.FinancialPlanRepository,
 	goalRepo repositories.GoalRepository,
@@ -323,15 +741,36 @@ func NewGenerateReportsUseCaseWithPDF(
 	recommendationService *services.GoalRecommendationService,
 	pdfGenerator ReportPDFGenerator,
 	fileStorage TemporaryFileStoragePort,
+	savingsRateTargetRepo repositories.SavingsRateTargetRepository,
+	guardrailService *services.AssumptionGuardrailService,
+	dismissalRepo repositories.RecommendationDismissalRepository,
+	marketContextProvider ports.MarketContextProvider,
+	healthScoreRepo repositories.HealthScoreSnapshotRepository,
+	snapshotRepo repositories.CalculationSnapshotRepository,
 ) GenerateReportsUseCase {
-	return &generateReportsUseCaseImpl{
+	if guardrailService == nil {
+		guardrailService = services.NewDefaultAssumptionGuardrailService()
+	}
+	uc := &generateReportsUseCaseImpl{
 		financialPlanRepo:     financialPlanRepo,
 		goalRepo:              goalRepo,
 		calculationService:    calculationService,
 		recommendationService: recommendationService,
 		pdfGenerator:          pdfGenerator,
 		fileStorage:           fileStorage,
-	}
+		savingsRateTargetRepo: savingsRateTargetRepo,
+		guardrailService:      guardrailService,
+		dismissalRepo:         dismissalRepo,
+		taxService:            services.NewDefaultTaxEstimationService(),
+		marketContextProvider: marketContextProvider,
+		healthScoreRepo:       healthScoreRepo,
+		insightEngine:         services.NewProjectionInsightEngine(),
+		snapshotRepo:          snapshotRepo,
+		projectAssets:         (*entities.FinancialProfile).ProjectAssets,
+		suggestAdjustments:    recommendationService.SuggestGoalAdjustments,
+	}
+	uc.exportRegistry = uc.newReportExportRegistry()
+	return uc
 }
 
 // GenerateFinancialSummaryReport は財務サマリーレポートを生成する
@@ -345,35 +784,51 @@ func (uc *generateReportsUseCaseImpl) GenerateFinancialSummaryReport(
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
 
-	// 財務健全性を計算
-	financialHealth, err := uc.calculateFinancialHealth(plan)
+	// 財務健全性と現在の状況を計算（前回の計算後にデータが更新されていなければ保存済みの結果を使う）
+	financialHealth, currentSituation, err := uc.financialHealthFor(ctx, plan, input.UserID, input.ForceRecalculate)
 	if err != nil {
-		return nil, fmt.Errorf("財務健全性の計算に失敗しました: %w", err)
+		return nil, err
 	}
 
-	// 現在の状況を取得
-	currentSituation, err := uc.getCurrentSituation(plan)
+	// 主要指標を計算（トレンドは前月までの財務健全性スコアの記録と比較する）
+	now := time.Now()
+	keyMetrics, err := uc.calculateKeyMetrics(plan, uc.previousHealthScore(ctx, input.UserID, now))
 	if err != nil {
-		return nil, fmt.Errorf("現在の状況の取得に失敗しました: %w", err)
+		return nil, fmt.Errorf("主要指標の計算に失敗しました: %w", err)
 	}
 
-	// 主要指標を計算
-	keyMetrics, err := uc.calculateKeyMetrics(plan)
+	// 今回の財務健全性スコアを記録する
+	uc.recordHealthScore(ctx, input.UserID, financialHealth, currentSituation, now)
+
+	// 貯蓄率目標の進捗を評価
+	savingsRateTarget, err := evaluateActiveSavingsRateTarget(ctx, uc.savingsRateTargetRepo, plan, input.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("主要指標の計算に失敗しました: %w", err)
+		return nil, fmt.Errorf("貯蓄率目標の評価に失敗しました: %w", err)
 	}
 
-	// 推奨事項と警告を生成
-	recommendations, warnings := uc.generateRecommendationsAndWarnings(plan)
+	// 推奨事項と警告を生成し、ユーザーが却下したものを除く
+	recommendationItems, err := filterDismissedRecommendations(
+		ctx, uc.dismissalRepo, input.UserID, planRecommendations(plan, savingsRateTarget, uc.calculationService.Defaults(), now), now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	recommendations, warnings := splitRecommendationMessages(recommendationItems)
 
 	report := FinancialSummaryReport{
-		UserID:           input.UserID,
-		ReportDate:       time.Now().Format("2006-01-02"),
-		FinancialHealth:  *financialHealth,
-		CurrentSituation: *currentSituation,
-		KeyMetrics:       keyMetrics,
-		Recommendations:  recommendations,
-		Warnings:         warnings,
+		UserID:              input.UserID,
+		ReportDate:          time.Now().Format("2006-01-02"),
+		FinancialHealth:     *financialHealth,
+		CurrentSituation:    *currentSituation,
+		KeyMetrics:          keyMetrics,
+		Recommendations:     recommendations,
+		Warnings:            warnings,
+		RecommendationItems: recommendationItems,
+		SavingsRateTarget:   savingsRateTarget,
+		AssetAllocation:     assetAllocation(plan.Profile().CurrentSavings()),
+		Disclaimers:         uc.assumptionDisclaimers(plan.Profile()),
+		Assumptions:         newAssumptions(plan.Profile(), 0, now),
+		DataStaleness:       plan.Staleness(now),
 	}
 
 	return &FinancialSummaryReportOutput{
@@ -394,22 +849,25 @@ func (uc *generateReportsUseCaseImpl) GenerateAssetProjectionReport(
 	}
 
 	// 資産推移を計算
-	projections, err := plan.Profile().ProjectAssets(input.Years)
+	projections, err := uc.projectAssets(plan.Profile(), input.Years)
 	if err != nil {
 		return nil, fmt.Errorf("資産推移の計算に失敗しました: %w", err)
 	}
 
 	// サマリーを計算
-	summary, err := uc.calculateProjectionSummary(projections)
-	if err != nil {
-		return nil, fmt.Errorf("予測サマリーの計算に失敗しました: %w", err)
-	}
+	summary := summarizeProjections(uc.calculationService, projections)
 
 	// シナリオ分析を実行
 	scenarios := uc.generateScenarioAnalysis(plan, input.Years)
 
+	// ライフイベントの有無による差を計算
+	lifeEventImpact, err := newLifeEventImpact(plan.Profile(), projections, input.Years)
+	if err != nil {
+		return nil, err
+	}
+
 	// 洞察を生成
-	insights := uc.generateProjectionInsights(projections, scenarios)
+	insights := uc.insightEngine.GenerateInsights(projections, plan)
 
 	report := AssetProjectionReport{
 		UserID:          input.UserID,
@@ -418,6 +876,9 @@ func (uc *generateReportsUseCaseImpl) GenerateAssetProjectionReport(
 		Summary:         *summary,
 		Scenarios:       scenarios,
 		Insights:        insights,
+		Disclaimers:     uc.assumptionDisclaimers(plan.Profile()),
+		Assumptions:     newAssumptions(plan.Profile(), input.Years, time.Now()),
+		LifeEventImpact: lifeEventImpact,
 	}
 
 	return &AssetProjectionReportOutput{
@@ -426,22 +887,40 @@ func (uc *generateReportsUseCaseImpl) GenerateAssetProjectionReport(
 	}, nil
 }
 
+// newLifeEventImpact はライフイベントを考慮した資産推移と考慮しない場合の最終年の資産の差を返す
+// ライフイベントが未登録の場合は nil を返す
+func newLifeEventImpact(profile *entities.FinancialProfile, projections []entities.AssetProjection, years int) (*LifeEventImpact, error) {
+	lifeEvents := profile.LifeEvents()
+	if len(lifeEvents) == 0 || len(projections) == 0 {
+		return nil, nil
+	}
+
+	withoutEvents, err := profile.ProjectAssetsWithoutLifeEvents(years)
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントを除いた資産推移の計算に失敗しました: %w", err)
+	}
+
+	finalAssets := projections[len(projections)-1].TotalAssets.Amount()
+	finalWithoutEvents := withoutEvents[len(withoutEvents)-1].TotalAssets.Amount()
+	return &LifeEventImpact{
+		EventCount:               len(lifeEvents),
+		FinalAssets:              finalAssets,
+		FinalAssetsWithoutEvents: finalWithoutEvents,
+		Difference:               finalAssets - finalWithoutEvents,
+	}, nil
+}
+
 // GenerateGoalsProgressReport は目標進捗レポートを生成する
 func (uc *generateReportsUseCaseImpl) GenerateGoalsProgressReport(
 	ctx context.Context,
 	input GoalsProgressReportInput,
 ) (*GoalsProgressReportOutput, error) {
-	// 目標一覧を取得
-	goals, err := uc.goalRepo.FindByUserID(ctx, input.UserID)
-	if err != nil {
-		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
-	}
-
-	// 財務計画を取得
+	// 財務計画を取得（目標は財務計画と一緒に読み込まれる）
 	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
+	goals := plan.Goals()
 
 	// 目標進捗を計算
 	var goalProgresses []GoalProgress
@@ -453,13 +932,15 @@ func (uc *generateReportsUseCaseImpl) GenerateGoalsProgressReport(
 			return nil, fmt.Errorf("目標進捗の計算に失敗しました: %w", err)
 		}
 
-		// 推奨事項を生成
-		recommendations, err := uc.recommendationService.SuggestGoalAdjustments(goal, plan.Profile())
+		// 推奨事項を生成（1件の目標の失敗でレポート全体を失敗させず、推奨事項なしで続ける）
+		var recommendationTexts []string
+		var recommendationError string
+		recommendations, err := uc.suggestAdjustments(goal, plan.Profile())
 		if err != nil {
-			return nil, fmt.Errorf("推奨事項の生成に失敗しました: %w", err)
+			slog.Warn("failed to suggest goal adjustments; omitting recommendations", "goal_id", goal.ID(), "error", err)
+			recommendationTexts = []string{}
+			recommendationError = "この目標の推奨事項を生成できませんでした"
 		}
-
-		var recommendationTexts []string
 		for _, rec := range recommendations {
 			recommendationTexts = append(recommendationTexts, rec.Description)
 		}
@@ -472,8 +953,9 @@ func (uc *generateReportsUseCaseImpl) GenerateGoalsProgressReport(
 			Progress:        progress,
 			Status:          status,
 			DaysRemaining:   goal.GetRemainingDays(),
-			OnTrack:         onTrack,
-			Recommendations: recommendationTexts,
+			OnTrack:             onTrack,
+			Recommendations:     recommendationTexts,
+			RecommendationError: recommendationError,
 		})
 
 		// サマリーを更新
@@ -509,6 +991,8 @@ func (uc *generateReportsUseCaseImpl) GenerateGoalsProgressReport(
 		Summary:      summary,
 		Achievements: achievements,
 		NextSteps:    nextSteps,
+		Disclaimers:  uc.assumptionDisclaimers(plan.Profile()),
+		Assumptions:  newAssumptions(plan.Profile(), 0, time.Now()),
 	}
 
 	return &GoalsProgressReportOutput{
@@ -531,7 +1015,7 @@ func (uc *generateReportsUseCaseImpl) GenerateRetirementPlanReport(
 	// 退職データが設定されているかチェック
 	retirementData := plan.RetirementData()
 	if retirementData == nil {
-		return nil, fmt.Errorf("退職データが設定されていません")
+		return nil, ErrRetirementDataNotSet
 	}
 
 	// 退職資金計算
@@ -555,14 +1039,37 @@ func (uc *generateReportsUseCaseImpl) GenerateRetirementPlanReport(
 		return nil, fmt.Errorf("退職資金計算に失敗しました: %w", err)
 	}
 
+	// 収入源の内訳を生成
+	incomeSources, err := retirementIncomeSources(retirementData)
+	if err != nil {
+		return nil, fmt.Errorf("退職後の収入源の計算に失敗しました: %w", err)
+	}
+
+	// 手取りベースの退職資金を計算
+	afterTax, err := uc.calculateRetirementAfterTax(plan, retirementData, calculation, currentSavings, netSavings)
+	if err != nil {
+		return nil, fmt.Errorf("手取りベースの退職資金計算に失敗しました: %w", err)
+	}
+
+	// 生活水準ごとの比較を生成
+	lifestyleScenarios, err := calculateLifestyleScenarios(plan, input.LifestyleExpenses)
+	if err != nil {
+		return nil, err
+	}
+	lifestyles := retirementLifestyleComparisons(lifestyleScenarios, netSavings)
+
 	// 退職予測を生成
-	projections := uc.generateRetirementProjections(plan, retirementData)
+	projections := uc.generateRetirementProjections(plan, retirementData, calculation)
 
 	// 退職戦略を生成
 	strategies := uc.generateRetirementStrategies(calculation, plan)
 
 	// 推奨事項を生成
 	recommendations := uc.generateRetirementRecommendations(calculation)
+	if calculation.Shortfall.IsZero() && !afterTax.CanCoverExpenses {
+		recommendations = append(recommendations, fmt.Sprintf(
+			"額面では老後資金が足りていますが、年金・取り崩しにかかる税と保険料を考慮すると%.0f万円不足します", afterTax.Shortfall/10000))
+	}
 
 	// リスク評価を実行
 	riskAssessment := uc.assessRetirementRisks(plan, calculation)
@@ -571,10 +1078,15 @@ func (uc *generateReportsUseCaseImpl) GenerateRetirementPlanReport(
 		UserID:          input.UserID,
 		RetirementData:  retirementData,
 		Calculation:     calculation,
+		IncomeSources:   incomeSources,
+		AfterTax:        *afterTax,
+		Lifestyles:      lifestyles,
 		Projections:     projections,
 		Strategies:      strategies,
 		Recommendations: recommendations,
 		RiskAssessment:  riskAssessment,
+		Disclaimers:     uc.assumptionDisclaimers(plan.Profile()),
+		Assumptions:     newAssumptions(plan.Profile(), retirementData.CalculateYearsUntilRetirement(), time.Now()),
 	}
 
 	return &RetirementPlanReportOutput{
@@ -584,11 +1096,28 @@ func (uc *generateReportsUseCaseImpl) GenerateRetirementPlanReport(
 }
 
 // GenerateComprehensiveReport は包括的レポートを生成する
+// 各セクションは独立して生成し、必須セクション（財務サマリー）の失敗時のみ全体をエラーにする
+// 任意セクションの失敗はそのセクションを null とし、SectionsFailed に理由を含めた部分成功として返す
+// input.Sections が指定されている場合、指定されていない任意セクションは生成せず、レスポンスからも省略する
+// （財務サマリーは計算前提と注意書きの元になるため常に生成する）
 func (uc *generateReportsUseCaseImpl) GenerateComprehensiveReport(
 	ctx context.Context,
 	input ComprehensiveReportInput,
 ) (*ComprehensiveReportOutput, error) {
-	// 各種レポートを生成
+	sectionsFailed := []SectionError{}
+
+	var selected map[ReportSection]bool
+	if len(input.Sections) > 0 {
+		selected = make(map[ReportSection]bool, len(input.Sections))
+		for _, section := range input.Sections {
+			selected[section] = true
+		}
+	}
+	requested := func(section ReportSection) bool {
+		return selected == nil || selected[section]
+	}
+
+	// 財務サマリー（必須）
 	financialSummary, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{
 		UserID: input.UserID,
 	})
@@ -596,50 +1125,94 @@ func (uc *generateReportsUseCaseImpl) GenerateComprehensiveReport(
 		return nil, fmt.Errorf("財務サマリーレポートの生成に失敗しました: %w", err)
 	}
 
-	assetProjection, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput(input))
-	if err != nil {
-		return nil, fmt.Errorf("資産推移レポートの生成に失敗しました: %w", err)
+	// 資産推移（任意）
+	var assetProjection *AssetProjectionReport
+	if requested(ReportSectionAssetProjection) {
+		assetProjectionReport, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{
+			UserID: input.UserID,
+			Years:  input.Years,
+		})
+		if err != nil {
+			sectionsFailed = append(sectionsFailed, SectionError{
+				Section: ReportSectionAssetProjection,
+				Reason:  fmt.Sprintf("資産推移レポートの生成に失敗しました: %v", err),
+			})
+		} else {
+			assetProjection = &assetProjectionReport.Report
+		}
 	}
 
-	goalsProgress, err := uc.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{
-		UserID: input.UserID,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("目標進捗レポートの生成に失敗しました: %w", err)
+	// 目標進捗（任意）
+	var goalsProgress *GoalsProgressReport
+	if requested(ReportSectionGoalsProgress) {
+		goalsProgressReport, err := uc.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{
+			UserID: input.UserID,
+		})
+		if err != nil {
+			sectionsFailed = append(sectionsFailed, SectionError{
+				Section: ReportSectionGoalsProgress,
+				Reason:  fmt.Sprintf("目標進捗レポートの生成に失敗しました: %v", err),
+			})
+		} else {
+			goalsProgress = &goalsProgressReport.Report
+		}
 	}
 
-	// 退職計画レポート（オプション）
+	// 退職計画（任意。退職データ未設定は失敗として扱わない）
 	var retirementPlan *RetirementPlanReport
-	retirementReport, err := uc.GenerateRetirementPlanReport(ctx, RetirementPlanReportInput{
-		UserID: input.UserID,
-	})
-	if err == nil {
-		retirementPlan = &retirementReport.Report
+	if requested(ReportSectionRetirementPlan) {
+		retirementReport, err := uc.GenerateRetirementPlanReport(ctx, RetirementPlanReportInput{
+			UserID: input.UserID,
+		})
+		switch {
+		case err == nil:
+			retirementPlan = &retirementReport.Report
+		case !errors.Is(err, ErrRetirementDataNotSet):
+			sectionsFailed = append(sectionsFailed, SectionError{
+				Section: ReportSectionRetirementPlan,
+				Reason:  fmt.Sprintf("退職計画レポートの生成に失敗しました: %v", err),
+			})
+		}
 	}
 
 	// エグゼクティブサマリーを生成
-	executiveSummary := uc.generateExecutiveSummary(
-		&financialSummary.Report,
-		&assetProjection.Report,
-		&goalsProgress.Report,
-		retirementPlan,
-	)
+	var executiveSummary ExecutiveSummary
+	if requested(ReportSectionExecutiveSummary) {
+		executiveSummary = uc.generateExecutiveSummary(
+			&financialSummary.Report,
+			assetProjection,
+			goalsProgress,
+			retirementPlan,
+		)
+	}
 
 	// アクションプランを生成
-	actionPlan := uc.generateActionPlan(
-		&financialSummary.Report,
-		&goalsProgress.Report,
-		retirementPlan,
-	)
+	var actionPlan ActionPlan
+	if requested(ReportSectionActionPlan) {
+		actionPlan = uc.generateActionPlan(
+			&financialSummary.Report,
+			goalsProgress,
+			retirementPlan,
+		)
+	}
+
+	assumptions := financialSummary.Report.Assumptions
+	assumptions.ProjectionYears = input.Years
 
 	report := ComprehensiveReport{
 		UserID:           input.UserID,
 		ExecutiveSummary: executiveSummary,
 		FinancialSummary: financialSummary.Report,
-		AssetProjection:  assetProjection.Report,
-		GoalsProgress:    goalsProgress.Report,
+		AssetProjection:  assetProjection,
+		GoalsProgress:    goalsProgress,
 		RetirementPlan:   retirementPlan,
 		ActionPlan:       actionPlan,
+		// 各レポートの注意書きは同じ財務計画から生成されるため、財務サマリーのものを代表として掲載する
+		Disclaimers:    financialSummary.Report.Disclaimers,
+		MarketContext:  uc.loadMarketContext(ctx),
+		SectionsFailed: sectionsFailed,
+		Assumptions:    assumptions,
+		sections:       selected,
 	}
 
 	return &ComprehensiveReportOutput{
@@ -648,6 +1221,38 @@ func (uc *generateReportsUseCaseImpl) GenerateComprehensiveReport(
 	}, nil
 }
 
+// loadMarketContext は包括的レポートに添える市場概況を取得する
+// 市場概況は参考情報のため、取得できない場合はレポート全体を失敗にせず省略する
+func (uc *generateReportsUseCaseImpl) loadMarketContext(ctx context.Context) *MarketContextReport {
+	if uc.marketContextProvider == nil {
+		return nil
+	}
+
+	marketContext, err := uc.marketContextProvider.GetLatestContext(ctx)
+	if err != nil || marketContext == nil {
+		slog.Warn("market context unavailable; omitting from comprehensive report", "error", err)
+		return nil
+	}
+
+	return &MarketContextReport{
+		NikkeiReturn30Day: marketContext.NikkeiReturn30Day,
+		JGBYield10Year:    marketContext.JGBYield10Year,
+		CPI:               marketContext.CPI,
+		FetchedAt:         marketContext.FetchedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Summary:           summarizeMarketContext(marketContext),
+	}
+}
+
+// summarizeMarketContext は市場概況を利用者向けの文章にまとめる
+func summarizeMarketContext(marketContext *ports.MarketContext) string {
+	nikkei := fmt.Sprintf("日経平均は直近30日で%.1f%%上昇しました", marketContext.NikkeiReturn30Day)
+	if marketContext.NikkeiReturn30Day < 0 {
+		nikkei = fmt.Sprintf("日経平均は直近30日で%.1f%%下落しました", -marketContext.NikkeiReturn30Day)
+	}
+	return fmt.Sprintf("%s。10年国債利回りは%.2f%%、消費者物価指数は前年比%.1f%%です。",
+		nikkei, marketContext.JGBYield10Year, marketContext.CPI)
+}
+
 // calculateFinancialHealth は財務健全性を計算する
 func (uc *generateReportsUseCaseImpl) calculateFinancialHealth(plan *aggregates.FinancialPlan) (*FinancialHealth, error) {
 	// 貯蓄率を計算
@@ -656,7 +1261,7 @@ func (uc *generateReportsUseCaseImpl) calculateFinancialHealth(plan *aggregates.
 		return nil, err
 	}
 
-	monthlyIncome := plan.Profile().MonthlyIncome()
+	monthlyIncome := plan.Profile().NetMonthlyIncome()
 	savingsRate := (netSavings.Amount() / monthlyIncome.Amount()) * 100
 
 	// 緊急資金比率を計算
@@ -667,39 +1272,29 @@ func (uc *generateReportsUseCaseImpl) calculateFinancialHealth(plan *aggregates.
 
 	emergencyFundRatio := 0.0
 	if plan.EmergencyFund() != nil {
-		emergencyFundRatio = plan.EmergencyFund().CurrentFund.Amount() / monthlyExpenses.Amount()
+		emergencyFundRatio = plan.EmergencyFund().CurrentFund().Amount() / monthlyExpenses.Amount()
 	}
 
-	// 総合スコアを計算（簡略化）
-	score := 0
-	if savingsRate >= 20 {
-		score += 30
-	} else if savingsRate >= 10 {
-		score += 20
-	} else if savingsRate >= 5 {
-		score += 10
-	}
+	investmentReturn := plan.Profile().InvestmentReturn().AsPercentage()
 
-	if emergencyFundRatio >= 6 {
-		score += 30
-	} else if emergencyFundRatio >= 3 {
-		score += 20
-	} else if emergencyFundRatio >= 1 {
-		score += 10
+	// 債務対収入比率（返済中の負債の月々の返済額の合計 / 手取り月収）
+	// 支出項目に計上済みの返済も債務には含める
+	debtPayments, err := plan.Profile().Liabilities().MonthlyPaymentsAt(time.Now(), false)
+	if err != nil {
+		return nil, err
 	}
-
-	// 投資利回りによる加点
-	investmentReturn := plan.Profile().InvestmentReturn().AsPercentage()
-	if investmentReturn >= 5 {
-		score += 20
-	} else if investmentReturn >= 3 {
-		score += 15
-	} else if investmentReturn >= 1 {
-		score += 10
+	debtToIncomeRatio := 0.0
+	if monthlyIncome.IsPositive() {
+		debtToIncomeRatio = (debtPayments.Amount() / monthlyIncome.Amount()) * 100
 	}
 
-	// 債務対収入比率（簡略化：0と仮定）
-	debtToIncomeRatio := 0.0
+	financialHealth := newFinancialHealth(savingsRate, debtToIncomeRatio, emergencyFundRatio, investmentReturn)
+	return &financialHealth, nil
+}
+
+// newFinancialHealth は各指標から総合スコア（簡略化）とスコアレベルを計算する
+func newFinancialHealth(savingsRate, debtToIncomeRatio, emergencyFundRatio, investmentReturn float64) FinancialHealth {
+	score := savingsRateScore(savingsRate) + emergencyFundScore(emergencyFundRatio) + investmentReturnScore(investmentReturn)
 
 	// スコアレベルを決定
 	var scoreLevel string
@@ -714,13 +1309,185 @@ func (uc *generateReportsUseCaseImpl) calculateFinancialHealth(plan *aggregates.
 		scoreLevel = "poor"
 	}
 
-	return &FinancialHealth{
+	return FinancialHealth{
 		OverallScore:       score,
 		ScoreLevel:         scoreLevel,
 		SavingsRate:        savingsRate,
 		DebtToIncomeRatio:  debtToIncomeRatio,
 		EmergencyFundRatio: emergencyFundRatio,
-	}, nil
+	}
+}
+
+// financialHealthCache は保存する財務健全性の計算結果
+type financialHealthCache struct {
+	FinancialHealth  FinancialHealth  `json:"financial_health"`
+	CurrentSituation CurrentSituation `json:"current_situation"`
+}
+
+// financialHealthCachePayload は財務健全性の保存形式
+// 保存した値を世帯サマリー等の計算に再利用するため、レスポンス向けの丸め（MarshalJSON）を通さず全精度で保存する
+type financialHealthCachePayload struct {
+	FinancialHealth  rawFinancialHealth  `json:"financial_health"`
+	CurrentSituation rawCurrentSituation `json:"current_situation"`
+}
+
+type (
+	rawFinancialHealth  FinancialHealth
+	rawCurrentSituation CurrentSituation
+)
+
+// MarshalJSON は財務健全性を全精度でシリアライズする
+func (c financialHealthCache) MarshalJSON() ([]byte, error) {
+	return json.Marshal(financialHealthCachePayload{
+		FinancialHealth:  rawFinancialHealth(c.FinancialHealth),
+		CurrentSituation: rawCurrentSituation(c.CurrentSituation),
+	})
+}
+
+// UnmarshalJSON は保存した財務健全性を復元する
+func (c *financialHealthCache) UnmarshalJSON(data []byte) error {
+	var payload financialHealthCachePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	c.FinancialHealth = FinancialHealth(payload.FinancialHealth)
+	c.CurrentSituation = CurrentSituation(payload.CurrentSituation)
+	return nil
+}
+
+// financialHealthFor は財務健全性と現在の状況を返す
+// 最後の計算がデータの最終更新より後であれば保存済みの結果を返し、それ以外は計算して保存する
+func (uc *generateReportsUseCaseImpl) financialHealthFor(
+	ctx context.Context,
+	plan *aggregates.FinancialPlan,
+	userID entities.UserID,
+	forceRecalculate bool,
+) (*FinancialHealth, *CurrentSituation, error) {
+	if !forceRecalculate {
+		if cached := uc.cachedFinancialHealth(ctx, plan, userID); cached != nil {
+			return &cached.FinancialHealth, &cached.CurrentSituation, nil
+		}
+	}
+
+	financialHealth, err := uc.calculateFinancialHealth(plan)
+	if err != nil {
+		return nil, nil, fmt.Errorf("財務健全性の計算に失敗しました: %w", err)
+	}
+
+	currentSituation, err := uc.getCurrentSituation(plan)
+	if err != nil {
+		return nil, nil, fmt.Errorf("現在の状況の取得に失敗しました: %w", err)
+	}
+
+	uc.saveFinancialHealth(ctx, plan, userID, financialHealthCache{
+		FinancialHealth:  *financialHealth,
+		CurrentSituation: *currentSituation,
+	})
+	return financialHealth, currentSituation, nil
+}
+
+// cachedFinancialHealth は再利用できる保存済みの財務健全性を返す
+// 未計算・データ更新後・計算バージョン変更後の場合や、取得に失敗した場合は nil を返す
+func (uc *generateReportsUseCaseImpl) cachedFinancialHealth(
+	ctx context.Context,
+	plan *aggregates.FinancialPlan,
+	userID entities.UserID,
+) *financialHealthCache {
+	if uc.snapshotRepo == nil || !plan.IsCalculationUpToDate() {
+		return nil
+	}
+
+	snapshot, err := uc.snapshotRepo.FindByUserIDAndKind(ctx, userID, entities.CalculationSnapshotKindFinancialHealth)
+	if err != nil {
+		slog.Warn("failed to load financial health snapshot", "user_id", userID, "error", err)
+		return nil
+	}
+	if snapshot == nil || snapshot.IsStale(services.CalculationVersion) || snapshot.RecalculatedAt().Before(plan.LastProfileUpdatedAt()) {
+		return nil
+	}
+
+	var cached financialHealthCache
+	if err := json.Unmarshal(snapshot.Payload(), &cached); err != nil {
+		slog.Warn("failed to decode financial health snapshot", "user_id", userID, "error", err)
+		return nil
+	}
+	return &cached
+}
+
+// saveFinancialHealth は財務健全性の計算結果を保存し、計算日時を記録する（失敗してもレポート生成は継続する）
+func (uc *generateReportsUseCaseImpl) saveFinancialHealth(
+	ctx context.Context,
+	plan *aggregates.FinancialPlan,
+	userID entities.UserID,
+	result financialHealthCache,
+) {
+	if uc.snapshotRepo == nil {
+		return
+	}
+
+	payload, err := json.Marshal(result)
+	if err != nil {
+		slog.Warn("failed to encode financial health snapshot", "user_id", userID, "error", err)
+		return
+	}
+
+	calculatedAt := time.Now()
+	snapshot, err := entities.NewCalculationSnapshot(userID, entities.CalculationSnapshotKindFinancialHealth, payload, services.CalculationVersion, calculatedAt)
+	if err == nil {
+		err = uc.snapshotRepo.Save(ctx, snapshot)
+	}
+	if err != nil {
+		slog.Warn("failed to save financial health snapshot", "user_id", userID, "error", err)
+		return
+	}
+
+	if err := uc.financialPlanRepo.MarkCalculated(ctx, userID, calculatedAt); err != nil {
+		slog.Warn("failed to mark financial plan as calculated", "user_id", userID, "error", err)
+		return
+	}
+	plan.MarkCalculated(calculatedAt)
+}
+
+// savingsRateScore は貯蓄率（%）による財務健全性スコアの加点を返す
+func savingsRateScore(savingsRate float64) int {
+	switch {
+	case savingsRate >= 20:
+		return 30
+	case savingsRate >= 10:
+		return 20
+	case savingsRate >= 5:
+		return 10
+	default:
+		return 0
+	}
+}
+
+// emergencyFundScore は緊急資金比率（月数）による財務健全性スコアの加点を返す
+func emergencyFundScore(emergencyFundRatio float64) int {
+	switch {
+	case emergencyFundRatio >= 6:
+		return 30
+	case emergencyFundRatio >= 3:
+		return 20
+	case emergencyFundRatio >= 1:
+		return 10
+	default:
+		return 0
+	}
+}
+
+// investmentReturnScore は投資利回り（%）による財務健全性スコアの加点を返す
+func investmentReturnScore(investmentReturn float64) int {
+	switch {
+	case investmentReturn >= 5:
+		return 20
+	case investmentReturn >= 3:
+		return 15
+	case investmentReturn >= 1:
+		return 10
+	default:
+		return 0
+	}
 }
 
 // getCurrentSituation は現在の状況を取得する
@@ -740,18 +1507,78 @@ func (uc *generateReportsUseCaseImpl) getCurrentSituation(plan *aggregates.Finan
 		return nil, err
 	}
 
+	totalLiabilities, err := plan.Profile().TotalLiabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	netWorth, err := plan.Profile().NetWorth()
+	if err != nil {
+		return nil, err
+	}
+
+	debtPayments, err := plan.Profile().MonthlyDebtPayments()
+	if err != nil {
+		return nil, err
+	}
+
 	return &CurrentSituation{
-		MonthlyIncome:    plan.Profile().MonthlyIncome().Amount(),
-		MonthlyExpenses:  monthlyExpenses.Amount(),
-		NetSavings:       netSavings.Amount(),
-		TotalAssets:      totalAssets.Amount(),
-		InvestmentReturn: plan.Profile().InvestmentReturn().AsPercentage(),
-		InflationRate:    plan.Profile().InflationRate().AsPercentage(),
+		MonthlyIncome:       plan.Profile().MonthlyIncome().Amount(),
+		IncomeType:          string(plan.Profile().IncomeType()),
+		NetMonthlyIncome:    plan.Profile().NetMonthlyIncome().Amount(),
+		MonthlyExpenses:     monthlyExpenses.Amount(),
+		NetSavings:          netSavings.Amount(),
+		TotalAssets:         totalAssets.Amount(),
+		InvestmentReturn:    plan.Profile().InvestmentReturn().AsPercentage(),
+		InflationRate:       plan.Profile().InflationRate().AsPercentage(),
+		TotalLiabilities:    totalLiabilities.Amount(),
+		NetWorth:            netWorth.Amount(),
+		MonthlyDebtPayments: debtPayments.Amount(),
 	}, nil
 }
 
+// assetAllocationTypes は資産配分に表示する貯蓄の種類と表示名（表示順）
+var assetAllocationTypes = []struct {
+	savingsType string
+	label       string
+}{
+	{"deposit", "預金"},
+	{"investment", "投資"},
+	{"other", "その他"},
+}
+
+// assetAllocation は現在の貯蓄を種類ごとに集計し、総資産に占める割合を求める
+// 金額が0の種類は含めない
+func assetAllocation(savings entities.SavingsCollection) []AssetAllocation {
+	amounts := make(map[string]float64, len(assetAllocationTypes))
+	var total float64
+	for _, item := range savings {
+		amounts[item.Type] += item.Amount.Amount()
+		total += item.Amount.Amount()
+	}
+
+	allocation := make([]AssetAllocation, 0, len(assetAllocationTypes))
+	if total <= 0 {
+		return allocation
+	}
+	for _, t := range assetAllocationTypes {
+		amount := amounts[t.savingsType]
+		if amount <= 0 {
+			continue
+		}
+		allocation = append(allocation, AssetAllocation{
+			Type:       t.savingsType,
+			Label:      t.label,
+			Amount:     amount,
+			Percentage: amount / total * 100,
+		})
+	}
+	return allocation
+}
+
 // calculateKeyMetrics は主要指標を計算する
-func (uc *generateReportsUseCaseImpl) calculateKeyMetrics(plan *aggregates.FinancialPlan) ([]KeyMetric, error) {
+// previous は前月までの最後の財務健全性スコアの記録で、トレンドの判定に使う（nil の場合は "stable"）
+func (uc *generateReportsUseCaseImpl) calculateKeyMetrics(plan *aggregates.FinancialPlan, previous *entities.HealthScoreSnapshot) ([]KeyMetric, error) {
 	var metrics []KeyMetric
 
 	// 貯蓄率
@@ -760,24 +1587,25 @@ func (uc *generateReportsUseCaseImpl) calculateKeyMetrics(plan *aggregates.Finan
 		return nil, err
 	}
 
-	monthlyIncome := plan.Profile().MonthlyIncome()
+	monthlyIncome := plan.Profile().NetMonthlyIncome()
 	savingsRate := (netSavings.Amount() / monthlyIncome.Amount()) * 100
 
 	metrics = append(metrics, KeyMetric{
 		Name:        "貯蓄率",
 		Value:       savingsRate,
 		Unit:        "%",
-		Description: "月収に対する純貯蓄額の割合",
-		Trend:       "stable", // 実際の実装では履歴データから計算
+		Description: "手取り月収に対する純貯蓄額の割合",
+		Trend:       metricTrend(previous, savingsRate, (*entities.HealthScoreSnapshot).SavingsRate),
 	})
 
 	// 投資利回り
+	investmentReturn := plan.Profile().InvestmentReturn().AsPercentage()
 	metrics = append(metrics, KeyMetric{
 		Name:        "投資利回り",
-		Value:       plan.Profile().InvestmentReturn().AsPercentage(),
+		Value:       investmentReturn,
 		Unit:        "%",
 		Description: "年間の期待投資収益率",
-		Trend:       "stable",
+		Trend:       metricTrend(previous, investmentReturn, (*entities.HealthScoreSnapshot).InvestmentReturn),
 	})
 
 	// 総資産
@@ -791,58 +1619,298 @@ func (uc *generateReportsUseCaseImpl) calculateKeyMetrics(plan *aggregates.Finan
 		Value:       totalAssets.Amount(),
 		Unit:        "円",
 		Description: "現在の総貯蓄・投資額",
-		Trend:       "up",
+		Trend:       metricTrend(previous, totalAssets.Amount(), (*entities.HealthScoreSnapshot).TotalAssets),
 	})
 
+	// 年間の税・社会保険料負担（額面で入力されている場合のみ推定できる）
+	if plan.Profile().IncomeType() == entities.IncomeTypeGross {
+		estimate, err := uc.taxService.EstimateFromMonthlyGross(plan.Profile().MonthlyIncome())
+		if err != nil {
+			return nil, err
+		}
+
+		metrics = append(metrics, KeyMetric{
+			Name:        "年間税負担",
+			Value:       estimate.TotalBurden,
+			Unit:        "円",
+			Description: "額面年収から推定した所得税・住民税・社会保険料の年間合計",
+			Trend:       "stable",
+		})
+	}
+
 	return metrics, nil
 }
 
-// generateRecommendationsAndWarnings は推奨事項と警告を生成する
-func (uc *generateReportsUseCaseImpl) generateRecommendationsAndWarnings(plan *aggregates.FinancialPlan) ([]string, []string) {
-	var recommendations []string
-	var warnings []string
+// metricTrendTolerance は主要指標が変化したとみなす最小の差（記録は小数第2位まで保存する）
+const metricTrendTolerance = 0.005
 
-	// 貯蓄率チェック
-	netSavings, err := plan.Profile().CalculateNetSavings()
+// metricTrend は前月までの記録と比べた主要指標のトレンドを返す（記録がない場合は "stable"）
+func metricTrend(previous *entities.HealthScoreSnapshot, current float64, value func(*entities.HealthScoreSnapshot) float64) string {
+	if previous == nil {
+		return "stable"
+	}
+	switch diff := current - value(previous); {
+	case diff > metricTrendTolerance:
+		return "up"
+	case diff < -metricTrendTolerance:
+		return "down"
+	default:
+		return "stable"
+	}
+}
+
+// previousHealthScore は当月より前の最後の財務健全性スコアの記録を返す
+// 記録がない場合や取得に失敗した場合は nil を返す（レポート生成は継続する）
+func (uc *generateReportsUseCaseImpl) previousHealthScore(ctx context.Context, userID entities.UserID, now time.Time) *entities.HealthScoreSnapshot {
+	if uc.healthScoreRepo == nil {
+		return nil
+	}
+
+	history, err := uc.healthScoreRepo.FindByUserIDSince(ctx, userID, now.AddDate(0, -maxHealthScoreTrendMonths, 0))
+	if err != nil {
+		slog.Warn("failed to load health score history", "user_id", userID, "error", err)
+		return nil
+	}
+
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	var previous *entities.HealthScoreSnapshot
+	for _, snapshot := range history {
+		if snapshot.RecordedAt().Before(startOfMonth) {
+			previous = snapshot
+		}
+	}
+	return previous
+}
+
+// recordHealthScore は財務健全性スコアを記録する（失敗してもレポート生成は継続する）
+func (uc *generateReportsUseCaseImpl) recordHealthScore(
+	ctx context.Context,
+	userID entities.UserID,
+	health *FinancialHealth,
+	situation *CurrentSituation,
+	now time.Time,
+) {
+	if uc.healthScoreRepo == nil {
+		return
+	}
+
+	snapshot, err := entities.NewHealthScoreSnapshot(
+		userID,
+		health.OverallScore,
+		health.SavingsRate,
+		health.EmergencyFundRatio,
+		situation.InvestmentReturn,
+		situation.TotalAssets,
+		now,
+	)
 	if err == nil {
-		monthlyIncome := plan.Profile().MonthlyIncome()
-		savingsRate := (netSavings.Amount() / monthlyIncome.Amount()) * 100
-
-		if savingsRate < 10 {
-			warnings = append(warnings, "貯蓄率が10%を下回っています。支出の見直しを検討してください")
-			recommendations = append(recommendations, "月間支出を詳細に分析し、削減可能な項目を特定してください")
-		} else if savingsRate > 30 {
-			recommendations = append(recommendations, "優秀な貯蓄率です。投資商品の多様化を検討してください")
+		err = uc.healthScoreRepo.Save(ctx, snapshot)
+	}
+	if err != nil {
+		slog.Warn("failed to record health score", "user_id", userID, "error", err)
+	}
+}
+
+// GetHealthScoreTrend は財務健全性スコアの月ごとの推移と悪化傾向の警告を返す
+// 直近で2ヶ月以上連続してスコアが低下している場合は、低下幅が最も大きい指標を主因とする警告を生成する
+func (uc *generateReportsUseCaseImpl) GetHealthScoreTrend(
+	ctx context.Context,
+	input HealthScoreTrendInput,
+) (*HealthScoreTrendOutput, error) {
+	if uc.healthScoreRepo == nil {
+		return nil, errors.New("財務健全性スコアの履歴は利用できません")
+	}
+
+	months := input.Months
+	if months <= 0 {
+		months = defaultHealthScoreTrendMonths
+	}
+	if months > maxHealthScoreTrendMonths {
+		return nil, fmt.Errorf("期間は%dヶ月以内で指定してください", maxHealthScoreTrendMonths)
+	}
+
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -(months - 1), 0)
+	history, err := uc.healthScoreRepo.FindByUserIDSince(ctx, input.UserID, since)
+	if err != nil {
+		return nil, fmt.Errorf("財務健全性スコアの履歴の取得に失敗しました: %w", err)
+	}
+
+	monthly := entities.MonthlyHealthScoreSnapshots(history)
+	points := make([]HealthScorePoint, len(monthly))
+	for i, snapshot := range monthly {
+		points[i] = HealthScorePoint{
+			Month:              snapshot.RecordedAt().Format("2006-01"),
+			Score:              snapshot.Score(),
+			SavingsRate:        snapshot.SavingsRate(),
+			EmergencyFundRatio: snapshot.EmergencyFundRatio(),
+			InvestmentReturn:   snapshot.InvestmentReturn(),
+			TotalAssets:        snapshot.TotalAssets(),
+			RecordedAt:         snapshot.RecordedAt().Format(time.RFC3339),
 		}
 	}
 
-	// 緊急資金チェック
-	if plan.EmergencyFund() != nil {
-		monthlyExpenses, err := plan.Profile().MonthlyExpenses().Total()
-		if err == nil {
-			emergencyFundRatio := plan.EmergencyFund().CurrentFund.Amount() / monthlyExpenses.Amount()
-
-			if emergencyFundRatio < 3 {
-				warnings = append(warnings, "緊急資金が3ヶ月分の生活費を下回っています")
-				recommendations = append(recommendations, "緊急資金として3-6ヶ月分の生活費を確保してください")
-			}
+	output := &HealthScoreTrendOutput{
+		UserID:              input.UserID,
+		Months:              months,
+		Points:              points,
+		Trend:               "stable",
+		ConsecutiveDeclines: entities.ConsecutiveScoreDeclines(monthly),
+	}
+	if n := len(monthly); n >= 2 {
+		switch latest, prior := monthly[n-1].Score(), monthly[n-2].Score(); {
+		case latest > prior:
+			output.Trend = "up"
+		case latest < prior:
+			output.Trend = "down"
 		}
 	}
 
-	// 投資利回りチェック
-	investmentReturn := plan.Profile().InvestmentReturn().AsPercentage()
-	if investmentReturn < 3 {
-		recommendations = append(recommendations, "投資利回りが低めです。ポートフォリオの見直しを検討してください")
+	if output.ConsecutiveDeclines >= healthScoreDeclineWarningMonths {
+		from := monthly[len(monthly)-1-output.ConsecutiveDeclines]
+		to := monthly[len(monthly)-1]
+		output.Warning = healthScoreDeclineWarning(from, to, output.ConsecutiveDeclines)
+	}
+
+	return output, nil
+}
+
+// GetHouseholdFinancialSummary は世帯のメンバーの財務状況を合算した財務健全性を返す
+// メンバーごとの財務健全性と現在の状況は財務サマリーレポートと同じ方法で計算する（スコアの推移は記録しない）
+func (uc *generateReportsUseCaseImpl) GetHouseholdFinancialSummary(
+	ctx context.Context,
+	input GetHouseholdSummaryInput,
+) (*HouseholdSummaryOutput, error) {
+	memberIDs := make([]entities.UserID, 0, len(input.MemberUserIDs))
+	seen := make(map[entities.UserID]bool, len(input.MemberUserIDs))
+	for _, memberID := range input.MemberUserIDs {
+		if !seen[memberID] {
+			seen[memberID] = true
+			memberIDs = append(memberIDs, memberID)
+		}
+	}
+	if !seen[input.RequestingUserID] {
+		return nil, ErrRequesterNotHouseholdMember
+	}
+	if len(memberIDs) > maxHouseholdMembers {
+		return nil, fmt.Errorf("世帯のメンバーは%d人まで指定できます", maxHouseholdMembers)
+	}
+
+	members := make([]HouseholdMemberSummary, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		plan, err := uc.financialPlanRepo.FindByUserID(ctx, memberID)
+		if err != nil {
+			return nil, fmt.Errorf("メンバー %s の財務計画の取得に失敗しました: %w", memberID, err)
+		}
+
+		financialHealth, currentSituation, err := uc.financialHealthFor(ctx, plan, memberID, false)
+		if err != nil {
+			return nil, fmt.Errorf("メンバー %s の%w", memberID, err)
+		}
+
+		members = append(members, HouseholdMemberSummary{
+			UserID:           memberID,
+			FinancialHealth:  *financialHealth,
+			CurrentSituation: *currentSituation,
+		})
+	}
+
+	situation, financialHealth := aggregateHouseholdFinancialHealth(members)
+	return &HouseholdSummaryOutput{
+		MemberUserIDs:    memberIDs,
+		FinancialHealth:  financialHealth,
+		CurrentSituation: situation,
+		Members:          members,
+		GeneratedAt:      time.Now().Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+// aggregateHouseholdFinancialHealth はメンバーの現在の状況を合算し、世帯の財務健全性を計算する
+// 比率は合計額から計算し直す（緊急資金・返済額はメンバーの比率から金額に戻して合算する）
+func aggregateHouseholdFinancialHealth(members []HouseholdMemberSummary) (HouseholdSituation, FinancialHealth) {
+	var situation HouseholdSituation
+	var emergencyFund, debtPayments, weightedReturn, totalReturn float64
+	for _, member := range members {
+		current := member.CurrentSituation
+		situation.NetMonthlyIncome += current.NetMonthlyIncome
+		situation.MonthlyExpenses += current.MonthlyExpenses
+		situation.NetSavings += current.NetSavings
+		situation.TotalAssets += current.TotalAssets
+		situation.TotalLiabilities += current.TotalLiabilities
+		situation.NetWorth += current.NetWorth
+		situation.MonthlyDebtPayments += current.MonthlyDebtPayments
+
+		emergencyFund += member.FinancialHealth.EmergencyFundRatio * current.MonthlyExpenses
+		debtPayments += member.FinancialHealth.DebtToIncomeRatio / 100 * current.NetMonthlyIncome
+		weightedReturn += current.InvestmentReturn * current.TotalAssets
+		totalReturn += current.InvestmentReturn
+	}
+
+	// 総資産がない場合は単純平均とする
+	if situation.TotalAssets > 0 {
+		situation.InvestmentReturn = weightedReturn / situation.TotalAssets
+	} else if len(members) > 0 {
+		situation.InvestmentReturn = totalReturn / float64(len(members))
 	}
 
-	return recommendations, warnings
+	var savingsRate, debtToIncomeRatio, emergencyFundRatio float64
+	if situation.NetMonthlyIncome > 0 {
+		savingsRate = situation.NetSavings / situation.NetMonthlyIncome * 100
+		debtToIncomeRatio = debtPayments / situation.NetMonthlyIncome * 100
+	}
+	if situation.MonthlyExpenses > 0 {
+		emergencyFundRatio = emergencyFund / situation.MonthlyExpenses
+	}
+
+	return situation, newFinancialHealth(savingsRate, debtToIncomeRatio, emergencyFundRatio, situation.InvestmentReturn)
+}
+
+// healthScoreDeclineWarning は from から to までのスコア低下の主因を特定して警告を生成する
+// 主因はスコアへの加点の減少幅が最も大きい指標とし、同じ場合は貯蓄率・緊急資金・利回りの順に優先する
+func healthScoreDeclineWarning(from, to *entities.HealthScoreSnapshot, declines int) *HealthScoreWarning {
+	causes := []struct {
+		cause  string
+		label  string
+		change string
+		drop   int
+	}{
+		{
+			cause:  HealthScoreCauseSavingsRateDecline,
+			label:  "貯蓄率の低下",
+			change: fmt.Sprintf("%.1f%%→%.1f%%", from.SavingsRate(), to.SavingsRate()),
+			drop:   savingsRateScore(from.SavingsRate()) - savingsRateScore(to.SavingsRate()),
+		},
+		{
+			cause:  HealthScoreCauseEmergencyFundDrawdown,
+			label:  "緊急資金の取り崩し",
+			change: fmt.Sprintf("%.1fヶ月分→%.1fヶ月分", from.EmergencyFundRatio(), to.EmergencyFundRatio()),
+			drop:   emergencyFundScore(from.EmergencyFundRatio()) - emergencyFundScore(to.EmergencyFundRatio()),
+		},
+		{
+			cause:  HealthScoreCauseInvestmentReturnDecline,
+			label:  "想定利回りの低下",
+			change: fmt.Sprintf("%.1f%%→%.1f%%", from.InvestmentReturn(), to.InvestmentReturn()),
+			drop:   investmentReturnScore(from.InvestmentReturn()) - investmentReturnScore(to.InvestmentReturn()),
+		},
+	}
+
+	primary := causes[0]
+	for _, candidate := range causes[1:] {
+		if candidate.drop > primary.drop {
+			primary = candidate
+		}
+	}
+
+	return &HealthScoreWarning{
+		Message: fmt.Sprintf("財務健全性スコアが%dヶ月連続で低下しています（%d→%d）。主な要因は%s（%s）です",
+			declines, from.Score(), to.Score(), primary.label, primary.change),
+		Cause: primary.cause,
+	}
 }
 
 // その他のヘルパーメソッドは簡略化のため省略
 // 実際の実装では以下のメソッドも必要：
-// - calculateProjectionSummary
 // - generateScenarioAnalysis
-// - generateProjectionInsights
 // - getGoalStatusText
 // - generateAchievements
 // - generateNextSteps
@@ -853,27 +1921,6 @@ func (uc *generateReportsUseCaseImpl) generateRecommendationsAndWarnings(plan *a
 // - generateExecutiveSummary
 // - generateActionPlan
 
-// calculateProjectionSummary は予測サマリーを計算する（簡略版）
-func (uc *generateReportsUseCaseImpl) calculateProjectionSummary(projections []entities.AssetProjection) (*ProjectionSummary, error) {
-	if len(projections) == 0 {
-		return &ProjectionSummary{}, nil
-	}
-
-	initialAmount := projections[0].TotalAssets.Amount()
-	finalAmount := projections[len(projections)-1].TotalAssets.Amount()
-	totalGrowth := finalAmount - initialAmount
-	growthPercentage := (totalGrowth / initialAmount) * 100
-	averageReturn := growthPercentage / float64(len(projections))
-
-	return &ProjectionSummary{
-		InitialAmount:    initialAmount,
-		FinalAmount:      finalAmount,
-		TotalGrowth:      totalGrowth,
-		GrowthPercentage: growthPercentage,
-		AverageReturn:    averageReturn,
-	}, nil
-}
-
 // generateScenarioAnalysis はシナリオ分析を生成する（簡略版）
 func (uc *generateReportsUseCaseImpl) generateScenarioAnalysis(plan *aggregates.FinancialPlan, years int) []ScenarioAnalysis {
 	// 楽観的、標準、悲観的シナリオを生成
@@ -901,32 +1948,83 @@ func (uc *generateReportsUseCaseImpl) generateScenarioAnalysis(plan *aggregates.
 		},
 	}
 
-	// 各シナリオの最終金額を計算（簡略化）
+	// 想定値が現実的な上限を超える場合は、上限に抑えた現実的シナリオを追加する
+	profile := plan.Profile()
+	if uc.guardrailService.IsHighReturn(profile.InvestmentReturn()) || uc.guardrailService.IsHighInflation(profile.InflationRate()) {
+		scenarios = append(scenarios, ScenarioAnalysis{
+			Name: "現実的シナリオ",
+			Description: fmt.Sprintf("想定利回りを年率%g%%、インフレ率を年率%g%%以下の現実的な範囲に抑えた場合",
+				uc.guardrailService.ReturnCeiling(), uc.guardrailService.InflationCeiling()),
+			InvestmentReturn: math.Min(profile.InvestmentReturn().AsPercentage(), uc.guardrailService.ReturnCeiling()),
+			InflationRate:    math.Min(profile.InflationRate().AsPercentage(), uc.guardrailService.InflationCeiling()),
+			Impact:           "標準シナリオとの差が、高い想定値に依存している資産額の目安です",
+		})
+	}
+
+	// 各シナリオの最終金額を計算
 	for i := range scenarios {
-		// 実際の計算ロジックを実装
-		scenarios[i].FinalAmount = 1000000 // プレースホルダー
-		scenarios[i].RealValue = 900000    // プレースホルダー
+		scenarios[i].FinalAmount, scenarios[i].RealValue = uc.projectScenario(profile, scenarios[i], years)
 	}
 
 	return scenarios
 }
 
-// generateProjectionInsights は予測洞察を生成する（簡略版）
-func (uc *generateReportsUseCaseImpl) generateProjectionInsights(projections []entities.AssetProjection, scenarios []ScenarioAnalysis) []string {
-	var insights []string
+// projectScenario はシナリオの利回り・インフレ率で資産推移を計算し、最終年の名目額と実質額を返す
+// 悲観的シナリオで利回りが負になる場合は0%として計算する。計算できない場合は0を返す
+func (uc *generateReportsUseCaseImpl) projectScenario(profile *entities.FinancialProfile, scenario ScenarioAnalysis, years int) (float64, float64) {
+	investmentReturn, err := valueobjects.NewRate(math.Max(scenario.InvestmentReturn, 0))
+	if err != nil {
+		return 0, 0
+	}
+	inflationRate, err := valueobjects.NewRate(math.Max(scenario.InflationRate, 0))
+	if err != nil {
+		return 0, 0
+	}
 
-	if len(projections) > 0 {
-		finalProjection := projections[len(projections)-1]
-		compoundEffect := finalProjection.InvestmentGains.Amount() / finalProjection.ContributedAmount.Amount() * 100
+	scenarioProfile, err := entities.NewFinancialProfile(
+		profile.UserID(),
+		profile.MonthlyIncome(),
+		profile.MonthlyExpenses(),
+		profile.CurrentSavings(),
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		return 0, 0
+	}
+	if err := scenarioProfile.SetIncomeType(profile.IncomeType(), profile.NetMonthlyIncome()); err != nil {
+		return 0, 0
+	}
+	scenarioProfile.SetLiabilities(profile.Liabilities())
+	scenarioProfile.SetLifeEvents(profile.LifeEvents())
 
-		if compoundEffect > 100 {
-			insights = append(insights, "複利効果により投資収益が元本を上回る見込みです")
-		}
+	projections, err := scenarioProfile.ProjectAssets(years)
+	if err != nil || len(projections) == 0 {
+		return 0, 0
+	}
 
-		insights = append(insights, "長期投資により安定した資産形成が期待できます")
+	final := projections[len(projections)-1]
+	return final.TotalAssets.Amount(), final.RealValue.Amount()
+}
+
+// assumptionDisclaimers は想定値が現実的な上限を超える場合の注意書きを返す
+func (uc *generateReportsUseCaseImpl) assumptionDisclaimers(profile *entities.FinancialProfile) []string {
+	var disclaimers []string
+
+	if uc.guardrailService.IsHighReturn(profile.InvestmentReturn()) {
+		disclaimers = append(disclaimers, fmt.Sprintf(
+			"この計画は年率%g%%という高い想定利回りに基づいています。現実的な上限（年率%g%%）を超えており、実際の資産額は大きく下回る可能性があります",
+			profile.InvestmentReturn().AsPercentage(), uc.guardrailService.ReturnCeiling(),
+		))
+	}
+	if uc.guardrailService.IsHighInflation(profile.InflationRate()) {
+		disclaimers = append(disclaimers, fmt.Sprintf(
+			"この計画は年率%g%%という高い想定インフレ率に基づいています。現実的な上限（年率%g%%）を超えており、実質的な資産価値が過小に評価されている可能性があります",
+			profile.InflationRate().AsPercentage(), uc.guardrailService.InflationCeiling(),
+		))
 	}
 
-	return insights
+	return disclaimers
 }
 
 // getGoalStatusText は目標の状態テキストを取得する（簡略版）
@@ -979,32 +2077,315 @@ func (uc *generateReportsUseCaseImpl) generateNextSteps(goalProgresses []GoalPro
 	return nextSteps
 }
 
-// generateRetirementProjections は退職予測を生成する（簡略版）
-func (uc *generateReportsUseCaseImpl) generateRetirementProjections(plan *aggregates.FinancialPlan, retirementData *entities.RetirementData) []RetirementProjection {
-	// 簡略化された実装
+// generateRetirementProjections は退職時点の予測を生成する
+// 月間不足額は退職時点までのインフレを反映した名目額で表す
+func (uc *generateReportsUseCaseImpl) generateRetirementProjections(
+	plan *aggregates.FinancialPlan,
+	retirementData *entities.RetirementData,
+	calculation *entities.RetirementCalculation,
+) []RetirementProjection {
+	yearsToRetirement := retirementData.CalculateYearsUntilRetirement()
+
+	monthlyShortfall := 0.0
+	if shortfall, err := retirementData.GetPensionShortfall(); err == nil {
+		monthlyShortfall = shortfall.Amount()
+	}
+
 	return []RetirementProjection{
 		{
-			Age:               65,
-			YearsToRetirement: 30,
-			ProjectedAssets:   50000000,
-			RequiredAssets:    60000000,
-			SufficiencyRate:   83.3,
-			MonthlyShortfall:  50000,
+			Age:               retirementData.RetirementAge(),
+			YearsToRetirement: yearsToRetirement,
+			ProjectedAssets:   calculation.ProjectedAmount.Amount(),
+			RequiredAssets:    calculation.RequiredAmount.Amount(),
+			SufficiencyRate:   calculation.SufficiencyRate.AsPercentage(),
+			MonthlyShortfall: uc.calculationService.FutureValueWithInflation(
+				monthlyShortfall,
+				plan.Profile().InflationRate().AsPercentage(),
+				yearsToRetirement,
+			),
 		},
 	}
 }
 
-// generateRetirementStrategies は退職戦略を生成する（簡略版）
-func (uc *generateReportsUseCaseImpl) generateRetirementStrategies(calculation *entities.RetirementCalculation, plan *aggregates.FinancialPlan) []RetirementStrategy {
-	return []RetirementStrategy{
-		{
-			Name:        "貯蓄額増加",
-			Description: "月間貯蓄額を増やして退職資金を確保する",
-			Impact:      100000,
-			Effort:      "medium",
-			Timeline:    "即座に開始可能",
+// retirementIncomeSources は退職後の月間収支の内訳を返す
+func retirementIncomeSources(retirementData *entities.RetirementData) (RetirementIncomeSources, error) {
+	benefits, err := retirementData.TotalMonthlyBenefits()
+	if err != nil {
+		return RetirementIncomeSources{}, err
+	}
+	shortfall, err := retirementData.GetPensionShortfall()
+	if err != nil {
+		return RetirementIncomeSources{}, err
+	}
+
+	return RetirementIncomeSources{
+		PensionAmount:              retirementData.PensionAmount().Amount(),
+		KokuminNenkinMonthlyAmount: retirementData.KokuminNenkinMonthlyAmount().Amount(),
+		TotalMonthlyBenefits:       benefits.Amount(),
+		MonthlyRetirementExpenses:  retirementData.MonthlyRetirementExpenses().Amount(),
+		NurseCareInsuranceMonthly:  retirementData.NurseCareInsuranceMonthly().Amount(),
+		MonthlyShortfall:           shortfall.Amount(),
+	}, nil
+}
+
+// calculateRetirementAfterTax は退職後の税・社会保険料を差し引いた手取りベースで老後資金を再計算する
+// 必要資金は手取りの公的給付で賄えない月額から求め、取り崩し額にかかる税の分だけ割り増す
+// 取り崩し額のうち運用益の割合は、退職時点の予想資産額に占める運用益（現在の貯蓄と積立額の合計を超える分）の割合とみなす
+func (uc *generateReportsUseCaseImpl) calculateRetirementAfterTax(
+	plan *aggregates.FinancialPlan,
+	retirementData *entities.RetirementData,
+	calculation *entities.RetirementCalculation,
+	currentSavings valueobjects.Money,
+	netSavings valueobjects.Money,
+) (*RetirementAfterTax, error) {
+	grossBenefits, err := retirementData.TotalMonthlyBenefits()
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := uc.taxService.EstimateRetirementAnnual(grossBenefits.Amount()*12, retirementData.NurseCareInsuranceMonthly().Amount()*12)
+	if err != nil {
+		return nil, err
+	}
+	netBenefits, err := valueobjects.NewMoneyJPY(estimate.MonthlyNetPension)
+	if err != nil {
+		return nil, err
+	}
+	requiredBeforeDrawdownTax, err := retirementData.CalculateRequiredRetirementFundWithBenefits(netBenefits, plan.Profile().InflationRate())
+	if err != nil {
+		return nil, err
+	}
+
+	projected := calculation.ProjectedAmount.Amount()
+	principal := currentSavings.Amount() + netSavings.Amount()*float64(retirementData.CalculateYearsUntilRetirement()*12)
+	gainRatio := 0.0
+	if projected > 0 {
+		gainRatio = math.Max(projected-principal, 0) / projected
+	}
+	drawdownTaxRate := uc.taxService.DrawdownTaxRate(gainRatio)
+	required := math.Round(requiredBeforeDrawdownTax.Amount() / (1 - drawdownTaxRate))
+
+	sufficiencyRate := 100.0
+	if required > 0 {
+		sufficiencyRate = math.Min(projected/required*100, 100)
+	}
+	monthlyExpenses := retirementData.MonthlyRetirementExpenses().Amount() + retirementData.NurseCareInsuranceMonthly().Amount()
+	table := uc.taxService.Table()
+
+	return &RetirementAfterTax{
+		GrossMonthlyBenefits: grossBenefits.Amount(),
+		NetMonthlyBenefits:   netBenefits.Amount(),
+		MonthlyShortfall:     math.Max(monthlyExpenses-netBenefits.Amount(), 0),
+		GrossRequiredAmount:  calculation.RequiredAmount.Amount(),
+		RequiredAmount:       required,
+		ProjectedAmount:      projected,
+		Shortfall:            math.Max(required-projected, 0),
+		SufficiencyRate:      sufficiencyRate,
+		CanCoverExpenses:     projected >= required,
+		TaxEstimate:          estimate,
+		TaxRates: RetirementTaxRates{
+			TableVersion:        table.Version,
+			HealthInsuranceRate: table.RetireeHealthInsuranceRate * 100,
+			ResidentTaxRate:     table.ResidentTaxRate * 100,
+			CapitalGainsTaxRate: table.CapitalGainsTaxRate * 100,
+			DrawdownTaxRate:     drawdownTaxRate * 100,
 		},
+	}, nil
+}
+
+// retirementLifestyleComparisons は生活水準ごとの計算結果をレポート用の比較に変換する
+func retirementLifestyleComparisons(scenarios []entities.LifestyleRetirementCalculation, netSavings valueobjects.Money) []RetirementLifestyleComparison {
+	comparisons := make([]RetirementLifestyleComparison, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		calculation := scenario.Calculation
+		additional := math.Max(calculation.RecommendedMonthlySavings.Amount()-netSavings.Amount(), 0)
+
+		summary := fmt.Sprintf("%sには月%.1f万円の生活費が必要で、老後資金は%.0f万円必要です（充足率%.0f%%）",
+			scenario.Label, scenario.MonthlyExpenses.Amount()/10000, calculation.RequiredAmount.Amount()/10000, calculation.SufficiencyRate.AsPercentage())
+		if additional > 0 {
+			summary += fmt.Sprintf("。実現するには月%.1f万円の貯蓄の上乗せが必要です", additional/10000)
+		}
+
+		comparisons = append(comparisons, RetirementLifestyleComparison{
+			Lifestyle:                scenario.Lifestyle,
+			Label:                    scenario.Label,
+			MonthlyExpenses:          scenario.MonthlyExpenses.Amount(),
+			IsCustomized:             scenario.IsCustomized,
+			RequiredAmount:           calculation.RequiredAmount.Amount(),
+			ProjectedAmount:          calculation.ProjectedAmount.Amount(),
+			SufficiencyRate:          calculation.SufficiencyRate.AsPercentage(),
+			AdditionalMonthlySavings: additional,
+			Summary:                  summary,
+		})
 	}
+	return comparisons
+}
+
+// retirementStrategyMinImpact は退職戦略として提示する充足率の改善幅の下限（ポイント）
+const retirementStrategyMinImpact = 2.0
+
+// retirementStrategyEffortWeights は実施負担ごとの重み
+// 充足率の改善幅をこの重みで割った値を負担あたりの効果として並び替えに使う
+var retirementStrategyEffortWeights = map[string]float64{
+	"low":    1,
+	"medium": 2,
+	"high":   3,
+}
+
+// retirementStrategyCandidate は試算対象の退職戦略
+type retirementStrategyCandidate struct {
+	name        string
+	description string
+	effort      string
+	timeline    string
+	calculate   func() (*entities.RetirementCalculation, error)
+}
+
+// generateRetirementStrategies は退職戦略を生成する
+// 月間貯蓄額の増加・退職の延期・退職後支出の削減・運用利回りの改善をそれぞれ試算し、
+// 充足率が十分に改善する戦略を負担あたりの効果が大きい順に返す
+func (uc *generateReportsUseCaseImpl) generateRetirementStrategies(calculation *entities.RetirementCalculation, plan *aggregates.FinancialPlan) []RetirementStrategy {
+	strategies := []RetirementStrategy{}
+
+	retirementData := plan.RetirementData()
+	if calculation == nil || retirementData == nil {
+		return strategies
+	}
+
+	profile := plan.Profile()
+	currentSavings, err := profile.CurrentSavings().Total()
+	if err != nil {
+		return strategies
+	}
+	netSavings, err := profile.CalculateNetSavings()
+	if err != nil {
+		return strategies
+	}
+	investmentReturn := profile.InvestmentReturn()
+	inflationRate := profile.InflationRate()
+
+	// 退職データの一部を変更した場合の充足度を計算する
+	calculateWith := func(retirementAge int, monthlyExpenses valueobjects.Money) (*entities.RetirementCalculation, error) {
+		variant, err := entities.NewRetirementData(
+			retirementData.UserID(),
+			retirementData.CurrentAge(),
+			retirementAge,
+			retirementData.LifeExpectancy(),
+			monthlyExpenses,
+			retirementData.PensionAmount(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if err := variant.RestorePublicBenefits(retirementData.KokuminNenkinMonthlyAmount(), retirementData.NurseCareInsuranceMonthly()); err != nil {
+			return nil, err
+		}
+		return variant.CalculateRetirementSufficiency(currentSavings, netSavings, investmentReturn, inflationRate)
+	}
+
+	var candidates []retirementStrategyCandidate
+
+	// (a) 月間貯蓄額を1万〜3万円増やす
+	for _, increase := range []float64{10000, 20000, 30000} {
+		candidates = append(candidates, retirementStrategyCandidate{
+			name:        fmt.Sprintf("月間貯蓄額を%.0f万円増やす", increase/10000),
+			description: fmt.Sprintf("毎月の貯蓄額を%.0f円増やして退職資金を積み増す", increase),
+			effort:      "medium",
+			timeline:    "即座に開始可能",
+			calculate: func() (*entities.RetirementCalculation, error) {
+				additional, err := valueobjects.NewMoneyJPY(increase)
+				if err != nil {
+					return nil, err
+				}
+				monthlySavings, err := netSavings.Add(additional)
+				if err != nil {
+					return nil, err
+				}
+				return retirementData.CalculateRetirementSufficiency(currentSavings, monthlySavings, investmentReturn, inflationRate)
+			},
+		})
+	}
+
+	// (b) 退職を1〜5年遅らせる
+	for years := 1; years <= 5; years++ {
+		retirementAge := retirementData.RetirementAge() + years
+		if retirementAge > 100 || retirementAge > retirementData.LifeExpectancy() {
+			break
+		}
+		candidates = append(candidates, retirementStrategyCandidate{
+			name:        fmt.Sprintf("退職を%d年遅らせる", years),
+			description: fmt.Sprintf("退職年齢を%d歳から%d歳に延ばし、積立期間を長く・取り崩し期間を短くする", retirementData.RetirementAge(), retirementAge),
+			effort:      "high",
+			timeline:    "退職時期の見直し時",
+			calculate: func() (*entities.RetirementCalculation, error) {
+				return calculateWith(retirementAge, retirementData.MonthlyRetirementExpenses())
+			},
+		})
+	}
+
+	// (c) 退職後の支出を5〜15%削減する
+	for _, reduction := range []float64{5, 10, 15} {
+		reducedExpenses, err := retirementData.MonthlyRetirementExpenses().MultiplyByFloat(1 - reduction/100)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, retirementStrategyCandidate{
+			name:        fmt.Sprintf("退職後の支出を%.0f%%削減する", reduction),
+			description: fmt.Sprintf("退職後の月間支出を%.0f円から%.0f円に抑える", retirementData.MonthlyRetirementExpenses().Amount(), reducedExpenses.Amount()),
+			effort:      "high",
+			timeline:    "退職前に生活水準を見直す",
+			calculate: func() (*entities.RetirementCalculation, error) {
+				return calculateWith(retirementData.RetirementAge(), reducedExpenses)
+			},
+		})
+	}
+
+	// (d) 運用利回りを0.5〜1.0ポイント改善する
+	for _, improvement := range []float64{0.5, 1.0} {
+		improvedReturn, err := valueobjects.NewRate(investmentReturn.AsPercentage() + improvement)
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, retirementStrategyCandidate{
+			name:        fmt.Sprintf("運用利回りを%.1fポイント改善する", improvement),
+			description: fmt.Sprintf("資産配分を見直し、想定利回りを%.1f%%から%.1f%%に引き上げる", investmentReturn.AsPercentage(), improvedReturn.AsPercentage()),
+			effort:      "low",
+			timeline:    "6ヶ月以内",
+			calculate: func() (*entities.RetirementCalculation, error) {
+				return retirementData.CalculateRetirementSufficiency(currentSavings, netSavings, improvedReturn, inflationRate)
+			},
+		})
+	}
+
+	currentSufficiency := calculation.SufficiencyRate.AsPercentage()
+	for _, candidate := range candidates {
+		result, err := candidate.calculate()
+		if err != nil {
+			continue
+		}
+
+		// 充足率がほとんど変わらない戦略は提示しない
+		impact := result.SufficiencyRate.AsPercentage() - currentSufficiency
+		if impact <= retirementStrategyMinImpact {
+			continue
+		}
+
+		strategies = append(strategies, RetirementStrategy{
+			Name:               candidate.name,
+			Description:        candidate.description,
+			Impact:             impact,
+			Effort:             candidate.effort,
+			Timeline:           candidate.timeline,
+			NewSufficiencyRate: result.SufficiencyRate.AsPercentage(),
+			ShortfallReduction: calculation.Shortfall.Amount() - result.Shortfall.Amount(),
+		})
+	}
+
+	sort.SliceStable(strategies, func(i, j int) bool {
+		return strategies[i].Impact/retirementStrategyEffortWeights[strategies[i].Effort] >
+			strategies[j].Impact/retirementStrategyEffortWeights[strategies[j].Effort]
+	})
+
+	return strategies
 }
 
 // generateRetirementRecommendations は退職推奨事項を生成する（簡略版）
@@ -1035,6 +2416,7 @@ func (uc *generateReportsUseCaseImpl) assessRetirementRisks(plan *aggregates.Fin
 }
 
 // generateExecutiveSummary はエグゼクティブサマリーを生成する（簡略版）
+// 生成に失敗した任意セクションや、生成対象として指定されていない任意セクションは nil で渡される
 func (uc *generateReportsUseCaseImpl) generateExecutiveSummary(
 	financialSummary *FinancialSummaryReport,
 	assetProjection *AssetProjectionReport,
@@ -1047,6 +2429,19 @@ func (uc *generateReportsUseCaseImpl) generateExecutiveSummary(
 		CriticalActions:      []string{"緊急資金の確保"},
 		OpportunityAreas:     []string{"投資利回りの改善"},
 		FinancialHealthScore: financialSummary.FinancialHealth.OverallScore,
+		ConfidenceNote:       stalenessConfidenceNote(financialSummary.DataStaleness),
+	}
+}
+
+// stalenessConfidenceNote は財務データの鮮度に応じた結果の信頼性の注記を返す（fresh の場合は空）
+func stalenessConfidenceNote(staleness aggregates.DataStaleness) string {
+	switch staleness.Level {
+	case aggregates.StalenessStale:
+		return fmt.Sprintf("財務データが%dヶ月更新されていないため、このレポートの信頼性は低下しています。収入・支出・貯蓄が現在の状況と合っているか見直してください", staleness.MonthsSinceReview)
+	case aggregates.StalenessAging:
+		return fmt.Sprintf("財務データが%dヶ月更新されていません。現在の状況と異なる場合はレポートの精度が下がります", staleness.MonthsSinceReview)
+	default:
+		return ""
 	}
 }
 
@@ -1091,108 +2486,40 @@ func (uc *generateReportsUseCaseImpl) generateActionPlan(
 }
 
 // ExportReportToPDF はレポートをPDF/CSV形式でエクスポートする
-// ReportTypeに応じてDBからデータを取得してレポートを生成し、指定フォーマットで保存する
+// ReportTypeに応じてDBからデータを取得してレポートを生成し、Formatに登録された変換処理でファイル化して保存する
+// 存在しないレポートタイプ・ファイル形式や、対応していない組み合わせの場合は ErrInvalidReportType などを返す
 func (uc *generateReportsUseCaseImpl) ExportReportToPDF(
 	ctx context.Context,
 	input ExportReportInput,
 ) (*ExportReportOutput, error) {
-	if uc.fileStorage == nil {
-		return nil, fmt.Errorf("ファイルストレージが設定されていません")
-	}
-
-	// CSVフォーマットの場合は専用処理
-	if input.Format == "csv" {
-		return uc.exportAsCSV(ctx, input)
-	}
-
-	// PDF/その他フォーマット: DBからレポートデータを生成してPDF化
-	if uc.pdfGenerator == nil {
-		return nil, fmt.Errorf("PDFジェネレーターが設定されていません")
-	}
-
-	var pdfContent []byte
-	var err error
-
-	switch input.ReportType {
-	case "financial_summary":
-		output, genErr := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: input.UserID})
-		if genErr != nil {
-			return nil, fmt.Errorf("財務サマリーレポートの生成に失敗しました: %w", genErr)
-		}
-		pdfContent, err = uc.pdfGenerator.Generate(input.ReportType, output.Report)
-	case "asset_projection":
-		output, genErr := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{UserID: input.UserID, Years: 10})
-		if genErr != nil {
-			return nil, fmt.Errorf("資産推移レポートの生成に失敗しました: %w", genErr)
-		}
-		pdfContent, err = uc.pdfGenerator.Generate(input.ReportType, output.Report)
-	case "goals_progress":
-		output, genErr := uc.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{UserID: input.UserID})
-		if genErr != nil {
-			return nil, fmt.Errorf("目標進捗レポートの生成に失敗しました: %w", genErr)
-		}
-		pdfContent, err = uc.pdfGenerator.Generate(input.ReportType, output.Report)
-	case "retirement_plan":
-		output, genErr := uc.GenerateRetirementPlanReport(ctx, RetirementPlanReportInput{UserID: input.UserID})
-		if genErr != nil {
-			return nil, fmt.Errorf("退職計画レポートの生成に失敗しました: %w", genErr)
-		}
-		pdfContent, err = uc.pdfGenerator.Generate(input.ReportType, output.Report)
-	case "comprehensive":
-		output, genErr := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{UserID: input.UserID, Years: 10})
-		if genErr != nil {
-			return nil, fmt.Errorf("包括的レポートの生成に失敗しました: %w", genErr)
-		}
-		pdfContent, err = uc.pdfGenerator.Generate(input.ReportType, output.Report)
-	default:
-		return nil, fmt.Errorf("サポートされていないレポートタイプです: %s", input.ReportType)
-	}
-
+	generate, renderer, err := uc.exportRegistry.Lookup(input.ReportType, input.Format)
 	if err != nil {
-		return nil, fmt.Errorf("PDFの生成に失敗しました: %w", err)
-	}
-
-	fileName := fmt.Sprintf("%s_%s_%s.pdf", string(input.UserID), input.ReportType, time.Now().Format("20060102_150405"))
-	fileSize := int64(len(pdfContent))
-
-	token, expiresAt, err := uc.fileStorage.SaveFile(fileName, pdfContent)
-	if err != nil {
-		return nil, fmt.Errorf("ファイルの保存に失敗しました: %w", err)
+		return nil, err
 	}
 
-	return &ExportReportOutput{
-		FileName:      fileName,
-		FileSize:      fileSize,
-		DownloadToken: token,
-		ExpiresAt:     expiresAt.Format(time.RFC3339),
-	}, nil
-}
-
-// exportAsCSV はCSVフォーマットでレポートをエクスポートする（financial_summaryのみ対応）
-func (uc *generateReportsUseCaseImpl) exportAsCSV(ctx context.Context, input ExportReportInput) (*ExportReportOutput, error) {
-	if input.ReportType != "financial_summary" {
-		return nil, fmt.Errorf("CSVエクスポートは financial_summary のみ対応しています（got: %s）", input.ReportType)
+	if uc.fileStorage == nil {
+		return nil, fmt.Errorf("ファイルストレージが設定されていません")
 	}
 
-	output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: input.UserID})
+	reportData, err := generate(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("財務サマリーレポートの生成に失敗しました: %w", err)
+		return nil, fmt.Errorf("%sの生成に失敗しました: %w", input.ReportType, err)
 	}
 
-	csvData, err := GenerateFinancialSummaryCSVData(output.Report)
+	content, err := renderer.Render(input.ReportType, reportData)
 	if err != nil {
-		return nil, fmt.Errorf("CSVの生成に失敗しました: %w", err)
+		return nil, fmt.Errorf("%sの生成に失敗しました: %w", input.Format, err)
 	}
 
-	fileName := fmt.Sprintf("%s_%s_%s.csv", string(input.UserID), input.ReportType, time.Now().Format("20060102_150405"))
-	token, expiresAt, err := uc.fileStorage.SaveFile(fileName, csvData)
+	fileName := fmt.Sprintf("%s_%s_%s.%s", string(input.UserID), string(input.ReportType), time.Now().Format("20060102_150405"), renderer.Extension)
+	token, expiresAt, err := uc.fileStorage.SaveFile(fileName, content)
 	if err != nil {
 		return nil, fmt.Errorf("ファイルの保存に失敗しました: %w", err)
 	}
 
 	return &ExportReportOutput{
 		FileName:      fileName,
-		FileSize:      int64(len(csvData)),
+		FileSize:      int64(len(content)),
 		DownloadToken: token,
 		ExpiresAt:     expiresAt.Format(time.RFC3339),
 	}, nil
@@ -1216,6 +2543,8 @@ func GenerateFinancialSummaryCSVData(report FinancialSummaryReport) ([]byte, err
 	_ = w.Write([]string{"現在の状況", "月間支出", strconv.FormatFloat(report.CurrentSituation.MonthlyExpenses, 'f', 0, 64), "円"})
 	_ = w.Write([]string{"現在の状況", "純貯蓄", strconv.FormatFloat(report.CurrentSituation.NetSavings, 'f', 0, 64), "円"})
 	_ = w.Write([]string{"現在の状況", "総資産", strconv.FormatFloat(report.CurrentSituation.TotalAssets, 'f', 0, 64), "円"})
+	_ = w.Write([]string{"現在の状況", "負債残高", strconv.FormatFloat(report.CurrentSituation.TotalLiabilities, 'f', 0, 64), "円"})
+	_ = w.Write([]string{"現在の状況", "純資産", strconv.FormatFloat(report.CurrentSituation.NetWorth, 'f', 0, 64), "円"})
 	_ = w.Write([]string{"現在の状況", "投資利回り", strconv.FormatFloat(report.CurrentSituation.InvestmentReturn, 'f', 2, 64), "%"})
 	_ = w.Write([]string{"現在の状況", "インフレ率", strconv.FormatFloat(report.CurrentSituation.InflationRate, 'f', 2, 64), "%"})
 
@@ -1223,6 +2552,17 @@ func GenerateFinancialSummaryCSVData(report FinancialSummaryReport) ([]byte, err
 		_ = w.Write([]string{"主要指標", m.Name, strconv.FormatFloat(m.Value, 'f', 2, 64), m.Unit})
 	}
 
+	assumptions := report.Assumptions
+	_ = w.Write([]string{"計算の前提", "投資利回り", strconv.FormatFloat(assumptions.InvestmentReturn, 'f', 2, 64), "%"})
+	_ = w.Write([]string{"計算の前提", "インフレ率", strconv.FormatFloat(assumptions.InflationRate, 'f', 2, 64), "%"})
+	_ = w.Write([]string{"計算の前提", "手取り月収", strconv.FormatFloat(assumptions.MonthlyIncome, 'f', 0, 64), "円"})
+	_ = w.Write([]string{"計算の前提", "月間支出合計", strconv.FormatFloat(assumptions.MonthlyExpensesTotal, 'f', 0, 64), "円"})
+	_ = w.Write([]string{"計算の前提", "月間純貯蓄額", strconv.FormatFloat(assumptions.NetSavings, 'f', 0, 64), "円"})
+	_ = w.Write([]string{"計算の前提", "複利頻度", assumptions.CompoundingFrequency, ""})
+	_ = w.Write([]string{"計算の前提", "税・社会保険料の考慮", strconv.FormatBool(assumptions.TaxConsidered), ""})
+	_ = w.Write([]string{"計算の前提", "計算バージョン", strconv.Itoa(assumptions.CalculationVersion), ""})
+	_ = w.Write([]string{"計算の前提", "計算日時", assumptions.CalculatedAt.Format(time.RFC3339), ""})
+
 	w.Flush()
 	if err := w.Error(); err != nil {
 		return nil, err
@@ -2,16 +2,21 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,10 +27,10 @@ import (
 // mockReportPDFGenerator は ReportPDFGenerator インターフェースのモック
 // 実装時に usecases パッケージ内で定義される ReportPDFGenerator インターフェースに対応する
 type mockReportPDFGenerator struct {
-	generateFunc func(reportType string, reportData interface{}) ([]byte, error)
+	generateFunc func(reportType ReportType, reportData interface{}) ([]byte, error)
 }
 
-func (m *mockReportPDFGenerator) Generate(reportType string, reportData interface{}) ([]byte, error) {
+func (m *mockReportPDFGenerator) Generate(reportType ReportType, reportData interface{}) ([]byte, error) {
 	if m.generateFunc != nil {
 		return m.generateFunc(reportType, reportData)
 	}
@@ -90,9 +95,42 @@ func TestGenerateReportsUseCase_GenerateFinancialSummaryReport(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, output)
 		assert.NotEmpty(t, output.GeneratedAt)
+		assert.Equal(t, []AssetAllocation{
+			{Type: "deposit", Label: "預金", Amount: 1000000, Percentage: 100},
+		}, output.Report.AssetAllocation)
 		mockPlanRepo.AssertExpectations(t)
 	})
 
+	t.Run("正常系: 額面入力の場合は年間税負担を主要指標に含め、手取りで貯蓄率を計算する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		taxService := services.NewDefaultTaxEstimationService()
+		require.NoError(t, taxService.ApplyIncomeType(plan.Profile(), entities.IncomeTypeGross))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		estimate, err := taxService.EstimateFromMonthlyGross(plan.Profile().MonthlyIncome())
+		require.NoError(t, err)
+
+		metrics := make(map[string]KeyMetric)
+		for _, metric := range output.Report.KeyMetrics {
+			metrics[metric.Name] = metric
+		}
+		require.Contains(t, metrics, "年間税負担")
+		assert.Equal(t, estimate.TotalBurden, metrics["年間税負担"].Value)
+
+		netIncome := plan.Profile().NetMonthlyIncome().Amount()
+		assert.InDelta(t, (netIncome-180000)/netIncome*100, metrics["貯蓄率"].Value, 0.0001)
+		assert.Equal(t, "gross", output.Report.CurrentSituation.IncomeType)
+		assert.Equal(t, netIncome, output.Report.CurrentSituation.NetMonthlyIncome)
+	})
+
 	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo := new(MockGoalRepository)
@@ -107,6 +145,49 @@ func TestGenerateReportsUseCase_GenerateFinancialSummaryReport(t *testing.T) {
 		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
 		mockPlanRepo.AssertExpectations(t)
 	})
+
+	t.Run("正常系: 却下した警告はレポートに含まれない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockDismissalRepo := new(MockRecommendationDismissalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlanWithEmergencyFundData("user-001"), nil)
+		mockDismissalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.RecommendationDismissal{
+			entities.ReconstructRecommendationDismissal("user-001", newEmergencyFundShortfallWarningID(), 40, time.Now().AddDate(0, 0, -1), nil),
+		}, nil)
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, nil, nil, nil, mockDismissalRepo, nil, nil, nil)
+		output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		assert.NotContains(t, output.Report.Warnings, "緊急資金が3ヶ月分の生活費を下回っています")
+		assert.Contains(t, output.Report.Recommendations, "緊急資金として3-6ヶ月分の生活費を確保してください")
+		for _, item := range output.Report.RecommendationItems {
+			assert.NotEqual(t, newEmergencyFundShortfallWarningID(), item.ID)
+		}
+		mockDismissalRepo.AssertExpectations(t)
+	})
+}
+
+func TestAssetAllocation(t *testing.T) {
+	t.Run("正常系: 貯蓄を種類ごとに集計し、預金・投資・その他の順に並べる", func(t *testing.T) {
+		allocation := assetAllocation(entities.SavingsCollection{
+			{Type: "investment", Amount: mustNewMoney(500000)},
+			{Type: "deposit", Amount: mustNewMoney(1000000)},
+			{Type: "investment", Amount: mustNewMoney(500000)},
+		})
+
+		assert.Equal(t, []AssetAllocation{
+			{Type: "deposit", Label: "預金", Amount: 1000000, Percentage: 50},
+			{Type: "investment", Label: "投資", Amount: 1000000, Percentage: 50},
+		}, allocation)
+	})
+
+	t.Run("正常系: 貯蓄がない場合は空", func(t *testing.T) {
+		assert.Empty(t, assetAllocation(entities.SavingsCollection{}))
+		assert.Empty(t, assetAllocation(entities.SavingsCollection{{Type: "deposit", Amount: mustNewMoney(0)}}))
+	})
 }
 
 // ===========================
@@ -149,6 +230,64 @@ func TestGenerateReportsUseCase_GenerateAssetProjectionReport(t *testing.T) {
 		require.Error(t, err)
 		mockPlanRepo.AssertExpectations(t)
 	})
+
+	t.Run("正常系: 高い想定利回りの計画には注意書きと現実的シナリオが含まれる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		investmentReturn, _ := valueobjects.NewRate(20.0)
+		inflationRate, _ := valueobjects.NewRate(2.0)
+		profile, err := entities.NewFinancialProfile(
+			"user-001",
+			mustNewMoney(400000),
+			entities.ExpenseCollection{{Category: "住居費", Amount: mustNewMoney(120000)}},
+			entities.SavingsCollection{{Type: "deposit", Amount: mustNewMoney(1000000)}},
+			investmentReturn,
+			inflationRate,
+		)
+		require.NoError(t, err)
+		plan, err := aggregates.NewFinancialPlan(profile)
+		require.NoError(t, err)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Report.Disclaimers, 1)
+		assert.Contains(t, output.Report.Disclaimers[0], "この計画は年率20%という高い想定利回りに基づいています")
+
+		var realistic *ScenarioAnalysis
+		for i := range output.Report.Scenarios {
+			if output.Report.Scenarios[i].Name == "現実的シナリオ" {
+				realistic = &output.Report.Scenarios[i]
+			}
+		}
+		require.NotNil(t, realistic)
+		assert.Equal(t, services.DefaultRealisticReturnCeiling, realistic.InvestmentReturn)
+		assert.Greater(t, realistic.FinalAmount, 0.0)
+	})
+
+	t.Run("正常系: 上限以内の想定値では注意書きを含まない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Report.Disclaimers)
+		for _, scenario := range output.Report.Scenarios {
+			assert.NotEqual(t, "現実的シナリオ", scenario.Name)
+		}
+	})
 }
 
 // ===========================
@@ -163,9 +302,8 @@ func TestGenerateReportsUseCase_GenerateGoalsProgressReport(t *testing.T) {
 	t.Run("正常系: 目標進捗レポートを生成できる", func(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo := new(MockGoalRepository)
-		plan := newTestFinancialPlan("user-001")
 		goal := newTestGoal("user-001", "goal-001")
-		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{goal}, nil)
+		plan := newTestFinancialPlanWithGoal("user-001", goal)
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 
 		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
@@ -174,15 +312,57 @@ func TestGenerateReportsUseCase_GenerateGoalsProgressReport(t *testing.T) {
 		})
 
 		require.NoError(t, err)
-		assert.NotNil(t, output)
-		mockGoalRepo.AssertExpectations(t)
+		require.Len(t, output.Report.Goals, 1)
+		assert.Same(t, goal, output.Report.Goals[0].Goal)
+		// 目標は財務計画と一緒に読み込まれるため、目標リポジトリは参照しない
+		mockGoalRepo.AssertNotCalled(t, "FindByUserID", mock_anything(), mock_anything())
 		mockPlanRepo.AssertExpectations(t)
 	})
 
+	t.Run("正常系: 1件の目標の推奨事項の生成に失敗しても、すべての目標を含めて生成する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		goals := []*entities.Goal{
+			newTestGoal("user-001", "goal-001"),
+			newTestGoal("user-001", "goal-002"),
+			newTestGoal("user-001", "goal-003"),
+		}
+		plan.RestoreGoals(goals)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService).(*generateReportsUseCaseImpl)
+		uc.suggestAdjustments = func(goal *entities.Goal, profile *entities.FinancialProfile) ([]services.GoalRecommendation, error) {
+			if goal == goals[1] {
+				return nil, errors.New("月間拠出額が0のため推奨事項を計算できません")
+			}
+			return []services.GoalRecommendation{{Description: "月間拠出額を見直してください"}}, nil
+		}
+
+		output, err := uc.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Report.Goals, 3)
+		assert.Equal(t, 3, output.Report.Summary.TotalGoals)
+
+		failed := output.Report.Goals[1]
+		assert.Same(t, goals[1], failed.Goal)
+		assert.NotNil(t, failed.Recommendations)
+		assert.Empty(t, failed.Recommendations)
+		assert.NotEmpty(t, failed.RecommendationError)
+
+		for _, i := range []int{0, 2} {
+			assert.Equal(t, []string{"月間拠出額を見直してください"}, output.Report.Goals[i].Recommendations)
+			assert.Empty(t, output.Report.Goals[i].RecommendationError)
+		}
+	})
+
 	t.Run("異常系: FindByUserIDのエラーを伝播する", func(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo := new(MockGoalRepository)
-		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("db error"))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("db error"))
 
 		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
 		_, err := uc.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{
@@ -190,7 +370,7 @@ func TestGenerateReportsUseCase_GenerateGoalsProgressReport(t *testing.T) {
 		})
 
 		require.Error(t, err)
-		mockGoalRepo.AssertExpectations(t)
+		mockPlanRepo.AssertExpectations(t)
 	})
 }
 // ===========================
@@ -216,6 +396,90 @@ func TestGenerateReportsUseCase_GenerateRetirementPlanReport(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, output)
 		assert.NotEmpty(t, output.GeneratedAt)
+
+		// 退職時点（65歳、25年後）の月間不足額 120,000円 を年2%のインフレで名目額に換算
+		require.Len(t, output.Report.Projections, 1)
+		projection := output.Report.Projections[0]
+		assert.Equal(t, 65, projection.Age)
+		assert.Equal(t, 25, projection.YearsToRetirement)
+		assert.InDelta(t, 120000*math.Pow(1.02, 25), projection.MonthlyShortfall, 0.01)
+		assert.Equal(t, output.Report.Calculation.RequiredAmount.Amount(), projection.RequiredAssets)
+
+		// 収入源の内訳（国民年金・介護保険料は未入力）
+		assert.Equal(t, RetirementIncomeSources{
+			PensionAmount:             80000,
+			TotalMonthlyBenefits:      80000,
+			MonthlyRetirementExpenses: 200000,
+			MonthlyShortfall:          120000,
+		}, output.Report.IncomeSources)
+
+		// 生活水準ごとの比較（目安の月間生活費）
+		require.Len(t, output.Report.Lifestyles, 3)
+		comfortable := output.Report.Lifestyles[0]
+		assert.Equal(t, entities.RetirementLifestyleComfortable, comfortable.Lifestyle)
+		assert.Equal(t, 379000.0, comfortable.MonthlyExpenses)
+		assert.Contains(t, comfortable.Summary, "ゆとりある生活には月37.9万円の生活費が必要")
+		assert.Greater(t, comfortable.RequiredAmount, output.Report.Lifestyles[1].RequiredAmount)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 年金にかかる税・保険料を差し引いた手取りベースで必要資金を再計算する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlanWithRetirementData("user-001")
+		require.NoError(t, plan.RetirementData().UpdatePensionAmount(mustNewMoney(250000)))
+		require.NoError(t, plan.RetirementData().UpdateMonthlyRetirementExpenses(mustNewMoney(300000)))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateRetirementPlanReport(ctx, RetirementPlanReportInput{
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		afterTax := output.Report.AfterTax
+		// 年金 年300万円 → 健康保険料・所得税・住民税を差し引いて月222,769円
+		assert.Equal(t, 250000.0, afterTax.GrossMonthlyBenefits)
+		assert.Equal(t, 222769.0, afterTax.NetMonthlyBenefits)
+		assert.Equal(t, 77231.0, afterTax.MonthlyShortfall)
+		require.NotNil(t, afterTax.TaxEstimate)
+		assert.Equal(t, 326771.0, afterTax.TaxEstimate.TotalBurden)
+
+		// 額面と手取りの両方を示し、手取りベースの必要資金は額面ベースより多い
+		assert.Equal(t, output.Report.Calculation.RequiredAmount.Amount(), afterTax.GrossRequiredAmount)
+		assert.Greater(t, afterTax.RequiredAmount, afterTax.GrossRequiredAmount)
+		assert.Equal(t, afterTax.ProjectedAmount >= afterTax.RequiredAmount, afterTax.CanCoverExpenses)
+
+		// 前提の税率を明示する
+		assert.Equal(t, "R6", afterTax.TaxRates.TableVersion)
+		assert.Equal(t, 9.0, afterTax.TaxRates.HealthInsuranceRate)
+		assert.InDelta(t, 20.315, afterTax.TaxRates.CapitalGainsTaxRate, 0.0001)
+		assert.Greater(t, afterTax.TaxRates.DrawdownTaxRate, 0.0)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 生活水準の月間生活費を上書きして比較できる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlanWithRetirementData("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateRetirementPlanReport(ctx, RetirementPlanReportInput{
+			UserID: "user-001",
+			LifestyleExpenses: map[entities.RetirementLifestyle]float64{
+				entities.RetirementLifestyleComfortable: 500000,
+			},
+		})
+
+		require.NoError(t, err)
+		comfortable := output.Report.Lifestyles[0]
+		assert.Equal(t, 500000.0, comfortable.MonthlyExpenses)
+		assert.True(t, comfortable.IsCustomized)
+		// 貯蓄だけでは足りないため、上乗せが必要な月額を示す
+		assert.Greater(t, comfortable.AdditionalMonthlySavings, 0.0)
+		assert.Contains(t, comfortable.Summary, "月50.0万円の生活費が必要")
+		assert.Contains(t, comfortable.Summary, "貯蓄の上乗せが必要です")
 		mockPlanRepo.AssertExpectations(t)
 	})
 
@@ -251,6 +515,92 @@ func TestGenerateReportsUseCase_GenerateRetirementPlanReport(t *testing.T) {
 	})
 }
 
+func TestGenerateReportsUseCase_GenerateRetirementStrategies(t *testing.T) {
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+	uc := NewGenerateReportsUseCase(new(MockFinancialPlanRepository), new(MockGoalRepository), calcService, recService).(*generateReportsUseCaseImpl)
+
+	// 利回り・インフレ率0%で手計算しやすい計画
+	// 月間純貯蓄 50,000円、現在の貯蓄 600,000円、50歳で60歳退職・80歳まで、退職後の不足月額 100,000円
+	newFixturePlan := func(t *testing.T) *aggregates.FinancialPlan {
+		zeroRate, _ := valueobjects.NewRate(0)
+		profile, err := entities.NewFinancialProfile(
+			"user-001",
+			mustNewMoney(230000),
+			entities.ExpenseCollection{{Category: "生活費", Amount: mustNewMoney(180000)}},
+			entities.SavingsCollection{{Type: "deposit", Amount: mustNewMoney(600000)}},
+			zeroRate,
+			zeroRate,
+		)
+		require.NoError(t, err)
+		plan, err := aggregates.NewFinancialPlan(profile)
+		require.NoError(t, err)
+		retirement, err := entities.NewRetirementData("user-001", 50, 60, 80, mustNewMoney(300000), mustNewMoney(200000))
+		require.NoError(t, err)
+		require.NoError(t, plan.SetRetirementData(retirement))
+		return plan
+	}
+
+	t.Run("正常系: 退職延期の効果を手計算どおりに試算できる", func(t *testing.T) {
+		plan := newFixturePlan(t)
+		// 必要額 100,000 × 12 × 20年 = 24,000,000円、予想額 600,000 + 50,000 × 120 = 6,600,000円
+		calculation, err := plan.RetirementData().CalculateRetirementSufficiency(
+			mustNewMoney(600000), mustNewMoney(50000), plan.Profile().InvestmentReturn(), plan.Profile().InflationRate())
+		require.NoError(t, err)
+		require.InDelta(t, 27.5, calculation.SufficiencyRate.AsPercentage(), 0.0001)
+
+		strategies := uc.generateRetirementStrategies(calculation, plan)
+		byName := make(map[string]RetirementStrategy)
+		for _, strategy := range strategies {
+			byName[strategy.Name] = strategy
+		}
+
+		// 61歳退職: 必要額 100,000 × 12 × 19年 = 22,800,000円、予想額 600,000 + 50,000 × 132 = 7,200,000円
+		delayOne, ok := byName["退職を1年遅らせる"]
+		require.True(t, ok)
+		assert.InDelta(t, 7200000.0/22800000.0*100, delayOne.NewSufficiencyRate, 0.0001)
+		assert.InDelta(t, 7200000.0/22800000.0*100-27.5, delayOne.Impact, 0.0001)
+		assert.InDelta(t, 17400000-15600000, delayOne.ShortfallReduction, 0.01)
+		assert.Equal(t, "high", delayOne.Effort)
+
+		// 65歳退職: 必要額 100,000 × 12 × 15年 = 18,000,000円、予想額 600,000 + 50,000 × 180 = 9,600,000円
+		delayFive, ok := byName["退職を5年遅らせる"]
+		require.True(t, ok)
+		assert.InDelta(t, 9600000.0/18000000.0*100, delayFive.NewSufficiencyRate, 0.0001)
+		assert.InDelta(t, 17400000-8400000, delayFive.ShortfallReduction, 0.01)
+	})
+
+	t.Run("正常系: 改善幅が小さい戦略は除外し負担あたりの効果順に並べる", func(t *testing.T) {
+		plan := newFixturePlan(t)
+		calculation, err := plan.RetirementData().CalculateRetirementSufficiency(
+			mustNewMoney(600000), mustNewMoney(50000), plan.Profile().InvestmentReturn(), plan.Profile().InflationRate())
+		require.NoError(t, err)
+
+		strategies := uc.generateRetirementStrategies(calculation, plan)
+		require.NotEmpty(t, strategies)
+		for i, strategy := range strategies {
+			assert.Greater(t, strategy.Impact, retirementStrategyMinImpact)
+			if i > 0 {
+				prev := strategies[i-1]
+				assert.GreaterOrEqual(t,
+					prev.Impact/retirementStrategyEffortWeights[prev.Effort],
+					strategy.Impact/retirementStrategyEffortWeights[strategy.Effort])
+			}
+		}
+	})
+
+	t.Run("正常系: 充足率が100%の場合は戦略を提示しない", func(t *testing.T) {
+		plan := newTestFinancialPlanWithRetirementData("user-001")
+		fullRate, _ := valueobjects.NewRate(100)
+		calculation := &entities.RetirementCalculation{
+			Shortfall:       mustNewMoney(0),
+			SufficiencyRate: fullRate,
+		}
+
+		assert.Empty(t, uc.generateRetirementStrategies(calculation, plan))
+	})
+}
+
 // ===========================
 // GenerateComprehensiveReport Tests
 // ===========================
@@ -265,7 +615,6 @@ func TestGenerateReportsUseCase_GenerateComprehensiveReport(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		plan := newTestFinancialPlan("user-001")
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
-		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, nil)
 
 		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
 		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
@@ -276,7 +625,156 @@ func TestGenerateReportsUseCase_GenerateComprehensiveReport(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, output)
 		assert.NotEmpty(t, output.GeneratedAt)
+		assert.NotNil(t, output.Report.AssetProjection)
+		assert.NotNil(t, output.Report.GoalsProgress)
+		// 退職データ未設定は失敗として扱わない
+		assert.Nil(t, output.Report.RetirementPlan)
+		assert.NotNil(t, output.Report.SectionsFailed)
+		assert.Empty(t, output.Report.SectionsFailed)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 長期間見直されていない財務データは警告と信頼性の注記を付ける", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		plan.RestoreLastReviewedAt(time.Now().AddDate(-2, 0, -1))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		summary := output.Report.FinancialSummary
+		assert.Equal(t, aggregates.StalenessStale, summary.DataStaleness.Level)
+		assert.Equal(t, 24, summary.DataStaleness.MonthsSinceReview)
+		assert.Contains(t, summary.Warnings, "財務データが24ヶ月更新されていません。前提を見直してください")
+		assert.Contains(t, output.Report.ExecutiveSummary.ConfidenceNote, "信頼性は低下しています")
+	})
+
+	t.Run("正常系: 最近確認した財務データには信頼性の注記を付けない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, aggregates.StalenessFresh, output.Report.FinancialSummary.DataStaleness.Level)
+		assert.Empty(t, output.Report.ExecutiveSummary.ConfidenceNote)
+	})
+
+	t.Run("正常系: 任意セクションの失敗はnullとエラー理由を付けて他セクションを返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan := newTestFinancialPlanWithRetirementData("user-001")
+		// 財務サマリー・資産推移の後、目標進捗の財務計画の取得のみ失敗させる
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil).Twice()
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("db error")).Once()
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService)
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: "user-001",
+			Years:  0, // 資産推移の計算に失敗する
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.Report.AssetProjection)
+		assert.Nil(t, output.Report.GoalsProgress)
+		assert.NotNil(t, output.Report.RetirementPlan)
+		assert.NotZero(t, output.Report.FinancialSummary.CurrentSituation.MonthlyIncome)
+
+		require.Len(t, output.Report.SectionsFailed, 2)
+		assert.Equal(t, ReportSectionAssetProjection, output.Report.SectionsFailed[0].Section)
+		assert.Contains(t, output.Report.SectionsFailed[0].Reason, "資産推移レポートの生成に失敗しました")
+		assert.Equal(t, ReportSectionGoalsProgress, output.Report.SectionsFailed[1].Section)
+		assert.Contains(t, output.Report.SectionsFailed[1].Reason, "db error")
 		mockPlanRepo.AssertExpectations(t)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 指定されていないセクションは生成せずレスポンスからも省略する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService).(*generateReportsUseCaseImpl)
+		projectAssetsCalls := 0
+		uc.projectAssets = func(profile *entities.FinancialProfile, years int) ([]entities.AssetProjection, error) {
+			projectAssetsCalls++
+			return profile.ProjectAssets(years)
+		}
+
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID:   "user-001",
+			Years:    10,
+			Sections: []ReportSection{ReportSectionFinancialSummary, ReportSectionActionPlan},
+		})
+
+		require.NoError(t, err)
+		assert.Zero(t, projectAssetsCalls)
+		assert.Nil(t, output.Report.AssetProjection)
+		assert.Nil(t, output.Report.GoalsProgress)
+		assert.Empty(t, output.Report.SectionsFailed)
+		// 目標進捗を指定していないため目標は取得しない
+		mockGoalRepo.AssertNotCalled(t, "FindByUserID", mock_anything(), mock_anything())
+
+		data, err := json.Marshal(output.Report)
+		require.NoError(t, err)
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &fields))
+		assert.Contains(t, fields, "financial_summary")
+		assert.Contains(t, fields, "action_plan")
+		assert.Contains(t, fields, "assumptions")
+		assert.Contains(t, fields, "sections_failed")
+		for _, key := range []string{"executive_summary", "asset_projection", "goals_progress", "retirement_plan"} {
+			assert.NotContains(t, fields, key)
+		}
+	})
+
+	t.Run("正常系: セクションを指定しない場合はすべてのセクションを生成する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, mockGoalRepo, calcService, recService).(*generateReportsUseCaseImpl)
+		projectAssetsCalls := 0
+		uc.projectAssets = func(profile *entities.FinancialProfile, years int) ([]entities.AssetProjection, error) {
+			projectAssetsCalls++
+			return profile.ProjectAssets(years)
+		}
+
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, projectAssetsCalls)
+		assert.NotNil(t, output.Report.AssetProjection)
+		assert.NotNil(t, output.Report.GoalsProgress)
+		assert.NotEmpty(t, output.Report.ExecutiveSummary.OverallStatus)
+		assert.NotEmpty(t, output.Report.ActionPlan.ShortTerm)
+
+		data, err := json.Marshal(output.Report)
+		require.NoError(t, err)
+		var fields map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(data, &fields))
+		for _, section := range ComprehensiveReportSections {
+			assert.Contains(t, fields, string(section))
+		}
+		// 退職データ未設定の場合は従来どおり null で返す
+		assert.Equal(t, "null", string(fields["retirement_plan"]))
+		mockGoalRepo.AssertExpectations(t)
 	})
 
 	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
@@ -291,8 +789,87 @@ func TestGenerateReportsUseCase_GenerateComprehensiveReport(t *testing.T) {
 		})
 
 		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務サマリーレポートの生成に失敗しました")
 		mockPlanRepo.AssertExpectations(t)
 	})
+
+	t.Run("正常系: 市場概況をレポートに添える", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		provider := &stubMarketContextProvider{context: &ports.MarketContext{
+			NikkeiReturn30Day: 2.3,
+			JGBYield10Year:    1.5,
+			CPI:               2.9,
+			FetchedAt:         time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC),
+		}}
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, nil, nil, nil, nil, provider, nil, nil)
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Report.MarketContext)
+		assert.Equal(t, 2.3, output.Report.MarketContext.NikkeiReturn30Day)
+		assert.Equal(t, "日経平均は直近30日で2.3%上昇しました。10年国債利回りは1.50%、消費者物価指数は前年比2.9%です。", output.Report.MarketContext.Summary)
+	})
+
+	t.Run("正常系: 市場概況を取得できない場合は省略してレポートを返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		provider := &stubMarketContextProvider{err: errors.New("feed unavailable")}
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, nil, nil, nil, nil, provider, nil, nil)
+		output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: "user-001",
+			Years:  10,
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.Report.MarketContext)
+		assert.Empty(t, output.Report.SectionsFailed)
+	})
+}
+
+// stubMarketContextProvider は固定の市場概況またはエラーを返すテスト用のプロバイダー
+type stubMarketContextProvider struct {
+	context *ports.MarketContext
+	err     error
+}
+
+func (p *stubMarketContextProvider) GetLatestContext(ctx context.Context) (*ports.MarketContext, error) {
+	return p.context, p.err
+}
+
+func TestReportSection_IsRequired(t *testing.T) {
+	assert.True(t, ReportSectionFinancialSummary.IsRequired())
+	assert.False(t, ReportSectionAssetProjection.IsRequired())
+	assert.False(t, ReportSectionGoalsProgress.IsRequired())
+	assert.False(t, ReportSectionRetirementPlan.IsRequired())
+}
+
+func TestParseReportSections(t *testing.T) {
+	t.Run("正常系: 空文字列はすべてのセクションを表す nil を返す", func(t *testing.T) {
+		sections, err := ParseReportSections("")
+		require.NoError(t, err)
+		assert.Nil(t, sections)
+	})
+
+	t.Run("正常系: カンマ区切りのセクション名を解析する", func(t *testing.T) {
+		sections, err := ParseReportSections("executive_summary, asset_projection")
+		require.NoError(t, err)
+		assert.Equal(t, []ReportSection{ReportSectionExecutiveSummary, ReportSectionAssetProjection}, sections)
+	})
+
+	t.Run("異常系: 不明なセクション名はエラー", func(t *testing.T) {
+		_, err := ParseReportSections("financial_summary,market_context")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrUnknownReportSection)
+		assert.Contains(t, err.Error(), "market_context")
+	})
 }
 
 // ===========================
@@ -315,8 +892,8 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 		expectedToken := "test-download-token-xyz"
 
 		pdfGen := &mockReportPDFGenerator{
-			generateFunc: func(reportType string, reportData interface{}) ([]byte, error) {
-				assert.Equal(t, "financial_summary", reportType)
+			generateFunc: func(reportType ReportType, reportData interface{}) ([]byte, error) {
+				assert.Equal(t, ReportTypeFinancialSummary, reportType)
 				return pdfContent, nil
 			},
 		}
@@ -329,8 +906,8 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 			},
 		}
 
-		// 新シグネチャ: NewGenerateReportsUseCaseWithPDF(planRepo, goalRepo, calcService, recService, pdfGen, fileStorage)
-		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, pdfGen, fileStorage)
+		// 新シグネチャ: NewGenerateReportsUseCaseWithPDF(planRepo, goalRepo, calcService, recService, pdfGen, fileStorage, savingsRateTargetRepo, guardrailService, dismissalRepo, marketContextProvider, healthScoreRepo, snapshotRepo)
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, pdfGen, fileStorage, nil, nil, nil, nil, nil, nil)
 		output, err := uc.ExportReportToPDF(ctx, ExportReportInput{
 			UserID:     "user-001",
 			ReportType: "financial_summary",
@@ -356,13 +933,13 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 
 		pdfGen := &mockReportPDFGenerator{
-			generateFunc: func(reportType string, reportData interface{}) ([]byte, error) {
+			generateFunc: func(reportType ReportType, reportData interface{}) ([]byte, error) {
 				return nil, errors.New("PDF生成エンジンエラー")
 			},
 		}
 		fileStorage := &mockTemporaryFileStoragePort{}
 
-		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, pdfGen, fileStorage)
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, pdfGen, fileStorage, nil, nil, nil, nil, nil, nil)
 		_, err := uc.ExportReportToPDF(ctx, ExportReportInput{
 			UserID:     "user-001",
 			ReportType: "financial_summary",
@@ -382,7 +959,7 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 
 		pdfGen := &mockReportPDFGenerator{
-			generateFunc: func(reportType string, reportData interface{}) ([]byte, error) {
+			generateFunc: func(reportType ReportType, reportData interface{}) ([]byte, error) {
 				return []byte("<html>pdf</html>"), nil
 			},
 		}
@@ -392,7 +969,7 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 			},
 		}
 
-		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, pdfGen, fileStorage)
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, pdfGen, fileStorage, nil, nil, nil, nil, nil, nil)
 		_, err := uc.ExportReportToPDF(ctx, ExportReportInput{
 			UserID:     "user-001",
 			ReportType: "financial_summary",
@@ -404,6 +981,37 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 		assert.Contains(t, err.Error(), "保存")
 	})
 
+	t.Run("正常系: CSVには生成時点の計算の前提が含まれる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+
+		plan := newTestFinancialPlan(entities.UserID("user-001"))
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		var saved []byte
+		fileStorage := &mockTemporaryFileStoragePort{
+			saveFileFunc: func(fileName string, data []byte) (string, time.Time, error) {
+				saved = data
+				return "csv-token", time.Now().Add(24 * time.Hour), nil
+			},
+		}
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, fileStorage, nil, nil, nil, nil, nil, nil)
+		_, err := uc.ExportReportToPDF(ctx, ExportReportInput{
+			UserID:     "user-001",
+			ReportType: "financial_summary",
+			Format:     "csv",
+		})
+		require.NoError(t, err)
+
+		csvText := string(saved)
+		assert.Contains(t, csvText, "計算の前提,投資利回り,5.00,%")
+		assert.Contains(t, csvText, "計算の前提,手取り月収,400000,円")
+		assert.Contains(t, csvText, "計算の前提,月間支出合計,180000,円")
+		assert.Contains(t, csvText, "計算の前提,月間純貯蓄額,220000,円")
+		assert.Contains(t, csvText, fmt.Sprintf("計算の前提,計算バージョン,%d,", services.CalculationVersion))
+	})
+
 	t.Run("異常系: pdfGeneratorがnilの場合はエラーが返る", func(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo := new(MockGoalRepository)
@@ -420,4 +1028,301 @@ func TestGenerateReportsUseCase_ExportReportToPDF(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "ファイルストレージ")
 	})
-}
\ No newline at end of file
+}
+// newTestHealthScoreSnapshot は monthsAgo ヶ月前の月初に記録した財務健全性スコアを作成するヘルパー
+func newTestHealthScoreSnapshot(t *testing.T, monthsAgo, score int, savingsRate, emergencyFundRatio, investmentReturn, totalAssets float64) *entities.HealthScoreSnapshot {
+	t.Helper()
+	now := time.Now()
+	recordedAt := time.Date(now.Year(), now.Month(), 1, 12, 0, 0, 0, now.Location()).AddDate(0, -monthsAgo, 0)
+	snapshot, err := entities.NewHealthScoreSnapshot("user-001", score, savingsRate, emergencyFundRatio, investmentReturn, totalAssets, recordedAt)
+	require.NoError(t, err)
+	return snapshot
+}
+
+func TestGenerateReportsUseCase_HealthScoreHistory(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 財務サマリー生成時にスコアを記録し前月との比較でトレンドを判定する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockHealthRepo := new(MockHealthScoreSnapshotRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockHealthRepo.On("FindByUserIDSince", mock_anything(), entities.UserID("user-001"), mock_anything()).Return([]*entities.HealthScoreSnapshot{
+			newTestHealthScoreSnapshot(t, 2, 80, 10, 3, 5, 1200000),
+			newTestHealthScoreSnapshot(t, 1, 80, 99, 3, 5, 900000),
+		}, nil)
+		var recorded *entities.HealthScoreSnapshot
+		mockHealthRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			recorded = args.Get(1).(*entities.HealthScoreSnapshot)
+		}).Return(nil)
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, nil, nil, nil, nil, nil, mockHealthRepo, nil)
+		output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		trends := map[string]string{}
+		for _, metric := range output.Report.KeyMetrics {
+			trends[metric.Name] = metric.Trend
+		}
+		assert.Equal(t, "down", trends["貯蓄率"])
+		assert.Equal(t, "stable", trends["投資利回り"])
+		assert.Equal(t, "up", trends["総資産"])
+
+		require.NotNil(t, recorded)
+		assert.Equal(t, output.Report.FinancialHealth.OverallScore, recorded.Score())
+		assert.Equal(t, 1000000.0, recorded.TotalAssets())
+		mockHealthRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: スコアの記録に失敗してもレポートを返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockHealthRepo := new(MockHealthScoreSnapshotRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockHealthRepo.On("FindByUserIDSince", mock_anything(), entities.UserID("user-001"), mock_anything()).Return(nil, errors.New("db error"))
+		mockHealthRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, nil, nil, nil, nil, nil, mockHealthRepo, nil)
+		output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		for _, metric := range output.Report.KeyMetrics {
+			assert.Equal(t, "stable", metric.Trend, metric.Name)
+		}
+	})
+
+	t.Run("正常系: 2ヶ月連続の低下は主因を添えて警告する", func(t *testing.T) {
+		mockHealthRepo := new(MockHealthScoreSnapshotRepository)
+		mockHealthRepo.On("FindByUserIDSince", mock_anything(), entities.UserID("user-001"), mock_anything()).Return([]*entities.HealthScoreSnapshot{
+			newTestHealthScoreSnapshot(t, 3, 60, 8, 2, 5, 1000000),
+			newTestHealthScoreSnapshot(t, 2, 80, 20, 6, 5, 1500000),
+			newTestHealthScoreSnapshot(t, 1, 70, 20, 3, 5, 1200000),
+			newTestHealthScoreSnapshot(t, 0, 60, 20, 2, 5, 1000000),
+		}, nil)
+
+		uc := NewGenerateReportsUseCaseWithPDF(nil, nil, calcService, recService, nil, nil, nil, nil, nil, nil, mockHealthRepo, nil)
+		output, err := uc.GetHealthScoreTrend(ctx, HealthScoreTrendInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 6, output.Months)
+		assert.Len(t, output.Points, 4)
+		assert.Equal(t, "down", output.Trend)
+		assert.Equal(t, 2, output.ConsecutiveDeclines)
+		require.NotNil(t, output.Warning)
+		assert.Equal(t, HealthScoreCauseEmergencyFundDrawdown, output.Warning.Cause)
+		assert.Contains(t, output.Warning.Message, "2ヶ月連続で低下しています（80→60）")
+	})
+
+	t.Run("正常系: 貯蓄率の低下幅が最も大きい場合は貯蓄率を主因とする", func(t *testing.T) {
+		mockHealthRepo := new(MockHealthScoreSnapshotRepository)
+		mockHealthRepo.On("FindByUserIDSince", mock_anything(), entities.UserID("user-001"), mock_anything()).Return([]*entities.HealthScoreSnapshot{
+			newTestHealthScoreSnapshot(t, 2, 80, 20, 6, 5, 1500000),
+			newTestHealthScoreSnapshot(t, 1, 70, 10, 6, 5, 1500000),
+			newTestHealthScoreSnapshot(t, 0, 60, 4, 6, 5, 1500000),
+		}, nil)
+
+		uc := NewGenerateReportsUseCaseWithPDF(nil, nil, calcService, recService, nil, nil, nil, nil, nil, nil, mockHealthRepo, nil)
+		output, err := uc.GetHealthScoreTrend(ctx, HealthScoreTrendInput{UserID: "user-001", Months: 3})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Warning)
+		assert.Equal(t, HealthScoreCauseSavingsRateDecline, output.Warning.Cause)
+	})
+
+	t.Run("正常系: 記録が1ヶ月分のみの場合はstableで警告しない", func(t *testing.T) {
+		mockHealthRepo := new(MockHealthScoreSnapshotRepository)
+		mockHealthRepo.On("FindByUserIDSince", mock_anything(), entities.UserID("user-001"), mock_anything()).Return([]*entities.HealthScoreSnapshot{
+			newTestHealthScoreSnapshot(t, 0, 60, 20, 2, 5, 1000000),
+		}, nil)
+
+		uc := NewGenerateReportsUseCaseWithPDF(nil, nil, calcService, recService, nil, nil, nil, nil, nil, nil, mockHealthRepo, nil)
+		output, err := uc.GetHealthScoreTrend(ctx, HealthScoreTrendInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "stable", output.Trend)
+		assert.Zero(t, output.ConsecutiveDeclines)
+		assert.Nil(t, output.Warning)
+	})
+
+	t.Run("異常系: 上限を超える期間はエラー", func(t *testing.T) {
+		uc := NewGenerateReportsUseCaseWithPDF(nil, nil, calcService, recService, nil, nil, nil, nil, nil, nil, new(MockHealthScoreSnapshotRepository), nil)
+		_, err := uc.GetHealthScoreTrend(ctx, HealthScoreTrendInput{UserID: "user-001", Months: 61})
+
+		require.Error(t, err)
+	})
+
+	t.Run("異常系: 履歴の保存先が未設定の場合はエラー", func(t *testing.T) {
+		uc := NewGenerateReportsUseCaseWithPDF(nil, nil, calcService, recService, nil, nil, nil, nil, nil, nil, nil, nil)
+		_, err := uc.GetHealthScoreTrend(ctx, HealthScoreTrendInput{UserID: "user-001"})
+
+		require.Error(t, err)
+	})
+}
+
+func TestGenerateReportsUseCase_FinancialHealthCache(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	// setup は保存した計算結果をそのまま返すインメモリの計算結果リポジトリを用意する
+	setup := func(plan *aggregates.FinancialPlan) (*MockFinancialPlanRepository, *countingCalculationSnapshotRepository) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("MarkCalculated", mock_anything(), entities.UserID("user-001"), mock_anything()).Return(nil)
+		return mockPlanRepo, &countingCalculationSnapshotRepository{inMemoryCalculationSnapshotRepository: newInMemoryCalculationSnapshotRepository()}
+	}
+
+	t.Run("正常系: データに変更がなければ2回目は保存済みの結果を返し再計算しない", func(t *testing.T) {
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo, snapshotRepo := setup(plan)
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, new(MockGoalRepository), calcService, recService, nil, nil, nil, nil, nil, nil, nil, snapshotRepo)
+		first, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+		require.NoError(t, err)
+		second, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+		require.NoError(t, err)
+
+		assert.Equal(t, first.Report.FinancialHealth, second.Report.FinancialHealth)
+		assert.Equal(t, first.Report.CurrentSituation, second.Report.CurrentSituation)
+		assert.Equal(t, 1, snapshotRepo.saves)
+		mockPlanRepo.AssertNumberOfCalls(t, "MarkCalculated", 1)
+		require.NotNil(t, plan.LastCalculatedAt())
+	})
+
+	t.Run("正常系: プロファイルを更新すると再計算する", func(t *testing.T) {
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo, snapshotRepo := setup(plan)
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, new(MockGoalRepository), calcService, recService, nil, nil, nil, nil, nil, nil, nil, snapshotRepo)
+		_, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+		require.NoError(t, err)
+
+		// 計算より後にプロファイルを更新する
+		calculatedAt := plan.LastCalculatedAt().Add(-time.Minute)
+		plan.RestoreCalculationTimestamps(&calculatedAt, calculatedAt.Add(-time.Minute))
+		require.NoError(t, plan.UpdateProfile(plan.Profile()))
+
+		_, err = uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, snapshotRepo.saves)
+	})
+
+	t.Run("正常系: ForceRecalculate の場合は保存済みの結果を使わない", func(t *testing.T) {
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo, snapshotRepo := setup(plan)
+
+		uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, new(MockGoalRepository), calcService, recService, nil, nil, nil, nil, nil, nil, nil, snapshotRepo)
+		_, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+		require.NoError(t, err)
+		_, err = uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001", ForceRecalculate: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, snapshotRepo.saves)
+		mockPlanRepo.AssertNumberOfCalls(t, "MarkCalculated", 2)
+	})
+}
+
+// countingCalculationSnapshotRepository は保存回数（＝計算の実行回数）を数える計算結果リポジトリ
+type countingCalculationSnapshotRepository struct {
+	*inMemoryCalculationSnapshotRepository
+	saves int
+}
+
+func (r *countingCalculationSnapshotRepository) Save(ctx context.Context, snapshot *entities.CalculationSnapshot) error {
+	r.saves++
+	return r.inMemoryCalculationSnapshotRepository.Save(ctx, snapshot)
+}
+
+// ===========================
+// GetHouseholdFinancialSummary Tests
+// ===========================
+
+// newTestSecondMemberFinancialPlan は世帯のもう一人のメンバー用の財務計画（月収300,000円・支出200,000円・貯蓄2,000,000円・利回り3%）を作成するヘルパー
+func newTestSecondMemberFinancialPlan(userID entities.UserID) *aggregates.FinancialPlan {
+	investmentReturn, _ := valueobjects.NewRate(3.0)
+	inflationRate, _ := valueobjects.NewRate(2.0)
+	profile, err := entities.NewFinancialProfile(
+		userID,
+		mustNewMoney(300000),
+		entities.ExpenseCollection{{Category: "生活費", Amount: mustNewMoney(200000)}},
+		entities.SavingsCollection{{Type: "deposit", Amount: mustNewMoney(2000000)}},
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		panic("テスト用財務プロファイルの作成に失敗: " + err.Error())
+	}
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		panic("テスト用財務計画の作成に失敗: " + err.Error())
+	}
+	return plan
+}
+
+func TestGenerateReportsUseCase_GetHouseholdFinancialSummary(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 2人の財務状況を合算し、世帯の総資産はメンバーの総資産の合計になる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-002")).Return(newTestSecondMemberFinancialPlan("user-002"), nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.GetHouseholdFinancialSummary(ctx, GetHouseholdSummaryInput{
+			RequestingUserID: "user-001",
+			MemberUserIDs:    []entities.UserID{"user-001", "user-002", "user-001"},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.UserID{"user-001", "user-002"}, output.MemberUserIDs)
+		require.Len(t, output.Members, 2)
+
+		memberTotal := output.Members[0].CurrentSituation.TotalAssets + output.Members[1].CurrentSituation.TotalAssets
+		assert.Equal(t, memberTotal, output.CurrentSituation.TotalAssets)
+		assert.Equal(t, 3000000.0, output.CurrentSituation.TotalAssets)
+		assert.Equal(t, 700000.0, output.CurrentSituation.NetMonthlyIncome)
+		assert.Equal(t, 380000.0, output.CurrentSituation.MonthlyExpenses)
+		assert.Equal(t, 320000.0, output.CurrentSituation.NetSavings)
+		// (5% × 1,000,000 + 3% × 2,000,000) / 3,000,000
+		assert.InDelta(t, 3.667, output.CurrentSituation.InvestmentReturn, 0.001)
+		// 320,000 / 700,000
+		assert.InDelta(t, 45.714, output.FinancialHealth.SavingsRate, 0.001)
+		// 貯蓄率20%以上(30) + 緊急資金なし(0) + 利回り3%以上(15)
+		assert.Equal(t, 45, output.FinancialHealth.OverallScore)
+		assert.Equal(t, "fair", output.FinancialHealth.ScoreLevel)
+		mockPlanRepo.AssertNumberOfCalls(t, "FindByUserID", 2)
+	})
+
+	t.Run("異常系: リクエストしたユーザーがメンバーに含まれていない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		_, err := uc.GetHouseholdFinancialSummary(ctx, GetHouseholdSummaryInput{
+			RequestingUserID: "user-003",
+			MemberUserIDs:    []entities.UserID{"user-001", "user-002"},
+		})
+
+		assert.ErrorIs(t, err, ErrRequesterNotHouseholdMember)
+		mockPlanRepo.AssertNotCalled(t, "FindByUserID", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: メンバーの財務計画がない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-002")).Return(nil, errors.New("not found"))
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		_, err := uc.GetHouseholdFinancialSummary(ctx, GetHouseholdSummaryInput{
+			RequestingUserID: "user-001",
+			MemberUserIDs:    []entities.UserID{"user-001", "user-002"},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "メンバー user-002 の財務計画の取得に失敗しました")
+	})
+}
@@ -0,0 +1,169 @@
+package usecases
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// updateGolden は golden ファイルを現在の出力で再生成するフラグ
+// 計算ロジックを意図的に変更した場合のみ以下で更新する:
+//
+//	go test ./application/usecases/ -run Golden -update
+var updateGolden = flag.Bool("update", false, "golden ファイルを現在の出力で更新する")
+
+const (
+	// goldenDir は golden ファイルの配置ディレクトリ
+	goldenDir = "testdata/golden"
+	// goldenAbsTolerance は浮動小数比較の絶対許容誤差（0付近の値向け）
+	goldenAbsTolerance = 1e-6
+	// goldenRelTolerance は浮動小数比較の相対許容誤差（金額など大きな値向け）
+	goldenRelTolerance = 1e-9
+)
+
+// assertGolden は actual をJSONに変換し、testdata/golden/<name>.golden.json と比較する
+// 数値は許容誤差内であれば一致とみなす
+func assertGolden(t *testing.T, name string, actual interface{}) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		t.Fatalf("golden 比較用のJSON変換に失敗しました: %v", err)
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join(goldenDir, name+".golden.json")
+	if *updateGolden {
+		if err := os.MkdirAll(goldenDir, 0o755); err != nil {
+			t.Fatalf("golden ディレクトリの作成に失敗しました: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("golden ファイルの書き込みに失敗しました: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden ファイルの読み込みに失敗しました（-update で生成してください）: %v", err)
+	}
+
+	var wantValue, gotValue interface{}
+	if err := json.Unmarshal(want, &wantValue); err != nil {
+		t.Fatalf("golden ファイルのJSON解析に失敗しました: %v", err)
+	}
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("出力のJSON解析に失敗しました: %v", err)
+	}
+
+	var diffs []string
+	compareGoldenValue("$", wantValue, gotValue, &diffs)
+	for _, diff := range diffs {
+		t.Error(diff)
+	}
+	if len(diffs) > 0 {
+		t.Logf("意図した変更であれば -update で %s を更新してください", path)
+	}
+}
+
+// compareGoldenValue はJSONから復元した値を再帰的に比較し、差分を diffs に追加する
+func compareGoldenValue(path string, want, got interface{}, diffs *[]string) {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: 型が異なります want=%T got=%T", path, want, got))
+			return
+		}
+		keys := make([]string, 0, len(w)+len(g))
+		for key := range w {
+			keys = append(keys, key)
+		}
+		for key := range g {
+			if _, exists := w[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			wv, wok := w[key]
+			gv, gok := g[key]
+			switch {
+			case !wok:
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: 予期しないフィールドです got=%v", path, key, gv))
+			case !gok:
+				*diffs = append(*diffs, fmt.Sprintf("%s.%s: フィールドがありません want=%v", path, key, wv))
+			default:
+				compareGoldenValue(path+"."+key, wv, gv, diffs)
+			}
+		}
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: 型が異なります want=%T got=%T", path, want, got))
+			return
+		}
+		if len(w) != len(g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: 要素数が異なります want=%d got=%d", path, len(w), len(g)))
+			return
+		}
+		for i := range w {
+			compareGoldenValue(fmt.Sprintf("%s[%d]", path, i), w[i], g[i], diffs)
+		}
+	case float64:
+		g, ok := got.(float64)
+		if !ok {
+			*diffs = append(*diffs, fmt.Sprintf("%s: 型が異なります want=%T got=%T", path, want, got))
+			return
+		}
+		if !floatsWithinTolerance(w, g) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: 値が異なります want=%v got=%v", path, w, g))
+		}
+	default:
+		if want != got {
+			*diffs = append(*diffs, fmt.Sprintf("%s: 値が異なります want=%v got=%v", path, want, got))
+		}
+	}
+}
+
+// floatsWithinTolerance は2つの浮動小数が許容誤差内で等しいかを判定する
+func floatsWithinTolerance(want, got float64) bool {
+	diff := math.Abs(want - got)
+	if diff <= goldenAbsTolerance {
+		return true
+	}
+	return diff <= goldenRelTolerance*math.Max(math.Abs(want), math.Abs(got))
+}
+
+func TestCompareGoldenValue(t *testing.T) {
+	t.Run("許容誤差内の数値は一致とみなす", func(t *testing.T) {
+		var diffs []string
+		compareGoldenValue("$", map[string]interface{}{"amount": 1234567.0}, map[string]interface{}{"amount": 1234567.0000001}, &diffs)
+		if len(diffs) != 0 {
+			t.Errorf("Expected no diffs, got %v", diffs)
+		}
+	})
+
+	t.Run("許容誤差を超える数値・欠落フィールド・要素数の違いを検出する", func(t *testing.T) {
+		want := map[string]interface{}{
+			"amount": 1000.0,
+			"items":  []interface{}{1.0, 2.0},
+			"label":  "a",
+		}
+		got := map[string]interface{}{
+			"amount": 1000.01,
+			"items":  []interface{}{1.0},
+			"extra":  true,
+		}
+		var diffs []string
+		compareGoldenValue("$", want, got, &diffs)
+		if len(diffs) != 4 {
+			t.Errorf("Expected 4 diffs, got %d: %v", len(diffs), diffs)
+		}
+	})
+}
@@ -0,0 +1,195 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ImpersonationTokenTTL はなりすましセッションのアクセストークンの有効期間（リフレッシュトークンは発行しない）
+const ImpersonationTokenTTL = 15 * time.Minute
+
+var (
+	// ErrImpersonationForbidden は管理者権限のないユーザーがなりすましを要求した場合のエラー
+	ErrImpersonationForbidden = errors.New("なりすましには管理者権限が必要です")
+	// ErrImpersonationReasonRequired はなりすましの理由が指定されていない場合のエラー
+	ErrImpersonationReasonRequired = errors.New("なりすましの理由は必須です")
+	// ErrImpersonationSelf は管理者が自分自身を対象にした場合のエラー
+	ErrImpersonationSelf = errors.New("自分自身にはなりすませません")
+)
+
+// ImpersonationUseCase は管理者によるなりすまし（サポート用の閲覧）のユースケース
+type ImpersonationUseCase interface {
+	// Impersonate は対象ユーザーとして閲覧するための短期間のアクセストークンを発行し、発行を監査ログに記録する
+	Impersonate(ctx context.Context, input ImpersonateInput) (*ImpersonateOutput, error)
+
+	// RecordRequest はなりすましセッションで行われたリクエストを監査ログに記録する
+	RecordRequest(ctx context.Context, claims *TokenClaims, method, route string) error
+
+	// RecordExpiredSessions は有効期限を過ぎたなりすましセッションの期限切れを監査ログに記録し、記録した件数を返す
+	RecordExpiredSessions(ctx context.Context) (int, error)
+}
+
+// ImpersonateInput はなりすましセッション発行の入力
+type ImpersonateInput struct {
+	AdminUserID  entities.UserID `json:"admin_user_id"`
+	TargetUserID entities.UserID `json:"user_id"`
+	Reason       string          `json:"reason"`
+}
+
+// ImpersonateOutput はなりすましセッション発行の出力
+type ImpersonateOutput struct {
+	AccessToken  string    `json:"access_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	SessionID    string    `json:"session_id"`
+	TargetUserID string    `json:"user_id"`
+}
+
+// impersonationUseCaseImpl はImpersonationUseCaseの実装
+type impersonationUseCaseImpl struct {
+	userRepo     repositories.UserRepository
+	auditLogRepo repositories.AuditLogRepository
+	jwtSecret    string
+	logger       *log.UseCaseLogger
+	now          func() time.Time
+}
+
+// NewImpersonationUseCase は新しいImpersonationUseCaseを作成する
+// トークンは通常の認証と同じ署名鍵で発行し、AuthUseCase.VerifyToken で検証する
+func NewImpersonationUseCase(
+	userRepo repositories.UserRepository,
+	auditLogRepo repositories.AuditLogRepository,
+	jwtSecret string,
+) ImpersonationUseCase {
+	return &impersonationUseCaseImpl{
+		userRepo:     userRepo,
+		auditLogRepo: auditLogRepo,
+		jwtSecret:    jwtSecret,
+		logger:       log.NewUseCaseLogger("ImpersonationUseCase"),
+		now:          time.Now,
+	}
+}
+
+// Impersonate は対象ユーザーとして閲覧するための短期間のアクセストークンを発行し、発行を監査ログに記録する
+func (uc *impersonationUseCaseImpl) Impersonate(ctx context.Context, input ImpersonateInput) (*ImpersonateOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "Impersonate",
+		slog.String("admin_user_id", input.AdminUserID.String()),
+		log.UserID(input.TargetUserID.String()),
+	)
+
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, ErrImpersonationReasonRequired
+	}
+
+	admin, err := uc.userRepo.FindByID(ctx, input.AdminUserID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーが見つかりません: %w", err)
+	}
+	if !admin.IsAdmin() {
+		uc.logger.OperationError(ctx, "Impersonate", ErrImpersonationForbidden)
+		return nil, ErrImpersonationForbidden
+	}
+	if input.AdminUserID == input.TargetUserID {
+		return nil, ErrImpersonationSelf
+	}
+
+	target, err := uc.userRepo.FindByID(ctx, input.TargetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("対象ユーザーが見つかりません: %w", err)
+	}
+
+	now := uc.now()
+	expiresAt := now.Add(ImpersonationTokenTTL)
+	sessionID := uuid.New().String()
+
+	// 監査ログに記録できない場合はトークンを発行しない
+	entry, err := entities.NewImpersonationStartedEntry(admin.ID(), target.ID(), sessionID, reason, expiresAt, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.auditLogRepo.Save(ctx, entry); err != nil {
+		uc.logger.OperationError(ctx, "Impersonate", err, slog.String("step", "save_audit_log"))
+		return nil, fmt.Errorf("監査ログの記録に失敗しました: %w", err)
+	}
+
+	claims := TokenClaims{
+		UserID:         target.ID().String(),
+		Email:          target.Email().String(),
+		ImpersonatorID: admin.ID().String(),
+		Impersonated:   true,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(uc.jwtSecret))
+	if err != nil {
+		return nil, fmt.Errorf("トークンの生成に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "Impersonate", slog.String("session_id", sessionID))
+
+	return &ImpersonateOutput{
+		AccessToken:  token,
+		ExpiresAt:    expiresAt,
+		SessionID:    sessionID,
+		TargetUserID: target.ID().String(),
+	}, nil
+}
+
+// RecordRequest はなりすましセッションで行われたリクエストを監査ログに記録する
+func (uc *impersonationUseCaseImpl) RecordRequest(ctx context.Context, claims *TokenClaims, method, route string) error {
+	if claims == nil || !claims.Impersonated {
+		return errors.New("なりすましのトークンではありません")
+	}
+
+	entry, err := entities.NewImpersonationRequestEntry(
+		entities.UserID(claims.ImpersonatorID),
+		entities.UserID(claims.UserID),
+		claims.ID,
+		method,
+		route,
+		uc.now(),
+	)
+	if err != nil {
+		return err
+	}
+	if err := uc.auditLogRepo.Save(ctx, entry); err != nil {
+		return fmt.Errorf("監査ログの記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// RecordExpiredSessions は有効期限を過ぎたなりすましセッションの期限切れを監査ログに記録し、記録した件数を返す
+func (uc *impersonationUseCaseImpl) RecordExpiredSessions(ctx context.Context) (int, error) {
+	now := uc.now()
+	sessions, err := uc.auditLogRepo.FindExpiredImpersonationSessions(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	recorded := 0
+	for _, started := range sessions {
+		entry, err := started.ExpiredEntry(now)
+		if err != nil {
+			return recorded, err
+		}
+		if err := uc.auditLogRepo.Save(ctx, entry); err != nil {
+			return recorded, fmt.Errorf("監査ログの記録に失敗しました: %w", err)
+		}
+		recorded++
+	}
+	return recorded, nil
+}
@@ -0,0 +1,156 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testImpersonationTargetID = "8d2e4f6a-1b3c-4d5e-9f7a-0b1c2d3e4f5a"
+
+// newTestImpersonationUsers は管理者（testAuthUserID）と対象ユーザーを登録したモックを作成するヘルパー
+func newTestImpersonationUsers(t *testing.T, isAdmin bool) *MockUserRepository {
+	t.Helper()
+	admin, err := entities.NewUser(testAuthUserID, "admin@example.com", "password123")
+	require.NoError(t, err)
+	admin.RestoreAdmin(isAdmin)
+	target, err := entities.NewUser(testImpersonationTargetID, "target@example.com", "password123")
+	require.NoError(t, err)
+
+	mockUserRepo := new(MockUserRepository)
+	mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(admin, nil)
+	mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testImpersonationTargetID)).Return(target, nil)
+	return mockUserRepo
+}
+
+func TestImpersonationUseCase_Impersonate(t *testing.T) {
+	ctx := context.Background()
+	input := ImpersonateInput{
+		AdminUserID:  testAuthUserID,
+		TargetUserID: testImpersonationTargetID,
+		Reason:       "  問い合わせ #1234 の表示崩れの調査  ",
+	}
+
+	t.Run("正常系: 対象ユーザーとして検証できる15分間のトークンを発行し、発行を記録する", func(t *testing.T) {
+		mockAuditRepo := new(MockAuditLogRepository)
+		var started *entities.AuditLogEntry
+		mockAuditRepo.On("Save", mock_anything(), mock.AnythingOfType("*entities.AuditLogEntry")).
+			Run(func(args mock.Arguments) { started = args.Get(1).(*entities.AuditLogEntry) }).
+			Return(nil)
+
+		uc := NewImpersonationUseCase(newTestImpersonationUsers(t, true), mockAuditRepo, testJWTSecret)
+		output, err := uc.Impersonate(ctx, input)
+		require.NoError(t, err)
+
+		// 通常の認証と同じ検証で、なりすましのクレームが読み取れる
+		claims, err := newTestAuthUseCase(new(MockUserRepository), new(MockRefreshTokenRepository)).VerifyToken(ctx, output.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, testImpersonationTargetID, claims.UserID)
+		assert.Equal(t, "target@example.com", claims.Email)
+		assert.Equal(t, testAuthUserID, claims.ImpersonatorID)
+		assert.True(t, claims.Impersonated)
+		assert.Equal(t, output.SessionID, claims.ID)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), claims.ExpiresAt.Time, 5*time.Second)
+
+		require.NotNil(t, started)
+		assert.Equal(t, entities.AuditActionImpersonationStarted, started.Action())
+		assert.Equal(t, entities.UserID(testAuthUserID), started.ActorUserID())
+		assert.Equal(t, entities.UserID(testImpersonationTargetID), started.TargetUserID())
+		assert.Equal(t, output.SessionID, started.SessionID())
+		assert.Equal(t, "問い合わせ #1234 の表示崩れの調査", started.Reason())
+		assert.Equal(t, output.ExpiresAt, *started.ExpiresAt())
+	})
+
+	t.Run("異常系: 管理者権限がない場合は発行しない", func(t *testing.T) {
+		mockAuditRepo := new(MockAuditLogRepository)
+
+		uc := NewImpersonationUseCase(newTestImpersonationUsers(t, false), mockAuditRepo, testJWTSecret)
+		_, err := uc.Impersonate(ctx, input)
+
+		require.ErrorIs(t, err, ErrImpersonationForbidden)
+		mockAuditRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 理由が空白のみの場合は発行しない", func(t *testing.T) {
+		blank := input
+		blank.Reason = "   "
+
+		uc := NewImpersonationUseCase(new(MockUserRepository), new(MockAuditLogRepository), testJWTSecret)
+		_, err := uc.Impersonate(ctx, blank)
+
+		require.ErrorIs(t, err, ErrImpersonationReasonRequired)
+	})
+
+	t.Run("異常系: 監査ログに記録できない場合はトークンを発行しない", func(t *testing.T) {
+		mockAuditRepo := new(MockAuditLogRepository)
+		mockAuditRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		uc := NewImpersonationUseCase(newTestImpersonationUsers(t, true), mockAuditRepo, testJWTSecret)
+		output, err := uc.Impersonate(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "監査ログの記録に失敗しました")
+		assert.Nil(t, output)
+	})
+}
+
+func TestImpersonationUseCase_RecordRequest(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 操作者・対象・ルートを記録する", func(t *testing.T) {
+		mockAuditRepo := new(MockAuditLogRepository)
+		mockAuditRepo.On("Save", mock_anything(), mock.MatchedBy(func(entry *entities.AuditLogEntry) bool {
+			return entry.Action() == entities.AuditActionImpersonationRequest &&
+				entry.ActorUserID() == testAuthUserID &&
+				entry.TargetUserID() == testImpersonationTargetID &&
+				entry.SessionID() == "session-001" &&
+				entry.Method() == "GET" &&
+				entry.Route() == "/api/v1/financial-data"
+		})).Return(nil)
+
+		claims := &TokenClaims{UserID: testImpersonationTargetID, ImpersonatorID: testAuthUserID, Impersonated: true}
+		claims.ID = "session-001"
+		uc := NewImpersonationUseCase(new(MockUserRepository), mockAuditRepo, testJWTSecret)
+
+		require.NoError(t, uc.RecordRequest(ctx, claims, "GET", "/api/v1/financial-data"))
+		mockAuditRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: なりすましでないトークンは記録しない", func(t *testing.T) {
+		uc := NewImpersonationUseCase(new(MockUserRepository), new(MockAuditLogRepository), testJWTSecret)
+
+		require.Error(t, uc.RecordRequest(ctx, &TokenClaims{UserID: testAuthUserID}, "GET", "/api/v1/goals"))
+	})
+}
+
+func TestImpersonationUseCase_RecordExpiredSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 期限切れのセッションごとに期限切れを記録する", func(t *testing.T) {
+		issuedAt := time.Now().Add(-time.Hour)
+		started, err := entities.NewImpersonationStartedEntry(testAuthUserID, testImpersonationTargetID, "session-001", "調査", issuedAt.Add(ImpersonationTokenTTL), issuedAt)
+		require.NoError(t, err)
+
+		mockAuditRepo := new(MockAuditLogRepository)
+		mockAuditRepo.On("FindExpiredImpersonationSessions", mock_anything(), mock_anything()).Return([]*entities.AuditLogEntry{started}, nil)
+		mockAuditRepo.On("Save", mock_anything(), mock.MatchedBy(func(entry *entities.AuditLogEntry) bool {
+			return entry.Action() == entities.AuditActionImpersonationExpired &&
+				entry.SessionID() == "session-001" &&
+				entry.ActorUserID() == testAuthUserID &&
+				entry.ExpiresAt().Equal(*started.ExpiresAt())
+		})).Return(nil)
+
+		uc := NewImpersonationUseCase(new(MockUserRepository), mockAuditRepo, testJWTSecret)
+		recorded, err := uc.RecordExpiredSessions(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, recorded)
+		mockAuditRepo.AssertExpectations(t)
+	})
+}
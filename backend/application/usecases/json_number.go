@@ -0,0 +1,44 @@
+package usecases
+
+import (
+	"math"
+	"strconv"
+)
+
+// レスポンスの数値表現
+// 金額は円単位の整数（外貨は小数点以下2桁）、割合・利率は小数点以下2桁に丸めて出力する。丸めはシリアライズ時のみ行い、内部の計算は全精度のまま扱う
+// encoding/json は 1e21 以上の値を指数表記で出力するため、strconv で固定小数点表記に整形する
+
+// yenJSON は円単位の整数に丸めてシリアライズする金額
+type yenJSON float64
+
+// MarshalJSON は金額を円単位の整数としてシリアライズする
+func (y yenJSON) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(roundForJSON(float64(y), 0), 'f', 0, 64)), nil
+}
+
+// currencyAmountJSON は小数点以下2桁（補助単位）に丸めてシリアライズする外貨の金額
+type currencyAmountJSON float64
+
+// MarshalJSON は外貨の金額を小数点以下2桁に丸めてシリアライズする
+func (c currencyAmountJSON) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(roundForJSON(float64(c), 2), 'f', -1, 64)), nil
+}
+
+// percentJSON は小数点以下2桁に丸めてシリアライズする割合・利率
+type percentJSON float64
+
+// MarshalJSON は割合・利率を小数点以下2桁に丸めてシリアライズする
+func (p percentJSON) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(roundForJSON(float64(p), 2), 'f', -1, 64)), nil
+}
+
+// roundForJSON は指定した小数点以下の桁数に丸める（-0 は 0 として扱う）
+func roundForJSON(value float64, decimals int) float64 {
+	scale := math.Pow10(decimals)
+	rounded := math.Round(value*scale) / scale
+	if rounded == 0 {
+		return 0
+	}
+	return rounded
+}
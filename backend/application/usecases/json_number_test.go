@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectJSONNumbers はJSON中のすべての数値をリテラルの文字列のまま返す
+func collectJSONNumbers(t *testing.T, data []byte) []string {
+	t.Helper()
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var numbers []string
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			break
+		}
+		if number, ok := token.(json.Number); ok {
+			numbers = append(numbers, number.String())
+		}
+	}
+	return numbers
+}
+
+func TestResponseNumberFormatting(t *testing.T) {
+	// 桁の多い金額・割り切れない割合を含むレスポンスのフィクスチャ
+	fixture := struct {
+		Summary     ProjectionSummary          `json:"summary"`
+		Situation   CurrentSituation           `json:"current_situation"`
+		Health      FinancialHealth            `json:"financial_health"`
+		Goals       GoalsSummary               `json:"goals_summary"`
+		Progress    []GoalProgressProjection   `json:"projection"`
+		Projections []entities.AssetProjection `json:"projections"`
+	}{
+		Summary: ProjectionSummary{
+			InitialAmount:    3749967.04,
+			FinalAmount:      2.5e21,
+			TotalGrowth:      35588790.14,
+			GrowthPercentage: 849.0427451863685,
+			AverageReturn:    84.90427451863685,
+			TargetAmount:     50000000.0,
+		},
+		Situation: CurrentSituation{
+			MonthlyIncome:    400000.4,
+			NetMonthlyIncome: 312345.678,
+			MonthlyExpenses:  180000,
+			NetSavings:       132345.678,
+			TotalAssets:      1e21,
+			InvestmentReturn: 5.000000000000001,
+			InflationRate:    2,
+			NetWorth:         -0.3,
+		},
+		Health: FinancialHealth{
+			OverallScore:       72,
+			SavingsRate:        83.33333333333334,
+			DebtToIncomeRatio:  0.000001,
+			EmergencyFundRatio: 5.555555555555555,
+		},
+		Goals: GoalsSummary{
+			TotalGoals:      3,
+			TotalTarget:     12000000,
+			TotalCurrent:    3333333.3333,
+			OverallProgress: 27.777777777777775,
+		},
+		Progress: []GoalProgressProjection{
+			{Week: 1, Date: "2025-01-08", ProjectedAmount: 123456.789, ProgressRate: 41.15226337448559},
+		},
+		Projections: []entities.AssetProjection{
+			{Year: 1, TotalAssets: mustNewMoney(1234567.89), RealValue: mustNewMoney(1210360.68)},
+		},
+	}
+
+	data, err := json.Marshal(fixture)
+	require.NoError(t, err)
+
+	numbers := collectJSONNumbers(t, data)
+	require.NotEmpty(t, numbers)
+	for _, number := range numbers {
+		assert.NotContains(t, strings.ToLower(number), "e", "指数表記で出力されています: %s", number)
+		assert.NotEqual(t, "-0", number)
+		if dot := strings.Index(number, "."); dot >= 0 {
+			assert.LessOrEqual(t, len(number)-dot-1, 2, "小数点以下が2桁を超えています: %s", number)
+		}
+	}
+
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	summary := decoded["summary"].(map[string]any)
+	assert.Equal(t, 3749967.0, summary["initial_amount"])
+	assert.Equal(t, 849.04, summary["growth_percentage"])
+	health := decoded["financial_health"].(map[string]any)
+	assert.Equal(t, 83.33, health["savings_rate"])
+	assert.Equal(t, 0.0, health["debt_to_income_ratio"])
+
+	// 丸めはシリアライズ時のみで、内部の値は全精度のまま
+	assert.Equal(t, 83.33333333333334, fixture.Health.SavingsRate)
+}
+
+func TestFinancialHealthCache_KeepsFullPrecision(t *testing.T) {
+	original := financialHealthCache{
+		FinancialHealth:  FinancialHealth{OverallScore: 72, SavingsRate: 83.33333333333334, EmergencyFundRatio: 5.555555555555555},
+		CurrentSituation: CurrentSituation{NetMonthlyIncome: 312345.678, InvestmentReturn: 5.000000000000001},
+	}
+
+	payload, err := json.Marshal(original)
+	require.NoError(t, err)
+
+	var restored financialHealthCache
+	require.NoError(t, json.Unmarshal(payload, &restored))
+	assert.Equal(t, original, restored)
+}
@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// maxActiveAPIKeysPerUser はユーザーごとに保持できる有効なAPIキーの上限
+const maxActiveAPIKeysPerUser = 10
+
+// ErrInvalidAPIKey はAPIキーが存在しない、または失効している場合のエラー
+var ErrInvalidAPIKey = errors.New("無効なAPIキーです")
+
+// ManageAPIKeysUseCase は外部ツール連携用APIキーの発行・失効・認証のユースケース
+type ManageAPIKeysUseCase interface {
+	// IssueAPIKey は新しいAPIキーを発行する（平文キーはこのときだけ返却する）
+	IssueAPIKey(ctx context.Context, input IssueAPIKeyInput) (*IssueAPIKeyOutput, error)
+
+	// ListAPIKeys はユーザーのAPIキー一覧を取得する（キーは末尾のみ表示）
+	ListAPIKeys(ctx context.Context, input ListAPIKeysInput) (*ListAPIKeysOutput, error)
+
+	// RevokeAPIKey はAPIキーを失効させる
+	RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) error
+
+	// AuthenticateAPIKey は平文のAPIキーを検証し、最終使用日時を更新する
+	AuthenticateAPIKey(ctx context.Context, key string) (*entities.APIKey, error)
+}
+
+// IssueAPIKeyInput はAPIキー発行の入力
+type IssueAPIKeyInput struct {
+	UserID entities.UserID `json:"user_id"`
+	Name   string          `json:"name"`
+	Scopes []string        `json:"scopes"`
+}
+
+// IssueAPIKeyOutput はAPIキー発行の出力
+type IssueAPIKeyOutput struct {
+	APIKey *entities.APIKey `json:"api_key"`
+	Key    string           `json:"key"` // 平文のAPIキー（再表示できない）
+}
+
+// ListAPIKeysInput はAPIキー一覧取得の入力
+type ListAPIKeysInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// ListAPIKeysOutput はAPIキー一覧取得の出力
+type ListAPIKeysOutput struct {
+	APIKeys []*entities.APIKey `json:"api_keys"`
+}
+
+// RevokeAPIKeyInput はAPIキー失効の入力
+type RevokeAPIKeyInput struct {
+	UserID   entities.UserID   `json:"user_id"`
+	APIKeyID entities.APIKeyID `json:"api_key_id"`
+}
+
+// manageAPIKeysUseCaseImpl はManageAPIKeysUseCaseの実装
+type manageAPIKeysUseCaseImpl struct {
+	apiKeyRepo repositories.APIKeyRepository
+	logger     *log.UseCaseLogger
+}
+
+// NewManageAPIKeysUseCase は新しいManageAPIKeysUseCaseを作成する
+func NewManageAPIKeysUseCase(apiKeyRepo repositories.APIKeyRepository) ManageAPIKeysUseCase {
+	return &manageAPIKeysUseCaseImpl{
+		apiKeyRepo: apiKeyRepo,
+		logger:     log.NewUseCaseLogger("ManageAPIKeysUseCase"),
+	}
+}
+
+// IssueAPIKey は新しいAPIキーを発行する
+func (uc *manageAPIKeysUseCaseImpl) IssueAPIKey(ctx context.Context, input IssueAPIKeyInput) (*IssueAPIKeyOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "IssueAPIKey",
+		log.UserID(string(input.UserID)),
+	)
+
+	existing, err := uc.apiKeyRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "IssueAPIKey", err,
+			slog.String("step", "find_api_keys"),
+		)
+		return nil, fmt.Errorf("APIキーの取得に失敗しました: %w", err)
+	}
+
+	activeCount := 0
+	for _, apiKey := range existing {
+		if !apiKey.IsRevoked() {
+			activeCount++
+		}
+	}
+	if activeCount >= maxActiveAPIKeysPerUser {
+		return nil, fmt.Errorf("有効なAPIキーは%d個までです。不要なキーを失効させてください", maxActiveAPIKeysPerUser)
+	}
+
+	scopes := make([]entities.APIKeyScope, len(input.Scopes))
+	for i, scope := range input.Scopes {
+		scopes[i] = entities.APIKeyScope(scope)
+	}
+
+	apiKey, key, err := entities.NewAPIKey(input.UserID, input.Name, scopes)
+	if err != nil {
+		uc.logger.OperationError(ctx, "IssueAPIKey", err,
+			slog.String("step", "create_api_key"),
+		)
+		return nil, fmt.Errorf("APIキーの作成に失敗しました: %w", err)
+	}
+
+	if err := uc.apiKeyRepo.Save(ctx, apiKey); err != nil {
+		uc.logger.OperationError(ctx, "IssueAPIKey", err,
+			slog.String("step", "save_api_key"),
+		)
+		return nil, fmt.Errorf("APIキーの保存に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "IssueAPIKey",
+		slog.String("api_key_id", apiKey.ID().String()),
+	)
+
+	return &IssueAPIKeyOutput{APIKey: apiKey, Key: key}, nil
+}
+
+// ListAPIKeys はユーザーのAPIキー一覧を取得する
+func (uc *manageAPIKeysUseCaseImpl) ListAPIKeys(ctx context.Context, input ListAPIKeysInput) (*ListAPIKeysOutput, error) {
+	apiKeys, err := uc.apiKeyRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("APIキーの取得に失敗しました: %w", err)
+	}
+	if apiKeys == nil {
+		apiKeys = []*entities.APIKey{}
+	}
+
+	return &ListAPIKeysOutput{APIKeys: apiKeys}, nil
+}
+
+// RevokeAPIKey はAPIキーを失効させる
+// 他のユーザーのキーは存在しないものとして扱う
+func (uc *manageAPIKeysUseCaseImpl) RevokeAPIKey(ctx context.Context, input RevokeAPIKeyInput) error {
+	ctx = uc.logger.StartOperation(ctx, "RevokeAPIKey",
+		log.UserID(string(input.UserID)),
+		slog.String("api_key_id", input.APIKeyID.String()),
+	)
+
+	apiKey, err := uc.apiKeyRepo.FindByID(ctx, input.APIKeyID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "RevokeAPIKey", err,
+			slog.String("step", "find_api_key"),
+		)
+		return fmt.Errorf("APIキーの取得に失敗しました: %w", err)
+	}
+	if apiKey.UserID() != input.UserID {
+		return errors.New("APIキーが見つかりません")
+	}
+
+	if err := apiKey.Revoke(); err != nil {
+		return err
+	}
+
+	if err := uc.apiKeyRepo.Update(ctx, apiKey); err != nil {
+		uc.logger.OperationError(ctx, "RevokeAPIKey", err,
+			slog.String("step", "update_api_key"),
+		)
+		return fmt.Errorf("APIキーの更新に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "RevokeAPIKey")
+	return nil
+}
+
+// AuthenticateAPIKey は平文のAPIキーを検証し、最終使用日時を更新する
+func (uc *manageAPIKeysUseCaseImpl) AuthenticateAPIKey(ctx context.Context, key string) (*entities.APIKey, error) {
+	if key == "" {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiKey, err := uc.apiKeyRepo.FindByKeyHash(ctx, entities.HashAPIKey(key))
+	if err != nil || !apiKey.VerifyKey(key) {
+		return nil, ErrInvalidAPIKey
+	}
+
+	apiKey.MarkUsed()
+	if err := uc.apiKeyRepo.Update(ctx, apiKey); err != nil {
+		// 最終使用日時の更新失敗で認証自体は拒否しない
+		uc.logger.OperationError(ctx, "AuthenticateAPIKey", err,
+			slog.String("step", "update_last_used_at"),
+		)
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAPIKey はテスト用のAPIキーと平文キーを作成するヘルパー
+func newTestAPIKey(t *testing.T, userID entities.UserID, scopes ...entities.APIKeyScope) (*entities.APIKey, string) {
+	t.Helper()
+	apiKey, key, err := entities.NewAPIKey(userID, "テスト連携", scopes)
+	require.NoError(t, err)
+	return apiKey, key
+}
+
+func TestManageAPIKeysUseCase_IssueAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 平文キーは発行時のみ返却しハッシュで保存する", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.APIKey{}, nil)
+		mockRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		output, err := uc.IssueAPIKey(ctx, IssueAPIKeyInput{
+			UserID: "user-001",
+			Name:   "家計簿アプリ",
+			Scopes: []string{"read-only"},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.APIKey.VerifyKey(output.Key))
+		data, err := json.Marshal(output.APIKey)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), output.APIKey.KeyHash())
+		assert.NotContains(t, string(data), output.Key)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 無効なスコープ", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByUserID", mock_anything(), mock_anything()).Return([]*entities.APIKey{}, nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		_, err := uc.IssueAPIKey(ctx, IssueAPIKeyInput{UserID: "user-001", Name: "連携", Scopes: []string{"admin"}})
+
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 有効なキーの上限を超える", func(t *testing.T) {
+		existing := make([]*entities.APIKey, maxActiveAPIKeysPerUser)
+		for i := range existing {
+			existing[i], _ = newTestAPIKey(t, "user-001", entities.APIKeyScopeReadOnly)
+		}
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByUserID", mock_anything(), mock_anything()).Return(existing, nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		_, err := uc.IssueAPIKey(ctx, IssueAPIKeyInput{UserID: "user-001", Name: "連携", Scopes: []string{"read-only"}})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "までです")
+	})
+}
+
+func TestManageAPIKeysUseCase_RevokeAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 自分のキーを失効させる", func(t *testing.T) {
+		apiKey, _ := newTestAPIKey(t, "user-001", entities.APIKeyScopeReadOnly)
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByID", mock_anything(), apiKey.ID()).Return(apiKey, nil)
+		mockRepo.On("Update", mock_anything(), apiKey).Return(nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		err := uc.RevokeAPIKey(ctx, RevokeAPIKeyInput{UserID: "user-001", APIKeyID: apiKey.ID()})
+
+		require.NoError(t, err)
+		assert.True(t, apiKey.IsRevoked())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 他のユーザーのキーは見つからない扱い", func(t *testing.T) {
+		apiKey, _ := newTestAPIKey(t, "user-002", entities.APIKeyScopeReadOnly)
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByID", mock_anything(), apiKey.ID()).Return(apiKey, nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		err := uc.RevokeAPIKey(ctx, RevokeAPIKeyInput{UserID: "user-001", APIKeyID: apiKey.ID()})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "見つかりません")
+		assert.False(t, apiKey.IsRevoked())
+	})
+}
+
+func TestManageAPIKeysUseCase_AuthenticateAPIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 最終使用日時を更新する", func(t *testing.T) {
+		apiKey, key := newTestAPIKey(t, "user-001", entities.APIKeyScopeReports)
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByKeyHash", mock_anything(), entities.HashAPIKey(key)).Return(apiKey, nil)
+		mockRepo.On("Update", mock_anything(), apiKey).Return(nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		authenticated, err := uc.AuthenticateAPIKey(ctx, key)
+
+		require.NoError(t, err)
+		assert.Equal(t, apiKey.ID(), authenticated.ID())
+		assert.NotNil(t, authenticated.LastUsedAt())
+	})
+
+	t.Run("異常系: 失効したキー", func(t *testing.T) {
+		apiKey, key := newTestAPIKey(t, "user-001", entities.APIKeyScopeReports)
+		require.NoError(t, apiKey.Revoke())
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByKeyHash", mock_anything(), mock_anything()).Return(apiKey, nil)
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		_, err := uc.AuthenticateAPIKey(ctx, key)
+
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 存在しないキー", func(t *testing.T) {
+		mockRepo := new(MockAPIKeyRepository)
+		mockRepo.On("FindByKeyHash", mock_anything(), mock_anything()).Return(nil, errors.New("APIキーが見つかりません"))
+
+		uc := NewManageAPIKeysUseCase(mockRepo)
+		_, err := uc.AuthenticateAPIKey(ctx, "fpc_unknown")
+
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+}
@@ -1,14 +1,20 @@
 package usecases
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"strings"
+	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/financial-planning-calculator/backend/infrastructure/log"
 )
@@ -27,9 +33,21 @@ type ManageFinancialDataUseCase interface {
 	// UpdateRetirementData は退職データを更新する
 	UpdateRetirementData(ctx context.Context, input UpdateRetirementDataInput) (*UpdateRetirementDataOutput, error)
 
+	// ApplyPensionEstimate は年金見込み額を推定して退職データの年金額に反映する
+	ApplyPensionEstimate(ctx context.Context, input ApplyPensionEstimateInput) (*UpdateRetirementDataOutput, error)
+
+	// UpdateCurrentAge は退職データの現在の年齢を更新する
+	UpdateCurrentAge(ctx context.Context, input UpdateCurrentAgeInput) (*UpdateCurrentAgeOutput, error)
+
 	// UpdateEmergencyFund は緊急資金設定を更新する
 	UpdateEmergencyFund(ctx context.Context, input UpdateEmergencyFundInput) (*UpdateEmergencyFundOutput, error)
 
+	// ConfirmFinancialDataCurrent は財務データに変更がないことを確認し、最終確認日時を記録する
+	ConfirmFinancialDataCurrent(ctx context.Context, input ConfirmFinancialDataCurrentInput) (*ConfirmFinancialDataCurrentOutput, error)
+
+	// ImportBankCSV は銀行の入出金明細CSVを取り込み、支出と貯蓄に反映する
+	ImportBankCSV(ctx context.Context, input ImportBankCSVInput) (*ImportBankCSVOutput, error)
+
 	// DeleteFinancialPlan は財務計画を削除する
 	DeleteFinancialPlan(ctx context.Context, input DeleteFinancialPlanInput) error
 }
@@ -38,6 +56,10 @@ type ManageFinancialDataUseCase interface {
 type CreateFinancialPlanInput struct {
 	UserID                     entities.UserID `json:"user_id"`
 	MonthlyIncome              float64         `json:"monthly_income"`
+	IncomeType                 string          `json:"income_type,omitempty"`         // gross: 額面, net: 手取り（省略時）
+	BirthDate                  *string         `json:"birth_date,omitempty"`          // 生年月日（YYYY-MM-DD）
+	ExpectedVolatility         *float64        `json:"expected_volatility,omitempty"` // 想定ボラティリティ（年率%）。省略時は貯蓄の内訳から推定する
+	RiskTolerance              string          `json:"risk_tolerance,omitempty"`      // conservative: 安定重視, moderate: バランス（省略時）, aggressive: 積極運用
 	MonthlyExpenses            []ExpenseItem   `json:"monthly_expenses"`
 	CurrentSavings             []SavingsItem   `json:"current_savings"`
 	InvestmentReturn           float64         `json:"investment_return"`
@@ -47,6 +69,9 @@ type CreateFinancialPlanInput struct {
 	PensionAmount              *float64        `json:"pension_amount,omitempty"`
 	EmergencyFundTargetMonths  *int            `json:"emergency_fund_target_months,omitempty"`
 	EmergencyFundCurrentAmount *float64        `json:"emergency_fund_current_amount,omitempty"`
+	// 現実的な上限を超える想定利回り・インフレ率を承知の上で使用する場合に true を指定する
+	AcknowledgeHighReturn    bool `json:"acknowledge_high_return"`
+	AcknowledgeHighInflation bool `json:"acknowledge_high_inflation"`
 }
 
 // ExpenseItem は支出項目
@@ -88,16 +113,29 @@ type FinancialDataResponse struct {
 	EmergencyFund map[string]interface{} `json:"emergency_fund,omitempty"`
 	CreatedAt     string                 `json:"created_at,omitempty"`
 	UpdatedAt     string                 `json:"updated_at,omitempty"`
+	// Staleness は財務データを最後に更新・確認してからの経過と鮮度（古い場合は見直しを促す）
+	Staleness *aggregates.DataStaleness `json:"staleness,omitempty"`
+	// Version は財務計画のバージョン（更新リクエストの version に指定すると、他のセッションによる更新を上書きせずに検出できる）
+	Version int `json:"version,omitempty"`
 }
 
 // UpdateFinancialProfileInput は財務プロファイル更新の入力
 type UpdateFinancialProfileInput struct {
-	UserID           entities.UserID `json:"user_id"`
-	MonthlyIncome    float64         `json:"monthly_income"`
-	MonthlyExpenses  []ExpenseItem   `json:"monthly_expenses"`
-	CurrentSavings   []SavingsItem   `json:"current_savings"`
-	InvestmentReturn float64         `json:"investment_return"`
-	InflationRate    float64         `json:"inflation_rate"`
+	UserID             entities.UserID `json:"user_id"`
+	MonthlyIncome      float64         `json:"monthly_income"`
+	IncomeType         string          `json:"income_type,omitempty"`         // gross: 額面, net: 手取り（省略時）
+	BirthDate          *string         `json:"birth_date,omitempty"`          // 生年月日（YYYY-MM-DD）。省略時は既存の値を引き継ぐ
+	ExpectedVolatility *float64        `json:"expected_volatility,omitempty"` // 想定ボラティリティ（年率%）。省略時は既存の値を引き継ぐ
+	RiskTolerance      string          `json:"risk_tolerance,omitempty"`      // リスク許容度。省略時は既存の値を引き継ぐ
+	MonthlyExpenses    []ExpenseItem   `json:"monthly_expenses"`
+	CurrentSavings     []SavingsItem   `json:"current_savings"`
+	InvestmentReturn   float64         `json:"investment_return"`
+	InflationRate      float64         `json:"inflation_rate"`
+	// 現実的な上限を超える想定利回り・インフレ率を承知の上で使用する場合に true を指定する
+	AcknowledgeHighReturn    bool `json:"acknowledge_high_return"`
+	AcknowledgeHighInflation bool `json:"acknowledge_high_inflation"`
+	// Version は更新の元にした財務計画のバージョン。指定した場合、他のセッションで更新されていれば競合として更新しない
+	Version *int `json:"version,omitempty"`
 }
 
 // UpdateFinancialProfileOutput は財務プロファイル更新の出力
@@ -112,6 +150,14 @@ type UpdateRetirementDataInput struct {
 	RetirementAge             int             `json:"retirement_age"`
 	MonthlyRetirementExpenses float64         `json:"monthly_retirement_expenses"`
 	PensionAmount             float64         `json:"pension_amount"`
+	// KokuminNenkinMonthlyAmount は国民年金の月額
+	// 未指定で KokuminNenkinContributionYears が指定された場合は納付年数から推定し、どちらも未指定の場合は現在の値を引き継ぐ
+	KokuminNenkinMonthlyAmount     *float64 `json:"kokumin_nenkin_monthly_amount,omitempty"`
+	KokuminNenkinContributionYears *int     `json:"kokumin_nenkin_contribution_years,omitempty"`
+	// NurseCareInsuranceMonthly は介護保険料の月額（未指定の場合は現在の値を引き継ぐ）
+	NurseCareInsuranceMonthly *float64 `json:"nurse_care_insurance_monthly,omitempty"`
+	// Version は更新の元にした財務計画のバージョン（省略時は確認しない）
+	Version *int `json:"version,omitempty"`
 }
 
 // UpdateRetirementDataOutput は退職データ更新の出力
@@ -120,17 +166,72 @@ type UpdateRetirementDataOutput struct {
 	*FinancialDataResponse
 }
 
+// UpdateCurrentAgeInput は現在の年齢更新の入力
+type UpdateCurrentAgeInput struct {
+	UserID     entities.UserID `json:"user_id"`
+	CurrentAge int             `json:"current_age"`
+	Version    *int            `json:"version,omitempty"` // 更新の元にした財務計画のバージョン（省略時は確認しない）
+}
+
+// UpdateCurrentAgeOutput は現在の年齢更新の出力
+type UpdateCurrentAgeOutput struct {
+	*FinancialDataResponse
+}
+
+// ApplyPensionEstimateInput は年金見込み額反映の入力
+type ApplyPensionEstimateInput struct {
+	UserID            entities.UserID `json:"user_id"`
+	ContributionYears int             `json:"contribution_years"`
+	MonthlyIncome     float64         `json:"monthly_income"`
+	BirthYear         int             `json:"birth_year"`
+	Version           *int            `json:"version,omitempty"` // 更新の元にした財務計画のバージョン（省略時は確認しない）
+}
+
 // UpdateEmergencyFundInput は緊急資金設定更新の入力
 type UpdateEmergencyFundInput struct {
 	UserID        entities.UserID `json:"user_id"`
 	TargetMonths  int             `json:"target_months"`
 	CurrentAmount float64         `json:"current_amount"`
+	// MonthlyContribution は毎月の積立予定額。省略時は現在の設定を維持する
+	MonthlyContribution *float64 `json:"monthly_contribution,omitempty"`
+	// Version は更新の元にした財務計画のバージョン（省略時は確認しない）
+	Version *int `json:"version,omitempty"`
 }
 
 // UpdateEmergencyFundOutput は緊急資金設定更新の出力
 // フロントエンド向けに FinancialDataResponse を返す
 type UpdateEmergencyFundOutput struct {
 	*FinancialDataResponse
+	// Warnings は設定は保存したが見直しを推奨する事項（積立予定額が純貯蓄額を超えている場合など）
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ConfirmFinancialDataCurrentInput は財務データの現状確認の入力
+type ConfirmFinancialDataCurrentInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// ConfirmFinancialDataCurrentOutput は財務データの現状確認の出力
+// フロントエンド向けに FinancialDataResponse を返す
+type ConfirmFinancialDataCurrentOutput struct {
+	*FinancialDataResponse
+}
+
+// ImportBankCSVInput は銀行CSV取込の入力
+type ImportBankCSVInput struct {
+	UserID  entities.UserID     `json:"user_id"`
+	Format  ports.BankCSVFormat `json:"format"`
+	CSVData []byte              `json:"-"`
+}
+
+// ImportBankCSVOutput は銀行CSV取込の出力
+type ImportBankCSVOutput struct {
+	TransactionCount int           `json:"transaction_count"`
+	Months           int           `json:"months"`           // 明細に含まれる月数
+	Expenses         []ExpenseItem `json:"expenses"`         // 出金明細から作成した支出項目
+	Savings          []SavingsItem `json:"savings"`          // 入金明細から作成した貯蓄項目
+	MonthlyExpenses  []ExpenseItem `json:"monthly_expenses"` // カテゴリ別の月平均支出（プロファイルに反映した値）
+	*FinancialDataResponse
 }
 
 // DeleteFinancialPlanInput は財務計画削除の入力
@@ -138,29 +239,73 @@ type DeleteFinancialPlanInput struct {
 	UserID entities.UserID `json:"user_id"`
 }
 
+// HighAssumptionError は現実的な上限を超える想定値が同意なしに指定された場合のエラー
+type HighAssumptionError struct {
+	Warnings []services.AssumptionWarning
+}
+
+// Error はエラーメッセージを返す
+func (e *HighAssumptionError) Error() string {
+	return "想定値が現実的な上限を超えているため、同意が必要です"
+}
+
 // manageFinancialDataUseCaseImpl はManageFinancialDataUseCaseの実装
 type manageFinancialDataUseCaseImpl struct {
-	financialPlanRepo repositories.FinancialPlanRepository
-	logger            *log.UseCaseLogger
+	financialPlanRepo  repositories.FinancialPlanRepository
+	unitOfWork         repositories.UnitOfWork
+	bankCSVParsers     map[ports.BankCSVFormat]ports.BankCSVParser
+	calculationService *services.FinancialCalculationService
+	guardrailService   *services.AssumptionGuardrailService
+	taxService         *services.TaxEstimationService
+	logger             *log.UseCaseLogger
+	idGenerator        ports.IDGenerator
 }
 
 // NewManageFinancialDataUseCase は新しいManageFinancialDataUseCaseを作成する
+// bankCSVParsers が nil の場合、銀行CSV取込は利用できない
+// guardrailService が nil の場合、デフォルトの上限で想定値をチェックする
 func NewManageFinancialDataUseCase(
 	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+	bankCSVParsers map[ports.BankCSVFormat]ports.BankCSVParser,
+	guardrailService *services.AssumptionGuardrailService,
 ) ManageFinancialDataUseCase {
+	if guardrailService == nil {
+		guardrailService = services.NewDefaultAssumptionGuardrailService()
+	}
 	return &manageFinancialDataUseCaseImpl{
-		financialPlanRepo: financialPlanRepo,
-		logger:            log.NewUseCaseLogger("ManageFinancialDataUseCase"),
+		financialPlanRepo:  financialPlanRepo,
+		unitOfWork:         unitOfWork,
+		bankCSVParsers:     bankCSVParsers,
+		calculationService: services.NewFinancialCalculationService(),
+		guardrailService:   guardrailService,
+		taxService:         services.NewDefaultTaxEstimationService(),
+		logger:             log.NewUseCaseLogger("ManageFinancialDataUseCase"),
+		idGenerator:        ports.UUIDGenerator{},
 	}
 }
 
+// NewManageFinancialDataUseCaseWithIDGenerator は財務計画のIDを idGenerator で採番するManageFinancialDataUseCaseを作成する
+// テストで採番結果を固定する場合に使用する
+func NewManageFinancialDataUseCaseWithIDGenerator(
+	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+	bankCSVParsers map[ports.BankCSVFormat]ports.BankCSVParser,
+	guardrailService *services.AssumptionGuardrailService,
+	idGenerator ports.IDGenerator,
+) ManageFinancialDataUseCase {
+	uc := NewManageFinancialDataUseCase(financialPlanRepo, unitOfWork, bankCSVParsers, guardrailService).(*manageFinancialDataUseCaseImpl)
+	uc.idGenerator = idGenerator
+	return uc
+}
+
 // CreateFinancialPlan は新しい財務計画を作成する
 func (uc *manageFinancialDataUseCaseImpl) CreateFinancialPlan(
 	ctx context.Context,
 	input CreateFinancialPlanInput,
 ) (*CreateFinancialPlanOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "CreateFinancialPlan",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 	)
 
 	// 既存の財務計画があるかチェック
@@ -189,8 +334,17 @@ func (uc *manageFinancialDataUseCaseImpl) CreateFinancialPlan(
 		return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
 	}
 
+	// 想定値が現実的な上限を超える場合は同意を確認する
+	if err := uc.applyAssumptionAcknowledgements(profile, input.AcknowledgeHighReturn, input.AcknowledgeHighInflation); err != nil {
+		uc.logger.OperationError(ctx, "CreateFinancialPlan", err,
+			slog.String("step", "check_assumptions"),
+		)
+		return nil, err
+	}
+
 	// 財務計画を作成
-	plan, err := aggregates.NewFinancialPlan(profile)
+	now := time.Now()
+	plan, err := aggregates.NewFinancialPlanWithID(aggregates.FinancialPlanID(uc.idGenerator.NewID()), profile, now, now)
 	if err != nil {
 		uc.logger.OperationError(ctx, "CreateFinancialPlan", err,
 			slog.String("step", "create_plan"),
@@ -200,7 +354,7 @@ func (uc *manageFinancialDataUseCaseImpl) CreateFinancialPlan(
 
 	// 退職データが提供されている場合は設定
 	if input.RetirementAge != nil && input.MonthlyRetirementExpenses != nil && input.PensionAmount != nil {
-		retirementData, err := uc.createRetirementData(input.UserID, *input.RetirementAge, *input.MonthlyRetirementExpenses, *input.PensionAmount)
+		retirementData, err := uc.createRetirementData(profile, *input.RetirementAge, *input.MonthlyRetirementExpenses, *input.PensionAmount)
 		if err != nil {
 			uc.logger.OperationError(ctx, "CreateFinancialPlan", err,
 				slog.String("step", "create_retirement_data"),
@@ -227,15 +381,7 @@ func (uc *manageFinancialDataUseCaseImpl) CreateFinancialPlan(
 			return nil, fmt.Errorf("緊急資金額の作成に失敗しました: %w", err)
 		}
 
-		emergencyConfig, err := aggregates.NewEmergencyFundConfig(*input.EmergencyFundTargetMonths, currentFund)
-		if err != nil {
-			uc.logger.OperationError(ctx, "CreateFinancialPlan", err,
-				slog.String("step", "create_emergency_config"),
-			)
-			return nil, fmt.Errorf("緊急資金設定の作成に失敗しました: %w", err)
-		}
-
-		err = plan.UpdateEmergencyFund(emergencyConfig)
+		err = plan.UpdateEmergencyFundSettings(*input.EmergencyFundTargetMonths, currentFund)
 		if err != nil {
 			uc.logger.OperationError(ctx, "CreateFinancialPlan", err,
 				slog.String("step", "update_emergency_fund"),
@@ -270,7 +416,7 @@ func (uc *manageFinancialDataUseCaseImpl) GetFinancialPlan(
 	input GetFinancialPlanInput,
 ) (*GetFinancialPlanOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "GetFinancialPlan",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 	)
 
 	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
@@ -294,7 +440,7 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateFinancialProfile(
 	input UpdateFinancialProfileInput,
 ) (*UpdateFinancialProfileOutput, error) {
 	ctx = uc.logger.StartOperation(ctx, "UpdateFinancialProfile",
-		slog.String("user_id", string(input.UserID)),
+		log.UserID(string(input.UserID)),
 	)
 
 	// 既存の財務計画を取得
@@ -305,6 +451,12 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateFinancialProfile(
 		)
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
+	if err := checkPlanVersion(plan, input.Version); err != nil {
+		uc.logger.OperationError(ctx, "UpdateFinancialProfile", err,
+			slog.String("step", "check_version"),
+		)
+		return nil, err
+	}
 
 	// 新しい財務プロファイルを作成
 	profile, err := uc.createFinancialProfileFromUpdate(input)
@@ -315,6 +467,39 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateFinancialProfile(
 		return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
 	}
 
+	// 生年月日が省略された場合は既存の値を引き継ぐ
+	if input.BirthDate == nil {
+		if err := profile.SetBirthDate(plan.Profile().BirthDate()); err != nil {
+			uc.logger.OperationError(ctx, "UpdateFinancialProfile", err,
+				slog.String("step", "create_profile"),
+			)
+			return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
+		}
+	}
+
+	// 想定ボラティリティ・リスク許容度が省略された場合は既存の値を引き継ぐ
+	if input.ExpectedVolatility == nil {
+		if volatility, ok := plan.Profile().ExpectedVolatility(); ok {
+			profile.SetExpectedVolatility(&volatility)
+		}
+	}
+	if input.RiskTolerance == "" {
+		if err := profile.SetRiskTolerance(plan.Profile().RiskTolerance()); err != nil {
+			uc.logger.OperationError(ctx, "UpdateFinancialProfile", err,
+				slog.String("step", "create_profile"),
+			)
+			return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
+		}
+	}
+
+	// 想定値が現実的な上限を超える場合は同意を確認する
+	if err := uc.applyAssumptionAcknowledgements(profile, input.AcknowledgeHighReturn, input.AcknowledgeHighInflation); err != nil {
+		uc.logger.OperationError(ctx, "UpdateFinancialProfile", err,
+			slog.String("step", "check_assumptions"),
+		)
+		return nil, err
+	}
+
 	// 財務プロファイルを更新
 	err = plan.UpdateProfile(profile)
 	if err != nil {
@@ -339,19 +524,32 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateFinancialProfile(
 	return convertPlanToFinancialDataResponse(plan, input.UserID), nil
 }
 
+// checkPlanVersion は更新の元にしたバージョンが読み込んだ財務計画のバージョンと一致するか確認する
+// version が省略された場合は、version を送らない既存クライアントとの互換のため確認しない
+// その場合も保存時にリポジトリが読み込んだバージョンで更新するため、読み込みから保存までの間の競合は検出される
+func checkPlanVersion(plan *aggregates.FinancialPlan, version *int) error {
+	if version != nil && *version != plan.Version() {
+		return &repositories.VersionConflictError{CurrentVersion: plan.Version()}
+	}
+	return nil
+}
+
 // convertPlanToFinancialDataResponse は FinancialPlan を FinancialDataResponse に変換
 func convertPlanToFinancialDataResponse(plan *aggregates.FinancialPlan, userID entities.UserID) *UpdateFinancialProfileOutput {
-	if plan == nil {
-		return &UpdateFinancialProfileOutput{
-			FinancialDataResponse: &FinancialDataResponse{
-				UserID: string(userID),
-			},
-		}
+	return &UpdateFinancialProfileOutput{
+		FinancialDataResponse: NewFinancialDataResponse(plan, userID),
 	}
+}
 
+// NewFinancialDataResponse は FinancialPlan をフロントエンド向けの FinancialDataResponse に変換する
+// 取得（GET）と更新系のレスポンスで同じ内容を返すため、財務データを返すすべての経路でこの変換を使う
+func NewFinancialDataResponse(plan *aggregates.FinancialPlan, userID entities.UserID) *FinancialDataResponse {
 	response := &FinancialDataResponse{
 		UserID: string(userID),
 	}
+	if plan == nil {
+		return response
+	}
 
 	// Profile を変換（値オブジェクトをプリミティブに）
 	if profile := plan.Profile(); profile != nil {
@@ -382,21 +580,36 @@ func convertPlanToFinancialDataResponse(plan *aggregates.FinancialPlan, userID e
 		}
 
 		profileMap := map[string]interface{}{
-			"monthly_income":    profile.MonthlyIncome().Amount(),
-			"monthly_expenses":  expenses,
-			"current_savings":   savings,
-			"investment_return": profile.InvestmentReturn().AsPercentage(),
-			"inflation_rate":    profile.InflationRate().AsPercentage(),
+			"monthly_income":             profile.MonthlyIncome().Amount(),
+			"income_type":                string(profile.IncomeType()),
+			"net_monthly_income":         profile.NetMonthlyIncome().Amount(),
+			"monthly_expenses":           expenses,
+			"current_savings":            savings,
+			"investment_return":          profile.InvestmentReturn().AsPercentage(),
+			"inflation_rate":             profile.InflationRate().AsPercentage(),
+			"acknowledge_high_return":    profile.HighReturnAcknowledged(),
+			"acknowledge_high_inflation": profile.HighInflationAcknowledged(),
 		}
+		if birthDate := profile.BirthDate(); birthDate != nil {
+			profileMap["birth_date"] = birthDate.Format(birthDateLayout)
+			if age, ok := profile.CurrentAge(); ok {
+				profileMap["current_age"] = age
+			}
+		}
+		addRiskProfile(profileMap, profile)
+		addLiabilitySummary(profileMap, profile)
 		response.Profile = profileMap
 	}
 
 	// RetirementData を変換（値オブジェクトをプリミティブに）
 	if retirement := plan.RetirementData(); retirement != nil {
 		retirementMap := map[string]interface{}{
-			"retirement_age":              retirement.RetirementAge(),
-			"monthly_retirement_expenses": retirement.MonthlyRetirementExpenses().Amount(),
-			"pension_amount":              retirement.PensionAmount().Amount(),
+			"current_age":                   retirement.CurrentAge(),
+			"retirement_age":                retirement.RetirementAge(),
+			"monthly_retirement_expenses":   retirement.MonthlyRetirementExpenses().Amount(),
+			"pension_amount":                retirement.PensionAmount().Amount(),
+			"kokumin_nenkin_monthly_amount": retirement.KokuminNenkinMonthlyAmount().Amount(),
+			"nurse_care_insurance_monthly":  retirement.NurseCareInsuranceMonthly().Amount(),
 		}
 		response.Retirement = retirementMap
 	}
@@ -404,15 +617,18 @@ func convertPlanToFinancialDataResponse(plan *aggregates.FinancialPlan, userID e
 	// EmergencyFund を変換（値オブジェクトをプリミティブに）
 	if emergencyFund := plan.EmergencyFund(); emergencyFund != nil {
 		emergencyMap := map[string]interface{}{
-			"target_months": emergencyFund.TargetMonths,
-			"current_fund":  emergencyFund.CurrentFund.Amount(),
+			"target_months":        emergencyFund.TargetMonths(),
+			"current_fund":         emergencyFund.CurrentFund().Amount(),
+			"monthly_contribution": emergencyFund.MonthlyContribution().Amount(),
 		}
 		response.EmergencyFund = emergencyMap
 	}
 
-	return &UpdateFinancialProfileOutput{
-		FinancialDataResponse: response,
-	}
+	staleness := plan.Staleness(time.Now())
+	response.Staleness = &staleness
+	response.Version = plan.Version()
+
+	return response
 }
 
 // UpdateRetirementData は退職データを更新する
@@ -425,13 +641,20 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateRetirementData(
 	if err != nil {
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
+	if err := checkPlanVersion(plan, input.Version); err != nil {
+		return nil, err
+	}
 
 	// 退職データを作成
-	retirementData, err := uc.createRetirementData(input.UserID, input.RetirementAge, input.MonthlyRetirementExpenses, input.PensionAmount)
+	retirementData, err := uc.createRetirementData(plan.Profile(), input.RetirementAge, input.MonthlyRetirementExpenses, input.PensionAmount)
 	if err != nil {
 		return nil, fmt.Errorf("退職データの作成に失敗しました: %w", err)
 	}
 
+	if err := applyPublicBenefits(retirementData, plan.RetirementData(), input); err != nil {
+		return nil, err
+	}
+
 	// 退職データを設定
 	err = plan.SetRetirementData(retirementData)
 	if err != nil {
@@ -446,7 +669,83 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateRetirementData(
 
 	// フロントエンド向けレスポンスに変換して返す
 	return &UpdateRetirementDataOutput{
-		FinancialDataResponse: convertPlanToFinancialDataResponse(plan, input.UserID).FinancialDataResponse,
+		FinancialDataResponse: NewFinancialDataResponse(plan, input.UserID),
+	}, nil
+}
+
+// ApplyPensionEstimate は年金見込み額を推定して退職データの年金額に反映する
+func (uc *manageFinancialDataUseCaseImpl) ApplyPensionEstimate(
+	ctx context.Context,
+	input ApplyPensionEstimateInput,
+) (*UpdateRetirementDataOutput, error) {
+	// 既存の財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+	if err := checkPlanVersion(plan, input.Version); err != nil {
+		return nil, err
+	}
+
+	retirementData := plan.RetirementData()
+	if retirementData == nil {
+		return nil, errors.New("退職データが設定されていません")
+	}
+
+	// 年金見込み額を推定
+	monthlyPension := uc.calculationService.EstimateNationalPension(input.ContributionYears, input.MonthlyIncome, input.BirthYear)
+	if monthlyPension <= 0 {
+		return nil, errors.New("年金見込み額を推定できません")
+	}
+
+	pensionAmount, err := valueobjects.NewMoneyJPY(monthlyPension)
+	if err != nil {
+		return nil, fmt.Errorf("年金額の作成に失敗しました: %w", err)
+	}
+
+	if err := retirementData.UpdatePensionAmount(pensionAmount); err != nil {
+		return nil, fmt.Errorf("年金額の更新に失敗しました: %w", err)
+	}
+
+	// 財務計画を保存
+	err = uc.financialPlanRepo.Update(ctx, plan)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の保存に失敗しました: %w", err)
+	}
+
+	// フロントエンド向けレスポンスに変換して返す
+	return &UpdateRetirementDataOutput{
+		FinancialDataResponse: NewFinancialDataResponse(plan, input.UserID),
+	}, nil
+}
+
+// UpdateCurrentAge は退職データの現在の年齢を更新する
+// 年齢の検証は退職データが行い、生年月日が登録されている場合は更新できない
+func (uc *manageFinancialDataUseCaseImpl) UpdateCurrentAge(
+	ctx context.Context,
+	input UpdateCurrentAgeInput,
+) (*UpdateCurrentAgeOutput, error) {
+	// 既存の財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+	if err := checkPlanVersion(plan, input.Version); err != nil {
+		return nil, err
+	}
+
+	if err := plan.UpdateRetirementCurrentAge(input.CurrentAge); err != nil {
+		return nil, fmt.Errorf("現在の年齢の更新に失敗しました: %w", err)
+	}
+
+	// 財務計画を保存
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("財務計画の保存に失敗しました: %w", err)
+	}
+
+	// フロントエンド向けレスポンスに変換して返す
+	return &UpdateCurrentAgeOutput{
+		FinancialDataResponse: NewFinancialDataResponse(plan, input.UserID),
 	}, nil
 }
 
@@ -460,6 +759,9 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateEmergencyFund(
 	if err != nil {
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
+	if err := checkPlanVersion(plan, input.Version); err != nil {
+		return nil, err
+	}
 
 	// 緊急資金設定を作成
 	currentFund, err := valueobjects.NewMoneyJPY(input.CurrentAmount)
@@ -467,15 +769,20 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateEmergencyFund(
 		return nil, fmt.Errorf("緊急資金額の作成に失敗しました: %w", err)
 	}
 
-	emergencyConfig, err := aggregates.NewEmergencyFundConfig(input.TargetMonths, currentFund)
+	// 緊急資金設定を更新（積立履歴は保持する）
+	err = plan.UpdateEmergencyFundSettings(input.TargetMonths, currentFund)
 	if err != nil {
-		return nil, fmt.Errorf("緊急資金設定の作成に失敗しました: %w", err)
+		return nil, fmt.Errorf("緊急資金設定の更新に失敗しました: %w", err)
 	}
 
-	// 緊急資金設定を更新
-	err = plan.UpdateEmergencyFund(emergencyConfig)
-	if err != nil {
-		return nil, fmt.Errorf("緊急資金設定の更新に失敗しました: %w", err)
+	if input.MonthlyContribution != nil {
+		monthlyContribution, err := valueobjects.NewMoneyJPY(*input.MonthlyContribution)
+		if err != nil {
+			return nil, fmt.Errorf("緊急資金の月間積立額の作成に失敗しました: %w", err)
+		}
+		if err := plan.UpdateEmergencyFundMonthlyContribution(monthlyContribution); err != nil {
+			return nil, fmt.Errorf("緊急資金設定の更新に失敗しました: %w", err)
+		}
 	}
 
 	// 財務計画を保存
@@ -485,29 +792,146 @@ func (uc *manageFinancialDataUseCaseImpl) UpdateEmergencyFund(
 	}
 
 	// フロントエンド向けレスポンスに変換して返す
-	return &UpdateEmergencyFundOutput{
-		FinancialDataResponse: convertPlanToFinancialDataResponse(plan, input.UserID).FinancialDataResponse,
+	output := &UpdateEmergencyFundOutput{
+		FinancialDataResponse: NewFinancialDataResponse(plan, input.UserID),
+	}
+	if plan.EmergencyFundOvercommitted() {
+		output.Warnings = []string{aggregates.EmergencyFundOvercommitmentMessage}
+	}
+	return output, nil
+}
+
+// ConfirmFinancialDataCurrent は財務データに変更がないことを確認し、最終確認日時を記録する
+// データ自体は変更しないため、保存済みの計算結果は引き続き再利用される
+func (uc *manageFinancialDataUseCaseImpl) ConfirmFinancialDataCurrent(
+	ctx context.Context,
+	input ConfirmFinancialDataCurrentInput,
+) (*ConfirmFinancialDataCurrentOutput, error) {
+	// 既存の財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	plan.MarkReviewed(time.Now())
+
+	// 財務計画を保存
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("財務計画の保存に失敗しました: %w", err)
+	}
+
+	return &ConfirmFinancialDataCurrentOutput{
+		FinancialDataResponse: NewFinancialDataResponse(plan, input.UserID),
 	}, nil
 }
 
-// DeleteFinancialPlan は財務計画を削除する
-func (uc *manageFinancialDataUseCaseImpl) DeleteFinancialPlan(
+// ImportBankCSV は銀行の入出金明細CSVを取り込み、支出と貯蓄に反映する
+// 出金はキーワードで支出カテゴリに分類し、カテゴリ別の月平均額で同じカテゴリの既存支出を置き換える
+// 入金は預金として現在の貯蓄に追加する
+func (uc *manageFinancialDataUseCaseImpl) ImportBankCSV(
 	ctx context.Context,
-	input DeleteFinancialPlanInput,
-) error {
+	input ImportBankCSVInput,
+) (*ImportBankCSVOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "ImportBankCSV",
+		log.UserID(string(input.UserID)),
+		slog.String("format", string(input.Format)),
+	)
+
+	parser, ok := uc.bankCSVParsers[input.Format]
+	if !ok {
+		err := fmt.Errorf("サポートされていないCSV形式です: %s", input.Format)
+		uc.logger.OperationError(ctx, "ImportBankCSV", err,
+			slog.String("step", "select_parser"),
+		)
+		return nil, err
+	}
+
+	transactions, err := parser.Parse(bytes.NewReader(input.CSVData))
+	if err != nil {
+		uc.logger.OperationError(ctx, "ImportBankCSV", err,
+			slog.String("step", "parse_csv"),
+		)
+		return nil, fmt.Errorf("CSVの解析に失敗しました: %w", err)
+	}
+
 	// 既存の財務計画を取得
 	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
 	if err != nil {
-		return fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+		uc.logger.OperationError(ctx, "ImportBankCSV", err,
+			slog.String("step", "find_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
 
-	// 財務計画を削除
-	err = uc.financialPlanRepo.Delete(ctx, plan.ID())
+	expenses, savings := convertBankTransactions(transactions)
+	months := countTransactionMonths(transactions)
+	monthlyExpenses := averageMonthlyExpenses(expenses, months)
+
+	profile, err := uc.createFinancialProfileFromImport(plan.Profile(), monthlyExpenses, savings)
 	if err != nil {
-		return fmt.Errorf("財務計画の削除に失敗しました: %w", err)
+		uc.logger.OperationError(ctx, "ImportBankCSV", err,
+			slog.String("step", "create_profile"),
+		)
+		return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
 	}
 
-	return nil
+	if err := plan.UpdateProfile(profile); err != nil {
+		uc.logger.OperationError(ctx, "ImportBankCSV", err,
+			slog.String("step", "update_profile"),
+		)
+		return nil, fmt.Errorf("財務プロファイルの更新に失敗しました: %w", err)
+	}
+
+	// 財務計画を保存
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		uc.logger.OperationError(ctx, "ImportBankCSV", err,
+			slog.String("step", "save_plan"),
+		)
+		return nil, fmt.Errorf("財務計画の保存に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "ImportBankCSV",
+		slog.Int("transaction_count", len(transactions)),
+		slog.Int("months", months),
+	)
+
+	return &ImportBankCSVOutput{
+		TransactionCount:      len(transactions),
+		Months:                months,
+		Expenses:              expenses,
+		Savings:               savings,
+		MonthlyExpenses:       monthlyExpenses,
+		FinancialDataResponse: NewFinancialDataResponse(plan, input.UserID),
+	}, nil
+}
+
+// DeleteFinancialPlan は財務計画を削除する
+func (uc *manageFinancialDataUseCaseImpl) DeleteFinancialPlan(
+	ctx context.Context,
+	input DeleteFinancialPlanInput,
+) error {
+	// 取得から関連データの削除までを1つのトランザクションで行う
+	return uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		// 既存の財務計画を取得
+		plan, err := repos.FinancialPlans.FindByUserID(ctx, input.UserID)
+		if err != nil {
+			return fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+		}
+
+		// 財務計画に紐づく目標を削除
+		for _, goal := range plan.Goals() {
+			if err := repos.Goals.Delete(ctx, goal.ID()); err != nil {
+				return fmt.Errorf("目標の削除に失敗しました: %w", err)
+			}
+		}
+
+		// 財務計画を削除
+		if err := repos.FinancialPlans.Delete(ctx, plan.ID()); err != nil {
+			return fmt.Errorf("財務計画の削除に失敗しました: %w", err)
+		}
+
+		return nil
+	})
 }
 
 // createFinancialProfile は財務プロファイルを作成する
@@ -542,8 +966,14 @@ func (uc *manageFinancialDataUseCaseImpl) createFinancialProfile(input CreateFin
 		return nil, fmt.Errorf("インフレ率の作成に失敗しました: %w", err)
 	}
 
+	// 月収の入力区分を作成
+	incomeType, err := entities.ParseIncomeType(input.IncomeType)
+	if err != nil {
+		return nil, err
+	}
+
 	// 財務プロファイルを作成
-	return entities.NewFinancialProfile(
+	profile, err := entities.NewFinancialProfile(
 		input.UserID,
 		monthlyIncome,
 		*monthlyExpenses,
@@ -551,6 +981,22 @@ func (uc *manageFinancialDataUseCaseImpl) createFinancialProfile(input CreateFin
 		investmentReturn,
 		inflationRate,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 額面入力の場合は税・社会保険料を控除した手取りを推定する
+	if err := uc.taxService.ApplyIncomeType(profile, incomeType); err != nil {
+		return nil, err
+	}
+
+	if err := applyBirthDate(profile, input.BirthDate); err != nil {
+		return nil, err
+	}
+	if err := applyRiskSettings(profile, input.ExpectedVolatility, input.RiskTolerance); err != nil {
+		return nil, err
+	}
+	return profile, nil
 }
 
 // createFinancialProfileFromUpdate は更新用の財務プロファイルを作成する
@@ -585,8 +1031,14 @@ func (uc *manageFinancialDataUseCaseImpl) createFinancialProfileFromUpdate(input
 		return nil, fmt.Errorf("インフレ率の作成に失敗しました: %w", err)
 	}
 
+	// 月収の入力区分を作成
+	incomeType, err := entities.ParseIncomeType(input.IncomeType)
+	if err != nil {
+		return nil, err
+	}
+
 	// 財務プロファイルを作成
-	return entities.NewFinancialProfile(
+	profile, err := entities.NewFinancialProfile(
 		input.UserID,
 		monthlyIncome,
 		*monthlyExpenses,
@@ -594,6 +1046,118 @@ func (uc *manageFinancialDataUseCaseImpl) createFinancialProfileFromUpdate(input
 		investmentReturn,
 		inflationRate,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 額面入力の場合は税・社会保険料を控除した手取りを推定する
+	if err := uc.taxService.ApplyIncomeType(profile, incomeType); err != nil {
+		return nil, err
+	}
+
+	if err := applyBirthDate(profile, input.BirthDate); err != nil {
+		return nil, err
+	}
+	if err := applyRiskSettings(profile, input.ExpectedVolatility, input.RiskTolerance); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// applyBirthDate は YYYY-MM-DD 形式の生年月日をプロファイルに設定する
+// nil または空文字の場合は未設定のままにする
+func applyBirthDate(profile *entities.FinancialProfile, birthDate *string) error {
+	if birthDate == nil || *birthDate == "" {
+		return nil
+	}
+	date, err := time.Parse(birthDateLayout, *birthDate)
+	if err != nil {
+		return fmt.Errorf("生年月日はYYYY-MM-DD形式で指定してください: %w", err)
+	}
+	return profile.SetBirthDate(&date)
+}
+
+// applyRiskSettings は想定ボラティリティ（年率%）とリスク許容度をプロファイルに設定する
+// ボラティリティが nil の場合は未設定のまま（貯蓄の内訳から推定）、リスク許容度が空文字の場合はバランスにする
+func applyRiskSettings(profile *entities.FinancialProfile, expectedVolatility *float64, riskTolerance string) error {
+	tolerance, err := entities.ParseRiskTolerance(riskTolerance)
+	if err != nil {
+		return err
+	}
+	if err := profile.SetRiskTolerance(tolerance); err != nil {
+		return err
+	}
+	if expectedVolatility == nil {
+		return nil
+	}
+	volatility, err := valueobjects.NewRate(*expectedVolatility)
+	if err != nil {
+		return fmt.Errorf("想定ボラティリティの作成に失敗しました: %w", err)
+	}
+	profile.SetExpectedVolatility(&volatility)
+	return nil
+}
+
+// addRiskProfile は想定ボラティリティ・リスク許容度とリスク指標をレスポンスのプロファイルに追加する
+// ボラティリティが未設定の場合は推定値を返し、volatility_estimated を true にする
+func addRiskProfile(profileMap map[string]interface{}, profile *entities.FinancialProfile) {
+	_, configured := profile.ExpectedVolatility()
+	profileMap["expected_volatility"] = profile.Volatility().AsPercentage()
+	profileMap["volatility_estimated"] = !configured
+	profileMap["risk_tolerance"] = string(profile.RiskTolerance())
+	if sharpe, ok := profile.RiskAdjustedReturn(); ok {
+		profileMap["risk_adjusted_return"] = sharpe
+	}
+	profileMap["worst_case_return"] = profile.WorstCaseReturn()
+}
+
+// addLiabilitySummary はプロファイルのマップに負債残高の合計・純資産・月々の返済額を追加する
+// 計算できない項目は省略する
+func addLiabilitySummary(profileMap map[string]interface{}, profile *entities.FinancialProfile) {
+	if totalLiabilities, err := profile.TotalLiabilities(); err == nil {
+		profileMap["total_liabilities"] = totalLiabilities.Amount()
+	}
+	if netWorth, err := profile.NetWorth(); err == nil {
+		profileMap["net_worth"] = netWorth.Amount()
+	}
+	if debtPayments, err := profile.MonthlyDebtPayments(); err == nil {
+		profileMap["monthly_debt_payments"] = debtPayments.Amount()
+	}
+}
+
+// applyAssumptionAcknowledgements は想定値を現実的な上限と比較し、同意の有無をプロファイルに記録する
+// 上限を超える値に同意がない場合は HighAssumptionError を返す
+// 同意は上限を超える値についてのみ記録し、上限以内の値への同意は保持しない
+func (uc *manageFinancialDataUseCaseImpl) applyAssumptionAcknowledgements(
+	profile *entities.FinancialProfile,
+	acknowledgeHighReturn bool,
+	acknowledgeHighInflation bool,
+) error {
+	warnings := uc.guardrailService.CheckAssumptions(profile.InvestmentReturn(), profile.InflationRate())
+
+	var unacknowledged []services.AssumptionWarning
+	highReturn, highInflation := false, false
+	for _, warning := range warnings {
+		switch warning.Field {
+		case services.AssumptionFieldInvestmentReturn:
+			highReturn = true
+			if !acknowledgeHighReturn {
+				unacknowledged = append(unacknowledged, warning)
+			}
+		case services.AssumptionFieldInflationRate:
+			highInflation = true
+			if !acknowledgeHighInflation {
+				unacknowledged = append(unacknowledged, warning)
+			}
+		}
+	}
+
+	if len(unacknowledged) > 0 {
+		return &HighAssumptionError{Warnings: unacknowledged}
+	}
+
+	profile.AcknowledgeHighAssumptions(highReturn, highInflation)
+	return nil
 }
 
 // createExpenseCollection は支出コレクションを作成する
@@ -655,8 +1219,16 @@ func (uc *manageFinancialDataUseCaseImpl) createSavingsCollection(savings []Savi
 	return &collection, nil
 }
 
+const (
+	// birthDateLayout は生年月日の入力・出力形式
+	birthDateLayout = "2006-01-02"
+	// defaultCurrentAge は生年月日が未設定の場合に退職データの作成で仮定する年齢
+	defaultCurrentAge = 30
+)
+
 // createRetirementData は退職データを作成する
-func (uc *manageFinancialDataUseCaseImpl) createRetirementData(userID entities.UserID, retirementAge int, monthlyExpenses float64, pensionAmount float64) (*entities.RetirementData, error) {
+// 現在の年齢はプロファイルの生年月日から求め、未設定の場合は30歳と仮定する
+func (uc *manageFinancialDataUseCaseImpl) createRetirementData(profile *entities.FinancialProfile, retirementAge int, monthlyExpenses float64, pensionAmount float64) (*entities.RetirementData, error) {
 	monthlyRetirementExpenses, err := valueobjects.NewMoneyJPY(monthlyExpenses)
 	if err != nil {
 		return nil, fmt.Errorf("月間退職後支出の作成に失敗しました: %w", err)
@@ -667,12 +1239,14 @@ func (uc *manageFinancialDataUseCaseImpl) createRetirementData(userID entities.U
 		return nil, fmt.Errorf("年金額の作成に失敗しました: %w", err)
 	}
 
-	// 現在の年齢を仮定（実際の実装では別途取得が必要）
-	currentAge := 30     // デフォルト値
+	currentAge := defaultCurrentAge
+	if age, ok := profile.CurrentAge(); ok {
+		currentAge = age
+	}
 	lifeExpectancy := 85 // デフォルト値
 
 	return entities.NewRetirementData(
-		userID,
+		profile.UserID(),
 		currentAge,
 		retirementAge,
 		lifeExpectancy,
@@ -680,3 +1254,196 @@ func (uc *manageFinancialDataUseCaseImpl) createRetirementData(userID entities.U
 		pension,
 	)
 }
+
+// applyPublicBenefits は退職データ更新の入力から国民年金と介護保険料の月額を反映する
+// 入力で指定されなかった値は現在の退職データ（current）から引き継ぐ
+func applyPublicBenefits(retirementData, current *entities.RetirementData, input UpdateRetirementDataInput) error {
+	if current != nil {
+		if err := retirementData.RestorePublicBenefits(current.KokuminNenkinMonthlyAmount(), current.NurseCareInsuranceMonthly()); err != nil {
+			return fmt.Errorf("退職データの作成に失敗しました: %w", err)
+		}
+	}
+
+	switch {
+	case input.KokuminNenkinMonthlyAmount != nil:
+		amount, err := valueobjects.NewMoneyJPY(*input.KokuminNenkinMonthlyAmount)
+		if err != nil {
+			return fmt.Errorf("国民年金の月額の作成に失敗しました: %w", err)
+		}
+		if err := retirementData.UpdateKokuminNenkinMonthlyAmount(amount); err != nil {
+			return fmt.Errorf("国民年金の月額の設定に失敗しました: %w", err)
+		}
+	case input.KokuminNenkinContributionYears != nil:
+		amount, err := entities.EstimateKokuminNenkinMonthlyAmount(*input.KokuminNenkinContributionYears)
+		if err != nil {
+			return fmt.Errorf("国民年金の月額の推定に失敗しました: %w", err)
+		}
+		if err := retirementData.UpdateKokuminNenkinMonthlyAmount(amount); err != nil {
+			return fmt.Errorf("国民年金の月額の設定に失敗しました: %w", err)
+		}
+	}
+
+	if input.NurseCareInsuranceMonthly != nil {
+		amount, err := valueobjects.NewMoneyJPY(*input.NurseCareInsuranceMonthly)
+		if err != nil {
+			return fmt.Errorf("介護保険料の月額の作成に失敗しました: %w", err)
+		}
+		if err := retirementData.UpdateNurseCareInsuranceMonthly(amount); err != nil {
+			return fmt.Errorf("介護保険料の月額の設定に失敗しました: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// createFinancialProfileFromImport は既存の財務プロファイルに取込結果を反映した財務プロファイルを作成する
+// 月収・利回り・インフレ率とそれらへの同意は既存の値を引き継ぐ
+func (uc *manageFinancialDataUseCaseImpl) createFinancialProfileFromImport(
+	current *entities.FinancialProfile,
+	monthlyExpenses []ExpenseItem,
+	savings []SavingsItem,
+) (*entities.FinancialProfile, error) {
+	imported, err := uc.createExpenseCollection(monthlyExpenses)
+	if err != nil {
+		return nil, fmt.Errorf("月間支出の作成に失敗しました: %w", err)
+	}
+
+	importedCategories := make(map[string]bool, len(monthlyExpenses))
+	for _, expense := range monthlyExpenses {
+		importedCategories[expense.Category] = true
+	}
+
+	// 取込対象外のカテゴリの既存支出は残す
+	var expenses entities.ExpenseCollection
+	for _, expense := range current.MonthlyExpenses() {
+		if !importedCategories[expense.Category] {
+			expenses = append(expenses, expense)
+		}
+	}
+	expenses = append(expenses, *imported...)
+
+	importedSavings, err := uc.createSavingsCollection(savings)
+	if err != nil {
+		return nil, fmt.Errorf("現在の貯蓄の作成に失敗しました: %w", err)
+	}
+	currentSavings := append(entities.SavingsCollection{}, current.CurrentSavings()...)
+	currentSavings = append(currentSavings, *importedSavings...)
+
+	profile, err := entities.NewFinancialProfile(
+		current.UserID(),
+		current.MonthlyIncome(),
+		expenses,
+		currentSavings,
+		current.InvestmentReturn(),
+		current.InflationRate(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	profile.AcknowledgeHighAssumptions(current.HighReturnAcknowledged(), current.HighInflationAcknowledged())
+	if err := profile.SetIncomeType(current.IncomeType(), current.NetMonthlyIncome()); err != nil {
+		return nil, err
+	}
+	if err := profile.SetBirthDate(current.BirthDate()); err != nil {
+		return nil, err
+	}
+	if volatility, ok := current.ExpectedVolatility(); ok {
+		profile.SetExpectedVolatility(&volatility)
+	}
+	if err := profile.SetRiskTolerance(current.RiskTolerance()); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// bankExpenseCategoryRule は摘要のキーワードと支出カテゴリの対応
+type bankExpenseCategoryRule struct {
+	category string
+	keywords []string
+}
+
+// bankExpenseCategoryRules は摘要から支出カテゴリを判定するルール（先頭から順に評価する）
+var bankExpenseCategoryRules = []bankExpenseCategoryRule{
+	{category: "住居費", keywords: []string{"家賃", "ヤチン", "管理費", "住宅ローン"}},
+	{category: "水道光熱費", keywords: []string{"電気", "デンキ", "ガス", "水道", "スイドウ"}},
+	{category: "通信費", keywords: []string{"携帯", "通信", "電話", "インターネット", "ドコモ", "ソフトバンク", "au"}},
+	{category: "保険料", keywords: []string{"保険", "ホケン"}},
+	{category: "交通費", keywords: []string{"交通", "鉄道", "バス", "タクシー", "JR", "Suica", "PASMO"}},
+	{category: "医療費", keywords: []string{"病院", "医院", "クリニック", "薬局"}},
+	{category: "食費", keywords: []string{"食", "スーパー", "コンビニ", "レストラン"}},
+}
+
+// defaultBankExpenseCategory はどのルールにも該当しない出金のカテゴリ
+const defaultBankExpenseCategory = "その他"
+
+// categorizeBankTransaction は摘要のキーワードから支出カテゴリを判定する
+func categorizeBankTransaction(description string) string {
+	for _, rule := range bankExpenseCategoryRules {
+		for _, keyword := range rule.keywords {
+			if strings.Contains(description, keyword) {
+				return rule.category
+			}
+		}
+	}
+	return defaultBankExpenseCategory
+}
+
+// convertBankTransactions は出金明細を支出項目に、入金明細を預金の貯蓄項目に変換する
+func convertBankTransactions(transactions []ports.BankTransaction) ([]ExpenseItem, []SavingsItem) {
+	expenses := make([]ExpenseItem, 0, len(transactions))
+	savings := make([]SavingsItem, 0)
+
+	for _, transaction := range transactions {
+		description := transaction.Description
+		if transaction.IsWithdrawal() {
+			expenses = append(expenses, ExpenseItem{
+				Category:    categorizeBankTransaction(description),
+				Amount:      transaction.Withdrawal,
+				Description: &description,
+			})
+		}
+		if transaction.Deposit > 0 {
+			savings = append(savings, SavingsItem{
+				Type:        "deposit",
+				Amount:      transaction.Deposit,
+				Description: &description,
+			})
+		}
+	}
+
+	return expenses, savings
+}
+
+// countTransactionMonths は明細に含まれる年月の数を返す（最低1）
+func countTransactionMonths(transactions []ports.BankTransaction) int {
+	months := make(map[string]bool)
+	for _, transaction := range transactions {
+		months[transaction.Date.Format("2006-01")] = true
+	}
+	if len(months) == 0 {
+		return 1
+	}
+	return len(months)
+}
+
+// averageMonthlyExpenses は支出項目をカテゴリ別に集計し、月平均額を返す
+// カテゴリは最初に出現した順に並べる
+func averageMonthlyExpenses(expenses []ExpenseItem, months int) []ExpenseItem {
+	totals := make(map[string]float64)
+	var categories []string
+	for _, expense := range expenses {
+		if _, exists := totals[expense.Category]; !exists {
+			categories = append(categories, expense.Category)
+		}
+		totals[expense.Category] += expense.Amount
+	}
+
+	result := make([]ExpenseItem, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, ExpenseItem{
+			Category: category,
+			Amount:   math.Round(totals[category] / float64(months)),
+		})
+	}
+	return result
+}
@@ -3,12 +3,19 @@ package usecases
 import (
 	"context"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/application/testutil"
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -65,11 +72,11 @@ func TestManageFinancialDataUseCase_CreateFinancialPlan(t *testing.T) {
 		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
 		mockRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCaseWithIDGenerator(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil, testutil.NewSequentialIDGenerator())
 		output, err := uc.CreateFinancialPlan(ctx, baseInput)
 
 		require.NoError(t, err)
-		assert.NotEmpty(t, output.PlanID)
+		assert.Equal(t, aggregates.FinancialPlanID(testutil.SequentialID(1)), output.PlanID)
 		assert.Equal(t, entities.UserID("user-001"), output.UserID)
 		mockRepo.AssertExpectations(t)
 	})
@@ -78,7 +85,7 @@ func TestManageFinancialDataUseCase_CreateFinancialPlan(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(true, nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.CreateFinancialPlan(ctx, baseInput)
 
 		require.Error(t, err)
@@ -90,7 +97,7 @@ func TestManageFinancialDataUseCase_CreateFinancialPlan(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, errors.New("db error"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.CreateFinancialPlan(ctx, baseInput)
 
 		require.Error(t, err)
@@ -103,7 +110,7 @@ func TestManageFinancialDataUseCase_CreateFinancialPlan(t *testing.T) {
 		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
 		mockRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.CreateFinancialPlan(ctx, baseInput)
 
 		require.Error(t, err)
@@ -123,7 +130,7 @@ func TestManageFinancialDataUseCase_GetFinancialPlan(t *testing.T) {
 		plan := newTestFinancialPlan("user-001")
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		output, err := uc.GetFinancialPlan(ctx, GetFinancialPlanInput{UserID: "user-001"})
 
 		require.NoError(t, err)
@@ -135,7 +142,7 @@ func TestManageFinancialDataUseCase_GetFinancialPlan(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.GetFinancialPlan(ctx, GetFinancialPlanInput{UserID: "user-999"})
 
 		require.Error(t, err)
@@ -164,7 +171,7 @@ func TestManageFinancialDataUseCase_UpdateFinancialProfile(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		output, err := uc.UpdateFinancialProfile(ctx, input)
 
 		require.NoError(t, err)
@@ -176,7 +183,7 @@ func TestManageFinancialDataUseCase_UpdateFinancialProfile(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.UpdateFinancialProfile(ctx, input)
 
 		require.Error(t, err)
@@ -189,7 +196,7 @@ func TestManageFinancialDataUseCase_UpdateFinancialProfile(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.UpdateFinancialProfile(ctx, input)
 
 		require.Error(t, err)
@@ -197,10 +204,390 @@ func TestManageFinancialDataUseCase_UpdateFinancialProfile(t *testing.T) {
 	})
 }
 
+// ===========================
+// Assumption Guardrail Tests
+// ===========================
+
+func TestManageFinancialDataUseCase_AssumptionGuardrail(t *testing.T) {
+	ctx := context.Background()
+
+	newInput := func(investmentReturn, inflationRate float64) CreateFinancialPlanInput {
+		return CreateFinancialPlanInput{
+			UserID:           "user-001",
+			MonthlyIncome:    400000,
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn: investmentReturn,
+			InflationRate:    inflationRate,
+		}
+	}
+
+	t.Run("正常系: 上限以内の想定値は同意なしで作成できる", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		var saved *aggregates.FinancialPlan
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+		mockRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*aggregates.FinancialPlan)
+		}).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, newInput(5.0, 2.0))
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.False(t, saved.Profile().HighReturnAcknowledged())
+		assert.False(t, saved.Profile().HighInflationAcknowledged())
+	})
+
+	t.Run("異常系: 上限を超える想定利回りは同意がないと作成できない", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.CreateFinancialPlan(ctx, newInput(20.0, 2.0))
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		var highErr *HighAssumptionError
+		require.True(t, errors.As(err, &highErr))
+		require.Len(t, highErr.Warnings, 1)
+		assert.Equal(t, services.AssumptionFieldInvestmentReturn, highErr.Warnings[0].Field)
+		assert.Equal(t, 20.0, highErr.Warnings[0].Value)
+		assert.Equal(t, services.DefaultRealisticReturnCeiling, highErr.Warnings[0].Ceiling)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 同意があれば上限を超える想定利回りで作成でき、同意が保存される", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		var saved *aggregates.FinancialPlan
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+		mockRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*aggregates.FinancialPlan)
+		}).Return(nil)
+
+		input := newInput(20.0, 2.0)
+		input.AcknowledgeHighReturn = true
+		// 上限以内のインフレ率への同意は記録されない
+		input.AcknowledgeHighInflation = true
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, input)
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.True(t, saved.Profile().HighReturnAcknowledged())
+		assert.False(t, saved.Profile().HighInflationAcknowledged())
+	})
+
+	t.Run("異常系: 利回りへの同意だけでは高いインフレ率は受け付けない", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+
+		input := newInput(20.0, 15.0)
+		input.AcknowledgeHighReturn = true
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, input)
+
+		var highErr *HighAssumptionError
+		require.True(t, errors.As(err, &highErr))
+		require.Len(t, highErr.Warnings, 1)
+		assert.Equal(t, services.AssumptionFieldInflationRate, highErr.Warnings[0].Field)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 設定した上限が使われる", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+
+		guardrail := services.NewAssumptionGuardrailService(6.0, 5.0)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, guardrail)
+		_, err := uc.CreateFinancialPlan(ctx, newInput(7.0, 2.0))
+
+		var highErr *HighAssumptionError
+		require.True(t, errors.As(err, &highErr))
+		assert.Equal(t, 6.0, highErr.Warnings[0].Ceiling)
+	})
+
+	t.Run("異常系: 上限を超える値への更新は同意がないと保存しない", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateFinancialProfile(ctx, UpdateFinancialProfileInput{
+			UserID:           "user-001",
+			MonthlyIncome:    400000,
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn: 20.0,
+			InflationRate:    2.0,
+		})
+
+		var highErr *HighAssumptionError
+		require.True(t, errors.As(err, &highErr))
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 同意があれば上限を超える値に更新できる", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateFinancialProfile(ctx, UpdateFinancialProfileInput{
+			UserID:                "user-001",
+			MonthlyIncome:         400000,
+			MonthlyExpenses:       []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:        []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn:      20.0,
+			InflationRate:         2.0,
+			AcknowledgeHighReturn: true,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, plan.Profile().HighReturnAcknowledged())
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 // ===========================
 // DeleteFinancialPlan Tests
 // ===========================
 
+func TestManageFinancialDataUseCase_IncomeType(t *testing.T) {
+	ctx := context.Background()
+
+	newInput := func(monthlyIncome float64, incomeType string) CreateFinancialPlanInput {
+		return CreateFinancialPlanInput{
+			UserID:           "user-001",
+			MonthlyIncome:    monthlyIncome,
+			IncomeType:       incomeType,
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn: 5.0,
+			InflationRate:    2.0,
+		}
+	}
+
+	createPlan := func(t *testing.T, input CreateFinancialPlanInput) *aggregates.FinancialPlan {
+		mockRepo := new(MockFinancialPlanRepository)
+		var saved *aggregates.FinancialPlan
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+		mockRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*aggregates.FinancialPlan)
+		}).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, input)
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		return saved
+	}
+
+	t.Run("正常系: 入力区分を省略すると手取りとして扱う", func(t *testing.T) {
+		plan := createPlan(t, newInput(400000, ""))
+
+		assert.Equal(t, entities.IncomeTypeNet, plan.Profile().IncomeType())
+		assert.Equal(t, 400000.0, plan.Profile().NetMonthlyIncome().Amount())
+	})
+
+	t.Run("正常系: 額面入力と推定された手取りでの入力は同じ資産推移になる", func(t *testing.T) {
+		grossPlan := createPlan(t, newInput(500000, "gross"))
+		netMonthlyIncome := grossPlan.Profile().NetMonthlyIncome().Amount()
+		assert.Equal(t, entities.IncomeTypeGross, grossPlan.Profile().IncomeType())
+		assert.Equal(t, 500000.0, grossPlan.Profile().MonthlyIncome().Amount())
+		assert.Less(t, netMonthlyIncome, 500000.0)
+
+		netPlan := createPlan(t, newInput(netMonthlyIncome, "net"))
+
+		grossProjections, err := grossPlan.Profile().ProjectAssets(10)
+		require.NoError(t, err)
+		netProjections, err := netPlan.Profile().ProjectAssets(10)
+		require.NoError(t, err)
+		assert.Equal(t, netProjections[9].TotalAssets.Amount(), grossProjections[9].TotalAssets.Amount())
+
+		// 額面と手取りの両方を返す
+		response := convertPlanToFinancialDataResponse(grossPlan, "user-001")
+		assert.Equal(t, 500000.0, response.Profile["monthly_income"])
+		assert.Equal(t, "gross", response.Profile["income_type"])
+		assert.Equal(t, netMonthlyIncome, response.Profile["net_monthly_income"])
+	})
+
+	t.Run("異常系: 無効な入力区分はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, newInput(400000, "monthly"))
+
+		require.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageFinancialDataUseCase_BirthDate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	birthDate := time.Date(now.Year()-45, 1, 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+
+	t.Run("正常系: 退職データの年齢はプロファイルの生年月日から求める", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		var saved *aggregates.FinancialPlan
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+		mockRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*aggregates.FinancialPlan)
+		}).Return(nil)
+
+		retirementAge := 65
+		monthlyRetirementExpenses := 250000.0
+		pensionAmount := 150000.0
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, CreateFinancialPlanInput{
+			UserID:                    "user-001",
+			MonthlyIncome:             400000,
+			BirthDate:                 &birthDate,
+			MonthlyExpenses:           []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:            []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn:          5.0,
+			InflationRate:             2.0,
+			RetirementAge:             &retirementAge,
+			MonthlyRetirementExpenses: &monthlyRetirementExpenses,
+			PensionAmount:             &pensionAmount,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		age, ok := saved.CurrentAge()
+		require.True(t, ok)
+		assert.Equal(t, 45, age)
+		require.NotNil(t, saved.RetirementData())
+		assert.Equal(t, 45, saved.RetirementData().CurrentAge())
+		assert.Equal(t, 20, saved.RetirementData().CalculateYearsUntilRetirement())
+	})
+
+	t.Run("正常系: 更新時に生年月日を省略すると既存の値を引き継ぐ", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		require.NoError(t, applyBirthDate(plan.Profile(), &birthDate))
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.UpdateFinancialProfile(ctx, UpdateFinancialProfileInput{
+			UserID:           "user-001",
+			MonthlyIncome:    500000,
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 150000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 2000000}},
+			InvestmentReturn: 6.0,
+			InflationRate:    2.5,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, plan.Profile().BirthDate())
+		assert.Equal(t, birthDate, output.Profile["birth_date"])
+		assert.Equal(t, 45, output.Profile["current_age"])
+	})
+
+	t.Run("異常系: 生年月日の形式が不正な場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+
+		invalid := "1980/01/01"
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, CreateFinancialPlanInput{
+			UserID:           "user-001",
+			MonthlyIncome:    400000,
+			BirthDate:        &invalid,
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn: 5.0,
+			InflationRate:    2.0,
+		})
+
+		require.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageFinancialDataUseCase_RiskSettings(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 想定ボラティリティとリスク許容度を設定できる", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		var saved *aggregates.FinancialPlan
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+		mockRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*aggregates.FinancialPlan)
+		}).Return(nil)
+
+		volatility := 12.0
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, CreateFinancialPlanInput{
+			UserID:             "user-001",
+			MonthlyIncome:      400000,
+			ExpectedVolatility: &volatility,
+			RiskTolerance:      "conservative",
+			MonthlyExpenses:    []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:     []SavingsItem{{Type: "investment", Amount: 1000000}},
+			InvestmentReturn:   5.0,
+			InflationRate:      2.0,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		configured, ok := saved.Profile().ExpectedVolatility()
+		require.True(t, ok)
+		assert.Equal(t, 12.0, configured.AsPercentage())
+		assert.Equal(t, entities.RiskToleranceConservative, saved.Profile().RiskTolerance())
+	})
+
+	t.Run("正常系: 更新時に省略すると既存の値を引き継ぐ", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		volatility := 18.0
+		require.NoError(t, applyRiskSettings(plan.Profile(), &volatility, "aggressive"))
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.UpdateFinancialProfile(ctx, UpdateFinancialProfileInput{
+			UserID:           "user-001",
+			MonthlyIncome:    500000,
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 150000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 2000000}},
+			InvestmentReturn: 6.0,
+			InflationRate:    2.5,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 18.0, output.Profile["expected_volatility"])
+		assert.Equal(t, false, output.Profile["volatility_estimated"])
+		assert.Equal(t, "aggressive", output.Profile["risk_tolerance"])
+		assert.InDelta(t, 6.0-2*18.0, output.Profile["worst_case_return"], 1e-9)
+	})
+
+	t.Run("異常系: 無効なリスク許容度はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(false, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.CreateFinancialPlan(ctx, CreateFinancialPlanInput{
+			UserID:           "user-001",
+			MonthlyIncome:    400000,
+			RiskTolerance:    "reckless",
+			MonthlyExpenses:  []ExpenseItem{{Category: "住居費", Amount: 120000}},
+			CurrentSavings:   []SavingsItem{{Type: "deposit", Amount: 1000000}},
+			InvestmentReturn: 5.0,
+			InflationRate:    2.0,
+		})
+
+		require.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
 func TestManageFinancialDataUseCase_DeleteFinancialPlan(t *testing.T) {
 	ctx := context.Background()
 
@@ -210,7 +597,7 @@ func TestManageFinancialDataUseCase_DeleteFinancialPlan(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Delete", mock_anything(), plan.ID()).Return(nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		err := uc.DeleteFinancialPlan(ctx, DeleteFinancialPlanInput{UserID: "user-001"})
 
 		require.NoError(t, err)
@@ -221,7 +608,7 @@ func TestManageFinancialDataUseCase_DeleteFinancialPlan(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		err := uc.DeleteFinancialPlan(ctx, DeleteFinancialPlanInput{UserID: "user-001"})
 
 		require.Error(t, err)
@@ -234,12 +621,33 @@ func TestManageFinancialDataUseCase_DeleteFinancialPlan(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Delete", mock_anything(), plan.ID()).Return(errors.New("db error"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		err := uc.DeleteFinancialPlan(ctx, DeleteFinancialPlanInput{UserID: "user-001"})
 
 		require.Error(t, err)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("異常系: 財務計画の削除に失敗した場合は目標の削除もロールバックされる", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		plan := newTestFinancialPlan("user-001")
+		require.NoError(t, plan.AddGoal(goal))
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("Delete", mock_anything(), goal.ID()).Return(nil)
+		mockRepo.On("Delete", mock_anything(), plan.ID()).Return(errors.New("db error"))
+
+		uow := newStubUnitOfWork(mockRepo, mockGoalRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, uow, nil, nil)
+		err := uc.DeleteFinancialPlan(ctx, DeleteFinancialPlanInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の削除に失敗しました")
+		assert.True(t, uow.rolledBack)
+		assert.False(t, uow.committed)
+		mockGoalRepo.AssertExpectations(t)
+	})
 }
 
 // ===========================
@@ -261,7 +669,7 @@ func TestManageFinancialDataUseCase_UpdateRetirementData(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		output, err := uc.UpdateRetirementData(ctx, input)
 
 		require.NoError(t, err)
@@ -269,11 +677,36 @@ func TestManageFinancialDataUseCase_UpdateRetirementData(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("正常系: 納付年数から国民年金を推定し、未指定の介護保険料は引き継ぐ", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		nurseCare := 6000.0
+		withNurseCare := input
+		withNurseCare.NurseCareInsuranceMonthly = &nurseCare
+		_, err := uc.UpdateRetirementData(ctx, withNurseCare)
+		require.NoError(t, err)
+
+		contributionYears := 30
+		withContributionYears := input
+		withContributionYears.KokuminNenkinContributionYears = &contributionYears
+		output, err := uc.UpdateRetirementData(ctx, withContributionYears)
+		require.NoError(t, err)
+
+		retirement := plan.RetirementData()
+		assert.Equal(t, 30*1657.0, retirement.KokuminNenkinMonthlyAmount().Amount())
+		assert.Equal(t, 6000.0, retirement.NurseCareInsuranceMonthly().Amount())
+		assert.Equal(t, 30*1657.0, output.Retirement["kokumin_nenkin_monthly_amount"])
+	})
+
 	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.UpdateRetirementData(ctx, input)
 
 		require.Error(t, err)
@@ -287,7 +720,7 @@ func TestManageFinancialDataUseCase_UpdateRetirementData(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.UpdateRetirementData(ctx, input)
 
 		require.Error(t, err)
@@ -295,6 +728,169 @@ func TestManageFinancialDataUseCase_UpdateRetirementData(t *testing.T) {
 	})
 }
 
+// ===========================
+// ApplyPensionEstimate Tests
+// ===========================
+
+func TestManageFinancialDataUseCase_ApplyPensionEstimate(t *testing.T) {
+	ctx := context.Background()
+	input := ApplyPensionEstimateInput{
+		UserID:            "user-001",
+		ContributionYears: 40,
+		MonthlyIncome:     439000,
+		BirthYear:         1980,
+	}
+
+	t.Run("正常系: 推定した年金額が退職データに反映される", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		retirementData, err := entities.NewRetirementData("user-001", 40, 65, 85, mustNewMoney(250000), mustNewMoney(0))
+		require.NoError(t, err)
+		require.NoError(t, plan.SetRetirementData(retirementData))
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.ApplyPensionEstimate(ctx, input)
+
+		require.NoError(t, err)
+		assert.NotNil(t, output)
+		assert.Equal(t, 164246.0, plan.RetirementData().PensionAmount().Amount())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 退職データが未設定の場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.ApplyPensionEstimate(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "退職データが設定されていません")
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.ApplyPensionEstimate(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestManageFinancialDataUseCase_ConfirmFinancialDataCurrent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 最終確認日時を記録し、鮮度を fresh にする", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		plan.RestoreLastReviewedAt(time.Now().AddDate(-1, -2, 0))
+		lastProfileUpdatedAt := plan.LastProfileUpdatedAt()
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.ConfirmFinancialDataCurrent(ctx, ConfirmFinancialDataCurrentInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		require.NotNil(t, output.Staleness)
+		assert.Equal(t, aggregates.StalenessFresh, output.Staleness.Level)
+		assert.WithinDuration(t, time.Now(), plan.LastReviewedAt(), time.Minute)
+		// データ自体は変わらないため、計算結果に影響するデータの最終更新日時は変えない
+		assert.Equal(t, lastProfileUpdatedAt, plan.LastProfileUpdatedAt())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("財務データが見つかりません"))
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.ConfirmFinancialDataCurrent(ctx, ConfirmFinancialDataCurrentInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageFinancialDataUseCase_UpdateCurrentAge(t *testing.T) {
+	ctx := context.Background()
+
+	newPlanWithAge := func(t *testing.T, age int) *aggregates.FinancialPlan {
+		plan := newTestFinancialPlan("user-001")
+		retirementData, err := entities.NewRetirementData("user-001", age, 65, 85, mustNewMoney(250000), mustNewMoney(150000))
+		require.NoError(t, err)
+		require.NoError(t, plan.SetRetirementData(retirementData))
+		return plan
+	}
+
+	t.Run("正常系: 1歳増やすと年齢と更新日時が保存される", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newPlanWithAge(t, 35)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.UpdateCurrentAge(ctx, UpdateCurrentAgeInput{UserID: "user-001", CurrentAge: 36})
+
+		require.NoError(t, err)
+		// 再取得しなくてよいよう、更新後の財務データ全体を返す
+		require.NotNil(t, output.FinancialDataResponse)
+		assert.Equal(t, 36, output.Retirement["current_age"])
+		assert.NotNil(t, output.Profile)
+		assert.Equal(t, plan.Version(), output.Version)
+		assert.Equal(t, 36, plan.RetirementData().CurrentAge())
+		assert.NotNil(t, plan.RetirementData().LastAgeUpdateDate())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 年齢を減らす更新は拒否される", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newPlanWithAge(t, 35)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateCurrentAge(ctx, UpdateCurrentAgeInput{UserID: "user-001", CurrentAge: 34})
+
+		require.ErrorIs(t, err, entities.ErrInvalidCurrentAge)
+		assert.Equal(t, 35, plan.RetirementData().CurrentAge())
+		assert.Nil(t, plan.RetirementData().LastAgeUpdateDate())
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 退職年齢を超える更新は拒否される", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newPlanWithAge(t, 35)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateCurrentAge(ctx, UpdateCurrentAgeInput{UserID: "user-001", CurrentAge: 66})
+
+		require.ErrorIs(t, err, entities.ErrInvalidCurrentAge)
+		assert.Equal(t, 35, plan.RetirementData().CurrentAge())
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 退職データが未設定の場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateCurrentAge(ctx, UpdateCurrentAgeInput{UserID: "user-001", CurrentAge: 36})
+
+		require.ErrorIs(t, err, aggregates.ErrRetirementDataNotSet)
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
 // ===========================
 // UpdateEmergencyFund Tests
 // ===========================
@@ -313,11 +909,33 @@ func TestManageFinancialDataUseCase_UpdateEmergencyFund(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		output, err := uc.UpdateEmergencyFund(ctx, input)
 
 		require.NoError(t, err)
 		assert.NotNil(t, output)
+		assert.Empty(t, output.Warnings)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 純貯蓄額を超える積立予定額は保存して警告を返す", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		// 月間純貯蓄額は 400,000円 - 180,000円 = 220,000円
+		overcommitted := input
+		contribution := 250000.0
+		overcommitted.MonthlyContribution = &contribution
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.UpdateEmergencyFund(ctx, overcommitted)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{aggregates.EmergencyFundOvercommitmentMessage}, output.Warnings)
+		assert.Equal(t, 250000.0, plan.EmergencyFund().MonthlyContribution().Amount())
+		assert.Equal(t, 250000.0, output.EmergencyFund["monthly_contribution"])
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -325,7 +943,7 @@ func TestManageFinancialDataUseCase_UpdateEmergencyFund(t *testing.T) {
 		mockRepo := new(MockFinancialPlanRepository)
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.UpdateEmergencyFund(ctx, input)
 
 		require.Error(t, err)
@@ -339,10 +957,203 @@ func TestManageFinancialDataUseCase_UpdateEmergencyFund(t *testing.T) {
 		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
 		mockRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
-		uc := NewManageFinancialDataUseCase(mockRepo)
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
 		_, err := uc.UpdateEmergencyFund(ctx, input)
 
 		require.Error(t, err)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("正常系: 読み込んだバージョンを指定した場合は更新できる", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		plan.RestoreVersion(5)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		versioned := input
+		version := 5
+		versioned.Version = &version
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.UpdateEmergencyFund(ctx, versioned)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, output.Version)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: バージョンを省略した場合は確認せずに更新する（既存クライアントとの互換）", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		plan.RestoreVersion(5)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		require.Nil(t, input.Version)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		output, err := uc.UpdateEmergencyFund(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, output.Version)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 他のセッションで更新された古いバージョンを指定した場合は更新しない", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		plan.RestoreVersion(5)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		stale := input
+		version := 4
+		stale.Version = &version
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateEmergencyFund(ctx, stale)
+
+		var conflictErr *repositories.VersionConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.Equal(t, 5, conflictErr.CurrentVersion)
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 保存時にバージョンが競合した場合は競合エラーを返す", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), plan).Return(&repositories.VersionConflictError{CurrentVersion: 2})
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), nil, nil)
+		_, err := uc.UpdateEmergencyFund(ctx, input)
+
+		assert.ErrorIs(t, err, repositories.ErrVersionConflict)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+// ===========================
+// ImportBankCSV Tests
+// ===========================
+
+// stubBankCSVParser は固定の明細を返すテスト用パーサー
+type stubBankCSVParser struct {
+	transactions []ports.BankTransaction
+	err          error
+}
+
+func (p *stubBankCSVParser) Parse(r io.Reader) ([]ports.BankTransaction, error) {
+	return p.transactions, p.err
+}
+
+func TestManageFinancialDataUseCase_ImportBankCSV(t *testing.T) {
+	ctx := context.Background()
+	transactions := []ports.BankTransaction{
+		{Date: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Description: "給与", Deposit: 300000},
+		{Date: time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), Description: "家賃 4月分", Withdrawal: 85000},
+		{Date: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), Description: "スーパー まるや", Withdrawal: 4000},
+		{Date: time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC), Description: "家賃 5月分", Withdrawal: 85000},
+		{Date: time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC), Description: "コンビニ", Withdrawal: 1000},
+		{Date: time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC), Description: "ATM引出", Withdrawal: 20000},
+	}
+	parsers := map[ports.BankCSVFormat]ports.BankCSVParser{
+		ports.BankCSVFormatMizuho: &stubBankCSVParser{transactions: transactions},
+	}
+	input := ImportBankCSVInput{
+		UserID:  "user-001",
+		Format:  ports.BankCSVFormatMizuho,
+		CSVData: []byte("dummy"),
+	}
+
+	t.Run("正常系: 出金をカテゴリ別の月平均支出、入金を預金として反映する", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), parsers, nil)
+		output, err := uc.ImportBankCSV(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, 6, output.TransactionCount)
+		assert.Equal(t, 2, output.Months)
+		assert.Len(t, output.Expenses, 5)
+		require.Len(t, output.Savings, 1)
+		assert.Equal(t, "deposit", output.Savings[0].Type)
+		assert.Equal(t, 300000.0, output.Savings[0].Amount)
+		assert.Equal(t, []ExpenseItem{
+			{Category: "住居費", Amount: 85000},
+			{Category: "食費", Amount: 2500},
+			{Category: "その他", Amount: 10000},
+		}, output.MonthlyExpenses)
+
+		// 同じカテゴリの既存支出は置き換えられる
+		profile := plan.Profile()
+		assert.Len(t, profile.MonthlyExpenses(), 3)
+		total, err := profile.MonthlyExpenses().Total()
+		require.NoError(t, err)
+		assert.Equal(t, 97500.0, total.Amount())
+		assert.Len(t, profile.CurrentSavings(), 2)
+		assert.Equal(t, 400000.0, profile.MonthlyIncome().Amount())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 未対応のCSV形式はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), parsers, nil)
+		_, err := uc.ImportBankCSV(ctx, ImportBankCSVInput{UserID: "user-001", Format: "unknown"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "サポートされていないCSV形式です")
+		mockRepo.AssertNotCalled(t, "FindByUserID", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: CSVの解析に失敗した場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		failing := map[ports.BankCSVFormat]ports.BankCSVParser{
+			ports.BankCSVFormatMizuho: &stubBankCSVParser{err: errors.New("2行目: 日付が不正です")},
+		}
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), failing, nil)
+		_, err := uc.ImportBankCSV(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CSVの解析に失敗しました")
+		mockRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
+		mockRepo := new(MockFinancialPlanRepository)
+		mockRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
+
+		uc := NewManageFinancialDataUseCase(mockRepo, newStubUnitOfWork(mockRepo, nil), parsers, nil)
+		_, err := uc.ImportBankCSV(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCategorizeBankTransaction(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    string
+	}{
+		{"家賃 4月分", "住居費"},
+		{"東京電力 電気料金", "水道光熱費"},
+		{"スーパー まるや", "食費"},
+		{"外食 ランチ", "食費"},
+		{"ドコモ 携帯料金", "通信費"},
+		{"生命保険料", "保険料"},
+		{"ATM引出", "その他"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			assert.Equal(t, tt.expected, categorizeBankTransaction(tt.description))
+		})
+	}
 }
@@ -1,19 +1,71 @@
 package usecases
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
 	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 )
 
+// ErrGoalHasDependents は他の目標の前提になっている目標を削除しようとした場合のエラー
+var ErrGoalHasDependents = errors.New("他の目標の前提になっているため削除できません（force を指定すると依存を解除して削除します）")
+
+// ErrGoalImageStorageUnavailable は画像ストレージが設定されていない場合のエラー
+var ErrGoalImageStorageUnavailable = errors.New("画像ストレージが設定されていません")
+
+// ErrGoalAlreadyCompleted は達成済みの目標の月間拠出額を月次必要額に合わせようとした場合のエラー
+var ErrGoalAlreadyCompleted = errors.New("達成済みの目標のため月次必要額はありません")
+
+// DefaultContributionRoundTo は月次必要額を月間拠出額として採用する際の切り上げ単位（円）
+const DefaultContributionRoundTo = 1000
+
+// MaxGoalImageSize は目標に添付できる画像の最大サイズ（バイト）
+const MaxGoalImageSize = 5 * 1024 * 1024
+
+// goalImageExtensions は目標に添付できる画像の Content-Type と拡張子
+var goalImageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// DuplicateGoalError は同じタイプ・同じ目標名で目標金額がほぼ同じアクティブな目標が既に存在する場合のエラー
+type DuplicateGoalError struct {
+	ExistingGoalID entities.GoalID
+}
+
+// Error はエラーメッセージを返す
+func (e *DuplicateGoalError) Error() string {
+	return "同じ内容の目標が既に存在します（重複して作成する場合は allow_duplicate を指定してください）"
+}
+
+// ContributionOverBudgetError は採用しようとした月間拠出額と他のアクティブな目標の拠出額の合計が月間純貯蓄額を超える場合のエラー
+type ContributionOverBudgetError struct {
+	MonthlyContribution    float64 // 採用しようとした月間拠出額
+	OtherGoalsContribution float64 // 他のアクティブな目標の月間拠出額の合計
+	MonthlyNetSavings      float64 // 月間純貯蓄額
+	ExceededAmount         float64 // 月間純貯蓄額を超える額
+}
+
+// Error はエラーメッセージを返す
+func (e *ContributionOverBudgetError) Error() string {
+	return "他の目標と合わせた月間拠出額が月間純貯蓄額を超えています（超過したまま設定する場合は force を指定してください）"
+}
+
 // ManageGoalsUseCase は目標管理のユースケース
 type ManageGoalsUseCase interface {
 	// CreateGoal は新しい目標を作成する
@@ -39,6 +91,33 @@ type ManageGoalsUseCase interface {
 
 	// AnalyzeGoalFeasibility は目標の実現可能性を分析する
 	AnalyzeGoalFeasibility(ctx context.Context, input AnalyzeGoalFeasibilityInput) (*AnalyzeGoalFeasibilityOutput, error)
+
+	// GetPrioritizedGoals はユーザーのアクティブな目標を拠出の優先順位（EffectiveROI の高い順）で取得する
+	GetPrioritizedGoals(ctx context.Context, input GetPrioritizedGoalsInput) (*GetPrioritizedGoalsOutput, error)
+
+	// GetOverdueGoals はユーザーの期限切れの目標を立て直しの選択肢とともに取得する
+	GetOverdueGoals(ctx context.Context, input GetOverdueGoalsInput) (*OverdueGoalsOutput, error)
+
+	// ExportGoalProgressCSV は目標の進捗履歴をCSVとして出力する
+	ExportGoalProgressCSV(ctx context.Context, input ExportGoalProgressInput) ([]byte, error)
+
+	// ExportGoalsCSV はユーザーの全目標の進捗履歴をまとめてCSVとして出力する
+	ExportGoalsCSV(ctx context.Context, userID entities.UserID) ([]byte, error)
+
+	// UploadGoalImage は目標の画像をストレージに保存して目標に紐付ける（既存の画像は置き換える）
+	UploadGoalImage(ctx context.Context, input UploadGoalImageInput) (*GoalImageOutput, error)
+
+	// DeleteGoalImage は目標の画像の紐付けを解除してストレージから削除する
+	DeleteGoalImage(ctx context.Context, input DeleteGoalImageInput) error
+
+	// UpdateGoalExchangeRate は外貨建ての目標の為替レートを更新し、再計算した目標を返す
+	UpdateGoalExchangeRate(ctx context.Context, input UpdateGoalExchangeRateInput) (*GetGoalOutput, error)
+
+	// EstimateAchievementProbability は進捗履歴の積立ペースの実績から目標の達成確率を推定する
+	EstimateAchievementProbability(ctx context.Context, input EstimateAchievementProbabilityInput) (*EstimateAchievementProbabilityOutput, error)
+
+	// AdoptRequiredContribution は月次必要額を切り上げた額を目標の月間拠出額として設定し、再計算した目標と実現可能性を返す
+	AdoptRequiredContribution(ctx context.Context, input AdoptRequiredContributionInput) (*AdoptRequiredContributionOutput, error)
 }
 
 // CreateGoalInput は目標作成の入力
@@ -51,6 +130,27 @@ type CreateGoalInput struct {
 	CurrentAmount       float64         `json:"current_amount"`
 	MonthlyContribution float64         `json:"monthly_contribution"`
 	Description         *string         `json:"description,omitempty"`
+	Notes               string          `json:"notes,omitempty"`
+	ImageURL            string          `json:"image_url,omitempty"`       // 外部の画像URL（アップロードする場合は UploadGoalImage を使う）
+	DependsOn           []string        `json:"depends_on,omitempty"`      // 前提となる目標のID一覧（すべて完了後に拠出を開始する）
+	AllowDuplicate      bool            `json:"allow_duplicate,omitempty"` // 類似する目標が既に存在しても作成する
+	// Currency は目標の通貨（省略時は円）。円以外の場合、TargetAmount は目標の通貨で指定し ExchangeRate が必須
+	// 現在金額と月間拠出額は常に円で指定する
+	Currency     string             `json:"currency,omitempty"`
+	ExchangeRate *ExchangeRateInput `json:"exchange_rate,omitempty"`
+	// ContributionMin・ContributionStretch は月間拠出額の幅（任意、円。0 は未設定）
+	// MonthlyContribution を目標額として 最低額 ≤ 月間拠出額 ≤ ストレッチ額 である必要がある
+	ContributionMin     *float64 `json:"contribution_min,omitempty"`
+	ContributionStretch *float64 `json:"contribution_stretch,omitempty"`
+	// OverduePolicy は期限超過時の扱い（extend・archive・notify、省略時は notify）
+	OverduePolicy string `json:"overdue_policy,omitempty"`
+}
+
+// ExchangeRateInput は外貨建ての目標の為替レートの入力（外貨1単位あたりの円の金額）
+type ExchangeRateInput struct {
+	Rate   float64 `json:"rate"`
+	Source string  `json:"source,omitempty"` // レートの取得元（省略時は手入力）
+	AsOf   string  `json:"as_of,omitempty"`  // レートの基準日時（RFC3339、省略時は現在日時）
 }
 
 // CreateGoalOutput は目標作成の出力
@@ -71,15 +171,54 @@ type GetGoalOutput struct {
 	Goal     *entities.Goal        `json:"goal"`
 	Progress entities.ProgressRate `json:"progress"`
 	Status   GoalStatus            `json:"status"`
+	GoalContribution
+	Currency *GoalCurrencyDetail `json:"currency,omitempty"` // 外貨建ての目標の場合のみ
+}
+
+// GoalCurrencyDetail は外貨建ての目標の目標の通貨での金額と換算に使った為替レート
+// 計画の通貨（円）での金額は Goal と GoalContribution に含まれる
+type GoalCurrencyDetail struct {
+	GoalCurrency                string               `json:"goal_currency"`
+	PlanCurrency                string               `json:"plan_currency"`
+	TargetAmount                currencyAmountJSON   `json:"target_amount"`                 // 目標の通貨での目標金額
+	CurrentAmount               currencyAmountJSON   `json:"current_amount"`                // 現在の金額を目標の通貨に換算した金額
+	RequiredMonthlyContribution currencyAmountJSON   `json:"required_monthly_contribution"` // 月次必要額を目標の通貨に換算した金額
+	ExchangeRate                ExchangeRateDetail   `json:"exchange_rate"`
+	ExchangeRateHistory         []ExchangeRateDetail `json:"exchange_rate_history,omitempty"` // 以前に使っていた為替レート（古い順）
+}
+
+// ExchangeRateDetail は為替レートとその経過日数
+type ExchangeRateDetail struct {
+	Rate    float64 `json:"rate"` // 外貨1単位あたりの円の金額
+	Source  string  `json:"source"`
+	AsOf    string  `json:"as_of"`
+	AgeDays int     `json:"age_days"` // 基準日時からの経過日数
+}
+
+// UpdateGoalExchangeRateInput は外貨建ての目標の為替レート更新の入力
+type UpdateGoalExchangeRateInput struct {
+	GoalID entities.GoalID `json:"goal_id"`
+	UserID entities.UserID `json:"user_id"`
+	ExchangeRateInput
+}
+
+// GoalContribution は現時点の残額と残期間から算出した月次拠出の状況
+type GoalContribution struct {
+	RequiredMonthlyContribution float64 `json:"required_monthly_contribution"` // 期限までに達成するための月次必要額
+	ContributionGap             float64 `json:"contribution_gap"`              // 月次必要額と現在の月間拠出額の差（正: 不足, 負: 余裕）
+	IsUnachievable              bool    `json:"is_unachievable"`               // 期限切れで未達成のため期限内の達成が不可能
+	FinalPush                   bool    `json:"final_push"`                    // 残り1ヶ月以内のため月次必要額は残額全額（1回で拠出）
 }
 
 // GoalStatus は目標の状態
 type GoalStatus struct {
-	IsActive    bool   `json:"is_active"`
-	IsCompleted bool   `json:"is_completed"`
-	IsOverdue   bool   `json:"is_overdue"`
-	DaysLeft    int    `json:"days_left"`
-	Message     string `json:"message"`
+	IsActive    bool              `json:"is_active"`
+	IsCompleted bool              `json:"is_completed"`
+	IsOverdue   bool              `json:"is_overdue"`
+	IsWaiting   bool              `json:"is_waiting"`            // 前提目標の達成待ち（待機中）か
+	WaitingFor  []entities.GoalID `json:"waiting_for,omitempty"` // 未達成の前提目標のID
+	DaysLeft    int               `json:"days_left"`
+	Message     string            `json:"message"`
 }
 
 // GetGoalsByUserInput はユーザー目標一覧取得の入力
@@ -97,9 +236,12 @@ type GetGoalsByUserOutput struct {
 
 // GoalWithStatus は状態付きの目標
 type GoalWithStatus struct {
-	Goal     *entities.Goal        `json:"goal"`
-	Progress entities.ProgressRate `json:"progress"`
-	Status   GoalStatus            `json:"status"`
+	Goal                    *entities.Goal        `json:"goal"`
+	Progress                entities.ProgressRate `json:"progress"`
+	Status                  GoalStatus            `json:"status"`
+	EffectiveStartDate      string                `json:"effective_start_date"`                // 拠出を開始する日（前提目標がある場合はその完了見込み日）
+	EstimatedCompletionDate string                `json:"estimated_completion_date,omitempty"` // 現在の月間拠出額での完了見込み日
+	GoalContribution
 }
 
 // GoalsSummary は目標のサマリー
@@ -113,6 +255,27 @@ type GoalsSummary struct {
 	OverallProgress float64 `json:"overall_progress"`
 }
 
+// MarshalJSON は金額を円単位の整数、進捗率を小数点以下2桁に丸めてシリアライズする
+func (s GoalsSummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		TotalGoals      int         `json:"total_goals"`
+		ActiveGoals     int         `json:"active_goals"`
+		CompletedGoals  int         `json:"completed_goals"`
+		OverdueGoals    int         `json:"overdue_goals"`
+		TotalTarget     yenJSON     `json:"total_target"`
+		TotalCurrent    yenJSON     `json:"total_current"`
+		OverallProgress percentJSON `json:"overall_progress"`
+	}{
+		TotalGoals:      s.TotalGoals,
+		ActiveGoals:     s.ActiveGoals,
+		CompletedGoals:  s.CompletedGoals,
+		OverdueGoals:    s.OverdueGoals,
+		TotalTarget:     yenJSON(s.TotalTarget),
+		TotalCurrent:    yenJSON(s.TotalCurrent),
+		OverallProgress: percentJSON(s.OverallProgress),
+	})
+}
+
 // UpdateGoalInput は目標更新の入力
 type UpdateGoalInput struct {
 	GoalID              entities.GoalID `json:"goal_id"`
@@ -122,13 +285,26 @@ type UpdateGoalInput struct {
 	TargetDate          *string         `json:"target_date,omitempty"` // RFC3339 format
 	MonthlyContribution *float64        `json:"monthly_contribution,omitempty"`
 	Description         *string         `json:"description,omitempty"`
+	Notes               *string         `json:"notes,omitempty"`
+	ImageURL            *string         `json:"image_url,omitempty"` // 空文字で画像の紐付けを解除
 	IsActive            *bool           `json:"is_active,omitempty"`
+	DependsOn           *[]string       `json:"depends_on,omitempty"` // 前提となる目標のID一覧（空配列で依存を解除）
+	// ContributionMin・ContributionStretch は月間拠出額の幅（0 で解除）。更新後に 最低額 ≤ 月間拠出額 ≤ ストレッチ額 である必要がある
+	ContributionMin     *float64 `json:"contribution_min,omitempty"`
+	ContributionStretch *float64 `json:"contribution_stretch,omitempty"`
+	// OverduePolicy は期限超過時の扱い（extend・archive・notify）
+	OverduePolicy *string `json:"overdue_policy,omitempty"`
+	// AutoAdjustContribution が true の場合、更新後の目標金額・目標日から求めた月次必要額を月間拠出額に設定する
+	// （MonthlyContribution の指定より優先する。達成済みの目標は変更しない）
+	AutoAdjustContribution bool `json:"auto_adjust_contribution"`
 }
 
 // UpdateGoalOutput は目標更新の出力
 type UpdateGoalOutput struct {
 	Success   bool   `json:"success"`
 	UpdatedAt string `json:"updated_at"`
+	// RecommendedMonthlyContribution は更新後の目標に対する月次必要額（自動調整の有無に関わらず返す）
+	RecommendedMonthlyContribution float64 `json:"recommended_monthly_contribution"`
 }
 
 // UpdateGoalProgressInput は目標進捗更新の入力
@@ -151,6 +327,45 @@ type UpdateGoalProgressOutput struct {
 type DeleteGoalInput struct {
 	GoalID entities.GoalID `json:"goal_id"`
 	UserID entities.UserID `json:"user_id"`
+	Force  bool            `json:"force"` // 他の目標の前提になっている場合に依存を解除して削除する
+}
+
+// AdoptRequiredContributionInput は月次必要額の月間拠出額への採用の入力
+type AdoptRequiredContributionInput struct {
+	GoalID  entities.GoalID `json:"goal_id"`
+	UserID  entities.UserID `json:"user_id"`
+	RoundTo float64         `json:"round_to"` // 切り上げ単位（円）。0以下の場合は DefaultContributionRoundTo
+	Force   bool            `json:"force"`    // 月間純貯蓄額を超える場合も設定する
+}
+
+// AdoptRequiredContributionOutput は月次必要額の月間拠出額への採用の出力
+// 目標・進捗・月次拠出の状況は更新後の値で、Feasibility は更新後の月間拠出額で分析した実現可能性
+type AdoptRequiredContributionOutput struct {
+	GetGoalOutput
+	PreviousMonthlyContribution float64                       `json:"previous_monthly_contribution"`
+	AdoptedMonthlyContribution  float64                       `json:"adopted_monthly_contribution"`
+	OverBudget                  bool                          `json:"over_budget"` // force を指定して月間純貯蓄額を超えたまま設定した
+	Feasibility                 *AnalyzeGoalFeasibilityOutput `json:"feasibility"`
+}
+
+// UploadGoalImageInput は目標画像アップロードの入力
+type UploadGoalImageInput struct {
+	GoalID      entities.GoalID `json:"goal_id"`
+	UserID      entities.UserID `json:"user_id"`
+	ContentType string          `json:"content_type"`
+	Data        []byte          `json:"-"`
+}
+
+// GoalImageOutput は目標画像アップロードの出力
+type GoalImageOutput struct {
+	ImageURL  string `json:"image_url"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// DeleteGoalImageInput は目標画像削除の入力
+type DeleteGoalImageInput struct {
+	GoalID entities.GoalID `json:"goal_id"`
+	UserID entities.UserID `json:"user_id"`
 }
 
 // GetGoalRecommendationsInput は目標推奨事項取得の入力
@@ -160,9 +375,11 @@ type GetGoalRecommendationsInput struct {
 }
 
 // GetGoalRecommendationsOutput は目標推奨事項取得の出力
+// 貯蓄推奨を生成できなかった場合は SavingsAdvice を null とし、SavingsAdviceError に理由を示す
 type GetGoalRecommendationsOutput struct {
-	Recommendations []services.GoalRecommendation   `json:"recommendations"`
-	SavingsAdvice   *services.SavingsRecommendation `json:"savings_advice"`
+	Recommendations    []services.GoalRecommendation   `json:"recommendations"`
+	SavingsAdvice      *services.SavingsRecommendation `json:"savings_advice"`
+	SavingsAdviceError string                          `json:"savings_advice_error,omitempty"`
 }
 
 // AnalyzeGoalFeasibilityInput は目標実現可能性分析の入力
@@ -188,26 +405,170 @@ type FeasibilityInsight struct {
 	Severity    string `json:"severity"` // "info", "warning", "error"
 }
 
+// EstimateAchievementProbabilityInput は目標の達成確率推定の入力
+type EstimateAchievementProbabilityInput struct {
+	GoalID entities.GoalID `json:"goal_id"`
+	UserID entities.UserID `json:"user_id"`
+}
+
+// EstimateAchievementProbabilityOutput は目標の達成確率推定の出力
+type EstimateAchievementProbabilityOutput struct {
+	GoalID entities.GoalID `json:"goal_id"`
+	*services.AchievementProbabilityEstimate
+}
+
+// GetPrioritizedGoalsInput は目標の優先順位取得の入力
+type GetPrioritizedGoalsInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// GetPrioritizedGoalsOutput は目標の優先順位取得の出力
+type GetPrioritizedGoalsOutput struct {
+	Goals []PrioritizedGoal `json:"goals"`
+}
+
+// PrioritizedGoal は優先順位付きの目標
+type PrioritizedGoal struct {
+	Priority     int            `json:"priority"` // 優先順位（1が最優先）
+	Goal         *entities.Goal `json:"goal"`
+	EffectiveROI float64        `json:"effective_roi"` // 拠出1円あたりで達成できる目標金額
+}
+
+// GetOverdueGoalsInput は期限切れ目標取得の入力
+type GetOverdueGoalsInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// OverdueGoalsOutput は期限切れ目標取得の出力
+type OverdueGoalsOutput struct {
+	Goals []OverdueGoal `json:"goals"`
+}
+
+// OverdueGoal は立て直しの選択肢付きの期限切れ目標
+type OverdueGoal struct {
+	Goal               *entities.Goal      `json:"goal"`
+	DaysOverdue        int                 `json:"days_overdue"`     // 目標日を過ぎた日数
+	RemainingAmount    float64             `json:"remaining_amount"` // 残り必要金額
+	RemediationOptions []RemediationOption `json:"remediation_options"`
+}
+
+// 期限切れ目標の立て直し方
+const (
+	RemediationStrategyExtendDate   = "extend_date"   // 目標金額はそのままで目標日を延長する
+	RemediationStrategyReduceTarget = "reduce_target" // 目標日を近くに置き直し、目標金額を下げる
+)
+
+// RemediationOption は期限切れ目標の立て直しの選択肢
+// extend_date は NewTargetDate と RequiredMonthlyContribution を、reduce_target は NewTargetAmount と AchievableBy を設定する
+type RemediationOption struct {
+	Strategy                    string     `json:"strategy"`
+	NewTargetDate               *time.Time `json:"new_target_date,omitempty"`
+	RequiredMonthlyContribution float64    `json:"required_monthly_contribution,omitempty"`
+	NewTargetAmount             float64    `json:"new_target_amount,omitempty"`
+	AchievableBy                *time.Time `json:"achievable_by,omitempty"`
+}
+
+// ExportGoalProgressInput は目標進捗CSVエクスポートの入力
+type ExportGoalProgressInput struct {
+	GoalID entities.GoalID `json:"goal_id"`
+	UserID entities.UserID `json:"user_id"`
+}
+
 // manageGoalsUseCaseImpl はManageGoalsUseCaseの実装
 type manageGoalsUseCaseImpl struct {
 	goalRepo              repositories.GoalRepository
 	financialPlanRepo     repositories.FinancialPlanRepository
+	unitOfWork            repositories.UnitOfWork
 	recommendationService *services.GoalRecommendationService
+	fileStorage           ports.FileStorage
+	idGenerator           ports.IDGenerator
+	progressSnapshotRepo  repositories.GoalProgressSnapshotRepository
+	contributionHistory   repositories.GoalContributionHistoryRepository
+	adviseSavings         func(goal *entities.Goal, currentSavings valueobjects.Money, timeRemaining valueobjects.Period) (*services.SavingsRecommendation, error)
 }
 
 // NewManageGoalsUseCase は新しいManageGoalsUseCaseを作成する
 func NewManageGoalsUseCase(
 	goalRepo repositories.GoalRepository,
 	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+	recommendationService *services.GoalRecommendationService,
+) ManageGoalsUseCase {
+	return &manageGoalsUseCaseImpl{
+		goalRepo:              goalRepo,
+		financialPlanRepo:     financialPlanRepo,
+		unitOfWork:            unitOfWork,
+		recommendationService: recommendationService,
+		idGenerator:           ports.UUIDGenerator{},
+		adviseSavings:         recommendationService.RecommendMonthlySavings,
+	}
+}
+
+// NewManageGoalsUseCaseWithFileStorage は画像ストレージ付きのManageGoalsUseCaseを作成する
+// fileStorage が nil の場合、画像のアップロードは ErrGoalImageStorageUnavailable を返す
+func NewManageGoalsUseCaseWithFileStorage(
+	goalRepo repositories.GoalRepository,
+	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
 	recommendationService *services.GoalRecommendationService,
+	fileStorage ports.FileStorage,
 ) ManageGoalsUseCase {
 	return &manageGoalsUseCaseImpl{
 		goalRepo:              goalRepo,
 		financialPlanRepo:     financialPlanRepo,
+		unitOfWork:            unitOfWork,
 		recommendationService: recommendationService,
+		fileStorage:           fileStorage,
+		idGenerator:           ports.UUIDGenerator{},
+		adviseSavings:         recommendationService.RecommendMonthlySavings,
 	}
 }
 
+// NewManageGoalsUseCaseWithIDGenerator は目標のIDを idGenerator で採番するManageGoalsUseCaseを作成する
+// テストで採番結果を固定する場合に使用する
+func NewManageGoalsUseCaseWithIDGenerator(
+	goalRepo repositories.GoalRepository,
+	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+	recommendationService *services.GoalRecommendationService,
+	idGenerator ports.IDGenerator,
+) ManageGoalsUseCase {
+	uc := NewManageGoalsUseCase(goalRepo, financialPlanRepo, unitOfWork, recommendationService).(*manageGoalsUseCaseImpl)
+	uc.idGenerator = idGenerator
+	return uc
+}
+
+// NewManageGoalsUseCaseWithProgressHistory は目標の進捗履歴を記録する画像ストレージ付きのManageGoalsUseCaseを作成する
+// progressSnapshotRepo が nil の場合、進捗履歴を記録せず、達成確率は計画上の月間拠出額で推定する
+func NewManageGoalsUseCaseWithProgressHistory(
+	goalRepo repositories.GoalRepository,
+	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+	recommendationService *services.GoalRecommendationService,
+	fileStorage ports.FileStorage,
+	progressSnapshotRepo repositories.GoalProgressSnapshotRepository,
+) ManageGoalsUseCase {
+	uc := NewManageGoalsUseCaseWithFileStorage(goalRepo, financialPlanRepo, unitOfWork, recommendationService, fileStorage).(*manageGoalsUseCaseImpl)
+	uc.progressSnapshotRepo = progressSnapshotRepo
+	return uc
+}
+
+// NewManageGoalsUseCaseWithContributionHistory は進捗履歴に加えて月間拠出額の変更履歴を記録するManageGoalsUseCaseを作成する
+// contributionHistory が nil の場合、月間拠出額の変更履歴は記録しない
+func NewManageGoalsUseCaseWithContributionHistory(
+	goalRepo repositories.GoalRepository,
+	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+	recommendationService *services.GoalRecommendationService,
+	fileStorage ports.FileStorage,
+	progressSnapshotRepo repositories.GoalProgressSnapshotRepository,
+	contributionHistory repositories.GoalContributionHistoryRepository,
+) ManageGoalsUseCase {
+	uc := NewManageGoalsUseCaseWithProgressHistory(goalRepo, financialPlanRepo, unitOfWork, recommendationService, fileStorage, progressSnapshotRepo).(*manageGoalsUseCaseImpl)
+	uc.contributionHistory = contributionHistory
+	return uc
+}
+
 // CreateGoal は新しい目標を作成する
 func (uc *manageGoalsUseCaseImpl) CreateGoal(
 	ctx context.Context,
@@ -234,12 +595,39 @@ func (uc *manageGoalsUseCaseImpl) CreateGoal(
 		return nil, fmt.Errorf("目標日の解析に失敗しました: %w", err)
 	}
 
-	// 金額を作成
+	// 金額を作成（外貨建ての目標は目標金額を為替レートで円に換算する）
 	targetAmount, err := valueobjects.NewMoneyJPY(input.TargetAmount)
 	if err != nil {
 		return nil, fmt.Errorf("目標金額の作成に失敗しました: %w", err)
 	}
 
+	var goalCurrencyTarget *valueobjects.Money
+	var exchangeRate valueobjects.ExchangeRate
+	if input.Currency != "" {
+		currency, err := valueobjects.ParseCurrency(input.Currency)
+		if err != nil {
+			return nil, err
+		}
+		if currency != valueobjects.JPY {
+			if input.ExchangeRate == nil {
+				return nil, errors.New("外貨建ての目標には為替レートが必要です")
+			}
+			exchangeRate, err = newExchangeRate(currency, *input.ExchangeRate, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			target, err := valueobjects.NewMoney(input.TargetAmount, currency)
+			if err != nil {
+				return nil, fmt.Errorf("目標金額の作成に失敗しました: %w", err)
+			}
+			targetAmount, err = exchangeRate.ToBase(target)
+			if err != nil {
+				return nil, fmt.Errorf("目標金額の換算に失敗しました: %w", err)
+			}
+			goalCurrencyTarget = &target
+		}
+	}
+
 	currentAmount, err := valueobjects.NewMoneyJPY(input.CurrentAmount)
 	if err != nil {
 		return nil, fmt.Errorf("現在金額の作成に失敗しました: %w", err)
@@ -264,8 +652,20 @@ func (uc *manageGoalsUseCaseImpl) CreateGoal(
 		}
 	}
 
+	// 取込や二重送信による重複作成を防ぐ（明示的に許可された場合を除く）
+	if !input.AllowDuplicate {
+		similarGoal, err := uc.goalRepo.FindSimilarGoal(ctx, input.UserID, goalType, input.Title, targetAmount)
+		if err != nil {
+			return nil, fmt.Errorf("類似する目標の確認に失敗しました: %w", err)
+		}
+		if similarGoal != nil {
+			return nil, &DuplicateGoalError{ExistingGoalID: similarGoal.ID()}
+		}
+	}
+
 	// 目標を作成
-	goal, err := entities.NewGoal(
+	goal, err := entities.NewGoalWithGeneratedID(
+		entities.GoalID(uc.idGenerator.NewID()),
 		input.UserID,
 		goalType,
 		input.Title,
@@ -283,6 +683,44 @@ func (uc *manageGoalsUseCaseImpl) CreateGoal(
 		return nil, fmt.Errorf("現在金額の設定に失敗しました: %w", err)
 	}
 
+	// 外貨建ての目標の通貨と為替レートを設定
+	if goalCurrencyTarget != nil {
+		if err := goal.SetGoalCurrency(*goalCurrencyTarget, exchangeRate); err != nil {
+			return nil, fmt.Errorf("目標の通貨の設定に失敗しました: %w", err)
+		}
+	}
+
+	// 月間拠出額の幅を設定
+	if input.ContributionMin != nil || input.ContributionStretch != nil {
+		minimum, stretch, err := resolveContributionRange(goal, input.ContributionMin, input.ContributionStretch)
+		if err != nil {
+			return nil, err
+		}
+		if err := goal.UpdateContributionPlan(minimum, monthlyContribution, stretch); err != nil {
+			return nil, fmt.Errorf("月間拠出額の幅の設定に失敗しました: %w", err)
+		}
+	}
+
+	// メモと画像を設定
+	if err := goal.UpdateNotes(input.Notes); err != nil {
+		return nil, fmt.Errorf("メモの設定に失敗しました: %w", err)
+	}
+	goal.UpdateImageURL(input.ImageURL)
+
+	// 期限超過時ポリシーを設定
+	if input.OverduePolicy != "" {
+		if err := goal.UpdateOverduePolicy(entities.GoalOverduePolicy(input.OverduePolicy)); err != nil {
+			return nil, err
+		}
+	}
+
+	// 前提となる目標を設定
+	if len(input.DependsOn) > 0 {
+		if err := uc.setGoalDependencies(ctx, goal, input.DependsOn); err != nil {
+			return nil, err
+		}
+	}
+
 	// 財務計画を取得して達成可能性をチェック（財務データが見つからない場合はチェックをスキップ）
 	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
 	if err != nil {
@@ -296,7 +734,9 @@ func (uc *manageGoalsUseCaseImpl) CreateGoal(
 	}
 
 	if plan != nil {
-		achievable, err := goal.IsAchievable(plan.Profile())
+		// 前提目標がある場合は前提目標の完了見込み日から拠出を開始するものとして判定する
+		startDate := goalStartDate(append(append([]*entities.Goal{}, plan.Goals()...), goal), goal)
+		achievable, err := goal.IsAchievableFrom(plan.Profile(), startDate)
 		if err != nil {
 			return nil, fmt.Errorf("目標の達成可能性チェックに失敗しました: %w", err)
 		}
@@ -306,25 +746,32 @@ func (uc *manageGoalsUseCaseImpl) CreateGoal(
 		}
 	}
 
-	// 目標を保存
-	err = uc.goalRepo.Save(ctx, goal)
-	if err != nil {
-		return nil, fmt.Errorf("目標の保存に失敗しました: %w", err)
-	}
-
-	// 財務計画が存在する場合は目標を追加して更新する
-	if plan != nil {
-		err = plan.AddGoal(goal)
-		if err != nil {
-			return nil, fmt.Errorf("財務計画への目標追加に失敗しました: %w", err)
+	// 目標の保存と財務計画の更新を1つのトランザクションで行う
+	err = uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		if err := repos.Goals.Save(ctx, goal); err != nil {
+			return fmt.Errorf("目標の保存に失敗しました: %w", err)
 		}
 
-		err = uc.financialPlanRepo.Update(ctx, plan)
-		if err != nil {
-			return nil, fmt.Errorf("財務計画の更新に失敗しました: %w", err)
+		// 財務計画が存在する場合は目標を追加して更新する
+		if plan != nil {
+			if err := plan.AddGoal(goal); err != nil {
+				return fmt.Errorf("財務計画への目標追加に失敗しました: %w", err)
+			}
+
+			if err := repos.FinancialPlans.Update(ctx, plan); err != nil {
+				return fmt.Errorf("財務計画の更新に失敗しました: %w", err)
+			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	// 作成時の現在金額を積立ペースの起点として記録する
+	uc.recordGoalProgress(ctx, goal)
+
 	return &CreateGoalOutput{
 		GoalID:    goal.ID(),
 		UserID:    input.UserID,
@@ -354,13 +801,29 @@ func (uc *manageGoalsUseCaseImpl) GetGoal(
 		return nil, fmt.Errorf("進捗の計算に失敗しました: %w", err)
 	}
 
-	// 状態を生成
-	status := uc.generateGoalStatus(goal)
+	// 状態を生成（前提目標がある場合は待機中かを判定するため目標一覧を取得する）
+	var goals []*entities.Goal
+	if goal.HasDependency() {
+		goals, err = uc.goalRepo.FindByUserID(ctx, input.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+		}
+	}
+	status := uc.generateGoalStatus(goal, goals)
+
+	now := time.Now()
+	contribution := uc.calculateGoalContribution(goal, now)
+	currencyDetail, err := goalCurrencyDetail(goal, contribution, now)
+	if err != nil {
+		return nil, err
+	}
 
 	return &GetGoalOutput{
-		Goal:     goal,
-		Progress: progress,
-		Status:   status,
+		Goal:             goal,
+		Progress:         progress,
+		Status:           status,
+		GoalContribution: contribution,
+		Currency:         currencyDetail,
 	}, nil
 }
 
@@ -385,6 +848,17 @@ func (uc *manageGoalsUseCaseImpl) GetGoalsByUser(
 		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
 	}
 
+	// 依存関係を考慮した拠出スケジュールを算出（タイプで絞り込んだ場合も前提目標を解決できるようアクティブな目標全体から算出する）
+	scheduleGoals := goals
+	if input.GoalType != nil {
+		scheduleGoals, err = uc.goalRepo.FindActiveGoalsByUserID(ctx, input.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+		}
+	}
+	scheduleGoals = append(append([]*entities.Goal{}, scheduleGoals...), goals...)
+	schedules := entities.BuildGoalSchedules(scheduleGoals, time.Now())
+
 	// 状態付きの目標リストを作成
 	var goalsWithStatus []GoalWithStatus
 	var summary GoalsSummary
@@ -397,13 +871,22 @@ func (uc *manageGoalsUseCaseImpl) GetGoalsByUser(
 			progress, _ = entities.NewProgressRate(0) // 0% で進捗を初期化 (エラーは無視し、0%とする)
 		}
 
-		status := uc.generateGoalStatus(goal)
+		status := uc.generateGoalStatus(goal, scheduleGoals)
 
-		goalsWithStatus = append(goalsWithStatus, GoalWithStatus{
-			Goal:     goal,
-			Progress: progress,
-			Status:   status,
-		})
+		goalWithStatus := GoalWithStatus{
+			Goal:             goal,
+			Progress:         progress,
+			Status:           status,
+			GoalContribution: uc.calculateGoalContribution(goal, time.Now()),
+		}
+		if schedule, ok := schedules[goal.ID()]; ok {
+			goalWithStatus.EffectiveStartDate = schedule.EffectiveStartDate.Format(time.RFC3339)
+			if schedule.HasEstimatedCompletion() {
+				goalWithStatus.EstimatedCompletionDate = schedule.EstimatedCompletionDate.Format(time.RFC3339)
+			}
+		}
+
+		goalsWithStatus = append(goalsWithStatus, goalWithStatus)
 
 		// サマリーを更新
 		summary.TotalGoals++
@@ -457,7 +940,8 @@ func (uc *manageGoalsUseCaseImpl) UpdateGoal(
 	}
 
 	if input.TargetAmount != nil {
-		targetAmount, err := valueobjects.NewMoneyJPY(*input.TargetAmount)
+		// 外貨建ての目標の目標金額は目標の通貨で指定する
+		targetAmount, err := valueobjects.NewMoney(*input.TargetAmount, goal.Currency())
 		if err != nil {
 			return nil, fmt.Errorf("目標金額の作成に失敗しました: %w", err)
 		}
@@ -480,21 +964,61 @@ func (uc *manageGoalsUseCaseImpl) UpdateGoal(
 		}
 	}
 
-	if input.MonthlyContribution != nil {
-		monthlyContribution, err := valueobjects.NewMoneyJPY(*input.MonthlyContribution)
+	// 月間拠出額とその幅は 最低額 ≤ 月間拠出額 ≤ ストレッチ額 を保つようまとめて更新する
+	if input.MonthlyContribution != nil || input.ContributionMin != nil || input.ContributionStretch != nil {
+		monthlyContribution := goal.MonthlyContribution()
+		if input.MonthlyContribution != nil {
+			monthlyContribution, err = valueobjects.NewMoneyJPY(*input.MonthlyContribution)
+			if err != nil {
+				return nil, fmt.Errorf("月間拠出額の作成に失敗しました: %w", err)
+			}
+		}
+		minimum, stretch, err := resolveContributionRange(goal, input.ContributionMin, input.ContributionStretch)
+		if err != nil {
+			return nil, err
+		}
+
+		err = goal.UpdateContributionPlan(minimum, monthlyContribution, stretch)
 		if err != nil {
-			return nil, fmt.Errorf("月間拠出額の作成に失敗しました: %w", err)
+			return nil, fmt.Errorf("月間拠出額の更新に失敗しました: %w", err)
 		}
+	}
 
-		err = goal.UpdateMonthlyContribution(monthlyContribution)
+	// 更新後の目標金額・目標日に対する月次必要額を求め、指定があれば月間拠出額に反映する
+	recommended := uc.calculateGoalContribution(goal, time.Now()).RequiredMonthlyContribution
+	previousContribution := goal.MonthlyContribution().Amount()
+	autoAdjusted := false
+	if input.AutoAdjustContribution && !goal.IsCompleted() {
+		contribution, err := valueobjects.NewMoneyJPY(recommended)
 		if err != nil {
+			return nil, fmt.Errorf("月間拠出額の作成に失敗しました: %w", err)
+		}
+		if err := goal.UpdateMonthlyContribution(contribution); err != nil {
 			return nil, fmt.Errorf("月間拠出額の更新に失敗しました: %w", err)
 		}
+		autoAdjusted = true
 	}
 
 	// Note: Description update is not available in the current Goal entity
 	// This would need to be added to the Goal entity if required
 
+	if input.Notes != nil {
+		if err := goal.UpdateNotes(*input.Notes); err != nil {
+			return nil, fmt.Errorf("メモの更新に失敗しました: %w", err)
+		}
+	}
+
+	previousImageURL := goal.ImageURL()
+	if input.ImageURL != nil {
+		goal.UpdateImageURL(*input.ImageURL)
+	}
+
+	if input.OverduePolicy != nil {
+		if err := goal.UpdateOverduePolicy(entities.GoalOverduePolicy(*input.OverduePolicy)); err != nil {
+			return nil, err
+		}
+	}
+
 	if input.IsActive != nil {
 		if *input.IsActive {
 			goal.Activate()
@@ -503,15 +1027,33 @@ func (uc *manageGoalsUseCaseImpl) UpdateGoal(
 		}
 	}
 
+	if input.DependsOn != nil {
+		if len(*input.DependsOn) == 0 {
+			goal.ClearDependencies()
+		} else if err := uc.setGoalDependencies(ctx, goal, *input.DependsOn); err != nil {
+			return nil, err
+		}
+	}
+
 	// 目標を保存
 	err = uc.goalRepo.Update(ctx, goal)
 	if err != nil {
 		return nil, fmt.Errorf("目標の保存に失敗しました: %w", err)
 	}
 
+	// 画像が置き換えられた場合は、保存に成功した後で以前の画像を削除する
+	if previousImageURL != goal.ImageURL() {
+		uc.deleteStoredImage(ctx, goal.ID(), previousImageURL)
+	}
+
+	if autoAdjusted && previousContribution != goal.MonthlyContribution().Amount() {
+		uc.recordContributionChange(ctx, goal, previousContribution, recommended, false)
+	}
+
 	return &UpdateGoalOutput{
-		Success:   true,
-		UpdatedAt: goal.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		Success:                        true,
+		UpdatedAt:                      goal.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		RecommendedMonthlyContribution: recommended,
 	}, nil
 }
 
@@ -557,6 +1099,8 @@ func (uc *manageGoalsUseCaseImpl) UpdateGoalProgress(
 		return nil, fmt.Errorf("目標の保存に失敗しました: %w", err)
 	}
 
+	uc.recordGoalProgress(ctx, goal)
+
 	return &UpdateGoalProgressOutput{
 		Success:     true,
 		NewProgress: progress,
@@ -565,99 +1109,516 @@ func (uc *manageGoalsUseCaseImpl) UpdateGoalProgress(
 	}, nil
 }
 
-// DeleteGoal は目標を削除する
-func (uc *manageGoalsUseCaseImpl) DeleteGoal(
-	ctx context.Context,
-	input DeleteGoalInput,
-) error {
-	// 目標を取得
-	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
-	if err != nil {
-		return fmt.Errorf("目標の取得に失敗しました: %w", err)
-	}
-
-	// ユーザーIDが一致するかチェック
-	if goal.UserID() != input.UserID {
-		return errors.New("指定された目標にアクセスする権限がありません")
-	}
-
-	// 財務計画から目標を削除
-	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
-	if err != nil {
-		return fmt.Errorf("財務計画の取得に失敗しました: %w", err)
-	}
-
-	err = plan.RemoveGoal(input.GoalID)
-	if err != nil {
-		return fmt.Errorf("財務計画からの目標削除に失敗しました: %w", err)
+// recordGoalProgress は目標の現在金額を進捗履歴に記録する（失敗しても進捗の更新は継続する）
+func (uc *manageGoalsUseCaseImpl) recordGoalProgress(ctx context.Context, goal *entities.Goal) {
+	if uc.progressSnapshotRepo == nil {
+		return
 	}
 
-	err = uc.financialPlanRepo.Update(ctx, plan)
-	if err != nil {
-		return fmt.Errorf("財務計画の更新に失敗しました: %w", err)
+	snapshot, err := entities.NewGoalProgressSnapshot(goal.ID(), goal.UserID(), goal.CurrentAmount().Amount(), goal.UpdatedAt())
+	if err == nil {
+		err = uc.progressSnapshotRepo.Save(ctx, snapshot)
 	}
-
-	// 目標を削除
-	err = uc.goalRepo.Delete(ctx, input.GoalID)
 	if err != nil {
-		return fmt.Errorf("目標の削除に失敗しました: %w", err)
+		slog.Warn("failed to record goal progress", "goal_id", goal.ID(), "error", err)
 	}
-
-	return nil
 }
 
-// GetGoalRecommendations は目標の推奨事項を取得する
-func (uc *manageGoalsUseCaseImpl) GetGoalRecommendations(
+// AdoptRequiredContribution は詳細表示と同じ月次必要額を round_to 円単位に切り上げ、目標の月間拠出額として設定する
+// 他のアクティブな目標の月間拠出額との合計が月間純貯蓄額を超える場合は、force を指定しない限り ContributionOverBudgetError を返す
+func (uc *manageGoalsUseCaseImpl) AdoptRequiredContribution(
 	ctx context.Context,
-	input GetGoalRecommendationsInput,
-) (*GetGoalRecommendationsOutput, error) {
-	// 目標を取得
+	input AdoptRequiredContributionInput,
+) (*AdoptRequiredContributionOutput, error) {
 	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
 	if err != nil {
 		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
 	}
-
-	// ユーザーIDが一致するかチェック
 	if goal.UserID() != input.UserID {
 		return nil, errors.New("指定された目標にアクセスする権限がありません")
 	}
+	if goal.IsCompleted() {
+		return nil, ErrGoalAlreadyCompleted
+	}
+
+	required := uc.calculateGoalContribution(goal, time.Now()).RequiredMonthlyContribution
+	adopted := roundUpContribution(required, input.RoundTo)
 
-	// 財務計画を取得
 	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
 	}
-
-	// 推奨事項を生成
-	recommendations, err := uc.recommendationService.SuggestGoalAdjustments(goal, plan.Profile())
+	netSavings, err := plan.Profile().CalculateNetSavings()
 	if err != nil {
-		return nil, fmt.Errorf("推奨事項の生成に失敗しました: %w", err)
+		return nil, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
 	}
 
-	// 貯蓄推奨を生成
-	remainingDays := goal.GetRemainingDays()
-	remainingMonths := remainingDays / 30 // 概算
-	timeRemaining, err := valueobjects.NewPeriodFromMonths(remainingMonths)
+	activeGoals, err := uc.goalRepo.FindActiveGoalsByUserID(ctx, input.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("残り期間の計算に失敗しました: %w", err)
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+	otherGoalsContribution := 0.0
+	for _, other := range activeGoals {
+		if other.ID() == goal.ID() || other.IsCompleted() {
+			continue
+		}
+		otherGoalsContribution += other.MonthlyContribution().Amount()
 	}
 
-	currentSavings, err := plan.Profile().CurrentSavings().Total()
-	if err != nil {
-		return nil, fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
+	exceeded := adopted + otherGoalsContribution - netSavings.Amount()
+	overBudget := exceeded > 0
+	if overBudget && !input.Force {
+		return nil, &ContributionOverBudgetError{
+			MonthlyContribution:    adopted,
+			OtherGoalsContribution: otherGoalsContribution,
+			MonthlyNetSavings:      netSavings.Amount(),
+			ExceededAmount:         exceeded,
+		}
 	}
 
-	savingsAdvice, err := uc.recommendationService.RecommendMonthlySavings(goal, currentSavings, timeRemaining)
+	contribution, err := valueobjects.NewMoneyJPY(adopted)
 	if err != nil {
-		return nil, fmt.Errorf("貯蓄推奨の生成に失敗しました: %w", err)
+		return nil, fmt.Errorf("月間拠出額の作成に失敗しました: %w", err)
 	}
-
-	return &GetGoalRecommendationsOutput{
-		Recommendations: recommendations,
-		SavingsAdvice:   savingsAdvice,
+	previous := goal.MonthlyContribution().Amount()
+	if err := goal.UpdateMonthlyContribution(contribution); err != nil {
+		return nil, fmt.Errorf("月間拠出額の更新に失敗しました: %w", err)
+	}
+	if err := uc.goalRepo.Update(ctx, goal); err != nil {
+		return nil, fmt.Errorf("目標の保存に失敗しました: %w", err)
+	}
+
+	uc.recordContributionChange(ctx, goal, previous, required, overBudget)
+
+	detail, err := uc.GetGoal(ctx, GetGoalInput{GoalID: input.GoalID, UserID: input.UserID})
+	if err != nil {
+		return nil, err
+	}
+	feasibility, err := uc.AnalyzeGoalFeasibility(ctx, AnalyzeGoalFeasibilityInput{GoalID: input.GoalID, UserID: input.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AdoptRequiredContributionOutput{
+		GetGoalOutput:               *detail,
+		PreviousMonthlyContribution: previous,
+		AdoptedMonthlyContribution:  adopted,
+		OverBudget:                  overBudget,
+		Feasibility:                 feasibility,
+	}, nil
+}
+
+// roundUpContribution は月次必要額を roundTo 円単位に切り上げる（roundTo が0以下の場合は DefaultContributionRoundTo）
+func roundUpContribution(amount, roundTo float64) float64 {
+	if roundTo <= 0 {
+		roundTo = DefaultContributionRoundTo
+	}
+	if amount <= 0 {
+		return 0
+	}
+	// 浮動小数点の誤差でちょうど割り切れる額が1単位切り上がらないようにする
+	return math.Ceil(amount/roundTo-1e-9) * roundTo
+}
+
+// resolveContributionRange は入力された月間拠出額の幅を値オブジェクトに変換する
+// 入力が nil の場合は目標に設定済みの値をそのまま使い、0 の場合は未設定にする
+func resolveContributionRange(goal *entities.Goal, minimumInput, stretchInput *float64) (*valueobjects.Money, *valueobjects.Money, error) {
+	minimum, err := resolveOptionalContribution(minimumInput, goal.ContributionMin())
+	if err != nil {
+		return nil, nil, fmt.Errorf("月間拠出額の最低額の作成に失敗しました: %w", err)
+	}
+	stretch, err := resolveOptionalContribution(stretchInput, goal.ContributionStretch())
+	if err != nil {
+		return nil, nil, fmt.Errorf("月間拠出額のストレッチ額の作成に失敗しました: %w", err)
+	}
+	return minimum, stretch, nil
+}
+
+// resolveOptionalContribution は任意の月間拠出額の入力を値オブジェクトに変換する（nilの場合は current、0の場合は未設定）
+func resolveOptionalContribution(input *float64, current *valueobjects.Money) (*valueobjects.Money, error) {
+	if input == nil {
+		return current, nil
+	}
+	if *input == 0 {
+		return nil, nil
+	}
+	amount, err := valueobjects.NewMoneyJPY(*input)
+	if err != nil {
+		return nil, err
+	}
+	return &amount, nil
+}
+
+// optionalAmount は任意の金額を数値で返す（nilの場合はnil）
+func optionalAmount(money *valueobjects.Money) *float64 {
+	if money == nil {
+		return nil
+	}
+	amount := money.Amount()
+	return &amount
+}
+
+// recordContributionChange は月間拠出額の変更を履歴に記録する（失敗しても月間拠出額の更新は継続する）
+func (uc *manageGoalsUseCaseImpl) recordContributionChange(ctx context.Context, goal *entities.Goal, previous, required float64, overBudget bool) {
+	if uc.contributionHistory == nil {
+		return
+	}
+
+	change, err := entities.NewGoalContributionChange(
+		goal.ID(),
+		goal.UserID(),
+		previous,
+		goal.MonthlyContribution().Amount(),
+		required,
+		overBudget,
+		goal.UpdatedAt(),
+	)
+	if err == nil {
+		err = uc.contributionHistory.Save(ctx, change)
+	}
+	if err != nil {
+		slog.Warn("failed to record goal contribution change", "goal_id", goal.ID(), "error", err)
+	}
+}
+
+// EstimateAchievementProbability は進捗履歴の直近の平均積立額から、期限までに目標金額に到達する確率を推定する
+// 進捗履歴が不足している場合（進捗履歴を記録しない構成を含む）は、計画上の月間拠出額で推定する
+func (uc *manageGoalsUseCaseImpl) EstimateAchievementProbability(
+	ctx context.Context,
+	input EstimateAchievementProbabilityInput,
+) (*EstimateAchievementProbabilityOutput, error) {
+	// 目標を取得
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	// ユーザーIDが一致するかチェック
+	if goal.UserID() != input.UserID {
+		return nil, errors.New("指定された目標にアクセスする権限がありません")
+	}
+
+	now := time.Now()
+	var history []*entities.GoalProgressSnapshot
+	if uc.progressSnapshotRepo != nil {
+		history, err = uc.progressSnapshotRepo.FindByGoalIDSince(ctx, goal.ID(), services.AchievementHistorySince(now))
+		if err != nil {
+			return nil, fmt.Errorf("進捗履歴の取得に失敗しました: %w", err)
+		}
+	}
+
+	estimate, err := uc.recommendationService.EstimateAchievementProbability(goal, history, now)
+	if err != nil {
+		return nil, fmt.Errorf("達成確率の推定に失敗しました: %w", err)
+	}
+
+	return &EstimateAchievementProbabilityOutput{
+		GoalID:                         goal.ID(),
+		AchievementProbabilityEstimate: estimate,
+	}, nil
+}
+
+// DeleteGoal は目標を削除する
+func (uc *manageGoalsUseCaseImpl) DeleteGoal(
+	ctx context.Context,
+	input DeleteGoalInput,
+) error {
+	// 目標を取得
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	// ユーザーIDが一致するかチェック
+	if goal.UserID() != input.UserID {
+		return errors.New("指定された目標にアクセスする権限がありません")
+	}
+
+	// 財務計画から目標を削除
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	// この目標を前提にしている目標は、force 指定時のみ依存を解除して削除する
+	// 他の前提目標への依存は残し、削除する目標への依存のみ解除する
+	for _, dependent := range plan.Goals() {
+		if !dependent.DependsOnGoal(input.GoalID) {
+			continue
+		}
+		if !input.Force {
+			return ErrGoalHasDependents
+		}
+		dependent.RemoveDependency(input.GoalID)
+	}
+
+	err = plan.RemoveGoal(input.GoalID)
+	if err != nil {
+		return fmt.Errorf("財務計画からの目標削除に失敗しました: %w", err)
+	}
+
+	// 依存を解除した目標の更新と目標の論理削除は、財務計画の更新として1つのトランザクションで行う
+	err = uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		if err := repos.FinancialPlans.Update(ctx, plan); err != nil {
+			return fmt.Errorf("財務計画の更新に失敗しました: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 目標の削除が確定した後で画像を削除する（削除に失敗しても目標の削除は取り消さない）
+	uc.deleteStoredImage(ctx, goal.ID(), goal.ImageURL())
+	return nil
+}
+
+// UploadGoalImage は目標の画像をストレージに保存して目標に紐付ける（既存の画像は置き換える）
+func (uc *manageGoalsUseCaseImpl) UploadGoalImage(
+	ctx context.Context,
+	input UploadGoalImageInput,
+) (*GoalImageOutput, error) {
+	if uc.fileStorage == nil {
+		return nil, ErrGoalImageStorageUnavailable
+	}
+
+	ext, ok := goalImageExtensions[input.ContentType]
+	if !ok {
+		return nil, fmt.Errorf("画像の形式が正しくありません（JPEG・PNG・GIF・WebPのみ）: %s", input.ContentType)
+	}
+	if len(input.Data) == 0 {
+		return nil, errors.New("画像が空です")
+	}
+	if len(input.Data) > MaxGoalImageSize {
+		return nil, fmt.Errorf("画像のサイズは%dMB以下にしてください", MaxGoalImageSize/1024/1024)
+	}
+
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+	if goal.UserID() != input.UserID {
+		return nil, errors.New("指定された目標にアクセスする権限がありません")
+	}
+
+	// 置き換え前の画像を残したまま新しいキーで保存し、目標の更新に成功してから以前の画像を削除する
+	key := fmt.Sprintf("goals/%s/%s%s", goal.ID(), uc.idGenerator.NewID(), ext)
+	imageURL, err := uc.fileStorage.Save(ctx, key, input.ContentType, input.Data)
+	if err != nil {
+		return nil, fmt.Errorf("画像の保存に失敗しました: %w", err)
+	}
+
+	previousImageURL := goal.ImageURL()
+	goal.UpdateImageURL(imageURL)
+	if err := uc.goalRepo.Update(ctx, goal); err != nil {
+		// 目標に紐付かない画像が残らないよう、保存した画像を削除する
+		uc.deleteStoredImage(ctx, goal.ID(), imageURL)
+		return nil, fmt.Errorf("目標の保存に失敗しました: %w", err)
+	}
+
+	uc.deleteStoredImage(ctx, goal.ID(), previousImageURL)
+
+	return &GoalImageOutput{
+		ImageURL:  imageURL,
+		UpdatedAt: goal.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
 }
 
+// DeleteGoalImage は目標の画像の紐付けを解除してストレージから削除する
+func (uc *manageGoalsUseCaseImpl) DeleteGoalImage(
+	ctx context.Context,
+	input DeleteGoalImageInput,
+) error {
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+	if goal.UserID() != input.UserID {
+		return errors.New("指定された目標にアクセスする権限がありません")
+	}
+
+	previousImageURL := goal.ImageURL()
+	if previousImageURL == "" {
+		return nil
+	}
+
+	// 先に紐付けを解除し、目標から参照されない状態になってから画像を削除する
+	goal.UpdateImageURL("")
+	if err := uc.goalRepo.Update(ctx, goal); err != nil {
+		return fmt.Errorf("目標の保存に失敗しました: %w", err)
+	}
+
+	uc.deleteStoredImage(ctx, goal.ID(), previousImageURL)
+	return nil
+}
+
+// UpdateGoalExchangeRate は外貨建ての目標の為替レートを更新し、再計算した目標を返す
+// 以前の為替レートは目標の為替レートの履歴に残る
+func (uc *manageGoalsUseCaseImpl) UpdateGoalExchangeRate(
+	ctx context.Context,
+	input UpdateGoalExchangeRateInput,
+) (*GetGoalOutput, error) {
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+	if goal.UserID() != input.UserID {
+		return nil, errors.New("指定された目標にアクセスする権限がありません")
+	}
+	if !goal.IsForeignCurrency() {
+		return nil, entities.ErrGoalNotForeignCurrency
+	}
+
+	rate, err := newExchangeRate(goal.Currency(), input.ExchangeRateInput, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := goal.UpdateExchangeRate(rate); err != nil {
+		return nil, fmt.Errorf("為替レートの更新に失敗しました: %w", err)
+	}
+
+	if err := uc.goalRepo.Update(ctx, goal); err != nil {
+		return nil, fmt.Errorf("目標の保存に失敗しました: %w", err)
+	}
+
+	return uc.GetGoal(ctx, GetGoalInput{GoalID: input.GoalID, UserID: input.UserID})
+}
+
+// newExchangeRate は入力から外貨 currency の円に対する為替レートを作成する（基準日時の省略時は now）
+func newExchangeRate(currency valueobjects.Currency, input ExchangeRateInput, now time.Time) (valueobjects.ExchangeRate, error) {
+	asOf := now
+	if input.AsOf != "" {
+		parsed, err := time.Parse(time.RFC3339, input.AsOf)
+		if err != nil {
+			return valueobjects.ExchangeRate{}, fmt.Errorf("為替レートの基準日時の解析に失敗しました: %w", err)
+		}
+		asOf = parsed
+	}
+	rate, err := valueobjects.NewExchangeRate(valueobjects.JPY, currency, input.Rate, input.Source, asOf)
+	if err != nil {
+		return valueobjects.ExchangeRate{}, fmt.Errorf("為替レートの作成に失敗しました: %w", err)
+	}
+	return rate, nil
+}
+
+// goalCurrencyDetail は外貨建ての目標の目標の通貨での金額と為替レートを生成する（外貨建てでない場合はnil）
+func goalCurrencyDetail(goal *entities.Goal, contribution GoalContribution, now time.Time) (*GoalCurrencyDetail, error) {
+	rate := goal.ExchangeRate()
+	if !goal.IsForeignCurrency() || rate == nil {
+		return nil, nil
+	}
+
+	currentAmount, err := goal.ToGoalCurrency(goal.CurrentAmount())
+	if err != nil {
+		return nil, fmt.Errorf("現在の金額の換算に失敗しました: %w", err)
+	}
+	required, err := valueobjects.NewMoneyJPY(contribution.RequiredMonthlyContribution)
+	if err != nil {
+		return nil, fmt.Errorf("月次必要額の作成に失敗しました: %w", err)
+	}
+	requiredInGoalCurrency, err := goal.ToGoalCurrency(required)
+	if err != nil {
+		return nil, fmt.Errorf("月次必要額の換算に失敗しました: %w", err)
+	}
+
+	detail := &GoalCurrencyDetail{
+		GoalCurrency:                string(goal.Currency()),
+		PlanCurrency:                string(goal.TargetAmount().Currency()),
+		TargetAmount:                currencyAmountJSON(goal.TargetAmountInGoalCurrency().Amount()),
+		CurrentAmount:               currencyAmountJSON(currentAmount.Amount()),
+		RequiredMonthlyContribution: currencyAmountJSON(requiredInGoalCurrency.Amount()),
+		ExchangeRate:                exchangeRateDetail(*rate, now),
+	}
+	for _, previous := range goal.ExchangeRateHistory() {
+		detail.ExchangeRateHistory = append(detail.ExchangeRateHistory, exchangeRateDetail(previous, now))
+	}
+	return detail, nil
+}
+
+// exchangeRateDetail は為替レートを now 時点の経過日数とともに出力用に変換する
+func exchangeRateDetail(rate valueobjects.ExchangeRate, now time.Time) ExchangeRateDetail {
+	return ExchangeRateDetail{
+		Rate:    rate.Rate(),
+		Source:  rate.Source(),
+		AsOf:    rate.AsOf().Format(time.RFC3339),
+		AgeDays: int(rate.Age(now).Hours() / 24),
+	}
+}
+
+// deleteStoredImage はストレージの画像を削除する
+// 目標の更新は確定済みのため、削除に失敗してもエラーにせずログに記録する（外部URLはストレージ側で無視される）
+func (uc *manageGoalsUseCaseImpl) deleteStoredImage(ctx context.Context, goalID entities.GoalID, imageURL string) {
+	if uc.fileStorage == nil || imageURL == "" {
+		return
+	}
+	if err := uc.fileStorage.Delete(ctx, imageURL); err != nil {
+		slog.Error("failed to delete goal image", "goal_id", goalID, "image_url", imageURL, "error", err)
+	}
+}
+
+// GetGoalRecommendations は目標の推奨事項を取得する
+func (uc *manageGoalsUseCaseImpl) GetGoalRecommendations(
+	ctx context.Context,
+	input GetGoalRecommendationsInput,
+) (*GetGoalRecommendationsOutput, error) {
+	// 目標を取得
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	// ユーザーIDが一致するかチェック
+	if goal.UserID() != input.UserID {
+		return nil, errors.New("指定された目標にアクセスする権限がありません")
+	}
+
+	// 財務計画を取得
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	// 推奨事項を生成
+	recommendations, err := uc.recommendationService.SuggestGoalAdjustments(goal, plan.Profile())
+	if err != nil {
+		return nil, fmt.Errorf("推奨事項の生成に失敗しました: %w", err)
+	}
+
+	output := &GetGoalRecommendationsOutput{
+		Recommendations: recommendations,
+	}
+
+	// 貯蓄推奨を生成（失敗しても推奨事項は返す）
+	savingsAdvice, err := uc.savingsAdvice(goal, plan.Profile())
+	if err != nil {
+		slog.Warn("failed to recommend monthly savings; omitting savings advice", "goal_id", goal.ID(), "error", err)
+		output.SavingsAdviceError = "この目標の貯蓄推奨を生成できませんでした"
+		return output, nil
+	}
+	output.SavingsAdvice = savingsAdvice
+
+	return output, nil
+}
+
+// savingsAdvice は目標の残り期間と現在の貯蓄合計から月間貯蓄額の推奨を生成する
+func (uc *manageGoalsUseCaseImpl) savingsAdvice(goal *entities.Goal, profile *entities.FinancialProfile) (*services.SavingsRecommendation, error) {
+	timeRemaining, err := valueobjects.NewPeriodFromMonths(goal.RemainingMonthsFrom(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("残り期間の計算に失敗しました: %w", err)
+	}
+
+	currentSavings, err := profile.CurrentSavings().Total()
+	if err != nil {
+		return nil, fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
+	}
+
+	savingsAdvice, err := uc.adviseSavings(goal, currentSavings, timeRemaining)
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄推奨の生成に失敗しました: %w", err)
+	}
+	return savingsAdvice, nil
+}
+
 // AnalyzeGoalFeasibility は目標の実現可能性を分析する
 func (uc *manageGoalsUseCaseImpl) AnalyzeGoalFeasibility(
 	ctx context.Context,
@@ -686,8 +1647,19 @@ func (uc *manageGoalsUseCaseImpl) AnalyzeGoalFeasibility(
 		return nil, fmt.Errorf("実現可能性の分析に失敗しました: %w", err)
 	}
 
+	// 前提目標がある場合は前提目標の完了見込み日から拠出を開始するものとして分析する
+	startDate := goalStartDate(plan.Goals(), goal)
+	if startDate.After(time.Now()) {
+		requiredMonthlySavings, err := goal.CalculateRequiredMonthlySavingsFrom(startDate)
+		if err != nil {
+			return nil, fmt.Errorf("拠出開始後の月間必要貯蓄額の計算に失敗しました: %w", err)
+		}
+		feasibility["effective_start_date"] = startDate.Format(time.RFC3339)
+		feasibility["required_monthly_savings"] = requiredMonthlySavings.Amount()
+	}
+
 	// 達成可能性を判定
-	achievable, err := goal.IsAchievable(plan.Profile())
+	achievable, err := goal.IsAchievableFrom(plan.Profile(), startDate)
 	if err != nil {
 		return nil, fmt.Errorf("達成可能性の判定に失敗しました: %w", err)
 	}
@@ -709,19 +1681,62 @@ func (uc *manageGoalsUseCaseImpl) AnalyzeGoalFeasibility(
 	}, nil
 }
 
+// setGoalDependencies は前提となる目標を取得し、所有者と循環を検証して目標に設定する
+func (uc *manageGoalsUseCaseImpl) setGoalDependencies(ctx context.Context, goal *entities.Goal, prerequisiteIDs []string) error {
+	prerequisites := make([]*entities.Goal, 0, len(prerequisiteIDs))
+	for _, prerequisiteID := range prerequisiteIDs {
+		prerequisite, err := uc.goalRepo.FindByID(ctx, entities.GoalID(prerequisiteID))
+		if err != nil {
+			return fmt.Errorf("前提となる目標の取得に失敗しました: %w", err)
+		}
+		prerequisites = append(prerequisites, prerequisite)
+	}
+
+	goals, err := uc.goalRepo.FindByUserID(ctx, goal.UserID())
+	if err != nil {
+		return fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	if err := goal.SetDependencies(prerequisites, goals); err != nil {
+		return fmt.Errorf("前提となる目標の設定に失敗しました: %w", err)
+	}
+
+	return nil
+}
+
+// goalStartDate は goals の依存関係から目標の拠出開始日を返す（goals に含まれない場合は現在日時）
+func goalStartDate(goals []*entities.Goal, goal *entities.Goal) time.Time {
+	now := time.Now()
+	if schedule, ok := entities.BuildGoalSchedules(goals, now)[goal.ID()]; ok {
+		return schedule.EffectiveStartDate
+	}
+	return now
+}
+
 // generateGoalStatus は目標の状態を生成する
-func (uc *manageGoalsUseCaseImpl) generateGoalStatus(goal *entities.Goal) GoalStatus {
+// goals は前提目標の達成状況を判定するための目標一覧
+func (uc *manageGoalsUseCaseImpl) generateGoalStatus(goal *entities.Goal, goals []*entities.Goal) GoalStatus {
 	isActive := goal.IsActive()
 	isCompleted := goal.IsCompleted()
 	isOverdue := goal.IsOverdue()
 	daysLeft := goal.GetRemainingDays()
 
+	var waitingFor []entities.GoalID
+	if isActive && !isCompleted {
+		for _, prerequisite := range entities.WaitingPrerequisites(goal, goals) {
+			waitingFor = append(waitingFor, prerequisite.ID())
+		}
+	}
+	isWaiting := len(waitingFor) > 0
+
 	var message string
 	switch {
 	case isCompleted:
 		message = "目標を達成しました！"
 	case isOverdue:
 		message = "目標期限を過ぎています"
+	case isWaiting:
+		message = "前提となる目標の達成待ちです（待機中）"
 	case daysLeft <= 30:
 		message = "目標期限が近づいています"
 	case !isActive:
@@ -734,11 +1749,40 @@ func (uc *manageGoalsUseCaseImpl) generateGoalStatus(goal *entities.Goal) GoalSt
 		IsActive:    isActive,
 		IsCompleted: isCompleted,
 		IsOverdue:   isOverdue,
+		IsWaiting:   isWaiting,
+		WaitingFor:  waitingFor,
 		DaysLeft:    daysLeft,
 		Message:     message,
 	}
 }
 
+// calculateGoalContribution は now 時点の残額と残期間から月次必要額と現在の拠出額との差を算出する
+// 達成済みの目標は必要額・差ともに0とし、期限切れの目標は残額全額を必要額として達成不能とする
+// 残期間は目標進捗予測（CalculateGoalProjection）と同じく entities.Goal.RequiredSavingsPlanFrom で算出する
+func (uc *manageGoalsUseCaseImpl) calculateGoalContribution(goal *entities.Goal, now time.Time) GoalContribution {
+	if goal.IsCompleted() {
+		return GoalContribution{}
+	}
+
+	plan, err := goal.RequiredSavingsPlanFrom(now)
+	if err != nil {
+		slog.Error("failed to calculate required monthly contribution", "goal_id", goal.ID(), "error", err)
+		return GoalContribution{IsUnachievable: goal.IsOverdue()}
+	}
+
+	requiredAmount := plan.MonthlyAmount.Amount()
+	if requiredAmount < 0 {
+		requiredAmount = 0
+	}
+
+	return GoalContribution{
+		RequiredMonthlyContribution: requiredAmount,
+		ContributionGap:             requiredAmount - goal.MonthlyContribution().Amount(),
+		IsUnachievable:              goal.IsOverdue(),
+		FinalPush:                   plan.FinalPush,
+	}
+}
+
 // generateFeasibilityInsights は実現可能性の洞察を生成する
 func (uc *manageGoalsUseCaseImpl) generateFeasibilityInsights(
 	goal *entities.Goal,
@@ -807,3 +1851,213 @@ func (uc *manageGoalsUseCaseImpl) generateFeasibilityInsights(
 
 	return insights
 }
+
+// GetPrioritizedGoals はユーザーのアクティブな目標を拠出の優先順位（EffectiveROI の高い順）で取得する
+func (uc *manageGoalsUseCaseImpl) GetPrioritizedGoals(
+	ctx context.Context,
+	input GetPrioritizedGoalsInput,
+) (*GetPrioritizedGoalsOutput, error) {
+	goals, err := uc.goalRepo.FindActiveGoalsByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	// 財務計画を取得（想定利回りを ROI の計算に使う）
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	prioritized, err := uc.recommendationService.PrioritizeGoalsByROI(goals, plan.Profile())
+	if err != nil {
+		return nil, fmt.Errorf("目標の優先順位付けに失敗しました: %w", err)
+	}
+
+	output := &GetPrioritizedGoalsOutput{Goals: make([]PrioritizedGoal, 0, len(prioritized))}
+	for i, goal := range prioritized {
+		roi, err := uc.recommendationService.EffectiveROI(goal, plan.Profile())
+		if err != nil {
+			return nil, fmt.Errorf("目標の優先順位付けに失敗しました: %w", err)
+		}
+		output.Goals = append(output.Goals, PrioritizedGoal{
+			Priority:     i + 1,
+			Goal:         goal,
+			EffectiveROI: roi,
+		})
+	}
+
+	return output, nil
+}
+
+const (
+	// overdueExtensionDefaultMonths は月間拠出額が0の期限切れ目標で目標日を延長する月数
+	overdueExtensionDefaultMonths = 12
+	// overdueReduceTargetMonths は目標金額を下げる場合に置き直す目標日までの最長の月数
+	overdueReduceTargetMonths = 6
+)
+
+// GetOverdueGoals はユーザーのアクティブな目標のうち期限切れのものを立て直しの選択肢とともに取得する
+// 期限切れの目標がない場合は空の一覧を返す
+func (uc *manageGoalsUseCaseImpl) GetOverdueGoals(
+	ctx context.Context,
+	input GetOverdueGoalsInput,
+) (*OverdueGoalsOutput, error) {
+	goals, err := uc.goalRepo.FindActiveGoalsByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	now := time.Now()
+	output := &OverdueGoalsOutput{Goals: []OverdueGoal{}}
+	for _, goal := range goals {
+		if !goal.IsOverdue() {
+			continue
+		}
+
+		remainingAmount, err := goal.GetRemainingAmount()
+		if err != nil {
+			return nil, fmt.Errorf("残り必要金額の計算に失敗しました: %w", err)
+		}
+
+		output.Goals = append(output.Goals, OverdueGoal{
+			Goal:               goal,
+			DaysOverdue:        int(now.Sub(goal.TargetDate()).Hours() / 24),
+			RemainingAmount:    remainingAmount.Amount(),
+			RemediationOptions: overdueRemediationOptions(goal, remainingAmount.Amount(), now),
+		})
+	}
+
+	return output, nil
+}
+
+// overdueRemediationOptions は期限切れ目標の立て直しの選択肢（目標日の延長・目標金額の引き下げ）を返す
+// 目標日の延長は現在の月間拠出額で残額を積み立て終える月数（拠出額が0の場合は12ヶ月）だけ延長し、
+// 目標金額の引き下げは最長6ヶ月後を目標日として、その間に積み立てられる金額を現在の金額に加えた額とする
+func overdueRemediationOptions(goal *entities.Goal, remainingAmount float64, now time.Time) []RemediationOption {
+	contribution := goal.MonthlyContribution().Amount()
+
+	extendMonths := overdueExtensionDefaultMonths
+	if contribution > 0 {
+		extendMonths = int(math.Ceil(remainingAmount / contribution))
+	}
+	if extendMonths < 1 {
+		extendMonths = 1
+	}
+	requiredContribution := math.Ceil(remainingAmount / float64(extendMonths))
+	newTargetDate := now.AddDate(0, extendMonths, 0)
+
+	// 拠出額が0の場合は延長案の月次必要額で積み立てる前提とする
+	if contribution <= 0 {
+		contribution = requiredContribution
+	}
+	reduceMonths := min(extendMonths, overdueReduceTargetMonths)
+	newTargetAmount := math.Min(
+		math.Floor(goal.CurrentAmount().Amount()+contribution*float64(reduceMonths)),
+		goal.TargetAmount().Amount(),
+	)
+	achievableBy := now.AddDate(0, reduceMonths, 0)
+
+	return []RemediationOption{
+		{
+			Strategy:                    RemediationStrategyExtendDate,
+			NewTargetDate:               &newTargetDate,
+			RequiredMonthlyContribution: requiredContribution,
+		},
+		{
+			Strategy:        RemediationStrategyReduceTarget,
+			NewTargetAmount: newTargetAmount,
+			AchievableBy:    &achievableBy,
+		},
+	}
+}
+
+// ExportGoalProgressCSV は目標の進捗履歴（日付・金額・進捗率・メモ）をBOM付きUTF-8のCSVとして出力する
+func (uc *manageGoalsUseCaseImpl) ExportGoalProgressCSV(
+	ctx context.Context,
+	input ExportGoalProgressInput,
+) ([]byte, error) {
+	// 目標を取得
+	goal, err := uc.goalRepo.FindByID(ctx, input.GoalID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	// ユーザーIDが一致するかチェック
+	if goal.UserID() != input.UserID {
+		return nil, errors.New("指定された目標にアクセスする権限がありません")
+	}
+
+	row, err := goalProgressCSVRow(goal)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := writeGoalCSV([]string{"日付", "金額", "進捗率", "メモ"}, [][]string{row})
+	if err != nil {
+		return nil, fmt.Errorf("CSV生成に失敗しました: %w", err)
+	}
+	return data, nil
+}
+
+// ExportGoalsCSV はユーザーの全目標の進捗履歴をBOM付きUTF-8のCSVとしてまとめて出力する
+func (uc *manageGoalsUseCaseImpl) ExportGoalsCSV(
+	ctx context.Context,
+	userID entities.UserID,
+) ([]byte, error) {
+	goals, err := uc.goalRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	rows := make([][]string, 0, len(goals))
+	for _, goal := range goals {
+		row, err := goalProgressCSVRow(goal)
+		if err != nil {
+			return nil, err
+		}
+		goalColumns := []string{
+			string(goal.ID()),
+			goal.Title(),
+			goal.GoalType().String(),
+			strconv.FormatFloat(goal.TargetAmount().Amount(), 'f', 0, 64),
+		}
+		rows = append(rows, append(goalColumns, row...))
+	}
+
+	data, err := writeGoalCSV([]string{"目標ID", "目標名", "目標タイプ", "目標金額", "日付", "金額", "進捗率", "メモ"}, rows)
+	if err != nil {
+		return nil, fmt.Errorf("CSV生成に失敗しました: %w", err)
+	}
+	return data, nil
+}
+
+// goalProgressCSVRow は目標の進捗をCSVの1行（日付・金額・進捗率・メモ）に変換する
+// 進捗は目標の現在額として保持しており履歴を持たないため、現在の状態（最終更新日時点）のみを出力する
+func goalProgressCSVRow(goal *entities.Goal) ([]string, error) {
+	progress, err := goal.CalculateProgress(goal.CurrentAmount())
+	if err != nil {
+		return nil, fmt.Errorf("進捗の計算に失敗しました: %w", err)
+	}
+
+	return []string{
+		goal.UpdatedAt().Format("2006-01-02"),
+		strconv.FormatFloat(goal.CurrentAmount().Amount(), 'f', 0, 64),
+		strconv.FormatFloat(progress.AsPercentage(), 'f', 1, 64),
+		"",
+	}, nil
+}
+
+// writeGoalCSV はヘッダーと行をBOM付きUTF-8のCSVバイト列に変換する（Excelでの文字化け防止）
+func writeGoalCSV(header []string, rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("\xEF\xBB\xBF")
+
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
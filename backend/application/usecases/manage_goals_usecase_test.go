@@ -2,15 +2,20 @@ package usecases
 
 import (
 	"context"
+	"encoding/csv"
 	"errors"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/application/testutil"
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,22 +57,45 @@ func TestManageGoalsUseCase_CreateGoal(t *testing.T) {
 		// 財務データが見つからないエラーを返す → 達成可能性チェックをスキップして保存
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).
 			Return(nil, errors.New("財務データが見つかりません"))
-		mockGoalRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+		mockGoalRepo.On("FindSimilarGoal", mock_anything(), entities.UserID("user-001"), entities.GoalTypeSavings, "新車購入", mock_anything()).Return(nil, nil)
+		mockGoalRepo.On("Save", mock_anything(), mock.MatchedBy(func(goal *entities.Goal) bool {
+			return goal.ID() == entities.GoalID(testutil.SequentialID(1))
+		})).Return(nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCaseWithIDGenerator(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, testutil.NewSequentialIDGenerator())
 		output, err := uc.CreateGoal(ctx, baseInput)
 
 		require.NoError(t, err)
-		assert.NotEmpty(t, output.GoalID)
+		assert.Equal(t, entities.GoalID(testutil.SequentialID(1)), output.GoalID)
 		assert.Equal(t, entities.UserID("user-001"), output.UserID)
 		mockGoalRepo.AssertExpectations(t)
 	})
 
+	t.Run("異常系: 財務計画の更新に失敗した場合は目標の保存もロールバックされる", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan := newTestFinancialPlan("user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindSimilarGoal", mock_anything(), entities.UserID("user-001"), entities.GoalTypeSavings, "新車購入", mock_anything()).Return(nil, nil)
+		mockGoalRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		uow := newStubUnitOfWork(mockPlanRepo, mockGoalRepo)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, uow, recService)
+		_, err := uc.CreateGoal(ctx, baseInput)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の更新に失敗しました")
+		assert.True(t, uow.rolledBack)
+		assert.False(t, uow.committed)
+		mockGoalRepo.AssertCalled(t, "Save", mock_anything(), mock_anything())
+	})
+
 	t.Run("異常系: 無効な目標タイプの場合はエラー", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.CreateGoal(ctx, CreateGoalInput{
 			UserID:              "user-001",
 			GoalType:            "invalid_type",
@@ -86,7 +114,7 @@ func TestManageGoalsUseCase_CreateGoal(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.CreateGoal(ctx, CreateGoalInput{
 			UserID:              "user-001",
 			GoalType:            "savings",
@@ -106,9 +134,10 @@ func TestManageGoalsUseCase_CreateGoal(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).
 			Return(nil, errors.New("財務データが見つかりません"))
+		mockGoalRepo.On("FindSimilarGoal", mock_anything(), entities.UserID("user-001"), entities.GoalTypeSavings, "新車購入", mock_anything()).Return(nil, nil)
 		mockGoalRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.CreateGoal(ctx, baseInput)
 
 		require.Error(t, err)
@@ -121,10 +150,11 @@ func TestManageGoalsUseCase_CreateGoal(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		plan := newTestFinancialPlan("user-001")
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindSimilarGoal", mock_anything(), entities.UserID("user-001"), entities.GoalTypeSavings, "新車購入", mock_anything()).Return(nil, nil)
 		mockGoalRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
 		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.CreateGoal(ctx, baseInput)
 
 		if err == nil {
@@ -133,6 +163,38 @@ func TestManageGoalsUseCase_CreateGoal(t *testing.T) {
 		// 達成不可能と判定された場合も正常なビジネスロジック
 		mockGoalRepo.AssertExpectations(t)
 	})
+
+	t.Run("異常系: 類似する目標が既に存在する場合は既存目標のIDを返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		existingGoal := newTestGoal("user-001", "")
+		mockGoalRepo.On("FindSimilarGoal", mock_anything(), entities.UserID("user-001"), entities.GoalTypeSavings, "新車購入", mock_anything()).Return(existingGoal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.CreateGoal(ctx, baseInput)
+
+		var duplicateErr *DuplicateGoalError
+		require.ErrorAs(t, err, &duplicateErr)
+		assert.Equal(t, existingGoal.ID(), duplicateErr.ExistingGoalID)
+		mockGoalRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: allow_duplicate を指定した場合は重複確認をせずに作成する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).
+			Return(nil, errors.New("財務データが見つかりません"))
+		mockGoalRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+
+		input := baseInput
+		input.AllowDuplicate = true
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.CreateGoal(ctx, input)
+
+		require.NoError(t, err)
+		assert.NotEmpty(t, output.GoalID)
+		mockGoalRepo.AssertNotCalled(t, "FindSimilarGoal", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 // ===========================
@@ -150,7 +212,7 @@ func TestManageGoalsUseCase_GetGoal(t *testing.T) {
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.GetGoal(ctx, GetGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-001",
@@ -167,7 +229,7 @@ func TestManageGoalsUseCase_GetGoal(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.GetGoal(ctx, GetGoalInput{
 			GoalID: "goal-999",
 			UserID: "user-001",
@@ -184,7 +246,7 @@ func TestManageGoalsUseCase_GetGoal(t *testing.T) {
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.GetGoal(ctx, GetGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-002", // 異なるユーザー
@@ -196,6 +258,73 @@ func TestManageGoalsUseCase_GetGoal(t *testing.T) {
 	})
 }
 
+func TestManageGoalsUseCase_GetGoal_Contribution(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	getGoal := func(t *testing.T, goal *entities.Goal) *GetGoalOutput {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.GetGoal(ctx, GetGoalInput{GoalID: goal.ID(), UserID: goal.UserID()})
+		require.NoError(t, err)
+		return output
+	}
+
+	t.Run("正常系: 残額と残期間から月次必要額と拠出額との差を返す", func(t *testing.T) {
+		goal := newTestGoal("user-001", "goal-001")
+
+		output := getGoal(t, goal)
+
+		expected, err := goal.CalculateRequiredMonthlySavings()
+		require.NoError(t, err)
+		assert.Equal(t, expected.Amount(), output.RequiredMonthlyContribution)
+		assert.Equal(t, expected.Amount()-50000, output.ContributionGap)
+		assert.Less(t, output.ContributionGap, 0.0) // 現在の拠出額で期限内に達成できる
+		assert.False(t, output.IsUnachievable)
+	})
+
+	t.Run("正常系: 進捗更新後は残額に応じて必要額が再計算される", func(t *testing.T) {
+		goal := newTestGoal("user-001", "goal-001")
+		before := getGoal(t, goal).RequiredMonthlyContribution
+
+		require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(500000)))
+		after := getGoal(t, goal).RequiredMonthlyContribution
+
+		assert.InDelta(t, before/2, after, 1)
+	})
+
+	t.Run("正常系: 達成済みの目標は必要額・差ともに0", func(t *testing.T) {
+		goal := newTestGoal("user-001", "goal-001")
+		require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(1200000)))
+
+		output := getGoal(t, goal)
+
+		assert.Equal(t, 0.0, output.RequiredMonthlyContribution)
+		assert.Equal(t, 0.0, output.ContributionGap)
+		assert.False(t, output.IsUnachievable)
+	})
+
+	t.Run("正常系: 期限切れの目標は残額全額を必要額とし達成不能とする", func(t *testing.T) {
+		past := time.Now().AddDate(0, -1, 0)
+		goal, err := entities.NewGoalWithID(
+			"goal-001", "user-001", entities.GoalTypeSavings, "旅行資金",
+			mustNewMoney(1000000), past, mustNewMoney(10000), past, past,
+		)
+		require.NoError(t, err)
+		require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(200000)))
+
+		output := getGoal(t, goal)
+
+		assert.Equal(t, 800000.0, output.RequiredMonthlyContribution)
+		assert.Equal(t, 790000.0, output.ContributionGap)
+		assert.True(t, output.IsUnachievable)
+	})
+}
+
 // ===========================
 // GetGoalsByUser Tests
 // ===========================
@@ -211,7 +340,7 @@ func TestManageGoalsUseCase_GetGoalsByUser(t *testing.T) {
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{goal}, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.GetGoalsByUser(ctx, GetGoalsByUserInput{
 			UserID:     "user-001",
 			ActiveOnly: false,
@@ -219,6 +348,7 @@ func TestManageGoalsUseCase_GetGoalsByUser(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Len(t, output.Goals, 1)
+		assert.Greater(t, output.Goals[0].RequiredMonthlyContribution, 0.0)
 		mockGoalRepo.AssertExpectations(t)
 	})
 
@@ -227,7 +357,7 @@ func TestManageGoalsUseCase_GetGoalsByUser(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{}, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.GetGoalsByUser(ctx, GetGoalsByUserInput{
 			UserID:     "user-001",
 			ActiveOnly: false,
@@ -243,7 +373,7 @@ func TestManageGoalsUseCase_GetGoalsByUser(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("db error"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.GetGoalsByUser(ctx, GetGoalsByUserInput{
 			UserID:     "user-001",
 			ActiveOnly: false,
@@ -259,7 +389,7 @@ func TestManageGoalsUseCase_GetGoalsByUser(t *testing.T) {
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{goal}, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.GetGoalsByUser(ctx, GetGoalsByUserInput{
 			UserID:     "user-001",
 			ActiveOnly: true,
@@ -289,34 +419,60 @@ func TestManageGoalsUseCase_DeleteGoal(t *testing.T) {
 	calcService := services.NewFinancialCalculationService()
 	recService := services.NewGoalRecommendationService(calcService)
 
-	t.Run("正常系: 目標を削除できる", func(t *testing.T) {
+	t.Run("正常系: 目標を財務計画から論理削除できる", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		goal := newTestGoal("user-001", "goal-001")
 		plan := newTestFinancialPlanWithGoal("user-001", goal)
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
-		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
-		mockGoalRepo.On("Delete", mock_anything(), goal.ID()).Return(nil)
+		mockPlanRepo.On("Update", mock_anything(), plan).Return(nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		err := uc.DeleteGoal(ctx, DeleteGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-001",
 		})
 
 		require.NoError(t, err)
+		assert.Empty(t, plan.Goals())
+		require.Len(t, plan.RemovedGoals(), 1)
+		assert.True(t, goal.IsDeleted())
+		// 目標は財務計画の更新で論理削除され、物理削除はしない
+		mockGoalRepo.AssertNotCalled(t, "Delete", mock_anything(), mock_anything())
 		mockGoalRepo.AssertExpectations(t)
 		mockPlanRepo.AssertExpectations(t)
 	})
 
+	t.Run("異常系: 財務計画の更新に失敗した場合はロールバックされる", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		plan := newTestFinancialPlanWithGoal("user-001", goal)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		uow := newStubUnitOfWork(mockPlanRepo, mockGoalRepo)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, uow, recService)
+		err := uc.DeleteGoal(ctx, DeleteGoalInput{
+			GoalID: goal.ID(),
+			UserID: "user-001",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の更新に失敗しました")
+		assert.True(t, uow.rolledBack)
+		assert.False(t, uow.committed)
+	})
+
 	t.Run("異常系: 別ユーザーの目標は削除できない", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		err := uc.DeleteGoal(ctx, DeleteGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-002",
@@ -326,6 +482,56 @@ func TestManageGoalsUseCase_DeleteGoal(t *testing.T) {
 		assert.Contains(t, err.Error(), "権限がありません")
 		mockGoalRepo.AssertExpectations(t)
 	})
+
+	t.Run("異常系: 他の目標の前提になっている目標は force なしでは削除できない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		prerequisite := newTestGoal("user-001", "goal-001")
+		dependent := newTestGoal("user-001", "goal-002")
+		plan := newTestFinancialPlanWithGoal("user-001", prerequisite)
+		require.NoError(t, plan.AddGoal(dependent))
+		require.NoError(t, dependent.SetDependencies([]*entities.Goal{prerequisite}, plan.Goals()))
+		mockGoalRepo.On("FindByID", mock_anything(), prerequisite.ID()).Return(prerequisite, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		err := uc.DeleteGoal(ctx, DeleteGoalInput{
+			GoalID: prerequisite.ID(),
+			UserID: "user-001",
+		})
+
+		require.ErrorIs(t, err, ErrGoalHasDependents)
+		assert.True(t, dependent.HasDependency())
+		mockGoalRepo.AssertNotCalled(t, "Delete", mock_anything(), mock_anything())
+	})
+
+	t.Run("正常系: force を指定すると削除する目標への依存のみ解除して削除する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		prerequisite := newTestGoal("user-001", "goal-001")
+		otherPrerequisite := newTestGoal("user-001", "goal-003")
+		dependent := newTestGoal("user-001", "goal-002")
+		plan := newTestFinancialPlanWithGoal("user-001", prerequisite)
+		require.NoError(t, plan.AddGoal(otherPrerequisite))
+		require.NoError(t, plan.AddGoal(dependent))
+		require.NoError(t, dependent.SetDependencies([]*entities.Goal{prerequisite, otherPrerequisite}, plan.Goals()))
+		mockGoalRepo.On("FindByID", mock_anything(), prerequisite.ID()).Return(prerequisite, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		err := uc.DeleteGoal(ctx, DeleteGoalInput{
+			GoalID: prerequisite.ID(),
+			UserID: "user-001",
+			Force:  true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.GoalID{otherPrerequisite.ID()}, dependent.DependsOn())
+		assert.Len(t, plan.Goals(), 2)
+		mockGoalRepo.AssertExpectations(t)
+		mockPlanRepo.AssertExpectations(t)
+	})
 }
 // ===========================
 // UpdateGoal Tests
@@ -344,7 +550,7 @@ func TestManageGoalsUseCase_UpdateGoal(t *testing.T) {
 		mockGoalRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
 		title := "新しい目標タイトル"
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.UpdateGoal(ctx, UpdateGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-001",
@@ -362,7 +568,7 @@ func TestManageGoalsUseCase_UpdateGoal(t *testing.T) {
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-002",
@@ -378,7 +584,7 @@ func TestManageGoalsUseCase_UpdateGoal(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{
 			GoalID: "goal-999",
 			UserID: "user-001",
@@ -396,7 +602,7 @@ func TestManageGoalsUseCase_UpdateGoal(t *testing.T) {
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 		mockGoalRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{
 			GoalID: goal.ID(),
 			UserID: "user-001",
@@ -408,6 +614,113 @@ func TestManageGoalsUseCase_UpdateGoal(t *testing.T) {
 	})
 }
 
+func TestManageGoalsUseCase_UpdateGoal_AutoAdjustContribution(t *testing.T) {
+	ctx := context.Background()
+	recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+	targetAmount := 2000000.0
+
+	t.Run("正常系: 自動調整を指定すると更新後の目標に対する月次必要額を月間拠出額に設定し履歴に記録する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockHistoryRepo := new(MockGoalContributionHistoryRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockHistoryRepo.On("Save", mock_anything(), mock.MatchedBy(func(change *entities.GoalContributionChange) bool {
+			return change.GoalID() == goal.ID() && change.PreviousContribution() == 50000 &&
+				change.NewContribution() == goal.MonthlyContribution().Amount()
+		})).Return(nil)
+
+		uc := NewManageGoalsUseCaseWithContributionHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, nil, mockHistoryRepo)
+		output, err := uc.UpdateGoal(ctx, UpdateGoalInput{
+			GoalID:                 goal.ID(),
+			UserID:                 "user-001",
+			TargetAmount:           &targetAmount,
+			AutoAdjustContribution: true,
+		})
+
+		require.NoError(t, err)
+		// 残額200万円・約2年のため月次必要額は約83,300円
+		assert.InDelta(t, 83333, output.RecommendedMonthlyContribution, 4000)
+		assert.Equal(t, output.RecommendedMonthlyContribution, goal.MonthlyContribution().Amount())
+		mockGoalRepo.AssertExpectations(t)
+		mockHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 自動調整を指定しない場合は月間拠出額を変更せず月次必要額だけを返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockHistoryRepo := new(MockGoalContributionHistoryRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+
+		uc := NewManageGoalsUseCaseWithContributionHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, nil, mockHistoryRepo)
+		output, err := uc.UpdateGoal(ctx, UpdateGoalInput{
+			GoalID:       goal.ID(),
+			UserID:       "user-001",
+			TargetAmount: &targetAmount,
+		})
+
+		require.NoError(t, err)
+		assert.InDelta(t, 83333, output.RecommendedMonthlyContribution, 4000)
+		assert.Equal(t, 50000.0, goal.MonthlyContribution().Amount())
+		mockHistoryRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageGoalsUseCase_UpdateGoal_ContributionRange(t *testing.T) {
+	ctx := context.Background()
+	recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+	floatPtr := func(v float64) *float64 { return &v }
+
+	t.Run("正常系: 月間拠出額と最低額・ストレッチ額をまとめて更新できる", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{
+			GoalID:              goal.ID(),
+			UserID:              "user-001",
+			MonthlyContribution: floatPtr(100000),
+			ContributionMin:     floatPtr(80000),
+			ContributionStretch: floatPtr(120000),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 80000.0, goal.MinimumMonthlyContribution().Amount())
+		assert.Equal(t, 100000.0, goal.MonthlyContribution().Amount())
+		assert.Equal(t, 120000.0, goal.StretchMonthlyContribution().Amount())
+
+		// 0 を指定すると解除する
+		_, err = uc.UpdateGoal(ctx, UpdateGoalInput{GoalID: goal.ID(), UserID: "user-001", ContributionMin: floatPtr(0)})
+		require.NoError(t, err)
+		assert.Nil(t, goal.ContributionMin())
+		assert.Equal(t, 120000.0, goal.StretchMonthlyContribution().Amount())
+	})
+
+	t.Run("異常系: 最低額が月間拠出額を超える場合は更新しない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{
+			GoalID:          goal.ID(),
+			UserID:          "user-001",
+			ContributionMin: floatPtr(60000),
+		})
+
+		require.ErrorIs(t, err, entities.ErrContributionOutOfRange)
+		assert.False(t, goal.HasContributionRange())
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
 // ===========================
 // UpdateGoalProgress Tests
 // ===========================
@@ -424,7 +737,7 @@ func TestManageGoalsUseCase_UpdateGoalProgress(t *testing.T) {
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 		mockGoalRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		output, err := uc.UpdateGoalProgress(ctx, UpdateGoalProgressInput{
 			GoalID:        goal.ID(),
 			UserID:        "user-001",
@@ -442,7 +755,7 @@ func TestManageGoalsUseCase_UpdateGoalProgress(t *testing.T) {
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.UpdateGoalProgress(ctx, UpdateGoalProgressInput{
 			GoalID:        goal.ID(),
 			UserID:        "user-002",
@@ -459,7 +772,7 @@ func TestManageGoalsUseCase_UpdateGoalProgress(t *testing.T) {
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.UpdateGoalProgress(ctx, UpdateGoalProgressInput{
 			GoalID:        "goal-999",
 			UserID:        "user-001",
@@ -471,120 +784,526 @@ func TestManageGoalsUseCase_UpdateGoalProgress(t *testing.T) {
 	})
 }
 
+func TestManageGoalsUseCase_UpdateGoalProgress_RecordsHistory(t *testing.T) {
+	ctx := context.Background()
+	recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+
+	t.Run("正常系: 更新後の現在金額を進捗履歴に記録する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockSnapshotRepo := new(MockGoalProgressSnapshotRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+		mockSnapshotRepo.On("Save", mock_anything(), mock.MatchedBy(func(snapshot *entities.GoalProgressSnapshot) bool {
+			return snapshot.GoalID() == goal.ID() && snapshot.UserID() == "user-001" && snapshot.Amount() == 500000
+		})).Return(nil)
+
+		uc := NewManageGoalsUseCaseWithProgressHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, mockSnapshotRepo)
+		_, err := uc.UpdateGoalProgress(ctx, UpdateGoalProgressInput{
+			GoalID:        goal.ID(),
+			UserID:        "user-001",
+			CurrentAmount: 500000,
+		})
+
+		require.NoError(t, err)
+		mockSnapshotRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 進捗履歴の記録に失敗しても進捗の更新は成功する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockSnapshotRepo := new(MockGoalProgressSnapshotRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+		mockSnapshotRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		uc := NewManageGoalsUseCaseWithProgressHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, mockSnapshotRepo)
+		output, err := uc.UpdateGoalProgress(ctx, UpdateGoalProgressInput{
+			GoalID:        goal.ID(),
+			UserID:        "user-001",
+			CurrentAmount: 500000,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Success)
+	})
+}
+
 // ===========================
-// GetGoalRecommendations Tests
+// EstimateAchievementProbability Tests
 // ===========================
 
-func TestManageGoalsUseCase_GetGoalRecommendations(t *testing.T) {
+func TestManageGoalsUseCase_EstimateAchievementProbability(t *testing.T) {
 	ctx := context.Background()
-	calcService := services.NewFinancialCalculationService()
-	recService := services.NewGoalRecommendationService(calcService)
+	recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+
+	// newHistory は前月から遡って毎月 monthly ずつ積み立てた months ヶ月分の進捗履歴を作成する
+	newHistory := func(t *testing.T, goalID entities.GoalID, monthly float64, months int) []*entities.GoalProgressSnapshot {
+		t.Helper()
+		now := time.Now()
+		start := time.Date(now.Year(), now.Month(), 1, 12, 0, 0, 0, now.Location())
+		history := make([]*entities.GoalProgressSnapshot, 0, months+1)
+		for i := 0; i <= months; i++ {
+			snapshot, err := entities.NewGoalProgressSnapshot(goalID, "user-001", monthly*float64(i), start.AddDate(0, i-months-1, 0))
+			require.NoError(t, err)
+			history = append(history, snapshot)
+		}
+		return history
+	}
 
-	t.Run("正常系: 目標推奨事項を取得できる", func(t *testing.T) {
+	t.Run("正常系: 進捗履歴の積立ペースから達成確率を推定する", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockSnapshotRepo := new(MockGoalProgressSnapshotRepository)
 		goal := newTestGoal("user-001", "goal-001")
-		plan := newTestFinancialPlan("user-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
-		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockSnapshotRepo.On("FindByGoalIDSince", mock_anything(), goal.ID(), mock_anything()).Return(newHistory(t, goal.ID(), 10000, 4), nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
-		output, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
-			GoalID: goal.ID(),
-			UserID: "user-001",
-		})
+		uc := NewManageGoalsUseCaseWithProgressHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, mockSnapshotRepo)
+		output, err := uc.EstimateAchievementProbability(ctx, EstimateAchievementProbabilityInput{GoalID: goal.ID(), UserID: "user-001"})
 
 		require.NoError(t, err)
-		assert.NotNil(t, output)
-		mockGoalRepo.AssertExpectations(t)
-		mockPlanRepo.AssertExpectations(t)
+		assert.Equal(t, goal.ID(), output.GoalID)
+		assert.Equal(t, services.AchievementBasisActualPace, output.Basis)
+		assert.Equal(t, services.PaceBehind, output.PaceStatus)
+		assert.Equal(t, 4, output.Rationale.HistoryMonths)
+		assert.Equal(t, 10000.0, output.Rationale.AverageMonthlyContribution)
+		assert.Less(t, output.Probability, 50.0)
+		mockSnapshotRepo.AssertExpectations(t)
 	})
 
-	t.Run("異常系: 目標が存在しない場合はエラー", func(t *testing.T) {
+	t.Run("正常系: 進捗履歴を記録しない構成では計画ベースで推定する", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
-		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
-		_, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
-			GoalID: "goal-999",
-			UserID: "user-001",
-		})
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.EstimateAchievementProbability(ctx, EstimateAchievementProbabilityInput{GoalID: goal.ID(), UserID: "user-001"})
 
-		require.Error(t, err)
-		mockGoalRepo.AssertExpectations(t)
+		require.NoError(t, err)
+		assert.Equal(t, services.AchievementBasisPlannedContribution, output.Basis)
+		assert.NotEmpty(t, output.FallbackReason)
+		assert.Equal(t, 50000.0, output.Rationale.AverageMonthlyContribution)
 	})
 
-	t.Run("異常系: 別ユーザーの目標は推奨事項を取得できない", func(t *testing.T) {
+	t.Run("異常系: 別ユーザーの目標は推定できない", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockSnapshotRepo := new(MockGoalProgressSnapshotRepository)
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
-		_, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
-			GoalID: goal.ID(),
-			UserID: "user-002",
-		})
+		uc := NewManageGoalsUseCaseWithProgressHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, mockSnapshotRepo)
+		_, err := uc.EstimateAchievementProbability(ctx, EstimateAchievementProbabilityInput{GoalID: goal.ID(), UserID: "user-002"})
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "権限がありません")
-		mockGoalRepo.AssertExpectations(t)
+		mockSnapshotRepo.AssertNotCalled(t, "FindByGoalIDSince", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 進捗履歴の取得に失敗した場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockSnapshotRepo := new(MockGoalProgressSnapshotRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockSnapshotRepo.On("FindByGoalIDSince", mock_anything(), goal.ID(), mock_anything()).Return(nil, errors.New("db error"))
+
+		uc := NewManageGoalsUseCaseWithProgressHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, mockSnapshotRepo)
+		_, err := uc.EstimateAchievementProbability(ctx, EstimateAchievementProbabilityInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "進捗履歴の取得に失敗しました")
 	})
 }
 
 // ===========================
-// AnalyzeGoalFeasibility Tests
+// GetPrioritizedGoals Tests
 // ===========================
 
-func TestManageGoalsUseCase_AnalyzeGoalFeasibility(t *testing.T) {
+func TestManageGoalsUseCase_GetPrioritizedGoals(t *testing.T) {
 	ctx := context.Background()
 	calcService := services.NewFinancialCalculationService()
 	recService := services.NewGoalRecommendationService(calcService)
 
-	t.Run("正常系: 目標実現可能性を分析できる", func(t *testing.T) {
+	t.Run("正常系: ほぼ達成済みの目標を最優先にしてROIとともに返す", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
-		goal := newTestGoal("user-001", "goal-001")
-		plan := newTestFinancialPlan("user-001")
-		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
-		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		justStarted := newTestGoal("user-001", "")
+		nearlyComplete := newTestGoal("user-001", "")
+		require.NoError(t, nearlyComplete.UpdateCurrentAmount(mustNewMoney(900000)))
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).
+			Return([]*entities.Goal{justStarted, nearlyComplete}, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
-		output, err := uc.AnalyzeGoalFeasibility(ctx, AnalyzeGoalFeasibilityInput{
-			GoalID: goal.ID(),
-			UserID: "user-001",
-		})
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.GetPrioritizedGoals(ctx, GetPrioritizedGoalsInput{UserID: "user-001"})
 
 		require.NoError(t, err)
-		assert.NotNil(t, output)
+		require.Len(t, output.Goals, 2)
+		assert.Equal(t, 1, output.Goals[0].Priority)
+		assert.Equal(t, nearlyComplete.ID(), output.Goals[0].Goal.ID())
+		assert.Equal(t, justStarted.ID(), output.Goals[1].Goal.ID())
+		assert.Greater(t, output.Goals[0].EffectiveROI, output.Goals[1].EffectiveROI)
 		mockGoalRepo.AssertExpectations(t)
 		mockPlanRepo.AssertExpectations(t)
 	})
 
-	t.Run("異常系: 目標が存在しない場合はエラー", func(t *testing.T) {
+	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
-		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{}, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
-		_, err := uc.AnalyzeGoalFeasibility(ctx, AnalyzeGoalFeasibilityInput{
-			GoalID: "goal-999",
-			UserID: "user-001",
-		})
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.GetPrioritizedGoals(ctx, GetPrioritizedGoalsInput{UserID: "user-001"})
 
 		require.Error(t, err)
-		mockGoalRepo.AssertExpectations(t)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
 	})
+}
 
-	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
+// ===========================
+// GetOverdueGoals Tests
+// ===========================
+
+// newTestOverdueGoal は目標日が3ヶ月前で進捗30%の期限切れ目標を作成するヘルパー
+func newTestOverdueGoal(t *testing.T, userID entities.UserID) *entities.Goal {
+	t.Helper()
+	now := time.Now()
+	goal, err := entities.NewGoalWithID(
+		entities.NewGoalID(),
+		userID,
+		entities.GoalTypeSavings,
+		"旅行資金",
+		mustNewMoney(1000000),
+		now.AddDate(0, -3, 0),
+		mustNewMoney(50000),
+		now.AddDate(-1, 0, 0),
+		now.AddDate(-1, 0, 0),
+	)
+	require.NoError(t, err)
+	require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(300000)))
+	return goal
+}
+
+func TestManageGoalsUseCase_GetOverdueGoals(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 期限切れの目標のみを立て直しの選択肢とともに返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		overdue := newTestOverdueGoal(t, "user-001")
+		active := newTestGoal("user-001", "")
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).
+			Return([]*entities.Goal{overdue, active}, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.GetOverdueGoals(ctx, GetOverdueGoalsInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		require.Len(t, output.Goals, 1)
+		got := output.Goals[0]
+		assert.Equal(t, overdue.ID(), got.Goal.ID())
+		assert.InDelta(t, 700000, got.RemainingAmount, 0.01)
+		assert.Greater(t, got.DaysOverdue, 0)
+		require.Len(t, got.RemediationOptions, 2)
+
+		now := time.Now()
+		extend := got.RemediationOptions[0]
+		assert.Equal(t, RemediationStrategyExtendDate, extend.Strategy)
+		require.NotNil(t, extend.NewTargetDate)
+		assert.True(t, extend.NewTargetDate.After(now))
+		assert.Greater(t, extend.RequiredMonthlyContribution, 0.0)
+		// 現在の拠出額 50,000円/月 で残額 700,000円 を積み立てるには14ヶ月かかる
+		assert.Equal(t, now.AddDate(0, 14, 0).Format("2006-01-02"), extend.NewTargetDate.Format("2006-01-02"))
+		assert.InDelta(t, 50000, extend.RequiredMonthlyContribution, 0.01)
+
+		reduce := got.RemediationOptions[1]
+		assert.Equal(t, RemediationStrategyReduceTarget, reduce.Strategy)
+		require.NotNil(t, reduce.AchievableBy)
+		assert.True(t, reduce.AchievableBy.After(now))
+		assert.Greater(t, reduce.NewTargetAmount, overdue.CurrentAmount().Amount())
+		assert.Less(t, reduce.NewTargetAmount, overdue.TargetAmount().Amount())
+		// 6ヶ月で 50,000円 × 6 を積み立てる
+		assert.InDelta(t, 600000, reduce.NewTargetAmount, 0.01)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 月間拠出額が0の場合も正の月次必要額と将来の達成日を返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		overdue := newTestOverdueGoal(t, "user-001")
+		require.NoError(t, overdue.UpdateMonthlyContribution(mustNewMoney(0)))
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).
+			Return([]*entities.Goal{overdue}, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.GetOverdueGoals(ctx, GetOverdueGoalsInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		require.Len(t, output.Goals, 1)
+		options := output.Goals[0].RemediationOptions
+		assert.Greater(t, options[0].RequiredMonthlyContribution, 0.0)
+		assert.True(t, options[0].NewTargetDate.After(time.Now()))
+		assert.True(t, options[1].AchievableBy.After(time.Now()))
+		assert.Greater(t, options[1].NewTargetAmount, overdue.CurrentAmount().Amount())
+	})
+
+	t.Run("正常系: 期限切れの目標がない場合は空の一覧を返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).
+			Return([]*entities.Goal{newTestGoal("user-001", "")}, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.GetOverdueGoals(ctx, GetOverdueGoalsInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.NotNil(t, output.Goals)
+		assert.Empty(t, output.Goals)
+	})
+
+	t.Run("異常系: 目標の取得に失敗した場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).
+			Return(nil, errors.New("db error"))
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.GetOverdueGoals(ctx, GetOverdueGoalsInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "目標の取得に失敗しました")
+	})
+}
+
+// ===========================
+// ExportGoalProgressCSV / ExportGoalsCSV Tests
+// ===========================
+
+func TestManageGoalsUseCase_ExportGoalProgressCSV(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 履歴がない場合は現在の状態を1行出力する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(250000)))
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		data, err := uc.ExportGoalProgressCSV(ctx, ExportGoalProgressInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(data), "\xEF\xBB\xBF"), "BOM付きUTF-8で出力する")
+		expected := "\xEF\xBB\xBF日付,金額,進捗率,メモ\n" +
+			goal.UpdatedAt().Format("2006-01-02") + ",250000,25.0,\n"
+		assert.Equal(t, expected, string(data))
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 別ユーザーの目標はエクスポートできない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.ExportGoalProgressCSV(ctx, ExportGoalProgressInput{GoalID: goal.ID(), UserID: "user-002"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "権限がありません")
+	})
+}
+
+func TestManageGoalsUseCase_ExportGoalsCSV(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 全目標を1行ずつ出力する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		car := newTestGoal("user-001", "")
+		house := newTestGoal("user-001", "")
+		require.NoError(t, house.UpdateTitle("住宅, 頭金"))
+		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{car, house}, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		data, err := uc.ExportGoalsCSV(ctx, "user-001")
+
+		require.NoError(t, err)
+		records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(data), "\xEF\xBB\xBF"))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, []string{"目標ID", "目標名", "目標タイプ", "目標金額", "日付", "金額", "進捗率", "メモ"}, records[0])
+		assert.Equal(t, string(car.ID()), records[1][0])
+		assert.Equal(t, "住宅, 頭金", records[2][1])
+		assert.Equal(t, "1000000", records[2][3])
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 目標の取得に失敗した場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("db error"))
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.ExportGoalsCSV(ctx, "user-001")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "目標の取得に失敗しました")
+	})
+}
+
+// ===========================
+// GetGoalRecommendations Tests
+// ===========================
+
+func TestManageGoalsUseCase_GetGoalRecommendations(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 目標推奨事項を取得できる", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		plan := newTestFinancialPlan("user-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
+			GoalID: goal.ID(),
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, output)
+		mockGoalRepo.AssertExpectations(t)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 貯蓄推奨の生成に失敗しても推奨事項は返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		plan := newTestFinancialPlan("user-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService).(*manageGoalsUseCaseImpl)
+		uc.adviseSavings = func(goal *entities.Goal, currentSavings valueobjects.Money, timeRemaining valueobjects.Period) (*services.SavingsRecommendation, error) {
+			return nil, errors.New("貯蓄推奨を計算できません")
+		}
+
+		output, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
+			GoalID: goal.ID(),
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		expected, err := recService.SuggestGoalAdjustments(goal, plan.Profile())
+		require.NoError(t, err)
+		assert.Equal(t, expected, output.Recommendations)
+		assert.Nil(t, output.SavingsAdvice)
+		assert.NotEmpty(t, output.SavingsAdviceError)
+	})
+
+	t.Run("異常系: 目標が存在しない場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
+			GoalID: "goal-999",
+			UserID: "user-001",
+		})
+
+		require.Error(t, err)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 別ユーザーの目標は推奨事項を取得できない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.GetGoalRecommendations(ctx, GetGoalRecommendationsInput{
+			GoalID: goal.ID(),
+			UserID: "user-002",
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "権限がありません")
+		mockGoalRepo.AssertExpectations(t)
+	})
+}
+
+// ===========================
+// AnalyzeGoalFeasibility Tests
+// ===========================
+
+func TestManageGoalsUseCase_AnalyzeGoalFeasibility(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 目標実現可能性を分析できる", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		plan := newTestFinancialPlan("user-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.AnalyzeGoalFeasibility(ctx, AnalyzeGoalFeasibilityInput{
+			GoalID: goal.ID(),
+			UserID: "user-001",
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, output)
+		mockGoalRepo.AssertExpectations(t)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 目標が存在しない場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindByID", mock_anything(), entities.GoalID("goal-999")).Return(nil, errors.New("not found"))
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.AnalyzeGoalFeasibility(ctx, AnalyzeGoalFeasibilityInput{
+			GoalID: "goal-999",
+			UserID: "user-001",
+		})
+
+		require.Error(t, err)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 財務計画が存在しない場合はエラー", func(t *testing.T) {
 		mockGoalRepo := new(MockGoalRepository)
 		mockPlanRepo := new(MockFinancialPlanRepository)
 		goal := newTestGoal("user-001", "goal-001")
 		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
 		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
 
-		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, recService)
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
 		_, err := uc.AnalyzeGoalFeasibility(ctx, AnalyzeGoalFeasibilityInput{
 			GoalID: goal.ID(),
 			UserID: "user-001",
@@ -595,4 +1314,560 @@ func TestManageGoalsUseCase_AnalyzeGoalFeasibility(t *testing.T) {
 		mockGoalRepo.AssertExpectations(t)
 		mockPlanRepo.AssertExpectations(t)
 	})
-}
\ No newline at end of file
+}
+
+// ===========================
+// AdoptRequiredContribution Tests
+// ===========================
+
+// newTestGoalWithContribution は月間拠出額を指定したテスト用の目標を作成するヘルパー
+func newTestGoalWithContribution(t *testing.T, userID entities.UserID, contribution float64) *entities.Goal {
+	t.Helper()
+	goal := newTestGoal(userID, "")
+	amount, err := valueobjects.NewMoneyJPY(contribution)
+	require.NoError(t, err)
+	require.NoError(t, goal.UpdateMonthlyContribution(amount))
+	return goal
+}
+
+func TestManageGoalsUseCase_AdoptRequiredContribution(t *testing.T) {
+	ctx := context.Background()
+	recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+
+	// newUseCase は目標と他のアクティブな目標、月間純貯蓄額22万円の財務計画を返すモックでユースケースを作成する
+	newUseCase := func(goal *entities.Goal, others []*entities.Goal) (ManageGoalsUseCase, *MockGoalRepository, *MockGoalContributionHistoryRepository) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockHistoryRepo := new(MockGoalContributionHistoryRepository)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return(append([]*entities.Goal{goal}, others...), nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		uc := NewManageGoalsUseCaseWithContributionHistory(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, nil, nil, mockHistoryRepo)
+		return uc, mockGoalRepo, mockHistoryRepo
+	}
+
+	t.Run("正常系: 純貯蓄額の範囲内なら月次必要額を1,000円単位に切り上げて設定し履歴に記録する", func(t *testing.T) {
+		goal := newTestGoalWithContribution(t, "user-001", 30000)
+		other := newTestGoalWithContribution(t, "user-001", 50000)
+		uc, mockGoalRepo, mockHistoryRepo := newUseCase(goal, []*entities.Goal{other})
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockHistoryRepo.On("Save", mock_anything(), mock.MatchedBy(func(change *entities.GoalContributionChange) bool {
+			return change.GoalID() == goal.ID() && change.PreviousContribution() == 30000 &&
+				change.NewContribution() == goal.MonthlyContribution().Amount() && !change.OverBudget()
+		})).Return(nil)
+
+		output, err := uc.AdoptRequiredContribution(ctx, AdoptRequiredContributionInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.NoError(t, err)
+		// 残額100万円・約2年のため月次必要額は約41,700円
+		assert.InDelta(t, 41667, output.RequiredMonthlyContribution, 2000)
+		assert.Equal(t, 30000.0, output.PreviousMonthlyContribution)
+		assert.Equal(t, 0.0, math.Mod(output.AdoptedMonthlyContribution, 1000))
+		assert.GreaterOrEqual(t, output.AdoptedMonthlyContribution, output.RequiredMonthlyContribution)
+		assert.Less(t, output.AdoptedMonthlyContribution-output.RequiredMonthlyContribution, 1000.0)
+		assert.Equal(t, output.AdoptedMonthlyContribution, goal.MonthlyContribution().Amount())
+		assert.False(t, output.OverBudget)
+		require.NotNil(t, output.Feasibility)
+		mockGoalRepo.AssertExpectations(t)
+		mockHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: round_to を指定した単位で切り上げる", func(t *testing.T) {
+		goal := newTestGoalWithContribution(t, "user-001", 30000)
+		uc, mockGoalRepo, mockHistoryRepo := newUseCase(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockHistoryRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+
+		output, err := uc.AdoptRequiredContribution(ctx, AdoptRequiredContributionInput{GoalID: goal.ID(), UserID: "user-001", RoundTo: 10000})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0.0, math.Mod(output.AdoptedMonthlyContribution, 10000))
+		assert.Less(t, output.AdoptedMonthlyContribution-output.RequiredMonthlyContribution, 10000.0)
+	})
+
+	t.Run("異常系: 他の目標と合わせて純貯蓄額を超える場合は更新せずに超過の詳細を返す", func(t *testing.T) {
+		goal := newTestGoalWithContribution(t, "user-001", 30000)
+		other := newTestGoalWithContribution(t, "user-001", 200000)
+		uc, mockGoalRepo, mockHistoryRepo := newUseCase(goal, []*entities.Goal{other})
+
+		_, err := uc.AdoptRequiredContribution(ctx, AdoptRequiredContributionInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		var overBudgetErr *ContributionOverBudgetError
+		require.ErrorAs(t, err, &overBudgetErr)
+		assert.Equal(t, 200000.0, overBudgetErr.OtherGoalsContribution)
+		assert.Equal(t, 220000.0, overBudgetErr.MonthlyNetSavings)
+		assert.InDelta(t, overBudgetErr.MonthlyContribution+200000-220000, overBudgetErr.ExceededAmount, 0.01)
+		assert.Equal(t, 30000.0, goal.MonthlyContribution().Amount())
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+		mockHistoryRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+
+	t.Run("正常系: force を指定すると純貯蓄額を超えても設定し超過を記録する", func(t *testing.T) {
+		goal := newTestGoalWithContribution(t, "user-001", 30000)
+		other := newTestGoalWithContribution(t, "user-001", 200000)
+		uc, mockGoalRepo, mockHistoryRepo := newUseCase(goal, []*entities.Goal{other})
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockHistoryRepo.On("Save", mock_anything(), mock.MatchedBy(func(change *entities.GoalContributionChange) bool {
+			return change.OverBudget()
+		})).Return(nil)
+
+		output, err := uc.AdoptRequiredContribution(ctx, AdoptRequiredContributionInput{GoalID: goal.ID(), UserID: "user-001", Force: true})
+
+		require.NoError(t, err)
+		assert.True(t, output.OverBudget)
+		assert.Equal(t, output.AdoptedMonthlyContribution, goal.MonthlyContribution().Amount())
+		mockHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 履歴の記録に失敗しても月間拠出額の更新は成功する", func(t *testing.T) {
+		goal := newTestGoalWithContribution(t, "user-001", 30000)
+		uc, mockGoalRepo, mockHistoryRepo := newUseCase(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockHistoryRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		output, err := uc.AdoptRequiredContribution(ctx, AdoptRequiredContributionInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, output.AdoptedMonthlyContribution, goal.MonthlyContribution().Amount())
+	})
+
+	t.Run("異常系: 他のユーザーの目標は更新できない", func(t *testing.T) {
+		goal := newTestGoalWithContribution(t, "user-002", 30000)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.AdoptRequiredContribution(ctx, AdoptRequiredContributionInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "アクセスする権限がありません")
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
+func TestRoundUpContribution(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   float64
+		roundTo  float64
+		expected float64
+	}{
+		{name: "正常系: 端数を1,000円単位に切り上げる", amount: 72300, roundTo: 0, expected: 73000},
+		{name: "正常系: 割り切れる額はそのまま", amount: 72000, roundTo: 1000, expected: 72000},
+		{name: "正常系: 指定した単位で切り上げる", amount: 72301, roundTo: 100, expected: 72400},
+		{name: "正常系: 0円は0円", amount: 0, roundTo: 1000, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, roundUpContribution(tt.amount, tt.roundTo))
+		})
+	}
+}
+
+// ===========================
+// Goal Image / Metadata Tests
+// ===========================
+
+// newTestManageGoalsUseCaseWithFileStorage はモックの画像ストレージを使うユースケースを作成するヘルパー
+func newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo *MockGoalRepository, mockPlanRepo *MockFinancialPlanRepository, mockStorage *MockFileStorage) ManageGoalsUseCase {
+	recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+	return NewManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService, mockStorage)
+}
+
+func TestManageGoalsUseCase_UploadGoalImage(t *testing.T) {
+	ctx := context.Background()
+	imageData := []byte("png")
+
+	t.Run("正常系: 画像を保存して目標に紐付け、以前の画像を削除する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockStorage.On("Save", mock_anything(), mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "goals/"+string(goal.ID())+"/") && strings.HasSuffix(key, ".png")
+		}), "image/png", imageData).Return("/uploads/goals/new.png", nil)
+		mockStorage.On("Delete", mock_anything(), "/uploads/goals/old.png").Return(nil)
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		output, err := uc.UploadGoalImage(ctx, UploadGoalImageInput{
+			GoalID:      goal.ID(),
+			UserID:      "user-001",
+			ContentType: "image/png",
+			Data:        imageData,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "/uploads/goals/new.png", output.ImageURL)
+		assert.Equal(t, "/uploads/goals/new.png", goal.ImageURL())
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 目標の保存に失敗した場合は保存した画像を削除し、以前の画像は残す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(errors.New("db error"))
+		mockStorage.On("Save", mock_anything(), mock_anything(), "image/png", imageData).Return("/uploads/goals/new.png", nil)
+		mockStorage.On("Delete", mock_anything(), "/uploads/goals/new.png").Return(nil)
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		_, err := uc.UploadGoalImage(ctx, UploadGoalImageInput{
+			GoalID:      goal.ID(),
+			UserID:      "user-001",
+			ContentType: "image/png",
+			Data:        imageData,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "目標の保存に失敗しました")
+		mockStorage.AssertExpectations(t)
+		mockStorage.AssertNotCalled(t, "Delete", mock_anything(), "/uploads/goals/old.png")
+	})
+
+	t.Run("異常系: 対応していない形式の画像は保存しない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		_, err := uc.UploadGoalImage(ctx, UploadGoalImageInput{
+			GoalID:      "goal-001",
+			UserID:      "user-001",
+			ContentType: "image/svg+xml",
+			Data:        imageData,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "画像の形式が正しくありません")
+		mockStorage.AssertNotCalled(t, "Save", mock_anything(), mock_anything(), mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 画像ストレージが設定されていない場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		recService := services.NewGoalRecommendationService(services.NewFinancialCalculationService())
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.UploadGoalImage(ctx, UploadGoalImageInput{
+			GoalID:      "goal-001",
+			UserID:      "user-001",
+			ContentType: "image/png",
+			Data:        imageData,
+		})
+
+		assert.ErrorIs(t, err, ErrGoalImageStorageUnavailable)
+	})
+}
+
+func TestManageGoalsUseCase_DeleteGoalImage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 紐付けを解除してから画像を削除する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockStorage.On("Delete", mock_anything(), "/uploads/goals/old.png").Return(nil)
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		err := uc.DeleteGoalImage(ctx, DeleteGoalImageInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Empty(t, goal.ImageURL())
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 画像の削除に失敗しても紐付けの解除は成功とする", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockStorage.On("Delete", mock_anything(), "/uploads/goals/old.png").Return(errors.New("storage error"))
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		err := uc.DeleteGoalImage(ctx, DeleteGoalImageInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Empty(t, goal.ImageURL())
+	})
+
+	t.Run("異常系: 目標の保存に失敗した場合は画像を削除しない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(errors.New("db error"))
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		err := uc.DeleteGoalImage(ctx, DeleteGoalImageInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.Error(t, err)
+		mockStorage.AssertNotCalled(t, "Delete", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageGoalsUseCase_GoalMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: メモを更新し、画像URLを置き換えると以前の画像を削除する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+		mockStorage.On("Delete", mock_anything(), "/uploads/goals/old.png").Return(nil)
+
+		notes := "<b>ボーナス</b>から積み立てる"
+		imageURL := "https://example.com/car.png"
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{
+			GoalID:   goal.ID(),
+			UserID:   "user-001",
+			Notes:    &notes,
+			ImageURL: &imageURL,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "ボーナスから積み立てる", goal.Notes())
+		assert.Equal(t, imageURL, goal.ImageURL())
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 目標を削除すると画像も削除する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockStorage := new(MockFileStorage)
+		goal := newTestGoal("user-001", "goal-001")
+		goal.UpdateImageURL("/uploads/goals/old.png")
+		plan := newTestFinancialPlanWithGoal("user-001", goal)
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+		mockStorage.On("Delete", mock_anything(), "/uploads/goals/old.png").Return(nil)
+
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, mockStorage)
+		err := uc.DeleteGoal(ctx, DeleteGoalInput{GoalID: goal.ID(), UserID: "user-001"})
+
+		require.NoError(t, err)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("異常系: メモが長すぎる場合は更新しない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		notes := strings.Repeat("あ", entities.MaxGoalNotesLength+1)
+		uc := newTestManageGoalsUseCaseWithFileStorage(mockGoalRepo, mockPlanRepo, new(MockFileStorage))
+		_, err := uc.UpdateGoal(ctx, UpdateGoalInput{GoalID: goal.ID(), UserID: "user-001", Notes: &notes})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "メモの更新に失敗しました")
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
+// ===========================
+// Goal Currency Tests
+// ===========================
+
+// newTestUSDGoal は円で積み立てるドル建ての目標（目標金額 10,000 USD、現在の金額 750,000 円）を作成するヘルパー
+func newTestUSDGoal(t *testing.T, rate float64, asOf time.Time) *entities.Goal {
+	t.Helper()
+
+	goal := newTestGoal("user-001", "goal-001")
+	target, err := valueobjects.NewMoney(10000, valueobjects.USD)
+	require.NoError(t, err)
+	exchangeRate, err := valueobjects.NewExchangeRate(valueobjects.JPY, valueobjects.USD, rate, "", asOf)
+	require.NoError(t, err)
+	require.NoError(t, goal.SetGoalCurrency(target, exchangeRate))
+	require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(750000)))
+	return goal
+}
+
+func TestManageGoalsUseCase_GoalCurrency(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	baseInput := CreateGoalInput{
+		UserID:              "user-001",
+		GoalType:            "savings",
+		Title:               "海外留学",
+		TargetAmount:        10000,
+		TargetDate:          time.Now().AddDate(2, 0, 0).Format(time.RFC3339),
+		CurrentAmount:       100000,
+		MonthlyContribution: 50000,
+		Currency:            "usd",
+		ExchangeRate:        &ExchangeRateInput{Rate: 150},
+	}
+
+	t.Run("正常系: ドル建ての目標は目標金額を円に換算して作成する", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).
+			Return(nil, errors.New("財務データが見つかりません"))
+		mockGoalRepo.On("FindSimilarGoal", mock_anything(), entities.UserID("user-001"), entities.GoalTypeSavings, "海外留学", mock_anything()).Return(nil, nil)
+		var saved *entities.Goal
+		mockGoalRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*entities.Goal)
+		}).Return(nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.CreateGoal(ctx, baseInput)
+
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.Equal(t, valueobjects.USD, saved.Currency())
+		assert.Equal(t, 10000.0, saved.TargetAmountInGoalCurrency().Amount())
+		assert.Equal(t, valueobjects.JPY, saved.TargetAmount().Currency())
+		assert.Equal(t, 1500000.0, saved.TargetAmount().Amount())
+		assert.Equal(t, valueobjects.ExchangeRateSourceManual, saved.ExchangeRate().Source())
+	})
+
+	t.Run("異常系: 外貨建ての目標に為替レートがない場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		input := baseInput
+		input.ExchangeRate = nil
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.CreateGoal(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "為替レートが必要です")
+		mockGoalRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 対応していない通貨の場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		input := baseInput
+		input.Currency = "GBP"
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.CreateGoal(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "対応していない通貨です")
+	})
+
+	t.Run("正常系: 円で積み立てるドル建ての目標は為替レートごとに進捗と月次必要額が変わる", func(t *testing.T) {
+		now := time.Now()
+		tests := []struct {
+			name             string
+			rate             float64
+			expectedProgress float64
+			expectedTarget   float64
+		}{
+			{"1ドル150円", 150, 50, 1500000},
+			{"1ドル125円", 125, 60, 1250000},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockGoalRepo := new(MockGoalRepository)
+				mockPlanRepo := new(MockFinancialPlanRepository)
+				goal := newTestUSDGoal(t, tt.rate, now.AddDate(0, 0, -3))
+				mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+				uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+				output, err := uc.GetGoal(ctx, GetGoalInput{GoalID: goal.ID(), UserID: "user-001"})
+
+				require.NoError(t, err)
+				assert.InDelta(t, tt.expectedProgress, output.Progress.AsPercentage(), 0.01)
+				assert.InDelta(t, tt.expectedTarget, output.Goal.TargetAmount().Amount(), 0.01)
+				require.NotNil(t, output.Currency)
+				assert.Equal(t, "USD", output.Currency.GoalCurrency)
+				assert.Equal(t, "JPY", output.Currency.PlanCurrency)
+				assert.InDelta(t, 10000, float64(output.Currency.TargetAmount), 0.01)
+				assert.InDelta(t, 750000/tt.rate, float64(output.Currency.CurrentAmount), 0.01)
+				// 月次必要額は円の必要額を為替レートで換算した金額
+				assert.InDelta(t, output.RequiredMonthlyContribution/tt.rate, float64(output.Currency.RequiredMonthlyContribution), 0.01)
+				assert.Equal(t, tt.rate, output.Currency.ExchangeRate.Rate)
+				assert.Equal(t, 3, output.Currency.ExchangeRate.AgeDays)
+			})
+		}
+	})
+
+	t.Run("正常系: 為替レートを更新すると再計算した目標を返し、以前のレートを履歴に残す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestUSDGoal(t, 150, time.Now().AddDate(0, 0, -30))
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+		mockGoalRepo.On("Update", mock_anything(), goal).Return(nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		output, err := uc.UpdateGoalExchangeRate(ctx, UpdateGoalExchangeRateInput{
+			GoalID:            goal.ID(),
+			UserID:            "user-001",
+			ExchangeRateInput: ExchangeRateInput{Rate: 125, Source: "bank"},
+		})
+
+		require.NoError(t, err)
+		assert.InDelta(t, 60, output.Progress.AsPercentage(), 0.01)
+		assert.InDelta(t, 1250000, output.Goal.TargetAmount().Amount(), 0.01)
+		require.NotNil(t, output.Currency)
+		assert.Equal(t, 125.0, output.Currency.ExchangeRate.Rate)
+		assert.Equal(t, "bank", output.Currency.ExchangeRate.Source)
+		assert.Equal(t, 0, output.Currency.ExchangeRate.AgeDays)
+		require.Len(t, output.Currency.ExchangeRateHistory, 1)
+		assert.Equal(t, 150.0, output.Currency.ExchangeRateHistory[0].Rate)
+		assert.Equal(t, 30, output.Currency.ExchangeRateHistory[0].AgeDays)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 円建ての目標の為替レートは更新できない", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestGoal("user-001", "goal-001")
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.UpdateGoalExchangeRate(ctx, UpdateGoalExchangeRateInput{
+			GoalID:            goal.ID(),
+			UserID:            "user-001",
+			ExchangeRateInput: ExchangeRateInput{Rate: 125},
+		})
+
+		assert.ErrorIs(t, err, entities.ErrGoalNotForeignCurrency)
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 為替レートが正の値でない場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		goal := newTestUSDGoal(t, 150, time.Now())
+		mockGoalRepo.On("FindByID", mock_anything(), goal.ID()).Return(goal, nil)
+
+		uc := NewManageGoalsUseCase(mockGoalRepo, mockPlanRepo, newStubUnitOfWork(mockPlanRepo, mockGoalRepo), recService)
+		_, err := uc.UpdateGoalExchangeRate(ctx, UpdateGoalExchangeRateInput{
+			GoalID:            goal.ID(),
+			UserID:            "user-001",
+			ExchangeRateInput: ExchangeRateInput{Rate: 0},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "為替レートの作成に失敗しました")
+		assert.Equal(t, 150.0, goal.ExchangeRate().Rate())
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
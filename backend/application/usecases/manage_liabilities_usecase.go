@@ -0,0 +1,314 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// ManageLiabilitiesUseCase は負債（ローン）管理のユースケース
+type ManageLiabilitiesUseCase interface {
+	// GetLiabilities は負債の一覧と純資産を取得する
+	GetLiabilities(ctx context.Context, input GetLiabilitiesInput) (*LiabilitiesOutput, error)
+
+	// CreateLiability は負債を登録する
+	CreateLiability(ctx context.Context, input CreateLiabilityInput) (*LiabilitiesOutput, error)
+
+	// UpdateLiability は負債を更新する
+	UpdateLiability(ctx context.Context, input UpdateLiabilityInput) (*LiabilitiesOutput, error)
+
+	// DeleteLiability は負債を削除する
+	DeleteLiability(ctx context.Context, input DeleteLiabilityInput) (*LiabilitiesOutput, error)
+}
+
+// GetLiabilitiesInput は負債一覧取得の入力
+type GetLiabilitiesInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// LiabilityAttributes は負債の属性の入力
+// 住宅ローンで MonthlyPayment が0の場合は、残高・金利・返済終了日までの期間から元利均等返済の返済額を求める
+type LiabilityAttributes struct {
+	LiabilityType      string    `json:"type"`
+	Name               string    `json:"name"`
+	PrincipalRemaining float64   `json:"principal_remaining"`
+	InterestRate       float64   `json:"interest_rate"` // 年利（%）
+	MonthlyPayment     float64   `json:"monthly_payment"`
+	EndDate            time.Time `json:"end_date"`
+	IncludedInExpenses bool      `json:"included_in_expenses"`
+}
+
+// CreateLiabilityInput は負債登録の入力
+type CreateLiabilityInput struct {
+	UserID entities.UserID `json:"user_id"`
+	LiabilityAttributes
+}
+
+// UpdateLiabilityInput は負債更新の入力
+type UpdateLiabilityInput struct {
+	UserID      entities.UserID      `json:"user_id"`
+	LiabilityID entities.LiabilityID `json:"liability_id"`
+	LiabilityAttributes
+}
+
+// DeleteLiabilityInput は負債削除の入力
+type DeleteLiabilityInput struct {
+	UserID      entities.UserID      `json:"user_id"`
+	LiabilityID entities.LiabilityID `json:"liability_id"`
+}
+
+// LiabilitySummary は負債と完済予定日
+type LiabilitySummary struct {
+	ID                 string    `json:"id"`
+	LiabilityType      string    `json:"type"`
+	Name               string    `json:"name"`
+	PrincipalRemaining float64   `json:"principal_remaining"`
+	InterestRate       float64   `json:"interest_rate"`
+	MonthlyPayment     float64   `json:"monthly_payment"`
+	EndDate            string    `json:"end_date"` // YYYY-MM-DD
+	IncludedInExpenses bool      `json:"included_in_expenses"`
+	PayoffDate         string    `json:"payoff_date"` // YYYY-MM-DD
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+// LiabilitiesOutput は負債の一覧と純資産の出力
+type LiabilitiesOutput struct {
+	Liabilities      []LiabilitySummary `json:"liabilities"`
+	TotalLiabilities float64            `json:"total_liabilities"`
+	NetWorth         float64            `json:"net_worth"`
+	// MonthlyDebtPayments は支出項目に計上されていない返済額の合計（純貯蓄額の計算で差し引かれる額）
+	MonthlyDebtPayments float64 `json:"monthly_debt_payments"`
+}
+
+// manageLiabilitiesUseCaseImpl はManageLiabilitiesUseCaseの実装
+type manageLiabilitiesUseCaseImpl struct {
+	financialPlanRepo  repositories.FinancialPlanRepository
+	calculationService *services.FinancialCalculationService
+	logger             *log.UseCaseLogger
+}
+
+// NewManageLiabilitiesUseCase は新しいManageLiabilitiesUseCaseを作成する
+func NewManageLiabilitiesUseCase(financialPlanRepo repositories.FinancialPlanRepository) ManageLiabilitiesUseCase {
+	return &manageLiabilitiesUseCaseImpl{
+		financialPlanRepo:  financialPlanRepo,
+		calculationService: services.NewFinancialCalculationService(),
+		logger:             log.NewUseCaseLogger("ManageLiabilitiesUseCase"),
+	}
+}
+
+// GetLiabilities は負債の一覧と純資産を取得する
+func (uc *manageLiabilitiesUseCaseImpl) GetLiabilities(
+	ctx context.Context,
+	input GetLiabilitiesInput,
+) (*LiabilitiesOutput, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	return buildLiabilitiesOutput(plan, time.Now())
+}
+
+// CreateLiability は負債を登録する
+func (uc *manageLiabilitiesUseCaseImpl) CreateLiability(
+	ctx context.Context,
+	input CreateLiabilityInput,
+) (*LiabilitiesOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CreateLiability",
+		log.UserID(string(input.UserID)),
+		slog.String("liability_type", input.LiabilityType),
+	)
+
+	if err := uc.resolveMortgagePayment(&input.LiabilityAttributes, time.Now()); err != nil {
+		return nil, fmt.Errorf("負債の作成に失敗しました: %w", err)
+	}
+	principal, rate, payment, err := input.LiabilityAttributes.valueObjects()
+	if err != nil {
+		return nil, fmt.Errorf("負債の作成に失敗しました: %w", err)
+	}
+
+	liability, err := entities.NewLiability(
+		entities.LiabilityType(input.LiabilityType),
+		input.Name,
+		principal,
+		rate,
+		payment,
+		input.EndDate,
+		input.IncludedInExpenses,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("負債の作成に失敗しました: %w", err)
+	}
+
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	if err := plan.AddLiability(liability); err != nil {
+		return nil, fmt.Errorf("負債の作成に失敗しました: %w", err)
+	}
+
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		uc.logger.OperationError(ctx, "CreateLiability", err,
+			slog.String("step", "update_plan"),
+		)
+		return nil, fmt.Errorf("負債の保存に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "CreateLiability",
+		slog.String("liability_id", string(liability.ID())),
+	)
+
+	return buildLiabilitiesOutput(plan, time.Now())
+}
+
+// UpdateLiability は負債を更新する
+func (uc *manageLiabilitiesUseCaseImpl) UpdateLiability(
+	ctx context.Context,
+	input UpdateLiabilityInput,
+) (*LiabilitiesOutput, error) {
+	if err := uc.resolveMortgagePayment(&input.LiabilityAttributes, time.Now()); err != nil {
+		return nil, fmt.Errorf("負債の更新に失敗しました: %w", err)
+	}
+	principal, rate, payment, err := input.LiabilityAttributes.valueObjects()
+	if err != nil {
+		return nil, fmt.Errorf("負債の更新に失敗しました: %w", err)
+	}
+
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	if plan.Profile().Liabilities().FindByID(input.LiabilityID) == nil {
+		return nil, fmt.Errorf("負債が見つかりません: %s", input.LiabilityID)
+	}
+
+	if _, err := plan.UpdateLiability(
+		input.LiabilityID,
+		entities.LiabilityType(input.LiabilityType),
+		input.Name,
+		principal,
+		rate,
+		payment,
+		input.EndDate,
+		input.IncludedInExpenses,
+	); err != nil {
+		return nil, fmt.Errorf("負債の更新に失敗しました: %w", err)
+	}
+
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("負債の保存に失敗しました: %w", err)
+	}
+
+	return buildLiabilitiesOutput(plan, time.Now())
+}
+
+// DeleteLiability は負債を削除する
+func (uc *manageLiabilitiesUseCaseImpl) DeleteLiability(
+	ctx context.Context,
+	input DeleteLiabilityInput,
+) (*LiabilitiesOutput, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	if plan.Profile().Liabilities().FindByID(input.LiabilityID) == nil {
+		return nil, fmt.Errorf("負債が見つかりません: %s", input.LiabilityID)
+	}
+
+	if err := plan.RemoveLiability(input.LiabilityID); err != nil {
+		return nil, fmt.Errorf("負債の削除に失敗しました: %w", err)
+	}
+
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("負債の保存に失敗しました: %w", err)
+	}
+
+	return buildLiabilitiesOutput(plan, time.Now())
+}
+
+// resolveMortgagePayment は住宅ローンの月々の返済額が未指定（0）の場合に、
+// 残高を返済終了日までの期間で元利均等返済する返済予定の初回の返済額を設定する
+func (uc *manageLiabilitiesUseCaseImpl) resolveMortgagePayment(attrs *LiabilityAttributes, now time.Time) error {
+	if entities.LiabilityType(attrs.LiabilityType) != entities.LiabilityTypeMortgage || attrs.MonthlyPayment != 0 {
+		return nil
+	}
+
+	termMonths := entities.MonthsBetween(now, attrs.EndDate)
+	schedule, err := uc.calculationService.GenerateAmortizationSchedule(attrs.PrincipalRemaining, attrs.InterestRate, termMonths)
+	if err != nil {
+		return fmt.Errorf("返済予定から月々の返済額を計算できません: %w", err)
+	}
+	attrs.MonthlyPayment = schedule[0].Payment
+	return nil
+}
+
+// valueObjects は入力の金額・利率を値オブジェクトに変換する
+func (a LiabilityAttributes) valueObjects() (valueobjects.Money, valueobjects.Rate, valueobjects.Money, error) {
+	principal, err := valueobjects.NewMoneyJPY(a.PrincipalRemaining)
+	if err != nil {
+		return valueobjects.Money{}, valueobjects.Rate{}, valueobjects.Money{}, fmt.Errorf("無効な残高: %w", err)
+	}
+	rate, err := valueobjects.NewRate(a.InterestRate)
+	if err != nil {
+		return valueobjects.Money{}, valueobjects.Rate{}, valueobjects.Money{}, fmt.Errorf("無効な金利: %w", err)
+	}
+	payment, err := valueobjects.NewMoneyJPY(a.MonthlyPayment)
+	if err != nil {
+		return valueobjects.Money{}, valueobjects.Rate{}, valueobjects.Money{}, fmt.Errorf("無効な返済額: %w", err)
+	}
+	return principal, rate, payment, nil
+}
+
+// buildLiabilitiesOutput は財務計画の負債から出力を組み立てる
+func buildLiabilitiesOutput(plan *aggregates.FinancialPlan, now time.Time) (*LiabilitiesOutput, error) {
+	profile := plan.Profile()
+
+	totalLiabilities, err := profile.TotalLiabilities()
+	if err != nil {
+		return nil, err
+	}
+	netWorth, err := profile.NetWorth()
+	if err != nil {
+		return nil, fmt.Errorf("純資産の計算に失敗しました: %w", err)
+	}
+	debtPayments, err := profile.MonthlyDebtPayments()
+	if err != nil {
+		return nil, err
+	}
+
+	liabilities := profile.Liabilities()
+	summaries := make([]LiabilitySummary, 0, len(liabilities))
+	for _, liability := range liabilities {
+		summaries = append(summaries, LiabilitySummary{
+			ID:                 string(liability.ID()),
+			LiabilityType:      string(liability.LiabilityType()),
+			Name:               liability.Name(),
+			PrincipalRemaining: liability.PrincipalRemaining().Amount(),
+			InterestRate:       liability.InterestRate().AsPercentage(),
+			MonthlyPayment:     liability.MonthlyPayment().Amount(),
+			EndDate:            liability.EndDate().Format("2006-01-02"),
+			IncludedInExpenses: liability.IncludedInExpenses(),
+			PayoffDate:         liability.PayoffDateFrom(now).Format("2006-01-02"),
+			UpdatedAt:          liability.UpdatedAt(),
+		})
+	}
+
+	return &LiabilitiesOutput{
+		Liabilities:         summaries,
+		TotalLiabilities:    totalLiabilities.Amount(),
+		NetWorth:            netWorth.Amount(),
+		MonthlyDebtPayments: debtPayments.Amount(),
+	}, nil
+}
@@ -0,0 +1,237 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLiabilityAttributes はテスト用の自動車ローン（残高540,000円・月30,000円・無利息）の入力を作成するヘルパー
+func newTestLiabilityAttributes() LiabilityAttributes {
+	return LiabilityAttributes{
+		LiabilityType:      string(entities.LiabilityTypeCarLoan),
+		Name:               "自動車ローン",
+		PrincipalRemaining: 540000,
+		InterestRate:       0,
+		MonthlyPayment:     30000,
+		EndDate:            time.Now().AddDate(3, 0, 0),
+	}
+}
+
+// newTestFinancialPlanWithLiability はテスト用の負債を登録した財務計画を作成するヘルパー
+func newTestFinancialPlanWithLiability(t *testing.T, userID entities.UserID) (*aggregates.FinancialPlan, *entities.Liability) {
+	t.Helper()
+	plan := newTestFinancialPlan(userID)
+	attributes := newTestLiabilityAttributes()
+	principal, rate, payment, err := attributes.valueObjects()
+	require.NoError(t, err)
+	liability, err := entities.NewLiability(entities.LiabilityTypeCarLoan, attributes.Name, principal, rate, payment, attributes.EndDate, false)
+	require.NoError(t, err)
+	require.NoError(t, plan.AddLiability(liability))
+	return plan, liability
+}
+
+func TestManageLiabilitiesUseCase_CreateLiability(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 負債を登録して純資産と返済額を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		output, err := uc.CreateLiability(ctx, CreateLiabilityInput{
+			UserID:              "user-001",
+			LiabilityAttributes: newTestLiabilityAttributes(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Liabilities, 1)
+		assert.Equal(t, 540000.0, output.TotalLiabilities)
+		assert.Equal(t, 460000.0, output.NetWorth)
+		assert.Equal(t, 30000.0, output.MonthlyDebtPayments)
+		// 18ヶ月で完済する
+		assert.Equal(t, time.Now().AddDate(0, 18, 0).Format("2006-01-02"), output.Liabilities[0].PayoffDate)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 無効な種類の場合は保存しない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+
+		attributes := newTestLiabilityAttributes()
+		attributes.LiabilityType = "credit_card"
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		_, err := uc.CreateLiability(ctx, CreateLiabilityInput{UserID: "user-001", LiabilityAttributes: attributes})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "負債の作成に失敗しました")
+		mockPlanRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("正常系: 住宅ローンの返済額を省略すると返済予定から計算する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		output, err := uc.CreateLiability(ctx, CreateLiabilityInput{
+			UserID: "user-001",
+			LiabilityAttributes: LiabilityAttributes{
+				LiabilityType:      string(entities.LiabilityTypeMortgage),
+				Name:               "住宅ローン",
+				PrincipalRemaining: 30000000,
+				InterestRate:       1.5,
+				EndDate:            time.Now().AddDate(35, 0, 1), // 残り420ヶ月
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.Liabilities, 1)
+		// 3,000万円・年1.5%・35年の元利均等返済
+		assert.Equal(t, 91855.0, output.Liabilities[0].MonthlyPayment)
+	})
+
+	t.Run("異常系: 返済終了日を過ぎた住宅ローンは返済額を計算できない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		_, err := uc.CreateLiability(ctx, CreateLiabilityInput{
+			UserID: "user-001",
+			LiabilityAttributes: LiabilityAttributes{
+				LiabilityType:      string(entities.LiabilityTypeMortgage),
+				PrincipalRemaining: 30000000,
+				InterestRate:       1.5,
+				EndDate:            time.Now().AddDate(0, 0, -1),
+			},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "返済予定から月々の返済額を計算できません")
+		mockPlanRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 財務計画がない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
+
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		_, err := uc.CreateLiability(ctx, CreateLiabilityInput{UserID: "user-001", LiabilityAttributes: newTestLiabilityAttributes()})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+	})
+}
+
+func TestManageLiabilitiesUseCase_UpdateLiability(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 支出に計上済みにすると返済額を差し引かない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, liability := newTestFinancialPlanWithLiability(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		attributes := newTestLiabilityAttributes()
+		attributes.IncludedInExpenses = true
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		output, err := uc.UpdateLiability(ctx, UpdateLiabilityInput{
+			UserID:              "user-001",
+			LiabilityID:         liability.ID(),
+			LiabilityAttributes: attributes,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, output.Liabilities[0].IncludedInExpenses)
+		assert.Equal(t, 0.0, output.MonthlyDebtPayments)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 存在しない負債の場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, _ := newTestFinancialPlanWithLiability(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		_, err := uc.UpdateLiability(ctx, UpdateLiabilityInput{
+			UserID:              "user-001",
+			LiabilityID:         "missing",
+			LiabilityAttributes: newTestLiabilityAttributes(),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "負債が見つかりません")
+		mockPlanRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageLiabilitiesUseCase_DeleteLiability(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 負債を削除すると純資産は総資産と同じになる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, liability := newTestFinancialPlanWithLiability(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		uc := NewManageLiabilitiesUseCase(mockPlanRepo)
+		output, err := uc.DeleteLiability(ctx, DeleteLiabilityInput{UserID: "user-001", LiabilityID: liability.ID()})
+
+		require.NoError(t, err)
+		assert.Empty(t, output.Liabilities)
+		assert.Equal(t, 0.0, output.TotalLiabilities)
+		assert.Equal(t, 1000000.0, output.NetWorth)
+	})
+}
+
+func TestGenerateReportsUseCase_Liabilities(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 負債から純資産と債務対収入比率を計算する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, _ := newTestFinancialPlanWithLiability(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		situation := output.Report.CurrentSituation
+		assert.Equal(t, 540000.0, situation.TotalLiabilities)
+		assert.Equal(t, 460000.0, situation.NetWorth)
+		assert.Equal(t, 30000.0, situation.MonthlyDebtPayments)
+		assert.Equal(t, 190000.0, situation.NetSavings)
+		// 30,000 / 400,000
+		assert.InDelta(t, 7.5, output.Report.FinancialHealth.DebtToIncomeRatio, 0.001)
+	})
+}
+
+func TestCalculateProjectionUseCase_CalculateAssetProjection_Liabilities(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: 負債の返済予定を考慮して純資産の推移と完済予定を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, liability := newTestFinancialPlanWithLiability(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewCalculateProjectionUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.CalculateAssetProjection(ctx, AssetProjectionInput{UserID: "user-001", Years: 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, 180000.0, output.Projections[0].TotalLiabilities.Amount())
+		assert.True(t, output.Projections[1].TotalLiabilities.IsZero())
+		assert.Equal(t, output.Projections[2].TotalAssets.Amount(), output.Projections[2].NetWorth.Amount())
+		require.Len(t, output.LiabilityPayoffs, 1)
+		assert.Equal(t, string(liability.ID()), output.LiabilityPayoffs[0].LiabilityID)
+	})
+}
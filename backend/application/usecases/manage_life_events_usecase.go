@@ -0,0 +1,259 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// ManageLifeEventsUseCase はライフイベント（結婚・出産・子供の独立など）管理のユースケース
+type ManageLifeEventsUseCase interface {
+	// GetLifeEvents はライフイベントの一覧を取得する
+	GetLifeEvents(ctx context.Context, input GetLifeEventsInput) (*LifeEventsOutput, error)
+
+	// CreateLifeEvent はライフイベントを登録する
+	CreateLifeEvent(ctx context.Context, input CreateLifeEventInput) (*LifeEventsOutput, error)
+
+	// UpdateLifeEvent はライフイベントを更新する
+	UpdateLifeEvent(ctx context.Context, input UpdateLifeEventInput) (*LifeEventsOutput, error)
+
+	// DeleteLifeEvent はライフイベントを削除する
+	DeleteLifeEvent(ctx context.Context, input DeleteLifeEventInput) (*LifeEventsOutput, error)
+}
+
+// GetLifeEventsInput はライフイベント一覧取得の入力
+type GetLifeEventsInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// LifeEventAttributes はライフイベントの属性の入力
+type LifeEventAttributes struct {
+	Category      string  `json:"category"`
+	Name          string  `json:"name"`
+	Year          int     `json:"year"`
+	Recurrence    string  `json:"recurrence"`
+	AmountChange  float64 `json:"amount_change"`  // 支出の増減額（正: 増加, 負: 減少）
+	DurationYears int     `json:"duration_years"` // 継続する年数（継続の場合のみ。0 は予測期間の終わりまで）
+}
+
+// CreateLifeEventInput はライフイベント登録の入力
+type CreateLifeEventInput struct {
+	UserID entities.UserID `json:"user_id"`
+	LifeEventAttributes
+}
+
+// UpdateLifeEventInput はライフイベント更新の入力
+type UpdateLifeEventInput struct {
+	UserID      entities.UserID      `json:"user_id"`
+	LifeEventID entities.LifeEventID `json:"life_event_id"`
+	LifeEventAttributes
+}
+
+// DeleteLifeEventInput はライフイベント削除の入力
+type DeleteLifeEventInput struct {
+	UserID      entities.UserID      `json:"user_id"`
+	LifeEventID entities.LifeEventID `json:"life_event_id"`
+}
+
+// LifeEventSummary はライフイベントと支出が増減する期間
+type LifeEventSummary struct {
+	ID            string    `json:"id"`
+	Category      string    `json:"category"`
+	Name          string    `json:"name"`
+	Year          int       `json:"year"`
+	Recurrence    string    `json:"recurrence"`
+	AmountChange  float64   `json:"amount_change"`
+	DurationYears int       `json:"duration_years"`
+	EndYear       *int      `json:"end_year"` // 支出の増減が続く最後の年（期限のない継続イベントは null）
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// LifeEventsOutput はライフイベントの一覧の出力
+type LifeEventsOutput struct {
+	LifeEvents []LifeEventSummary `json:"life_events"`
+}
+
+// manageLifeEventsUseCaseImpl はManageLifeEventsUseCaseの実装
+type manageLifeEventsUseCaseImpl struct {
+	financialPlanRepo repositories.FinancialPlanRepository
+	logger            *log.UseCaseLogger
+}
+
+// NewManageLifeEventsUseCase は新しいManageLifeEventsUseCaseを作成する
+func NewManageLifeEventsUseCase(financialPlanRepo repositories.FinancialPlanRepository) ManageLifeEventsUseCase {
+	return &manageLifeEventsUseCaseImpl{
+		financialPlanRepo: financialPlanRepo,
+		logger:            log.NewUseCaseLogger("ManageLifeEventsUseCase"),
+	}
+}
+
+// GetLifeEvents はライフイベントの一覧を取得する
+func (uc *manageLifeEventsUseCaseImpl) GetLifeEvents(
+	ctx context.Context,
+	input GetLifeEventsInput,
+) (*LifeEventsOutput, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	return buildLifeEventsOutput(plan), nil
+}
+
+// CreateLifeEvent はライフイベントを登録する
+func (uc *manageLifeEventsUseCaseImpl) CreateLifeEvent(
+	ctx context.Context,
+	input CreateLifeEventInput,
+) (*LifeEventsOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "CreateLifeEvent",
+		log.UserID(string(input.UserID)),
+		slog.String("category", input.Category),
+	)
+
+	amountChange, err := input.LifeEventAttributes.amountChange()
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントの作成に失敗しました: %w", err)
+	}
+
+	event, err := entities.NewLifeEvent(
+		entities.LifeEventCategory(input.Category),
+		input.Name,
+		input.Year,
+		entities.LifeEventRecurrence(input.Recurrence),
+		amountChange,
+		input.DurationYears,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントの作成に失敗しました: %w", err)
+	}
+
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	if err := plan.AddLifeEvent(event); err != nil {
+		return nil, fmt.Errorf("ライフイベントの作成に失敗しました: %w", err)
+	}
+
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		uc.logger.OperationError(ctx, "CreateLifeEvent", err,
+			slog.String("step", "update_plan"),
+		)
+		return nil, fmt.Errorf("ライフイベントの保存に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "CreateLifeEvent",
+		slog.String("life_event_id", string(event.ID())),
+	)
+
+	return buildLifeEventsOutput(plan), nil
+}
+
+// UpdateLifeEvent はライフイベントを更新する
+func (uc *manageLifeEventsUseCaseImpl) UpdateLifeEvent(
+	ctx context.Context,
+	input UpdateLifeEventInput,
+) (*LifeEventsOutput, error) {
+	amountChange, err := input.LifeEventAttributes.amountChange()
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントの更新に失敗しました: %w", err)
+	}
+
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	if plan.Profile().LifeEvents().FindByID(input.LifeEventID) == nil {
+		return nil, fmt.Errorf("ライフイベントが見つかりません: %s", input.LifeEventID)
+	}
+
+	if _, err := plan.UpdateLifeEvent(
+		input.LifeEventID,
+		entities.LifeEventCategory(input.Category),
+		input.Name,
+		input.Year,
+		entities.LifeEventRecurrence(input.Recurrence),
+		amountChange,
+		input.DurationYears,
+	); err != nil {
+		return nil, fmt.Errorf("ライフイベントの更新に失敗しました: %w", err)
+	}
+
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("ライフイベントの保存に失敗しました: %w", err)
+	}
+
+	return buildLifeEventsOutput(plan), nil
+}
+
+// DeleteLifeEvent はライフイベントを削除する
+func (uc *manageLifeEventsUseCaseImpl) DeleteLifeEvent(
+	ctx context.Context,
+	input DeleteLifeEventInput,
+) (*LifeEventsOutput, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	if plan.Profile().LifeEvents().FindByID(input.LifeEventID) == nil {
+		return nil, fmt.Errorf("ライフイベントが見つかりません: %s", input.LifeEventID)
+	}
+
+	if err := plan.RemoveLifeEvent(input.LifeEventID); err != nil {
+		return nil, fmt.Errorf("ライフイベントの削除に失敗しました: %w", err)
+	}
+
+	if err := uc.financialPlanRepo.Update(ctx, plan); err != nil {
+		return nil, fmt.Errorf("ライフイベントの保存に失敗しました: %w", err)
+	}
+
+	return buildLifeEventsOutput(plan), nil
+}
+
+// amountChange は入力の増減額を値オブジェクトに変換する
+func (a LifeEventAttributes) amountChange() (valueobjects.Money, error) {
+	amount, err := valueobjects.NewMoneyJPY(a.AmountChange)
+	if err != nil {
+		return valueobjects.Money{}, fmt.Errorf("無効な増減額: %w", err)
+	}
+	return amount, nil
+}
+
+// buildLifeEventsOutput は財務計画のライフイベントから出力を組み立てる
+func buildLifeEventsOutput(plan *aggregates.FinancialPlan) *LifeEventsOutput {
+	lifeEvents := plan.Profile().LifeEvents()
+	summaries := make([]LifeEventSummary, 0, len(lifeEvents))
+	for _, event := range lifeEvents {
+		summary := LifeEventSummary{
+			ID:            string(event.ID()),
+			Category:      string(event.Category()),
+			Name:          event.Name(),
+			Year:          event.Year(),
+			Recurrence:    string(event.Recurrence()),
+			AmountChange:  event.AmountChange().Amount(),
+			DurationYears: event.DurationYears(),
+			UpdatedAt:     event.UpdatedAt(),
+		}
+		switch {
+		case event.Recurrence() == entities.LifeEventOneTime:
+			endYear := event.Year()
+			summary.EndYear = &endYear
+		case event.DurationYears() > 0:
+			endYear := event.Year() + event.DurationYears() - 1
+			summary.EndYear = &endYear
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return &LifeEventsOutput{LifeEvents: summaries}
+}
@@ -0,0 +1,196 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestLifeEventAttributes はテスト用の教育費（来年から3年間・月50,000円の支出増）の入力を作成するヘルパー
+func newTestLifeEventAttributes() LifeEventAttributes {
+	return LifeEventAttributes{
+		Category:      string(entities.LifeEventCategoryEducation),
+		Name:          "大学の学費",
+		Year:          time.Now().Year() + 1,
+		Recurrence:    string(entities.LifeEventRecurring),
+		AmountChange:  50000,
+		DurationYears: 3,
+	}
+}
+
+// newTestFinancialPlanWithLifeEvent はテスト用のライフイベントを登録した財務計画を作成するヘルパー
+func newTestFinancialPlanWithLifeEvent(t *testing.T, userID entities.UserID) (*aggregates.FinancialPlan, *entities.LifeEvent) {
+	t.Helper()
+	plan := newTestFinancialPlan(userID)
+	attributes := newTestLifeEventAttributes()
+	amountChange, err := attributes.amountChange()
+	require.NoError(t, err)
+	event, err := entities.NewLifeEvent(entities.LifeEventCategoryEducation, attributes.Name, attributes.Year, entities.LifeEventRecurring, amountChange, attributes.DurationYears)
+	require.NoError(t, err)
+	require.NoError(t, plan.AddLifeEvent(event))
+	return plan, event
+}
+
+func TestManageLifeEventsUseCase_CreateLifeEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: ライフイベントを登録して支出が増減する期間を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		output, err := uc.CreateLifeEvent(ctx, CreateLifeEventInput{
+			UserID:              "user-001",
+			LifeEventAttributes: newTestLifeEventAttributes(),
+		})
+
+		require.NoError(t, err)
+		require.Len(t, output.LifeEvents, 1)
+		assert.Equal(t, 50000.0, output.LifeEvents[0].AmountChange)
+		require.NotNil(t, output.LifeEvents[0].EndYear)
+		assert.Equal(t, time.Now().Year()+3, *output.LifeEvents[0].EndYear)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 期限のない継続イベントは終了年を返さない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+		mockPlanRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
+
+		attributes := newTestLifeEventAttributes()
+		attributes.Category = string(entities.LifeEventCategoryChildIndependent)
+		attributes.AmountChange = -30000
+		attributes.DurationYears = 0
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		output, err := uc.CreateLifeEvent(ctx, CreateLifeEventInput{UserID: "user-001", LifeEventAttributes: attributes})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.LifeEvents[0].EndYear)
+	})
+
+	t.Run("異常系: 発生年が過去の場合は保存しない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+
+		attributes := newTestLifeEventAttributes()
+		attributes.Year = time.Now().Year() - 1
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		_, err := uc.CreateLifeEvent(ctx, CreateLifeEventInput{UserID: "user-001", LifeEventAttributes: attributes})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ライフイベントの作成に失敗しました")
+		mockPlanRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 財務計画がない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
+
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		_, err := uc.CreateLifeEvent(ctx, CreateLifeEventInput{UserID: "user-001", LifeEventAttributes: newTestLifeEventAttributes()})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務計画の取得に失敗しました")
+	})
+}
+
+func TestManageLifeEventsUseCase_UpdateLifeEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 継続年数を変更する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, event := newTestFinancialPlanWithLifeEvent(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		attributes := newTestLifeEventAttributes()
+		attributes.DurationYears = 4
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		output, err := uc.UpdateLifeEvent(ctx, UpdateLifeEventInput{
+			UserID:              "user-001",
+			LifeEventID:         event.ID(),
+			LifeEventAttributes: attributes,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, output.LifeEvents[0].DurationYears)
+		mockPlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 存在しないライフイベントの場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, _ := newTestFinancialPlanWithLifeEvent(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		_, err := uc.UpdateLifeEvent(ctx, UpdateLifeEventInput{
+			UserID:              "user-001",
+			LifeEventID:         "missing",
+			LifeEventAttributes: newTestLifeEventAttributes(),
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ライフイベントが見つかりません")
+		mockPlanRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
+
+func TestManageLifeEventsUseCase_DeleteLifeEvent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: ライフイベントを削除する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, event := newTestFinancialPlanWithLifeEvent(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockPlanRepo.On("Update", mock_anything(), plan).Return(nil)
+
+		uc := NewManageLifeEventsUseCase(mockPlanRepo)
+		output, err := uc.DeleteLifeEvent(ctx, DeleteLifeEventInput{UserID: "user-001", LifeEventID: event.ID()})
+
+		require.NoError(t, err)
+		assert.Empty(t, output.LifeEvents)
+	})
+}
+
+func TestGenerateReportsUseCase_GenerateAssetProjectionReport_LifeEvents(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	t.Run("正常系: ライフイベントの有無による資産の差を返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		plan, _ := newTestFinancialPlanWithLifeEvent(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{UserID: "user-001", Years: 5})
+
+		require.NoError(t, err)
+		impact := output.Report.LifeEventImpact
+		require.NotNil(t, impact)
+		assert.Equal(t, 1, impact.EventCount)
+		assert.Equal(t, output.Report.Projections[4].TotalAssets.Amount(), impact.FinalAssets)
+		// 3年間・月50,000円の支出増（元本1,800,000円と運用益の分だけ少なくなる）
+		assert.Less(t, impact.Difference, -1800000.0)
+		assert.InDelta(t, impact.FinalAssets-impact.FinalAssetsWithoutEvents, impact.Difference, 0.01)
+		assert.Contains(t, output.Report.Insights[len(output.Report.Insights)-1], "ライフイベントにより5年後の資産が")
+	})
+
+	t.Run("正常系: ライフイベントがない場合は差を返さない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewGenerateReportsUseCase(mockPlanRepo, new(MockGoalRepository), calcService, recService)
+		output, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{UserID: "user-001", Years: 5})
+
+		require.NoError(t, err)
+		assert.Nil(t, output.Report.LifeEventImpact)
+	})
+}
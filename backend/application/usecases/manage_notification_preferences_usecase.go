@@ -0,0 +1,274 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// maxReviewExcessPercent は見直し推奨の測定値（超過率%）の上限
+const maxReviewExcessPercent = 1000.0
+
+// ManageNotificationPreferencesUseCase は通知設定管理と目標通知のユースケース
+type ManageNotificationPreferencesUseCase interface {
+	// GetNotificationPreferences はユーザーの通知設定一覧を取得する
+	GetNotificationPreferences(ctx context.Context, input GetNotificationPreferencesInput) (*GetNotificationPreferencesOutput, error)
+
+	// UpdateNotificationPreference はイベント・チャネルごとの通知設定を保存する
+	UpdateNotificationPreference(ctx context.Context, input UpdateNotificationPreferenceInput) (*UpdateNotificationPreferenceOutput, error)
+
+	// NotifyGoalEvents はユーザーの目標を評価し、期限接近・達成・見直し推奨の通知を配信する
+	NotifyGoalEvents(ctx context.Context, input NotifyGoalEventsInput) (*NotifyGoalEventsOutput, error)
+}
+
+// GetNotificationPreferencesInput は通知設定一覧取得の入力
+type GetNotificationPreferencesInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// GetNotificationPreferencesOutput は通知設定一覧取得の出力
+type GetNotificationPreferencesOutput struct {
+	Preferences []*entities.NotificationPreference `json:"preferences"`
+}
+
+// UpdateNotificationPreferenceInput は通知設定保存の入力
+type UpdateNotificationPreferenceInput struct {
+	UserID      entities.UserID `json:"user_id"`
+	EventType   string          `json:"event_type"`
+	Channel     string          `json:"channel"`
+	Enabled     bool            `json:"enabled"`
+	Threshold   *float64        `json:"threshold,omitempty"` // 未指定の場合はイベント種別ごとの初期値
+	Destination string          `json:"destination"`
+}
+
+// UpdateNotificationPreferenceOutput は通知設定保存の出力
+type UpdateNotificationPreferenceOutput struct {
+	Preference *entities.NotificationPreference `json:"preference"`
+}
+
+// NotifyGoalEventsInput は目標通知の入力
+type NotifyGoalEventsInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// GoalNotificationResult は目標ごとの通知配信結果
+type GoalNotificationResult struct {
+	GoalID    entities.GoalID                    `json:"goal_id"`
+	EventType entities.NotificationEventType     `json:"event_type"`
+	Delivered []entities.NotificationChannelType `json:"delivered"`
+	Queued    []entities.NotificationChannelType `json:"queued"`
+}
+
+// NotifyGoalEventsOutput は目標通知の出力
+type NotifyGoalEventsOutput struct {
+	Results []GoalNotificationResult `json:"results"`
+}
+
+// manageNotificationPreferencesUseCaseImpl はManageNotificationPreferencesUseCaseの実装
+type manageNotificationPreferencesUseCaseImpl struct {
+	preferenceRepo repositories.NotificationPreferenceRepository
+	goalRepo       repositories.GoalRepository
+	dispatcher     ports.NotificationDispatcher
+	logger         *log.UseCaseLogger
+}
+
+// NewManageNotificationPreferencesUseCase は新しいManageNotificationPreferencesUseCaseを作成する
+func NewManageNotificationPreferencesUseCase(
+	preferenceRepo repositories.NotificationPreferenceRepository,
+	goalRepo repositories.GoalRepository,
+	dispatcher ports.NotificationDispatcher,
+) ManageNotificationPreferencesUseCase {
+	return &manageNotificationPreferencesUseCaseImpl{
+		preferenceRepo: preferenceRepo,
+		goalRepo:       goalRepo,
+		dispatcher:     dispatcher,
+		logger:         log.NewUseCaseLogger("ManageNotificationPreferencesUseCase"),
+	}
+}
+
+// GetNotificationPreferences はユーザーの通知設定一覧を取得する
+func (uc *manageNotificationPreferencesUseCaseImpl) GetNotificationPreferences(
+	ctx context.Context,
+	input GetNotificationPreferencesInput,
+) (*GetNotificationPreferencesOutput, error) {
+	preferences, err := uc.preferenceRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("通知設定の取得に失敗しました: %w", err)
+	}
+	if preferences == nil {
+		preferences = []*entities.NotificationPreference{}
+	}
+
+	return &GetNotificationPreferencesOutput{Preferences: preferences}, nil
+}
+
+// UpdateNotificationPreference はイベント・チャネルごとの通知設定を保存する
+func (uc *manageNotificationPreferencesUseCaseImpl) UpdateNotificationPreference(
+	ctx context.Context,
+	input UpdateNotificationPreferenceInput,
+) (*UpdateNotificationPreferenceOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "UpdateNotificationPreference",
+		log.UserID(string(input.UserID)),
+		slog.String("event_type", input.EventType),
+		slog.String("channel", input.Channel),
+	)
+
+	eventType := entities.NotificationEventType(input.EventType)
+	threshold := eventType.DefaultThreshold()
+	if input.Threshold != nil {
+		threshold = *input.Threshold
+	}
+
+	preference, err := entities.NewNotificationPreference(
+		input.UserID,
+		eventType,
+		entities.NotificationChannelType(input.Channel),
+		input.Enabled,
+		threshold,
+		input.Destination,
+	)
+	if err != nil {
+		uc.logger.OperationError(ctx, "UpdateNotificationPreference", err,
+			slog.String("step", "create_preference"),
+		)
+		return nil, fmt.Errorf("通知設定の作成に失敗しました: %w", err)
+	}
+
+	if err := uc.preferenceRepo.Save(ctx, preference); err != nil {
+		uc.logger.OperationError(ctx, "UpdateNotificationPreference", err,
+			slog.String("step", "save_preference"),
+		)
+		return nil, fmt.Errorf("通知設定の保存に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "UpdateNotificationPreference",
+		slog.String("preference_id", string(preference.ID())),
+	)
+
+	return &UpdateNotificationPreferenceOutput{Preference: preference}, nil
+}
+
+// NotifyGoalEvents はユーザーの目標を評価し、期限接近・達成・見直し推奨の通知を配信する
+func (uc *manageNotificationPreferencesUseCaseImpl) NotifyGoalEvents(
+	ctx context.Context,
+	input NotifyGoalEventsInput,
+) (*NotifyGoalEventsOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "NotifyGoalEvents",
+		log.UserID(string(input.UserID)),
+	)
+
+	goals, err := uc.goalRepo.FindActiveGoalsByUserID(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "NotifyGoalEvents", err,
+			slog.String("step", "find_goals"),
+		)
+		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+
+	output := &NotifyGoalEventsOutput{Results: []GoalNotificationResult{}}
+	now := time.Now()
+	for _, goal := range goals {
+		for _, n := range buildGoalNotifications(goal, now) {
+			result, err := uc.dispatcher.Dispatch(ctx, n)
+			if err != nil {
+				uc.logger.OperationError(ctx, "NotifyGoalEvents", err,
+					slog.String("step", "dispatch"),
+					slog.String("goal_id", string(goal.ID())),
+				)
+				return nil, fmt.Errorf("通知の配信に失敗しました: %w", err)
+			}
+			if len(result.Delivered) == 0 && len(result.Queued) == 0 {
+				continue
+			}
+			output.Results = append(output.Results, GoalNotificationResult{
+				GoalID:    goal.ID(),
+				EventType: n.EventType,
+				Delivered: result.Delivered,
+				Queued:    result.Queued,
+			})
+		}
+	}
+
+	uc.logger.EndOperation(ctx, "NotifyGoalEvents",
+		slog.Int("goal_count", len(goals)),
+		slog.Int("notification_count", len(output.Results)),
+	)
+
+	return output, nil
+}
+
+// buildGoalNotifications は目標から各イベントの通知候補を作成する
+// 閾値による配信判定はディスパッチャーが通知設定ごとに行う
+func buildGoalNotifications(goal *entities.Goal, now time.Time) []ports.Notification {
+	notifications := []ports.Notification{}
+
+	progress, err := goal.CalculateProgress(goal.CurrentAmount())
+	if err != nil {
+		return notifications
+	}
+
+	if progress.AsPercentage() > 0 {
+		notifications = append(notifications, ports.Notification{
+			UserID:     goal.UserID(),
+			EventType:  entities.NotificationEventGoalAchieved,
+			Title:      "目標達成のお知らせ",
+			Message:    fmt.Sprintf("「%s」の進捗率が%.1f%%に達しました", goal.Title(), progress.AsPercentage()),
+			Value:      progress.AsPercentage(),
+			GoalID:     string(goal.ID()),
+			OccurredAt: now,
+		})
+	}
+
+	if goal.IsCompleted() || goal.IsOverdue() {
+		return notifications
+	}
+
+	remainingDays := goal.GetRemainingDays()
+	notifications = append(notifications, ports.Notification{
+		UserID:     goal.UserID(),
+		EventType:  entities.NotificationEventGoalDeadlineApproaching,
+		Title:      "目標期限が近づいています",
+		Message:    fmt.Sprintf("「%s」の期限まで残り%d日です", goal.Title(), remainingDays),
+		Value:      float64(remainingDays),
+		GoalID:     string(goal.ID()),
+		OccurredAt: now,
+	})
+
+	required, err := goal.CalculateRequiredMonthlySavings()
+	if err != nil {
+		return notifications
+	}
+	excessPercent := reviewExcessPercent(required.Amount(), goal.MonthlyContribution().Amount())
+	if excessPercent > 0 {
+		notifications = append(notifications, ports.Notification{
+			UserID:    goal.UserID(),
+			EventType: entities.NotificationEventGoalReviewRecommended,
+			Title:     "目標の見直しをおすすめします",
+			Message: fmt.Sprintf("「%s」の達成に必要な月間貯蓄額（%.0f円）が現在の積立額を%.0f%%上回っています",
+				goal.Title(), required.Amount(), excessPercent),
+			Value:      excessPercent,
+			GoalID:     string(goal.ID()),
+			OccurredAt: now,
+		})
+	}
+
+	return notifications
+}
+
+// reviewExcessPercent は必要月間貯蓄額が現在の積立額を上回る割合（%）を返す
+func reviewExcessPercent(required, contribution float64) float64 {
+	if required <= contribution {
+		return 0
+	}
+	if contribution <= 0 {
+		return maxReviewExcessPercent
+	}
+	return math.Min((required-contribution)/contribution*100, maxReviewExcessPercent)
+}
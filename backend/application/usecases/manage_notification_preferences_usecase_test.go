@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestGoalNearDeadline は期限が20日後で進捗50%、積立額が不足している目標を作成するヘルパー
+func newTestGoalNearDeadline(t *testing.T, userID entities.UserID) *entities.Goal {
+	t.Helper()
+	goal, err := entities.NewGoal(
+		userID,
+		entities.GoalTypeSavings,
+		"旅行資金",
+		mustNewMoney(1000000),
+		time.Now().AddDate(0, 0, 20),
+		mustNewMoney(10000),
+	)
+	require.NoError(t, err)
+	require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(500000)))
+	return goal
+}
+
+// matchEventType は指定イベント種別の通知にマッチするMatcherを返す
+func matchEventType(eventType entities.NotificationEventType) interface{} {
+	return mock.MatchedBy(func(n ports.Notification) bool { return n.EventType == eventType })
+}
+
+// ===========================
+// UpdateNotificationPreference Tests
+// ===========================
+
+func TestManageNotificationPreferencesUseCase_UpdateNotificationPreference(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 閾値未指定の場合はイベント種別の初期値で保存する", func(t *testing.T) {
+		mockPrefRepo := new(MockNotificationPreferenceRepository)
+		mockPrefRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+
+		uc := NewManageNotificationPreferencesUseCase(mockPrefRepo, new(MockGoalRepository), new(MockNotificationDispatcher))
+		output, err := uc.UpdateNotificationPreference(ctx, UpdateNotificationPreferenceInput{
+			UserID:    "user-001",
+			EventType: string(entities.NotificationEventGoalDeadlineApproaching),
+			Channel:   string(entities.NotificationChannelEmail),
+			Enabled:   true,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 30.0, output.Preference.Threshold())
+		assert.True(t, output.Preference.IsEnabled())
+		mockPrefRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: Webhookの送信先がない場合はエラー", func(t *testing.T) {
+		mockPrefRepo := new(MockNotificationPreferenceRepository)
+
+		uc := NewManageNotificationPreferencesUseCase(mockPrefRepo, new(MockGoalRepository), new(MockNotificationDispatcher))
+		_, err := uc.UpdateNotificationPreference(ctx, UpdateNotificationPreferenceInput{
+			UserID:    "user-001",
+			EventType: string(entities.NotificationEventGoalAchieved),
+			Channel:   string(entities.NotificationChannelWebhook),
+			Enabled:   true,
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "通知設定の作成に失敗しました")
+		mockPrefRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
+// ===========================
+// GetNotificationPreferences Tests
+// ===========================
+
+func TestManageNotificationPreferencesUseCase_GetNotificationPreferences(t *testing.T) {
+	ctx := context.Background()
+	mockPrefRepo := new(MockNotificationPreferenceRepository)
+	mockPrefRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, nil)
+
+	uc := NewManageNotificationPreferencesUseCase(mockPrefRepo, new(MockGoalRepository), new(MockNotificationDispatcher))
+	output, err := uc.GetNotificationPreferences(ctx, GetNotificationPreferencesInput{UserID: "user-001"})
+
+	require.NoError(t, err)
+	assert.NotNil(t, output.Preferences)
+	assert.Empty(t, output.Preferences)
+}
+
+// ===========================
+// NotifyGoalEvents Tests
+// ===========================
+
+func TestManageNotificationPreferencesUseCase_NotifyGoalEvents(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 期限接近・達成・見直し推奨の通知候補を配信し、配信された結果のみ返す", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockDispatcher := new(MockNotificationDispatcher)
+		goal := newTestGoalNearDeadline(t, "user-001")
+
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{goal}, nil)
+		mockDispatcher.On("Dispatch", mock_anything(), matchEventType(entities.NotificationEventGoalDeadlineApproaching)).
+			Return(&ports.DispatchResult{Delivered: []entities.NotificationChannelType{entities.NotificationChannelEmail}}, nil)
+		mockDispatcher.On("Dispatch", mock_anything(), matchEventType(entities.NotificationEventGoalReviewRecommended)).
+			Return(&ports.DispatchResult{Queued: []entities.NotificationChannelType{entities.NotificationChannelWebhook}}, nil)
+		mockDispatcher.On("Dispatch", mock_anything(), matchEventType(entities.NotificationEventGoalAchieved)).
+			Return(&ports.DispatchResult{}, nil)
+
+		uc := NewManageNotificationPreferencesUseCase(new(MockNotificationPreferenceRepository), mockGoalRepo, mockDispatcher)
+		output, err := uc.NotifyGoalEvents(ctx, NotifyGoalEventsInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		require.Len(t, output.Results, 2)
+		assert.Equal(t, entities.NotificationEventGoalDeadlineApproaching, output.Results[0].EventType)
+		assert.Equal(t, entities.NotificationEventGoalReviewRecommended, output.Results[1].EventType)
+		mockDispatcher.AssertNumberOfCalls(t, "Dispatch", 3)
+	})
+
+	t.Run("異常系: 配信処理でエラーが発生した場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockDispatcher := new(MockNotificationDispatcher)
+		goal := newTestGoalNearDeadline(t, "user-001")
+
+		mockGoalRepo.On("FindActiveGoalsByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{goal}, nil)
+		mockDispatcher.On("Dispatch", mock_anything(), mock_anything()).Return(nil, errors.New("db error"))
+
+		uc := NewManageNotificationPreferencesUseCase(new(MockNotificationPreferenceRepository), mockGoalRepo, mockDispatcher)
+		_, err := uc.NotifyGoalEvents(ctx, NotifyGoalEventsInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "通知の配信に失敗しました")
+	})
+}
+
+func TestReviewExcessPercent(t *testing.T) {
+	assert.Equal(t, 0.0, reviewExcessPercent(40000, 50000))
+	assert.InDelta(t, 25.0, reviewExcessPercent(50000, 40000), 0.001)
+	assert.Equal(t, maxReviewExcessPercent, reviewExcessPercent(10000, 0))
+}
@@ -0,0 +1,332 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// ErrRecommendationNotFound は現在の財務状況から生成される推奨事項に指定IDがない場合のエラー
+var ErrRecommendationNotFound = errors.New("推奨事項が見つかりません")
+
+// 推奨事項を生成する閾値
+const (
+	lowSavingsRateThreshold      = 10.0 // 貯蓄率の下限（%）
+	highSavingsRateThreshold     = 30.0 // 投資の多様化を勧める貯蓄率（%）
+	lowInvestmentReturnThreshold = 3.0  // 見直しを勧める投資利回り（%）
+)
+
+// ManageRecommendationsUseCase は推奨事項の取得と却下のユースケース
+type ManageRecommendationsUseCase interface {
+	// GetRecommendations は却下済みのものを除いた推奨事項と警告を取得する
+	GetRecommendations(ctx context.Context, input GetRecommendationsInput) (*RecommendationsOutput, error)
+
+	// DismissRecommendation は推奨事項を却下する（スヌーズ期限を指定した場合は期限まで非表示）
+	DismissRecommendation(ctx context.Context, input DismissRecommendationInput) (*DismissRecommendationOutput, error)
+}
+
+// GetRecommendationsInput は推奨事項取得の入力
+type GetRecommendationsInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// RecommendationsOutput は推奨事項取得の出力
+type RecommendationsOutput struct {
+	Recommendations []entities.Recommendation `json:"recommendations"`
+}
+
+// DismissRecommendationInput は推奨事項却下の入力
+type DismissRecommendationInput struct {
+	UserID           entities.UserID           `json:"user_id"`
+	RecommendationID entities.RecommendationID `json:"recommendation_id"`
+	SnoozeUntil      *time.Time                `json:"snooze_until,omitempty"`
+}
+
+// DismissRecommendationOutput は推奨事項却下の出力
+type DismissRecommendationOutput struct {
+	RecommendationID entities.RecommendationID `json:"recommendation_id"`
+	DismissedAt      time.Time                 `json:"dismissed_at"`
+	SnoozeUntil      *time.Time                `json:"snooze_until,omitempty"`
+}
+
+// manageRecommendationsUseCaseImpl はManageRecommendationsUseCaseの実装
+type manageRecommendationsUseCaseImpl struct {
+	financialPlanRepo     repositories.FinancialPlanRepository
+	savingsRateTargetRepo repositories.SavingsRateTargetRepository
+	dismissalRepo         repositories.RecommendationDismissalRepository
+	calculationService    *services.FinancialCalculationService
+	logger                *log.UseCaseLogger
+}
+
+// NewManageRecommendationsUseCase は新しいManageRecommendationsUseCaseを作成する
+// savingsRateTargetRepo が nil の場合、貯蓄率目標に関する警告は生成しない
+func NewManageRecommendationsUseCase(
+	financialPlanRepo repositories.FinancialPlanRepository,
+	savingsRateTargetRepo repositories.SavingsRateTargetRepository,
+	dismissalRepo repositories.RecommendationDismissalRepository,
+	calculationService *services.FinancialCalculationService,
+) ManageRecommendationsUseCase {
+	return &manageRecommendationsUseCaseImpl{
+		financialPlanRepo:     financialPlanRepo,
+		savingsRateTargetRepo: savingsRateTargetRepo,
+		dismissalRepo:         dismissalRepo,
+		calculationService:    calculationService,
+		logger:                log.NewUseCaseLogger("ManageRecommendationsUseCase"),
+	}
+}
+
+// GetRecommendations は却下済みのものを除いた推奨事項と警告を取得する
+func (uc *manageRecommendationsUseCaseImpl) GetRecommendations(
+	ctx context.Context,
+	input GetRecommendationsInput,
+) (*RecommendationsOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "GetRecommendations",
+		log.UserID(string(input.UserID)),
+	)
+
+	recommendations, err := uc.generateRecommendations(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "GetRecommendations", err,
+			slog.String("step", "generate_recommendations"),
+		)
+		return nil, err
+	}
+
+	recommendations, err = filterDismissedRecommendations(ctx, uc.dismissalRepo, input.UserID, recommendations, time.Now())
+	if err != nil {
+		uc.logger.OperationError(ctx, "GetRecommendations", err,
+			slog.String("step", "filter_dismissed"),
+		)
+		return nil, err
+	}
+
+	uc.logger.EndOperation(ctx, "GetRecommendations",
+		slog.Int("recommendation_count", len(recommendations)),
+	)
+
+	return &RecommendationsOutput{Recommendations: recommendations}, nil
+}
+
+// DismissRecommendation は推奨事項を却下する（スヌーズ期限を指定した場合は期限まで非表示）
+// 却下時点の重要度を記録し、以降に重要度が悪化した場合の再表示判定に使用する
+func (uc *manageRecommendationsUseCaseImpl) DismissRecommendation(
+	ctx context.Context,
+	input DismissRecommendationInput,
+) (*DismissRecommendationOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "DismissRecommendation",
+		log.UserID(string(input.UserID)),
+		slog.String("recommendation_id", string(input.RecommendationID)),
+	)
+
+	recommendations, err := uc.generateRecommendations(ctx, input.UserID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "DismissRecommendation", err,
+			slog.String("step", "generate_recommendations"),
+		)
+		return nil, err
+	}
+
+	var target *entities.Recommendation
+	for i := range recommendations {
+		if recommendations[i].ID == input.RecommendationID {
+			target = &recommendations[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil, ErrRecommendationNotFound
+	}
+
+	dismissal, err := entities.NewRecommendationDismissal(input.UserID, *target, time.Now(), input.SnoozeUntil)
+	if err != nil {
+		return nil, fmt.Errorf("推奨事項の却下の作成に失敗しました: %w", err)
+	}
+
+	if err := uc.dismissalRepo.Save(ctx, dismissal); err != nil {
+		uc.logger.OperationError(ctx, "DismissRecommendation", err,
+			slog.String("step", "save_dismissal"),
+		)
+		return nil, fmt.Errorf("推奨事項の却下の保存に失敗しました: %w", err)
+	}
+
+	uc.logger.EndOperation(ctx, "DismissRecommendation")
+
+	return &DismissRecommendationOutput{
+		RecommendationID: dismissal.RecommendationID(),
+		DismissedAt:      dismissal.DismissedAt(),
+		SnoozeUntil:      dismissal.SnoozeUntil(),
+	}, nil
+}
+
+// generateRecommendations は財務計画と貯蓄率目標から推奨事項を生成する（却下状態は考慮しない）
+func (uc *manageRecommendationsUseCaseImpl) generateRecommendations(
+	ctx context.Context,
+	userID entities.UserID,
+) ([]entities.Recommendation, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	savingsRateTarget, err := evaluateActiveSavingsRateTarget(ctx, uc.savingsRateTargetRepo, plan, userID)
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄率目標の評価に失敗しました: %w", err)
+	}
+
+	return planRecommendations(plan, savingsRateTarget, uc.calculationService.Defaults(), time.Now()), nil
+}
+
+// planRecommendations は財務計画と貯蓄率目標の進捗から推奨事項と警告を生成する
+// IDは種別と閾値から決まるため、同じ状況が続く限り同じIDになる
+// 財務データの鮮度は now 時点で判定し、緊急資金の推奨月数は defaults に従う
+func planRecommendations(
+	plan *aggregates.FinancialPlan,
+	savingsRateTarget *entities.SavingsRateTargetProgress,
+	defaults services.CalculationDefaults,
+	now time.Time,
+) []entities.Recommendation {
+	var recommendations []entities.Recommendation
+
+	// 貯蓄率チェック
+	netSavings, err := plan.Profile().CalculateNetSavings()
+	if err == nil {
+		monthlyIncome := plan.Profile().NetMonthlyIncome()
+		savingsRate := (netSavings.Amount() / monthlyIncome.Amount()) * 100
+
+		if savingsRate < lowSavingsRateThreshold {
+			severity := lowSavingsRateThreshold - savingsRate
+			recommendations = append(recommendations,
+				entities.NewRecommendation(entities.RecommendationTypeLowSavingsRate, entities.RecommendationKindWarning,
+					"貯蓄率が10%を下回っています。支出の見直しを検討してください", severity, formatThreshold(lowSavingsRateThreshold)),
+				entities.NewRecommendation(entities.RecommendationTypeLowSavingsRate, entities.RecommendationKindRecommendation,
+					"月間支出を詳細に分析し、削減可能な項目を特定してください", severity, formatThreshold(lowSavingsRateThreshold)),
+			)
+		} else if savingsRate > highSavingsRateThreshold {
+			recommendations = append(recommendations,
+				entities.NewRecommendation(entities.RecommendationTypeHighSavingsRate, entities.RecommendationKindRecommendation,
+					"優秀な貯蓄率です。投資商品の多様化を検討してください", 0, formatThreshold(highSavingsRateThreshold)),
+			)
+		}
+	}
+
+	// 緊急資金チェック
+	if plan.EmergencyFund() != nil {
+		monthlyExpenses, err := plan.Profile().MonthlyExpenses().Total()
+		if err == nil {
+			emergencyFundRatio := plan.EmergencyFund().CurrentFund().Amount() / monthlyExpenses.Amount()
+
+			minimumMonths := defaults.EmergencyFundMonths
+			if emergencyFundRatio < minimumMonths {
+				// 重要度は最低限の月数に対する不足率（%）
+				severity := (1 - emergencyFundRatio/minimumMonths) * 100
+				recommendations = append(recommendations,
+					entities.NewRecommendation(entities.RecommendationTypeEmergencyFundShortfall, entities.RecommendationKindWarning,
+						fmt.Sprintf("緊急資金が%sヶ月分の生活費を下回っています", formatThreshold(minimumMonths)), severity, formatThreshold(minimumMonths)),
+					entities.NewRecommendation(entities.RecommendationTypeEmergencyFundShortfall, entities.RecommendationKindRecommendation,
+						fmt.Sprintf("緊急資金として%s-%sヶ月分の生活費を確保してください", formatThreshold(minimumMonths), formatThreshold(minimumMonths*2)), severity, formatThreshold(minimumMonths)),
+				)
+			}
+		}
+	}
+
+	// 投資利回りチェック
+	investmentReturn := plan.Profile().InvestmentReturn().AsPercentage()
+	if investmentReturn < lowInvestmentReturnThreshold {
+		recommendations = append(recommendations,
+			entities.NewRecommendation(entities.RecommendationTypeLowInvestmentReturn, entities.RecommendationKindRecommendation,
+				"投資利回りが低めです。ポートフォリオの見直しを検討してください", 0, formatThreshold(lowInvestmentReturnThreshold)),
+		)
+	}
+
+	// 貯蓄率目標チェック（目標値が変わった場合は別の推奨事項として扱う）
+	if savingsRateTarget != nil {
+		for _, message := range savingsRateTargetWarnings(savingsRateTarget) {
+			recommendations = append(recommendations,
+				entities.NewRecommendation(entities.RecommendationTypeSavingsRateBelowTarget, entities.RecommendationKindWarning,
+					message, savingsRateTarget.TargetPercent-savingsRateTarget.CurrentRate, formatThreshold(savingsRateTarget.TargetPercent)),
+			)
+		}
+	}
+
+	// 財務データの鮮度チェック（古いとみなす月数を超えて見直されていない場合）
+	if staleness := plan.Staleness(now); staleness.IsStale() {
+		recommendations = append(recommendations,
+			entities.NewRecommendation(entities.RecommendationTypeStaleFinancialData, entities.RecommendationKindWarning,
+				staleness.Message, float64(staleness.MonthsSinceReview-aggregates.StalenessStaleMonths), formatThreshold(aggregates.StalenessStaleMonths)),
+		)
+	}
+
+	return recommendations
+}
+
+// splitRecommendationMessages は推奨事項を区分ごとのメッセージに分ける
+func splitRecommendationMessages(recommendations []entities.Recommendation) ([]string, []string) {
+	var recommendationMessages []string
+	var warningMessages []string
+	for _, recommendation := range recommendations {
+		if recommendation.Kind == entities.RecommendationKindWarning {
+			warningMessages = append(warningMessages, recommendation.Message)
+		} else {
+			recommendationMessages = append(recommendationMessages, recommendation.Message)
+		}
+	}
+	return recommendationMessages, warningMessages
+}
+
+// filterDismissedRecommendations はユーザーが却下した推奨事項を取り除く
+// dismissalRepo が nil の場合はそのまま返す
+func filterDismissedRecommendations(
+	ctx context.Context,
+	dismissalRepo repositories.RecommendationDismissalRepository,
+	userID entities.UserID,
+	recommendations []entities.Recommendation,
+	now time.Time,
+) ([]entities.Recommendation, error) {
+	if dismissalRepo == nil {
+		return recommendations, nil
+	}
+
+	dismissals, err := dismissalRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("推奨事項の却下の取得に失敗しました: %w", err)
+	}
+
+	return entities.FilterDismissedRecommendations(recommendations, dismissals, now), nil
+}
+
+// evaluateActiveSavingsRateTarget は有効な貯蓄率目標があれば進捗を評価する
+// repo が nil の場合や有効な目標がない場合は nil を返す
+func evaluateActiveSavingsRateTarget(
+	ctx context.Context,
+	repo repositories.SavingsRateTargetRepository,
+	plan *aggregates.FinancialPlan,
+	userID entities.UserID,
+) (*entities.SavingsRateTargetProgress, error) {
+	if repo == nil {
+		return nil, nil
+	}
+
+	target, err := repo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, nil
+	}
+
+	return evaluateSavingsRateTarget(ctx, repo, target, plan, time.Now())
+}
+
+// formatThreshold は推奨事項IDのパラメータ用に閾値を文字列化する
+func formatThreshold(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
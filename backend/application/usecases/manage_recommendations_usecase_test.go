@@ -0,0 +1,216 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newEmergencyFundShortfallWarningID は緊急資金不足の警告IDを返すヘルパー
+func newEmergencyFundShortfallWarningID() entities.RecommendationID {
+	return entities.NewRecommendationID(entities.RecommendationTypeEmergencyFundShortfall, entities.RecommendationKindWarning, "3")
+}
+
+func TestManageRecommendationsUseCase_GetRecommendations(t *testing.T) {
+	ctx := context.Background()
+	warningID := newEmergencyFundShortfallWarningID()
+	expiredSnooze := time.Now().AddDate(0, 0, -1)
+
+	// newTestFinancialPlanWithEmergencyFundData の緊急資金は 300,000円 / 180,000円 ≒ 1.67ヶ月分
+	// 3ヶ月分に対する不足率（重要度）は約44ポイント
+	tests := []struct {
+		name             string
+		dismissals       []*entities.RecommendationDismissal
+		expectIncluded   bool
+		expectedReRaised bool
+	}{
+		{
+			name:           "正常系: 却下していない警告は表示される",
+			dismissals:     []*entities.RecommendationDismissal{},
+			expectIncluded: true,
+		},
+		{
+			name: "正常系: 却下した警告は表示されない",
+			dismissals: []*entities.RecommendationDismissal{
+				entities.ReconstructRecommendationDismissal("user-001", warningID, 40, time.Now().AddDate(0, 0, -1), nil),
+			},
+			expectIncluded: false,
+		},
+		{
+			name: "正常系: スヌーズ期限を過ぎた警告は再表示される",
+			dismissals: []*entities.RecommendationDismissal{
+				entities.ReconstructRecommendationDismissal("user-001", warningID, 40, time.Now().AddDate(0, 0, -7), &expiredSnooze),
+			},
+			expectIncluded: true,
+		},
+		{
+			name: "正常系: 却下後に不足率が10ポイントを超えて悪化した警告は再表示フラグ付きで表示される",
+			dismissals: []*entities.RecommendationDismissal{
+				entities.ReconstructRecommendationDismissal("user-001", warningID, 20, time.Now().AddDate(0, 0, -1), nil),
+			},
+			expectIncluded:   true,
+			expectedReRaised: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlanRepo := new(MockFinancialPlanRepository)
+			mockDismissalRepo := new(MockRecommendationDismissalRepository)
+			mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlanWithEmergencyFundData("user-001"), nil)
+			mockDismissalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(tt.dismissals, nil)
+
+			uc := NewManageRecommendationsUseCase(mockPlanRepo, nil, mockDismissalRepo, services.NewFinancialCalculationService())
+			output, err := uc.GetRecommendations(ctx, GetRecommendationsInput{UserID: "user-001"})
+
+			require.NoError(t, err)
+			var found *entities.Recommendation
+			for i := range output.Recommendations {
+				if output.Recommendations[i].ID == warningID {
+					found = &output.Recommendations[i]
+				}
+			}
+			if !tt.expectIncluded {
+				assert.Nil(t, found)
+				return
+			}
+			require.NotNil(t, found)
+			assert.Equal(t, tt.expectedReRaised, found.ReRaised)
+		})
+	}
+}
+
+func TestManageRecommendationsUseCase_DismissRecommendation(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 却下時点の重要度を記録して保存する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockDismissalRepo := new(MockRecommendationDismissalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlanWithEmergencyFundData("user-001"), nil)
+
+		var saved *entities.RecommendationDismissal
+		mockDismissalRepo.On("Save", mock_anything(), mock_anything()).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*entities.RecommendationDismissal)
+		}).Return(nil)
+
+		snoozeUntil := time.Now().AddDate(0, 1, 0)
+		uc := NewManageRecommendationsUseCase(mockPlanRepo, nil, mockDismissalRepo, services.NewFinancialCalculationService())
+		output, err := uc.DismissRecommendation(ctx, DismissRecommendationInput{
+			UserID:           "user-001",
+			RecommendationID: newEmergencyFundShortfallWarningID(),
+			SnoozeUntil:      &snoozeUntil,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, newEmergencyFundShortfallWarningID(), output.RecommendationID)
+		require.NotNil(t, saved)
+		assert.InDelta(t, (1-(300000.0/180000.0)/3)*100, saved.SeverityAtDismissal(), 0.01)
+		assert.Equal(t, &snoozeUntil, saved.SnoozeUntil())
+		mockDismissalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 現在生成されない推奨事項IDの場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockDismissalRepo := new(MockRecommendationDismissalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		// newTestFinancialPlan の貯蓄率は55%のため、貯蓄率の低さに関する警告は生成されない
+		uc := NewManageRecommendationsUseCase(mockPlanRepo, nil, mockDismissalRepo, services.NewFinancialCalculationService())
+		_, err := uc.DismissRecommendation(ctx, DismissRecommendationInput{
+			UserID:           "user-001",
+			RecommendationID: entities.NewRecommendationID(entities.RecommendationTypeLowSavingsRate, entities.RecommendationKindWarning, "10"),
+		})
+
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, ErrRecommendationNotFound))
+		mockDismissalRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
+func TestPlanRecommendations_StaleFinancialData(t *testing.T) {
+	plan := newTestFinancialPlan("user-001")
+	lastReviewedAt := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+	plan.RestoreLastReviewedAt(lastReviewedAt)
+	staleWarningID := entities.NewRecommendationID(entities.RecommendationTypeStaleFinancialData, entities.RecommendationKindWarning, "12")
+
+	findStaleWarning := func(recommendations []entities.Recommendation) *entities.Recommendation {
+		for i := range recommendations {
+			if recommendations[i].ID == staleWarningID {
+				return &recommendations[i]
+			}
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		now         time.Time
+		expectAlert bool
+	}{
+		{name: "正常系: 最終確認から3ヶ月未満（fresh）は警告しない", now: lastReviewedAt.AddDate(0, 2, 0), expectAlert: false},
+		{name: "正常系: 最終確認から3〜12ヶ月（aging）は警告しない", now: lastReviewedAt.AddDate(0, 12, 0), expectAlert: false},
+		{name: "正常系: 最終確認から12ヶ月超（stale）は警告する", now: lastReviewedAt.AddDate(0, 14, 0), expectAlert: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warning := findStaleWarning(planRecommendations(plan, nil, services.DefaultCalculationDefaults(), tt.now))
+
+			if !tt.expectAlert {
+				assert.Nil(t, warning)
+				return
+			}
+			require.NotNil(t, warning)
+			assert.Equal(t, "財務データが14ヶ月更新されていません。前提を見直してください", warning.Message)
+			assert.Equal(t, 2.0, warning.Severity)
+		})
+	}
+}
+
+func TestPlanRecommendations_EmergencyFundMonths(t *testing.T) {
+	// newTestFinancialPlanWithEmergencyFundData の緊急資金は 300,000円 / 180,000円 ≒ 1.67ヶ月分
+	plan := newTestFinancialPlanWithEmergencyFundData("user-001")
+	now := time.Now()
+
+	findWarning := func(recommendations []entities.Recommendation) *entities.Recommendation {
+		for i := range recommendations {
+			if recommendations[i].Type == entities.RecommendationTypeEmergencyFundShortfall && recommendations[i].Kind == entities.RecommendationKindWarning {
+				return &recommendations[i]
+			}
+		}
+		return nil
+	}
+
+	t.Run("正常系: 既定では3ヶ月分を下回ると警告する", func(t *testing.T) {
+		warning := findWarning(planRecommendations(plan, nil, services.DefaultCalculationDefaults(), now))
+
+		require.NotNil(t, warning)
+		assert.Equal(t, newEmergencyFundShortfallWarningID(), warning.ID)
+		assert.Equal(t, "緊急資金が3ヶ月分の生活費を下回っています", warning.Message)
+	})
+
+	t.Run("正常系: 設定した推奨月数を閾値にする", func(t *testing.T) {
+		defaults := services.DefaultCalculationDefaults()
+		defaults.EmergencyFundMonths = 6
+
+		warning := findWarning(planRecommendations(plan, nil, defaults, now))
+
+		require.NotNil(t, warning)
+		assert.Equal(t, entities.NewRecommendationID(entities.RecommendationTypeEmergencyFundShortfall, entities.RecommendationKindWarning, "6"), warning.ID)
+		assert.Equal(t, "緊急資金が6ヶ月分の生活費を下回っています", warning.Message)
+	})
+
+	t.Run("正常系: 推奨月数を満たしていれば警告しない", func(t *testing.T) {
+		defaults := services.DefaultCalculationDefaults()
+		defaults.EmergencyFundMonths = 1.5
+
+		assert.Nil(t, findWarning(planRecommendations(plan, nil, defaults, now)))
+	})
+}
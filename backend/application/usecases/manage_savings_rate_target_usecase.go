@@ -0,0 +1,248 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// ManageSavingsRateTargetUseCase は貯蓄率目標管理のユースケース
+type ManageSavingsRateTargetUseCase interface {
+	// SetSavingsRateTarget は貯蓄率目標を設定する（既存の有効な目標は無効化される）
+	SetSavingsRateTarget(ctx context.Context, input SetSavingsRateTargetInput) (*SavingsRateTargetOutput, error)
+
+	// GetSavingsRateTarget は有効な貯蓄率目標と進捗を取得する
+	GetSavingsRateTarget(ctx context.Context, input GetSavingsRateTargetInput) (*SavingsRateTargetOutput, error)
+
+	// RecordMonthlySavingsActual は月次の収入・支出実績を記録する
+	RecordMonthlySavingsActual(ctx context.Context, input RecordMonthlySavingsActualInput) (*SavingsRateTargetOutput, error)
+}
+
+// SetSavingsRateTargetInput は貯蓄率目標設定の入力
+type SetSavingsRateTargetInput struct {
+	UserID        entities.UserID `json:"user_id"`
+	TargetPercent float64         `json:"target_percent"`
+	PeriodStart   time.Time       `json:"period_start"`
+	PeriodEnd     time.Time       `json:"period_end"`
+}
+
+// GetSavingsRateTargetInput は貯蓄率目標取得の入力
+type GetSavingsRateTargetInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// RecordMonthlySavingsActualInput は月次実績記録の入力
+type RecordMonthlySavingsActualInput struct {
+	UserID   entities.UserID `json:"user_id"`
+	Month    time.Time       `json:"month"`
+	Income   float64         `json:"income"`
+	Expenses float64         `json:"expenses"`
+}
+
+// SavingsRateTargetOutput は貯蓄率目標と進捗の出力
+type SavingsRateTargetOutput struct {
+	Target   *entities.SavingsRateTarget         `json:"target"`
+	Progress *entities.SavingsRateTargetProgress `json:"progress"`
+	Warnings []string                            `json:"warnings"`
+}
+
+// manageSavingsRateTargetUseCaseImpl はManageSavingsRateTargetUseCaseの実装
+type manageSavingsRateTargetUseCaseImpl struct {
+	savingsRateTargetRepo repositories.SavingsRateTargetRepository
+	financialPlanRepo     repositories.FinancialPlanRepository
+	unitOfWork            repositories.UnitOfWork
+	logger                *log.UseCaseLogger
+}
+
+// NewManageSavingsRateTargetUseCase は新しいManageSavingsRateTargetUseCaseを作成する
+func NewManageSavingsRateTargetUseCase(
+	savingsRateTargetRepo repositories.SavingsRateTargetRepository,
+	financialPlanRepo repositories.FinancialPlanRepository,
+	unitOfWork repositories.UnitOfWork,
+) ManageSavingsRateTargetUseCase {
+	return &manageSavingsRateTargetUseCaseImpl{
+		savingsRateTargetRepo: savingsRateTargetRepo,
+		financialPlanRepo:     financialPlanRepo,
+		unitOfWork:            unitOfWork,
+		logger:                log.NewUseCaseLogger("ManageSavingsRateTargetUseCase"),
+	}
+}
+
+// SetSavingsRateTarget は貯蓄率目標を設定する（既存の有効な目標は無効化される）
+func (uc *manageSavingsRateTargetUseCaseImpl) SetSavingsRateTarget(
+	ctx context.Context,
+	input SetSavingsRateTargetInput,
+) (*SavingsRateTargetOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "SetSavingsRateTarget",
+		log.UserID(string(input.UserID)),
+		slog.Float64("target_percent", input.TargetPercent),
+	)
+
+	target, err := entities.NewSavingsRateTarget(input.UserID, input.TargetPercent, input.PeriodStart, input.PeriodEnd)
+	if err != nil {
+		uc.logger.OperationError(ctx, "SetSavingsRateTarget", err,
+			slog.String("step", "create_target"),
+		)
+		return nil, fmt.Errorf("貯蓄率目標の作成に失敗しました: %w", err)
+	}
+
+	// 有効な目標は1件のみのため、既存の目標の無効化と新しい目標の保存を1つのトランザクションで行う
+	// （保存に失敗した場合に有効な目標がなくならないようにする）
+	err = uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		existing, err := repos.SavingsRateTargets.FindActiveByUserID(ctx, input.UserID)
+		if err != nil {
+			uc.logger.OperationError(ctx, "SetSavingsRateTarget", err,
+				slog.String("step", "find_active_target"),
+			)
+			return fmt.Errorf("既存の貯蓄率目標の取得に失敗しました: %w", err)
+		}
+		if existing != nil {
+			existing.Deactivate()
+			if err := repos.SavingsRateTargets.Update(ctx, existing); err != nil {
+				uc.logger.OperationError(ctx, "SetSavingsRateTarget", err,
+					slog.String("step", "deactivate_target"),
+				)
+				return fmt.Errorf("既存の貯蓄率目標の無効化に失敗しました: %w", err)
+			}
+		}
+
+		if err := repos.SavingsRateTargets.Save(ctx, target); err != nil {
+			uc.logger.OperationError(ctx, "SetSavingsRateTarget", err,
+				slog.String("step", "save_target"),
+			)
+			return fmt.Errorf("貯蓄率目標の保存に失敗しました: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := uc.buildOutput(ctx, target)
+	if err != nil {
+		uc.logger.OperationError(ctx, "SetSavingsRateTarget", err,
+			slog.String("step", "evaluate_progress"),
+		)
+		return nil, err
+	}
+
+	uc.logger.EndOperation(ctx, "SetSavingsRateTarget",
+		slog.String("target_id", string(target.ID())),
+	)
+
+	return output, nil
+}
+
+// GetSavingsRateTarget は有効な貯蓄率目標と進捗を取得する
+func (uc *manageSavingsRateTargetUseCaseImpl) GetSavingsRateTarget(
+	ctx context.Context,
+	input GetSavingsRateTargetInput,
+) (*SavingsRateTargetOutput, error) {
+	target, err := uc.savingsRateTargetRepo.FindActiveByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄率目標の取得に失敗しました: %w", err)
+	}
+	if target == nil {
+		return nil, errors.New("貯蓄率目標が見つかりません")
+	}
+
+	return uc.buildOutput(ctx, target)
+}
+
+// RecordMonthlySavingsActual は月次の収入・支出実績を記録する
+func (uc *manageSavingsRateTargetUseCaseImpl) RecordMonthlySavingsActual(
+	ctx context.Context,
+	input RecordMonthlySavingsActualInput,
+) (*SavingsRateTargetOutput, error) {
+	if input.Income < 0 || input.Expenses < 0 {
+		return nil, errors.New("収入と支出は0以上である必要があります")
+	}
+
+	actual := entities.MonthlySavingsActual{
+		Month:    input.Month,
+		Income:   input.Income,
+		Expenses: input.Expenses,
+	}
+	if err := uc.savingsRateTargetRepo.SaveMonthlyActual(ctx, input.UserID, actual); err != nil {
+		return nil, fmt.Errorf("月次実績の保存に失敗しました: %w", err)
+	}
+
+	target, err := uc.savingsRateTargetRepo.FindActiveByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄率目標の取得に失敗しました: %w", err)
+	}
+	if target == nil {
+		// 目標未設定でも実績の記録は成功とする
+		return &SavingsRateTargetOutput{Warnings: []string{}}, nil
+	}
+
+	return uc.buildOutput(ctx, target)
+}
+
+// buildOutput は目標の進捗を評価して出力を組み立てる
+func (uc *manageSavingsRateTargetUseCaseImpl) buildOutput(
+	ctx context.Context,
+	target *entities.SavingsRateTarget,
+) (*SavingsRateTargetOutput, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, target.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+
+	progress, err := evaluateSavingsRateTarget(ctx, uc.savingsRateTargetRepo, target, plan, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SavingsRateTargetOutput{
+		Target:   target,
+		Progress: progress,
+		Warnings: savingsRateTargetWarnings(progress),
+	}, nil
+}
+
+// evaluateSavingsRateTarget は月次実績と財務プロファイルから貯蓄率目標の進捗を評価する
+// 当月の実績が記録されていればその貯蓄率を、なければプロファイルの貯蓄率を現在の貯蓄率とする
+func evaluateSavingsRateTarget(
+	ctx context.Context,
+	repo repositories.SavingsRateTargetRepository,
+	target *entities.SavingsRateTarget,
+	plan *aggregates.FinancialPlan,
+	now time.Time,
+) (*entities.SavingsRateTargetProgress, error) {
+	actuals, err := repo.FindMonthlyActuals(ctx, target.UserID(), target.PeriodStart(), target.PeriodEnd())
+	if err != nil {
+		return nil, fmt.Errorf("月次実績の取得に失敗しました: %w", err)
+	}
+
+	currentRate, err := plan.Profile().CalculateSavingsRate()
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄率の計算に失敗しました: %w", err)
+	}
+	for _, actual := range actuals {
+		if actual.Month.Year() == now.Year() && actual.Month.Month() == now.Month() {
+			currentRate = actual.SavingsRate()
+		}
+	}
+
+	return target.EvaluateProgress(actuals, currentRate), nil
+}
+
+// savingsRateTargetWarnings は貯蓄率目標の進捗から警告を生成する
+func savingsRateTargetWarnings(progress *entities.SavingsRateTargetProgress) []string {
+	warnings := []string{}
+	if progress.IsBelowThreshold {
+		warnings = append(warnings, fmt.Sprintf(
+			"現在の貯蓄率（%.1f%%）が目標（%.1f%%）を%.0fポイント以上下回っています",
+			progress.CurrentRate, progress.TargetPercent, entities.SavingsRateWarningThreshold,
+		))
+	}
+	return warnings
+}
@@ -0,0 +1,254 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSavingsRateTarget はテスト用の貯蓄率目標（2025年通年）を作成するヘルパー
+func newTestSavingsRateTarget(t *testing.T, userID entities.UserID, targetPercent float64) *entities.SavingsRateTarget {
+	t.Helper()
+	target, err := entities.NewSavingsRateTarget(
+		userID,
+		targetPercent,
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	)
+	require.NoError(t, err)
+	return target
+}
+
+// savingsActualsFixture は4ヶ月分の月次実績（目標25%に対して2ヶ月未達）
+func savingsActualsFixture() []entities.MonthlySavingsActual {
+	return []entities.MonthlySavingsActual{
+		{Month: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Income: 400000, Expenses: 280000}, // 30%
+		{Month: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), Income: 400000, Expenses: 320000}, // 20%
+		{Month: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), Income: 400000, Expenses: 300000}, // 25%
+		{Month: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), Income: 400000, Expenses: 340000}, // 15%
+	}
+}
+
+// newSavingsRateTargetUnitOfWork は貯蓄率目標のモックリポジトリでトランザクションを実行するUnitOfWorkを作成する
+func newSavingsRateTargetUnitOfWork(targetRepo repositories.SavingsRateTargetRepository) *stubUnitOfWork {
+	return &stubUnitOfWork{repos: repositories.TxRepositories{SavingsRateTargets: targetRepo}}
+}
+
+// ===========================
+// SetSavingsRateTarget Tests
+// ===========================
+
+func TestManageSavingsRateTargetUseCase_SetSavingsRateTarget(t *testing.T) {
+	ctx := context.Background()
+	input := SetSavingsRateTargetInput{
+		UserID:        "user-001",
+		TargetPercent: 25,
+		PeriodStart:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:     time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("正常系: 既存の有効な目標を無効化して新しい目標を保存する", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		existing := newTestSavingsRateTarget(t, "user-001", 10)
+
+		mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(existing, nil)
+		mockTargetRepo.On("Update", mock_anything(), existing).Return(nil)
+		mockTargetRepo.On("Save", mock_anything(), mock_anything()).Return(nil)
+		mockTargetRepo.On("FindMonthlyActuals", mock_anything(), entities.UserID("user-001"), mock_anything(), mock_anything()).Return([]entities.MonthlySavingsActual{}, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		output, err := uc.SetSavingsRateTarget(ctx, input)
+
+		require.NoError(t, err)
+		assert.False(t, existing.IsActive())
+		assert.True(t, output.Target.IsActive())
+		assert.Equal(t, 25.0, output.Target.TargetPercent())
+		mockTargetRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 新しい目標の保存に失敗した場合は無効化もロールバックする", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		existing := newTestSavingsRateTarget(t, "user-001", 10)
+
+		mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(existing, nil)
+		mockTargetRepo.On("Update", mock_anything(), existing).Return(nil)
+		mockTargetRepo.On("Save", mock_anything(), mock_anything()).Return(errors.New("db error"))
+
+		uow := newSavingsRateTargetUnitOfWork(mockTargetRepo)
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, uow)
+		_, err := uc.SetSavingsRateTarget(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "貯蓄率目標の保存に失敗しました")
+		assert.True(t, uow.rolledBack)
+		assert.False(t, uow.committed)
+		mockTargetRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 目標が範囲外の場合はエラー", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		invalid := input
+		invalid.TargetPercent = 81
+		_, err := uc.SetSavingsRateTarget(ctx, invalid)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "貯蓄率目標の作成に失敗しました")
+		mockTargetRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+
+	t.Run("異常系: 期間が24ヶ月を超える場合はエラー", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		invalid := input
+		invalid.PeriodEnd = time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+		_, err := uc.SetSavingsRateTarget(ctx, invalid)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "24ヶ月")
+		mockTargetRepo.AssertNotCalled(t, "Save", mock_anything(), mock_anything())
+	})
+}
+
+// ===========================
+// GetSavingsRateTarget Tests
+// ===========================
+
+func TestManageSavingsRateTargetUseCase_GetSavingsRateTarget(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 4ヶ月中2ヶ月が目標未達の進捗を返す", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		target := newTestSavingsRateTarget(t, "user-001", 25)
+
+		mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(target, nil)
+		mockTargetRepo.On("FindMonthlyActuals", mock_anything(), entities.UserID("user-001"), target.PeriodStart(), target.PeriodEnd()).Return(savingsActualsFixture(), nil)
+		// プロファイルの貯蓄率: (400,000 - 180,000) / 400,000 = 55%
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		output, err := uc.GetSavingsRateTarget(ctx, GetSavingsRateTargetInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, output.Progress.MonthsOnTarget)
+		assert.Equal(t, 2, output.Progress.MonthsOffTarget)
+		assert.Equal(t, 12, output.Progress.TotalMonths)
+		assert.InDelta(t, 55.0, output.Progress.CurrentRate, 0.001)
+		// (30 + 20 + 25 + 15 + 55 × 8) / 12
+		assert.InDelta(t, 530.0/12, output.Progress.ProjectedAverageRate, 0.001)
+		assert.Empty(t, output.Warnings)
+		mockTargetRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 現在の貯蓄率が目標を5ポイント超下回ると警告", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		target := newTestSavingsRateTarget(t, "user-001", 61)
+
+		mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(target, nil)
+		mockTargetRepo.On("FindMonthlyActuals", mock_anything(), entities.UserID("user-001"), mock_anything(), mock_anything()).Return(savingsActualsFixture(), nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		output, err := uc.GetSavingsRateTarget(ctx, GetSavingsRateTargetInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.True(t, output.Progress.IsBelowThreshold)
+		require.Len(t, output.Warnings, 1)
+		assert.Contains(t, output.Warnings[0], "下回っています")
+	})
+
+	t.Run("異常系: 有効な目標がない場合はエラー", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, nil)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		_, err := uc.GetSavingsRateTarget(ctx, GetSavingsRateTargetInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "貯蓄率目標が見つかりません")
+	})
+}
+
+// ===========================
+// RecordMonthlySavingsActual Tests
+// ===========================
+
+func TestManageSavingsRateTargetUseCase_RecordMonthlySavingsActual(t *testing.T) {
+	ctx := context.Background()
+	input := RecordMonthlySavingsActualInput{
+		UserID:   "user-001",
+		Month:    time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		Income:   400000,
+		Expenses: 300000,
+	}
+
+	t.Run("正常系: 目標未設定でも実績を記録できる", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockTargetRepo.On("SaveMonthlyActual", mock_anything(), entities.UserID("user-001"), mock_anything()).Return(nil)
+		mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, nil)
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		output, err := uc.RecordMonthlySavingsActual(ctx, input)
+
+		require.NoError(t, err)
+		assert.Nil(t, output.Target)
+		mockTargetRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 保存に失敗した場合はエラー", func(t *testing.T) {
+		mockTargetRepo := new(MockSavingsRateTargetRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockTargetRepo.On("SaveMonthlyActual", mock_anything(), entities.UserID("user-001"), mock_anything()).Return(errors.New("db error"))
+
+		uc := NewManageSavingsRateTargetUseCase(mockTargetRepo, mockPlanRepo, newSavingsRateTargetUnitOfWork(mockTargetRepo))
+		_, err := uc.RecordMonthlySavingsActual(ctx, input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "月次実績の保存に失敗しました")
+	})
+}
+
+// ===========================
+// FinancialSummaryReport Integration Tests
+// ===========================
+
+func TestGenerateReportsUseCase_FinancialSummaryWithSavingsRateTarget(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	mockPlanRepo := new(MockFinancialPlanRepository)
+	mockGoalRepo := new(MockGoalRepository)
+	mockTargetRepo := new(MockSavingsRateTargetRepository)
+	target := newTestSavingsRateTarget(t, "user-001", 61)
+
+	mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestFinancialPlan("user-001"), nil)
+	mockTargetRepo.On("FindActiveByUserID", mock_anything(), entities.UserID("user-001")).Return(target, nil)
+	mockTargetRepo.On("FindMonthlyActuals", mock_anything(), entities.UserID("user-001"), mock_anything(), mock_anything()).Return(savingsActualsFixture(), nil)
+
+	uc := NewGenerateReportsUseCaseWithPDF(mockPlanRepo, mockGoalRepo, calcService, recService, nil, nil, mockTargetRepo, nil, nil, nil, nil, nil)
+	output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: "user-001"})
+
+	require.NoError(t, err)
+	require.NotNil(t, output.Report.SavingsRateTarget)
+	assert.Equal(t, 4, output.Report.SavingsRateTarget.MonthsOffTarget)
+	assert.Contains(t, output.Report.Warnings[len(output.Report.Warnings)-1], "下回っています")
+}
@@ -2,9 +2,13 @@ package usecases
 
 import (
 	"context"
+	"time"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -47,6 +51,11 @@ func (m *MockFinancialPlanRepository) Update(ctx context.Context, plan *aggregat
 	return args.Error(0)
 }
 
+func (m *MockFinancialPlanRepository) MarkCalculated(ctx context.Context, userID entities.UserID, calculatedAt time.Time) error {
+	args := m.Called(ctx, userID, calculatedAt)
+	return args.Error(0)
+}
+
 func (m *MockFinancialPlanRepository) Delete(ctx context.Context, id aggregates.FinancialPlanID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -62,6 +71,14 @@ func (m *MockFinancialPlanRepository) ExistsByUserID(ctx context.Context, userID
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockFinancialPlanRepository) FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	args := m.Called(ctx, afterUserID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.UserID), args.Error(1)
+}
+
 // -------------------------------------------------------------------
 // MockGoalRepository
 // -------------------------------------------------------------------
@@ -122,11 +139,27 @@ func (m *MockGoalRepository) Exists(ctx context.Context, id entities.GoalID) (bo
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockGoalRepository) FindSimilarGoal(ctx context.Context, userID entities.UserID, goalType entities.GoalType, title string, targetAmount valueobjects.Money) (*entities.Goal, error) {
+	args := m.Called(ctx, userID, goalType, title, targetAmount)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.Goal), args.Error(1)
+}
+
 func (m *MockGoalRepository) CountActiveGoalsByType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) (int, error) {
 	args := m.Called(ctx, userID, goalType)
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockGoalRepository) FindOverdueActiveGoals(ctx context.Context, asOf time.Time) ([]*entities.Goal, error) {
+	args := m.Called(ctx, asOf)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.Goal), args.Error(1)
+}
+
 // -------------------------------------------------------------------
 // MockUserRepository
 // -------------------------------------------------------------------
@@ -184,6 +217,14 @@ func (m *MockUserRepository) FindByProviderUserID(ctx context.Context, provider
 	return args.Get(0).(*entities.User), args.Error(1)
 }
 
+func (m *MockUserRepository) FindDigestSubscribers(ctx context.Context) ([]*entities.User, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.User), args.Error(1)
+}
+
 // -------------------------------------------------------------------
 // MockRefreshTokenRepository
 // -------------------------------------------------------------------
@@ -223,6 +264,11 @@ func (m *MockRefreshTokenRepository) Delete(ctx context.Context, id entities.Ref
 	return args.Error(0)
 }
 
+func (m *MockRefreshTokenRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockRefreshTokenRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
 	args := m.Called(ctx, userID)
 	return args.Error(0)
@@ -324,11 +370,182 @@ func (m *MockPasswordResetTokenRepository) DeleteExpired(ctx context.Context) er
 	return args.Error(0)
 }
 
+func (m *MockPasswordResetTokenRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
 func (m *MockPasswordResetTokenRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
 	args := m.Called(ctx, userID)
 	return args.Error(0)
 }
 
+// -------------------------------------------------------------------
+// MockSavingsRateTargetRepository
+// -------------------------------------------------------------------
+
+type MockSavingsRateTargetRepository struct {
+	mock.Mock
+}
+
+func (m *MockSavingsRateTargetRepository) Save(ctx context.Context, target *entities.SavingsRateTarget) error {
+	args := m.Called(ctx, target)
+	return args.Error(0)
+}
+
+func (m *MockSavingsRateTargetRepository) FindActiveByUserID(ctx context.Context, userID entities.UserID) (*entities.SavingsRateTarget, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.SavingsRateTarget), args.Error(1)
+}
+
+func (m *MockSavingsRateTargetRepository) Update(ctx context.Context, target *entities.SavingsRateTarget) error {
+	args := m.Called(ctx, target)
+	return args.Error(0)
+}
+
+func (m *MockSavingsRateTargetRepository) SaveMonthlyActual(ctx context.Context, userID entities.UserID, actual entities.MonthlySavingsActual) error {
+	args := m.Called(ctx, userID, actual)
+	return args.Error(0)
+}
+
+func (m *MockSavingsRateTargetRepository) FindMonthlyActuals(ctx context.Context, userID entities.UserID, from, to time.Time) ([]entities.MonthlySavingsActual, error) {
+	args := m.Called(ctx, userID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.MonthlySavingsActual), args.Error(1)
+}
+
+func (m *MockSavingsRateTargetRepository) CountMonthlyActualsByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSavingsRateTargetRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockNotificationPreferenceRepository
+// -------------------------------------------------------------------
+
+type MockNotificationPreferenceRepository struct {
+	mock.Mock
+}
+
+func (m *MockNotificationPreferenceRepository) Save(ctx context.Context, preference *entities.NotificationPreference) error {
+	args := m.Called(ctx, preference)
+	return args.Error(0)
+}
+
+func (m *MockNotificationPreferenceRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.NotificationPreference, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationPreferenceRepository) FindEnabledByUserIDAndEventType(ctx context.Context, userID entities.UserID, eventType entities.NotificationEventType) ([]*entities.NotificationPreference, error) {
+	args := m.Called(ctx, userID, eventType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.NotificationPreference), args.Error(1)
+}
+
+func (m *MockNotificationPreferenceRepository) Delete(ctx context.Context, id entities.NotificationPreferenceID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationPreferenceRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationPreferenceRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockAPIKeyRepository
+// -------------------------------------------------------------------
+
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAPIKeyRepository) Save(ctx context.Context, apiKey *entities.APIKey) error {
+	args := m.Called(ctx, apiKey)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) FindByID(ctx context.Context, id entities.APIKeyID) (*entities.APIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.APIKey, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.APIKey), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) Update(ctx context.Context, apiKey *entities.APIKey) error {
+	args := m.Called(ctx, apiKey)
+	return args.Error(0)
+}
+
+func (m *MockAPIKeyRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockAPIKeyRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockNotificationDispatcher
+// -------------------------------------------------------------------
+
+type MockNotificationDispatcher struct {
+	mock.Mock
+}
+
+func (m *MockNotificationDispatcher) Dispatch(ctx context.Context, n ports.Notification) (*ports.DispatchResult, error) {
+	args := m.Called(ctx, n)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ports.DispatchResult), args.Error(1)
+}
+
+func (m *MockNotificationDispatcher) RetryFailed(ctx context.Context) int {
+	args := m.Called(ctx)
+	return args.Int(0)
+}
+
 // -------------------------------------------------------------------
 // MockEmailService
 // -------------------------------------------------------------------
@@ -341,3 +558,302 @@ func (m *MockEmailService) SendPasswordResetEmail(ctx context.Context, toEmail,
 	args := m.Called(ctx, toEmail, resetURL)
 	return args.Error(0)
 }
+
+// -------------------------------------------------------------------
+// stubUnitOfWork
+// -------------------------------------------------------------------
+
+// stubUnitOfWork はモックリポジトリでfnを実行し、コミット・ロールバックの結果を記録するUnitOfWork
+type stubUnitOfWork struct {
+	repos      repositories.TxRepositories
+	committed  bool
+	rolledBack bool
+}
+
+func newStubUnitOfWork(planRepo repositories.FinancialPlanRepository, goalRepo repositories.GoalRepository) *stubUnitOfWork {
+	return &stubUnitOfWork{
+		repos: repositories.TxRepositories{
+			FinancialPlans: planRepo,
+			Goals:          goalRepo,
+		},
+	}
+}
+
+func (u *stubUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context, repos repositories.TxRepositories) error) error {
+	if err := fn(ctx, u.repos); err != nil {
+		u.rolledBack = true
+		return err
+	}
+	u.committed = true
+	return nil
+}
+
+// -------------------------------------------------------------------
+// MockRecommendationDismissalRepository
+// -------------------------------------------------------------------
+
+type MockRecommendationDismissalRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecommendationDismissalRepository) Save(ctx context.Context, dismissal *entities.RecommendationDismissal) error {
+	args := m.Called(ctx, dismissal)
+	return args.Error(0)
+}
+
+func (m *MockRecommendationDismissalRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.RecommendationDismissal, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.RecommendationDismissal), args.Error(1)
+}
+
+func (m *MockRecommendationDismissalRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRecommendationDismissalRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockHealthScoreSnapshotRepository
+// -------------------------------------------------------------------
+
+type MockHealthScoreSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *MockHealthScoreSnapshotRepository) Save(ctx context.Context, snapshot *entities.HealthScoreSnapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
+
+func (m *MockHealthScoreSnapshotRepository) FindByUserIDSince(ctx context.Context, userID entities.UserID, since time.Time) ([]*entities.HealthScoreSnapshot, error) {
+	args := m.Called(ctx, userID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.HealthScoreSnapshot), args.Error(1)
+}
+
+func (m *MockHealthScoreSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockHealthScoreSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockGoalProgressSnapshotRepository
+// -------------------------------------------------------------------
+
+type MockGoalProgressSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *MockGoalProgressSnapshotRepository) Save(ctx context.Context, snapshot *entities.GoalProgressSnapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
+
+func (m *MockGoalProgressSnapshotRepository) FindByGoalIDSince(ctx context.Context, goalID entities.GoalID, since time.Time) ([]*entities.GoalProgressSnapshot, error) {
+	args := m.Called(ctx, goalID, since)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GoalProgressSnapshot), args.Error(1)
+}
+
+func (m *MockGoalProgressSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockGoalProgressSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockGoalContributionHistoryRepository
+// -------------------------------------------------------------------
+
+type MockGoalContributionHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockGoalContributionHistoryRepository) Save(ctx context.Context, change *entities.GoalContributionChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func (m *MockGoalContributionHistoryRepository) FindByGoalID(ctx context.Context, goalID entities.GoalID) ([]*entities.GoalContributionChange, error) {
+	args := m.Called(ctx, goalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.GoalContributionChange), args.Error(1)
+}
+
+func (m *MockGoalContributionHistoryRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockGoalContributionHistoryRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockPeerBenchmarkRepository
+// -------------------------------------------------------------------
+
+type MockPeerBenchmarkRepository struct {
+	mock.Mock
+}
+
+func (m *MockPeerBenchmarkRepository) ReplaceAll(ctx context.Context, benchmarks []entities.PeerBenchmark) error {
+	args := m.Called(ctx, benchmarks)
+	return args.Error(0)
+}
+
+func (m *MockPeerBenchmarkRepository) FindBySegment(ctx context.Context, segment entities.PeerSegment) ([]entities.PeerBenchmark, error) {
+	args := m.Called(ctx, segment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.PeerBenchmark), args.Error(1)
+}
+
+// -------------------------------------------------------------------
+// MockPeerBenchmarkConsentRepository
+// -------------------------------------------------------------------
+
+type MockPeerBenchmarkConsentRepository struct {
+	mock.Mock
+}
+
+func (m *MockPeerBenchmarkConsentRepository) OptIn(ctx context.Context, userID entities.UserID, optedInAt time.Time) error {
+	args := m.Called(ctx, userID, optedInAt)
+	return args.Error(0)
+}
+
+func (m *MockPeerBenchmarkConsentRepository) OptOut(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockPeerBenchmarkConsentRepository) FindOptedInAt(ctx context.Context, userID entities.UserID) (*time.Time, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *MockPeerBenchmarkConsentRepository) FindOptedInUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	args := m.Called(ctx, afterUserID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entities.UserID), args.Error(1)
+}
+
+// -------------------------------------------------------------------
+// MockCalculationSnapshotRepository
+// -------------------------------------------------------------------
+
+type MockCalculationSnapshotRepository struct {
+	mock.Mock
+}
+
+func (m *MockCalculationSnapshotRepository) Save(ctx context.Context, snapshot *entities.CalculationSnapshot) error {
+	args := m.Called(ctx, snapshot)
+	return args.Error(0)
+}
+
+func (m *MockCalculationSnapshotRepository) FindByUserIDAndKind(ctx context.Context, userID entities.UserID, kind entities.CalculationSnapshotKind) (*entities.CalculationSnapshot, error) {
+	args := m.Called(ctx, userID, kind)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.CalculationSnapshot), args.Error(1)
+}
+
+func (m *MockCalculationSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCalculationSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockFileStorage
+// -------------------------------------------------------------------
+
+type MockFileStorage struct {
+	mock.Mock
+}
+
+func (m *MockFileStorage) Save(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	args := m.Called(ctx, key, contentType, data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockFileStorage) Delete(ctx context.Context, url string) error {
+	args := m.Called(ctx, url)
+	return args.Error(0)
+}
+
+// -------------------------------------------------------------------
+// MockAuditLogRepository
+// -------------------------------------------------------------------
+
+type MockAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAuditLogRepository) Save(ctx context.Context, entry *entities.AuditLogEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockAuditLogRepository) FindExpiredImpersonationSessions(ctx context.Context, now time.Time) ([]*entities.AuditLogEntry, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.AuditLogEntry), args.Error(1)
+}
+
+// -------------------------------------------------------------------
+// MockDigestDeliveryRepository
+// -------------------------------------------------------------------
+
+type MockDigestDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *MockDigestDeliveryRepository) Save(ctx context.Context, delivery *entities.DigestDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockDigestDeliveryRepository) FindLatestByUserID(ctx context.Context, userID entities.UserID) (*entities.DigestDelivery, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entities.DigestDelivery), args.Error(1)
+}
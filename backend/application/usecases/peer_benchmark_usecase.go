@@ -0,0 +1,327 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+const (
+	// DefaultPeerBenchmarkCacheTTL は区分ごとの集計結果をキャッシュする期間のデフォルト値
+	DefaultPeerBenchmarkCacheTTL = time.Hour
+	// DefaultPeerBenchmarkBatchSize は集計時に一度に取得する参加ユーザー数のデフォルト値
+	DefaultPeerBenchmarkBatchSize = 500
+)
+
+// PeerBenchmarkUseCase は同年代比較（匿名集計）のユースケース
+type PeerBenchmarkUseCase interface {
+	// GetPeerComparison はユーザーの年代・年収帯の集計結果と、その中での位置を返す
+	GetPeerComparison(ctx context.Context, userID entities.UserID) (*PeerComparisonOutput, error)
+
+	// GetPeerBenchmarkOptIn は匿名集計への参加状況を返す
+	GetPeerBenchmarkOptIn(ctx context.Context, userID entities.UserID) (*PeerBenchmarkOptInOutput, error)
+
+	// SetPeerBenchmarkOptIn は匿名集計への参加・取り消しを設定する
+	SetPeerBenchmarkOptIn(ctx context.Context, input SetPeerBenchmarkOptInInput) (*PeerBenchmarkOptInOutput, error)
+
+	// AggregatePeerBenchmarks は参加しているユーザーの財務データから区分ごとの集計結果を作り直す
+	AggregatePeerBenchmarks(ctx context.Context, input AggregatePeerBenchmarksInput) (*AggregatePeerBenchmarksOutput, error)
+}
+
+// PeerMetricComparison は指標ごとの集計結果と、その中でのユーザーの位置
+type PeerMetricComparison struct {
+	Metric entities.PeerBenchmarkMetric `json:"metric"`
+	Value  float64                      `json:"value"`
+	P10    float64                      `json:"p10"`
+	P25    float64                      `json:"p25"`
+	Median float64                      `json:"median"`
+	P75    float64                      `json:"p75"`
+	P90    float64                      `json:"p90"`
+	services.PeerStanding
+}
+
+// PeerComparisonOutput は同年代比較の出力
+type PeerComparisonOutput struct {
+	Segment entities.PeerSegment `json:"segment"`
+	OptedIn bool                 `json:"opted_in"`
+	// Available は区分の集計結果があるか（参加者が最小集計人数に満たない区分は集計結果を返さない）
+	Available    bool                   `json:"available"`
+	SampleSize   int                    `json:"sample_size,omitempty"`
+	Metrics      []PeerMetricComparison `json:"metrics"`
+	AggregatedAt *time.Time             `json:"aggregated_at,omitempty"`
+	Message      string                 `json:"message,omitempty"`
+}
+
+// SetPeerBenchmarkOptInInput は匿名集計への参加設定の入力
+type SetPeerBenchmarkOptInInput struct {
+	UserID  entities.UserID `json:"user_id"`
+	OptedIn bool            `json:"opted_in"`
+}
+
+// PeerBenchmarkOptInOutput は匿名集計への参加状況
+type PeerBenchmarkOptInOutput struct {
+	OptedIn   bool       `json:"opted_in"`
+	OptedInAt *time.Time `json:"opted_in_at,omitempty"`
+}
+
+// AggregatePeerBenchmarksInput は匿名集計の入力
+type AggregatePeerBenchmarksInput struct {
+	BatchSize int `json:"batch_size"` // 0以下の場合は DefaultPeerBenchmarkBatchSize
+}
+
+// AggregatePeerBenchmarksOutput は匿名集計の結果
+type AggregatePeerBenchmarksOutput struct {
+	Participants int `json:"participants"` // 参加しているユーザー数
+	Sampled      int `json:"sampled"`      // 集計に含めたユーザー数
+	// Skipped は財務データや年齢が未設定のため集計に含めなかったユーザー数
+	Skipped      int       `json:"skipped"`
+	Segments     int       `json:"segments"`   // 最小集計人数を満たした区分の数
+	Benchmarks   int       `json:"benchmarks"` // 保存した集計結果（区分×指標）の数
+	AggregatedAt time.Time `json:"aggregated_at"`
+}
+
+// peerBenchmarkCacheEntry は区分ごとの集計結果のキャッシュ
+type peerBenchmarkCacheEntry struct {
+	benchmarks []entities.PeerBenchmark
+	expiresAt  time.Time
+}
+
+// peerBenchmarkUseCaseImpl はPeerBenchmarkUseCaseの実装
+type peerBenchmarkUseCaseImpl struct {
+	financialPlanRepo repositories.FinancialPlanRepository
+	consentRepo       repositories.PeerBenchmarkConsentRepository
+	benchmarkRepo     repositories.PeerBenchmarkRepository
+	benchmarkService  *services.PeerBenchmarkService
+	cacheTTL          time.Duration
+	now               func() time.Time
+	logger            *log.UseCaseLogger
+
+	mu    sync.Mutex
+	cache map[entities.PeerSegment]peerBenchmarkCacheEntry
+}
+
+// NewPeerBenchmarkUseCase は新しいPeerBenchmarkUseCaseを作成する
+// 集計結果は集計ジョブでのみ更新されるため、区分ごとに cacheTTL の間キャッシュする（0以下の場合はデフォルト値）
+func NewPeerBenchmarkUseCase(
+	financialPlanRepo repositories.FinancialPlanRepository,
+	consentRepo repositories.PeerBenchmarkConsentRepository,
+	benchmarkRepo repositories.PeerBenchmarkRepository,
+	benchmarkService *services.PeerBenchmarkService,
+	cacheTTL time.Duration,
+) PeerBenchmarkUseCase {
+	if benchmarkService == nil {
+		benchmarkService = services.NewDefaultPeerBenchmarkService()
+	}
+	if cacheTTL <= 0 {
+		cacheTTL = DefaultPeerBenchmarkCacheTTL
+	}
+	return &peerBenchmarkUseCaseImpl{
+		financialPlanRepo: financialPlanRepo,
+		consentRepo:       consentRepo,
+		benchmarkRepo:     benchmarkRepo,
+		benchmarkService:  benchmarkService,
+		cacheTTL:          cacheTTL,
+		now:               time.Now,
+		logger:            log.NewUseCaseLogger("PeerBenchmarkUseCase"),
+		cache:             make(map[entities.PeerSegment]peerBenchmarkCacheEntry),
+	}
+}
+
+// GetPeerComparison はユーザーの年代・年収帯の集計結果と、その中での位置を返す
+// 比較するだけなら参加は不要だが、参加していないユーザーの財務データは集計に含めない
+func (uc *peerBenchmarkUseCaseImpl) GetPeerComparison(ctx context.Context, userID entities.UserID) (*PeerComparisonOutput, error) {
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("財務データの取得に失敗しました: %w", err)
+	}
+
+	sample, err := uc.benchmarkService.Sample(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	optedInAt, err := uc.consentRepo.FindOptedInAt(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	benchmarks, err := uc.findBenchmarks(ctx, sample.Segment)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &PeerComparisonOutput{
+		Segment: sample.Segment,
+		OptedIn: optedInAt != nil,
+		Metrics: []PeerMetricComparison{},
+	}
+	if len(benchmarks) == 0 {
+		output.Message = fmt.Sprintf("同じ年代・年収帯の集計人数が%d人に満たないため、比較結果はまだありません", uc.benchmarkService.MinGroupSize())
+		return output, nil
+	}
+
+	byMetric := make(map[entities.PeerBenchmarkMetric]entities.PeerBenchmark, len(benchmarks))
+	for _, benchmark := range benchmarks {
+		byMetric[benchmark.Metric] = benchmark
+	}
+	for _, metric := range entities.PeerBenchmarkMetrics {
+		benchmark, ok := byMetric[metric]
+		if !ok {
+			continue
+		}
+		value := sample.Values[metric]
+		output.Metrics = append(output.Metrics, PeerMetricComparison{
+			Metric:       metric,
+			Value:        value,
+			P10:          benchmark.P10,
+			P25:          benchmark.P25,
+			Median:       benchmark.Median,
+			P75:          benchmark.P75,
+			P90:          benchmark.P90,
+			PeerStanding: uc.benchmarkService.Standing(value, benchmark),
+		})
+		output.SampleSize = benchmark.SampleSize
+		aggregatedAt := benchmark.AggregatedAt
+		output.AggregatedAt = &aggregatedAt
+	}
+	output.Available = len(output.Metrics) > 0
+
+	return output, nil
+}
+
+// GetPeerBenchmarkOptIn は匿名集計への参加状況を返す
+func (uc *peerBenchmarkUseCaseImpl) GetPeerBenchmarkOptIn(ctx context.Context, userID entities.UserID) (*PeerBenchmarkOptInOutput, error) {
+	optedInAt, err := uc.consentRepo.FindOptedInAt(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &PeerBenchmarkOptInOutput{OptedIn: optedInAt != nil, OptedInAt: optedInAt}, nil
+}
+
+// SetPeerBenchmarkOptIn は匿名集計への参加・取り消しを設定する
+// 取り消した場合も保存済みの集計結果には次回の集計まで反映されない
+func (uc *peerBenchmarkUseCaseImpl) SetPeerBenchmarkOptIn(ctx context.Context, input SetPeerBenchmarkOptInInput) (*PeerBenchmarkOptInOutput, error) {
+	if input.OptedIn {
+		if err := uc.consentRepo.OptIn(ctx, input.UserID, uc.now()); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := uc.consentRepo.OptOut(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetPeerBenchmarkOptIn(ctx, input.UserID)
+}
+
+// AggregatePeerBenchmarks は参加しているユーザーの財務データから区分ごとの集計結果を作り直す
+// 財務データや年齢が未設定のユーザーは集計に含めず、参加ユーザーの取得や保存に失敗した場合はそれまでの件数とエラーを返す
+func (uc *peerBenchmarkUseCaseImpl) AggregatePeerBenchmarks(
+	ctx context.Context,
+	input AggregatePeerBenchmarksInput,
+) (*AggregatePeerBenchmarksOutput, error) {
+	batchSize := input.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultPeerBenchmarkBatchSize
+	}
+
+	ctx = uc.logger.StartOperation(ctx, "AggregatePeerBenchmarks", slog.Int("min_group_size", uc.benchmarkService.MinGroupSize()))
+
+	output := &AggregatePeerBenchmarksOutput{AggregatedAt: uc.now()}
+	var (
+		samples     []services.PeerSample
+		afterUserID entities.UserID
+	)
+	for {
+		if err := ctx.Err(); err != nil {
+			return output, fmt.Errorf("集計が中断されました: %w", err)
+		}
+
+		userIDs, err := uc.consentRepo.FindOptedInUserIDs(ctx, afterUserID, batchSize)
+		if err != nil {
+			uc.logger.OperationError(ctx, "AggregatePeerBenchmarks", err, slog.String("step", "find_users"))
+			return output, fmt.Errorf("集計対象のユーザーの取得に失敗しました: %w", err)
+		}
+
+		for _, userID := range userIDs {
+			output.Participants++
+			plan, err := uc.financialPlanRepo.FindByUserID(ctx, userID)
+			if err != nil {
+				output.Skipped++
+				continue
+			}
+			sample, err := uc.benchmarkService.Sample(plan)
+			if err != nil {
+				if !errors.Is(err, services.ErrPeerAgeUnknown) {
+					uc.logger.OperationError(ctx, "AggregatePeerBenchmarks", err, log.UserID(string(userID)))
+				}
+				output.Skipped++
+				continue
+			}
+			samples = append(samples, sample)
+		}
+
+		if len(userIDs) < batchSize {
+			break
+		}
+		afterUserID = userIDs[len(userIDs)-1]
+	}
+	output.Sampled = len(samples)
+
+	benchmarks := uc.benchmarkService.Aggregate(samples, output.AggregatedAt)
+	if err := uc.benchmarkRepo.ReplaceAll(ctx, benchmarks); err != nil {
+		uc.logger.OperationError(ctx, "AggregatePeerBenchmarks", err, slog.String("step", "save"))
+		return output, fmt.Errorf("集計結果の保存に失敗しました: %w", err)
+	}
+	uc.clearCache()
+
+	segments := make(map[entities.PeerSegment]bool)
+	for _, benchmark := range benchmarks {
+		segments[benchmark.Segment] = true
+	}
+	output.Segments = len(segments)
+	output.Benchmarks = len(benchmarks)
+
+	uc.logger.EndOperation(ctx, "AggregatePeerBenchmarks",
+		slog.Int("participants", output.Participants),
+		slog.Int("sampled", output.Sampled),
+		slog.Int("segments", output.Segments),
+	)
+	return output, nil
+}
+
+// findBenchmarks は区分の集計結果をキャッシュから取得し、期限切れの場合はリポジトリから読み込む
+func (uc *peerBenchmarkUseCaseImpl) findBenchmarks(ctx context.Context, segment entities.PeerSegment) ([]entities.PeerBenchmark, error) {
+	now := uc.now()
+
+	uc.mu.Lock()
+	entry, ok := uc.cache[segment]
+	uc.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.benchmarks, nil
+	}
+
+	benchmarks, err := uc.benchmarkRepo.FindBySegment(ctx, segment)
+	if err != nil {
+		return nil, fmt.Errorf("同年代比較の集計結果の取得に失敗しました: %w", err)
+	}
+
+	uc.mu.Lock()
+	uc.cache[segment] = peerBenchmarkCacheEntry{benchmarks: benchmarks, expiresAt: now.Add(uc.cacheTTL)}
+	uc.mu.Unlock()
+	return benchmarks, nil
+}
+
+// clearCache は集計結果のキャッシュを破棄する
+func (uc *peerBenchmarkUseCaseImpl) clearCache() {
+	uc.mu.Lock()
+	uc.cache = make(map[entities.PeerSegment]peerBenchmarkCacheEntry)
+	uc.mu.Unlock()
+}
@@ -0,0 +1,269 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPeerPlan は年齢を設定したテスト用の財務計画を作成するヘルパー（月収400,000円・貯蓄率55%）
+func newTestPeerPlan(t *testing.T, userID entities.UserID, currentAge int) *aggregates.FinancialPlan {
+	t.Helper()
+	plan := newTestFinancialPlan(userID)
+	retirement, err := entities.NewRetirementData(userID, currentAge, 65, 90, mustNewMoney(200000), mustNewMoney(100000))
+	require.NoError(t, err)
+	require.NoError(t, plan.SetRetirementData(retirement))
+	return plan
+}
+
+// newTestPeerBenchmarkUseCase は現在時刻を固定したPeerBenchmarkUseCaseを作成するヘルパー
+func newTestPeerBenchmarkUseCase(
+	planRepo *MockFinancialPlanRepository,
+	consentRepo *MockPeerBenchmarkConsentRepository,
+	benchmarkRepo *MockPeerBenchmarkRepository,
+	minGroupSize int,
+	now *time.Time,
+) *peerBenchmarkUseCaseImpl {
+	uc := NewPeerBenchmarkUseCase(
+		planRepo,
+		consentRepo,
+		benchmarkRepo,
+		services.NewPeerBenchmarkService(minGroupSize),
+		time.Hour,
+	).(*peerBenchmarkUseCaseImpl)
+	uc.now = func() time.Time { return *now }
+	return uc
+}
+
+func TestPeerBenchmarkUseCase_GetPeerComparison(t *testing.T) {
+	ctx := context.Background()
+	userID := entities.UserID("user-001")
+	segment := entities.PeerSegment{AgeBand: "30s", IncomeBand: "3m_5m"}
+	aggregatedAt := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	benchmarks := []entities.PeerBenchmark{
+		{Segment: segment, Metric: entities.PeerMetricTotalAssets, SampleSize: 120, P10: 200000, P25: 500000, Median: 1000000, P75: 3000000, P90: 6000000, AggregatedAt: aggregatedAt},
+		{Segment: segment, Metric: entities.PeerMetricSavingsRate, SampleSize: 120, P10: 5, P25: 10, Median: 20, P75: 30, P90: 45, AggregatedAt: aggregatedAt},
+	}
+
+	t.Run("正常系: 区分の集計結果と位置を指標の表示順で返す", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		now := aggregatedAt.Add(time.Hour)
+		optedInAt := aggregatedAt.AddDate(0, -1, 0)
+		mockPlanRepo.On("FindByUserID", mock_anything(), userID).Return(newTestPeerPlan(t, userID, 35), nil)
+		mockConsentRepo.On("FindOptedInAt", mock_anything(), userID).Return(&optedInAt, nil)
+		mockBenchmarkRepo.On("FindBySegment", mock_anything(), segment).Return(benchmarks, nil)
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, mockConsentRepo, mockBenchmarkRepo, 50, &now)
+		output, err := uc.GetPeerComparison(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, segment, output.Segment)
+		assert.True(t, output.OptedIn)
+		assert.True(t, output.Available)
+		assert.Equal(t, 120, output.SampleSize)
+		require.NotNil(t, output.AggregatedAt)
+		assert.Equal(t, aggregatedAt, *output.AggregatedAt)
+		// 集計結果のない緊急資金月数は含めない
+		require.Len(t, output.Metrics, 2)
+		assert.Equal(t, entities.PeerMetricSavingsRate, output.Metrics[0].Metric)
+		assert.InDelta(t, 55.0, output.Metrics[0].Value, 0.0001)
+		assert.Equal(t, services.PeerPositionAboveP90, output.Metrics[0].Position)
+		assert.Equal(t, entities.PeerMetricTotalAssets, output.Metrics[1].Metric)
+		assert.Equal(t, services.PeerPositionP25P50, output.Metrics[1].Position)
+		require.NotNil(t, output.Metrics[1].Percentile)
+		assert.Equal(t, 50.0, *output.Metrics[1].Percentile)
+	})
+
+	t.Run("正常系: 集計人数が足りない区分は比較結果なし", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		now := aggregatedAt
+		mockPlanRepo.On("FindByUserID", mock_anything(), userID).Return(newTestPeerPlan(t, userID, 35), nil)
+		mockConsentRepo.On("FindOptedInAt", mock_anything(), userID).Return(nil, nil)
+		mockBenchmarkRepo.On("FindBySegment", mock_anything(), segment).Return([]entities.PeerBenchmark{}, nil)
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, mockConsentRepo, mockBenchmarkRepo, 50, &now)
+		output, err := uc.GetPeerComparison(ctx, userID)
+
+		require.NoError(t, err)
+		assert.False(t, output.OptedIn)
+		assert.False(t, output.Available)
+		assert.Empty(t, output.Metrics)
+		assert.Contains(t, output.Message, "50人")
+	})
+
+	t.Run("正常系: キャッシュの期限内はリポジトリを読み直さない", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		now := aggregatedAt
+		mockPlanRepo.On("FindByUserID", mock_anything(), userID).Return(newTestPeerPlan(t, userID, 35), nil)
+		mockConsentRepo.On("FindOptedInAt", mock_anything(), userID).Return(nil, nil)
+		mockBenchmarkRepo.On("FindBySegment", mock_anything(), segment).Return(benchmarks, nil)
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, mockConsentRepo, mockBenchmarkRepo, 50, &now)
+		_, err := uc.GetPeerComparison(ctx, userID)
+		require.NoError(t, err)
+		now = now.Add(30 * time.Minute)
+		_, err = uc.GetPeerComparison(ctx, userID)
+		require.NoError(t, err)
+		mockBenchmarkRepo.AssertNumberOfCalls(t, "FindBySegment", 1)
+
+		// 期限切れ後は読み直す
+		now = now.Add(time.Hour)
+		_, err = uc.GetPeerComparison(ctx, userID)
+		require.NoError(t, err)
+		mockBenchmarkRepo.AssertNumberOfCalls(t, "FindBySegment", 2)
+	})
+
+	t.Run("異常系: 年齢が分からない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		now := aggregatedAt
+		mockPlanRepo.On("FindByUserID", mock_anything(), userID).Return(newTestFinancialPlan(userID), nil)
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, mockConsentRepo, mockBenchmarkRepo, 50, &now)
+		_, err := uc.GetPeerComparison(ctx, userID)
+
+		assert.ErrorIs(t, err, services.ErrPeerAgeUnknown)
+		mockBenchmarkRepo.AssertNotCalled(t, "FindBySegment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 財務データがない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		now := aggregatedAt
+		mockPlanRepo.On("FindByUserID", mock_anything(), userID).Return(nil, errors.New("財務データが見つかりません"))
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, new(MockPeerBenchmarkConsentRepository), new(MockPeerBenchmarkRepository), 50, &now)
+		_, err := uc.GetPeerComparison(ctx, userID)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務データの取得に失敗しました")
+	})
+}
+
+func TestPeerBenchmarkUseCase_SetPeerBenchmarkOptIn(t *testing.T) {
+	ctx := context.Background()
+	userID := entities.UserID("user-001")
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 参加を記録する", func(t *testing.T) {
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockConsentRepo.On("OptIn", mock_anything(), userID, now).Return(nil)
+		mockConsentRepo.On("FindOptedInAt", mock_anything(), userID).Return(&now, nil)
+
+		uc := newTestPeerBenchmarkUseCase(new(MockFinancialPlanRepository), mockConsentRepo, new(MockPeerBenchmarkRepository), 50, &now)
+		output, err := uc.SetPeerBenchmarkOptIn(ctx, SetPeerBenchmarkOptInInput{UserID: userID, OptedIn: true})
+
+		require.NoError(t, err)
+		assert.True(t, output.OptedIn)
+		assert.Equal(t, now, *output.OptedInAt)
+		mockConsentRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 参加を取り消す", func(t *testing.T) {
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockConsentRepo.On("OptOut", mock_anything(), userID).Return(nil)
+		mockConsentRepo.On("FindOptedInAt", mock_anything(), userID).Return(nil, nil)
+
+		uc := newTestPeerBenchmarkUseCase(new(MockFinancialPlanRepository), mockConsentRepo, new(MockPeerBenchmarkRepository), 50, &now)
+		output, err := uc.SetPeerBenchmarkOptIn(ctx, SetPeerBenchmarkOptInInput{UserID: userID, OptedIn: false})
+
+		require.NoError(t, err)
+		assert.False(t, output.OptedIn)
+		assert.Nil(t, output.OptedInAt)
+		mockConsentRepo.AssertExpectations(t)
+	})
+}
+
+func TestPeerBenchmarkUseCase_AggregatePeerBenchmarks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 参加しているユーザーのみをバッチごとに集計して保存する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		mockConsentRepo.On("FindOptedInUserIDs", mock_anything(), entities.UserID(""), 2).
+			Return([]entities.UserID{"user-001", "user-002"}, nil)
+		mockConsentRepo.On("FindOptedInUserIDs", mock_anything(), entities.UserID("user-002"), 2).
+			Return([]entities.UserID{"user-003"}, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestPeerPlan(t, "user-001", 31), nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-002")).Return(newTestPeerPlan(t, "user-002", 38), nil)
+		// 年齢が分からないユーザーは集計に含めない
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-003")).Return(newTestFinancialPlan("user-003"), nil)
+		mockBenchmarkRepo.On("ReplaceAll", mock_anything(), mock.MatchedBy(func(benchmarks []entities.PeerBenchmark) bool {
+			if len(benchmarks) != len(entities.PeerBenchmarkMetrics) {
+				return false
+			}
+			for _, benchmark := range benchmarks {
+				if benchmark.SampleSize != 2 || !benchmark.AggregatedAt.Equal(now) {
+					return false
+				}
+			}
+			return benchmarks[0].Segment == entities.PeerSegment{AgeBand: "30s", IncomeBand: "3m_5m"}
+		})).Return(nil)
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, mockConsentRepo, mockBenchmarkRepo, 2, &now)
+		output, err := uc.AggregatePeerBenchmarks(ctx, AggregatePeerBenchmarksInput{BatchSize: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, output.Participants)
+		assert.Equal(t, 2, output.Sampled)
+		assert.Equal(t, 1, output.Skipped)
+		assert.Equal(t, 1, output.Segments)
+		assert.Equal(t, len(entities.PeerBenchmarkMetrics), output.Benchmarks)
+		assert.Equal(t, now, output.AggregatedAt)
+		mockPlanRepo.AssertExpectations(t)
+		mockConsentRepo.AssertExpectations(t)
+		mockBenchmarkRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 集計後はキャッシュを破棄する", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		segment := entities.PeerSegment{AgeBand: "30s", IncomeBand: "3m_5m"}
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(newTestPeerPlan(t, "user-001", 35), nil)
+		mockConsentRepo.On("FindOptedInAt", mock_anything(), entities.UserID("user-001")).Return(nil, nil)
+		mockConsentRepo.On("FindOptedInUserIDs", mock_anything(), entities.UserID(""), DefaultPeerBenchmarkBatchSize).
+			Return([]entities.UserID{}, nil)
+		mockBenchmarkRepo.On("FindBySegment", mock_anything(), segment).Return([]entities.PeerBenchmark{}, nil)
+		mockBenchmarkRepo.On("ReplaceAll", mock_anything(), mock_anything()).Return(nil)
+
+		uc := newTestPeerBenchmarkUseCase(mockPlanRepo, mockConsentRepo, mockBenchmarkRepo, 50, &now)
+		_, err := uc.GetPeerComparison(ctx, "user-001")
+		require.NoError(t, err)
+		_, err = uc.AggregatePeerBenchmarks(ctx, AggregatePeerBenchmarksInput{})
+		require.NoError(t, err)
+		_, err = uc.GetPeerComparison(ctx, "user-001")
+		require.NoError(t, err)
+
+		mockBenchmarkRepo.AssertNumberOfCalls(t, "FindBySegment", 2)
+	})
+
+	t.Run("異常系: 参加ユーザーの取得に失敗した場合は保存しない", func(t *testing.T) {
+		mockConsentRepo := new(MockPeerBenchmarkConsentRepository)
+		mockBenchmarkRepo := new(MockPeerBenchmarkRepository)
+		mockConsentRepo.On("FindOptedInUserIDs", mock_anything(), entities.UserID(""), DefaultPeerBenchmarkBatchSize).
+			Return(nil, errors.New("connection refused"))
+
+		uc := newTestPeerBenchmarkUseCase(new(MockFinancialPlanRepository), mockConsentRepo, mockBenchmarkRepo, 50, &now)
+		_, err := uc.AggregatePeerBenchmarks(ctx, AggregatePeerBenchmarksInput{})
+
+		require.Error(t, err)
+		mockBenchmarkRepo.AssertNotCalled(t, "ReplaceAll", mock.Anything, mock.Anything)
+	})
+}
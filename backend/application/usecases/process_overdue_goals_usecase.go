@@ -0,0 +1,225 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// OverdueGoalAction は期限超過の目標に対して実行した処理
+type OverdueGoalAction string
+
+const (
+	OverdueGoalActionExtended OverdueGoalAction = "extended" // 期限を延長した
+	OverdueGoalActionArchived OverdueGoalAction = "archived" // アーカイブ（非アクティブ化）した
+	OverdueGoalActionNotified OverdueGoalAction = "notified" // 通知のみ行った
+	OverdueGoalActionFailed   OverdueGoalAction = "failed"   // 処理に失敗した
+)
+
+// ProcessOverdueGoalsUseCase は期限を過ぎた目標に期限超過時ポリシーを適用するユースケース
+type ProcessOverdueGoalsUseCase interface {
+	// ProcessOverdueGoals は期限超過の目標すべてにポリシーを適用し、結果をユーザーに通知する
+	ProcessOverdueGoals(ctx context.Context) (*ProcessOverdueGoalsOutput, error)
+}
+
+// OverdueGoalResult は目標ごとの処理結果
+type OverdueGoalResult struct {
+	GoalID          entities.GoalID            `json:"goal_id"`
+	UserID          entities.UserID            `json:"user_id"`
+	Policy          entities.GoalOverduePolicy `json:"policy"`
+	Action          OverdueGoalAction          `json:"action"`
+	OverdueDays     int                        `json:"overdue_days"`
+	PreviousDueDate time.Time                  `json:"previous_due_date"`
+	NewDueDate      *time.Time                 `json:"new_due_date,omitempty"` // 期限を延長した場合のみ
+	// Notified は1つ以上のチャネルで通知を配信（またはリトライキューに追加）したか
+	Notified bool   `json:"notified"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ProcessOverdueGoalsOutput は期限超過の目標の一括処理の結果
+type ProcessOverdueGoalsOutput struct {
+	Targets     int                 `json:"targets"`  // 期限超過の目標数
+	Extended    int                 `json:"extended"` // 期限を延長した目標数
+	Archived    int                 `json:"archived"` // アーカイブした目標数
+	Notified    int                 `json:"notified"` // 通知のみ行った目標数
+	Failed      int                 `json:"failed"`   // 処理に失敗した目標数
+	Results     []OverdueGoalResult `json:"results"`
+	ProcessedAt time.Time           `json:"processed_at"`
+}
+
+// processOverdueGoalsUseCaseImpl はProcessOverdueGoalsUseCaseの実装
+type processOverdueGoalsUseCaseImpl struct {
+	goalRepo   repositories.GoalRepository
+	dispatcher ports.NotificationDispatcher
+	now        func() time.Time
+	logger     *log.UseCaseLogger
+}
+
+// NewProcessOverdueGoalsUseCase は新しいProcessOverdueGoalsUseCaseを作成する
+func NewProcessOverdueGoalsUseCase(
+	goalRepo repositories.GoalRepository,
+	dispatcher ports.NotificationDispatcher,
+) ProcessOverdueGoalsUseCase {
+	return &processOverdueGoalsUseCaseImpl{
+		goalRepo:   goalRepo,
+		dispatcher: dispatcher,
+		now:        time.Now,
+		logger:     log.NewUseCaseLogger("ProcessOverdueGoalsUseCase"),
+	}
+}
+
+// ProcessOverdueGoals は期限超過の目標すべてにポリシーを適用し、結果をユーザーに通知する
+// extend は現在の月間拠出額で達成に必要な期間を再計算して期限を延長し、延長できない場合は通知のみ行う
+// 目標ごとの失敗は結果に記録して処理を続け、対象の取得に失敗した場合や中断された場合はそれまでの結果とエラーを返す
+func (uc *processOverdueGoalsUseCaseImpl) ProcessOverdueGoals(ctx context.Context) (*ProcessOverdueGoalsOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "ProcessOverdueGoals")
+
+	now := uc.now()
+	output := &ProcessOverdueGoalsOutput{Results: []OverdueGoalResult{}, ProcessedAt: now}
+
+	goals, err := uc.goalRepo.FindOverdueActiveGoals(ctx, now)
+	if err != nil {
+		uc.logger.OperationError(ctx, "ProcessOverdueGoals", err, slog.String("step", "find_goals"))
+		return output, fmt.Errorf("期限超過の目標の取得に失敗しました: %w", err)
+	}
+	output.Targets = len(goals)
+
+	for _, goal := range goals {
+		if err := ctx.Err(); err != nil {
+			return output, fmt.Errorf("期限超過の目標の処理が中断されました: %w", err)
+		}
+
+		result := uc.processOverdueGoal(ctx, goal, now)
+		switch result.Action {
+		case OverdueGoalActionExtended:
+			output.Extended++
+		case OverdueGoalActionArchived:
+			output.Archived++
+		case OverdueGoalActionNotified:
+			output.Notified++
+		default:
+			output.Failed++
+		}
+		output.Results = append(output.Results, result)
+
+		log.Info(ctx, "期限超過の目標を処理しました",
+			log.UserID(string(result.UserID)),
+			slog.String("goal_id", string(result.GoalID)),
+			slog.String("policy", string(result.Policy)),
+			slog.String("action", string(result.Action)),
+			slog.Int("overdue_days", result.OverdueDays),
+			slog.Bool("notified", result.Notified),
+		)
+	}
+
+	uc.logger.EndOperation(ctx, "ProcessOverdueGoals",
+		slog.Int("targets", output.Targets),
+		slog.Int("extended", output.Extended),
+		slog.Int("archived", output.Archived),
+		slog.Int("notified", output.Notified),
+		slog.Int("failed", output.Failed),
+	)
+
+	return output, nil
+}
+
+// processOverdueGoal は1件の目標にポリシーを適用して保存し、処理内容をユーザーに通知する
+func (uc *processOverdueGoalsUseCaseImpl) processOverdueGoal(ctx context.Context, goal *entities.Goal, now time.Time) OverdueGoalResult {
+	result := OverdueGoalResult{
+		GoalID:          goal.ID(),
+		UserID:          goal.UserID(),
+		Policy:          goal.OverduePolicy(),
+		Action:          OverdueGoalActionNotified,
+		OverdueDays:     overdueDays(goal.TargetDate(), now),
+		PreviousDueDate: goal.TargetDate(),
+	}
+
+	switch result.Policy {
+	case entities.GoalOverduePolicyExtend:
+		newDueDate, err := goal.ExtendTargetDate(now)
+		if err != nil {
+			// 延長できない場合は期限超過の通知のみ行い、ユーザーに拠出額の見直しを促す
+			result.Error = err.Error()
+			break
+		}
+		if err := uc.goalRepo.Update(ctx, goal); err != nil {
+			uc.logger.OperationError(ctx, "ProcessOverdueGoals", err,
+				slog.String("step", "extend"),
+				slog.String("goal_id", string(goal.ID())),
+			)
+			result.Action = OverdueGoalActionFailed
+			result.Error = fmt.Sprintf("目標の更新に失敗しました: %v", err)
+			return result
+		}
+		result.Action = OverdueGoalActionExtended
+		result.NewDueDate = &newDueDate
+	case entities.GoalOverduePolicyArchive:
+		goal.Deactivate()
+		if err := uc.goalRepo.Update(ctx, goal); err != nil {
+			uc.logger.OperationError(ctx, "ProcessOverdueGoals", err,
+				slog.String("step", "archive"),
+				slog.String("goal_id", string(goal.ID())),
+			)
+			result.Action = OverdueGoalActionFailed
+			result.Error = fmt.Sprintf("目標の更新に失敗しました: %v", err)
+			return result
+		}
+		result.Action = OverdueGoalActionArchived
+	}
+
+	dispatchResult, err := uc.dispatcher.Dispatch(ctx, buildOverdueGoalNotification(goal, result, now))
+	if err != nil {
+		// ポリシーの適用は完了しているため、通知の失敗はログに残して処理結果は維持する
+		uc.logger.OperationError(ctx, "ProcessOverdueGoals", err,
+			slog.String("step", "dispatch"),
+			slog.String("goal_id", string(goal.ID())),
+		)
+		return result
+	}
+	result.Notified = len(dispatchResult.Delivered) > 0 || len(dispatchResult.Queued) > 0
+
+	return result
+}
+
+// buildOverdueGoalNotification は期限超過の目標の処理内容を伝える通知を組み立てる
+func buildOverdueGoalNotification(goal *entities.Goal, result OverdueGoalResult, now time.Time) ports.Notification {
+	var title, message string
+	switch result.Action {
+	case OverdueGoalActionExtended:
+		title = "目標の期限を延長しました"
+		message = fmt.Sprintf("「%s」は期限（%s）を%d日過ぎたため、現在の月間拠出額で達成できる %s まで期限を延長しました",
+			goal.Title(), result.PreviousDueDate.Format("2006-01-02"), result.OverdueDays, result.NewDueDate.Format("2006-01-02"))
+	case OverdueGoalActionArchived:
+		title = "目標をアーカイブしました"
+		message = fmt.Sprintf("「%s」は期限（%s）を%d日過ぎたため、アーカイブしました",
+			goal.Title(), result.PreviousDueDate.Format("2006-01-02"), result.OverdueDays)
+	default:
+		title = "目標の期限を過ぎています"
+		message = fmt.Sprintf("「%s」は期限（%s）を%d日過ぎています。目標日や月間拠出額を見直してください",
+			goal.Title(), result.PreviousDueDate.Format("2006-01-02"), result.OverdueDays)
+	}
+
+	return ports.Notification{
+		UserID:     goal.UserID(),
+		EventType:  entities.NotificationEventGoalOverdue,
+		Title:      title,
+		Message:    message,
+		Value:      float64(result.OverdueDays),
+		GoalID:     string(goal.ID()),
+		OccurredAt: now,
+	}
+}
+
+// overdueDays は期限から now までの経過日数を返す
+func overdueDays(dueDate, now time.Time) int {
+	if !now.After(dueDate) {
+		return 0
+	}
+	return int(now.Sub(dueDate).Hours() / 24)
+}
@@ -0,0 +1,153 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestOverdueGoalWithPolicy は期限を1ヶ月過ぎた進捗50%の目標を作成するヘルパー（月間拠出額5万円）
+func newTestOverdueGoalWithPolicy(t *testing.T, id entities.GoalID, policy entities.GoalOverduePolicy, now time.Time) *entities.Goal {
+	t.Helper()
+	goal, err := entities.NewGoalWithID(
+		id,
+		"user-001",
+		entities.GoalTypeSavings,
+		"旅行資金",
+		mustNewMoney(1000000),
+		now.AddDate(0, -1, 0),
+		mustNewMoney(50000),
+		now,
+		now,
+	)
+	require.NoError(t, err)
+	require.NoError(t, goal.UpdateCurrentAmount(mustNewMoney(500000)))
+	require.NoError(t, goal.UpdateOverduePolicy(policy))
+	return goal
+}
+
+// newTestProcessOverdueGoalsUseCase は現在時刻を固定したユースケースを作成するヘルパー
+func newTestProcessOverdueGoalsUseCase(goalRepo *MockGoalRepository, dispatcher *MockNotificationDispatcher, now time.Time) *processOverdueGoalsUseCaseImpl {
+	uc := NewProcessOverdueGoalsUseCase(goalRepo, dispatcher).(*processOverdueGoalsUseCaseImpl)
+	uc.now = func() time.Time { return now }
+	return uc
+}
+
+func TestProcessOverdueGoalsUseCase_ProcessOverdueGoals(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC)
+	delivered := &ports.DispatchResult{
+		Delivered: []entities.NotificationChannelType{entities.NotificationChannelEmail},
+		Queued:    []entities.NotificationChannelType{},
+	}
+
+	t.Run("正常系: ポリシーごとに延長・アーカイブ・通知を行い、すべてユーザーに通知する", func(t *testing.T) {
+		extendGoal := newTestOverdueGoalWithPolicy(t, "goal-extend", entities.GoalOverduePolicyExtend, now)
+		archiveGoal := newTestOverdueGoalWithPolicy(t, "goal-archive", entities.GoalOverduePolicyArchive, now)
+		notifyGoal := newTestOverdueGoalWithPolicy(t, "goal-notify", entities.GoalOverduePolicyNotify, now)
+
+		mockGoalRepo := new(MockGoalRepository)
+		mockGoalRepo.On("FindOverdueActiveGoals", mock_anything(), now).Return([]*entities.Goal{extendGoal, archiveGoal, notifyGoal}, nil)
+		mockGoalRepo.On("Update", mock_anything(), extendGoal).Return(nil)
+		mockGoalRepo.On("Update", mock_anything(), archiveGoal).Return(nil)
+		mockDispatcher := new(MockNotificationDispatcher)
+		mockDispatcher.On("Dispatch", mock_anything(), matchEventType(entities.NotificationEventGoalOverdue)).Return(delivered, nil)
+
+		uc := newTestProcessOverdueGoalsUseCase(mockGoalRepo, mockDispatcher, now)
+		output, err := uc.ProcessOverdueGoals(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, output.Targets)
+		assert.Equal(t, 1, output.Extended)
+		assert.Equal(t, 1, output.Archived)
+		assert.Equal(t, 1, output.Notified)
+		assert.Equal(t, 0, output.Failed)
+		require.Len(t, output.Results, 3)
+
+		// 残り50万円・月5万円のため10ヶ月後まで延長する
+		extended := output.Results[0]
+		assert.Equal(t, OverdueGoalActionExtended, extended.Action)
+		require.NotNil(t, extended.NewDueDate)
+		assert.Equal(t, 10, entities.MonthsBetween(now, *extended.NewDueDate))
+		assert.Equal(t, *extended.NewDueDate, extendGoal.TargetDate())
+		assert.Equal(t, 31, extended.OverdueDays)
+
+		assert.Equal(t, OverdueGoalActionArchived, output.Results[1].Action)
+		assert.False(t, archiveGoal.IsActive())
+
+		assert.Equal(t, OverdueGoalActionNotified, output.Results[2].Action)
+		assert.True(t, notifyGoal.IsActive())
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), notifyGoal)
+
+		for _, result := range output.Results {
+			assert.True(t, result.Notified)
+		}
+		mockDispatcher.AssertNumberOfCalls(t, "Dispatch", 3)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 月間拠出額が未設定で延長できない場合は通知のみ行う", func(t *testing.T) {
+		goal := newTestOverdueGoalWithPolicy(t, "goal-extend", entities.GoalOverduePolicyExtend, now)
+		require.NoError(t, goal.UpdateMonthlyContribution(mustNewMoney(0)))
+		dueDate := goal.TargetDate()
+
+		mockGoalRepo := new(MockGoalRepository)
+		mockGoalRepo.On("FindOverdueActiveGoals", mock_anything(), now).Return([]*entities.Goal{goal}, nil)
+		mockDispatcher := new(MockNotificationDispatcher)
+		mockDispatcher.On("Dispatch", mock_anything(), mock.MatchedBy(func(n ports.Notification) bool {
+			return n.EventType == entities.NotificationEventGoalOverdue && n.Title == "目標の期限を過ぎています" && n.Value == 31
+		})).Return(delivered, nil)
+
+		uc := newTestProcessOverdueGoalsUseCase(mockGoalRepo, mockDispatcher, now)
+		output, err := uc.ProcessOverdueGoals(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, output.Notified)
+		assert.Equal(t, OverdueGoalActionNotified, output.Results[0].Action)
+		assert.Equal(t, entities.ErrGoalCannotBeExtended.Error(), output.Results[0].Error)
+		assert.Equal(t, dueDate, goal.TargetDate())
+		mockGoalRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+		mockDispatcher.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 目標の更新に失敗した場合は失敗として記録し、残りの目標の処理を続ける", func(t *testing.T) {
+		archiveGoal := newTestOverdueGoalWithPolicy(t, "goal-archive", entities.GoalOverduePolicyArchive, now)
+		notifyGoal := newTestOverdueGoalWithPolicy(t, "goal-notify", entities.GoalOverduePolicyNotify, now)
+
+		mockGoalRepo := new(MockGoalRepository)
+		mockGoalRepo.On("FindOverdueActiveGoals", mock_anything(), now).Return([]*entities.Goal{archiveGoal, notifyGoal}, nil)
+		mockGoalRepo.On("Update", mock_anything(), archiveGoal).Return(errors.New("db error"))
+		mockDispatcher := new(MockNotificationDispatcher)
+		mockDispatcher.On("Dispatch", mock_anything(), mock_anything()).Return(delivered, nil)
+
+		uc := newTestProcessOverdueGoalsUseCase(mockGoalRepo, mockDispatcher, now)
+		output, err := uc.ProcessOverdueGoals(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, output.Failed)
+		assert.Equal(t, 1, output.Notified)
+		assert.Equal(t, OverdueGoalActionFailed, output.Results[0].Action)
+		assert.Contains(t, output.Results[0].Error, "目標の更新に失敗しました")
+		assert.False(t, output.Results[0].Notified)
+		mockDispatcher.AssertNumberOfCalls(t, "Dispatch", 1)
+	})
+
+	t.Run("異常系: 期限超過の目標の取得に失敗した場合はエラー", func(t *testing.T) {
+		mockGoalRepo := new(MockGoalRepository)
+		mockGoalRepo.On("FindOverdueActiveGoals", mock_anything(), now).Return(nil, errors.New("db error"))
+
+		uc := newTestProcessOverdueGoalsUseCase(mockGoalRepo, new(MockNotificationDispatcher), now)
+		output, err := uc.ProcessOverdueGoals(ctx)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "期限超過の目標の取得に失敗しました")
+		assert.Equal(t, 0, output.Targets)
+	})
+}
@@ -0,0 +1,347 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// 一括再計算の既定値
+const (
+	DefaultRecalculationBatchSize   = 100 // 1回に取得するユーザー数
+	DefaultRecalculationConcurrency = 4   // 同時に再計算するユーザー数
+	recalculationProjectionYears    = 10  // 財務予測・レポートの期間（レポートPDFの既定値と同じ）
+)
+
+// RecalculationScope は一括再計算の対象
+type RecalculationScope string
+
+const (
+	RecalculationScopeProjections RecalculationScope = "projections" // 財務予測
+	RecalculationScopeReports     RecalculationScope = "reports"     // レポート
+	RecalculationScopeAlerts      RecalculationScope = "alerts"      // 推奨事項・警告
+	RecalculationScopeAll         RecalculationScope = "all"         // すべて
+)
+
+// ParseRecalculationScope は文字列から一括再計算の対象を解析する
+func ParseRecalculationScope(value string) (RecalculationScope, error) {
+	scope := RecalculationScope(value)
+	if len(scope.snapshotKinds()) == 0 {
+		return "", fmt.Errorf("無効な再計算の対象です: %s (使用可能: projections, reports, alerts, all)", value)
+	}
+	return scope, nil
+}
+
+// snapshotKinds は再計算の対象に含まれる計算結果の種別を返す
+func (s RecalculationScope) snapshotKinds() []entities.CalculationSnapshotKind {
+	switch s {
+	case RecalculationScopeProjections:
+		return []entities.CalculationSnapshotKind{entities.CalculationSnapshotKindProjection}
+	case RecalculationScopeReports:
+		return []entities.CalculationSnapshotKind{entities.CalculationSnapshotKindReport}
+	case RecalculationScopeAlerts:
+		return []entities.CalculationSnapshotKind{entities.CalculationSnapshotKindAlert}
+	case RecalculationScopeAll:
+		return []entities.CalculationSnapshotKind{
+			entities.CalculationSnapshotKindProjection,
+			entities.CalculationSnapshotKindReport,
+			entities.CalculationSnapshotKindAlert,
+		}
+	default:
+		return nil
+	}
+}
+
+// DefaultRecalculationRunID は対象と現在の計算バージョンから既定の実行IDを返す
+// 同じ計算バージョンで再実行すると前回の続きから再開し、バージョンを上げると最初から再計算する
+func DefaultRecalculationRunID(scope RecalculationScope) string {
+	return fmt.Sprintf("%s-v%d", scope, services.CalculationVersion)
+}
+
+// RecalculateUseCase は既定の前提条件や計算ロジックの変更後に保存済みの計算結果を一括で再計算するユースケース
+type RecalculateUseCase interface {
+	// Recalculate は対象ユーザーの計算結果を再計算して保存する
+	// 個々のユーザーの失敗では中断せず、失敗したユーザーは次回の再開時に再計算する
+	Recalculate(ctx context.Context, input RecalculateInput) (*RecalculateOutput, error)
+}
+
+// RecalculateInput は一括再計算の入力
+type RecalculateInput struct {
+	Scope       RecalculationScope `json:"scope"`
+	UserID      entities.UserID    `json:"user_id,omitempty"` // 指定した場合はこのユーザーのみ再計算する
+	RunID       string             `json:"run_id,omitempty"`  // 省略時は DefaultRecalculationRunID
+	BatchSize   int                `json:"batch_size,omitempty"`
+	Concurrency int                `json:"concurrency,omitempty"`
+}
+
+// RecalculationFailure は再計算に失敗したユーザー
+type RecalculationFailure struct {
+	UserID entities.UserID `json:"user_id"`
+	Error  string          `json:"error"`
+}
+
+// RecalculateOutput は一括再計算の出力
+type RecalculateOutput struct {
+	RunID              string                 `json:"run_id"`
+	CalculationVersion int                    `json:"calculation_version"`
+	Processed          int                    `json:"processed"` // 再計算したユーザー数
+	Failed             int                    `json:"failed"`    // 再計算に失敗したユーザー数
+	Skipped            int                    `json:"skipped"`   // 完了済み・財務データなしで読み飛ばしたユーザー数
+	Failures           []RecalculationFailure `json:"failures,omitempty"`
+}
+
+// recalculateUseCaseImpl はRecalculateUseCaseの実装
+type recalculateUseCaseImpl struct {
+	financialPlanRepo      repositories.FinancialPlanRepository
+	snapshotRepo           repositories.CalculationSnapshotRepository
+	progressRepo           repositories.RecalculationProgressRepository
+	projectionUseCase      CalculateProjectionUseCase
+	reportsUseCase         GenerateReportsUseCase
+	recommendationsUseCase ManageRecommendationsUseCase
+	logger                 *log.UseCaseLogger
+}
+
+// NewRecalculateUseCase は新しいRecalculateUseCaseを作成する
+func NewRecalculateUseCase(
+	financialPlanRepo repositories.FinancialPlanRepository,
+	snapshotRepo repositories.CalculationSnapshotRepository,
+	progressRepo repositories.RecalculationProgressRepository,
+	projectionUseCase CalculateProjectionUseCase,
+	reportsUseCase GenerateReportsUseCase,
+	recommendationsUseCase ManageRecommendationsUseCase,
+) RecalculateUseCase {
+	return &recalculateUseCaseImpl{
+		financialPlanRepo:      financialPlanRepo,
+		snapshotRepo:           snapshotRepo,
+		progressRepo:           progressRepo,
+		projectionUseCase:      projectionUseCase,
+		reportsUseCase:         reportsUseCase,
+		recommendationsUseCase: recommendationsUseCase,
+		logger:                 log.NewUseCaseLogger("RecalculateUseCase"),
+	}
+}
+
+// Recalculate は対象ユーザーの計算結果を再計算して保存する
+// ユーザーの取得や進捗の読み込みに失敗した場合は、それまでの集計結果とエラーを返す
+func (uc *recalculateUseCaseImpl) Recalculate(ctx context.Context, input RecalculateInput) (*RecalculateOutput, error) {
+	kinds := input.Scope.snapshotKinds()
+	if len(kinds) == 0 {
+		return nil, fmt.Errorf("無効な再計算の対象です: %s", input.Scope)
+	}
+
+	runID := input.RunID
+	if runID == "" {
+		runID = DefaultRecalculationRunID(input.Scope)
+	}
+	batchSize := input.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultRecalculationBatchSize
+	}
+	concurrency := input.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultRecalculationConcurrency
+	}
+
+	ctx = uc.logger.StartOperation(ctx, "Recalculate",
+		slog.String("scope", string(input.Scope)),
+		slog.String("run_id", runID),
+		slog.Int("calculation_version", services.CalculationVersion),
+	)
+
+	output := &RecalculateOutput{
+		RunID:              runID,
+		CalculationVersion: services.CalculationVersion,
+	}
+
+	completedIDs, err := uc.progressRepo.FindCompletedUserIDs(ctx, runID)
+	if err != nil {
+		uc.logger.OperationError(ctx, "Recalculate", err, slog.String("step", "find_progress"))
+		return output, fmt.Errorf("再計算の進捗の取得に失敗しました: %w", err)
+	}
+	completed := make(map[entities.UserID]bool, len(completedIDs))
+	for _, id := range completedIDs {
+		completed[id] = true
+	}
+
+	if input.UserID != "" {
+		exists, err := uc.financialPlanRepo.ExistsByUserID(ctx, input.UserID)
+		if err != nil {
+			uc.logger.OperationError(ctx, "Recalculate", err, slog.String("step", "exists_plan"))
+			return output, fmt.Errorf("財務計画の存在確認に失敗しました: %w", err)
+		}
+		if !exists {
+			output.Skipped++
+		} else {
+			uc.recalculateBatch(ctx, runID, kinds, []entities.UserID{input.UserID}, completed, concurrency, output)
+		}
+	} else {
+		var afterUserID entities.UserID
+		for {
+			if err := ctx.Err(); err != nil {
+				return output, fmt.Errorf("再計算が中断されました: %w", err)
+			}
+
+			userIDs, err := uc.financialPlanRepo.FindUserIDs(ctx, afterUserID, batchSize)
+			if err != nil {
+				uc.logger.OperationError(ctx, "Recalculate", err, slog.String("step", "find_users"))
+				return output, fmt.Errorf("再計算対象のユーザーの取得に失敗しました: %w", err)
+			}
+			if len(userIDs) == 0 {
+				break
+			}
+
+			uc.recalculateBatch(ctx, runID, kinds, userIDs, completed, concurrency, output)
+
+			afterUserID = userIDs[len(userIDs)-1]
+			if len(userIDs) < batchSize {
+				break
+			}
+		}
+	}
+
+	sort.Slice(output.Failures, func(i, j int) bool {
+		return output.Failures[i].UserID < output.Failures[j].UserID
+	})
+
+	uc.logger.EndOperation(ctx, "Recalculate",
+		slog.Int("processed", output.Processed),
+		slog.Int("failed", output.Failed),
+		slog.Int("skipped", output.Skipped),
+	)
+
+	return output, nil
+}
+
+// recalculateBatch はユーザーのバッチを最大 concurrency 件ずつ並行して再計算し、結果を output に集計する
+// 完了済みのユーザーは読み飛ばし、再計算に成功したユーザーは完了として記録する
+func (uc *recalculateUseCaseImpl) recalculateBatch(
+	ctx context.Context,
+	runID string,
+	kinds []entities.CalculationSnapshotKind,
+	userIDs []entities.UserID,
+	completed map[entities.UserID]bool,
+	concurrency int,
+	output *RecalculateOutput,
+) {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+
+	for _, userID := range userIDs {
+		if completed[userID] {
+			mu.Lock()
+			output.Skipped++
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(userID entities.UserID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := uc.recalculateUser(ctx, userID, kinds)
+			if err == nil {
+				if markErr := uc.progressRepo.MarkCompleted(ctx, runID, userID); markErr != nil {
+					err = fmt.Errorf("再計算の進捗の保存に失敗しました: %w", markErr)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				uc.logger.OperationError(ctx, "Recalculate", err, log.UserID(string(userID)))
+				output.Failed++
+				output.Failures = append(output.Failures, RecalculationFailure{UserID: userID, Error: err.Error()})
+				return
+			}
+			output.Processed++
+		}(userID)
+	}
+
+	wg.Wait()
+}
+
+// recalculateUser はユーザーの指定種別の計算結果を再計算し、現在の計算バージョンで保存する
+func (uc *recalculateUseCaseImpl) recalculateUser(
+	ctx context.Context,
+	userID entities.UserID,
+	kinds []entities.CalculationSnapshotKind,
+) (err error) {
+	// 1ユーザーの予期しないパニックで一括再計算全体を止めない
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("再計算中にパニックが発生しました: %v", r)
+		}
+	}()
+
+	for _, kind := range kinds {
+		result, err := uc.calculate(ctx, userID, kind)
+		if err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("計算結果のシリアライズに失敗しました: %w", err)
+		}
+
+		snapshot, err := entities.NewCalculationSnapshot(userID, kind, payload, services.CalculationVersion, time.Now())
+		if err != nil {
+			return fmt.Errorf("計算結果の作成に失敗しました: %w", err)
+		}
+		if err := uc.snapshotRepo.Save(ctx, snapshot); err != nil {
+			return fmt.Errorf("計算結果の保存に失敗しました: %w", err)
+		}
+	}
+	return nil
+}
+
+// calculate は種別に応じたユースケースを実行して計算結果を返す
+func (uc *recalculateUseCaseImpl) calculate(
+	ctx context.Context,
+	userID entities.UserID,
+	kind entities.CalculationSnapshotKind,
+) (any, error) {
+	switch kind {
+	case entities.CalculationSnapshotKindProjection:
+		output, err := uc.projectionUseCase.CalculateComprehensiveProjection(ctx, ComprehensiveProjectionInput{
+			UserID: userID,
+			Years:  recalculationProjectionYears,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("財務予測の再計算に失敗しました: %w", err)
+		}
+		return output, nil
+	case entities.CalculationSnapshotKindReport:
+		output, err := uc.reportsUseCase.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{
+			UserID: userID,
+			Years:  recalculationProjectionYears,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("レポートの再生成に失敗しました: %w", err)
+		}
+		return output, nil
+	case entities.CalculationSnapshotKindAlert:
+		output, err := uc.recommendationsUseCase.GetRecommendations(ctx, GetRecommendationsInput{UserID: userID})
+		if err != nil {
+			return nil, fmt.Errorf("推奨事項の再生成に失敗しました: %w", err)
+		}
+		return output, nil
+	default:
+		return nil, errors.New("無効な計算結果の種別です")
+	}
+}
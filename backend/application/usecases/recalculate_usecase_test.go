@@ -0,0 +1,370 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// -------------------------------------------------------------------
+// インメモリリポジトリ・スタブ
+// -------------------------------------------------------------------
+
+// inMemoryPlanUserRepository は財務計画を持つユーザーIDだけを保持するインメモリリポジトリ
+type inMemoryPlanUserRepository struct {
+	repositories.FinancialPlanRepository
+	userIDs []entities.UserID
+}
+
+func newInMemoryPlanUserRepository(userIDs ...entities.UserID) *inMemoryPlanUserRepository {
+	sorted := append([]entities.UserID(nil), userIDs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return &inMemoryPlanUserRepository{userIDs: sorted}
+}
+
+func (r *inMemoryPlanUserRepository) FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	var result []entities.UserID
+	for _, id := range r.userIDs {
+		if id > afterUserID && len(result) < limit {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+func (r *inMemoryPlanUserRepository) ExistsByUserID(ctx context.Context, userID entities.UserID) (bool, error) {
+	for _, id := range r.userIDs {
+		if id == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// inMemoryCalculationSnapshotRepository は計算結果を保持するインメモリリポジトリ
+type inMemoryCalculationSnapshotRepository struct {
+	mu        sync.Mutex
+	snapshots map[string]*entities.CalculationSnapshot
+}
+
+func newInMemoryCalculationSnapshotRepository() *inMemoryCalculationSnapshotRepository {
+	return &inMemoryCalculationSnapshotRepository{snapshots: make(map[string]*entities.CalculationSnapshot)}
+}
+
+func (r *inMemoryCalculationSnapshotRepository) Save(ctx context.Context, snapshot *entities.CalculationSnapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.snapshots[string(snapshot.UserID())+"/"+string(snapshot.Kind())] = snapshot
+	return nil
+}
+
+func (r *inMemoryCalculationSnapshotRepository) FindByUserIDAndKind(
+	ctx context.Context,
+	userID entities.UserID,
+	kind entities.CalculationSnapshotKind,
+) (*entities.CalculationSnapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.snapshots[string(userID)+"/"+string(kind)], nil
+}
+
+func (r *inMemoryCalculationSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	for _, snapshot := range r.snapshots {
+		if snapshot.UserID() == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *inMemoryCalculationSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, snapshot := range r.snapshots {
+		if snapshot.UserID() == userID {
+			delete(r.snapshots, key)
+		}
+	}
+	return nil
+}
+
+// inMemoryRecalculationProgressRepository は再計算の進捗を保持するインメモリリポジトリ
+type inMemoryRecalculationProgressRepository struct {
+	mu        sync.Mutex
+	completed map[string][]entities.UserID
+}
+
+func newInMemoryRecalculationProgressRepository() *inMemoryRecalculationProgressRepository {
+	return &inMemoryRecalculationProgressRepository{completed: make(map[string][]entities.UserID)}
+}
+
+func (r *inMemoryRecalculationProgressRepository) MarkCompleted(ctx context.Context, runID string, userID entities.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.completed[runID] = append(r.completed[runID], userID)
+	return nil
+}
+
+func (r *inMemoryRecalculationProgressRepository) FindCompletedUserIDs(ctx context.Context, runID string) ([]entities.UserID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]entities.UserID(nil), r.completed[runID]...), nil
+}
+
+// recalculationCalls はユーザーごとのユースケース呼び出し回数と失敗させるユーザーを保持する
+type recalculationCalls struct {
+	mu      sync.Mutex
+	calls   map[entities.UserID]int
+	failing map[entities.UserID]bool
+}
+
+func newRecalculationCalls(failing ...entities.UserID) *recalculationCalls {
+	c := &recalculationCalls{calls: make(map[entities.UserID]int), failing: make(map[entities.UserID]bool)}
+	for _, id := range failing {
+		c.failing[id] = true
+	}
+	return c
+}
+
+func (c *recalculationCalls) record(userID entities.UserID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls[userID]++
+	if c.failing[userID] {
+		return errors.New("計算エラー")
+	}
+	return nil
+}
+
+func (c *recalculationCalls) setFailing(userID entities.UserID, failing bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failing[userID] = failing
+}
+
+func (c *recalculationCalls) count(userID entities.UserID) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls[userID]
+}
+
+type stubRecalculationProjectionUseCase struct {
+	CalculateProjectionUseCase
+	calls *recalculationCalls
+}
+
+func (s *stubRecalculationProjectionUseCase) CalculateComprehensiveProjection(ctx context.Context, input ComprehensiveProjectionInput) (*ComprehensiveProjectionOutput, error) {
+	if err := s.calls.record(input.UserID); err != nil {
+		return nil, err
+	}
+	return &ComprehensiveProjectionOutput{}, nil
+}
+
+type stubRecalculationReportsUseCase struct {
+	GenerateReportsUseCase
+	calls *recalculationCalls
+}
+
+func (s *stubRecalculationReportsUseCase) GenerateComprehensiveReport(ctx context.Context, input ComprehensiveReportInput) (*ComprehensiveReportOutput, error) {
+	if err := s.calls.record(input.UserID); err != nil {
+		return nil, err
+	}
+	return &ComprehensiveReportOutput{}, nil
+}
+
+type stubRecalculationRecommendationsUseCase struct {
+	ManageRecommendationsUseCase
+	calls *recalculationCalls
+}
+
+func (s *stubRecalculationRecommendationsUseCase) GetRecommendations(ctx context.Context, input GetRecommendationsInput) (*RecommendationsOutput, error) {
+	if err := s.calls.record(input.UserID); err != nil {
+		return nil, err
+	}
+	return &RecommendationsOutput{Recommendations: []entities.Recommendation{}}, nil
+}
+
+type recalculateTestEnv struct {
+	useCase     RecalculateUseCase
+	snapshots   *inMemoryCalculationSnapshotRepository
+	progress    *inMemoryRecalculationProgressRepository
+	projections *recalculationCalls
+	reports     *recalculationCalls
+	alerts      *recalculationCalls
+}
+
+func newRecalculateTestEnv(userIDs ...entities.UserID) *recalculateTestEnv {
+	env := &recalculateTestEnv{
+		snapshots:   newInMemoryCalculationSnapshotRepository(),
+		progress:    newInMemoryRecalculationProgressRepository(),
+		projections: newRecalculationCalls(),
+		reports:     newRecalculationCalls(),
+		alerts:      newRecalculationCalls(),
+	}
+	env.useCase = NewRecalculateUseCase(
+		newInMemoryPlanUserRepository(userIDs...),
+		env.snapshots,
+		env.progress,
+		&stubRecalculationProjectionUseCase{calls: env.projections},
+		&stubRecalculationReportsUseCase{calls: env.reports},
+		&stubRecalculationRecommendationsUseCase{calls: env.alerts},
+	)
+	return env
+}
+
+// -------------------------------------------------------------------
+// テスト
+// -------------------------------------------------------------------
+
+func TestParseRecalculationScope(t *testing.T) {
+	for _, value := range []string{"projections", "reports", "alerts", "all"} {
+		scope, err := ParseRecalculationScope(value)
+		require.NoError(t, err)
+		assert.Equal(t, RecalculationScope(value), scope)
+	}
+
+	_, err := ParseRecalculationScope("invalid")
+	assert.Error(t, err)
+}
+
+func TestRecalculateUseCase_Recalculate(t *testing.T) {
+	ctx := context.Background()
+	users := []entities.UserID{"user-1", "user-2", "user-3", "user-4", "user-5"}
+
+	t.Run("正常系: 全ユーザーをバッチ単位で再計算し計算バージョン付きで保存する", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+
+		output, err := env.useCase.Recalculate(ctx, RecalculateInput{
+			Scope:       RecalculationScopeAll,
+			BatchSize:   2,
+			Concurrency: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, output.Processed)
+		assert.Equal(t, 0, output.Failed)
+		assert.Equal(t, 0, output.Skipped)
+		assert.Equal(t, DefaultRecalculationRunID(RecalculationScopeAll), output.RunID)
+		assert.Equal(t, services.CalculationVersion, output.CalculationVersion)
+
+		for _, userID := range users {
+			for _, kind := range []entities.CalculationSnapshotKind{
+				entities.CalculationSnapshotKindProjection,
+				entities.CalculationSnapshotKindReport,
+				entities.CalculationSnapshotKindAlert,
+			} {
+				snapshot, err := env.snapshots.FindByUserIDAndKind(ctx, userID, kind)
+				require.NoError(t, err)
+				require.NotNil(t, snapshot, "%s/%s", userID, kind)
+				assert.Equal(t, services.CalculationVersion, snapshot.CalculationVersion())
+				assert.False(t, snapshot.IsStale(services.CalculationVersion))
+				assert.False(t, snapshot.RecalculatedAt().IsZero())
+			}
+		}
+	})
+
+	t.Run("正常系: 対象に含まれない種別は再計算しない", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+
+		output, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: RecalculationScopeAlerts})
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, output.Processed)
+		assert.Equal(t, 1, env.alerts.count("user-1"))
+		assert.Equal(t, 0, env.projections.count("user-1"))
+		assert.Equal(t, 0, env.reports.count("user-1"))
+	})
+
+	t.Run("異常系: 一部のユーザーの失敗では中断せず失敗として集計する", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+		env.reports.setFailing("user-2", true)
+		env.reports.setFailing("user-4", true)
+
+		output, err := env.useCase.Recalculate(ctx, RecalculateInput{
+			Scope:       RecalculationScopeAll,
+			BatchSize:   2,
+			Concurrency: 3,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, output.Processed)
+		assert.Equal(t, 2, output.Failed)
+		require.Len(t, output.Failures, 2)
+		assert.Equal(t, entities.UserID("user-2"), output.Failures[0].UserID)
+		assert.Equal(t, entities.UserID("user-4"), output.Failures[1].UserID)
+		assert.Contains(t, output.Failures[0].Error, "レポートの再生成に失敗しました")
+
+		// 失敗したユーザーは完了として記録しない
+		completed, _ := env.progress.FindCompletedUserIDs(ctx, output.RunID)
+		assert.ElementsMatch(t, []entities.UserID{"user-1", "user-3", "user-5"}, completed)
+		snapshot, _ := env.snapshots.FindByUserIDAndKind(ctx, "user-5", entities.CalculationSnapshotKindAlert)
+		assert.NotNil(t, snapshot)
+	})
+
+	t.Run("正常系: 同じ実行IDで再開すると完了済みのユーザーを読み飛ばし失敗したユーザーのみ再計算する", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+		env.projections.setFailing("user-3", true)
+
+		first, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: RecalculationScopeProjections, BatchSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 4, first.Processed)
+		assert.Equal(t, 1, first.Failed)
+
+		env.projections.setFailing("user-3", false)
+		second, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: RecalculationScopeProjections, BatchSize: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, first.RunID, second.RunID)
+		assert.Equal(t, 1, second.Processed)
+		assert.Equal(t, 0, second.Failed)
+		assert.Equal(t, 4, second.Skipped)
+		assert.Equal(t, 1, env.projections.count("user-1"))
+		assert.Equal(t, 2, env.projections.count("user-3"))
+
+		// 別の実行IDを指定すると最初から再計算する
+		third, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: RecalculationScopeProjections, RunID: "manual-rerun"})
+		require.NoError(t, err)
+		assert.Equal(t, 5, third.Processed)
+		assert.Equal(t, 0, third.Skipped)
+	})
+
+	t.Run("正常系: ユーザーを指定した場合はそのユーザーのみ再計算する", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+
+		output, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: RecalculationScopeAll, UserID: "user-2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, output.Processed)
+		assert.Equal(t, 1, env.projections.count("user-2"))
+		assert.Equal(t, 0, env.projections.count("user-1"))
+	})
+
+	t.Run("正常系: 財務データのないユーザーを指定した場合は読み飛ばす", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+
+		output, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: RecalculationScopeAll, UserID: "unknown"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, output.Processed)
+		assert.Equal(t, 1, output.Skipped)
+	})
+
+	t.Run("異常系: 無効な対象はエラー", func(t *testing.T) {
+		env := newRecalculateTestEnv(users...)
+
+		_, err := env.useCase.Recalculate(ctx, RecalculateInput{Scope: "invalid"})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,309 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ReportType はエクスポートするレポートの種類
+type ReportType string
+
+const (
+	ReportTypeFinancialSummary ReportType = "financial_summary" // 財務サマリー
+	ReportTypeAssetProjection  ReportType = "asset_projection"  // 資産推移
+	ReportTypeGoalsProgress    ReportType = "goals_progress"    // 目標進捗
+	ReportTypeRetirementPlan   ReportType = "retirement_plan"   // 退職計画
+	ReportTypeComprehensive    ReportType = "comprehensive"     // 包括的レポート
+)
+
+// ReportTypes はエクスポートできるレポートの種類（エラーレスポンスで案内する順）
+var ReportTypes = []ReportType{
+	ReportTypeFinancialSummary,
+	ReportTypeAssetProjection,
+	ReportTypeGoalsProgress,
+	ReportTypeRetirementPlan,
+	ReportTypeComprehensive,
+}
+
+// IsValid はReportTypeが有効かどうかを確認する
+func (t ReportType) IsValid() bool {
+	for _, reportType := range ReportTypes {
+		if t == reportType {
+			return true
+		}
+	}
+	return false
+}
+
+// String はReportTypeの文字列表現を返す
+func (t ReportType) String() string {
+	switch t {
+	case ReportTypeFinancialSummary:
+		return "財務サマリーレポート"
+	case ReportTypeAssetProjection:
+		return "資産推移レポート"
+	case ReportTypeGoalsProgress:
+		return "目標進捗レポート"
+	case ReportTypeRetirementPlan:
+		return "退職計画レポート"
+	case ReportTypeComprehensive:
+		return "包括的レポート"
+	default:
+		return "不明"
+	}
+}
+
+// ExportFormat はレポートをエクスポートするファイル形式
+type ExportFormat string
+
+const (
+	ExportFormatPDF ExportFormat = "pdf" // PDF（HTML形式で出力する）
+	ExportFormatCSV ExportFormat = "csv" // BOM付きUTF-8のCSV
+)
+
+// ExportFormats はエクスポートできるファイル形式（エラーレスポンスで案内する順）
+var ExportFormats = []ExportFormat{
+	ExportFormatPDF,
+	ExportFormatCSV,
+}
+
+// IsValid はExportFormatが有効かどうかを確認する
+func (f ExportFormat) IsValid() bool {
+	for _, format := range ExportFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// String はExportFormatの文字列表現を返す
+func (f ExportFormat) String() string {
+	switch f {
+	case ExportFormatPDF:
+		return "PDF"
+	case ExportFormatCSV:
+		return "CSV"
+	default:
+		return "不明"
+	}
+}
+
+var (
+	// ErrInvalidReportType は存在しないレポートタイプが指定された場合のエラー
+	ErrInvalidReportType = errors.New("レポートタイプが無効です")
+	// ErrInvalidExportFormat は存在しないファイル形式が指定された場合のエラー
+	ErrInvalidExportFormat = errors.New("ファイル形式が無効です")
+	// ErrUnsupportedReportExport はレポートタイプを指定したファイル形式でエクスポートできない場合のエラー
+	ErrUnsupportedReportExport = errors.New("指定したレポートタイプはこのファイル形式でエクスポートできません")
+)
+
+// ParseReportType は文字列をReportTypeに変換する。存在しない場合は許可される値を含む ErrInvalidReportType を返す
+func ParseReportType(value string) (ReportType, error) {
+	reportType := ReportType(value)
+	if !reportType.IsValid() {
+		return "", fmt.Errorf("%w: %q（使用可能: %s）", ErrInvalidReportType, value, joinValues(ReportTypes))
+	}
+	return reportType, nil
+}
+
+// ParseExportFormat は文字列をExportFormatに変換する。存在しない場合は許可される値を含む ErrInvalidExportFormat を返す
+func ParseExportFormat(value string) (ExportFormat, error) {
+	format := ExportFormat(value)
+	if !format.IsValid() {
+		return "", fmt.Errorf("%w: %q（使用可能: %s）", ErrInvalidExportFormat, value, joinValues(ExportFormats))
+	}
+	return format, nil
+}
+
+// UnsupportedReportExportError はレポートタイプを指定したファイル形式でエクスポートできない場合のエラー
+type UnsupportedReportExportError struct {
+	ReportType ReportType
+	Format     ExportFormat
+	Supported  []ReportType // Format でエクスポートできるレポートタイプ
+}
+
+func (e *UnsupportedReportExportError) Error() string {
+	return fmt.Sprintf("%s は %s 形式でエクスポートできません（%s 形式で使用可能: %s）",
+		string(e.ReportType), string(e.Format), string(e.Format), joinValues(e.Supported))
+}
+
+// Is は errors.Is(err, ErrUnsupportedReportExport) で判定できるようにする
+func (e *UnsupportedReportExportError) Is(target error) bool {
+	return target == ErrUnsupportedReportExport
+}
+
+// ReportGenerator はエクスポートするレポートを生成し、レンダラーに渡すレポートデータを返す
+type ReportGenerator func(ctx context.Context, input ExportReportInput) (interface{}, error)
+
+// ReportRenderer はレポートデータを1つのファイル形式に変換する
+type ReportRenderer struct {
+	// Extension はファイル名の拡張子（"." を含まない）
+	Extension string
+	// ReportTypes はこの形式でエクスポートできるレポートタイプ
+	ReportTypes []ReportType
+	// Render はレポートデータをファイルの内容に変換する
+	Render func(reportType ReportType, reportData interface{}) ([]byte, error)
+}
+
+// ReportExportRegistry はレポートタイプごとの生成処理とファイル形式ごとの変換処理の対応表
+// 新しいファイル形式はレンダラーを登録するだけで追加でき、コントローラーの変更は不要
+type ReportExportRegistry struct {
+	generators map[ReportType]ReportGenerator
+	renderers  map[ExportFormat]ReportRenderer
+}
+
+// NewReportExportRegistry は生成処理と変換処理の対応表を作成する
+// すべてのレポートタイプに生成処理が、すべてのファイル形式に変換処理が登録されていない場合や、
+// 変換処理が未登録・存在しないレポートタイプを対象にしている場合はエラーを返す（起動時に設定の誤りを検出するため）
+func NewReportExportRegistry(
+	generators map[ReportType]ReportGenerator,
+	renderers map[ExportFormat]ReportRenderer,
+) (*ReportExportRegistry, error) {
+	var problems []string
+	for _, reportType := range ReportTypes {
+		if generators[reportType] == nil {
+			problems = append(problems, fmt.Sprintf("%s の生成処理が登録されていません", string(reportType)))
+		}
+	}
+	for reportType := range generators {
+		if !reportType.IsValid() {
+			problems = append(problems, fmt.Sprintf("存在しないレポートタイプ %q の生成処理が登録されています", string(reportType)))
+		}
+	}
+	for _, format := range ExportFormats {
+		renderer, ok := renderers[format]
+		if !ok || renderer.Render == nil {
+			problems = append(problems, fmt.Sprintf("%s の変換処理が登録されていません", string(format)))
+			continue
+		}
+		if renderer.Extension == "" {
+			problems = append(problems, fmt.Sprintf("%s の拡張子が設定されていません", string(format)))
+		}
+		if len(renderer.ReportTypes) == 0 {
+			problems = append(problems, fmt.Sprintf("%s でエクスポートできるレポートタイプがありません", string(format)))
+		}
+		for _, reportType := range renderer.ReportTypes {
+			if generators[reportType] == nil {
+				problems = append(problems, fmt.Sprintf("%s の対象 %q の生成処理が登録されていません", string(format), string(reportType)))
+			}
+		}
+	}
+	for format := range renderers {
+		if !format.IsValid() {
+			problems = append(problems, fmt.Sprintf("存在しないファイル形式 %q の変換処理が登録されています", string(format)))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("レポートのエクスポート設定が不正です: %s", strings.Join(problems, "; "))
+	}
+
+	return &ReportExportRegistry{generators: generators, renderers: renderers}, nil
+}
+
+// Lookup はレポートタイプとファイル形式の組み合わせに対応する生成処理と変換処理を返す
+func (r *ReportExportRegistry) Lookup(reportType ReportType, format ExportFormat) (ReportGenerator, ReportRenderer, error) {
+	if !reportType.IsValid() {
+		_, err := ParseReportType(string(reportType))
+		return nil, ReportRenderer{}, err
+	}
+	if !format.IsValid() {
+		_, err := ParseExportFormat(string(format))
+		return nil, ReportRenderer{}, err
+	}
+
+	renderer := r.renderers[format]
+	for _, supported := range renderer.ReportTypes {
+		if supported == reportType {
+			return r.generators[reportType], renderer, nil
+		}
+	}
+	return nil, ReportRenderer{}, &UnsupportedReportExportError{
+		ReportType: reportType,
+		Format:     format,
+		Supported:  append([]ReportType(nil), renderer.ReportTypes...),
+	}
+}
+
+// newReportExportRegistry はこのユースケースのレポート生成処理と変換処理を登録した対応表を作成する
+// 登録内容はコードで固定されているため、不整合はユースケースの作成時（サーバー起動時）に panic で知らせる
+func (uc *generateReportsUseCaseImpl) newReportExportRegistry() *ReportExportRegistry {
+	generators := map[ReportType]ReportGenerator{
+		ReportTypeFinancialSummary: func(ctx context.Context, input ExportReportInput) (interface{}, error) {
+			output, err := uc.GenerateFinancialSummaryReport(ctx, FinancialSummaryReportInput{UserID: input.UserID})
+			if err != nil {
+				return nil, err
+			}
+			return output.Report, nil
+		},
+		ReportTypeAssetProjection: func(ctx context.Context, input ExportReportInput) (interface{}, error) {
+			output, err := uc.GenerateAssetProjectionReport(ctx, AssetProjectionReportInput{UserID: input.UserID, Years: input.years()})
+			if err != nil {
+				return nil, err
+			}
+			return output.Report, nil
+		},
+		ReportTypeGoalsProgress: func(ctx context.Context, input ExportReportInput) (interface{}, error) {
+			output, err := uc.GenerateGoalsProgressReport(ctx, GoalsProgressReportInput{UserID: input.UserID})
+			if err != nil {
+				return nil, err
+			}
+			return output.Report, nil
+		},
+		ReportTypeRetirementPlan: func(ctx context.Context, input ExportReportInput) (interface{}, error) {
+			output, err := uc.GenerateRetirementPlanReport(ctx, RetirementPlanReportInput{UserID: input.UserID})
+			if err != nil {
+				return nil, err
+			}
+			return output.Report, nil
+		},
+		ReportTypeComprehensive: func(ctx context.Context, input ExportReportInput) (interface{}, error) {
+			output, err := uc.GenerateComprehensiveReport(ctx, ComprehensiveReportInput{UserID: input.UserID, Years: input.years()})
+			if err != nil {
+				return nil, err
+			}
+			return output.Report, nil
+		},
+	}
+
+	renderers := map[ExportFormat]ReportRenderer{
+		ExportFormatPDF: {
+			Extension:   "pdf",
+			ReportTypes: ReportTypes,
+			Render: func(reportType ReportType, reportData interface{}) ([]byte, error) {
+				if uc.pdfGenerator == nil {
+					return nil, errors.New("PDFジェネレーターが設定されていません")
+				}
+				return uc.pdfGenerator.Generate(reportType, reportData)
+			},
+		},
+		ExportFormatCSV: {
+			Extension:   "csv",
+			ReportTypes: []ReportType{ReportTypeFinancialSummary},
+			Render: func(reportType ReportType, reportData interface{}) ([]byte, error) {
+				report, ok := reportData.(FinancialSummaryReport)
+				if !ok {
+					return nil, fmt.Errorf("無効なレポートデータ型です（%s）", reportType)
+				}
+				return GenerateFinancialSummaryCSVData(report)
+			},
+		},
+	}
+
+	registry, err := NewReportExportRegistry(generators, renderers)
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
+
+// joinValues は許可される値をカンマ区切りで連結する
+func joinValues[T ~string](values []T) string {
+	names := make([]string, len(values))
+	for i, value := range values {
+		names[i] = string(value)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,230 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportType_IsValid(t *testing.T) {
+	for _, reportType := range ReportTypes {
+		assert.True(t, reportType.IsValid(), reportType)
+		assert.NotEqual(t, "不明", reportType.String())
+	}
+	assert.False(t, ReportType("comprehansive").IsValid())
+	assert.False(t, ReportType("").IsValid())
+}
+
+func TestExportFormat_IsValid(t *testing.T) {
+	for _, format := range ExportFormats {
+		assert.True(t, format.IsValid(), format)
+		assert.NotEqual(t, "不明", format.String())
+	}
+	assert.False(t, ExportFormat("excel").IsValid())
+	assert.False(t, ExportFormat("PDF").IsValid())
+}
+
+func TestParseReportType(t *testing.T) {
+	t.Run("正常系: 有効なレポートタイプを変換できる", func(t *testing.T) {
+		reportType, err := ParseReportType("goals_progress")
+
+		require.NoError(t, err)
+		assert.Equal(t, ReportTypeGoalsProgress, reportType)
+	})
+
+	t.Run("異常系: 綴りを誤ったレポートタイプは許可される値とともにエラーになる", func(t *testing.T) {
+		_, err := ParseReportType("comprehansive")
+
+		require.ErrorIs(t, err, ErrInvalidReportType)
+		assert.Contains(t, err.Error(), `"comprehansive"`)
+		for _, reportType := range ReportTypes {
+			assert.Contains(t, err.Error(), string(reportType))
+		}
+	})
+}
+
+func TestParseExportFormat(t *testing.T) {
+	t.Run("正常系: 有効なファイル形式を変換できる", func(t *testing.T) {
+		format, err := ParseExportFormat("csv")
+
+		require.NoError(t, err)
+		assert.Equal(t, ExportFormatCSV, format)
+	})
+
+	t.Run("異常系: 変換処理のないファイル形式は許可される値とともにエラーになる", func(t *testing.T) {
+		_, err := ParseExportFormat("excel")
+
+		require.ErrorIs(t, err, ErrInvalidExportFormat)
+		assert.Contains(t, err.Error(), "pdf, csv")
+	})
+}
+
+// newTestReportGenerators はすべてのレポートタイプに空のレポートを返す生成処理を登録したテスト用の対応表を作成するヘルパー
+func newTestReportGenerators() map[ReportType]ReportGenerator {
+	generators := make(map[ReportType]ReportGenerator, len(ReportTypes))
+	for _, reportType := range ReportTypes {
+		generators[reportType] = func(ctx context.Context, input ExportReportInput) (interface{}, error) {
+			return struct{}{}, nil
+		}
+	}
+	return generators
+}
+
+// newTestReportRenderers はすべてのファイル形式に変換処理を登録したテスト用の対応表を作成するヘルパー
+func newTestReportRenderers() map[ExportFormat]ReportRenderer {
+	render := func(reportType ReportType, reportData interface{}) ([]byte, error) {
+		return []byte(reportType), nil
+	}
+	return map[ExportFormat]ReportRenderer{
+		ExportFormatPDF: {Extension: "pdf", ReportTypes: ReportTypes, Render: render},
+		ExportFormatCSV: {Extension: "csv", ReportTypes: []ReportType{ReportTypeFinancialSummary}, Render: render},
+	}
+}
+
+func TestNewReportExportRegistry(t *testing.T) {
+	t.Run("正常系: すべてのレポートタイプとファイル形式が登録されていれば作成できる", func(t *testing.T) {
+		registry, err := NewReportExportRegistry(newTestReportGenerators(), newTestReportRenderers())
+
+		require.NoError(t, err)
+		_, renderer, err := registry.Lookup(ReportTypeRetirementPlan, ExportFormatPDF)
+		require.NoError(t, err)
+		assert.Equal(t, "pdf", renderer.Extension)
+	})
+
+	t.Run("異常系: 生成処理が未登録のレポートタイプがあると作成時にエラーになる", func(t *testing.T) {
+		generators := newTestReportGenerators()
+		delete(generators, ReportTypeGoalsProgress)
+
+		_, err := NewReportExportRegistry(generators, newTestReportRenderers())
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "goals_progress")
+	})
+
+	t.Run("異常系: 変換処理が未登録のファイル形式があると作成時にエラーになる", func(t *testing.T) {
+		renderers := newTestReportRenderers()
+		delete(renderers, ExportFormatCSV)
+
+		_, err := NewReportExportRegistry(newTestReportGenerators(), renderers)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "csv の変換処理が登録されていません")
+	})
+
+	t.Run("異常系: 存在しないレポートタイプ・ファイル形式を登録すると作成時にエラーになる", func(t *testing.T) {
+		generators := newTestReportGenerators()
+		generators["comprehansive"] = generators[ReportTypeComprehensive]
+		renderers := newTestReportRenderers()
+		renderers["excel"] = renderers[ExportFormatPDF]
+		csv := renderers[ExportFormatCSV]
+		csv.ReportTypes = append(csv.ReportTypes, "summary")
+		renderers[ExportFormatCSV] = csv
+
+		_, err := NewReportExportRegistry(generators, renderers)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"comprehansive"`)
+		assert.Contains(t, err.Error(), `"excel"`)
+		assert.Contains(t, err.Error(), `"summary"`)
+	})
+
+	t.Run("異常系: 変換処理の対象外の組み合わせは対象のレポートタイプとともにエラーになる", func(t *testing.T) {
+		registry, err := NewReportExportRegistry(newTestReportGenerators(), newTestReportRenderers())
+		require.NoError(t, err)
+
+		_, _, err = registry.Lookup(ReportTypeComprehensive, ExportFormatCSV)
+
+		require.ErrorIs(t, err, ErrUnsupportedReportExport)
+		var unsupported *UnsupportedReportExportError
+		require.True(t, errors.As(err, &unsupported))
+		assert.Equal(t, []ReportType{ReportTypeFinancialSummary}, unsupported.Supported)
+	})
+}
+
+func TestGenerateReportsUseCase_ExportReportCombinations(t *testing.T) {
+	ctx := context.Background()
+	calcService := services.NewFinancialCalculationService()
+	recService := services.NewGoalRecommendationService(calcService)
+
+	newUseCase := func(t *testing.T, saved *string) GenerateReportsUseCase {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).
+			Return(newTestFinancialPlanWithRetirementData("user-001"), nil)
+		pdfGen := &mockReportPDFGenerator{}
+		fileStorage := &mockTemporaryFileStoragePort{
+			saveFileFunc: func(fileName string, data []byte) (string, time.Time, error) {
+				*saved = fileName
+				return "token", time.Now().Add(time.Hour), nil
+			},
+		}
+		return NewGenerateReportsUseCaseWithPDF(mockPlanRepo, new(MockGoalRepository), calcService, recService, pdfGen, fileStorage, nil, nil, nil, nil, nil, nil)
+	}
+
+	supported := map[ExportFormat][]ReportType{
+		ExportFormatPDF: ReportTypes,
+		ExportFormatCSV: {ReportTypeFinancialSummary},
+	}
+	for _, format := range ExportFormats {
+		for _, reportType := range ReportTypes {
+			isSupported := false
+			for _, s := range supported[format] {
+				if s == reportType {
+					isSupported = true
+				}
+			}
+
+			if isSupported {
+				t.Run(fmt.Sprintf("正常系: %sを%s形式でエクスポートできる", reportType, format), func(t *testing.T) {
+					var fileName string
+					uc := newUseCase(t, &fileName)
+
+					output, err := uc.ExportReportToPDF(ctx, ExportReportInput{UserID: "user-001", ReportType: reportType, Format: format})
+
+					require.NoError(t, err)
+					assert.Equal(t, "token", output.DownloadToken)
+					assert.True(t, strings.HasPrefix(fileName, "user-001_"+string(reportType)+"_"), fileName)
+					assert.True(t, strings.HasSuffix(fileName, "."+string(format)), fileName)
+				})
+				continue
+			}
+
+			t.Run(fmt.Sprintf("異常系: %sは%s形式ではエクスポートできない", reportType, format), func(t *testing.T) {
+				var fileName string
+				uc := newUseCase(t, &fileName)
+
+				_, err := uc.ExportReportToPDF(ctx, ExportReportInput{UserID: "user-001", ReportType: reportType, Format: format})
+
+				require.ErrorIs(t, err, ErrUnsupportedReportExport)
+				assert.Empty(t, fileName)
+			})
+		}
+	}
+
+	t.Run("異常系: 綴りを誤ったレポートタイプはエクスポートせずエラーになる", func(t *testing.T) {
+		var fileName string
+		uc := newUseCase(t, &fileName)
+
+		_, err := uc.ExportReportToPDF(ctx, ExportReportInput{UserID: "user-001", ReportType: "comprehansive", Format: ExportFormatPDF})
+
+		require.ErrorIs(t, err, ErrInvalidReportType)
+		assert.Empty(t, fileName)
+	})
+
+	t.Run("異常系: 変換処理のないファイル形式はエクスポートせずエラーになる", func(t *testing.T) {
+		var fileName string
+		uc := newUseCase(t, &fileName)
+
+		_, err := uc.ExportReportToPDF(ctx, ExportReportInput{UserID: "user-001", ReportType: ReportTypeFinancialSummary, Format: "excel"})
+
+		require.ErrorIs(t, err, ErrInvalidExportFormat)
+		assert.Empty(t, fileName)
+	})
+}
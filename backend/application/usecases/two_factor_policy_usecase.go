@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// ErrTwoFactorRequiredByPolicy は必須化ポリシーの対象ユーザーが2段階認証を無効化しようとした場合のエラー
+var ErrTwoFactorRequiredByPolicy = errors.New("2段階認証の必須化ポリシーの対象のため無効化できません")
+
+// TwoFactorPolicyUseCase は2段階認証の必須化ポリシーのユースケース
+type TwoFactorPolicyUseCase interface {
+	// GetRequirement はユーザーに2段階認証が必須かどうかと有効化の期限を返す
+	GetRequirement(ctx context.Context, userID entities.UserID) (*TwoFactorRequirementOutput, error)
+}
+
+// TwoFactorRequirementOutput は2段階認証の必須化状況
+type TwoFactorRequirementOutput struct {
+	Required bool                                `json:"required"`
+	Reason   entities.TwoFactorRequirementReason `json:"reason,omitempty"`
+	Enabled  bool                                `json:"enabled"`
+	// Deadline は有効化の期限（必須化されてから猶予期間が経過する日時。必須でない場合は nil）
+	Deadline *time.Time `json:"deadline,omitempty"`
+	// Enforced は期限を過ぎても未設定のため、保護ルートの利用を制限するか
+	Enforced bool `json:"enforced"`
+}
+
+// twoFactorPolicyUseCase はTwoFactorPolicyUseCaseの実装
+type twoFactorPolicyUseCase struct {
+	userRepo          repositories.UserRepository
+	financialPlanRepo repositories.FinancialPlanRepository
+	policy            entities.TwoFactorPolicy
+	now               func() time.Time
+}
+
+// NewTwoFactorPolicyUseCase は新しいTwoFactorPolicyUseCaseを作成する
+func NewTwoFactorPolicyUseCase(
+	userRepo repositories.UserRepository,
+	financialPlanRepo repositories.FinancialPlanRepository,
+	policy entities.TwoFactorPolicy,
+) TwoFactorPolicyUseCase {
+	return &twoFactorPolicyUseCase{
+		userRepo:          userRepo,
+		financialPlanRepo: financialPlanRepo,
+		policy:            policy,
+		now:               time.Now,
+	}
+}
+
+// GetRequirement はユーザーに2段階認証が必須かどうかと有効化の期限を返す
+// 初めて必須と判定した日時を猶予期間の起点として保存し、必須でなくなった場合は起点を解除する
+func (uc *twoFactorPolicyUseCase) GetRequirement(ctx context.Context, userID entities.UserID) (*TwoFactorRequirementOutput, error) {
+	logger := log.ForUseCase(ctx, "GetTwoFactorRequirement").With(log.UserID(string(userID)))
+
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("ユーザーが見つかりません: %w", err)
+	}
+
+	var totalAssets float64
+	if uc.policy.DependsOnAssets() {
+		totalAssets, err = uc.totalAssets(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := uc.now()
+	reason, required := uc.policy.RequirementFor(totalAssets)
+	changed := false
+	if required {
+		changed = user.MarkTwoFactorRequired(now)
+	} else {
+		changed = user.ClearTwoFactorRequirement()
+	}
+	if changed {
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			logger.ErrorContext(ctx, "2段階認証の必須化状況の保存に失敗しました", "error", err)
+			return nil, fmt.Errorf("ユーザーの更新に失敗しました: %w", err)
+		}
+		if required {
+			logger.InfoContext(ctx, "2段階認証の必須化ポリシーを適用しました", "reason", string(reason))
+		}
+	}
+
+	output := &TwoFactorRequirementOutput{
+		Required: required,
+		Reason:   reason,
+		Enabled:  user.TwoFactorEnabled(),
+	}
+	if required {
+		deadline := uc.policy.DeadlineFrom(*user.TwoFactorRequiredSince())
+		output.Deadline = &deadline
+		output.Enforced = !user.TwoFactorEnabled() && !now.Before(deadline)
+	}
+	return output, nil
+}
+
+// totalAssets はユーザーの現在の貯蓄合計を返す（財務データが未登録の場合は0）
+func (uc *twoFactorPolicyUseCase) totalAssets(ctx context.Context, userID entities.UserID) (float64, error) {
+	exists, err := uc.financialPlanRepo.ExistsByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("財務計画の確認に失敗しました: %w", err)
+	}
+	if !exists {
+		return 0, nil
+	}
+
+	plan, err := uc.financialPlanRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+	}
+	total, err := plan.Profile().CurrentSavings().Total()
+	if err != nil {
+		return 0, fmt.Errorf("総資産の計算に失敗しました: %w", err)
+	}
+	return total.Amount(), nil
+}
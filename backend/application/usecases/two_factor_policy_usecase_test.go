@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTwoFactorPolicyUseCase は現在時刻を固定したTwoFactorPolicyUseCaseを作成するヘルパー
+func newTestTwoFactorPolicyUseCase(
+	t *testing.T,
+	userRepo *MockUserRepository,
+	planRepo *MockFinancialPlanRepository,
+	requiredForAll bool,
+	assetThreshold float64,
+	now time.Time,
+) TwoFactorPolicyUseCase {
+	t.Helper()
+	policy, err := entities.NewTwoFactorPolicy(requiredForAll, assetThreshold, 14)
+	require.NoError(t, err)
+	uc := NewTwoFactorPolicyUseCase(userRepo, planRepo, policy).(*twoFactorPolicyUseCase)
+	uc.now = func() time.Time { return now }
+	return uc
+}
+
+// newTestPolicyUser はテスト用のローカルユーザーを作成するヘルパー
+func newTestPolicyUser(t *testing.T, twoFactorEnabled bool) *entities.User {
+	t.Helper()
+	user, err := entities.NewUser(testAuthUserID, "policy@example.com", "password123")
+	require.NoError(t, err)
+	if twoFactorEnabled {
+		require.NoError(t, user.EnableTwoFactor("JBSWY3DPEHPK3PXP", []string{"hashed-code"}))
+	}
+	return user
+}
+
+func TestTwoFactorPolicyUseCase_GetRequirement(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("正常系: 総資産が閾値を超えると猶予期間の起点を保存し期限を返す", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		user := newTestPolicyUser(t, false)
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
+		mockUserRepo.On("Update", mock_anything(), user).Return(nil)
+		mockPlanRepo.On("ExistsByUserID", mock_anything(), entities.UserID(testAuthUserID)).Return(true, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID(testAuthUserID)).Return(newTestFinancialPlan(testAuthUserID), nil)
+
+		uc := newTestTwoFactorPolicyUseCase(t, mockUserRepo, mockPlanRepo, false, 500000, now)
+		output, err := uc.GetRequirement(ctx, testAuthUserID)
+
+		require.NoError(t, err)
+		assert.True(t, output.Required)
+		assert.Equal(t, entities.TwoFactorReasonHighAssets, output.Reason)
+		require.NotNil(t, output.Deadline)
+		assert.Equal(t, now.AddDate(0, 0, 14), *output.Deadline)
+		assert.False(t, output.Enforced)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 猶予期間を過ぎても未設定の場合は制限する", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		user := newTestPolicyUser(t, false)
+		user.MarkTwoFactorRequired(now.AddDate(0, 0, -14))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
+
+		uc := newTestTwoFactorPolicyUseCase(t, mockUserRepo, mockPlanRepo, true, 0, now)
+		output, err := uc.GetRequirement(ctx, testAuthUserID)
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.TwoFactorReasonOrganizationPolicy, output.Reason)
+		assert.True(t, output.Enforced)
+		// 組織ポリシーでは総資産を参照せず、起点も変わらないため保存しない
+		mockPlanRepo.AssertNotCalled(t, "ExistsByUserID", mock_anything(), mock_anything())
+		mockUserRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+
+	t.Run("正常系: 2段階認証を設定済みの場合は期限を過ぎても制限しない", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		user := newTestPolicyUser(t, true)
+		user.MarkTwoFactorRequired(now.AddDate(0, 0, -30))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
+
+		uc := newTestTwoFactorPolicyUseCase(t, mockUserRepo, new(MockFinancialPlanRepository), true, 0, now)
+		output, err := uc.GetRequirement(ctx, testAuthUserID)
+
+		require.NoError(t, err)
+		assert.True(t, output.Required)
+		assert.True(t, output.Enabled)
+		assert.False(t, output.Enforced)
+	})
+
+	t.Run("正常系: 総資産が閾値以下になると必須化を解除する", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		user := newTestPolicyUser(t, false)
+		user.MarkTwoFactorRequired(now.AddDate(0, 0, -30))
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
+		mockUserRepo.On("Update", mock_anything(), user).Return(nil)
+		mockPlanRepo.On("ExistsByUserID", mock_anything(), entities.UserID(testAuthUserID)).Return(false, nil)
+
+		uc := newTestTwoFactorPolicyUseCase(t, mockUserRepo, mockPlanRepo, false, 500000, now)
+		output, err := uc.GetRequirement(ctx, testAuthUserID)
+
+		require.NoError(t, err)
+		assert.False(t, output.Required)
+		assert.Nil(t, output.Deadline)
+		assert.Nil(t, user.TwoFactorRequiredSince())
+		mockUserRepo.AssertExpectations(t)
+	})
+}
+
+func TestAuthUseCase_Disable2FA_TwoFactorPolicy(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("異常系: 必須化ポリシーの対象ユーザーは無効化できない", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		user := newTestPolicyUser(t, true)
+		user.MarkTwoFactorRequired(now)
+		mockUserRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(user, nil)
+
+		policyUseCase := newTestTwoFactorPolicyUseCase(t, mockUserRepo, new(MockFinancialPlanRepository), true, 0, now)
+		uc := NewAuthUseCaseWithTwoFactorPolicy(
+			mockUserRepo,
+			new(MockRefreshTokenRepository),
+			new(MockPasswordResetTokenRepository),
+			new(MockEmailService),
+			testJWTSecret,
+			testJWTExpiration,
+			testRefreshTokenExpiration,
+			policyUseCase,
+		)
+		err := uc.Disable2FA(ctx, Disable2FAInput{UserID: testAuthUserID, Password: "password123"})
+
+		require.ErrorIs(t, err, ErrTwoFactorRequiredByPolicy)
+		assert.True(t, user.TwoFactorEnabled())
+		mockUserRepo.AssertNotCalled(t, "Update", mock_anything(), mock_anything())
+	})
+}
@@ -0,0 +1,636 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// UserDataBackupSchemaVersion は現在のバックアップJSONのスキーマバージョン
+// バックアップの形式を変更する場合は値を上げ、旧バージョンからの移行処理を userDataBackupMigrations に追加する
+const UserDataBackupSchemaVersion = 1
+
+var (
+	// ErrUserDataOverwriteNotConfirmed は既存の財務データがあり、上書きの確認がないためインポートできない場合のエラー
+	ErrUserDataOverwriteNotConfirmed = errors.New("既存の財務データがあります。上書きする場合は確認が必要です")
+
+	// ErrUnsupportedBackupSchemaVersion はバックアップのスキーマバージョンに対応していない場合のエラー
+	ErrUnsupportedBackupSchemaVersion = errors.New("サポートされていないバックアップのスキーマバージョンです")
+)
+
+// userDataBackupMigration はあるスキーマバージョンのバックアップJSONを次のバージョンの形式に変換する
+type userDataBackupMigration func(backup map[string]json.RawMessage) error
+
+// userDataBackupMigrations はスキーマバージョンごとの移行処理
+// キーは移行元のバージョンで、変換後のバージョンはキー+1となる
+var userDataBackupMigrations = map[int]userDataBackupMigration{}
+
+// UserDataBackupUseCase は財務データのバックアップ（JSONエクスポート・インポート）のユースケース
+// 認証情報（パスワード・2FA・トークンなど）はバックアップに含めない
+type UserDataBackupUseCase interface {
+	// ExportUserData は財務計画・目標・退職設定・緊急資金を含むバックアップを作成する
+	ExportUserData(ctx context.Context, userID entities.UserID) (*UserDataBackup, error)
+
+	// ImportUserData はバックアップJSONから財務データを復元する
+	// 既存の財務データがある場合、overwrite が false なら ErrUserDataOverwriteNotConfirmed を返す
+	ImportUserData(ctx context.Context, userID entities.UserID, data []byte, overwrite bool) (*ImportUserDataOutput, error)
+}
+
+// UserDataBackup はユーザーの財務データのバックアップ
+type UserDataBackup struct {
+	SchemaVersion int                  `json:"schema_version"`
+	ExportedAt    time.Time            `json:"exported_at"`
+	Profile       BackupProfile        `json:"profile"`
+	Retirement    *BackupRetirement    `json:"retirement,omitempty"`
+	EmergencyFund *BackupEmergencyFund `json:"emergency_fund,omitempty"`
+	Goals         []BackupGoal         `json:"goals"`
+}
+
+// BackupProfile は財務プロファイルのバックアップ
+type BackupProfile struct {
+	MonthlyIncome            float64       `json:"monthly_income"`
+	IncomeType               string        `json:"income_type"`
+	BirthDate                *string       `json:"birth_date,omitempty"`
+	ExpectedVolatility       *float64      `json:"expected_volatility,omitempty"`
+	RiskTolerance            string        `json:"risk_tolerance,omitempty"`
+	MonthlyExpenses          []ExpenseItem `json:"monthly_expenses"`
+	CurrentSavings           []SavingsItem `json:"current_savings"`
+	InvestmentReturn         float64       `json:"investment_return"`
+	InflationRate            float64       `json:"inflation_rate"`
+	AcknowledgeHighReturn    bool          `json:"acknowledge_high_return"`
+	AcknowledgeHighInflation bool          `json:"acknowledge_high_inflation"`
+}
+
+// BackupRetirement は退職設定のバックアップ
+type BackupRetirement struct {
+	CurrentAge                int     `json:"current_age"` // 生年月日が未設定の場合に使用する
+	RetirementAge             int     `json:"retirement_age"`
+	LifeExpectancy            int     `json:"life_expectancy"`
+	MonthlyRetirementExpenses float64 `json:"monthly_retirement_expenses"`
+	PensionAmount             float64 `json:"pension_amount"`
+	KokuminNenkinMonthly      float64 `json:"kokumin_nenkin_monthly_amount,omitempty"`
+	NurseCareInsuranceMonthly float64 `json:"nurse_care_insurance_monthly,omitempty"`
+}
+
+// BackupEmergencyFund は緊急資金のバックアップ
+type BackupEmergencyFund struct {
+	TargetMonths  int                           `json:"target_months"`
+	CurrentFund   float64                       `json:"current_fund"`
+	Contributions []BackupEmergencyContribution `json:"contributions"`
+}
+
+// BackupEmergencyContribution は緊急資金の積立履歴1件のバックアップ
+type BackupEmergencyContribution struct {
+	Amount        float64   `json:"amount"`
+	ContributedAt time.Time `json:"contributed_at"`
+}
+
+// BackupGoal は目標のバックアップ
+// ID は前提となる目標の参照にのみ使用し、インポート時は新しいIDを採番する
+type BackupGoal struct {
+	ID                  string    `json:"id"`
+	GoalType            string    `json:"goal_type"`
+	Title               string    `json:"title"`
+	TargetAmount        float64   `json:"target_amount"`
+	TargetDate          time.Time `json:"target_date"`
+	CurrentAmount       float64   `json:"current_amount"`
+	MonthlyContribution float64   `json:"monthly_contribution"`
+	ContributionMin     *float64  `json:"contribution_min,omitempty"`
+	ContributionStretch *float64  `json:"contribution_stretch,omitempty"`
+	IsActive            bool      `json:"is_active"`
+	DependsOn           []string  `json:"depends_on,omitempty"`
+	Notes               string    `json:"notes,omitempty"`
+	ImageURL            string    `json:"image_url,omitempty"`
+	OverduePolicy       string    `json:"overdue_policy,omitempty"`
+	CreatedAt           time.Time `json:"created_at"`
+}
+
+// ImportUserDataOutput はバックアップのインポート結果
+type ImportUserDataOutput struct {
+	UserID        entities.UserID `json:"user_id"`
+	SchemaVersion int             `json:"schema_version"` // インポートしたバックアップの元のスキーマバージョン
+	Migrated      bool            `json:"migrated"`       // 現在のスキーマバージョンへの移行を行ったか
+	Overwritten   bool            `json:"overwritten"`    // 既存の財務データを上書きしたか
+	Goals         int             `json:"goals"`
+}
+
+// userDataBackupUseCaseImpl はUserDataBackupUseCaseの実装
+type userDataBackupUseCaseImpl struct {
+	unitOfWork repositories.UnitOfWork
+	taxService *services.TaxEstimationService
+	logger     *log.UseCaseLogger
+}
+
+// NewUserDataBackupUseCase は新しいUserDataBackupUseCaseを作成する
+func NewUserDataBackupUseCase(unitOfWork repositories.UnitOfWork) UserDataBackupUseCase {
+	return &userDataBackupUseCaseImpl{
+		unitOfWork: unitOfWork,
+		taxService: services.NewDefaultTaxEstimationService(),
+		logger:     log.NewUseCaseLogger("UserDataBackupUseCase"),
+	}
+}
+
+// ExportUserData は財務計画・目標・退職設定・緊急資金を含むバックアップを作成する
+func (uc *userDataBackupUseCaseImpl) ExportUserData(ctx context.Context, userID entities.UserID) (*UserDataBackup, error) {
+	var backup *UserDataBackup
+	// 財務計画と目標の整合性を保つため、取得は1つのトランザクション内で行う
+	err := uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		plan, err := repos.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("財務データの取得に失敗しました: %w", err)
+		}
+
+		goals, err := repos.Goals.FindByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("目標の取得に失敗しました: %w", err)
+		}
+
+		backup = newUserDataBackup(plan, goals)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// ImportUserData はバックアップJSONから財務データを復元する
+func (uc *userDataBackupUseCaseImpl) ImportUserData(
+	ctx context.Context,
+	userID entities.UserID,
+	data []byte,
+	overwrite bool,
+) (*ImportUserDataOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "ImportUserData",
+		log.UserID(string(userID)),
+		slog.Bool("overwrite", overwrite),
+	)
+
+	backup, sourceVersion, err := parseUserDataBackup(data, userDataBackupMigrations, UserDataBackupSchemaVersion)
+	if err != nil {
+		uc.logger.OperationError(ctx, "ImportUserData", err,
+			slog.String("step", "parse_backup"),
+		)
+		return nil, fmt.Errorf("バックアップの解析に失敗しました: %w", err)
+	}
+
+	plan, goals, err := uc.restoreUserData(userID, backup)
+	if err != nil {
+		uc.logger.OperationError(ctx, "ImportUserData", err,
+			slog.String("step", "restore_backup"),
+		)
+		return nil, fmt.Errorf("バックアップの復元に失敗しました: %w", err)
+	}
+
+	overwritten := false
+	err = uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		planExists, err := repos.FinancialPlans.ExistsByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("財務計画の確認に失敗しました: %w", err)
+		}
+		existingGoals, err := repos.Goals.FindByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("目標の取得に失敗しました: %w", err)
+		}
+
+		if planExists || len(existingGoals) > 0 {
+			if !overwrite {
+				return ErrUserDataOverwriteNotConfirmed
+			}
+
+			for _, goal := range existingGoals {
+				if err := repos.Goals.Delete(ctx, goal.ID()); err != nil {
+					return fmt.Errorf("目標の削除に失敗しました: %w", err)
+				}
+			}
+			if planExists {
+				existingPlan, err := repos.FinancialPlans.FindByUserID(ctx, userID)
+				if err != nil {
+					return fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+				}
+				if err := repos.FinancialPlans.Delete(ctx, existingPlan.ID()); err != nil {
+					return fmt.Errorf("財務計画の削除に失敗しました: %w", err)
+				}
+			}
+			overwritten = true
+		}
+
+		if err := repos.FinancialPlans.Save(ctx, plan); err != nil {
+			return fmt.Errorf("財務計画の保存に失敗しました: %w", err)
+		}
+		for _, goal := range goals {
+			if err := repos.Goals.Save(ctx, goal); err != nil {
+				return fmt.Errorf("目標の保存に失敗しました: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.OperationError(ctx, "ImportUserData", err,
+			slog.String("step", "save_backup"),
+		)
+		return nil, err
+	}
+
+	uc.logger.EndOperation(ctx, "ImportUserData",
+		slog.Int("schema_version", sourceVersion),
+		slog.Bool("overwritten", overwritten),
+		slog.Int("goals", len(goals)),
+	)
+
+	return &ImportUserDataOutput{
+		UserID:        userID,
+		SchemaVersion: sourceVersion,
+		Migrated:      sourceVersion != UserDataBackupSchemaVersion,
+		Overwritten:   overwritten,
+		Goals:         len(goals),
+	}, nil
+}
+
+// newUserDataBackup は財務計画と目標からバックアップを作成する
+func newUserDataBackup(plan *aggregates.FinancialPlan, goals []*entities.Goal) *UserDataBackup {
+	profile := plan.Profile()
+
+	expenses := make([]ExpenseItem, 0, len(profile.MonthlyExpenses()))
+	for _, expense := range profile.MonthlyExpenses() {
+		description := expense.Description
+		expenses = append(expenses, ExpenseItem{Category: expense.Category, Amount: expense.Amount.Amount(), Description: &description})
+	}
+	savings := make([]SavingsItem, 0, len(profile.CurrentSavings()))
+	for _, saving := range profile.CurrentSavings() {
+		description := saving.Description
+		savings = append(savings, SavingsItem{Type: saving.Type, Amount: saving.Amount.Amount(), Description: &description})
+	}
+
+	var birthDate *string
+	if date := profile.BirthDate(); date != nil {
+		formatted := date.Format(birthDateLayout)
+		birthDate = &formatted
+	}
+
+	var expectedVolatility *float64
+	if volatility, ok := profile.ExpectedVolatility(); ok {
+		percentage := volatility.AsPercentage()
+		expectedVolatility = &percentage
+	}
+
+	backup := &UserDataBackup{
+		SchemaVersion: UserDataBackupSchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Profile: BackupProfile{
+			MonthlyIncome:            profile.MonthlyIncome().Amount(),
+			IncomeType:               string(profile.IncomeType()),
+			BirthDate:                birthDate,
+			ExpectedVolatility:       expectedVolatility,
+			RiskTolerance:            string(profile.RiskTolerance()),
+			MonthlyExpenses:          expenses,
+			CurrentSavings:           savings,
+			InvestmentReturn:         profile.InvestmentReturn().AsPercentage(),
+			InflationRate:            profile.InflationRate().AsPercentage(),
+			AcknowledgeHighReturn:    profile.HighReturnAcknowledged(),
+			AcknowledgeHighInflation: profile.HighInflationAcknowledged(),
+		},
+		Goals: make([]BackupGoal, 0, len(goals)),
+	}
+
+	if retirement := plan.RetirementData(); retirement != nil {
+		backup.Retirement = &BackupRetirement{
+			CurrentAge:                retirement.CurrentAge(),
+			RetirementAge:             retirement.RetirementAge(),
+			LifeExpectancy:            retirement.LifeExpectancy(),
+			MonthlyRetirementExpenses: retirement.MonthlyRetirementExpenses().Amount(),
+			PensionAmount:             retirement.PensionAmount().Amount(),
+			KokuminNenkinMonthly:      retirement.KokuminNenkinMonthlyAmount().Amount(),
+			NurseCareInsuranceMonthly: retirement.NurseCareInsuranceMonthly().Amount(),
+		}
+	}
+
+	if fund := plan.EmergencyFund(); fund != nil {
+		contributions := make([]BackupEmergencyContribution, 0, len(fund.Contributions()))
+		for _, contribution := range fund.Contributions() {
+			contributions = append(contributions, BackupEmergencyContribution{
+				Amount:        contribution.Amount.Amount(),
+				ContributedAt: contribution.ContributedAt,
+			})
+		}
+		backup.EmergencyFund = &BackupEmergencyFund{
+			TargetMonths:  fund.TargetMonths(),
+			CurrentFund:   fund.CurrentFund().Amount(),
+			Contributions: contributions,
+		}
+	}
+
+	for _, goal := range goals {
+		dependsOn := make([]string, 0, len(goal.DependsOn()))
+		for _, id := range goal.DependsOn() {
+			dependsOn = append(dependsOn, string(id))
+		}
+		backup.Goals = append(backup.Goals, BackupGoal{
+			ID:                  string(goal.ID()),
+			GoalType:            string(goal.GoalType()),
+			Title:               goal.Title(),
+			TargetAmount:        goal.TargetAmount().Amount(),
+			TargetDate:          goal.TargetDate(),
+			CurrentAmount:       goal.CurrentAmount().Amount(),
+			MonthlyContribution: goal.MonthlyContribution().Amount(),
+			ContributionMin:     optionalAmount(goal.ContributionMin()),
+			ContributionStretch: optionalAmount(goal.ContributionStretch()),
+			IsActive:            goal.IsActive(),
+			DependsOn:           dependsOn,
+			Notes:               goal.Notes(),
+			ImageURL:            goal.ImageURL(),
+			OverduePolicy:       string(goal.OverduePolicy()),
+			CreatedAt:           goal.CreatedAt(),
+		})
+	}
+
+	return backup
+}
+
+// parseUserDataBackup はバックアップJSONを現在のスキーマバージョンに移行してから解析する
+// 移行前のスキーマバージョンもあわせて返す
+func parseUserDataBackup(
+	data []byte,
+	migrations map[int]userDataBackupMigration,
+	currentVersion int,
+) (*UserDataBackup, int, error) {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, 0, fmt.Errorf("JSONの形式が正しくありません: %w", err)
+	}
+
+	var sourceVersion int
+	versionJSON, ok := raw["schema_version"]
+	if !ok {
+		return nil, 0, errors.New("スキーマバージョンが含まれていません")
+	}
+	if err := json.Unmarshal(versionJSON, &sourceVersion); err != nil {
+		return nil, 0, fmt.Errorf("スキーマバージョンの形式が正しくありません: %w", err)
+	}
+	if sourceVersion < 1 || sourceVersion > currentVersion {
+		return nil, 0, fmt.Errorf("%w: %d", ErrUnsupportedBackupSchemaVersion, sourceVersion)
+	}
+
+	for version := sourceVersion; version < currentVersion; version++ {
+		migrate, ok := migrations[version]
+		if !ok {
+			return nil, 0, fmt.Errorf("%w: バージョン%dからの移行処理がありません", ErrUnsupportedBackupSchemaVersion, version)
+		}
+		if err := migrate(raw); err != nil {
+			return nil, 0, fmt.Errorf("バージョン%dからの移行に失敗しました: %w", version, err)
+		}
+	}
+	raw["schema_version"] = json.RawMessage(strconv.Itoa(currentVersion))
+
+	migrated, err := json.Marshal(raw)
+	if err != nil {
+		return nil, 0, fmt.Errorf("移行後のバックアップの作成に失敗しました: %w", err)
+	}
+
+	var backup UserDataBackup
+	if err := json.Unmarshal(migrated, &backup); err != nil {
+		return nil, 0, fmt.Errorf("バックアップの形式が正しくありません: %w", err)
+	}
+
+	return &backup, sourceVersion, nil
+}
+
+// restoreUserData はバックアップから財務計画と目標を復元する
+// 目標には新しいIDを採番し、前提となる目標の参照も新しいIDに置き換える
+func (uc *userDataBackupUseCaseImpl) restoreUserData(
+	userID entities.UserID,
+	backup *UserDataBackup,
+) (*aggregates.FinancialPlan, []*entities.Goal, error) {
+	profile, err := uc.restoreProfile(userID, backup.Profile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("財務計画の作成に失敗しました: %w", err)
+	}
+
+	if r := backup.Retirement; r != nil {
+		monthlyExpenses, err := valueobjects.NewMoneyJPY(r.MonthlyRetirementExpenses)
+		if err != nil {
+			return nil, nil, fmt.Errorf("退職後の月間支出の作成に失敗しました: %w", err)
+		}
+		pension, err := valueobjects.NewMoneyJPY(r.PensionAmount)
+		if err != nil {
+			return nil, nil, fmt.Errorf("年金額の作成に失敗しました: %w", err)
+		}
+		retirement, err := entities.NewRetirementData(userID, r.CurrentAge, r.RetirementAge, r.LifeExpectancy, monthlyExpenses, pension)
+		if err != nil {
+			return nil, nil, fmt.Errorf("退職データの作成に失敗しました: %w", err)
+		}
+		kokuminNenkin, err := valueobjects.NewMoneyJPY(r.KokuminNenkinMonthly)
+		if err != nil {
+			return nil, nil, fmt.Errorf("国民年金の月額の作成に失敗しました: %w", err)
+		}
+		nurseCareInsurance, err := valueobjects.NewMoneyJPY(r.NurseCareInsuranceMonthly)
+		if err != nil {
+			return nil, nil, fmt.Errorf("介護保険料の月額の作成に失敗しました: %w", err)
+		}
+		if err := retirement.RestorePublicBenefits(kokuminNenkin, nurseCareInsurance); err != nil {
+			return nil, nil, fmt.Errorf("退職データの作成に失敗しました: %w", err)
+		}
+		if err := plan.SetRetirementData(retirement); err != nil {
+			return nil, nil, fmt.Errorf("退職データの設定に失敗しました: %w", err)
+		}
+	}
+
+	if f := backup.EmergencyFund; f != nil {
+		currentFund, err := valueobjects.NewMoneyJPY(f.CurrentFund)
+		if err != nil {
+			return nil, nil, fmt.Errorf("緊急資金の現在額の作成に失敗しました: %w", err)
+		}
+		contributions := make([]aggregates.EmergencyFundContribution, 0, len(f.Contributions))
+		for _, c := range f.Contributions {
+			amount, err := valueobjects.NewMoneyJPY(c.Amount)
+			if err != nil {
+				return nil, nil, fmt.Errorf("緊急資金の積立額の作成に失敗しました: %w", err)
+			}
+			contributions = append(contributions, aggregates.EmergencyFundContribution{Amount: amount, ContributedAt: c.ContributedAt})
+		}
+		fund, err := aggregates.NewEmergencyFundWithContributions(f.TargetMonths, currentFund, contributions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("緊急資金の作成に失敗しました: %w", err)
+		}
+		if err := plan.UpdateEmergencyFund(fund); err != nil {
+			return nil, nil, fmt.Errorf("緊急資金の設定に失敗しました: %w", err)
+		}
+	}
+
+	goals, err := restoreGoals(userID, backup.Goals)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return plan, goals, nil
+}
+
+// restoreProfile はバックアップから財務プロファイルを復元する
+func (uc *userDataBackupUseCaseImpl) restoreProfile(userID entities.UserID, p BackupProfile) (*entities.FinancialProfile, error) {
+	monthlyIncome, err := valueobjects.NewMoneyJPY(p.MonthlyIncome)
+	if err != nil {
+		return nil, fmt.Errorf("月収の作成に失敗しました: %w", err)
+	}
+
+	var expenses entities.ExpenseCollection
+	for _, expense := range p.MonthlyExpenses {
+		amount, err := valueobjects.NewMoneyJPY(expense.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("支出額の作成に失敗しました: %w", err)
+		}
+		item := entities.ExpenseItem{Category: expense.Category, Amount: amount}
+		if expense.Description != nil {
+			item.Description = *expense.Description
+		}
+		expenses = append(expenses, item)
+	}
+
+	var savings entities.SavingsCollection
+	for _, saving := range p.CurrentSavings {
+		amount, err := valueobjects.NewMoneyJPY(saving.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("貯蓄額の作成に失敗しました: %w", err)
+		}
+		item := entities.SavingsItem{Type: saving.Type, Amount: amount}
+		if saving.Description != nil {
+			item.Description = *saving.Description
+		}
+		savings = append(savings, item)
+	}
+
+	investmentReturn, err := valueobjects.NewRate(p.InvestmentReturn)
+	if err != nil {
+		return nil, fmt.Errorf("投資利回りの作成に失敗しました: %w", err)
+	}
+	inflationRate, err := valueobjects.NewRate(p.InflationRate)
+	if err != nil {
+		return nil, fmt.Errorf("インフレ率の作成に失敗しました: %w", err)
+	}
+
+	profile, err := entities.NewFinancialProfile(userID, monthlyIncome, expenses, savings, investmentReturn, inflationRate)
+	if err != nil {
+		return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
+	}
+
+	incomeType, err := entities.ParseIncomeType(p.IncomeType)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.taxService.ApplyIncomeType(profile, incomeType); err != nil {
+		return nil, err
+	}
+	if err := applyBirthDate(profile, p.BirthDate); err != nil {
+		return nil, err
+	}
+	if err := applyRiskSettings(profile, p.ExpectedVolatility, p.RiskTolerance); err != nil {
+		return nil, err
+	}
+	// エクスポート時点で同意済みの高い想定値は、同意を引き継いで復元する
+	profile.AcknowledgeHighAssumptions(p.AcknowledgeHighReturn, p.AcknowledgeHighInflation)
+
+	return profile, nil
+}
+
+// restoreGoals はバックアップから目標を復元する
+// 期限を過ぎた目標もそのまま復元できるよう、目標日の検証を行わない復元用のコンストラクタを使用する
+func restoreGoals(userID entities.UserID, backupGoals []BackupGoal) ([]*entities.Goal, error) {
+	now := time.Now()
+	newIDs := make(map[string]entities.GoalID, len(backupGoals))
+	for _, g := range backupGoals {
+		newIDs[g.ID] = entities.NewGoalID()
+	}
+
+	goals := make([]*entities.Goal, 0, len(backupGoals))
+	for _, g := range backupGoals {
+		targetAmount, err := valueobjects.NewMoneyJPY(g.TargetAmount)
+		if err != nil {
+			return nil, fmt.Errorf("目標金額の作成に失敗しました: %w", err)
+		}
+		monthlyContribution, err := valueobjects.NewMoneyJPY(g.MonthlyContribution)
+		if err != nil {
+			return nil, fmt.Errorf("月間拠出額の作成に失敗しました: %w", err)
+		}
+		currentAmount, err := valueobjects.NewMoneyJPY(g.CurrentAmount)
+		if err != nil {
+			return nil, fmt.Errorf("現在の金額の作成に失敗しました: %w", err)
+		}
+
+		createdAt := g.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = now
+		}
+
+		goal, err := entities.NewGoalWithID(
+			newIDs[g.ID],
+			userID,
+			entities.GoalType(g.GoalType),
+			g.Title,
+			targetAmount,
+			g.TargetDate,
+			monthlyContribution,
+			createdAt,
+			now,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("目標「%s」の復元に失敗しました: %w", g.Title, err)
+		}
+		if err := goal.UpdateCurrentAmount(currentAmount); err != nil {
+			return nil, fmt.Errorf("目標「%s」の現在の金額の設定に失敗しました: %w", g.Title, err)
+		}
+		if g.ContributionMin != nil || g.ContributionStretch != nil {
+			minimum, stretch, err := resolveContributionRange(goal, g.ContributionMin, g.ContributionStretch)
+			if err != nil {
+				return nil, fmt.Errorf("目標「%s」の月間拠出額の幅の設定に失敗しました: %w", g.Title, err)
+			}
+			if err := goal.UpdateContributionPlan(minimum, monthlyContribution, stretch); err != nil {
+				return nil, fmt.Errorf("目標「%s」の月間拠出額の幅の設定に失敗しました: %w", g.Title, err)
+			}
+		}
+		if !g.IsActive {
+			goal.Deactivate()
+		}
+		if err := goal.UpdateNotes(g.Notes); err != nil {
+			return nil, fmt.Errorf("目標「%s」のメモの設定に失敗しました: %w", g.Title, err)
+		}
+		if g.ImageURL != "" {
+			goal.UpdateImageURL(g.ImageURL)
+		}
+		if g.OverduePolicy != "" {
+			if err := goal.UpdateOverduePolicy(entities.GoalOverduePolicy(g.OverduePolicy)); err != nil {
+				return nil, fmt.Errorf("目標「%s」の期限超過時ポリシーの設定に失敗しました: %w", g.Title, err)
+			}
+		}
+
+		dependsOn := make([]entities.GoalID, 0, len(g.DependsOn))
+		for _, prerequisiteID := range g.DependsOn {
+			newID, ok := newIDs[prerequisiteID]
+			if !ok {
+				return nil, fmt.Errorf("目標「%s」の前提となる目標がバックアップに含まれていません: %s", g.Title, prerequisiteID)
+			}
+			dependsOn = append(dependsOn, newID)
+		}
+		goal.RestoreDependencies(dependsOn)
+
+		goals = append(goals, goal)
+	}
+
+	return goals, nil
+}
@@ -0,0 +1,213 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestBackupPlan は退職データ・緊急資金・目標を含むバックアップ用の財務計画を作成する
+func newTestBackupPlan(t *testing.T, userID entities.UserID) (*aggregates.FinancialPlan, []*entities.Goal) {
+	t.Helper()
+	plan := newTestFinancialPlanWithRetirementData(userID)
+	birthDate := time.Date(1985, 4, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, plan.Profile().SetBirthDate(&birthDate))
+	require.NoError(t, plan.AddEmergencyFundContribution(mustNewMoney(50000), time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)))
+
+	house := newTestGoal(userID, "")
+	car := newTestGoal(userID, "")
+	require.NoError(t, car.SetDependencies([]*entities.Goal{house}, []*entities.Goal{house, car}))
+	require.NoError(t, car.UpdateCurrentAmount(mustNewMoney(120000)))
+	return plan, []*entities.Goal{house, car}
+}
+
+func TestUserDataBackupUseCase_ExportUserData(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 財務計画・目標・退職設定・緊急資金をエクスポートできる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		plan, goals := newTestBackupPlan(t, "user-001")
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(goals, nil)
+
+		uc := NewUserDataBackupUseCase(newStubUnitOfWork(mockPlanRepo, mockGoalRepo))
+		backup, err := uc.ExportUserData(ctx, "user-001")
+
+		require.NoError(t, err)
+		assert.Equal(t, UserDataBackupSchemaVersion, backup.SchemaVersion)
+		assert.Equal(t, 400000.0, backup.Profile.MonthlyIncome)
+		require.NotNil(t, backup.Profile.BirthDate)
+		assert.Equal(t, "1985-04-01", *backup.Profile.BirthDate)
+		require.NotNil(t, backup.Retirement)
+		assert.Equal(t, 65, backup.Retirement.RetirementAge)
+		require.NotNil(t, backup.EmergencyFund)
+		assert.Len(t, backup.EmergencyFund.Contributions, 1)
+		require.Len(t, backup.Goals, 2)
+		assert.Equal(t, []string{string(goals[0].ID())}, backup.Goals[1].DependsOn)
+
+		// 認証情報はバックアップに含めない
+		data, err := json.Marshal(backup)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "password")
+		assert.NotContains(t, string(data), "token")
+	})
+
+	t.Run("異常系: 財務データが存在しない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-999")).Return(nil, errors.New("not found"))
+
+		uc := NewUserDataBackupUseCase(newStubUnitOfWork(mockPlanRepo, mockGoalRepo))
+		_, err := uc.ExportUserData(ctx, "user-999")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "財務データの取得に失敗しました")
+	})
+}
+
+func TestUserDataBackupUseCase_ImportUserData(t *testing.T) {
+	ctx := context.Background()
+
+	exportBackup := func(t *testing.T) []byte {
+		t.Helper()
+		plan, goals := newTestBackupPlan(t, "user-001")
+		data, err := json.Marshal(newUserDataBackup(plan, goals))
+		require.NoError(t, err)
+		return data
+	}
+
+	t.Run("正常系: 既存データがない場合はバックアップを復元できる", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-002")).Return(false, nil)
+		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-002")).Return([]*entities.Goal{}, nil)
+
+		var savedPlan *aggregates.FinancialPlan
+		mockPlanRepo.On("Save", mock_anything(), mock.AnythingOfType("*aggregates.FinancialPlan")).
+			Run(func(args mock.Arguments) { savedPlan = args.Get(1).(*aggregates.FinancialPlan) }).
+			Return(nil)
+		var savedGoals []*entities.Goal
+		mockGoalRepo.On("Save", mock_anything(), mock.AnythingOfType("*entities.Goal")).
+			Run(func(args mock.Arguments) { savedGoals = append(savedGoals, args.Get(1).(*entities.Goal)) }).
+			Return(nil)
+
+		uc := NewUserDataBackupUseCase(newStubUnitOfWork(mockPlanRepo, mockGoalRepo))
+		output, err := uc.ImportUserData(ctx, "user-002", exportBackup(t), false)
+
+		require.NoError(t, err)
+		assert.False(t, output.Overwritten)
+		assert.False(t, output.Migrated)
+		assert.Equal(t, 2, output.Goals)
+
+		require.NotNil(t, savedPlan)
+		assert.Equal(t, entities.UserID("user-002"), savedPlan.Profile().UserID())
+		require.NotNil(t, savedPlan.Profile().BirthDate())
+		require.NotNil(t, savedPlan.RetirementData())
+		assert.Equal(t, 85, savedPlan.RetirementData().LifeExpectancy())
+		assert.Len(t, savedPlan.EmergencyFund().Contributions(), 1)
+
+		// 目標は新しいIDで復元し、前提となる目標の参照も新しいIDに置き換える
+		require.Len(t, savedGoals, 2)
+		assert.Equal(t, entities.UserID("user-002"), savedGoals[1].UserID())
+		assert.Equal(t, []entities.GoalID{savedGoals[0].ID()}, savedGoals[1].DependsOn())
+		assert.Equal(t, 120000.0, savedGoals[1].CurrentAmount().Amount())
+		mockPlanRepo.AssertExpectations(t)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 既存データがあり上書きの確認がない場合はエラー", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		mockPlanRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(true, nil)
+		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{}, nil)
+
+		unitOfWork := newStubUnitOfWork(mockPlanRepo, mockGoalRepo)
+		uc := NewUserDataBackupUseCase(unitOfWork)
+		_, err := uc.ImportUserData(ctx, "user-001", exportBackup(t), false)
+
+		require.ErrorIs(t, err, ErrUserDataOverwriteNotConfirmed)
+		assert.True(t, unitOfWork.rolledBack)
+		mockPlanRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: 上書きを確認した場合は既存データを置き換える", func(t *testing.T) {
+		mockPlanRepo := new(MockFinancialPlanRepository)
+		mockGoalRepo := new(MockGoalRepository)
+		existingPlan := newTestFinancialPlan("user-001")
+		existingGoal := newTestGoal("user-001", "")
+		mockPlanRepo.On("ExistsByUserID", mock_anything(), entities.UserID("user-001")).Return(true, nil)
+		mockPlanRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(existingPlan, nil)
+		mockPlanRepo.On("Delete", mock_anything(), existingPlan.ID()).Return(nil)
+		mockPlanRepo.On("Save", mock_anything(), mock.AnythingOfType("*aggregates.FinancialPlan")).Return(nil)
+		mockGoalRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return([]*entities.Goal{existingGoal}, nil)
+		mockGoalRepo.On("Delete", mock_anything(), existingGoal.ID()).Return(nil)
+		mockGoalRepo.On("Save", mock_anything(), mock.AnythingOfType("*entities.Goal")).Return(nil)
+
+		uc := NewUserDataBackupUseCase(newStubUnitOfWork(mockPlanRepo, mockGoalRepo))
+		output, err := uc.ImportUserData(ctx, "user-001", exportBackup(t), true)
+
+		require.NoError(t, err)
+		assert.True(t, output.Overwritten)
+		mockPlanRepo.AssertExpectations(t)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 現在より新しいスキーマバージョンはインポートできない", func(t *testing.T) {
+		uc := NewUserDataBackupUseCase(newStubUnitOfWork(new(MockFinancialPlanRepository), new(MockGoalRepository)))
+		_, err := uc.ImportUserData(ctx, "user-001", []byte(`{"schema_version": 99, "profile": {}}`), true)
+
+		require.ErrorIs(t, err, ErrUnsupportedBackupSchemaVersion)
+	})
+
+	t.Run("異常系: スキーマバージョンがない場合はエラー", func(t *testing.T) {
+		uc := NewUserDataBackupUseCase(newStubUnitOfWork(new(MockFinancialPlanRepository), new(MockGoalRepository)))
+		_, err := uc.ImportUserData(ctx, "user-001", []byte(`{"profile": {}}`), true)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "スキーマバージョンが含まれていません")
+	})
+}
+
+func TestParseUserDataBackup_Migration(t *testing.T) {
+	// バージョン1では月収を "income" として保存していた想定の移行処理
+	migrations := map[int]userDataBackupMigration{
+		1: func(backup map[string]json.RawMessage) error {
+			var profile map[string]json.RawMessage
+			if err := json.Unmarshal(backup["profile"], &profile); err != nil {
+				return err
+			}
+			profile["monthly_income"] = profile["income"]
+			delete(profile, "income")
+			migrated, err := json.Marshal(profile)
+			if err != nil {
+				return err
+			}
+			backup["profile"] = migrated
+			return nil
+		},
+	}
+
+	t.Run("正常系: 旧バージョンのバックアップを現在の形式に移行できる", func(t *testing.T) {
+		backup, sourceVersion, err := parseUserDataBackup([]byte(`{"schema_version": 1, "profile": {"income": 300000}}`), migrations, 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, sourceVersion)
+		assert.Equal(t, 2, backup.SchemaVersion)
+		assert.Equal(t, 300000.0, backup.Profile.MonthlyIncome)
+	})
+
+	t.Run("異常系: 移行処理がないバージョンはエラー", func(t *testing.T) {
+		_, _, err := parseUserDataBackup([]byte(`{"schema_version": 1, "profile": {}}`), migrations, 3)
+
+		require.ErrorIs(t, err, ErrUnsupportedBackupSchemaVersion)
+	})
+}
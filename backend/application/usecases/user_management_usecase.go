@@ -0,0 +1,275 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// UserManagementUseCase はアカウント削除（GDPRの消去権）に関するユースケース
+type UserManagementUseCase interface {
+	// PreviewAccountDeletion はアカウント削除で消去されるデータの件数を取得する
+	PreviewAccountDeletion(ctx context.Context, input PreviewAccountDeletionInput) (*DeletionPreviewOutput, error)
+
+	// DeleteAccount はアカウントと関連データをすべて削除する
+	DeleteAccount(ctx context.Context, input DeleteAccountInput) (*DeleteAccountOutput, error)
+}
+
+// PreviewAccountDeletionInput はアカウント削除プレビューの入力
+type PreviewAccountDeletionInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// DeletionPreviewOutput はアカウント削除で消去されるデータの件数
+type DeletionPreviewOutput struct {
+	UserID         entities.UserID `json:"user_id"`
+	FinancialPlans int             `json:"financial_plans"`
+	Goals          int             `json:"goals"`
+	// GoalProgressEntries は目標の進捗履歴（進捗の記録と月間拠出額の変更履歴）の件数
+	GoalProgressEntries int `json:"goal_progress_entries"`
+	// MonthlySnapshots は貯蓄率目標の月次実績（収入・支出）の件数
+	MonthlySnapshots int `json:"monthly_snapshots"`
+	// LoginAttempts はログイン試行記録の件数
+	// ログイン試行はIP単位のレート制限ストアにのみ一時保存され、ユーザーに紐づかないため常に0となる
+	LoginAttempts       int `json:"login_attempts"`
+	RefreshTokens       int `json:"refresh_tokens"`
+	PasswordResetTokens int `json:"password_reset_tokens"`
+	// APIKeys は発行したAPIキーの件数（失効済みを含む）
+	APIKeys                  int `json:"api_keys"`
+	NotificationPreferences  int `json:"notification_preferences"`
+	RecommendationDismissals int `json:"recommendation_dismissals"`
+	// HealthScoreSnapshots は財務健全性スコアの履歴の件数
+	HealthScoreSnapshots int `json:"health_score_snapshots"`
+	// CalculationSnapshots は保存済みの計算結果の件数
+	CalculationSnapshots int `json:"calculation_snapshots"`
+	// PeerBenchmarkConsents は同年代比較の匿名集計への参加記録の件数（0 または 1）
+	PeerBenchmarkConsents int `json:"peer_benchmark_consents"`
+	// GoalSharedWithOthers は他のメンバーと共有している目標のタイトル
+	// 現在は目標の共有機能がないため、常に空となる
+	GoalSharedWithOthers []string `json:"goal_shared_with_others"`
+}
+
+// DeleteAccountInput はアカウント削除の入力
+type DeleteAccountInput struct {
+	UserID entities.UserID `json:"user_id"`
+}
+
+// DeleteAccountOutput はアカウント削除の出力
+type DeleteAccountOutput struct {
+	UserID  entities.UserID        `json:"user_id"`
+	Deleted *DeletionPreviewOutput `json:"deleted"`
+}
+
+// userManagementUseCaseImpl はUserManagementUseCaseの実装
+type userManagementUseCaseImpl struct {
+	unitOfWork repositories.UnitOfWork
+	logger     *log.UseCaseLogger
+}
+
+// NewUserManagementUseCase は新しいUserManagementUseCaseを作成する
+func NewUserManagementUseCase(unitOfWork repositories.UnitOfWork) UserManagementUseCase {
+	return &userManagementUseCaseImpl{
+		unitOfWork: unitOfWork,
+		logger:     log.NewUseCaseLogger("UserManagementUseCase"),
+	}
+}
+
+// PreviewAccountDeletion はアカウント削除で消去されるデータの件数を取得する
+func (uc *userManagementUseCaseImpl) PreviewAccountDeletion(
+	ctx context.Context,
+	input PreviewAccountDeletionInput,
+) (*DeletionPreviewOutput, error) {
+	var preview *DeletionPreviewOutput
+	// 件数の整合性を保つため、集計は1つのトランザクション内で行う
+	err := uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		var err error
+		preview, _, err = uc.collectDeletionPreview(ctx, repos, input.UserID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// DeleteAccount はアカウントと関連データをすべて削除する
+// 削除直前のプレビューと同じトランザクションで削除し、削除した件数を返す
+func (uc *userManagementUseCaseImpl) DeleteAccount(
+	ctx context.Context,
+	input DeleteAccountInput,
+) (*DeleteAccountOutput, error) {
+	ctx = uc.logger.StartOperation(ctx, "DeleteAccount",
+		log.UserID(string(input.UserID)),
+	)
+
+	var preview *DeletionPreviewOutput
+	err := uc.unitOfWork.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		var (
+			goals []*entities.Goal
+			err   error
+		)
+		preview, goals, err = uc.collectDeletionPreview(ctx, repos, input.UserID)
+		if err != nil {
+			return err
+		}
+
+		// 目標の進捗履歴は目標の削除でも消えるが、財務計画から削除済みの目標の履歴も残さないようユーザー単位で削除する
+		if err := repos.GoalProgressSnapshots.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("目標の進捗履歴の削除に失敗しました: %w", err)
+		}
+		if err := repos.GoalContributionHistory.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("月間拠出額の変更履歴の削除に失敗しました: %w", err)
+		}
+
+		for _, goal := range goals {
+			if err := repos.Goals.Delete(ctx, goal.ID()); err != nil {
+				return fmt.Errorf("目標の削除に失敗しました: %w", err)
+			}
+		}
+
+		if preview.FinancialPlans > 0 {
+			plan, err := repos.FinancialPlans.FindByUserID(ctx, input.UserID)
+			if err != nil {
+				return fmt.Errorf("財務計画の取得に失敗しました: %w", err)
+			}
+			if err := repos.FinancialPlans.Delete(ctx, plan.ID()); err != nil {
+				return fmt.Errorf("財務計画の削除に失敗しました: %w", err)
+			}
+		}
+
+		if err := repos.SavingsRateTargets.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("貯蓄率目標の削除に失敗しました: %w", err)
+		}
+		if err := repos.RefreshTokens.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("リフレッシュトークンの削除に失敗しました: %w", err)
+		}
+		if err := repos.PasswordResetTokens.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("パスワードリセットトークンの削除に失敗しました: %w", err)
+		}
+		// APIキーはユーザーの削除後も認証に使えてしまうため、ユーザーと同じトランザクションで削除する
+		if err := repos.APIKeys.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("APIキーの削除に失敗しました: %w", err)
+		}
+		if err := repos.NotificationPreferences.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("通知設定の削除に失敗しました: %w", err)
+		}
+		if err := repos.RecommendationDismissals.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("推奨事項の却下の削除に失敗しました: %w", err)
+		}
+		if err := repos.HealthScoreSnapshots.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("財務健全性スコアの履歴の削除に失敗しました: %w", err)
+		}
+		if err := repos.CalculationSnapshots.DeleteByUserID(ctx, input.UserID); err != nil {
+			return fmt.Errorf("計算結果の削除に失敗しました: %w", err)
+		}
+		if err := repos.PeerBenchmarkConsents.OptOut(ctx, input.UserID); err != nil {
+			return fmt.Errorf("匿名集計への参加記録の削除に失敗しました: %w", err)
+		}
+		if err := repos.Users.Delete(ctx, input.UserID); err != nil {
+			return fmt.Errorf("ユーザーの削除に失敗しました: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		uc.logger.OperationError(ctx, "DeleteAccount", err,
+			slog.String("step", "delete_account"),
+		)
+		return nil, err
+	}
+
+	uc.logger.EndOperation(ctx, "DeleteAccount",
+		slog.Int("goals", preview.Goals),
+		slog.Int("financial_plans", preview.FinancialPlans),
+	)
+
+	return &DeleteAccountOutput{
+		UserID:  input.UserID,
+		Deleted: preview,
+	}, nil
+}
+
+// collectDeletionPreview は削除対象のデータ件数を集計する
+// 削除処理で再利用できるよう、集計に使った目標の一覧も返す
+func (uc *userManagementUseCaseImpl) collectDeletionPreview(
+	ctx context.Context,
+	repos repositories.TxRepositories,
+	userID entities.UserID,
+) (*DeletionPreviewOutput, []*entities.Goal, error) {
+	exists, err := repos.Users.Exists(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ユーザーの確認に失敗しました: %w", err)
+	}
+	if !exists {
+		return nil, nil, fmt.Errorf("ユーザーが見つかりません: %s", userID)
+	}
+
+	preview := &DeletionPreviewOutput{
+		UserID:               userID,
+		GoalSharedWithOthers: []string{},
+	}
+
+	planExists, err := repos.FinancialPlans.ExistsByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("財務計画の確認に失敗しました: %w", err)
+	}
+	if planExists {
+		preview.FinancialPlans = 1
+	}
+
+	goals, err := repos.Goals.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
+	}
+	preview.Goals = len(goals)
+
+	if preview.MonthlySnapshots, err = repos.SavingsRateTargets.CountMonthlyActualsByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("月次実績の件数取得に失敗しました: %w", err)
+	}
+	if preview.RefreshTokens, err = repos.RefreshTokens.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("リフレッシュトークン数の取得に失敗しました: %w", err)
+	}
+	if preview.PasswordResetTokens, err = repos.PasswordResetTokens.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("パスワードリセットトークン数の取得に失敗しました: %w", err)
+	}
+
+	progressSnapshots, err := repos.GoalProgressSnapshots.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("目標の進捗履歴の件数取得に失敗しました: %w", err)
+	}
+	contributionChanges, err := repos.GoalContributionHistory.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("月間拠出額の変更履歴の件数取得に失敗しました: %w", err)
+	}
+	preview.GoalProgressEntries = progressSnapshots + contributionChanges
+
+	if preview.APIKeys, err = repos.APIKeys.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("APIキーの件数取得に失敗しました: %w", err)
+	}
+	if preview.NotificationPreferences, err = repos.NotificationPreferences.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("通知設定の件数取得に失敗しました: %w", err)
+	}
+	if preview.RecommendationDismissals, err = repos.RecommendationDismissals.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("推奨事項の却下の件数取得に失敗しました: %w", err)
+	}
+	if preview.HealthScoreSnapshots, err = repos.HealthScoreSnapshots.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("財務健全性スコアの履歴の件数取得に失敗しました: %w", err)
+	}
+	if preview.CalculationSnapshots, err = repos.CalculationSnapshots.CountByUserID(ctx, userID); err != nil {
+		return nil, nil, fmt.Errorf("計算結果の件数取得に失敗しました: %w", err)
+	}
+	optedInAt, err := repos.PeerBenchmarkConsents.FindOptedInAt(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("匿名集計への参加状況の取得に失敗しました: %w", err)
+	}
+	if optedInAt != nil {
+		preview.PeerBenchmarkConsents = 1
+	}
+
+	return preview, goals, nil
+}
@@ -0,0 +1,292 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// userManagementMocks はアカウント削除テスト用のモック一式
+type userManagementMocks struct {
+	plans               *MockFinancialPlanRepository
+	goals               *MockGoalRepository
+	users               *MockUserRepository
+	refreshTokens       *MockRefreshTokenRepository
+	passwordResetTokens *MockPasswordResetTokenRepository
+	savingsRateTargets  *MockSavingsRateTargetRepository
+	apiKeys             *MockAPIKeyRepository
+	notifications       *MockNotificationPreferenceRepository
+	dismissals          *MockRecommendationDismissalRepository
+	healthScores        *MockHealthScoreSnapshotRepository
+	calculations        *MockCalculationSnapshotRepository
+	peerConsents        *MockPeerBenchmarkConsentRepository
+	progressSnapshots   *MockGoalProgressSnapshotRepository
+	contributionHistory *MockGoalContributionHistoryRepository
+	// userData は expectCounts で設定するその他のユーザーデータの件数（既定はすべて0件）
+	userData userDataCounts
+}
+
+// userDataCounts は財務計画・目標・トークン以外のユーザーデータの件数
+type userDataCounts struct {
+	apiKeys, notifications, dismissals, healthScores, calculations int
+	progressSnapshots, contributionChanges                         int
+	peerOptedIn                                                    bool
+}
+
+func newUserManagementMocks() *userManagementMocks {
+	return &userManagementMocks{
+		plans:               new(MockFinancialPlanRepository),
+		goals:               new(MockGoalRepository),
+		users:               new(MockUserRepository),
+		refreshTokens:       new(MockRefreshTokenRepository),
+		passwordResetTokens: new(MockPasswordResetTokenRepository),
+		savingsRateTargets:  new(MockSavingsRateTargetRepository),
+		apiKeys:             new(MockAPIKeyRepository),
+		notifications:       new(MockNotificationPreferenceRepository),
+		dismissals:          new(MockRecommendationDismissalRepository),
+		healthScores:        new(MockHealthScoreSnapshotRepository),
+		calculations:        new(MockCalculationSnapshotRepository),
+		peerConsents:        new(MockPeerBenchmarkConsentRepository),
+		progressSnapshots:   new(MockGoalProgressSnapshotRepository),
+		contributionHistory: new(MockGoalContributionHistoryRepository),
+	}
+}
+
+func (m *userManagementMocks) unitOfWork() *stubUnitOfWork {
+	return &stubUnitOfWork{
+		repos: repositories.TxRepositories{
+			FinancialPlans:           m.plans,
+			Goals:                    m.goals,
+			Users:                    m.users,
+			RefreshTokens:            m.refreshTokens,
+			PasswordResetTokens:      m.passwordResetTokens,
+			SavingsRateTargets:       m.savingsRateTargets,
+			APIKeys:                  m.apiKeys,
+			NotificationPreferences:  m.notifications,
+			RecommendationDismissals: m.dismissals,
+			HealthScoreSnapshots:     m.healthScores,
+			CalculationSnapshots:     m.calculations,
+			PeerBenchmarkConsents:    m.peerConsents,
+			GoalProgressSnapshots:    m.progressSnapshots,
+			GoalContributionHistory:  m.contributionHistory,
+		},
+	}
+}
+
+// expectCounts はプレビューの集計に使われるモックの戻り値を設定する
+func (m *userManagementMocks) expectCounts(userID entities.UserID, hasPlan bool, goals []*entities.Goal, snapshots, refreshTokens, resetTokens int) {
+	m.users.On("Exists", mock_anything(), userID).Return(true, nil)
+	m.plans.On("ExistsByUserID", mock_anything(), userID).Return(hasPlan, nil)
+	m.goals.On("FindByUserID", mock_anything(), userID).Return(goals, nil)
+	m.savingsRateTargets.On("CountMonthlyActualsByUserID", mock_anything(), userID).Return(snapshots, nil)
+	m.refreshTokens.On("CountByUserID", mock_anything(), userID).Return(refreshTokens, nil)
+	m.passwordResetTokens.On("CountByUserID", mock_anything(), userID).Return(resetTokens, nil)
+	m.apiKeys.On("CountByUserID", mock_anything(), userID).Return(m.userData.apiKeys, nil)
+	m.notifications.On("CountByUserID", mock_anything(), userID).Return(m.userData.notifications, nil)
+	m.dismissals.On("CountByUserID", mock_anything(), userID).Return(m.userData.dismissals, nil)
+	m.healthScores.On("CountByUserID", mock_anything(), userID).Return(m.userData.healthScores, nil)
+	m.calculations.On("CountByUserID", mock_anything(), userID).Return(m.userData.calculations, nil)
+	m.progressSnapshots.On("CountByUserID", mock_anything(), userID).Return(m.userData.progressSnapshots, nil)
+	m.contributionHistory.On("CountByUserID", mock_anything(), userID).Return(m.userData.contributionChanges, nil)
+	var optedInAt *time.Time
+	if m.userData.peerOptedIn {
+		now := time.Now()
+		optedInAt = &now
+	}
+	m.peerConsents.On("FindOptedInAt", mock_anything(), userID).Return(optedInAt, nil)
+}
+
+// expectUserDataDeletion はトークン以外のユーザーデータの削除を期待する
+func (m *userManagementMocks) expectUserDataDeletion(userID entities.UserID) {
+	m.apiKeys.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.notifications.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.dismissals.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.healthScores.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.calculations.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.progressSnapshots.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.contributionHistory.On("DeleteByUserID", mock_anything(), userID).Return(nil).Once()
+	m.peerConsents.On("OptOut", mock_anything(), userID).Return(nil).Once()
+}
+
+// assertUserDataDeleted はトークン以外のユーザーデータがすべて削除されたことを確認する
+func (m *userManagementMocks) assertUserDataDeleted(t *testing.T) {
+	t.Helper()
+	m.apiKeys.AssertExpectations(t)
+	m.notifications.AssertExpectations(t)
+	m.dismissals.AssertExpectations(t)
+	m.healthScores.AssertExpectations(t)
+	m.calculations.AssertExpectations(t)
+	m.progressSnapshots.AssertExpectations(t)
+	m.contributionHistory.AssertExpectations(t)
+	m.peerConsents.AssertExpectations(t)
+}
+
+func TestUserManagementUseCase_PreviewAccountDeletion(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 削除対象の件数を集計できる", func(t *testing.T) {
+		m := newUserManagementMocks()
+		goals := []*entities.Goal{
+			newTestGoal("user-001", "goal-001"),
+			newTestGoal("user-001", "goal-002"),
+		}
+		m.userData = userDataCounts{
+			apiKeys: 2, notifications: 4, dismissals: 1, healthScores: 30, calculations: 3,
+			progressSnapshots: 12, contributionChanges: 5, peerOptedIn: true,
+		}
+		m.expectCounts("user-001", true, goals, 6, 3, 1)
+
+		uc := NewUserManagementUseCase(m.unitOfWork())
+		output, err := uc.PreviewAccountDeletion(ctx, PreviewAccountDeletionInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, entities.UserID("user-001"), output.UserID)
+		assert.Equal(t, 1, output.FinancialPlans)
+		assert.Equal(t, 2, output.Goals)
+		assert.Equal(t, 17, output.GoalProgressEntries)
+		assert.Equal(t, 6, output.MonthlySnapshots)
+		assert.Equal(t, 0, output.LoginAttempts)
+		assert.Equal(t, 3, output.RefreshTokens)
+		assert.Equal(t, 1, output.PasswordResetTokens)
+		assert.Equal(t, 2, output.APIKeys)
+		assert.Equal(t, 4, output.NotificationPreferences)
+		assert.Equal(t, 1, output.RecommendationDismissals)
+		assert.Equal(t, 30, output.HealthScoreSnapshots)
+		assert.Equal(t, 3, output.CalculationSnapshots)
+		assert.Equal(t, 1, output.PeerBenchmarkConsents)
+		assert.NotNil(t, output.GoalSharedWithOthers)
+		assert.Empty(t, output.GoalSharedWithOthers)
+	})
+
+	t.Run("正常系: 財務計画がない場合は0件", func(t *testing.T) {
+		m := newUserManagementMocks()
+		m.expectCounts("user-001", false, nil, 0, 0, 0)
+
+		uc := NewUserManagementUseCase(m.unitOfWork())
+		output, err := uc.PreviewAccountDeletion(ctx, PreviewAccountDeletionInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, output.FinancialPlans)
+		assert.Equal(t, 0, output.Goals)
+		assert.Equal(t, 0, output.GoalProgressEntries)
+		assert.Equal(t, 0, output.APIKeys)
+		assert.Equal(t, 0, output.PeerBenchmarkConsents)
+	})
+
+	t.Run("異常系: ユーザーが存在しない場合", func(t *testing.T) {
+		m := newUserManagementMocks()
+		m.users.On("Exists", mock_anything(), entities.UserID("unknown")).Return(false, nil)
+
+		uc := NewUserManagementUseCase(m.unitOfWork())
+		output, err := uc.PreviewAccountDeletion(ctx, PreviewAccountDeletionInput{UserID: "unknown"})
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "ユーザーが見つかりません")
+	})
+}
+
+func TestUserManagementUseCase_DeleteAccount(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: プレビューの件数と削除件数が一致する", func(t *testing.T) {
+		m := newUserManagementMocks()
+		goals := []*entities.Goal{
+			newTestGoal("user-001", "goal-001"),
+			newTestGoal("user-001", "goal-002"),
+			newTestGoal("user-001", "goal-003"),
+		}
+		plan := newTestFinancialPlan("user-001")
+		m.userData = userDataCounts{apiKeys: 1, notifications: 2, progressSnapshots: 3, contributionChanges: 1, peerOptedIn: true}
+		m.expectCounts("user-001", true, goals, 4, 2, 1)
+		m.expectUserDataDeletion("user-001")
+		for _, goal := range goals {
+			m.goals.On("Delete", mock_anything(), goal.ID()).Return(nil).Once()
+		}
+		m.plans.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(plan, nil)
+		m.plans.On("Delete", mock_anything(), plan.ID()).Return(nil).Once()
+		m.savingsRateTargets.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil).Once()
+		m.refreshTokens.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil).Once()
+		m.passwordResetTokens.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil).Once()
+		m.users.On("Delete", mock_anything(), entities.UserID("user-001")).Return(nil).Once()
+
+		uow := m.unitOfWork()
+		uc := NewUserManagementUseCase(uow)
+
+		preview, err := uc.PreviewAccountDeletion(ctx, PreviewAccountDeletionInput{UserID: "user-001"})
+		require.NoError(t, err)
+
+		output, err := uc.DeleteAccount(ctx, DeleteAccountInput{UserID: "user-001"})
+		require.NoError(t, err)
+
+		assert.Equal(t, preview, output.Deleted)
+		assert.True(t, uow.committed)
+		m.goals.AssertNumberOfCalls(t, "Delete", preview.Goals)
+		m.plans.AssertNumberOfCalls(t, "Delete", preview.FinancialPlans)
+		m.users.AssertNumberOfCalls(t, "Delete", 1)
+		m.savingsRateTargets.AssertExpectations(t)
+		m.refreshTokens.AssertExpectations(t)
+		m.passwordResetTokens.AssertExpectations(t)
+		m.assertUserDataDeleted(t)
+	})
+
+	t.Run("正常系: 財務計画がない場合は財務計画を削除しない", func(t *testing.T) {
+		m := newUserManagementMocks()
+		m.expectCounts("user-001", false, nil, 0, 0, 0)
+		m.expectUserDataDeletion("user-001")
+		m.savingsRateTargets.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		m.refreshTokens.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		m.passwordResetTokens.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		m.users.On("Delete", mock_anything(), entities.UserID("user-001")).Return(nil)
+
+		uc := NewUserManagementUseCase(m.unitOfWork())
+		output, err := uc.DeleteAccount(ctx, DeleteAccountInput{UserID: "user-001"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, output.Deleted.FinancialPlans)
+		m.plans.AssertNotCalled(t, "FindByUserID", mock.Anything, mock.Anything)
+		m.plans.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: ユーザーの削除に失敗した場合はロールバックされる", func(t *testing.T) {
+		m := newUserManagementMocks()
+		goal := newTestGoal("user-001", "goal-001")
+		m.expectCounts("user-001", false, []*entities.Goal{goal}, 0, 1, 0)
+		m.expectUserDataDeletion("user-001")
+		m.goals.On("Delete", mock_anything(), goal.ID()).Return(nil)
+		m.savingsRateTargets.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		m.refreshTokens.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		m.passwordResetTokens.On("DeleteByUserID", mock_anything(), entities.UserID("user-001")).Return(nil)
+		m.users.On("Delete", mock_anything(), entities.UserID("user-001")).Return(errors.New("db error"))
+
+		uow := m.unitOfWork()
+		uc := NewUserManagementUseCase(uow)
+		output, err := uc.DeleteAccount(ctx, DeleteAccountInput{UserID: "user-001"})
+
+		require.Error(t, err)
+		assert.Nil(t, output)
+		assert.Contains(t, err.Error(), "ユーザーの削除に失敗しました")
+		assert.True(t, uow.rolledBack)
+		assert.False(t, uow.committed)
+	})
+
+	t.Run("異常系: ユーザーが存在しない場合は何も削除しない", func(t *testing.T) {
+		m := newUserManagementMocks()
+		m.users.On("Exists", mock_anything(), entities.UserID("unknown")).Return(false, nil)
+
+		uc := NewUserManagementUseCase(m.unitOfWork())
+		_, err := uc.DeleteAccount(ctx, DeleteAccountInput{UserID: "unknown"})
+
+		require.Error(t, err)
+		m.users.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		m.goals.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+		m.apiKeys.AssertNotCalled(t, "DeleteByUserID", mock.Anything, mock.Anything)
+	})
+}
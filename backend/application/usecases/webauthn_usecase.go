@@ -508,6 +508,7 @@ func (uc *webAuthnUseCaseImpl) generateToken(user *entities.User) (string, time.
 		UserID: user.ID().String(),
 		Email:  user.Email().String(),
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			NotBefore: jwt.NewNumericDate(time.Now()),
@@ -67,10 +67,10 @@ func TestWebAuthnUseCase_BeginRegistration_UserNotFound(t *testing.T) {
 	userRepo := new(MockUserRepository)
 	credRepo := new(MockWebAuthnCredentialRepository)
 	tokenRepo := new(MockRefreshTokenRepository)
-	userRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
+	userRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(nil, errors.New("not found"))
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-	_, err := uc.BeginRegistration(ctx, "user-001")
+	_, err := uc.BeginRegistration(ctx, testAuthUserID)
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "ユーザーが見つかりません")
@@ -84,12 +84,12 @@ func TestWebAuthnUseCase_BeginRegistration_CredentialRepoError(t *testing.T) {
 	credRepo := new(MockWebAuthnCredentialRepository)
 	tokenRepo := new(MockRefreshTokenRepository)
 
-	testUser := newTestUser("user-001", "test@example.com")
-	userRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(testUser, nil)
-	credRepo.On("FindByUserID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("db error"))
+	testUser := newTestUser(testAuthUserID, "test@example.com")
+	userRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(testUser, nil)
+	credRepo.On("FindByUserID", mock_anything(), entities.UserID(testAuthUserID)).Return(nil, errors.New("db error"))
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-	_, err := uc.BeginRegistration(ctx, "user-001")
+	_, err := uc.BeginRegistration(ctx, testAuthUserID)
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "既存のクレデンシャル取得に失敗")
@@ -125,11 +125,11 @@ func TestWebAuthnUseCase_FinishRegistration_UserNotFound(t *testing.T) {
 	userRepo := new(MockUserRepository)
 	credRepo := new(MockWebAuthnCredentialRepository)
 	tokenRepo := new(MockRefreshTokenRepository)
-	userRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(nil, errors.New("not found"))
+	userRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(nil, errors.New("not found"))
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
 	err := uc.FinishRegistration(ctx, FinishRegistrationInput{
-		UserID:      "user-001",
+		UserID:      testAuthUserID,
 		SessionData: "dummydata",
 		Response:    "{}",
 	})
@@ -146,12 +146,12 @@ func TestWebAuthnUseCase_FinishRegistration_InvalidSessionData(t *testing.T) {
 	credRepo := new(MockWebAuthnCredentialRepository)
 	tokenRepo := new(MockRefreshTokenRepository)
 
-	testUser := newTestUser("user-001", "test@example.com")
-	userRepo.On("FindByID", mock_anything(), entities.UserID("user-001")).Return(testUser, nil)
+	testUser := newTestUser(testAuthUserID, "test@example.com")
+	userRepo.On("FindByID", mock_anything(), entities.UserID(testAuthUserID)).Return(testUser, nil)
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
 	err := uc.FinishRegistration(ctx, FinishRegistrationInput{
-		UserID:      "user-001",
+		UserID:      testAuthUserID,
 		SessionData: "!!!invalid-base64!!!",
 		Response:    "{}",
 	})
@@ -214,12 +214,12 @@ func TestWebAuthnUseCase_ListCredentials(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		cred := newTestCredential("cred-001", uid)
 		credRepo.On("FindByUserID", mock_anything(), uid).Return([]*entities.WebAuthnCredential{cred}, nil)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		result, err := uc.ListCredentials(ctx, "user-001")
+		result, err := uc.ListCredentials(ctx, testAuthUserID)
 
 		require.NoError(t, err)
 		require.Len(t, result, 1)
@@ -234,11 +234,11 @@ func TestWebAuthnUseCase_ListCredentials(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		credRepo.On("FindByUserID", mock_anything(), uid).Return([]*entities.WebAuthnCredential{}, nil)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		result, err := uc.ListCredentials(ctx, "user-001")
+		result, err := uc.ListCredentials(ctx, testAuthUserID)
 
 		require.NoError(t, err)
 		assert.Empty(t, result)
@@ -262,11 +262,11 @@ func TestWebAuthnUseCase_ListCredentials(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		credRepo.On("FindByUserID", mock_anything(), uid).Return(nil, errors.New("db error"))
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		_, err := uc.ListCredentials(ctx, "user-001")
+		_, err := uc.ListCredentials(ctx, testAuthUserID)
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "クレデンシャルの取得に失敗")
@@ -278,7 +278,7 @@ func TestWebAuthnUseCase_ListCredentials(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		cred := newTestCredential("cred-001", uid)
 		// UpdateSignCount でlastUsedAtを設定
 		require.NoError(t, cred.UpdateSignCount(1))
@@ -286,7 +286,7 @@ func TestWebAuthnUseCase_ListCredentials(t *testing.T) {
 		credRepo.On("FindByUserID", mock_anything(), uid).Return([]*entities.WebAuthnCredential{cred}, nil)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		result, err := uc.ListCredentials(ctx, "user-001")
+		result, err := uc.ListCredentials(ctx, testAuthUserID)
 
 		require.NoError(t, err)
 		require.Len(t, result, 1)
@@ -307,7 +307,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		cid := entities.CredentialID("cred-001")
 		cred := newTestCredential("cred-001", uid)
 
@@ -315,7 +315,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		credRepo.On("Delete", mock_anything(), cid).Return(nil)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.DeleteCredential(ctx, "user-001", "cred-001")
+		err := uc.DeleteCredential(ctx, testAuthUserID, "cred-001")
 
 		require.NoError(t, err)
 		credRepo.AssertExpectations(t)
@@ -339,7 +339,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		tokenRepo := new(MockRefreshTokenRepository)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.DeleteCredential(ctx, "user-001", "")
+		err := uc.DeleteCredential(ctx, testAuthUserID, "")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "無効なクレデンシャルID")
@@ -354,7 +354,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		credRepo.On("FindByID", mock_anything(), cid).Return(nil, errors.New("not found"))
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.DeleteCredential(ctx, "user-001", "cred-001")
+		err := uc.DeleteCredential(ctx, testAuthUserID, "cred-001")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "クレデンシャルが見つかりません")
@@ -367,7 +367,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		tokenRepo := new(MockRefreshTokenRepository)
 
 		// クレデンシャルはuser-002のもの
-		ownerUID := entities.UserID("user-002")
+		ownerUID := entities.UserID(testOtherUserID)
 		cid := entities.CredentialID("cred-001")
 		cred := newTestCredential("cred-001", ownerUID)
 
@@ -375,7 +375,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
 		// user-001として削除しようとする
-		err := uc.DeleteCredential(ctx, "user-001", "cred-001")
+		err := uc.DeleteCredential(ctx, testAuthUserID, "cred-001")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "このクレデンシャルの所有者ではありません")
@@ -387,7 +387,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		cid := entities.CredentialID("cred-001")
 		cred := newTestCredential("cred-001", uid)
 
@@ -395,7 +395,7 @@ func TestWebAuthnUseCase_DeleteCredential(t *testing.T) {
 		credRepo.On("Delete", mock_anything(), cid).Return(errors.New("db error"))
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.DeleteCredential(ctx, "user-001", "cred-001")
+		err := uc.DeleteCredential(ctx, testAuthUserID, "cred-001")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "クレデンシャルの削除に失敗")
@@ -415,7 +415,7 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		cid := entities.CredentialID("cred-001")
 		cred := newTestCredential("cred-001", uid)
 
@@ -423,7 +423,7 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		credRepo.On("Update", mock_anything(), mock_anything()).Return(nil)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.RenameCredential(ctx, "user-001", "cred-001", "New Name")
+		err := uc.RenameCredential(ctx, testAuthUserID, "cred-001", "New Name")
 
 		require.NoError(t, err)
 		assert.Equal(t, "New Name", cred.Name())
@@ -448,7 +448,7 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		tokenRepo := new(MockRefreshTokenRepository)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.RenameCredential(ctx, "user-001", "", "New Name")
+		err := uc.RenameCredential(ctx, testAuthUserID, "", "New Name")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "無効なクレデンシャルID")
@@ -463,7 +463,7 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		credRepo.On("FindByID", mock_anything(), cid).Return(nil, errors.New("not found"))
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.RenameCredential(ctx, "user-001", "cred-001", "New Name")
+		err := uc.RenameCredential(ctx, testAuthUserID, "cred-001", "New Name")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "クレデンシャルが見つかりません")
@@ -475,14 +475,14 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		ownerUID := entities.UserID("user-002")
+		ownerUID := entities.UserID(testOtherUserID)
 		cid := entities.CredentialID("cred-001")
 		cred := newTestCredential("cred-001", ownerUID)
 
 		credRepo.On("FindByID", mock_anything(), cid).Return(cred, nil)
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.RenameCredential(ctx, "user-001", "cred-001", "New Name")
+		err := uc.RenameCredential(ctx, testAuthUserID, "cred-001", "New Name")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "このクレデンシャルの所有者ではありません")
@@ -494,7 +494,7 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		credRepo := new(MockWebAuthnCredentialRepository)
 		tokenRepo := new(MockRefreshTokenRepository)
 
-		uid := entities.UserID("user-001")
+		uid := entities.UserID(testAuthUserID)
 		cid := entities.CredentialID("cred-001")
 		cred := newTestCredential("cred-001", uid)
 
@@ -502,7 +502,7 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 		credRepo.On("Update", mock_anything(), mock_anything()).Return(errors.New("db error"))
 
 		uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
-		err := uc.RenameCredential(ctx, "user-001", "cred-001", "New Name")
+		err := uc.RenameCredential(ctx, testAuthUserID, "cred-001", "New Name")
 
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "クレデンシャルの更新に失敗")
@@ -514,6 +514,14 @@ func TestWebAuthnUseCase_RenameCredential(t *testing.T) {
 // Helper: newTestUser
 // ===========================
 
+// 認証系のテストで使用するユーザーID（UUID v4）
+const (
+	testAuthUserID     = "3f9a2c1e-6b7d-4e8f-9a0b-1c2d3e4f5a6b"
+	testOtherUserID    = "8d4e6f2a-1b3c-4d5e-8f9a-0b1c2d3e4f5a"
+	testUnknownUserID  = "c7b1d3e5-9f2a-4b6c-a8d0-e2f4a6b8c0d2"
+	testExistingUserID = "5e2f8a1b-7c9d-4e3f-b1a2-c3d4e5f6a7b8"
+)
+
 func newTestUser(id, email string) *entities.User {
 	user, _ := entities.NewUser(id, email, "Password123!")
 	return user
@@ -581,7 +589,7 @@ func TestWebAuthnUseCase_GenerateToken(t *testing.T) {
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
 
-	testUser := newTestUser("user-001", "test@example.com")
+	testUser := newTestUser(testAuthUserID, "test@example.com")
 	token, expiresAt, err := uc.generateToken(testUser)
 
 	require.NoError(t, err)
@@ -599,7 +607,7 @@ func TestWebAuthnUseCase_GenerateRefreshToken(t *testing.T) {
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
 
-	rawToken, err := uc.generateRefreshToken(ctx, entities.UserID("user-001"))
+	rawToken, err := uc.generateRefreshToken(ctx, entities.UserID(testAuthUserID))
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, rawToken)
@@ -616,7 +624,7 @@ func TestWebAuthnUseCase_GenerateRefreshToken_RepositoryError(t *testing.T) {
 
 	uc := newTestWebAuthnUseCase(userRepo, credRepo, tokenRepo)
 
-	_, err := uc.generateRefreshToken(ctx, entities.UserID("user-001"))
+	_, err := uc.generateRefreshToken(ctx, entities.UserID(testAuthUserID))
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "リフレッシュトークンの保存に失敗")
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories"
+)
+
+// 同年代比較に参加しているユーザーの財務データから、年代・年収帯ごとの匿名集計を作り直す
+// 定期実行（日次など）を想定しており、集計人数が最小集計人数に満たない区分は保存しない
+func main() {
+	var batchSize int
+	flag.IntVar(&batchSize, "batch-size", usecases.DefaultPeerBenchmarkBatchSize, "Number of opted-in users fetched per batch")
+	flag.Parse()
+
+	// Load database configuration
+	dbConfig := config.NewDatabaseConfig()
+
+	// Connect to database
+	db, err := config.NewDatabaseConnection(dbConfig)
+	if err != nil {
+		log.Fatalf("データベース接続に失敗しました: %v", err)
+	}
+	defer db.Close()
+
+	repoFactory := repositories.NewRepositoryFactoryWithCircuitBreaker(
+		db,
+		database.NewSlidingWindowCircuitBreaker(dbConfig.CircuitBreaker),
+	)
+	serverCfg := config.LoadServerConfig()
+
+	peerBenchmarkUseCase := usecases.NewPeerBenchmarkUseCase(
+		repoFactory.NewFinancialPlanRepository(),
+		repoFactory.NewPeerBenchmarkConsentRepository(),
+		repoFactory.NewPeerBenchmarkRepository(),
+		services.NewPeerBenchmarkService(serverCfg.PeerBenchmarkMinGroupSize),
+		serverCfg.PeerBenchmarkCacheTTL,
+	)
+
+	// 中断した場合は保存済みの集計結果を変更しない
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	output, err := peerBenchmarkUseCase.AggregatePeerBenchmarks(ctx, usecases.AggregatePeerBenchmarksInput{
+		BatchSize: batchSize,
+	})
+	if output != nil {
+		printSummary(output)
+	}
+	if err != nil {
+		log.Fatalf("同年代比較の集計に失敗しました: %v", err)
+	}
+}
+
+// printSummary は集計の結果を表示する
+func printSummary(output *usecases.AggregatePeerBenchmarksOutput) {
+	fmt.Printf("集計日時: %s\n", output.AggregatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("参加: %d人, 集計: %d人, スキップ: %d人\n", output.Participants, output.Sampled, output.Skipped)
+	fmt.Printf("区分: %d件, 集計結果: %d件\n", output.Segments, output.Benchmarks)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/financial-planning-calculator/backend/infrastructure/email"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories"
+)
+
+// ダイジェストメールの配信を有効にしていて、配信時刻を迎えたユーザーにダイジェストメールを送信する
+// 毎時の定期実行を想定しており、配信時刻はこのバッチを実行する環境のタイムゾーン（TZ）で判定する
+func main() {
+	// Load database configuration
+	dbConfig := config.NewDatabaseConfig()
+
+	// Connect to database
+	db, err := config.NewDatabaseConnection(dbConfig)
+	if err != nil {
+		log.Fatalf("データベース接続に失敗しました: %v", err)
+	}
+	defer db.Close()
+
+	repoFactory := repositories.NewRepositoryFactoryWithCircuitBreaker(
+		db,
+		database.NewSlidingWindowCircuitBreaker(dbConfig.CircuitBreaker),
+	)
+	financialPlanRepo := repoFactory.NewFinancialPlanRepository()
+	goalRepo := repoFactory.NewGoalRepository()
+
+	calculationService := services.NewFinancialCalculationService()
+	recommendationService := services.NewGoalRecommendationService(calculationService)
+	serverCfg := config.LoadServerConfig()
+
+	digestUseCase := usecases.NewDigestUseCase(
+		repoFactory.NewUserRepository(),
+		repoFactory.NewDigestDeliveryRepository(),
+		usecases.NewGenerateReportsUseCaseWithPDF(
+			financialPlanRepo,
+			goalRepo,
+			calculationService,
+			recommendationService,
+			nil,
+			nil,
+			repoFactory.NewSavingsRateTargetRepository(),
+			services.NewAssumptionGuardrailService(
+				serverCfg.RealisticReturnCeiling,
+				serverCfg.RealisticInflationCeiling,
+			),
+			repoFactory.NewRecommendationDismissalRepository(),
+			nil,
+			repoFactory.NewHealthScoreSnapshotRepository(),
+			repoFactory.NewCalculationSnapshotRepository(),
+		),
+	)
+	mailer := email.NewMailer(
+		serverCfg.SMTPHost,
+		serverCfg.SMTPPort,
+		serverCfg.SMTPUser,
+		serverCfg.SMTPPassword,
+		serverCfg.SMTPFrom,
+	)
+
+	// 中断した場合は送信済みのユーザーまでの結果を表示する（送信済みのユーザーは記録済みのため再送しない）
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	users, err := digestUseCase.FindDueUsers(ctx)
+	if err != nil {
+		log.Fatalf("ダイジェストメールの配信対象の取得に失敗しました: %v", err)
+	}
+
+	summary := sendDigests(ctx, digestUseCase, mailer, users)
+	slog.Info("ダイジェストメールの配信が完了しました",
+		"targets", summary.targets,
+		"sent", summary.sent,
+		"failed", summary.failed,
+	)
+	fmt.Printf("対象: %d件, 送信: %d件, 失敗: %d件\n", summary.targets, summary.sent, summary.failed)
+	if ctx.Err() != nil {
+		log.Fatalf("ダイジェストメールの配信が中断されました: %v", ctx.Err())
+	}
+	if summary.failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// digestSummary はダイジェストメールの配信結果の集計
+type digestSummary struct {
+	targets int
+	sent    int
+	failed  int
+}
+
+// sendDigests は対象ユーザーごとにダイジェストメールを組み立てて送信し、送信を記録する
+// 1人のユーザーの失敗では中断せず、失敗したユーザーは前回の配信日時が更新されないため次回の実行で再送する
+func sendDigests(ctx context.Context, digestUseCase usecases.DigestUseCase, mailer email.Mailer, users []*entities.User) digestSummary {
+	summary := digestSummary{targets: len(users)}
+	for _, user := range users {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := sendDigest(ctx, digestUseCase, mailer, user); err != nil {
+			summary.failed++
+			slog.Error("ダイジェストメールの配信に失敗しました", "user_id", string(user.ID()), "error", err)
+			continue
+		}
+		summary.sent++
+	}
+	return summary
+}
+
+// sendDigest は1人のユーザーのダイジェストメールを組み立てて送信し、送信を記録する
+func sendDigest(ctx context.Context, digestUseCase usecases.DigestUseCase, mailer email.Mailer, user *entities.User) error {
+	digest, err := digestUseCase.BuildDigest(ctx, user)
+	if err != nil {
+		return err
+	}
+	message, err := email.RenderDigest(digest)
+	if err != nil {
+		return err
+	}
+	if err := mailer.Send(ctx, message); err != nil {
+		return fmt.Errorf("メール送信に失敗しました: %w", err)
+	}
+	// 送信後の記録に失敗した場合、次回の実行で同じ内容を再送することがある
+	return digestUseCase.RecordDigestSent(ctx, user, digest)
+}
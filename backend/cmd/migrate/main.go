@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/financial-planning-calculator/backend/config"
 	"github.com/financial-planning-calculator/backend/infrastructure/database"
@@ -10,7 +12,7 @@ import (
 
 func main() {
 	var command string
-	flag.StringVar(&command, "command", "up", "Migration command: up, down, status")
+	flag.StringVar(&command, "command", "up", "Migration command: up, down, status, verify-indexes")
 	flag.Parse()
 
 	// Load database configuration
@@ -40,7 +42,20 @@ func main() {
 		if err := migrator.Status(); err != nil {
 			log.Fatalf("マイグレーション状況の取得に失敗しました: %v", err)
 		}
+	case "verify-indexes":
+		missing, err := migrator.CheckIndexes(context.Background())
+		if err != nil {
+			log.Fatalf("インデックスの確認に失敗しました: %v", err)
+		}
+		if len(missing) > 0 {
+			for _, index := range missing {
+				log.Printf("不足しているインデックス: %s", index)
+			}
+			db.Close()
+			os.Exit(1)
+		}
+		log.Println("必要なインデックスは全て存在します")
 	default:
-		log.Fatalf("無効なコマンドです: %s (使用可能: up, down, status)", command)
+		log.Fatalf("無効なコマンドです: %s (使用可能: up, down, status, verify-indexes)", command)
 	}
 }
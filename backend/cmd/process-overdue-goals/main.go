@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/financial-planning-calculator/backend/infrastructure/notification"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories"
+)
+
+// 期限を過ぎた未達成の目標に、目標ごとの期限超過時ポリシー（extend/archive/notify）を適用する
+// 定期実行（日次など）を想定しており、処理内容は目標ごとにユーザーへ通知する
+func main() {
+	// Load database configuration
+	dbConfig := config.NewDatabaseConfig()
+
+	// Connect to database
+	db, err := config.NewDatabaseConnection(dbConfig)
+	if err != nil {
+		log.Fatalf("データベース接続に失敗しました: %v", err)
+	}
+	defer db.Close()
+
+	repoFactory := repositories.NewRepositoryFactoryWithCircuitBreaker(
+		db,
+		database.NewSlidingWindowCircuitBreaker(dbConfig.CircuitBreaker),
+	)
+
+	// サーバーと同じチャネル構成で配信する。リトライキューはプロセス内のため、ジョブ終了後は再送しない
+	notificationDispatcher := notification.NewPreferenceDispatcher(
+		repoFactory.NewNotificationPreferenceRepository(),
+		notification.NewRetryQueue(notification.DefaultMaxRetryAttempts, notification.DefaultRetryBackoff),
+		notification.NewLogChannel(entities.NotificationChannelEmail),
+		notification.NewLogChannel(entities.NotificationChannelPush),
+		notification.NewWebhookChannel(nil),
+	)
+
+	processOverdueGoalsUseCase := usecases.NewProcessOverdueGoalsUseCase(
+		repoFactory.NewGoalRepository(),
+		notificationDispatcher,
+	)
+
+	// 中断した場合は処理済みの目標までの結果を表示する
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	output, err := processOverdueGoalsUseCase.ProcessOverdueGoals(ctx)
+	if output != nil {
+		printSummary(output)
+	}
+	if err != nil {
+		log.Fatalf("期限超過の目標の処理に失敗しました: %v", err)
+	}
+}
+
+// printSummary は処理の結果を表示する
+func printSummary(output *usecases.ProcessOverdueGoalsOutput) {
+	fmt.Printf("処理日時: %s\n", output.ProcessedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("対象: %d件, 延長: %d件, アーカイブ: %d件, 通知のみ: %d件, 失敗: %d件\n",
+		output.Targets, output.Extended, output.Archived, output.Notified, output.Failed)
+	for _, result := range output.Results {
+		if result.Error == "" {
+			continue
+		}
+		fmt.Printf("  %s (%s): %s\n", result.GoalID, result.Action, result.Error)
+	}
+}
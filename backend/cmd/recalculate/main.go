@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories"
+)
+
+// 既定の前提条件や計算ロジックを変更した後に、保存済みの計算結果を一括で再計算する
+// 同じ計算バージョン・対象で再実行すると前回の続きから再開する
+func main() {
+	var (
+		scopeFlag   string
+		userID      string
+		runID       string
+		batchSize   int
+		concurrency int
+	)
+	flag.StringVar(&scopeFlag, "scope", "all", "Recalculation scope: projections, reports, alerts, all")
+	flag.StringVar(&userID, "user", "", "Recalculate only this user ID")
+	flag.StringVar(&runID, "run-id", "", "Run ID for resuming (default: <scope>-v<calculation version>)")
+	flag.IntVar(&batchSize, "batch-size", usecases.DefaultRecalculationBatchSize, "Number of users fetched per batch")
+	flag.IntVar(&concurrency, "concurrency", usecases.DefaultRecalculationConcurrency, "Number of users recalculated concurrently")
+	flag.Parse()
+
+	scope, err := usecases.ParseRecalculationScope(scopeFlag)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	var targetUserID entities.UserID
+	if userID != "" {
+		targetUserID, err = entities.NewUserID(userID)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+
+	// Load database configuration
+	dbConfig := config.NewDatabaseConfig()
+
+	// Connect to database
+	db, err := config.NewDatabaseConnection(dbConfig)
+	if err != nil {
+		log.Fatalf("データベース接続に失敗しました: %v", err)
+	}
+	defer db.Close()
+
+	repoFactory := repositories.NewRepositoryFactoryWithCircuitBreaker(
+		db,
+		database.NewSlidingWindowCircuitBreaker(dbConfig.CircuitBreaker),
+	)
+	financialPlanRepo := repoFactory.NewFinancialPlanRepository()
+	goalRepo := repoFactory.NewGoalRepository()
+	savingsRateTargetRepo := repoFactory.NewSavingsRateTargetRepository()
+	recommendationDismissalRepo := repoFactory.NewRecommendationDismissalRepository()
+	calculationSnapshotRepo := repoFactory.NewCalculationSnapshotRepository()
+
+	calculationService := services.NewFinancialCalculationService()
+	recommendationService := services.NewGoalRecommendationService(calculationService)
+	serverCfg := config.LoadServerConfig()
+	assumptionGuardrailService := services.NewAssumptionGuardrailService(
+		serverCfg.RealisticReturnCeiling,
+		serverCfg.RealisticInflationCeiling,
+	)
+
+	recalculateUseCase := usecases.NewRecalculateUseCase(
+		financialPlanRepo,
+		calculationSnapshotRepo,
+		repoFactory.NewRecalculationProgressRepository(),
+		usecases.NewCalculateProjectionUseCase(
+			financialPlanRepo,
+			goalRepo,
+			calculationService,
+			recommendationService,
+		),
+		usecases.NewGenerateReportsUseCaseWithPDF(
+			financialPlanRepo,
+			goalRepo,
+			calculationService,
+			recommendationService,
+			nil,
+			nil,
+			savingsRateTargetRepo,
+			assumptionGuardrailService,
+			recommendationDismissalRepo,
+			nil,
+			nil,
+			calculationSnapshotRepo,
+		),
+		usecases.NewManageRecommendationsUseCase(
+			financialPlanRepo,
+			savingsRateTargetRepo,
+			recommendationDismissalRepo,
+			calculationService,
+		),
+	)
+
+	// 中断した場合も完了済みのユーザーは記録済みのため、同じ実行IDで再開できる
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	output, err := recalculateUseCase.Recalculate(ctx, usecases.RecalculateInput{
+		Scope:       scope,
+		UserID:      targetUserID,
+		RunID:       runID,
+		BatchSize:   batchSize,
+		Concurrency: concurrency,
+	})
+	if output != nil {
+		printSummary(output)
+	}
+	if err != nil {
+		log.Fatalf("一括再計算に失敗しました: %v", err)
+	}
+	if output.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// printSummary は一括再計算の結果を表示する
+func printSummary(output *usecases.RecalculateOutput) {
+	fmt.Printf("実行ID: %s (計算バージョン: %d)\n", output.RunID, output.CalculationVersion)
+	fmt.Printf("再計算: %d件, 失敗: %d件, スキップ: %d件\n", output.Processed, output.Failed, output.Skipped)
+	for _, failure := range output.Failures {
+		fmt.Printf("  - %s: %s\n", failure.UserID, failure.Error)
+	}
+}
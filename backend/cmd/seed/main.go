@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log"
 
 	"github.com/financial-planning-calculator/backend/config"
@@ -8,6 +9,14 @@ import (
 )
 
 func main() {
+	var (
+		perfTest bool
+		count    int
+	)
+	flag.BoolVar(&perfTest, "perf-test", false, "Seed large datasets for load testing instead of the sample data")
+	flag.IntVar(&count, "count", 10000, "Number of users to seed with --perf-test")
+	flag.Parse()
+
 	// Load database configuration
 	dbConfig := config.NewDatabaseConfig()
 
@@ -21,6 +30,15 @@ func main() {
 	// Create seeder
 	seeder := database.NewSeeder(db)
 
+	// 負荷試験用データの投入（投入済みの場合はスキップされる）
+	if perfTest {
+		if err := seeder.SeedPerformanceTestData(count); err != nil {
+			log.Fatalf("負荷試験用データの投入に失敗しました: %v", err)
+		}
+		log.Println("負荷試験用データの投入が完了しました")
+		return
+	}
+
 	// Execute seeding
 	if err := seeder.Seed(); err != nil {
 		log.Fatalf("シードデータの投入に失敗しました: %v", err)
@@ -1,21 +1,53 @@
 package config
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
+	"math/rand/v2"
 	"os"
+	"time"
 
 	_ "github.com/lib/pq"
 )
 
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Host           string
+	Port           string
+	User           string
+	Password       string
+	DBName         string
+	SSLMode        string
+	CircuitBreaker CircuitBreakerConfig
+	Retry          ConnectionRetryConfig
+	Pool           ConnectionPoolConfig
+}
+
+// ConnectionRetryConfig は起動時のデータベース接続リトライの設定
+// Railway/Neon などのコールドスタートで接続を受け付けるまで数秒かかる場合に備え、指数バックオフ（ジッター付き）で再試行する
+type ConnectionRetryConfig struct {
+	MaxAttempts     int           // 接続試行の最大回数
+	MaxElapsedTime  time.Duration // 最初の試行からの最大経過時間（超える場合は待機せずに諦める）
+	InitialInterval time.Duration // 初回の再試行までの待機時間
+	MaxInterval     time.Duration // 再試行の待機時間の上限
+	ConnectTimeout  time.Duration // 1回の接続試行のタイムアウト
+}
+
+// ConnectionPoolConfig はコネクションプールの設定
+type ConnectionPoolConfig struct {
+	MaxOpenConns    int           // 最大接続数
+	MaxIdleConns    int           // 最大アイドル接続数
+	ConnMaxLifetime time.Duration // 接続を再利用する最大時間
+}
+
+// CircuitBreakerConfig はデータベース呼び出しを保護するサーキットブレーカーの設定
+type CircuitBreakerConfig struct {
+	MaxRequests      uint32        // 半開状態で試行を許可するリクエスト数
+	Interval         time.Duration // 閉状態で失敗を数えるスライディングウィンドウの長さ
+	Timeout          time.Duration // 開状態から半開状態に移るまでの時間
+	FailureThreshold uint32        // 開状態に移る連続失敗回数
 }
 
 func NewDatabaseConfig() *DatabaseConfig {
@@ -26,7 +58,59 @@ func NewDatabaseConfig() *DatabaseConfig {
 		Password: getEnv("DB_PASSWORD", "password"),
 		DBName:   getEnv("DB_NAME", "financial_planning"),
 		SSLMode:  getEnv("DB_SSLMODE", "disable"),
+		CircuitBreaker: CircuitBreakerConfig{
+			MaxRequests:      getEnvUint32("DB_CIRCUIT_BREAKER_MAX_REQUESTS", 1),
+			Interval:         getEnvDuration("DB_CIRCUIT_BREAKER_INTERVAL", 60*time.Second),
+			Timeout:          getEnvDuration("DB_CIRCUIT_BREAKER_TIMEOUT", 30*time.Second),
+			FailureThreshold: getEnvUint32("DB_CIRCUIT_BREAKER_FAILURE_THRESHOLD", 5),
+		},
+		Retry: ConnectionRetryConfig{
+			MaxAttempts:     getEnvInt("DB_CONNECT_MAX_ATTEMPTS", 10),
+			MaxElapsedTime:  getEnvDuration("DB_CONNECT_MAX_ELAPSED_TIME", 60*time.Second),
+			InitialInterval: getEnvDuration("DB_CONNECT_INITIAL_INTERVAL", 500*time.Millisecond),
+			MaxInterval:     getEnvDuration("DB_CONNECT_MAX_INTERVAL", 10*time.Second),
+			ConnectTimeout:  getEnvDuration("DB_CONNECT_TIMEOUT", 5*time.Second),
+		},
+		Pool: ConnectionPoolConfig{
+			MaxOpenConns:    getEnvInt("DB_MAX_OPEN_CONNS", 25),
+			MaxIdleConns:    getEnvInt("DB_MAX_IDLE_CONNS", 5),
+			ConnMaxLifetime: getEnvDuration("DB_CONN_MAX_LIFETIME", 30*time.Minute),
+		},
+	}
+}
+
+// Validate は接続リトライ・コネクションプールの設定値を検証する
+// 複数の問題がある場合はすべてを errors.Join で結合して返す
+func (config *DatabaseConfig) Validate() error {
+	var errs []error
+
+	if config.Retry.MaxAttempts < 1 {
+		errs = append(errs, fmt.Errorf("DB_CONNECT_MAX_ATTEMPTS は1以上である必要があります（現在: %d）", config.Retry.MaxAttempts))
 	}
+	if config.Retry.MaxElapsedTime <= 0 {
+		errs = append(errs, fmt.Errorf("DB_CONNECT_MAX_ELAPSED_TIME は正の値である必要があります（現在: %s）", config.Retry.MaxElapsedTime))
+	}
+	if config.Retry.InitialInterval <= 0 {
+		errs = append(errs, fmt.Errorf("DB_CONNECT_INITIAL_INTERVAL は正の値である必要があります（現在: %s）", config.Retry.InitialInterval))
+	}
+	if config.Retry.MaxInterval < config.Retry.InitialInterval {
+		errs = append(errs, fmt.Errorf("DB_CONNECT_MAX_INTERVAL は DB_CONNECT_INITIAL_INTERVAL 以上である必要があります（現在: %s）", config.Retry.MaxInterval))
+	}
+	if config.Retry.ConnectTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("DB_CONNECT_TIMEOUT は正の値である必要があります（現在: %s）", config.Retry.ConnectTimeout))
+	}
+
+	if config.Pool.MaxOpenConns < 1 {
+		errs = append(errs, fmt.Errorf("DB_MAX_OPEN_CONNS は1以上である必要があります（現在: %d）", config.Pool.MaxOpenConns))
+	}
+	if config.Pool.MaxIdleConns < 0 || config.Pool.MaxIdleConns > config.Pool.MaxOpenConns {
+		errs = append(errs, fmt.Errorf("DB_MAX_IDLE_CONNS は0以上 DB_MAX_OPEN_CONNS 以下である必要があります（現在: %d）", config.Pool.MaxIdleConns))
+	}
+	if config.Pool.ConnMaxLifetime < 0 {
+		errs = append(errs, fmt.Errorf("DB_CONN_MAX_LIFETIME は0以上である必要があります（現在: %s）", config.Pool.ConnMaxLifetime))
+	}
+
+	return errors.Join(errs...)
 }
 
 func (config *DatabaseConfig) ConnectionString() string {
@@ -36,20 +120,88 @@ func (config *DatabaseConfig) ConnectionString() string {
 	)
 }
 
+// NewDatabaseConnection はデータベースに接続する
+// 接続できるまで Retry の設定に従って再試行し、コネクションプールを Pool の設定で構成する
+// サーバー・マイグレーション・シードのすべてのコマンドがこの関数で接続する
 func NewDatabaseConnection(config *DatabaseConfig) (*sql.DB, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("データベース設定が不正です: %w", err)
+	}
+
 	db, err := sql.Open("postgres", config.ConnectionString())
 	if err != nil {
 		return nil, fmt.Errorf("データベース接続の作成に失敗しました: %w", err)
 	}
 
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("データベースへの接続に失敗しました: %w", err)
+	db.SetMaxOpenConns(config.Pool.MaxOpenConns)
+	db.SetMaxIdleConns(config.Pool.MaxIdleConns)
+	db.SetConnMaxLifetime(config.Pool.ConnMaxLifetime)
+
+	log.Printf("データベース接続設定: host=%s port=%s dbname=%s 最大試行回数=%d 最大経過時間=%s 接続タイムアウト=%s 最大接続数=%d 最大アイドル接続数=%d 接続の最大再利用時間=%s",
+		config.Host, config.Port, config.DBName,
+		config.Retry.MaxAttempts, config.Retry.MaxElapsedTime, config.Retry.ConnectTimeout,
+		config.Pool.MaxOpenConns, config.Pool.MaxIdleConns, config.Pool.ConnMaxLifetime)
+
+	attempts, err := pingWithRetry(context.Background(), db, config.Retry)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("データベースへの接続に失敗しました（%d回試行）: %w", attempts, err)
 	}
 
-	log.Println("データベースに正常に接続しました")
+	log.Printf("データベースに正常に接続しました（%d回目の試行）", attempts)
 	return db, nil
 }
 
+// pingWithRetry は接続できるまで指数バックオフで再試行し、試行回数を返す
+// 最大試行回数に達するか、次の待機で最大経過時間を超える場合は最後のエラーを返す
+func pingWithRetry(ctx context.Context, db *sql.DB, retry ConnectionRetryConfig) (int, error) {
+	start := time.Now()
+	interval := retry.InitialInterval
+
+	for attempt := 1; ; attempt++ {
+		pingCtx, cancel := context.WithTimeout(ctx, retry.ConnectTimeout)
+		err := db.PingContext(pingCtx)
+		cancel()
+		if err == nil {
+			return attempt, nil
+		}
+
+		if attempt >= retry.MaxAttempts {
+			return attempt, err
+		}
+
+		wait := withJitter(interval)
+		if time.Since(start)+wait > retry.MaxElapsedTime {
+			return attempt, err
+		}
+
+		log.Printf("データベースへの接続に失敗しました（%d/%d回目）。%s後に再試行します: %v", attempt, retry.MaxAttempts, wait.Round(time.Millisecond), err)
+
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		interval = min(interval*2, retry.MaxInterval)
+	}
+}
+
+// withJitter は待機時間を [interval/2, interval] の範囲でランダムにずらす
+// 複数のインスタンスが同時に再起動した場合に接続試行が集中しないようにする
+func withJitter(interval time.Duration) time.Duration {
+	half := interval / 2
+	return half + rand.N(half+1)
+}
+
+// getEnvUint32 は正の整数の環境変数を取得する（不正な値・0以下の場合はデフォルト値）
+func getEnvUint32(key string, defaultValue uint32) uint32 {
+	if value := getEnvInt(key, int(defaultValue)); value > 0 {
+		return uint32(value)
+	}
+	return defaultValue
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startFlakyPostgres は最初の refuse 回の接続を即座に切断し、それ以降は認証なしで接続を受け付ける
+// 最小限の PostgreSQL サーバーを起動し、接続先のホスト・ポートと受け付けた接続数のカウンタを返す
+func startFlakyPostgres(t *testing.T, refuse int32) (string, string, *atomic.Int32) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	var connections atomic.Int32
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			if connections.Add(1) <= refuse {
+				conn.Close()
+				continue
+			}
+			go serveFakePostgres(conn)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	return host, port, &connections
+}
+
+// serveFakePostgres は起動メッセージに認証成功を返し、以降のクエリには空の結果を返す
+func serveFakePostgres(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	// StartupMessage: 長さ(4バイト) + 本文
+	var length int32
+	if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+		return
+	}
+	if _, err := io.CopyN(io.Discard, reader, int64(length-4)); err != nil {
+		return
+	}
+	// AuthenticationOk + ReadyForQuery
+	conn.Write([]byte{'R', 0, 0, 0, 8, 0, 0, 0, 0, 'Z', 0, 0, 0, 5, 'I'})
+
+	for {
+		msgType, err := reader.ReadByte()
+		if err != nil {
+			return
+		}
+		if err := binary.Read(reader, binary.BigEndian, &length); err != nil {
+			return
+		}
+		if _, err := io.CopyN(io.Discard, reader, int64(length-4)); err != nil {
+			return
+		}
+		switch msgType {
+		case 'Q':
+			// EmptyQueryResponse + ReadyForQuery
+			conn.Write([]byte{'I', 0, 0, 0, 4, 'Z', 0, 0, 0, 5, 'I'})
+		case 'X':
+			return
+		}
+	}
+}
+
+// newTestDatabaseConfig は短い待機時間で再試行する接続設定を返す
+func newTestDatabaseConfig(host, port string, maxAttempts int) *DatabaseConfig {
+	return &DatabaseConfig{
+		Host:     host,
+		Port:     port,
+		User:     "postgres",
+		Password: "password",
+		DBName:   "financial_planning",
+		SSLMode:  "disable",
+		Retry: ConnectionRetryConfig{
+			MaxAttempts:     maxAttempts,
+			MaxElapsedTime:  10 * time.Second,
+			InitialInterval: time.Millisecond,
+			MaxInterval:     5 * time.Millisecond,
+			ConnectTimeout:  time.Second,
+		},
+		Pool: ConnectionPoolConfig{
+			MaxOpenConns:    1,
+			MaxIdleConns:    1,
+			ConnMaxLifetime: time.Minute,
+		},
+	}
+}
+
+func TestNewDatabaseConnection_Retry(t *testing.T) {
+	t.Run("接続を拒否されても再試行して接続できる", func(t *testing.T) {
+		host, port, connections := startFlakyPostgres(t, 3)
+
+		db, err := NewDatabaseConnection(newTestDatabaseConfig(host, port, 5))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		defer db.Close()
+
+		if got := connections.Load(); got != 4 {
+			t.Errorf("Expected 4 connection attempts, got %d", got)
+		}
+	})
+
+	t.Run("最大試行回数に達した場合は試行回数を含むエラーを返す", func(t *testing.T) {
+		host, port, connections := startFlakyPostgres(t, 10)
+
+		_, err := NewDatabaseConnection(newTestDatabaseConfig(host, port, 3))
+		if err == nil {
+			t.Fatal("Expected error but got none")
+		}
+		if !strings.Contains(err.Error(), "3回試行") {
+			t.Errorf("Expected error to mention attempts, got %v", err)
+		}
+		if got := connections.Load(); got != 3 {
+			t.Errorf("Expected 3 connection attempts, got %d", got)
+		}
+	})
+
+	t.Run("次の待機で最大経過時間を超える場合は再試行しない", func(t *testing.T) {
+		host, port, connections := startFlakyPostgres(t, 10)
+		config := newTestDatabaseConfig(host, port, 10)
+		config.Retry.InitialInterval = time.Second
+		config.Retry.MaxInterval = time.Second
+		config.Retry.MaxElapsedTime = 100 * time.Millisecond
+
+		_, err := NewDatabaseConnection(config)
+		if err == nil || !strings.Contains(err.Error(), "1回試行") {
+			t.Errorf("Expected error after 1 attempt, got %v", err)
+		}
+		if got := connections.Load(); got != 1 {
+			t.Errorf("Expected 1 connection attempt, got %d", got)
+		}
+	})
+}
+
+func TestNewDatabaseConfig_RetryAndPool(t *testing.T) {
+	t.Run("環境変数から読み込む", func(t *testing.T) {
+		t.Setenv("DB_CONNECT_MAX_ATTEMPTS", "7")
+		t.Setenv("DB_CONNECT_MAX_ELAPSED_TIME", "2m")
+		t.Setenv("DB_CONNECT_INITIAL_INTERVAL", "250ms")
+		t.Setenv("DB_CONNECT_MAX_INTERVAL", "5s")
+		t.Setenv("DB_CONNECT_TIMEOUT", "3s")
+		t.Setenv("DB_MAX_OPEN_CONNS", "40")
+		t.Setenv("DB_MAX_IDLE_CONNS", "10")
+		t.Setenv("DB_CONN_MAX_LIFETIME", "1h")
+
+		config := NewDatabaseConfig()
+
+		expectedRetry := ConnectionRetryConfig{
+			MaxAttempts:     7,
+			MaxElapsedTime:  2 * time.Minute,
+			InitialInterval: 250 * time.Millisecond,
+			MaxInterval:     5 * time.Second,
+			ConnectTimeout:  3 * time.Second,
+		}
+		if config.Retry != expectedRetry {
+			t.Errorf("Expected retry config %+v, got %+v", expectedRetry, config.Retry)
+		}
+		expectedPool := ConnectionPoolConfig{MaxOpenConns: 40, MaxIdleConns: 10, ConnMaxLifetime: time.Hour}
+		if config.Pool != expectedPool {
+			t.Errorf("Expected pool config %+v, got %+v", expectedPool, config.Pool)
+		}
+		if err := config.Validate(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("未設定・不正な形式の場合はデフォルト値", func(t *testing.T) {
+		t.Setenv("DB_CONNECT_MAX_ATTEMPTS", "many")
+		t.Setenv("DB_CONNECT_MAX_ELAPSED_TIME", "60")
+
+		config := NewDatabaseConfig()
+
+		if config.Retry.MaxAttempts != 10 || config.Retry.MaxElapsedTime != 60*time.Second {
+			t.Errorf("Expected default retry config, got %+v", config.Retry)
+		}
+		if err := config.Validate(); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name        string
+		key         string
+		value       string
+		expectError string
+	}{
+		{"最大試行回数が0", "DB_CONNECT_MAX_ATTEMPTS", "0", "DB_CONNECT_MAX_ATTEMPTS"},
+		{"最大経過時間が負", "DB_CONNECT_MAX_ELAPSED_TIME", "-1s", "DB_CONNECT_MAX_ELAPSED_TIME"},
+		{"最大待機時間が初回より短い", "DB_CONNECT_MAX_INTERVAL", "100ms", "DB_CONNECT_MAX_INTERVAL"},
+		{"接続タイムアウトが0", "DB_CONNECT_TIMEOUT", "0s", "DB_CONNECT_TIMEOUT"},
+		{"最大接続数が0", "DB_MAX_OPEN_CONNS", "0", "DB_MAX_OPEN_CONNS"},
+		{"最大アイドル接続数が最大接続数を超える", "DB_MAX_IDLE_CONNS", "30", "DB_MAX_IDLE_CONNS"},
+		{"接続の最大再利用時間が負", "DB_CONN_MAX_LIFETIME", "-1m", "DB_CONN_MAX_LIFETIME"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.key, tt.value)
+
+			err := NewDatabaseConfig().Validate()
+			if err == nil {
+				t.Fatalf("Expected error containing %q but got none", tt.expectError)
+			}
+			if !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
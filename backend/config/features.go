@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// featureEnvPrefix は機能フラグを切り替える環境変数の接頭辞（例: FEATURE_REPORT_HISTORY=true）
+const featureEnvPrefix = "FEATURE_"
+
+// 機能フラグ名（環境変数は FEATURE_ + 大文字の名前）
+const (
+	FeatureCookieAuth          = "cookie_auth"           // Cookie によるトークン管理
+	FeatureReportHistory       = "report_history"        // 生成したレポートの保存と履歴表示
+	FeatureHouseholdMode       = "household_mode"        // 世帯単位の財務計画
+	FeatureHighReturnGuardrail = "high_return_guardrail" // 想定利回り・インフレ率の上限チェック
+)
+
+// featureDefinition は機能フラグの既定値と公開範囲
+type featureDefinition struct {
+	defaultEnabled bool
+	public         bool // GET /api/v1/features でフロントエンドに公開するか
+}
+
+// featureDefinitions は既知の機能フラグ。ここにない名前の環境変数は起動時に警告する
+var featureDefinitions = map[string]featureDefinition{
+	FeatureCookieAuth:          {defaultEnabled: false, public: true},
+	FeatureReportHistory:       {defaultEnabled: false, public: true},
+	FeatureHouseholdMode:       {defaultEnabled: false, public: true},
+	FeatureHighReturnGuardrail: {defaultEnabled: true, public: true},
+}
+
+// FeatureFlags は環境変数から読み込んだ機能フラグの状態
+type FeatureFlags struct {
+	enabled  map[string]bool
+	warnings []string
+}
+
+// LoadFeatureFlags は FEATURE_* 環境変数から機能フラグを読み込む
+func LoadFeatureFlags() *FeatureFlags {
+	return loadFeatureFlags(os.Environ())
+}
+
+// loadFeatureFlags は "KEY=VALUE" 形式の環境変数一覧から機能フラグを読み込む
+// 未知のフラグ名と真偽値として解釈できない値は既定値のまま警告に記録する
+func loadFeatureFlags(environ []string) *FeatureFlags {
+	flags := NewFeatureFlags(nil)
+
+	for _, entry := range environ {
+		key, value, found := strings.Cut(entry, "=")
+		if !found || !strings.HasPrefix(key, featureEnvPrefix) {
+			continue
+		}
+
+		name := strings.ToLower(strings.TrimPrefix(key, featureEnvPrefix))
+		if _, known := featureDefinitions[name]; !known {
+			flags.warnings = append(flags.warnings, fmt.Sprintf("未知の機能フラグです: %s", key))
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			flags.warnings = append(flags.warnings, fmt.Sprintf("%s は true または false である必要があります（現在: %q）", key, value))
+			continue
+		}
+		flags.enabled[name] = enabled
+	}
+
+	sort.Strings(flags.warnings)
+	return flags
+}
+
+// NewFeatureFlags は既定値に overrides を上書きした機能フラグを作成する（テスト用）
+// 未知のフラグ名は無視して警告に記録する
+func NewFeatureFlags(overrides map[string]bool) *FeatureFlags {
+	flags := &FeatureFlags{enabled: make(map[string]bool, len(featureDefinitions))}
+	for name, definition := range featureDefinitions {
+		flags.enabled[name] = definition.defaultEnabled
+	}
+
+	for name, enabled := range overrides {
+		if _, known := featureDefinitions[name]; !known {
+			flags.warnings = append(flags.warnings, fmt.Sprintf("未知の機能フラグです: %s", name))
+			continue
+		}
+		flags.enabled[name] = enabled
+	}
+
+	sort.Strings(flags.warnings)
+	return flags
+}
+
+// IsEnabled は機能が有効かを返す。未知のフラグ名は無効として扱う
+// nil の場合は既定値を返す
+func (f *FeatureFlags) IsEnabled(name string) bool {
+	if f == nil {
+		return featureDefinitions[name].defaultEnabled
+	}
+	return f.enabled[name]
+}
+
+// Public はフロントエンドに公開する機能フラグの状態を返す
+func (f *FeatureFlags) Public() map[string]bool {
+	public := make(map[string]bool, len(featureDefinitions))
+	for name, definition := range featureDefinitions {
+		if definition.public {
+			public[name] = f.IsEnabled(name)
+		}
+	}
+	return public
+}
+
+// Warnings は読み込み時に無視した設定の警告を返す
+func (f *FeatureFlags) Warnings() []string {
+	if f == nil {
+		return nil
+	}
+	return f.warnings
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadFeatureFlags_Defaults(t *testing.T) {
+	flags := loadFeatureFlags(nil)
+
+	expected := map[string]bool{
+		FeatureCookieAuth:          false,
+		FeatureReportHistory:       false,
+		FeatureHouseholdMode:       false,
+		FeatureHighReturnGuardrail: true,
+	}
+	for name, want := range expected {
+		if got := flags.IsEnabled(name); got != want {
+			t.Errorf("Expected %s to be %v, got %v", name, want, got)
+		}
+	}
+	if len(flags.Warnings()) != 0 {
+		t.Errorf("Expected no warnings, got %v", flags.Warnings())
+	}
+	if flags.IsEnabled("unknown_feature") {
+		t.Error("Expected unknown feature to be disabled")
+	}
+}
+
+func TestLoadFeatureFlags_EnvOverrides(t *testing.T) {
+	flags := loadFeatureFlags([]string{
+		"FEATURE_REPORT_HISTORY=true",
+		"FEATURE_HIGH_RETURN_GUARDRAIL=false",
+		"FEATURE_HOUSEHOLD_MODE=yes",
+		"FEATURE_DARK_MODE=true",
+		"PORT=8080",
+	})
+
+	if !flags.IsEnabled(FeatureReportHistory) {
+		t.Error("Expected report_history to be enabled")
+	}
+	if flags.IsEnabled(FeatureHighReturnGuardrail) {
+		t.Error("Expected high_return_guardrail to be disabled")
+	}
+	// 真偽値として解釈できない値は既定値のまま
+	if flags.IsEnabled(FeatureHouseholdMode) {
+		t.Error("Expected household_mode to keep its default")
+	}
+
+	warnings := strings.Join(flags.Warnings(), "\n")
+	for _, key := range []string{"FEATURE_DARK_MODE", "FEATURE_HOUSEHOLD_MODE"} {
+		if !strings.Contains(warnings, key) {
+			t.Errorf("Expected warning for %s, got %q", key, warnings)
+		}
+	}
+	if len(flags.Warnings()) != 2 {
+		t.Errorf("Expected 2 warnings, got %v", flags.Warnings())
+	}
+}
+
+func TestFeatureFlags_Public(t *testing.T) {
+	flags := NewFeatureFlags(map[string]bool{FeatureCookieAuth: true})
+
+	public := flags.Public()
+	if len(public) != len(featureDefinitions) {
+		t.Errorf("Expected %d public flags, got %v", len(featureDefinitions), public)
+	}
+	if !public[FeatureCookieAuth] {
+		t.Error("Expected cookie_auth to be exposed as enabled")
+	}
+
+	var nilFlags *FeatureFlags
+	if !nilFlags.IsEnabled(FeatureHighReturnGuardrail) || nilFlags.IsEnabled(FeatureReportHistory) {
+		t.Error("Expected nil flags to return defaults")
+	}
+}
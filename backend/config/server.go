@@ -1,18 +1,37 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 )
 
+// 実行環境（APP_ENV）
+const (
+	EnvironmentDevelopment = "development"
+	EnvironmentProduction  = "production"
+)
+
+// 廃止予定の user_id クエリパラメータの扱い（LEGACY_USER_ID_PARAM）
+const (
+	LegacyUserIDParamAllow  = "allow"  // 許容する
+	LegacyUserIDParamWarn   = "warn"   // 許容し、レスポンスの warnings で警告する
+	LegacyUserIDParamReject = "reject" // 400 で拒否する
+)
+
 // ServerConfig holds server configuration
 type ServerConfig struct {
+	Environment         string // APP_ENV（development / production）
 	Port                string
 	Debug               bool
-	AllowedOrigins      []string
+	AllowedOrigins      []string // ALLOWED_ORIGINS（カンマ区切り。前後の空白は無視する）
 	CORSMaxAge          int
+	// CORS で Cookie などの認証情報の送信を許可するか（許可する場合は ALLOWED_ORIGINS に * を指定できない）
+	CORSAllowCredentials bool // CORS_ALLOW_CREDENTIALS
 	RateLimitRPS        int
 	RateLimitBurst      int
 	AuthRateLimitRPS    int
@@ -30,6 +49,14 @@ type ServerConfig struct {
 	TempFileSecret      string
 	TempFileExpiry      time.Duration
 	CleanupInterval     time.Duration
+	// 目標の画像などアップロードファイルの保存先と参照URL
+	UploadDir           string
+	UploadBaseURL       string
+	// レポートに埋め込むチャート画像（幅・高さは CHART_DPI=96 で描画した場合のピクセル数。0以下の場合は既定値を使用する）
+	ChartWidth    int     // CHART_WIDTH
+	ChartHeight   int     // CHART_HEIGHT
+	ChartDPI      float64 // CHART_DPI（大きくすると同じレイアウトのまま高解像度の画像になる）
+	ChartFontPath string  // CHART_FONT_PATH（日本語のグリフを含む TrueType フォント。未設定の場合、チャートの日本語は表示されない）
 	// Basic Authentication
 	EnableBasicAuth     bool
 	BasicAuthUsername   string
@@ -64,18 +91,52 @@ type ServerConfig struct {
 	GroqAPIKey string // GROQ_API_KEY
 	GroqModel  string // GROQ_MODEL (例: "llama3-8b-8192")
 	FAQDir     string // FAQ_DIR (例: "docs/faq")
+	// 財務アドバイスのLLM設定（LLMEndpointURL が未設定の場合は定型のアドバイスを返す）
+	LLMEndpointURL string // LLM_ENDPOINT_URL（OpenAI互換のチャット補完API）
+	LLMAPIKey      string // LLM_API_KEY
+	LLMModel       string // LLM_MODEL
 	// New Relic APM
 	NewRelicLicenseKey string // NEW_RELIC_LICENSE_KEY
 	NewRelicAppName    string // NEW_RELIC_APP_NAME
+	// Database（起動時の検証用。接続設定は DatabaseConfig を参照）
+	DBHost string // DB_HOST
+	DBPort string // DB_PORT
+	DBUser string // DB_USER
+	DBName string // DB_NAME
+	// 想定値の現実的な上限（年率%）。超える値は acknowledge_high_* による同意が必要
+	RealisticReturnCeiling    float64 // REALISTIC_RETURN_CEILING
+	RealisticInflationCeiling float64 // REALISTIC_INFLATION_CEILING
+	// 計算の前提値（0以下の場合は既定値を使用する）
+	CalcRetirementPlanningYears  int     // CALC_RETIREMENT_PLANNING_YEARS（退職までの期間が分からない場合に想定する年数）
+	CalcStandardInvestmentReturn float64 // CALC_STANDARD_INVESTMENT_RETURN（標準的とみなす想定利回り、年率%）
+	CalcRecommendedSavingsRate   float64 // CALC_RECOMMENDED_SAVINGS_RATE（理想的とみなす貯蓄率、%）
+	CalcEmergencyFundMonths      float64 // CALC_EMERGENCY_FUND_MONTHS（最低限確保すべき緊急資金の月数）
+	// 現在のリクエストスキーマのバージョン。API-Version ヘッダーで旧バージョンを指定したリクエストはこのバージョンに変換する
+	APIVersion string // API_VERSION
+	// 認証済みリクエストに付与された廃止予定の user_id クエリパラメータの扱い（allow | warn | reject、空の場合は allow）
+	LegacyUserIDParam string // LEGACY_USER_ID_PARAM
+	// バージョンなしの /api 以下へのリクエストを /api/v1 以下へ転送するか（移行期間が終わったら false にする）
+	LegacyRedirectEnabled bool // LEGACY_REDIRECT_ENABLED
+	// 2段階認証の必須化ポリシー（いずれも未設定の場合は必須化しない）
+	TwoFactorRequiredForAll         bool    // TWO_FACTOR_REQUIRED_FOR_ALL（組織ポリシーとして全ユーザーに必須化）
+	TwoFactorRequiredAssetThreshold float64 // TWO_FACTOR_REQUIRED_ASSET_THRESHOLD（総資産がこの額を超えるユーザーに必須化。0 の場合は無効）
+	TwoFactorGracePeriodDays        int     // TWO_FACTOR_GRACE_PERIOD_DAYS（必須化されてから有効化するまでの猶予日数）
+	// 同年代比較（匿名集計）
+	PeerBenchmarkMinGroupSize int           // PEER_BENCHMARK_MIN_GROUP_SIZE（これ未満の人数の区分は集計結果を返さない）
+	PeerBenchmarkCacheTTL     time.Duration // PEER_BENCHMARK_CACHE_TTL（区分ごとの集計結果をキャッシュする期間）
+	// 機能フラグ
+	Features *FeatureFlags // FEATURE_*
 }
 
 // LoadServerConfig loads server configuration from environment variables
 func LoadServerConfig() *ServerConfig {
 	config := &ServerConfig{
+		Environment:         getEnv("APP_ENV", EnvironmentDevelopment),
 		Port:                getEnv("PORT", "8080"),
 		Debug:               getEnvBool("DEBUG", false),
-		AllowedOrigins:      getEnvSlice("ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:3001", "https://localhost:3000", "https://localhost:3001"}),
+		AllowedOrigins:      getEnvOrigins("ALLOWED_ORIGINS", []string{"http://localhost:3000", "http://localhost:3001", "https://localhost:3000", "https://localhost:3001"}),
 		CORSMaxAge:          getEnvInt("CORS_MAX_AGE", 86400),
+		CORSAllowCredentials: getEnvBool("CORS_ALLOW_CREDENTIALS", true),
 		RateLimitRPS:        getEnvInt("RATE_LIMIT_RPS", 100),
 		RateLimitBurst:      getEnvInt("RATE_LIMIT_BURST", 50),
 		AuthRateLimitRPS:    getEnvInt("AUTH_RATE_LIMIT_RPS", 10),
@@ -93,18 +154,25 @@ func LoadServerConfig() *ServerConfig {
 		TempFileSecret:      getEnv("TEMP_FILE_SECRET", "change-this-secret-in-production"),
 		TempFileExpiry:      getEnvDuration("TEMP_FILE_EXPIRY", 24*time.Hour),
 		CleanupInterval:     getEnvDuration("CLEANUP_INTERVAL", 1*time.Hour),
+		UploadDir:           getEnv("UPLOAD_DIR", "/tmp/financial-planning-uploads"),
+		UploadBaseURL:       getEnv("UPLOAD_BASE_URL", "/uploads"),
+		// レポートのチャート画像
+		ChartWidth:    getEnvInt("CHART_WIDTH", 800),
+		ChartHeight:   getEnvInt("CHART_HEIGHT", 400),
+		ChartDPI:      getEnvFloat("CHART_DPI", 96),
+		ChartFontPath: getEnv("CHART_FONT_PATH", ""),
 		// Basic Authentication
 		EnableBasicAuth:     getEnvBool("ENABLE_BASIC_AUTH", false),
 		BasicAuthUsername:   getEnv("BASIC_AUTH_USERNAME", "admin"),
 		BasicAuthPassword:   getEnv("BASIC_AUTH_PASSWORD", "change-me"),
 		// JWT Authentication
-		JWTSecret:              getEnv("JWT_SECRET", "change-this-secret-in-production"),
+		JWTSecret:              getEnv("JWT_SECRET", defaultJWTSecret),
 		JWTExpiration:          getEnvDuration("JWT_EXPIRATION", 24*time.Hour),
 		RefreshTokenExpiration: getEnvDuration("REFRESH_TOKEN_EXPIRATION", 7*24*time.Hour), // 7日間
 		// GitHub OAuth
 		GitHubClientID:       getEnv("GITHUB_CLIENT_ID", ""),
 		GitHubClientSecret:   getEnv("GITHUB_CLIENT_SECRET", ""),
-		GitHubCallbackURL:    getEnv("GITHUB_CALLBACK_URL", "http://localhost:8080/api/auth/github/callback"),
+		GitHubCallbackURL:    getEnv("GITHUB_CALLBACK_URL", "http://localhost:8080/api/v1/auth/github/callback"),
 		OAuthSuccessRedirect: getEnv("OAUTH_SUCCESS_REDIRECT", "http://localhost:3000/auth/callback"),
 		OAuthFailureRedirect: getEnv("OAUTH_FAILURE_REDIRECT", "http://localhost:3000/login?error=oauth_failed"),
 		// Cookie Security
@@ -115,7 +183,7 @@ func LoadServerConfig() *ServerConfig {
 		WebAuthnRPOrigin:     getEnv("WEBAUTHN_RP_ORIGIN", "http://localhost:3000"),
 		// CSP: バックエンドはAPIサーバーのためHTMLを返さない厳格なポリシーをデフォルトとする
 		// 本番環境では CONTENT_SECURITY_POLICY 環境変数で上書き可能
-		// 開発環境では ENABLE_SECURE_HEADERS=false でヘッダー自体を無効化する
+		// Swagger UI には表示に必要な範囲で緩めた CSP を別途適用する（web.DefaultSwaggerContentSecurityPolicy）
 		ContentSecurityPolicy: getEnv("CONTENT_SECURITY_POLICY", "default-src 'none'; frame-ancestors 'none'; form-action 'none'"),
 		// SMTP メール設定（デフォルトはResend）
 		SMTPHost:     getEnv("SMTP_HOST", "smtp.resend.com"),
@@ -129,14 +197,183 @@ func LoadServerConfig() *ServerConfig {
 		GroqAPIKey: getEnv("GROQ_API_KEY", ""),
 		GroqModel:  getEnv("GROQ_MODEL", "llama3-8b-8192"),
 		FAQDir:     getEnv("FAQ_DIR", "docs/faq"),
+		// 財務アドバイスのLLM設定
+		LLMEndpointURL: getEnv("LLM_ENDPOINT_URL", ""),
+		LLMAPIKey:      getEnv("LLM_API_KEY", ""),
+		LLMModel:       getEnv("LLM_MODEL", ""),
 		// New Relic APM
 		NewRelicLicenseKey: getEnv("NEW_RELIC_LICENSE_KEY", ""),
 		NewRelicAppName:    getEnv("NEW_RELIC_APP_NAME", "financial-planning-calculator"),
+		// Database: 未設定を検出するためデフォルト値を使わない
+		DBHost: os.Getenv("DB_HOST"),
+		DBPort: os.Getenv("DB_PORT"),
+		DBUser: os.Getenv("DB_USER"),
+		DBName: os.Getenv("DB_NAME"),
+		// 想定値の現実的な上限
+		RealisticReturnCeiling:    getEnvFloat("REALISTIC_RETURN_CEILING", 8.0),
+		RealisticInflationCeiling: getEnvFloat("REALISTIC_INFLATION_CEILING", 10.0),
+		// 計算の前提値
+		CalcRetirementPlanningYears:  getEnvInt("CALC_RETIREMENT_PLANNING_YEARS", 30),
+		CalcStandardInvestmentReturn: getEnvFloat("CALC_STANDARD_INVESTMENT_RETURN", 5.0),
+		CalcRecommendedSavingsRate:   getEnvFloat("CALC_RECOMMENDED_SAVINGS_RATE", 20.0),
+		CalcEmergencyFundMonths:      getEnvFloat("CALC_EMERGENCY_FUND_MONTHS", 3.0),
+		// リクエストスキーマのバージョン
+		APIVersion: getEnv("API_VERSION", "v2"),
+		// 廃止予定の user_id クエリパラメータの扱い
+		LegacyUserIDParam: getEnv("LEGACY_USER_ID_PARAM", LegacyUserIDParamAllow),
+		// バージョンなしのパスの転送
+		LegacyRedirectEnabled: getEnvBool("LEGACY_REDIRECT_ENABLED", true),
+		// 2段階認証の必須化ポリシー
+		TwoFactorRequiredForAll:         getEnvBool("TWO_FACTOR_REQUIRED_FOR_ALL", false),
+		TwoFactorRequiredAssetThreshold: getEnvFloat("TWO_FACTOR_REQUIRED_ASSET_THRESHOLD", 0),
+		TwoFactorGracePeriodDays:        getEnvInt("TWO_FACTOR_GRACE_PERIOD_DAYS", 14),
+		// 同年代比較（匿名集計）
+		PeerBenchmarkMinGroupSize: getEnvInt("PEER_BENCHMARK_MIN_GROUP_SIZE", 50),
+		PeerBenchmarkCacheTTL:     getEnvDuration("PEER_BENCHMARK_CACHE_TTL", time.Hour),
+		// 機能フラグ
+		Features: LoadFeatureFlags(),
 	}
 
 	return config
 }
 
+// minJWTSecretLength はJWT署名鍵の最小文字数
+const minJWTSecretLength = 32
+
+// defaultJWTSecret は JWT_SECRET 未設定時に使われるデフォルト値
+const defaultJWTSecret = "change-this-secret-in-production"
+
+// IsProduction は本番モードで起動しているかを返す
+func (c *ServerConfig) IsProduction() bool {
+	return c.Environment == EnvironmentProduction
+}
+
+// Validate は必須設定の欠落・不正値をまとめて検証する
+// 複数の問題がある場合はすべてを errors.Join で結合して返す
+func (c *ServerConfig) Validate() error {
+	return errors.Join(c.validationErrors()...)
+}
+
+// ValidateOnStartup は起動時に設定を検証する
+// 本番モードでは問題があればエラーを返して起動を中止させ、開発モードでは警告として返すに留める
+func (c *ServerConfig) ValidateOnStartup() ([]string, error) {
+	errs := c.validationErrors()
+	if c.IsProduction() {
+		return nil, errors.Join(errs...)
+	}
+
+	warnings := make([]string, 0, len(errs))
+	for _, err := range errs {
+		warnings = append(warnings, err.Error())
+	}
+	return warnings, nil
+}
+
+// validationErrors は設定の問題を1項目ずつ返す
+func (c *ServerConfig) validationErrors() []error {
+	var errs []error
+
+	if c.Environment != EnvironmentDevelopment && c.Environment != EnvironmentProduction {
+		errs = append(errs, fmt.Errorf("APP_ENV は %s または %s である必要があります（現在: %q）", EnvironmentDevelopment, EnvironmentProduction, c.Environment))
+	}
+
+	switch {
+	case c.JWTSecret == "" || c.JWTSecret == defaultJWTSecret:
+		errs = append(errs, errors.New("JWT_SECRET が未設定です（デフォルト値のままでは起動できません）"))
+	case len(c.JWTSecret) < minJWTSecretLength:
+		errs = append(errs, fmt.Errorf("JWT_SECRET は%d文字以上である必要があります（現在: %d文字）", minJWTSecretLength, len(c.JWTSecret)))
+	}
+
+	if !isValidPort(c.Port) {
+		errs = append(errs, fmt.Errorf("PORT は1〜65535の数値である必要があります（現在: %q）", c.Port))
+	}
+
+	hasOrigin := false
+	for _, origin := range c.AllowedOrigins {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+		hasOrigin = true
+		if origin == AllowAllOrigins {
+			if c.CORSAllowCredentials {
+				errs = append(errs, errors.New("ALLOWED_ORIGINS の * は CORS_ALLOW_CREDENTIALS=true と併用できません"))
+			}
+			continue
+		}
+		if err := validateOrigin(origin); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !hasOrigin {
+		errs = append(errs, errors.New("ALLOWED_ORIGINS には1つ以上のオリジンを指定する必要があります"))
+	}
+
+	if strings.TrimSpace(c.DBHost) == "" {
+		errs = append(errs, errors.New("DB_HOST は必須です"))
+	}
+	if c.DBPort != "" && !isValidPort(c.DBPort) {
+		errs = append(errs, fmt.Errorf("DB_PORT は1〜65535の数値である必要があります（現在: %q）", c.DBPort))
+	}
+	if strings.TrimSpace(c.DBUser) == "" {
+		errs = append(errs, errors.New("DB_USER は必須です"))
+	}
+	if strings.TrimSpace(c.DBName) == "" {
+		errs = append(errs, errors.New("DB_NAME は必須です"))
+	}
+
+	switch c.LegacyUserIDParam {
+	case "", LegacyUserIDParamAllow, LegacyUserIDParamWarn, LegacyUserIDParamReject:
+	default:
+		errs = append(errs, fmt.Errorf("LEGACY_USER_ID_PARAM は %s・%s・%s のいずれかである必要があります（現在: %q）",
+			LegacyUserIDParamAllow, LegacyUserIDParamWarn, LegacyUserIDParamReject, c.LegacyUserIDParam))
+	}
+
+	if c.TwoFactorRequiredAssetThreshold < 0 {
+		errs = append(errs, fmt.Errorf("TWO_FACTOR_REQUIRED_ASSET_THRESHOLD は0以上である必要があります（現在: %v）", c.TwoFactorRequiredAssetThreshold))
+	}
+	if c.TwoFactorGracePeriodDays < 0 {
+		errs = append(errs, fmt.Errorf("TWO_FACTOR_GRACE_PERIOD_DAYS は0以上である必要があります（現在: %d）", c.TwoFactorGracePeriodDays))
+	}
+
+	return errs
+}
+
+// isValidPort はポート番号が1〜65535の数値かを返す
+func isValidPort(value string) bool {
+	port, err := strconv.Atoi(value)
+	return err == nil && port >= 1 && port <= 65535
+}
+
+// validateOrigin は許可オリジンが「http(s)://ホスト[:ポート]」の形式かを検証する
+func validateOrigin(origin string) error {
+	u, err := url.Parse(origin)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" ||
+		(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return fmt.Errorf("ALLOWED_ORIGINS のオリジンは http(s)://ホスト[:ポート] の形式である必要があります（現在: %q）", origin)
+	}
+	if port := u.Port(); port != "" && !isValidPort(port) {
+		return fmt.Errorf("ALLOWED_ORIGINS のオリジンのポートが不正です（現在: %q）", origin)
+	}
+	return nil
+}
+
+// AllowAllOrigins はすべてのオリジンを許可する ALLOWED_ORIGINS の値（認証情報の送信を許可しない場合のみ指定できる）
+const AllowAllOrigins = "*"
+
+// ParseAllowedOrigins はカンマ区切りの許可オリジンを分割する
+// 各要素の前後の空白と末尾の / を取り除き、空の要素は無視する（例: " https://a.example/, ,https://b.example " → 2件）
+// ブラウザが送る Origin ヘッダーは末尾に / を含まないため、取り除かないと一致しない
+func ParseAllowedOrigins(value string) []string {
+	origins := make([]string, 0)
+	for _, origin := range strings.Split(value, ",") {
+		if origin = strings.TrimSuffix(strings.TrimSpace(origin), "/"); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
 // Helper functions for environment variable parsing
 
 func getEnvBool(key string, defaultValue bool) bool {
@@ -157,6 +394,15 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+			return parsed
+		}
+	}
+	return defaultValue
+}
+
 func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
 		if parsed, err := time.ParseDuration(value); err == nil {
@@ -166,9 +412,9 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	return defaultValue
 }
 
-func getEnvSlice(key string, defaultValue []string) []string {
+func getEnvOrigins(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
-		return strings.Split(value, ",")
+		return ParseAllowedOrigins(value)
 	}
 	return defaultValue
 }
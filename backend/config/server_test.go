@@ -0,0 +1,201 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+// setValidEnv は検証をすべて通過する環境変数を設定し、元に戻す関数を返す
+func setValidEnv(t *testing.T) func() {
+	t.Helper()
+	valid := map[string]string{
+		"JWT_SECRET":      "0123456789abcdef0123456789abcdef",
+		"PORT":            "8080",
+		"ALLOWED_ORIGINS": "http://localhost:3000",
+		"DB_HOST":         "localhost",
+		"DB_USER":         "postgres",
+		"DB_NAME":         "financial_planning",
+		"DB_PORT":         "5432",
+		"APP_ENV":         "production",
+		// テストケースで変更する項目も元に戻せるように設定する
+		"LEGACY_USER_ID_PARAM":                "allow",
+		"CORS_ALLOW_CREDENTIALS":              "true",
+		"TWO_FACTOR_REQUIRED_ASSET_THRESHOLD": "0",
+		"TWO_FACTOR_GRACE_PERIOD_DAYS":        "14",
+	}
+
+	originals := make(map[string]*string, len(valid))
+	for key, value := range valid {
+		if original, ok := os.LookupEnv(key); ok {
+			originals[key] = &original
+		} else {
+			originals[key] = nil
+		}
+		os.Setenv(key, value)
+	}
+
+	return func() {
+		for key, original := range originals {
+			if original == nil {
+				os.Unsetenv(key)
+			} else {
+				os.Setenv(key, *original)
+			}
+		}
+	}
+}
+
+func TestServerConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		value       string
+		expectError string // 空の場合はエラーなし
+	}{
+		{"すべて有効", "", "", ""},
+		{"JWT_SECRETが32文字ちょうど", "JWT_SECRET", strings.Repeat("a", 32), ""},
+		{"JWT_SECRETが32文字未満", "JWT_SECRET", strings.Repeat("a", 31), "JWT_SECRET"},
+		{"JWT_SECRETが未設定", "JWT_SECRET", "", "JWT_SECRET が未設定"},
+		{"PORTが下限", "PORT", "1", ""},
+		{"PORTが上限", "PORT", "65535", ""},
+		{"PORTが0", "PORT", "0", "PORT"},
+		{"PORTが範囲外", "PORT", "65536", "PORT"},
+		{"PORTが数値でない", "PORT", "http", "PORT"},
+		{"ALLOWED_ORIGINSが空要素のみ", "ALLOWED_ORIGINS", " , ", "ALLOWED_ORIGINS"},
+		{"ALLOWED_ORIGINSが複数", "ALLOWED_ORIGINS", "https://example.com,http://localhost:3000", ""},
+		{"ALLOWED_ORIGINSが空白区切りを含む", "ALLOWED_ORIGINS", " https://example.com , http://localhost:3000/ ", ""},
+		{"ALLOWED_ORIGINSにスキームがない", "ALLOWED_ORIGINS", "example.com", "ALLOWED_ORIGINS"},
+		{"ALLOWED_ORIGINSにパスを含む", "ALLOWED_ORIGINS", "https://example.com/app", "ALLOWED_ORIGINS"},
+		{"ALLOWED_ORIGINSがワイルドカード（認証情報の送信を許可）", "ALLOWED_ORIGINS", "*", "CORS_ALLOW_CREDENTIALS"},
+		{"LEGACY_USER_ID_PARAMが未設定", "LEGACY_USER_ID_PARAM", "", ""},
+		{"LEGACY_USER_ID_PARAMがreject", "LEGACY_USER_ID_PARAM", "reject", ""},
+		{"LEGACY_USER_ID_PARAMが不正", "LEGACY_USER_ID_PARAM", "deny", "LEGACY_USER_ID_PARAM"},
+		{"TWO_FACTOR_REQUIRED_ASSET_THRESHOLDが正の値", "TWO_FACTOR_REQUIRED_ASSET_THRESHOLD", "50000000", ""},
+		{"TWO_FACTOR_REQUIRED_ASSET_THRESHOLDが負の値", "TWO_FACTOR_REQUIRED_ASSET_THRESHOLD", "-1", "TWO_FACTOR_REQUIRED_ASSET_THRESHOLD"},
+		{"TWO_FACTOR_GRACE_PERIOD_DAYSが0", "TWO_FACTOR_GRACE_PERIOD_DAYS", "0", ""},
+		{"TWO_FACTOR_GRACE_PERIOD_DAYSが負の値", "TWO_FACTOR_GRACE_PERIOD_DAYS", "-7", "TWO_FACTOR_GRACE_PERIOD_DAYS"},
+		{"DB_HOSTが未設定", "DB_HOST", "", "DB_HOST"},
+		{"DB_USERが未設定", "DB_USER", "", "DB_USER"},
+		{"DB_NAMEが未設定", "DB_NAME", "", "DB_NAME"},
+		{"DB_PORTが数値でない", "DB_PORT", "postgres", "DB_PORT"},
+		{"APP_ENVが不正", "APP_ENV", "staging", "APP_ENV"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := setValidEnv(t)
+			defer restore()
+
+			if tt.key != "" {
+				if tt.value == "" {
+					os.Unsetenv(tt.key)
+				} else {
+					os.Setenv(tt.key, tt.value)
+				}
+			}
+
+			err := LoadServerConfig().Validate()
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Expected error containing %q but got none", tt.expectError)
+			}
+			if !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestServerConfig_Validate_WildcardOriginWithoutCredentials(t *testing.T) {
+	restore := setValidEnv(t)
+	defer restore()
+	os.Setenv("ALLOWED_ORIGINS", "*")
+	os.Setenv("CORS_ALLOW_CREDENTIALS", "false")
+
+	if err := LoadServerConfig().Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestParseAllowedOrigins(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{"単一", "https://example.com", []string{"https://example.com"}},
+		{"前後の空白を取り除く", " https://example.com ,\thttp://localhost:3000 ", []string{"https://example.com", "http://localhost:3000"}},
+		{"末尾の/を取り除く", "https://example.com/", []string{"https://example.com"}},
+		{"空の要素は無視する", "https://example.com,, ,", []string{"https://example.com"}},
+		{"空文字", "", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseAllowedOrigins(tt.value)
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") || len(got) != len(tt.want) {
+				t.Errorf("ParseAllowedOrigins(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerConfig_Validate_ReportsAllErrors(t *testing.T) {
+	cfg := &ServerConfig{
+		JWTSecret:      "short",
+		Port:           "abc",
+		AllowedOrigins: []string{},
+		DBHost:         "",
+	}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("Expected error but got none")
+	}
+
+	for _, field := range []string{"JWT_SECRET", "PORT", "ALLOWED_ORIGINS", "DB_HOST", "DB_USER", "DB_NAME"} {
+		if !strings.Contains(err.Error(), field) {
+			t.Errorf("Expected error to mention %s, got %v", field, err)
+		}
+	}
+}
+
+func TestServerConfig_ValidateOnStartup(t *testing.T) {
+	newInvalidConfig := func(environment string) *ServerConfig {
+		return &ServerConfig{
+			Environment:    environment,
+			JWTSecret:      "",
+			Port:           "8080",
+			AllowedOrigins: []string{"http://localhost:3000"},
+			DBHost:         "localhost",
+			DBUser:         "postgres",
+			DBName:         "financial_planning",
+		}
+	}
+
+	t.Run("本番モードでは検証エラーを返す", func(t *testing.T) {
+		warnings, err := newInvalidConfig(EnvironmentProduction).ValidateOnStartup()
+		if err == nil || !strings.Contains(err.Error(), "JWT_SECRET") {
+			t.Errorf("Expected JWT_SECRET error, got %v", err)
+		}
+		if len(warnings) != 0 {
+			t.Errorf("Expected no warnings in production, got %v", warnings)
+		}
+	})
+
+	t.Run("開発モードでは警告に留める", func(t *testing.T) {
+		warnings, err := newInvalidConfig(EnvironmentDevelopment).ValidateOnStartup()
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if len(warnings) != 1 || !strings.Contains(warnings[0], "JWT_SECRET") {
+			t.Errorf("Expected one JWT_SECRET warning, got %v", warnings)
+		}
+	})
+}
@@ -495,6 +495,65 @@ const docTemplate = `{
                 }
             }
         },
+        "/financial-data/{user_id}/projections/quick": {
+            "get": {
+                "description": "ダッシュボード向けに、1年分の資産推移だけを計算して現在の資産・1年後の資産・増加見込み・貯蓄率・緊急資金の充足月数を返します。包括的予測より軽量で、Cache-Control: private, max-age=300 を付けて返します",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "financial-data"
+                ],
+                "summary": "簡易予測（1年後）",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ユーザーID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.QuickProjectionOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/controllers.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/financial-data/{user_id}/retirement": {
             "put": {
                 "description": "退職データを更新します",
@@ -1294,9 +1353,13 @@ const docTemplate = `{
                     {
                         "enum": [
                             "financial_summary",
+                            "asset_projection",
+                            "goals_progress",
+                            "retirement_plan",
                             "comprehensive"
                         ],
                         "type": "string",
+                        "default": "comprehensive",
                         "description": "レポートタイプ",
                         "name": "report_type",
                         "in": "query"
@@ -1463,6 +1526,14 @@ const docTemplate = `{
                 "years"
             ],
             "properties": {
+                "contribution_timing": {
+                    "description": "積立を各月の期初（beginning）・期末（end）のどちらに行うか（未指定の場合は期末）",
+                    "type": "string",
+                    "enum": [
+                        "beginning",
+                        "end"
+                    ]
+                },
                 "user_id": {
                     "type": "string"
                 },
@@ -1677,7 +1748,6 @@ const docTemplate = `{
                     "type": "string",
                     "enum": [
                         "pdf",
-                        "excel",
                         "csv"
                     ]
                 },
@@ -2838,6 +2908,42 @@ const docTemplate = `{
                 }
             }
         },
+        "usecases.QuickEmergencyFundStatus": {
+            "type": "object",
+            "properties": {
+                "months_of_expenses_covered": {
+                    "description": "現在の緊急資金で賄える月間支出の月数",
+                    "type": "number"
+                }
+            }
+        },
+        "usecases.QuickProjectionOutput": {
+            "type": "object",
+            "properties": {
+                "current_assets": {
+                    "type": "number"
+                },
+                "emergency_fund_status": {
+                    "description": "緊急資金の状況（緊急資金が未設定の場合は省略）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/usecases.QuickEmergencyFundStatus"
+                        }
+                    ]
+                },
+                "estimated_growth": {
+                    "description": "1年後の資産 - 現在の資産",
+                    "type": "number"
+                },
+                "projected_assets_1_year": {
+                    "type": "number"
+                },
+                "savings_rate": {
+                    "description": "%",
+                    "type": "number"
+                }
+            }
+        },
         "usecases.RequiredAdjustment": {
             "type": "object",
             "properties": {
@@ -3106,7 +3212,7 @@ const docTemplate = `{
 var SwaggerInfo = &swag.Spec{
 	Version:          "1.0",
 	Host:             "localhost:8080",
-	BasePath:         "/api",
+	BasePath:         "/api/v1",
 	Schemes:          []string{},
 	Title:            "財務計画計算機 API",
 	Description:      "将来の資産形成と老後の財務計画を可視化するアプリケーションのAPI",
@@ -0,0 +1,68 @@
+package aggregates
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// StalenessAgingMonths は財務データの見直しを勧め始める経過月数
+	StalenessAgingMonths = 3
+	// StalenessStaleMonths はこの月数を超えて見直していない財務データを古いとみなす
+	StalenessStaleMonths = 12
+)
+
+// StalenessLevel は財務データの鮮度
+type StalenessLevel string
+
+const (
+	StalenessFresh StalenessLevel = "fresh" // 最終確認から3ヶ月未満
+	StalenessAging StalenessLevel = "aging" // 最終確認から3〜12ヶ月
+	StalenessStale StalenessLevel = "stale" // 最終確認から12ヶ月超
+)
+
+// DataStaleness は財務データを最後に確認してからの経過と鮮度を表す
+type DataStaleness struct {
+	Level             StalenessLevel `json:"level"`
+	MonthsSinceReview int            `json:"months_since_review"`
+	LastReviewedAt    time.Time      `json:"last_reviewed_at"`
+	Message           string         `json:"message,omitempty"` // 見直しを促すメッセージ（fresh の場合は空）
+}
+
+// EvaluateDataStaleness は最終確認日時から now 時点の財務データの鮮度を判定する
+func EvaluateDataStaleness(lastReviewedAt, now time.Time) DataStaleness {
+	months := elapsedMonths(lastReviewedAt, now)
+	staleness := DataStaleness{
+		Level:             StalenessFresh,
+		MonthsSinceReview: months,
+		LastReviewedAt:    lastReviewedAt,
+	}
+
+	switch {
+	case months > StalenessStaleMonths:
+		staleness.Level = StalenessStale
+		staleness.Message = fmt.Sprintf("財務データが%dヶ月更新されていません。前提を見直してください", months)
+	case months >= StalenessAgingMonths:
+		staleness.Level = StalenessAging
+		staleness.Message = fmt.Sprintf("財務データが%dヶ月更新されていません。変更がないか確認してください", months)
+	}
+	return staleness
+}
+
+// IsStale は財務データが古いとみなす経過月数を超えているかを返す
+func (s DataStaleness) IsStale() bool {
+	return s.Level == StalenessStale
+}
+
+// elapsedMonths は from から to までに経過した月数を返す（日付が応当日に達していない月は数えない）
+func elapsedMonths(from, to time.Time) int {
+	if !to.After(from) {
+		return 0
+	}
+	to = to.In(from.Location())
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if to.Day() < from.Day() {
+		months--
+	}
+	return months
+}
@@ -0,0 +1,88 @@
+package aggregates
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluateDataStaleness(t *testing.T) {
+	lastReviewedAt := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		now        time.Time
+		wantLevel  StalenessLevel
+		wantMonths int
+	}{
+		{name: "確認直後は fresh", now: lastReviewedAt, wantLevel: StalenessFresh, wantMonths: 0},
+		{name: "3ヶ月の応当日の前日までは fresh", now: time.Date(2025, 4, 14, 23, 0, 0, 0, time.UTC), wantLevel: StalenessFresh, wantMonths: 2},
+		{name: "3ヶ月経過すると aging", now: time.Date(2025, 4, 15, 9, 0, 0, 0, time.UTC), wantLevel: StalenessAging, wantMonths: 3},
+		{name: "12ヶ月経過までは aging", now: time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC), wantLevel: StalenessAging, wantMonths: 12},
+		{name: "12ヶ月を超えると stale", now: time.Date(2026, 2, 15, 9, 0, 0, 0, time.UTC), wantLevel: StalenessStale, wantMonths: 13},
+		{name: "確認日時より前の時刻は経過0ヶ月として扱う", now: lastReviewedAt.AddDate(0, -1, 0), wantLevel: StalenessFresh, wantMonths: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			staleness := EvaluateDataStaleness(lastReviewedAt, tt.now)
+
+			if staleness.Level != tt.wantLevel {
+				t.Errorf("Level = %s, want %s", staleness.Level, tt.wantLevel)
+			}
+			if staleness.MonthsSinceReview != tt.wantMonths {
+				t.Errorf("MonthsSinceReview = %d, want %d", staleness.MonthsSinceReview, tt.wantMonths)
+			}
+			if (staleness.Message == "") != (tt.wantLevel == StalenessFresh) {
+				t.Errorf("fresh 以外の場合のみメッセージを返すべきです: %q", staleness.Message)
+			}
+			if staleness.IsStale() != (tt.wantLevel == StalenessStale) {
+				t.Errorf("IsStale = %v", staleness.IsStale())
+			}
+		})
+	}
+
+	t.Run("stale の場合は経過月数と見直しを促すメッセージを返す", func(t *testing.T) {
+		staleness := EvaluateDataStaleness(lastReviewedAt, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC))
+
+		want := "財務データが14ヶ月更新されていません。前提を見直してください"
+		if staleness.Message != want {
+			t.Errorf("Message = %q, want %q", staleness.Message, want)
+		}
+	})
+}
+
+func TestFinancialPlan_Staleness(t *testing.T) {
+	t.Run("確認すると最終確認日時が更新されるが、計算結果は再利用できる", func(t *testing.T) {
+		plan := createTestFinancialPlan(t)
+		reviewedAt := plan.LastReviewedAt().AddDate(1, 6, 0)
+		plan.MarkCalculated(plan.LastProfileUpdatedAt().Add(time.Minute))
+
+		plan.MarkReviewed(reviewedAt)
+
+		if !plan.LastReviewedAt().Equal(reviewedAt) {
+			t.Errorf("LastReviewedAt = %v, want %v", plan.LastReviewedAt(), reviewedAt)
+		}
+		if !plan.IsCalculationUpToDate() {
+			t.Error("確認だけではデータの最終更新日時を変えないべきです")
+		}
+		if level := plan.Staleness(reviewedAt.AddDate(0, 1, 0)).Level; level != StalenessFresh {
+			t.Errorf("確認から1ヶ月後は fresh であるべきです: %s", level)
+		}
+	})
+
+	t.Run("データを更新すると最終確認日時も更新される", func(t *testing.T) {
+		plan := createTestFinancialPlan(t)
+		plan.RestoreLastReviewedAt(time.Now().AddDate(-2, 0, 0))
+		if !plan.Staleness(time.Now()).IsStale() {
+			t.Fatal("2年前に確認したデータは stale であるべきです")
+		}
+
+		if err := plan.UpdateEmergencyFundSettings(6, mustCreateMoney(500000)); err != nil {
+			t.Fatalf("緊急資金設定の更新に失敗しました: %v", err)
+		}
+
+		if level := plan.Staleness(time.Now()).Level; level != StalenessFresh {
+			t.Errorf("更新後は fresh であるべきです: %s", level)
+		}
+	})
+}
@@ -0,0 +1,378 @@
+package aggregates
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+const (
+	// maxEmergencyFundTargetMonths は緊急資金の目標月数の上限
+	maxEmergencyFundTargetMonths = 24
+	// minEmergencyFundPaceMonths は達成ペースの分析に必要な積立期間（月数）の下限
+	minEmergencyFundPaceMonths = 1
+)
+
+// EmergencyFundContribution は緊急資金への積立履歴の1件を表す
+type EmergencyFundContribution struct {
+	Amount        valueobjects.Money `json:"amount"`
+	ContributedAt time.Time          `json:"contributed_at"`
+}
+
+// EmergencyFundPace は積立履歴から求めた達成ペースを表す
+type EmergencyFundPace struct {
+	AverageMonthlyContribution valueobjects.Money `json:"average_monthly_contribution"`
+	EstimatedMonthsToTarget    int                `json:"estimated_months_to_target"` // 現在のペースで目標に達するまでの月数（達成できない場合は-1）
+	ContributionCount          int                `json:"contribution_count"`
+}
+
+// EmergencyFund は緊急資金を表す（FinancialPlan集約の一部）
+// 目標月数・現在額・毎月の積立予定額・積立履歴と、月間支出から求めた必要額を保持する
+type EmergencyFund struct {
+	targetMonths        int
+	currentFund         valueobjects.Money
+	requiredAmount      valueobjects.Money
+	monthlyContribution valueobjects.Money
+	contributions       []EmergencyFundContribution
+}
+
+// NewEmergencyFund は新しい緊急資金を作成する
+func NewEmergencyFund(targetMonths int, currentFund valueobjects.Money) (*EmergencyFund, error) {
+	if err := validateEmergencyFundTargetMonths(targetMonths); err != nil {
+		return nil, err
+	}
+
+	if currentFund.IsNegative() {
+		return nil, errors.New("現在の緊急資金は負の値にできません")
+	}
+
+	// 必要額は月間支出から再計算するまで、積立予定額は設定するまで0とする
+	zero, err := valueobjects.NewMoney(0, currentFund.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("必要緊急資金の初期化に失敗しました: %w", err)
+	}
+
+	return &EmergencyFund{
+		targetMonths:        targetMonths,
+		currentFund:         currentFund,
+		requiredAmount:      zero,
+		monthlyContribution: zero,
+		contributions:       make([]EmergencyFundContribution, 0),
+	}, nil
+}
+
+// NewEmergencyFundWithContributions は積立履歴付きで緊急資金を復元する（リポジトリでの復元用）
+// 現在額は履歴とは独立に保持されるため、履歴の合計と一致している必要はない
+func NewEmergencyFundWithContributions(
+	targetMonths int,
+	currentFund valueobjects.Money,
+	contributions []EmergencyFundContribution,
+) (*EmergencyFund, error) {
+	fund, err := NewEmergencyFund(targetMonths, currentFund)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contribution := range contributions {
+		if err := fund.validateContribution(contribution.Amount); err != nil {
+			return nil, err
+		}
+		fund.contributions = append(fund.contributions, contribution)
+	}
+
+	return fund, nil
+}
+
+// validateEmergencyFundTargetMonths は目標月数の範囲を検証する
+func validateEmergencyFundTargetMonths(targetMonths int) error {
+	if targetMonths < 0 {
+		return errors.New("緊急資金の目標月数は負の値にできません")
+	}
+
+	if targetMonths > maxEmergencyFundTargetMonths {
+		return fmt.Errorf("緊急資金の目標月数は%dヶ月以下である必要があります", maxEmergencyFundTargetMonths)
+	}
+
+	return nil
+}
+
+// TargetMonths は何ヶ月分の生活費を確保するかを返す
+func (ef *EmergencyFund) TargetMonths() int {
+	return ef.targetMonths
+}
+
+// CurrentFund は現在の緊急資金額を返す
+func (ef *EmergencyFund) CurrentFund() valueobjects.Money {
+	return ef.currentFund
+}
+
+// RequiredAmount は最後に再計算した必要緊急資金額を返す
+func (ef *EmergencyFund) RequiredAmount() valueobjects.Money {
+	return ef.requiredAmount
+}
+
+// MonthlyContribution は毎月の積立予定額を返す（未設定の場合は0）
+func (ef *EmergencyFund) MonthlyContribution() valueobjects.Money {
+	return ef.monthlyContribution
+}
+
+// Contributions は積立履歴を返す
+func (ef *EmergencyFund) Contributions() []EmergencyFundContribution {
+	return ef.contributions
+}
+
+// SetMonthlyContribution は毎月の積立予定額を設定する（0で積立予定なし）
+// 積立予定額は月間純貯蓄額のうち緊急資金に充てる分で、資産推移の総資産には引き続き含まれる
+func (ef *EmergencyFund) SetMonthlyContribution(amount valueobjects.Money) error {
+	if amount.IsNegative() {
+		return errors.New("緊急資金の月間積立額は負の値にできません")
+	}
+
+	if amount.Currency() != ef.currentFund.Currency() {
+		return errors.New("月間積立額の通貨が緊急資金と異なります")
+	}
+
+	ef.monthlyContribution = amount
+	return nil
+}
+
+// UpdateSettings は目標月数と現在額を更新する（積立履歴は保持する）
+func (ef *EmergencyFund) UpdateSettings(targetMonths int, currentFund valueobjects.Money) error {
+	if err := validateEmergencyFundTargetMonths(targetMonths); err != nil {
+		return err
+	}
+
+	if currentFund.IsNegative() {
+		return errors.New("現在の緊急資金は負の値にできません")
+	}
+
+	if currentFund.Currency() != ef.currentFund.Currency() {
+		return errors.New("緊急資金の通貨は変更できません")
+	}
+
+	ef.targetMonths = targetMonths
+	ef.currentFund = currentFund
+	return nil
+}
+
+// AddContribution は緊急資金への積立を記録し、現在額に加算する
+func (ef *EmergencyFund) AddContribution(amount valueobjects.Money, contributedAt time.Time) error {
+	if err := ef.validateContribution(amount); err != nil {
+		return err
+	}
+
+	newFund, err := ef.currentFund.Add(amount)
+	if err != nil {
+		return fmt.Errorf("緊急資金の加算に失敗しました: %w", err)
+	}
+
+	ef.currentFund = newFund
+	ef.contributions = append(ef.contributions, EmergencyFundContribution{
+		Amount:        amount,
+		ContributedAt: contributedAt,
+	})
+	return nil
+}
+
+// validateContribution は積立額を検証する
+func (ef *EmergencyFund) validateContribution(amount valueobjects.Money) error {
+	if !amount.IsPositive() {
+		return errors.New("積立額は正の値である必要があります")
+	}
+
+	if amount.Currency() != ef.currentFund.Currency() {
+		return errors.New("積立額の通貨が緊急資金と異なります")
+	}
+
+	return nil
+}
+
+// RecalculateRequiredAmount は月間支出から必要緊急資金額を再計算する
+func (ef *EmergencyFund) RecalculateRequiredAmount(monthlyExpenses valueobjects.Money) error {
+	if monthlyExpenses.IsNegative() {
+		return errors.New("月間支出は負の値にできません")
+	}
+
+	requiredAmount, err := monthlyExpenses.MultiplyByFloat(float64(ef.targetMonths))
+	if err != nil {
+		return fmt.Errorf("必要緊急資金の計算に失敗しました: %w", err)
+	}
+
+	ef.requiredAmount = requiredAmount
+	return nil
+}
+
+// CalculateStatus は月間支出に基づいて必要額を再計算し、緊急資金の状況を返す
+// 目標達成までの月数は貯蓄額に依存するため、MonthsToTarget で別途求める
+func (ef *EmergencyFund) CalculateStatus(monthlyExpenses valueobjects.Money) (*EmergencyFundStatus, error) {
+	if err := ef.RecalculateRequiredAmount(monthlyExpenses); err != nil {
+		return nil, err
+	}
+
+	shortfall, err := ef.Shortfall()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &EmergencyFundStatus{
+		RequiredAmount:      ef.requiredAmount,
+		CurrentAmount:       ef.currentFund,
+		Shortfall:           shortfall,
+		MonthlyContribution: ef.monthlyContribution,
+	}
+	if month, ok := ef.TargetReachedMonth(); ok {
+		status.TargetReachedMonth = &month
+	}
+	return status, nil
+}
+
+// TargetReachedMonth は積立予定額で積み立てた場合に目標へ達する月（何ヶ月後か）を返す
+// 既に達成している場合は0、積立予定額が未設定で達成できない場合は false を返す
+func (ef *EmergencyFund) TargetReachedMonth() (int, bool) {
+	shortfall, err := ef.Shortfall()
+	if err != nil {
+		return 0, false
+	}
+	if !shortfall.IsPositive() {
+		return 0, true
+	}
+	if !ef.monthlyContribution.IsPositive() {
+		return 0, false
+	}
+	return int(math.Ceil(shortfall.Amount() / ef.monthlyContribution.Amount())), true
+}
+
+// ProjectStatus は積立予定額で months ヶ月積み立てた時点の緊急資金の状況を返す
+// 必要額に達した後は積立を止める（必要額は現在の月間支出から求めた額のまま）
+func (ef *EmergencyFund) ProjectStatus(months int) (*EmergencyFundStatus, error) {
+	accumulated, err := ef.monthlyContribution.MultiplyByFloat(float64(months))
+	if err != nil {
+		return nil, fmt.Errorf("緊急資金の積立額の計算に失敗しました: %w", err)
+	}
+
+	projectedFund := ef.currentFund
+	if ef.currentFund.Amount() < ef.requiredAmount.Amount() {
+		projectedFund, err = ef.currentFund.Add(accumulated)
+		if err != nil {
+			return nil, fmt.Errorf("緊急資金の予測額の計算に失敗しました: %w", err)
+		}
+		if projectedFund.Amount() > ef.requiredAmount.Amount() {
+			projectedFund = ef.requiredAmount
+		}
+	}
+
+	shortfall, err := ef.requiredAmount.Subtract(projectedFund)
+	if err != nil {
+		return nil, fmt.Errorf("緊急資金不足額の計算に失敗しました: %w", err)
+	}
+	if shortfall.IsNegative() {
+		if shortfall, err = valueobjects.NewMoney(0, ef.currentFund.Currency()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &EmergencyFundStatus{
+		RequiredAmount:      ef.requiredAmount,
+		CurrentAmount:       projectedFund,
+		Shortfall:           shortfall,
+		MonthlyContribution: ef.monthlyContribution,
+	}, nil
+}
+
+// Shortfall は必要額に対する不足額を返す（不足がない場合は0）
+func (ef *EmergencyFund) Shortfall() (valueobjects.Money, error) {
+	shortfall, err := ef.requiredAmount.Subtract(ef.currentFund)
+	if err != nil {
+		return valueobjects.Money{}, fmt.Errorf("緊急資金不足額の計算に失敗しました: %w", err)
+	}
+
+	if shortfall.IsNegative() {
+		return valueobjects.NewMoney(0, ef.currentFund.Currency())
+	}
+
+	return shortfall, nil
+}
+
+// IsFullyFunded は現在額が必要額以上かどうかを返す
+func (ef *EmergencyFund) IsFullyFunded() bool {
+	shortfall, err := ef.Shortfall()
+	if err != nil {
+		return false
+	}
+	return !shortfall.IsPositive()
+}
+
+// MonthsToTarget は毎月 monthlySavings を積み立てた場合に目標へ達するまでの月数を返す
+// 既に達成している場合や積立ができない場合は0を返す
+func (ef *EmergencyFund) MonthsToTarget(monthlySavings valueobjects.Money) int {
+	shortfall, err := ef.Shortfall()
+	if err != nil || !shortfall.IsPositive() || !monthlySavings.IsPositive() {
+		return 0
+	}
+	return int(shortfall.Amount() / monthlySavings.Amount())
+}
+
+// MonthsOfExpensesCovered は現在の緊急資金で月間支出の何ヶ月分を賄えるかを返す
+// 月間支出が0以下の場合は0を返す
+func (ef *EmergencyFund) MonthsOfExpensesCovered(monthlyExpenses valueobjects.Money) float64 {
+	if !monthlyExpenses.IsPositive() {
+		return 0
+	}
+	return ef.currentFund.Amount() / monthlyExpenses.Amount()
+}
+
+// AnalyzePace は積立履歴から月平均の積立額と、そのペースで目標に達するまでの月数を求める
+// 履歴がない場合は nil を返す
+func (ef *EmergencyFund) AnalyzePace(now time.Time) (*EmergencyFundPace, error) {
+	if len(ef.contributions) == 0 {
+		return nil, nil
+	}
+
+	earliest := ef.contributions[0].ContributedAt
+	total, err := valueobjects.NewMoney(0, ef.currentFund.Currency())
+	if err != nil {
+		return nil, fmt.Errorf("積立合計の初期化に失敗しました: %w", err)
+	}
+	for _, contribution := range ef.contributions {
+		if contribution.ContributedAt.Before(earliest) {
+			earliest = contribution.ContributedAt
+		}
+		total, err = total.Add(contribution.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("積立合計の計算に失敗しました: %w", err)
+		}
+	}
+
+	// 最初の積立月から現在の月までを積立期間とする（最低1ヶ月）
+	months := (now.Year()-earliest.Year())*12 + int(now.Month()-earliest.Month()) + 1
+	if months < minEmergencyFundPaceMonths {
+		months = minEmergencyFundPaceMonths
+	}
+
+	average, err := total.MultiplyByFloat(1 / float64(months))
+	if err != nil {
+		return nil, fmt.Errorf("月平均積立額の計算に失敗しました: %w", err)
+	}
+
+	shortfall, err := ef.Shortfall()
+	if err != nil {
+		return nil, err
+	}
+
+	estimatedMonths := 0
+	if shortfall.IsPositive() {
+		estimatedMonths = -1
+		if average.IsPositive() {
+			estimatedMonths = int(math.Ceil(shortfall.Amount() / average.Amount()))
+		}
+	}
+
+	return &EmergencyFundPace{
+		AverageMonthlyContribution: average,
+		EstimatedMonthsToTarget:    estimatedMonths,
+		ContributionCount:          len(ef.contributions),
+	}, nil
+}
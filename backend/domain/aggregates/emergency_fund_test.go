@@ -0,0 +1,281 @@
+package aggregates
+
+import (
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+func TestNewEmergencyFund(t *testing.T) {
+	tests := []struct {
+		name         string
+		targetMonths int
+		currentFund  float64
+		expectError  bool
+	}{
+		{"有効な設定", 6, 300000, false},
+		{"目標月数0", 0, 0, false},
+		{"目標月数が上限", 24, 0, false},
+		{"目標月数が負", -1, 0, true},
+		{"目標月数が上限超過", 25, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fund, err := NewEmergencyFund(tt.targetMonths, mustCreateMoney(tt.currentFund))
+			if tt.expectError {
+				if err == nil {
+					t.Error("エラーが発生するはずですが、発生しませんでした")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+			if fund.TargetMonths() != tt.targetMonths {
+				t.Errorf("目標月数が正しくありません。期待値: %d, 実際: %d", tt.targetMonths, fund.TargetMonths())
+			}
+		})
+	}
+}
+
+func TestEmergencyFund_AddContribution(t *testing.T) {
+	fund, _ := NewEmergencyFund(3, mustCreateMoney(100000))
+	contributedAt := time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)
+
+	if err := fund.AddContribution(mustCreateMoney(50000), contributedAt); err != nil {
+		t.Fatalf("積立の記録に失敗しました: %v", err)
+	}
+
+	if fund.CurrentFund().Amount() != 150000 {
+		t.Errorf("積立後の緊急資金額が正しくありません。期待値: 150000, 実際: %f", fund.CurrentFund().Amount())
+	}
+	if len(fund.Contributions()) != 1 || !fund.Contributions()[0].ContributedAt.Equal(contributedAt) {
+		t.Errorf("積立履歴が記録されていません: %+v", fund.Contributions())
+	}
+
+	if err := fund.AddContribution(mustCreateMoney(0), contributedAt); err == nil {
+		t.Error("0円の積立はエラーになるはずです")
+	}
+	if len(fund.Contributions()) != 1 {
+		t.Error("失敗した積立が履歴に残っています")
+	}
+}
+
+func TestEmergencyFund_CalculateStatus(t *testing.T) {
+	fund, _ := NewEmergencyFund(6, mustCreateMoney(400000))
+
+	status, err := fund.CalculateStatus(mustCreateMoney(100000))
+	if err != nil {
+		t.Fatalf("緊急資金状況の計算に失敗しました: %v", err)
+	}
+
+	if status.RequiredAmount.Amount() != 600000 {
+		t.Errorf("必要額が正しくありません。期待値: 600000, 実際: %f", status.RequiredAmount.Amount())
+	}
+	if status.Shortfall.Amount() != 200000 {
+		t.Errorf("不足額が正しくありません。期待値: 200000, 実際: %f", status.Shortfall.Amount())
+	}
+	if fund.IsFullyFunded() {
+		t.Error("不足があるのに達成済みと判定されました")
+	}
+	if months := fund.MonthsToTarget(mustCreateMoney(50000)); months != 4 {
+		t.Errorf("目標達成までの月数が正しくありません。期待値: 4, 実際: %d", months)
+	}
+
+	// 支出が減ると必要額が下がり、達成済みになる
+	status, err = fund.CalculateStatus(mustCreateMoney(60000))
+	if err != nil {
+		t.Fatalf("緊急資金状況の計算に失敗しました: %v", err)
+	}
+	if !status.Shortfall.IsZero() || !fund.IsFullyFunded() {
+		t.Errorf("必要額を満たしているのに不足と判定されました: %+v", status)
+	}
+	if months := fund.MonthsToTarget(mustCreateMoney(50000)); months != 0 {
+		t.Errorf("達成済みの場合は0ヶ月のはずです。実際: %d", months)
+	}
+}
+
+func TestEmergencyFund_MonthsOfExpensesCovered(t *testing.T) {
+	fund, _ := NewEmergencyFund(6, mustCreateMoney(450000))
+
+	if months := fund.MonthsOfExpensesCovered(mustCreateMoney(150000)); months != 3 {
+		t.Errorf("充足月数が正しくありません。期待値: 3, 実際: %f", months)
+	}
+	if months := fund.MonthsOfExpensesCovered(mustCreateMoney(0)); months != 0 {
+		t.Errorf("月間支出が0の場合は0ヶ月のはずです。実際: %f", months)
+	}
+}
+
+func TestEmergencyFund_AnalyzePace(t *testing.T) {
+	fund, _ := NewEmergencyFund(6, mustCreateMoney(0))
+	if err := fund.RecalculateRequiredAmount(mustCreateMoney(100000)); err != nil {
+		t.Fatalf("必要額の再計算に失敗しました: %v", err)
+	}
+
+	pace, err := fund.AnalyzePace(time.Now())
+	if err != nil || pace != nil {
+		t.Fatalf("積立履歴がない場合は nil のはずです: pace=%+v err=%v", pace, err)
+	}
+
+	// 4月〜6月の3ヶ月で合計150,000円を積立
+	for _, month := range []time.Month{time.April, time.May, time.June} {
+		if err := fund.AddContribution(mustCreateMoney(50000), time.Date(2024, month, 25, 0, 0, 0, 0, time.UTC)); err != nil {
+			t.Fatalf("積立の記録に失敗しました: %v", err)
+		}
+	}
+
+	pace, err = fund.AnalyzePace(time.Date(2024, time.June, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("達成ペースの分析に失敗しました: %v", err)
+	}
+	if pace.AverageMonthlyContribution.Amount() != 50000 {
+		t.Errorf("月平均積立額が正しくありません。期待値: 50000, 実際: %f", pace.AverageMonthlyContribution.Amount())
+	}
+	// 不足額 450,000円 ÷ 50,000円 = 9ヶ月
+	if pace.EstimatedMonthsToTarget != 9 {
+		t.Errorf("目標達成までの推定月数が正しくありません。期待値: 9, 実際: %d", pace.EstimatedMonthsToTarget)
+	}
+	if pace.ContributionCount != 3 {
+		t.Errorf("積立回数が正しくありません。期待値: 3, 実際: %d", pace.ContributionCount)
+	}
+}
+
+func TestFinancialPlan_UpdateProfile_RecalculatesEmergencyFund(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+
+	// 初期状態: 月間支出260,000円 × 3ヶ月
+	if plan.EmergencyFund().RequiredAmount().Amount() != 780000 {
+		t.Fatalf("初期の必要額が正しくありません。期待値: 780000, 実際: %f", plan.EmergencyFund().RequiredAmount().Amount())
+	}
+
+	profile := plan.Profile()
+	expenses := entities.ExpenseCollection{
+		{Category: "住居費", Amount: mustCreateMoney(100000)},
+	}
+	newProfile, err := entities.NewFinancialProfile(
+		profile.UserID(),
+		profile.MonthlyIncome(),
+		expenses,
+		profile.CurrentSavings(),
+		profile.InvestmentReturn(),
+		profile.InflationRate(),
+	)
+	if err != nil {
+		t.Fatalf("財務プロファイルの作成に失敗しました: %v", err)
+	}
+
+	if err := plan.UpdateProfile(newProfile); err != nil {
+		t.Fatalf("財務プロファイルの更新に失敗しました: %v", err)
+	}
+
+	if plan.EmergencyFund().RequiredAmount().Amount() != 300000 {
+		t.Errorf("支出変更後の必要額が正しくありません。期待値: 300000, 実際: %f", plan.EmergencyFund().RequiredAmount().Amount())
+	}
+}
+
+func TestFinancialPlan_UpdateEmergencyFundSettings_KeepsContributions(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+	if err := plan.AddEmergencyFundContribution(mustCreateMoney(20000), time.Now()); err != nil {
+		t.Fatalf("積立の記録に失敗しました: %v", err)
+	}
+
+	if err := plan.UpdateEmergencyFundSettings(6, mustCreateMoney(500000)); err != nil {
+		t.Fatalf("緊急資金設定の更新に失敗しました: %v", err)
+	}
+
+	fund := plan.EmergencyFund()
+	if fund.TargetMonths() != 6 || fund.CurrentFund().Amount() != 500000 {
+		t.Errorf("緊急資金設定が更新されていません: 目標月数=%d, 現在額=%f", fund.TargetMonths(), fund.CurrentFund().Amount())
+	}
+	if len(fund.Contributions()) != 1 {
+		t.Errorf("積立履歴が失われました: %+v", fund.Contributions())
+	}
+	if fund.RequiredAmount().Amount() != 1560000 {
+		t.Errorf("目標月数変更後の必要額が正しくありません。期待値: 1560000, 実際: %f", fund.RequiredAmount().Amount())
+	}
+}
+
+func TestFinancialPlan_GenerateProjection_EmergencyFundMonthlyContribution(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+	withoutContribution, err := plan.GenerateProjection(3)
+	if err != nil {
+		t.Fatalf("将来予測の生成に失敗しました: %v", err)
+	}
+
+	// 必要額 780,000円（月間支出260,000円 × 3ヶ月）に対して現在額 420,000円 → 不足額 360,000円
+	if err := plan.UpdateEmergencyFundSettings(3, mustCreateMoney(420000)); err != nil {
+		t.Fatalf("緊急資金設定の更新に失敗しました: %v", err)
+	}
+	if err := plan.UpdateEmergencyFundMonthlyContribution(mustCreateMoney(20000)); err != nil {
+		t.Fatalf("月間積立額の設定に失敗しました: %v", err)
+	}
+
+	projection, err := plan.GenerateProjection(3)
+	if err != nil {
+		t.Fatalf("将来予測の生成に失敗しました: %v", err)
+	}
+
+	// 毎月20,000円の積立で360,000円の不足は18ヶ月で解消する
+	status := projection.EmergencyFundStatus
+	if status.TargetReachedMonth == nil || *status.TargetReachedMonth != 18 {
+		t.Errorf("目標に達する月が正しくありません。期待値: 18, 実際: %v", status.TargetReachedMonth)
+	}
+	if status.MonthsToTarget != 18 {
+		t.Errorf("目標達成までの月数が正しくありません。期待値: 18, 実際: %d", status.MonthsToTarget)
+	}
+
+	if len(projection.EmergencyFundProjections) != 3 {
+		t.Fatalf("緊急資金の推移は予測年数分のはずです。実際: %d", len(projection.EmergencyFundProjections))
+	}
+	expected := []struct{ current, shortfall float64 }{
+		{660000, 120000},
+		{780000, 0},
+		{780000, 0},
+	}
+	for i, want := range expected {
+		got := projection.EmergencyFundProjections[i]
+		if got.Year != i+1 || got.CurrentAmount.Amount() != want.current || got.Shortfall.Amount() != want.shortfall {
+			t.Errorf("%d年目の緊急資金が正しくありません。期待値: 現在額=%.0f 不足額=%.0f, 実際: 年=%d 現在額=%.0f 不足額=%.0f",
+				i+1, want.current, want.shortfall, got.Year, got.CurrentAmount.Amount(), got.Shortfall.Amount())
+		}
+	}
+
+	// 積立予定額は純貯蓄額の内訳のため、総資産の推移は変わらない
+	for i, assetProjection := range projection.AssetProjections {
+		if assetProjection.TotalAssets.Amount() != withoutContribution.AssetProjections[i].TotalAssets.Amount() {
+			t.Errorf("%d年目の総資産が積立予定額の設定で変わりました", assetProjection.Year)
+		}
+	}
+
+	if plan.EmergencyFundOvercommitted() {
+		t.Error("純貯蓄額の範囲内の積立額で超過と判定されました")
+	}
+}
+
+func TestFinancialPlan_EmergencyFundOvercommitted(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+
+	// 月間純貯蓄額 140,000円（月収400,000円 - 支出260,000円）を超える積立予定額
+	if err := plan.UpdateEmergencyFundMonthlyContribution(mustCreateMoney(150000)); err != nil {
+		t.Fatalf("月間積立額の設定に失敗しました: %v", err)
+	}
+	if !plan.EmergencyFundOvercommitted() {
+		t.Error("純貯蓄額を超える積立額が超過と判定されませんでした")
+	}
+
+	found := false
+	for _, validationError := range plan.ValidatePlan() {
+		if validationError.Field == "emergency_fund.monthly_contribution" && validationError.Message == EmergencyFundOvercommitmentMessage {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("積立額の超過が計画の検証結果に含まれていません")
+	}
+
+	if err := plan.UpdateEmergencyFundMonthlyContribution(mustCreateMoney(-1)); err == nil {
+		t.Error("負の積立額でエラーになりませんでした")
+	}
+}
@@ -3,6 +3,7 @@ package aggregates
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/entities"
@@ -35,15 +36,29 @@ type PlanProjection struct {
 	AssetProjections      []entities.AssetProjection      `json:"asset_projections"`
 	RetirementCalculation *entities.RetirementCalculation `json:"retirement_calculation,omitempty"`
 	EmergencyFundStatus   *EmergencyFundStatus            `json:"emergency_fund_status,omitempty"`
-	GoalProgress          []GoalProgress                  `json:"goal_progress"`
+	// EmergencyFundProjections は積立予定額で積み立てた場合の各年末の緊急資金の状況（緊急資金がある場合のみ）
+	EmergencyFundProjections []EmergencyFundProjection `json:"emergency_fund_projections,omitempty"`
+	GoalProgress             []GoalProgress            `json:"goal_progress"`
 }
 
+// EmergencyFundOvercommitmentMessage は緊急資金の月間積立額が月間純貯蓄額を超えている場合の警告
+const EmergencyFundOvercommitmentMessage = "緊急資金の月間積立額が月間純貯蓄額を超えています"
+
 // EmergencyFundStatus は緊急資金の状況を表す
 type EmergencyFundStatus struct {
-	RequiredAmount valueobjects.Money `json:"required_amount"`
-	CurrentAmount  valueobjects.Money `json:"current_amount"`
-	Shortfall      valueobjects.Money `json:"shortfall"`
-	MonthsToTarget int                `json:"months_to_target"`
+	RequiredAmount      valueobjects.Money `json:"required_amount"`
+	CurrentAmount       valueobjects.Money `json:"current_amount"`
+	Shortfall           valueobjects.Money `json:"shortfall"`
+	MonthsToTarget      int                `json:"months_to_target"`
+	MonthlyContribution valueobjects.Money `json:"monthly_contribution"`
+	// TargetReachedMonth は積立予定額で目標に達する月（何ヶ月後か、達成済みなら0）。積立予定がなく達成できない場合は省略
+	TargetReachedMonth *int `json:"target_reached_month,omitempty"`
+}
+
+// EmergencyFundProjection は予測年ごとの緊急資金の状況を表す
+type EmergencyFundProjection struct {
+	Year int `json:"year"`
+	EmergencyFundStatus
 }
 
 // GoalProgress は目標の進捗状況を表す
@@ -54,41 +69,33 @@ type GoalProgress struct {
 	Message  string                `json:"message"`
 }
 
+// GoalAllocation は月間純貯蓄額の目標への配分を表す
+type GoalAllocation struct {
+	Goal            *entities.Goal     `json:"goal"`
+	RequiredAmount  valueobjects.Money `json:"required_amount"`       // 期日までに達成するための必要月額
+	AllocatedAmount valueobjects.Money `json:"allocated_amount"`      // 配分額（待機中の目標は0）
+	Waiting         bool               `json:"waiting"`               // 前提目標の達成待ち（待機中）か
+	WaitingFor      []entities.GoalID  `json:"waiting_for,omitempty"` // 未達成の前提目標のID
+}
+
 // FinancialPlan は財務計画の集約ルート
 type FinancialPlan struct {
 	id             FinancialPlanID
 	profile        *entities.FinancialProfile
 	goals          []*entities.Goal
+	removedGoals   []*entities.Goal // RemoveGoal で削除済みにした目標（保存時に削除日時を記録する）
 	retirementData *entities.RetirementData
-	emergencyFund  *EmergencyFundConfig
+	emergencyFund  *EmergencyFund
 	createdAt      time.Time
 	updatedAt      time.Time
-}
-
-// EmergencyFundConfig は緊急資金の設定を表す
-type EmergencyFundConfig struct {
-	TargetMonths int                `json:"target_months"` // 何ヶ月分の生活費を確保するか
-	CurrentFund  valueobjects.Money `json:"current_fund"`  // 現在の緊急資金額
-}
-
-// NewEmergencyFundConfig は新しい緊急資金設定を作成する
-func NewEmergencyFundConfig(targetMonths int, currentFund valueobjects.Money) (*EmergencyFundConfig, error) {
-	if targetMonths < 0 {
-		return nil, errors.New("緊急資金の目標月数は負の値にできません")
-	}
-
-	if targetMonths > 24 {
-		return nil, errors.New("緊急資金の目標月数は24ヶ月以下である必要があります")
-	}
-
-	if currentFund.IsNegative() {
-		return nil, errors.New("現在の緊急資金は負の値にできません")
-	}
-
-	return &EmergencyFundConfig{
-		TargetMonths: targetMonths,
-		CurrentFund:  currentFund,
-	}, nil
+	// lastCalculatedAt は財務健全性などの計算結果を最後に保存した日時（未計算の場合は nil）
+	lastCalculatedAt *time.Time
+	// lastProfileUpdatedAt は計算結果に影響するデータ（プロファイル・退職データ・緊急資金）を最後に更新した日時
+	lastProfileUpdatedAt time.Time
+	// lastReviewedAt はユーザーが財務データを最後に更新または「変更なし」と確認した日時
+	lastReviewedAt time.Time
+	// version は楽観的ロックに使うバージョン（作成時は1で、保存した更新ごとに1つ進む）
+	version int
 }
 
 // NewFinancialPlan は新しい財務計画を作成する
@@ -98,29 +105,27 @@ func NewFinancialPlan(profile *entities.FinancialProfile) (*FinancialPlan, error
 	}
 
 	// デフォルトの緊急資金設定（3ヶ月分）
-	defaultEmergencyFund, err := valueobjects.NewMoneyJPY(0)
+	emergencyFund, err := newDefaultEmergencyFund(profile)
 	if err != nil {
-		return nil, fmt.Errorf("デフォルト緊急資金の作成に失敗しました: %w", err)
-	}
-
-	emergencyConfig, err := NewEmergencyFundConfig(3, defaultEmergencyFund)
-	if err != nil {
-		return nil, fmt.Errorf("緊急資金設定の作成に失敗しました: %w", err)
+		return nil, err
 	}
 
 	now := time.Now()
 
 	return &FinancialPlan{
-		id:            NewFinancialPlanID(),
-		profile:       profile,
-		goals:         make([]*entities.Goal, 0),
-		emergencyFund: emergencyConfig,
-		createdAt:     now,
-		updatedAt:     now,
+		id:                   NewFinancialPlanID(),
+		profile:              profile,
+		goals:                make([]*entities.Goal, 0),
+		emergencyFund:        emergencyFund,
+		createdAt:            now,
+		updatedAt:            now,
+		lastProfileUpdatedAt: now,
+		lastReviewedAt:       now,
+		version:              1,
 	}, nil
 }
 
-// NewFinancialPlanWithID は指定されたIDで財務計画を作成する（リポジトリでの復元用）
+// NewFinancialPlanWithID は指定されたIDで財務計画を作成する（リポジトリでの復元や、ユースケースで採番したIDでの作成に使用する）
 func NewFinancialPlanWithID(
 	id FinancialPlanID,
 	profile *entities.FinancialProfile,
@@ -133,23 +138,55 @@ func NewFinancialPlanWithID(
 		return nil, errors.New("財務プロファイルは必須です")
 	}
 
+	emergencyFund, err := newDefaultEmergencyFund(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FinancialPlan{
+		id:                   id,
+		profile:              profile,
+		goals:                make([]*entities.Goal, 0),
+		emergencyFund:        emergencyFund,
+		createdAt:            createdAt,
+		updatedAt:            updatedAt,
+		lastProfileUpdatedAt: updatedAt,
+		lastReviewedAt:       updatedAt,
+		version:              1,
+	}, nil
+}
+
+// newDefaultEmergencyFund はデフォルトの緊急資金（3ヶ月分、現在額0）を作成し、必要額を計算する
+func newDefaultEmergencyFund(profile *entities.FinancialProfile) (*EmergencyFund, error) {
 	defaultEmergencyFund, err := valueobjects.NewMoneyJPY(0)
 	if err != nil {
 		return nil, fmt.Errorf("デフォルト緊急資金の作成に失敗しました: %w", err)
 	}
-	emergencyConfig, err := NewEmergencyFundConfig(3, defaultEmergencyFund)
+
+	emergencyFund, err := NewEmergencyFund(3, defaultEmergencyFund)
 	if err != nil {
 		return nil, fmt.Errorf("緊急資金設定の作成に失敗しました: %w", err)
 	}
 
-	return &FinancialPlan{
-		id:            id,
-		profile:       profile,
-		goals:         make([]*entities.Goal, 0),
-		emergencyFund: emergencyConfig,
-		createdAt:     createdAt,
-		updatedAt:     updatedAt,
-	}, nil
+	if err := recalculateEmergencyFund(emergencyFund, profile); err != nil {
+		return nil, err
+	}
+
+	return emergencyFund, nil
+}
+
+// recalculateEmergencyFund はプロファイルの月間支出から緊急資金の必要額を再計算する
+func recalculateEmergencyFund(emergencyFund *EmergencyFund, profile *entities.FinancialProfile) error {
+	monthlyExpenses, err := profile.MonthlyExpenses().Total()
+	if err != nil {
+		return fmt.Errorf("月間支出の計算に失敗しました: %w", err)
+	}
+
+	if err := emergencyFund.RecalculateRequiredAmount(monthlyExpenses); err != nil {
+		return fmt.Errorf("必要緊急資金の再計算に失敗しました: %w", err)
+	}
+
+	return nil
 }
 
 // ID は財務計画IDを返す
@@ -162,18 +199,23 @@ func (fp *FinancialPlan) Profile() *entities.FinancialProfile {
 	return fp.profile
 }
 
-// Goals は目標一覧を返す
+// Goals は目標一覧を返す（削除済みの目標は含まない）
 func (fp *FinancialPlan) Goals() []*entities.Goal {
 	return fp.goals
 }
 
+// RemovedGoals は RemoveGoal で削除済みにした目標を返す（リポジトリが削除日時を保存するために使う）
+func (fp *FinancialPlan) RemovedGoals() []*entities.Goal {
+	return fp.removedGoals
+}
+
 // RetirementData は退職データを返す
 func (fp *FinancialPlan) RetirementData() *entities.RetirementData {
 	return fp.retirementData
 }
 
-// EmergencyFund は緊急資金設定を返す
-func (fp *FinancialPlan) EmergencyFund() *EmergencyFundConfig {
+// EmergencyFund は緊急資金を返す
+func (fp *FinancialPlan) EmergencyFund() *EmergencyFund {
 	return fp.emergencyFund
 }
 
@@ -187,11 +229,81 @@ func (fp *FinancialPlan) UpdatedAt() time.Time {
 	return fp.updatedAt
 }
 
+// LastCalculatedAt は計算結果を最後に保存した日時を返す（未計算の場合は nil）
+func (fp *FinancialPlan) LastCalculatedAt() *time.Time {
+	return fp.lastCalculatedAt
+}
+
+// LastProfileUpdatedAt は計算結果に影響するデータを最後に更新した日時を返す
+func (fp *FinancialPlan) LastProfileUpdatedAt() time.Time {
+	return fp.lastProfileUpdatedAt
+}
+
+// MarkCalculated は計算結果を保存した日時を記録する
+func (fp *FinancialPlan) MarkCalculated(calculatedAt time.Time) {
+	fp.lastCalculatedAt = &calculatedAt
+}
+
+// IsCalculationUpToDate は最後の計算がデータの最終更新より後に行われたか（保存済みの計算結果を再利用できるか）を返す
+func (fp *FinancialPlan) IsCalculationUpToDate() bool {
+	return fp.lastCalculatedAt != nil && fp.lastCalculatedAt.After(fp.lastProfileUpdatedAt)
+}
+
+// RestoreCalculationTimestamps は保存されていた計算日時とデータの最終更新日時を復元する（リポジトリでの復元用）
+// 復元中の UpdateProfile などで更新された最終更新日時を上書きするため、復元の最後に呼び出す
+func (fp *FinancialPlan) RestoreCalculationTimestamps(lastCalculatedAt *time.Time, lastProfileUpdatedAt time.Time) {
+	fp.lastCalculatedAt = lastCalculatedAt
+	fp.lastProfileUpdatedAt = lastProfileUpdatedAt
+}
+
+// LastReviewedAt は財務データを最後に更新または確認した日時を返す
+func (fp *FinancialPlan) LastReviewedAt() time.Time {
+	return fp.lastReviewedAt
+}
+
+// MarkReviewed はユーザーが財務データに変更がないことを確認した日時を記録する
+// データ自体は変わらないため、保存済みの計算結果は引き続き再利用できる
+func (fp *FinancialPlan) MarkReviewed(reviewedAt time.Time) {
+	fp.updatedAt = reviewedAt
+	fp.lastReviewedAt = reviewedAt
+}
+
+// RestoreLastReviewedAt は保存されていた財務データの最終確認日時を復元する（リポジトリでの復元用）
+// 復元中の UpdateProfile などで更新された最終確認日時を上書きするため、復元の最後に呼び出す
+func (fp *FinancialPlan) RestoreLastReviewedAt(lastReviewedAt time.Time) {
+	fp.lastReviewedAt = lastReviewedAt
+}
+
+// Version は楽観的ロックに使う財務計画のバージョンを返す
+func (fp *FinancialPlan) Version() int {
+	return fp.version
+}
+
+// RestoreVersion は保存されていたバージョンを復元する（リポジトリでの復元と、更新の保存後にリポジトリが進めたバージョンの反映に使う）
+func (fp *FinancialPlan) RestoreVersion(version int) {
+	fp.version = version
+}
+
+// Staleness は now 時点の財務データの鮮度を返す
+func (fp *FinancialPlan) Staleness(now time.Time) DataStaleness {
+	return EvaluateDataStaleness(fp.lastReviewedAt, now)
+}
+
+// touchProfile は計算結果に影響するデータの更新を記録する（データの更新は内容の確認も兼ねる）
+func (fp *FinancialPlan) touchProfile() {
+	fp.updatedAt = time.Now()
+	fp.lastProfileUpdatedAt = fp.updatedAt
+	fp.lastReviewedAt = fp.updatedAt
+}
+
 // AddGoal は新しい目標を追加する
 func (fp *FinancialPlan) AddGoal(goal *entities.Goal) error {
 	if goal == nil {
 		return errors.New("目標は必須です")
 	}
+	if goal.IsDeleted() {
+		return errors.New("削除済みの目標は追加できません")
+	}
 
 	// 同じタイプの目標が既に存在するかチェック（退職・緊急資金目標は1つまで）
 	if goal.GoalType() == entities.GoalTypeRetirement || goal.GoalType() == entities.GoalTypeEmergency {
@@ -202,8 +314,9 @@ func (fp *FinancialPlan) AddGoal(goal *entities.Goal) error {
 		}
 	}
 
-	// 目標の達成可能性をチェック
-	achievable, err := goal.IsAchievable(fp.profile)
+	// 目標の達成可能性をチェック（前提目標がある場合は前提目標の完了見込み日から判定する）
+	schedules := entities.BuildGoalSchedules(append(append([]*entities.Goal{}, fp.goals...), goal), time.Now())
+	achievable, err := goal.IsAchievableFrom(fp.profile, schedules[goal.ID()].EffectiveStartDate)
 	if err != nil {
 		return fmt.Errorf("目標の達成可能性チェックに失敗しました: %w", err)
 	}
@@ -218,12 +331,16 @@ func (fp *FinancialPlan) AddGoal(goal *entities.Goal) error {
 }
 
 // RemoveGoal は目標を削除する
+// 目標は削除日時を記録して目標一覧から外し、リポジトリでの保存時に論理削除として反映する
 func (fp *FinancialPlan) RemoveGoal(goalID entities.GoalID) error {
 	for i, goal := range fp.goals {
 		if goal.ID() == goalID {
+			now := time.Now()
+			goal.MarkDeleted(now)
 			// スライスから要素を削除
 			fp.goals = append(fp.goals[:i], fp.goals[i+1:]...)
-			fp.updatedAt = time.Now()
+			fp.removedGoals = append(fp.removedGoals, goal)
+			fp.updatedAt = now
 			return nil
 		}
 	}
@@ -231,36 +348,260 @@ func (fp *FinancialPlan) RemoveGoal(goalID entities.GoalID) error {
 	return errors.New("指定された目標が見つかりません")
 }
 
+// RestoreGoals は保存済みの目標を財務計画に復元する（リポジトリでの復元用）
+// 保存済みの目標を取りこぼさないよう AddGoal の業務ルール（種類ごとの件数・達成可能性）は検証せず、削除済みの目標のみ除く
+func (fp *FinancialPlan) RestoreGoals(goals []*entities.Goal) {
+	for _, goal := range goals {
+		if goal == nil || goal.IsDeleted() {
+			continue
+		}
+		fp.goals = append(fp.goals, goal)
+	}
+}
+
 // UpdateProfile は財務プロファイルを更新する
+// 月間支出が変わるため、緊急資金の必要額も再計算する
 func (fp *FinancialPlan) UpdateProfile(profile *entities.FinancialProfile) error {
 	if profile == nil {
 		return errors.New("財務プロファイルは必須です")
 	}
 
+	if fp.emergencyFund != nil {
+		if err := recalculateEmergencyFund(fp.emergencyFund, profile); err != nil {
+			return err
+		}
+	}
+
+	// 負債とライフイベントは専用の操作（AddLiability など）で管理するため、更新前のプロファイルから引き継ぐ
+	if fp.profile != nil {
+		profile.SetLiabilities(fp.profile.Liabilities())
+		profile.SetLifeEvents(fp.profile.LifeEvents())
+	}
 	fp.profile = profile
-	fp.updatedAt = time.Now()
+	if err := fp.syncRetirementAge(); err != nil {
+		return err
+	}
+	fp.touchProfile()
+	return nil
+}
+
+// AddLiability は負債を追加する
+// 純貯蓄額と純資産が変わるため、計算結果に影響するデータの更新として記録する
+func (fp *FinancialPlan) AddLiability(liability *entities.Liability) error {
+	if err := fp.profile.AddLiability(liability); err != nil {
+		return err
+	}
+	fp.touchProfile()
+	return nil
+}
+
+// UpdateLiability は負債を更新する
+func (fp *FinancialPlan) UpdateLiability(
+	id entities.LiabilityID,
+	liabilityType entities.LiabilityType,
+	name string,
+	principalRemaining valueobjects.Money,
+	interestRate valueobjects.Rate,
+	monthlyPayment valueobjects.Money,
+	endDate time.Time,
+	includedInExpenses bool,
+) (*entities.Liability, error) {
+	liability, err := fp.profile.UpdateLiability(id, liabilityType, name, principalRemaining, interestRate, monthlyPayment, endDate, includedInExpenses)
+	if err != nil {
+		return nil, err
+	}
+	fp.touchProfile()
+	return liability, nil
+}
+
+// RemoveLiability は負債を削除する
+func (fp *FinancialPlan) RemoveLiability(id entities.LiabilityID) error {
+	if err := fp.profile.RemoveLiability(id); err != nil {
+		return err
+	}
+	fp.touchProfile()
+	return nil
+}
+
+// AddLifeEvent はライフイベントを追加する
+// 資産推移の予測が変わるため、計算結果に影響するデータの更新として記録する
+func (fp *FinancialPlan) AddLifeEvent(event *entities.LifeEvent) error {
+	if err := fp.profile.AddLifeEvent(event); err != nil {
+		return err
+	}
+	fp.touchProfile()
+	return nil
+}
+
+// UpdateLifeEvent はライフイベントを更新する
+func (fp *FinancialPlan) UpdateLifeEvent(
+	id entities.LifeEventID,
+	category entities.LifeEventCategory,
+	name string,
+	year int,
+	recurrence entities.LifeEventRecurrence,
+	amountChange valueobjects.Money,
+	durationYears int,
+) (*entities.LifeEvent, error) {
+	event, err := fp.profile.UpdateLifeEvent(id, category, name, year, recurrence, amountChange, durationYears)
+	if err != nil {
+		return nil, err
+	}
+	fp.touchProfile()
+	return event, nil
+}
+
+// RemoveLifeEvent はライフイベントを削除する
+func (fp *FinancialPlan) RemoveLifeEvent(id entities.LifeEventID) error {
+	if err := fp.profile.RemoveLifeEvent(id); err != nil {
+		return err
+	}
+	fp.touchProfile()
 	return nil
 }
 
 // SetRetirementData は退職データを設定する
+// プロファイルに生年月日がある場合、退職データの現在の年齢はプロファイルの年齢に揃える
 func (fp *FinancialPlan) SetRetirementData(retirementData *entities.RetirementData) error {
 	if retirementData == nil {
 		return errors.New("退職データは必須です")
 	}
 
 	fp.retirementData = retirementData
-	fp.updatedAt = time.Now()
+	if err := fp.syncRetirementAge(); err != nil {
+		return err
+	}
+	fp.touchProfile()
 	return nil
 }
 
-// UpdateEmergencyFund は緊急資金設定を更新する
-func (fp *FinancialPlan) UpdateEmergencyFund(config *EmergencyFundConfig) error {
-	if config == nil {
+// ErrRetirementDataNotSet は退職データが必要な操作で退職データが未設定の場合のエラー
+var ErrRetirementDataNotSet = errors.New("退職データが設定されていません")
+
+// ErrCurrentAgeDerivedFromBirthDate は生年月日から年齢を求めているため、年齢を直接更新できない場合のエラー
+var ErrCurrentAgeDerivedFromBirthDate = errors.New("生年月日が登録されているため、現在の年齢は生年月日から自動で計算されます")
+
+// UpdateRetirementCurrentAge は退職データの現在の年齢を更新する
+// プロファイルに生年月日がある場合は年齢を生年月日から求めるため、直接の更新は受け付けない
+func (fp *FinancialPlan) UpdateRetirementCurrentAge(newAge int) error {
+	if fp.retirementData == nil {
+		return ErrRetirementDataNotSet
+	}
+	if _, ok := fp.profile.CurrentAge(); ok {
+		return ErrCurrentAgeDerivedFromBirthDate
+	}
+
+	if err := fp.retirementData.UpdateCurrentAge(newAge); err != nil {
+		return err
+	}
+	fp.touchProfile()
+	return nil
+}
+
+// CurrentAge は年齢に依存する計算で使う現在の年齢を返す
+// プロファイルの生年月日を正とし、未設定の場合は退職データに入力された年齢を使う
+// どちらも無い場合は false を返す
+func (fp *FinancialPlan) CurrentAge() (int, bool) {
+	if age, ok := fp.profile.CurrentAge(); ok {
+		return age, true
+	}
+	if fp.retirementData != nil {
+		return fp.retirementData.CurrentAge(), true
+	}
+	return 0, false
+}
+
+// syncRetirementAge はプロファイルの生年月日から求めた年齢を退職データに反映する
+func (fp *FinancialPlan) syncRetirementAge() error {
+	if fp.retirementData == nil {
+		return nil
+	}
+	age, ok := fp.profile.CurrentAge()
+	if !ok {
+		return nil
+	}
+	if err := fp.retirementData.SyncCurrentAge(age); err != nil {
+		return fmt.Errorf("退職データの年齢の同期に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// UpdateEmergencyFund は緊急資金を置き換える
+func (fp *FinancialPlan) UpdateEmergencyFund(emergencyFund *EmergencyFund) error {
+	if emergencyFund == nil {
 		return errors.New("緊急資金設定は必須です")
 	}
 
-	fp.emergencyFund = config
-	fp.updatedAt = time.Now()
+	if err := recalculateEmergencyFund(emergencyFund, fp.profile); err != nil {
+		return err
+	}
+
+	fp.emergencyFund = emergencyFund
+	fp.touchProfile()
+	return nil
+}
+
+// UpdateEmergencyFundSettings は緊急資金の目標月数と現在額を更新する（積立履歴は保持する）
+func (fp *FinancialPlan) UpdateEmergencyFundSettings(targetMonths int, currentFund valueobjects.Money) error {
+	if fp.emergencyFund == nil {
+		emergencyFund, err := NewEmergencyFund(targetMonths, currentFund)
+		if err != nil {
+			return err
+		}
+		return fp.UpdateEmergencyFund(emergencyFund)
+	}
+
+	if err := fp.emergencyFund.UpdateSettings(targetMonths, currentFund); err != nil {
+		return err
+	}
+
+	if err := recalculateEmergencyFund(fp.emergencyFund, fp.profile); err != nil {
+		return err
+	}
+
+	fp.touchProfile()
+	return nil
+}
+
+// UpdateEmergencyFundMonthlyContribution は緊急資金の毎月の積立予定額を更新する
+// 月間純貯蓄額を超える額も設定できる（超過は EmergencyFundOvercommitted で警告する）
+func (fp *FinancialPlan) UpdateEmergencyFundMonthlyContribution(amount valueobjects.Money) error {
+	if fp.emergencyFund == nil {
+		return errors.New("緊急資金が設定されていません")
+	}
+
+	if err := fp.emergencyFund.SetMonthlyContribution(amount); err != nil {
+		return err
+	}
+
+	fp.touchProfile()
+	return nil
+}
+
+// EmergencyFundOvercommitted は緊急資金の月間積立額が月間純貯蓄額を超えているかを返す
+func (fp *FinancialPlan) EmergencyFundOvercommitted() bool {
+	if fp.emergencyFund == nil || !fp.emergencyFund.MonthlyContribution().IsPositive() {
+		return false
+	}
+
+	netSavings, err := fp.profile.CalculateNetSavings()
+	if err != nil {
+		return false
+	}
+	return fp.emergencyFund.MonthlyContribution().Amount() > netSavings.Amount()
+}
+
+// AddEmergencyFundContribution は緊急資金への積立を記録する
+func (fp *FinancialPlan) AddEmergencyFundContribution(amount valueobjects.Money, contributedAt time.Time) error {
+	if fp.emergencyFund == nil {
+		return errors.New("緊急資金が設定されていません")
+	}
+
+	if err := fp.emergencyFund.AddContribution(amount, contributedAt); err != nil {
+		return err
+	}
+
+	fp.touchProfile()
 	return nil
 }
 
@@ -274,7 +615,7 @@ func (fp *FinancialPlan) GenerateProjection(years int) (*PlanProjection, error)
 		GoalProgress: make([]GoalProgress, 0),
 	}
 
-	// 資産推移予測
+	// 資産推移予測（緊急資金の積立予定額は純貯蓄額の一部として総資産に含まれる）
 	assetProjections, err := fp.profile.ProjectAssets(years)
 	if err != nil {
 		return nil, fmt.Errorf("資産推移予測の生成に失敗しました: %w", err)
@@ -312,6 +653,17 @@ func (fp *FinancialPlan) GenerateProjection(years int) (*PlanProjection, error)
 			return nil, fmt.Errorf("緊急資金状況の計算に失敗しました: %w", err)
 		}
 		projection.EmergencyFundStatus = emergencyStatus
+
+		for year := 1; year <= years; year++ {
+			yearStatus, err := fp.emergencyFund.ProjectStatus(year * 12)
+			if err != nil {
+				return nil, fmt.Errorf("緊急資金の推移の計算に失敗しました: %w", err)
+			}
+			projection.EmergencyFundProjections = append(projection.EmergencyFundProjections, EmergencyFundProjection{
+				Year:                year,
+				EmergencyFundStatus: *yearStatus,
+			})
+		}
 	}
 
 	// 目標進捗
@@ -346,44 +698,42 @@ func (fp *FinancialPlan) calculateEmergencyFundStatus() (*EmergencyFundStatus, e
 		return nil, fmt.Errorf("月間支出の計算に失敗しました: %w", err)
 	}
 
-	// 必要緊急資金を計算
-	requiredAmount, err := monthlyExpenses.MultiplyByFloat(float64(fp.emergencyFund.TargetMonths))
+	status, err := fp.emergencyFund.CalculateStatus(monthlyExpenses)
 	if err != nil {
-		return nil, fmt.Errorf("必要緊急資金の計算に失敗しました: %w", err)
+		return nil, err
 	}
 
-	// 不足額を計算
-	shortfall, err := requiredAmount.Subtract(fp.emergencyFund.CurrentFund)
-	if err != nil {
-		return nil, fmt.Errorf("緊急資金不足額の計算に失敗しました: %w", err)
+	// 目標達成までの月数を計算（積立予定額がある場合はその額、ない場合は純貯蓄額の全額を積み立てる想定）
+	if fp.emergencyFund.MonthlyContribution().IsPositive() {
+		status.MonthsToTarget = fp.emergencyFund.MonthsToTarget(fp.emergencyFund.MonthlyContribution())
+	} else if netSavings, err := fp.profile.CalculateNetSavings(); err == nil {
+		status.MonthsToTarget = fp.emergencyFund.MonthsToTarget(netSavings)
 	}
 
-	// 不足がない場合は0にする
-	if shortfall.IsNegative() {
-		shortfall, _ = valueobjects.NewMoneyJPY(0)
-	}
+	return status, nil
+}
 
-	// 目標達成までの月数を計算
-	monthsToTarget := 0
-	if shortfall.IsPositive() {
-		netSavings, err := fp.profile.CalculateNetSavings()
-		if err == nil && netSavings.IsPositive() {
-			monthsToTarget = int(shortfall.Amount() / netSavings.Amount())
-		}
+// EmergencyFundStatus は現在の緊急資金の状況を返す
+func (fp *FinancialPlan) EmergencyFundStatus() (*EmergencyFundStatus, error) {
+	if fp.emergencyFund == nil {
+		return nil, errors.New("緊急資金が設定されていません")
 	}
-
-	return &EmergencyFundStatus{
-		RequiredAmount: requiredAmount,
-		CurrentAmount:  fp.emergencyFund.CurrentFund,
-		Shortfall:      shortfall,
-		MonthsToTarget: monthsToTarget,
-	}, nil
+	return fp.calculateEmergencyFundStatus()
 }
 
 // evaluateGoalProgress は目標の進捗を評価する
 func (fp *FinancialPlan) evaluateGoalProgress(goal *entities.Goal) (bool, string) {
-	// 目標達成可能性をチェック
-	achievable, err := goal.IsAchievable(fp.profile)
+	// 前提目標の達成待ちの間は拠出を開始しないため、進捗の遅れとして扱わない
+	if entities.IsGoalWaiting(goal, fp.goals) {
+		return true, "前提となる目標の達成待ちです"
+	}
+
+	// 目標達成可能性をチェック（前提目標がある場合は前提目標の完了見込み日から判定する）
+	startDate := entities.BuildGoalSchedules(fp.goals, time.Now())[goal.ID()].EffectiveStartDate
+	if startDate.IsZero() {
+		startDate = time.Now()
+	}
+	achievable, err := goal.IsAchievableFrom(fp.profile, startDate)
 	if err != nil {
 		return false, "進捗評価中にエラーが発生しました"
 	}
@@ -462,26 +812,29 @@ func (fp *FinancialPlan) ValidatePlan() []ValidationError {
 	}
 
 	// 緊急資金の妥当性チェック
-	if fp.emergencyFund != nil {
-		monthlyExpenses, err := fp.profile.MonthlyExpenses().Total()
+	if fp.emergencyFund != nil && !fp.emergencyFund.IsFullyFunded() {
+		requiredAmount := fp.emergencyFund.RequiredAmount()
+		shortfall, err := fp.emergencyFund.Shortfall()
 		if err == nil {
-			requiredAmount, err := monthlyExpenses.MultiplyByFloat(float64(fp.emergencyFund.TargetMonths))
-			if err == nil {
-				shortfall, err := requiredAmount.Subtract(fp.emergencyFund.CurrentFund)
-				if err == nil && shortfall.IsPositive() {
-					// 緊急資金が不足している場合の警告
-					shortfallRatio := shortfall.Amount() / requiredAmount.Amount()
-					if shortfallRatio > 0.5 {
-						errors = append(errors, ValidationError{
-							Field:   "emergency_fund",
-							Message: "緊急資金が大幅に不足しています。目標額の確保を優先してください",
-						})
-					}
-				}
+			// 緊急資金が不足している場合の警告
+			shortfallRatio := shortfall.Amount() / requiredAmount.Amount()
+			if shortfallRatio > 0.5 {
+				errors = append(errors, ValidationError{
+					Field:   "emergency_fund",
+					Message: "緊急資金が大幅に不足しています。目標額の確保を優先してください",
+				})
 			}
 		}
 	}
 
+	// 緊急資金の積立予定額が純貯蓄額を超えていないかチェック
+	if fp.EmergencyFundOvercommitted() {
+		errors = append(errors, ValidationError{
+			Field:   "emergency_fund.monthly_contribution",
+			Message: EmergencyFundOvercommitmentMessage,
+		})
+	}
+
 	return errors
 }
 
@@ -496,6 +849,96 @@ func (fp *FinancialPlan) GetActiveGoals() []*entities.Goal {
 	return activeGoals
 }
 
+// OptimizeAllocation は月間純貯蓄額を未完了のアクティブな目標に配分する
+// 前提目標を先に、依存関係のない目標同士は目標日の早い順に必要月額を満たすよう配分する
+// 前提目標の達成待ち（待機中）の目標には配分しない
+func (fp *FinancialPlan) OptimizeAllocation() ([]GoalAllocation, error) {
+	netSavings, err := fp.profile.CalculateNetSavings()
+	if err != nil {
+		return nil, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
+	}
+
+	remaining := netSavings.Amount()
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	now := time.Now()
+	schedules := entities.BuildGoalSchedules(fp.goals, now)
+
+	var targets []*entities.Goal
+	for _, goal := range fp.goals {
+		if goal.IsActive() && !goal.IsCompleted() {
+			targets = append(targets, goal)
+		}
+	}
+
+	allocations := make([]GoalAllocation, 0, len(targets))
+	for _, goal := range entities.SortGoalsByDependency(targets) {
+		startDate := schedules[goal.ID()].EffectiveStartDate
+		if startDate.IsZero() {
+			startDate = now
+		}
+		required, err := goal.CalculateRequiredMonthlySavingsFrom(startDate)
+		if err != nil {
+			return nil, fmt.Errorf("目標「%s」の必要月額の計算に失敗しました: %w", goal.Title(), err)
+		}
+
+		allocation := GoalAllocation{
+			Goal:           goal,
+			RequiredAmount: required,
+		}
+
+		allocated := 0.0
+		if waiting := entities.WaitingPrerequisites(goal, fp.goals); len(waiting) > 0 {
+			allocation.Waiting = true
+			for _, prerequisite := range waiting {
+				allocation.WaitingFor = append(allocation.WaitingFor, prerequisite.ID())
+			}
+		} else {
+			allocated = math.Min(required.Amount(), remaining)
+			remaining -= allocated
+		}
+
+		allocation.AllocatedAmount, err = valueobjects.NewMoney(allocated, netSavings.Currency())
+		if err != nil {
+			return nil, fmt.Errorf("配分額の作成に失敗しました: %w", err)
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	return allocations, nil
+}
+
+// GetGoalsNeedingAttention は対応が必要な目標（期限切れ・進捗の遅れ・達成困難）の進捗を返す
+// 前提目標の達成待ち（待機中）の目標は拠出を開始していないため対象外とする
+func (fp *FinancialPlan) GetGoalsNeedingAttention() ([]GoalProgress, error) {
+	var needingAttention []GoalProgress
+	for _, goal := range entities.SortGoalsByDependency(fp.goals) {
+		if !goal.IsActive() || goal.IsCompleted() || entities.IsGoalWaiting(goal, fp.goals) {
+			continue
+		}
+
+		onTrack, message := fp.evaluateGoalProgress(goal)
+		if onTrack {
+			continue
+		}
+
+		progress, err := goal.CalculateProgress(goal.CurrentAmount())
+		if err != nil {
+			return nil, fmt.Errorf("目標進捗の計算に失敗しました: %w", err)
+		}
+
+		needingAttention = append(needingAttention, GoalProgress{
+			Goal:     goal,
+			Progress: progress,
+			OnTrack:  onTrack,
+			Message:  message,
+		})
+	}
+	return needingAttention, nil
+}
+
 // GetGoalsByType は指定されたタイプの目標一覧を返す
 func (fp *FinancialPlan) GetGoalsByType(goalType entities.GoalType) []*entities.Goal {
 	var goals []*entities.Goal
@@ -1,6 +1,7 @@
 package aggregates
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -56,7 +57,7 @@ func TestNewFinancialPlan(t *testing.T) {
 		t.Error("緊急資金設定が初期化されていません")
 	}
 
-	if plan.EmergencyFund().TargetMonths != 3 {
+	if plan.EmergencyFund().TargetMonths() != 3 {
 		t.Error("デフォルトの緊急資金目標月数が正しくありません")
 	}
 }
@@ -115,6 +116,61 @@ func TestAddDuplicateRetirementGoal(t *testing.T) {
 	}
 }
 
+func TestRemoveGoal(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+	goal := createTestRetirementGoal(t)
+	if err := plan.AddGoal(goal); err != nil {
+		t.Fatalf("目標の追加に失敗しました: %v", err)
+	}
+
+	if err := plan.RemoveGoal(goal.ID()); err != nil {
+		t.Fatalf("目標の削除に失敗しました: %v", err)
+	}
+
+	// 検証
+	if len(plan.Goals()) != 0 {
+		t.Error("削除した目標が財務計画に残っています")
+	}
+	if len(plan.RemovedGoals()) != 1 || plan.RemovedGoals()[0] != goal {
+		t.Error("削除した目標が保存対象に含まれていません")
+	}
+	if !goal.IsDeleted() || goal.DeletedAt() == nil {
+		t.Error("削除した目標に削除日時が設定されていません")
+	}
+
+	// 削除済みの目標は再追加できない
+	if err := plan.AddGoal(goal); err == nil {
+		t.Error("削除済みの目標の追加がエラーになりませんでした")
+	}
+
+	// 退職目標を削除した後は新しい退職目標を追加できる
+	if err := plan.AddGoal(createTestRetirementGoal(t)); err != nil {
+		t.Errorf("退職目標の削除後に新しい退職目標を追加できませんでした: %v", err)
+	}
+
+	// 存在しない目標の削除はエラー
+	if err := plan.RemoveGoal(goal.ID()); err == nil {
+		t.Error("存在しない目標の削除がエラーになりませんでした")
+	}
+}
+
+func TestRestoreGoals(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+	active := createTestRetirementGoal(t)
+	deleted := createTestRetirementGoal(t)
+	deleted.MarkDeleted(time.Now())
+
+	plan.RestoreGoals([]*entities.Goal{active, nil, deleted})
+
+	// 検証
+	if len(plan.Goals()) != 1 || plan.Goals()[0] != active {
+		t.Errorf("削除されていない目標のみ復元される必要があります（件数: %d）", len(plan.Goals()))
+	}
+	if len(plan.RemovedGoals()) != 0 {
+		t.Error("復元した財務計画に削除した目標が含まれています")
+	}
+}
+
 func TestGenerateProjection(t *testing.T) {
 	plan := createTestFinancialPlan(t)
 
@@ -142,7 +198,7 @@ func TestValidatePlan(t *testing.T) {
 	plan := createTestFinancialPlan(t)
 
 	// 緊急資金を適切に設定
-	emergencyConfig, _ := NewEmergencyFundConfig(3, mustCreateMoney(540000)) // 3ヶ月分の支出
+	emergencyConfig, _ := NewEmergencyFund(3, mustCreateMoney(540000)) // 3ヶ月分の支出
 	err := plan.UpdateEmergencyFund(emergencyConfig)
 	if err != nil {
 		t.Fatalf("緊急資金設定の更新に失敗しました: %v", err)
@@ -157,6 +213,211 @@ func TestValidatePlan(t *testing.T) {
 	}
 }
 
+func TestOptimizeAllocation(t *testing.T) {
+	plan := createTestFinancialPlan(t) // 純貯蓄額: 140,000円/月
+
+	travel := createTestSavingsGoal(t, "海外旅行", 600000, time.Now().AddDate(1, 0, 0), time.Now())
+	car := createTestSavingsGoal(t, "車の購入", 1200000, time.Now().AddDate(2, 0, 0), time.Now())
+	house := createTestSavingsGoal(t, "住宅頭金", 1200000, time.Now().AddDate(5, 0, 0), time.Now())
+	for _, goal := range []*entities.Goal{house, car, travel} {
+		if err := plan.AddGoal(goal); err != nil {
+			t.Fatalf("目標の追加に失敗しました: %v", err)
+		}
+	}
+	if err := house.SetDependencies([]*entities.Goal{car}, plan.Goals()); err != nil {
+		t.Fatalf("前提となる目標の設定に失敗しました: %v", err)
+	}
+
+	allocations, err := plan.OptimizeAllocation()
+	if err != nil {
+		t.Fatalf("配分の算出に失敗しました: %v", err)
+	}
+	if len(allocations) != 3 {
+		t.Fatalf("未完了のアクティブな目標すべてを配分対象にすべきです: %d", len(allocations))
+	}
+
+	// 目標日の早い順、かつ前提目標が依存する目標より先に配分される
+	if allocations[0].Goal != travel || allocations[1].Goal != car || allocations[2].Goal != house {
+		t.Errorf("配分順が正しくありません: %s, %s, %s",
+			allocations[0].Goal.Title(), allocations[1].Goal.Title(), allocations[2].Goal.Title())
+	}
+
+	total := 0.0
+	for _, allocation := range allocations[:2] {
+		if allocation.Waiting {
+			t.Errorf("前提目標のない目標は待機中ではありません: %s", allocation.Goal.Title())
+		}
+		if allocation.AllocatedAmount.Amount() != allocation.RequiredAmount.Amount() {
+			t.Errorf("純貯蓄額が足りる場合は必要月額を満たすべきです: %s", allocation.Goal.Title())
+		}
+		total += allocation.AllocatedAmount.Amount()
+	}
+	if total > 140000 {
+		t.Errorf("配分額の合計が純貯蓄額を超えています: %.0f", total)
+	}
+
+	// 前提目標の達成待ちの目標には配分しない
+	waiting := allocations[2]
+	if !waiting.Waiting || !waiting.AllocatedAmount.IsZero() {
+		t.Errorf("待機中の目標には配分すべきではありません: %+v", waiting)
+	}
+	if len(waiting.WaitingFor) != 1 || waiting.WaitingFor[0] != car.ID() {
+		t.Errorf("未達成の前提目標が返されるべきです: %v", waiting.WaitingFor)
+	}
+}
+
+func TestGetGoalsNeedingAttention(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+
+	// 作成から1年経過して進捗0%の目標は遅れている
+	behind := createTestSavingsGoal(t, "海外旅行", 600000, time.Now().AddDate(1, 0, 0), time.Now().AddDate(-1, 0, 0))
+	// 同様に遅れているが、前提目標の達成待ちの目標は対象外
+	waiting := createTestSavingsGoal(t, "住宅頭金", 600000, time.Now().AddDate(3, 0, 0), time.Now().AddDate(-1, 0, 0))
+	// 作成直後の目標は順調
+	onTrack := createTestSavingsGoal(t, "車の購入", 1200000, time.Now().AddDate(2, 0, 0), time.Now())
+	for _, goal := range []*entities.Goal{behind, waiting, onTrack} {
+		if err := plan.AddGoal(goal); err != nil {
+			t.Fatalf("目標の追加に失敗しました: %v", err)
+		}
+	}
+	if err := waiting.SetDependencies([]*entities.Goal{behind}, plan.Goals()); err != nil {
+		t.Fatalf("前提となる目標の設定に失敗しました: %v", err)
+	}
+
+	needingAttention, err := plan.GetGoalsNeedingAttention()
+	if err != nil {
+		t.Fatalf("対応が必要な目標の取得に失敗しました: %v", err)
+	}
+	if len(needingAttention) != 1 || needingAttention[0].Goal != behind {
+		t.Fatalf("遅れている目標のみ返すべきです: %d件", len(needingAttention))
+	}
+	if needingAttention[0].OnTrack {
+		t.Error("対応が必要な目標は OnTrack=false であるべきです")
+	}
+}
+
+func TestFinancialPlan_CurrentAge(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+
+	// 生年月日も退職データも無い場合は年齢不明
+	if _, ok := plan.CurrentAge(); ok {
+		t.Error("年齢が未設定の場合は false を返すべきです")
+	}
+
+	// 生年月日が無い場合は退職データの年齢にフォールバックする
+	retirementData, err := entities.NewRetirementData("user123", 40, 65, 85, mustCreateMoney(250000), mustCreateMoney(150000))
+	if err != nil {
+		t.Fatalf("退職データの作成に失敗しました: %v", err)
+	}
+	if err := plan.SetRetirementData(retirementData); err != nil {
+		t.Fatalf("退職データの設定に失敗しました: %v", err)
+	}
+	if age, ok := plan.CurrentAge(); !ok || age != 40 {
+		t.Errorf("退職データの年齢を使うべきです: got %d", age)
+	}
+
+	// 生年月日を設定すると退職データの年齢もプロファイルに揃う
+	now := time.Now()
+	birthDate := time.Date(now.Year()-50, 1, 1, 0, 0, 0, 0, time.UTC)
+	profile := plan.Profile()
+	if err := profile.SetBirthDate(&birthDate); err != nil {
+		t.Fatalf("生年月日の設定に失敗しました: %v", err)
+	}
+	if err := plan.UpdateProfile(profile); err != nil {
+		t.Fatalf("プロファイルの更新に失敗しました: %v", err)
+	}
+	if age, ok := plan.CurrentAge(); !ok || age != 50 {
+		t.Errorf("プロファイルの年齢を使うべきです: got %d", age)
+	}
+	if plan.RetirementData().CurrentAge() != 50 {
+		t.Errorf("退職データの年齢が同期されていません: got %d", plan.RetirementData().CurrentAge())
+	}
+	if years := plan.RetirementData().CalculateYearsUntilRetirement(); years != 15 {
+		t.Errorf("退職までの年数はプロファイルの年齢から計算するべきです: got %d", years)
+	}
+}
+
+func TestFinancialPlan_UpdateRetirementCurrentAge(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+
+	// 退職データが無い場合は更新できない
+	if err := plan.UpdateRetirementCurrentAge(36); !errors.Is(err, ErrRetirementDataNotSet) {
+		t.Errorf("退職データが無い場合は ErrRetirementDataNotSet を返すべきです: got %v", err)
+	}
+
+	retirementData, err := entities.NewRetirementData("user123", 35, 65, 85, mustCreateMoney(250000), mustCreateMoney(150000))
+	if err != nil {
+		t.Fatalf("退職データの作成に失敗しました: %v", err)
+	}
+	if err := plan.SetRetirementData(retirementData); err != nil {
+		t.Fatalf("退職データの設定に失敗しました: %v", err)
+	}
+
+	// 年齢の更新は計算結果に影響するデータの更新として記録する
+	before := plan.LastProfileUpdatedAt()
+	time.Sleep(time.Millisecond)
+	if err := plan.UpdateRetirementCurrentAge(36); err != nil {
+		t.Fatalf("年齢の更新に失敗しました: %v", err)
+	}
+	if age, ok := plan.CurrentAge(); !ok || age != 36 {
+		t.Errorf("更新した年齢を使うべきです: got %d", age)
+	}
+	if !plan.LastProfileUpdatedAt().After(before) {
+		t.Error("年齢の更新でプロファイルの更新日時が記録されていません")
+	}
+
+	// 生年月日がある場合は年齢を直接更新できない
+	birthDate := time.Date(time.Now().Year()-40, 1, 1, 0, 0, 0, 0, time.UTC)
+	profile := plan.Profile()
+	if err := profile.SetBirthDate(&birthDate); err != nil {
+		t.Fatalf("生年月日の設定に失敗しました: %v", err)
+	}
+	if err := plan.UpdateRetirementCurrentAge(41); !errors.Is(err, ErrCurrentAgeDerivedFromBirthDate) {
+		t.Errorf("生年月日がある場合は ErrCurrentAgeDerivedFromBirthDate を返すべきです: got %v", err)
+	}
+}
+
+func TestFinancialPlan_IsCalculationUpToDate(t *testing.T) {
+	plan := createTestFinancialPlan(t)
+
+	// 未計算の場合は保存済みの計算結果を使えない
+	if plan.IsCalculationUpToDate() {
+		t.Error("未計算の場合は false を返すべきです")
+	}
+
+	// データの最終更新より後に計算した場合は使える
+	calculatedAt := time.Now().Add(-time.Minute)
+	plan.RestoreCalculationTimestamps(&calculatedAt, calculatedAt.Add(-time.Minute))
+	if !plan.IsCalculationUpToDate() {
+		t.Error("データの最終更新より後に計算した場合は true を返すべきです")
+	}
+
+	// 目標の追加は計算結果に影響しない
+	if err := plan.AddGoal(createTestRetirementGoal(t)); err != nil {
+		t.Fatalf("目標の追加に失敗しました: %v", err)
+	}
+	if !plan.IsCalculationUpToDate() {
+		t.Error("目標の追加で計算結果を無効にするべきではありません")
+	}
+
+	// プロファイルを更新すると計算結果は使えなくなる
+	if err := plan.UpdateProfile(plan.Profile()); err != nil {
+		t.Fatalf("プロファイルの更新に失敗しました: %v", err)
+	}
+	if plan.IsCalculationUpToDate() {
+		t.Error("プロファイルの更新後は false を返すべきです")
+	}
+
+	// 緊急資金の更新でも計算結果は使えなくなる
+	plan.RestoreCalculationTimestamps(&calculatedAt, calculatedAt.Add(-time.Minute))
+	if err := plan.UpdateEmergencyFundSettings(6, mustCreateMoney(100000)); err != nil {
+		t.Fatalf("緊急資金の更新に失敗しました: %v", err)
+	}
+	if plan.IsCalculationUpToDate() {
+		t.Error("緊急資金の更新後は false を返すべきです")
+	}
+}
+
 // ヘルパー関数
 func createTestFinancialPlan(t *testing.T) *FinancialPlan {
 	monthlyIncome, _ := valueobjects.NewMoneyJPY(400000)
@@ -211,6 +472,25 @@ func createTestRetirementGoal(t *testing.T) *entities.Goal {
 	return goal
 }
 
+func createTestSavingsGoal(t *testing.T, title string, targetAmount float64, targetDate, createdAt time.Time) *entities.Goal {
+	goal, err := entities.NewGoalWithID(
+		entities.NewGoalID(),
+		"user123",
+		entities.GoalTypeSavings,
+		title,
+		mustCreateMoney(targetAmount),
+		targetDate,
+		mustCreateMoney(50000),
+		createdAt,
+		createdAt,
+	)
+	if err != nil {
+		t.Fatalf("テスト用目標の作成に失敗しました: %v", err)
+	}
+
+	return goal
+}
+
 func mustCreateMoney(amount float64) valueobjects.Money {
 	money, err := valueobjects.NewMoneyJPY(amount)
 	if err != nil {
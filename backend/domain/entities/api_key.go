@@ -0,0 +1,291 @@
+package entities
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// APIKeyPrefix は発行するAPIキーの接頭辞
+	APIKeyPrefix = "fpc_"
+	// apiKeyVisibleChars は一覧表示で見せるキー末尾の文字数
+	apiKeyVisibleChars = 4
+	// maxAPIKeyNameLength はAPIキー名の最大文字数
+	maxAPIKeyNameLength = 100
+)
+
+// APIKeyID はAPIキーの一意識別子
+type APIKeyID string
+
+// NewAPIKeyID は新しいAPIキーIDを生成する
+func NewAPIKeyID() APIKeyID {
+	return APIKeyID(uuid.New().String())
+}
+
+// String はAPIKeyIDの文字列表現を返す
+func (id APIKeyID) String() string {
+	return string(id)
+}
+
+// APIKeyScope はAPIキーで許可する操作の範囲
+type APIKeyScope string
+
+const (
+	// APIKeyScopeReadOnly は参照系（GET）のAPIのみ許可する
+	APIKeyScopeReadOnly APIKeyScope = "read-only"
+	// APIKeyScopeReports はレポート生成APIを許可する
+	APIKeyScopeReports APIKeyScope = "reports"
+	// APIKeyScopeWrite は更新系のAPIを許可する（参照系も含む）
+	APIKeyScopeWrite APIKeyScope = "write"
+)
+
+// IsValid はスコープが有効な値かどうかを返す
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case APIKeyScopeReadOnly, APIKeyScopeReports, APIKeyScopeWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey は外部ツール連携用のAPIキーエンティティ
+// キー本体はハッシュ化して保持し、平文は発行時にのみ返却する
+type APIKey struct {
+	id         APIKeyID
+	userID     UserID
+	name       string
+	keyHash    string
+	lastFour   string
+	scopes     []APIKeyScope
+	lastUsedAt *time.Time
+	createdAt  time.Time
+	revokedAt  *time.Time
+}
+
+// NewAPIKey は新しいAPIキーを発行する
+// 戻り値の平文キーはクライアントへの返却にのみ使用し、保存しないこと
+func NewAPIKey(userID UserID, name string, scopes []APIKeyScope) (*APIKey, string, error) {
+	if userID == "" {
+		return nil, "", errors.New("ユーザーIDは必須です")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", errors.New("APIキー名は必須です")
+	}
+	if len([]rune(name)) > maxAPIKeyNameLength {
+		return nil, "", fmt.Errorf("APIキー名は%d文字以内で指定してください", maxAPIKeyNameLength)
+	}
+
+	normalizedScopes, err := normalizeAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// ランダムキーを生成（32バイト = 256ビット）
+	keyBytes := make([]byte, 32)
+	if _, err := rand.Read(keyBytes); err != nil {
+		return nil, "", fmt.Errorf("APIキーの生成に失敗しました: %w", err)
+	}
+	key := APIKeyPrefix + hex.EncodeToString(keyBytes)
+
+	apiKey := &APIKey{
+		id:        NewAPIKeyID(),
+		userID:    userID,
+		name:      name,
+		keyHash:   HashAPIKey(key),
+		lastFour:  key[len(key)-apiKeyVisibleChars:],
+		scopes:    normalizedScopes,
+		createdAt: time.Now(),
+	}
+
+	return apiKey, key, nil
+}
+
+// ReconstructAPIKey は既存のデータからAPIキーを再構築する（リポジトリからの取得用）
+func ReconstructAPIKey(
+	id string,
+	userID UserID,
+	name string,
+	keyHash string,
+	lastFour string,
+	scopes []APIKeyScope,
+	lastUsedAt *time.Time,
+	createdAt time.Time,
+	revokedAt *time.Time,
+) *APIKey {
+	return &APIKey{
+		id:         APIKeyID(id),
+		userID:     userID,
+		name:       name,
+		keyHash:    keyHash,
+		lastFour:   lastFour,
+		scopes:     scopes,
+		lastUsedAt: lastUsedAt,
+		createdAt:  createdAt,
+		revokedAt:  revokedAt,
+	}
+}
+
+// HashAPIKey はAPIキーをSHA-256でハッシュ化する（検索キーとして使用する）
+func HashAPIKey(key string) string {
+	return hashToken(key)
+}
+
+// normalizeAPIKeyScopes はスコープを検証し、重複を取り除く
+func normalizeAPIKeyScopes(scopes []APIKeyScope) ([]APIKeyScope, error) {
+	if len(scopes) == 0 {
+		return nil, errors.New("スコープを1つ以上指定してください")
+	}
+
+	seen := make(map[APIKeyScope]bool, len(scopes))
+	normalized := make([]APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("無効なスコープです: %s", scope)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		normalized = append(normalized, scope)
+	}
+	return normalized, nil
+}
+
+// ID はAPIキーのIDを返す
+func (k *APIKey) ID() APIKeyID {
+	return k.id
+}
+
+// UserID はAPIキーを所有するユーザーIDを返す
+func (k *APIKey) UserID() UserID {
+	return k.userID
+}
+
+// Name はAPIキーの名前を返す
+func (k *APIKey) Name() string {
+	return k.name
+}
+
+// KeyHash はAPIキーのハッシュ値を返す
+func (k *APIKey) KeyHash() string {
+	return k.keyHash
+}
+
+// LastFour はAPIキーの末尾の文字を返す
+func (k *APIKey) LastFour() string {
+	return k.lastFour
+}
+
+// MaskedKey は末尾の文字以外を伏せたAPIキーを返す（一覧表示用）
+func (k *APIKey) MaskedKey() string {
+	return APIKeyPrefix + "****" + k.lastFour
+}
+
+// Scopes はAPIキーのスコープを返す
+func (k *APIKey) Scopes() []APIKeyScope {
+	return k.scopes
+}
+
+// LastUsedAt はAPIキーの最終使用日時を返す（未使用の場合は nil）
+func (k *APIKey) LastUsedAt() *time.Time {
+	return k.lastUsedAt
+}
+
+// CreatedAt はAPIキーの作成日時を返す
+func (k *APIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
+
+// RevokedAt はAPIキーの失効日時を返す（失効していない場合は nil）
+func (k *APIKey) RevokedAt() *time.Time {
+	return k.revokedAt
+}
+
+// IsRevoked はAPIキーが失効されているかを返す
+func (k *APIKey) IsRevoked() bool {
+	return k.revokedAt != nil
+}
+
+// VerifyKey は提供されたキーがこのAPIキーと一致し、かつ有効かどうかを検証する
+func (k *APIKey) VerifyKey(key string) bool {
+	return !k.IsRevoked() && k.keyHash == HashAPIKey(key)
+}
+
+// HasScope は指定されたスコープが許可されているかを返す
+// write スコープは read-only の操作も許可する
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.scopes {
+		if s == scope {
+			return true
+		}
+		if scope == APIKeyScopeReadOnly && s == APIKeyScopeWrite {
+			return true
+		}
+	}
+	return false
+}
+
+// Revoke はAPIキーを失効させる
+func (k *APIKey) Revoke() error {
+	if k.IsRevoked() {
+		return errors.New("APIキーは既に失効しています")
+	}
+	now := time.Now()
+	k.revokedAt = &now
+	return nil
+}
+
+// MarkUsed はAPIキーの最終使用日時を更新する
+func (k *APIKey) MarkUsed() {
+	now := time.Now()
+	k.lastUsedAt = &now
+}
+
+// MarshalJSON はAPIKeyをJSONにシリアライズする（キーのハッシュ値は含めない）
+func (k *APIKey) MarshalJSON() ([]byte, error) {
+	type apiKeyJSON struct {
+		ID         string   `json:"id"`
+		UserID     string   `json:"user_id"`
+		Name       string   `json:"name"`
+		MaskedKey  string   `json:"masked_key"`
+		Scopes     []string `json:"scopes"`
+		LastUsedAt *string  `json:"last_used_at,omitempty"`
+		CreatedAt  string   `json:"created_at"`
+		RevokedAt  *string  `json:"revoked_at,omitempty"`
+	}
+
+	scopes := make([]string, len(k.scopes))
+	for i, scope := range k.scopes {
+		scopes[i] = string(scope)
+	}
+
+	return json.Marshal(apiKeyJSON{
+		ID:         string(k.id),
+		UserID:     string(k.userID),
+		Name:       k.name,
+		MaskedKey:  k.MaskedKey(),
+		Scopes:     scopes,
+		LastUsedAt: formatOptionalTime(k.lastUsedAt),
+		CreatedAt:  k.createdAt.Format(time.RFC3339),
+		RevokedAt:  formatOptionalTime(k.revokedAt),
+	})
+}
+
+// formatOptionalTime は nil 許容の日時をRFC3339形式の文字列に変換する
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,183 @@
+package entities
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditLogID は監査ログの一意識別子
+type AuditLogID string
+
+// NewAuditLogID は新しい監査ログIDを生成する
+func NewAuditLogID() AuditLogID {
+	return AuditLogID(uuid.New().String())
+}
+
+// String はAuditLogIDの文字列表現を返す
+func (id AuditLogID) String() string {
+	return string(id)
+}
+
+// AuditAction は監査ログに記録する操作の種類
+type AuditAction string
+
+const (
+	// AuditActionImpersonationStarted は管理者がなりすましセッションを発行した操作
+	AuditActionImpersonationStarted AuditAction = "impersonation_started"
+	// AuditActionImpersonationRequest はなりすましセッションで行われたリクエスト
+	AuditActionImpersonationRequest AuditAction = "impersonation_request"
+	// AuditActionImpersonationExpired はなりすましセッションの有効期限切れ
+	AuditActionImpersonationExpired AuditAction = "impersonation_expired"
+)
+
+// AuditLogEntry は監査ログの1件のエントリ（作成後は変更しない）
+type AuditLogEntry struct {
+	id           AuditLogID
+	action       AuditAction
+	actorUserID  UserID // 操作した管理者
+	targetUserID UserID // なりすましの対象ユーザー
+	sessionID    string // なりすましセッションのID（トークンの jti）
+	method       string
+	route        string
+	reason       string
+	expiresAt    *time.Time
+	createdAt    time.Time
+}
+
+// NewImpersonationStartedEntry はなりすましセッションの発行を記録するエントリを作成する
+func NewImpersonationStartedEntry(actorUserID, targetUserID UserID, sessionID, reason string, expiresAt, now time.Time) (*AuditLogEntry, error) {
+	if actorUserID == "" || targetUserID == "" {
+		return nil, errors.New("操作者と対象ユーザーのIDは必須です")
+	}
+	if sessionID == "" {
+		return nil, errors.New("セッションIDは必須です")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, errors.New("なりすましの理由は必須です")
+	}
+	return &AuditLogEntry{
+		id:           NewAuditLogID(),
+		action:       AuditActionImpersonationStarted,
+		actorUserID:  actorUserID,
+		targetUserID: targetUserID,
+		sessionID:    sessionID,
+		reason:       reason,
+		expiresAt:    &expiresAt,
+		createdAt:    now,
+	}, nil
+}
+
+// NewImpersonationRequestEntry はなりすましセッションで行われたリクエストを記録するエントリを作成する
+func NewImpersonationRequestEntry(actorUserID, targetUserID UserID, sessionID, method, route string, now time.Time) (*AuditLogEntry, error) {
+	if actorUserID == "" || targetUserID == "" {
+		return nil, errors.New("操作者と対象ユーザーのIDは必須です")
+	}
+	return &AuditLogEntry{
+		id:           NewAuditLogID(),
+		action:       AuditActionImpersonationRequest,
+		actorUserID:  actorUserID,
+		targetUserID: targetUserID,
+		sessionID:    sessionID,
+		method:       method,
+		route:        route,
+		createdAt:    now,
+	}, nil
+}
+
+// ExpiredEntry はなりすましセッションの発行エントリから有効期限切れを記録するエントリを作成する
+func (e *AuditLogEntry) ExpiredEntry(now time.Time) (*AuditLogEntry, error) {
+	if e.action != AuditActionImpersonationStarted || e.expiresAt == nil {
+		return nil, errors.New("なりすましセッションの発行エントリではありません")
+	}
+	expiresAt := *e.expiresAt
+	return &AuditLogEntry{
+		id:           NewAuditLogID(),
+		action:       AuditActionImpersonationExpired,
+		actorUserID:  e.actorUserID,
+		targetUserID: e.targetUserID,
+		sessionID:    e.sessionID,
+		expiresAt:    &expiresAt,
+		createdAt:    now,
+	}, nil
+}
+
+// ReconstructAuditLogEntry はデータベースから監査ログを再構築する
+func ReconstructAuditLogEntry(
+	id string,
+	action AuditAction,
+	actorUserID UserID,
+	targetUserID UserID,
+	sessionID string,
+	method string,
+	route string,
+	reason string,
+	expiresAt *time.Time,
+	createdAt time.Time,
+) *AuditLogEntry {
+	return &AuditLogEntry{
+		id:           AuditLogID(id),
+		action:       action,
+		actorUserID:  actorUserID,
+		targetUserID: targetUserID,
+		sessionID:    sessionID,
+		method:       method,
+		route:        route,
+		reason:       reason,
+		expiresAt:    expiresAt,
+		createdAt:    createdAt,
+	}
+}
+
+// ID は監査ログIDを返す
+func (e *AuditLogEntry) ID() AuditLogID {
+	return e.id
+}
+
+// Action は操作の種類を返す
+func (e *AuditLogEntry) Action() AuditAction {
+	return e.action
+}
+
+// ActorUserID は操作した管理者のIDを返す
+func (e *AuditLogEntry) ActorUserID() UserID {
+	return e.actorUserID
+}
+
+// TargetUserID はなりすましの対象ユーザーのIDを返す
+func (e *AuditLogEntry) TargetUserID() UserID {
+	return e.targetUserID
+}
+
+// SessionID はなりすましセッションのIDを返す
+func (e *AuditLogEntry) SessionID() string {
+	return e.sessionID
+}
+
+// Method はリクエストのHTTPメソッドを返す（リクエスト以外のエントリは空）
+func (e *AuditLogEntry) Method() string {
+	return e.method
+}
+
+// Route はリクエストのルートを返す（リクエスト以外のエントリは空）
+func (e *AuditLogEntry) Route() string {
+	return e.route
+}
+
+// Reason はなりすましの理由を返す（発行エントリのみ）
+func (e *AuditLogEntry) Reason() string {
+	return e.reason
+}
+
+// ExpiresAt はなりすましセッションの有効期限を返す（発行・期限切れエントリのみ）
+func (e *AuditLogEntry) ExpiresAt() *time.Time {
+	return e.expiresAt
+}
+
+// CreatedAt は記録日時を返す
+func (e *AuditLogEntry) CreatedAt() time.Time {
+	return e.createdAt
+}
@@ -0,0 +1,89 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// CalculationSnapshotKind は保存する計算結果の種別
+type CalculationSnapshotKind string
+
+const (
+	CalculationSnapshotKindProjection CalculationSnapshotKind = "projection" // 包括的な財務予測
+	CalculationSnapshotKindReport     CalculationSnapshotKind = "report"     // 包括的レポート
+	CalculationSnapshotKindAlert      CalculationSnapshotKind = "alert"      // 推奨事項・警告
+	// CalculationSnapshotKindFinancialHealth は財務サマリーレポートの財務健全性と現在の状況
+	CalculationSnapshotKindFinancialHealth CalculationSnapshotKind = "financial_health"
+)
+
+// CalculationSnapshot はユーザーごとに保存した計算結果を表すエンティティ
+// 計算時の計算バージョンを保持し、現在のバージョンと異なる場合は古い結果として扱う
+type CalculationSnapshot struct {
+	userID             UserID
+	kind               CalculationSnapshotKind
+	payload            []byte
+	calculationVersion int
+	recalculatedAt     time.Time
+}
+
+// NewCalculationSnapshot は計算結果のスナップショットを作成する
+func NewCalculationSnapshot(
+	userID UserID,
+	kind CalculationSnapshotKind,
+	payload []byte,
+	calculationVersion int,
+	recalculatedAt time.Time,
+) (*CalculationSnapshot, error) {
+	if userID == "" {
+		return nil, errors.New("ユーザーIDは必須です")
+	}
+	switch kind {
+	case CalculationSnapshotKindProjection, CalculationSnapshotKindReport, CalculationSnapshotKindAlert, CalculationSnapshotKindFinancialHealth:
+	default:
+		return nil, errors.New("無効な計算結果の種別です")
+	}
+	if len(payload) == 0 {
+		return nil, errors.New("計算結果は必須です")
+	}
+	if calculationVersion <= 0 {
+		return nil, errors.New("計算バージョンは1以上である必要があります")
+	}
+
+	return &CalculationSnapshot{
+		userID:             userID,
+		kind:               kind,
+		payload:            payload,
+		calculationVersion: calculationVersion,
+		recalculatedAt:     recalculatedAt,
+	}, nil
+}
+
+// UserID はユーザーIDを返す
+func (s *CalculationSnapshot) UserID() UserID {
+	return s.userID
+}
+
+// Kind は計算結果の種別を返す
+func (s *CalculationSnapshot) Kind() CalculationSnapshotKind {
+	return s.kind
+}
+
+// Payload は計算結果（JSON）を返す
+func (s *CalculationSnapshot) Payload() []byte {
+	return s.payload
+}
+
+// CalculationVersion は計算時の計算バージョンを返す
+func (s *CalculationSnapshot) CalculationVersion() int {
+	return s.calculationVersion
+}
+
+// RecalculatedAt は計算日時を返す
+func (s *CalculationSnapshot) RecalculatedAt() time.Time {
+	return s.recalculatedAt
+}
+
+// IsStale は現在の計算バージョンと異なるバージョンで計算された結果かを返す
+func (s *CalculationSnapshot) IsStale(currentVersion int) bool {
+	return s.calculationVersion != currentVersion
+}
@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// DigestDelivery は配信したダイジェストメールの記録を表すエンティティ
+// 次回のダイジェストで「前回からの進捗」や「新しい警告」を求める基準として、配信時点のスコア・警告・目標の積立額を保持する
+type DigestDelivery struct {
+	userID      UserID
+	frequency   DigestFrequency
+	healthScore int
+	warnings    []string
+	goalAmounts map[GoalID]float64 // 目標ごとの配信時点の積立額（円）
+	sentAt      time.Time
+}
+
+// NewDigestDelivery はダイジェストメールの配信記録を作成する
+func NewDigestDelivery(
+	userID UserID,
+	frequency DigestFrequency,
+	healthScore int,
+	warnings []string,
+	goalAmounts map[GoalID]float64,
+	sentAt time.Time,
+) (*DigestDelivery, error) {
+	if userID == "" {
+		return nil, errors.New("ユーザーIDは必須です")
+	}
+	if !frequency.IsValid() {
+		return nil, errors.New("無効な配信頻度です")
+	}
+	if healthScore < 0 || healthScore > 100 {
+		return nil, errors.New("財務健全性スコアは0から100の範囲である必要があります")
+	}
+	if sentAt.IsZero() {
+		return nil, errors.New("配信日時は必須です")
+	}
+	if warnings == nil {
+		warnings = []string{}
+	}
+	if goalAmounts == nil {
+		goalAmounts = map[GoalID]float64{}
+	}
+
+	return &DigestDelivery{
+		userID:      userID,
+		frequency:   frequency,
+		healthScore: healthScore,
+		warnings:    warnings,
+		goalAmounts: goalAmounts,
+		sentAt:      sentAt,
+	}, nil
+}
+
+// UserID はユーザーIDを返す
+func (d *DigestDelivery) UserID() UserID {
+	return d.userID
+}
+
+// Frequency は配信時の配信頻度を返す
+func (d *DigestDelivery) Frequency() DigestFrequency {
+	return d.frequency
+}
+
+// HealthScore は配信時点の財務健全性スコアを返す
+func (d *DigestDelivery) HealthScore() int {
+	return d.healthScore
+}
+
+// Warnings は配信時点の警告を返す
+func (d *DigestDelivery) Warnings() []string {
+	return d.warnings
+}
+
+// GoalAmounts は目標ごとの配信時点の積立額を返す
+func (d *DigestDelivery) GoalAmounts() map[GoalID]float64 {
+	return d.goalAmounts
+}
+
+// SentAt は配信日時を返す
+func (d *DigestDelivery) SentAt() time.Time {
+	return d.sentAt
+}
+
+// HasWarning は配信時点に同じ警告が含まれていたかを返す
+func (d *DigestDelivery) HasWarning(warning string) bool {
+	for _, w := range d.warnings {
+		if w == warning {
+			return true
+		}
+	}
+	return false
+}
+
+// GoalAmount は配信時点の目標の積立額を返す（配信後に作成された目標の場合は false）
+func (d *DigestDelivery) GoalAmount(goalID GoalID) (float64, bool) {
+	amount, ok := d.goalAmounts[goalID]
+	return amount, ok
+}
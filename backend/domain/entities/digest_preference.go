@@ -0,0 +1,120 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+// DigestFrequency はダイジェストメールの配信頻度
+type DigestFrequency string
+
+const (
+	DigestFrequencyOff    DigestFrequency = "off"    // 配信しない
+	DigestFrequencyWeekly DigestFrequency = "weekly" // 週1回
+	DigestFrequencyDaily  DigestFrequency = "daily"  // 毎日
+)
+
+// DefaultDigestDeliveryHour はダイジェストメールの既定の配信時刻（時）
+const DefaultDigestDeliveryHour = 8
+
+// ParseDigestFrequency は文字列をダイジェストメールの配信頻度に変換する
+func ParseDigestFrequency(value string) (DigestFrequency, error) {
+	frequency := DigestFrequency(value)
+	if !frequency.IsValid() {
+		return "", fmt.Errorf("無効な配信頻度です: %s（使用可能: off, weekly, daily）", value)
+	}
+	return frequency, nil
+}
+
+// IsValid は配信頻度が有効かどうかを返す
+func (f DigestFrequency) IsValid() bool {
+	switch f {
+	case DigestFrequencyOff, DigestFrequencyWeekly, DigestFrequencyDaily:
+		return true
+	default:
+		return false
+	}
+}
+
+// String は配信頻度の表示名を返す
+func (f DigestFrequency) String() string {
+	switch f {
+	case DigestFrequencyOff:
+		return "配信しない"
+	case DigestFrequencyWeekly:
+		return "週次"
+	case DigestFrequencyDaily:
+		return "日次"
+	default:
+		return "不明"
+	}
+}
+
+// interval は配信の間隔（日数）を返す
+func (f DigestFrequency) interval() int {
+	if f == DigestFrequencyWeekly {
+		return 7
+	}
+	return 1
+}
+
+// DigestPreference はユーザーのダイジェストメールの配信設定を表す値オブジェクト
+// 配信時刻はバッチを実行する環境のタイムゾーンで判定する
+type DigestPreference struct {
+	frequency    DigestFrequency
+	deliveryHour int
+}
+
+// NewDigestPreference は配信頻度と配信時刻（0〜23時）からダイジェストメールの配信設定を作成する
+func NewDigestPreference(frequency DigestFrequency, deliveryHour int) (DigestPreference, error) {
+	if !frequency.IsValid() {
+		return DigestPreference{}, fmt.Errorf("無効な配信頻度です: %s", string(frequency))
+	}
+	if deliveryHour < 0 || deliveryHour > 23 {
+		return DigestPreference{}, fmt.Errorf("配信時刻は0から23の範囲である必要があります: %d", deliveryHour)
+	}
+	return DigestPreference{frequency: frequency, deliveryHour: deliveryHour}, nil
+}
+
+// DefaultDigestPreference はダイジェストメールの既定の配信設定（配信しない）を返す
+func DefaultDigestPreference() DigestPreference {
+	return DigestPreference{frequency: DigestFrequencyOff, deliveryHour: DefaultDigestDeliveryHour}
+}
+
+// Frequency は配信頻度を返す
+func (p DigestPreference) Frequency() DigestFrequency {
+	if p.frequency == "" {
+		return DigestFrequencyOff
+	}
+	return p.frequency
+}
+
+// DeliveryHour は配信時刻（時）を返す
+func (p DigestPreference) DeliveryHour() int {
+	return p.deliveryHour
+}
+
+// IsEnabled はダイジェストメールを配信する設定かどうかを返す
+func (p DigestPreference) IsEnabled() bool {
+	return p.Frequency() != DigestFrequencyOff
+}
+
+// IsDue は now の時点でダイジェストメールを配信すべきかどうかを返す
+// 当日の配信時刻を過ぎていて、前回の配信が今回の配信期間（日次は当日の配信時刻以降、週次は6日前の配信時刻以降）に
+// 含まれない場合に配信する。一度も配信していない場合は、配信時刻を過ぎていれば配信する
+func (p DigestPreference) IsDue(lastSentAt *time.Time, now time.Time) bool {
+	if !p.IsEnabled() {
+		return false
+	}
+
+	slot := time.Date(now.Year(), now.Month(), now.Day(), p.deliveryHour, 0, 0, 0, now.Location())
+	if now.Before(slot) {
+		return false
+	}
+	if lastSentAt == nil {
+		return true
+	}
+
+	windowStart := slot.AddDate(0, 0, 1-p.Frequency().interval())
+	return lastSentAt.Before(windowStart)
+}
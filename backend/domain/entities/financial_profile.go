@@ -1,8 +1,11 @@
 package entities
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"strconv"
 	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
@@ -109,19 +112,142 @@ type AssetProjection struct {
 	RealValue         valueobjects.Money `json:"real_value"`
 	ContributedAmount valueobjects.Money `json:"contributed_amount"`
 	InvestmentGains   valueobjects.Money `json:"investment_gains"`
+	TotalLiabilities  valueobjects.Money `json:"total_liabilities"` // 年末時点の負債残高
+	NetWorth          valueobjects.Money `json:"net_worth"`         // 純資産（総資産 - 負債残高）
 }
 
+// MarshalJSON は資産推移の各金額を円単位の整数としてシリアライズする（指数表記にはしない）
+func (p AssetProjection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Year              int         `json:"year"`
+		TotalAssets       json.Number `json:"total_assets"`
+		RealValue         json.Number `json:"real_value"`
+		ContributedAmount json.Number `json:"contributed_amount"`
+		InvestmentGains   json.Number `json:"investment_gains"`
+		TotalLiabilities  json.Number `json:"total_liabilities"`
+		NetWorth          json.Number `json:"net_worth"`
+	}{
+		Year:              p.Year,
+		TotalAssets:       yenNumber(p.TotalAssets),
+		RealValue:         yenNumber(p.RealValue),
+		ContributedAmount: yenNumber(p.ContributedAmount),
+		InvestmentGains:   yenNumber(p.InvestmentGains),
+		TotalLiabilities:  yenNumber(p.TotalLiabilities),
+		NetWorth:          yenNumber(p.NetWorth),
+	})
+}
+
+// yenNumber は金額を円単位の整数に丸めた JSON の数値を返す
+func yenNumber(m valueobjects.Money) json.Number {
+	rounded := math.Round(m.Amount())
+	if rounded == 0 {
+		rounded = 0 // -0 を 0 として出力する
+	}
+	return json.Number(strconv.FormatFloat(rounded, 'f', 0, 64))
+}
+
+// IncomeType は月収の入力区分（額面・手取り）
+type IncomeType string
+
+const (
+	IncomeTypeNet   IncomeType = "net"   // 手取り
+	IncomeTypeGross IncomeType = "gross" // 額面（税・社会保険料控除前）
+)
+
+// ParseIncomeType は文字列から月収の入力区分を作成する
+// 空文字の場合は手取りとして扱う
+func ParseIncomeType(value string) (IncomeType, error) {
+	switch IncomeType(value) {
+	case "", IncomeTypeNet:
+		return IncomeTypeNet, nil
+	case IncomeTypeGross:
+		return IncomeTypeGross, nil
+	default:
+		return "", fmt.Errorf("無効な月収の入力区分です: %s", value)
+	}
+}
+
+// RiskTolerance は投資のリスク許容度
+type RiskTolerance string
+
+const (
+	RiskToleranceConservative RiskTolerance = "conservative" // 安定重視
+	RiskToleranceModerate     RiskTolerance = "moderate"     // バランス
+	RiskToleranceAggressive   RiskTolerance = "aggressive"   // 積極運用
+)
+
+// ParseRiskTolerance は文字列からリスク許容度を作成する
+// 空文字の場合はバランスとして扱う
+func ParseRiskTolerance(value string) (RiskTolerance, error) {
+	switch RiskTolerance(value) {
+	case "", RiskToleranceModerate:
+		return RiskToleranceModerate, nil
+	case RiskToleranceConservative:
+		return RiskToleranceConservative, nil
+	case RiskToleranceAggressive:
+		return RiskToleranceAggressive, nil
+	default:
+		return "", fmt.Errorf("無効なリスク許容度です: %s", value)
+	}
+}
+
+// MaxVolatility はリスク許容度で受け入れられる想定ボラティリティ（年率%）の上限を返す
+func (rt RiskTolerance) MaxVolatility() float64 {
+	switch rt {
+	case RiskToleranceConservative:
+		return 5
+	case RiskToleranceAggressive:
+		return 25
+	default:
+		return 15
+	}
+}
+
+const (
+	// RiskFreeRate はリスク調整後リターンの計算に使う無リスク金利（年率%、預金金利相当）
+	RiskFreeRate = 0.1
+	// WorstCaseSigma は最悪ケースのリターンを求めるときに期待リターンから差し引く標準偏差の倍数
+	WorstCaseSigma = 2.0
+)
+
+// assetTypeVolatility は貯蓄の種類ごとの想定ボラティリティ（年率%）
+// ボラティリティが未設定の場合に、貯蓄の内訳からプロファイル全体の値を推定するために使う
+var assetTypeVolatility = map[string]float64{
+	"deposit":    0,
+	"investment": 15,
+	"other":      5,
+}
+
+// MaxPlanningAge は計画の対象とする年齢の上限
+// 目標日にこの年齢を超える目標は現実的でないとみなす
+const MaxPlanningAge = 100
+
 // FinancialProfile はユーザーの財務プロファイルを表すエンティティ
 type FinancialProfile struct {
-	id               FinancialProfileID
-	userID           UserID
-	monthlyIncome    valueobjects.Money
+	id            FinancialProfileID
+	userID        UserID
+	monthlyIncome valueobjects.Money
+	// 月収の入力区分と、純貯蓄額の計算に使う手取り月収（額面入力の場合は推定額）
+	incomeType       IncomeType
+	netMonthlyIncome valueobjects.Money
+	// 生年月日（年齢に依存する計算の基準。未設定の場合は nil）
+	birthDate        *time.Time
 	monthlyExpenses  ExpenseCollection
 	currentSavings   SavingsCollection
+	liabilities      LiabilityCollection
+	lifeEvents       LifeEventCollection
 	investmentReturn valueobjects.Rate
 	inflationRate    valueobjects.Rate
-	createdAt        time.Time
-	updatedAt        time.Time
+	// 想定ボラティリティ（年率。未設定の場合は nil で、貯蓄の内訳から推定する）とリスク許容度
+	expectedVolatility *valueobjects.Rate
+	riskTolerance      RiskTolerance
+	// 資産推移の計算で積立を各月の期初に行うか期末に行うか（未設定の場合は期末拠出）
+	contributionTiming valueobjects.ContributionTiming
+	// 現実的な上限を超える想定利回り・インフレ率にユーザーが同意したか
+	highReturnAcknowledged    bool
+	highInflationAcknowledged bool
+	createdAt                 time.Time
+	updatedAt                 time.Time
 }
 
 // NewFinancialProfile は新しい財務プロファイルを作成する
@@ -171,6 +297,7 @@ func NewFinancialProfile(
 		currentSavings:   currentSavings,
 		investmentReturn: investmentReturn,
 		inflationRate:    inflationRate,
+		riskTolerance:    RiskToleranceModerate,
 		createdAt:        now,
 		updatedAt:        now,
 	}, nil
@@ -204,6 +331,7 @@ func NewFinancialProfileWithID(
 		currentSavings:   currentSavings,
 		investmentReturn: investmentReturn,
 		inflationRate:    inflationRate,
+		riskTolerance:    RiskToleranceModerate,
 		createdAt:        createdAt,
 		updatedAt:        updatedAt,
 	}, nil
@@ -224,6 +352,106 @@ func (fp *FinancialProfile) MonthlyIncome() valueobjects.Money {
 	return fp.monthlyIncome
 }
 
+// IncomeType は月収の入力区分を返す
+func (fp *FinancialProfile) IncomeType() IncomeType {
+	if fp.incomeType == "" {
+		return IncomeTypeNet
+	}
+	return fp.incomeType
+}
+
+// NetMonthlyIncome は手取り月収を返す
+// 額面で入力されている場合は税・社会保険料を控除した推定額を返す
+func (fp *FinancialProfile) NetMonthlyIncome() valueobjects.Money {
+	if fp.IncomeType() == IncomeTypeGross && fp.netMonthlyIncome.IsPositive() {
+		return fp.netMonthlyIncome
+	}
+	return fp.monthlyIncome
+}
+
+// SetIncomeType は月収の入力区分と手取り月収を設定する
+// 月収と同時に設定される属性のため、リポジトリからの復元にも使用し更新日時は変更しない
+func (fp *FinancialProfile) SetIncomeType(incomeType IncomeType, netMonthlyIncome valueobjects.Money) error {
+	switch incomeType {
+	case IncomeTypeNet:
+		fp.incomeType = IncomeTypeNet
+		fp.netMonthlyIncome = fp.monthlyIncome
+		return nil
+	case IncomeTypeGross:
+		if !netMonthlyIncome.IsPositive() {
+			return errors.New("手取り月収は正の値である必要があります")
+		}
+		exceeds, err := netMonthlyIncome.GreaterThan(fp.monthlyIncome)
+		if err != nil {
+			return fmt.Errorf("手取り月収の比較に失敗しました: %w", err)
+		}
+		if exceeds {
+			return errors.New("手取り月収は額面月収を超えることはできません")
+		}
+		fp.incomeType = IncomeTypeGross
+		fp.netMonthlyIncome = netMonthlyIncome
+		return nil
+	default:
+		return fmt.Errorf("無効な月収の入力区分です: %s", incomeType)
+	}
+}
+
+// BirthDate は生年月日を返す（未設定の場合は nil）
+func (fp *FinancialProfile) BirthDate() *time.Time {
+	if fp.birthDate == nil {
+		return nil
+	}
+	birthDate := *fp.birthDate
+	return &birthDate
+}
+
+// SetBirthDate は生年月日を設定する（nil の場合は未設定に戻す）
+// リポジトリからの復元にも使用し更新日時は変更しない
+func (fp *FinancialProfile) SetBirthDate(birthDate *time.Time) error {
+	if birthDate == nil {
+		fp.birthDate = nil
+		return nil
+	}
+
+	date := time.Date(birthDate.Year(), birthDate.Month(), birthDate.Day(), 0, 0, 0, 0, time.UTC)
+	if date.After(time.Now()) {
+		return errors.New("生年月日は未来の日付にできません")
+	}
+	if age := ageAt(date, time.Now()); age > 150 {
+		return errors.New("年齢は150歳以下である必要があります")
+	}
+
+	fp.birthDate = &date
+	return nil
+}
+
+// AgeAt は指定日時点の満年齢を返す
+// 生年月日が未設定の場合は false を返す
+func (fp *FinancialProfile) AgeAt(date time.Time) (int, bool) {
+	if fp.birthDate == nil {
+		return 0, false
+	}
+	return ageAt(*fp.birthDate, date), true
+}
+
+// CurrentAge は現在の満年齢を返す
+// 生年月日が未設定の場合は false を返し、年齢に依存する計算は年齢を使わない既定の挙動になる
+func (fp *FinancialProfile) CurrentAge() (int, bool) {
+	return fp.AgeAt(time.Now())
+}
+
+// ageAt は生年月日から指定日時点の満年齢を計算する
+func ageAt(birthDate, date time.Time) int {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
 // MonthlyExpenses は月間支出を返す
 func (fp *FinancialProfile) MonthlyExpenses() ExpenseCollection {
 	return fp.monthlyExpenses
@@ -234,6 +462,168 @@ func (fp *FinancialProfile) CurrentSavings() SavingsCollection {
 	return fp.currentSavings
 }
 
+// Liabilities は負債を返す
+func (fp *FinancialProfile) Liabilities() LiabilityCollection {
+	return append(LiabilityCollection(nil), fp.liabilities...)
+}
+
+// SetLiabilities は負債を設定する
+// リポジトリからの復元やプロファイルの置き換え時の引き継ぎに使用し更新日時は変更しない
+func (fp *FinancialProfile) SetLiabilities(liabilities LiabilityCollection) {
+	fp.liabilities = append(LiabilityCollection(nil), liabilities...)
+}
+
+// AddLiability は負債を追加する
+func (fp *FinancialProfile) AddLiability(liability *Liability) error {
+	if liability == nil {
+		return errors.New("負債は必須です")
+	}
+	if fp.liabilities.FindByID(liability.ID()) != nil {
+		return fmt.Errorf("負債は既に登録されています: %s", liability.ID())
+	}
+
+	fp.liabilities = append(fp.liabilities, liability)
+	fp.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateLiability は指定されたIDの負債を更新する
+func (fp *FinancialProfile) UpdateLiability(
+	id LiabilityID,
+	liabilityType LiabilityType,
+	name string,
+	principalRemaining valueobjects.Money,
+	interestRate valueobjects.Rate,
+	monthlyPayment valueobjects.Money,
+	endDate time.Time,
+	includedInExpenses bool,
+) (*Liability, error) {
+	liability := fp.liabilities.FindByID(id)
+	if liability == nil {
+		return nil, fmt.Errorf("負債が見つかりません: %s", id)
+	}
+	if err := liability.Update(liabilityType, name, principalRemaining, interestRate, monthlyPayment, endDate, includedInExpenses); err != nil {
+		return nil, err
+	}
+
+	fp.updatedAt = time.Now()
+	return liability, nil
+}
+
+// RemoveLiability は指定されたIDの負債を削除する
+func (fp *FinancialProfile) RemoveLiability(id LiabilityID) error {
+	for i, liability := range fp.liabilities {
+		if liability.ID() == id {
+			fp.liabilities = append(fp.liabilities[:i], fp.liabilities[i+1:]...)
+			fp.updatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("負債が見つかりません: %s", id)
+}
+
+// LifeEvents はライフイベントを返す
+func (fp *FinancialProfile) LifeEvents() LifeEventCollection {
+	return append(LifeEventCollection(nil), fp.lifeEvents...)
+}
+
+// SetLifeEvents はライフイベントを設定する
+// リポジトリからの復元やプロファイルの置き換え時の引き継ぎに使用し更新日時は変更しない
+func (fp *FinancialProfile) SetLifeEvents(lifeEvents LifeEventCollection) {
+	fp.lifeEvents = append(LifeEventCollection(nil), lifeEvents...)
+}
+
+// AddLifeEvent はライフイベントを追加する
+func (fp *FinancialProfile) AddLifeEvent(event *LifeEvent) error {
+	if event == nil {
+		return errors.New("ライフイベントは必須です")
+	}
+	if fp.lifeEvents.FindByID(event.ID()) != nil {
+		return fmt.Errorf("ライフイベントは既に登録されています: %s", event.ID())
+	}
+
+	fp.lifeEvents = append(fp.lifeEvents, event)
+	fp.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateLifeEvent は指定されたIDのライフイベントを更新する
+func (fp *FinancialProfile) UpdateLifeEvent(
+	id LifeEventID,
+	category LifeEventCategory,
+	name string,
+	year int,
+	recurrence LifeEventRecurrence,
+	amountChange valueobjects.Money,
+	durationYears int,
+) (*LifeEvent, error) {
+	event := fp.lifeEvents.FindByID(id)
+	if event == nil {
+		return nil, fmt.Errorf("ライフイベントが見つかりません: %s", id)
+	}
+	if err := event.Update(category, name, year, recurrence, amountChange, durationYears); err != nil {
+		return nil, err
+	}
+
+	fp.updatedAt = time.Now()
+	return event, nil
+}
+
+// RemoveLifeEvent は指定されたIDのライフイベントを削除する
+func (fp *FinancialProfile) RemoveLifeEvent(id LifeEventID) error {
+	for i, event := range fp.lifeEvents {
+		if event.ID() == id {
+			fp.lifeEvents = append(fp.lifeEvents[:i], fp.lifeEvents[i+1:]...)
+			fp.updatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("ライフイベントが見つかりません: %s", id)
+}
+
+// TotalLiabilities は負債残高の合計を返す
+func (fp *FinancialProfile) TotalLiabilities() (valueobjects.Money, error) {
+	return fp.liabilities.TotalBalance()
+}
+
+// NetWorth は純資産（貯蓄の合計 - 負債残高の合計）を返す（負債が上回る場合は負の値）
+func (fp *FinancialProfile) NetWorth() (valueobjects.Money, error) {
+	totalSavings, err := fp.currentSavings.Total()
+	if err != nil {
+		return valueobjects.Money{}, fmt.Errorf("貯蓄合計の計算に失敗しました: %w", err)
+	}
+
+	totalLiabilities, err := fp.TotalLiabilities()
+	if err != nil {
+		return valueobjects.Money{}, err
+	}
+
+	return totalSavings.Subtract(totalLiabilities)
+}
+
+// MonthlyDebtPayments は支出項目に計上されていない、返済中の負債の月々の返済額の合計を返す
+func (fp *FinancialProfile) MonthlyDebtPayments() (valueobjects.Money, error) {
+	return fp.liabilities.MonthlyPaymentsAt(time.Now(), true)
+}
+
+// EffectiveMonthlyExpenses は支出項目に負債の返済額（支出に計上済みのものを除く）を導出した支出項目として加えて返す
+func (fp *FinancialProfile) EffectiveMonthlyExpenses() (ExpenseCollection, error) {
+	debtPayments, err := fp.MonthlyDebtPayments()
+	if err != nil {
+		return nil, err
+	}
+	if !debtPayments.IsPositive() {
+		return fp.monthlyExpenses, nil
+	}
+
+	expenses := append(ExpenseCollection(nil), fp.monthlyExpenses...)
+	return append(expenses, ExpenseItem{
+		Category:    DebtRepaymentExpenseCategory,
+		Amount:      debtPayments,
+		Description: "負債の返済（登録済みの負債から自動計算）",
+	}), nil
+}
+
 // InvestmentReturn は投資利回りを返す
 func (fp *FinancialProfile) InvestmentReturn() valueobjects.Rate {
 	return fp.investmentReturn
@@ -244,6 +634,133 @@ func (fp *FinancialProfile) InflationRate() valueobjects.Rate {
 	return fp.inflationRate
 }
 
+// ExpectedVolatility はユーザーが設定した想定ボラティリティを返す（未設定の場合は false）
+func (fp *FinancialProfile) ExpectedVolatility() (valueobjects.Rate, bool) {
+	if fp.expectedVolatility == nil {
+		return valueobjects.Rate{}, false
+	}
+	return *fp.expectedVolatility, true
+}
+
+// SetExpectedVolatility は想定ボラティリティを設定する（nil の場合は未設定に戻す）
+// リポジトリからの復元にも使用し更新日時は変更しない
+func (fp *FinancialProfile) SetExpectedVolatility(volatility *valueobjects.Rate) {
+	if volatility == nil {
+		fp.expectedVolatility = nil
+		return
+	}
+	v := *volatility
+	fp.expectedVolatility = &v
+}
+
+// ContributionTiming は資産推移の計算で使う拠出タイミングを返す（未設定の場合は期末拠出）
+func (fp *FinancialProfile) ContributionTiming() valueobjects.ContributionTiming {
+	if fp.contributionTiming == "" {
+		return valueobjects.DefaultContributionTiming
+	}
+	return fp.contributionTiming
+}
+
+// SetContributionTiming は資産推移の計算で使う拠出タイミングを設定する
+// 更新日時は変更しない
+func (fp *FinancialProfile) SetContributionTiming(timing valueobjects.ContributionTiming) error {
+	if !timing.IsValid() {
+		return fmt.Errorf("無効な拠出タイミングです: %s", string(timing))
+	}
+	fp.contributionTiming = timing
+	return nil
+}
+
+// EstimatedVolatility は貯蓄の種類ごとの既定のボラティリティを残高で加重平均して推定する
+// 貯蓄がない場合は、利回りを想定していれば投資、そうでなければ預金として推定する
+func (fp *FinancialProfile) EstimatedVolatility() valueobjects.Rate {
+	var total, weighted float64
+	for _, item := range fp.currentSavings {
+		volatility, ok := assetTypeVolatility[item.Type]
+		if !ok {
+			volatility = assetTypeVolatility["other"]
+		}
+		total += item.Amount.Amount()
+		weighted += item.Amount.Amount() * volatility
+	}
+
+	estimated := assetTypeVolatility["deposit"]
+	if total > 0 {
+		estimated = weighted / total
+	} else if !fp.investmentReturn.IsZero() {
+		estimated = assetTypeVolatility["investment"]
+	}
+	rate, _ := valueobjects.NewRate(estimated)
+	return rate
+}
+
+// Volatility は計算に使う想定ボラティリティを返す
+// ユーザーが設定した値を優先し、未設定の場合は EstimatedVolatility を使う
+func (fp *FinancialProfile) Volatility() valueobjects.Rate {
+	if volatility, ok := fp.ExpectedVolatility(); ok {
+		return volatility
+	}
+	return fp.EstimatedVolatility()
+}
+
+// RiskTolerance はリスク許容度を返す
+func (fp *FinancialProfile) RiskTolerance() RiskTolerance {
+	if fp.riskTolerance == "" {
+		return RiskToleranceModerate
+	}
+	return fp.riskTolerance
+}
+
+// SetRiskTolerance はリスク許容度を設定する
+// リポジトリからの復元にも使用し更新日時は変更しない
+func (fp *FinancialProfile) SetRiskTolerance(riskTolerance RiskTolerance) error {
+	parsed, err := ParseRiskTolerance(string(riskTolerance))
+	if err != nil {
+		return err
+	}
+	fp.riskTolerance = parsed
+	return nil
+}
+
+// RiskAdjustedReturn はリスク1単位あたりの超過リターン（シャープレシオ）を返す
+// (期待リターン - 無リスク金利) / ボラティリティ。ボラティリティが0の場合は評価できないため false を返す
+func (fp *FinancialProfile) RiskAdjustedReturn() (float64, bool) {
+	volatility := fp.Volatility()
+	if volatility.IsZero() {
+		return 0, false
+	}
+	return (fp.investmentReturn.AsPercentage() - RiskFreeRate) / volatility.AsPercentage(), true
+}
+
+// WorstCaseReturn は最悪ケースの年率リターン（%）を返す
+// 期待リターンからボラティリティの WorstCaseSigma 倍を差し引いた値で、負の値になりうる（-100%未満にはしない）
+func (fp *FinancialProfile) WorstCaseReturn() float64 {
+	worst := fp.investmentReturn.AsPercentage() - WorstCaseSigma*fp.Volatility().AsPercentage()
+	return math.Max(worst, -99.99)
+}
+
+// ExceedsRiskTolerance は想定ボラティリティがリスク許容度の上限を超えているかを返す
+func (fp *FinancialProfile) ExceedsRiskTolerance() bool {
+	return fp.Volatility().AsPercentage() > fp.RiskTolerance().MaxVolatility()
+}
+
+// HighReturnAcknowledged は高い想定利回りにユーザーが同意しているかを返す
+func (fp *FinancialProfile) HighReturnAcknowledged() bool {
+	return fp.highReturnAcknowledged
+}
+
+// HighInflationAcknowledged は高い想定インフレ率にユーザーが同意しているかを返す
+func (fp *FinancialProfile) HighInflationAcknowledged() bool {
+	return fp.highInflationAcknowledged
+}
+
+// AcknowledgeHighAssumptions は高い想定利回り・インフレ率への同意を記録する
+// 利回りやインフレ率と同時に設定される属性のため、リポジトリからの復元にも使用し更新日時は変更しない
+func (fp *FinancialProfile) AcknowledgeHighAssumptions(highReturn, highInflation bool) {
+	fp.highReturnAcknowledged = highReturn
+	fp.highInflationAcknowledged = highInflation
+}
+
 // CreatedAt は作成日時を返す
 func (fp *FinancialProfile) CreatedAt() time.Time {
 	return fp.createdAt
@@ -254,14 +771,19 @@ func (fp *FinancialProfile) UpdatedAt() time.Time {
 	return fp.updatedAt
 }
 
-// CalculateNetSavings は月間純貯蓄額を計算する（収入 - 支出）
+// CalculateNetSavings は月間純貯蓄額を計算する（手取り収入 - 支出 - 支出に計上されていない負債の返済額）
 func (fp *FinancialProfile) CalculateNetSavings() (valueobjects.Money, error) {
-	totalExpenses, err := fp.monthlyExpenses.Total()
+	expenses, err := fp.EffectiveMonthlyExpenses()
+	if err != nil {
+		return valueobjects.Money{}, fmt.Errorf("負債の返済額の計算に失敗しました: %w", err)
+	}
+
+	totalExpenses, err := expenses.Total()
 	if err != nil {
 		return valueobjects.Money{}, fmt.Errorf("支出合計の計算に失敗しました: %w", err)
 	}
 
-	netSavings, err := fp.monthlyIncome.Subtract(totalExpenses)
+	netSavings, err := fp.NetMonthlyIncome().Subtract(totalExpenses)
 	if err != nil {
 		return valueobjects.Money{}, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
 	}
@@ -269,6 +791,21 @@ func (fp *FinancialProfile) CalculateNetSavings() (valueobjects.Money, error) {
 	return netSavings, nil
 }
 
+// CalculateSavingsRate は手取り月収に対する貯蓄率（%）を計算する
+func (fp *FinancialProfile) CalculateSavingsRate() (float64, error) {
+	netMonthlyIncome := fp.NetMonthlyIncome()
+	if !netMonthlyIncome.IsPositive() {
+		return 0, nil
+	}
+
+	netSavings, err := fp.CalculateNetSavings()
+	if err != nil {
+		return 0, err
+	}
+
+	return netSavings.Amount() / netMonthlyIncome.Amount() * 100, nil
+}
+
 // ValidateFinancialHealth は財務健全性をチェックする
 func (fp *FinancialProfile) ValidateFinancialHealth() error {
 	netSavings, err := fp.CalculateNetSavings()
@@ -287,7 +824,7 @@ func (fp *FinancialProfile) ValidateFinancialHealth() error {
 		return fmt.Errorf("貯蓄率の計算に失敗しました: %w", err)
 	}
 
-	minimumSavingsTarget, err := fp.monthlyIncome.MultiplyByFloat(0.1) // 収入の10%
+	minimumSavingsTarget, err := fp.NetMonthlyIncome().MultiplyByFloat(0.1) // 手取り収入の10%
 	if err != nil {
 		return fmt.Errorf("最低貯蓄目標の計算に失敗しました: %w", err)
 	}
@@ -305,11 +842,20 @@ func (fp *FinancialProfile) ValidateFinancialHealth() error {
 }
 
 // ProjectAssets は指定年数の資産推移を予測する
+// 負債がある場合は返済予定に沿って残高を減らし、完済した月以降は返済額を積立に回す
+// ライフイベントがある場合は発生年以降の支出の増減を積立額に反映する
 func (fp *FinancialProfile) ProjectAssets(years int) ([]AssetProjection, error) {
-	if years <= 0 {
-		return nil, errors.New("予測年数は正の値である必要があります")
-	}
+	return fp.projectCurrentAssets(years, fp.lifeEvents)
+}
 
+// ProjectAssetsWithoutLifeEvents はライフイベントを考慮しない場合の資産推移を予測する
+// ライフイベントによる資産への影響を比較するために使う
+func (fp *FinancialProfile) ProjectAssetsWithoutLifeEvents(years int) ([]AssetProjection, error) {
+	return fp.projectCurrentAssets(years, nil)
+}
+
+// projectCurrentAssets は現在の貯蓄と純貯蓄額から、指定したライフイベントを反映した資産推移を予測する
+func (fp *FinancialProfile) projectCurrentAssets(years int, lifeEvents LifeEventCollection) ([]AssetProjection, error) {
 	netSavings, err := fp.CalculateNetSavings()
 	if err != nil {
 		return nil, fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
@@ -320,81 +866,264 @@ func (fp *FinancialProfile) ProjectAssets(years int) ([]AssetProjection, error)
 		return nil, fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
 	}
 
-	projections := make([]AssetProjection, years)
+	now := time.Now()
+	return fp.projectAssets(
+		currentSavingsTotal, netSavings,
+		fp.debtScheduleFrom(now, years), newLifeEventSchedule(lifeEvents, now, years),
+		years, fp.investmentReturn.MonthlyDecimal(),
+	)
+}
 
-	// 月利を計算
-	monthlyInvestmentRate, err := fp.investmentReturn.MonthlyRate()
+// ProjectAssetsStream は ProjectAssets と同じ資産推移を1年ずつ fn に渡す
+// 全期間のスライスを保持しないため、長期の予測を逐次出力する場合に使う。fn がエラーを返した時点で中断する
+func (fp *FinancialProfile) ProjectAssetsStream(years int, fn func(AssetProjection) error) error {
+	netSavings, err := fp.CalculateNetSavings()
 	if err != nil {
-		return nil, fmt.Errorf("月利の計算に失敗しました: %w", err)
+		return fmt.Errorf("純貯蓄額の計算に失敗しました: %w", err)
 	}
 
-	// 月間インフレ率を計算（後で使用）
-	_, err = fp.inflationRate.MonthlyRate()
+	currentSavingsTotal, err := fp.currentSavings.Total()
 	if err != nil {
-		return nil, fmt.Errorf("月間インフレ率の計算に失敗しました: %w", err)
+		return fmt.Errorf("現在の貯蓄合計の計算に失敗しました: %w", err)
 	}
 
-	currentAssets := currentSavingsTotal
-	totalContributed := currentSavingsTotal
+	now := time.Now()
+	return fp.eachAssetProjection(
+		currentSavingsTotal, netSavings,
+		fp.debtScheduleFrom(now, years), newLifeEventSchedule(fp.lifeEvents, now, years),
+		years, fp.investmentReturn.MonthlyDecimal(), fn,
+	)
+}
+
+// debtScheduleFrom は負債の返済予定を返す（負債がない場合は nil）
+func (fp *FinancialProfile) debtScheduleFrom(start time.Time, years int) *debtSchedule {
+	if len(fp.liabilities) == 0 {
+		return nil
+	}
+	return newDebtSchedule(fp.liabilities, start, years)
+}
+
+// debtSchedule は予測期間中の負債の返済予定
+type debtSchedule struct {
+	// currentPayments は予測開始時点の月々の返済額の合計（純貯蓄額の計算で差し引かれている額）
+	currentPayments float64
+	payments        []float64 // 月ごとの返済額の合計
+	balances        []float64 // 月ごとの返済後の残高の合計
+}
+
+// newDebtSchedule は負債ごとの返済予定を月ごとに合計する
+func newDebtSchedule(liabilities LiabilityCollection, start time.Time, years int) *debtSchedule {
+	months := years * 12
+	schedule := &debtSchedule{
+		payments: make([]float64, months),
+		balances: make([]float64, months),
+	}
+	for _, liability := range liabilities {
+		if liability.IsRepayingAt(start) {
+			schedule.currentPayments += liability.MonthlyPayment().Amount()
+		}
+		for i, month := range liability.AmortizationFrom(start, months) {
+			schedule.payments[i] += month.Payment
+			schedule.balances[i] += month.Balance
+		}
+	}
+	return schedule
+}
+
+// contributionAdjustment は月の積立額の調整額を返す
+// 完済により返済額が減った分は積立に回り、最終月の一括返済などで増えた分は積立から差し引く
+func (ds *debtSchedule) contributionAdjustment(monthIndex int) float64 {
+	if ds == nil {
+		return 0
+	}
+	return ds.currentPayments - ds.payments[monthIndex]
+}
+
+// balanceAt は月末時点の負債残高の合計を返す
+func (ds *debtSchedule) balanceAt(monthIndex int) float64 {
+	if ds == nil {
+		return 0
+	}
+	return ds.balances[monthIndex]
+}
+
+// lifeEventSchedule は予測期間中のライフイベントによる月ごとの支出の増減額
+type lifeEventSchedule []float64
+
+// newLifeEventSchedule はライフイベントによる支出の増減を月ごとに合計する（ライフイベントがない場合は nil）
+func newLifeEventSchedule(lifeEvents LifeEventCollection, start time.Time, years int) lifeEventSchedule {
+	if len(lifeEvents) == 0 {
+		return nil
+	}
+	return lifeEvents.ExpenseChangesFrom(start, years*12)
+}
+
+// contributionAdjustment は月の積立額の調整額を返す（支出が増えた分は積立から差し引き、減った分は積立に回す）
+func (ls lifeEventSchedule) contributionAdjustment(monthIndex int) float64 {
+	if monthIndex >= len(ls) {
+		return 0
+	}
+	return -ls[monthIndex]
+}
+
+// ProjectAssetsFrom は初期額と月間積立額を指定して資産推移を予測する
+// 利回りとインフレ率はプロファイルの想定値を使う（特定の目標の積立推移の予測などに使用する）
+// 運用益は年利と同値の月利（valueobjects.Rate.MonthlyDecimal）で毎月複利計算し、積立は ContributionTiming に従って行う。
+// 既定の期末拠出では n 年後の資産は P(1+r)^n + C × 年金終価係数(月利, 12n) と一致し、
+// 期初拠出では積立が1か月分多く運用されるため、積立分が (1+r) 倍（期初払いの年金終価係数）になる
+func (fp *FinancialProfile) ProjectAssetsFrom(initialAmount, monthlyContribution valueobjects.Money, years int) ([]AssetProjection, error) {
+	// 月利を計算（長期の複利で誤差が広がらないよう丸めずに使う）
+	return fp.projectAssetsAtMonthlyRate(initialAmount, monthlyContribution, years, fp.investmentReturn.MonthlyDecimal())
+}
+
+// ProjectAssetsFromStream は ProjectAssetsFrom と同じ資産推移を1年ずつ fn に渡す
+func (fp *FinancialProfile) ProjectAssetsFromStream(initialAmount, monthlyContribution valueobjects.Money, years int, fn func(AssetProjection) error) error {
+	return fp.eachAssetProjection(initialAmount, monthlyContribution, nil, nil, years, fp.investmentReturn.MonthlyDecimal(), fn)
+}
+
+// ProjectWorstCaseAssetsFrom は最悪ケースのリターン（WorstCaseReturn）が毎年続いた場合の資産推移を予測する
+// 計算の前提は ProjectAssetsFrom と同じで、利回りだけを置き換える
+func (fp *FinancialProfile) ProjectWorstCaseAssetsFrom(initialAmount, monthlyContribution valueobjects.Money, years int) ([]AssetProjection, error) {
+	return fp.projectAssetsAtMonthlyRate(initialAmount, monthlyContribution, years, fp.worstCaseMonthlyRate())
+}
+
+// ProjectWorstCaseAssetsFromStream は ProjectWorstCaseAssetsFrom と同じ資産推移を1年ずつ fn に渡す
+func (fp *FinancialProfile) ProjectWorstCaseAssetsFromStream(initialAmount, monthlyContribution valueobjects.Money, years int, fn func(AssetProjection) error) error {
+	return fp.eachAssetProjection(initialAmount, monthlyContribution, nil, nil, years, fp.worstCaseMonthlyRate(), fn)
+}
+
+// worstCaseMonthlyRate は最悪ケースの年率リターンと同値の月利を返す
+func (fp *FinancialProfile) worstCaseMonthlyRate() float64 {
+	return math.Pow(1+fp.WorstCaseReturn()/100, 1.0/12.0) - 1
+}
+
+// projectAssetsAtMonthlyRate は指定した月利で資産推移を予測する
+func (fp *FinancialProfile) projectAssetsAtMonthlyRate(initialAmount, monthlyContribution valueobjects.Money, years int, monthlyInvestmentRate float64) ([]AssetProjection, error) {
+	return fp.projectAssets(initialAmount, monthlyContribution, nil, nil, years, monthlyInvestmentRate)
+}
+
+// projectAssets は指定した月利で資産推移を予測する
+// debts を指定した場合は返済予定に応じて毎月の積立額を調整し、年末時点の負債残高と純資産を設定する
+// lifeEvents を指定した場合はライフイベントによる支出の増減に応じて毎月の積立額を調整する
+func (fp *FinancialProfile) projectAssets(initialAmount, monthlyContribution valueobjects.Money, debts *debtSchedule, lifeEvents lifeEventSchedule, years int, monthlyInvestmentRate float64) ([]AssetProjection, error) {
+	if years <= 0 {
+		return nil, errors.New("予測年数は正の値である必要があります")
+	}
+
+	projections := make([]AssetProjection, 0, years)
+	err := fp.eachAssetProjection(initialAmount, monthlyContribution, debts, lifeEvents, years, monthlyInvestmentRate, func(projection AssetProjection) error {
+		projections = append(projections, projection)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return projections, nil
+}
+
+// eachAssetProjection は指定した月利で資産推移を計算し、年末ごとの予測を fn に渡す
+func (fp *FinancialProfile) eachAssetProjection(initialAmount, monthlyContribution valueobjects.Money, debts *debtSchedule, lifeEvents lifeEventSchedule, years int, monthlyInvestmentRate float64, fn func(AssetProjection) error) error {
+	if years <= 0 {
+		return errors.New("予測年数は正の値である必要があります")
+	}
+
+	currentAssets := initialAmount
+	totalContributed := initialAmount
+	// 期初拠出の場合は月間貯蓄を加算してから、その月の投資収益を計算する
+	contributeFirst := fp.ContributionTiming() == valueobjects.ContributionTimingBeginning
 
 	for year := 1; year <= years; year++ {
 		// 年間の複利計算
 		for month := 1; month <= 12; month++ {
+			// 月間貯蓄（負債の返済額とライフイベントによる支出の増減を反映する）
+			var err error
+			monthIndex := (year-1)*12 + month - 1
+			contribution := monthlyContribution
+			if adjustment := debts.contributionAdjustment(monthIndex) + lifeEvents.contributionAdjustment(monthIndex); adjustment != 0 {
+				contribution, err = valueobjects.NewMoney(monthlyContribution.Amount()+adjustment, monthlyContribution.Currency())
+				if err != nil {
+					return fmt.Errorf("月間貯蓄の計算に失敗しました: %w", err)
+				}
+			}
+
+			if contributeFirst {
+				if currentAssets, err = currentAssets.Add(contribution); err != nil {
+					return fmt.Errorf("資産への月間貯蓄加算に失敗しました: %w", err)
+				}
+			}
+
 			// 投資収益を加算
-			investmentGain, err := currentAssets.Multiply(monthlyInvestmentRate)
+			investmentGain, err := currentAssets.MultiplyByFloat(monthlyInvestmentRate)
 			if err != nil {
-				return nil, fmt.Errorf("投資収益の計算に失敗しました: %w", err)
+				return fmt.Errorf("投資収益の計算に失敗しました: %w", err)
 			}
 
 			currentAssets, err = currentAssets.Add(investmentGain)
 			if err != nil {
-				return nil, fmt.Errorf("資産への投資収益加算に失敗しました: %w", err)
+				return fmt.Errorf("資産への投資収益加算に失敗しました: %w", err)
 			}
 
-			// 月間貯蓄を加算
-			currentAssets, err = currentAssets.Add(netSavings)
-			if err != nil {
-				return nil, fmt.Errorf("資産への月間貯蓄加算に失敗しました: %w", err)
+			if !contributeFirst {
+				if currentAssets, err = currentAssets.Add(contribution); err != nil {
+					return fmt.Errorf("資産への月間貯蓄加算に失敗しました: %w", err)
+				}
 			}
 
-			totalContributed, err = totalContributed.Add(netSavings)
+			totalContributed, err = totalContributed.Add(contribution)
 			if err != nil {
-				return nil, fmt.Errorf("総拠出額の計算に失敗しました: %w", err)
+				return fmt.Errorf("総拠出額の計算に失敗しました: %w", err)
 			}
 		}
 
 		// 投資収益を計算
 		investmentGains, err := currentAssets.Subtract(totalContributed)
 		if err != nil {
-			return nil, fmt.Errorf("投資収益の計算に失敗しました: %w", err)
+			return fmt.Errorf("投資収益の計算に失敗しました: %w", err)
 		}
 
 		// インフレ調整後の実質価値を計算
-		inflationFactor := fp.inflationRate.CompoundFactor(year)
-		realValue, err := currentAssets.MultiplyByFloat(1.0 / inflationFactor)
+		realValue, err := valueobjects.NewMoney(
+			valueobjects.RealValue(currentAssets.Amount(), fp.inflationRate.AsPercentage(), year),
+			currentAssets.Currency(),
+		)
 		if err != nil {
-			return nil, fmt.Errorf("実質価値の計算に失敗しました: %w", err)
+			return fmt.Errorf("実質価値の計算に失敗しました: %w", err)
 		}
 
-		projections[year-1] = AssetProjection{
+		totalLiabilities, err := valueobjects.NewMoney(debts.balanceAt(year*12-1), currentAssets.Currency())
+		if err != nil {
+			return fmt.Errorf("負債残高の計算に失敗しました: %w", err)
+		}
+		netWorth, err := currentAssets.Subtract(totalLiabilities)
+		if err != nil {
+			return fmt.Errorf("純資産の計算に失敗しました: %w", err)
+		}
+
+		if err := fn(AssetProjection{
 			Year:              year,
 			TotalAssets:       currentAssets,
 			RealValue:         realValue,
 			ContributedAmount: totalContributed,
 			InvestmentGains:   investmentGains,
+			TotalLiabilities:  totalLiabilities,
+			NetWorth:          netWorth,
+		}); err != nil {
+			return err
 		}
 	}
 
-	return projections, nil
+	return nil
 }
 
 // UpdateMonthlyIncome は月収を更新する
+// 入力区分は維持するため、額面の場合は SetIncomeType で手取り月収を再設定する必要がある
 func (fp *FinancialProfile) UpdateMonthlyIncome(newIncome valueobjects.Money) error {
 	if !newIncome.IsPositive() {
 		return errors.New("月収は正の値である必要があります")
 	}
 
 	fp.monthlyIncome = newIncome
+	fp.netMonthlyIncome = valueobjects.Money{}
 	fp.updatedAt = time.Now()
 	return nil
 }
@@ -4,13 +4,23 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 
 	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
 )
 
+// DuplicateGoalAmountTolerance は重複とみなす目標金額の差の上限（既存目標の目標金額に対する割合）
+const DuplicateGoalAmountTolerance = 0.01
+
+// MaxGoalNotesLength は目標のメモの最大文字数
+const MaxGoalNotesLength = 1000
+
 // GoalID は目標の一意識別子
 type GoalID string
 
@@ -55,6 +65,31 @@ func (gt GoalType) String() string {
 	}
 }
 
+// GoalOverduePolicy は目標が期限を過ぎた場合の扱いを表す
+type GoalOverduePolicy string
+
+const (
+	GoalOverduePolicyNotify  GoalOverduePolicy = "notify"  // 期限超過を通知する（既定）
+	GoalOverduePolicyExtend  GoalOverduePolicy = "extend"  // 達成に必要な期間を再計算して期限を延長する
+	GoalOverduePolicyArchive GoalOverduePolicy = "archive" // 目標をアーカイブ（非アクティブ化）する
+)
+
+// IsValid はGoalOverduePolicyが有効かどうかを確認する
+func (p GoalOverduePolicy) IsValid() bool {
+	switch p {
+	case GoalOverduePolicyNotify, GoalOverduePolicyExtend, GoalOverduePolicyArchive:
+		return true
+	default:
+		return false
+	}
+}
+
+// ErrInvalidOverduePolicy は期限超過時ポリシーが無効な場合のエラー
+var ErrInvalidOverduePolicy = errors.New("期限超過時ポリシーは extend・archive・notify のいずれかである必要があります")
+
+// ErrGoalCannotBeExtended は月間拠出額が未設定などで期限を延長できない場合のエラー
+var ErrGoalCannotBeExtended = errors.New("月間拠出額が未設定のため目標の期限を延長できません")
+
 // ProgressRate は進捗率を表す値オブジェクト
 type ProgressRate struct {
 	rate valueobjects.Rate
@@ -110,12 +145,24 @@ type Goal struct {
 	targetDate          time.Time
 	currentAmount       valueobjects.Money
 	monthlyContribution valueobjects.Money
+	// 月間拠出額の幅（任意）。monthlyContribution を目標額として 最低額 ≤ 目標額 ≤ ストレッチ額 を保つ
+	contributionMin     *valueobjects.Money
+	contributionStretch *valueobjects.Money
 	isActive            bool
+	dependsOn           []GoalID          // 前提となる目標のID（空の場合は依存なし）
+	notes               string            // ユーザーのメモ
+	imageURL            string            // 目標のイメージ画像のURL（未設定の場合は空）
+	overduePolicy       GoalOverduePolicy // 期限超過時の扱い
+	// 外貨建ての目標の場合のみ設定する。targetAmount は為替レートで換算した計画の通貨の金額を保持する
+	goalCurrencyTarget  *valueobjects.Money
+	exchangeRate        *valueobjects.ExchangeRate
+	exchangeRateHistory []valueobjects.ExchangeRate // 以前に使っていた為替レート（古い順）
+	deletedAt           *time.Time                  // 財務計画から削除した日時（未削除の場合はnil）
 	createdAt           time.Time
 	updatedAt           time.Time
 }
 
-// NewGoal は新しい目標を作成する
+// NewGoal は新しい目標を作成する（タイトルのHTMLタグは取り除く）
 func NewGoal(
 	userID UserID,
 	goalType GoalType,
@@ -124,6 +171,24 @@ func NewGoal(
 	targetDate time.Time,
 	monthlyContribution valueobjects.Money,
 ) (*Goal, error) {
+	return NewGoalWithGeneratedID(NewGoalID(), userID, goalType, title, targetAmount, targetDate, monthlyContribution)
+}
+
+// NewGoalWithGeneratedID は呼び出し元で採番したIDで新しい目標を作成する
+// 検証内容は NewGoal と同じで、IDの採番をユースケースのIDGeneratorに任せる場合に使用する
+func NewGoalWithGeneratedID(
+	id GoalID,
+	userID UserID,
+	goalType GoalType,
+	title string,
+	targetAmount valueobjects.Money,
+	targetDate time.Time,
+	monthlyContribution valueobjects.Money,
+) (*Goal, error) {
+	if id == "" {
+		return nil, errors.New("目標IDは必須です")
+	}
+
 	if userID == "" {
 		return nil, errors.New("ユーザーIDは必須です")
 	}
@@ -132,6 +197,8 @@ func NewGoal(
 		return nil, errors.New("無効な目標タイプです")
 	}
 
+	// 保存型XSSを防ぐためHTMLタグを取り除いてから検証する
+	title = valueobjects.SanitizeText(title)
 	if title == "" {
 		return nil, errors.New("目標タイトルは必須です")
 	}
@@ -156,7 +223,7 @@ func NewGoal(
 	now := time.Now()
 
 	return &Goal{
-		id:                  NewGoalID(),
+		id:                  id,
 		userID:              userID,
 		goalType:            goalType,
 		title:               title,
@@ -165,6 +232,7 @@ func NewGoal(
 		currentAmount:       currentAmount,
 		monthlyContribution: monthlyContribution,
 		isActive:            true,
+		overduePolicy:       GoalOverduePolicyNotify,
 		createdAt:           now,
 		updatedAt:           now,
 	}, nil
@@ -220,6 +288,7 @@ func NewGoalWithID(
 		currentAmount:       currentAmount,
 		monthlyContribution: monthlyContribution,
 		isActive:            true,
+		overduePolicy:       GoalOverduePolicyNotify,
 		createdAt:           createdAt,
 		updatedAt:           updatedAt,
 	}, nil
@@ -265,11 +334,144 @@ func (g *Goal) MonthlyContribution() valueobjects.Money {
 	return g.monthlyContribution
 }
 
+// ContributionMin は月間拠出額の最低額を返す（未設定の場合はnil）
+func (g *Goal) ContributionMin() *valueobjects.Money {
+	return copyMoney(g.contributionMin)
+}
+
+// ContributionStretch は月間拠出額のストレッチ額（余裕がある月に拠出できる額）を返す（未設定の場合はnil）
+func (g *Goal) ContributionStretch() *valueobjects.Money {
+	return copyMoney(g.contributionStretch)
+}
+
+// HasContributionRange は月間拠出額の最低額・ストレッチ額のいずれかが設定されているかを返す
+func (g *Goal) HasContributionRange() bool {
+	return g.contributionMin != nil || g.contributionStretch != nil
+}
+
+// MinimumMonthlyContribution は最低限拠出する月間拠出額を返す（最低額が未設定の場合は月間拠出額）
+func (g *Goal) MinimumMonthlyContribution() valueobjects.Money {
+	if g.contributionMin != nil {
+		return *g.contributionMin
+	}
+	return g.monthlyContribution
+}
+
+// StretchMonthlyContribution は余裕がある場合の月間拠出額を返す（ストレッチ額が未設定の場合は月間拠出額）
+func (g *Goal) StretchMonthlyContribution() valueobjects.Money {
+	if g.contributionStretch != nil {
+		return *g.contributionStretch
+	}
+	return g.monthlyContribution
+}
+
+// DeletedAt は財務計画から削除した日時を返す（未削除の場合はnil）
+func (g *Goal) DeletedAt() *time.Time {
+	if g.deletedAt == nil {
+		return nil
+	}
+	deletedAt := *g.deletedAt
+	return &deletedAt
+}
+
+// IsDeleted は目標が削除済みかどうかを返す
+func (g *Goal) IsDeleted() bool {
+	return g.deletedAt != nil
+}
+
 // IsActive は目標がアクティブかどうかを返す
 func (g *Goal) IsActive() bool {
 	return g.isActive
 }
 
+// OverduePolicy は期限超過時ポリシーを返す
+func (g *Goal) OverduePolicy() GoalOverduePolicy {
+	if g.overduePolicy == "" {
+		return GoalOverduePolicyNotify
+	}
+	return g.overduePolicy
+}
+
+// DependsOn は前提となる目標のIDを返す（依存がない場合は空のスライス）
+func (g *Goal) DependsOn() []GoalID {
+	dependsOn := make([]GoalID, len(g.dependsOn))
+	copy(dependsOn, g.dependsOn)
+	return dependsOn
+}
+
+// HasDependency は前提となる目標が設定されているかどうかを返す
+func (g *Goal) HasDependency() bool {
+	return len(g.dependsOn) > 0
+}
+
+// DependsOnGoal は指定した目標を前提としているかどうかを返す
+func (g *Goal) DependsOnGoal(goalID GoalID) bool {
+	for _, id := range g.dependsOn {
+		if id == goalID {
+			return true
+		}
+	}
+	return false
+}
+
+// Notes はメモを返す
+func (g *Goal) Notes() string {
+	return g.notes
+}
+
+// ImageURL はイメージ画像のURLを返す（未設定の場合は空）
+func (g *Goal) ImageURL() string {
+	return g.imageURL
+}
+
+// Currency は目標の通貨を返す（外貨建てでない場合は計画の通貨）
+func (g *Goal) Currency() valueobjects.Currency {
+	if g.goalCurrencyTarget != nil {
+		return g.goalCurrencyTarget.Currency()
+	}
+	return g.targetAmount.Currency()
+}
+
+// IsForeignCurrency は目標が外貨建てかどうかを返す
+func (g *Goal) IsForeignCurrency() bool {
+	return g.goalCurrencyTarget != nil
+}
+
+// TargetAmountInGoalCurrency は目標の通貨での目標金額を返す
+func (g *Goal) TargetAmountInGoalCurrency() valueobjects.Money {
+	if g.goalCurrencyTarget != nil {
+		return *g.goalCurrencyTarget
+	}
+	return g.targetAmount
+}
+
+// ExchangeRate は換算に使う為替レートを返す（外貨建てでない場合はnil）
+func (g *Goal) ExchangeRate() *valueobjects.ExchangeRate {
+	if g.exchangeRate == nil {
+		return nil
+	}
+	rate := *g.exchangeRate
+	return &rate
+}
+
+// ExchangeRateHistory は以前に使っていた為替レートを古い順に返す
+func (g *Goal) ExchangeRateHistory() []valueobjects.ExchangeRate {
+	if len(g.exchangeRateHistory) == 0 {
+		return nil
+	}
+	history := make([]valueobjects.ExchangeRate, len(g.exchangeRateHistory))
+	copy(history, g.exchangeRateHistory)
+	return history
+}
+
+// ToGoalCurrency は計画の通貨の金額を目標の通貨に換算する（外貨建てでない場合はそのまま返す）
+func (g *Goal) ToGoalCurrency(amount valueobjects.Money) (valueobjects.Money, error) {
+	if g.exchangeRate == nil {
+		return amount, nil
+	}
+	return g.exchangeRate.FromBase(amount)
+}
+
 // CreatedAt は作成日時を返す
 func (g *Goal) CreatedAt() time.Time {
 	return g.createdAt
@@ -295,6 +497,11 @@ func (g *Goal) CalculateProgress(currentAmount valueobjects.Money) (ProgressRate
 
 // EstimateCompletionDate は月間貯蓄額に基づいて完了予定日を推定する
 func (g *Goal) EstimateCompletionDate(monthlySavings valueobjects.Money) (time.Time, error) {
+	return g.EstimateCompletionDateFrom(time.Now(), monthlySavings)
+}
+
+// EstimateCompletionDateFrom は指定日から拠出を始めた場合の完了予定日を推定する
+func (g *Goal) EstimateCompletionDateFrom(startDate time.Time, monthlySavings valueobjects.Money) (time.Time, error) {
 	if monthlySavings.IsZero() || monthlySavings.IsNegative() {
 		return time.Time{}, errors.New("月間貯蓄額は正の値である必要があります")
 	}
@@ -307,20 +514,103 @@ func (g *Goal) EstimateCompletionDate(monthlySavings valueobjects.Money) (time.T
 
 	// 既に目標達成している場合
 	if remainingAmount.IsZero() || remainingAmount.IsNegative() {
-		return time.Now(), nil
+		return startDate, nil
 	}
 
 	// 必要な月数を計算
 	monthsNeeded := remainingAmount.Amount() / monthlySavings.Amount()
 
 	// 完了予定日を計算
-	completionDate := time.Now().AddDate(0, int(monthsNeeded), 0)
+	completionDate := startDate.AddDate(0, int(monthsNeeded), 0)
 
 	return completionDate, nil
 }
 
+// EstimateCompletionDateWithInterest は積立額と運用益（月次複利）を考慮して完了予定日を推定する
+// annualReturn は年率の小数表記（5%なら0.05）。利回りが0の場合は EstimateCompletionDate と同じ結果になる
+func (g *Goal) EstimateCompletionDateWithInterest(monthlySavings valueobjects.Money, annualReturn float64) (time.Time, error) {
+	return g.EstimateCompletionDateWithInterestFrom(time.Now(), monthlySavings, annualReturn)
+}
+
+// EstimateCompletionDateWithInterestFrom は指定日から拠出を始めた場合の完了予定日を運用益込みで推定する
+func (g *Goal) EstimateCompletionDateWithInterestFrom(startDate time.Time, monthlySavings valueobjects.Money, annualReturn float64) (time.Time, error) {
+	if annualReturn == 0 {
+		return g.EstimateCompletionDateFrom(startDate, monthlySavings)
+	}
+	if monthlySavings.IsNegative() {
+		return time.Time{}, errors.New("月間貯蓄額は負の値にできません")
+	}
+	if annualReturn <= -1 {
+		return time.Time{}, errors.New("年間利回りは-100%より大きい必要があります")
+	}
+
+	// 既に目標達成している場合
+	if g.currentAmount.Amount() >= g.targetAmount.Amount() {
+		return startDate, nil
+	}
+
+	months, err := solveMonthsToTarget(g.currentAmount.Amount(), monthlySavings.Amount(), annualReturn/12, g.targetAmount.Amount())
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// 達成する月まで進める（浮動小数点の誤差で1ヶ月ずれないよう許容幅を設ける）
+	return startDate.AddDate(0, int(math.Ceil(months-1e-9)), 0), nil
+}
+
+// maxCompletionDateIterations はニュートン法で完了月数を求める際の最大反復回数
+const maxCompletionDateIterations = 1000
+
+// ErrMaxIterationsExceeded は完了予定日の計算が最大反復回数内に収束しなかったことを示す
+var ErrMaxIterationsExceeded = errors.New("完了予定日の計算が収束しませんでした")
+
+// ErrGoalNotForeignCurrency は外貨建てでない目標の為替レートを更新しようとしたことを表す
+var ErrGoalNotForeignCurrency = errors.New("外貨建ての目標ではありません")
+
+// ErrContributionOutOfRange は月間拠出額が 最低額 ≤ 月間拠出額 ≤ ストレッチ額 を満たさないことを表す
+var ErrContributionOutOfRange = errors.New("月間拠出額は最低額以上・ストレッチ額以下である必要があります")
+
+// solveMonthsToTarget は FV(n) = 現在額×(1+r)^n + 積立額×((1+r)^n-1)/r が目標金額に達する月数 n をニュートン法で求める
+func solveMonthsToTarget(currentAmount, monthlySavings, monthlyRate, targetAmount float64) (float64, error) {
+	// FV(n) = base×(1+r)^n - monthlySavings/r と変形し、(1+r)^n = ratio となる正の n があるかを先に判定する
+	// マイナス利回りでは FV が monthlySavings/|r| に漸近するため、それ以上の目標には到達しない
+	base := currentAmount + monthlySavings/monthlyRate
+	growth := math.Log1p(monthlyRate)
+	ratio := (targetAmount + monthlySavings/monthlyRate) / base
+	if base == 0 || ratio <= 0 || math.Log(ratio)/growth < 0 {
+		return 0, errors.New("この積立額と利回りでは目標金額に到達しません")
+	}
+
+	// 運用益を考慮しない月数を初期値とする（FV は n について単調かつ凸・凹のいずれかのため、解へ単調に収束する）
+	n := 0.0
+	if monthlySavings > 0 {
+		n = (targetAmount - currentAmount) / monthlySavings
+	}
+	for i := 0; i < maxCompletionDateIterations; i++ {
+		compound := math.Exp(growth * n)
+		f := base*compound - monthlySavings/monthlyRate - targetAmount
+		df := base * compound * growth
+
+		next := n - f/df
+		if math.IsNaN(next) || math.IsInf(next, 0) {
+			break
+		}
+		if math.Abs(next-n) < 1e-9 {
+			return math.Max(next, 0), nil
+		}
+		n = next
+	}
+	return 0, ErrMaxIterationsExceeded
+}
+
 // IsAchievable は財務プロファイルに基づいて目標が達成可能かどうかを判定する
 func (g *Goal) IsAchievable(financialProfile *FinancialProfile) (bool, error) {
+	return g.IsAchievableFrom(financialProfile, time.Now())
+}
+
+// IsAchievableFrom は指定日から拠出を始めた場合に目標が達成可能かどうかを判定する
+// 前提となる目標の完了後に拠出を始める目標は、拠出開始日から目標日までの期間で判定する
+func (g *Goal) IsAchievableFrom(financialProfile *FinancialProfile, startDate time.Time) (bool, error) {
 	if financialProfile == nil {
 		return false, errors.New("財務プロファイルが必要です")
 	}
@@ -337,12 +627,16 @@ func (g *Goal) IsAchievable(financialProfile *FinancialProfile) (bool, error) {
 	}
 
 	// 目標日までの期間を計算
-	now := time.Now()
-	if g.targetDate.Before(now) {
-		return false, nil // 目標日が過去の場合は達成不可能
+	if g.targetDate.Before(startDate) {
+		return false, nil // 目標日が拠出開始日より前の場合は達成不可能
 	}
 
-	monthsUntilTarget := int(g.targetDate.Sub(now).Hours() / (24 * 30)) // 概算の月数
+	// 目標日に計画対象の年齢上限を超える目標は現実的でないため達成不可能とみなす
+	if age, ok := financialProfile.AgeAt(g.targetDate); ok && age > MaxPlanningAge {
+		return false, nil
+	}
+
+	monthsUntilTarget := int(g.targetDate.Sub(startDate).Hours() / (24 * 30)) // 概算の月数
 
 	if monthsUntilTarget <= 0 {
 		return false, nil
@@ -378,9 +672,10 @@ func (g *Goal) UpdateCurrentAmount(newAmount valueobjects.Money) error {
 }
 
 // UpdateMonthlyContribution は月間拠出額を更新する
+// 月間拠出額の幅が設定されている場合は、最低額以上・ストレッチ額以下である必要がある
 func (g *Goal) UpdateMonthlyContribution(newContribution valueobjects.Money) error {
-	if newContribution.IsNegative() {
-		return errors.New("月間拠出額は負の値にできません")
+	if err := validateContributionRange(g.contributionMin, newContribution, g.contributionStretch); err != nil {
+		return err
 	}
 
 	g.monthlyContribution = newContribution
@@ -388,17 +683,142 @@ func (g *Goal) UpdateMonthlyContribution(newContribution valueobjects.Money) err
 	return nil
 }
 
+// UpdateContributionPlan は月間拠出額とその幅（最低額・ストレッチ額）をまとめて更新する
+// 最低額・ストレッチ額は nil で未設定にする。最低額 ≤ 月間拠出額 ≤ ストレッチ額 を満たす必要がある
+func (g *Goal) UpdateContributionPlan(minimum *valueobjects.Money, contribution valueobjects.Money, stretch *valueobjects.Money) error {
+	if err := validateContributionRange(minimum, contribution, stretch); err != nil {
+		return err
+	}
+
+	g.contributionMin = copyMoney(minimum)
+	g.monthlyContribution = contribution
+	g.contributionStretch = copyMoney(stretch)
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// RestoreContributionRange は永続化された月間拠出額の幅を復元する（リポジトリでの復元用）
+func (g *Goal) RestoreContributionRange(minimum, stretch *valueobjects.Money) {
+	g.contributionMin = copyMoney(minimum)
+	g.contributionStretch = copyMoney(stretch)
+}
+
+// validateContributionRange は月間拠出額とその幅が 最低額 ≤ 月間拠出額 ≤ ストレッチ額 を満たすかを検証する
+func validateContributionRange(minimum *valueobjects.Money, contribution valueobjects.Money, stretch *valueobjects.Money) error {
+	if contribution.IsNegative() {
+		return errors.New("月間拠出額は負の値にできません")
+	}
+	if minimum != nil {
+		if minimum.IsNegative() {
+			return errors.New("月間拠出額の最低額は負の値にできません")
+		}
+		if minimum.Amount() > contribution.Amount() {
+			return ErrContributionOutOfRange
+		}
+	}
+	if stretch != nil && stretch.Amount() < contribution.Amount() {
+		return ErrContributionOutOfRange
+	}
+	return nil
+}
+
+// copyMoney は呼び出し元と値を共有しないよう金額のコピーを返す（nilの場合はnil）
+func copyMoney(money *valueobjects.Money) *valueobjects.Money {
+	if money == nil {
+		return nil
+	}
+	copied := *money
+	return &copied
+}
+
 // UpdateTargetAmount は目標金額を更新する
+// 外貨建ての目標の場合は目標の通貨で指定し、現在の為替レートで計画の通貨に換算する
 func (g *Goal) UpdateTargetAmount(newAmount valueobjects.Money) error {
 	if !newAmount.IsPositive() {
 		return errors.New("目標金額は正の値である必要があります")
 	}
 
+	if g.exchangeRate != nil {
+		if newAmount.Currency() != g.Currency() {
+			return fmt.Errorf("外貨建ての目標の目標金額は %s で指定する必要があります", g.Currency())
+		}
+		converted, err := g.exchangeRate.ToBase(newAmount)
+		if err != nil {
+			return fmt.Errorf("目標金額の換算に失敗しました: %w", err)
+		}
+		g.goalCurrencyTarget = &newAmount
+		g.targetAmount = converted
+		g.updatedAt = time.Now()
+		return nil
+	}
+
 	g.targetAmount = newAmount
 	g.updatedAt = time.Now()
 	return nil
 }
 
+// SetGoalCurrency は目標を外貨建てにし、目標の通貨での目標金額と換算に使う為替レートを設定する
+// 計画の通貨での目標金額は為替レートで換算し直す。既に外貨建ての場合はそれまでの為替レートを履歴に残す
+func (g *Goal) SetGoalCurrency(target valueobjects.Money, rate valueobjects.ExchangeRate) error {
+	if !target.IsPositive() {
+		return errors.New("目標金額は正の値である必要があります")
+	}
+	if rate.Base() != g.targetAmount.Currency() {
+		return fmt.Errorf("為替レートの基準通貨は計画の通貨（%s）である必要があります", g.targetAmount.Currency())
+	}
+	converted, err := rate.ToBase(target)
+	if err != nil {
+		return fmt.Errorf("目標金額の換算に失敗しました: %w", err)
+	}
+
+	g.recordExchangeRate()
+	g.goalCurrencyTarget = &target
+	g.exchangeRate = &rate
+	g.targetAmount = converted
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateExchangeRate は外貨建ての目標の為替レートを更新し、計画の通貨での目標金額を換算し直す
+// それまでの為替レートは履歴に残す
+func (g *Goal) UpdateExchangeRate(rate valueobjects.ExchangeRate) error {
+	if g.goalCurrencyTarget == nil {
+		return ErrGoalNotForeignCurrency
+	}
+	if rate.Quote() != g.Currency() || rate.Base() != g.targetAmount.Currency() {
+		return fmt.Errorf("為替レートの通貨が目標と一致しません: %s/%s", rate.Quote(), rate.Base())
+	}
+	converted, err := rate.ToBase(*g.goalCurrencyTarget)
+	if err != nil {
+		return fmt.Errorf("目標金額の換算に失敗しました: %w", err)
+	}
+
+	g.recordExchangeRate()
+	g.exchangeRate = &rate
+	g.targetAmount = converted
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// recordExchangeRate は現在の為替レートを履歴に追加する
+// 値のコピーで保持される目標と配列を共有しないよう、履歴は新しいスライスに作り直す
+func (g *Goal) recordExchangeRate() {
+	if g.exchangeRate == nil {
+		return
+	}
+	history := make([]valueobjects.ExchangeRate, 0, len(g.exchangeRateHistory)+1)
+	history = append(history, g.exchangeRateHistory...)
+	g.exchangeRateHistory = append(history, *g.exchangeRate)
+}
+
+// RestoreGoalCurrency は永続化された外貨建ての目標の設定を復元する（リポジトリでの復元用）
+// 計画の通貨での目標金額は保存されている値をそのまま使う
+func (g *Goal) RestoreGoalCurrency(target valueobjects.Money, rate valueobjects.ExchangeRate, history []valueobjects.ExchangeRate) {
+	g.goalCurrencyTarget = &target
+	g.exchangeRate = &rate
+	g.exchangeRateHistory = history
+}
+
 // UpdateTargetDate は目標日を更新する
 func (g *Goal) UpdateTargetDate(newDate time.Time) error {
 	if newDate.Before(time.Now()) {
@@ -410,8 +830,9 @@ func (g *Goal) UpdateTargetDate(newDate time.Time) error {
 	return nil
 }
 
-// UpdateTitle は目標タイトルを更新する
+// UpdateTitle は目標タイトルを更新する（HTMLタグは取り除く）
 func (g *Goal) UpdateTitle(newTitle string) error {
+	newTitle = valueobjects.SanitizeText(newTitle)
 	if newTitle == "" {
 		return errors.New("目標タイトルは必須です")
 	}
@@ -421,6 +842,98 @@ func (g *Goal) UpdateTitle(newTitle string) error {
 	return nil
 }
 
+// UpdateNotes はメモを更新する（HTMLタグは取り除く）
+func (g *Goal) UpdateNotes(notes string) error {
+	notes = strings.TrimSpace(valueobjects.SanitizeText(notes))
+	if utf8.RuneCountInString(notes) > MaxGoalNotesLength {
+		return fmt.Errorf("メモは%d文字以内で入力してください", MaxGoalNotesLength)
+	}
+
+	g.notes = notes
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateImageURL はイメージ画像のURLを更新する（空文字の場合は画像を外す）
+func (g *Goal) UpdateImageURL(imageURL string) {
+	g.imageURL = strings.TrimSpace(imageURL)
+	g.updatedAt = time.Now()
+}
+
+// RestoreMetadata は永続化されたメモと画像URLを復元する（リポジトリでの復元用）
+func (g *Goal) RestoreMetadata(notes, imageURL string) {
+	g.notes = notes
+	g.imageURL = imageURL
+}
+
+// UpdateOverduePolicy は期限超過時ポリシーを更新する
+func (g *Goal) UpdateOverduePolicy(policy GoalOverduePolicy) error {
+	if !policy.IsValid() {
+		return ErrInvalidOverduePolicy
+	}
+
+	g.overduePolicy = policy
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// RestoreOverduePolicy は永続化された期限超過時ポリシーを復元する（リポジトリでの復元用）
+func (g *Goal) RestoreOverduePolicy(policy GoalOverduePolicy) {
+	g.overduePolicy = policy
+}
+
+// ExtendTargetDate は現在の月間拠出額で達成に必要な期間を再計算し、期限を延長する
+// 再計算した達成見込み日が now 以前になる場合は、now の1ヶ月後を新しい期限とする
+func (g *Goal) ExtendTargetDate(now time.Time) (time.Time, error) {
+	if g.IsCompleted() {
+		return time.Time{}, errors.New("達成済みの目標の期限は延長できません")
+	}
+	if !g.monthlyContribution.IsPositive() {
+		return time.Time{}, ErrGoalCannotBeExtended
+	}
+
+	newDate, err := g.EstimateCompletionDateFrom(now, g.monthlyContribution)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("達成見込み日の計算に失敗しました: %w", err)
+	}
+	if !newDate.After(now) {
+		newDate = now.AddDate(0, 1, 0)
+	}
+
+	g.targetDate = newDate
+	g.updatedAt = time.Now()
+	return newDate, nil
+}
+
+// NormalizeGoalTitle は重複判定のために目標名の表記ゆれを正規化する
+// NFKC正規化で全角・半角を統一し、大文字・小文字と空白の違いを無視する
+func NormalizeGoalTitle(title string) string {
+	normalized := strings.ToLower(norm.NFKC.String(title))
+	return strings.Join(strings.Fields(normalized), "")
+}
+
+// IsSimilarTo はアクティブな目標が同じタイプ・同じ目標名（正規化後）で、目標金額の差が1%以内かを判定する
+func (g *Goal) IsSimilarTo(goalType GoalType, title string, targetAmount valueobjects.Money) bool {
+	if !g.isActive || g.goalType != goalType {
+		return false
+	}
+	if NormalizeGoalTitle(g.title) != NormalizeGoalTitle(title) {
+		return false
+	}
+	existingAmount := g.targetAmount.Amount()
+	return math.Abs(existingAmount-targetAmount.Amount()) <= existingAmount*DuplicateGoalAmountTolerance
+}
+
+// FindSimilarGoal は goals の中から IsSimilarTo に該当する最初の目標を返す。該当しない場合は nil を返す
+func FindSimilarGoal(goals []*Goal, goalType GoalType, title string, targetAmount valueobjects.Money) *Goal {
+	for _, goal := range goals {
+		if goal.IsSimilarTo(goalType, title, targetAmount) {
+			return goal
+		}
+	}
+	return nil
+}
+
 // Activate は目標をアクティブにする
 func (g *Goal) Activate() {
 	g.isActive = true
@@ -433,6 +946,66 @@ func (g *Goal) Deactivate() {
 	g.updatedAt = time.Now()
 }
 
+// MarkDeleted は目標を削除済みにする（論理削除。既に削除済みの場合は削除日時を変更しない）
+func (g *Goal) MarkDeleted(at time.Time) {
+	if g.deletedAt != nil {
+		return
+	}
+	g.deletedAt = &at
+	g.updatedAt = at
+}
+
+// RestoreDeletedAt は永続化された削除日時を復元する（リポジトリでの復元用）
+func (g *Goal) RestoreDeletedAt(deletedAt *time.Time) {
+	g.deletedAt = deletedAt
+}
+
+// SetDependencies は前提となる目標を設定する（既存の設定は置き換える）
+// すべての前提目標の完了見込み日以降に拠出を開始する扱いになる。goals はユーザーの既存目標で、循環の検出に使う
+func (g *Goal) SetDependencies(prerequisites []*Goal, goals []*Goal) error {
+	if err := ValidateGoalDependencies(g, prerequisites, goals); err != nil {
+		return err
+	}
+
+	dependsOn := make([]GoalID, 0, len(prerequisites))
+	for _, prerequisite := range prerequisites {
+		if !containsGoalID(dependsOn, prerequisite.ID()) {
+			dependsOn = append(dependsOn, prerequisite.ID())
+		}
+	}
+
+	g.dependsOn = dependsOn
+	g.updatedAt = time.Now()
+	return nil
+}
+
+// RemoveDependency は指定した前提目標への依存を解除する（前提目標の削除時に使用する）
+func (g *Goal) RemoveDependency(prerequisiteID GoalID) {
+	dependsOn := make([]GoalID, 0, len(g.dependsOn))
+	for _, id := range g.dependsOn {
+		if id != prerequisiteID {
+			dependsOn = append(dependsOn, id)
+		}
+	}
+	if len(dependsOn) == len(g.dependsOn) {
+		return
+	}
+
+	g.dependsOn = dependsOn
+	g.updatedAt = time.Now()
+}
+
+// ClearDependencies は前提となる目標の設定をすべて解除する
+func (g *Goal) ClearDependencies() {
+	g.dependsOn = nil
+	g.updatedAt = time.Now()
+}
+
+// RestoreDependencies は永続化された前提目標のIDを復元する（リポジトリでの復元用）
+func (g *Goal) RestoreDependencies(prerequisiteIDs []GoalID) {
+	g.dependsOn = append([]GoalID(nil), prerequisiteIDs...)
+}
+
 // IsOverdue は目標が期限切れかどうかを返す
 func (g *Goal) IsOverdue() bool {
 	return time.Now().After(g.targetDate) && !g.IsCompleted()
@@ -472,46 +1045,149 @@ func (g *Goal) GetRemainingDays() int {
 	return int(duration.Hours() / 24)
 }
 
-// CalculateRequiredMonthlySavings は目標達成に必要な月間貯蓄額を計算する
-func (g *Goal) CalculateRequiredMonthlySavings() (valueobjects.Money, error) {
+// MonthsBetween は from から to までに満了する暦上の月数を返す（to が from 以前の場合は0）
+// 日数を30で割る概算ではなく、from に月数を加えた日付が to を超えないかで判定する
+// 時刻の差で月数が欠けないよう、両方を from のタイムゾーンの日付に切り捨ててから数える
+func MonthsBetween(from, to time.Time) int {
+	return completedMonths(truncateToDate(from, from.Location()), truncateToDate(to, from.Location()))
+}
+
+// truncateToDate は t を指定したタイムゾーンの日付（0時0分）に切り捨てる
+func truncateToDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// completedMonths は from から to までに満了する月数を時刻まで含めて判定する（to が from 以前の場合は0）
+func completedMonths(from, to time.Time) int {
+	to = to.In(from.Location())
+	if !to.After(from) {
+		return 0
+	}
+
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	// 月末起点の場合は AddDate が翌月に繰り越すため、超えなくなるまで減らす
+	for months > 0 && from.AddDate(0, months, 0).After(to) {
+		months--
+	}
+	return months
+}
+
+// FractionalMonthsBetween は from から to までの暦上の月数を端数込みで返す（to が from 以前の場合は0）
+// 満了した月数に、満了していない月の経過日数をその月の実日数で割った端数を加える
+func FractionalMonthsBetween(from, to time.Time) float64 {
+	to = to.In(from.Location())
+	if !to.After(from) {
+		return 0
+	}
+
+	months := completedMonths(from, to)
+	periodStart := from.AddDate(0, months, 0)
+	periodEnd := from.AddDate(0, months+1, 0)
+	return float64(months) + float64(to.Sub(periodStart))/float64(periodEnd.Sub(periodStart))
+}
+
+// RemainingMonthsFrom は指定日から目標日までに満了する月数を返す
+func (g *Goal) RemainingMonthsFrom(from time.Time) int {
+	return MonthsBetween(from, g.targetDate)
+}
+
+// RequiredSavingsPlan は目標達成に必要な毎月の拠出計画
+type RequiredSavingsPlan struct {
+	MonthlyAmount valueobjects.Money // 毎月の必要拠出額
+	Months        int                // 拠出回数
+	FinalPush     bool               // 残り1ヶ月以内のため、残額全額を1回で拠出する必要がある
+}
+
+// RequiredSavingsPlanFrom は指定日から拠出を始めた場合の拠出計画を返す
+// 残りが1ヶ月以内（期限切れを含む）の場合は残額全額を1回の拠出とし、FinalPush を true にする
+func (g *Goal) RequiredSavingsPlanFrom(startDate time.Time) (RequiredSavingsPlan, error) {
 	remainingAmount, err := g.GetRemainingAmount()
 	if err != nil {
-		return valueobjects.Money{}, fmt.Errorf("残り必要金額の計算に失敗しました: %w", err)
+		return RequiredSavingsPlan{}, fmt.Errorf("残り必要金額の計算に失敗しました: %w", err)
 	}
 
+	remainingMonths := g.RemainingMonthsFrom(startDate)
+
 	if remainingAmount.IsZero() || remainingAmount.IsNegative() {
-		return valueobjects.NewMoneyJPY(0)
+		zero, err := valueobjects.NewMoneyJPY(0)
+		if err != nil {
+			return RequiredSavingsPlan{}, err
+		}
+		return RequiredSavingsPlan{MonthlyAmount: zero, Months: remainingMonths}, nil
 	}
 
-	remainingDays := g.GetRemainingDays()
-	if remainingDays <= 0 {
-		return remainingAmount, nil // 期限が過ぎている場合は全額必要
+	if remainingMonths <= 1 {
+		return RequiredSavingsPlan{MonthlyAmount: remainingAmount, Months: 1, FinalPush: true}, nil
 	}
 
-	remainingMonths := float64(remainingDays) / 30.0 // 概算の月数
-	if remainingMonths < 1 {
-		remainingMonths = 1 // 最低1ヶ月とする
+	monthlyAmount, err := valueobjects.NewMoneyJPY(remainingAmount.Amount() / float64(remainingMonths))
+	if err != nil {
+		return RequiredSavingsPlan{}, err
 	}
+	return RequiredSavingsPlan{MonthlyAmount: monthlyAmount, Months: remainingMonths}, nil
+}
 
-	requiredMonthlySavings := remainingAmount.Amount() / remainingMonths
+// CalculateRequiredMonthlySavings は目標達成に必要な月間貯蓄額を計算する
+func (g *Goal) CalculateRequiredMonthlySavings() (valueobjects.Money, error) {
+	return g.CalculateRequiredMonthlySavingsFrom(time.Now())
+}
 
-	return valueobjects.NewMoneyJPY(requiredMonthlySavings)
+// CalculateRequiredMonthlySavingsFrom は指定日から拠出を始めた場合に目標達成に必要な月間貯蓄額を計算する
+// 残りが1ヶ月以内の場合は残額全額を返す（RequiredSavingsPlanFrom を参照）
+func (g *Goal) CalculateRequiredMonthlySavingsFrom(startDate time.Time) (valueobjects.Money, error) {
+	plan, err := g.RequiredSavingsPlanFrom(startDate)
+	if err != nil {
+		return valueobjects.Money{}, err
+	}
+	return plan.MonthlyAmount, nil
 }
 
 // MarshalJSON はGoalをJSONにシリアライズする
 func (g *Goal) MarshalJSON() ([]byte, error) {
 	type goalJSON struct {
-		ID                  string  `json:"id"`
-		UserID              string  `json:"user_id"`
-		GoalType            string  `json:"goal_type"`
-		Title               string  `json:"title"`
-		TargetAmount        float64 `json:"target_amount"`
-		TargetDate          string  `json:"target_date"`
-		CurrentAmount       float64 `json:"current_amount"`
-		MonthlyContribution float64 `json:"monthly_contribution"`
-		IsActive            bool    `json:"is_active"`
-		CreatedAt           string  `json:"created_at"`
-		UpdatedAt           string  `json:"updated_at"`
+		ID                  string   `json:"id"`
+		UserID              string   `json:"user_id"`
+		GoalType            string   `json:"goal_type"`
+		Title               string   `json:"title"`
+		TargetAmount        float64  `json:"target_amount"`
+		TargetDate          string   `json:"target_date"`
+		CurrentAmount       float64  `json:"current_amount"`
+		MonthlyContribution float64  `json:"monthly_contribution"`
+		ContributionMin     *float64 `json:"contribution_min,omitempty"`
+		ContributionStretch *float64 `json:"contribution_stretch,omitempty"`
+		IsActive            bool     `json:"is_active"`
+		DependsOn           []GoalID `json:"depends_on,omitempty"`
+		Notes               string   `json:"notes"`
+		ImageURL            string   `json:"image_url,omitempty"`
+		OverduePolicy       string   `json:"overdue_policy"`
+		Currency            string   `json:"currency,omitempty"`
+		GoalCurrencyTarget  *float64 `json:"goal_currency_target_amount,omitempty"`
+		ExchangeRate        *float64 `json:"exchange_rate,omitempty"`
+		DeletedAt           string   `json:"deleted_at,omitempty"`
+		CreatedAt           string   `json:"created_at"`
+		UpdatedAt           string   `json:"updated_at"`
+	}
+	var contributionMin, contributionStretch *float64
+	if g.contributionMin != nil {
+		amount := g.contributionMin.Amount()
+		contributionMin = &amount
+	}
+	if g.contributionStretch != nil {
+		amount := g.contributionStretch.Amount()
+		contributionStretch = &amount
+	}
+	var currency string
+	var goalCurrencyTarget, exchangeRate *float64
+	if g.goalCurrencyTarget != nil && g.exchangeRate != nil {
+		currency = string(g.goalCurrencyTarget.Currency())
+		target := g.goalCurrencyTarget.Amount()
+		rate := g.exchangeRate.Rate()
+		goalCurrencyTarget, exchangeRate = &target, &rate
+	}
+	var deletedAt string
+	if g.deletedAt != nil {
+		deletedAt = g.deletedAt.Format(time.RFC3339)
 	}
 	return json.Marshal(goalJSON{
 		ID:                  string(g.id),
@@ -522,7 +1198,17 @@ func (g *Goal) MarshalJSON() ([]byte, error) {
 		TargetDate:          g.targetDate.Format(time.RFC3339),
 		CurrentAmount:       g.currentAmount.Amount(),
 		MonthlyContribution: g.monthlyContribution.Amount(),
+		ContributionMin:     contributionMin,
+		ContributionStretch: contributionStretch,
 		IsActive:            g.isActive,
+		DependsOn:           g.dependsOn,
+		Notes:               g.notes,
+		ImageURL:            g.imageURL,
+		OverduePolicy:       string(g.OverduePolicy()),
+		Currency:            currency,
+		GoalCurrencyTarget:  goalCurrencyTarget,
+		ExchangeRate:        exchangeRate,
+		DeletedAt:           deletedAt,
 		CreatedAt:           g.createdAt.Format(time.RFC3339),
 		UpdatedAt:           g.updatedAt.Format(time.RFC3339),
 	})
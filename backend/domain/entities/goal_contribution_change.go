@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// GoalContributionChange は目標の月間拠出額の変更を記録するエンティティ
+// 月次必要額を採用して拠出額を見直した場合などに、変更前後の額と根拠を残す
+type GoalContributionChange struct {
+	goalID               GoalID
+	userID               UserID
+	previousContribution float64 // 変更前の月間拠出額（円）
+	newContribution      float64 // 変更後の月間拠出額（円）
+	requiredContribution float64 // 変更時点の月次必要額（円、端数処理前）
+	overBudget           bool    // 他の目標と合わせた拠出額が月間純貯蓄額を超えたまま変更したか
+	changedAt            time.Time
+}
+
+// NewGoalContributionChange は目標の月間拠出額の変更の記録を作成する
+func NewGoalContributionChange(
+	goalID GoalID,
+	userID UserID,
+	previousContribution float64,
+	newContribution float64,
+	requiredContribution float64,
+	overBudget bool,
+	changedAt time.Time,
+) (*GoalContributionChange, error) {
+	if goalID == "" {
+		return nil, errors.New("目標IDは必須です")
+	}
+	if userID == "" {
+		return nil, errors.New("ユーザーIDは必須です")
+	}
+	for _, amount := range []float64{previousContribution, newContribution, requiredContribution} {
+		if math.IsNaN(amount) || math.IsInf(amount, 0) {
+			return nil, errors.New("金額にNaNや無限大は指定できません")
+		}
+		if amount < 0 {
+			return nil, errors.New("金額は負の値にできません")
+		}
+	}
+	if changedAt.IsZero() {
+		return nil, errors.New("変更日時は必須です")
+	}
+
+	return &GoalContributionChange{
+		goalID:               goalID,
+		userID:               userID,
+		previousContribution: previousContribution,
+		newContribution:      newContribution,
+		requiredContribution: requiredContribution,
+		overBudget:           overBudget,
+		changedAt:            changedAt,
+	}, nil
+}
+
+// GoalID は目標IDを返す
+func (c *GoalContributionChange) GoalID() GoalID {
+	return c.goalID
+}
+
+// UserID はユーザーIDを返す
+func (c *GoalContributionChange) UserID() UserID {
+	return c.userID
+}
+
+// PreviousContribution は変更前の月間拠出額（円）を返す
+func (c *GoalContributionChange) PreviousContribution() float64 {
+	return c.previousContribution
+}
+
+// NewContribution は変更後の月間拠出額（円）を返す
+func (c *GoalContributionChange) NewContribution() float64 {
+	return c.newContribution
+}
+
+// RequiredContribution は変更時点の月次必要額（円、端数処理前）を返す
+func (c *GoalContributionChange) RequiredContribution() float64 {
+	return c.requiredContribution
+}
+
+// OverBudget は月間純貯蓄額を超えたまま変更したかを返す
+func (c *GoalContributionChange) OverBudget() bool {
+	return c.overBudget
+}
+
+// ChangedAt は変更日時を返す
+func (c *GoalContributionChange) ChangedAt() time.Time {
+	return c.changedAt
+}
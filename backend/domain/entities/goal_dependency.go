@@ -0,0 +1,259 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ErrGoalDependencyCycle は目標の依存関係が循環している場合のエラー
+var ErrGoalDependencyCycle = errors.New("目標の依存関係が循環しています")
+
+// GoalSchedule は依存関係を考慮した目標の拠出スケジュールを表す
+type GoalSchedule struct {
+	GoalID                  GoalID    `json:"goal_id"`
+	EffectiveStartDate      time.Time `json:"effective_start_date"`      // 拠出を開始する日（前提目標の完了見込み日）
+	EstimatedCompletionDate time.Time `json:"estimated_completion_date"` // 完了見込み日（拠出額が0の場合はゼロ値）
+}
+
+// HasEstimatedCompletion は完了見込み日が算出できたかを返す
+func (s GoalSchedule) HasEstimatedCompletion() bool {
+	return !s.EstimatedCompletionDate.IsZero()
+}
+
+// ValidateGoalDependencies は目標に前提目標を設定できるかを検証する
+// 所有者の一致と自己参照に加えて、設定後の goals の依存関係グラフが循環しないことを確認する
+func ValidateGoalDependencies(goal *Goal, prerequisites []*Goal, goals []*Goal) error {
+	if goal == nil {
+		return errors.New("目標は必須です")
+	}
+
+	prerequisiteIDs := make([]GoalID, 0, len(prerequisites))
+	for _, prerequisite := range prerequisites {
+		if prerequisite == nil {
+			return errors.New("前提となる目標は必須です")
+		}
+		if prerequisite.ID() == goal.ID() {
+			return fmt.Errorf("%w: 目標自身を前提にすることはできません", ErrGoalDependencyCycle)
+		}
+		if prerequisite.UserID() != goal.UserID() {
+			return errors.New("他のユーザーの目標を前提にすることはできません")
+		}
+		prerequisiteIDs = append(prerequisiteIDs, prerequisite.ID())
+	}
+
+	graph := make(map[GoalID][]GoalID, len(goals)+len(prerequisites)+1)
+	for _, g := range goals {
+		graph[g.ID()] = g.dependsOn
+	}
+	for _, prerequisite := range prerequisites {
+		if _, ok := graph[prerequisite.ID()]; !ok {
+			graph[prerequisite.ID()] = prerequisite.dependsOn
+		}
+	}
+	// 設定後の依存関係で循環を検出する
+	graph[goal.ID()] = prerequisiteIDs
+
+	if hasGoalDependencyCycle(graph) {
+		return ErrGoalDependencyCycle
+	}
+	return nil
+}
+
+// ValidateGoalDependencyGraph は目標一覧の依存関係グラフ全体を検証する
+// 存在しない目標への依存と循環をエラーとする
+func ValidateGoalDependencyGraph(goals []*Goal) error {
+	graph := make(map[GoalID][]GoalID, len(goals))
+	for _, g := range goals {
+		graph[g.ID()] = g.dependsOn
+	}
+
+	for _, g := range goals {
+		for _, prerequisiteID := range g.dependsOn {
+			if _, ok := graph[prerequisiteID]; !ok {
+				return fmt.Errorf("前提となる目標が見つかりません: %s", prerequisiteID)
+			}
+		}
+	}
+
+	if hasGoalDependencyCycle(graph) {
+		return ErrGoalDependencyCycle
+	}
+	return nil
+}
+
+// hasGoalDependencyCycle は依存関係グラフに循環があるかを深さ優先探索で判定する
+// グラフに含まれない目標への依存は無視する
+func hasGoalDependencyCycle(graph map[GoalID][]GoalID) bool {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[GoalID]int, len(graph))
+
+	var visit func(id GoalID) bool
+	visit = func(id GoalID) bool {
+		switch states[id] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+		states[id] = visiting
+		for _, prerequisiteID := range graph[id] {
+			if _, ok := graph[prerequisiteID]; ok && visit(prerequisiteID) {
+				return true
+			}
+		}
+		states[id] = visited
+		return false
+	}
+
+	for id := range graph {
+		if states[id] == unvisited && visit(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitingPrerequisites は目標の前提目標のうち未達成のものを返す
+// アクティブで完了していない前提目標が残っている間、目標は待機中として扱う
+func WaitingPrerequisites(goal *Goal, goals []*Goal) []*Goal {
+	if goal == nil || !goal.HasDependency() {
+		return nil
+	}
+
+	goalsByID := make(map[GoalID]*Goal, len(goals))
+	for _, g := range goals {
+		goalsByID[g.ID()] = g
+	}
+
+	var waiting []*Goal
+	for _, prerequisiteID := range goal.dependsOn {
+		prerequisite := goalsByID[prerequisiteID]
+		if prerequisite != nil && prerequisite.IsActive() && !prerequisite.IsCompleted() {
+			waiting = append(waiting, prerequisite)
+		}
+	}
+	return waiting
+}
+
+// IsGoalWaiting は目標が前提目標の達成待ち（待機中）かどうかを返す
+func IsGoalWaiting(goal *Goal, goals []*Goal) bool {
+	return len(WaitingPrerequisites(goal, goals)) > 0
+}
+
+// SortGoalsByDependency は前提目標が先に来るように目標を並べ替える
+// 依存関係のない目標同士は目標日の早い順に並べ、循環している目標は末尾に目標日順で並べる
+func SortGoalsByDependency(goals []*Goal) []*Goal {
+	remaining := make([]*Goal, len(goals))
+	copy(remaining, goals)
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return remaining[i].TargetDate().Before(remaining[j].TargetDate())
+	})
+
+	inGraph := make(map[GoalID]bool, len(goals))
+	for _, g := range goals {
+		inGraph[g.ID()] = true
+	}
+
+	sorted := make([]*Goal, 0, len(goals))
+	placed := make(map[GoalID]bool, len(goals))
+	for len(remaining) > 0 {
+		next := make([]*Goal, 0, len(remaining))
+		progressed := false
+		for _, g := range remaining {
+			ready := true
+			for _, prerequisiteID := range g.dependsOn {
+				if inGraph[prerequisiteID] && !placed[prerequisiteID] {
+					ready = false
+					break
+				}
+			}
+			// 1件ずつ確定させ、目標日順を優先する
+			if ready && !progressed {
+				sorted = append(sorted, g)
+				placed[g.ID()] = true
+				progressed = true
+				continue
+			}
+			next = append(next, g)
+		}
+		if !progressed {
+			// 循環している目標（永続化済みの不整合データ）は目標日順で末尾に並べる
+			return append(sorted, next...)
+		}
+		remaining = next
+	}
+	return sorted
+}
+
+// containsGoalID はIDの一覧に指定したIDが含まれるかを返す
+func containsGoalID(ids []GoalID, id GoalID) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildGoalSchedules は依存関係をたどって各目標の拠出開始日と完了見込み日を算出する
+// 依存のない目標・前提目標がすべて完了済み/非アクティブ/見つからない目標は now から拠出を開始する
+// 前提目標の拠出額が0で完了見込み日を算出できない場合は前提目標の目標日を拠出開始日とする
+func BuildGoalSchedules(goals []*Goal, now time.Time) map[GoalID]GoalSchedule {
+	goalsByID := make(map[GoalID]*Goal, len(goals))
+	for _, g := range goals {
+		goalsByID[g.ID()] = g
+	}
+
+	schedules := make(map[GoalID]GoalSchedule, len(goals))
+	resolving := make(map[GoalID]bool)
+
+	var resolve func(goal *Goal) GoalSchedule
+	resolve = func(goal *Goal) GoalSchedule {
+		if schedule, ok := schedules[goal.ID()]; ok {
+			return schedule
+		}
+		resolving[goal.ID()] = true
+		defer delete(resolving, goal.ID())
+
+		// すべての前提目標のうち最も遅い完了見込み日から拠出を開始する
+		startDate := now
+		for _, prerequisiteID := range goal.dependsOn {
+			prerequisite := goalsByID[prerequisiteID]
+			// 循環している場合（永続化済みの不整合データ）は依存を無視する
+			if prerequisite == nil || !prerequisite.IsActive() || prerequisite.IsCompleted() || resolving[prerequisite.ID()] {
+				continue
+			}
+			prerequisiteSchedule := resolve(prerequisite)
+			prerequisiteEnd := prerequisite.TargetDate()
+			if prerequisiteSchedule.HasEstimatedCompletion() {
+				prerequisiteEnd = prerequisiteSchedule.EstimatedCompletionDate
+			}
+			if prerequisiteEnd.After(startDate) {
+				startDate = prerequisiteEnd
+			}
+		}
+
+		schedule := GoalSchedule{
+			GoalID:             goal.ID(),
+			EffectiveStartDate: startDate,
+		}
+		if completionDate, err := goal.EstimateCompletionDateFrom(startDate, goal.MonthlyContribution()); err == nil {
+			schedule.EstimatedCompletionDate = completionDate
+		}
+
+		schedules[goal.ID()] = schedule
+		return schedule
+	}
+
+	for _, goal := range goals {
+		resolve(goal)
+	}
+
+	return schedules
+}
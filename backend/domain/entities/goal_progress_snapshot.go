@@ -0,0 +1,87 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// GoalProgressSnapshot はある時点の目標の積立額（現在金額）を記録するエンティティ
+// 同じ目標の記録は1日1件とし、同じ日に進捗を更新した場合は上書きする
+type GoalProgressSnapshot struct {
+	goalID     GoalID
+	userID     UserID
+	amount     float64 // 記録時点の現在金額（円）
+	recordedAt time.Time
+}
+
+// NewGoalProgressSnapshot は目標の進捗の記録を作成する
+func NewGoalProgressSnapshot(goalID GoalID, userID UserID, amount float64, recordedAt time.Time) (*GoalProgressSnapshot, error) {
+	if goalID == "" {
+		return nil, errors.New("目標IDは必須です")
+	}
+	if userID == "" {
+		return nil, errors.New("ユーザーIDは必須です")
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, errors.New("金額にNaNや無限大は指定できません")
+	}
+	if amount < 0 {
+		return nil, errors.New("金額は負の値にできません")
+	}
+	if recordedAt.IsZero() {
+		return nil, errors.New("記録日時は必須です")
+	}
+
+	return &GoalProgressSnapshot{
+		goalID:     goalID,
+		userID:     userID,
+		amount:     amount,
+		recordedAt: recordedAt,
+	}, nil
+}
+
+// GoalID は目標IDを返す
+func (s *GoalProgressSnapshot) GoalID() GoalID {
+	return s.goalID
+}
+
+// UserID はユーザーIDを返す
+func (s *GoalProgressSnapshot) UserID() UserID {
+	return s.userID
+}
+
+// Amount は記録時点の現在金額（円）を返す
+func (s *GoalProgressSnapshot) Amount() float64 {
+	return s.amount
+}
+
+// RecordedAt は記録日時を返す
+func (s *GoalProgressSnapshot) RecordedAt() time.Time {
+	return s.recordedAt
+}
+
+// MonthlyGoalContributions は進捗の記録から月ごとの積立額を古い順に返す
+// 各月の最後の記録を月末残高とみなし、前月からの増減を積立額とする。記録のない月を挟む場合は、
+// 増減を間の月数で均等に割り振る。snapshots は記録日時の昇順であること
+func MonthlyGoalContributions(snapshots []*GoalProgressSnapshot) []float64 {
+	var monthEnds []*GoalProgressSnapshot
+	for _, snapshot := range snapshots {
+		if n := len(monthEnds); n > 0 && sameMonth(monthEnds[n-1].recordedAt, snapshot.recordedAt) {
+			monthEnds[n-1] = snapshot
+			continue
+		}
+		monthEnds = append(monthEnds, snapshot)
+	}
+
+	var contributions []float64
+	for i := 1; i < len(monthEnds); i++ {
+		previous, current := monthEnds[i-1].recordedAt, monthEnds[i].recordedAt
+		gap := (current.Year()-previous.Year())*12 + int(current.Month()-previous.Month())
+		perMonth := (monthEnds[i].amount - monthEnds[i-1].amount) / float64(gap)
+		for j := 0; j < gap; j++ {
+			contributions = append(contributions, perMonth)
+		}
+	}
+	return contributions
+}
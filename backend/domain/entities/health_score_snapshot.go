@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// HealthScoreSnapshot はある時点の財務健全性スコアと、スコアの算出に使った指標を記録するエンティティ
+// 同じユーザーの記録は1日1件とし、同じ日に再計算した場合は上書きする
+type HealthScoreSnapshot struct {
+	userID             UserID
+	score              int
+	savingsRate        float64 // 貯蓄率（%）
+	emergencyFundRatio float64 // 緊急資金が月間支出の何ヶ月分か
+	investmentReturn   float64 // 想定投資利回り（年率%）
+	totalAssets        float64 // 総資産（円）
+	recordedAt         time.Time
+}
+
+// NewHealthScoreSnapshot は財務健全性スコアの記録を作成する
+func NewHealthScoreSnapshot(
+	userID UserID,
+	score int,
+	savingsRate, emergencyFundRatio, investmentReturn, totalAssets float64,
+	recordedAt time.Time,
+) (*HealthScoreSnapshot, error) {
+	if userID == "" {
+		return nil, errors.New("ユーザーIDは必須です")
+	}
+	if score < 0 || score > 100 {
+		return nil, errors.New("財務健全性スコアは0から100の範囲である必要があります")
+	}
+	for _, value := range []float64{savingsRate, emergencyFundRatio, investmentReturn, totalAssets} {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return nil, errors.New("指標にNaNや無限大は指定できません")
+		}
+	}
+	if recordedAt.IsZero() {
+		return nil, errors.New("記録日時は必須です")
+	}
+
+	return &HealthScoreSnapshot{
+		userID:             userID,
+		score:              score,
+		savingsRate:        savingsRate,
+		emergencyFundRatio: emergencyFundRatio,
+		investmentReturn:   investmentReturn,
+		totalAssets:        totalAssets,
+		recordedAt:         recordedAt,
+	}, nil
+}
+
+// UserID はユーザーIDを返す
+func (s *HealthScoreSnapshot) UserID() UserID {
+	return s.userID
+}
+
+// Score は財務健全性スコア（0-100）を返す
+func (s *HealthScoreSnapshot) Score() int {
+	return s.score
+}
+
+// SavingsRate は貯蓄率（%）を返す
+func (s *HealthScoreSnapshot) SavingsRate() float64 {
+	return s.savingsRate
+}
+
+// EmergencyFundRatio は緊急資金が月間支出の何ヶ月分かを返す
+func (s *HealthScoreSnapshot) EmergencyFundRatio() float64 {
+	return s.emergencyFundRatio
+}
+
+// InvestmentReturn は想定投資利回り（年率%）を返す
+func (s *HealthScoreSnapshot) InvestmentReturn() float64 {
+	return s.investmentReturn
+}
+
+// TotalAssets は総資産（円）を返す
+func (s *HealthScoreSnapshot) TotalAssets() float64 {
+	return s.totalAssets
+}
+
+// RecordedAt は記録日時を返す
+func (s *HealthScoreSnapshot) RecordedAt() time.Time {
+	return s.recordedAt
+}
+
+// MonthlyHealthScoreSnapshots は記録を月ごとにまとめ、各月の最後の記録を古い順に返す
+// snapshots は記録日時の昇順であること
+func MonthlyHealthScoreSnapshots(snapshots []*HealthScoreSnapshot) []*HealthScoreSnapshot {
+	var monthly []*HealthScoreSnapshot
+	for _, snapshot := range snapshots {
+		if n := len(monthly); n > 0 && sameMonth(monthly[n-1].recordedAt, snapshot.recordedAt) {
+			monthly[n-1] = snapshot
+			continue
+		}
+		monthly = append(monthly, snapshot)
+	}
+	return monthly
+}
+
+// ConsecutiveScoreDeclines は直近の記録から遡って、スコアが連続して低下した回数を返す
+func ConsecutiveScoreDeclines(snapshots []*HealthScoreSnapshot) int {
+	declines := 0
+	for i := len(snapshots) - 1; i > 0; i-- {
+		if snapshots[i].score >= snapshots[i-1].score {
+			break
+		}
+		declines++
+	}
+	return declines
+}
+
+// sameMonth は2つの日時が同じ年月かを返す
+func sameMonth(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
@@ -0,0 +1,335 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// LiabilityID は負債の一意識別子
+type LiabilityID string
+
+// NewLiabilityID は新しい負債IDを生成する
+func NewLiabilityID() LiabilityID {
+	return LiabilityID(uuid.New().String())
+}
+
+// LiabilityType は負債の種類
+type LiabilityType string
+
+const (
+	LiabilityTypeMortgage    LiabilityType = "mortgage"     // 住宅ローン
+	LiabilityTypeCarLoan     LiabilityType = "car_loan"     // 自動車ローン
+	LiabilityTypeStudentLoan LiabilityType = "student_loan" // 奨学金
+	LiabilityTypeOther       LiabilityType = "other"        // その他
+)
+
+// IsValid は負債の種類が有効かどうかを返す
+func (lt LiabilityType) IsValid() bool {
+	switch lt {
+	case LiabilityTypeMortgage, LiabilityTypeCarLoan, LiabilityTypeStudentLoan, LiabilityTypeOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// DebtRepaymentExpenseCategory は負債の返済額から導出する支出項目のカテゴリ
+const DebtRepaymentExpenseCategory = "debt_repayment"
+
+// Liability は負債（ローン）を表すエンティティ
+type Liability struct {
+	id                 LiabilityID
+	liabilityType      LiabilityType
+	name               string
+	principalRemaining valueobjects.Money
+	interestRate       valueobjects.Rate
+	monthlyPayment     valueobjects.Money
+	endDate            time.Time
+	// includedInExpenses は返済額を支出項目にすでに計上しているか
+	// true の場合は純貯蓄額の計算で返済額を二重に差し引かない
+	includedInExpenses bool
+	createdAt          time.Time
+	updatedAt          time.Time
+}
+
+// NewLiability は新しい負債を作成する
+func NewLiability(
+	liabilityType LiabilityType,
+	name string,
+	principalRemaining valueobjects.Money,
+	interestRate valueobjects.Rate,
+	monthlyPayment valueobjects.Money,
+	endDate time.Time,
+	includedInExpenses bool,
+) (*Liability, error) {
+	now := time.Now()
+	return NewLiabilityWithID(NewLiabilityID(), liabilityType, name, principalRemaining, interestRate, monthlyPayment, endDate, includedInExpenses, now, now)
+}
+
+// NewLiabilityWithID は指定されたIDで負債を作成する（リポジトリでの復元用）
+func NewLiabilityWithID(
+	id LiabilityID,
+	liabilityType LiabilityType,
+	name string,
+	principalRemaining valueobjects.Money,
+	interestRate valueobjects.Rate,
+	monthlyPayment valueobjects.Money,
+	endDate time.Time,
+	includedInExpenses bool,
+	createdAt, updatedAt time.Time,
+) (*Liability, error) {
+	if id == "" {
+		return nil, errors.New("負債IDは必須です")
+	}
+
+	liability := &Liability{
+		id:        id,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+	if err := liability.apply(liabilityType, name, principalRemaining, interestRate, monthlyPayment, endDate, includedInExpenses); err != nil {
+		return nil, err
+	}
+	return liability, nil
+}
+
+// apply はバリデーションのうえ負債の属性を設定する
+func (l *Liability) apply(
+	liabilityType LiabilityType,
+	name string,
+	principalRemaining valueobjects.Money,
+	interestRate valueobjects.Rate,
+	monthlyPayment valueobjects.Money,
+	endDate time.Time,
+	includedInExpenses bool,
+) error {
+	if !liabilityType.IsValid() {
+		return fmt.Errorf("無効な負債の種類です: %s", liabilityType)
+	}
+	if principalRemaining.IsNegative() {
+		return errors.New("残高は負の値にできません")
+	}
+	if !monthlyPayment.IsPositive() {
+		return errors.New("月々の返済額は正の値である必要があります")
+	}
+	if endDate.IsZero() {
+		return errors.New("返済終了日は必須です")
+	}
+
+	l.liabilityType = liabilityType
+	l.name = name
+	l.principalRemaining = principalRemaining
+	l.interestRate = interestRate
+	l.monthlyPayment = monthlyPayment
+	l.endDate = endDate
+	l.includedInExpenses = includedInExpenses
+	return nil
+}
+
+// Update は負債の属性を更新する
+func (l *Liability) Update(
+	liabilityType LiabilityType,
+	name string,
+	principalRemaining valueobjects.Money,
+	interestRate valueobjects.Rate,
+	monthlyPayment valueobjects.Money,
+	endDate time.Time,
+	includedInExpenses bool,
+) error {
+	if err := l.apply(liabilityType, name, principalRemaining, interestRate, monthlyPayment, endDate, includedInExpenses); err != nil {
+		return err
+	}
+	l.updatedAt = time.Now()
+	return nil
+}
+
+// ID は負債IDを返す
+func (l *Liability) ID() LiabilityID {
+	return l.id
+}
+
+// LiabilityType は負債の種類を返す
+func (l *Liability) LiabilityType() LiabilityType {
+	return l.liabilityType
+}
+
+// Name は負債の名称を返す
+func (l *Liability) Name() string {
+	return l.name
+}
+
+// PrincipalRemaining は残高（元本の残り）を返す
+func (l *Liability) PrincipalRemaining() valueobjects.Money {
+	return l.principalRemaining
+}
+
+// InterestRate は年利を返す
+func (l *Liability) InterestRate() valueobjects.Rate {
+	return l.interestRate
+}
+
+// MonthlyPayment は月々の返済額を返す
+func (l *Liability) MonthlyPayment() valueobjects.Money {
+	return l.monthlyPayment
+}
+
+// EndDate は返済終了日を返す
+func (l *Liability) EndDate() time.Time {
+	return l.endDate
+}
+
+// IncludedInExpenses は返済額を支出項目にすでに計上しているかを返す
+func (l *Liability) IncludedInExpenses() bool {
+	return l.includedInExpenses
+}
+
+// CreatedAt は作成日時を返す
+func (l *Liability) CreatedAt() time.Time {
+	return l.createdAt
+}
+
+// UpdatedAt は更新日時を返す
+func (l *Liability) UpdatedAt() time.Time {
+	return l.updatedAt
+}
+
+// IsRepayingAt は指定日時点で返済中（残高があり返済終了日前）かどうかを返す
+func (l *Liability) IsRepayingAt(date time.Time) bool {
+	return l.principalRemaining.IsPositive() && l.endDate.After(date)
+}
+
+// AmortizationMonth は返済予定の1ヶ月分
+type AmortizationMonth struct {
+	Month   int     // 起点からの月数（1始まり）
+	Payment float64 // その月の返済額
+	Balance float64 // 返済後の残高
+}
+
+// AmortizationFrom は指定日から months ヶ月分の返済予定を返す（元利均等返済）
+// 月利は年利の1/12とし、毎月の返済額から利息を差し引いた分で元本を減らす。
+// 返済終了日までに返済しきれない残高は、終了日の属する月に一括で返済したものとする
+func (l *Liability) AmortizationFrom(start time.Time, months int) []AmortizationMonth {
+	if months <= 0 {
+		return nil
+	}
+
+	schedule := make([]AmortizationMonth, months)
+	balance := l.principalRemaining.Amount()
+	monthlyRate := l.interestRate.AsDecimal() / 12
+	// 終了日を過ぎていても残高がある場合は翌月に一括返済する
+	finalMonth := max(MonthsBetween(start, l.endDate), 1)
+
+	for month := 1; month <= months; month++ {
+		var payment float64
+		if balance > 0 {
+			interest := balance * monthlyRate
+			payment = math.Min(l.monthlyPayment.Amount(), balance+interest)
+			if month >= finalMonth {
+				payment = balance + interest
+			}
+			balance = math.Max(balance+interest-payment, 0)
+		}
+		schedule[month-1] = AmortizationMonth{Month: month, Payment: payment, Balance: balance}
+	}
+	return schedule
+}
+
+// PayoffDateFrom は指定日から返済を続けた場合の完済予定日を返す
+// 返済額が利息に満たない場合も、返済終了日に残高を一括返済するため終了日までに完済する
+func (l *Liability) PayoffDateFrom(start time.Time) time.Time {
+	if !l.principalRemaining.IsPositive() {
+		return start
+	}
+
+	finalMonth := max(MonthsBetween(start, l.endDate), 1)
+	for _, month := range l.AmortizationFrom(start, finalMonth) {
+		if month.Balance <= 0 {
+			return start.AddDate(0, month.Month, 0)
+		}
+	}
+	return start.AddDate(0, finalMonth, 0)
+}
+
+// MarshalJSON はLiabilityをJSONにシリアライズする
+func (l *Liability) MarshalJSON() ([]byte, error) {
+	type liabilityJSON struct {
+		ID                 string  `json:"id"`
+		LiabilityType      string  `json:"type"`
+		Name               string  `json:"name"`
+		PrincipalRemaining float64 `json:"principal_remaining"`
+		InterestRate       float64 `json:"interest_rate"`
+		MonthlyPayment     float64 `json:"monthly_payment"`
+		EndDate            string  `json:"end_date"`
+		IncludedInExpenses bool    `json:"included_in_expenses"`
+		CreatedAt          string  `json:"created_at"`
+		UpdatedAt          string  `json:"updated_at"`
+	}
+	return json.Marshal(liabilityJSON{
+		ID:                 string(l.id),
+		LiabilityType:      string(l.liabilityType),
+		Name:               l.name,
+		PrincipalRemaining: l.principalRemaining.Amount(),
+		InterestRate:       l.interestRate.AsPercentage(),
+		MonthlyPayment:     l.monthlyPayment.Amount(),
+		EndDate:            l.endDate.Format("2006-01-02"),
+		IncludedInExpenses: l.includedInExpenses,
+		CreatedAt:          l.createdAt.Format(time.RFC3339),
+		UpdatedAt:          l.updatedAt.Format(time.RFC3339),
+	})
+}
+
+// LiabilityCollection は負債のコレクション
+type LiabilityCollection []*Liability
+
+// TotalBalance は残高の合計を返す
+func (lc LiabilityCollection) TotalBalance() (valueobjects.Money, error) {
+	total, err := valueobjects.NewMoneyJPY(0)
+	if err != nil {
+		return valueobjects.Money{}, err
+	}
+
+	for _, liability := range lc {
+		total, err = total.Add(liability.principalRemaining)
+		if err != nil {
+			return valueobjects.Money{}, fmt.Errorf("負債残高の合計の計算に失敗しました: %w", err)
+		}
+	}
+	return total, nil
+}
+
+// MonthlyPaymentsAt は指定日時点で返済中の負債の月々の返済額の合計を返す
+// excludeIncludedInExpenses が true の場合、支出項目に計上済みの負債を除く
+func (lc LiabilityCollection) MonthlyPaymentsAt(date time.Time, excludeIncludedInExpenses bool) (valueobjects.Money, error) {
+	total, err := valueobjects.NewMoneyJPY(0)
+	if err != nil {
+		return valueobjects.Money{}, err
+	}
+
+	for _, liability := range lc {
+		if !liability.IsRepayingAt(date) || (excludeIncludedInExpenses && liability.includedInExpenses) {
+			continue
+		}
+		total, err = total.Add(liability.monthlyPayment)
+		if err != nil {
+			return valueobjects.Money{}, fmt.Errorf("返済額の合計の計算に失敗しました: %w", err)
+		}
+	}
+	return total, nil
+}
+
+// FindByID は指定されたIDの負債を返す（見つからない場合は nil）
+func (lc LiabilityCollection) FindByID(id LiabilityID) *Liability {
+	for _, liability := range lc {
+		if liability.id == id {
+			return liability
+		}
+	}
+	return nil
+}
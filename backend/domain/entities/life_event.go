@@ -0,0 +1,321 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+
+	"github.com/google/uuid"
+)
+
+// LifeEventID はライフイベントの一意識別子
+type LifeEventID string
+
+// NewLifeEventID は新しいライフイベントIDを生成する
+func NewLifeEventID() LifeEventID {
+	return LifeEventID(uuid.New().String())
+}
+
+// LifeEventCategory はライフイベントの種類
+type LifeEventCategory string
+
+const (
+	LifeEventCategoryMarriage         LifeEventCategory = "marriage"          // 結婚
+	LifeEventCategoryChildbirth       LifeEventCategory = "childbirth"        // 出産
+	LifeEventCategoryEducation        LifeEventCategory = "education"         // 教育費
+	LifeEventCategoryChildIndependent LifeEventCategory = "child_independent" // 子供の独立
+	LifeEventCategoryHousing          LifeEventCategory = "housing"           // 住宅（購入・住み替え）
+	LifeEventCategoryOther            LifeEventCategory = "other"             // その他
+)
+
+// IsValid はライフイベントの種類が有効かどうかを返す
+func (c LifeEventCategory) IsValid() bool {
+	switch c {
+	case LifeEventCategoryMarriage, LifeEventCategoryChildbirth, LifeEventCategoryEducation,
+		LifeEventCategoryChildIndependent, LifeEventCategoryHousing, LifeEventCategoryOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// LifeEventRecurrence はライフイベントによる支出の増減の発生の仕方
+type LifeEventRecurrence string
+
+const (
+	LifeEventOneTime   LifeEventRecurrence = "one_time"  // 発生年に一度だけ（結婚式の費用など）
+	LifeEventRecurring LifeEventRecurrence = "recurring" // 発生年から毎月継続（子供の生活費、教育費など）
+)
+
+// IsValid は発生の仕方が有効かどうかを返す
+func (r LifeEventRecurrence) IsValid() bool {
+	return r == LifeEventOneTime || r == LifeEventRecurring
+}
+
+// MaxLifeEventDurationYears は継続するライフイベントの期間の上限（年）
+const MaxLifeEventDurationYears = 100
+
+// LifeEvent は結婚・出産・子供の独立などの支出を増減させるライフイベントを表すエンティティ
+type LifeEvent struct {
+	id         LifeEventID
+	category   LifeEventCategory
+	name       string
+	year       int // 発生年（西暦）
+	recurrence LifeEventRecurrence
+	// amountChange は支出の増減額（正: 増加, 負: 減少）
+	// 一時の場合は発生年の一度きりの金額、継続の場合は毎月の金額
+	amountChange valueobjects.Money
+	// durationYears は継続する年数（継続の場合のみ。0 は予測期間の終わりまで継続）
+	durationYears int
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewLifeEvent は新しいライフイベントを作成する（発生年は今年以降である必要がある）
+func NewLifeEvent(
+	category LifeEventCategory,
+	name string,
+	year int,
+	recurrence LifeEventRecurrence,
+	amountChange valueobjects.Money,
+	durationYears int,
+) (*LifeEvent, error) {
+	now := time.Now()
+	if err := validateLifeEventYear(year, now); err != nil {
+		return nil, err
+	}
+	return NewLifeEventWithID(NewLifeEventID(), category, name, year, recurrence, amountChange, durationYears, now, now)
+}
+
+// NewLifeEventWithID は指定されたIDでライフイベントを作成する（リポジトリでの復元用）
+// 保存後に発生年が過去になったイベントも復元できるよう、発生年が過去かどうかは検証しない
+func NewLifeEventWithID(
+	id LifeEventID,
+	category LifeEventCategory,
+	name string,
+	year int,
+	recurrence LifeEventRecurrence,
+	amountChange valueobjects.Money,
+	durationYears int,
+	createdAt, updatedAt time.Time,
+) (*LifeEvent, error) {
+	if id == "" {
+		return nil, errors.New("ライフイベントIDは必須です")
+	}
+
+	event := &LifeEvent{
+		id:        id,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+	if err := event.apply(category, name, year, recurrence, amountChange, durationYears); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// validateLifeEventYear は発生年が過去でないことを検証する
+func validateLifeEventYear(year int, now time.Time) error {
+	if year < now.Year() {
+		return fmt.Errorf("ライフイベントの発生年は%d年以降である必要があります: %d", now.Year(), year)
+	}
+	return nil
+}
+
+// apply はバリデーションのうえライフイベントの属性を設定する
+func (e *LifeEvent) apply(
+	category LifeEventCategory,
+	name string,
+	year int,
+	recurrence LifeEventRecurrence,
+	amountChange valueobjects.Money,
+	durationYears int,
+) error {
+	if !category.IsValid() {
+		return fmt.Errorf("無効なライフイベントの種類です: %s", category)
+	}
+	name = valueobjects.SanitizeText(name)
+	if name == "" {
+		return errors.New("ライフイベントの名称は必須です")
+	}
+	if year <= 0 {
+		return errors.New("ライフイベントの発生年は必須です")
+	}
+	if !recurrence.IsValid() {
+		return fmt.Errorf("無効なライフイベントの発生の仕方です: %s", recurrence)
+	}
+	if amountChange.IsZero() {
+		return errors.New("ライフイベントによる支出の増減額は0以外である必要があります")
+	}
+	if durationYears < 0 || durationYears > MaxLifeEventDurationYears {
+		return fmt.Errorf("ライフイベントの継続年数は0〜%d年である必要があります", MaxLifeEventDurationYears)
+	}
+	if recurrence == LifeEventOneTime && durationYears != 0 {
+		return errors.New("一時的なライフイベントには継続年数を指定できません")
+	}
+
+	e.category = category
+	e.name = name
+	e.year = year
+	e.recurrence = recurrence
+	e.amountChange = amountChange
+	e.durationYears = durationYears
+	return nil
+}
+
+// Update はライフイベントの属性を更新する（発生年は今年以降である必要がある）
+func (e *LifeEvent) Update(
+	category LifeEventCategory,
+	name string,
+	year int,
+	recurrence LifeEventRecurrence,
+	amountChange valueobjects.Money,
+	durationYears int,
+) error {
+	now := time.Now()
+	if err := validateLifeEventYear(year, now); err != nil {
+		return err
+	}
+	if err := e.apply(category, name, year, recurrence, amountChange, durationYears); err != nil {
+		return err
+	}
+	e.updatedAt = now
+	return nil
+}
+
+// ID はライフイベントIDを返す
+func (e *LifeEvent) ID() LifeEventID {
+	return e.id
+}
+
+// Category はライフイベントの種類を返す
+func (e *LifeEvent) Category() LifeEventCategory {
+	return e.category
+}
+
+// Name はライフイベントの名称を返す
+func (e *LifeEvent) Name() string {
+	return e.name
+}
+
+// Year は発生年を返す
+func (e *LifeEvent) Year() int {
+	return e.year
+}
+
+// Recurrence は発生の仕方を返す
+func (e *LifeEvent) Recurrence() LifeEventRecurrence {
+	return e.recurrence
+}
+
+// AmountChange は支出の増減額を返す（一時の場合は一度きりの金額、継続の場合は毎月の金額）
+func (e *LifeEvent) AmountChange() valueobjects.Money {
+	return e.amountChange
+}
+
+// DurationYears は継続する年数を返す（0 は予測期間の終わりまで継続）
+func (e *LifeEvent) DurationYears() int {
+	return e.durationYears
+}
+
+// CreatedAt は作成日時を返す
+func (e *LifeEvent) CreatedAt() time.Time {
+	return e.createdAt
+}
+
+// UpdatedAt は更新日時を返す
+func (e *LifeEvent) UpdatedAt() time.Time {
+	return e.updatedAt
+}
+
+// IsActiveInYear は継続するライフイベントが指定した年に支出を増減させるかを返す
+func (e *LifeEvent) IsActiveInYear(year int) bool {
+	if e.recurrence != LifeEventRecurring || year < e.year {
+		return false
+	}
+	return e.durationYears == 0 || year < e.year+e.durationYears
+}
+
+// ExpenseChangesFrom は start の属する月から months ヶ月分の、このイベントによる月ごとの支出の増減額を返す
+// 一時のイベントは発生年に入った最初の月（発生年が今年の場合は start の月）に計上する
+// 発生年が start より前の一時のイベントは計上しない
+func (e *LifeEvent) ExpenseChangesFrom(start time.Time, months int) []float64 {
+	if months <= 0 {
+		return nil
+	}
+
+	changes := make([]float64, months)
+	firstMonth := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	for i := range changes {
+		year := firstMonth.AddDate(0, i, 0).Year()
+		switch e.recurrence {
+		case LifeEventOneTime:
+			if year == e.year {
+				changes[i] = e.amountChange.Amount()
+				return changes
+			}
+		case LifeEventRecurring:
+			if e.IsActiveInYear(year) {
+				changes[i] = e.amountChange.Amount()
+			}
+		}
+	}
+	return changes
+}
+
+// MarshalJSON はLifeEventをJSONにシリアライズする
+func (e *LifeEvent) MarshalJSON() ([]byte, error) {
+	type lifeEventJSON struct {
+		ID            string  `json:"id"`
+		Category      string  `json:"category"`
+		Name          string  `json:"name"`
+		Year          int     `json:"year"`
+		Recurrence    string  `json:"recurrence"`
+		AmountChange  float64 `json:"amount_change"`
+		DurationYears int     `json:"duration_years"`
+		CreatedAt     string  `json:"created_at"`
+		UpdatedAt     string  `json:"updated_at"`
+	}
+	return json.Marshal(lifeEventJSON{
+		ID:            string(e.id),
+		Category:      string(e.category),
+		Name:          e.name,
+		Year:          e.year,
+		Recurrence:    string(e.recurrence),
+		AmountChange:  e.amountChange.Amount(),
+		DurationYears: e.durationYears,
+		CreatedAt:     e.createdAt.Format(time.RFC3339),
+		UpdatedAt:     e.updatedAt.Format(time.RFC3339),
+	})
+}
+
+// LifeEventCollection はライフイベントのコレクション
+type LifeEventCollection []*LifeEvent
+
+// FindByID は指定されたIDのライフイベントを返す（見つからない場合は nil）
+func (lc LifeEventCollection) FindByID(id LifeEventID) *LifeEvent {
+	for _, event := range lc {
+		if event.id == id {
+			return event
+		}
+	}
+	return nil
+}
+
+// ExpenseChangesFrom は start の属する月から months ヶ月分の、全イベントによる月ごとの支出の増減額の合計を返す
+func (lc LifeEventCollection) ExpenseChangesFrom(start time.Time, months int) []float64 {
+	if months <= 0 {
+		return nil
+	}
+
+	total := make([]float64, months)
+	for _, event := range lc {
+		for i, change := range event.ExpenseChangesFrom(start, months) {
+			total[i] += change
+		}
+	}
+	return total
+}
@@ -0,0 +1,304 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreferenceID は通知設定の一意識別子
+type NotificationPreferenceID string
+
+// NewNotificationPreferenceID は新しい通知設定IDを生成する
+func NewNotificationPreferenceID() NotificationPreferenceID {
+	return NotificationPreferenceID(uuid.New().String())
+}
+
+// NotificationEventType は通知対象のイベント種別
+type NotificationEventType string
+
+const (
+	// NotificationEventGoalDeadlineApproaching は目標期限の接近（閾値: 残り日数）
+	NotificationEventGoalDeadlineApproaching NotificationEventType = "goal_deadline_approaching"
+	// NotificationEventGoalAchieved は目標の達成（閾値: 進捗率%）
+	NotificationEventGoalAchieved NotificationEventType = "goal_achieved"
+	// NotificationEventGoalReviewRecommended は目標の見直し推奨（閾値: 必要月間貯蓄額が現在の積立額を超過する割合%）
+	NotificationEventGoalReviewRecommended NotificationEventType = "goal_review_recommended"
+	// NotificationEventGoalOverdue は目標の期限超過と期限超過時ポリシーの処理結果（閾値: 期限超過日数）
+	NotificationEventGoalOverdue NotificationEventType = "goal_overdue"
+)
+
+// IsValid はイベント種別が有効かどうかを判定する
+func (et NotificationEventType) IsValid() bool {
+	switch et {
+	case NotificationEventGoalDeadlineApproaching, NotificationEventGoalAchieved, NotificationEventGoalReviewRecommended, NotificationEventGoalOverdue:
+		return true
+	default:
+		return false
+	}
+}
+
+// DefaultThreshold はイベント種別ごとの閾値の初期値を返す
+func (et NotificationEventType) DefaultThreshold() float64 {
+	switch et {
+	case NotificationEventGoalDeadlineApproaching:
+		return 30 // 残り30日
+	case NotificationEventGoalAchieved:
+		return 100 // 進捗率100%
+	case NotificationEventGoalReviewRecommended:
+		return 20 // 必要額が積立額を20%超過
+	case NotificationEventGoalOverdue:
+		return 0 // 期限を過ぎたらすぐに通知
+	default:
+		return 0
+	}
+}
+
+// NotificationChannelType は通知チャネルの種別
+type NotificationChannelType string
+
+const (
+	NotificationChannelEmail   NotificationChannelType = "email"
+	NotificationChannelPush    NotificationChannelType = "push"
+	NotificationChannelWebhook NotificationChannelType = "webhook"
+)
+
+// IsValid はチャネル種別が有効かどうかを判定する
+func (ct NotificationChannelType) IsValid() bool {
+	switch ct {
+	case NotificationChannelEmail, NotificationChannelPush, NotificationChannelWebhook:
+		return true
+	default:
+		return false
+	}
+}
+
+// NotificationPreference はイベント種別ごとの通知チャネル設定を表すエンティティ
+type NotificationPreference struct {
+	id          NotificationPreferenceID
+	userID      UserID
+	eventType   NotificationEventType
+	channel     NotificationChannelType
+	enabled     bool
+	threshold   float64
+	destination string // webhookの送信先URLなど（emailの場合は空ならユーザーのメールアドレス）
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// NewNotificationPreference は新しい通知設定を作成する
+func NewNotificationPreference(
+	userID UserID,
+	eventType NotificationEventType,
+	channel NotificationChannelType,
+	enabled bool,
+	threshold float64,
+	destination string,
+) (*NotificationPreference, error) {
+	if err := validateNotificationPreference(userID, eventType, channel, threshold, destination); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &NotificationPreference{
+		id:          NewNotificationPreferenceID(),
+		userID:      userID,
+		eventType:   eventType,
+		channel:     channel,
+		enabled:     enabled,
+		threshold:   threshold,
+		destination: destination,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// NewNotificationPreferenceWithID は既存IDで通知設定を再構築する
+func NewNotificationPreferenceWithID(
+	id NotificationPreferenceID,
+	userID UserID,
+	eventType NotificationEventType,
+	channel NotificationChannelType,
+	enabled bool,
+	threshold float64,
+	destination string,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*NotificationPreference, error) {
+	if id == "" {
+		return nil, errors.New("通知設定IDは必須です")
+	}
+
+	if err := validateNotificationPreference(userID, eventType, channel, threshold, destination); err != nil {
+		return nil, err
+	}
+
+	return &NotificationPreference{
+		id:          id,
+		userID:      userID,
+		eventType:   eventType,
+		channel:     channel,
+		enabled:     enabled,
+		threshold:   threshold,
+		destination: destination,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}, nil
+}
+
+// validateNotificationPreference は通知設定の入力値を検証する
+func validateNotificationPreference(
+	userID UserID,
+	eventType NotificationEventType,
+	channel NotificationChannelType,
+	threshold float64,
+	destination string,
+) error {
+	if userID == "" {
+		return errors.New("ユーザーIDは必須です")
+	}
+
+	if !eventType.IsValid() {
+		return fmt.Errorf("無効な通知イベント種別です: %s", eventType)
+	}
+
+	if !channel.IsValid() {
+		return fmt.Errorf("無効な通知チャネルです: %s", channel)
+	}
+
+	switch eventType {
+	case NotificationEventGoalDeadlineApproaching:
+		if threshold < 1 || threshold > 365 {
+			return errors.New("期限接近の閾値は1日から365日の範囲で設定してください")
+		}
+	case NotificationEventGoalAchieved:
+		if threshold <= 0 || threshold > 100 {
+			return errors.New("達成通知の閾値は0%より大きく100%以下で設定してください")
+		}
+	case NotificationEventGoalReviewRecommended:
+		if threshold < 0 || threshold > 1000 {
+			return errors.New("見直し推奨の閾値は0%から1000%の範囲で設定してください")
+		}
+	case NotificationEventGoalOverdue:
+		if threshold < 0 || threshold > 365 {
+			return errors.New("期限超過の閾値は0日から365日の範囲で設定してください")
+		}
+	}
+
+	if channel == NotificationChannelWebhook {
+		if destination == "" {
+			return errors.New("Webhookの送信先URLは必須です")
+		}
+		u, err := url.Parse(destination)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return errors.New("Webhookの送信先URLの形式が正しくありません")
+		}
+	}
+
+	return nil
+}
+
+// ID は通知設定IDを返す
+func (p *NotificationPreference) ID() NotificationPreferenceID {
+	return p.id
+}
+
+// UserID はユーザーIDを返す
+func (p *NotificationPreference) UserID() UserID {
+	return p.userID
+}
+
+// EventType はイベント種別を返す
+func (p *NotificationPreference) EventType() NotificationEventType {
+	return p.eventType
+}
+
+// Channel はチャネル種別を返す
+func (p *NotificationPreference) Channel() NotificationChannelType {
+	return p.channel
+}
+
+// IsEnabled は通知が有効かどうかを返す
+func (p *NotificationPreference) IsEnabled() bool {
+	return p.enabled
+}
+
+// Threshold は通知の閾値を返す
+func (p *NotificationPreference) Threshold() float64 {
+	return p.threshold
+}
+
+// Destination は送信先を返す
+func (p *NotificationPreference) Destination() string {
+	return p.destination
+}
+
+// CreatedAt は作成日時を返す
+func (p *NotificationPreference) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+// UpdatedAt は更新日時を返す
+func (p *NotificationPreference) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+// ShouldNotify はイベントの測定値が閾値を満たし通知すべきかどうかを判定する
+// 期限接近は残り日数が閾値以下、達成・見直し推奨・期限超過は値が閾値以上のときに通知する
+func (p *NotificationPreference) ShouldNotify(value float64) bool {
+	if !p.enabled {
+		return false
+	}
+
+	switch p.eventType {
+	case NotificationEventGoalDeadlineApproaching:
+		return value <= p.threshold
+	case NotificationEventGoalAchieved, NotificationEventGoalReviewRecommended, NotificationEventGoalOverdue:
+		return value >= p.threshold
+	default:
+		return false
+	}
+}
+
+// Update は有効/無効・閾値・送信先を更新する
+func (p *NotificationPreference) Update(enabled bool, threshold float64, destination string) error {
+	if err := validateNotificationPreference(p.userID, p.eventType, p.channel, threshold, destination); err != nil {
+		return err
+	}
+
+	p.enabled = enabled
+	p.threshold = threshold
+	p.destination = destination
+	p.updatedAt = time.Now()
+	return nil
+}
+
+// MarshalJSON はNotificationPreferenceをJSONにシリアライズする
+func (p *NotificationPreference) MarshalJSON() ([]byte, error) {
+	type notificationPreferenceJSON struct {
+		ID          string  `json:"id"`
+		UserID      string  `json:"user_id"`
+		EventType   string  `json:"event_type"`
+		Channel     string  `json:"channel"`
+		Enabled     bool    `json:"enabled"`
+		Threshold   float64 `json:"threshold"`
+		Destination string  `json:"destination,omitempty"`
+		CreatedAt   string  `json:"created_at"`
+		UpdatedAt   string  `json:"updated_at"`
+	}
+	return json.Marshal(notificationPreferenceJSON{
+		ID:          string(p.id),
+		UserID:      string(p.userID),
+		EventType:   string(p.eventType),
+		Channel:     string(p.channel),
+		Enabled:     p.enabled,
+		Threshold:   p.threshold,
+		Destination: p.destination,
+		CreatedAt:   p.createdAt.Format(time.RFC3339),
+		UpdatedAt:   p.updatedAt.Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,39 @@
+package entities
+
+import "time"
+
+// PeerBenchmarkMetric は同年代比較の対象とする指標
+type PeerBenchmarkMetric string
+
+const (
+	PeerMetricSavingsRate         PeerBenchmarkMetric = "savings_rate"          // 貯蓄率（%）
+	PeerMetricEmergencyFundMonths PeerBenchmarkMetric = "emergency_fund_months" // 緊急資金が生活費の何ヶ月分か
+	PeerMetricTotalAssets         PeerBenchmarkMetric = "total_assets"          // 総資産（円）
+)
+
+// PeerBenchmarkMetrics は同年代比較の対象とする指標（表示順）
+var PeerBenchmarkMetrics = []PeerBenchmarkMetric{
+	PeerMetricSavingsRate,
+	PeerMetricEmergencyFundMonths,
+	PeerMetricTotalAssets,
+}
+
+// PeerSegment は同年代比較の区分（年代と年収帯）
+type PeerSegment struct {
+	AgeBand    string `json:"age_band"`    // 例: 30s
+	IncomeBand string `json:"income_band"` // 例: 5m_7m（年収500万円以上700万円未満）
+}
+
+// PeerBenchmark は区分・指標ごとの匿名集計の結果
+// 個人を特定できないよう、集計人数とパーセンタイルのみを保持する
+type PeerBenchmark struct {
+	Segment      PeerSegment
+	Metric       PeerBenchmarkMetric
+	SampleSize   int
+	P10          float64
+	P25          float64
+	Median       float64
+	P75          float64
+	P90          float64
+	AggregatedAt time.Time
+}
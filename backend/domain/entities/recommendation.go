@@ -0,0 +1,198 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// RecommendationEscalationThreshold は却下後に再表示する重要度の悪化幅（ポイント）
+// 却下時点からこの値を超えて重要度が悪化した推奨事項は、却下済みでも再表示する
+const RecommendationEscalationThreshold = 10.0
+
+// RecommendationID は推奨事項の識別子
+// 種別と主要パラメータから決定的に生成するため、再生成しても同じ推奨事項は同じIDになる
+type RecommendationID string
+
+// String はRecommendationIDの文字列表現を返す
+func (id RecommendationID) String() string {
+	return string(id)
+}
+
+// RecommendationType は推奨事項の種別
+type RecommendationType string
+
+const (
+	// RecommendationTypeLowSavingsRate は貯蓄率が低い（重要度: 10%に対する不足ポイント）
+	RecommendationTypeLowSavingsRate RecommendationType = "low_savings_rate"
+	// RecommendationTypeHighSavingsRate は貯蓄率が高く投資の多様化を勧める
+	RecommendationTypeHighSavingsRate RecommendationType = "high_savings_rate"
+	// RecommendationTypeEmergencyFundShortfall は緊急資金の不足（重要度: 3ヶ月分に対する不足率%）
+	RecommendationTypeEmergencyFundShortfall RecommendationType = "emergency_fund_shortfall"
+	// RecommendationTypeLowInvestmentReturn は投資利回りが低い
+	RecommendationTypeLowInvestmentReturn RecommendationType = "low_investment_return"
+	// RecommendationTypeSavingsRateBelowTarget は貯蓄率が目標を下回っている（重要度: 目標との差のポイント）
+	RecommendationTypeSavingsRateBelowTarget RecommendationType = "savings_rate_below_target"
+	// RecommendationTypeStaleFinancialData は財務データが長期間見直されていない（重要度: 古いとみなす月数を超えた月数）
+	RecommendationTypeStaleFinancialData RecommendationType = "stale_financial_data"
+)
+
+// RecommendationKind は推奨事項の区分
+type RecommendationKind string
+
+const (
+	RecommendationKindRecommendation RecommendationKind = "recommendation" // 推奨事項
+	RecommendationKindWarning        RecommendationKind = "warning"        // 警告
+)
+
+// Recommendation は財務状況から生成される推奨事項・警告を表す
+type Recommendation struct {
+	ID       RecommendationID   `json:"id"`
+	Type     RecommendationType `json:"type"`
+	Kind     RecommendationKind `json:"kind"`
+	Message  string             `json:"message"`
+	Severity float64            `json:"severity"`            // 重要度（種別ごとの不足ポイント。大きいほど深刻）
+	ReRaised bool               `json:"re_raised,omitempty"` // 却下後に重要度が悪化して再表示されたか
+}
+
+// NewRecommendationID は種別・区分と主要パラメータのハッシュから推奨事項IDを生成する
+// 重要度のように変動する値はパラメータに含めないこと（IDが変わり却下状態が失われるため）
+func NewRecommendationID(recType RecommendationType, kind RecommendationKind, params ...string) RecommendationID {
+	parts := append([]string{string(recType), string(kind)}, params...)
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return RecommendationID(hex.EncodeToString(sum[:8]))
+}
+
+// NewRecommendation は決定的なIDを持つ推奨事項を作成する
+func NewRecommendation(
+	recType RecommendationType,
+	kind RecommendationKind,
+	message string,
+	severity float64,
+	params ...string,
+) Recommendation {
+	return Recommendation{
+		ID:       NewRecommendationID(recType, kind, params...),
+		Type:     recType,
+		Kind:     kind,
+		Message:  message,
+		Severity: severity,
+	}
+}
+
+// RecommendationDismissal はユーザーによる推奨事項の却下を表すエンティティ
+type RecommendationDismissal struct {
+	userID              UserID
+	recommendationID    RecommendationID
+	severityAtDismissal float64
+	dismissedAt         time.Time
+	snoozeUntil         *time.Time
+}
+
+// NewRecommendationDismissal は推奨事項の却下を作成する
+// snoozeUntil を指定した場合はその日時まで非表示にし、以降は再表示する（nil の場合は無期限）
+func NewRecommendationDismissal(
+	userID UserID,
+	recommendation Recommendation,
+	dismissedAt time.Time,
+	snoozeUntil *time.Time,
+) (*RecommendationDismissal, error) {
+	if userID == "" {
+		return nil, errors.New("ユーザーIDは必須です")
+	}
+	if recommendation.ID == "" {
+		return nil, errors.New("推奨事項IDは必須です")
+	}
+	if snoozeUntil != nil && !snoozeUntil.After(dismissedAt) {
+		return nil, errors.New("スヌーズ期限は現在より後の日時を指定してください")
+	}
+
+	return &RecommendationDismissal{
+		userID:              userID,
+		recommendationID:    recommendation.ID,
+		severityAtDismissal: recommendation.Severity,
+		dismissedAt:         dismissedAt,
+		snoozeUntil:         snoozeUntil,
+	}, nil
+}
+
+// ReconstructRecommendationDismissal は既存のデータから却下を再構築する（リポジトリからの取得用）
+func ReconstructRecommendationDismissal(
+	userID UserID,
+	recommendationID RecommendationID,
+	severityAtDismissal float64,
+	dismissedAt time.Time,
+	snoozeUntil *time.Time,
+) *RecommendationDismissal {
+	return &RecommendationDismissal{
+		userID:              userID,
+		recommendationID:    recommendationID,
+		severityAtDismissal: severityAtDismissal,
+		dismissedAt:         dismissedAt,
+		snoozeUntil:         snoozeUntil,
+	}
+}
+
+// UserID はユーザーIDを返す
+func (d *RecommendationDismissal) UserID() UserID {
+	return d.userID
+}
+
+// RecommendationID は却下した推奨事項のIDを返す
+func (d *RecommendationDismissal) RecommendationID() RecommendationID {
+	return d.recommendationID
+}
+
+// SeverityAtDismissal は却下時点の重要度を返す
+func (d *RecommendationDismissal) SeverityAtDismissal() float64 {
+	return d.severityAtDismissal
+}
+
+// DismissedAt は却下日時を返す
+func (d *RecommendationDismissal) DismissedAt() time.Time {
+	return d.dismissedAt
+}
+
+// SnoozeUntil はスヌーズ期限を返す（無期限の場合はnil）
+func (d *RecommendationDismissal) SnoozeUntil() *time.Time {
+	return d.snoozeUntil
+}
+
+// IsSnoozeExpired はスヌーズ期限を過ぎたかどうかを返す
+func (d *RecommendationDismissal) IsSnoozeExpired(now time.Time) bool {
+	return d.snoozeUntil != nil && !now.Before(*d.snoozeUntil)
+}
+
+// IsEscalated は却下時点から重要度が閾値を超えて悪化したかどうかを返す
+func (d *RecommendationDismissal) IsEscalated(recommendation Recommendation) bool {
+	return recommendation.Severity-d.severityAtDismissal > RecommendationEscalationThreshold
+}
+
+// FilterDismissedRecommendations は却下済みの推奨事項を取り除く
+// スヌーズ期限を過ぎたものは再表示し、重要度が悪化したものは ReRaised を立てて再表示する
+func FilterDismissedRecommendations(
+	recommendations []Recommendation,
+	dismissals []*RecommendationDismissal,
+	now time.Time,
+) []Recommendation {
+	dismissalsByID := make(map[RecommendationID]*RecommendationDismissal, len(dismissals))
+	for _, dismissal := range dismissals {
+		dismissalsByID[dismissal.RecommendationID()] = dismissal
+	}
+
+	filtered := make([]Recommendation, 0, len(recommendations))
+	for _, recommendation := range recommendations {
+		dismissal, ok := dismissalsByID[recommendation.ID]
+		switch {
+		case !ok, dismissal.IsSnoozeExpired(now):
+			filtered = append(filtered, recommendation)
+		case dismissal.IsEscalated(recommendation):
+			recommendation.ReRaised = true
+			filtered = append(filtered, recommendation)
+		}
+	}
+
+	return filtered
+}
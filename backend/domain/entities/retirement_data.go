@@ -18,6 +18,13 @@ func NewRetirementDataID() RetirementDataID {
 	return RetirementDataID(uuid.New().String())
 }
 
+// KokuminNenkinMonthlyAmountPerContributionYear は国民年金（老齢基礎年金）の納付1年あたりの月額（円）
+// 満額（40年納付）で月額約6.6万円となる
+const KokuminNenkinMonthlyAmountPerContributionYear = 1657
+
+// MaxKokuminNenkinContributionYears は国民年金の納付年数の上限（20歳から60歳までの40年）
+const MaxKokuminNenkinContributionYears = 40
+
 // RetirementCalculation は老後資金計算結果を表す
 type RetirementCalculation struct {
 	RequiredAmount            valueobjects.Money `json:"required_amount"`             // 必要老後資金
@@ -35,11 +42,17 @@ type RetirementData struct {
 	retirementAge             int
 	lifeExpectancy            int
 	monthlyRetirementExpenses valueobjects.Money
-	pensionAmount             valueobjects.Money
+	pensionAmount             valueobjects.Money // 厚生年金などの年金額（月額）
+	kokuminNenkinMonthly      valueobjects.Money // 国民年金（老齢基礎年金）の月額
+	nurseCareInsuranceMonthly valueobjects.Money // 介護保険料などの退職後の月間負担
+	lastAgeUpdateDate         *time.Time         // UpdateCurrentAge で現在の年齢を最後に更新した日時（未更新の場合は nil）
 	createdAt                 time.Time
 	updatedAt                 time.Time
 }
 
+// ErrInvalidCurrentAge は現在の年齢の更新内容が退職データと矛盾する場合のエラー
+var ErrInvalidCurrentAge = errors.New("現在の年齢が不正です")
+
 // NewRetirementData は新しい退職データを作成する
 func NewRetirementData(
 	userID UserID,
@@ -82,6 +95,7 @@ func NewRetirementData(
 	}
 
 	now := time.Now()
+	zero, _ := valueobjects.NewMoneyJPY(0)
 
 	return &RetirementData{
 		id:                        NewRetirementDataID(),
@@ -91,6 +105,8 @@ func NewRetirementData(
 		lifeExpectancy:            lifeExpectancy,
 		monthlyRetirementExpenses: monthlyRetirementExpenses,
 		pensionAmount:             pensionAmount,
+		kokuminNenkinMonthly:      zero,
+		nurseCareInsuranceMonthly: zero,
 		createdAt:                 now,
 		updatedAt:                 now,
 	}, nil
@@ -113,6 +129,7 @@ func NewRetirementDataWithID(
 	if userID == "" {
 		return nil, errors.New("ユーザーIDは必須です")
 	}
+	zero, _ := valueobjects.NewMoneyJPY(0)
 	return &RetirementData{
 		id:                        id,
 		userID:                    userID,
@@ -121,6 +138,8 @@ func NewRetirementDataWithID(
 		lifeExpectancy:            lifeExpectancy,
 		monthlyRetirementExpenses: monthlyRetirementExpenses,
 		pensionAmount:             pensionAmount,
+		kokuminNenkinMonthly:      zero,
+		nurseCareInsuranceMonthly: zero,
 		createdAt:                 createdAt,
 		updatedAt:                 updatedAt,
 	}, nil
@@ -137,6 +156,7 @@ func (rd *RetirementData) UserID() UserID {
 }
 
 // CurrentAge は現在の年齢を返す
+// 財務計画に含まれる場合、プロファイルに生年月日があればその年齢と同期される
 func (rd *RetirementData) CurrentAge() int {
 	return rd.currentAge
 }
@@ -161,6 +181,25 @@ func (rd *RetirementData) PensionAmount() valueobjects.Money {
 	return rd.pensionAmount
 }
 
+// KokuminNenkinMonthlyAmount は国民年金（老齢基礎年金）の月額を返す
+func (rd *RetirementData) KokuminNenkinMonthlyAmount() valueobjects.Money {
+	return rd.kokuminNenkinMonthly
+}
+
+// NurseCareInsuranceMonthly は介護保険料などの退職後の月間負担を返す
+func (rd *RetirementData) NurseCareInsuranceMonthly() valueobjects.Money {
+	return rd.nurseCareInsuranceMonthly
+}
+
+// TotalMonthlyBenefits は退職後に受け取る公的給付の月額合計（年金額＋国民年金）を返す
+func (rd *RetirementData) TotalMonthlyBenefits() (valueobjects.Money, error) {
+	total, err := rd.pensionAmount.Add(rd.kokuminNenkinMonthly)
+	if err != nil {
+		return valueobjects.Money{}, fmt.Errorf("公的給付の合計の計算に失敗しました: %w", err)
+	}
+	return total, nil
+}
+
 // CreatedAt は作成日時を返す
 func (rd *RetirementData) CreatedAt() time.Time {
 	return rd.createdAt
@@ -190,40 +229,31 @@ func (rd *RetirementData) CalculateRetirementYears() int {
 }
 
 // CalculateRequiredRetirementFund は必要な老後資金を計算する
+// 月間支出（介護保険料を含む）から公的給付を差し引いた不足額をもとに valueobjects.RetirementRequirement で計算する
 func (rd *RetirementData) CalculateRequiredRetirementFund(inflationRate valueobjects.Rate) (valueobjects.Money, error) {
-	retirementYears := rd.CalculateRetirementYears()
-	if retirementYears <= 0 {
-		return valueobjects.NewMoneyJPY(0)
-	}
-
-	// 年金で不足する月額を計算
-	monthlyShortfall, err := rd.monthlyRetirementExpenses.Subtract(rd.pensionAmount)
+	benefits, err := rd.TotalMonthlyBenefits()
 	if err != nil {
 		return valueobjects.Money{}, fmt.Errorf("月間不足額の計算に失敗しました: %w", err)
 	}
+	return rd.CalculateRequiredRetirementFundWithBenefits(benefits, inflationRate)
+}
 
-	// 年金で足りている場合は0を返す
-	if monthlyShortfall.IsNegative() || monthlyShortfall.IsZero() {
-		return valueobjects.NewMoneyJPY(0)
-	}
-
-	// 退職時点でのインフレ調整
-	yearsUntilRetirement := rd.CalculateYearsUntilRetirement()
-	inflationFactor := inflationRate.CompoundFactor(yearsUntilRetirement)
-
-	adjustedMonthlyShortfall, err := monthlyShortfall.MultiplyByFloat(inflationFactor)
-	if err != nil {
-		return valueobjects.Money{}, fmt.Errorf("インフレ調整に失敗しました: %w", err)
-	}
-
-	// 退職後の総必要額を計算（月額 × 12ヶ月 × 退職後年数）
-	totalMonths := retirementYears * 12
-	requiredFund, err := adjustedMonthlyShortfall.MultiplyByFloat(float64(totalMonths))
+// CalculateRequiredRetirementFundWithBenefits は公的給付の月額を指定して必要な老後資金を計算する
+// 税・社会保険料を差し引いた手取りの給付額で必要資金を求める場合に使う
+func (rd *RetirementData) CalculateRequiredRetirementFundWithBenefits(benefits valueobjects.Money, inflationRate valueobjects.Rate) (valueobjects.Money, error) {
+	expenses, err := rd.monthlyRetirementExpenses.Add(rd.nurseCareInsuranceMonthly)
 	if err != nil {
-		return valueobjects.Money{}, fmt.Errorf("必要老後資金の計算に失敗しました: %w", err)
+		return valueobjects.Money{}, fmt.Errorf("月間不足額の計算に失敗しました: %w", err)
 	}
 
-	return requiredFund, nil
+	requiredFund := valueobjects.RetirementRequirement(
+		expenses.Amount(),
+		benefits.Amount(),
+		rd.CalculateYearsUntilRetirement(),
+		rd.CalculateRetirementYears(),
+		inflationRate.AsPercentage(),
+	)
+	return valueobjects.NewMoney(requiredFund, expenses.Currency())
 }
 
 // CalculateRetirementSufficiency は老後資金の充足度を計算する
@@ -289,6 +319,7 @@ func (rd *RetirementData) CalculateRetirementSufficiency(
 }
 
 // calculateProjectedAssets は退職時点での予想資産額を計算する
+// FinancialProfile.ProjectAssetsFrom と同じく、年利と同値の月利で毎月複利計算し月末に積み立てる
 func (rd *RetirementData) calculateProjectedAssets(
 	currentSavings valueobjects.Money,
 	monthlySavings valueobjects.Money,
@@ -299,11 +330,8 @@ func (rd *RetirementData) calculateProjectedAssets(
 		return currentSavings, nil
 	}
 
-	// 月利を計算
-	monthlyRate, err := investmentReturn.MonthlyRate()
-	if err != nil {
-		return valueobjects.Money{}, fmt.Errorf("月利の計算に失敗しました: %w", err)
-	}
+	// 月利を計算（長期の複利で誤差が広がらないよう丸めずに使う）
+	monthlyRate := investmentReturn.MonthlyDecimal()
 
 	currentAssets := currentSavings
 	totalMonths := years * 12
@@ -311,7 +339,7 @@ func (rd *RetirementData) calculateProjectedAssets(
 	// 複利計算
 	for month := 0; month < totalMonths; month++ {
 		// 投資収益を加算
-		investmentGain, err := currentAssets.Multiply(monthlyRate)
+		investmentGain, err := currentAssets.MultiplyByFloat(monthlyRate)
 		if err != nil {
 			return valueobjects.Money{}, fmt.Errorf("投資収益の計算に失敗しました: %w", err)
 		}
@@ -332,63 +360,67 @@ func (rd *RetirementData) calculateProjectedAssets(
 }
 
 // calculateRecommendedMonthlySavings は推奨月間貯蓄額を計算する
+// calculateProjectedAssets と同じ前提（月次複利・月末積立）で積み立てた場合にちょうど必要額に届く月額を
+// valueobjects.RequiredMonthlyContribution で求める。退職まで時間がない場合は不足額をそのまま返す
 func (rd *RetirementData) calculateRecommendedMonthlySavings(
 	currentSavings valueobjects.Money,
 	requiredAmount valueobjects.Money,
 	investmentReturn valueobjects.Rate,
 	years int,
 ) (valueobjects.Money, error) {
-	if years <= 0 {
-		// 退職まで時間がない場合は、不足額をそのまま返す
-		shortfall, err := requiredAmount.Subtract(currentSavings)
-		if err != nil {
-			return valueobjects.Money{}, err
-		}
-		if shortfall.IsNegative() {
-			return valueobjects.NewMoneyJPY(0)
-		}
-		return shortfall, nil
+	recommendedMonthlySavings := valueobjects.RequiredMonthlyContribution(
+		requiredAmount.Amount(),
+		currentSavings.Amount(),
+		investmentReturn.AsPercentage(),
+		years*12,
+	)
+	return valueobjects.NewMoney(recommendedMonthlySavings, requiredAmount.Currency())
+}
+
+// UpdateCurrentAge は誕生日を迎えたときなどに現在の年齢を更新し、更新日時を記録する
+// 年齢は前回の値から減らせず、退職年齢・平均寿命より小さい必要がある
+func (rd *RetirementData) UpdateCurrentAge(newAge int) error {
+	if newAge < 0 {
+		return fmt.Errorf("%w: 年齢は0歳以上である必要があります", ErrInvalidCurrentAge)
 	}
 
-	// 現在の資産が投資収益のみで成長した場合の将来価値
-	compoundFactor := investmentReturn.CompoundFactor(years)
-	futureValueOfCurrentSavings, err := currentSavings.MultiplyByFloat(compoundFactor)
-	if err != nil {
-		// 投資収益なしの場合
-		futureValueOfCurrentSavings = currentSavings
+	if newAge < rd.currentAge {
+		return fmt.Errorf("%w: 現在の年齢（%d歳）より小さい年齢には更新できません", ErrInvalidCurrentAge, rd.currentAge)
 	}
 
-	// 必要な追加資金
-	additionalRequired, err := requiredAmount.Subtract(futureValueOfCurrentSavings)
-	if err != nil {
-		return valueobjects.Money{}, fmt.Errorf("追加必要資金の計算に失敗しました: %w", err)
+	if newAge >= rd.retirementAge {
+		return fmt.Errorf("%w: 現在の年齢は退職年齢（%d歳）未満である必要があります", ErrInvalidCurrentAge, rd.retirementAge)
 	}
 
-	// 既に十分な資産がある場合
-	if additionalRequired.IsNegative() || additionalRequired.IsZero() {
-		return valueobjects.NewMoneyJPY(0)
+	if newAge >= rd.lifeExpectancy {
+		return fmt.Errorf("%w: 現在の年齢は平均寿命（%d歳）未満である必要があります", ErrInvalidCurrentAge, rd.lifeExpectancy)
 	}
 
-	// 年金現価係数を使用して月間貯蓄額を計算
-	// 簡略化のため、単純に期間で割る
-	totalMonths := years * 12
-	recommendedMonthlySavings := additionalRequired.Amount() / float64(totalMonths)
+	now := time.Now()
+	rd.currentAge = newAge
+	rd.lastAgeUpdateDate = &now
+	rd.updatedAt = now
+	return nil
+}
 
-	return valueobjects.NewMoneyJPY(recommendedMonthlySavings)
+// LastAgeUpdateDate は UpdateCurrentAge で現在の年齢を最後に更新した日時を返す（未更新の場合は nil）
+func (rd *RetirementData) LastAgeUpdateDate() *time.Time {
+	return rd.lastAgeUpdateDate
 }
 
-// UpdateCurrentAge は現在の年齢を更新する
-func (rd *RetirementData) UpdateCurrentAge(newAge int) error {
-	if newAge < 0 || newAge > 150 {
-		return errors.New("年齢は0歳から150歳の間である必要があります")
-	}
+// RestoreLastAgeUpdateDate は保存済みの年齢の更新日時を復元する（リポジトリでの復元用）
+func (rd *RetirementData) RestoreLastAgeUpdateDate(date *time.Time) {
+	rd.lastAgeUpdateDate = date
+}
 
-	if newAge > rd.retirementAge {
-		return errors.New("現在の年齢は退職年齢以下である必要があります")
+// SyncCurrentAge は財務プロファイルの生年月日から求めた年齢を反映する
+// 年齢の正は財務プロファイルのため、退職年齢を過ぎた年齢も受け付け、更新日時は変更しない
+func (rd *RetirementData) SyncCurrentAge(age int) error {
+	if age < 0 || age > 150 {
+		return errors.New("年齢は0歳から150歳の間である必要があります")
 	}
 
-	rd.currentAge = newAge
-	rd.updatedAt = time.Now()
+	rd.currentAge = age
 	return nil
 }
 
@@ -448,14 +480,61 @@ func (rd *RetirementData) UpdatePensionAmount(newAmount valueobjects.Money) erro
 	return nil
 }
 
+// UpdateKokuminNenkinMonthlyAmount は国民年金（老齢基礎年金）の月額を更新する
+func (rd *RetirementData) UpdateKokuminNenkinMonthlyAmount(newAmount valueobjects.Money) error {
+	if newAmount.IsNegative() {
+		return errors.New("国民年金の月額は負の値にできません")
+	}
+
+	rd.kokuminNenkinMonthly = newAmount
+	rd.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateNurseCareInsuranceMonthly は介護保険料などの退職後の月間負担を更新する
+func (rd *RetirementData) UpdateNurseCareInsuranceMonthly(newAmount valueobjects.Money) error {
+	if newAmount.IsNegative() {
+		return errors.New("介護保険料の月額は負の値にできません")
+	}
+
+	rd.nurseCareInsuranceMonthly = newAmount
+	rd.updatedAt = time.Now()
+	return nil
+}
+
+// RestorePublicBenefits は保存済みの国民年金の月額と介護保険料の月額を復元する（リポジトリでの復元用）
+// 更新日時は変更しない
+func (rd *RetirementData) RestorePublicBenefits(kokuminNenkinMonthly, nurseCareInsuranceMonthly valueobjects.Money) error {
+	if kokuminNenkinMonthly.IsNegative() || nurseCareInsuranceMonthly.IsNegative() {
+		return errors.New("国民年金・介護保険料の月額は負の値にできません")
+	}
+
+	rd.kokuminNenkinMonthly = kokuminNenkinMonthly
+	rd.nurseCareInsuranceMonthly = nurseCareInsuranceMonthly
+	return nil
+}
+
+// EstimateKokuminNenkinMonthlyAmount は納付年数から国民年金（老齢基礎年金）の月額を推定する
+// 納付1年あたり KokuminNenkinMonthlyAmountPerContributionYear 円とし、納付年数は40年を上限とする
+func EstimateKokuminNenkinMonthlyAmount(contributionYears int) (valueobjects.Money, error) {
+	if contributionYears < 0 {
+		return valueobjects.Money{}, errors.New("国民年金の納付年数は負の値にできません")
+	}
+	if contributionYears > MaxKokuminNenkinContributionYears {
+		contributionYears = MaxKokuminNenkinContributionYears
+	}
+	return valueobjects.NewMoneyJPY(float64(contributionYears * KokuminNenkinMonthlyAmountPerContributionYear))
+}
+
 // IsRetired は現在退職しているかどうかを返す
 func (rd *RetirementData) IsRetired() bool {
 	return rd.currentAge >= rd.retirementAge
 }
 
-// GetPensionShortfall は年金の不足額を返す
+// GetPensionShortfall は公的給付で賄えない月間の不足額を返す
+// 不足額 = 月間退職後支出 − (年金額 + 国民年金) + 介護保険料
 func (rd *RetirementData) GetPensionShortfall() (valueobjects.Money, error) {
-	shortfall, err := rd.monthlyRetirementExpenses.Subtract(rd.pensionAmount)
+	shortfall, err := rd.monthlyShortfall()
 	if err != nil {
 		return valueobjects.Money{}, fmt.Errorf("年金不足額の計算に失敗しました: %w", err)
 	}
@@ -468,6 +547,19 @@ func (rd *RetirementData) GetPensionShortfall() (valueobjects.Money, error) {
 	return shortfall, nil
 }
 
+// monthlyShortfall は月間退職後支出と介護保険料から公的給付を差し引いた月額を返す（負の値の場合は余剰）
+func (rd *RetirementData) monthlyShortfall() (valueobjects.Money, error) {
+	benefits, err := rd.TotalMonthlyBenefits()
+	if err != nil {
+		return valueobjects.Money{}, err
+	}
+	expenses, err := rd.monthlyRetirementExpenses.Add(rd.nurseCareInsuranceMonthly)
+	if err != nil {
+		return valueobjects.Money{}, err
+	}
+	return expenses.Subtract(benefits)
+}
+
 // IsPensionSufficient は年金が十分かどうかを返す
 func (rd *RetirementData) IsPensionSufficient() (bool, error) {
 	shortfall, err := rd.GetPensionShortfall()
@@ -0,0 +1,125 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// RetirementLifestyle は退職後の生活水準
+type RetirementLifestyle string
+
+const (
+	// RetirementLifestyleComfortable はゆとりある生活
+	RetirementLifestyleComfortable RetirementLifestyle = "comfortable"
+	// RetirementLifestyleStandard は標準的な生活
+	RetirementLifestyleStandard RetirementLifestyle = "standard"
+	// RetirementLifestyleMinimum は最低限の生活
+	RetirementLifestyleMinimum RetirementLifestyle = "minimum"
+)
+
+// RetirementLifestyles は生活水準の一覧（生活費の高い順）
+var RetirementLifestyles = []RetirementLifestyle{
+	RetirementLifestyleComfortable,
+	RetirementLifestyleStandard,
+	RetirementLifestyleMinimum,
+}
+
+// defaultLifestyleMonthlyExpenses は生活水準ごとの夫婦2人の月間生活費の目安（円）
+//   - ゆとりある生活: 生命保険文化センター「生活保障に関する調査」（2022年度）のゆとりある老後生活費 37.9万円
+//   - 標準的な生活: 総務省「家計調査」（2023年）の65歳以上の夫婦のみの無職世帯の消費支出 約25.1万円
+//   - 最低限の生活: 生命保険文化センター「生活保障に関する調査」（2022年度）の最低日常生活費 23.2万円
+var defaultLifestyleMonthlyExpenses = map[RetirementLifestyle]float64{
+	RetirementLifestyleComfortable: 379000,
+	RetirementLifestyleStandard:    251000,
+	RetirementLifestyleMinimum:     232000,
+}
+
+// IsValid は生活水準が有効かどうかを返す
+func (l RetirementLifestyle) IsValid() bool {
+	_, ok := defaultLifestyleMonthlyExpenses[l]
+	return ok
+}
+
+// Label は生活水準の表示名を返す
+func (l RetirementLifestyle) Label() string {
+	switch l {
+	case RetirementLifestyleComfortable:
+		return "ゆとりある生活"
+	case RetirementLifestyleStandard:
+		return "標準的な生活"
+	case RetirementLifestyleMinimum:
+		return "最低限の生活"
+	default:
+		return string(l)
+	}
+}
+
+// DefaultMonthlyExpenses は生活水準の月間生活費の目安を返す
+func (l RetirementLifestyle) DefaultMonthlyExpenses() (valueobjects.Money, error) {
+	amount, ok := defaultLifestyleMonthlyExpenses[l]
+	if !ok {
+		return valueobjects.Money{}, fmt.Errorf("無効な生活水準です: %s", l)
+	}
+	return valueobjects.NewMoneyJPY(amount)
+}
+
+// LifestyleRetirementCalculation は生活水準ごとの老後資金計算結果を表す
+type LifestyleRetirementCalculation struct {
+	Lifestyle       RetirementLifestyle    `json:"lifestyle"`
+	Label           string                 `json:"label"`
+	MonthlyExpenses valueobjects.Money     `json:"monthly_expenses"` // 想定した月間退職後支出
+	IsCustomized    bool                   `json:"is_customized"`    // ユーザーが月間生活費を上書きしたか
+	Calculation     *RetirementCalculation `json:"calculation"`
+}
+
+// CalculateRetirementByLifestyle は生活水準（ゆとり・標準・最低限）ごとに月間退職後支出を置き換えて退職資金の充足度を計算する
+// overrides で指定した生活水準は目安の代わりにその月額を使う。年金・介護保険料などの条件は退職データのものを使う
+func (rd *RetirementData) CalculateRetirementByLifestyle(
+	currentSavings valueobjects.Money,
+	monthlySavings valueobjects.Money,
+	investmentReturn valueobjects.Rate,
+	inflationRate valueobjects.Rate,
+	overrides map[RetirementLifestyle]valueobjects.Money,
+) ([]LifestyleRetirementCalculation, error) {
+	for lifestyle, expenses := range overrides {
+		if !lifestyle.IsValid() {
+			return nil, fmt.Errorf("無効な生活水準です: %s", lifestyle)
+		}
+		if expenses.IsNegative() {
+			return nil, errors.New("生活水準の月間生活費は負の値にできません")
+		}
+	}
+
+	results := make([]LifestyleRetirementCalculation, 0, len(RetirementLifestyles))
+	for _, lifestyle := range RetirementLifestyles {
+		expenses, customized := overrides[lifestyle]
+		if !customized {
+			defaultExpenses, err := lifestyle.DefaultMonthlyExpenses()
+			if err != nil {
+				return nil, err
+			}
+			expenses = defaultExpenses
+		}
+
+		// 退職データを書き換えないよう、月間退職後支出だけを置き換えた複製で計算する
+		variant := *rd
+		variant.monthlyRetirementExpenses = expenses
+
+		calculation, err := variant.CalculateRetirementSufficiency(currentSavings, monthlySavings, investmentReturn, inflationRate)
+		if err != nil {
+			return nil, fmt.Errorf("%sの退職資金計算に失敗しました: %w", lifestyle.Label(), err)
+		}
+
+		results = append(results, LifestyleRetirementCalculation{
+			Lifestyle:       lifestyle,
+			Label:           lifestyle.Label(),
+			MonthlyExpenses: expenses,
+			IsCustomized:    customized,
+			Calculation:     calculation,
+		})
+	}
+
+	return results, nil
+}
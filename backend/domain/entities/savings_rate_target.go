@@ -0,0 +1,294 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SavingsRateTargetID は貯蓄率目標の一意識別子
+type SavingsRateTargetID string
+
+// NewSavingsRateTargetID は新しい貯蓄率目標IDを生成する
+func NewSavingsRateTargetID() SavingsRateTargetID {
+	return SavingsRateTargetID(uuid.New().String())
+}
+
+const (
+	// MinSavingsRateTargetPercent は貯蓄率目標の下限（%）
+	MinSavingsRateTargetPercent = 1.0
+	// MaxSavingsRateTargetPercent は貯蓄率目標の上限（%）
+	MaxSavingsRateTargetPercent = 80.0
+	// MaxSavingsRateTargetPeriodMonths は貯蓄率目標の最長期間（月）
+	MaxSavingsRateTargetPeriodMonths = 24
+	// SavingsRateWarningThreshold は警告を出す目標との乖離幅（ポイント）
+	SavingsRateWarningThreshold = 5.0
+)
+
+// MonthlySavingsActual は月次の収入・支出の実績を表す
+type MonthlySavingsActual struct {
+	Month    time.Time `json:"month"`
+	Income   float64   `json:"income"`
+	Expenses float64   `json:"expenses"`
+}
+
+// SavingsRate は月次実績の貯蓄率（%）を返す
+func (a MonthlySavingsActual) SavingsRate() float64 {
+	if a.Income <= 0 {
+		return 0
+	}
+	return (a.Income - a.Expenses) / a.Income * 100
+}
+
+// MonthlySavingsRateResult は月ごとの目標達成状況を表す
+type MonthlySavingsRateResult struct {
+	Month       string  `json:"month"` // YYYY-MM
+	SavingsRate float64 `json:"savings_rate"`
+	OnTarget    bool    `json:"on_target"`
+}
+
+// SavingsRateTargetProgress は貯蓄率目標の進捗を表す
+type SavingsRateTargetProgress struct {
+	TargetPercent        float64                    `json:"target_percent"`
+	CurrentRate          float64                    `json:"current_rate"`
+	Gap                  float64                    `json:"gap"` // 現在の貯蓄率 - 目標（ポイント）
+	MonthsOnTarget       int                        `json:"months_on_target"`
+	MonthsOffTarget      int                        `json:"months_off_target"`
+	TotalMonths          int                        `json:"total_months"`
+	ProjectedAverageRate float64                    `json:"projected_average_rate"`
+	IsBelowThreshold     bool                       `json:"is_below_threshold"`
+	MonthlyResults       []MonthlySavingsRateResult `json:"monthly_results"`
+}
+
+// SavingsRateTarget は一定期間に維持したい貯蓄率の目標を表すエンティティ
+type SavingsRateTarget struct {
+	id            SavingsRateTargetID
+	userID        UserID
+	targetPercent float64
+	periodStart   time.Time
+	periodEnd     time.Time
+	isActive      bool
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewSavingsRateTarget は新しい貯蓄率目標を作成する
+func NewSavingsRateTarget(
+	userID UserID,
+	targetPercent float64,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*SavingsRateTarget, error) {
+	if err := validateSavingsRateTarget(userID, targetPercent, periodStart, periodEnd); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &SavingsRateTarget{
+		id:            NewSavingsRateTargetID(),
+		userID:        userID,
+		targetPercent: targetPercent,
+		periodStart:   periodStart,
+		periodEnd:     periodEnd,
+		isActive:      true,
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// NewSavingsRateTargetWithID は既存IDで貯蓄率目標を再構築する
+func NewSavingsRateTargetWithID(
+	id SavingsRateTargetID,
+	userID UserID,
+	targetPercent float64,
+	periodStart time.Time,
+	periodEnd time.Time,
+	isActive bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) (*SavingsRateTarget, error) {
+	if id == "" {
+		return nil, errors.New("貯蓄率目標IDは必須です")
+	}
+
+	if err := validateSavingsRateTarget(userID, targetPercent, periodStart, periodEnd); err != nil {
+		return nil, err
+	}
+
+	return &SavingsRateTarget{
+		id:            id,
+		userID:        userID,
+		targetPercent: targetPercent,
+		periodStart:   periodStart,
+		periodEnd:     periodEnd,
+		isActive:      isActive,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}, nil
+}
+
+// validateSavingsRateTarget は貯蓄率目標の入力値を検証する
+func validateSavingsRateTarget(userID UserID, targetPercent float64, periodStart, periodEnd time.Time) error {
+	if userID == "" {
+		return errors.New("ユーザーIDは必須です")
+	}
+
+	if targetPercent < MinSavingsRateTargetPercent || targetPercent > MaxSavingsRateTargetPercent {
+		return fmt.Errorf("貯蓄率目標は%.0f%%から%.0f%%の範囲で設定してください", MinSavingsRateTargetPercent, MaxSavingsRateTargetPercent)
+	}
+
+	if !periodEnd.After(periodStart) {
+		return errors.New("期間の終了日は開始日より後である必要があります")
+	}
+
+	if monthsBetween(periodStart, periodEnd) > MaxSavingsRateTargetPeriodMonths {
+		return fmt.Errorf("期間は最長%dヶ月までです", MaxSavingsRateTargetPeriodMonths)
+	}
+
+	return nil
+}
+
+// monthsBetween は開始月と終了月を含む月数を返す
+func monthsBetween(start, end time.Time) int {
+	return (end.Year()-start.Year())*12 + int(end.Month()-start.Month()) + 1
+}
+
+// ID は貯蓄率目標IDを返す
+func (t *SavingsRateTarget) ID() SavingsRateTargetID {
+	return t.id
+}
+
+// UserID はユーザーIDを返す
+func (t *SavingsRateTarget) UserID() UserID {
+	return t.userID
+}
+
+// TargetPercent は目標貯蓄率（%）を返す
+func (t *SavingsRateTarget) TargetPercent() float64 {
+	return t.targetPercent
+}
+
+// PeriodStart は期間の開始日を返す
+func (t *SavingsRateTarget) PeriodStart() time.Time {
+	return t.periodStart
+}
+
+// PeriodEnd は期間の終了日を返す
+func (t *SavingsRateTarget) PeriodEnd() time.Time {
+	return t.periodEnd
+}
+
+// IsActive は目標が有効かどうかを返す
+func (t *SavingsRateTarget) IsActive() bool {
+	return t.isActive
+}
+
+// CreatedAt は作成日時を返す
+func (t *SavingsRateTarget) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// UpdatedAt は更新日時を返す
+func (t *SavingsRateTarget) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+// PeriodMonths は期間の月数を返す
+func (t *SavingsRateTarget) PeriodMonths() int {
+	return monthsBetween(t.periodStart, t.periodEnd)
+}
+
+// Deactivate は目標を無効化する
+func (t *SavingsRateTarget) Deactivate() {
+	t.isActive = false
+	t.updatedAt = time.Now()
+}
+
+// ContainsMonth は指定月が目標期間に含まれるかどうかを返す
+func (t *SavingsRateTarget) ContainsMonth(month time.Time) bool {
+	m := month.Year()*12 + int(month.Month())
+	start := t.periodStart.Year()*12 + int(t.periodStart.Month())
+	end := t.periodEnd.Year()*12 + int(t.periodEnd.Month())
+	return m >= start && m <= end
+}
+
+// EvaluateProgress は月次実績と現在の貯蓄率から目標の進捗を評価する
+// 期末の平均貯蓄率は、実績のない残りの月が現在の貯蓄率で推移すると仮定して予測する
+func (t *SavingsRateTarget) EvaluateProgress(actuals []MonthlySavingsActual, currentRate float64) *SavingsRateTargetProgress {
+	progress := &SavingsRateTargetProgress{
+		TargetPercent:  t.targetPercent,
+		CurrentRate:    currentRate,
+		Gap:            currentRate - t.targetPercent,
+		TotalMonths:    t.PeriodMonths(),
+		MonthlyResults: []MonthlySavingsRateResult{},
+	}
+
+	// 同じ月の実績が複数ある場合は最後のものを採用する
+	seen := make(map[string]int)
+	for _, actual := range actuals {
+		if !t.ContainsMonth(actual.Month) {
+			continue
+		}
+		rate := actual.SavingsRate()
+		result := MonthlySavingsRateResult{
+			Month:       actual.Month.Format("2006-01"),
+			SavingsRate: rate,
+			OnTarget:    rate >= t.targetPercent,
+		}
+		if idx, ok := seen[result.Month]; ok {
+			progress.MonthlyResults[idx] = result
+			continue
+		}
+		seen[result.Month] = len(progress.MonthlyResults)
+		progress.MonthlyResults = append(progress.MonthlyResults, result)
+	}
+
+	observedTotal := 0.0
+	for _, result := range progress.MonthlyResults {
+		if result.OnTarget {
+			progress.MonthsOnTarget++
+		} else {
+			progress.MonthsOffTarget++
+		}
+		observedTotal += result.SavingsRate
+	}
+
+	remainingMonths := progress.TotalMonths - len(progress.MonthlyResults)
+	if remainingMonths < 0 {
+		remainingMonths = 0
+	}
+	if progress.TotalMonths > 0 {
+		progress.ProjectedAverageRate = (observedTotal + currentRate*float64(remainingMonths)) / float64(progress.TotalMonths)
+	}
+
+	progress.IsBelowThreshold = currentRate < t.targetPercent-SavingsRateWarningThreshold
+
+	return progress
+}
+
+// MarshalJSON はSavingsRateTargetをJSONにシリアライズする
+func (t *SavingsRateTarget) MarshalJSON() ([]byte, error) {
+	type savingsRateTargetJSON struct {
+		ID            string  `json:"id"`
+		UserID        string  `json:"user_id"`
+		TargetPercent float64 `json:"target_percent"`
+		PeriodStart   string  `json:"period_start"`
+		PeriodEnd     string  `json:"period_end"`
+		IsActive      bool    `json:"is_active"`
+		CreatedAt     string  `json:"created_at"`
+		UpdatedAt     string  `json:"updated_at"`
+	}
+	return json.Marshal(savingsRateTargetJSON{
+		ID:            string(t.id),
+		UserID:        string(t.userID),
+		TargetPercent: t.targetPercent,
+		PeriodStart:   t.periodStart.Format(time.RFC3339),
+		PeriodEnd:     t.periodEnd.Format(time.RFC3339),
+		IsActive:      t.isActive,
+		CreatedAt:     t.createdAt.Format(time.RFC3339),
+		UpdatedAt:     t.updatedAt.Format(time.RFC3339),
+	})
+}
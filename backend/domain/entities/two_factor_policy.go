@@ -0,0 +1,74 @@
+package entities
+
+import (
+	"errors"
+	"time"
+)
+
+// TwoFactorRequirementReason は2段階認証が必須とされた理由
+type TwoFactorRequirementReason string
+
+const (
+	TwoFactorReasonOrganizationPolicy TwoFactorRequirementReason = "organization_policy" // 組織ポリシーで全ユーザーに必須
+	TwoFactorReasonHighAssets         TwoFactorRequirementReason = "high_assets"         // 総資産が閾値を超えている
+)
+
+// TwoFactorPolicy は2段階認証を必須とする条件と猶予期間
+type TwoFactorPolicy struct {
+	requiredForAll  bool
+	assetThreshold  float64
+	gracePeriodDays int
+}
+
+// NewTwoFactorPolicy は2段階認証の必須化ポリシーを作成する
+// assetThreshold が0の場合は総資産による必須化を行わない
+func NewTwoFactorPolicy(requiredForAll bool, assetThreshold float64, gracePeriodDays int) (TwoFactorPolicy, error) {
+	if assetThreshold < 0 {
+		return TwoFactorPolicy{}, errors.New("総資産の閾値は0以上である必要があります")
+	}
+	if gracePeriodDays < 0 {
+		return TwoFactorPolicy{}, errors.New("猶予期間は0日以上である必要があります")
+	}
+	return TwoFactorPolicy{
+		requiredForAll:  requiredForAll,
+		assetThreshold:  assetThreshold,
+		gracePeriodDays: gracePeriodDays,
+	}, nil
+}
+
+// IsEnabled は必須化の条件が1つでも設定されているかを返す
+func (p TwoFactorPolicy) IsEnabled() bool {
+	return p.requiredForAll || p.assetThreshold > 0
+}
+
+// DependsOnAssets は必須かどうかの判定にユーザーの総資産が必要かを返す
+func (p TwoFactorPolicy) DependsOnAssets() bool {
+	return !p.requiredForAll && p.assetThreshold > 0
+}
+
+// AssetThreshold は必須化する総資産の閾値を返す（0の場合は無効）
+func (p TwoFactorPolicy) AssetThreshold() float64 {
+	return p.assetThreshold
+}
+
+// GracePeriodDays は必須化されてから有効化するまでの猶予日数を返す
+func (p TwoFactorPolicy) GracePeriodDays() int {
+	return p.gracePeriodDays
+}
+
+// RequirementFor は総資産に応じて2段階認証が必須かどうかとその理由を返す
+func (p TwoFactorPolicy) RequirementFor(totalAssets float64) (TwoFactorRequirementReason, bool) {
+	switch {
+	case p.requiredForAll:
+		return TwoFactorReasonOrganizationPolicy, true
+	case p.assetThreshold > 0 && totalAssets > p.assetThreshold:
+		return TwoFactorReasonHighAssets, true
+	default:
+		return "", false
+	}
+}
+
+// DeadlineFrom は必須化された日時から有効化の期限を返す
+func (p TwoFactorPolicy) DeadlineFrom(requiredSince time.Time) time.Time {
+	return requiredSince.AddDate(0, 0, p.gracePeriodDays)
+}
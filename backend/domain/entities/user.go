@@ -6,17 +6,27 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
-// UserID はユーザーの一意識別子
+// ErrInvalidUserIDFormat はユーザーIDがUUID v4形式でない場合のエラー
+var ErrInvalidUserIDFormat = errors.New("ユーザーIDはUUID v4形式である必要があります")
+
+// UserID はユーザーの一意識別子（UUID v4）
 type UserID string
 
 // NewUserID は既存のIDからUserIDを生成する
+// 外部から受け取ったIDはUUID v4形式であることを検証する
 func NewUserID(id string) (UserID, error) {
 	if id == "" {
 		return "", errors.New("ユーザーIDは必須です")
 	}
+	parsed, err := uuid.Parse(id)
+	// uuid.Parse は波括弧付きやURN形式も受け付けるため、標準の36文字表記に限定する
+	if err != nil || len(id) != 36 || parsed.Version() != 4 {
+		return "", ErrInvalidUserIDFormat
+	}
 	return UserID(id), nil
 }
 
@@ -105,8 +115,15 @@ type User struct {
 	twoFactorEnabled     bool
 	twoFactorSecret      string
 	twoFactorBackupCodes []string
-	createdAt            time.Time
-	updatedAt            time.Time
+	// twoFactorRequiredSince は2段階認証の必須化ポリシーが適用された日時（適用されていない場合は nil）
+	twoFactorRequiredSince *time.Time
+	// isAdmin はサポート用の管理者権限（データベースでのみ付与する）
+	isAdmin bool
+	// digestPreference はダイジェストメールの配信設定、digestLastSentAt は最後に配信した日時（未配信の場合は nil）
+	digestPreference DigestPreference
+	digestLastSentAt *time.Time
+	createdAt        time.Time
+	updatedAt        time.Time
 }
 
 // NewUser は新しいユーザーを作成する（新規登録用）
@@ -356,6 +373,82 @@ func (u *User) DisableTwoFactor() {
 	u.updatedAt = time.Now()
 }
 
+// TwoFactorRequiredSince は2段階認証の必須化ポリシーが適用された日時を返す（適用されていない場合は nil）
+func (u *User) TwoFactorRequiredSince() *time.Time {
+	return u.twoFactorRequiredSince
+}
+
+// MarkTwoFactorRequired は2段階認証の必須化ポリシーが適用されたことを記録する
+// 既に記録済みの場合は猶予期間の起点を変えないため更新せず、false を返す
+func (u *User) MarkTwoFactorRequired(now time.Time) bool {
+	if u.twoFactorRequiredSince != nil {
+		return false
+	}
+	u.twoFactorRequiredSince = &now
+	u.updatedAt = now
+	return true
+}
+
+// ClearTwoFactorRequirement は必須化ポリシーの適用を解除する（記録がない場合は false を返す）
+func (u *User) ClearTwoFactorRequirement() bool {
+	if u.twoFactorRequiredSince == nil {
+		return false
+	}
+	u.twoFactorRequiredSince = nil
+	u.updatedAt = time.Now()
+	return true
+}
+
+// RestoreTwoFactorRequiredSince は必須化ポリシーが適用された日時を復元する（リポジトリ用）
+func (u *User) RestoreTwoFactorRequiredSince(requiredSince *time.Time) {
+	u.twoFactorRequiredSince = requiredSince
+}
+
+// IsAdmin は管理者権限を持つかどうかを返す
+func (u *User) IsAdmin() bool {
+	return u.isAdmin
+}
+
+// RestoreAdmin は管理者権限を復元する（リポジトリ用）
+func (u *User) RestoreAdmin(isAdmin bool) {
+	u.isAdmin = isAdmin
+}
+
+// DigestPreference はダイジェストメールの配信設定を返す
+func (u *User) DigestPreference() DigestPreference {
+	if u.digestPreference.frequency == "" {
+		return DefaultDigestPreference()
+	}
+	return u.digestPreference
+}
+
+// DigestLastSentAt はダイジェストメールを最後に配信した日時を返す（未配信の場合は nil）
+func (u *User) DigestLastSentAt() *time.Time {
+	return u.digestLastSentAt
+}
+
+// UpdateDigestPreference はダイジェストメールの配信設定を更新する
+func (u *User) UpdateDigestPreference(preference DigestPreference) {
+	u.digestPreference = preference
+	u.updatedAt = time.Now()
+}
+
+// IsDigestDue は now の時点でダイジェストメールを配信すべきかどうかを返す
+func (u *User) IsDigestDue(now time.Time) bool {
+	return u.DigestPreference().IsDue(u.digestLastSentAt, now)
+}
+
+// MarkDigestSent はダイジェストメールを配信した日時を記録する
+func (u *User) MarkDigestSent(sentAt time.Time) {
+	u.digestLastSentAt = &sentAt
+}
+
+// RestoreDigest はダイジェストメールの配信設定と最後に配信した日時を復元する（リポジトリ用）
+func (u *User) RestoreDigest(preference DigestPreference, lastSentAt *time.Time) {
+	u.digestPreference = preference
+	u.digestLastSentAt = lastSentAt
+}
+
 // RegenerateBackupCodes はバックアップコードを再生成する
 func (u *User) RegenerateBackupCodes(backupCodes []string) error {
 	if !u.twoFactorEnabled {
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// APIKeyRepository はAPIキーの永続化を担当するリポジトリインターフェース
+type APIKeyRepository interface {
+	// Save は新しいAPIキーを保存する
+	Save(ctx context.Context, apiKey *entities.APIKey) error
+
+	// FindByID はIDからAPIキーを取得する
+	FindByID(ctx context.Context, id entities.APIKeyID) (*entities.APIKey, error)
+
+	// FindByKeyHash はキーのハッシュ値からAPIキーを取得する
+	FindByKeyHash(ctx context.Context, keyHash string) (*entities.APIKey, error)
+
+	// FindByUserID は指定されたユーザーIDのAPIキーをすべて取得する（失効済みを含む）
+	FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.APIKey, error)
+
+	// Update は既存のAPIキー情報を更新する（最終使用日時、失効状態など）
+	Update(ctx context.Context, apiKey *entities.APIKey) error
+
+	// CountByUserID は指定されたユーザーIDのAPIキーの件数を取得する（失効済みを含む）
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDのAPIキーをすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// AuditLogRepository は監査ログの永続化を担当するリポジトリインターフェース
+// 監査ログは追記のみで、更新・削除は行わない
+type AuditLogRepository interface {
+	// Save は監査ログを追記する
+	Save(ctx context.Context, entry *entities.AuditLogEntry) error
+
+	// FindExpiredImpersonationSessions は有効期限が now 以前で、期限切れが未記録のなりすましセッションの発行エントリを取得する
+	FindExpiredImpersonationSessions(ctx context.Context, now time.Time) ([]*entities.AuditLogEntry, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// CalculationSnapshotRepository は保存済みの計算結果の永続化を担当するリポジトリインターフェース
+type CalculationSnapshotRepository interface {
+	// Save は計算結果を保存する（同じユーザー・種別の計算結果は上書きする）
+	Save(ctx context.Context, snapshot *entities.CalculationSnapshot) error
+
+	// FindByUserIDAndKind は指定されたユーザー・種別の計算結果を取得する（存在しない場合はnil）
+	FindByUserIDAndKind(ctx context.Context, userID entities.UserID, kind entities.CalculationSnapshotKind) (*entities.CalculationSnapshot, error)
+
+	// CountByUserID は指定されたユーザーIDの計算結果の件数を取得する
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの計算結果をすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
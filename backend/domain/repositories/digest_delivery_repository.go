@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// DigestDeliveryRepository はダイジェストメールの配信記録の永続化を担当するリポジトリインターフェース
+type DigestDeliveryRepository interface {
+	// Save はダイジェストメールの配信記録を保存する
+	Save(ctx context.Context, delivery *entities.DigestDelivery) error
+
+	// FindLatestByUserID は指定されたユーザーの最新の配信記録を取得する（配信記録がない場合は nil を返す）
+	FindLatestByUserID(ctx context.Context, userID entities.UserID) (*entities.DigestDelivery, error)
+}
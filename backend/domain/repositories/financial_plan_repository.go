@@ -2,6 +2,9 @@ package repositories
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
@@ -19,8 +22,13 @@ type FinancialPlanRepository interface {
 	FindByUserID(ctx context.Context, userID entities.UserID) (*aggregates.FinancialPlan, error)
 
 	// Update は既存の財務計画を更新する
+	// 読み込んだ時点のバージョン（plan.Version()）から他のセッションが更新していた場合は更新せずに
+	// *VersionConflictError（ErrVersionConflict）を返し、更新できた場合は plan のバージョンを1つ進める
 	Update(ctx context.Context, plan *aggregates.FinancialPlan) error
 
+	// MarkCalculated は計算結果を保存した日時を記録する（より新しい日時が記録済みの場合は何もしない）
+	MarkCalculated(ctx context.Context, userID entities.UserID, calculatedAt time.Time) error
+
 	// Delete は指定されたIDの財務計画を削除する
 	Delete(ctx context.Context, id aggregates.FinancialPlanID) error
 
@@ -29,4 +37,26 @@ type FinancialPlanRepository interface {
 
 	// ExistsByUserID は指定されたユーザーIDの財務計画が存在するかチェックする
 	ExistsByUserID(ctx context.Context, userID entities.UserID) (bool, error)
+
+	// FindUserIDs は財務計画を持つユーザーのIDを afterUserID より後からユーザーIDの昇順で最大 limit 件取得する
+	FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error)
+}
+
+// ErrVersionConflict は財務計画が読み込んだ後に他のセッションで更新されていたため、更新できなかったことを表す
+var ErrVersionConflict = errors.New("財務計画は他のセッションで更新されています。最新のデータを取得してからやり直してください")
+
+// VersionConflictError は楽観的ロックによる更新の競合を表すエラー（errors.Is で ErrVersionConflict と判定できる）
+type VersionConflictError struct {
+	// CurrentVersion は保存されている財務計画の現在のバージョン
+	CurrentVersion int
+}
+
+// Error はエラーメッセージを返す
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s（現在のバージョン: %d）", ErrVersionConflict.Error(), e.CurrentVersion)
+}
+
+// Unwrap は ErrVersionConflict を返す
+func (e *VersionConflictError) Unwrap() error {
+	return ErrVersionConflict
 }
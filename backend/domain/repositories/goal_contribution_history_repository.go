@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// GoalContributionHistoryRepository は目標の月間拠出額の変更履歴の永続化を担当するリポジトリインターフェース
+// 変更履歴は追記のみで、更新は行わない（削除はアカウント削除時のみ）
+type GoalContributionHistoryRepository interface {
+	// Save は月間拠出額の変更を追記する
+	Save(ctx context.Context, change *entities.GoalContributionChange) error
+
+	// FindByGoalID は目標の月間拠出額の変更履歴を変更日時の昇順で取得する
+	FindByGoalID(ctx context.Context, goalID entities.GoalID) ([]*entities.GoalContributionChange, error)
+
+	// CountByUserID は指定されたユーザーIDの月間拠出額の変更履歴の件数を取得する
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの月間拠出額の変更履歴をすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
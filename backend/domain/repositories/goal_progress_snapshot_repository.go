@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// GoalProgressSnapshotRepository は目標の進捗履歴の永続化を担当するリポジトリインターフェース
+type GoalProgressSnapshotRepository interface {
+	// Save は目標の進捗を記録する（同じ目標・同じ日の記録は上書きする）
+	Save(ctx context.Context, snapshot *entities.GoalProgressSnapshot) error
+
+	// FindByGoalIDSince は指定日時以降の記録を記録日時の昇順で取得する
+	FindByGoalIDSince(ctx context.Context, goalID entities.GoalID, since time.Time) ([]*entities.GoalProgressSnapshot, error)
+
+	// CountByUserID は指定されたユーザーIDの目標の進捗履歴の件数を取得する
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの目標の進捗履歴をすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
@@ -2,8 +2,10 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 )
 
 // GoalRepository は目標の永続化を担当するリポジトリインターフェース
@@ -26,12 +28,19 @@ type GoalRepository interface {
 	// Update は既存の目標を更新する
 	Update(ctx context.Context, goal *entities.Goal) error
 
-	// Delete は指定されたIDの目標を削除する
+	// Delete は指定されたIDの目標を削除し、他の目標の前提目標からも取り除く
 	Delete(ctx context.Context, id entities.GoalID) error
 
 	// Exists は指定されたIDの目標が存在するかチェックする
 	Exists(ctx context.Context, id entities.GoalID) (bool, error)
 
+	// FindSimilarGoal は同じタイプ・同じ目標名（正規化後）で目標金額の差が1%以内のアクティブな目標を取得する
+	// 該当する目標がない場合は nil を返す
+	FindSimilarGoal(ctx context.Context, userID entities.UserID, goalType entities.GoalType, title string, targetAmount valueobjects.Money) (*entities.Goal, error)
+
 	// CountActiveGoalsByType は指定されたユーザーIDと目標タイプのアクティブな目標数を取得する
 	CountActiveGoalsByType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) (int, error)
+
+	// FindOverdueActiveGoals は期限が asOf より前で未達成のアクティブな目標を全ユーザー分、期限の古い順に取得する
+	FindOverdueActiveGoals(ctx context.Context, asOf time.Time) ([]*entities.Goal, error)
 }
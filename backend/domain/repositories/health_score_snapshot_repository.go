@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// HealthScoreSnapshotRepository は財務健全性スコアの履歴の永続化を担当するリポジトリインターフェース
+type HealthScoreSnapshotRepository interface {
+	// Save は財務健全性スコアを記録する（同じユーザー・同じ日の記録は上書きする）
+	Save(ctx context.Context, snapshot *entities.HealthScoreSnapshot) error
+
+	// FindByUserIDSince は指定日時以降の記録を記録日時の昇順で取得する
+	FindByUserIDSince(ctx context.Context, userID entities.UserID, since time.Time) ([]*entities.HealthScoreSnapshot, error)
+
+	// CountByUserID は指定されたユーザーIDの財務健全性スコアの履歴の件数を取得する
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの財務健全性スコアの履歴をすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
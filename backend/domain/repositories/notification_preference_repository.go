@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// NotificationPreferenceRepository は通知設定の永続化を担当するリポジトリインターフェース
+type NotificationPreferenceRepository interface {
+	// Save は通知設定を保存する（同じユーザー・イベント・チャネルの設定は上書きする）
+	Save(ctx context.Context, preference *entities.NotificationPreference) error
+
+	// FindByUserID は指定されたユーザーIDの通知設定をすべて取得する
+	FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.NotificationPreference, error)
+
+	// FindEnabledByUserIDAndEventType は指定イベントの有効な通知設定を取得する
+	FindEnabledByUserIDAndEventType(ctx context.Context, userID entities.UserID, eventType entities.NotificationEventType) ([]*entities.NotificationPreference, error)
+
+	// Delete は通知設定を削除する
+	Delete(ctx context.Context, id entities.NotificationPreferenceID) error
+
+	// CountByUserID は指定されたユーザーIDの通知設定の件数を取得する
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの通知設定をすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
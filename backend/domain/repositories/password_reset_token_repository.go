@@ -23,6 +23,9 @@ type PasswordResetTokenRepository interface {
 	// DeleteExpired は期限切れのトークンを全て削除する
 	DeleteExpired(ctx context.Context) error
 
+	// CountByUserID は指定ユーザーのトークン数を取得する（使用済み・期限切れを含む）
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
 	// DeleteByUserID は指定ユーザーのトークンを全て削除する
 	DeleteByUserID(ctx context.Context, userID entities.UserID) error
 }
@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// PeerBenchmarkRepository は同年代比較の匿名集計の永続化を担当するリポジトリインターフェース
+type PeerBenchmarkRepository interface {
+	// ReplaceAll は保存済みの集計結果をすべて破棄し、benchmarks に置き換える
+	// 最小集計人数に満たなくなった区分の古い結果が残らないよう、一括で入れ替える
+	ReplaceAll(ctx context.Context, benchmarks []entities.PeerBenchmark) error
+
+	// FindBySegment は区分の集計結果を取得する（集計結果がない場合は空のスライスを返す）
+	FindBySegment(ctx context.Context, segment entities.PeerSegment) ([]entities.PeerBenchmark, error)
+}
+
+// PeerBenchmarkConsentRepository は同年代比較の匿名集計への参加（オプトイン）の永続化を担当するリポジトリインターフェース
+type PeerBenchmarkConsentRepository interface {
+	// OptIn は匿名集計への参加を記録する（参加済みの場合は何もしない）
+	OptIn(ctx context.Context, userID entities.UserID, optedInAt time.Time) error
+
+	// OptOut は匿名集計への参加を取り消す（参加していない場合は何もしない）
+	OptOut(ctx context.Context, userID entities.UserID) error
+
+	// FindOptedInAt は匿名集計に参加した日時を取得する（参加していない場合は nil）
+	FindOptedInAt(ctx context.Context, userID entities.UserID) (*time.Time, error)
+
+	// FindOptedInUserIDs は匿名集計に参加しているユーザーのIDを afterUserID より後からユーザーIDの昇順で最大 limit 件取得する
+	FindOptedInUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error)
+}
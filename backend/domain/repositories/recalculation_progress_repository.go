@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// RecalculationProgressRepository は一括再計算の進捗の永続化を担当するリポジトリインターフェース
+// 中断した再計算を同じ実行IDで再開した場合、完了済みのユーザーを読み飛ばすために使う
+type RecalculationProgressRepository interface {
+	// MarkCompleted は指定された実行でユーザーの再計算が完了したことを記録する
+	MarkCompleted(ctx context.Context, runID string, userID entities.UserID) error
+
+	// FindCompletedUserIDs は指定された実行で再計算が完了したユーザーIDを取得する
+	FindCompletedUserIDs(ctx context.Context, runID string) ([]entities.UserID, error)
+}
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// RecommendationDismissalRepository は推奨事項の却下状態の永続化を担当するリポジトリインターフェース
+type RecommendationDismissalRepository interface {
+	// Save は却下を保存する（同じユーザー・推奨事項の却下は上書きする）
+	Save(ctx context.Context, dismissal *entities.RecommendationDismissal) error
+
+	// FindByUserID は指定されたユーザーIDの却下をすべて取得する
+	FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.RecommendationDismissal, error)
+
+	// CountByUserID は指定されたユーザーIDの推奨事項の却下の件数を取得する
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの推奨事項の却下をすべて削除する（アカウント削除時に使用）
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
@@ -23,6 +23,9 @@ type RefreshTokenRepository interface {
 	// Delete は指定されたIDのリフレッシュトークンを削除する
 	Delete(ctx context.Context, id entities.RefreshTokenID) error
 
+	// CountByUserID は指定されたユーザーIDのリフレッシュトークン数を取得する（失効・期限切れを含む）
+	CountByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
 	// DeleteByUserID は指定されたユーザーIDのすべてのリフレッシュトークンを削除する
 	DeleteByUserID(ctx context.Context, userID entities.UserID) error
 
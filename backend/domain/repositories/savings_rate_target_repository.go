@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// SavingsRateTargetRepository は貯蓄率目標と月次実績の永続化を担当するリポジトリインターフェース
+type SavingsRateTargetRepository interface {
+	// Save は貯蓄率目標を保存する
+	Save(ctx context.Context, target *entities.SavingsRateTarget) error
+
+	// FindActiveByUserID は指定されたユーザーIDの有効な貯蓄率目標を取得する（存在しない場合はnil）
+	FindActiveByUserID(ctx context.Context, userID entities.UserID) (*entities.SavingsRateTarget, error)
+
+	// Update は既存の貯蓄率目標を更新する
+	Update(ctx context.Context, target *entities.SavingsRateTarget) error
+
+	// SaveMonthlyActual は月次実績を保存する（同じ月の実績は上書きする）
+	SaveMonthlyActual(ctx context.Context, userID entities.UserID, actual entities.MonthlySavingsActual) error
+
+	// FindMonthlyActuals は指定期間の月次実績を月の昇順で取得する（from が月の途中の場合もその月の実績を含める）
+	FindMonthlyActuals(ctx context.Context, userID entities.UserID, from, to time.Time) ([]entities.MonthlySavingsActual, error)
+
+	// CountMonthlyActualsByUserID は指定されたユーザーIDの月次実績の件数を取得する
+	CountMonthlyActualsByUserID(ctx context.Context, userID entities.UserID) (int, error)
+
+	// DeleteByUserID は指定されたユーザーIDの貯蓄率目標と月次実績をすべて削除する
+	DeleteByUserID(ctx context.Context, userID entities.UserID) error
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import "context"
+
+// TxRepositories は同一トランザクション内で使用するリポジトリの集合
+type TxRepositories struct {
+	FinancialPlans      FinancialPlanRepository
+	Goals               GoalRepository
+	Users               UserRepository
+	RefreshTokens       RefreshTokenRepository
+	PasswordResetTokens PasswordResetTokenRepository
+	SavingsRateTargets  SavingsRateTargetRepository
+	// 以下はアカウント削除で同じトランザクション内に消去するユーザーごとのデータ
+	APIKeys                  APIKeyRepository
+	NotificationPreferences  NotificationPreferenceRepository
+	RecommendationDismissals RecommendationDismissalRepository
+	HealthScoreSnapshots     HealthScoreSnapshotRepository
+	CalculationSnapshots     CalculationSnapshotRepository
+	PeerBenchmarkConsents    PeerBenchmarkConsentRepository
+	GoalProgressSnapshots    GoalProgressSnapshotRepository
+	GoalContributionHistory  GoalContributionHistoryRepository
+}
+
+// UnitOfWork は複数リポジトリへの書き込みを1つのトランザクションにまとめるインターフェース
+type UnitOfWork interface {
+	// WithinTx はトランザクション内でfnを実行する
+	// fnがエラーを返した場合はロールバックし、そのエラーをそのまま返す
+	WithinTx(ctx context.Context, fn func(ctx context.Context, repos TxRepositories) error) error
+}
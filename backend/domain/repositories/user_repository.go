@@ -31,4 +31,7 @@ type UserRepository interface {
 
 	// FindByProviderUserID はOAuthプロバイダーのユーザーIDからユーザーを取得する
 	FindByProviderUserID(ctx context.Context, provider entities.AuthProvider, providerUserID string) (*entities.User, error)
+
+	// FindDigestSubscribers はダイジェストメールの配信を有効にしているユーザーを取得する
+	FindDigestSubscribers(ctx context.Context) ([]*entities.User, error)
 }
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// 想定値の現実的な上限（年率%）のデフォルト値
+const (
+	DefaultRealisticReturnCeiling    = 8.0
+	DefaultRealisticInflationCeiling = 10.0
+)
+
+// AssumptionField は上限チェックの対象となる想定値の種類
+type AssumptionField string
+
+const (
+	AssumptionFieldInvestmentReturn AssumptionField = "investment_return" // 想定利回り
+	AssumptionFieldInflationRate    AssumptionField = "inflation_rate"    // 想定インフレ率
+)
+
+// AssumptionWarning は現実的な上限を超える想定値に対する警告を表す
+type AssumptionWarning struct {
+	Field   AssumptionField `json:"field"`   // 対象の想定値
+	Value   float64         `json:"value"`   // 入力された値（年率%）
+	Ceiling float64         `json:"ceiling"` // 現実的な上限（年率%）
+	Message string          `json:"message"` // 過去の市場実績を踏まえた説明
+}
+
+// AssumptionGuardrailService は想定利回り・インフレ率が現実的な範囲にあるかを判定するドメインサービス
+// 上限を超える値は禁止せず、ユーザーの明示的な同意を求めるための警告を返す
+type AssumptionGuardrailService struct {
+	returnCeiling    float64
+	inflationCeiling float64
+}
+
+// NewAssumptionGuardrailService は新しいAssumptionGuardrailServiceを作成する
+// 上限が0以下の場合はデフォルト値を使用する
+func NewAssumptionGuardrailService(returnCeiling, inflationCeiling float64) *AssumptionGuardrailService {
+	if returnCeiling <= 0 {
+		returnCeiling = DefaultRealisticReturnCeiling
+	}
+	if inflationCeiling <= 0 {
+		inflationCeiling = DefaultRealisticInflationCeiling
+	}
+	return &AssumptionGuardrailService{
+		returnCeiling:    returnCeiling,
+		inflationCeiling: inflationCeiling,
+	}
+}
+
+// NewDefaultAssumptionGuardrailService はデフォルトの上限でAssumptionGuardrailServiceを作成する
+func NewDefaultAssumptionGuardrailService() *AssumptionGuardrailService {
+	return NewAssumptionGuardrailService(DefaultRealisticReturnCeiling, DefaultRealisticInflationCeiling)
+}
+
+// ReturnCeiling は想定利回りの現実的な上限（年率%）を返す
+func (s *AssumptionGuardrailService) ReturnCeiling() float64 {
+	return s.returnCeiling
+}
+
+// InflationCeiling は想定インフレ率の現実的な上限（年率%）を返す
+func (s *AssumptionGuardrailService) InflationCeiling() float64 {
+	return s.inflationCeiling
+}
+
+// IsHighReturn は想定利回りが現実的な上限を超えているかを返す
+func (s *AssumptionGuardrailService) IsHighReturn(investmentReturn valueobjects.Rate) bool {
+	return investmentReturn.AsPercentage() > s.returnCeiling
+}
+
+// IsHighInflation は想定インフレ率が現実的な上限を超えているかを返す
+func (s *AssumptionGuardrailService) IsHighInflation(inflationRate valueobjects.Rate) bool {
+	return inflationRate.AsPercentage() > s.inflationCeiling
+}
+
+// CheckAssumptions は現実的な上限を超える想定値に対する警告を返す
+// すべて上限以内の場合は空のスライスを返す
+func (s *AssumptionGuardrailService) CheckAssumptions(investmentReturn, inflationRate valueobjects.Rate) []AssumptionWarning {
+	warnings := make([]AssumptionWarning, 0, 2)
+
+	if s.IsHighReturn(investmentReturn) {
+		warnings = append(warnings, AssumptionWarning{
+			Field:   AssumptionFieldInvestmentReturn,
+			Value:   investmentReturn.AsPercentage(),
+			Ceiling: s.returnCeiling,
+			Message: fmt.Sprintf(
+				"想定利回り年率%.1f%%は現実的な上限（%.1f%%）を超えています。"+
+					"世界株式の長期的な実質リターンは年率5〜7%%程度、債券を含む分散投資では3〜5%%程度が目安で、"+
+					"高い利回りが長期間続くことはまれです。この値で計画する場合は acknowledge_high_return に true を指定してください",
+				investmentReturn.AsPercentage(), s.returnCeiling,
+			),
+		})
+	}
+
+	if s.IsHighInflation(inflationRate) {
+		warnings = append(warnings, AssumptionWarning{
+			Field:   AssumptionFieldInflationRate,
+			Value:   inflationRate.AsPercentage(),
+			Ceiling: s.inflationCeiling,
+			Message: fmt.Sprintf(
+				"想定インフレ率年率%.1f%%は現実的な上限（%.1f%%）を超えています。"+
+					"日本の消費者物価上昇率は過去数十年の大半で年率0〜3%%程度、"+
+					"1970年代の石油危機時でも10%%を超えたのは一時的です。この値で計画する場合は acknowledge_high_inflation に true を指定してください",
+				inflationRate.AsPercentage(), s.inflationCeiling,
+			),
+		})
+	}
+
+	return warnings
+}
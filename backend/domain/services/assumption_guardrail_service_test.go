@@ -0,0 +1,65 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+func TestCheckAssumptions(t *testing.T) {
+	service := NewAssumptionGuardrailService(DefaultRealisticReturnCeiling, DefaultRealisticInflationCeiling)
+
+	tests := []struct {
+		name             string
+		investmentReturn float64
+		inflationRate    float64
+		expectedFields   []AssumptionField
+	}{
+		{"上限以内", 5.0, 2.0, nil},
+		{"上限ちょうど", 8.0, 10.0, nil},
+		{"利回りが上限超過", 20.0, 2.0, []AssumptionField{AssumptionFieldInvestmentReturn}},
+		{"インフレ率が上限超過", 5.0, 12.0, []AssumptionField{AssumptionFieldInflationRate}},
+		{"両方が上限超過", 15.0, 15.0, []AssumptionField{AssumptionFieldInvestmentReturn, AssumptionFieldInflationRate}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			investmentReturn, _ := valueobjects.NewRate(tt.investmentReturn)
+			inflationRate, _ := valueobjects.NewRate(tt.inflationRate)
+
+			warnings := service.CheckAssumptions(investmentReturn, inflationRate)
+			if len(warnings) != len(tt.expectedFields) {
+				t.Fatalf("警告の件数が期待値と異なります: got %d, want %d", len(warnings), len(tt.expectedFields))
+			}
+			for i, warning := range warnings {
+				if warning.Field != tt.expectedFields[i] {
+					t.Errorf("警告の対象が期待値と異なります: got %s, want %s", warning.Field, tt.expectedFields[i])
+				}
+				if warning.Message == "" {
+					t.Error("警告メッセージが設定されていません")
+				}
+			}
+		})
+	}
+}
+
+func TestNewAssumptionGuardrailService_Ceilings(t *testing.T) {
+	service := NewAssumptionGuardrailService(6.0, 5.0)
+	if service.ReturnCeiling() != 6.0 || service.InflationCeiling() != 5.0 {
+		t.Errorf("指定した上限が設定されていません: %v, %v", service.ReturnCeiling(), service.InflationCeiling())
+	}
+
+	rate, _ := valueobjects.NewRate(7.0)
+	if !service.IsHighReturn(rate) {
+		t.Error("上限6%に対して7%は高い想定利回りと判定されるべきです")
+	}
+
+	// 0以下の上限はデフォルト値になる
+	service = NewAssumptionGuardrailService(0, -1)
+	if service.ReturnCeiling() != DefaultRealisticReturnCeiling {
+		t.Errorf("利回り上限のデフォルト値が設定されていません: %v", service.ReturnCeiling())
+	}
+	if service.InflationCeiling() != DefaultRealisticInflationCeiling {
+		t.Errorf("インフレ率上限のデフォルト値が設定されていません: %v", service.InflationCeiling())
+	}
+}
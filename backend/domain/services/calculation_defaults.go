@@ -0,0 +1,50 @@
+package services
+
+// 計算の前提値のデフォルト（設定で上書きしない場合の値）
+const (
+	DefaultRetirementPlanningYears = 30   // 退職までの想定期間（年）
+	DefaultInvestmentReturn        = 5.0  // 想定利回り（年率%）
+	DefaultRecommendedSavingsRate  = 20.0 // 推奨貯蓄率（%）
+	DefaultEmergencyFundMonths     = 3.0  // 緊急資金の推奨月数
+)
+
+// CalculationDefaults は計算ロジックで用いる前提値
+// 地域や運用方針に合わせて設定から上書きできるようにし、計算ロジック内にマジックナンバーを持たないようにする
+type CalculationDefaults struct {
+	// RetirementPlanningYears は退職までの期間が分からない場合に想定する年数
+	RetirementPlanningYears int
+	// InvestmentReturn は標準的とみなす想定利回り（年率%）。これを下回る場合は運用の見直しを勧める
+	InvestmentReturn float64
+	// RecommendedSavingsRate は理想的とみなす貯蓄率（%）
+	RecommendedSavingsRate float64
+	// EmergencyFundMonths は最低限確保すべき緊急資金の月数（生活費の何ヶ月分か）
+	EmergencyFundMonths float64
+}
+
+// DefaultCalculationDefaults は既定の前提値を返す
+func DefaultCalculationDefaults() CalculationDefaults {
+	return CalculationDefaults{
+		RetirementPlanningYears: DefaultRetirementPlanningYears,
+		InvestmentReturn:        DefaultInvestmentReturn,
+		RecommendedSavingsRate:  DefaultRecommendedSavingsRate,
+		EmergencyFundMonths:     DefaultEmergencyFundMonths,
+	}
+}
+
+// withFallbacks は0以下（未設定）の項目を既定値に置き換えた前提値を返す
+func (d CalculationDefaults) withFallbacks() CalculationDefaults {
+	defaults := DefaultCalculationDefaults()
+	if d.RetirementPlanningYears <= 0 {
+		d.RetirementPlanningYears = defaults.RetirementPlanningYears
+	}
+	if d.InvestmentReturn <= 0 {
+		d.InvestmentReturn = defaults.InvestmentReturn
+	}
+	if d.RecommendedSavingsRate <= 0 {
+		d.RecommendedSavingsRate = defaults.RecommendedSavingsRate
+	}
+	if d.EmergencyFundMonths <= 0 {
+		d.EmergencyFundMonths = defaults.EmergencyFundMonths
+	}
+	return d
+}
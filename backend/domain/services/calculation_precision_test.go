@@ -0,0 +1,406 @@
+package services
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// 精度テストの許容誤差
+//
+// 計算の前提:
+//   - 複利頻度: 月次。月利は年利と同値の実効換算 (1 + 年利)^(1/12) - 1 を丸めずに使う（12か月で年利と一致する）
+//   - 拠出タイミング: 既定は月末（期末払い。Excel の FV/PV 関数で支払期日=0 と同じ）。月初（期初払い、支払期日=1）も選択できる
+//
+// 金額は Money の仕様で毎月0.01円単位に丸めるため、30年（360か月）の積立でも累積誤差は1円未満に収まる
+const (
+	precisionToleranceYen    = 1.0  // 円建ての理論値との許容誤差
+	precisionToleranceFactor = 1e-9 // 係数の理論値との許容誤差
+)
+
+// assertWithin は実装値が理論値から許容誤差以内であることを検証する
+func assertWithin(t *testing.T, label string, got, want, tolerance float64) {
+	t.Helper()
+	if diff := math.Abs(got - want); diff > tolerance {
+		t.Errorf("%s が理論値と一致しません: got %.6f, want %.6f (誤差 %.6f > 許容誤差 %g)", label, got, want, diff, tolerance)
+	}
+}
+
+func TestFinancialFactors_StandardTables(t *testing.T) {
+	// 複利係数表（年利5%）の理論値
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{"年金終価係数（5%・30年）", valueobjects.AnnuityFutureValueFactor(0.05, 30), 66.438847503013240},
+		{"期初払いの年金終価係数（5%・30年）", valueobjects.AnnuityDueFutureValueFactor(0.05, 30), 69.760789878163902},
+		{"年金現価係数（5%・20年）", valueobjects.AnnuityPresentValueFactor(0.05, 20), 12.462210342539986},
+		{"減債基金係数（5%・10年）", valueobjects.SinkingFundFactor(0.05, 10), 0.079504574965456695},
+		{"資本回収係数（5%・20年）", 1 / valueobjects.AnnuityPresentValueFactor(0.05, 20), 0.080242587190691323},
+		{"利率0%の年金終価係数は期間数", valueobjects.AnnuityFutureValueFactor(0, 12), 12},
+		{"利率0%の年金現価係数は期間数", valueobjects.AnnuityPresentValueFactor(0, 12), 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertWithin(t, tt.name, tt.got, tt.expected, precisionToleranceFactor)
+		})
+	}
+}
+
+func TestMonthlyDecimal_CompoundsToAnnualRate(t *testing.T) {
+	annualRate, _ := valueobjects.NewRate(5.0)
+
+	// 12か月複利で年利と一致する
+	monthly := annualRate.MonthlyDecimal()
+	assertWithin(t, "月利", monthly, 0.0040741237836483016, precisionToleranceFactor)
+	assertWithin(t, "12か月複利", math.Pow(1+monthly, 12)-1, 0.05, precisionToleranceFactor)
+}
+
+func TestProjectAssets_MatchesExcelFV(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	// 年利5%・月3万円・30年積立（元本なし）
+	// 理論値は Excel の =FV((1+5%)^(1/12)-1, 360, -30000) と同じ
+	const expectedFV = 24_461_277.208734621
+
+	monthlyContribution, _ := valueobjects.NewMoneyJPY(30_000)
+	zero, _ := valueobjects.NewMoneyJPY(0)
+	annualRate, _ := valueobjects.NewRate(5.0)
+
+	t.Run("CalculateCompoundInterestWithRegularPayments", func(t *testing.T) {
+		result, err := service.CalculateCompoundInterestWithRegularPayments(zero, monthlyContribution, annualRate, 30)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		assertWithin(t, "30年後の積立額", result.FinalAmount.Amount(), expectedFV, precisionToleranceYen)
+		assertWithin(t, "総拠出額", result.TotalContribution.Amount(), 10_800_000, 0)
+	})
+
+	t.Run("FinancialProfile.ProjectAssetsFrom", func(t *testing.T) {
+		profile := newPrecisionTestProfile(t, 5.0)
+		projections, err := profile.ProjectAssetsFrom(zero, monthlyContribution, 30)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		assertWithin(t, "30年後の資産", projections[29].TotalAssets.Amount(), expectedFV, precisionToleranceYen)
+	})
+
+	t.Run("元本の複利は年複利と一致する", func(t *testing.T) {
+		// 100万円を年利5%で10年運用: 1,000,000 × 1.05^10（Excel の =FV(5%, 10, 0, -1000000)）
+		principal, _ := valueobjects.NewMoneyJPY(1_000_000)
+		profile := newPrecisionTestProfile(t, 5.0)
+		projections, err := profile.ProjectAssetsFrom(principal, zero, 10)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		assertWithin(t, "10年後の元本", projections[9].TotalAssets.Amount(), 1_628_894.6267774414, precisionToleranceYen)
+	})
+
+	t.Run("名目年利の月割り（Excel の FV(5%/12, …)）とは前提が異なる", func(t *testing.T) {
+		// 年利を12で割った月利で複利計算する場合の理論値 =FV(5%/12, 360, -30000)
+		nominalFV := 30_000 * valueobjects.AnnuityFutureValueFactor(0.05/12, 360)
+		assertWithin(t, "名目年利での将来価値", nominalFV, 24_967_759.060844152, precisionToleranceYen)
+		if nominalFV <= expectedFV {
+			t.Errorf("名目年利の月割りは実効換算より将来価値が大きくなるはずです: nominal %.0f, effective %.0f", nominalFV, expectedFV)
+		}
+	})
+}
+
+func TestProjectAssets_ContributionTiming(t *testing.T) {
+	// 年利5%・月3万円・30年積立（元本なし）
+	// 期末拠出は Excel の =FV((1+5%)^(1/12)-1, 360, -30000)、期初拠出は =FV((1+5%)^(1/12)-1, 360, -30000, 0, 1) と同じ
+	const (
+		expectedEndFV       = 24_461_277.208734621
+		expectedBeginningFV = 24_560_935.479989140
+	)
+
+	monthlyContribution, _ := valueobjects.NewMoneyJPY(30_000)
+	zero, _ := valueobjects.NewMoneyJPY(0)
+	monthlyRate := valueobjects.MonthlyEquivalentRate(5.0)
+
+	project := func(t *testing.T, profile *entities.FinancialProfile, initial valueobjects.Money) []entities.AssetProjection {
+		t.Helper()
+		projections, err := profile.ProjectAssetsFrom(initial, monthlyContribution, 30)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		return projections
+	}
+
+	t.Run("既定は期末拠出でこれまでの計算結果と一致する", func(t *testing.T) {
+		profile := newPrecisionTestProfile(t, 5.0)
+		if profile.ContributionTiming() != valueobjects.ContributionTimingEnd {
+			t.Errorf("既定の拠出タイミングは期末拠出のはずです: got %s", string(profile.ContributionTiming()))
+		}
+		assertWithin(t, "30年後の資産", project(t, profile, zero)[29].TotalAssets.Amount(), expectedEndFV, precisionToleranceYen)
+	})
+
+	t.Run("期初拠出は1か月分多く運用され期末拠出の (1+i) 倍になる", func(t *testing.T) {
+		profile := newPrecisionTestProfile(t, 5.0)
+		if err := profile.SetContributionTiming(valueobjects.ContributionTimingBeginning); err != nil {
+			t.Fatalf("拠出タイミングの設定に失敗しました: %v", err)
+		}
+		projections := project(t, profile, zero)
+
+		assertWithin(t, "30年後の資産", projections[29].TotalAssets.Amount(), expectedBeginningFV, precisionToleranceYen)
+		assertWithin(t, "期末拠出との比", expectedBeginningFV/expectedEndFV, 1+monthlyRate, precisionToleranceFactor)
+		assertWithin(t, "総拠出額", projections[29].ContributedAmount.Amount(), 10_800_000, 0)
+		assertWithin(t, "FutureValueWithTiming", valueobjects.FutureValueWithTiming(0, 30_000, 5.0, 360, valueobjects.ContributionTimingBeginning), expectedBeginningFV, precisionToleranceYen)
+	})
+
+	t.Run("元本の複利は拠出タイミングに影響されない", func(t *testing.T) {
+		principal, _ := valueobjects.NewMoneyJPY(1_000_000)
+		end := newPrecisionTestProfile(t, 5.0)
+		beginning := newPrecisionTestProfile(t, 5.0)
+		if err := beginning.SetContributionTiming(valueobjects.ContributionTimingBeginning); err != nil {
+			t.Fatalf("拠出タイミングの設定に失敗しました: %v", err)
+		}
+
+		// 差は積立分の1か月分の運用益 C × 年金終価係数(i, n) × i だけ
+		diff := project(t, beginning, principal)[29].TotalAssets.Amount() - project(t, end, principal)[29].TotalAssets.Amount()
+		assertWithin(t, "期初拠出と期末拠出の差", diff, 30_000*valueobjects.AnnuityFutureValueFactor(monthlyRate, 360)*monthlyRate, precisionToleranceYen)
+	})
+
+	t.Run("無効な拠出タイミングは設定できない", func(t *testing.T) {
+		profile := newPrecisionTestProfile(t, 5.0)
+		if err := profile.SetContributionTiming("middle"); err == nil {
+			t.Error("無効な拠出タイミングでエラーになるはずです")
+		}
+		if profile.ContributionTiming() != valueobjects.ContributionTimingEnd {
+			t.Errorf("無効な値を設定しても拠出タイミングは変わらないはずです: got %s", string(profile.ContributionTiming()))
+		}
+	})
+}
+
+func TestRetirementCalculation_MatchesStandardFormulas(t *testing.T) {
+	// 35歳から65歳まで積み立て、65歳から90歳まで月15万円の不足を取り崩す
+	expenses, _ := valueobjects.NewMoneyJPY(300_000)
+	pension, _ := valueobjects.NewMoneyJPY(150_000)
+	retirement, err := entities.NewRetirementData("user-001", 35, 65, 90, expenses, pension)
+	if err != nil {
+		t.Fatalf("退職データの作成に失敗しました: %v", err)
+	}
+
+	currentSavings, _ := valueobjects.NewMoneyJPY(2_000_000)
+	monthlySavings, _ := valueobjects.NewMoneyJPY(50_000)
+	investmentReturn, _ := valueobjects.NewRate(4.0)
+	inflationRate, _ := valueobjects.NewRate(0)
+
+	calculation, err := retirement.CalculateRetirementSufficiency(currentSavings, monthlySavings, investmentReturn, inflationRate)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	// 退職時の予想資産 = 元本の複利 + 積立の年金終価係数
+	monthlyRate := investmentReturn.MonthlyDecimal()
+	expectedProjected := 2_000_000*math.Pow(1.04, 30) + 50_000*valueobjects.AnnuityFutureValueFactor(monthlyRate, 360)
+	assertWithin(t, "退職時の予想資産", calculation.ProjectedAmount.Amount(), expectedProjected, precisionToleranceYen)
+
+	// 推奨月間貯蓄額 = (必要額 - 元本の複利) × 減債基金係数
+	expectedRequired := 150_000.0 * 12 * 25
+	assertWithin(t, "必要老後資金", calculation.RequiredAmount.Amount(), expectedRequired, precisionToleranceYen)
+	expectedRecommended := (expectedRequired - 2_000_000*math.Pow(1.04, 30)) * valueobjects.SinkingFundFactor(monthlyRate, 360)
+	assertWithin(t, "推奨月間貯蓄額", calculation.RecommendedMonthlySavings.Amount(), expectedRecommended, precisionToleranceYen)
+
+	// 推奨月間貯蓄額で積み立てると、ちょうど必要老後資金に届く
+	recommendedSavings := calculation.RecommendedMonthlySavings
+	withRecommended, err := retirement.CalculateRetirementSufficiency(currentSavings, recommendedSavings, investmentReturn, inflationRate)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	// 推奨額は0.01円単位に丸めるため、360か月分の丸め誤差を許容する
+	assertWithin(t, "推奨額で積み立てた退職時の資産", withRecommended.ProjectedAmount.Amount(), expectedRequired, precisionToleranceYen+0.01*360)
+}
+
+func TestGenerateDrawdownSchedule_MatchesAnnuityPresentValue(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	// 年金現価係数で求めた元本から月末に月15万円を25年間取り崩すと、最終月にちょうど尽きる
+	annualRate, _ := valueobjects.NewRate(3.0)
+	startAssets := 150_000 * valueobjects.AnnuityPresentValueFactor(annualRate.MonthlyDecimal(), 300)
+
+	schedule, err := service.GenerateDrawdownSchedule(startAssets, 150_000, 3.0, 25)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	assertWithin(t, "取り崩し開始時の元本", startAssets, 31_772_341.165078398, precisionToleranceYen)
+	assertWithin(t, "25年後の残高", schedule[24].EndBalance, 0, precisionToleranceYen)
+	assertWithin(t, "取り崩し総額", totalWithdrawals(schedule), 150_000*300, precisionToleranceYen)
+}
+
+// totalWithdrawals は取り崩しスケジュールの取り崩し総額を返す
+func totalWithdrawals(schedule []DrawdownEntry) float64 {
+	total := 0.0
+	for _, entry := range schedule {
+		total += entry.Withdrawals
+	}
+	return total
+}
+
+// calculationFormulaCases は計算式の比較に使う代表的な入力
+var calculationFormulaCases = []struct {
+	name                string
+	principal           float64
+	monthlyContribution float64
+	annualReturn        float64
+	inflationRate       float64
+	years               int
+}{
+	{"元本のみ・年利5%・10年", 1_000_000, 0, 5, 2, 10},
+	{"積立のみ・年利5%・30年", 0, 30_000, 5, 2, 30},
+	{"元本と積立・年利3%・20年", 2_000_000, 50_000, 3, 1, 20},
+	{"利回り0%・インフレ3%・15年", 500_000, 20_000, 0, 3, 15},
+	{"元本なし・積立なし・1年", 0, 0, 7, 2, 1},
+}
+
+func TestFutureValueAndRealValue_MatchProjectAssets(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	for _, tc := range calculationFormulaCases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile := newPrecisionTestProfileWithInflation(t, tc.annualReturn, tc.inflationRate)
+			principal, _ := valueobjects.NewMoneyJPY(tc.principal)
+			contribution, _ := valueobjects.NewMoneyJPY(tc.monthlyContribution)
+
+			projections, err := profile.ProjectAssetsFrom(principal, contribution, tc.years)
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			// 資産推移は毎月0.01円単位に丸めるため、各年末の値は計算式と1円以内で一致する
+			for _, projection := range projections {
+				nominal := service.FutureValue(tc.principal, tc.monthlyContribution, tc.annualReturn, projection.Year*12)
+				assertWithin(t, "名目の資産額", projection.TotalAssets.Amount(), nominal, precisionToleranceYen)
+				assertWithin(t, "実質の資産額", projection.RealValue.Amount(),
+					service.RealValue(projection.TotalAssets.Amount(), tc.inflationRate, projection.Year), 0.01)
+			}
+		})
+	}
+}
+
+func TestRetirementFormulas_MatchPreviousImplementation(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	cases := []struct {
+		name                      string
+		currentAge, retirementAge int
+		lifeExpectancy            int
+		expenses, pension         float64
+		currentSavings            float64
+		annualReturn, inflation   float64
+	}{
+		{"標準", 40, 65, 90, 280_000, 150_000, 3_000_000, 4, 2},
+		{"インフレなし", 35, 65, 90, 300_000, 150_000, 2_000_000, 4, 0},
+		{"年金で賄える", 50, 65, 85, 200_000, 220_000, 1_000_000, 3, 1},
+		{"退職済み", 70, 65, 90, 250_000, 150_000, 20_000_000, 2, 1},
+		{"貯蓄で到達済み", 45, 60, 80, 250_000, 200_000, 50_000_000, 5, 2},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expenses, _ := valueobjects.NewMoneyJPY(tc.expenses)
+			pension, _ := valueobjects.NewMoneyJPY(tc.pension)
+			currentSavings, _ := valueobjects.NewMoneyJPY(tc.currentSavings)
+			monthlySavings, _ := valueobjects.NewMoneyJPY(30_000)
+			investmentReturn, _ := valueobjects.NewRate(tc.annualReturn)
+			inflationRate, _ := valueobjects.NewRate(tc.inflation)
+
+			retirement, err := entities.NewRetirementDataWithID("rd-001", "user-001", tc.currentAge, tc.retirementAge, tc.lifeExpectancy, expenses, pension, time.Now(), time.Now())
+			if err != nil {
+				t.Fatalf("退職データの作成に失敗しました: %v", err)
+			}
+			calculation, err := retirement.CalculateRetirementSufficiency(currentSavings, monthlySavings, investmentReturn, inflationRate)
+			if err != nil {
+				t.Fatalf("予期しないエラー: %v", err)
+			}
+
+			yearsUntilRetirement := retirement.CalculateYearsUntilRetirement()
+			previousRequired := previousRequiredRetirementFund(tc.expenses, tc.pension, yearsUntilRetirement, retirement.CalculateRetirementYears(), inflationRate)
+			previousRecommended := previousRecommendedMonthlySavings(tc.currentSavings, previousRequired, investmentReturn, yearsUntilRetirement)
+
+			assertWithin(t, "必要老後資金", calculation.RequiredAmount.Amount(), previousRequired, 0.01)
+			assertWithin(t, "推奨月間貯蓄額", calculation.RecommendedMonthlySavings.Amount(), previousRecommended, 0.01)
+			assertWithin(t, "RetirementRequirement", service.RetirementRequirement(
+				tc.expenses, tc.pension, yearsUntilRetirement, retirement.CalculateRetirementYears(), tc.inflation), previousRequired, 0.01)
+		})
+	}
+}
+
+func TestGrowthPercentage_ZeroInitialAmount(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	assertWithin(t, "増加率", service.GrowthPercentage(1_000_000, 1_500_000), 50, precisionToleranceFactor)
+	// 初期額が0以下の場合は0除算せずに0を返す
+	for _, initial := range []float64{0, -100} {
+		got := service.GrowthPercentage(initial, 1_000_000)
+		if got != 0 || math.IsNaN(got) {
+			t.Errorf("初期額 %.0f の増加率は0であるべきです: got %v", initial, got)
+		}
+	}
+}
+
+// previousRequiredRetirementFund は計算式を集約する前の RetirementData.CalculateRequiredRetirementFund の計算（比較用）
+func previousRequiredRetirementFund(expenses, pension float64, yearsUntilRetirement, retirementYears int, inflationRate valueobjects.Rate) float64 {
+	shortfall := expenses - pension
+	if retirementYears <= 0 || shortfall <= 0 {
+		return 0
+	}
+	monthly, _ := valueobjects.NewMoneyJPY(shortfall)
+	adjusted, _ := monthly.MultiplyByFloat(inflationRate.CompoundFactor(yearsUntilRetirement))
+	total, _ := adjusted.MultiplyByFloat(float64(retirementYears * 12))
+	return total.Amount()
+}
+
+// previousRecommendedMonthlySavings は計算式を集約する前の推奨月間貯蓄額の計算（比較用）
+func previousRecommendedMonthlySavings(currentSavings, required float64, investmentReturn valueobjects.Rate, years int) float64 {
+	if years <= 0 {
+		return math.Max(required-currentSavings, 0)
+	}
+	current, _ := valueobjects.NewMoneyJPY(currentSavings)
+	futureValue, _ := current.MultiplyByFloat(investmentReturn.CompoundFactor(years))
+	additional := required - futureValue.Amount()
+	if additional <= 0 {
+		return 0
+	}
+	recommended, _ := valueobjects.NewMoneyJPY(additional * valueobjects.SinkingFundFactor(investmentReturn.MonthlyDecimal(), years*12))
+	return recommended.Amount()
+}
+
+// newPrecisionTestProfile は指定した利回りで精度テスト用の財務プロファイルを作成する
+func newPrecisionTestProfile(t *testing.T, investmentReturnPercent float64) *entities.FinancialProfile {
+	t.Helper()
+	income, _ := valueobjects.NewMoneyJPY(400_000)
+	expense, _ := valueobjects.NewMoneyJPY(200_000)
+	savings, _ := valueobjects.NewMoneyJPY(0)
+	investmentReturn, _ := valueobjects.NewRate(investmentReturnPercent)
+	inflationRate, _ := valueobjects.NewRate(0)
+	profile, err := entities.NewFinancialProfile(
+		entities.UserID("user-001"),
+		income,
+		entities.ExpenseCollection{{Category: "生活費", Amount: expense}},
+		entities.SavingsCollection{{Type: "deposit", Amount: savings}},
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		t.Fatalf("プロファイルの作成に失敗しました: %v", err)
+	}
+	return profile
+}
+
+// newPrecisionTestProfileWithInflation はインフレ率を指定した精度テスト用の財務プロファイルを作成する
+func newPrecisionTestProfileWithInflation(t *testing.T, investmentReturnPercent, inflationPercent float64) *entities.FinancialProfile {
+	t.Helper()
+	profile := newPrecisionTestProfile(t, investmentReturnPercent)
+	inflationRate, _ := valueobjects.NewRate(inflationPercent)
+	if err := profile.UpdateInflationRate(inflationRate); err != nil {
+		t.Fatalf("インフレ率の設定に失敗しました: %v", err)
+	}
+	return profile
+}
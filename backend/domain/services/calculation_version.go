@@ -0,0 +1,6 @@
+package services
+
+// CalculationVersion は計算ロジック・既定の前提条件のバージョン
+// 計算式の修正や既定値（インフレ率など）の変更で計算結果が変わる場合はインクリメントする
+// 保存済みの計算結果はこの値と一緒に保存し、値が異なるものを古い結果として再計算の対象とする
+const CalculationVersion = 2
@@ -9,11 +9,24 @@ import (
 )
 
 // FinancialCalculationService は財務計算に関するドメインサービス
-type FinancialCalculationService struct{}
+type FinancialCalculationService struct {
+	defaults CalculationDefaults
+}
 
-// NewFinancialCalculationService は新しいFinancialCalculationServiceを作成する
+// NewFinancialCalculationService は既定の前提値でFinancialCalculationServiceを作成する
 func NewFinancialCalculationService() *FinancialCalculationService {
-	return &FinancialCalculationService{}
+	return NewFinancialCalculationServiceWithDefaults(DefaultCalculationDefaults())
+}
+
+// NewFinancialCalculationServiceWithDefaults は設定から読み込んだ前提値でFinancialCalculationServiceを作成する
+// 0以下の前提値は既定値を使用する
+func NewFinancialCalculationServiceWithDefaults(defaults CalculationDefaults) *FinancialCalculationService {
+	return &FinancialCalculationService{defaults: defaults.withFallbacks()}
+}
+
+// Defaults は計算に用いる前提値を返す
+func (fcs *FinancialCalculationService) Defaults() CalculationDefaults {
+	return fcs.defaults
 }
 
 // CompoundInterestResult は複利計算の結果を表す
@@ -90,6 +103,7 @@ func (fcs *FinancialCalculationService) CalculateCompoundInterest(
 }
 
 // CalculateCompoundInterestWithRegularPayments は定期積立を含む複利計算を実行する
+// 年利と同値の月利で毎月複利計算し、積立は月末に行う（FinancialProfile.ProjectAssetsFrom と同じ前提）
 func (fcs *FinancialCalculationService) CalculateCompoundInterestWithRegularPayments(
 	principal valueobjects.Money,
 	monthlyPayment valueobjects.Money,
@@ -109,21 +123,19 @@ func (fcs *FinancialCalculationService) CalculateCompoundInterestWithRegularPaym
 		}, nil
 	}
 
-	// 月利を計算
-	monthlyRate, err := annualRate.MonthlyRate()
-	if err != nil {
-		return nil, fmt.Errorf("月利の計算に失敗しました: %w", err)
-	}
+	// 月利を計算（長期の複利で誤差が広がらないよう丸めずに使う）
+	monthlyRate := annualRate.MonthlyDecimal()
 
 	currentAmount := principal
 	totalMonths := years * 12
 	totalContribution := principal
+	var err error
 
 	// 月次複利計算
 	for month := 0; month < totalMonths; month++ {
 		// 投資収益を加算
-		if !monthlyRate.IsZero() {
-			interestGain, err := currentAmount.Multiply(monthlyRate)
+		if monthlyRate != 0 {
+			interestGain, err := currentAmount.MultiplyByFloat(monthlyRate)
 			if err != nil {
 				return nil, fmt.Errorf("月次投資収益の計算に失敗しました: %w", err)
 			}
@@ -180,8 +192,10 @@ func (fcs *FinancialCalculationService) CalculateInflationAdjustedValue(
 	}
 
 	// インフレ調整: Real Value = Nominal Value / (1 + inflation_rate)^years
-	inflationFactor := inflationRate.CompoundFactor(years)
-	realValue, err := amount.MultiplyByFloat(1.0 / inflationFactor)
+	realValue, err := valueobjects.NewMoney(
+		fcs.PresentValue(amount.Amount(), inflationRate.AsPercentage(), years),
+		amount.Currency(),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("実質価値の計算に失敗しました: %w", err)
 	}
@@ -207,6 +221,71 @@ func (fcs *FinancialCalculationService) CalculateInflationAdjustedValue(
 	}, nil
 }
 
+// FutureValueWithInflation は現在価値を年率インフレ率（%）で years 年後の名目価値に換算する
+// FV = PV × (1 + r/100)^years
+func (fcs *FinancialCalculationService) FutureValueWithInflation(presentValue, annualInflation float64, years int) float64 {
+	return presentValue * valueobjects.InflationFactor(annualInflation, years)
+}
+
+// PresentValue は years 年後の名目価値を年率インフレ率（%）で現在価値（実質価値）に割り戻す
+// FutureValueWithInflation の逆変換。RealValue と同じ計算
+func (fcs *FinancialCalculationService) PresentValue(futureValue, annualInflation float64, years int) float64 {
+	return fcs.RealValue(futureValue, annualInflation, years)
+}
+
+// GrowthPercentage は initial から final への増加率（%）を返す
+// initial が0以下の場合は増加率を定義できないため0を返す（0除算で NaN・無限大にならないようにする）
+func (fcs *FinancialCalculationService) GrowthPercentage(initial, final float64) float64 {
+	if initial <= 0 {
+		return 0
+	}
+	return (final - initial) / initial * 100
+}
+
+// --- 計算方法（公開用） ---
+// 以下は資産推移・インフレ調整・老後資金の計算式で、エンティティとユースケースの計算はすべてこれらと同じ式に従う
+// 実装は valueobjects パッケージにあり、エンティティ（サービスを参照できない）からも同じ実装を使う
+// 金額は円、利回り・インフレ率は年率（%）で受け取り、途中で丸めない（RetirementRequirement の月額を除く）
+
+// FutureValue は元本と毎月の積立額から months か月後の資産額（名目）を返す
+//
+//	i  = (1 + annualReturn/100)^(1/12) − 1          … 年率と同値の月利
+//	FV = P × (1 + i)^n + C × ((1 + i)^n − 1) / i    … 月次複利・月末積立（i = 0 の場合は P + C × n）
+//
+// FinancialProfile.ProjectAssetsFrom の各年末の資産額は、毎月の金額を1銭単位に丸めることを除いてこの式と一致する
+func (fcs *FinancialCalculationService) FutureValue(principal, monthlyContribution, annualReturn float64, months int) float64 {
+	return valueobjects.FutureValue(principal, monthlyContribution, annualReturn, months)
+}
+
+// RealValue は years 年後の名目額を現在の購買力に換算した実質額を返す
+//
+//	RV = FV / (1 + inflation/100)^years
+//
+// 年数が0以下、またはインフレ率が-100%以下の場合は換算しない
+func (fcs *FinancialCalculationService) RealValue(nominal, annualInflation float64, years int) float64 {
+	return valueobjects.RealValue(nominal, annualInflation, years)
+}
+
+// RequiredMonthlyContribution は現在額 current から months か月後に target に到達するために必要な毎月の積立額を返す
+//
+//	不足額 = target − current × (1 + i)^n
+//	C      = 不足額 × i / ((1 + i)^n − 1)              … 減債基金係数（i = 0 の場合は 不足額 / n）
+//
+// 既に到達できる場合は0、期間が0以下の場合は max(target − current, 0) を返す
+func (fcs *FinancialCalculationService) RequiredMonthlyContribution(target, current, annualReturn float64, months int) float64 {
+	return valueobjects.RequiredMonthlyContribution(target, current, annualReturn, months)
+}
+
+// RetirementRequirement は退職後の生活費のうち年金で賄えない分の総額（退職時点の名目額）を返す
+//
+//	月間不足額 = (expenses − pension) × (1 + inflation/100)^yearsUntilRetirement   … 小数点以下2桁に丸める
+//	必要額     = 月間不足額 × 12 × retirementYears
+//
+// 年金で賄える場合や退職後年数が0以下の場合は0。退職後のインフレと運用益は考慮しない
+func (fcs *FinancialCalculationService) RetirementRequirement(monthlyExpenses, monthlyPension float64, yearsUntilRetirement, retirementYears int, annualInflation float64) float64 {
+	return valueobjects.RetirementRequirement(monthlyExpenses, monthlyPension, yearsUntilRetirement, retirementYears, annualInflation)
+}
+
 // CalculateRetirementNeeds は老後資金の必要額を計算する
 func (fcs *FinancialCalculationService) CalculateRetirementNeeds(
 	monthlyExpenses valueobjects.Money,
@@ -328,25 +407,17 @@ func (fcs *FinancialCalculationService) CalculateRequiredSavingsRate(
 		return valueobjects.Rate{}, errors.New("現在の収入は正の値である必要があります")
 	}
 
-	// 現在の貯蓄が将来どれだけ成長するかを計算
-	futureValueOfCurrentSavings, err := fcs.CalculateFutureValue(currentSavings, investmentReturn, years)
-	if err != nil {
-		return valueobjects.Rate{}, fmt.Errorf("現在貯蓄の将来価値計算に失敗しました: %w", err)
-	}
-
-	// 追加で必要な金額を計算
-	additionalRequired, err := targetAmount.Subtract(futureValueOfCurrentSavings)
-	if err != nil {
-		return valueobjects.Rate{}, fmt.Errorf("追加必要額の計算に失敗しました: %w", err)
-	}
+	// 月間必要貯蓄額を計算（現在の貯蓄の運用益を差し引き、月末積立の積立額にも運用益が付く前提）
+	monthlySavingsRequired := fcs.RequiredMonthlyContribution(
+		targetAmount.Amount(), currentSavings.Amount(), investmentReturn.AsPercentage(), years*12)
 
 	// 既に目標を達成している場合
-	if additionalRequired.IsNegative() || additionalRequired.IsZero() {
+	if monthlySavingsRequired <= 0 {
 		return valueobjects.NewRate(0)
 	}
 
-	// 年間必要貯蓄額を計算（簡略化：複利効果を無視）
-	annualSavingsRequired := additionalRequired.Amount() / float64(years)
+	// 年間必要貯蓄額を計算
+	annualSavingsRequired := monthlySavingsRequired * 12
 
 	// 必要貯蓄率を計算
 	requiredSavingsRate := (annualSavingsRequired / currentIncome.Amount()) * 100
@@ -458,3 +529,335 @@ func (fcs *FinancialCalculationService) CalculateDebtPayoffTime(
 
 	return months, nil
 }
+
+// 公的年金の計算に用いる定数（令和6年度の水準）
+const (
+	// kouseiNenkinMultiplier は厚生年金（報酬比例部分）の給付乗率（5.481/1000）
+	kouseiNenkinMultiplier = 5.481 / 1000
+	// maxStandardRemuneration は標準報酬月額の上限
+	maxStandardRemuneration = 650000.0
+	// minStandardRemuneration は標準報酬月額の下限
+	minStandardRemuneration = 88000.0
+	// fullBasicPensionAnnual は老齢基礎年金の満額（年額、昭和31年4月2日以降生まれ）
+	fullBasicPensionAnnual = 816000.0
+	// fullBasicPensionAnnualSenior は老齢基礎年金の満額（年額、昭和31年4月1日以前生まれ）
+	fullBasicPensionAnnualSenior = 813700.0
+	// basicPensionFullContributionMonths は基礎年金を満額受給するための納付月数
+	basicPensionFullContributionMonths = 480
+	// seniorBasicPensionBirthYear はこの年以前の生まれに旧満額を適用する境界年
+	seniorBasicPensionBirthYear = 1956
+)
+
+// EstimateNationalPension は加入年数・平均月収・生年から公的年金の月額見込みを推定する
+// 厚生年金（報酬比例部分）= 平均標準報酬額 × 5.481/1000 × 加入月数 に老齢基礎年金を加算する
+// 入力が不正な場合は0を返す
+func (fcs *FinancialCalculationService) EstimateNationalPension(
+	contributionYears int,
+	averageMonthlyIncome float64,
+	birthYear int,
+) float64 {
+	if contributionYears <= 0 || averageMonthlyIncome <= 0 || birthYear <= 0 {
+		return 0
+	}
+
+	contributionMonths := contributionYears * 12
+
+	// 平均標準報酬額は標準報酬月額の上下限で丸める
+	standardRemuneration := math.Min(math.Max(averageMonthlyIncome, minStandardRemuneration), maxStandardRemuneration)
+
+	// 報酬比例部分（年額）
+	earningsRelatedAnnual := standardRemuneration * kouseiNenkinMultiplier * float64(contributionMonths)
+
+	// 老齢基礎年金（年額）は納付月数に応じて按分する（最大480ヶ月）
+	fullBasicPension := fullBasicPensionAnnual
+	if birthYear <= seniorBasicPensionBirthYear {
+		fullBasicPension = fullBasicPensionAnnualSenior
+	}
+	basicMonths := contributionMonths
+	if basicMonths > basicPensionFullContributionMonths {
+		basicMonths = basicPensionFullContributionMonths
+	}
+	basicPensionAnnual := fullBasicPension * float64(basicMonths) / float64(basicPensionFullContributionMonths)
+
+	return math.Round((earningsRelatedAnnual + basicPensionAnnual) / 12)
+}
+
+const (
+	// equityRatioBase は「基準値 - 年齢」で株式比率の目安を求める基準値
+	equityRatioBase = 100.0
+	// minEquityRatio・maxEquityRatio は推奨する株式比率（%）の下限・上限
+	minEquityRatio = 20.0
+	maxEquityRatio = 90.0
+)
+
+// RecommendedEquityRatio は年齢から推奨する株式の比率（%）を「100 - 年齢」の目安で計算する
+// 極端な配分を避けるため20%〜90%の範囲に収める
+func (fcs *FinancialCalculationService) RecommendedEquityRatio(age int) float64 {
+	return math.Min(math.Max(equityRatioBase-float64(age), minEquityRatio), maxEquityRatio)
+}
+
+const (
+	// maxTimeToAmountMonths は目標到達期間の探索上限（100年）
+	maxTimeToAmountMonths = 1200
+	// DefaultUnrealisticReturnThreshold は非現実的とみなす年利回り（%）の初期値
+	DefaultUnrealisticReturnThreshold = 15.0
+	// maxRequiredReturnPercent は必要利回りの探索上限（%）
+	maxRequiredReturnPercent = 100.0
+	// requiredReturnPrecisionPercent は必要利回りの二分探索の収束幅（%）
+	requiredReturnPrecisionPercent = 0.0001
+)
+
+// TimeToAmountResult は目標金額到達期間計算の結果を表す
+type TimeToAmountResult struct {
+	Months    int     `json:"months"`    // 到達までの月数（切り上げ）
+	Years     float64 `json:"years"`     // 到達までの年数
+	Reachable bool    `json:"reachable"` // 到達可能か
+}
+
+// RequiredReturnResult は必要利回り計算の結果を表す
+type RequiredReturnResult struct {
+	AnnualReturn float64 `json:"annual_return"` // 必要な年利回り（%、0.01%単位で切り上げ）
+	Reachable    bool    `json:"reachable"`     // 探索範囲（0〜100%）で到達可能か
+	Unrealistic  bool    `json:"unrealistic"`   // 閾値を超える非現実的な利回りか
+}
+
+// CalculateTimeToAmount は現在資産と月間積立額から目標金額に到達するまでの期間を計算する
+// 解析解 n = ln((T·i + C) / (P·i + C)) / ln(1 + i) を用い、月単位で切り上げる（精度±1ヶ月）
+// 純貯蓄が0以下で運用益でも資産が増えない場合や、100年以内に到達しない場合は到達不可とする
+func (fcs *FinancialCalculationService) CalculateTimeToAmount(
+	currentAssets valueobjects.Money,
+	monthlyContribution valueobjects.Money,
+	targetAmount valueobjects.Money,
+	annualReturn valueobjects.Rate,
+) (*TimeToAmountResult, error) {
+	if !targetAmount.IsPositive() {
+		return nil, errors.New("目標金額は正の値である必要があります")
+	}
+
+	principal := currentAssets.Amount()
+	contribution := monthlyContribution.Amount()
+	target := targetAmount.Amount()
+
+	if principal >= target {
+		return &TimeToAmountResult{Months: 0, Years: 0, Reachable: true}, nil
+	}
+
+	// Rate.MonthlyRate は小数点以下4桁に丸められ長期では1ヶ月以上ずれるため、年利から直接換算する
+	i := math.Pow(1+annualReturn.AsDecimal(), 1.0/12.0) - 1
+
+	unreachable := &TimeToAmountResult{Months: -1, Years: -1, Reachable: false}
+
+	var months float64
+	if i == 0 {
+		if contribution <= 0 {
+			return unreachable, nil
+		}
+		months = (target - principal) / contribution
+	} else {
+		// 1ヶ月あたりの資産増加（運用益＋積立）が0以下なら資産は増えない
+		denominator := principal*i + contribution
+		if denominator <= 0 {
+			return unreachable, nil
+		}
+		months = math.Log((target*i+contribution)/denominator) / math.Log(1+i)
+	}
+
+	// 浮動小数の誤差で整数月が1ヶ月ずれないよう、わずかな許容幅を設けて切り上げる
+	roundedMonths := int(math.Ceil(months - 1e-9))
+	if roundedMonths > maxTimeToAmountMonths {
+		return unreachable, nil
+	}
+
+	return &TimeToAmountResult{
+		Months:    roundedMonths,
+		Years:     math.Round(float64(roundedMonths)/12*100) / 100,
+		Reachable: true,
+	}, nil
+}
+
+// CalculateRequiredReturn は指定年数で目標金額に到達するために必要な年利回りを計算する
+// 0〜100%の範囲を二分探索し、0.01%単位で切り上げた値を返す（精度±0.01%）
+// unrealisticThreshold（%）を超える場合は非現実的とし、0以下の場合は初期値（15%）を用いる
+func (fcs *FinancialCalculationService) CalculateRequiredReturn(
+	currentAssets valueobjects.Money,
+	monthlyContribution valueobjects.Money,
+	targetAmount valueobjects.Money,
+	years int,
+	unrealisticThreshold float64,
+) (*RequiredReturnResult, error) {
+	if !targetAmount.IsPositive() {
+		return nil, errors.New("目標金額は正の値である必要があります")
+	}
+	if years <= 0 {
+		return nil, errors.New("年数は正の値である必要があります")
+	}
+	if unrealisticThreshold <= 0 {
+		unrealisticThreshold = DefaultUnrealisticReturnThreshold
+	}
+
+	principal := currentAssets.Amount()
+	contribution := monthlyContribution.Amount()
+	target := targetAmount.Amount()
+	months := years * 12
+
+	futureValueAt := func(annualPercent float64) float64 {
+		return fcs.FutureValue(principal, contribution, annualPercent, months)
+	}
+
+	// 運用益なしで到達できる場合は0%
+	if futureValueAt(0) >= target {
+		return &RequiredReturnResult{AnnualReturn: 0, Reachable: true}, nil
+	}
+
+	// 探索上限でも到達できない場合は到達不可
+	if futureValueAt(maxRequiredReturnPercent) < target {
+		return &RequiredReturnResult{
+			AnnualReturn: maxRequiredReturnPercent,
+			Reachable:    false,
+			Unrealistic:  true,
+		}, nil
+	}
+
+	// 二分探索: low では未到達、high では到達を保つ
+	low, high := 0.0, maxRequiredReturnPercent
+	for high-low > requiredReturnPrecisionPercent {
+		mid := (low + high) / 2
+		if futureValueAt(mid) >= target {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	annualReturn := math.Ceil(high*100) / 100
+
+	return &RequiredReturnResult{
+		AnnualReturn: annualReturn,
+		Reachable:    true,
+		Unrealistic:  annualReturn > unrealisticThreshold,
+	}, nil
+}
+
+// DrawdownEntry は退職後の取り崩し期間における1年分の資産推移を表す
+type DrawdownEntry struct {
+	Year            int     `json:"year"`             // 退職後の年数（1始まり）
+	StartBalance    float64 `json:"start_balance"`    // 年初残高
+	Withdrawals     float64 `json:"withdrawals"`      // 年間の取り崩し額（残高が尽きた場合は実際に取り崩せた額）
+	InvestmentGains float64 `json:"investment_gains"` // 年間の運用益
+	EndBalance      float64 `json:"end_balance"`      // 年末残高
+	Depleted        bool    `json:"depleted"`         // この年までに資産が尽きたか
+}
+
+// GenerateDrawdownSchedule は退職時の資産を毎月取り崩した場合の年ごとの資産推移を生成する
+// 月初残高に月次複利（年利 annualReturn% を月利に換算）で運用益を加えてから月末に取り崩し、
+// 残高が取り崩し額に満たない月は残高をすべて取り崩して以降の年を枯渇として扱う
+func (fcs *FinancialCalculationService) GenerateDrawdownSchedule(
+	startAssets float64,
+	monthlyWithdrawal float64,
+	annualReturn float64,
+	retirementYears int,
+) ([]DrawdownEntry, error) {
+	if startAssets < 0 {
+		return nil, errors.New("退職時の資産は0以上である必要があります")
+	}
+	if monthlyWithdrawal < 0 {
+		return nil, errors.New("月間取り崩し額は0以上である必要があります")
+	}
+	if annualReturn <= -100 {
+		return nil, errors.New("運用利回りは-100%より大きい必要があります")
+	}
+	if retirementYears <= 0 {
+		return nil, errors.New("退職後の年数は正の値である必要があります")
+	}
+
+	// CalculateTimeToAmount と同様に年利から月利を直接換算する
+	monthlyRate := math.Pow(1+annualReturn/100, 1.0/12.0) - 1
+
+	schedule := make([]DrawdownEntry, 0, retirementYears)
+	balance := startAssets
+	depleted := false
+	for year := 1; year <= retirementYears; year++ {
+		entry := DrawdownEntry{Year: year, StartBalance: balance}
+		for month := 0; month < 12 && !depleted; month++ {
+			gains := balance * monthlyRate
+			balance += gains
+			entry.InvestmentGains += gains
+
+			withdrawal := monthlyWithdrawal
+			if balance <= withdrawal {
+				withdrawal = balance
+				depleted = monthlyWithdrawal > 0
+			}
+			balance -= withdrawal
+			entry.Withdrawals += withdrawal
+		}
+		entry.EndBalance = balance
+		entry.Depleted = depleted
+		schedule = append(schedule, entry)
+	}
+
+	return schedule, nil
+}
+
+// maxAmortizationTermMonths は返済予定を生成できる返済期間の上限（50年）
+const maxAmortizationTermMonths = 600
+
+// AmortizationEntry は元利均等返済の1ヶ月分の返済予定
+type AmortizationEntry struct {
+	Month            int     `json:"month"`             // 返済開始からの月数（1始まり）
+	Payment          float64 `json:"payment"`           // その月の返済額（元金＋利息）
+	Principal        float64 `json:"principal"`         // 返済額のうち元金
+	Interest         float64 `json:"interest"`          // 返済額のうち利息
+	RemainingBalance float64 `json:"remaining_balance"` // 返済後の残高
+}
+
+// GenerateAmortizationSchedule は借入額を元利均等返済した場合の毎月の返済予定を生成する
+// 月利は年利 annualRate% の1/12とし、毎月の返済額と利息は円未満を四捨五入する。
+// 端数の累積で残る元金は最終回の返済額で調整するため、元金の合計は借入額に一致し、最終回の残高は0になる
+func (fcs *FinancialCalculationService) GenerateAmortizationSchedule(
+	loanAmount float64,
+	annualRate float64,
+	termMonths int,
+) ([]AmortizationEntry, error) {
+	if loanAmount <= 0 || math.IsInf(loanAmount, 0) || math.IsNaN(loanAmount) {
+		return nil, errors.New("借入額は正の値である必要があります")
+	}
+	if annualRate < 0 || annualRate > 100 || math.IsNaN(annualRate) {
+		return nil, errors.New("金利は0%以上100%以下である必要があります")
+	}
+	if termMonths <= 0 || termMonths > maxAmortizationTermMonths {
+		return nil, fmt.Errorf("返済期間は1〜%dヶ月である必要があります", maxAmortizationTermMonths)
+	}
+
+	// Liability.AmortizationFrom と同様に年利の1/12を月利とする（国内の住宅ローンの慣行）
+	monthlyRate := annualRate / 100 / 12
+	payment := loanAmount / float64(termMonths)
+	if monthlyRate > 0 {
+		payment = loanAmount * monthlyRate / (1 - math.Pow(1+monthlyRate, -float64(termMonths)))
+	}
+	payment = math.Round(payment)
+
+	schedule := make([]AmortizationEntry, 0, termMonths)
+	balance := loanAmount
+	for month := 1; month <= termMonths; month++ {
+		interest := math.Round(balance * monthlyRate)
+		principal := payment - interest
+		if month == termMonths || principal > balance {
+			principal = balance
+		}
+		balance -= principal
+
+		schedule = append(schedule, AmortizationEntry{
+			Month:            month,
+			Payment:          principal + interest,
+			Principal:        principal,
+			Interest:         interest,
+			RemainingBalance: balance,
+		})
+		if balance <= 0 {
+			break
+		}
+	}
+
+	return schedule, nil
+}
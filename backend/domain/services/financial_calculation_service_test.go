@@ -1,6 +1,7 @@
 package services
 
 import (
+	"math"
 	"testing"
 
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
@@ -189,6 +190,54 @@ func TestCalculatePresentValue(t *testing.T) {
 	}
 }
 
+func TestFutureValueWithInflation(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	// 100万円の10年後の名目価値（インフレ率2%）: 1,000,000 × 1.02^10
+	expected := 1000000 * math.Pow(1.02, 10)
+	if got := service.FutureValueWithInflation(1000000, 2, 10); math.Abs(got-expected) > 1e-6 {
+		t.Errorf("名目価値が期待値と異なります。期待値: %.6f, 実際: %.6f", expected, got)
+	}
+
+	// 高インフレでも複利で計算する（単純な割り算による近似と乖離しない）
+	expected = 1000000 * math.Pow(1.5, 10)
+	if got := service.FutureValueWithInflation(1000000, 50, 10); math.Abs(got-expected) > 1e-3 {
+		t.Errorf("高インフレ時の名目価値が期待値と異なります。期待値: %.3f, 実際: %.3f", expected, got)
+	}
+
+	// 年数が0の場合は調整しない
+	if got := service.FutureValueWithInflation(1000000, 2, 0); got != 1000000 {
+		t.Errorf("年数0の場合は元の値のはずです。実際: %f", got)
+	}
+}
+
+func TestPresentValue_RoundTrip(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	tests := []struct {
+		name      string
+		value     float64
+		inflation float64
+		years     int
+	}{
+		{"標準的なインフレ", 1000000, 2, 30},
+		{"デフレ", 500000, -1.5, 20},
+		{"高インフレ", 12345678, 80, 15},
+		{"インフレなし", 300000, 0, 10},
+		{"端数のある金額", 0.01, 3.3, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presentValue := service.PresentValue(tt.value, tt.inflation, tt.years)
+			roundTrip := service.FutureValueWithInflation(presentValue, tt.inflation, tt.years)
+			if math.Abs(roundTrip-tt.value) > tt.value*1e-12 {
+				t.Errorf("往復変換で値が一致しません。期待値: %.10f, 実際: %.10f", tt.value, roundTrip)
+			}
+		})
+	}
+}
+
 func TestCalculateEmergencyFundTarget(t *testing.T) {
 	service := NewFinancialCalculationService()
 
@@ -292,3 +341,513 @@ func TestFinancialCalculationServiceEdgeCases(t *testing.T) {
 		t.Error("ゼロ期間では最終金額は元本と同じになるはずです")
 	}
 }
+
+func TestRecommendedEquityRatio(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	tests := []struct {
+		age      int
+		expected float64
+	}{
+		{5, 90},  // 上限
+		{30, 70}, // 100 - 30
+		{65, 35}, // 100 - 65
+		{90, 20}, // 下限
+	}
+
+	for _, tt := range tests {
+		if got := service.RecommendedEquityRatio(tt.age); got != tt.expected {
+			t.Errorf("%d歳の株式比率が期待値と異なります: got %v, want %v", tt.age, got, tt.expected)
+		}
+	}
+}
+
+func TestEstimateNationalPension(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	tests := []struct {
+		name                 string
+		contributionYears    int
+		averageMonthlyIncome float64
+		birthYear            int
+		expected             float64
+	}{
+		{
+			// 厚生労働省のモデルケース相当: 平均標準報酬43.9万円で40年加入
+			// 報酬比例 439,000 × 5.481/1000 × 480 = 1,154,956 + 基礎年金 816,000
+			name:                 "モデルケース（40年加入・平均月収43.9万円）",
+			contributionYears:    40,
+			averageMonthlyIncome: 439000,
+			birthYear:            1980,
+			expected:             164246,
+		},
+		{
+			// 報酬比例 300,000 × 5.481/1000 × 360 = 591,948 + 基礎年金 816,000 × 360/480 = 612,000
+			name:                 "30年加入は基礎年金を按分",
+			contributionYears:    30,
+			averageMonthlyIncome: 300000,
+			birthYear:            1990,
+			expected:             100329,
+		},
+		{
+			// 昭和31年4月1日以前生まれは基礎年金満額が813,700円
+			name:                 "1956年以前生まれは旧満額を適用",
+			contributionYears:    40,
+			averageMonthlyIncome: 439000,
+			birthYear:            1955,
+			expected:             164055,
+		},
+		{
+			// 標準報酬月額の上限65万円で計算される
+			name:                 "標準報酬月額の上限を適用",
+			contributionYears:    40,
+			averageMonthlyIncome: 1000000,
+			birthYear:            1980,
+			expected:             210506,
+		},
+		{
+			// 基礎年金は480ヶ月で頭打ち、報酬比例部分は540ヶ月分加算される
+			name:                 "45年加入でも基礎年金は満額まで",
+			contributionYears:    45,
+			averageMonthlyIncome: 439000,
+			birthYear:            1980,
+			expected:             176277,
+		},
+		{
+			name:                 "加入年数が0の場合は0",
+			contributionYears:    0,
+			averageMonthlyIncome: 300000,
+			birthYear:            1980,
+			expected:             0,
+		},
+		{
+			name:                 "平均月収が負の場合は0",
+			contributionYears:    40,
+			averageMonthlyIncome: -1,
+			birthYear:            1980,
+			expected:             0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := service.EstimateNationalPension(tt.contributionYears, tt.averageMonthlyIncome, tt.birthYear)
+			if got != tt.expected {
+				t.Errorf("年金月額の推定値が期待値と異なります: expected %.0f, got %.0f", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCalculateTimeToAmount(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	// 年利6%・月10万円積立を120ヶ月続けたときの閉形式の将来価値
+	monthlyRate6 := math.Pow(1.06, 1.0/12.0) - 1
+	fv120 := 100000 * (math.Pow(1+monthlyRate6, 120) - 1) / monthlyRate6
+
+	tests := []struct {
+		name              string
+		currentAssets     float64
+		contribution      float64
+		target            float64
+		annualReturn      float64
+		expectedMonths    int
+		expectedReachable bool
+	}{
+		{
+			// 利回り0%: (200万 - 100万) / 10万 = 10ヶ月
+			name:              "利回り0%は単純な割り算",
+			currentAssets:     1000000,
+			contribution:      100000,
+			target:            2000000,
+			annualReturn:      0,
+			expectedMonths:    10,
+			expectedReachable: true,
+		},
+		{
+			name:              "年利6%・月10万円積立で閉形式の120ヶ月後の金額に到達",
+			currentAssets:     0,
+			contribution:      100000,
+			target:            math.Floor(fv120),
+			annualReturn:      6,
+			expectedMonths:    120,
+			expectedReachable: true,
+		},
+		{
+			// 100万円を年利5%で運用し、積立なしで (1.05)^10 倍 = 約162.9万円に到達するのは120ヶ月後
+			name:              "積立なしでも運用益のみで到達",
+			currentAssets:     1000000,
+			contribution:      0,
+			target:            1628894,
+			annualReturn:      5,
+			expectedMonths:    120,
+			expectedReachable: true,
+		},
+		{
+			name:              "既に目標金額以上の場合は0ヶ月",
+			currentAssets:     3000000,
+			contribution:      0,
+			target:            2000000,
+			annualReturn:      3,
+			expectedMonths:    0,
+			expectedReachable: true,
+		},
+		{
+			name:              "純貯蓄が0以下で運用益もない場合は到達不可",
+			currentAssets:     1000000,
+			contribution:      -10000,
+			target:            2000000,
+			annualReturn:      0,
+			expectedMonths:    -1,
+			expectedReachable: false,
+		},
+		{
+			// 月間運用益（約2,470円）が取り崩し額（1万円）を下回る
+			name:              "運用益が取り崩しを補えない場合は到達不可",
+			currentAssets:     1000000,
+			contribution:      -10000,
+			target:            2000000,
+			annualReturn:      3,
+			expectedMonths:    -1,
+			expectedReachable: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentAssets, _ := valueobjects.NewMoneyJPY(tt.currentAssets)
+			contribution, _ := valueobjects.NewMoneyJPY(tt.contribution)
+			target, _ := valueobjects.NewMoneyJPY(tt.target)
+			rate, _ := valueobjects.NewRate(tt.annualReturn)
+
+			result, err := service.CalculateTimeToAmount(currentAssets, contribution, target, rate)
+			if err != nil {
+				t.Fatalf("目標到達期間の計算に失敗しました: %v", err)
+			}
+			if result.Reachable != tt.expectedReachable {
+				t.Errorf("到達可否が期待値と異なります。期待値: %v, 実際: %v", tt.expectedReachable, result.Reachable)
+			}
+			if result.Months != tt.expectedMonths {
+				t.Errorf("月数が期待値と異なります。期待値: %d, 実際: %d", tt.expectedMonths, result.Months)
+			}
+		})
+	}
+
+	t.Run("目標金額が0以下の場合はエラー", func(t *testing.T) {
+		zero, _ := valueobjects.NewMoneyJPY(0)
+		rate, _ := valueobjects.NewRate(5)
+		if _, err := service.CalculateTimeToAmount(zero, zero, zero, rate); err == nil {
+			t.Error("エラーが期待されましたが、発生しませんでした")
+		}
+	})
+}
+
+func TestCalculateRequiredReturn(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	// 年利7%・月5万円積立で20年運用したときの閉形式の将来価値
+	monthlyRate7 := math.Pow(1.07, 1.0/12.0) - 1
+	growth7 := math.Pow(1+monthlyRate7, 240)
+	fv7 := 1000000*growth7 + 50000*(growth7-1)/monthlyRate7
+
+	tests := []struct {
+		name                string
+		currentAssets       float64
+		contribution        float64
+		target              float64
+		years               int
+		threshold           float64
+		expectedReturn      float64
+		expectedReachable   bool
+		expectedUnrealistic bool
+	}{
+		{
+			// 100万円 × (1.05)^10 に到達するには年利5%
+			name:              "積立なしの一括運用",
+			currentAssets:     1000000,
+			contribution:      0,
+			target:            1000000 * math.Pow(1.05, 10),
+			years:             10,
+			expectedReturn:    5.00,
+			expectedReachable: true,
+		},
+		{
+			name:              "積立ありで閉形式の将来価値から年利7%を逆算",
+			currentAssets:     1000000,
+			contribution:      50000,
+			target:            fv7,
+			years:             20,
+			expectedReturn:    7.00,
+			expectedReachable: true,
+		},
+		{
+			name:              "積立のみで到達できる場合は0%",
+			currentAssets:     1000000,
+			contribution:      100000,
+			target:            2000000,
+			years:             1,
+			expectedReturn:    0,
+			expectedReachable: true,
+		},
+		{
+			// 10年で10倍にするには (10)^(1/10) - 1 ≈ 25.89%
+			name:                "初期値の閾値15%を超える場合は非現実的",
+			currentAssets:       1000000,
+			contribution:        0,
+			target:              10000000,
+			years:               10,
+			expectedReturn:      25.89,
+			expectedReachable:   true,
+			expectedUnrealistic: true,
+		},
+		{
+			name:              "閾値を指定した場合はその値で判定",
+			currentAssets:     1000000,
+			contribution:      0,
+			target:            10000000,
+			years:             10,
+			threshold:         30,
+			expectedReturn:    25.89,
+			expectedReachable: true,
+		},
+		{
+			name:                "年利100%でも到達できない場合は到達不可",
+			currentAssets:       1000,
+			contribution:        0,
+			target:              1000000000,
+			years:               1,
+			expectedReturn:      100,
+			expectedReachable:   false,
+			expectedUnrealistic: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentAssets, _ := valueobjects.NewMoneyJPY(tt.currentAssets)
+			contribution, _ := valueobjects.NewMoneyJPY(tt.contribution)
+			target, _ := valueobjects.NewMoneyJPY(tt.target)
+
+			result, err := service.CalculateRequiredReturn(currentAssets, contribution, target, tt.years, tt.threshold)
+			if err != nil {
+				t.Fatalf("必要利回りの計算に失敗しました: %v", err)
+			}
+			// 精度は±0.01%
+			if math.Abs(result.AnnualReturn-tt.expectedReturn) > 0.01 {
+				t.Errorf("必要利回りが期待値と異なります。期待値: %.2f%%, 実際: %.4f%%", tt.expectedReturn, result.AnnualReturn)
+			}
+			if result.Reachable != tt.expectedReachable {
+				t.Errorf("到達可否が期待値と異なります。期待値: %v, 実際: %v", tt.expectedReachable, result.Reachable)
+			}
+			if result.Unrealistic != tt.expectedUnrealistic {
+				t.Errorf("非現実的判定が期待値と異なります。期待値: %v, 実際: %v", tt.expectedUnrealistic, result.Unrealistic)
+			}
+		})
+	}
+
+	t.Run("年数が0以下の場合はエラー", func(t *testing.T) {
+		money, _ := valueobjects.NewMoneyJPY(1000000)
+		if _, err := service.CalculateRequiredReturn(money, money, money, 0, 0); err == nil {
+			t.Error("エラーが期待されましたが、発生しませんでした")
+		}
+	})
+}
+
+func TestGenerateDrawdownSchedule(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	// depletionYear は最初に資産が尽きた年を返す（尽きない場合は0）
+	depletionYear := func(schedule []DrawdownEntry) int {
+		for _, entry := range schedule {
+			if entry.Depleted {
+				return entry.Year
+			}
+		}
+		return 0
+	}
+
+	t.Run("必要額を上回る資産がある場合は枯渇しない", func(t *testing.T) {
+		// 3,000万円から月10万円を20年間（総額2,400万円）取り崩す
+		schedule, err := service.GenerateDrawdownSchedule(30000000, 100000, 0, 20)
+		if err != nil {
+			t.Fatalf("取り崩し計画の生成に失敗しました: %v", err)
+		}
+		if len(schedule) != 20 {
+			t.Fatalf("年数が期待値と異なります。期待値: 20, 実際: %d", len(schedule))
+		}
+		if year := depletionYear(schedule); year != 0 {
+			t.Errorf("枯渇しないはずが%d年目に枯渇しました", year)
+		}
+		last := schedule[len(schedule)-1]
+		if math.Abs(last.EndBalance-6000000) > 0.01 {
+			t.Errorf("最終残高が期待値と異なります。期待値: 6000000, 実際: %.2f", last.EndBalance)
+		}
+		for i := 1; i < len(schedule); i++ {
+			if schedule[i].StartBalance != schedule[i-1].EndBalance {
+				t.Errorf("%d年目の年初残高が前年の年末残高と一致しません", schedule[i].Year)
+			}
+		}
+	})
+
+	t.Run("資産が不足する場合は枯渇する年が正確に分かる", func(t *testing.T) {
+		// 1,000万円から月10万円を取り崩すと100ヶ月目（9年目）に尽きる
+		schedule, err := service.GenerateDrawdownSchedule(10000000, 100000, 0, 20)
+		if err != nil {
+			t.Fatalf("取り崩し計画の生成に失敗しました: %v", err)
+		}
+		if year := depletionYear(schedule); year != 9 {
+			t.Fatalf("枯渇する年が期待値と異なります。期待値: 9, 実際: %d", year)
+		}
+		if math.Abs(schedule[7].EndBalance-400000) > 0.01 {
+			t.Errorf("8年目の年末残高が期待値と異なります。期待値: 400000, 実際: %.2f", schedule[7].EndBalance)
+		}
+		if math.Abs(schedule[8].Withdrawals-400000) > 0.01 || schedule[8].EndBalance != 0 {
+			t.Errorf("9年目は残高40万円をすべて取り崩すはずです: %+v", schedule[8])
+		}
+		for _, entry := range schedule[9:] {
+			if !entry.Depleted || entry.Withdrawals != 0 || entry.EndBalance != 0 {
+				t.Errorf("枯渇後の年は残高0のままであるべきです: %+v", entry)
+			}
+		}
+	})
+
+	t.Run("運用益があると枯渇する年が遅くなる", func(t *testing.T) {
+		withoutReturn, err := service.GenerateDrawdownSchedule(10000000, 100000, 0, 20)
+		if err != nil {
+			t.Fatalf("取り崩し計画の生成に失敗しました: %v", err)
+		}
+		withReturn, err := service.GenerateDrawdownSchedule(10000000, 100000, 3, 20)
+		if err != nil {
+			t.Fatalf("取り崩し計画の生成に失敗しました: %v", err)
+		}
+
+		// 年3%では約115ヶ月目（10年目）に尽きる
+		if year := depletionYear(withReturn); year != 10 {
+			t.Errorf("枯渇する年が期待値と異なります。期待値: 10, 実際: %d", year)
+		}
+		if depletionYear(withReturn) <= depletionYear(withoutReturn) {
+			t.Errorf("運用益があると枯渇が遅くなるべきです。運用なし: %d年目, 運用あり: %d年目",
+				depletionYear(withoutReturn), depletionYear(withReturn))
+		}
+		if withReturn[0].InvestmentGains <= 0 {
+			t.Errorf("運用益が計上されていません: %+v", withReturn[0])
+		}
+	})
+
+	t.Run("不正な入力はエラー", func(t *testing.T) {
+		if _, err := service.GenerateDrawdownSchedule(-1, 100000, 0, 20); err == nil {
+			t.Error("負の資産はエラーになるべきです")
+		}
+		if _, err := service.GenerateDrawdownSchedule(10000000, -1, 0, 20); err == nil {
+			t.Error("負の取り崩し額はエラーになるべきです")
+		}
+		if _, err := service.GenerateDrawdownSchedule(10000000, 100000, 0, 0); err == nil {
+			t.Error("退職後の年数が0の場合はエラーになるべきです")
+		}
+	})
+}
+
+func TestGenerateAmortizationSchedule(t *testing.T) {
+	service := NewFinancialCalculationService()
+
+	tests := []struct {
+		name        string
+		loanAmount  float64
+		annualRate  float64
+		termMonths  int
+		wantPayment float64 // 初回の返済額
+	}{
+		{"住宅ローン3,000万円・年1.5%・35年", 30000000, 1.5, 420, 91855},
+		{"住宅ローン4,500万円・年0.5%・35年", 45000000, 0.5, 420, 116813},
+		{"金利0%は元金を均等に返済する", 1200000, 0, 12, 100000},
+		{"端数が出る借入額", 1234567, 3.2, 37, 35084},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := service.GenerateAmortizationSchedule(tt.loanAmount, tt.annualRate, tt.termMonths)
+			if err != nil {
+				t.Fatalf("返済予定の生成に失敗しました: %v", err)
+			}
+			if len(schedule) != tt.termMonths {
+				t.Fatalf("返済回数が期待値と異なります。期待値: %d, 実際: %d", tt.termMonths, len(schedule))
+			}
+			if schedule[0].Payment != tt.wantPayment {
+				t.Errorf("初回の返済額が期待値と異なります。期待値: %.0f, 実際: %.0f", tt.wantPayment, schedule[0].Payment)
+			}
+
+			var totalPrincipal float64
+			for i, entry := range schedule {
+				totalPrincipal += entry.Principal
+				if entry.Month != i+1 {
+					t.Errorf("%d回目の月数が期待値と異なります: %d", i+1, entry.Month)
+				}
+				if entry.Payment != entry.Principal+entry.Interest {
+					t.Errorf("%d回目の返済額が元金と利息の合計と一致しません: %+v", entry.Month, entry)
+				}
+			}
+			if math.Abs(totalPrincipal-tt.loanAmount) > 0.01 {
+				t.Errorf("元金の合計が借入額と一致しません。期待値: %.0f, 実際: %.2f", tt.loanAmount, totalPrincipal)
+			}
+			if last := schedule[len(schedule)-1]; math.Abs(last.RemainingBalance) > 1 {
+				t.Errorf("最終回の残高が0円（±1円）ではありません: %.2f", last.RemainingBalance)
+			}
+		})
+	}
+
+	t.Run("返済が進むほど元金の割合が増える", func(t *testing.T) {
+		schedule, err := service.GenerateAmortizationSchedule(30000000, 1.5, 420)
+		if err != nil {
+			t.Fatalf("返済予定の生成に失敗しました: %v", err)
+		}
+		// 初回の利息は 3,000万円 × 1.5% / 12 = 37,500円
+		if schedule[0].Interest != 37500 {
+			t.Errorf("初回の利息が期待値と異なります。期待値: 37500, 実際: %.0f", schedule[0].Interest)
+		}
+		if schedule[419].Principal <= schedule[0].Principal || schedule[419].Interest >= schedule[0].Interest {
+			t.Errorf("最終回は初回より元金が多く利息が少ないべきです: 初回 %+v, 最終回 %+v", schedule[0], schedule[419])
+		}
+	})
+
+	t.Run("不正な入力はエラー", func(t *testing.T) {
+		if _, err := service.GenerateAmortizationSchedule(0, 1.5, 420); err == nil {
+			t.Error("借入額が0の場合はエラーになるべきです")
+		}
+		if _, err := service.GenerateAmortizationSchedule(30000000, -0.1, 420); err == nil {
+			t.Error("負の金利はエラーになるべきです")
+		}
+		if _, err := service.GenerateAmortizationSchedule(30000000, 1.5, 0); err == nil {
+			t.Error("返済期間が0の場合はエラーになるべきです")
+		}
+		if _, err := service.GenerateAmortizationSchedule(30000000, 1.5, 601); err == nil {
+			t.Error("返済期間が50年を超える場合はエラーになるべきです")
+		}
+	})
+}
+
+func TestNewFinancialCalculationServiceWithDefaults(t *testing.T) {
+	if got := NewFinancialCalculationService().Defaults(); got != DefaultCalculationDefaults() {
+		t.Errorf("既定の前提値が設定されていません: %+v", got)
+	}
+
+	service := NewFinancialCalculationServiceWithDefaults(CalculationDefaults{
+		RetirementPlanningYears: 25,
+		InvestmentReturn:        4.0,
+		RecommendedSavingsRate:  15.0,
+		EmergencyFundMonths:     6,
+	})
+	if got := service.Defaults(); got.RetirementPlanningYears != 25 || got.InvestmentReturn != 4.0 || got.RecommendedSavingsRate != 15.0 || got.EmergencyFundMonths != 6 {
+		t.Errorf("指定した前提値が設定されていません: %+v", got)
+	}
+
+	// 0以下の前提値はデフォルト値になる
+	service = NewFinancialCalculationServiceWithDefaults(CalculationDefaults{RetirementPlanningYears: -1, EmergencyFundMonths: 6})
+	got := service.Defaults()
+	if got.RetirementPlanningYears != DefaultRetirementPlanningYears || got.InvestmentReturn != DefaultInvestmentReturn || got.RecommendedSavingsRate != DefaultRecommendedSavingsRate {
+		t.Errorf("未設定の前提値にデフォルト値が設定されていません: %+v", got)
+	}
+	if got.EmergencyFundMonths != 6 {
+		t.Errorf("指定した緊急資金の推奨月数が上書きされています: %v", got.EmergencyFundMonths)
+	}
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+const (
+	// AchievementPaceMonths は積立ペースの実績として平均する直近の月数
+	AchievementPaceMonths = 6
+	// MinAchievementPaceMonths は積立ペースの実績から推定するのに必要な最低限の月数
+	// これに満たない場合は計画上の月間拠出額で推定する
+	MinAchievementPaceMonths = 3
+	// plannedContributionVariation は計画ベースで推定する場合に想定する毎月の積立額のばらつき（月間拠出額に対する標準偏差の割合）
+	plannedContributionVariation = 0.2
+	// paceTolerance は実績の積立額が計画どおりとみなす計画との差（計画の月間拠出額に対する割合）
+	paceTolerance = 0.05
+)
+
+// AchievementBasis は達成確率の推定に使った積立額の根拠
+type AchievementBasis string
+
+const (
+	AchievementBasisActualPace          AchievementBasis = "actual_pace"          // 進捗履歴の実績の積立ペース
+	AchievementBasisPlannedContribution AchievementBasis = "planned_contribution" // 計画上の月間拠出額（履歴が不足している場合）
+)
+
+// PaceStatus は実績の積立ペースと計画の比較
+type PaceStatus string
+
+const (
+	PaceAhead   PaceStatus = "ahead"    // 計画を上回っている
+	PaceOnTrack PaceStatus = "on_track" // 計画どおり
+	PaceBehind  PaceStatus = "behind"   // 計画を下回っている
+	PaceUnknown PaceStatus = "unknown"  // 履歴が不足しているため比較できない
+)
+
+// AchievementProbabilityEstimate は期限までに目標金額に到達する確率の推定結果
+type AchievementProbabilityEstimate struct {
+	Probability         float64              `json:"probability"` // 達成確率（%）
+	Basis               AchievementBasis     `json:"basis"`
+	FallbackReason      string               `json:"fallback_reason,omitempty"` // 計画ベースで推定した理由
+	PaceStatus          PaceStatus           `json:"pace_status"`
+	EarlyCompletionDate *time.Time           `json:"early_completion_date,omitempty"` // 計画を上回るペースで期限より前に達成する見込みの日付
+	Rationale           AchievementRationale `json:"rationale"`
+}
+
+// AchievementRationale は達成確率の算出根拠
+// 毎月の積立額が平均 AverageMonthlyContribution・標準偏差 ContributionStdDev の正規分布に従うとみなし、
+// 残り RemainingMonths ヶ月の積立総額が RemainingAmount 以上になる確率を達成確率とする
+type AchievementRationale struct {
+	HistoryMonths               int     `json:"history_months"`                // 平均に使った実績の月数（計画ベースの場合は履歴にある月数）
+	AverageMonthlyContribution  float64 `json:"average_monthly_contribution"`  // 推定に使った毎月の積立額
+	ContributionStdDev          float64 `json:"contribution_std_dev"`          // 毎月の積立額の標準偏差
+	PlannedMonthlyContribution  float64 `json:"planned_monthly_contribution"`  // 計画上の月間拠出額
+	RequiredMonthlyContribution float64 `json:"required_monthly_contribution"` // 期限までに達成するのに必要な毎月の積立額
+	RemainingAmount             float64 `json:"remaining_amount"`
+	RemainingMonths             int     `json:"remaining_months"`
+	ExpectedAmountAtDeadline    float64 `json:"expected_amount_at_deadline"` // 推定した積立額で続けた場合の期限時点の見込み額
+	Explanation                 string  `json:"explanation"`
+}
+
+// AchievementHistorySince は達成確率の推定に使う進捗履歴の取得開始日時を返す
+// 直近 AchievementPaceMonths ヶ月分の積立額を求めるため、その前月の初日から取得する
+func AchievementHistorySince(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -AchievementPaceMonths, 0)
+}
+
+// EstimateAchievementProbability は進捗履歴の直近の積立ペースから、期限までに目標金額に到達する確率を推定する
+// history は記録日時の昇順であること。実績の月数が MinAchievementPaceMonths に満たない場合は計画上の月間拠出額で推定する
+func (grs *GoalRecommendationService) EstimateAchievementProbability(
+	goal *entities.Goal,
+	history []*entities.GoalProgressSnapshot,
+	now time.Time,
+) (*AchievementProbabilityEstimate, error) {
+	if goal == nil {
+		return nil, errors.New("目標は必須です")
+	}
+
+	remainingAmount, err := goal.GetRemainingAmount()
+	if err != nil {
+		return nil, fmt.Errorf("残り必要金額の計算に失敗しました: %w", err)
+	}
+	remaining := math.Max(remainingAmount.Amount(), 0)
+	remainingMonths := goal.RemainingMonthsFrom(now)
+	planned := goal.MonthlyContribution().Amount()
+
+	contributions := entities.MonthlyGoalContributions(history)
+	if len(contributions) > AchievementPaceMonths {
+		contributions = contributions[len(contributions)-AchievementPaceMonths:]
+	}
+
+	estimate := &AchievementProbabilityEstimate{
+		Basis:      AchievementBasisActualPace,
+		PaceStatus: PaceUnknown,
+		Rationale: AchievementRationale{
+			HistoryMonths:              len(contributions),
+			PlannedMonthlyContribution: planned,
+			RemainingAmount:            remaining,
+			RemainingMonths:            remainingMonths,
+		},
+	}
+	rationale := &estimate.Rationale
+
+	var mean, stdDev float64
+	if len(contributions) >= MinAchievementPaceMonths {
+		mean, stdDev = meanAndStdDev(contributions)
+		estimate.PaceStatus = comparePace(mean, planned)
+	} else {
+		estimate.Basis = AchievementBasisPlannedContribution
+		estimate.FallbackReason = fmt.Sprintf(
+			"進捗履歴が%dヶ月分しかないため（%dヶ月分以上必要）、計画上の月間拠出額で推定しました",
+			len(contributions), MinAchievementPaceMonths)
+		mean, stdDev = planned, planned*plannedContributionVariation
+	}
+	rationale.AverageMonthlyContribution = math.Round(mean)
+	rationale.ContributionStdDev = math.Round(stdDev)
+	rationale.ExpectedAmountAtDeadline = math.Round(goal.CurrentAmount().Amount() + math.Max(mean, 0)*float64(remainingMonths))
+	if remainingMonths > 0 {
+		rationale.RequiredMonthlyContribution = math.Ceil(remaining / float64(remainingMonths))
+	}
+
+	switch {
+	case remaining == 0:
+		estimate.Probability = 100
+		rationale.Explanation = "目標金額に到達済みです"
+		return estimate, nil
+	case remainingMonths == 0:
+		estimate.Probability = 0
+		rationale.Explanation = fmt.Sprintf("期限までに積み立てられる月が残っていないため、残り%.0f円に到達できません", remaining)
+		return estimate, nil
+	}
+
+	estimate.Probability = math.Round(reachProbability(mean, stdDev, remainingMonths, remaining)*1000) / 10
+
+	var explanation strings.Builder
+	if estimate.Basis == AchievementBasisActualPace {
+		fmt.Fprintf(&explanation, "直近%dヶ月の平均積立額 %.0f円（標準偏差 %.0f円）", len(contributions), mean, stdDev)
+	} else {
+		fmt.Fprintf(&explanation, "計画上の月間拠出額 %.0f円（ばらつきを%.0f%%と想定）", planned, plannedContributionVariation*100)
+	}
+	fmt.Fprintf(&explanation, "で積み立てると、期限までの%dヶ月で約%.0f円の積立が見込まれます（残り%.0f円、必要な積立額は月%.0f円）",
+		remainingMonths, math.Max(mean, 0)*float64(remainingMonths), remaining, rationale.RequiredMonthlyContribution)
+
+	switch estimate.PaceStatus {
+	case PaceBehind:
+		fmt.Fprintf(&explanation, "。積立額が計画（月%.0f円）を下回っているため、計画どおりに積み立てる場合より達成確率は低くなります", planned)
+	case PaceAhead:
+		completionDate := now.AddDate(0, int(math.Ceil(remaining/mean)), 0)
+		if completionDate.Before(goal.TargetDate()) {
+			estimate.EarlyCompletionDate = &completionDate
+			fmt.Fprintf(&explanation, "。計画（月%.0f円）を上回るペースのため、期限より前の%sに達成する見込みです",
+				planned, completionDate.Format("2006年1月"))
+		}
+	}
+	rationale.Explanation = explanation.String()
+
+	return estimate, nil
+}
+
+// comparePace は実績の平均積立額を計画上の月間拠出額と比較する
+func comparePace(actual, planned float64) PaceStatus {
+	tolerance := planned * paceTolerance
+	switch {
+	case actual > planned+tolerance:
+		return PaceAhead
+	case actual < planned-tolerance:
+		return PaceBehind
+	default:
+		return PaceOnTrack
+	}
+}
+
+// reachProbability は毎月の積立額が平均 mean・標準偏差 stdDev の正規分布に従う場合に、
+// months ヶ月の積立総額が target 以上になる確率（0〜1）を返す
+func reachProbability(mean, stdDev float64, months int, target float64) float64 {
+	expected := mean * float64(months)
+	spread := stdDev * math.Sqrt(float64(months))
+	if spread == 0 {
+		if expected >= target {
+			return 1
+		}
+		return 0
+	}
+	z := (expected - target) / spread
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// meanAndStdDev は値の平均と標本標準偏差を返す
+func meanAndStdDev(values []float64) (float64, float64) {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+
+	var squares float64
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)-1))
+}
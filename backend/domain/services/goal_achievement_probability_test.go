@@ -0,0 +1,204 @@
+package services
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// newProbabilityTestGoal は目標金額2,000,000円・現在金額1,000,000円・計画上の月間拠出額50,000円で、
+// start から2年後を期限とする目標を作成するヘルパー
+func newProbabilityTestGoal(t *testing.T, start time.Time) *entities.Goal {
+	t.Helper()
+	target, _ := valueobjects.NewMoneyJPY(2000000)
+	contribution, _ := valueobjects.NewMoneyJPY(50000)
+	current, _ := valueobjects.NewMoneyJPY(1000000)
+
+	goal, err := entities.NewGoal("user-001", entities.GoalTypeSavings, "住宅購入資金", target, start.AddDate(2, 0, 0), contribution)
+	if err != nil {
+		t.Fatalf("目標の作成に失敗しました: %v", err)
+	}
+	if err := goal.UpdateCurrentAmount(current); err != nil {
+		t.Fatalf("現在金額の設定に失敗しました: %v", err)
+	}
+	return goal
+}
+
+// progressHistory は start の len(contributions) ヶ月前から毎月 contributions ずつ積み立てて、start 時点で1,000,000円になる進捗履歴を作成するヘルパー
+func progressHistory(t *testing.T, start time.Time, contributions ...float64) []*entities.GoalProgressSnapshot {
+	t.Helper()
+	amount := 1000000.0
+	for _, c := range contributions {
+		amount -= c
+	}
+
+	history := make([]*entities.GoalProgressSnapshot, 0, len(contributions)+1)
+	for i := 0; i <= len(contributions); i++ {
+		if i > 0 {
+			amount += contributions[i-1]
+		}
+		snapshot, err := entities.NewGoalProgressSnapshot("goal-001", "user-001", amount, start.AddDate(0, i-len(contributions), 0))
+		if err != nil {
+			t.Fatalf("進捗の記録の作成に失敗しました: %v", err)
+		}
+		history = append(history, snapshot)
+	}
+	return history
+}
+
+func TestEstimateAchievementProbability(t *testing.T) {
+	service := NewGoalRecommendationService(NewFinancialCalculationService())
+	// 月末をまたいで暦月がずれないよう、月初を基準にする
+	now := time.Now()
+	start := time.Date(now.Year(), now.Month(), 1, 12, 0, 0, 0, time.Local)
+
+	t.Run("積立が計画を下回っている場合は達成確率が下がる", func(t *testing.T) {
+		goal := newProbabilityTestGoal(t, start)
+		planned, err := service.EstimateAchievementProbability(goal, nil, start)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		estimate, err := service.EstimateAchievementProbability(goal, progressHistory(t, start, 30000, 32000, 28000, 31000, 29000, 30000), start)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		if estimate.Basis != AchievementBasisActualPace {
+			t.Errorf("Basis = %s, want %s", estimate.Basis, AchievementBasisActualPace)
+		}
+		if estimate.PaceStatus != PaceBehind {
+			t.Errorf("PaceStatus = %s, want %s", estimate.PaceStatus, PaceBehind)
+		}
+		if estimate.Probability >= planned.Probability {
+			t.Errorf("計画を下回るペースの達成確率（%.1f%%）は計画ベース（%.1f%%）より低いべきです", estimate.Probability, planned.Probability)
+		}
+		if estimate.Rationale.AverageMonthlyContribution != 30000 || estimate.Rationale.HistoryMonths != 6 {
+			t.Errorf("算出根拠が期待値と異なります: %+v", estimate.Rationale)
+		}
+		if estimate.EarlyCompletionDate != nil {
+			t.Error("計画を下回るペースでは前倒し完了日を返さないべきです")
+		}
+		if !strings.Contains(estimate.Rationale.Explanation, "計画（月50000円）を下回っている") {
+			t.Errorf("説明に計画を下回っている旨を含めるべきです: %s", estimate.Rationale.Explanation)
+		}
+	})
+
+	t.Run("積立が計画を上回っている場合は前倒し完了日を返す", func(t *testing.T) {
+		goal := newProbabilityTestGoal(t, start)
+
+		estimate, err := service.EstimateAchievementProbability(goal, progressHistory(t, start, 100000, 98000, 102000, 100000), start)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		if estimate.PaceStatus != PaceAhead {
+			t.Errorf("PaceStatus = %s, want %s", estimate.PaceStatus, PaceAhead)
+		}
+		if estimate.Probability < 99 {
+			t.Errorf("期限までに十分な積立が見込まれる場合の達成確率は高いべきです: %.1f%%", estimate.Probability)
+		}
+		if estimate.EarlyCompletionDate == nil {
+			t.Fatal("前倒し完了日を返すべきです")
+		}
+		// 残り1,000,000円を月100,000円で積み立てると10ヶ月後に達成する
+		if want := start.AddDate(0, 10, 0); !estimate.EarlyCompletionDate.Equal(want) {
+			t.Errorf("EarlyCompletionDate = %v, want %v", estimate.EarlyCompletionDate, want)
+		}
+	})
+
+	t.Run("直近の月数分の積立額のみを平均する", func(t *testing.T) {
+		goal := newProbabilityTestGoal(t, start)
+
+		estimate, err := service.EstimateAchievementProbability(goal, progressHistory(t, start, 200000, 200000, 50000, 50000, 50000, 50000, 50000, 50000), start)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		if estimate.Rationale.HistoryMonths != AchievementPaceMonths {
+			t.Errorf("HistoryMonths = %d, want %d", estimate.Rationale.HistoryMonths, AchievementPaceMonths)
+		}
+		if estimate.PaceStatus != PaceOnTrack || estimate.Rationale.AverageMonthlyContribution != 50000 {
+			t.Errorf("直近%dヶ月より前の積立額は平均に含めないべきです: %s, %+v", AchievementPaceMonths, estimate.PaceStatus, estimate.Rationale)
+		}
+	})
+
+	t.Run("履歴が不足している場合は計画ベースにフォールバックする", func(t *testing.T) {
+		goal := newProbabilityTestGoal(t, start)
+
+		estimate, err := service.EstimateAchievementProbability(goal, progressHistory(t, start, 30000, 30000), start)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+
+		if estimate.Basis != AchievementBasisPlannedContribution {
+			t.Errorf("Basis = %s, want %s", estimate.Basis, AchievementBasisPlannedContribution)
+		}
+		if estimate.PaceStatus != PaceUnknown {
+			t.Errorf("PaceStatus = %s, want %s", estimate.PaceStatus, PaceUnknown)
+		}
+		if !strings.Contains(estimate.FallbackReason, "2ヶ月分") {
+			t.Errorf("フォールバックの理由に履歴の月数を含めるべきです: %s", estimate.FallbackReason)
+		}
+		if estimate.Rationale.AverageMonthlyContribution != 50000 || estimate.Rationale.ContributionStdDev != 10000 {
+			t.Errorf("計画上の月間拠出額で推定するべきです: %+v", estimate.Rationale)
+		}
+	})
+
+	t.Run("目標金額に到達済みの場合は100%", func(t *testing.T) {
+		goal := newProbabilityTestGoal(t, start)
+		reached, _ := valueobjects.NewMoneyJPY(2000000)
+		if err := goal.UpdateCurrentAmount(reached); err != nil {
+			t.Fatalf("現在金額の更新に失敗しました: %v", err)
+		}
+
+		estimate, err := service.EstimateAchievementProbability(goal, nil, start)
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if estimate.Probability != 100 {
+			t.Errorf("Probability = %.1f, want 100", estimate.Probability)
+		}
+	})
+
+	t.Run("期限までに積み立てる月が残っていない場合は0%", func(t *testing.T) {
+		goal := newProbabilityTestGoal(t, start)
+
+		estimate, err := service.EstimateAchievementProbability(goal, nil, goal.TargetDate())
+		if err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		if estimate.Probability != 0 {
+			t.Errorf("Probability = %.1f, want 0", estimate.Probability)
+		}
+	})
+}
+
+func TestReachProbability(t *testing.T) {
+	tests := []struct {
+		name   string
+		mean   float64
+		stdDev float64
+		months int
+		target float64
+		want   float64
+	}{
+		{"見込み額がちょうど目標額の場合は50%", 50000, 10000, 12, 600000, 0.5},
+		{"見込み額が標準偏差1つ分上回る場合は約84%", 50000, 10000, 4, 180000, 0.8413},
+		{"ばらつきがなく目標額に届く場合は100%", 50000, 0, 12, 600000, 1},
+		{"ばらつきがなく目標額に届かない場合は0%", 50000, 0, 12, 600001, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reachProbability(tt.mean, tt.stdDev, tt.months, tt.target)
+			if math.Abs(got-tt.want) > 0.0001 {
+				t.Errorf("reachProbability = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
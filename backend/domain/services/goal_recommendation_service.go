@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/entities"
@@ -195,6 +196,71 @@ func (grs *GoalRecommendationService) SuggestGoalAdjustments(
 	return recommendations, nil
 }
 
+// EffectiveROI は目標に拠出する1円あたりで達成できる目標金額（目標金額 ÷ 期限までに必要な拠出総額）を返す
+// 必要な拠出総額は残額を残り月数（概算、最低1ヶ月）で毎月積み立てる場合の総額で、プロファイルの想定利回りの運用益の分だけ少なくなる
+// 目標金額が同じであれば、残額の少ない達成間近の目標ほど少ない拠出で達成できるため値が大きくなる。達成済みの目標は0を返す
+func (grs *GoalRecommendationService) EffectiveROI(
+	goal *entities.Goal,
+	financialProfile *entities.FinancialProfile,
+) (float64, error) {
+	if goal == nil {
+		return 0, errors.New("目標は必須です")
+	}
+
+	if financialProfile == nil {
+		return 0, errors.New("財務プロファイルは必須です")
+	}
+
+	remainingAmount, err := goal.GetRemainingAmount()
+	if err != nil {
+		return 0, fmt.Errorf("残り必要金額の計算に失敗しました: %w", err)
+	}
+	if !remainingAmount.IsPositive() {
+		return 0, nil
+	}
+
+	remainingMonths := max(goal.GetRemainingDays()/30, 1) // 概算の月数
+	requiredMonthlyContribution := valueobjects.RequiredMonthlyContribution(
+		remainingAmount.Amount(), 0, financialProfile.InvestmentReturn().AsPercentage(), remainingMonths)
+	totalContribution := requiredMonthlyContribution * float64(remainingMonths)
+
+	return goal.TargetAmount().Amount() / totalContribution, nil
+}
+
+// PrioritizeGoalsByROI は目標を EffectiveROI の高い順（拠出1円あたりの効果が大きい順）に並べ替えて返す
+// EffectiveROI が同じ場合は期限の近い目標を優先する。引数のスライスは変更しない
+func (grs *GoalRecommendationService) PrioritizeGoalsByROI(
+	goals []*entities.Goal,
+	financialProfile *entities.FinancialProfile,
+) ([]*entities.Goal, error) {
+	type goalWithROI struct {
+		goal *entities.Goal
+		roi  float64
+	}
+
+	ranked := make([]goalWithROI, 0, len(goals))
+	for _, goal := range goals {
+		roi, err := grs.EffectiveROI(goal, financialProfile)
+		if err != nil {
+			return nil, err
+		}
+		ranked = append(ranked, goalWithROI{goal: goal, roi: roi})
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].roi != ranked[j].roi {
+			return ranked[i].roi > ranked[j].roi
+		}
+		return ranked[i].goal.TargetDate().Before(ranked[j].goal.TargetDate())
+	})
+
+	prioritized := make([]*entities.Goal, len(ranked))
+	for i, r := range ranked {
+		prioritized[i] = r.goal
+	}
+	return prioritized, nil
+}
+
 // suggestSavingsIncrease は貯蓄額増加を推奨する
 func (grs *GoalRecommendationService) suggestSavingsIncrease(
 	goal *entities.Goal,
@@ -334,7 +400,7 @@ func (grs *GoalRecommendationService) suggestExpenseReduction(
 	}
 
 	// 月収に対する支出削減の割合を計算
-	monthlyIncome := financialProfile.MonthlyIncome()
+	monthlyIncome := financialProfile.NetMonthlyIncome()
 	reductionPercentage := (shortfall.Amount() / monthlyIncome.Amount()) * 100
 
 	return &GoalRecommendation{
@@ -361,7 +427,7 @@ func (grs *GoalRecommendationService) suggestInvestmentStrategy(
 
 	// 現在の投資利回りが低い場合のみ推奨
 	currentReturn := financialProfile.InvestmentReturn()
-	if currentReturn.AsPercentage() >= 5.0 {
+	if currentReturn.AsPercentage() >= grs.calculationService.Defaults().InvestmentReturn {
 		return nil // 既に適切な利回り
 	}
 
@@ -381,6 +447,11 @@ func (grs *GoalRecommendationService) suggestInvestmentStrategy(
 		strategy = "バランス型の投資信託での運用を検討してください"
 	}
 
+	// 年齢が分かる場合は株式比率の目安を添える
+	if age, ok := financialProfile.CurrentAge(); ok {
+		strategy += fmt.Sprintf("（%d歳の株式比率の目安は%.0f%%です）", age, grs.calculationService.RecommendedEquityRatio(age))
+	}
+
 	return &GoalRecommendation{
 		Type:        "investment_strategy",
 		Title:       "投資戦略の見直し",
@@ -493,6 +564,11 @@ func (grs *GoalRecommendationService) AnalyzeGoalFeasibility(
 	if err != nil {
 		return nil, fmt.Errorf("達成可能性の判定に失敗しました: %w", err)
 	}
+	// 月間拠出額の最低額が設定されている場合は、最低額の拠出でも期限までに達成できることを条件とする
+	if minimum := goal.ContributionMin(); minimum != nil {
+		analysis["minimum_monthly_contribution"] = minimum.Amount()
+		achievable = achievable && minimum.Amount() >= requiredMonthlySavings.Amount()
+	}
 	analysis["achievable"] = achievable
 
 	// 進捗率
@@ -505,6 +581,15 @@ func (grs *GoalRecommendationService) AnalyzeGoalFeasibility(
 	// リスク評価
 	analysis["risk_level"] = grs.assessRiskLevel(goal, financialProfile)
 
+	// 年齢に依存する指標（生年月日が未設定の場合は含めない）
+	if age, ok := financialProfile.CurrentAge(); ok {
+		analysis["current_age"] = age
+		analysis["recommended_equity_ratio"] = grs.calculationService.RecommendedEquityRatio(age)
+		if ageAtTarget, ok := financialProfile.AgeAt(goal.TargetDate()); ok {
+			analysis["age_at_target"] = ageAtTarget
+		}
+	}
+
 	return analysis, nil
 }
 
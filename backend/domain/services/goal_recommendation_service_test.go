@@ -183,7 +183,124 @@ func TestAnalyzeGoalFeasibility(t *testing.T) {
 	}
 }
 
+func TestAnalyzeGoalFeasibility_ContributionMin(t *testing.T) {
+	service := NewGoalRecommendationService(NewFinancialCalculationService())
+	profile := createTestFinancialProfile(t)
+
+	tests := []struct {
+		name           string
+		minimum        float64
+		wantAchievable bool
+	}{
+		// 残額200万円・3年のため月次必要額は約55,600円
+		{name: "最低額でも月次必要額に届く場合は達成可能", minimum: 60000, wantAchievable: true},
+		{name: "最低額では月次必要額に届かない場合は達成不可能", minimum: 30000, wantAchievable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goal := createTestGoal(t)
+			minimum := mustCreateMoneyForTest(tt.minimum)
+			stretch := mustCreateMoneyForTest(80000)
+			if err := goal.UpdateContributionPlan(&minimum, mustCreateMoneyForTest(60000), &stretch); err != nil {
+				t.Fatalf("月間拠出額の幅の設定に失敗しました: %v", err)
+			}
+
+			analysis, err := service.AnalyzeGoalFeasibility(goal, profile)
+			if err != nil {
+				t.Fatalf("目標実現可能性分析に失敗しました: %v", err)
+			}
+
+			if analysis["achievable"] != tt.wantAchievable {
+				t.Errorf("achievable = %v, want %v", analysis["achievable"], tt.wantAchievable)
+			}
+			if analysis["minimum_monthly_contribution"] != tt.minimum {
+				t.Errorf("minimum_monthly_contribution = %v, want %v", analysis["minimum_monthly_contribution"], tt.minimum)
+			}
+		})
+	}
+}
+
 // ヘルパー関数
+func TestPrioritizeGoalsByROI(t *testing.T) {
+	calculationService := NewFinancialCalculationService()
+	service := NewGoalRecommendationService(calculationService)
+	profile := createTestFinancialProfile(t)
+
+	// 目標金額・期限が同じで、ほぼ達成済みの目標と始めたばかりの目標
+	justStarted := createTestGoal(t)
+	nearlyComplete := createTestGoal(t)
+	if err := nearlyComplete.UpdateCurrentAmount(mustCreateMoneyForTest(1900000)); err != nil {
+		t.Fatalf("現在金額の更新に失敗しました: %v", err)
+	}
+	completed := createTestGoal(t)
+	if err := completed.UpdateCurrentAmount(mustCreateMoneyForTest(2000000)); err != nil {
+		t.Fatalf("現在金額の更新に失敗しました: %v", err)
+	}
+
+	goals := []*entities.Goal{completed, justStarted, nearlyComplete}
+	prioritized, err := service.PrioritizeGoalsByROI(goals, profile)
+	if err != nil {
+		t.Fatalf("目標の優先順位付けに失敗しました: %v", err)
+	}
+
+	// 検証: ほぼ達成済みの目標が最優先、達成済みの目標は最後
+	expected := []*entities.Goal{nearlyComplete, justStarted, completed}
+	for i, goal := range expected {
+		if prioritized[i] != goal {
+			t.Errorf("優先順位[%d]が期待と異なります", i)
+		}
+	}
+
+	// 引数のスライスは変更しない
+	if goals[0] != completed {
+		t.Error("引数のスライスが並べ替えられています")
+	}
+
+	nearlyCompleteROI, _ := service.EffectiveROI(nearlyComplete, profile)
+	justStartedROI, _ := service.EffectiveROI(justStarted, profile)
+	if nearlyCompleteROI <= justStartedROI {
+		t.Errorf("ほぼ達成済みの目標のROI(%.2f)が始めたばかりの目標のROI(%.2f)以下です", nearlyCompleteROI, justStartedROI)
+	}
+}
+
+func TestEffectiveROI(t *testing.T) {
+	calculationService := NewFinancialCalculationService()
+	service := NewGoalRecommendationService(calculationService)
+
+	goal := createTestGoal(t)
+	if err := goal.UpdateCurrentAmount(mustCreateMoneyForTest(1000000)); err != nil {
+		t.Fatalf("現在金額の更新に失敗しました: %v", err)
+	}
+
+	// 利回り0%では 目標金額 ÷ 残額 と一致する
+	zeroReturnProfile := createTestFinancialProfile(t)
+	zeroRate, _ := valueobjects.NewRate(0)
+	if err := zeroReturnProfile.UpdateInvestmentReturn(zeroRate); err != nil {
+		t.Fatalf("想定利回りの更新に失敗しました: %v", err)
+	}
+	roi, err := service.EffectiveROI(goal, zeroReturnProfile)
+	if err != nil {
+		t.Fatalf("ROIの計算に失敗しました: %v", err)
+	}
+	if roi < 1.999 || roi > 2.001 {
+		t.Errorf("利回り0%%のROIが期待値と異なります: got %.4f, want 2.0", roi)
+	}
+
+	// 運用益の分だけ必要な拠出が減るため、利回りがあるとROIは高くなる
+	withReturn, err := service.EffectiveROI(goal, createTestFinancialProfile(t))
+	if err != nil {
+		t.Fatalf("ROIの計算に失敗しました: %v", err)
+	}
+	if withReturn <= roi {
+		t.Errorf("利回り5%%のROI(%.4f)が利回り0%%のROI(%.4f)以下です", withReturn, roi)
+	}
+
+	if _, err := service.EffectiveROI(goal, nil); err == nil {
+		t.Error("財務プロファイルがnilの場合はエラーになるはずです")
+	}
+}
+
 func createTestGoal(t *testing.T) *entities.Goal {
 	targetAmount, _ := valueobjects.NewMoneyJPY(2000000)
 	monthlyContribution, _ := valueobjects.NewMoneyJPY(50000)
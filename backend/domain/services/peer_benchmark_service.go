@@ -0,0 +1,238 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// DefaultPeerBenchmarkMinGroupSize は集計結果を返す区分の最小人数のデフォルト値
+// これ未満の区分は個人を特定できるおそれがあるため集計結果を返さない
+const DefaultPeerBenchmarkMinGroupSize = 50
+
+// ErrPeerAgeUnknown は年齢が分からず年代の区分を決められない場合のエラー
+var ErrPeerAgeUnknown = errors.New("年齢が設定されていないため同年代との比較ができません（生年月日または退職設定の現在の年齢を設定してください）")
+
+// 区分の中での位置（集計済みのパーセンタイルのどの間にあるか）
+const (
+	PeerPositionBelowP10 = "below_p10"
+	PeerPositionP10P25   = "p10_p25"
+	PeerPositionP25P50   = "p25_p50"
+	PeerPositionP50P75   = "p50_p75"
+	PeerPositionP75P90   = "p75_p90"
+	PeerPositionAboveP90 = "above_p90"
+)
+
+// peerIncomeBands は年収帯の区分（上限は含まない、円）
+var peerIncomeBands = []struct {
+	upper float64
+	band  string
+}{
+	{upper: 3_000_000, band: "under_3m"},
+	{upper: 5_000_000, band: "3m_5m"},
+	{upper: 7_000_000, band: "5m_7m"},
+	{upper: 10_000_000, band: "7m_10m"},
+	{upper: math.Inf(1), band: "10m_plus"},
+}
+
+// PeerSample は集計・比較に使う1人分の区分と指標（ユーザーを識別する情報は持たない）
+type PeerSample struct {
+	Segment entities.PeerSegment
+	Values  map[entities.PeerBenchmarkMetric]float64
+}
+
+// PeerStanding は区分の中での位置
+type PeerStanding struct {
+	Position string `json:"position"` // below_p10 | p10_p25 | p25_p50 | p50_p75 | p75_p90 | above_p90
+	// Percentile は集計済みのパーセンタイルから線形補間で推定した順位（P10〜P90の範囲外の場合は省略）
+	Percentile *float64 `json:"percentile,omitempty"`
+}
+
+// PeerBenchmarkService は年代・年収帯ごとの匿名集計と、集計結果の中での位置を求めるドメインサービス
+type PeerBenchmarkService struct {
+	minGroupSize int
+}
+
+// NewPeerBenchmarkService は新しいPeerBenchmarkServiceを作成する
+// 最小集計人数が0以下の場合はデフォルト値を使用する
+func NewPeerBenchmarkService(minGroupSize int) *PeerBenchmarkService {
+	if minGroupSize <= 0 {
+		minGroupSize = DefaultPeerBenchmarkMinGroupSize
+	}
+	return &PeerBenchmarkService{minGroupSize: minGroupSize}
+}
+
+// NewDefaultPeerBenchmarkService はデフォルトの最小集計人数でPeerBenchmarkServiceを作成する
+func NewDefaultPeerBenchmarkService() *PeerBenchmarkService {
+	return NewPeerBenchmarkService(DefaultPeerBenchmarkMinGroupSize)
+}
+
+// MinGroupSize は集計結果を返す区分の最小人数を返す
+func (s *PeerBenchmarkService) MinGroupSize() int {
+	return s.minGroupSize
+}
+
+// PeerAgeBand は年齢から年代の区分を返す（20歳未満は under_20、60歳以上は 60_plus）
+func PeerAgeBand(age int) string {
+	switch {
+	case age < 20:
+		return "under_20"
+	case age >= 60:
+		return "60_plus"
+	default:
+		return fmt.Sprintf("%ds", age/10*10)
+	}
+}
+
+// PeerIncomeBand は月収（額面）から年収帯の区分を返す
+func PeerIncomeBand(monthlyIncome float64) string {
+	annualIncome := monthlyIncome * 12
+	for _, band := range peerIncomeBands {
+		if annualIncome < band.upper {
+			return band.band
+		}
+	}
+	return peerIncomeBands[len(peerIncomeBands)-1].band
+}
+
+// Sample は財務計画から区分と比較する指標を取り出す
+// 緊急資金月数は緊急資金の現在額を月間支出で割った値、総資産は現在の貯蓄の合計とする
+func (s *PeerBenchmarkService) Sample(plan *aggregates.FinancialPlan) (PeerSample, error) {
+	age, ok := plan.CurrentAge()
+	if !ok {
+		return PeerSample{}, ErrPeerAgeUnknown
+	}
+	profile := plan.Profile()
+
+	savingsRate, err := profile.CalculateSavingsRate()
+	if err != nil {
+		return PeerSample{}, fmt.Errorf("貯蓄率の計算に失敗しました: %w", err)
+	}
+
+	monthlyExpenses, err := profile.MonthlyExpenses().Total()
+	if err != nil {
+		return PeerSample{}, fmt.Errorf("月間支出の計算に失敗しました: %w", err)
+	}
+	emergencyFundMonths := 0.0
+	if plan.EmergencyFund() != nil && monthlyExpenses.IsPositive() {
+		emergencyFundMonths = plan.EmergencyFund().CurrentFund().Amount() / monthlyExpenses.Amount()
+	}
+
+	totalAssets, err := profile.CurrentSavings().Total()
+	if err != nil {
+		return PeerSample{}, fmt.Errorf("総資産の計算に失敗しました: %w", err)
+	}
+
+	return PeerSample{
+		Segment: entities.PeerSegment{
+			AgeBand:    PeerAgeBand(age),
+			IncomeBand: PeerIncomeBand(profile.MonthlyIncome().Amount()),
+		},
+		Values: map[entities.PeerBenchmarkMetric]float64{
+			entities.PeerMetricSavingsRate:         savingsRate,
+			entities.PeerMetricEmergencyFundMonths: emergencyFundMonths,
+			entities.PeerMetricTotalAssets:         totalAssets.Amount(),
+		},
+	}, nil
+}
+
+// Aggregate は区分ごとに各指標のパーセンタイルを計算する
+// 人数が最小集計人数に満たない区分は結果に含めない。結果は区分（年代・年収帯）と指標の順に並べる
+func (s *PeerBenchmarkService) Aggregate(samples []PeerSample, aggregatedAt time.Time) []entities.PeerBenchmark {
+	groups := make(map[entities.PeerSegment][]PeerSample)
+	for _, sample := range samples {
+		groups[sample.Segment] = append(groups[sample.Segment], sample)
+	}
+
+	segments := make([]entities.PeerSegment, 0, len(groups))
+	for segment, members := range groups {
+		if len(members) >= s.minGroupSize {
+			segments = append(segments, segment)
+		}
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		if segments[i].AgeBand != segments[j].AgeBand {
+			return segments[i].AgeBand < segments[j].AgeBand
+		}
+		return segments[i].IncomeBand < segments[j].IncomeBand
+	})
+
+	var benchmarks []entities.PeerBenchmark
+	for _, segment := range segments {
+		members := groups[segment]
+		for _, metric := range entities.PeerBenchmarkMetrics {
+			values := make([]float64, 0, len(members))
+			for _, member := range members {
+				values = append(values, member.Values[metric])
+			}
+			sort.Float64s(values)
+
+			benchmarks = append(benchmarks, entities.PeerBenchmark{
+				Segment:      segment,
+				Metric:       metric,
+				SampleSize:   len(values),
+				P10:          percentile(values, 10),
+				P25:          percentile(values, 25),
+				Median:       percentile(values, 50),
+				P75:          percentile(values, 75),
+				P90:          percentile(values, 90),
+				AggregatedAt: aggregatedAt,
+			})
+		}
+	}
+	return benchmarks
+}
+
+// Standing は値が区分の中でどの位置にあるかを返す
+func (s *PeerBenchmarkService) Standing(value float64, benchmark entities.PeerBenchmark) PeerStanding {
+	if value < benchmark.P10 {
+		return PeerStanding{Position: PeerPositionBelowP10}
+	}
+	if value > benchmark.P90 {
+		return PeerStanding{Position: PeerPositionAboveP90}
+	}
+
+	points := []struct {
+		percentile float64
+		value      float64
+		position   string
+	}{
+		{percentile: 10, value: benchmark.P10},
+		{percentile: 25, value: benchmark.P25, position: PeerPositionP10P25},
+		{percentile: 50, value: benchmark.Median, position: PeerPositionP25P50},
+		{percentile: 75, value: benchmark.P75, position: PeerPositionP50P75},
+		{percentile: 90, value: benchmark.P90, position: PeerPositionP75P90},
+	}
+	for i := 1; i < len(points); i++ {
+		lower, upper := points[i-1], points[i]
+		if value > upper.value {
+			continue
+		}
+		// 同じ値が続く区間（分布に偏りがある場合）は区間の上端の順位とする
+		rank := upper.percentile
+		if upper.value > lower.value {
+			rank = lower.percentile + (value-lower.value)/(upper.value-lower.value)*(upper.percentile-lower.percentile)
+		}
+		return PeerStanding{Position: upper.position, Percentile: &rank}
+	}
+	return PeerStanding{Position: PeerPositionAboveP90}
+}
+
+// percentile は昇順に並んだ値の p パーセンタイルを線形補間で返す
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower]
+	}
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
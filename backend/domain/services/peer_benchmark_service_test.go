@@ -0,0 +1,271 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// newPeerTestPlan はテスト用の財務計画（月収400,000円・月間支出200,000円・貯蓄1,000,000円）を作成するヘルパー
+// currentAge が0の場合は年齢を設定しない
+func newPeerTestPlan(t *testing.T, currentAge int, emergencyFund float64) *aggregates.FinancialPlan {
+	t.Helper()
+	userID, _ := entities.NewUserID("1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed")
+	income, _ := valueobjects.NewMoneyJPY(400000)
+	expense, _ := valueobjects.NewMoneyJPY(200000)
+	savings, _ := valueobjects.NewMoneyJPY(1000000)
+	investmentReturn, _ := valueobjects.NewRate(5.0)
+	inflationRate, _ := valueobjects.NewRate(2.0)
+
+	profile, err := entities.NewFinancialProfile(
+		userID,
+		income,
+		entities.ExpenseCollection{{Category: "生活費", Amount: expense}},
+		entities.SavingsCollection{{Type: "deposit", Amount: savings}},
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		t.Fatalf("財務プロファイルの作成に失敗しました: %v", err)
+	}
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		t.Fatalf("財務計画の作成に失敗しました: %v", err)
+	}
+
+	if currentAge > 0 {
+		retirementExpenses, _ := valueobjects.NewMoneyJPY(200000)
+		pension, _ := valueobjects.NewMoneyJPY(100000)
+		retirement, err := entities.NewRetirementData(userID, currentAge, 65, 90, retirementExpenses, pension)
+		if err != nil {
+			t.Fatalf("退職データの作成に失敗しました: %v", err)
+		}
+		if err := plan.SetRetirementData(retirement); err != nil {
+			t.Fatalf("退職データの設定に失敗しました: %v", err)
+		}
+	}
+	if emergencyFund > 0 {
+		currentFund, _ := valueobjects.NewMoneyJPY(emergencyFund)
+		fund, err := aggregates.NewEmergencyFund(6, currentFund)
+		if err != nil {
+			t.Fatalf("緊急資金の作成に失敗しました: %v", err)
+		}
+		if err := plan.UpdateEmergencyFund(fund); err != nil {
+			t.Fatalf("緊急資金の設定に失敗しました: %v", err)
+		}
+	}
+	return plan
+}
+
+// peerSamples は同じ区分で貯蓄率が 1, 2, ..., n のサンプルを作成するヘルパー
+func peerSamples(segment entities.PeerSegment, n int) []PeerSample {
+	samples := make([]PeerSample, 0, n)
+	for i := 1; i <= n; i++ {
+		samples = append(samples, PeerSample{
+			Segment: segment,
+			Values: map[entities.PeerBenchmarkMetric]float64{
+				entities.PeerMetricSavingsRate:         float64(i),
+				entities.PeerMetricEmergencyFundMonths: 3,
+				entities.PeerMetricTotalAssets:         float64(i) * 100000,
+			},
+		})
+	}
+	return samples
+}
+
+func TestPeerAgeBand(t *testing.T) {
+	tests := []struct {
+		age  int
+		want string
+	}{
+		{age: 18, want: "under_20"},
+		{age: 20, want: "20s"},
+		{age: 29, want: "20s"},
+		{age: 35, want: "30s"},
+		{age: 59, want: "50s"},
+		{age: 60, want: "60_plus"},
+		{age: 72, want: "60_plus"},
+	}
+	for _, tt := range tests {
+		if got := PeerAgeBand(tt.age); got != tt.want {
+			t.Errorf("PeerAgeBand(%d) = %s, want %s", tt.age, got, tt.want)
+		}
+	}
+}
+
+func TestPeerIncomeBand(t *testing.T) {
+	tests := []struct {
+		monthlyIncome float64
+		want          string
+	}{
+		{monthlyIncome: 200000, want: "under_3m"},
+		{monthlyIncome: 250000, want: "3m_5m"}, // 年収300万円ちょうどは上の区分
+		{monthlyIncome: 400000, want: "3m_5m"},
+		{monthlyIncome: 500000, want: "5m_7m"},
+		{monthlyIncome: 700000, want: "7m_10m"},
+		{monthlyIncome: 1000000, want: "10m_plus"},
+	}
+	for _, tt := range tests {
+		if got := PeerIncomeBand(tt.monthlyIncome); got != tt.want {
+			t.Errorf("PeerIncomeBand(%v) = %s, want %s", tt.monthlyIncome, got, tt.want)
+		}
+	}
+}
+
+func TestPeerBenchmarkService_Sample(t *testing.T) {
+	service := NewDefaultPeerBenchmarkService()
+
+	t.Run("正常系: 区分と指標を取り出す", func(t *testing.T) {
+		sample, err := service.Sample(newPeerTestPlan(t, 34, 600000))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := entities.PeerSegment{AgeBand: "30s", IncomeBand: "3m_5m"}
+		if sample.Segment != want {
+			t.Errorf("Segment = %+v, want %+v", sample.Segment, want)
+		}
+		if got := sample.Values[entities.PeerMetricSavingsRate]; got != 50 {
+			t.Errorf("savings_rate = %v, want 50", got)
+		}
+		if got := sample.Values[entities.PeerMetricEmergencyFundMonths]; got != 3 {
+			t.Errorf("emergency_fund_months = %v, want 3", got)
+		}
+		if got := sample.Values[entities.PeerMetricTotalAssets]; got != 1000000 {
+			t.Errorf("total_assets = %v, want 1000000", got)
+		}
+	})
+
+	t.Run("正常系: 緊急資金が未設定の場合は0ヶ月", func(t *testing.T) {
+		sample, err := service.Sample(newPeerTestPlan(t, 34, 0))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := sample.Values[entities.PeerMetricEmergencyFundMonths]; got != 0 {
+			t.Errorf("emergency_fund_months = %v, want 0", got)
+		}
+	})
+
+	t.Run("異常系: 年齢が分からない場合はエラー", func(t *testing.T) {
+		if _, err := service.Sample(newPeerTestPlan(t, 0, 0)); err != ErrPeerAgeUnknown {
+			t.Errorf("error = %v, want ErrPeerAgeUnknown", err)
+		}
+	})
+}
+
+func TestPeerBenchmarkService_Aggregate(t *testing.T) {
+	aggregatedAt := time.Date(2026, 10, 1, 3, 0, 0, 0, time.UTC)
+	large := entities.PeerSegment{AgeBand: "30s", IncomeBand: "5m_7m"}
+	small := entities.PeerSegment{AgeBand: "20s", IncomeBand: "3m_5m"}
+
+	t.Run("正常系: 最小集計人数に満たない区分は含めない", func(t *testing.T) {
+		service := NewPeerBenchmarkService(50)
+		samples := append(peerSamples(large, 101), peerSamples(small, 49)...)
+
+		benchmarks := service.Aggregate(samples, aggregatedAt)
+
+		if len(benchmarks) != len(entities.PeerBenchmarkMetrics) {
+			t.Fatalf("len(benchmarks) = %d, want %d", len(benchmarks), len(entities.PeerBenchmarkMetrics))
+		}
+		for i, benchmark := range benchmarks {
+			if benchmark.Segment != large {
+				t.Errorf("Segment = %+v, want %+v", benchmark.Segment, large)
+			}
+			if benchmark.Metric != entities.PeerBenchmarkMetrics[i] {
+				t.Errorf("Metric = %s, want %s", benchmark.Metric, entities.PeerBenchmarkMetrics[i])
+			}
+			if benchmark.SampleSize != 101 {
+				t.Errorf("SampleSize = %d, want 101", benchmark.SampleSize)
+			}
+			if !benchmark.AggregatedAt.Equal(aggregatedAt) {
+				t.Errorf("AggregatedAt = %v, want %v", benchmark.AggregatedAt, aggregatedAt)
+			}
+		}
+
+		// 1〜101 の値のパーセンタイル
+		savingsRate := benchmarks[0]
+		got := []float64{savingsRate.P10, savingsRate.P25, savingsRate.Median, savingsRate.P75, savingsRate.P90}
+		want := []float64{11, 26, 51, 76, 91}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("percentiles = %v, want %v", got, want)
+				break
+			}
+		}
+	})
+
+	t.Run("正常系: 区分は年代・年収帯の順に並べる", func(t *testing.T) {
+		service := NewPeerBenchmarkService(2)
+		samples := append(peerSamples(large, 2), peerSamples(small, 2)...)
+
+		benchmarks := service.Aggregate(samples, aggregatedAt)
+
+		if len(benchmarks) != 2*len(entities.PeerBenchmarkMetrics) {
+			t.Fatalf("len(benchmarks) = %d, want %d", len(benchmarks), 2*len(entities.PeerBenchmarkMetrics))
+		}
+		if benchmarks[0].Segment != small || benchmarks[len(benchmarks)-1].Segment != large {
+			t.Errorf("segments are not sorted: first=%+v last=%+v", benchmarks[0].Segment, benchmarks[len(benchmarks)-1].Segment)
+		}
+	})
+
+	t.Run("正常系: 最小集計人数が0以下の場合はデフォルト値", func(t *testing.T) {
+		service := NewPeerBenchmarkService(0)
+		if service.MinGroupSize() != DefaultPeerBenchmarkMinGroupSize {
+			t.Errorf("MinGroupSize() = %d, want %d", service.MinGroupSize(), DefaultPeerBenchmarkMinGroupSize)
+		}
+		if benchmarks := service.Aggregate(peerSamples(large, 49), aggregatedAt); len(benchmarks) != 0 {
+			t.Errorf("len(benchmarks) = %d, want 0", len(benchmarks))
+		}
+	})
+}
+
+func TestPeerBenchmarkService_Standing(t *testing.T) {
+	service := NewDefaultPeerBenchmarkService()
+	benchmark := entities.PeerBenchmark{P10: 10, P25: 20, Median: 30, P75: 40, P90: 50}
+
+	tests := []struct {
+		name           string
+		value          float64
+		wantPosition   string
+		wantPercentile *float64
+	}{
+		{name: "P10未満", value: 5, wantPosition: PeerPositionBelowP10},
+		{name: "P10とP25の間", value: 15, wantPosition: PeerPositionP10P25, wantPercentile: peerFloatPtr(17.5)},
+		{name: "中央値ちょうど", value: 30, wantPosition: PeerPositionP25P50, wantPercentile: peerFloatPtr(50)},
+		{name: "P50とP75の間", value: 36, wantPosition: PeerPositionP50P75, wantPercentile: peerFloatPtr(65)},
+		{name: "P75とP90の間", value: 50, wantPosition: PeerPositionP75P90, wantPercentile: peerFloatPtr(90)},
+		{name: "P90超", value: 80, wantPosition: PeerPositionAboveP90},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standing := service.Standing(tt.value, benchmark)
+			if standing.Position != tt.wantPosition {
+				t.Errorf("Position = %s, want %s", standing.Position, tt.wantPosition)
+			}
+			switch {
+			case tt.wantPercentile == nil && standing.Percentile != nil:
+				t.Errorf("Percentile = %v, want nil", *standing.Percentile)
+			case tt.wantPercentile != nil && standing.Percentile == nil:
+				t.Errorf("Percentile = nil, want %v", *tt.wantPercentile)
+			case tt.wantPercentile != nil && *standing.Percentile != *tt.wantPercentile:
+				t.Errorf("Percentile = %v, want %v", *standing.Percentile, *tt.wantPercentile)
+			}
+		})
+	}
+
+	t.Run("同じ値が続く区間は上端の順位", func(t *testing.T) {
+		flat := entities.PeerBenchmark{P10: 0, P25: 0, Median: 0, P75: 3, P90: 6}
+		standing := service.Standing(0, flat)
+		if standing.Position != PeerPositionP10P25 || standing.Percentile == nil || *standing.Percentile != 25 {
+			t.Errorf("standing = %+v, want p10_p25 at 25", standing)
+		}
+	})
+}
+
+// peerFloatPtr はfloat64のポインタを返すヘルパー
+func peerFloatPtr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// 洞察の判定に使う資産の節目（円）
+const (
+	InsightTenMillionThreshold     = 10_000_000  // 資産1,000万円
+	InsightHundredMillionThreshold = 100_000_000 // 資産1億円
+	// InsightInflationErosionRatio は実質価値が名目額のこの割合を下回るとインフレの影響を伝える
+	InsightInflationErosionRatio = 0.8
+)
+
+// InsightFacts は資産推移と財務計画から算出した、洞察の条件判定とテンプレートの変数展開に使う値
+// 〜Year のフィールドは該当する年（1始まり）で、該当しない場合は0
+type InsightFacts struct {
+	Years          int     // 予測年数
+	InitialAssets  float64 // 現在の貯蓄合計
+	FinalAssets    float64 // 最終年末の資産
+	FinalRealValue float64 // 最終年末の資産のインフレ調整後の実質価値
+
+	BreakevenYear      int // 投資収益が総拠出額を初めて上回る年
+	DoublingYear       int // 資産が現在の2倍に初めて達する年
+	TenMillionYear     int // 資産が1,000万円に初めて達する年（現在すでに達している場合は0）
+	HundredMillionYear int // 資産が1億円に初めて達する年（現在すでに達している場合は0）
+
+	EmergencyFundTarget float64 // 緊急資金の必要額（未設定の場合は0）
+	EmergencyFundYear   int     // 資産が緊急資金の必要額に初めて達する年（現在すでに達している場合は0）
+
+	InitialLiabilities float64 // 現在の負債残高
+	DebtFreeYear       int     // 負債を完済する年
+
+	LifeEventCount      int     // 登録済みのライフイベントの数
+	LifeEventDifference float64 // ライフイベントを考慮した最終年の資産 - 考慮しない場合の資産
+}
+
+// variables はテンプレートで使える変数（{名前} の形式で参照する）を返す
+func (f InsightFacts) variables() map[string]string {
+	realValueRatio := 0.0
+	if f.FinalAssets > 0 {
+		realValueRatio = f.FinalRealValue / f.FinalAssets * 100
+	}
+	return map[string]string{
+		"years":                 strconv.Itoa(f.Years),
+		"initial_assets":        formatManYen(f.InitialAssets),
+		"final_assets":          formatManYen(f.FinalAssets),
+		"final_real_value":      formatManYen(f.FinalRealValue),
+		"real_value_ratio":      fmt.Sprintf("%.0f", realValueRatio),
+		"breakeven_year":        strconv.Itoa(f.BreakevenYear),
+		"doubling_year":         strconv.Itoa(f.DoublingYear),
+		"doubled_assets":        formatManYen(f.InitialAssets * 2),
+		"ten_million_year":      strconv.Itoa(f.TenMillionYear),
+		"hundred_million_year":  strconv.Itoa(f.HundredMillionYear),
+		"emergency_fund_target": formatManYen(f.EmergencyFundTarget),
+		"emergency_fund_year":   strconv.Itoa(f.EmergencyFundYear),
+		"debt_free_year":        strconv.Itoa(f.DebtFreeYear),
+		"life_event_count":      strconv.Itoa(f.LifeEventCount),
+		"life_event_decrease":   formatManYen(-f.LifeEventDifference),
+		"asset_decrease":        formatManYen(f.InitialAssets - f.FinalAssets),
+		"initial_liabilities":   formatManYen(f.InitialLiabilities),
+	}
+}
+
+// formatManYen は金額を万円単位の文字列にする（例: 12,345,678 → "1235万円"）
+func formatManYen(amount float64) string {
+	return fmt.Sprintf("%.0f万円", amount/10000)
+}
+
+// InsightRule は洞察のルール
+// Condition が true を返した場合に、Template の {変数名} を InsightFacts の値で置き換えた文を洞察とする
+type InsightRule struct {
+	ID        string
+	Condition func(facts InsightFacts) bool
+	Template  string
+}
+
+// DefaultInsightRules は資産推移の洞察のデフォルトのルールを返す（洞察はこの順に並ぶ）
+func DefaultInsightRules() []InsightRule {
+	return []InsightRule{
+		{
+			ID:        "breakeven",
+			Condition: func(f InsightFacts) bool { return f.BreakevenYear > 0 },
+			Template:  "{breakeven_year}年目に投資収益が総拠出額を上回り、複利効果が本格的に働き始める見込みです",
+		},
+		{
+			ID:        "long_term_growth",
+			Condition: func(f InsightFacts) bool { return f.Years > 0 && f.FinalAssets >= f.InitialAssets },
+			Template:  "長期投資により安定した資産形成が期待できます",
+		},
+		{
+			ID:        "doubling",
+			Condition: func(f InsightFacts) bool { return f.DoublingYear > 0 },
+			Template:  "{doubling_year}年目に資産が現在の2倍の{doubled_assets}に達する見込みです",
+		},
+		{
+			ID:        "ten_million",
+			Condition: func(f InsightFacts) bool { return f.TenMillionYear > 0 },
+			Template:  "{ten_million_year}年目に資産が1,000万円を超える見込みです",
+		},
+		{
+			ID:        "hundred_million",
+			Condition: func(f InsightFacts) bool { return f.HundredMillionYear > 0 },
+			Template:  "{hundred_million_year}年目に資産が1億円を超える見込みです",
+		},
+		{
+			ID:        "emergency_fund",
+			Condition: func(f InsightFacts) bool { return f.EmergencyFundYear > 0 },
+			Template:  "{emergency_fund_year}年目に資産が緊急資金の必要額（{emergency_fund_target}）を上回る見込みです",
+		},
+		{
+			ID:        "debt_free",
+			Condition: func(f InsightFacts) bool { return f.DebtFreeYear > 0 },
+			Template:  "{debt_free_year}年目に負債（現在{initial_liabilities}）を完済し、返済額を資産形成に回せるようになります",
+		},
+		{
+			ID: "inflation_erosion",
+			Condition: func(f InsightFacts) bool {
+				return f.FinalAssets > 0 && f.FinalRealValue < f.FinalAssets*InsightInflationErosionRatio
+			},
+			Template: "インフレにより{years}年後の資産の実質価値は名目額の約{real_value_ratio}%（{final_real_value}）に目減りする見込みです",
+		},
+		{
+			ID:        "asset_decrease",
+			Condition: func(f InsightFacts) bool { return f.Years > 0 && f.FinalAssets < f.InitialAssets },
+			Template:  "現在の収支では{years}年後の資産が現在より{asset_decrease}減る見込みです。支出の見直しを検討しましょう",
+		},
+		{
+			ID:        "life_events",
+			Condition: func(f InsightFacts) bool { return f.LifeEventCount > 0 && f.LifeEventDifference < 0 },
+			Template:  "ライフイベントにより{years}年後の資産が約{life_event_decrease}少なくなる見込みです",
+		},
+	}
+}
+
+// ProjectionInsightEngine は資産推移の数値からルールに基づいて洞察の文章を生成するドメインサービス
+type ProjectionInsightEngine struct {
+	rules []InsightRule
+}
+
+// NewProjectionInsightEngine はデフォルトのルールでProjectionInsightEngineを作成する
+func NewProjectionInsightEngine() *ProjectionInsightEngine {
+	return NewProjectionInsightEngineWithRules(DefaultInsightRules())
+}
+
+// NewProjectionInsightEngineWithRules は指定したルールでProjectionInsightEngineを作成する
+func NewProjectionInsightEngineWithRules(rules []InsightRule) *ProjectionInsightEngine {
+	return &ProjectionInsightEngine{rules: rules}
+}
+
+// GenerateInsights は資産推移と財務計画から、条件を満たすルールの洞察をルールの順に返す
+// plan が nil の場合は現在の資産・負債・緊急資金・ライフイベントに関するルールは該当しない
+func (e *ProjectionInsightEngine) GenerateInsights(projections []entities.AssetProjection, plan *aggregates.FinancialPlan) []string {
+	if len(projections) == 0 {
+		return nil
+	}
+
+	return e.insightsFor(NewInsightFacts(projections, plan))
+}
+
+// insightsFor は条件を満たすルールの洞察をルールの順に返す
+func (e *ProjectionInsightEngine) insightsFor(facts InsightFacts) []string {
+	replacer := newTemplateReplacer(facts.variables())
+
+	var insights []string
+	for _, rule := range e.rules {
+		if rule.Condition(facts) {
+			insights = append(insights, replacer.Replace(rule.Template))
+		}
+	}
+	return insights
+}
+
+// newTemplateReplacer はテンプレートの {変数名} を値に置き換える Replacer を作成する
+func newTemplateReplacer(variables map[string]string) *strings.Replacer {
+	pairs := make([]string, 0, len(variables)*2)
+	for name, value := range variables {
+		pairs = append(pairs, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// NewInsightFacts は資産推移と財務計画から洞察の判定に使う値を算出する
+func NewInsightFacts(projections []entities.AssetProjection, plan *aggregates.FinancialPlan) InsightFacts {
+	facts := InsightFacts{Years: len(projections)}
+	if len(projections) == 0 {
+		return facts
+	}
+
+	final := projections[len(projections)-1]
+	facts.FinalAssets = final.TotalAssets.Amount()
+	facts.FinalRealValue = final.RealValue.Amount()
+
+	if plan != nil {
+		profile := plan.Profile()
+		if savings, err := profile.CurrentSavings().Total(); err == nil {
+			facts.InitialAssets = savings.Amount()
+		}
+		if liabilities, err := profile.TotalLiabilities(); err == nil {
+			facts.InitialLiabilities = liabilities.Amount()
+		}
+		if fund := plan.EmergencyFund(); fund != nil {
+			facts.EmergencyFundTarget = fund.RequiredAmount().Amount()
+		}
+		if lifeEvents := profile.LifeEvents(); len(lifeEvents) > 0 {
+			facts.LifeEventCount = len(lifeEvents)
+			if withoutEvents, err := profile.ProjectAssetsWithoutLifeEvents(len(projections)); err == nil {
+				facts.LifeEventDifference = facts.FinalAssets - withoutEvents[len(withoutEvents)-1].TotalAssets.Amount()
+			}
+		}
+	}
+
+	for _, projection := range projections {
+		assets := projection.TotalAssets.Amount()
+		if facts.BreakevenYear == 0 && projection.InvestmentGains.Amount() > projection.ContributedAmount.Amount() {
+			facts.BreakevenYear = projection.Year
+		}
+		if facts.DoublingYear == 0 && facts.InitialAssets > 0 && assets >= facts.InitialAssets*2 {
+			facts.DoublingYear = projection.Year
+		}
+		facts.TenMillionYear = firstCrossingYear(facts.TenMillionYear, facts.InitialAssets, assets, InsightTenMillionThreshold, projection.Year)
+		facts.HundredMillionYear = firstCrossingYear(facts.HundredMillionYear, facts.InitialAssets, assets, InsightHundredMillionThreshold, projection.Year)
+		if facts.EmergencyFundTarget > 0 {
+			facts.EmergencyFundYear = firstCrossingYear(facts.EmergencyFundYear, facts.InitialAssets, assets, facts.EmergencyFundTarget, projection.Year)
+		}
+		if facts.DebtFreeYear == 0 && facts.InitialLiabilities > 0 && projection.TotalLiabilities.IsZero() {
+			facts.DebtFreeYear = projection.Year
+		}
+	}
+	return facts
+}
+
+// firstCrossingYear は現在は閾値未満の資産が初めて閾値に達した年を返す（既に記録済みの場合はその年）
+func firstCrossingYear(recorded int, initialAssets, assets, threshold float64, year int) int {
+	if recorded > 0 || initialAssets >= threshold || assets < threshold {
+		return recorded
+	}
+	return year
+}
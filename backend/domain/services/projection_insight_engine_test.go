@@ -0,0 +1,216 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// insightProjection はテスト用の年末時点の資産推移を作成するヘルパー（実質価値は名目額の9割とする）
+func insightProjection(year int, totalAssets, contributed, liabilities float64) entities.AssetProjection {
+	money := func(amount float64) valueobjects.Money {
+		m, _ := valueobjects.NewMoneyJPY(amount)
+		return m
+	}
+	return entities.AssetProjection{
+		Year:              year,
+		TotalAssets:       money(totalAssets),
+		RealValue:         money(totalAssets * 0.9),
+		ContributedAmount: money(contributed),
+		InvestmentGains:   money(totalAssets - contributed),
+		TotalLiabilities:  money(liabilities),
+		NetWorth:          money(totalAssets - liabilities),
+	}
+}
+
+// newInsightTestPlan はテスト用の財務計画（貯蓄300,000円・月間支出180,000円）を作成するヘルパー
+func newInsightTestPlan(t *testing.T) *aggregates.FinancialPlan {
+	t.Helper()
+	userID, _ := entities.NewUserID("1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed")
+	income, _ := valueobjects.NewMoneyJPY(400000)
+	expense, _ := valueobjects.NewMoneyJPY(180000)
+	savings, _ := valueobjects.NewMoneyJPY(300000)
+	investmentReturn, _ := valueobjects.NewRate(5.0)
+	inflationRate, _ := valueobjects.NewRate(2.0)
+
+	profile, err := entities.NewFinancialProfile(
+		userID,
+		income,
+		entities.ExpenseCollection{{Category: "生活費", Amount: expense}},
+		entities.SavingsCollection{{Type: "deposit", Amount: savings}},
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		t.Fatalf("財務プロファイルの作成に失敗しました: %v", err)
+	}
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		t.Fatalf("財務計画の作成に失敗しました: %v", err)
+	}
+	return plan
+}
+
+// findInsightRule は指定したIDのデフォルトルールを返すヘルパー
+func findInsightRule(t *testing.T, id string) InsightRule {
+	t.Helper()
+	for _, rule := range DefaultInsightRules() {
+		if rule.ID == id {
+			return rule
+		}
+	}
+	t.Fatalf("ルールが見つかりません: %s", id)
+	return InsightRule{}
+}
+
+func TestDefaultInsightRules(t *testing.T) {
+	tests := []struct {
+		ruleID   string
+		matching InsightFacts
+		want     string
+		other    InsightFacts // 条件を満たさない値
+	}{
+		{
+			ruleID:   "breakeven",
+			matching: InsightFacts{Years: 20, BreakevenYear: 15},
+			want:     "15年目に投資収益が総拠出額を上回り、複利効果が本格的に働き始める見込みです",
+			other:    InsightFacts{Years: 20},
+		},
+		{
+			ruleID:   "long_term_growth",
+			matching: InsightFacts{Years: 10, InitialAssets: 1000000, FinalAssets: 5000000},
+			want:     "長期投資により安定した資産形成が期待できます",
+			other:    InsightFacts{Years: 10, InitialAssets: 5000000, FinalAssets: 1000000},
+		},
+		{
+			ruleID:   "doubling",
+			matching: InsightFacts{Years: 10, InitialAssets: 3000000, DoublingYear: 8},
+			want:     "8年目に資産が現在の2倍の600万円に達する見込みです",
+			other:    InsightFacts{Years: 5, InitialAssets: 3000000},
+		},
+		{
+			ruleID:   "ten_million",
+			matching: InsightFacts{Years: 10, TenMillionYear: 5},
+			want:     "5年目に資産が1,000万円を超える見込みです",
+			other:    InsightFacts{Years: 10},
+		},
+		{
+			ruleID:   "hundred_million",
+			matching: InsightFacts{Years: 30, HundredMillionYear: 25},
+			want:     "25年目に資産が1億円を超える見込みです",
+			other:    InsightFacts{Years: 30},
+		},
+		{
+			ruleID:   "emergency_fund",
+			matching: InsightFacts{Years: 5, EmergencyFundTarget: 540000, EmergencyFundYear: 2},
+			want:     "2年目に資産が緊急資金の必要額（54万円）を上回る見込みです",
+			other:    InsightFacts{Years: 5, EmergencyFundTarget: 540000},
+		},
+		{
+			ruleID:   "debt_free",
+			matching: InsightFacts{Years: 5, InitialLiabilities: 540000, DebtFreeYear: 2},
+			want:     "2年目に負債（現在54万円）を完済し、返済額を資産形成に回せるようになります",
+			other:    InsightFacts{Years: 5, InitialLiabilities: 540000},
+		},
+		{
+			ruleID:   "inflation_erosion",
+			matching: InsightFacts{Years: 30, FinalAssets: 10000000, FinalRealValue: 5500000},
+			want:     "インフレにより30年後の資産の実質価値は名目額の約55%（550万円）に目減りする見込みです",
+			other:    InsightFacts{Years: 5, FinalAssets: 10000000, FinalRealValue: 9000000},
+		},
+		{
+			ruleID:   "asset_decrease",
+			matching: InsightFacts{Years: 10, InitialAssets: 5000000, FinalAssets: 3000000},
+			want:     "現在の収支では10年後の資産が現在より200万円減る見込みです。支出の見直しを検討しましょう",
+			other:    InsightFacts{Years: 10, InitialAssets: 3000000, FinalAssets: 5000000},
+		},
+		{
+			ruleID:   "life_events",
+			matching: InsightFacts{Years: 5, LifeEventCount: 1, LifeEventDifference: -1900000},
+			want:     "ライフイベントにより5年後の資産が約190万円少なくなる見込みです",
+			other:    InsightFacts{Years: 5, LifeEventCount: 1, LifeEventDifference: 500000},
+		},
+	}
+
+	if len(tests) != len(DefaultInsightRules()) {
+		t.Fatalf("すべてのルールをテストする必要があります: got %d, want %d", len(tests), len(DefaultInsightRules()))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ruleID, func(t *testing.T) {
+			engine := NewProjectionInsightEngineWithRules([]InsightRule{findInsightRule(t, tt.ruleID)})
+
+			if got := engine.insightsFor(tt.matching); !reflect.DeepEqual(got, []string{tt.want}) {
+				t.Errorf("洞察が期待値と異なります: got %q, want %q", got, tt.want)
+			}
+			if got := engine.insightsFor(tt.other); len(got) != 0 {
+				t.Errorf("条件を満たさない場合は洞察を返さないべきです: got %q", got)
+			}
+		})
+	}
+}
+
+func TestNewInsightFacts(t *testing.T) {
+	plan := newInsightTestPlan(t)
+	target := plan.EmergencyFund().RequiredAmount().Amount()
+	if target <= 300000 || target >= 1200000 {
+		t.Fatalf("前提となる緊急資金の必要額が想定外です: %f", target)
+	}
+
+	projections := []entities.AssetProjection{
+		insightProjection(1, 500000, 500000, 0),
+		insightProjection(2, 1200000, 1000000, 0),
+		insightProjection(3, 12000000, 2000000, 0),
+	}
+
+	facts := NewInsightFacts(projections, plan)
+
+	want := InsightFacts{
+		Years:               3,
+		InitialAssets:       300000,
+		FinalAssets:         12000000,
+		FinalRealValue:      10800000,
+		BreakevenYear:       3,
+		DoublingYear:        2,
+		TenMillionYear:      3,
+		EmergencyFundTarget: target,
+		EmergencyFundYear:   2,
+	}
+	if !reflect.DeepEqual(facts, want) {
+		t.Errorf("算出した値が期待値と異なります:\ngot  %+v\nwant %+v", facts, want)
+	}
+}
+
+func TestProjectionInsightEngine_GenerateInsights(t *testing.T) {
+	engine := NewProjectionInsightEngine()
+
+	t.Run("条件を満たすルールの洞察をルールの順に返す", func(t *testing.T) {
+		projections := []entities.AssetProjection{
+			insightProjection(1, 500000, 500000, 0),
+			insightProjection(2, 1200000, 1000000, 0),
+		}
+
+		got := engine.GenerateInsights(projections, newInsightTestPlan(t))
+
+		want := []string{
+			"長期投資により安定した資産形成が期待できます",
+			"2年目に資産が現在の2倍の60万円に達する見込みです",
+		}
+		if len(got) != 3 || !reflect.DeepEqual(got[:2], want) {
+			t.Fatalf("洞察が期待値と異なります: %q", got)
+		}
+		if !strings.HasPrefix(got[2], "2年目に資産が緊急資金の必要額") {
+			t.Errorf("緊急資金の洞察が期待値と異なります: %q", got[2])
+		}
+	})
+
+	t.Run("資産推移がない場合は洞察を返さない", func(t *testing.T) {
+		if got := engine.GenerateInsights(nil, newInsightTestPlan(t)); got != nil {
+			t.Errorf("洞察は nil であるべきです: %q", got)
+		}
+	})
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// IncomeTaxBracket は所得税の速算表の1段階を表す
+// 課税所得が UpperLimit 以下の場合に「課税所得 × Rate - Deduction」で税額を求める
+type IncomeTaxBracket struct {
+	UpperLimit float64 // 課税所得の上限（円）。最上位の段階は math.Inf(1)
+	Rate       float64 // 税率（小数）
+	Deduction  float64 // 控除額（円）
+}
+
+// EmploymentIncomeDeductionBracket は給与所得控除の1段階を表す
+// 給与収入が UpperLimit 以下の場合に「給与収入 × Rate + Addition」を控除額とする
+type EmploymentIncomeDeductionBracket struct {
+	UpperLimit float64 // 給与収入の上限（円）。最上位の段階は math.Inf(1)
+	Rate       float64 // 給与収入に乗じる割合（小数）
+	Addition   float64 // 加算額（円）
+}
+
+// PublicPensionDeductionBracket は公的年金等控除（65歳以上・公的年金等以外の所得が1,000万円以下）の1段階を表す
+// 公的年金等の収入が UpperLimit 以下の場合に「収入 × Rate + Addition」を控除額とする
+type PublicPensionDeductionBracket struct {
+	UpperLimit float64 // 公的年金等の収入の上限（円）。最上位の段階は math.Inf(1)
+	Rate       float64 // 収入に乗じる割合（小数）
+	Addition   float64 // 加算額（円）
+}
+
+// TaxTable は手取り推定に使用する税率・控除額の定義
+// 税制改正に合わせて新しい版を追加し、Version で推定に使用した版を識別する
+type TaxTable struct {
+	Version                    string
+	SocialInsuranceRate        float64 // 額面に対する社会保険料の概算割合
+	EmploymentIncomeDeductions []EmploymentIncomeDeductionBracket
+	BasicDeduction             float64 // 基礎控除（円）
+	IncomeTaxBrackets          []IncomeTaxBracket
+	ReconstructionSurtaxRate   float64 // 復興特別所得税（所得税額に対する割合）
+	ResidentTaxRate            float64 // 住民税（所得割）の概算税率
+	PublicPensionDeductions    []PublicPensionDeductionBracket
+	// RetireeHealthInsuranceRate は退職後の健康保険料（国民健康保険・後期高齢者医療の所得割）の概算割合
+	// 年金所得から RetireeHealthInsuranceDeduction を差し引いた額に乗じる。介護保険料は退職データの月額を使う
+	RetireeHealthInsuranceRate      float64
+	RetireeHealthInsuranceDeduction float64
+	CapitalGainsTaxRate             float64 // 運用益にかかる税率（所得税・復興特別所得税・住民税の合計）
+}
+
+// TaxTableR6 は令和6年分の税率・控除額
+var TaxTableR6 = TaxTable{
+	Version:             "R6",
+	SocialInsuranceRate: 0.15,
+	EmploymentIncomeDeductions: []EmploymentIncomeDeductionBracket{
+		{UpperLimit: 1_625_000, Rate: 0, Addition: 550_000},
+		{UpperLimit: 1_800_000, Rate: 0.4, Addition: -100_000},
+		{UpperLimit: 3_600_000, Rate: 0.3, Addition: 80_000},
+		{UpperLimit: 6_600_000, Rate: 0.2, Addition: 440_000},
+		{UpperLimit: 8_500_000, Rate: 0.1, Addition: 1_100_000},
+		{UpperLimit: math.Inf(1), Rate: 0, Addition: 1_950_000},
+	},
+	BasicDeduction: 480_000,
+	IncomeTaxBrackets: []IncomeTaxBracket{
+		{UpperLimit: 1_950_000, Rate: 0.05, Deduction: 0},
+		{UpperLimit: 3_300_000, Rate: 0.10, Deduction: 97_500},
+		{UpperLimit: 6_950_000, Rate: 0.20, Deduction: 427_500},
+		{UpperLimit: 9_000_000, Rate: 0.23, Deduction: 636_000},
+		{UpperLimit: 18_000_000, Rate: 0.33, Deduction: 1_536_000},
+		{UpperLimit: 40_000_000, Rate: 0.40, Deduction: 2_796_000},
+		{UpperLimit: math.Inf(1), Rate: 0.45, Deduction: 4_796_000},
+	},
+	ReconstructionSurtaxRate: 0.021,
+	ResidentTaxRate:          0.10,
+	PublicPensionDeductions: []PublicPensionDeductionBracket{
+		{UpperLimit: 3_300_000, Rate: 0, Addition: 1_100_000},
+		{UpperLimit: 4_100_000, Rate: 0.25, Addition: 275_000},
+		{UpperLimit: 7_700_000, Rate: 0.15, Addition: 685_000},
+		{UpperLimit: 10_000_000, Rate: 0.05, Addition: 1_455_000},
+		{UpperLimit: math.Inf(1), Rate: 0, Addition: 1_955_000},
+	},
+	RetireeHealthInsuranceRate:      0.09,
+	RetireeHealthInsuranceDeduction: 430_000,
+	CapitalGainsTaxRate:             0.20315,
+}
+
+// DefaultTaxTable は手取り推定に使用する現行の税率・控除額
+var DefaultTaxTable = TaxTableR6
+
+// TaxEstimate は額面年収から推定した税・社会保険料と手取り額
+type TaxEstimate struct {
+	TableVersion              string  `json:"table_version"`
+	AnnualGrossIncome         float64 `json:"annual_gross_income"`
+	SocialInsurance           float64 `json:"social_insurance"`
+	EmploymentIncomeDeduction float64 `json:"employment_income_deduction"`
+	TaxableIncome             float64 `json:"taxable_income"`
+	IncomeTax                 float64 `json:"income_tax"`
+	ResidentTax               float64 `json:"resident_tax"`
+	TotalBurden               float64 `json:"total_burden"` // 社会保険料・所得税・住民税の合計
+	AnnualNetIncome           float64 `json:"annual_net_income"`
+	MonthlyNetIncome          float64 `json:"monthly_net_income"`
+}
+
+// RetirementTaxEstimate は退職後の公的年金の額面年額から推定した税・社会保険料と手取り額
+type RetirementTaxEstimate struct {
+	TableVersion           string  `json:"table_version"`
+	AnnualPension          float64 `json:"annual_pension"`
+	PublicPensionDeduction float64 `json:"public_pension_deduction"`
+	PensionIncome          float64 `json:"pension_income"` // 公的年金等に係る雑所得（収入 − 公的年金等控除）
+	HealthInsurance        float64 `json:"health_insurance"`
+	TaxableIncome          float64 `json:"taxable_income"`
+	IncomeTax              float64 `json:"income_tax"`
+	ResidentTax            float64 `json:"resident_tax"`
+	TotalBurden            float64 `json:"total_burden"` // 健康保険料・所得税・住民税の合計（介護保険料は含まない）
+	AnnualNetPension       float64 `json:"annual_net_pension"`
+	MonthlyNetPension      float64 `json:"monthly_net_pension"`
+}
+
+// TaxEstimationService は額面収入から所得税・住民税・社会保険料を概算し手取りを推定するドメインサービス
+// 扶養控除などの個別の控除は考慮しない概算である
+type TaxEstimationService struct {
+	table TaxTable
+}
+
+// NewTaxEstimationService は指定した税率表でTaxEstimationServiceを作成する
+func NewTaxEstimationService(table TaxTable) *TaxEstimationService {
+	return &TaxEstimationService{table: table}
+}
+
+// NewDefaultTaxEstimationService は現行の税率表でTaxEstimationServiceを作成する
+func NewDefaultTaxEstimationService() *TaxEstimationService {
+	return NewTaxEstimationService(DefaultTaxTable)
+}
+
+// TableVersion は推定に使用する税率表の版を返す
+func (s *TaxEstimationService) TableVersion() string {
+	return s.table.Version
+}
+
+// CalculateIncomeTax は課税所得に速算表を適用して所得税額（復興特別所得税を除く）を計算する
+func (s *TaxEstimationService) CalculateIncomeTax(taxableIncome float64) float64 {
+	if taxableIncome <= 0 {
+		return 0
+	}
+	// 課税所得は1,000円未満を切り捨てる
+	taxableIncome = math.Floor(taxableIncome/1000) * 1000
+	for _, bracket := range s.table.IncomeTaxBrackets {
+		if taxableIncome <= bracket.UpperLimit {
+			return taxableIncome*bracket.Rate - bracket.Deduction
+		}
+	}
+	return 0
+}
+
+// CalculateEmploymentIncomeDeduction は給与収入に対する給与所得控除額を計算する
+// 控除額は給与収入を超えない
+func (s *TaxEstimationService) CalculateEmploymentIncomeDeduction(annualGrossIncome float64) float64 {
+	if annualGrossIncome <= 0 {
+		return 0
+	}
+	for _, bracket := range s.table.EmploymentIncomeDeductions {
+		if annualGrossIncome <= bracket.UpperLimit {
+			return math.Min(annualGrossIncome*bracket.Rate+bracket.Addition, annualGrossIncome)
+		}
+	}
+	return 0
+}
+
+// CalculatePublicPensionDeduction は公的年金等の収入に対する公的年金等控除額を計算する
+// 控除額は収入を超えない
+func (s *TaxEstimationService) CalculatePublicPensionDeduction(annualPension float64) float64 {
+	if annualPension <= 0 {
+		return 0
+	}
+	for _, bracket := range s.table.PublicPensionDeductions {
+		if annualPension <= bracket.UpperLimit {
+			return math.Min(annualPension*bracket.Rate+bracket.Addition, annualPension)
+		}
+	}
+	return 0
+}
+
+// EstimateAnnual は額面年収から税・社会保険料と手取り額を推定する
+func (s *TaxEstimationService) EstimateAnnual(annualGrossIncome float64) (*TaxEstimate, error) {
+	if annualGrossIncome <= 0 {
+		return nil, errors.New("額面年収は正の値である必要があります")
+	}
+
+	socialInsurance := math.Round(annualGrossIncome * s.table.SocialInsuranceRate)
+	employmentIncomeDeduction := s.CalculateEmploymentIncomeDeduction(annualGrossIncome)
+	taxableIncome := math.Max(annualGrossIncome-employmentIncomeDeduction-socialInsurance-s.table.BasicDeduction, 0)
+
+	baseIncomeTax := s.CalculateIncomeTax(taxableIncome)
+	// 復興特別所得税を加えた税額は1円未満を切り捨てる
+	incomeTax := math.Floor(baseIncomeTax * (1 + s.table.ReconstructionSurtaxRate))
+	residentTax := math.Round(taxableIncome * s.table.ResidentTaxRate)
+
+	totalBurden := socialInsurance + incomeTax + residentTax
+	annualNetIncome := annualGrossIncome - totalBurden
+
+	return &TaxEstimate{
+		TableVersion:              s.table.Version,
+		AnnualGrossIncome:         annualGrossIncome,
+		SocialInsurance:           socialInsurance,
+		EmploymentIncomeDeduction: employmentIncomeDeduction,
+		TaxableIncome:             taxableIncome,
+		IncomeTax:                 incomeTax,
+		ResidentTax:               residentTax,
+		TotalBurden:               totalBurden,
+		AnnualNetIncome:           annualNetIncome,
+		MonthlyNetIncome:          math.Round(annualNetIncome / 12),
+	}, nil
+}
+
+// EstimateRetirementAnnual は公的年金の額面年額から退職後の税・健康保険料と手取り額を推定する
+// annualNurseCareInsurance（介護保険料の年額）は負担額には含めず、社会保険料控除としてのみ扱う
+func (s *TaxEstimationService) EstimateRetirementAnnual(annualPension, annualNurseCareInsurance float64) (*RetirementTaxEstimate, error) {
+	if annualPension < 0 || annualNurseCareInsurance < 0 {
+		return nil, errors.New("年金額・介護保険料は負の値にできません")
+	}
+
+	publicPensionDeduction := s.CalculatePublicPensionDeduction(annualPension)
+	pensionIncome := annualPension - publicPensionDeduction
+	healthInsurance := math.Round(math.Max(pensionIncome-s.table.RetireeHealthInsuranceDeduction, 0) * s.table.RetireeHealthInsuranceRate)
+	taxableIncome := math.Max(pensionIncome-healthInsurance-annualNurseCareInsurance-s.table.BasicDeduction, 0)
+
+	baseIncomeTax := s.CalculateIncomeTax(taxableIncome)
+	incomeTax := math.Floor(baseIncomeTax * (1 + s.table.ReconstructionSurtaxRate))
+	residentTax := math.Round(taxableIncome * s.table.ResidentTaxRate)
+
+	totalBurden := healthInsurance + incomeTax + residentTax
+	annualNetPension := annualPension - totalBurden
+
+	return &RetirementTaxEstimate{
+		TableVersion:           s.table.Version,
+		AnnualPension:          annualPension,
+		PublicPensionDeduction: publicPensionDeduction,
+		PensionIncome:          pensionIncome,
+		HealthInsurance:        healthInsurance,
+		TaxableIncome:          taxableIncome,
+		IncomeTax:              incomeTax,
+		ResidentTax:            residentTax,
+		TotalBurden:            totalBurden,
+		AnnualNetPension:       annualNetPension,
+		MonthlyNetPension:      math.Round(annualNetPension / 12),
+	}, nil
+}
+
+// DrawdownTaxRate は資産の取り崩し額に対する実効税率を返す
+// 取り崩し額のうち運用益の割合（gainRatio）に運用益の税率を乗じる。元本部分には課税しない
+func (s *TaxEstimationService) DrawdownTaxRate(gainRatio float64) float64 {
+	return math.Min(math.Max(gainRatio, 0), 1) * s.table.CapitalGainsTaxRate
+}
+
+// Table は推定に使用する税率表を返す（レポートに前提の税率を示すために使う）
+func (s *TaxEstimationService) Table() TaxTable {
+	return s.table
+}
+
+// EstimateFromMonthlyGross は額面月収（12か月分を年収とみなす）から税・社会保険料と手取り額を推定する
+func (s *TaxEstimationService) EstimateFromMonthlyGross(monthlyGrossIncome valueobjects.Money) (*TaxEstimate, error) {
+	return s.EstimateAnnual(monthlyGrossIncome.Amount() * 12)
+}
+
+// ApplyIncomeType は月収の入力区分をプロファイルに設定する
+// 額面の場合は手取り月収を推定して設定し、手取りの場合は入力額をそのまま手取りとして扱う
+func (s *TaxEstimationService) ApplyIncomeType(profile *entities.FinancialProfile, incomeType entities.IncomeType) error {
+	if profile == nil {
+		return errors.New("財務プロファイルは必須です")
+	}
+
+	if incomeType != entities.IncomeTypeGross {
+		return profile.SetIncomeType(incomeType, profile.MonthlyIncome())
+	}
+
+	estimate, err := s.EstimateFromMonthlyGross(profile.MonthlyIncome())
+	if err != nil {
+		return fmt.Errorf("手取り月収の推定に失敗しました: %w", err)
+	}
+	netMonthlyIncome, err := valueobjects.NewMoneyJPY(estimate.MonthlyNetIncome)
+	if err != nil {
+		return fmt.Errorf("手取り月収の作成に失敗しました: %w", err)
+	}
+	return profile.SetIncomeType(incomeType, netMonthlyIncome)
+}
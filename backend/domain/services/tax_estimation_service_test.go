@@ -0,0 +1,248 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+func TestCalculateIncomeTax(t *testing.T) {
+	service := NewTaxEstimationService(TaxTableR6)
+
+	// 国税庁「所得税の税率」（No.2260）の速算表による計算例
+	tests := []struct {
+		name          string
+		taxableIncome float64
+		expected      float64
+	}{
+		{"課税所得なし", 0, 0},
+		{"5%の段階", 1_000_000, 50_000},
+		{"5%の段階の上限", 1_950_000, 97_500},
+		{"10%の段階の上限", 3_300_000, 232_500},
+		{"23%の段階（国税庁の計算例）", 7_000_000, 974_000},
+		{"33%の段階", 10_000_000, 1_764_000},
+		{"45%の段階", 50_000_000, 17_704_000},
+		{"1,000円未満は切り捨て", 1_000_999, 50_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.CalculateIncomeTax(tt.taxableIncome); got != tt.expected {
+				t.Errorf("所得税額が期待値と異なります: got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateEmploymentIncomeDeduction(t *testing.T) {
+	service := NewTaxEstimationService(TaxTableR6)
+
+	tests := []struct {
+		name        string
+		annualGross float64
+		expected    float64
+	}{
+		{"最低額を下回る給与収入は全額控除", 500_000, 500_000},
+		{"162.5万円以下は55万円", 1_500_000, 550_000},
+		{"360万円以下", 3_000_000, 980_000},
+		{"660万円以下", 5_000_000, 1_440_000},
+		{"850万円超は上限の195万円", 10_000_000, 1_950_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.CalculateEmploymentIncomeDeduction(tt.annualGross); got != tt.expected {
+				t.Errorf("給与所得控除額が期待値と異なります: got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEstimateAnnual(t *testing.T) {
+	service := NewTaxEstimationService(TaxTableR6)
+
+	estimate, err := service.EstimateAnnual(6_000_000)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	// 社会保険料 90万円、給与所得控除 164万円、基礎控除 48万円 → 課税所得 298万円
+	if estimate.SocialInsurance != 900_000 {
+		t.Errorf("社会保険料が期待値と異なります: got %v", estimate.SocialInsurance)
+	}
+	if estimate.TaxableIncome != 2_980_000 {
+		t.Errorf("課税所得が期待値と異なります: got %v", estimate.TaxableIncome)
+	}
+	// 所得税 200,500円 × 1.021（復興特別所得税）の1円未満切り捨て
+	if estimate.IncomeTax != 204_710 {
+		t.Errorf("所得税額が期待値と異なります: got %v", estimate.IncomeTax)
+	}
+	if estimate.ResidentTax != 298_000 {
+		t.Errorf("住民税額が期待値と異なります: got %v", estimate.ResidentTax)
+	}
+	if estimate.TotalBurden != 1_402_710 {
+		t.Errorf("税・社会保険料の合計が期待値と異なります: got %v", estimate.TotalBurden)
+	}
+	if estimate.AnnualNetIncome != 4_597_290 || estimate.MonthlyNetIncome != 383_108 {
+		t.Errorf("手取り額が期待値と異なります: got %v (月 %v)", estimate.AnnualNetIncome, estimate.MonthlyNetIncome)
+	}
+	if estimate.TableVersion != "R6" {
+		t.Errorf("税率表の版が期待値と異なります: got %s", estimate.TableVersion)
+	}
+
+	if _, err := service.EstimateAnnual(0); err == nil {
+		t.Error("額面年収が0の場合はエラーになるべきです")
+	}
+}
+
+func TestCalculatePublicPensionDeduction(t *testing.T) {
+	service := NewTaxEstimationService(TaxTableR6)
+
+	tests := []struct {
+		name          string
+		annualPension float64
+		expected      float64
+	}{
+		{"最低額を下回る年金は全額控除", 960_000, 960_000},
+		{"330万円以下は110万円", 3_000_000, 1_100_000},
+		{"410万円以下", 4_000_000, 1_275_000},
+		{"770万円以下", 5_000_000, 1_435_000},
+		{"1,000万円超は上限の195.5万円", 12_000_000, 1_955_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := service.CalculatePublicPensionDeduction(tt.annualPension); got != tt.expected {
+				t.Errorf("公的年金等控除額が期待値と異なります: got %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEstimateRetirementAnnual(t *testing.T) {
+	service := NewTaxEstimationService(TaxTableR6)
+
+	estimate, err := service.EstimateRetirementAnnual(3_000_000, 0)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	// 公的年金等控除 110万円 → 雑所得 190万円、健康保険料 (190万円 − 43万円) × 9%
+	if estimate.PensionIncome != 1_900_000 || estimate.HealthInsurance != 132_300 {
+		t.Errorf("雑所得・健康保険料が期待値と異なります: got %v, %v", estimate.PensionIncome, estimate.HealthInsurance)
+	}
+	// 課税所得 190万円 − 132,300円 − 基礎控除 48万円
+	if estimate.TaxableIncome != 1_287_700 {
+		t.Errorf("課税所得が期待値と異なります: got %v", estimate.TaxableIncome)
+	}
+	// 所得税 64,350円 × 1.021 の1円未満切り捨て、住民税は課税所得の10%
+	if estimate.IncomeTax != 65_701 || estimate.ResidentTax != 128_770 {
+		t.Errorf("所得税・住民税が期待値と異なります: got %v, %v", estimate.IncomeTax, estimate.ResidentTax)
+	}
+	if estimate.AnnualNetPension != 2_673_229 || estimate.MonthlyNetPension != 222_769 {
+		t.Errorf("手取り額が期待値と異なります: got %v (月 %v)", estimate.AnnualNetPension, estimate.MonthlyNetPension)
+	}
+
+	// 介護保険料は社会保険料控除として課税所得を減らす
+	withNurseCare, err := service.EstimateRetirementAnnual(3_000_000, 120_000)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if withNurseCare.TaxableIncome != 1_167_700 {
+		t.Errorf("介護保険料を控除した課税所得が期待値と異なります: got %v", withNurseCare.TaxableIncome)
+	}
+
+	// 公的年金等控除以下の年金には税・保険料がかからない
+	small, err := service.EstimateRetirementAnnual(960_000, 0)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if small.TotalBurden != 0 || small.MonthlyNetPension != 80_000 {
+		t.Errorf("控除以下の年金の負担が期待値と異なります: got %v (月 %v)", small.TotalBurden, small.MonthlyNetPension)
+	}
+
+	if _, err := service.EstimateRetirementAnnual(-1, 0); err == nil {
+		t.Error("年金額が負の場合はエラーになるべきです")
+	}
+}
+
+func TestDrawdownTaxRate(t *testing.T) {
+	service := NewTaxEstimationService(TaxTableR6)
+
+	if got := service.DrawdownTaxRate(0.5); got != 0.5*0.20315 {
+		t.Errorf("取り崩しの実効税率が期待値と異なります: got %v", got)
+	}
+	if got := service.DrawdownTaxRate(-0.1); got != 0 {
+		t.Errorf("運用益がない場合は0であるべきです: got %v", got)
+	}
+	if got := service.DrawdownTaxRate(1.5); got != 0.20315 {
+		t.Errorf("実効税率は運用益の税率を超えないべきです: got %v", got)
+	}
+}
+
+func TestApplyIncomeType_GrossAndNetConverge(t *testing.T) {
+	service := NewDefaultTaxEstimationService()
+
+	newProfile := func(monthlyIncome float64) *entities.FinancialProfile {
+		income, _ := valueobjects.NewMoneyJPY(monthlyIncome)
+		expense, _ := valueobjects.NewMoneyJPY(200_000)
+		savings, _ := valueobjects.NewMoneyJPY(1_000_000)
+		investmentReturn, _ := valueobjects.NewRate(5.0)
+		inflationRate, _ := valueobjects.NewRate(2.0)
+		profile, err := entities.NewFinancialProfile(
+			entities.UserID("user-001"),
+			income,
+			entities.ExpenseCollection{{Category: "生活費", Amount: expense}},
+			entities.SavingsCollection{{Type: "deposit", Amount: savings}},
+			investmentReturn,
+			inflationRate,
+		)
+		if err != nil {
+			t.Fatalf("プロファイルの作成に失敗しました: %v", err)
+		}
+		return profile
+	}
+
+	// 額面50万円で入力したプロファイル
+	grossProfile := newProfile(500_000)
+	if err := service.ApplyIncomeType(grossProfile, entities.IncomeTypeGross); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	netIncome := grossProfile.NetMonthlyIncome().Amount()
+	if netIncome >= 500_000 {
+		t.Fatalf("手取り月収は額面より少ないはずです: got %v", netIncome)
+	}
+
+	// 推定された手取り額を手取りとして入力したプロファイル
+	netProfile := newProfile(netIncome)
+	if err := service.ApplyIncomeType(netProfile, entities.IncomeTypeNet); err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+
+	grossSavings, _ := grossProfile.CalculateNetSavings()
+	netSavings, _ := netProfile.CalculateNetSavings()
+	if grossSavings.Amount() != netSavings.Amount() {
+		t.Errorf("純貯蓄額が一致しません: gross %v, net %v", grossSavings.Amount(), netSavings.Amount())
+	}
+
+	grossProjections, err := grossProfile.ProjectAssets(10)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	netProjections, err := netProfile.ProjectAssets(10)
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	for i := range grossProjections {
+		if grossProjections[i].TotalAssets.Amount() != netProjections[i].TotalAssets.Amount() {
+			t.Errorf("%d年目の資産額が一致しません: gross %v, net %v",
+				grossProjections[i].Year, grossProjections[i].TotalAssets.Amount(), netProjections[i].TotalAssets.Amount())
+		}
+	}
+
+	// 表示用に額面の月収も保持する
+	if grossProfile.MonthlyIncome().Amount() != 500_000 || grossProfile.IncomeType() != entities.IncomeTypeGross {
+		t.Error("額面の月収と入力区分が保持されていません")
+	}
+}
@@ -0,0 +1,57 @@
+package valueobjects
+
+import "fmt"
+
+// ContributionTiming は積立の拠出タイミング（各期の期初に拠出するか期末に拠出するか）
+type ContributionTiming string
+
+const (
+	// ContributionTimingEnd は期末拠出（毎月末に積み立てる。Excel の FV 関数で支払期日=0 と同じ前提）
+	ContributionTimingEnd ContributionTiming = "end"
+	// ContributionTimingBeginning は期初拠出（毎月初に積み立てる。Excel の FV 関数で支払期日=1 と同じ前提）
+	// 拠出した月から運用されるため、期末拠出より1期分多く運用益が付く
+	ContributionTimingBeginning ContributionTiming = "beginning"
+)
+
+// DefaultContributionTiming は拠出タイミングを指定しない場合の既定値（期末拠出）
+// 給与の受け取り後に積み立てる一般的な前提で、これまでの計算結果とも一致する
+const DefaultContributionTiming = ContributionTimingEnd
+
+// ParseContributionTiming は文字列を拠出タイミングに変換する
+// 空文字の場合は既定値（期末拠出）として扱う
+func ParseContributionTiming(value string) (ContributionTiming, error) {
+	if value == "" {
+		return DefaultContributionTiming, nil
+	}
+	timing := ContributionTiming(value)
+	if !timing.IsValid() {
+		return "", fmt.Errorf("無効な拠出タイミングです: %s（使用可能: %s, %s）", value, string(ContributionTimingBeginning), string(ContributionTimingEnd))
+	}
+	return timing, nil
+}
+
+// IsValid は拠出タイミングが有効かどうかを返す
+func (t ContributionTiming) IsValid() bool {
+	return t == ContributionTimingBeginning || t == ContributionTimingEnd
+}
+
+// String は拠出タイミングの表示名を返す
+func (t ContributionTiming) String() string {
+	switch t {
+	case ContributionTimingBeginning:
+		return "期初拠出"
+	case ContributionTimingEnd:
+		return "期末拠出"
+	default:
+		return "不明"
+	}
+}
+
+// AnnuityFutureValueFactor は拠出タイミングに応じた年金終価係数を返す
+// 期末拠出は AnnuityFutureValueFactor、期初拠出は AnnuityDueFutureValueFactor と同じ
+func (t ContributionTiming) AnnuityFutureValueFactor(ratePerPeriod float64, periods int) float64 {
+	if t == ContributionTimingBeginning {
+		return AnnuityDueFutureValueFactor(ratePerPeriod, periods)
+	}
+	return AnnuityFutureValueFactor(ratePerPeriod, periods)
+}
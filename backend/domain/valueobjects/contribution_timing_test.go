@@ -0,0 +1,57 @@
+package valueobjects
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseContributionTiming(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected ContributionTiming
+		wantErr  bool
+	}{
+		{"", ContributionTimingEnd, false},
+		{"end", ContributionTimingEnd, false},
+		{"beginning", ContributionTimingBeginning, false},
+		{"middle", "", true},
+		{"END", "", true},
+	}
+
+	for _, tt := range tests {
+		timing, err := ParseContributionTiming(tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%q: Expected error", tt.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: Expected no error, got %v", tt.value, err)
+		}
+		if timing != tt.expected {
+			t.Errorf("%q: Expected %s, got %s", tt.value, string(tt.expected), string(timing))
+		}
+	}
+}
+
+func TestContributionTimingAnnuityFutureValueFactor(t *testing.T) {
+	// 期初払いは各拠出が1期分多く運用されるため、期末払いの (1 + i) 倍になる
+	for _, rate := range []float64{0, 0.001, 0.0040741237836483016, 0.05} {
+		end := ContributionTimingEnd.AnnuityFutureValueFactor(rate, 360)
+		beginning := ContributionTimingBeginning.AnnuityFutureValueFactor(rate, 360)
+		if math.Abs(beginning-end*(1+rate)) > 1e-9 {
+			t.Errorf("rate %g: Expected beginning factor %f, got %f", rate, end*(1+rate), beginning)
+		}
+	}
+
+	// 未設定の拠出タイミングは期末払いとして扱う
+	if got := ContributionTiming("").AnnuityFutureValueFactor(0.05, 30); got != AnnuityFutureValueFactor(0.05, 30) {
+		t.Errorf("Expected end-of-period factor, got %f", got)
+	}
+
+	// 期間が0以下の場合はどちらも0
+	if got := ContributionTimingBeginning.AnnuityFutureValueFactor(0.05, 0); got != 0 {
+		t.Errorf("Expected 0 for zero periods, got %f", got)
+	}
+}
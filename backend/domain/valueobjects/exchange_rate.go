@@ -0,0 +1,106 @@
+package valueobjects
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// ExchangeRateSourceManual はユーザーが手入力した為替レートの取得元
+const ExchangeRateSourceManual = "manual"
+
+// ExchangeRate は外貨1単位あたりの基準通貨の金額を表す値オブジェクト（例: 1 USD = 150 JPY）
+// 取得元と基準日を持ち、手入力のレートも外部の為替レート提供元から取得したレートも同じ形で扱う
+type ExchangeRate struct {
+	base   Currency  // 基準通貨（財務計画の通貨）
+	quote  Currency  // 外貨（目標の通貨）
+	rate   float64   // 外貨1単位あたりの基準通貨の金額
+	source string    // 取得元（手入力の場合は manual）
+	asOf   time.Time // レートの基準日時
+}
+
+// NewExchangeRate は新しいExchangeRate値オブジェクトを作成する（バリデーション付き）
+// source を省略した場合は手入力（manual）として扱う
+func NewExchangeRate(base, quote Currency, rate float64, source string, asOf time.Time) (ExchangeRate, error) {
+	if !base.IsSupported() || !quote.IsSupported() {
+		return ExchangeRate{}, fmt.Errorf("対応していない通貨です: %s/%s", quote, base)
+	}
+	if base == quote {
+		return ExchangeRate{}, errors.New("為替レートの通貨は基準通貨と異なる必要があります")
+	}
+	if math.IsNaN(rate) || math.IsInf(rate, 0) || rate <= 0 {
+		return ExchangeRate{}, errors.New("為替レートは正の値である必要があります")
+	}
+	if asOf.IsZero() {
+		return ExchangeRate{}, errors.New("為替レートの基準日時は必須です")
+	}
+
+	source = strings.TrimSpace(source)
+	if source == "" {
+		source = ExchangeRateSourceManual
+	}
+
+	return ExchangeRate{
+		base:   base,
+		quote:  quote,
+		rate:   rate,
+		source: source,
+		asOf:   asOf,
+	}, nil
+}
+
+// Base は基準通貨を返す
+func (r ExchangeRate) Base() Currency {
+	return r.base
+}
+
+// Quote は外貨を返す
+func (r ExchangeRate) Quote() Currency {
+	return r.quote
+}
+
+// Rate は外貨1単位あたりの基準通貨の金額を返す
+func (r ExchangeRate) Rate() float64 {
+	return r.rate
+}
+
+// Source はレートの取得元を返す
+func (r ExchangeRate) Source() string {
+	return r.source
+}
+
+// AsOf はレートの基準日時を返す
+func (r ExchangeRate) AsOf() time.Time {
+	return r.asOf
+}
+
+// Age は now 時点でのレートの経過時間を返す（基準日時が未来の場合は0）
+func (r ExchangeRate) Age(now time.Time) time.Duration {
+	if now.Before(r.asOf) {
+		return 0
+	}
+	return now.Sub(r.asOf)
+}
+
+// ToBase は外貨の金額を基準通貨に換算する
+func (r ExchangeRate) ToBase(amount Money) (Money, error) {
+	if amount.Currency() != r.quote {
+		return Money{}, fmt.Errorf("為替レートの通貨と異なる金額は換算できません: %s と %s", amount.Currency(), r.quote)
+	}
+	return NewMoney(amount.Amount()*r.rate, r.base)
+}
+
+// FromBase は基準通貨の金額を外貨に換算する
+func (r ExchangeRate) FromBase(amount Money) (Money, error) {
+	if amount.Currency() != r.base {
+		return Money{}, fmt.Errorf("為替レートの基準通貨と異なる金額は換算できません: %s と %s", amount.Currency(), r.base)
+	}
+	return NewMoney(amount.Amount()/r.rate, r.quote)
+}
+
+// String は為替レートの文字列表現を返す
+func (r ExchangeRate) String() string {
+	return fmt.Sprintf("1 %s = %g %s", r.quote, r.rate, r.base)
+}
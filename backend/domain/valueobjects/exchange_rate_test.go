@@ -0,0 +1,86 @@
+package valueobjects
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	asOf := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	rate, err := NewExchangeRate(JPY, USD, 150, "", asOf)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if rate.Rate() != 150 || rate.Base() != JPY || rate.Quote() != USD {
+		t.Errorf("Unexpected rate: %s", rate)
+	}
+	if rate.Source() != ExchangeRateSourceManual {
+		t.Errorf("Expected default source %q, got %q", ExchangeRateSourceManual, rate.Source())
+	}
+
+	invalid := []struct {
+		name  string
+		base  Currency
+		quote Currency
+		rate  float64
+		asOf  time.Time
+	}{
+		{"zero rate", JPY, USD, 0, asOf},
+		{"negative rate", JPY, USD, -150, asOf},
+		{"NaN rate", JPY, USD, math.NaN(), asOf},
+		{"unsupported currency", JPY, Currency("GBP"), 190, asOf},
+		{"same currency", JPY, JPY, 1, asOf},
+		{"missing as of", JPY, USD, 150, time.Time{}},
+	}
+	for _, tt := range invalid {
+		if _, err := NewExchangeRate(tt.base, tt.quote, tt.rate, "", tt.asOf); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestExchangeRateConversion(t *testing.T) {
+	rate, _ := NewExchangeRate(JPY, USD, 150, "manual", time.Now())
+
+	usd, _ := NewMoney(10000, USD)
+	jpy, err := rate.ToBase(usd)
+	if err != nil || jpy.Amount() != 1500000 || jpy.Currency() != JPY {
+		t.Errorf("ToBase = %s, %v; want 1500000.00 JPY", jpy, err)
+	}
+
+	back, err := rate.FromBase(jpy)
+	if err != nil || back.Amount() != 10000 || back.Currency() != USD {
+		t.Errorf("FromBase = %s, %v; want 10000.00 USD", back, err)
+	}
+
+	eur, _ := NewMoney(100, EUR)
+	if _, err := rate.ToBase(eur); err == nil {
+		t.Error("Expected error when converting a different currency")
+	}
+	if _, err := rate.FromBase(usd); err == nil {
+		t.Error("Expected error when converting from a non-base currency")
+	}
+}
+
+func TestExchangeRateAge(t *testing.T) {
+	asOf := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	rate, _ := NewExchangeRate(JPY, USD, 150, "manual", asOf)
+
+	if age := rate.Age(asOf.Add(72 * time.Hour)); age != 72*time.Hour {
+		t.Errorf("Expected age 72h, got %s", age)
+	}
+	if age := rate.Age(asOf.Add(-time.Hour)); age != 0 {
+		t.Errorf("Expected age 0 for a future rate, got %s", age)
+	}
+}
+
+func TestParseCurrency(t *testing.T) {
+	if currency, err := ParseCurrency(" usd "); err != nil || currency != USD {
+		t.Errorf("ParseCurrency(usd) = %s, %v; want USD", currency, err)
+	}
+	if _, err := ParseCurrency("GBP"); err == nil {
+		t.Error("Expected error for unsupported currency")
+	}
+}
@@ -0,0 +1,80 @@
+package valueobjects
+
+import "math"
+
+// このファイルは資産推移・インフレ調整・老後資金の計算式の唯一の実装を提供する
+// エンティティとドメインサービスの双方から使えるよう値オブジェクトのパッケージに置き、
+// 計算方法の説明（公開する計算方法）は services.FinancialCalculationService の同名メソッドに記載する
+
+// MonthlyEquivalentRate は年率（%）と同値の月利を小数で返す（12か月複利で年率と一致する (1 + r/100)^(1/12) - 1）
+// Rate.MonthlyDecimal と同じ換算で、丸めは行わない
+func MonthlyEquivalentRate(annualPercent float64) float64 {
+	return math.Pow(1+annualPercent/100, 1.0/12.0) - 1
+}
+
+// InflationFactor は年率インフレ率（%）の years 年分の複利係数 (1 + r/100)^years を返す
+// 年数が0以下、または係数が正にならない（-100%以下の）場合は調整しないものとして1を返す
+func InflationFactor(annualInflation float64, years int) float64 {
+	if years <= 0 {
+		return 1
+	}
+	factor := math.Pow(1+annualInflation/100, float64(years))
+	if factor <= 0 || math.IsNaN(factor) || math.IsInf(factor, 0) {
+		return 1
+	}
+	return factor
+}
+
+// FutureValue は月次複利・月末積立での months か月後の将来価値を返す
+// FV = P(1+i)^n + C × 年金終価係数(i, n)、i は年率 annualReturn（%）と同値の月利
+func FutureValue(principal, monthlyContribution, annualReturn float64, months int) float64 {
+	return FutureValueWithTiming(principal, monthlyContribution, annualReturn, months, ContributionTimingEnd)
+}
+
+// FutureValueWithTiming は月次複利で、拠出タイミングを指定した months か月後の将来価値を返す
+// 期初拠出の場合は年金終価係数に (1+i) を掛ける（FV = P(1+i)^n + C × 年金終価係数(i, n) × (1+i)）
+func FutureValueWithTiming(principal, monthlyContribution, annualReturn float64, months int, timing ContributionTiming) float64 {
+	if months <= 0 {
+		return principal
+	}
+	i := MonthlyEquivalentRate(annualReturn)
+	return principal*math.Pow(1+i, float64(months)) + monthlyContribution*timing.AnnuityFutureValueFactor(i, months)
+}
+
+// RealValue は years 年後の名目価値を年率インフレ率（%）で現在価値（実質価値）に割り戻す
+// RV = FV / (1 + r/100)^years
+func RealValue(nominal, annualInflation float64, years int) float64 {
+	return nominal / InflationFactor(annualInflation, years)
+}
+
+// RequiredMonthlyContribution は months か月後に target に到達するために毎月末に積み立てる額を返す
+// 不足額 = target − current × (1+i)^n に減債基金係数(i, n) を掛ける。到達済みの場合は0
+// 期間が0以下の場合は運用できないため、不足額をそのまま返す
+func RequiredMonthlyContribution(target, current, annualReturn float64, months int) float64 {
+	if months <= 0 {
+		return math.Max(target-current, 0)
+	}
+	i := MonthlyEquivalentRate(annualReturn)
+	shortfall := target - current*math.Pow(1+i, float64(months))
+	if shortfall <= 0 {
+		return 0
+	}
+	return shortfall * SinkingFundFactor(i, months)
+}
+
+// RetirementRequirement は退職後の生活費のうち年金で賄えない分の総額（退職時点の名目額）を返す
+// 月間不足額（支出 − 年金）を退職までの年数分インフレ調整し、小数点以下2桁に丸めた月額に 12 × 退職後年数 を掛ける
+// 年金で賄える場合や退職後年数が0以下の場合は0
+func RetirementRequirement(monthlyExpenses, monthlyPension float64, yearsUntilRetirement, retirementYears int, annualInflation float64) float64 {
+	monthlyShortfall := monthlyExpenses - monthlyPension
+	if retirementYears <= 0 || monthlyShortfall <= 0 {
+		return 0
+	}
+	adjustedMonthlyShortfall := roundToCents(monthlyShortfall * InflationFactor(annualInflation, yearsUntilRetirement))
+	return adjustedMonthlyShortfall * float64(retirementYears*12)
+}
+
+// roundToCents は金額を Money と同じく小数点以下2桁に丸める
+func roundToCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 )
 
 // Currency は通貨の種類を表す
@@ -15,6 +16,25 @@ const (
 	EUR Currency = "EUR" // ユーロ
 )
 
+// IsSupported は対応している通貨かどうかを返す
+func (c Currency) IsSupported() bool {
+	switch c {
+	case JPY, USD, EUR:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseCurrency は通貨コード（大文字・小文字を区別しない）を対応している通貨に変換する
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if !currency.IsSupported() {
+		return "", fmt.Errorf("対応していない通貨です: %s", code)
+	}
+	return currency, nil
+}
+
 // Money は通貨付きの金額を表す値オブジェクト
 // 不変性を保証し、同一通貨間でのみ演算を許可する
 type Money struct {
@@ -115,6 +115,54 @@ func (r Rate) CompoundFactor(periods int) float64 {
 	return math.Pow(1+r.AsDecimal(), float64(periods))
 }
 
+// MonthlyDecimal は年利と同値の月利を丸めずに小数で返す（例：年利5%の場合は約0.0040741）
+// 12か月複利で年利と一致する実効換算 (1 + annual_rate)^(1/12) - 1 を用いる。
+// MonthlyRate は小数点以下4桁（パーセンテージ）に丸めるため、長期の積立計算ではこちらを使う
+func (r Rate) MonthlyDecimal() float64 {
+	return MonthlyEquivalentRate(r.value)
+}
+
+// AnnuityFutureValueFactor は1期あたりの利率 ratePerPeriod での年金終価係数を返す
+// 毎期末に1ずつ積み立てた場合の periods 期後の元利合計 ((1 + i)^n - 1) / i（Excel の FV 関数で支払期日=0 と同じ前提）
+func AnnuityFutureValueFactor(ratePerPeriod float64, periods int) float64 {
+	if periods <= 0 {
+		return 0
+	}
+	if ratePerPeriod == 0 {
+		return float64(periods)
+	}
+	return (math.Pow(1+ratePerPeriod, float64(periods)) - 1) / ratePerPeriod
+}
+
+// AnnuityDueFutureValueFactor は1期あたりの利率 ratePerPeriod での期初払いの年金終価係数を返す
+// 毎期初に1ずつ積み立てた場合の periods 期後の元利合計 ((1 + i)^n - 1) / i × (1 + i)（Excel の FV 関数で支払期日=1 と同じ前提）
+// 各拠出が期末払いより1期分多く運用されるため、期末払いの係数の (1 + i) 倍になる
+func AnnuityDueFutureValueFactor(ratePerPeriod float64, periods int) float64 {
+	return AnnuityFutureValueFactor(ratePerPeriod, periods) * (1 + ratePerPeriod)
+}
+
+// AnnuityPresentValueFactor は1期あたりの利率 ratePerPeriod での年金現価係数を返す
+// 毎期末に1ずつ受け取るために必要な元本 (1 - (1 + i)^-n) / i（Excel の PV 関数で支払期日=0 と同じ前提）
+func AnnuityPresentValueFactor(ratePerPeriod float64, periods int) float64 {
+	if periods <= 0 {
+		return 0
+	}
+	if ratePerPeriod == 0 {
+		return float64(periods)
+	}
+	return (1 - math.Pow(1+ratePerPeriod, -float64(periods))) / ratePerPeriod
+}
+
+// SinkingFundFactor は1期あたりの利率 ratePerPeriod での減債基金係数（年金終価係数の逆数）を返す
+// periods 期後に1を貯めるために毎期末に積み立てる額 i / ((1 + i)^n - 1)
+func SinkingFundFactor(ratePerPeriod float64, periods int) float64 {
+	factor := AnnuityFutureValueFactor(ratePerPeriod, periods)
+	if factor == 0 {
+		return 0
+	}
+	return 1 / factor
+}
+
 // MonthlyRate は年利を月利に変換する
 func (r Rate) MonthlyRate() (Rate, error) {
 	// 年利を月利に変換: (1 + annual_rate)^(1/12) - 1
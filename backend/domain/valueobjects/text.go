@@ -0,0 +1,37 @@
+package valueobjects
+
+import (
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// maxSanitizePasses は SanitizeText がタグの除去を繰り返す最大回数
+const maxSanitizePasses = 5
+
+// textPolicy はHTMLタグを一切許可しないサニタイズポリシー
+// script・style も要素自体は除去し、中身は文字列として残す（要素を許可しないためタグとして出力されることはない）
+var textPolicy = bluemonday.StrictPolicy().
+	AllowUnsafe(true).
+	AllowElementsContent("script", "style")
+
+// SanitizeText は自由入力テキストからHTMLタグを取り除く（保存型XSS対策）
+// 日本語・絵文字・記号はそのまま残し、&lt; などのエンティティは文字に戻して保存する
+// エンティティを戻した結果タグが現れる場合に備え、結果が変わらなくなるまで除去を繰り返す
+func SanitizeText(input string) string {
+	if !strings.ContainsAny(input, "<&") {
+		return input
+	}
+
+	text := input
+	for i := 0; i < maxSanitizePasses; i++ {
+		next := html.UnescapeString(textPolicy.Sanitize(text))
+		if next == text {
+			return text
+		}
+		text = next
+	}
+	// 収束しない場合はエスケープした状態のまま返す
+	return textPolicy.Sanitize(text)
+}
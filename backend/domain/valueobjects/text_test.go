@@ -0,0 +1,30 @@
+package valueobjects
+
+import (
+	"testing"
+)
+
+func TestSanitizeText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"scriptタグを除去して中身を残す", "<script>alert('xss')</script>", "alert('xss')"},
+		{"日本語はそのまま", "こんにちは", "こんにちは"},
+		{"絵文字と記号はそのまま", "マイホーム購入🏠（頭金）& 旅行 \"2025\" 3 < 5", "マイホーム購入🏠（頭金）& 旅行 \"2025\" 3 < 5"},
+		{"属性付きタグを除去", "<img src=x onerror=alert(1)>旅行資金", "旅行資金"},
+		{"書式タグを除去", "<b>老後</b>資金", "老後資金"},
+		{"エンティティで隠したタグも除去", "&lt;script&gt;alert(1)&lt;/script&gt;", "alert(1)"},
+		{"入れ子にしたタグも除去", "<<script>script>alert(1)<</script>/script>", "alert(1)"},
+		{"改行とタブはそのまま", "1行目\n\t2行目", "1行目\n\t2行目"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeText(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
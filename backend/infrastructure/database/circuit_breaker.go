@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/lib/pq"
+	"github.com/sony/gobreaker/v2"
+)
+
+// ErrCircuitOpen はサーキットブレーカーが開いていてデータベースへの呼び出しを遮断した場合のエラー
+var ErrCircuitOpen = errors.New("データベースが一時的に利用できません（サーキットブレーカーが開いています）")
+
+// デフォルトの連続失敗回数（この回数連続で失敗するとブレーカーを開く）
+const defaultCircuitBreakerFailureThreshold = 5
+
+// スライディングウィンドウを分割するバケット数
+const circuitBreakerWindowBuckets = 10
+
+// CircuitState はサーキットブレーカーの状態
+type CircuitState string
+
+const (
+	CircuitStateClosed   CircuitState = "closed"    // 通常どおり呼び出す
+	CircuitStateHalfOpen CircuitState = "half-open" // 回復確認のため一部の呼び出しのみ許可する
+	CircuitStateOpen     CircuitState = "open"      // 呼び出しを遮断する
+)
+
+// CircuitBreaker はデータベース障害の連鎖を防ぐサーキットブレーカー
+type CircuitBreaker interface {
+	// Execute はブレーカーが閉じていれば fn を実行し、開いていれば fn を呼ばずに ErrCircuitOpen を返す
+	Execute(fn func() error) error
+	// State は現在の状態を返す
+	State() CircuitState
+}
+
+// SlidingWindowCircuitBreaker は sony/gobreaker を使ったスライディングウィンドウ方式のサーキットブレーカー
+// 閉状態では Interval の間の失敗をバケット単位で数え、FailureThreshold 回連続で失敗すると開状態に移る
+// 開状態は Timeout 経過後に半開状態となり、MaxRequests 件の試行がすべて成功すると閉状態に戻る
+type SlidingWindowCircuitBreaker struct {
+	breaker *gobreaker.CircuitBreaker[struct{}]
+}
+
+// NewSlidingWindowCircuitBreaker は新しいSlidingWindowCircuitBreakerを作成する
+func NewSlidingWindowCircuitBreaker(cfg config.CircuitBreakerConfig) *SlidingWindowCircuitBreaker {
+	threshold := cfg.FailureThreshold
+	if threshold == 0 {
+		threshold = defaultCircuitBreakerFailureThreshold
+	}
+
+	var bucketPeriod time.Duration
+	if cfg.Interval > 0 {
+		bucketPeriod = cfg.Interval / circuitBreakerWindowBuckets
+	}
+
+	return &SlidingWindowCircuitBreaker{
+		breaker: gobreaker.NewCircuitBreaker[struct{}](gobreaker.Settings{
+			Name:         "database",
+			MaxRequests:  cfg.MaxRequests,
+			Interval:     cfg.Interval,
+			BucketPeriod: bucketPeriod,
+			Timeout:      cfg.Timeout,
+			ReadyToTrip: func(counts gobreaker.Counts) bool {
+				return counts.ConsecutiveFailures >= threshold
+			},
+			IsSuccessful: isDatabaseAvailable,
+			OnStateChange: func(name string, from, to gobreaker.State) {
+				log.Printf("サーキットブレーカー(%s)の状態が変化しました: %s -> %s", name, from, to)
+			},
+		}),
+	}
+}
+
+// Execute はブレーカーを通して fn を実行する
+func (b *SlidingWindowCircuitBreaker) Execute(fn func() error) error {
+	_, err := b.breaker.Execute(func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+		return ErrCircuitOpen
+	}
+	return err
+}
+
+// State は現在の状態を返す
+func (b *SlidingWindowCircuitBreaker) State() CircuitState {
+	switch b.breaker.State() {
+	case gobreaker.StateOpen:
+		return CircuitStateOpen
+	case gobreaker.StateHalfOpen:
+		return CircuitStateHalfOpen
+	default:
+		return CircuitStateClosed
+	}
+}
+
+// isDatabaseAvailable はエラーがデータベースの障害を示していないかを返す
+// 該当行なし・呼び出し元のキャンセル・PostgreSQLが返したエラー（制約違反など）はデータベースが応答しているため失敗として数えない
+func isDatabaseAvailable(err error) bool {
+	if err == nil || errors.Is(err, sql.ErrNoRows) || errors.Is(err, context.Canceled) {
+		return true
+	}
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr)
+}
@@ -0,0 +1,73 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCircuitBreaker() *SlidingWindowCircuitBreaker {
+	return NewSlidingWindowCircuitBreaker(config.CircuitBreakerConfig{
+		MaxRequests:      1,
+		Interval:         time.Minute,
+		Timeout:          50 * time.Millisecond,
+		FailureThreshold: 5,
+	})
+}
+
+func TestSlidingWindowCircuitBreaker(t *testing.T) {
+	errDB := errors.New("dial tcp: connection refused")
+
+	t.Run("正常系: 5回連続で失敗すると開き、タイムアウト後の成功で閉じる", func(t *testing.T) {
+		breaker := newTestCircuitBreaker()
+		calls := 0
+		failing := func() error {
+			calls++
+			return errDB
+		}
+
+		for i := 0; i < 5; i++ {
+			require.ErrorIs(t, breaker.Execute(failing), errDB)
+		}
+		assert.Equal(t, CircuitStateOpen, breaker.State())
+
+		// 開いている間は fn を呼ばずに ErrCircuitOpen を返す
+		require.ErrorIs(t, breaker.Execute(failing), ErrCircuitOpen)
+		assert.Equal(t, 5, calls)
+
+		time.Sleep(60 * time.Millisecond)
+		assert.Equal(t, CircuitStateHalfOpen, breaker.State())
+
+		require.NoError(t, breaker.Execute(func() error { return nil }))
+		assert.Equal(t, CircuitStateClosed, breaker.State())
+	})
+
+	t.Run("正常系: データベースが応答したエラーは失敗として数えない", func(t *testing.T) {
+		breaker := newTestCircuitBreaker()
+
+		for i := 0; i < 10; i++ {
+			require.ErrorIs(t, breaker.Execute(func() error { return sql.ErrNoRows }), sql.ErrNoRows)
+			require.Error(t, breaker.Execute(func() error { return &pq.Error{Code: "23505"} }))
+		}
+		assert.Equal(t, CircuitStateClosed, breaker.State())
+	})
+
+	t.Run("正常系: 成功を挟むと連続失敗回数がリセットされる", func(t *testing.T) {
+		breaker := newTestCircuitBreaker()
+
+		for i := 0; i < 4; i++ {
+			_ = breaker.Execute(func() error { return errDB })
+		}
+		require.NoError(t, breaker.Execute(func() error { return nil }))
+		for i := 0; i < 4; i++ {
+			_ = breaker.Execute(func() error { return errDB })
+		}
+		assert.Equal(t, CircuitStateClosed, breaker.State())
+	})
+}
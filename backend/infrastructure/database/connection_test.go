@@ -14,6 +14,8 @@ func TestDatabaseConnection(t *testing.T) {
 
 	// Load test database configuration
 	dbConfig := config.NewDatabaseConfig()
+	// データベースがない環境ですぐにスキップできるよう再試行しない
+	dbConfig.Retry.MaxAttempts = 1
 
 	// Try to connect to database
 	db, err := config.NewDatabaseConnection(dbConfig)
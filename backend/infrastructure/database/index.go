@@ -0,0 +1,163 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// identifierPattern はテーブル名・インデックス名・カラム名として許可する識別子
+var identifierPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// IndexDefinition はクエリの性能に必要なインデックスの定義
+type IndexDefinition struct {
+	Table   string
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+// Validate はインデックスの定義を検証する
+func (d IndexDefinition) Validate() error {
+	if !identifierPattern.MatchString(d.Table) {
+		return fmt.Errorf("無効なテーブル名です: %q", d.Table)
+	}
+	if !identifierPattern.MatchString(d.Name) {
+		return fmt.Errorf("無効なインデックス名です: %q", d.Name)
+	}
+	if len(d.Columns) == 0 {
+		return fmt.Errorf("インデックス %s のカラムを1つ以上指定する必要があります", d.Name)
+	}
+	for _, column := range d.Columns {
+		if !identifierPattern.MatchString(column) {
+			return fmt.Errorf("インデックス %s のカラム名が無効です: %q", d.Name, column)
+		}
+	}
+	return nil
+}
+
+// SQL はインデックスを作成するSQLを返す（既に存在する場合は何もしない）
+func (d IndexDefinition) SQL() string {
+	unique := ""
+	if d.Unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX IF NOT EXISTS %s ON %s(%s);", unique, d.Name, d.Table, strings.Join(d.Columns, ", "))
+}
+
+// MigrationStep はGoのコードで宣言するマイグレーションの手順
+// 宣言したインデックスは CheckIndexes で存在を確認する
+type MigrationStep struct {
+	indexes []IndexDefinition
+}
+
+// CreateIndex はインデックスの作成を手順に追加する
+func (s *MigrationStep) CreateIndex(table, name string, columns []string, unique bool) *MigrationStep {
+	s.indexes = append(s.indexes, IndexDefinition{
+		Table:   table,
+		Name:    name,
+		Columns: append([]string(nil), columns...),
+		Unique:  unique,
+	})
+	return s
+}
+
+// Indexes は手順で作成するインデックスの定義を返す
+func (s *MigrationStep) Indexes() []IndexDefinition {
+	return append([]IndexDefinition(nil), s.indexes...)
+}
+
+// SQL は手順を実行するSQLを返す
+func (s *MigrationStep) SQL() (string, error) {
+	statements := make([]string, 0, len(s.indexes))
+	for _, index := range s.indexes {
+		if err := index.Validate(); err != nil {
+			return "", err
+		}
+		statements = append(statements, index.SQL())
+	}
+	return strings.Join(statements, "\n"), nil
+}
+
+// QueryIndexes はリポジトリの検索クエリに必要なインデックス
+// ここに追加したインデックスは migrations/ のSQLファイルでも作成する必要がある
+var QueryIndexes = new(MigrationStep).
+	// 目標一覧（GoalRepository.FindByUserID）: user_id で絞り込み created_at の降順で並べる
+	CreateIndex("goals", "idx_goals_user_id_created_at", []string{"user_id", "created_at"}, false).
+	// 財務計画（FinancialPlanRepository.FindByUserID）
+	CreateIndex("financial_data", "idx_financial_data_user_id", []string{"user_id"}, false).
+	// リフレッシュトークンの検証（RefreshTokenRepository.FindByTokenHash）と一覧
+	CreateIndex("refresh_tokens", "idx_refresh_tokens_token_hash", []string{"token_hash"}, false).
+	CreateIndex("refresh_tokens", "idx_refresh_tokens_user_id", []string{"user_id"}, false).
+	// パスワードリセットトークンの検証（PasswordResetTokenRepository.FindByTokenHash）と一覧
+	CreateIndex("password_reset_tokens", "idx_prt_token_hash", []string{"token_hash"}, false).
+	CreateIndex("password_reset_tokens", "idx_prt_user_id", []string{"user_id"}, false).
+	// パスキー・APIキーの一覧（FindByUserID）
+	CreateIndex("webauthn_credentials", "idx_webauthn_credentials_user_id", []string{"user_id"}, false).
+	CreateIndex("api_keys", "idx_api_keys_user_id", []string{"user_id"}, false).
+	// ライフイベント（財務計画の読み込み時に financial_data_id で取得する）
+	CreateIndex("life_events", "idx_life_events_financial_data_id", []string{"financial_data_id"}, false)
+
+// MissingIndex はデータベースに存在しない（または UNIQUE でない）インデックス
+type MissingIndex struct {
+	IndexDefinition
+	NotUnique bool // 同名のインデックスは存在するが UNIQUE でない
+}
+
+// String はログ出力用の表現を返す
+func (m MissingIndex) String() string {
+	reason := "存在しません"
+	if m.NotUnique {
+		reason = "UNIQUE ではありません"
+	}
+	return fmt.Sprintf("%s ON %s(%s): %s", m.Name, m.Table, strings.Join(m.Columns, ", "), reason)
+}
+
+// existingIndex は pg_indexes から取得したインデックス
+type existingIndex struct {
+	table  string
+	unique bool
+}
+
+// CheckIndexes は QueryIndexes のうちデータベースに存在しないインデックスを返す
+func (m *Migrator) CheckIndexes(ctx context.Context) ([]MissingIndex, error) {
+	query := `SELECT tablename, indexname, indexdef FROM pg_indexes WHERE schemaname = current_schema()`
+	rows, err := m.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("インデックス一覧の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]existingIndex)
+	for rows.Next() {
+		var table, name, definition string
+		if err := rows.Scan(&table, &name, &definition); err != nil {
+			return nil, fmt.Errorf("インデックス情報の読み取りに失敗しました: %w", err)
+		}
+		existing[name] = existingIndex{
+			table:  table,
+			unique: strings.HasPrefix(definition, "CREATE UNIQUE INDEX"),
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("インデックス情報の読み取りに失敗しました: %w", err)
+	}
+
+	return findMissingIndexes(QueryIndexes.Indexes(), existing), nil
+}
+
+// findMissingIndexes は expected のうち existing に存在しないインデックスを返す
+func findMissingIndexes(expected []IndexDefinition, existing map[string]existingIndex) []MissingIndex {
+	var missing []MissingIndex
+	for _, index := range expected {
+		found, ok := existing[index.Name]
+		switch {
+		case !ok || found.table != index.Table:
+			missing = append(missing, MissingIndex{IndexDefinition: index})
+		case index.Unique && !found.unique:
+			missing = append(missing, MissingIndex{IndexDefinition: index, NotUnique: true})
+		}
+	}
+	return missing
+}
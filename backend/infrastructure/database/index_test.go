@@ -0,0 +1,82 @@
+package database
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationStep_CreateIndex(t *testing.T) {
+	t.Run("正常系: 宣言したインデックスを作成するSQLを生成する", func(t *testing.T) {
+		step := new(MigrationStep).
+			CreateIndex("goals", "idx_goals_user_id_created_at", []string{"user_id", "created_at"}, false).
+			CreateIndex("api_keys", "idx_api_keys_key_hash", []string{"key_hash"}, true)
+
+		sql, err := step.SQL()
+
+		require.NoError(t, err)
+		assert.Equal(t,
+			"CREATE INDEX IF NOT EXISTS idx_goals_user_id_created_at ON goals(user_id, created_at);\n"+
+				"CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_key_hash ON api_keys(key_hash);",
+			sql)
+		assert.Len(t, step.Indexes(), 2)
+	})
+
+	t.Run("異常系: 無効な識別子やカラムなしの場合はエラー", func(t *testing.T) {
+		tests := []*MigrationStep{
+			new(MigrationStep).CreateIndex("goals; DROP TABLE goals", "idx_goals", []string{"user_id"}, false),
+			new(MigrationStep).CreateIndex("goals", "idx-goals", []string{"user_id"}, false),
+			new(MigrationStep).CreateIndex("goals", "idx_goals", nil, false),
+			new(MigrationStep).CreateIndex("goals", "idx_goals", []string{"user_id DESC"}, false),
+		}
+		for _, step := range tests {
+			_, err := step.SQL()
+			assert.Error(t, err)
+		}
+	})
+}
+
+func TestQueryIndexes_CreatedByMigrations(t *testing.T) {
+	sql, err := QueryIndexes.SQL()
+	require.NoError(t, err)
+
+	migrations, err := NewMigrator(nil).loadMigrations()
+	require.NoError(t, err)
+	var upSQL strings.Builder
+	for _, migration := range migrations {
+		upSQL.WriteString(migration.UpSQL)
+	}
+
+	// 宣言したインデックスは全てマイグレーションファイルで同じ定義のまま作成する
+	for _, statement := range strings.Split(sql, "\n") {
+		assert.Contains(t, upSQL.String(), statement)
+	}
+}
+
+func TestFindMissingIndexes(t *testing.T) {
+	expected := []IndexDefinition{
+		{Table: "goals", Name: "idx_goals_user_id_created_at", Columns: []string{"user_id", "created_at"}},
+		{Table: "refresh_tokens", Name: "idx_refresh_tokens_token_hash", Columns: []string{"token_hash"}},
+		{Table: "api_keys", Name: "idx_api_keys_key_hash", Columns: []string{"key_hash"}, Unique: true},
+		{Table: "password_reset_tokens", Name: "idx_prt_token_hash", Columns: []string{"token_hash"}},
+	}
+	existing := map[string]existingIndex{
+		"idx_goals_user_id_created_at": {table: "goals"},
+		"idx_api_keys_key_hash":        {table: "api_keys"},
+		"idx_prt_token_hash":           {table: "refresh_tokens"},
+	}
+
+	missing := findMissingIndexes(expected, existing)
+
+	require.Len(t, missing, 3)
+	assert.Equal(t, "idx_refresh_tokens_token_hash", missing[0].Name)
+	assert.False(t, missing[0].NotUnique)
+	assert.Equal(t, "idx_api_keys_key_hash", missing[1].Name)
+	assert.True(t, missing[1].NotUnique, "UNIQUE でないインデックスは不足として扱うべきです")
+	assert.Equal(t, "idx_prt_token_hash", missing[2].Name, "別テーブルの同名インデックスは不足として扱うべきです")
+	assert.Contains(t, missing[1].String(), "UNIQUE ではありません")
+
+	assert.Empty(t, findMissingIndexes(expected[:1], existing))
+}
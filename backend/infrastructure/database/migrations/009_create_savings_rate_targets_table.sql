@@ -0,0 +1,50 @@
+-- 009_create_savings_rate_targets_table.sql
+-- 貯蓄率目標と月次実績テーブルを作成
+
+-- 貯蓄率目標テーブル
+CREATE TABLE IF NOT EXISTS savings_rate_targets (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id VARCHAR(255) NOT NULL,
+    target_percent DECIMAL(5,2) NOT NULL CHECK (target_percent >= 1 AND target_percent <= 80),
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT check_savings_rate_target_period CHECK (period_end > period_start)
+);
+
+-- インデックス: 有効な目標はユーザーごとに1件のみ
+CREATE UNIQUE INDEX IF NOT EXISTS idx_savings_rate_targets_active_user
+    ON savings_rate_targets(user_id) WHERE is_active;
+
+-- 月次の収入・支出実績テーブル
+CREATE TABLE IF NOT EXISTS monthly_savings_actuals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id VARCHAR(255) NOT NULL,
+    month DATE NOT NULL,
+    income DECIMAL(15,2) NOT NULL CHECK (income >= 0),
+    expenses DECIMAL(15,2) NOT NULL CHECK (expenses >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_user_month_savings_actual UNIQUE (user_id, month)
+);
+
+-- 更新日時自動更新トリガー
+CREATE TRIGGER update_savings_rate_targets_updated_at
+    BEFORE UPDATE ON savings_rate_targets
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_monthly_savings_actuals_updated_at
+    BEFORE UPDATE ON monthly_savings_actuals
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- コメント追加
+COMMENT ON TABLE savings_rate_targets IS '貯蓄率目標テーブル。一定期間に維持したい貯蓄率を管理';
+COMMENT ON COLUMN savings_rate_targets.is_active IS '有効な目標かどうか。ユーザーごとに有効な目標は1件のみ';
+COMMENT ON TABLE monthly_savings_actuals IS '月次の収入・支出実績テーブル。貯蓄率目標の達成状況の算出に使用';
+COMMENT ON COLUMN monthly_savings_actuals.month IS '実績の対象月（月初日で保存）';
@@ -0,0 +1,3 @@
+-- 貯蓄率目標と月次実績テーブルの削除
+DROP TABLE IF EXISTS monthly_savings_actuals;
+DROP TABLE IF EXISTS savings_rate_targets;
@@ -0,0 +1,31 @@
+-- 010_create_notification_preferences_table.sql
+-- 通知設定テーブルを作成
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(50) NOT NULL CHECK (event_type IN ('goal_deadline_approaching', 'goal_achieved', 'goal_review_recommended')),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('email', 'push', 'webhook')),
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    threshold DECIMAL(10,2) NOT NULL,
+    destination VARCHAR(2048) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT unique_user_event_channel UNIQUE (user_id, event_type, channel)
+);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_notification_preferences_user_event
+    ON notification_preferences(user_id, event_type) WHERE enabled;
+
+-- 更新日時自動更新トリガー
+CREATE TRIGGER update_notification_preferences_updated_at
+    BEFORE UPDATE ON notification_preferences
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- コメント追加
+COMMENT ON TABLE notification_preferences IS '通知設定テーブル。目標イベントごとの通知チャネルと閾値を管理';
+COMMENT ON COLUMN notification_preferences.threshold IS 'イベント種別ごとの閾値（期限接近: 残り日数, 達成: 進捗率%, 見直し推奨: 超過率%）';
+COMMENT ON COLUMN notification_preferences.destination IS '送信先（webhookのURLなど）';
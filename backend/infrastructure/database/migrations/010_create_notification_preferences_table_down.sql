@@ -0,0 +1,2 @@
+-- 通知設定テーブルの削除
+DROP TABLE IF EXISTS notification_preferences;
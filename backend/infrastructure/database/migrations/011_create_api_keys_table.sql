@@ -0,0 +1,25 @@
+-- 011_create_api_keys_table.sql
+-- 外部ツール連携用のAPIキーテーブルを作成
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,
+    last_four VARCHAR(4) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+
+    CONSTRAINT valid_api_key_scopes CHECK (scopes <@ ARRAY['read-only', 'reports', 'write']::TEXT[])
+);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
+
+-- コメント追加
+COMMENT ON TABLE api_keys IS 'APIキーテーブル。外部ツール連携用のキーをハッシュ化して保存';
+COMMENT ON COLUMN api_keys.key_hash IS 'APIキーのSHA-256ハッシュ（平文は発行時にのみ返却）';
+COMMENT ON COLUMN api_keys.last_four IS '一覧表示用のキー末尾4文字';
+COMMENT ON COLUMN api_keys.scopes IS '許可スコープ（read-only, reports, write）';
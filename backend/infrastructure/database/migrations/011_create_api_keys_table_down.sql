@@ -0,0 +1,2 @@
+-- APIキーテーブルの削除
+DROP TABLE IF EXISTS api_keys;
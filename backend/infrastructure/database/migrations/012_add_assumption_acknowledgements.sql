@@ -0,0 +1,9 @@
+-- 012_add_assumption_acknowledgements.sql
+-- 現実的な上限を超える想定利回り・インフレ率への同意フラグを追加
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS high_return_acknowledged BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS high_inflation_acknowledged BOOLEAN NOT NULL DEFAULT false;
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.high_return_acknowledged IS '現実的な上限を超える想定利回りにユーザーが同意したか';
+COMMENT ON COLUMN financial_data.high_inflation_acknowledged IS '現実的な上限を超える想定インフレ率にユーザーが同意したか';
@@ -0,0 +1,3 @@
+-- 想定利回り・インフレ率への同意フラグの削除
+ALTER TABLE financial_data DROP COLUMN IF EXISTS high_inflation_acknowledged;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS high_return_acknowledged;
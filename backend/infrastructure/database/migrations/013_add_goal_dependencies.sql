@@ -0,0 +1,13 @@
+-- 013_add_goal_dependencies.sql
+-- 目標の依存関係（前提となる目標の完了後に拠出を開始する）を追加
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS depends_on_goal_id UUID REFERENCES goals(id) ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED;
+
+-- 自身を前提にすることはできない（循環はアプリケーション側で検出する）
+ALTER TABLE goals ADD CONSTRAINT no_self_dependency CHECK (depends_on_goal_id IS NULL OR depends_on_goal_id <> id);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_goals_depends_on_goal_id ON goals(depends_on_goal_id) WHERE depends_on_goal_id IS NOT NULL;
+
+-- コメント追加
+COMMENT ON COLUMN goals.depends_on_goal_id IS '前提となる目標のID。前提目標の完了見込み日以降に拠出を開始する';
@@ -0,0 +1,4 @@
+-- 目標の依存関係の削除
+DROP INDEX IF EXISTS idx_goals_depends_on_goal_id;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS no_self_dependency;
+ALTER TABLE goals DROP COLUMN IF EXISTS depends_on_goal_id;
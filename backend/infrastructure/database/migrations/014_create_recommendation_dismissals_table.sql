@@ -0,0 +1,19 @@
+-- 014_create_recommendation_dismissals_table.sql
+-- 推奨事項の却下状態テーブルを作成
+
+CREATE TABLE IF NOT EXISTS recommendation_dismissals (
+    user_id VARCHAR(255) NOT NULL,
+    recommendation_id VARCHAR(64) NOT NULL,
+    severity_at_dismissal DECIMAL(10,2) NOT NULL DEFAULT 0,
+    dismissed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    snooze_until TIMESTAMP WITH TIME ZONE,
+
+    PRIMARY KEY (user_id, recommendation_id),
+    CONSTRAINT check_recommendation_snooze_until CHECK (snooze_until IS NULL OR snooze_until > dismissed_at)
+);
+
+-- コメント追加
+COMMENT ON TABLE recommendation_dismissals IS '推奨事項の却下状態テーブル。却下した推奨事項をレポートから除外する';
+COMMENT ON COLUMN recommendation_dismissals.recommendation_id IS '推奨事項ID（種別と主要パラメータのハッシュ）';
+COMMENT ON COLUMN recommendation_dismissals.severity_at_dismissal IS '却下時点の重要度。10ポイントを超えて悪化した場合は再表示する';
+COMMENT ON COLUMN recommendation_dismissals.snooze_until IS 'スヌーズ期限。NULLの場合は無期限に非表示';
@@ -0,0 +1,2 @@
+-- 推奨事項の却下状態テーブルの削除
+DROP TABLE IF EXISTS recommendation_dismissals;
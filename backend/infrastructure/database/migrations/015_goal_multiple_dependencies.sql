@@ -0,0 +1,20 @@
+-- 015_goal_multiple_dependencies.sql
+-- 目標の依存関係を複数の前提目標に対応させる（すべての前提目標の完了後に拠出を開始する）
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS depends_on_goal_ids UUID[] NOT NULL DEFAULT '{}';
+
+-- 既存の単一の前提目標を移行
+UPDATE goals SET depends_on_goal_ids = ARRAY[depends_on_goal_id] WHERE depends_on_goal_id IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_goals_depends_on_goal_id;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS no_self_dependency;
+ALTER TABLE goals DROP COLUMN IF EXISTS depends_on_goal_id;
+
+-- 自身を前提にすることはできない（循環・存在しない目標への依存はアプリケーション側で検出する）
+ALTER TABLE goals ADD CONSTRAINT no_self_dependency CHECK (NOT (id = ANY(depends_on_goal_ids)));
+
+-- インデックス（前提目標から依存している目標を検索する）
+CREATE INDEX IF NOT EXISTS idx_goals_depends_on_goal_ids ON goals USING GIN (depends_on_goal_ids);
+
+-- コメント追加
+COMMENT ON COLUMN goals.depends_on_goal_ids IS '前提となる目標のID一覧。すべての前提目標の完了見込み日以降に拠出を開始する';
@@ -0,0 +1,13 @@
+-- 目標の複数依存関係を単一の前提目標に戻す（先頭の前提目標のみ保持する）
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS depends_on_goal_id UUID REFERENCES goals(id) ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED;
+
+UPDATE goals SET depends_on_goal_id = depends_on_goal_ids[1]
+WHERE cardinality(depends_on_goal_ids) > 0
+  AND EXISTS (SELECT 1 FROM goals prerequisite WHERE prerequisite.id = goals.depends_on_goal_ids[1]);
+
+DROP INDEX IF EXISTS idx_goals_depends_on_goal_ids;
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS no_self_dependency;
+ALTER TABLE goals DROP COLUMN IF EXISTS depends_on_goal_ids;
+
+ALTER TABLE goals ADD CONSTRAINT no_self_dependency CHECK (depends_on_goal_id IS NULL OR depends_on_goal_id <> id);
+CREATE INDEX IF NOT EXISTS idx_goals_depends_on_goal_id ON goals(depends_on_goal_id) WHERE depends_on_goal_id IS NOT NULL;
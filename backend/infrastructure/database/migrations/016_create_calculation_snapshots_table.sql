@@ -0,0 +1,32 @@
+-- 016_create_calculation_snapshots_table.sql
+-- 保存済みの計算結果テーブルと一括再計算の進捗テーブルを作成
+
+CREATE TABLE IF NOT EXISTS calculation_snapshots (
+    user_id VARCHAR(255) NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    payload JSONB NOT NULL,
+    calculation_version INTEGER NOT NULL,
+    recalculated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, kind),
+    CONSTRAINT check_calculation_snapshot_kind CHECK (kind IN ('projection', 'report', 'alert')),
+    CONSTRAINT check_calculation_snapshot_version CHECK (calculation_version > 0)
+);
+
+-- 古い計算バージョンの結果を探すためのインデックス
+CREATE INDEX IF NOT EXISTS idx_calculation_snapshots_version ON calculation_snapshots(calculation_version);
+
+CREATE TABLE IF NOT EXISTS recalculation_progress (
+    run_id VARCHAR(128) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    completed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (run_id, user_id)
+);
+
+-- コメント追加
+COMMENT ON TABLE calculation_snapshots IS '保存済みの計算結果テーブル。計算バージョンが現在と異なる結果は古いものとして扱う';
+COMMENT ON COLUMN calculation_snapshots.kind IS '計算結果の種別（projection: 財務予測, report: レポート, alert: 推奨事項・警告）';
+COMMENT ON COLUMN calculation_snapshots.calculation_version IS '計算時の計算バージョン（services.CalculationVersion）';
+COMMENT ON COLUMN calculation_snapshots.recalculated_at IS '計算日時';
+COMMENT ON TABLE recalculation_progress IS '一括再計算の進捗テーブル。同じ実行IDで再開した場合は完了済みのユーザーを読み飛ばす';
@@ -0,0 +1,3 @@
+-- 保存済みの計算結果テーブルと一括再計算の進捗テーブルの削除
+DROP TABLE IF EXISTS recalculation_progress;
+DROP TABLE IF EXISTS calculation_snapshots;
@@ -0,0 +1,9 @@
+-- 017_add_income_type.sql
+-- 月収の入力区分（額面・手取り）を追加
+-- 既存のプロファイルは手取りとして扱う
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS income_type VARCHAR(10) NOT NULL DEFAULT 'net'
+    CHECK (income_type IN ('net', 'gross'));
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.income_type IS '月収の入力区分（net: 手取り, gross: 額面）';
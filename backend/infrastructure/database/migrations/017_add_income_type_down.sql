@@ -0,0 +1,2 @@
+-- 月収の入力区分の削除
+ALTER TABLE financial_data DROP COLUMN IF EXISTS income_type;
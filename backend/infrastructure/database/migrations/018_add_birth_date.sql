@@ -0,0 +1,9 @@
+-- 018_add_birth_date.sql
+-- 年齢に依存する計算の基準となる生年月日を財務データに追加
+-- 未設定（NULL）の場合は退職データに入力された年齢を使う
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS birth_date DATE;
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.birth_date IS '生年月日（年齢に依存する計算の基準）';
+COMMENT ON COLUMN retirement_data.current_age IS '現在の年齢（財務データに生年月日がある場合はその年齢と同期される）';
@@ -0,0 +1,3 @@
+-- 生年月日の削除
+COMMENT ON COLUMN retirement_data.current_age IS NULL;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS birth_date;
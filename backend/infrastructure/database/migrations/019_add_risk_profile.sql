@@ -0,0 +1,10 @@
+-- 019_add_risk_profile.sql
+-- 投資のリスク評価に使う想定ボラティリティとリスク許容度を財務データに追加
+-- 想定ボラティリティが未設定（NULL）の場合は貯蓄の内訳から推定する
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS expected_volatility DECIMAL(5,2) CHECK (expected_volatility >= 0 AND expected_volatility <= 100);
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS risk_tolerance VARCHAR(20) NOT NULL DEFAULT 'moderate' CHECK (risk_tolerance IN ('conservative', 'moderate', 'aggressive'));
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.expected_volatility IS '想定ボラティリティ（年率%）。NULLの場合は貯蓄の内訳から推定する';
+COMMENT ON COLUMN financial_data.risk_tolerance IS 'リスク許容度（conservative: 安定重視, moderate: バランス, aggressive: 積極運用）';
@@ -0,0 +1,3 @@
+-- 想定ボラティリティ・リスク許容度の削除
+ALTER TABLE financial_data DROP COLUMN IF EXISTS risk_tolerance;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS expected_volatility;
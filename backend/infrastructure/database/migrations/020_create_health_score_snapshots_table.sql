@@ -0,0 +1,24 @@
+-- 020_create_health_score_snapshots_table.sql
+-- 財務健全性スコアの履歴テーブルを作成
+
+CREATE TABLE IF NOT EXISTS health_score_snapshots (
+    user_id VARCHAR(255) NOT NULL,
+    recorded_on DATE NOT NULL,
+    score INTEGER NOT NULL,
+    savings_rate DECIMAL(10,2) NOT NULL DEFAULT 0,
+    emergency_fund_ratio DECIMAL(10,2) NOT NULL DEFAULT 0,
+    investment_return DECIMAL(5,2) NOT NULL DEFAULT 0,
+    total_assets DECIMAL(15,2) NOT NULL DEFAULT 0,
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, recorded_on),
+    CONSTRAINT check_health_score_range CHECK (score >= 0 AND score <= 100)
+);
+
+-- コメント追加
+COMMENT ON TABLE health_score_snapshots IS '財務健全性スコアの履歴テーブル。財務サマリーレポートの生成時に1日1件記録する';
+COMMENT ON COLUMN health_score_snapshots.recorded_on IS '記録日。同じ日に再計算した場合は上書きする';
+COMMENT ON COLUMN health_score_snapshots.savings_rate IS '貯蓄率（%）';
+COMMENT ON COLUMN health_score_snapshots.emergency_fund_ratio IS '緊急資金が月間支出の何ヶ月分か';
+COMMENT ON COLUMN health_score_snapshots.investment_return IS '想定投資利回り（年率%）';
+COMMENT ON COLUMN health_score_snapshots.total_assets IS '総資産（円）';
@@ -0,0 +1,2 @@
+-- 財務健全性スコアの履歴テーブルの削除
+DROP TABLE IF EXISTS health_score_snapshots;
@@ -0,0 +1,15 @@
+-- 021_add_calculation_timestamps.sql
+-- 財務健全性の計算結果を再利用するため、最終計算日時とデータの最終更新日時を財務データに追加
+-- 最終計算日時がデータの最終更新日時より後であれば、保存済みの計算結果（calculation_snapshots）を返す
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS last_calculated_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS last_profile_updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- 計算結果の種別に財務健全性を追加
+ALTER TABLE calculation_snapshots DROP CONSTRAINT IF EXISTS check_calculation_snapshot_kind;
+ALTER TABLE calculation_snapshots ADD CONSTRAINT check_calculation_snapshot_kind CHECK (kind IN ('projection', 'report', 'alert', 'financial_health'));
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.last_calculated_at IS '財務健全性の計算結果を最後に保存した日時。NULLの場合は未計算';
+COMMENT ON COLUMN financial_data.last_profile_updated_at IS '計算結果に影響するデータ（プロファイル・退職データ・緊急資金）の最終更新日時';
+COMMENT ON COLUMN calculation_snapshots.kind IS '計算結果の種別（projection: 財務予測, report: レポート, alert: 推奨事項・警告, financial_health: 財務健全性）';
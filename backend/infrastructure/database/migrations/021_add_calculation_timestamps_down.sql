@@ -0,0 +1,7 @@
+-- 最終計算日時・データの最終更新日時の削除
+DELETE FROM calculation_snapshots WHERE kind = 'financial_health';
+ALTER TABLE calculation_snapshots DROP CONSTRAINT IF EXISTS check_calculation_snapshot_kind;
+ALTER TABLE calculation_snapshots ADD CONSTRAINT check_calculation_snapshot_kind CHECK (kind IN ('projection', 'report', 'alert'));
+
+ALTER TABLE financial_data DROP COLUMN IF EXISTS last_profile_updated_at;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS last_calculated_at;
@@ -0,0 +1,10 @@
+-- 022_add_retirement_public_benefits.sql
+-- 退職データに国民年金（老齢基礎年金）の月額と介護保険料の月額を追加
+-- 既存データは 0（未入力）とし、年金額のみで不足額を計算していた従来の結果を変えない
+
+ALTER TABLE retirement_data ADD COLUMN IF NOT EXISTS kokumin_nenkin_monthly_amount DECIMAL(15,2) NOT NULL DEFAULT 0 CHECK (kokumin_nenkin_monthly_amount >= 0);
+ALTER TABLE retirement_data ADD COLUMN IF NOT EXISTS nurse_care_insurance_monthly DECIMAL(15,2) NOT NULL DEFAULT 0 CHECK (nurse_care_insurance_monthly >= 0);
+
+-- コメント追加
+COMMENT ON COLUMN retirement_data.kokumin_nenkin_monthly_amount IS '国民年金（老齢基礎年金）の月額（円）';
+COMMENT ON COLUMN retirement_data.nurse_care_insurance_monthly IS '介護保険料などの退職後の月間負担（円）';
@@ -0,0 +1,3 @@
+-- 国民年金・介護保険料の月額の削除
+ALTER TABLE retirement_data DROP COLUMN IF EXISTS nurse_care_insurance_monthly;
+ALTER TABLE retirement_data DROP COLUMN IF EXISTS kokumin_nenkin_monthly_amount;
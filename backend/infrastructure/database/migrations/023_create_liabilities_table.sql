@@ -0,0 +1,33 @@
+-- 023_create_liabilities_table.sql
+-- 負債（住宅ローン・自動車ローン・奨学金など）テーブルを作成
+
+CREATE TABLE IF NOT EXISTS liabilities (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    financial_data_id UUID NOT NULL REFERENCES financial_data(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('mortgage', 'car_loan', 'student_loan', 'other')),
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    principal_remaining DECIMAL(15,2) NOT NULL CHECK (principal_remaining >= 0),
+    interest_rate DECIMAL(7,4) NOT NULL DEFAULT 0 CHECK (interest_rate >= 0 AND interest_rate <= 100),
+    monthly_payment DECIMAL(15,2) NOT NULL CHECK (monthly_payment > 0),
+    end_date DATE NOT NULL,
+    included_in_expenses BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_liabilities_financial_data_id ON liabilities(financial_data_id);
+
+-- 更新日時自動更新トリガー
+CREATE TRIGGER update_liabilities_updated_at
+    BEFORE UPDATE ON liabilities
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- コメント追加
+COMMENT ON TABLE liabilities IS '負債テーブル。純資産の算出と、返済額を純貯蓄額から差し引くために使用';
+COMMENT ON COLUMN liabilities.principal_remaining IS '残高（元本の残り、円）';
+COMMENT ON COLUMN liabilities.interest_rate IS '年利（%）';
+COMMENT ON COLUMN liabilities.monthly_payment IS '月々の返済額（円）';
+COMMENT ON COLUMN liabilities.end_date IS '返済終了日';
+COMMENT ON COLUMN liabilities.included_in_expenses IS '返済額を支出項目に計上済みか。true の場合は純貯蓄額の計算で返済額を二重に差し引かない';
@@ -0,0 +1,2 @@
+-- 負債テーブルの削除
+DROP TABLE IF EXISTS liabilities;
@@ -0,0 +1,12 @@
+-- 024_add_goal_metadata.sql
+-- 目標にメモとイメージ画像のURLを追加
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS image_url TEXT NOT NULL DEFAULT '';
+
+-- メモの長さ制限（アプリケーション側でも検証する）
+ALTER TABLE goals ADD CONSTRAINT goal_notes_length CHECK (char_length(notes) <= 1000);
+
+-- コメント追加
+COMMENT ON COLUMN goals.notes IS '目標に添えるユーザーのメモ（HTMLタグは除去済み）';
+COMMENT ON COLUMN goals.image_url IS '目標のイメージ画像のURL。アップロードした画像はファイルストレージのURLを保持する';
@@ -0,0 +1,4 @@
+-- 目標のメモとイメージ画像のURLの削除
+ALTER TABLE goals DROP CONSTRAINT IF EXISTS goal_notes_length;
+ALTER TABLE goals DROP COLUMN IF EXISTS image_url;
+ALTER TABLE goals DROP COLUMN IF EXISTS notes;
@@ -0,0 +1,7 @@
+-- 025_add_two_factor_required_since.sql
+-- 2段階認証の必須化ポリシーが適用された日時を追加（猶予期間の起点）
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS two_factor_required_since TIMESTAMP WITH TIME ZONE;
+
+-- コメント追加
+COMMENT ON COLUMN users.two_factor_required_since IS '2段階認証の必須化ポリシー（組織ポリシー・総資産の閾値）が適用された日時。この日時から猶予期間を過ぎても未設定の場合は保護ルートを利用できない';
@@ -0,0 +1,2 @@
+-- 2段階認証の必須化ポリシーが適用された日時の削除
+ALTER TABLE users DROP COLUMN IF EXISTS two_factor_required_since;
@@ -0,0 +1,32 @@
+-- 026_add_admin_and_audit_logs.sql
+-- サポート用の管理者権限と、なりすまし操作の監査ログテーブルを追加
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    action VARCHAR(50) NOT NULL,
+    actor_user_id VARCHAR(255) NOT NULL,
+    target_user_id VARCHAR(255) NOT NULL,
+    session_id VARCHAR(255) NOT NULL DEFAULT '',
+    method VARCHAR(10) NOT NULL DEFAULT '',
+    route VARCHAR(255) NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT valid_audit_log_action CHECK (action IN ('impersonation_started', 'impersonation_request', 'impersonation_expired'))
+);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target_user_id ON audit_logs(target_user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_session_id ON audit_logs(session_id, action);
+
+-- コメント追加
+COMMENT ON COLUMN users.is_admin IS 'サポート用の管理者権限。なりすましセッションの発行に必要（データベースでのみ付与する）';
+COMMENT ON TABLE audit_logs IS '監査ログテーブル。追記のみで更新・削除は行わない';
+COMMENT ON COLUMN audit_logs.actor_user_id IS '操作した管理者のユーザーID';
+COMMENT ON COLUMN audit_logs.target_user_id IS 'なりすましの対象ユーザーID';
+COMMENT ON COLUMN audit_logs.session_id IS 'なりすましセッションのID（アクセストークンの jti）';
+COMMENT ON COLUMN audit_logs.reason IS 'なりすましの理由（発行時のみ）';
+COMMENT ON COLUMN audit_logs.expires_at IS 'なりすましセッションの有効期限（発行・期限切れのエントリのみ）';
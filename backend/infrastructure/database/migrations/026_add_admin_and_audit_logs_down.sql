@@ -0,0 +1,3 @@
+-- 監査ログテーブルと管理者権限の削除
+DROP TABLE IF EXISTS audit_logs;
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,11 @@
+-- 027_add_emergency_fund_settings.sql
+-- 緊急資金の設定（目標月数・現在額・毎月の積立予定額）を財務データに追加
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS emergency_fund_target_months INTEGER CHECK (emergency_fund_target_months BETWEEN 0 AND 24);
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS emergency_fund_current_amount DECIMAL(15,2) CHECK (emergency_fund_current_amount >= 0);
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS emergency_fund_monthly_contribution DECIMAL(15,2) NOT NULL DEFAULT 0 CHECK (emergency_fund_monthly_contribution >= 0);
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.emergency_fund_target_months IS '緊急資金の目標月数（何ヶ月分の生活費を確保するか）。NULLの場合は既定値（3ヶ月）';
+COMMENT ON COLUMN financial_data.emergency_fund_current_amount IS '現在の緊急資金額';
+COMMENT ON COLUMN financial_data.emergency_fund_monthly_contribution IS '緊急資金への毎月の積立予定額。純貯蓄額の一部として総資産の推移にも含まれる';
@@ -0,0 +1,4 @@
+-- 緊急資金の設定の削除
+ALTER TABLE financial_data DROP COLUMN IF EXISTS emergency_fund_monthly_contribution;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS emergency_fund_current_amount;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS emergency_fund_target_months;
@@ -0,0 +1,7 @@
+-- 028_add_retirement_last_age_update_date.sql
+-- 退職データの現在の年齢を最後に更新した日時を追加
+
+ALTER TABLE retirement_data ADD COLUMN IF NOT EXISTS last_age_update_date TIMESTAMP WITH TIME ZONE;
+
+-- コメント追加
+COMMENT ON COLUMN retirement_data.last_age_update_date IS '現在の年齢を最後に更新した日時。NULLの場合は年齢の更新なし';
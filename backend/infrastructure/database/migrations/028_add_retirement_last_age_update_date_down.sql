@@ -0,0 +1,2 @@
+-- 退職データの年齢の更新日時の削除
+ALTER TABLE retirement_data DROP COLUMN IF EXISTS last_age_update_date;
@@ -0,0 +1,7 @@
+-- 029_add_goal_currency.sql
+-- 外貨建ての目標の通貨・目標金額・為替レートを保存する列を追加
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS goal_currency JSONB;
+
+-- コメント追加
+COMMENT ON COLUMN goals.goal_currency IS '外貨建ての目標の通貨・目標の通貨での目標金額・為替レートとその履歴。NULLの場合は計画の通貨（円）建て。target_amount には換算後の円の金額を保存する';
@@ -0,0 +1,2 @@
+-- 外貨建ての目標の設定の削除
+ALTER TABLE goals DROP COLUMN IF EXISTS goal_currency;
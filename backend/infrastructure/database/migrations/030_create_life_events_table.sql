@@ -0,0 +1,32 @@
+-- 030_create_life_events_table.sql
+-- ライフイベント（結婚・出産・教育費・子供の独立など）テーブルを作成
+
+CREATE TABLE IF NOT EXISTS life_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    financial_data_id UUID NOT NULL REFERENCES financial_data(id) ON DELETE CASCADE,
+    category VARCHAR(30) NOT NULL CHECK (category IN ('marriage', 'childbirth', 'education', 'child_independent', 'housing', 'other')),
+    name VARCHAR(255) NOT NULL,
+    event_year INTEGER NOT NULL CHECK (event_year > 0),
+    recurrence VARCHAR(20) NOT NULL CHECK (recurrence IN ('one_time', 'recurring')),
+    amount_change DECIMAL(15,2) NOT NULL CHECK (amount_change <> 0),
+    duration_years INTEGER NOT NULL DEFAULT 0 CHECK (duration_years >= 0 AND duration_years <= 100),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (recurrence = 'recurring' OR duration_years = 0)
+);
+
+-- インデックス
+CREATE INDEX IF NOT EXISTS idx_life_events_financial_data_id ON life_events(financial_data_id);
+
+-- 更新日時自動更新トリガー
+CREATE TRIGGER update_life_events_updated_at
+    BEFORE UPDATE ON life_events
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- コメント追加
+COMMENT ON TABLE life_events IS 'ライフイベントテーブル。資産推移の予測で発生年以降の支出を増減させるために使用';
+COMMENT ON COLUMN life_events.event_year IS '発生年（西暦）';
+COMMENT ON COLUMN life_events.recurrence IS '発生の仕方。one_time は発生年に一度だけ、recurring は発生年から毎月継続';
+COMMENT ON COLUMN life_events.amount_change IS '支出の増減額（円。正は増加、負は減少）。one_time は一度きりの金額、recurring は毎月の金額';
+COMMENT ON COLUMN life_events.duration_years IS '継続する年数（recurring のみ。0 は予測期間の終わりまで継続）';
@@ -0,0 +1,2 @@
+-- ライフイベントテーブルの削除
+DROP TABLE IF EXISTS life_events;
@@ -0,0 +1,16 @@
+-- 031_add_query_indexes.sql
+-- リポジトリの検索クエリに必要なインデックスを作成
+-- database.QueryIndexes と同じ内容を保つこと（migrate -command=verify-indexes で存在を確認できる）
+
+-- 目標一覧: user_id で絞り込み created_at の降順で並べる
+CREATE INDEX IF NOT EXISTS idx_goals_user_id_created_at ON goals(user_id, created_at);
+
+-- 既存のマイグレーションで作成済みのインデックス（手動で削除された環境でも再作成する）
+CREATE INDEX IF NOT EXISTS idx_financial_data_user_id ON financial_data(user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_prt_token_hash ON password_reset_tokens(token_hash);
+CREATE INDEX IF NOT EXISTS idx_prt_user_id ON password_reset_tokens(user_id);
+CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user_id ON webauthn_credentials(user_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
+CREATE INDEX IF NOT EXISTS idx_life_events_financial_data_id ON life_events(financial_data_id);
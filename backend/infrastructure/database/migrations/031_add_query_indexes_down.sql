@@ -0,0 +1,2 @@
+-- このマイグレーションで追加したインデックスの削除（既存のマイグレーションで作成したインデックスは残す）
+DROP INDEX IF EXISTS idx_goals_user_id_created_at;
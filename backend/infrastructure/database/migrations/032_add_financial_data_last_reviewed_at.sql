@@ -0,0 +1,14 @@
+-- 032_add_financial_data_last_reviewed_at.sql
+-- 財務データの最終確認日時を追加
+-- 長期間見直されていない財務データに基づくレポートに、前提の見直しを促す警告を表示するために使用する
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS last_reviewed_at TIMESTAMP WITH TIME ZONE;
+
+-- 既存のデータは更新日時を最終確認日時とみなす
+UPDATE financial_data SET last_reviewed_at = COALESCE(updated_at, created_at, CURRENT_TIMESTAMP) WHERE last_reviewed_at IS NULL;
+
+ALTER TABLE financial_data ALTER COLUMN last_reviewed_at SET DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE financial_data ALTER COLUMN last_reviewed_at SET NOT NULL;
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.last_reviewed_at IS 'ユーザーが財務データ（プロファイル・退職データ・緊急資金）を最後に更新、または変更がないことを確認した日時';
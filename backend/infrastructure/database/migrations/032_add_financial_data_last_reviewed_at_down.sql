@@ -0,0 +1,2 @@
+-- 財務データの最終確認日時の削除
+ALTER TABLE financial_data DROP COLUMN IF EXISTS last_reviewed_at;
@@ -0,0 +1,17 @@
+-- 033_create_goal_progress_snapshots_table.sql
+-- 目標の進捗履歴テーブルを作成
+
+CREATE TABLE IF NOT EXISTS goal_progress_snapshots (
+    goal_id UUID NOT NULL REFERENCES goals(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    recorded_on DATE NOT NULL,
+    amount DECIMAL(15,2) NOT NULL CHECK (amount >= 0),
+    recorded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (goal_id, recorded_on)
+);
+
+-- コメント追加
+COMMENT ON TABLE goal_progress_snapshots IS '目標の進捗履歴テーブル。目標の進捗を更新した時に1日1件記録し、積立ペースの実績の算出に使う';
+COMMENT ON COLUMN goal_progress_snapshots.recorded_on IS '記録日。同じ日に進捗を更新した場合は上書きする';
+COMMENT ON COLUMN goal_progress_snapshots.amount IS '記録時点の目標の現在金額（円）';
@@ -0,0 +1,2 @@
+-- 目標の進捗履歴テーブルの削除
+DROP TABLE IF EXISTS goal_progress_snapshots;
@@ -0,0 +1,10 @@
+-- 034_add_financial_data_version.sql
+-- 財務データのバージョンを追加
+-- 別のセッションで同時に財務計画を更新した場合に、後から保存した更新で先の更新を上書きしないよう楽観的ロックに使用する
+
+ALTER TABLE financial_data ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE financial_data ADD CONSTRAINT chk_financial_data_version CHECK (version >= 1);
+
+-- コメント追加
+COMMENT ON COLUMN financial_data.version IS '楽観的ロックに使う財務計画のバージョン（作成時は1で、更新ごとに1つ進む）';
@@ -0,0 +1,3 @@
+-- 財務データのバージョンの削除
+ALTER TABLE financial_data DROP CONSTRAINT IF EXISTS chk_financial_data_version;
+ALTER TABLE financial_data DROP COLUMN IF EXISTS version;
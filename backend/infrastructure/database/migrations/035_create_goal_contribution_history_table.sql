@@ -0,0 +1,21 @@
+-- 035_create_goal_contribution_history_table.sql
+-- 目標の月間拠出額の変更履歴テーブルを作成
+
+CREATE TABLE IF NOT EXISTS goal_contribution_history (
+    id BIGSERIAL PRIMARY KEY,
+    goal_id UUID NOT NULL REFERENCES goals(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    previous_contribution DECIMAL(15,2) NOT NULL CHECK (previous_contribution >= 0),
+    new_contribution DECIMAL(15,2) NOT NULL CHECK (new_contribution >= 0),
+    required_contribution DECIMAL(15,2) NOT NULL CHECK (required_contribution >= 0),
+    over_budget BOOLEAN NOT NULL DEFAULT FALSE,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_goal_contribution_history_goal_id_changed_at
+    ON goal_contribution_history (goal_id, changed_at);
+
+-- コメント追加
+COMMENT ON TABLE goal_contribution_history IS '目標の月間拠出額の変更履歴テーブル。月次必要額を採用して拠出額を見直した時に記録する';
+COMMENT ON COLUMN goal_contribution_history.required_contribution IS '変更時点の月次必要額（円、端数処理前）';
+COMMENT ON COLUMN goal_contribution_history.over_budget IS '他の目標と合わせた拠出額が月間純貯蓄額を超えたまま変更したか（force指定）';
@@ -0,0 +1,2 @@
+-- 目標の月間拠出額の変更履歴テーブルの削除
+DROP TABLE IF EXISTS goal_contribution_history;
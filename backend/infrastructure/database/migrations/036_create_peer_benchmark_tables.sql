@@ -0,0 +1,27 @@
+-- 036_create_peer_benchmark_tables.sql
+-- 同年代比較の匿名集計テーブルと、集計への参加（オプトイン）テーブルを作成
+
+CREATE TABLE IF NOT EXISTS peer_benchmark_consents (
+    user_id VARCHAR(255) PRIMARY KEY,
+    opted_in_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS peer_benchmarks (
+    age_band VARCHAR(20) NOT NULL,
+    income_band VARCHAR(20) NOT NULL,
+    metric VARCHAR(50) NOT NULL,
+    sample_size INTEGER NOT NULL CHECK (sample_size > 0),
+    p10 DECIMAL(15,2) NOT NULL,
+    p25 DECIMAL(15,2) NOT NULL,
+    median DECIMAL(15,2) NOT NULL,
+    p75 DECIMAL(15,2) NOT NULL,
+    p90 DECIMAL(15,2) NOT NULL,
+    aggregated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    PRIMARY KEY (age_band, income_band, metric)
+);
+
+-- コメント追加
+COMMENT ON TABLE peer_benchmark_consents IS '同年代比較の匿名集計への参加テーブル。参加しているユーザーのみ行を持ち、取り消すと削除する';
+COMMENT ON TABLE peer_benchmarks IS '年代・年収帯ごとの匿名集計テーブル。集計ジョブが一括で置き換え、最小集計人数に満たない区分は保存しない';
+COMMENT ON COLUMN peer_benchmarks.sample_size IS '区分の集計人数';
@@ -0,0 +1,3 @@
+-- 同年代比較の匿名集計テーブルと参加テーブルの削除
+DROP TABLE IF EXISTS peer_benchmarks;
+DROP TABLE IF EXISTS peer_benchmark_consents;
@@ -0,0 +1,9 @@
+-- 037_add_goal_contribution_range.sql
+-- 目標の月間拠出額の幅（最低額・ストレッチ額）を保存する列を追加
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS contribution_min DECIMAL(15,2) CHECK (contribution_min >= 0);
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS contribution_stretch DECIMAL(15,2) CHECK (contribution_stretch >= 0);
+
+-- コメント追加
+COMMENT ON COLUMN goals.contribution_min IS '最低限拠出する月間拠出額。NULLの場合は monthly_contribution と同じ。実現可能性はこの額で判定する';
+COMMENT ON COLUMN goals.contribution_stretch IS '余裕がある月に拠出できる月間拠出額。NULLの場合は monthly_contribution と同じ';
@@ -0,0 +1,3 @@
+-- 目標の月間拠出額の幅の削除
+ALTER TABLE goals DROP COLUMN IF EXISTS contribution_stretch;
+ALTER TABLE goals DROP COLUMN IF EXISTS contribution_min;
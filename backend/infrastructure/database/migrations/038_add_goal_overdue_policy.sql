@@ -0,0 +1,13 @@
+-- 038_add_goal_overdue_policy.sql
+-- 目標の期限超過時ポリシーの列を追加し、期限超過の通知イベントを通知設定で選べるようにする
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS overdue_policy VARCHAR(20) NOT NULL DEFAULT 'notify'
+    CHECK (overdue_policy IN ('extend', 'archive', 'notify'));
+
+ALTER TABLE notification_preferences DROP CONSTRAINT IF EXISTS notification_preferences_event_type_check;
+ALTER TABLE notification_preferences ADD CONSTRAINT notification_preferences_event_type_check
+    CHECK (event_type IN ('goal_deadline_approaching', 'goal_achieved', 'goal_review_recommended', 'goal_overdue'));
+
+-- コメント追加
+COMMENT ON COLUMN goals.overdue_policy IS '期限超過時の扱い（extend: 必要な期間を再計算して延長, archive: 非アクティブ化, notify: 通知のみ）';
+COMMENT ON COLUMN notification_preferences.threshold IS 'イベント種別ごとの閾値（期限接近: 残り日数, 達成: 進捗率%, 見直し推奨: 超過率%, 期限超過: 超過日数）';
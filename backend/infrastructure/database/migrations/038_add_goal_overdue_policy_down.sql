@@ -0,0 +1,7 @@
+-- 目標の期限超過時ポリシーの削除
+DELETE FROM notification_preferences WHERE event_type = 'goal_overdue';
+ALTER TABLE notification_preferences DROP CONSTRAINT IF EXISTS notification_preferences_event_type_check;
+ALTER TABLE notification_preferences ADD CONSTRAINT notification_preferences_event_type_check
+    CHECK (event_type IN ('goal_deadline_approaching', 'goal_achieved', 'goal_review_recommended'));
+
+ALTER TABLE goals DROP COLUMN IF EXISTS overdue_policy;
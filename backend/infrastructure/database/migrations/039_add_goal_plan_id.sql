@@ -0,0 +1,17 @@
+-- 039_add_goal_plan_id.sql
+-- 目標を財務計画（financial_data）に紐付ける plan_id と、財務計画から削除した日時を記録する deleted_at を追加
+
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS plan_id UUID REFERENCES financial_data(id) ON DELETE SET NULL;
+ALTER TABLE goals ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+-- 既存の目標を同じユーザーの財務計画に紐付ける
+UPDATE goals g SET plan_id = fd.id
+    FROM financial_data fd
+    WHERE fd.user_id = g.user_id AND g.plan_id IS NULL;
+
+-- 財務計画の取得時に目標を結合するためのインデックス
+CREATE INDEX IF NOT EXISTS idx_goals_plan_id ON goals(plan_id) WHERE deleted_at IS NULL;
+
+-- コメント追加
+COMMENT ON COLUMN goals.plan_id IS '目標が属する財務計画（financial_data.id）。財務データの作成前に作成した目標はNULLで、財務データの保存時に紐付ける';
+COMMENT ON COLUMN goals.deleted_at IS '財務計画から削除した日時（論理削除）。NULLの場合は未削除';
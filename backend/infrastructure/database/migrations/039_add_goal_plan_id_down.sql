@@ -0,0 +1,5 @@
+-- 目標の財務計画への紐付けの削除（論理削除済みの目標は物理削除する）
+DELETE FROM goals WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_goals_plan_id;
+ALTER TABLE goals DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE goals DROP COLUMN IF EXISTS plan_id;
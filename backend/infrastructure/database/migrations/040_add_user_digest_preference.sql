@@ -0,0 +1,31 @@
+-- 040_add_user_digest_preference.sql
+-- ダイジェストメールの配信設定の列と、配信記録テーブルを作成
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_frequency VARCHAR(10) NOT NULL DEFAULT 'off'
+    CHECK (digest_frequency IN ('off', 'weekly', 'daily'));
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_delivery_hour SMALLINT NOT NULL DEFAULT 8
+    CHECK (digest_delivery_hour BETWEEN 0 AND 23);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_last_sent_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_users_digest_frequency
+    ON users (digest_frequency) WHERE digest_frequency <> 'off';
+
+CREATE TABLE IF NOT EXISTS digest_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(10) NOT NULL CHECK (frequency IN ('weekly', 'daily')),
+    health_score INTEGER NOT NULL CHECK (health_score BETWEEN 0 AND 100),
+    warnings TEXT[] NOT NULL DEFAULT '{}',
+    goal_amounts JSONB NOT NULL DEFAULT '{}',
+    sent_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_digest_deliveries_user_id_sent_at
+    ON digest_deliveries (user_id, sent_at DESC);
+
+-- コメント追加
+COMMENT ON COLUMN users.digest_frequency IS 'ダイジェストメールの配信頻度（off: 配信しない, weekly: 週次, daily: 日次）';
+COMMENT ON COLUMN users.digest_delivery_hour IS 'ダイジェストメールの配信時刻（時、バッチを実行する環境のタイムゾーン）';
+COMMENT ON COLUMN users.digest_last_sent_at IS 'ダイジェストメールを最後に配信した日時';
+COMMENT ON TABLE digest_deliveries IS 'ダイジェストメールの配信記録テーブル。次回の配信で前回からの進捗や新しい警告を求める基準にする';
+COMMENT ON COLUMN digest_deliveries.goal_amounts IS '目標IDごとの配信時点の積立額（円）';
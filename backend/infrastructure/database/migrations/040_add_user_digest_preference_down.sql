@@ -0,0 +1,7 @@
+-- ダイジェストメールの配信設定と配信記録の削除
+DROP TABLE IF EXISTS digest_deliveries;
+
+DROP INDEX IF EXISTS idx_users_digest_frequency;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_last_sent_at;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_delivery_hour;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_frequency;
@@ -0,0 +1,30 @@
+-- 041_remove_deleted_goal_dependencies.sql
+-- 目標を削除（物理削除・論理削除）したときに、他の目標の depends_on_goal_ids から削除した目標のIDを取り除く
+-- depends_on_goal_ids（UUID[]）は外部キーを持てないため、015 で失われた ON DELETE SET NULL 相当の整合性をトリガーで保つ
+
+CREATE OR REPLACE FUNCTION remove_deleted_goal_from_dependencies()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' OR (OLD.deleted_at IS NULL AND NEW.deleted_at IS NOT NULL) THEN
+        UPDATE goals
+            SET depends_on_goal_ids = array_remove(depends_on_goal_ids, OLD.id)
+            WHERE depends_on_goal_ids @> ARRAY[OLD.id];
+    END IF;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER remove_deleted_goal_from_dependencies AFTER DELETE OR UPDATE OF deleted_at ON goals
+    FOR EACH ROW EXECUTE FUNCTION remove_deleted_goal_from_dependencies();
+
+-- 既に削除済みの目標（または存在しない目標）への依存を取り除く
+UPDATE goals g
+    SET depends_on_goal_ids = ARRAY(
+        SELECT prerequisite_id FROM unnest(g.depends_on_goal_ids) AS prerequisite_id
+        WHERE EXISTS (SELECT 1 FROM goals p WHERE p.id = prerequisite_id AND p.deleted_at IS NULL)
+    )
+    WHERE cardinality(g.depends_on_goal_ids) > 0
+      AND EXISTS (
+        SELECT 1 FROM unnest(g.depends_on_goal_ids) AS prerequisite_id
+        WHERE NOT EXISTS (SELECT 1 FROM goals p WHERE p.id = prerequisite_id AND p.deleted_at IS NULL)
+      );
@@ -0,0 +1,3 @@
+-- 目標の削除時に依存関係を取り除くトリガーの削除
+DROP TRIGGER IF EXISTS remove_deleted_goal_from_dependencies ON goals;
+DROP FUNCTION IF EXISTS remove_deleted_goal_from_dependencies();
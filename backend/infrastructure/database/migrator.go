@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"io/fs"
@@ -283,5 +284,18 @@ func (m *Migrator) Status() error {
 		log.Printf("%s\t%s\t%s", migration.Version, status, migration.Name)
 	}
 
+	missing, err := m.CheckIndexes(context.Background())
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		log.Println("インデックス: 必要なインデックスは全て存在します")
+		return nil
+	}
+	log.Printf("インデックス: %d件の必要なインデックスが不足しています", len(missing))
+	for _, index := range missing {
+		log.Printf("  %s", index)
+	}
+
 	return nil
 }
@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"io/fs"
 	"log"
+	"math/rand"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 //go:embed seeds/*.sql
@@ -101,3 +105,182 @@ func (s *Seeder) loadSeedFiles() ([]*SeedFile, error) {
 
 	return seedFileList, nil
 }
+
+const (
+	// performanceSeedName は負荷試験用データの投入記録（seed_records）の名前
+	performanceSeedName = "performance_test_data"
+	// performanceSeedRandomSeed は負荷試験用データを再現可能にするための乱数シード
+	performanceSeedRandomSeed = 42
+	// performanceSeedBatchSize は1回の INSERT で投入する行数（プレースホルダー数の上限を避けるため）
+	performanceSeedBatchSize = 100
+	// performanceSeedProgressInterval は進捗をログに出力する行数の間隔
+	performanceSeedProgressInterval = 1000
+	// performanceSeedMaxGoals はユーザーごとの目標数の上限（この値未満）
+	performanceSeedMaxGoals = 10
+)
+
+// performanceSeedGoalTypes は負荷試験用の目標に割り当てる目標タイプ
+var performanceSeedGoalTypes = []string{"savings", "retirement", "emergency", "custom"}
+
+// performanceSeedTable は負荷試験用データのテーブルごとの投入内容
+type performanceSeedTable struct {
+	Name    string
+	Columns []string
+	Rows    [][]any
+}
+
+// SeedPerformanceTestData は負荷試験用に n 人のユーザーと、各ユーザーの財務データ1件・目標0〜9件を投入する
+// 乱数シードを固定しているため同じ n では常に同じデータになる
+// 投入済みの場合は seed_records の記録を確認して何もしない
+func (s *Seeder) SeedPerformanceTestData(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("投入するユーザー数は1以上で指定してください: %d", n)
+	}
+
+	if err := s.createSeedRecordsTable(); err != nil {
+		return err
+	}
+
+	var recordedCount int
+	err := s.db.QueryRow(`SELECT row_count FROM seed_records WHERE name = $1`, performanceSeedName).Scan(&recordedCount)
+	if err == nil {
+		log.Printf("負荷試験用データ（%d 行）は既に投入済みのためスキップします", recordedCount)
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return fmt.Errorf("シードの投入記録の取得に失敗しました: %w", err)
+	}
+
+	tables := generatePerformanceTestData(n, time.Now())
+
+	totalRows := 0
+	for _, table := range tables {
+		totalRows += len(table.Rows)
+	}
+	log.Printf("負荷試験用データ（ユーザー %d 人・%d 行）を投入中...", n, totalRows)
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+
+	inserted := 0
+	for _, table := range tables {
+		for start := 0; start < len(table.Rows); start += performanceSeedBatchSize {
+			end := min(start+performanceSeedBatchSize, len(table.Rows))
+			batch := table.Rows[start:end]
+
+			args := make([]any, 0, len(batch)*len(table.Columns))
+			for _, row := range batch {
+				args = append(args, row...)
+			}
+			if _, err := tx.Exec(buildBatchInsertQuery(table.Name, table.Columns, len(batch)), args...); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("%s への負荷試験用データの投入に失敗しました: %w", table.Name, err)
+			}
+
+			// 進捗の間隔をまたいだときにログを出力する
+			if (inserted+len(batch))/performanceSeedProgressInterval > inserted/performanceSeedProgressInterval {
+				log.Printf("負荷試験用データを投入中: %d / %d 行", inserted+len(batch), totalRows)
+			}
+			inserted += len(batch)
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO seed_records (name, row_count) VALUES ($1, $2)`, performanceSeedName, totalRows)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("シードの投入記録の保存に失敗しました: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("負荷試験用データのコミットに失敗しました: %w", err)
+	}
+
+	log.Printf("負荷試験用データ（%d 行）が正常に投入されました", totalRows)
+	return nil
+}
+
+// createSeedRecordsTable はシードの投入記録テーブルがなければ作成する
+func (s *Seeder) createSeedRecordsTable() error {
+	query := `
+		CREATE TABLE IF NOT EXISTS seed_records (
+			name VARCHAR(255) PRIMARY KEY,
+			row_count INTEGER NOT NULL,
+			seeded_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+		);
+	`
+	if _, err := s.db.Exec(query); err != nil {
+		return fmt.Errorf("シードの投入記録テーブルの作成に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// generatePerformanceTestData は負荷試験用のユーザー・財務データ・目標の行を生成する
+// 目標の期日は now を起点にするため、日付以外の値のみが再現可能になる
+func generatePerformanceTestData(n int, now time.Time) []performanceSeedTable {
+	rng := rand.New(rand.NewSource(performanceSeedRandomSeed))
+
+	users := performanceSeedTable{Name: "users", Columns: []string{"id", "email"}}
+	financialData := performanceSeedTable{
+		Name:    "financial_data",
+		Columns: []string{"id", "user_id", "monthly_income", "investment_return", "inflation_rate"},
+	}
+	goals := performanceSeedTable{
+		Name:    "goals",
+		Columns: []string{"id", "user_id", "type", "title", "target_amount", "target_date", "current_amount", "monthly_contribution"},
+	}
+
+	for i := 1; i <= n; i++ {
+		userID := uuid.Must(uuid.NewRandomFromReader(rng)).String()
+		users.Rows = append(users.Rows, []any{userID, fmt.Sprintf("perf-user-%06d@example.com", i)})
+
+		financialData.Rows = append(financialData.Rows, []any{
+			uuid.Must(uuid.NewRandomFromReader(rng)).String(),
+			userID,
+			float64(200000 + rng.Intn(60)*10000),
+			float64(rng.Intn(70)) / 10,
+			float64(rng.Intn(30)) / 10,
+		})
+
+		goalCount := rng.Intn(performanceSeedMaxGoals)
+		for j := 1; j <= goalCount; j++ {
+			targetAmount := float64(100000 + rng.Intn(300)*100000)
+			goals.Rows = append(goals.Rows, []any{
+				uuid.Must(uuid.NewRandomFromReader(rng)).String(),
+				userID,
+				performanceSeedGoalTypes[rng.Intn(len(performanceSeedGoalTypes))],
+				fmt.Sprintf("負荷試験用の目標 %d", j),
+				targetAmount,
+				now.AddDate(1+rng.Intn(30), 0, 0).Format("2006-01-02"),
+				float64(rng.Intn(int(targetAmount/10000))) * 10000,
+				float64(rng.Intn(20)) * 5000,
+			})
+		}
+	}
+
+	return []performanceSeedTable{users, financialData, goals}
+}
+
+// buildBatchInsertQuery は rowCount 行分のプレースホルダーを持つ複数行の INSERT 文を組み立てる
+func buildBatchInsertQuery(table string, columns []string, rowCount int) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "INSERT INTO %s (%s) VALUES ", table, strings.Join(columns, ", "))
+
+	placeholder := 1
+	for i := 0; i < rowCount; i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(")
+		for j := range columns {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "$%d", placeholder)
+			placeholder++
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
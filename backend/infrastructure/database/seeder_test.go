@@ -0,0 +1,98 @@
+package database
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/config"
+)
+
+func TestGeneratePerformanceTestData(t *testing.T) {
+	now := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	first := generatePerformanceTestData(50, now)
+	second := generatePerformanceTestData(50, now)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatal("同じユーザー数では同じデータが生成されるべきです")
+	}
+
+	users, financialData, goals := first[0], first[1], first[2]
+	if len(users.Rows) != 50 || len(financialData.Rows) != 50 {
+		t.Fatalf("ユーザーと財務データは50件ずつ生成されるべきです: users=%d, financial_data=%d", len(users.Rows), len(financialData.Rows))
+	}
+	if len(goals.Rows) > 50*(performanceSeedMaxGoals-1) {
+		t.Errorf("目標はユーザーごとに最大%d件であるべきです: %d", performanceSeedMaxGoals-1, len(goals.Rows))
+	}
+
+	for _, row := range goals.Rows {
+		targetAmount, currentAmount := row[4].(float64), row[6].(float64)
+		if currentAmount > targetAmount {
+			t.Errorf("現在の金額が目標金額を超えています: %v > %v", currentAmount, targetAmount)
+		}
+		if targetDate, _ := time.Parse("2006-01-02", row[5].(string)); !targetDate.After(now) {
+			t.Errorf("目標の期日は未来の日付であるべきです: %v", row[5])
+		}
+	}
+}
+
+func TestBuildBatchInsertQuery(t *testing.T) {
+	got := buildBatchInsertQuery("goals", []string{"id", "user_id"}, 3)
+	want := "INSERT INTO goals (id, user_id) VALUES ($1, $2), ($3, $4), ($5, $6)"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+// clearPerformanceTestData は負荷試験用データと投入記録を削除する（ベンチマークの繰り返し用）
+func clearPerformanceTestData(db *sql.DB) error {
+	queries := []string{
+		`DELETE FROM goals WHERE user_id IN (SELECT id FROM users WHERE email LIKE 'perf-user-%@example.com')`,
+		`DELETE FROM financial_data WHERE user_id IN (SELECT id FROM users WHERE email LIKE 'perf-user-%@example.com')`,
+		`DELETE FROM users WHERE email LIKE 'perf-user-%@example.com'`,
+		`DELETE FROM seed_records WHERE name = '` + performanceSeedName + `'`,
+	}
+	for _, query := range queries {
+		if _, err := db.Exec(query); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func BenchmarkSeedPerformanceTestData(b *testing.B) {
+	dbConfig := config.NewDatabaseConfig()
+	// データベースがない環境ですぐにスキップできるよう再試行しない
+	dbConfig.Retry.MaxAttempts = 1
+
+	db, err := config.NewDatabaseConnection(dbConfig)
+	if err != nil {
+		b.Skipf("Database connection failed (expected in CI): %v", err)
+		return
+	}
+	defer db.Close()
+
+	seeder := NewSeeder(db)
+	if err := seeder.createSeedRecordsTable(); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		if err := clearPerformanceTestData(db); err != nil {
+			b.Fatalf("負荷試験用データの削除に失敗しました: %v", err)
+		}
+		b.StartTimer()
+
+		if err := seeder.SeedPerformanceTestData(1000); err != nil {
+			b.Fatalf("負荷試験用データの投入に失敗しました: %v", err)
+		}
+	}
+
+	b.StopTimer()
+	if err := clearPerformanceTestData(db); err != nil {
+		b.Fatalf("負荷試験用データの削除に失敗しました: %v", err)
+	}
+}
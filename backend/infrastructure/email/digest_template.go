@@ -0,0 +1,118 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"math"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+)
+
+//go:embed templates/digest.txt.tmpl templates/digest.html.tmpl
+var digestTemplateFiles embed.FS
+
+// digestTemplateFuncs はダイジェストメールのテンプレートで使う書式関数
+var digestTemplateFuncs = map[string]any{
+	"date":         formatDigestDate,
+	"yen":          formatYen,
+	"percent":      func(value float64) string { return fmt.Sprintf("%.1f%%", value) },
+	"signed":       formatSignedPoints,
+	"scoreLevel":   scoreLevelText,
+	"upcomingDays": func() int { return usecases.DigestUpcomingGoalDays },
+}
+
+var (
+	digestTextTemplate = texttemplate.Must(texttemplate.New("digest.txt.tmpl").
+				Funcs(digestTemplateFuncs).ParseFS(digestTemplateFiles, "templates/digest.txt.tmpl"))
+	digestHTMLTemplate = htmltemplate.Must(htmltemplate.New("digest.html.tmpl").
+				Funcs(digestTemplateFuncs).ParseFS(digestTemplateFiles, "templates/digest.html.tmpl"))
+)
+
+// RenderDigest はダイジェストメールの内容から送信するメール（件名・テキスト・HTML）を組み立てる
+func RenderDigest(digest *usecases.Digest) (Message, error) {
+	var text, html bytes.Buffer
+	if err := digestTextTemplate.Execute(&text, digest); err != nil {
+		return Message{}, fmt.Errorf("ダイジェストメール（テキスト）の生成に失敗しました: %w", err)
+	}
+	if err := digestHTMLTemplate.Execute(&html, digest); err != nil {
+		return Message{}, fmt.Errorf("ダイジェストメール（HTML）の生成に失敗しました: %w", err)
+	}
+
+	return Message{
+		To:       digest.Email,
+		Subject:  fmt.Sprintf("%sダイジェスト（%s）", digest.Frequency.String(), formatDigestDate(digest.GeneratedAt)),
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
+
+// formatDigestDate は日時を「2006年1月2日」の形式にする（*time.Time も受け付ける）
+func formatDigestDate(value any) string {
+	switch t := value.(type) {
+	case time.Time:
+		return t.Format("2006年1月2日")
+	case *time.Time:
+		if t == nil {
+			return ""
+		}
+		return t.Format("2006年1月2日")
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// formatYen は金額を円単位に四捨五入し、3桁区切りの「¥1,234,567」の形式にする
+func formatYen(amount float64) string {
+	rounded := int64(math.Round(amount))
+	sign := ""
+	if rounded < 0 {
+		sign = "-"
+		rounded = -rounded
+	}
+
+	digits := strconv.FormatInt(rounded, 10)
+	var b strings.Builder
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return sign + "¥" + b.String()
+}
+
+// formatSignedPoints はスコアの増減を符号付きで表す（増減なしは「±0」）
+func formatSignedPoints(change *int) string {
+	switch {
+	case change == nil:
+		return ""
+	case *change > 0:
+		return fmt.Sprintf("+%d", *change)
+	case *change < 0:
+		return strconv.Itoa(*change)
+	default:
+		return "±0"
+	}
+}
+
+// scoreLevelText は財務健全性スコアの水準を日本語の表示名にする
+func scoreLevelText(level string) string {
+	switch level {
+	case "excellent":
+		return "優秀"
+	case "good":
+		return "良好"
+	case "fair":
+		return "普通"
+	case "poor":
+		return "要改善"
+	default:
+		return level
+	}
+}
@@ -0,0 +1,134 @@
+package email
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// updateFixtures はダイジェストメールの期待値ファイルを現在の出力で再生成するフラグ
+// テンプレートを意図的に変更した場合のみ以下で更新する:
+//
+//	go test ./infrastructure/email/ -run TestRenderDigest -update
+var updateFixtures = flag.Bool("update", false, "testdata の期待値ファイルを現在の出力で更新する")
+
+// newFirstDigestFixture は初回配信（前回の配信記録なし）のダイジェスト
+func newFirstDigestFixture() *usecases.Digest {
+	return &usecases.Digest{
+		UserID:      "user-001",
+		Email:       "user@example.com",
+		Frequency:   entities.DigestFrequencyWeekly,
+		GeneratedAt: time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC),
+		HealthScore: usecases.DigestHealthScore{Score: 72, Level: "good"},
+		UpcomingGoals: []usecases.DigestUpcomingGoal{
+			{
+				GoalID:          "goal-001",
+				Title:           "夏の旅行",
+				TargetDate:      time.Date(2026, 6, 20, 0, 0, 0, 0, time.UTC),
+				DaysRemaining:   18,
+				ProgressPercent: 80,
+				RemainingAmount: 60000,
+			},
+		},
+		GoalProgress: []usecases.DigestGoalProgress{},
+		NewWarnings:  []string{"緊急資金が月間支出の3ヶ月分を下回っています"},
+	}
+}
+
+// newFollowUpDigestFixture は2回目以降の配信（前回との比較あり）のダイジェスト
+func newFollowUpDigestFixture() *usecases.Digest {
+	previousSentAt := time.Date(2026, 5, 25, 8, 0, 0, 0, time.UTC)
+	change := -3
+	return &usecases.Digest{
+		UserID:         "user-001",
+		Email:          "user@example.com",
+		Frequency:      entities.DigestFrequencyDaily,
+		GeneratedAt:    time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC),
+		PreviousSentAt: &previousSentAt,
+		HealthScore:    usecases.DigestHealthScore{Score: 69, Level: "fair", Change: &change},
+		UpcomingGoals:  []usecases.DigestUpcomingGoal{},
+		GoalProgress: []usecases.DigestGoalProgress{
+			{
+				GoalID:          "goal-002",
+				Title:           "住宅購入の頭金 <2027>",
+				CurrentAmount:   1250000,
+				TargetAmount:    5000000,
+				ProgressPercent: 25,
+				AmountIncreased: 50000,
+			},
+		},
+		NewWarnings: []string{},
+	}
+}
+
+// assertFixture は got を testdata/<name> と比較する
+func assertFixture(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *updateFixtures {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "期待値ファイルの読み込みに失敗しました（-update で生成してください）")
+	assert.Equal(t, string(want), got, "意図した変更であれば -update で %s を更新してください", path)
+}
+
+func TestRenderDigest(t *testing.T) {
+	t.Run("初回配信は全警告と期限が近い目標を載せ、前回との比較がないことを案内する", func(t *testing.T) {
+		message, err := RenderDigest(newFirstDigestFixture())
+
+		require.NoError(t, err)
+		assert.Equal(t, "user@example.com", message.To)
+		assert.Equal(t, "週次ダイジェスト（2026年6月1日）", message.Subject)
+		assertFixture(t, "digest_first.txt", message.TextBody)
+		assertFixture(t, "digest_first.html", message.HTMLBody)
+	})
+
+	t.Run("2回目以降はスコアの増減と前回からの目標の進捗を載せる", func(t *testing.T) {
+		message, err := RenderDigest(newFollowUpDigestFixture())
+
+		require.NoError(t, err)
+		assert.Equal(t, "日次ダイジェスト（2026年6月1日）", message.Subject)
+		assert.Contains(t, message.TextBody, "69点（普通） 前回比 -3点")
+		assert.Contains(t, message.TextBody, "¥50,000 増加（¥1,250,000 / ¥5,000,000、進捗 25.0%）")
+		// HTMLでは目標名などのユーザー入力をエスケープする
+		assert.Contains(t, message.HTMLBody, "住宅購入の頭金 &lt;2027&gt;")
+		assert.False(t, strings.Contains(message.HTMLBody, "<2027>"))
+		assertFixture(t, "digest_follow_up.txt", message.TextBody)
+		assertFixture(t, "digest_follow_up.html", message.HTMLBody)
+	})
+}
+
+func TestFormatYen(t *testing.T) {
+	tests := map[float64]string{
+		0:          "¥0",
+		999:        "¥999",
+		1000:       "¥1,000",
+		1234567.5:  "¥1,234,568",
+		-60000:     "-¥60,000",
+		5000000000: "¥5,000,000,000",
+	}
+	for amount, want := range tests {
+		assert.Equal(t, want, formatYen(amount), amount)
+	}
+}
+
+func TestFormatSignedPoints(t *testing.T) {
+	up, down, flat := 5, -2, 0
+	assert.Equal(t, "+5", formatSignedPoints(&up))
+	assert.Equal(t, "-2", formatSignedPoints(&down))
+	assert.Equal(t, "±0", formatSignedPoints(&flat))
+	assert.Equal(t, "", formatSignedPoints(nil))
+}
@@ -0,0 +1,202 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+// smtpTimeout はSMTPサーバーとのやり取り全体のタイムアウト（ctx に期限がない場合に使う）
+const smtpTimeout = 30 * time.Second
+
+// Message は送信するメール（テキストとHTMLの両方を含む）
+type Message struct {
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// Mailer は任意の内容のメールを送信するインターフェース
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// LogMailer は開発用のメール送信（stdoutにログ出力）
+type LogMailer struct{}
+
+// NewLogMailer は開発用のメール送信を作成する
+func NewLogMailer() Mailer {
+	return &LogMailer{}
+}
+
+// Send はメールの宛先・件名・本文（テキスト）をログに出力する（開発用）
+func (m *LogMailer) Send(_ context.Context, message Message) error {
+	slog.Info("メール送信（開発モード）",
+		"to", message.To,
+		"subject", message.Subject,
+		"text_body", message.TextBody,
+	)
+	return nil
+}
+
+// SMTPMailer はSMTPサーバー経由でメールを送信する
+// サーバーが STARTTLS に対応している場合は暗号化してから認証する
+type SMTPMailer struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer はSMTPメール送信を作成する
+func NewSMTPMailer(host string, port int, username, password, from string) Mailer {
+	return &SMTPMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// Send はテキストとHTMLを multipart/alternative にまとめたメールを送信する
+func (m *SMTPMailer) Send(ctx context.Context, message Message) error {
+	body, err := m.buildMessage(message)
+	if err != nil {
+		return fmt.Errorf("メールの生成に失敗しました: %w", err)
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, smtpTimeout)
+		defer cancel()
+	}
+
+	addr := net.JoinHostPort(m.host, strconv.Itoa(m.port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("SMTPサーバーへの接続に失敗しました: %w", err)
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTPサーバーへの接続に失敗しました: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTPサーバーへの接続に失敗しました: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("STARTTLSに失敗しました: %w", err)
+		}
+	}
+	if m.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return fmt.Errorf("SMTP認証に失敗しました: %w", err)
+		}
+	}
+	if err := client.Mail(m.from); err != nil {
+		return fmt.Errorf("送信元の指定に失敗しました: %w", err)
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return fmt.Errorf("宛先の指定に失敗しました: %w", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("メール送信に失敗しました: %w", err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		writer.Close()
+		return fmt.Errorf("メール送信に失敗しました: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("メール送信に失敗しました: %w", err)
+	}
+
+	return client.Quit()
+}
+
+// buildMessage はヘッダーとテキスト・HTMLの本文からメールのデータを組み立てる
+// 件名はRFC 2047、本文はBase64でエンコードする（日本語を含むため）
+func (m *SMTPMailer) buildMessage(message Message) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", m.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", writer.Boundary())
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{contentType: "text/plain; charset=UTF-8", body: message.TextBody},
+		{contentType: "text/html; charset=UTF-8", body: message.HTMLBody},
+	}
+	for _, part := range parts {
+		if part.body == "" {
+			continue
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part.contentType)
+		header.Set("Content-Transfer-Encoding", "base64")
+		partWriter, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := partWriter.Write([]byte(wrapBase64(part.body))); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// wrapBase64 は本文をBase64でエンコードし、76文字ごとに改行する（RFC 2045）
+func wrapBase64(body string) string {
+	encoded := base64.StdEncoding.EncodeToString([]byte(body))
+	var buf bytes.Buffer
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+	return buf.String()
+}
+
+// NewMailer はSMTP設定に基づいてメール送信を作成する
+// SMTP_PASSWORD が未設定の場合は開発用（ログ出力）を使う
+func NewMailer(host string, port int, username, password, from string) Mailer {
+	if password == "" {
+		slog.Warn("SMTP_PASSWORDが未設定のため開発用メール送信（ログ出力）を使用します")
+		return NewLogMailer()
+	}
+	return NewSMTPMailer(host, port, username, password, from)
+}
@@ -0,0 +1,79 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPMailer_BuildMessage(t *testing.T) {
+	mailer := &SMTPMailer{from: "noreply@example.com"}
+
+	data, err := mailer.buildMessage(Message{
+		To:       "user@example.com",
+		Subject:  "週次ダイジェスト（2026年6月1日）",
+		TextBody: "財務健全性スコア: 72点",
+		HTMLBody: "<p>財務健全性スコア: 72点</p>",
+	})
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, "noreply@example.com", msg.Header.Get("From"))
+	assert.Equal(t, "user@example.com", msg.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "週次ダイジェスト（2026年6月1日）", subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	// multipart.Reader は Content-Transfer-Encoding が quoted-printable の場合のみ自動でデコードするため、
+	// base64 の本文はここで検証できる形に戻す
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	var contentTypes []string
+	var bodies []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+		assert.Equal(t, "base64", part.Header.Get("Content-Transfer-Encoding"))
+		encoded, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies = append(bodies, decodeBase64Body(t, string(encoded)))
+	}
+	assert.Equal(t, []string{"text/plain; charset=UTF-8", "text/html; charset=UTF-8"}, contentTypes)
+	assert.Equal(t, []string{"財務健全性スコア: 72点", "<p>財務健全性スコア: 72点</p>"}, bodies)
+}
+
+func TestLogMailer_Send(t *testing.T) {
+	err := NewLogMailer().Send(context.Background(), Message{To: "user@example.com", Subject: "件名", TextBody: "本文"})
+
+	assert.NoError(t, err)
+}
+
+func TestNewMailer(t *testing.T) {
+	assert.IsType(t, &LogMailer{}, NewMailer("smtp.example.com", 587, "user", "", "noreply@example.com"))
+	assert.IsType(t, &SMTPMailer{}, NewMailer("smtp.example.com", 587, "user", "secret", "noreply@example.com"))
+}
+
+// decodeBase64Body は76文字ごとに改行されたBase64の本文をデコードする
+func decodeBase64Body(t *testing.T, encoded string) string {
+	t.Helper()
+	decoded, err := base64.StdEncoding.DecodeString(strings.NewReplacer("\r", "", "\n", "").Replace(encoded))
+	require.NoError(t, err)
+	return string(decoded)
+}
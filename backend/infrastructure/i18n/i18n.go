@@ -0,0 +1,94 @@
+// Package i18n はAPIレスポンスのメッセージを Accept-Language に応じて日本語・英語で返すためのメッセージ辞書を提供する
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Language はメッセージの言語
+type Language string
+
+const (
+	// Japanese は日本語
+	Japanese Language = "ja"
+	// English は英語
+	English Language = "en"
+	// DefaultLanguage は未対応の言語が指定された場合に使用する言語
+	DefaultLanguage = Japanese
+)
+
+// IsSupported は言語がメッセージ辞書で対応しているかを返す
+func (l Language) IsSupported() bool {
+	return l == Japanese || l == English
+}
+
+// ParseAcceptLanguage は Accept-Language ヘッダーから対応言語のうち最も優先度の高い言語を返す
+// 言語タグは主言語（en-US の en）で判定し、対応言語がない場合は日本語を返す
+func ParseAcceptLanguage(header string) Language {
+	type candidate struct {
+		lang    Language
+		quality float64
+		order   int
+	}
+
+	var candidates []candidate
+	for i, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		lang := Language(primary)
+		if !lang.IsSupported() {
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{lang: lang, quality: quality, order: i})
+	}
+
+	if len(candidates) == 0 {
+		return DefaultLanguage
+	}
+
+	// 優先度が同じ場合はヘッダーに先に書かれた言語を優先する
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].lang
+}
+
+// Has はメッセージキーが辞書に登録されているかを返す
+func Has(key string) bool {
+	_, ok := messages[key]
+	return ok
+}
+
+// Message はメッセージキーに対応する指定言語のメッセージを返す
+// 引数を指定した場合はメッセージを書式として展開する。指定言語の訳がない場合は日本語、
+// キーが辞書にない場合はキーをそのまま返す
+func Message(lang Language, key string, args ...any) string {
+	translations, ok := messages[key]
+	if !ok {
+		return key
+	}
+
+	message, ok := translations[lang]
+	if !ok {
+		message = translations[DefaultLanguage]
+	}
+	if len(args) == 0 {
+		return message
+	}
+	return fmt.Sprintf(message, args...)
+}
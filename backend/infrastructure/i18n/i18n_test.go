@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   Language
+	}{
+		{"未指定の場合は日本語", "", Japanese},
+		{"英語", "en", English},
+		{"地域付きの英語", "en-US", English},
+		{"大文字の言語タグ", "EN-GB", English},
+		{"日本語", "ja-JP", Japanese},
+		{"優先度の高い言語を選ぶ", "ja;q=0.5, en;q=0.9", English},
+		{"優先度が同じ場合は先に書かれた言語", "en, ja", English},
+		{"未対応の言語は読み飛ばす", "fr-FR, en;q=0.8", English},
+		{"未対応の言語のみの場合は日本語", "fr, de;q=0.9", Japanese},
+		{"ワイルドカードは日本語", "*", Japanese},
+		{"q=0 の言語は選ばない", "en;q=0", Japanese},
+		{"不正な q 値は読み飛ばす", "en;q=abc, ja;q=0.1", Japanese},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseAcceptLanguage(tt.header); got != tt.want {
+				t.Errorf("ParseAcceptLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	t.Run("指定した言語のメッセージを返す", func(t *testing.T) {
+		if got := Message(English, "UNAUTHORIZED"); got != "Authentication required" {
+			t.Errorf("got %q", got)
+		}
+		if got := Message(Japanese, "UNAUTHORIZED"); got != "認証が必要です" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("引数を書式として展開する", func(t *testing.T) {
+		if got := Message(English, "validation.gte", "monthly_income", "0"); got != "monthly_income must be greater than or equal to 0" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("未対応の言語は日本語にフォールバックする", func(t *testing.T) {
+		if got := Message(Language("fr"), "NOT_FOUND"); got != "リソースが見つかりません" {
+			t.Errorf("got %q", got)
+		}
+	})
+
+	t.Run("辞書にないキーはそのまま返す", func(t *testing.T) {
+		if got := Message(English, "UNKNOWN_KEY"); got != "UNKNOWN_KEY" {
+			t.Errorf("got %q", got)
+		}
+		if Has("UNKNOWN_KEY") {
+			t.Error("Has should return false for unknown keys")
+		}
+	})
+}
+
+// TestMessages_AllEntriesHaveJapaneseAndEnglish は辞書のすべてのキーに日本語と英語があり、書式の引数の数が一致することを確認する
+func TestMessages_AllEntriesHaveJapaneseAndEnglish(t *testing.T) {
+	for key, translations := range messages {
+		ja, ok := translations[Japanese]
+		if !ok || ja == "" {
+			t.Errorf("%s: 日本語のメッセージがありません", key)
+		}
+		en, ok := translations[English]
+		if !ok || en == "" {
+			t.Errorf("%s: 英語のメッセージがありません", key)
+		}
+		if strings.Count(ja, "%s") != strings.Count(en, "%s") {
+			t.Errorf("%s: 書式の引数の数が言語間で異なります（ja=%q, en=%q）", key, ja, en)
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package i18n
+
+// messages はメッセージキーごとの各言語のメッセージ
+// キーはエラーコード（ErrorCode・BusinessLogicError.Type）とし、ビジネスロジックエラーの提案は "<コード>.suggestion"、
+// バリデーションエラーは "validation.<タグ>" とする。日本語は必須で、英語の訳がない場合は日本語を返す
+var messages = map[string]map[Language]string{
+	// HTTPエラー・エラーレスポンスのコード
+	"BAD_REQUEST": {
+		Japanese: "リクエストが無効です",
+		English:  "Invalid request",
+	},
+	"UNAUTHORIZED": {
+		Japanese: "認証が必要です",
+		English:  "Authentication required",
+	},
+	"FORBIDDEN": {
+		Japanese: "アクセスが拒否されました",
+		English:  "Access denied",
+	},
+	"2FA_REQUIRED": {
+		Japanese: "2段階認証の設定が必要です",
+		English:  "Two-factor authentication must be enabled",
+	},
+	"NOT_FOUND": {
+		Japanese: "リソースが見つかりません",
+		English:  "Resource not found",
+	},
+	"CONFLICT": {
+		Japanese: "リソースが競合しています",
+		English:  "Resource conflict",
+	},
+	"TOO_MANY_REQUESTS": {
+		Japanese: "リクエスト数が上限を超えています",
+		English:  "Too many requests",
+	},
+	"INTERNAL_SERVER_ERROR": {
+		Japanese: "内部サーバーエラーが発生しました",
+		English:  "Internal server error",
+	},
+	"SERVICE_UNAVAILABLE": {
+		Japanese: "サービスが利用できません",
+		English:  "Service unavailable",
+	},
+	"TIMEOUT": {
+		Japanese: "リクエストがタイムアウトしました",
+		English:  "Request timed out",
+	},
+	"VALIDATION_ERROR": {
+		Japanese: "入力値が無効です",
+		English:  "Invalid input",
+	},
+	"UNKNOWN_ERROR": {
+		Japanese: "エラーが発生しました",
+		English:  "An error occurred",
+	},
+	"BUSINESS_LOGIC_ERROR": {
+		Japanese: "ビジネスロジックエラーが発生しました",
+		English:  "Business rule validation failed",
+	},
+	"CALCULATION_ERROR": {
+		Japanese: "計算処理でエラーが発生しました",
+		English:  "Calculation failed",
+	},
+	"INSUFFICIENT_DATA": {
+		Japanese: "計算に必要なデータが不足しています",
+		English:  "Insufficient data for the calculation",
+	},
+	"INSUFFICIENT_DATA.suggestion": {
+		Japanese: "必要なデータを入力してから再度お試しください",
+		English:  "Enter the required data and try again",
+	},
+	"DATA_INTEGRITY_ERROR": {
+		Japanese: "データの整合性エラーが発生しました",
+		English:  "Data integrity error",
+	},
+
+	// バリデーションエラー（1つ目の引数は項目名、2つ目はタグのパラメータ）
+	"validation.required": {
+		Japanese: "%sは必須です",
+		English:  "%s is required",
+	},
+	"validation.gt": {
+		Japanese: "%sは%sより大きい値を入力してください",
+		English:  "%s must be greater than %s",
+	},
+	"validation.gte": {
+		Japanese: "%sは%s以上の値を入力してください",
+		English:  "%s must be greater than or equal to %s",
+	},
+	"validation.lt": {
+		Japanese: "%sは%sより小さい値を入力してください",
+		English:  "%s must be less than %s",
+	},
+	"validation.lte": {
+		Japanese: "%sは%s以下の値を入力してください",
+		English:  "%s must be less than or equal to %s",
+	},
+	"validation.min": {
+		Japanese: "%sは%s文字以上で入力してください",
+		English:  "%s must be at least %s characters",
+	},
+	"validation.max": {
+		Japanese: "%sは%s文字以下で入力してください",
+		English:  "%s must be at most %s characters",
+	},
+	"validation.oneof": {
+		Japanese: "%sは有効な値を選択してください（%s）",
+		English:  "%s must be one of: %s",
+	},
+	"validation.len": {
+		Japanese: "%sは%s文字で入力してください",
+		English:  "%s must be exactly %s characters",
+	},
+	"validation.email": {
+		Japanese: "%sは有効なメールアドレスを入力してください",
+		English:  "%s must be a valid email address",
+	},
+	"validation.uuid": {
+		Japanese: "%sは有効なUUID形式で入力してください",
+		English:  "%s must be a valid UUID",
+	},
+	"validation.dive": {
+		Japanese: "%sの項目に無効な値が含まれています",
+		English:  "%s contains an invalid item",
+	},
+	"validation.numeric": {
+		Japanese: "%sは数値で入力してください",
+		English:  "%s must be numeric",
+	},
+	"validation.alpha": {
+		Japanese: "%sは英字のみで入力してください",
+		English:  "%s must contain only letters",
+	},
+	"validation.alphanum": {
+		Japanese: "%sは英数字のみで入力してください",
+		English:  "%s must contain only letters and digits",
+	},
+	"validation.url": {
+		Japanese: "%sは有効なURL形式で入力してください",
+		English:  "%s must be a valid URL",
+	},
+	"validation.datetime": {
+		Japanese: "%sは有効な日時形式で入力してください",
+		English:  "%s must be a valid date and time",
+	},
+	"validation.safetext": {
+		Japanese: "%sに使用できない文字が含まれています",
+		English:  "%s contains characters that are not allowed",
+	},
+	"validation.notblank": {
+		Japanese: "%sは空白以外の文字を入力してください",
+		English:  "%s must not be blank",
+	},
+	"validation.safeurl": {
+		Japanese: "%sは外部からアクセス可能なhttp(s)のURLを入力してください",
+		English:  "%s must be a publicly accessible http(s) URL",
+	},
+	"validation.default": {
+		Japanese: "%sの値が無効です",
+		English:  "%s is invalid",
+	},
+
+	// ビジネスロジックエラー（BusinessLogicError.Type）
+	"INVALID_MONTHLY_INCOME": {
+		Japanese: "月収は0より大きい値を入力してください",
+		English:  "Monthly income must be greater than 0",
+	},
+	"INVALID_MONTHLY_INCOME.suggestion": {
+		Japanese: "正の数値を入力してください",
+		English:  "Enter a positive number",
+	},
+	"INVALID_INVESTMENT_RETURN": {
+		Japanese: "投資利回りは0%から100%の範囲で入力してください",
+		English:  "Investment return must be between 0% and 100%",
+	},
+	"INVALID_INVESTMENT_RETURN.suggestion": {
+		Japanese: "現実的な投資利回り（例：3-7%）を入力してください",
+		English:  "Enter a realistic investment return (e.g. 3-7%)",
+	},
+	"INVALID_INFLATION_RATE": {
+		Japanese: "インフレ率は0%から50%の範囲で入力してください",
+		English:  "Inflation rate must be between 0% and 50%",
+	},
+	"INVALID_INFLATION_RATE.suggestion": {
+		Japanese: "現実的なインフレ率（例：1-3%）を入力してください",
+		English:  "Enter a realistic inflation rate (e.g. 1-3%)",
+	},
+	"INVALID_EXPENSE_AMOUNT": {
+		Japanese: "支出金額は0より大きい値を入力してください",
+		English:  "Expense amount must be greater than 0",
+	},
+	"INVALID_EXPENSE_AMOUNT.suggestion": {
+		Japanese: "正の数値を入力してください",
+		English:  "Enter a positive number",
+	},
+	"INSUFFICIENT_SAVINGS": {
+		Japanese: "月間支出が月収を上回っています",
+		English:  "Monthly expenses exceed monthly income",
+	},
+	"INSUFFICIENT_SAVINGS.suggestion": {
+		Japanese: "支出を見直すか、収入を増やすことを検討してください",
+		English:  "Review your expenses or consider increasing your income",
+	},
+	"INVALID_USER_ID": {
+		Japanese: "ユーザーIDの形式が正しくありません",
+		English:  "User ID format is invalid",
+	},
+	"INVALID_RETIREMENT_AGE": {
+		Japanese: "退職年齢は50歳から100歳の範囲で入力してください",
+		English:  "Retirement age must be between 50 and 100",
+	},
+	"INVALID_RETIREMENT_AGE.suggestion": {
+		Japanese: "現実的な退職年齢を入力してください",
+		English:  "Enter a realistic retirement age",
+	},
+	"INVALID_RETIREMENT_EXPENSES": {
+		Japanese: "老後月間生活費は0より大きい値を入力してください",
+		English:  "Monthly retirement expenses must be greater than 0",
+	},
+	"INVALID_RETIREMENT_EXPENSES.suggestion": {
+		Japanese: "現実的な生活費を入力してください",
+		English:  "Enter realistic living expenses",
+	},
+	"INVALID_PENSION_AMOUNT": {
+		Japanese: "年金受給額は0以上の値を入力してください",
+		English:  "Pension amount must be 0 or greater",
+	},
+	"INVALID_PENSION_AMOUNT.suggestion": {
+		Japanese: "予想される年金受給額を入力してください",
+		English:  "Enter your expected pension amount",
+	},
+	"INVALID_TARGET_MONTHS": {
+		Japanese: "緊急資金目標月数は1ヶ月から24ヶ月の範囲で入力してください",
+		English:  "Emergency fund target must be between 1 and 24 months",
+	},
+	"INVALID_TARGET_MONTHS.suggestion": {
+		Japanese: "一般的には3-6ヶ月分の生活費が推奨されます",
+		English:  "3 to 6 months of living expenses is generally recommended",
+	},
+	"INVALID_EMERGENCY_FUND_AMOUNT": {
+		Japanese: "現在の緊急資金額は0以上の値を入力してください",
+		English:  "Current emergency fund amount must be 0 or greater",
+	},
+	"INVALID_EMERGENCY_FUND_AMOUNT.suggestion": {
+		Japanese: "現在保有している緊急資金の金額を入力してください",
+		English:  "Enter the amount of emergency funds you currently hold",
+	},
+	"INVALID_PROJECTION_YEARS": {
+		Japanese: "予測年数は1年から100年の範囲で入力してください",
+		English:  "Projection years must be between 1 and 100",
+	},
+	"INVALID_PROJECTION_YEARS.suggestion": {
+		Japanese: "現実的な予測期間を入力してください",
+		English:  "Enter a realistic projection period",
+	},
+	"INVALID_TARGET_AMOUNT": {
+		Japanese: "目標金額は0より大きい値を入力してください",
+		English:  "Target amount must be greater than 0",
+	},
+	"INVALID_TARGET_AMOUNT.suggestion": {
+		Japanese: "達成したい具体的な金額を入力してください",
+		English:  "Enter the specific amount you want to reach",
+	},
+	"INVALID_CURRENT_AMOUNT": {
+		Japanese: "現在の金額は0以上の値を入力してください",
+		English:  "Current amount must be 0 or greater",
+	},
+	"INVALID_CURRENT_AMOUNT.suggestion": {
+		Japanese: "現在の達成状況を正確に入力してください",
+		English:  "Enter your current progress accurately",
+	},
+	"INVALID_MONTHLY_CONTRIBUTION": {
+		Japanese: "月間積立額は0以上の値を入力してください",
+		English:  "Monthly contribution must be 0 or greater",
+	},
+	"INVALID_MONTHLY_CONTRIBUTION.suggestion": {
+		Japanese: "毎月積み立て可能な金額を入力してください",
+		English:  "Enter the amount you can save each month",
+	},
+	"GOAL_ALREADY_ACHIEVED": {
+		Japanese: "現在の金額が目標金額を上回っています",
+		English:  "Current amount exceeds the target amount",
+	},
+	"GOAL_ALREADY_ACHIEVED.suggestion": {
+		Japanese: "目標金額を見直すか、新しい目標を設定してください",
+		English:  "Review the target amount or set a new goal",
+	},
+	"MISSING_WEBHOOK_URL": {
+		Japanese: "Webhookの送信先URLは必須です",
+		English:  "Webhook destination URL is required",
+	},
+	"MISSING_WEBHOOK_URL.suggestion": {
+		Japanese: "通知を受け取るURLを指定してください",
+		English:  "Specify the URL that receives notifications",
+	},
+	"INVALID_PERIOD": {
+		Japanese: "期間の終了日は開始日より後である必要があります",
+		English:  "Period end date must be after the start date",
+	},
+	"INVALID_PERIOD.suggestion": {
+		Japanese: "開始日と終了日を確認してください",
+		English:  "Check the start and end dates",
+	},
+	"INVALID_PERIOD_LENGTH": {
+		Japanese: "期間は最長24ヶ月までです",
+		English:  "Period can be at most 24 months",
+	},
+	"INVALID_PERIOD_LENGTH.suggestion": {
+		Japanese: "1年単位など、見直しやすい期間を設定してください",
+		English:  "Set a period that is easy to review, such as one year",
+	},
+}
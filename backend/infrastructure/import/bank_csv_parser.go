@@ -0,0 +1,283 @@
+// Package importer は外部サービスが出力するファイルを取り込むためのパーサーを提供する
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxPreambleRows はヘッダー行を探す際に読み飛ばす前置き行の上限
+const maxPreambleRows = 20
+
+// bankTransactionDateLayouts は明細の日付として受け付ける書式
+var bankTransactionDateLayouts = []string{
+	"2006/1/2",
+	"2006-1-2",
+	"2006.1.2",
+	"2006年1月2日",
+	"20060102",
+}
+
+// bankCSVColumns は銀行CSVの各項目に対応するヘッダー名（別名を含む）
+type bankCSVColumns struct {
+	date         []string
+	descriptions [][]string // 複数列を連結して摘要とする
+	withdrawal   []string
+	deposit      []string
+	balance      []string
+}
+
+// columnBankCSVParser はヘッダー名で列を特定する銀行CSVパーサー
+type columnBankCSVParser struct {
+	columns bankCSVColumns
+}
+
+// NewMizuhoCSVParser はみずほ銀行の入出金明細CSV（日付,内容,出金金額,入金金額,残高）のパーサーを作成する
+func NewMizuhoCSVParser() ports.BankCSVParser {
+	return &columnBankCSVParser{
+		columns: bankCSVColumns{
+			date:         []string{"日付", "取引日"},
+			descriptions: [][]string{{"内容", "お取引内容", "摘要"}},
+			withdrawal:   []string{"出金金額", "お引出金額", "お支払金額"},
+			deposit:      []string{"入金金額", "お預入金額"},
+			balance:      []string{"残高", "差引残高"},
+		},
+	}
+}
+
+// NewMUFGCSVParser は三菱UFJ銀行の入出金明細CSV（日付,摘要,摘要内容,支払い金額,預かり金額,差引残高,...）のパーサーを作成する
+func NewMUFGCSVParser() ports.BankCSVParser {
+	return &columnBankCSVParser{
+		columns: bankCSVColumns{
+			date:         []string{"日付"},
+			descriptions: [][]string{{"摘要"}, {"摘要内容"}},
+			withdrawal:   []string{"支払い金額", "お支払金額"},
+			deposit:      []string{"預かり金額", "お預り金額"},
+			balance:      []string{"差引残高", "残高"},
+		},
+	}
+}
+
+// NewBankCSVParsers は対応する全書式のパーサーを返す
+func NewBankCSVParsers() map[ports.BankCSVFormat]ports.BankCSVParser {
+	return map[ports.BankCSVFormat]ports.BankCSVParser{
+		ports.BankCSVFormatMizuho: NewMizuhoCSVParser(),
+		ports.BankCSVFormatMUFG:   NewMUFGCSVParser(),
+	}
+}
+
+// columnIndexes はヘッダー行から特定した列番号
+type columnIndexes struct {
+	date         int
+	descriptions []int
+	withdrawal   int
+	deposit      int
+	balance      int
+}
+
+// Parse はCSVを読み込み、入出金明細の一覧を返す
+// 文字コードはUTF-8（BOM付きを含む）とShift_JISに対応する
+func (p *columnBankCSVParser) Parse(r io.Reader) ([]ports.BankTransaction, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("CSVの読み込みに失敗しました: %w", err)
+	}
+
+	data, err := decodeBankCSV(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("CSVの解析に失敗しました: %w", err)
+	}
+
+	headerRow, indexes, err := p.findHeader(records)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]ports.BankTransaction, 0, len(records)-headerRow-1)
+	for i := headerRow + 1; i < len(records); i++ {
+		record := records[i]
+		line := i + 1
+		if isBlankRecord(record) {
+			continue
+		}
+
+		transaction, err := p.parseRecord(record, indexes)
+		if err != nil {
+			return nil, fmt.Errorf("%d行目: %w", line, err)
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if len(transactions) == 0 {
+		return nil, errors.New("CSVに入出金明細が含まれていません")
+	}
+
+	return transactions, nil
+}
+
+// findHeader は前置き行を読み飛ばしてヘッダー行と列番号を特定する
+func (p *columnBankCSVParser) findHeader(records [][]string) (int, *columnIndexes, error) {
+	for row := 0; row < len(records) && row < maxPreambleRows; row++ {
+		header := make(map[string]int, len(records[row]))
+		for i, name := range records[row] {
+			header[strings.TrimSpace(name)] = i
+		}
+
+		indexes := &columnIndexes{
+			date:       lookupColumn(header, p.columns.date),
+			withdrawal: lookupColumn(header, p.columns.withdrawal),
+			deposit:    lookupColumn(header, p.columns.deposit),
+			balance:    lookupColumn(header, p.columns.balance),
+		}
+		if indexes.date < 0 || indexes.withdrawal < 0 || indexes.deposit < 0 {
+			continue
+		}
+		for _, aliases := range p.columns.descriptions {
+			if index := lookupColumn(header, aliases); index >= 0 {
+				indexes.descriptions = append(indexes.descriptions, index)
+			}
+		}
+		return row, indexes, nil
+	}
+
+	return 0, nil, errors.New("CSVのヘッダー行が見つかりません（書式を確認してください）")
+}
+
+// parseRecord は明細1行を解析する
+func (p *columnBankCSVParser) parseRecord(record []string, indexes *columnIndexes) (ports.BankTransaction, error) {
+	date, err := parseTransactionDate(field(record, indexes.date))
+	if err != nil {
+		return ports.BankTransaction{}, err
+	}
+
+	withdrawal, err := parseAmount(field(record, indexes.withdrawal))
+	if err != nil {
+		return ports.BankTransaction{}, fmt.Errorf("出金金額が不正です: %w", err)
+	}
+	deposit, err := parseAmount(field(record, indexes.deposit))
+	if err != nil {
+		return ports.BankTransaction{}, fmt.Errorf("入金金額が不正です: %w", err)
+	}
+	if withdrawal == 0 && deposit == 0 {
+		return ports.BankTransaction{}, errors.New("出金金額と入金金額のいずれかが必要です")
+	}
+
+	var balance float64
+	if indexes.balance >= 0 {
+		balance, err = parseAmount(field(record, indexes.balance))
+		if err != nil {
+			return ports.BankTransaction{}, fmt.Errorf("残高が不正です: %w", err)
+		}
+	}
+
+	descriptions := make([]string, 0, len(indexes.descriptions))
+	for _, index := range indexes.descriptions {
+		if value := normalizeDescription(field(record, index)); value != "" {
+			descriptions = append(descriptions, value)
+		}
+	}
+
+	return ports.BankTransaction{
+		Date:        date,
+		Description: strings.Join(descriptions, " "),
+		Withdrawal:  withdrawal,
+		Deposit:     deposit,
+		Balance:     balance,
+	}, nil
+}
+
+// decodeBankCSV はUTF-8でなければShift_JISとしてデコードし、BOMを取り除く
+func decodeBankCSV(raw []byte) ([]byte, error) {
+	if utf8.Valid(raw) {
+		return bytes.TrimPrefix(raw, []byte("\xef\xbb\xbf")), nil
+	}
+
+	decoded, _, err := transform.Bytes(japanese.ShiftJIS.NewDecoder(), raw)
+	if err != nil {
+		return nil, fmt.Errorf("CSVの文字コード変換に失敗しました: %w", err)
+	}
+	return decoded, nil
+}
+
+// parseTransactionDate は明細の日付を解析する
+func parseTransactionDate(value string) (time.Time, error) {
+	normalized := norm.NFKC.String(strings.TrimSpace(value))
+	for _, layout := range bankTransactionDateLayouts {
+		if date, err := time.Parse(layout, normalized); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("日付が不正です: %q", value)
+}
+
+// parseAmount は「1,234」「1,234円」などの金額表記を解析する（空欄は0）
+func parseAmount(value string) (float64, error) {
+	normalized := norm.NFKC.String(strings.TrimSpace(value))
+	normalized = strings.NewReplacer(",", "", "円", "", "¥", "", "\\", "", " ", "").Replace(normalized)
+	if normalized == "" {
+		return 0, nil
+	}
+
+	amount, err := strconv.ParseFloat(normalized, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q を数値に変換できません", value)
+	}
+	if amount < 0 {
+		return 0, fmt.Errorf("%q は負の値です", value)
+	}
+	return amount, nil
+}
+
+// normalizeDescription は摘要をNFKC正規化し、英数字を半角に、半角カナを全角に揃える
+func normalizeDescription(value string) string {
+	return strings.Join(strings.Fields(norm.NFKC.String(value)), " ")
+}
+
+// lookupColumn は別名のいずれかに一致する列番号を返す（見つからない場合は-1）
+func lookupColumn(header map[string]int, aliases []string) int {
+	for _, alias := range aliases {
+		if index, ok := header[alias]; ok {
+			return index
+		}
+	}
+	return -1
+}
+
+// field は列番号の値を返す（列が存在しない場合は空文字）
+func field(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return record[index]
+}
+
+// isBlankRecord は空行かを判定する
+func isBlankRecord(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+// sampleMizuhoCSV はみずほ銀行の入出金明細CSVのサンプル
+const sampleMizuhoCSV = `"入出金明細照会"
+"口座","普通 1234567"
+日付,内容,出金金額,入金金額,残高
+2024/04/01,給与　ｶﾌﾞｼｷｶﾞｲｼﾔ,,"300,000","1,300,000"
+2024/04/05,家賃 4月分,"85,000",,"1,215,000"
+2024/04/10,スーパー　まるや,"4,320",,"1,210,680"
+2024/04/15,電気料金,"6,800",,"1,203,880"
+
+`
+
+// sampleMUFGCSV は三菱UFJ銀行の入出金明細CSVのサンプル
+const sampleMUFGCSV = `"日付","摘要","摘要内容","支払い金額","預かり金額","差引残高","メモ","未資金化区分","入払区分"
+"2024/4/1","振込","ｷﾕｳﾖ","","250,000","750,000","","",""
+"2024/4/25","カード","コンビニ　ＡＢＣ","1,200","","748,800","","",""
+`
+
+func TestMizuhoCSVParser_Parse(t *testing.T) {
+	parser := NewMizuhoCSVParser()
+
+	t.Run("UTF-8のCSVを解析できる", func(t *testing.T) {
+		transactions, err := parser.Parse(strings.NewReader(sampleMizuhoCSV))
+		require.NoError(t, err)
+		require.Len(t, transactions, 4)
+
+		assert.Equal(t, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), transactions[0].Date)
+		assert.Equal(t, "給与 カブシキガイシヤ", transactions[0].Description)
+		assert.Equal(t, 300000.0, transactions[0].Deposit)
+		assert.False(t, transactions[0].IsWithdrawal())
+
+		assert.Equal(t, "家賃 4月分", transactions[1].Description)
+		assert.Equal(t, 85000.0, transactions[1].Withdrawal)
+		assert.Equal(t, 1215000.0, transactions[1].Balance)
+		assert.True(t, transactions[1].IsWithdrawal())
+	})
+
+	t.Run("Shift_JISのCSVを解析できる", func(t *testing.T) {
+		encoded, _, err := transform.String(japanese.ShiftJIS.NewEncoder(), sampleMizuhoCSV)
+		require.NoError(t, err)
+
+		transactions, err := parser.Parse(strings.NewReader(encoded))
+		require.NoError(t, err)
+		require.Len(t, transactions, 4)
+		assert.Equal(t, "スーパー まるや", transactions[2].Description)
+		assert.Equal(t, 4320.0, transactions[2].Withdrawal)
+	})
+
+	t.Run("BOM付きのCSVを解析できる", func(t *testing.T) {
+		transactions, err := parser.Parse(strings.NewReader("\ufeff" + sampleMizuhoCSV))
+		require.NoError(t, err)
+		assert.Len(t, transactions, 4)
+	})
+
+	t.Run("ヘッダー行がない場合はエラー", func(t *testing.T) {
+		_, err := parser.Parse(strings.NewReader("2024/04/01,給与,,300000,1300000\n"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ヘッダー行")
+	})
+
+	t.Run("金額が不正な場合は行番号を含むエラー", func(t *testing.T) {
+		csv := "日付,内容,出金金額,入金金額,残高\n2024/04/01,家賃,abc,,1000\n"
+		_, err := parser.Parse(strings.NewReader(csv))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "2行目")
+		assert.Contains(t, err.Error(), "出金金額")
+	})
+
+	t.Run("日付が不正な場合はエラー", func(t *testing.T) {
+		csv := "日付,内容,出金金額,入金金額,残高\n4月1日,家賃,1000,,1000\n"
+		_, err := parser.Parse(strings.NewReader(csv))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "日付")
+	})
+
+	t.Run("明細が空の場合はエラー", func(t *testing.T) {
+		_, err := parser.Parse(strings.NewReader("日付,内容,出金金額,入金金額,残高\n"))
+		require.Error(t, err)
+	})
+}
+
+func TestMUFGCSVParser_Parse(t *testing.T) {
+	transactions, err := NewMUFGCSVParser().Parse(strings.NewReader(sampleMUFGCSV))
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+
+	assert.Equal(t, "振込 キユウヨ", transactions[0].Description)
+	assert.Equal(t, 250000.0, transactions[0].Deposit)
+	assert.Equal(t, "カード コンビニ ABC", transactions[1].Description)
+	assert.Equal(t, 1200.0, transactions[1].Withdrawal)
+	assert.Equal(t, 748800.0, transactions[1].Balance)
+}
+
+func TestNewBankCSVParsers(t *testing.T) {
+	parsers := NewBankCSVParsers()
+	assert.Len(t, parsers, 2)
+}
@@ -0,0 +1,226 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+const (
+	// maxAdviceSummaryRunes はプロンプトに埋め込む財務サマリーの上限文字数（約500トークン）
+	maxAdviceSummaryRunes = 1000
+	// maxAdviceSummaryItems はプロンプトに含める推奨事項・警告の件数の上限
+	maxAdviceSummaryItems = 3
+	// adviceRequestTimeout はLLM APIへのリクエストのタイムアウト
+	// サーバーのリクエストタイムアウト（既定30秒）より前にフォールバックの回答を返せるよう短めにする
+	adviceRequestTimeout = 25 * time.Second
+	// maxAdviceResponseBytes はLLM APIのレスポンスとして読み込む上限サイズ
+	maxAdviceResponseBytes = 1 << 20
+)
+
+// FinancialSummaryGenerator は財務サマリーレポートを生成するインタフェース（usecases.GenerateReportsUseCase が満たす）
+type FinancialSummaryGenerator interface {
+	GenerateFinancialSummaryReport(ctx context.Context, input usecases.FinancialSummaryReportInput) (*usecases.FinancialSummaryReportOutput, error)
+}
+
+// LLMAdvisoryService はユーザーの財務サマリーをプロンプトに埋め込み、LLMからアドバイスを取得するports.FinancialAdvisoryServiceの実装
+// エンドポイントはOpenAI互換のチャット補完API（非ストリーム）とする
+type LLMAdvisoryService struct {
+	summaries  FinancialSummaryGenerator
+	endpoint   string
+	apiKey     string
+	model      string
+	fallback   ports.FinancialAdvisoryService
+	httpClient *http.Client
+}
+
+// chatCompletionRequest はチャット補完APIのリクエストの構造体
+type chatCompletionRequest struct {
+	Model    string        `json:"model,omitempty"`
+	Messages []groqMessage `json:"messages"`
+	Stream   bool          `json:"stream"`
+}
+
+// chatCompletionResponse はチャット補完APIのレスポンスの構造体
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message groqMessage `json:"message"`
+	} `json:"choices"`
+}
+
+// NewLLMAdvisoryService はLLMを使った財務アドバイスサービスを生成する
+// fallback を指定した場合、LLM APIの呼び出しに失敗したときはそのアドバイスを返す
+func NewLLMAdvisoryService(
+	summaries FinancialSummaryGenerator,
+	endpoint, apiKey, model string,
+	fallback ports.FinancialAdvisoryService,
+) *LLMAdvisoryService {
+	return &LLMAdvisoryService{
+		summaries:  summaries,
+		endpoint:   endpoint,
+		apiKey:     apiKey,
+		model:      model,
+		fallback:   fallback,
+		httpClient: &http.Client{Timeout: adviceRequestTimeout},
+	}
+}
+
+// GetAdvice はユーザーの財務サマリーと相談内容からプロンプトを構築し、LLMの回答を返す
+func (s *LLMAdvisoryService) GetAdvice(ctx context.Context, userID, message string) (string, error) {
+	if strings.TrimSpace(message) == "" {
+		return "", errors.New("相談内容が空です")
+	}
+
+	systemPrompt := buildAdviceSystemPrompt(s.financialSummary(ctx, userID))
+
+	advice, err := s.complete(ctx, systemPrompt, message)
+	if err != nil {
+		if s.fallback == nil {
+			return "", err
+		}
+		slog.Warn("LLMからのアドバイスの取得に失敗したため定型のアドバイスを返します", slog.Any("error", err))
+		return s.fallback.GetAdvice(ctx, userID, message)
+	}
+
+	return advice, nil
+}
+
+// financialSummary はプロンプトに埋め込む財務サマリーを返す
+// 財務データが未登録などでサマリーを生成できない場合は、一般的なアドバイスを求めるよう空文字を返す
+func (s *LLMAdvisoryService) financialSummary(ctx context.Context, userID string) string {
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ""
+	}
+
+	output, err := s.summaries.GenerateFinancialSummaryReport(ctx, usecases.FinancialSummaryReportInput{UserID: uid})
+	if err != nil {
+		slog.Warn("財務サマリーを生成できないため、財務状況を含めずにアドバイスを求めます",
+			slog.String("user_id", userID),
+			slog.Any("error", err),
+		)
+		return ""
+	}
+
+	return condenseFinancialSummary(&output.Report)
+}
+
+// complete はチャット補完APIにリクエストを送り、回答の本文を返す
+func (s *LLMAdvisoryService) complete(ctx context.Context, systemPrompt, message string) (string, error) {
+	bodyBytes, err := json.Marshal(chatCompletionRequest{
+		Model: s.model,
+		Messages: []groqMessage{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: message},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("リクエストのエンコードに失敗しました: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return "", fmt.Errorf("HTTPリクエストの作成に失敗しました: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("LLM APIサーバーへの接続に失敗しました: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("LLM APIサーバーがエラーを返しました: status=%d", resp.StatusCode)
+	}
+
+	var completion chatCompletionResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxAdviceResponseBytes)).Decode(&completion); err != nil {
+		return "", fmt.Errorf("レスポンスのデコードに失敗しました: %w", err)
+	}
+	if len(completion.Choices) == 0 || strings.TrimSpace(completion.Choices[0].Message.Content) == "" {
+		return "", errors.New("LLM APIサーバーの回答が空です")
+	}
+
+	return strings.TrimSpace(completion.Choices[0].Message.Content), nil
+}
+
+// buildAdviceSystemPrompt は財務サマリーを埋め込んだシステムプロンプトを構築する
+func buildAdviceSystemPrompt(summary string) string {
+	var sb strings.Builder
+
+	sb.WriteString("あなたはFinPlan（財務計画計算機）のファイナンシャルアドバイザーです。")
+	sb.WriteString("ユーザーの財務状況を踏まえ、日本の制度（NISA・iDeCo・公的年金など）を前提に、具体的で実行しやすいアドバイスを日本語で簡潔に回答してください。")
+	sb.WriteString("特定の金融商品の購入を勧めることはせず、必要に応じて専門家への相談を促してください。\n\n")
+
+	if summary == "" {
+		sb.WriteString("ユーザーの財務データは未登録です。一般的なアドバイスを回答し、財務データを登録するとより具体的な助言ができることを伝えてください。")
+		return sb.String()
+	}
+
+	sb.WriteString("## ユーザーの財務状況\n")
+	sb.WriteString(summary)
+
+	return sb.String()
+}
+
+// condenseFinancialSummary は財務サマリーレポートをプロンプト用の短い箇条書きに要約する
+// 上限（約500トークン）を超える場合は末尾を切り詰める
+func condenseFinancialSummary(report *usecases.FinancialSummaryReport) string {
+	var sb strings.Builder
+
+	health := report.FinancialHealth
+	situation := report.CurrentSituation
+
+	fmt.Fprintf(&sb, "- 財務健全性スコア: %d/100（%s）\n", health.OverallScore, health.ScoreLevel)
+	fmt.Fprintf(&sb, "- 月収: %.0f円（手取り %.0f円）\n", situation.MonthlyIncome, situation.NetMonthlyIncome)
+	fmt.Fprintf(&sb, "- 月間支出: %.0f円\n", situation.MonthlyExpenses)
+	fmt.Fprintf(&sb, "- 月間純貯蓄: %.0f円（貯蓄率 %.1f%%）\n", situation.NetSavings, health.SavingsRate)
+	fmt.Fprintf(&sb, "- 総資産: %.0f円\n", situation.TotalAssets)
+	fmt.Fprintf(&sb, "- 緊急資金: 月間支出の%.1fヶ月分\n", health.EmergencyFundRatio)
+	fmt.Fprintf(&sb, "- 想定利回り: 年%.1f%% / インフレ率: 年%.1f%%\n", situation.InvestmentReturn, situation.InflationRate)
+	if report.SavingsRateTarget != nil {
+		fmt.Fprintf(&sb, "- 貯蓄率目標: %.1f%%\n", report.SavingsRateTarget.TargetPercent)
+	}
+	writeSummaryItems(&sb, "警告", report.Warnings)
+	writeSummaryItems(&sb, "推奨事項", report.Recommendations)
+
+	return truncateRunes(sb.String(), maxAdviceSummaryRunes)
+}
+
+// writeSummaryItems は推奨事項・警告を上限件数まで書き出す
+func writeSummaryItems(sb *strings.Builder, label string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	if len(items) > maxAdviceSummaryItems {
+		items = items[:maxAdviceSummaryItems]
+	}
+	fmt.Fprintf(sb, "- %s:\n", label)
+	for _, item := range items {
+		fmt.Fprintf(sb, "  - %s\n", item)
+	}
+}
+
+// truncateRunes は文字列を指定した文字数までに切り詰める
+func truncateRunes(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit]) + "…"
+}
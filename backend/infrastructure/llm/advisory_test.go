@@ -0,0 +1,224 @@
+package llm_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/infrastructure/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// テストヘルパー
+// ===========================
+
+const advisoryTestUserID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+// stubFinancialSummaryGenerator は固定の財務サマリーを返すFinancialSummaryGenerator
+type stubFinancialSummaryGenerator struct {
+	report usecases.FinancialSummaryReport
+	err    error
+	userID string // 呼び出されたユーザーID
+}
+
+func (s *stubFinancialSummaryGenerator) GenerateFinancialSummaryReport(_ context.Context, input usecases.FinancialSummaryReportInput) (*usecases.FinancialSummaryReportOutput, error) {
+	s.userID = string(input.UserID)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &usecases.FinancialSummaryReportOutput{Report: s.report}, nil
+}
+
+// capturedChatRequest はモックサーバーが受け取ったチャット補完リクエスト
+type capturedChatRequest struct {
+	Authorization string
+	Model         string `json:"model"`
+	Messages      []struct {
+		Role    string `json:"role"`
+		Content string `json:"content"`
+	} `json:"messages"`
+}
+
+// setupChatCompletionServer はチャット補完APIのモックサーバーを立ち上げ、受け取ったリクエストを記録する
+func setupChatCompletionServer(t *testing.T, status int, reply string) (*httptest.Server, *capturedChatRequest) {
+	t.Helper()
+	captured := &capturedChatRequest{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured.Authorization = r.Header.Get("Authorization")
+		require.NoError(t, json.NewDecoder(r.Body).Decode(captured))
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]string{"role": "assistant", "content": reply}},
+			},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, captured
+}
+
+// newTestFinancialSummaryReport はテスト用の財務サマリーレポートを作成する
+func newTestFinancialSummaryReport() usecases.FinancialSummaryReport {
+	return usecases.FinancialSummaryReport{
+		FinancialHealth: usecases.FinancialHealth{
+			OverallScore:       62,
+			ScoreLevel:         "fair",
+			SavingsRate:        12.5,
+			EmergencyFundRatio: 2.5,
+		},
+		CurrentSituation: usecases.CurrentSituation{
+			MonthlyIncome:    400000,
+			NetMonthlyIncome: 320000,
+			MonthlyExpenses:  280000,
+			NetSavings:       40000,
+			TotalAssets:      3500000,
+			InvestmentReturn: 4,
+			InflationRate:    2,
+		},
+		Warnings:        []string{"緊急資金が不足しています"},
+		Recommendations: []string{"固定費を見直しましょう", "積立投資を検討しましょう"},
+	}
+}
+
+// ===========================
+// LLMAdvisoryService.GetAdvice Tests
+// ===========================
+
+func TestLLMAdvisoryService_GetAdvice(t *testing.T) {
+	t.Run("正常系: 財務サマリーをプロンプトに埋め込み、LLMの回答を返す", func(t *testing.T) {
+		srv, captured := setupChatCompletionServer(t, http.StatusOK, "  まずは緊急資金を月間支出の6ヶ月分まで積み立てましょう。  ")
+		summaries := &stubFinancialSummaryGenerator{report: newTestFinancialSummaryReport()}
+		service := llm.NewLLMAdvisoryService(summaries, srv.URL, "test-api-key", "test-model", nil)
+
+		advice, err := service.GetAdvice(context.Background(), advisoryTestUserID, "月間貯蓄を増やすにはどうすればいいですか?")
+
+		require.NoError(t, err)
+		assert.Equal(t, "まずは緊急資金を月間支出の6ヶ月分まで積み立てましょう。", advice)
+		assert.Equal(t, advisoryTestUserID, summaries.userID)
+		assert.Equal(t, "Bearer test-api-key", captured.Authorization)
+		assert.Equal(t, "test-model", captured.Model)
+
+		require.Len(t, captured.Messages, 2)
+		systemPrompt := captured.Messages[0].Content
+		assert.Equal(t, "system", captured.Messages[0].Role)
+		for _, expected := range []string{
+			"財務健全性スコア: 62/100（fair）",
+			"月収: 400000円（手取り 320000円）",
+			"月間支出: 280000円",
+			"月間純貯蓄: 40000円（貯蓄率 12.5%）",
+			"総資産: 3500000円",
+			"緊急資金: 月間支出の2.5ヶ月分",
+			"緊急資金が不足しています",
+			"固定費を見直しましょう",
+		} {
+			assert.Contains(t, systemPrompt, expected)
+		}
+		assert.Equal(t, "user", captured.Messages[1].Role)
+		assert.Equal(t, "月間貯蓄を増やすにはどうすればいいですか?", captured.Messages[1].Content)
+	})
+
+	t.Run("正常系: 財務サマリーは約500トークンに収まるよう切り詰める", func(t *testing.T) {
+		srv, captured := setupChatCompletionServer(t, http.StatusOK, "回答")
+		report := newTestFinancialSummaryReport()
+		report.Recommendations = []string{strings.Repeat("長い推奨事項", 200), "2件目", "3件目", "4件目"}
+		service := llm.NewLLMAdvisoryService(&stubFinancialSummaryGenerator{report: report}, srv.URL, "", "", nil)
+
+		_, err := service.GetAdvice(context.Background(), advisoryTestUserID, "老後資金は足りますか")
+
+		require.NoError(t, err)
+		systemPrompt := captured.Messages[0].Content
+		summary := systemPrompt[strings.Index(systemPrompt, "## ユーザーの財務状況"):]
+		assert.LessOrEqual(t, len([]rune(summary)), 1100)
+		assert.NotContains(t, systemPrompt, "4件目")
+		assert.Empty(t, captured.Authorization, "APIキーが未設定の場合は認証ヘッダーを送らない")
+	})
+
+	t.Run("正常系: 財務サマリーを生成できない場合は財務状況なしで相談する", func(t *testing.T) {
+		srv, captured := setupChatCompletionServer(t, http.StatusOK, "一般的なアドバイスです")
+		summaries := &stubFinancialSummaryGenerator{err: errors.New("財務計画が見つかりません")}
+		service := llm.NewLLMAdvisoryService(summaries, srv.URL, "test-api-key", "", nil)
+
+		advice, err := service.GetAdvice(context.Background(), advisoryTestUserID, "投資を始めたい")
+
+		require.NoError(t, err)
+		assert.Equal(t, "一般的なアドバイスです", advice)
+		assert.Contains(t, captured.Messages[0].Content, "財務データは未登録です")
+		assert.NotContains(t, captured.Messages[0].Content, "## ユーザーの財務状況")
+	})
+
+	t.Run("異常系: LLM APIがエラーを返した場合はエラー", func(t *testing.T) {
+		srv, _ := setupChatCompletionServer(t, http.StatusInternalServerError, "")
+		service := llm.NewLLMAdvisoryService(&stubFinancialSummaryGenerator{report: newTestFinancialSummaryReport()}, srv.URL, "test-api-key", "", nil)
+
+		_, err := service.GetAdvice(context.Background(), advisoryTestUserID, "投資を始めたい")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status=500")
+	})
+
+	t.Run("正常系: LLM APIがエラーを返した場合はフォールバックのアドバイスを返す", func(t *testing.T) {
+		srv, _ := setupChatCompletionServer(t, http.StatusServiceUnavailable, "")
+		fallback := llm.NewStaticAdvisoryService()
+		service := llm.NewLLMAdvisoryService(&stubFinancialSummaryGenerator{report: newTestFinancialSummaryReport()}, srv.URL, "test-api-key", "", fallback)
+
+		advice, err := service.GetAdvice(context.Background(), advisoryTestUserID, "NISAで投資を始めたい")
+
+		require.NoError(t, err)
+		expected, _ := fallback.GetAdvice(context.Background(), advisoryTestUserID, "NISAで投資を始めたい")
+		assert.Equal(t, expected, advice)
+	})
+
+	t.Run("異常系: 相談内容が空の場合はエラー", func(t *testing.T) {
+		service := llm.NewLLMAdvisoryService(&stubFinancialSummaryGenerator{}, "http://127.0.0.1:0", "", "", nil)
+
+		_, err := service.GetAdvice(context.Background(), advisoryTestUserID, "  ")
+
+		require.Error(t, err)
+	})
+}
+
+// ===========================
+// StaticAdvisoryService.GetAdvice Tests
+// ===========================
+
+func TestStaticAdvisoryService_GetAdvice(t *testing.T) {
+	service := llm.NewStaticAdvisoryService()
+
+	tests := []struct {
+		name     string
+		message  string
+		contains string
+	}{
+		{"貯蓄の相談", "月間貯蓄を増やすにはどうすればいいですか?", "固定費"},
+		{"老後の相談", "老後資金はいくら必要ですか", "公的年金"},
+		{"投資の相談（英字は大文字小文字を区別しない）", "nisaとidecoの違いは？", "非課税制度"},
+		{"緊急資金の相談", "生活防衛資金はどれくらい？", "3〜6ヶ月分"},
+		{"返済の相談", "カードローンの返済を優先すべき？", "金利の高い借入"},
+		{"該当なし", "こんにちは", "財務サマリー"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			advice, err := service.GetAdvice(context.Background(), advisoryTestUserID, tt.message)
+
+			require.NoError(t, err)
+			assert.Contains(t, advice, tt.contains)
+		})
+	}
+
+	t.Run("異常系: 相談内容が空の場合はエラー", func(t *testing.T) {
+		_, err := service.GetAdvice(context.Background(), advisoryTestUserID, "")
+		require.Error(t, err)
+	})
+}
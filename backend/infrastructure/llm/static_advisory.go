@@ -0,0 +1,67 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// staticAdvice はキーワードに対応する定型のアドバイス
+type staticAdvice struct {
+	keywords []string
+	advice   string
+}
+
+// staticAdvices は相談内容のキーワードと定型のアドバイスの一覧（先に一致したものを返す）
+var staticAdvices = []staticAdvice{
+	{
+		keywords: []string{"緊急", "生活防衛", "もしも"},
+		advice:   "緊急資金は月間支出の3〜6ヶ月分を目安に、すぐに引き出せる普通預金などで確保しましょう。目標額に届くまでは投資よりも緊急資金の積立を優先するのがおすすめです。",
+	},
+	{
+		keywords: []string{"老後", "年金", "退職", "リタイア"},
+		advice:   "老後資金は、退職後の生活費から公的年金で賄える額を差し引いた不足分を目安に準備します。退職資金計算で充足率を確認し、不足する場合は毎月の積立額の増額や退職時期の見直しを検討しましょう。",
+	},
+	{
+		keywords: []string{"投資", "NISA", "iDeCo", "運用", "資産形成"},
+		advice:   "長期の資産形成には、NISAやiDeCoなどの非課税制度を活用した積立投資が有効です。緊急資金を確保したうえで、値動きに耐えられる範囲のリスクで分散投資を続けましょう。",
+	},
+	{
+		keywords: []string{"ローン", "借金", "返済", "カード"},
+		advice:   "金利の高い借入から優先して返済しましょう。リボ払いやカードローンは金利負担が大きいため、貯蓄より返済を優先すると総支払額を抑えられます。",
+	},
+	{
+		keywords: []string{"貯蓄", "貯金", "節約", "支出", "家計"},
+		advice:   "月間貯蓄を増やすには、まず住居費・通信費・保険料などの固定費を見直すのが効果的です。給与が入ったらすぐに一定額を積み立てる「先取り貯蓄」にすると、無理なく貯蓄率を高められます。",
+	},
+}
+
+// defaultStaticAdvice はどのキーワードにも一致しない場合のアドバイス
+const defaultStaticAdvice = "まずは収入・支出・貯蓄を登録して、財務サマリーで貯蓄率と緊急資金の状況を確認しましょう。貯蓄率20%・緊急資金は月間支出の6ヶ月分が一つの目安です。"
+
+// StaticAdvisoryService は相談内容のキーワードに応じて定型のアドバイスを返すports.FinancialAdvisoryServiceの実装
+// LLMを利用できない環境やテストでのフォールバックとして使用する
+type StaticAdvisoryService struct{}
+
+// NewStaticAdvisoryService は定型のアドバイスを返すサービスを生成する
+func NewStaticAdvisoryService() *StaticAdvisoryService {
+	return &StaticAdvisoryService{}
+}
+
+// GetAdvice は相談内容に含まれるキーワードに対応する定型のアドバイスを返す
+func (s *StaticAdvisoryService) GetAdvice(_ context.Context, _ string, message string) (string, error) {
+	if strings.TrimSpace(message) == "" {
+		return "", errors.New("相談内容が空です")
+	}
+
+	normalized := strings.ToLower(message)
+	for _, candidate := range staticAdvices {
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(normalized, strings.ToLower(keyword)) {
+				return candidate.advice, nil
+			}
+		}
+	}
+
+	return defaultStaticAdvice, nil
+}
@@ -33,7 +33,12 @@ func init() {
 		// ソースコードの位置情報を追加
 		AddSource: true,
 	}
-	logger = slog.New(slog.NewJSONHandler(os.Stdout, opts))
+	var handler slog.Handler = slog.NewJSONHandler(os.Stdout, opts)
+	// リリースモードではメールアドレス・トークンを伏せ字にする
+	if maskingEnabled {
+		handler = NewRedactingHandler(handler)
+	}
+	logger = slog.New(handler)
 }
 
 // getLogLevel は環境変数からログレベルを取得します
@@ -71,7 +76,7 @@ func WithContext(ctx context.Context) *slog.Logger {
 	}
 
 	if userID, ok := ctx.Value(UserIDKey).(string); ok && userID != "" {
-		l = l.With(slog.String("user_id", userID))
+		l = l.With(UserID(userID))
 	}
 
 	if operation, ok := ctx.Value(OperationKey).(string); ok && operation != "" {
@@ -0,0 +1,133 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// PIIGroup は個人を特定できる値をまとめるログのグループ名
+// 下流のログフィルタはこのグループを丸ごと除外できる
+const PIIGroup = "pii"
+
+var (
+	emailPattern        = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	jwtPattern          = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]*\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+`)
+	refreshTokenPattern = regexp.MustCompile(`\b[0-9a-f]{64}\b`)
+)
+
+// maskingEnabled はログの個人情報をマスクするかどうか
+var maskingEnabled = isMaskingEnvironment()
+
+// isMaskingEnvironment はリリースモード（GIN_MODE=release）または本番環境（APP_ENV/ENV=production）かを返す
+// 開発環境ではデバッグしやすいようマスクせずに出力する
+func isMaskingEnvironment() bool {
+	return os.Getenv("GIN_MODE") == "release" ||
+		os.Getenv("APP_ENV") == "production" ||
+		os.Getenv("ENV") == "production"
+}
+
+// MaskingEnabled はログの個人情報をマスクしているかを返します
+func MaskingEnabled() bool {
+	return maskingEnabled
+}
+
+// MaskEmail はメールアドレスをローカル部の先頭1文字だけ残してマスクします（例: k***@example.com）
+func MaskEmail(email string) string {
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return "***"
+	}
+	_, size := utf8.DecodeRuneInString(local)
+	return local[:size] + "***@" + domain
+}
+
+// Email はメールアドレスのログ属性を返します（マスクが有効な場合はマスクした値）
+func Email(email string) slog.Attr {
+	if maskingEnabled {
+		email = MaskEmail(email)
+	}
+	return slog.String("email", email)
+}
+
+// UserID はユーザーIDのログ属性を返します
+// ユーザーIDはそのまま残し、下流のフィルタで除外できるよう PIIGroup にまとめます
+func UserID(userID string) slog.Attr {
+	return slog.Group(PIIGroup, slog.String("user_id", userID))
+}
+
+// ForUseCase はコンテキストの情報とユースケース名を付与したロガーを返します
+func ForUseCase(ctx context.Context, usecase string) *slog.Logger {
+	return WithContext(ctx).With(slog.String("usecase", usecase))
+}
+
+// RedactingHandler はメールアドレス・JWT・リフレッシュトークンに一致する値を伏せ字にする slog.Handler
+// 呼び出し側で Email などを使い忘れた場合の安全策として、すべてのログの値とメッセージに適用する
+type RedactingHandler struct {
+	next slog.Handler
+}
+
+// NewRedactingHandler は next に渡す前にログを伏せ字にするハンドラーを作成します
+func NewRedactingHandler(next slog.Handler) *RedactingHandler {
+	return &RedactingHandler{next: next}
+}
+
+// Enabled は next のログレベル設定に従います
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle はメッセージと属性を伏せ字にして next に渡します
+func (h *RedactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, redactString(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(redactAttr(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+// WithAttrs は属性を伏せ字にしてから next に追加します
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = redactAttr(attr)
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(redacted)}
+}
+
+// WithGroup はグループを next に追加します
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name)}
+}
+
+// redactAttr は属性の値を伏せ字にします（グループは再帰的に処理する）
+func redactAttr(attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+	switch value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, redactString(value.String()))
+	case slog.KindGroup:
+		group := value.Group()
+		redacted := make([]any, len(group))
+		for i, a := range group {
+			redacted[i] = redactAttr(a)
+		}
+		return slog.Group(attr.Key, redacted...)
+	case slog.KindAny:
+		if err, ok := value.Any().(error); ok {
+			return slog.String(attr.Key, redactString(err.Error()))
+		}
+	}
+	return slog.Attr{Key: attr.Key, Value: value}
+}
+
+// redactString は文字列に含まれるメールアドレス・JWT・リフレッシュトークンを伏せ字にします
+func redactString(s string) string {
+	s = jwtPattern.ReplaceAllString(s, "[REDACTED_JWT]")
+	s = refreshTokenPattern.ReplaceAllString(s, "[REDACTED_TOKEN]")
+	return emailPattern.ReplaceAllStringFunc(s, MaskEmail)
+}
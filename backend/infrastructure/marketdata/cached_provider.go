@@ -0,0 +1,63 @@
+package marketdata
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+)
+
+// DefaultMarketContextCacheTTL は市場概況をキャッシュする期間
+const DefaultMarketContextCacheTTL = 24 * time.Hour
+
+// cachedMarketContextProvider は取得した市場概況をメモリ上にキャッシュするports.MarketContextProviderの実装
+type cachedMarketContextProvider struct {
+	provider ports.MarketContextProvider
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu       sync.Mutex
+	cached   *ports.MarketContext
+	cachedAt time.Time
+}
+
+// NewCachedMarketContextProvider はプロバイダーを24時間のメモリキャッシュでラップする
+func NewCachedMarketContextProvider(provider ports.MarketContextProvider) ports.MarketContextProvider {
+	return newCachedMarketContextProvider(provider, DefaultMarketContextCacheTTL, time.Now)
+}
+
+func newCachedMarketContextProvider(
+	provider ports.MarketContextProvider,
+	ttl time.Duration,
+	now func() time.Time,
+) *cachedMarketContextProvider {
+	return &cachedMarketContextProvider{
+		provider: provider,
+		ttl:      ttl,
+		now:      now,
+	}
+}
+
+// GetLatestContext はキャッシュが有効な間はキャッシュした市場概況を返し、期限切れの場合は再取得する
+// 取得に失敗した場合はキャッシュを更新せずエラーを返す
+func (p *cachedMarketContextProvider) GetLatestContext(ctx context.Context) (*ports.MarketContext, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.cached != nil && now.Sub(p.cachedAt) < p.ttl {
+		marketContext := *p.cached
+		return &marketContext, nil
+	}
+
+	marketContext, err := p.provider.GetLatestContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cached := *marketContext
+	p.cached = &cached
+	p.cachedAt = now
+	return marketContext, nil
+}
@@ -0,0 +1,73 @@
+package marketdata
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+)
+
+// countingProvider は呼び出し回数を記録するテスト用のプロバイダー
+type countingProvider struct {
+	calls int
+	err   error
+}
+
+func (p *countingProvider) GetLatestContext(ctx context.Context) (*ports.MarketContext, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &ports.MarketContext{NikkeiReturn30Day: float64(p.calls)}, nil
+}
+
+func TestCachedMarketContextProvider_CachesWithinTTL(t *testing.T) {
+	underlying := &countingProvider{}
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	provider := newCachedMarketContextProvider(underlying, DefaultMarketContextCacheTTL, func() time.Time { return now })
+
+	for i := 0; i < 3; i++ {
+		if _, err := provider.GetLatestContext(context.Background()); err != nil {
+			t.Fatalf("予期しないエラー: %v", err)
+		}
+		now = now.Add(8 * time.Hour)
+	}
+	if underlying.calls != 1 {
+		t.Errorf("TTL内はプロバイダーを1回だけ呼び出すべきです: got %d", underlying.calls)
+	}
+
+	// 最初の取得から24時間経過したら再取得する
+	marketContext, err := provider.GetLatestContext(context.Background())
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if underlying.calls != 2 || marketContext.NikkeiReturn30Day != 2 {
+		t.Errorf("TTL経過後は再取得するべきです: calls %d, nikkei %v", underlying.calls, marketContext.NikkeiReturn30Day)
+	}
+}
+
+func TestCachedMarketContextProvider_DoesNotCacheErrors(t *testing.T) {
+	underlying := &countingProvider{err: errors.New("feed unavailable")}
+	provider := newCachedMarketContextProvider(underlying, DefaultMarketContextCacheTTL, time.Now)
+
+	for i := 0; i < 2; i++ {
+		if _, err := provider.GetLatestContext(context.Background()); err == nil {
+			t.Fatal("プロバイダーのエラーを返すべきです")
+		}
+	}
+	if underlying.calls != 2 {
+		t.Errorf("エラーはキャッシュせず再取得するべきです: got %d", underlying.calls)
+	}
+}
+
+func TestStaticMarketContextProvider(t *testing.T) {
+	marketContext, err := NewStaticMarketContextProvider().GetLatestContext(context.Background())
+	if err != nil {
+		t.Fatalf("予期しないエラー: %v", err)
+	}
+	if marketContext.NikkeiReturn30Day != lastKnownNikkeiReturn30Day || marketContext.FetchedAt.IsZero() {
+		t.Errorf("既定の市場概況が返されていません: %+v", marketContext)
+	}
+}
@@ -0,0 +1,45 @@
+package marketdata
+
+import (
+	"context"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+)
+
+// 最後に確認した市場概況（外部のニュースフィードと連携するまでの既定値）
+const (
+	lastKnownNikkeiReturn30Day = 2.3
+	lastKnownJGBYield10Year    = 1.5
+	lastKnownCPI               = 2.9
+)
+
+// lastKnownFetchedAt は既定値を確認した日時
+var lastKnownFetchedAt = time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC)
+
+// staticMarketContextProvider は固定の市場概況を返すports.MarketContextProviderの実装
+type staticMarketContextProvider struct {
+	context ports.MarketContext
+}
+
+// NewStaticMarketContextProvider は最後に確認した市場概況を返すプロバイダーを作成する
+// テストや外部フィードを利用できない場合のフォールバックとして使用する
+func NewStaticMarketContextProvider() ports.MarketContextProvider {
+	return NewStaticMarketContextProviderWith(ports.MarketContext{
+		NikkeiReturn30Day: lastKnownNikkeiReturn30Day,
+		JGBYield10Year:    lastKnownJGBYield10Year,
+		CPI:               lastKnownCPI,
+		FetchedAt:         lastKnownFetchedAt,
+	})
+}
+
+// NewStaticMarketContextProviderWith は指定した市場概況を返すプロバイダーを作成する
+func NewStaticMarketContextProviderWith(marketContext ports.MarketContext) ports.MarketContextProvider {
+	return &staticMarketContextProvider{context: marketContext}
+}
+
+// GetLatestContext は固定の市場概況を返す
+func (p *staticMarketContextProvider) GetLatestContext(ctx context.Context) (*ports.MarketContext, error) {
+	marketContext := p.context
+	return &marketContext, nil
+}
@@ -0,0 +1,86 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// LogChannel は開発用の通知チャネル（stdoutにログ出力）
+type LogChannel struct {
+	channelType entities.NotificationChannelType
+}
+
+// NewLogChannel は指定種別の開発用通知チャネルを作成する
+func NewLogChannel(channelType entities.NotificationChannelType) Channel {
+	return &LogChannel{channelType: channelType}
+}
+
+// Type はチャネル種別を返す
+func (c *LogChannel) Type() entities.NotificationChannelType {
+	return c.channelType
+}
+
+// Send は通知内容をログに出力する（開発用）
+func (c *LogChannel) Send(_ context.Context, preference *entities.NotificationPreference, n ports.Notification) error {
+	slog.Info("通知（開発モード）",
+		"channel", string(c.channelType),
+		"user_id", string(n.UserID),
+		"event_type", string(n.EventType),
+		"destination", preference.Destination(),
+		"title", n.Title,
+		"message", n.Message,
+	)
+	return nil
+}
+
+// WebhookChannel は通知設定の送信先URLへJSONをPOSTする通知チャネル
+type WebhookChannel struct {
+	client *http.Client
+}
+
+// NewWebhookChannel はWebhook通知チャネルを作成する（clientがnilの場合は10秒タイムアウトのクライアントを使用）
+func NewWebhookChannel(client *http.Client) Channel {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookChannel{client: client}
+}
+
+// Type はチャネル種別を返す
+func (c *WebhookChannel) Type() entities.NotificationChannelType {
+	return entities.NotificationChannelWebhook
+}
+
+// Send は送信先URLに通知をJSONでPOSTする
+func (c *WebhookChannel) Send(ctx context.Context, preference *entities.NotificationPreference, n ports.Notification) error {
+	jsonData, err := json.Marshal(n)
+	if err != nil {
+		return fmt.Errorf("リクエストの生成に失敗しました: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, preference.Destination(), bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("リクエストの作成に失敗しました: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Webhookの送信に失敗しました: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("Webhookエラー: status=%d", resp.StatusCode)
+	}
+
+	return nil
+}
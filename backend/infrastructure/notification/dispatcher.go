@@ -0,0 +1,120 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+// Channel は通知チャネルのインターフェース
+type Channel interface {
+	// Type はチャネル種別を返す
+	Type() entities.NotificationChannelType
+
+	// Send は通知設定の送信先に通知を送信する
+	Send(ctx context.Context, preference *entities.NotificationPreference, n ports.Notification) error
+}
+
+// PreferenceDispatcher は通知設定リポジトリを参照して配信先を決定するディスパッチャー
+type PreferenceDispatcher struct {
+	preferenceRepo repositories.NotificationPreferenceRepository
+	channels       map[entities.NotificationChannelType]Channel
+	retryQueue     *RetryQueue
+}
+
+var _ ports.NotificationDispatcher = (*PreferenceDispatcher)(nil)
+
+// NewPreferenceDispatcher は新しいPreferenceDispatcherを作成する
+func NewPreferenceDispatcher(
+	preferenceRepo repositories.NotificationPreferenceRepository,
+	retryQueue *RetryQueue,
+	channels ...Channel,
+) *PreferenceDispatcher {
+	channelMap := make(map[entities.NotificationChannelType]Channel, len(channels))
+	for _, ch := range channels {
+		channelMap[ch.Type()] = ch
+	}
+	return &PreferenceDispatcher{
+		preferenceRepo: preferenceRepo,
+		channels:       channelMap,
+		retryQueue:     retryQueue,
+	}
+}
+
+// Dispatch はユーザーの通知設定に従って通知を配信する（失敗した配信はリトライキューに入る）
+func (d *PreferenceDispatcher) Dispatch(ctx context.Context, n ports.Notification) (*ports.DispatchResult, error) {
+	preferences, err := d.preferenceRepo.FindEnabledByUserIDAndEventType(ctx, n.UserID, n.EventType)
+	if err != nil {
+		return nil, fmt.Errorf("通知設定の取得に失敗しました: %w", err)
+	}
+
+	result := &ports.DispatchResult{
+		Delivered: []entities.NotificationChannelType{},
+		Queued:    []entities.NotificationChannelType{},
+	}
+	for _, preference := range preferences {
+		if !preference.ShouldNotify(n.Value) {
+			continue
+		}
+
+		channel, ok := d.channels[preference.Channel()]
+		if !ok {
+			slog.Warn("通知チャネルが登録されていません",
+				slog.String("channel", string(preference.Channel())),
+				log.UserID(string(n.UserID)),
+			)
+			continue
+		}
+
+		if err := channel.Send(ctx, preference, n); err != nil {
+			slog.Warn("通知の配信に失敗したためリトライキューに追加します",
+				slog.String("channel", string(preference.Channel())),
+				log.UserID(string(n.UserID)),
+				slog.Any("error", err),
+			)
+			d.retryQueue.Enqueue(preference, n, err, time.Now())
+			result.Queued = append(result.Queued, preference.Channel())
+			continue
+		}
+		result.Delivered = append(result.Delivered, preference.Channel())
+	}
+
+	return result, nil
+}
+
+// RetryFailed はリトライ時刻に達した配信を再送し、成功件数を返す
+func (d *PreferenceDispatcher) RetryFailed(ctx context.Context) int {
+	now := time.Now()
+	delivered := 0
+	for _, item := range d.retryQueue.DequeueDue(now) {
+		channel, ok := d.channels[item.Preference.Channel()]
+		if !ok {
+			continue
+		}
+
+		if err := channel.Send(ctx, item.Preference, item.Notification); err != nil {
+			d.retryQueue.Requeue(item, err, now)
+			continue
+		}
+		delivered++
+	}
+	return delivered
+}
+
+// StartRetryWorker は一定間隔でリトライキューを処理するゴルーチンを開始する
+func (d *PreferenceDispatcher) StartRetryWorker(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			d.RetryFailed(context.Background())
+		}
+	}()
+}
@@ -0,0 +1,234 @@
+package notification_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// ===========================
+// テストヘルパー
+// ===========================
+
+// mockPreferenceRepository は通知設定リポジトリのモック
+type mockPreferenceRepository struct {
+	mock.Mock
+}
+
+func (m *mockPreferenceRepository) Save(ctx context.Context, preference *entities.NotificationPreference) error {
+	args := m.Called(ctx, preference)
+	return args.Error(0)
+}
+
+func (m *mockPreferenceRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.NotificationPreference, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*entities.NotificationPreference), args.Error(1)
+}
+
+func (m *mockPreferenceRepository) FindEnabledByUserIDAndEventType(ctx context.Context, userID entities.UserID, eventType entities.NotificationEventType) ([]*entities.NotificationPreference, error) {
+	args := m.Called(ctx, userID, eventType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entities.NotificationPreference), args.Error(1)
+}
+
+func (m *mockPreferenceRepository) Delete(ctx context.Context, id entities.NotificationPreferenceID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *mockPreferenceRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *mockPreferenceRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+// mockChannel は通知チャネルのモック
+type mockChannel struct {
+	mock.Mock
+	channelType entities.NotificationChannelType
+}
+
+func (m *mockChannel) Type() entities.NotificationChannelType {
+	return m.channelType
+}
+
+func (m *mockChannel) Send(ctx context.Context, preference *entities.NotificationPreference, n ports.Notification) error {
+	args := m.Called(ctx, preference, n)
+	return args.Error(0)
+}
+
+func mustNewPreference(t *testing.T, eventType entities.NotificationEventType, channel entities.NotificationChannelType, threshold float64, destination string) *entities.NotificationPreference {
+	t.Helper()
+	preference, err := entities.NewNotificationPreference("user-001", eventType, channel, true, threshold, destination)
+	require.NoError(t, err)
+	return preference
+}
+
+func deadlineNotification(remainingDays float64) ports.Notification {
+	return ports.Notification{
+		UserID:     "user-001",
+		EventType:  entities.NotificationEventGoalDeadlineApproaching,
+		Title:      "目標期限が近づいています",
+		Value:      remainingDays,
+		OccurredAt: time.Now(),
+	}
+}
+
+// ===========================
+// Dispatch Tests
+// ===========================
+
+func TestPreferenceDispatcher_Dispatch(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 閾値を満たすチャネルにのみ配信する", func(t *testing.T) {
+		repo := new(mockPreferenceRepository)
+		email := &mockChannel{channelType: entities.NotificationChannelEmail}
+		push := &mockChannel{channelType: entities.NotificationChannelPush}
+		emailPref := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelEmail, 30, "")
+		pushPref := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelPush, 7, "")
+
+		repo.On("FindEnabledByUserIDAndEventType", mock.Anything, entities.UserID("user-001"), entities.NotificationEventGoalDeadlineApproaching).
+			Return([]*entities.NotificationPreference{emailPref, pushPref}, nil)
+		email.On("Send", mock.Anything, emailPref, mock.Anything).Return(nil)
+
+		dispatcher := notification.NewPreferenceDispatcher(repo, notification.NewRetryQueue(3, time.Minute), email, push)
+		result, err := dispatcher.Dispatch(ctx, deadlineNotification(20))
+
+		require.NoError(t, err)
+		assert.Equal(t, []entities.NotificationChannelType{entities.NotificationChannelEmail}, result.Delivered)
+		assert.Empty(t, result.Queued)
+		push.AssertNotCalled(t, "Send", mock.Anything, mock.Anything, mock.Anything)
+		email.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 配信に失敗した通知はリトライキューに入る", func(t *testing.T) {
+		repo := new(mockPreferenceRepository)
+		email := &mockChannel{channelType: entities.NotificationChannelEmail}
+		emailPref := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelEmail, 30, "")
+		queue := notification.NewRetryQueue(3, time.Minute)
+
+		repo.On("FindEnabledByUserIDAndEventType", mock.Anything, mock.Anything, mock.Anything).
+			Return([]*entities.NotificationPreference{emailPref}, nil)
+		email.On("Send", mock.Anything, emailPref, mock.Anything).Return(errors.New("smtp error"))
+
+		dispatcher := notification.NewPreferenceDispatcher(repo, queue, email)
+		result, err := dispatcher.Dispatch(ctx, deadlineNotification(10))
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Delivered)
+		assert.Equal(t, []entities.NotificationChannelType{entities.NotificationChannelEmail}, result.Queued)
+		assert.Equal(t, 1, queue.Len())
+	})
+
+	t.Run("異常系: 通知設定の取得に失敗した場合はエラー", func(t *testing.T) {
+		repo := new(mockPreferenceRepository)
+		repo.On("FindEnabledByUserIDAndEventType", mock.Anything, mock.Anything, mock.Anything).
+			Return(nil, errors.New("db error"))
+
+		dispatcher := notification.NewPreferenceDispatcher(repo, notification.NewRetryQueue(3, time.Minute))
+		_, err := dispatcher.Dispatch(ctx, deadlineNotification(10))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "通知設定の取得に失敗しました")
+	})
+}
+
+// ===========================
+// RetryQueue Tests
+// ===========================
+
+func TestPreferenceDispatcher_RetryFailed(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockPreferenceRepository)
+	email := &mockChannel{channelType: entities.NotificationChannelEmail}
+	emailPref := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelEmail, 30, "")
+	queue := notification.NewRetryQueue(3, time.Minute)
+
+	// リトライ時刻を過去にして即時に再送対象とする
+	queue.Enqueue(emailPref, deadlineNotification(10), errors.New("smtp error"), time.Now().Add(-2*time.Minute))
+	email.On("Send", mock.Anything, emailPref, mock.Anything).Return(nil)
+
+	dispatcher := notification.NewPreferenceDispatcher(repo, queue, email)
+	delivered := dispatcher.RetryFailed(ctx)
+
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, 0, queue.Len())
+}
+
+func TestRetryQueue_DropsAfterMaxAttempts(t *testing.T) {
+	emailPref := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelEmail, 30, "")
+	queue := notification.NewRetryQueue(2, time.Minute)
+	now := time.Now()
+
+	queue.Enqueue(emailPref, deadlineNotification(10), errors.New("smtp error"), now)
+	require.Equal(t, 1, queue.Len())
+
+	// リトライ時刻前は取り出されない
+	assert.Empty(t, queue.DequeueDue(now))
+
+	due := queue.DequeueDue(now.Add(time.Minute))
+	require.Len(t, due, 1)
+	assert.Equal(t, 1, due[0].Attempts)
+
+	// 2回目の失敗で最大試行回数に達して破棄される
+	queue.Requeue(due[0], errors.New("smtp error"), now)
+	assert.Equal(t, 0, queue.Len())
+}
+
+// ===========================
+// WebhookChannel Tests
+// ===========================
+
+func TestWebhookChannel_Send(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("正常系: 送信先URLに通知をJSONでPOSTする", func(t *testing.T) {
+		var received ports.Notification
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		t.Cleanup(srv.Close)
+
+		preference := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelWebhook, 30, srv.URL)
+		channel := notification.NewWebhookChannel(srv.Client())
+
+		require.NoError(t, channel.Send(ctx, preference, deadlineNotification(10)))
+		assert.Equal(t, entities.NotificationEventGoalDeadlineApproaching, received.EventType)
+		assert.Equal(t, 10.0, received.Value)
+	})
+
+	t.Run("異常系: 4xx/5xxはエラー", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(srv.Close)
+
+		preference := mustNewPreference(t, entities.NotificationEventGoalDeadlineApproaching, entities.NotificationChannelWebhook, 30, srv.URL)
+		channel := notification.NewWebhookChannel(srv.Client())
+
+		err := channel.Send(ctx, preference, deadlineNotification(10))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "status=500")
+	})
+}
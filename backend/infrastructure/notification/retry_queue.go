@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+)
+
+const (
+	// DefaultMaxRetryAttempts はリトライの最大試行回数の初期値
+	DefaultMaxRetryAttempts = 3
+	// DefaultRetryBackoff はリトライ間隔の初期値（試行回数に比例して延びる）
+	DefaultRetryBackoff = time.Minute
+)
+
+// RetryItem はリトライ待ちの配信
+type RetryItem struct {
+	Preference    *entities.NotificationPreference
+	Notification  ports.Notification
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+}
+
+// RetryQueue は配信に失敗した通知を保持するインメモリのリトライキュー
+type RetryQueue struct {
+	mu          sync.Mutex
+	items       []*RetryItem
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewRetryQueue は新しいリトライキューを作成する
+func NewRetryQueue(maxAttempts int, backoff time.Duration) *RetryQueue {
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxRetryAttempts
+	}
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+	return &RetryQueue{
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Enqueue は初回の配信に失敗した通知をキューに追加する
+func (q *RetryQueue) Enqueue(preference *entities.NotificationPreference, n ports.Notification, cause error, now time.Time) {
+	q.Requeue(&RetryItem{
+		Preference:   preference,
+		Notification: n,
+	}, cause, now)
+}
+
+// Requeue は試行回数を加算してキューに戻す（最大試行回数に達した場合は破棄する）
+func (q *RetryQueue) Requeue(item *RetryItem, cause error, now time.Time) {
+	item.Attempts++
+	if cause != nil {
+		item.LastError = cause.Error()
+	}
+
+	if item.Attempts >= q.maxAttempts {
+		slog.Error("通知の配信が最大試行回数に達したため破棄します",
+			slog.String("channel", string(item.Preference.Channel())),
+			log.UserID(string(item.Notification.UserID)),
+			slog.Int("attempts", item.Attempts),
+			slog.String("last_error", item.LastError),
+		)
+		return
+	}
+
+	item.NextAttemptAt = now.Add(q.backoff * time.Duration(item.Attempts))
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.items = append(q.items, item)
+}
+
+// DequeueDue はリトライ時刻に達した配信をキューから取り出す
+func (q *RetryQueue) DequeueDue(now time.Time) []*RetryItem {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var due []*RetryItem
+	remaining := q.items[:0]
+	for _, item := range q.items {
+		if !item.NextAttemptAt.After(now) {
+			due = append(due, item)
+		} else {
+			remaining = append(remaining, item)
+		}
+	}
+	q.items = remaining
+	return due
+}
+
+// Len はキュー内の配信数を返す
+func (q *RetryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.items)
+}
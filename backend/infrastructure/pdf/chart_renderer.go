@@ -0,0 +1,316 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/golang/freetype/truetype"
+	chart "github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+// チャート画像の既定の大きさと解像度
+const (
+	DefaultChartWidth  = 800
+	DefaultChartHeight = 400
+	DefaultChartDPI    = 96.0
+)
+
+// ChartConfig はチャート画像の生成設定（大きさ・解像度が0以下の場合は既定値を使用する）
+type ChartConfig struct {
+	// Width, Height は DefaultChartDPI で描画した場合の画像の大きさ（ピクセル）
+	Width  int
+	Height int
+	// DPI は画像の解像度。DefaultChartDPI の2倍にすると同じレイアウトのまま縦横2倍の画素数で描画する
+	DPI float64
+	// FontPath は凡例・軸ラベルに使う日本語のグリフを含む TrueType フォント（.ttf）のパス
+	// 空の場合はライブラリ既定の欧文フォントを使うため、日本語は表示できない
+	FontPath string
+}
+
+// ChartValue はチャートの1項目（棒・扇形）の値
+type ChartValue struct {
+	Label string
+	Value float64
+}
+
+// ChartSeries は折れ線グラフの1系列
+type ChartSeries struct {
+	Name    string
+	YValues []float64
+}
+
+// LineChart は折れ線グラフの描画内容
+type LineChart struct {
+	Title      string
+	XAxisLabel string
+	YAxisLabel string
+	XValues    []float64
+	Series     []ChartSeries
+	// FormatX, FormatY は軸の目盛りの表示形式（nil の場合は数値をそのまま表示する）
+	FormatX func(float64) string
+	FormatY func(float64) string
+}
+
+// BarChart は棒グラフの描画内容
+type BarChart struct {
+	Title string
+	Bars  []ChartValue
+	// Max は縦軸の上限（棒の値がこれを超える場合は最大の値を上限にする）
+	Max     float64
+	FormatY func(float64) string
+}
+
+// PieChart は円グラフの描画内容
+type PieChart struct {
+	Title  string
+	Slices []ChartValue
+}
+
+// chartColors は系列・項目の描画色（画面のカラースキームに合わせた青系統から順に使う）
+var chartColors = []drawing.Color{
+	drawing.ColorFromHex("2563eb"),
+	drawing.ColorFromHex("10b981"),
+	drawing.ColorFromHex("f59e0b"),
+	drawing.ColorFromHex("ef4444"),
+	drawing.ColorFromHex("8b5cf6"),
+	drawing.ColorFromHex("6b7280"),
+}
+
+// ChartRenderer はレポートに埋め込むチャート（折れ線・棒・円グラフ）をPNG画像として生成する
+type ChartRenderer struct {
+	width  int
+	height int
+	dpi    float64
+	scale  float64 // DefaultChartDPI に対する倍率（線の太さや余白を解像度に合わせる）
+	font   *truetype.Font
+}
+
+// NewChartRenderer は新しい ChartRenderer を作成する
+// FontPath を指定した場合は起動時にフォントを読み込み、読み込めない場合はエラーを返す
+func NewChartRenderer(config ChartConfig) (*ChartRenderer, error) {
+	if config.Width <= 0 {
+		config.Width = DefaultChartWidth
+	}
+	if config.Height <= 0 {
+		config.Height = DefaultChartHeight
+	}
+	if config.DPI <= 0 {
+		config.DPI = DefaultChartDPI
+	}
+
+	scale := config.DPI / DefaultChartDPI
+	renderer := &ChartRenderer{
+		width:  int(math.Round(float64(config.Width) * scale)),
+		height: int(math.Round(float64(config.Height) * scale)),
+		dpi:    config.DPI,
+		scale:  scale,
+	}
+	if config.FontPath != "" {
+		data, err := os.ReadFile(config.FontPath)
+		if err != nil {
+			return nil, fmt.Errorf("チャートのフォントの読み込みに失敗しました: %w", err)
+		}
+		font, err := truetype.Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("チャートのフォントを TrueType として解析できませんでした（%s）: %w", config.FontPath, err)
+		}
+		renderer.font = font
+	}
+	return renderer, nil
+}
+
+// HasFont は日本語表示用のフォントが設定されているかを返す
+func (r *ChartRenderer) HasFont() bool {
+	return r.font != nil
+}
+
+// RenderLineChart は折れ線グラフを描画する（横軸の値は2つ以上必要）
+func (r *ChartRenderer) RenderLineChart(data LineChart) ([]byte, error) {
+	if len(data.XValues) < 2 {
+		return nil, fmt.Errorf("折れ線グラフには2つ以上のデータが必要です")
+	}
+
+	series := make([]chart.Series, 0, len(data.Series))
+	for i, s := range data.Series {
+		if len(s.YValues) != len(data.XValues) {
+			return nil, fmt.Errorf("系列「%s」のデータ数が横軸と一致しません", s.Name)
+		}
+		color := chartColors[i%len(chartColors)]
+		series = append(series, chart.ContinuousSeries{
+			Name: s.Name,
+			Style: chart.Style{
+				StrokeColor: color,
+				StrokeWidth: 2 * r.scale,
+			},
+			XValues: data.XValues,
+			YValues: s.YValues,
+		})
+	}
+
+	graph := chart.Chart{
+		TitleStyle: chart.Hidden(),
+		Width:      r.width,
+		Height:     r.height,
+		DPI:        r.dpi,
+		Font:       r.font,
+		Background: chart.Style{Padding: r.padding()},
+		XAxis: chart.XAxis{
+			Name:           data.XAxisLabel,
+			ValueFormatter: valueFormatter(data.FormatX),
+			Ticks:          r.xTicks(data.XValues, data.FormatX),
+		},
+		YAxis: chart.YAxis{
+			Name:           data.YAxisLabel,
+			ValueFormatter: valueFormatter(data.FormatY),
+		},
+		Series: series,
+	}
+	graph.Elements = []chart.Renderable{chart.Legend(&graph), r.title(data.Title)}
+
+	return r.render(graph.Render)
+}
+
+// RenderBarChart は棒グラフを描画する
+func (r *ChartRenderer) RenderBarChart(data BarChart) ([]byte, error) {
+	if len(data.Bars) == 0 {
+		return nil, fmt.Errorf("棒グラフには1つ以上のデータが必要です")
+	}
+
+	max := data.Max
+	bars := make([]chart.Value, 0, len(data.Bars))
+	for i, bar := range data.Bars {
+		max = math.Max(max, bar.Value)
+		color := chartColors[i%len(chartColors)]
+		bars = append(bars, chart.Value{
+			Label: bar.Label,
+			Value: bar.Value,
+			Style: chart.Style{FillColor: color, StrokeColor: color},
+		})
+	}
+	if max <= 0 {
+		max = 1
+	}
+
+	// 棒の数に応じて幅と間隔を決め、キャンバスの幅に収める
+	slot := float64(r.width) * 0.8 / float64(len(bars))
+	graph := chart.BarChart{
+		TitleStyle: chart.Hidden(),
+		Width:      r.width,
+		Height:     r.height,
+		DPI:        r.dpi,
+		Font:       r.font,
+		Background: chart.Style{Padding: r.padding()},
+		BarWidth:   int(slot * 0.6),
+		BarSpacing: int(slot * 0.4),
+		XAxis:      chart.Style{TextWrap: chart.TextWrapWord},
+		YAxis: chart.YAxis{
+			Range:          &chart.ContinuousRange{Min: 0, Max: max},
+			ValueFormatter: valueFormatter(data.FormatY),
+		},
+		Bars:     bars,
+		Elements: []chart.Renderable{r.title(data.Title)},
+	}
+
+	return r.render(graph.Render)
+}
+
+// RenderPieChart は円グラフを描画する（値が0以下の項目は描画しない）
+func (r *ChartRenderer) RenderPieChart(data PieChart) ([]byte, error) {
+	slices := make([]chart.Value, 0, len(data.Slices))
+	for i, slice := range data.Slices {
+		if slice.Value <= 0 {
+			continue
+		}
+		color := chartColors[i%len(chartColors)]
+		slices = append(slices, chart.Value{
+			Label: slice.Label,
+			Value: slice.Value,
+			Style: chart.Style{FillColor: color, FontColor: drawing.ColorWhite, FontSize: 10},
+		})
+	}
+	if len(slices) == 0 {
+		return nil, fmt.Errorf("円グラフには正の値のデータが必要です")
+	}
+
+	graph := chart.PieChart{
+		TitleStyle: chart.Hidden(),
+		Width:      r.width,
+		Height:     r.height,
+		DPI:        r.dpi,
+		Font:       r.font,
+		Background: chart.Style{Padding: r.padding()},
+		Values:     slices,
+		Elements:   []chart.Renderable{r.title(data.Title)},
+	}
+
+	return r.render(graph.Render)
+}
+
+// render はチャートをPNG画像として書き出す
+func (r *ChartRenderer) render(renderFunc func(chart.RendererProvider, io.Writer) error) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := renderFunc(chart.PNG, &buf); err != nil {
+		return nil, fmt.Errorf("チャートの描画に失敗しました: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// title はチャートのタイトルを画像上端の余白に描画する
+// go-chart の円グラフはタイトルを描画領域の内側に描くため、すべてのチャートで余白に描画して重ならないようにする
+func (r *ChartRenderer) title(title string) chart.Renderable {
+	return func(renderer chart.Renderer, _ chart.Box, defaults chart.Style) {
+		if title == "" {
+			return
+		}
+		style := chart.Style{
+			FontSize:            12,
+			FontColor:           chart.DefaultTextColor,
+			TextHorizontalAlign: chart.TextHorizontalAlignCenter,
+			TextVerticalAlign:   chart.TextVerticalAlignMiddle,
+		}.InheritFrom(defaults)
+		chart.Draw.TextWithin(renderer, title, chart.Box{Right: r.width, Bottom: r.padding().Top}, style)
+	}
+}
+
+// xTicks は横軸の目盛りを横軸の値の位置に置く（多い場合は最後の値から等間隔に10個程度まで間引く）
+// 経過年数のような整数の値に小数の目盛りが付かないようにする
+func (r *ChartRenderer) xTicks(values []float64, format func(float64) string) []chart.Tick {
+	if format == nil {
+		format = func(v float64) string { return fmt.Sprintf("%g", v) }
+	}
+	step := (len(values) + 9) / 10
+	ticks := make([]chart.Tick, 0, 11)
+	for i, v := range values {
+		if (len(values)-1-i)%step == 0 {
+			ticks = append(ticks, chart.Tick{Value: v, Label: format(v)})
+		}
+	}
+	return ticks
+}
+
+// padding はタイトルと凡例が重ならないよう上側を広くとった余白
+func (r *ChartRenderer) padding() chart.Box {
+	return chart.Box{
+		Top:    int(50 * r.scale),
+		Left:   int(20 * r.scale),
+		Right:  int(20 * r.scale),
+		Bottom: int(20 * r.scale),
+	}
+}
+
+// valueFormatter は軸の目盛りの表示形式を go-chart の形式に変換する
+func valueFormatter(format func(float64) string) chart.ValueFormatter {
+	if format == nil {
+		return nil
+	}
+	return func(v interface{}) string {
+		if value, ok := v.(float64); ok {
+			return format(value)
+		}
+		return fmt.Sprint(v)
+	}
+}
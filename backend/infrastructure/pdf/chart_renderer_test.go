@@ -0,0 +1,174 @@
+package pdf
+
+import (
+	"bytes"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2/roboto"
+)
+
+// decodePNGSize はPNG画像を読み込み、幅と高さを返す
+func decodePNGSize(t *testing.T, data []byte) (int, int) {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("PNG画像として読み込めません: %v", err)
+	}
+	return img.Bounds().Dx(), img.Bounds().Dy()
+}
+
+func newTestLineChart() LineChart {
+	return LineChart{
+		Title:      "資産成長曲線",
+		XAxisLabel: "経過年数",
+		YAxisLabel: "金額",
+		XValues:    []float64{1, 2, 3},
+		Series: []ChartSeries{
+			{Name: "総資産", YValues: []float64{1000000, 2100000, 3300000}},
+			{Name: "積立元本", YValues: []float64{1000000, 2000000, 3000000}},
+		},
+		FormatY: formatManYen,
+	}
+}
+
+func TestNewChartRenderer(t *testing.T) {
+	t.Run("未指定の大きさ・解像度は既定値を使う", func(t *testing.T) {
+		renderer, err := NewChartRenderer(ChartConfig{})
+		if err != nil {
+			t.Fatalf("NewChartRenderer failed: %v", err)
+		}
+		if renderer.HasFont() {
+			t.Error("フォント未指定の場合は HasFont() が false であること")
+		}
+
+		image, err := renderer.RenderLineChart(newTestLineChart())
+		if err != nil {
+			t.Fatalf("RenderLineChart failed: %v", err)
+		}
+		if width, height := decodePNGSize(t, image); width != DefaultChartWidth || height != DefaultChartHeight {
+			t.Errorf("画像の大きさ = %dx%d; want %dx%d", width, height, DefaultChartWidth, DefaultChartHeight)
+		}
+	})
+
+	t.Run("解像度を2倍にすると同じレイアウトで縦横2倍の画像になる", func(t *testing.T) {
+		renderer, err := NewChartRenderer(ChartConfig{Width: 600, Height: 300, DPI: DefaultChartDPI * 2})
+		if err != nil {
+			t.Fatalf("NewChartRenderer failed: %v", err)
+		}
+
+		image, err := renderer.RenderLineChart(newTestLineChart())
+		if err != nil {
+			t.Fatalf("RenderLineChart failed: %v", err)
+		}
+		if width, height := decodePNGSize(t, image); width != 1200 || height != 600 {
+			t.Errorf("画像の大きさ = %dx%d; want 1200x600", width, height)
+		}
+	})
+
+	t.Run("TrueType フォントを読み込める", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "font.ttf")
+		if err := os.WriteFile(path, roboto.Roboto, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		renderer, err := NewChartRenderer(ChartConfig{FontPath: path})
+		if err != nil {
+			t.Fatalf("NewChartRenderer failed: %v", err)
+		}
+		if !renderer.HasFont() {
+			t.Error("フォントを指定した場合は HasFont() が true であること")
+		}
+	})
+
+	t.Run("フォントが存在しない場合はエラー", func(t *testing.T) {
+		if _, err := NewChartRenderer(ChartConfig{FontPath: filepath.Join(t.TempDir(), "missing.ttf")}); err == nil {
+			t.Error("Expected error for missing font")
+		}
+	})
+
+	t.Run("TrueType でないファイルはエラー", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "font.ttf")
+		if err := os.WriteFile(path, []byte("not a font"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewChartRenderer(ChartConfig{FontPath: path}); err == nil {
+			t.Error("Expected error for invalid font")
+		}
+	})
+}
+
+func TestChartRenderer_RenderLineChart(t *testing.T) {
+	renderer, _ := NewChartRenderer(ChartConfig{})
+
+	t.Run("データが1件の場合はエラー", func(t *testing.T) {
+		chart := newTestLineChart()
+		chart.XValues = []float64{1}
+		chart.Series = []ChartSeries{{Name: "総資産", YValues: []float64{1000000}}}
+		if _, err := renderer.RenderLineChart(chart); err == nil {
+			t.Error("Expected error for single data point")
+		}
+	})
+
+	t.Run("系列のデータ数が横軸と一致しない場合はエラー", func(t *testing.T) {
+		chart := newTestLineChart()
+		chart.Series[0].YValues = []float64{1000000}
+		if _, err := renderer.RenderLineChart(chart); err == nil {
+			t.Error("Expected error for mismatched series length")
+		}
+	})
+}
+
+func TestChartRenderer_RenderBarChart(t *testing.T) {
+	renderer, _ := NewChartRenderer(ChartConfig{})
+
+	t.Run("進捗がすべて0%でも描画できる", func(t *testing.T) {
+		image, err := renderer.RenderBarChart(BarChart{
+			Title: "目標進捗率",
+			Bars:  []ChartValue{{Label: "旅行", Value: 0}, {Label: "住宅購入", Value: 0}},
+			Max:   100,
+		})
+		if err != nil {
+			t.Fatalf("RenderBarChart failed: %v", err)
+		}
+		decodePNGSize(t, image)
+	})
+
+	t.Run("データがない場合はエラー", func(t *testing.T) {
+		if _, err := renderer.RenderBarChart(BarChart{Title: "目標進捗率"}); err == nil {
+			t.Error("Expected error for empty bars")
+		}
+	})
+}
+
+func TestChartRenderer_RenderPieChart(t *testing.T) {
+	renderer, _ := NewChartRenderer(ChartConfig{})
+
+	t.Run("正の値の項目を描画できる", func(t *testing.T) {
+		image, err := renderer.RenderPieChart(PieChart{
+			Title:  "アセットアロケーション",
+			Slices: []ChartValue{{Label: "預金 60.0%", Value: 600000}, {Label: "投資 40.0%", Value: 400000}, {Label: "その他", Value: 0}},
+		})
+		if err != nil {
+			t.Fatalf("RenderPieChart failed: %v", err)
+		}
+		decodePNGSize(t, image)
+	})
+
+	t.Run("正の値の項目がない場合はエラー", func(t *testing.T) {
+		if _, err := renderer.RenderPieChart(PieChart{Slices: []ChartValue{{Label: "預金", Value: 0}}}); err == nil {
+			t.Error("Expected error for zero values")
+		}
+	})
+}
+
+func TestTruncateRunes(t *testing.T) {
+	if got := truncateRunes("住宅購入の頭金", 12); got != "住宅購入の頭金" {
+		t.Errorf("truncateRunes = %s; want 住宅購入の頭金", got)
+	}
+	if got := truncateRunes("子どもの大学進学費用（私立理系）", 8); got != "子どもの大学進…" {
+		t.Errorf("truncateRunes = %s; want 子どもの大学進…", got)
+	}
+}
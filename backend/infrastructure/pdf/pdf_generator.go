@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"html"
 	"time"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 )
 
 // Generator はPDF生成インターフェース
@@ -19,13 +21,20 @@ type Generator interface {
 }
 
 // HTMLGenerator はHTML形式でPDFを生成する（簡易実装）
-type HTMLGenerator struct{}
+type HTMLGenerator struct {
+	charts *ChartRenderer // nil の場合はチャートを埋め込まない
+}
 
 // NewHTMLGenerator は新しいHTMLGeneratorを作成する
 func NewHTMLGenerator() *HTMLGenerator {
 	return &HTMLGenerator{}
 }
 
+// NewHTMLGeneratorWithCharts は資産成長曲線・目標進捗バー・アセットアロケーションのチャートを画像として埋め込むHTMLGeneratorを作成する
+func NewHTMLGeneratorWithCharts(charts *ChartRenderer) *HTMLGenerator {
+	return &HTMLGenerator{charts: charts}
+}
+
 // GenerateFinancialSummaryPDF は財務サマリーレポートのPDFを生成する
 func (g *HTMLGenerator) GenerateFinancialSummaryPDF(report *usecases.FinancialSummaryReport) ([]byte, error) {
 	html := g.generateFinancialSummaryHTML(report)
@@ -89,8 +98,9 @@ func (g *HTMLGenerator) generateFinancialSummaryHTML(report *usecases.FinancialS
 <body>
     <div class="header">
         <h1>財務サマリーレポート</h1>
-        <p>作成日: ` + report.ReportDate + `</p>
+        <p>作成日: ` + g.escape(report.ReportDate) + `</p>
     </div>
+` + g.disclaimerSection(report.Disclaimers) + g.assumptionsSection(report.Assumptions) + `
 
     <div class="section">
         <h2>財務健全性スコア</h2>
@@ -125,7 +135,7 @@ func (g *HTMLGenerator) generateFinancialSummaryHTML(report *usecases.FinancialS
         <div class="metric">
             <div class="metric-label">総資産</div>
             <div class="metric-value">¥` + g.formatNumber(report.CurrentSituation.TotalAssets) + `</div>
-        </div>
+        </div>` + g.assetAllocationChartSection(report.AssetAllocation) + `
     </div>
 
     <div class="section">
@@ -144,9 +154,9 @@ func (g *HTMLGenerator) generateFinancialSummaryHTML(report *usecases.FinancialS
 	for _, metric := range report.KeyMetrics {
 		buf.WriteString(`
                 <tr>
-                    <td>` + metric.Name + `</td>
+                    <td>` + g.escape(metric.Name) + `</td>
                     <td>` + g.formatMetricValue(metric.Value, metric.Unit) + `</td>
-                    <td>` + metric.Description + `</td>
+                    <td>` + g.escape(metric.Description) + `</td>
                     <td>` + g.getTrendIcon(metric.Trend) + `</td>
                 </tr>`)
 	}
@@ -162,7 +172,7 @@ func (g *HTMLGenerator) generateFinancialSummaryHTML(report *usecases.FinancialS
         <h2>推奨事項</h2>`)
 		for _, rec := range report.Recommendations {
 			buf.WriteString(`
-        <div class="list-item">` + rec + `</div>`)
+        <div class="list-item">` + g.escape(rec) + `</div>`)
 		}
 		buf.WriteString(`
     </div>`)
@@ -174,7 +184,7 @@ func (g *HTMLGenerator) generateFinancialSummaryHTML(report *usecases.FinancialS
         <h2>注意事項</h2>`)
 		for _, warning := range report.Warnings {
 			buf.WriteString(`
-        <div class="list-item warning">⚠️ ` + warning + `</div>`)
+        <div class="list-item warning">⚠️ ` + g.escape(warning) + `</div>`)
 		}
 		buf.WriteString(`
     </div>`)
@@ -226,17 +236,18 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
         <h1>包括的財務レポート</h1>
         <p>作成日: ` + time.Now().Format("2006年01月02日") + `</p>
     </div>
+` + g.disclaimerSection(report.Disclaimers) + g.assumptionsSection(report.Assumptions) + `
 
     <div class="executive-summary">
         <h2 style="border: none; margin-top: 0;">エグゼクティブサマリー</h2>
-        <p><strong>総合ステータス:</strong> ` + report.ExecutiveSummary.OverallStatus + `</p>
+        <p><strong>総合ステータス:</strong> ` + g.escape(report.ExecutiveSummary.OverallStatus) + `</p>
         <p><strong>財務健全性スコア:</strong> ` + fmt.Sprintf("%d/100", report.ExecutiveSummary.FinancialHealthScore) + `</p>
         
         <h3>主要ハイライト</h3>`)
 
 	for _, highlight := range report.ExecutiveSummary.KeyHighlights {
 		buf.WriteString(`
-        <div class="highlight">✓ ` + highlight + `</div>`)
+        <div class="highlight">✓ ` + g.escape(highlight) + `</div>`)
 	}
 
 	buf.WriteString(`
@@ -245,7 +256,7 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
 
 	for _, action := range report.ExecutiveSummary.CriticalActions {
 		buf.WriteString(`
-        <div class="highlight">⚡ ` + action + `</div>`)
+        <div class="highlight">⚡ ` + g.escape(action) + `</div>`)
 	}
 
 	buf.WriteString(`
@@ -269,12 +280,14 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
     <div class="metric">
         <div class="metric-label">総資産</div>
         <div class="metric-value">¥` + g.formatNumber(report.FinancialSummary.CurrentSituation.TotalAssets) + `</div>
-    </div>`)
+    </div>` + g.assetAllocationChartSection(report.FinancialSummary.AssetAllocation))
 
 	// 資産推移セクション
-	buf.WriteString(`
+	if report.AssetProjection != nil {
+		buf.WriteString(`
     <h2>資産推移予測</h2>
-    <p>予測期間: ` + fmt.Sprintf("%d年", report.AssetProjection.ProjectionYears) + `</p>
+    <p>予測期間: ` + fmt.Sprintf("%d年", report.AssetProjection.ProjectionYears) + `</p>` +
+			g.assetGrowthChartSection(report.AssetProjection.Projections) + `
     <table>
         <thead>
             <tr>
@@ -287,20 +300,20 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
         </thead>
         <tbody>`)
 
-	// 最初、中間、最後の年のみ表示
-	projections := report.AssetProjection.Projections
-	displayYears := []int{0}
-	if len(projections) > 2 {
-		displayYears = append(displayYears, len(projections)/2)
-	}
-	if len(projections) > 1 {
-		displayYears = append(displayYears, len(projections)-1)
-	}
+		// 最初、中間、最後の年のみ表示
+		projections := report.AssetProjection.Projections
+		displayYears := []int{0}
+		if len(projections) > 2 {
+			displayYears = append(displayYears, len(projections)/2)
+		}
+		if len(projections) > 1 {
+			displayYears = append(displayYears, len(projections)-1)
+		}
 
-	for _, idx := range displayYears {
-		if idx < len(projections) {
-			p := projections[idx]
-			buf.WriteString(`
+		for _, idx := range displayYears {
+			if idx < len(projections) {
+				p := projections[idx]
+				buf.WriteString(`
             <tr>
                 <td>` + fmt.Sprintf("%d年後", p.Year) + `</td>
                 <td>¥` + g.formatNumber(p.TotalAssets.Amount()) + `</td>
@@ -308,18 +321,23 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
                 <td>¥` + g.formatNumber(p.ContributedAmount.Amount()) + `</td>
                 <td>¥` + g.formatNumber(p.InvestmentGains.Amount()) + `</td>
             </tr>`)
+			}
 		}
-	}
 
-	buf.WriteString(`
+		buf.WriteString(`
         </tbody>
     </table>`)
+	} else {
+		buf.WriteString(g.failedSectionHTML("資産推移予測", usecases.ReportSectionAssetProjection, report.SectionsFailed))
+	}
 
 	// 目標進捗セクション
-	buf.WriteString(`
+	if report.GoalsProgress != nil {
+		buf.WriteString(`
     <h2>目標進捗状況</h2>
     <p>総目標数: ` + fmt.Sprintf("%d", report.GoalsProgress.Summary.TotalGoals) + ` (アクティブ: ` + fmt.Sprintf("%d", report.GoalsProgress.Summary.ActiveGoals) + `)</p>
-    <p>全体進捗率: ` + fmt.Sprintf("%.1f%%", report.GoalsProgress.Summary.OverallProgress) + `</p>
+    <p>全体進捗率: ` + fmt.Sprintf("%.1f%%", report.GoalsProgress.Summary.OverallProgress) + `</p>` +
+			g.goalProgressChartSection(report.GoalsProgress.Goals) + `
     
     <table>
         <thead>
@@ -333,20 +351,23 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
         </thead>
         <tbody>`)
 
-	for _, goalProgress := range report.GoalsProgress.Goals {
-		buf.WriteString(`
+		for _, goalProgress := range report.GoalsProgress.Goals {
+			buf.WriteString(`
             <tr>
-                <td>` + goalProgress.Goal.Title() + `</td>
+                <td>` + g.escape(goalProgress.Goal.Title()) + `</td>
                 <td>¥` + g.formatNumber(goalProgress.Goal.TargetAmount().Amount()) + `</td>
                 <td>¥` + g.formatNumber(goalProgress.Goal.CurrentAmount().Amount()) + `</td>
                 <td>` + fmt.Sprintf("%.1f%%", goalProgress.Progress.AsPercentage()) + `</td>
-                <td>` + goalProgress.Status + `</td>
+                <td>` + g.escape(goalProgress.Status) + `</td>
             </tr>`)
-	}
+		}
 
-	buf.WriteString(`
+		buf.WriteString(`
         </tbody>
     </table>`)
+	} else {
+		buf.WriteString(g.failedSectionHTML("目標進捗状況", usecases.ReportSectionGoalsProgress, report.SectionsFailed))
+	}
 
 	// アクションプラン
 	buf.WriteString(`
@@ -357,10 +378,10 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
 	for _, action := range report.ActionPlan.ShortTerm {
 		priorityClass := "priority-" + action.Priority
 		buf.WriteString(`
-    <div class="action-item ` + priorityClass + `">
-        <strong>` + action.Title + `</strong> [優先度: ` + action.Priority + `]<br>
-        ` + action.Description + `<br>
-        <small>期限: ` + action.Timeline + ` | 影響: ` + action.Impact + ` | 労力: ` + action.Effort + `</small>
+    <div class="action-item ` + g.escape(priorityClass) + `">
+        <strong>` + g.escape(action.Title) + `</strong> [優先度: ` + g.escape(action.Priority) + `]<br>
+        ` + g.escape(action.Description) + `<br>
+        <small>期限: ` + g.escape(action.Timeline) + ` | 影響: ` + g.escape(action.Impact) + ` | 労力: ` + g.escape(action.Effort) + `</small>
     </div>`)
 	}
 
@@ -371,10 +392,10 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
 	for _, action := range report.ActionPlan.MediumTerm {
 		priorityClass := "priority-" + action.Priority
 		buf.WriteString(`
-    <div class="action-item ` + priorityClass + `">
-        <strong>` + action.Title + `</strong> [優先度: ` + action.Priority + `]<br>
-        ` + action.Description + `<br>
-        <small>期限: ` + action.Timeline + ` | 影響: ` + action.Impact + ` | 労力: ` + action.Effort + `</small>
+    <div class="action-item ` + g.escape(priorityClass) + `">
+        <strong>` + g.escape(action.Title) + `</strong> [優先度: ` + g.escape(action.Priority) + `]<br>
+        ` + g.escape(action.Description) + `<br>
+        <small>期限: ` + g.escape(action.Timeline) + ` | 影響: ` + g.escape(action.Impact) + ` | 労力: ` + g.escape(action.Effort) + `</small>
     </div>`)
 	}
 
@@ -385,10 +406,10 @@ func (g *HTMLGenerator) generateComprehensiveHTML(report *usecases.Comprehensive
 	for _, action := range report.ActionPlan.LongTerm {
 		priorityClass := "priority-" + action.Priority
 		buf.WriteString(`
-    <div class="action-item ` + priorityClass + `">
-        <strong>` + action.Title + `</strong> [優先度: ` + action.Priority + `]<br>
-        ` + action.Description + `<br>
-        <small>期限: ` + action.Timeline + ` | 影響: ` + action.Impact + ` | 労力: ` + action.Effort + `</small>
+    <div class="action-item ` + g.escape(priorityClass) + `">
+        <strong>` + g.escape(action.Title) + `</strong> [優先度: ` + g.escape(action.Priority) + `]<br>
+        ` + g.escape(action.Description) + `<br>
+        <small>期限: ` + g.escape(action.Timeline) + ` | 影響: ` + g.escape(action.Impact) + ` | 労力: ` + g.escape(action.Effort) + `</small>
     </div>`)
 	}
 
@@ -411,10 +432,12 @@ func (g *HTMLGenerator) generateAssetProjectionHTML(report *usecases.AssetProjec
 <head><meta charset="UTF-8"><title>資産推移レポート</title></head>
 <body>
 <h1>資産推移レポート</h1>
+%s
 <p>予測期間: %d年</p>
+%s
 <p>レポート生成日: %s</p>
 </body>
-</html>`, report.ProjectionYears, time.Now().Format("2006-01-02"))
+</html>`, g.disclaimerSection(report.Disclaimers)+g.assumptionsSection(report.Assumptions), report.ProjectionYears, g.assetGrowthChartSection(report.Projections), time.Now().Format("2006-01-02"))
 }
 
 // generateGoalsProgressHTML は目標進捗レポートのHTML生成（簡略版）
@@ -424,10 +447,12 @@ func (g *HTMLGenerator) generateGoalsProgressHTML(report *usecases.GoalsProgress
 <head><meta charset="UTF-8"><title>目標進捗レポート</title></head>
 <body>
 <h1>目標進捗レポート</h1>
+%s
 <p>総目標数: %d</p>
+%s
 <p>レポート生成日: %s</p>
 </body>
-</html>`, report.Summary.TotalGoals, time.Now().Format("2006-01-02"))
+</html>`, g.disclaimerSection(report.Disclaimers)+g.assumptionsSection(report.Assumptions), report.Summary.TotalGoals, g.goalProgressChartSection(report.Goals), time.Now().Format("2006-01-02"))
 }
 
 // generateRetirementPlanHTML は退職計画レポートのHTML生成（簡略版）
@@ -437,13 +462,221 @@ func (g *HTMLGenerator) generateRetirementPlanHTML(report *usecases.RetirementPl
 <head><meta charset="UTF-8"><title>退職計画レポート</title></head>
 <body>
 <h1>退職計画レポート</h1>
+%s
+%s
+%s
+%s
 <p>レポート生成日: %s</p>
 </body>
-</html>`, time.Now().Format("2006-01-02"))
+</html>`, g.disclaimerSection(report.Disclaimers)+g.assumptionsSection(report.Assumptions), g.retirementIncomeSourcesSection(report.IncomeSources), g.retirementAfterTaxSection(report.AfterTax), g.retirementLifestylesSection(report.Lifestyles), time.Now().Format("2006-01-02"))
+}
+
+// retirementAfterTaxSection は額面ベースと手取りベースの老後資金の比較セクションを生成する
+// 手取りの計算に使った税率・保険料率もあわせて示す
+func (g *HTMLGenerator) retirementAfterTaxSection(afterTax usecases.RetirementAfterTax) string {
+	judgement := "手取りベースでも退職後の生活費を賄える見込みです"
+	if !afterTax.CanCoverExpenses {
+		judgement = fmt.Sprintf("手取りベースでは老後資金が¥%s不足する見込みです", g.formatNumber(afterTax.Shortfall))
+	}
+
+	return fmt.Sprintf(`
+<h2>税・社会保険料を考慮した老後資金</h2>
+<table>
+    <thead>
+        <tr><th></th><th>額面</th><th>手取り</th></tr>
+    </thead>
+    <tbody>
+        <tr><td>公的給付（月額）</td><td>¥%s</td><td>¥%s</td></tr>
+        <tr><td>必要老後資金</td><td>¥%s</td><td>¥%s</td></tr>
+    </tbody>
+</table>
+<p>%s（充足率 %.1f%%）</p>
+<table>
+    <tbody>
+        <tr><th>税率表</th><td>%s</td></tr>
+        <tr><th>健康保険料率（年金所得に対する概算）</th><td>%.1f%%</td></tr>
+        <tr><th>住民税率</th><td>%.1f%%</td></tr>
+        <tr><th>運用益の税率</th><td>%.3f%%</td></tr>
+        <tr><th>取り崩し額に対する実効税率</th><td>%.2f%%</td></tr>
+    </tbody>
+</table>`,
+		g.formatNumber(afterTax.GrossMonthlyBenefits),
+		g.formatNumber(afterTax.NetMonthlyBenefits),
+		g.formatNumber(afterTax.GrossRequiredAmount),
+		g.formatNumber(afterTax.RequiredAmount),
+		judgement,
+		afterTax.SufficiencyRate,
+		g.escape(afterTax.TaxRates.TableVersion),
+		afterTax.TaxRates.HealthInsuranceRate,
+		afterTax.TaxRates.ResidentTaxRate,
+		afterTax.TaxRates.CapitalGainsTaxRate,
+		afterTax.TaxRates.DrawdownTaxRate,
+	)
+}
+
+// retirementIncomeSourcesSection は退職後の月間収支の内訳セクションを生成する
+func (g *HTMLGenerator) retirementIncomeSourcesSection(sources usecases.RetirementIncomeSources) string {
+	rows := []struct {
+		label  string
+		amount float64
+	}{
+		{"年金（厚生年金など）", sources.PensionAmount},
+		{"国民年金（老齢基礎年金）", sources.KokuminNenkinMonthlyAmount},
+		{"公的給付の合計", sources.TotalMonthlyBenefits},
+		{"月間退職後支出", sources.MonthlyRetirementExpenses},
+		{"介護保険料", sources.NurseCareInsuranceMonthly},
+		{"月間不足額（貯蓄から取り崩す額）", sources.MonthlyShortfall},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`
+<h2>退職後の収入源の内訳（月額）</h2>
+<table>
+    <tbody>`)
+	for _, row := range rows {
+		buf.WriteString(`
+        <tr>
+            <td>` + row.label + `</td>
+            <td>¥` + g.formatNumber(row.amount) + `</td>
+        </tr>`)
+	}
+	buf.WriteString(`
+    </tbody>
+</table>`)
+
+	return buf.String()
+}
+
+// retirementLifestylesSection は生活水準ごとの退職資金の比較セクションを生成する
+func (g *HTMLGenerator) retirementLifestylesSection(lifestyles []usecases.RetirementLifestyleComparison) string {
+	if len(lifestyles) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`
+<h2>生活水準別の比較</h2>
+<table>
+    <thead>
+        <tr><th>生活水準</th><th>月間生活費</th><th>必要老後資金</th><th>充足率</th></tr>
+    </thead>
+    <tbody>`)
+	for _, lifestyle := range lifestyles {
+		buf.WriteString(`
+        <tr>
+            <td>` + g.escape(lifestyle.Label) + `</td>
+            <td>¥` + g.formatNumber(lifestyle.MonthlyExpenses) + `</td>
+            <td>¥` + g.formatNumber(lifestyle.RequiredAmount) + `</td>
+            <td>` + fmt.Sprintf("%.1f%%", lifestyle.SufficiencyRate) + `</td>
+        </tr>`)
+	}
+	buf.WriteString(`
+    </tbody>
+</table>`)
+	for _, lifestyle := range lifestyles {
+		buf.WriteString(`
+<p>` + g.escape(lifestyle.Summary) + `</p>`)
+	}
+
+	return buf.String()
 }
 
 // ヘルパー関数
 
+// disclaimerSection は高い想定値に基づく計画であることの注意書きセクションを生成する
+// 読み飛ばされないよう、スタイルはインラインで指定してレポート冒頭に配置する
+func (g *HTMLGenerator) disclaimerSection(disclaimers []string) string {
+	if len(disclaimers) == 0 {
+		return ""
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`
+    <div class="disclaimer" style="border: 2px solid #ef4444; background: #fef2f2; padding: 15px; margin: 20px 0; border-radius: 8px;">
+        <h2 style="color: #b91c1c; margin-top: 0; border: none; page-break-before: auto;">⚠️ 想定値に関する重要な注意</h2>`)
+	for _, disclaimer := range disclaimers {
+		buf.WriteString(`
+        <p style="font-weight: bold;">` + g.escape(disclaimer) + `</p>`)
+	}
+	buf.WriteString(`
+    </div>`)
+
+	return buf.String()
+}
+
+// assumptionsSection は計算前提（利回り・インフレ率・収支・期間・複利頻度・拠出タイミング・税の考慮・計算バージョン・計算日時）のセクションを返す
+// 前提を変更すると結果が変わるため、すべてのレポートに掲載する
+func (g *HTMLGenerator) assumptionsSection(assumptions usecases.Assumptions) string {
+	period := "—"
+	if assumptions.ProjectionYears > 0 {
+		period = fmt.Sprintf("%d年", assumptions.ProjectionYears)
+	}
+	compounding := assumptions.CompoundingFrequency
+	if compounding == usecases.CompoundingFrequencyMonthly {
+		compounding = "月次"
+	}
+	// 拠出タイミングを記録する前に保存した結果は期末拠出で計算されている
+	timing := assumptions.ContributionTiming
+	if timing == "" {
+		timing = valueobjects.DefaultContributionTiming
+	}
+	tax := "考慮しない（手取り月収で計算）"
+	if assumptions.TaxConsidered {
+		tax = "考慮する（額面月収から税・社会保険料を控除）"
+	}
+
+	return fmt.Sprintf(`
+    <div class="section">
+        <h2>計算の前提</h2>
+        <table>
+            <tr><th>投資利回り（年率）</th><td>%.1f%%</td></tr>
+            <tr><th>インフレ率（年率）</th><td>%.1f%%</td></tr>
+            <tr><th>手取り月収</th><td>%s</td></tr>
+            <tr><th>月間支出合計</th><td>%s</td></tr>
+            <tr><th>月間純貯蓄額</th><td>%s</td></tr>
+            <tr><th>計算期間</th><td>%s</td></tr>
+            <tr><th>複利頻度</th><td>%s</td></tr>
+            <tr><th>拠出タイミング</th><td>%s</td></tr>
+            <tr><th>税・社会保険料</th><td>%s</td></tr>
+            <tr><th>計算バージョン</th><td>%d</td></tr>
+            <tr><th>計算日時</th><td>%s</td></tr>
+        </table>
+        <p>前提を変更すると計算結果も変わります。運用益への課税は考慮していません。</p>
+    </div>`,
+		assumptions.InvestmentReturn,
+		assumptions.InflationRate,
+		"¥"+g.formatNumber(assumptions.MonthlyIncome),
+		"¥"+g.formatNumber(assumptions.MonthlyExpensesTotal),
+		"¥"+g.formatNumber(assumptions.NetSavings),
+		period,
+		g.escape(compounding),
+		g.escape(timing.String()),
+		tax,
+		assumptions.CalculationVersion,
+		assumptions.CalculatedAt.Format("2006-01-02 15:04"),
+	)
+}
+
+// failedSectionHTML は生成に失敗したセクションの代わりに表示する見出しと理由を返す
+func (g *HTMLGenerator) failedSectionHTML(title string, section usecases.ReportSection, failures []usecases.SectionError) string {
+	reason := "このセクションは生成できませんでした"
+	for _, failure := range failures {
+		if failure.Section == section {
+			reason += "（" + failure.Reason + "）"
+			break
+		}
+	}
+	return `
+    <h2>` + g.escape(title) + `</h2>
+    <p>` + g.escape(reason) + `</p>`
+}
+
+// escape はユーザー入力を含む文字列をHTMLエスケープする
+// 目標タイトルや説明などはHTMLに埋め込む前に必ずこの関数を通すこと
+func (g *HTMLGenerator) escape(s string) string {
+	return html.EscapeString(s)
+}
+
 func (g *HTMLGenerator) formatNumber(num float64) string {
 	// カンマ区切りで数値をフォーマット
 	str := fmt.Sprintf("%.0f", num)
@@ -501,6 +734,7 @@ func (g *HTMLGenerator) getTrendIcon(trend string) string {
 }
 
 // JSONGenerator はJSON形式でレポートを生成する
+// 文字列は encoding/json により <, >, & が \u003c などにエスケープされるため、HTMLエスケープは行わない
 type JSONGenerator struct{}
 
 // NewJSONGenerator は新しいJSONGeneratorを作成する
@@ -534,7 +768,7 @@ func (g *JSONGenerator) GenerateRetirementPlanPDF(report *usecases.RetirementPla
 }
 
 // HTMLGeneratorAdapter は HTMLGenerator を usecases.ReportPDFGenerator インターフェースに適合させるアダプター
-// usecases.ReportPDFGenerator は Generate(reportType string, reportData interface{}) ([]byte, error) を要求する
+// usecases.ReportPDFGenerator は Generate(reportType usecases.ReportType, reportData interface{}) ([]byte, error) を要求する
 type HTMLGeneratorAdapter struct {
 	generator *HTMLGenerator
 }
@@ -546,40 +780,47 @@ func NewHTMLGeneratorAdapter() *HTMLGeneratorAdapter {
 	}
 }
 
+// NewHTMLGeneratorAdapterWithCharts はチャートを画像として埋め込む HTMLGeneratorAdapter を作成する
+func NewHTMLGeneratorAdapterWithCharts(charts *ChartRenderer) *HTMLGeneratorAdapter {
+	return &HTMLGeneratorAdapter{
+		generator: NewHTMLGeneratorWithCharts(charts),
+	}
+}
+
 // Generate はレポートタイプに応じて HTMLGenerator の適切なメソッドを呼び出す
-func (a *HTMLGeneratorAdapter) Generate(reportType string, reportData interface{}) ([]byte, error) {
+func (a *HTMLGeneratorAdapter) Generate(reportType usecases.ReportType, reportData interface{}) ([]byte, error) {
 	switch reportType {
-	case "financial_summary":
+	case usecases.ReportTypeFinancialSummary:
 		report, ok := reportData.(usecases.FinancialSummaryReport)
 		if !ok {
 			return nil, fmt.Errorf("無効なレポートデータ型です（financial_summary）")
 		}
 		return a.generator.GenerateFinancialSummaryPDF(&report)
-	case "comprehensive":
+	case usecases.ReportTypeComprehensive:
 		report, ok := reportData.(usecases.ComprehensiveReport)
 		if !ok {
 			return nil, fmt.Errorf("無効なレポートデータ型です（comprehensive）")
 		}
 		return a.generator.GenerateComprehensivePDF(&report)
-	case "asset_projection":
+	case usecases.ReportTypeAssetProjection:
 		report, ok := reportData.(usecases.AssetProjectionReport)
 		if !ok {
 			return nil, fmt.Errorf("無効なレポートデータ型です（asset_projection）")
 		}
 		return a.generator.GenerateAssetProjectionPDF(&report)
-	case "goals_progress":
+	case usecases.ReportTypeGoalsProgress:
 		report, ok := reportData.(usecases.GoalsProgressReport)
 		if !ok {
 			return nil, fmt.Errorf("無効なレポートデータ型です（goals_progress）")
 		}
 		return a.generator.GenerateGoalsProgressPDF(&report)
-	case "retirement_plan":
+	case usecases.ReportTypeRetirementPlan:
 		report, ok := reportData.(usecases.RetirementPlanReport)
 		if !ok {
 			return nil, fmt.Errorf("無効なレポートデータ型です（retirement_plan）")
 		}
 		return a.generator.GenerateRetirementPlanPDF(&report)
 	default:
-		return nil, fmt.Errorf("サポートされていないレポートタイプです: %s", reportType)
+		return nil, fmt.Errorf("サポートされていないレポートタイプです: %s", string(reportType))
 	}
 }
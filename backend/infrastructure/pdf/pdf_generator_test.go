@@ -1,10 +1,13 @@
 package pdf
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 )
 
 func TestHTMLGenerator_FormatNumber(t *testing.T) {
@@ -64,6 +67,16 @@ func TestHTMLGenerator_GenerateFinancialSummaryPDF(t *testing.T) {
 		},
 		Recommendations: []string{"月間支出を詳細に分析してください"},
 		Warnings:        []string{"緊急資金が3ヶ月分の生活費を下回っています"},
+		Assumptions: usecases.Assumptions{
+			InvestmentReturn:     5.0,
+			InflationRate:        2.0,
+			CompoundingFrequency: usecases.CompoundingFrequencyMonthly,
+			MonthlyIncome:        400000,
+			MonthlyExpensesTotal: 280000,
+			NetSavings:           120000,
+			CalculationVersion:   2,
+			CalculatedAt:         time.Date(2024, 11, 13, 9, 30, 0, 0, time.UTC),
+		},
 	}
 
 	html, err := generator.GenerateFinancialSummaryPDF(report)
@@ -88,6 +101,13 @@ func TestHTMLGenerator_GenerateFinancialSummaryPDF(t *testing.T) {
 		"主要指標",
 		"推奨事項",
 		"注意事項",
+		"計算の前提",
+		"<tr><th>複利頻度</th><td>月次</td></tr>",
+		"<tr><th>拠出タイミング</th><td>期末拠出</td></tr>",
+		"<tr><th>月間支出合計</th><td>¥280,000</td></tr>",
+		"<tr><th>月間純貯蓄額</th><td>¥120,000</td></tr>",
+		"<tr><th>計算バージョン</th><td>2</td></tr>",
+		"2024-11-13 09:30",
 	}
 
 	for _, element := range requiredElements {
@@ -117,11 +137,11 @@ func TestHTMLGenerator_GenerateComprehensivePDF(t *testing.T) {
 				TotalAssets:     1500000,
 			},
 		},
-		AssetProjection: usecases.AssetProjectionReport{
+		AssetProjection: &usecases.AssetProjectionReport{
 			ProjectionYears: 10,
 			Projections:     []entities.AssetProjection{},
 		},
-		GoalsProgress: usecases.GoalsProgressReport{
+		GoalsProgress: &usecases.GoalsProgressReport{
 			Summary: usecases.GoalsSummary{
 				TotalGoals:      3,
 				ActiveGoals:     2,
@@ -173,6 +193,197 @@ func TestHTMLGenerator_GenerateComprehensivePDF(t *testing.T) {
 	}
 }
 
+func TestHTMLGenerator_GenerateComprehensivePDF_FailedSections(t *testing.T) {
+	generator := NewHTMLGenerator()
+
+	report := &usecases.ComprehensiveReport{
+		UserID: entities.UserID("test-user"),
+		GoalsProgress: &usecases.GoalsProgressReport{
+			Summary: usecases.GoalsSummary{TotalGoals: 1},
+		},
+		SectionsFailed: []usecases.SectionError{
+			{Section: usecases.ReportSectionAssetProjection, Reason: "資産推移レポートの生成に失敗しました: <timeout>"},
+		},
+	}
+
+	html, err := generator.GenerateComprehensivePDF(report)
+	if err != nil {
+		t.Fatalf("GenerateComprehensivePDF failed: %v", err)
+	}
+
+	htmlStr := string(html)
+	for _, element := range []string{
+		"資産推移予測",
+		"このセクションは生成できませんでした（資産推移レポートの生成に失敗しました: &lt;timeout&gt;）",
+		"目標進捗状況",
+		"総目標数: 1",
+	} {
+		if !contains(htmlStr, element) {
+			t.Errorf("Generated HTML does not contain expected element: %s", element)
+		}
+	}
+}
+
+func TestHTMLGenerator_GenerateRetirementPlanPDF_AfterTax(t *testing.T) {
+	generator := NewHTMLGenerator()
+
+	report := &usecases.RetirementPlanReport{
+		UserID: entities.UserID("test-user"),
+		AfterTax: usecases.RetirementAfterTax{
+			GrossMonthlyBenefits: 250000,
+			NetMonthlyBenefits:   222769,
+			GrossRequiredAmount:  20000000,
+			RequiredAmount:       31000000,
+			Shortfall:            1000000,
+			SufficiencyRate:      96.8,
+			TaxRates: usecases.RetirementTaxRates{
+				TableVersion:        "R6",
+				HealthInsuranceRate: 9,
+				ResidentTaxRate:     10,
+				CapitalGainsTaxRate: 20.315,
+				DrawdownTaxRate:     8.5,
+			},
+		},
+	}
+
+	html, err := generator.GenerateRetirementPlanPDF(report)
+	if err != nil {
+		t.Fatalf("GenerateRetirementPlanPDF failed: %v", err)
+	}
+
+	htmlStr := string(html)
+	for _, element := range []string{
+		"税・社会保険料を考慮した老後資金",
+		"<tr><td>公的給付（月額）</td><td>¥250,000</td><td>¥222,769</td></tr>",
+		"<tr><td>必要老後資金</td><td>¥20,000,000</td><td>¥31,000,000</td></tr>",
+		"手取りベースでは老後資金が¥1,000,000不足する見込みです（充足率 96.8%）",
+		"<tr><th>運用益の税率</th><td>20.315%</td></tr>",
+		"<tr><th>取り崩し額に対する実効税率</th><td>8.50%</td></tr>",
+	} {
+		if !contains(htmlStr, element) {
+			t.Errorf("Generated HTML does not contain expected element: %s", element)
+		}
+	}
+}
+
+func TestHTMLGenerator_EscapesUserInput(t *testing.T) {
+	generator := NewHTMLGenerator()
+
+	script := `<script>alert("xss")</script>`
+	goal, err := entities.NewGoal(
+		entities.UserID("test-user"),
+		entities.GoalTypeSavings,
+		script,
+		mustNewMoneyJPY(t, 1000000),
+		time.Now().AddDate(1, 0, 0),
+		mustNewMoneyJPY(t, 10000),
+	)
+	if err != nil {
+		t.Fatalf("目標の作成に失敗しました: %v", err)
+	}
+
+	report := &usecases.ComprehensiveReport{
+		UserID: entities.UserID("test-user"),
+		ExecutiveSummary: usecases.ExecutiveSummary{
+			OverallStatus: script,
+		},
+		GoalsProgress: &usecases.GoalsProgressReport{
+			Goals: []usecases.GoalProgress{{Goal: goal, Status: "on_track"}},
+		},
+		ActionPlan: usecases.ActionPlan{
+			ShortTerm: []usecases.ActionItem{
+				{Priority: `high" onclick="alert(1)`, Title: script, Description: script},
+			},
+		},
+	}
+
+	html, err := generator.GenerateComprehensivePDF(report)
+	if err != nil {
+		t.Fatalf("GenerateComprehensivePDF failed: %v", err)
+	}
+
+	htmlStr := string(html)
+	if contains(htmlStr, "<script>") {
+		t.Error("ユーザー入力のscriptタグがエスケープされずに出力されています")
+	}
+	if contains(htmlStr, `onclick="alert(1)`) {
+		t.Error("属性値に埋め込まれたユーザー入力がエスケープされていません")
+	}
+	if !contains(htmlStr, "&lt;script&gt;alert(&#34;xss&#34;)&lt;/script&gt;") {
+		t.Error("エスケープされたタイトルが出力されていません")
+	}
+}
+
+func TestHTMLGenerator_EmbedsCharts(t *testing.T) {
+	goal, err := entities.NewGoal(
+		entities.UserID("test-user"),
+		entities.GoalTypeSavings,
+		"住宅購入の頭金",
+		mustNewMoneyJPY(t, 5000000),
+		time.Now().AddDate(3, 0, 0),
+		mustNewMoneyJPY(t, 150000),
+	)
+	if err != nil {
+		t.Fatalf("目標の作成に失敗しました: %v", err)
+	}
+	progress, err := entities.NewProgressRate(25)
+	if err != nil {
+		t.Fatalf("進捗率の作成に失敗しました: %v", err)
+	}
+
+	report := &usecases.ComprehensiveReport{
+		UserID: entities.UserID("test-user"),
+		FinancialSummary: usecases.FinancialSummaryReport{
+			AssetAllocation: []usecases.AssetAllocation{
+				{Type: "deposit", Label: "預金", Amount: 1000000, Percentage: 66.7},
+				{Type: "investment", Label: "投資", Amount: 500000, Percentage: 33.3},
+			},
+		},
+		AssetProjection: &usecases.AssetProjectionReport{
+			ProjectionYears: 2,
+			Projections: []entities.AssetProjection{
+				{Year: 1, TotalAssets: mustNewMoneyJPY(t, 3000000), RealValue: mustNewMoneyJPY(t, 2940000), ContributedAmount: mustNewMoneyJPY(t, 2900000)},
+				{Year: 2, TotalAssets: mustNewMoneyJPY(t, 4600000), RealValue: mustNewMoneyJPY(t, 4420000), ContributedAmount: mustNewMoneyJPY(t, 4300000)},
+			},
+		},
+		GoalsProgress: &usecases.GoalsProgressReport{
+			Goals: []usecases.GoalProgress{{Goal: goal, Progress: progress, Status: "on_track"}},
+		},
+	}
+
+	t.Run("チャートを設定した場合は資産成長曲線・目標進捗バー・アセットアロケーションを画像として埋め込む", func(t *testing.T) {
+		renderer, err := NewChartRenderer(ChartConfig{Width: 400, Height: 200})
+		if err != nil {
+			t.Fatalf("NewChartRenderer failed: %v", err)
+		}
+
+		html, err := NewHTMLGeneratorWithCharts(renderer).GenerateComprehensivePDF(report)
+		if err != nil {
+			t.Fatalf("GenerateComprehensivePDF failed: %v", err)
+		}
+
+		htmlStr := string(html)
+		for _, alt := range []string{"資産成長曲線", "目標進捗率", "アセットアロケーション"} {
+			if !contains(htmlStr, `" alt="`+alt+`"`) {
+				t.Errorf("%sのチャートが埋め込まれていません", alt)
+			}
+		}
+		if strings.Count(htmlStr, `<img src="data:image/png;base64,`) != 3 {
+			t.Errorf("Expected 3 embedded PNG images")
+		}
+	})
+
+	t.Run("チャートを設定しない場合は画像を埋め込まない", func(t *testing.T) {
+		html, err := NewHTMLGenerator().GenerateComprehensivePDF(report)
+		if err != nil {
+			t.Fatalf("GenerateComprehensivePDF failed: %v", err)
+		}
+		if contains(string(html), "<img") {
+			t.Error("チャートを設定していないのに画像が埋め込まれています")
+		}
+	})
+}
+
 func TestJSONGenerator_GenerateFinancialSummaryPDF(t *testing.T) {
 	generator := NewJSONGenerator()
 
@@ -205,6 +416,15 @@ func TestJSONGenerator_GenerateFinancialSummaryPDF(t *testing.T) {
 }
 
 // ヘルパー関数
+func mustNewMoneyJPY(t *testing.T, amount float64) valueobjects.Money {
+	t.Helper()
+	money, err := valueobjects.NewMoneyJPY(amount)
+	if err != nil {
+		t.Fatalf("金額の作成に失敗しました: %v", err)
+	}
+	return money
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && containsHelper(s, substr))
 }
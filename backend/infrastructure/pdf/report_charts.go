@@ -0,0 +1,142 @@
+package pdf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+)
+
+// goalChartLabelMaxRunes は目標進捗バーの目標名の最大文字数（超える場合は省略する）
+const goalChartLabelMaxRunes = 12
+
+// assetGrowthChart は資産推移の予測から資産成長曲線（総資産・実質価値・積立元本）を作成する
+func assetGrowthChart(projections []entities.AssetProjection) LineChart {
+	years := make([]float64, 0, len(projections))
+	totals := make([]float64, 0, len(projections))
+	realValues := make([]float64, 0, len(projections))
+	contributed := make([]float64, 0, len(projections))
+	for _, p := range projections {
+		years = append(years, float64(p.Year))
+		totals = append(totals, p.TotalAssets.Amount())
+		realValues = append(realValues, p.RealValue.Amount())
+		contributed = append(contributed, p.ContributedAmount.Amount())
+	}
+
+	return LineChart{
+		Title:      "資産成長曲線",
+		XAxisLabel: "経過年数",
+		YAxisLabel: "金額",
+		XValues:    years,
+		Series: []ChartSeries{
+			{Name: "総資産", YValues: totals},
+			{Name: "実質価値（インフレ調整後）", YValues: realValues},
+			{Name: "積立元本", YValues: contributed},
+		},
+		FormatX: func(v float64) string { return fmt.Sprintf("%.0f年後", v) },
+		FormatY: formatManYen,
+	}
+}
+
+// goalProgressChart は目標ごとの進捗率のバーを作成する
+func goalProgressChart(goals []usecases.GoalProgress) BarChart {
+	bars := make([]ChartValue, 0, len(goals))
+	for _, goalProgress := range goals {
+		bars = append(bars, ChartValue{
+			Label: truncateRunes(goalProgress.Goal.Title(), goalChartLabelMaxRunes),
+			Value: goalProgress.Progress.AsPercentage(),
+		})
+	}
+
+	return BarChart{
+		Title:   "目標進捗率",
+		Bars:    bars,
+		Max:     100,
+		FormatY: func(v float64) string { return fmt.Sprintf("%.0f%%", v) },
+	}
+}
+
+// assetAllocationChart は貯蓄の種類ごとの内訳からアセットアロケーションの円グラフを作成する
+func assetAllocationChart(allocation []usecases.AssetAllocation) PieChart {
+	slices := make([]ChartValue, 0, len(allocation))
+	for _, a := range allocation {
+		slices = append(slices, ChartValue{
+			Label: fmt.Sprintf("%s %.1f%%", a.Label, a.Percentage),
+			Value: a.Amount,
+		})
+	}
+
+	return PieChart{
+		Title:  "アセットアロケーション",
+		Slices: slices,
+	}
+}
+
+// chartSection はチャートをPNG画像としてHTMLに埋め込む
+// チャートを設定していない場合やデータが不足している場合は空文字を返し、描画に失敗した場合はレポートの生成は続けて代わりに案内を表示する
+func (g *HTMLGenerator) chartSection(alt string, render func(*ChartRenderer) ([]byte, error)) string {
+	if g.charts == nil {
+		return ""
+	}
+
+	image, err := render(g.charts)
+	if err != nil {
+		slog.Warn("レポートのチャートの生成に失敗しました", slog.String("chart", alt), slog.Any("error", err))
+		return `
+    <p class="chart-error">` + g.escape(alt) + `のチャートを生成できませんでした</p>`
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`
+    <div class="chart"><img src="data:image/png;base64,`)
+	buf.WriteString(base64.StdEncoding.EncodeToString(image))
+	buf.WriteString(`" alt="` + g.escape(alt) + `" style="max-width: 100%; height: auto;"></div>`)
+	return buf.String()
+}
+
+// assetGrowthChartSection は資産成長曲線のセクションを返す（予測が2年分未満の場合は表示しない）
+func (g *HTMLGenerator) assetGrowthChartSection(projections []entities.AssetProjection) string {
+	if len(projections) < 2 {
+		return ""
+	}
+	return g.chartSection("資産成長曲線", func(r *ChartRenderer) ([]byte, error) {
+		return r.RenderLineChart(assetGrowthChart(projections))
+	})
+}
+
+// goalProgressChartSection は目標進捗バーのセクションを返す（目標がない場合は表示しない）
+func (g *HTMLGenerator) goalProgressChartSection(goals []usecases.GoalProgress) string {
+	if len(goals) == 0 {
+		return ""
+	}
+	return g.chartSection("目標進捗率", func(r *ChartRenderer) ([]byte, error) {
+		return r.RenderBarChart(goalProgressChart(goals))
+	})
+}
+
+// assetAllocationChartSection はアセットアロケーションの円グラフのセクションを返す（貯蓄がない場合は表示しない）
+func (g *HTMLGenerator) assetAllocationChartSection(allocation []usecases.AssetAllocation) string {
+	if len(allocation) == 0 {
+		return ""
+	}
+	return g.chartSection("アセットアロケーション", func(r *ChartRenderer) ([]byte, error) {
+		return r.RenderPieChart(assetAllocationChart(allocation))
+	})
+}
+
+// formatManYen は金額を万円単位で表す（軸の目盛り用）
+func formatManYen(amount float64) string {
+	return fmt.Sprintf("%.0f万円", amount/10000)
+}
+
+// truncateRunes は文字数が max を超える文字列を省略記号付きで切り詰める
+func truncateRunes(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,17 +24,24 @@ type rateDTO struct {
 // --- Goal DTO ---
 
 type goalCacheDTO struct {
-	ID                  string    `json:"id"`
-	UserID              string    `json:"user_id"`
-	GoalType            string    `json:"goal_type"`
-	Title               string    `json:"title"`
-	TargetAmount        moneyDTO  `json:"target_amount"`
-	TargetDate          time.Time `json:"target_date"`
-	CurrentAmount       moneyDTO  `json:"current_amount"`
-	MonthlyContribution moneyDTO  `json:"monthly_contribution"`
-	IsActive            bool      `json:"is_active"`
-	CreatedAt           time.Time `json:"created_at"`
-	UpdatedAt           time.Time `json:"updated_at"`
+	ID                  string           `json:"id"`
+	UserID              string           `json:"user_id"`
+	GoalType            string           `json:"goal_type"`
+	Title               string           `json:"title"`
+	TargetAmount        moneyDTO         `json:"target_amount"`
+	TargetDate          time.Time        `json:"target_date"`
+	CurrentAmount       moneyDTO         `json:"current_amount"`
+	MonthlyContribution moneyDTO         `json:"monthly_contribution"`
+	ContributionMin     *moneyDTO        `json:"contribution_min,omitempty"`
+	ContributionStretch *moneyDTO        `json:"contribution_stretch,omitempty"`
+	IsActive            bool             `json:"is_active"`
+	CreatedAt           time.Time        `json:"created_at"`
+	UpdatedAt           time.Time        `json:"updated_at"`
+	DependsOnGoalIDs    []string         `json:"depends_on_goal_ids,omitempty"`
+	Notes               string           `json:"notes,omitempty"`
+	ImageURL            string           `json:"image_url,omitempty"`
+	GoalCurrency        *goalCurrencyDTO `json:"goal_currency,omitempty"`
+	OverduePolicy       string           `json:"overdue_policy,omitempty"`
 }
 
 func goalToDTO(g *entities.Goal) goalCacheDTO {
@@ -55,12 +63,50 @@ func goalToDTO(g *entities.Goal) goalCacheDTO {
 			Amount:   g.MonthlyContribution().Amount(),
 			Currency: string(g.MonthlyContribution().Currency()),
 		},
-		IsActive:  g.IsActive(),
-		CreatedAt: g.CreatedAt(),
-		UpdatedAt: g.UpdatedAt(),
+		ContributionMin:     optionalMoneyToDTO(g.ContributionMin()),
+		ContributionStretch: optionalMoneyToDTO(g.ContributionStretch()),
+		IsActive:            g.IsActive(),
+		CreatedAt:           g.CreatedAt(),
+		UpdatedAt:           g.UpdatedAt(),
+		DependsOnGoalIDs:    goalIDsToStrings(g.DependsOn()),
+		Notes:               g.Notes(),
+		ImageURL:            g.ImageURL(),
+		GoalCurrency:        goalCurrencyToDTO(g),
+		OverduePolicy:       string(g.OverduePolicy()),
 	}
 }
 
+// optionalMoneyToDTO は任意の金額をDTOに変換する（nilの場合はnil）
+func optionalMoneyToDTO(money *valueobjects.Money) *moneyDTO {
+	if money == nil {
+		return nil
+	}
+	return &moneyDTO{Amount: money.Amount(), Currency: string(money.Currency())}
+}
+
+// optionalMoneyFromDTO はDTOから任意の金額を復元する（nilの場合はnil）
+func optionalMoneyFromDTO(dto *moneyDTO) (*valueobjects.Money, error) {
+	if dto == nil {
+		return nil, nil
+	}
+	money, err := valueobjects.NewMoney(dto.Amount, valueobjects.Currency(dto.Currency))
+	if err != nil {
+		return nil, err
+	}
+	return &money, nil
+}
+
+func goalIDsToStrings(ids []entities.GoalID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = string(id)
+	}
+	return result
+}
+
 func goalFromDTO(dto goalCacheDTO) (*entities.Goal, error) {
 	targetAmount, err := valueobjects.NewMoney(dto.TargetAmount.Amount, valueobjects.Currency(dto.TargetAmount.Currency))
 	if err != nil {
@@ -99,6 +145,27 @@ func goalFromDTO(dto goalCacheDTO) (*entities.Goal, error) {
 		goal.Deactivate()
 	}
 
+	if len(dto.DependsOnGoalIDs) > 0 {
+		goal.RestoreDependencies(stringsToGoalIDs(dto.DependsOnGoalIDs))
+	}
+
+	goal.RestoreMetadata(dto.Notes, dto.ImageURL)
+
+	if err := restoreGoalCurrencyFromDTO(goal, dto.GoalCurrency); err != nil {
+		return nil, err
+	}
+
+	contributionMin, err := optionalMoneyFromDTO(dto.ContributionMin)
+	if err != nil {
+		return nil, fmt.Errorf("月間拠出額の最低額の復元に失敗しました: %w", err)
+	}
+	contributionStretch, err := optionalMoneyFromDTO(dto.ContributionStretch)
+	if err != nil {
+		return nil, fmt.Errorf("月間拠出額のストレッチ額の復元に失敗しました: %w", err)
+	}
+	goal.RestoreContributionRange(contributionMin, contributionStretch)
+	goal.RestoreOverduePolicy(entities.GoalOverduePolicy(dto.OverduePolicy))
+
 	return goal, nil
 }
 
@@ -136,37 +203,156 @@ type savingsItemDTO struct {
 	Description string   `json:"description,omitempty"`
 }
 
+type liabilityDTO struct {
+	ID                 string    `json:"id"`
+	LiabilityType      string    `json:"liability_type"`
+	Name               string    `json:"name,omitempty"`
+	PrincipalRemaining moneyDTO  `json:"principal_remaining"`
+	InterestRate       rateDTO   `json:"interest_rate"`
+	MonthlyPayment     moneyDTO  `json:"monthly_payment"`
+	EndDate            time.Time `json:"end_date"`
+	IncludedInExpenses bool      `json:"included_in_expenses,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+func liabilityToDTO(l *entities.Liability) liabilityDTO {
+	return liabilityDTO{
+		ID:                 string(l.ID()),
+		LiabilityType:      string(l.LiabilityType()),
+		Name:               l.Name(),
+		PrincipalRemaining: moneyDTO{Amount: l.PrincipalRemaining().Amount(), Currency: string(l.PrincipalRemaining().Currency())},
+		InterestRate:       rateDTO{Value: l.InterestRate().AsPercentage()},
+		MonthlyPayment:     moneyDTO{Amount: l.MonthlyPayment().Amount(), Currency: string(l.MonthlyPayment().Currency())},
+		EndDate:            l.EndDate(),
+		IncludedInExpenses: l.IncludedInExpenses(),
+		CreatedAt:          l.CreatedAt(),
+		UpdatedAt:          l.UpdatedAt(),
+	}
+}
+
+func liabilityFromDTO(dto liabilityDTO) (*entities.Liability, error) {
+	principal, err := valueobjects.NewMoney(dto.PrincipalRemaining.Amount, valueobjects.Currency(dto.PrincipalRemaining.Currency))
+	if err != nil {
+		return nil, fmt.Errorf("負債残高の復元に失敗しました: %w", err)
+	}
+	interestRate, err := valueobjects.NewRate(dto.InterestRate.Value)
+	if err != nil {
+		return nil, fmt.Errorf("負債の金利の復元に失敗しました: %w", err)
+	}
+	monthlyPayment, err := valueobjects.NewMoney(dto.MonthlyPayment.Amount, valueobjects.Currency(dto.MonthlyPayment.Currency))
+	if err != nil {
+		return nil, fmt.Errorf("負債の返済額の復元に失敗しました: %w", err)
+	}
+	return entities.NewLiabilityWithID(
+		entities.LiabilityID(dto.ID),
+		entities.LiabilityType(dto.LiabilityType),
+		dto.Name,
+		principal,
+		interestRate,
+		monthlyPayment,
+		dto.EndDate,
+		dto.IncludedInExpenses,
+		dto.CreatedAt,
+		dto.UpdatedAt,
+	)
+}
+
+type lifeEventDTO struct {
+	ID            string    `json:"id"`
+	Category      string    `json:"category"`
+	Name          string    `json:"name"`
+	Year          int       `json:"year"`
+	Recurrence    string    `json:"recurrence"`
+	AmountChange  moneyDTO  `json:"amount_change"`
+	DurationYears int       `json:"duration_years,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func lifeEventToDTO(e *entities.LifeEvent) lifeEventDTO {
+	return lifeEventDTO{
+		ID:            string(e.ID()),
+		Category:      string(e.Category()),
+		Name:          e.Name(),
+		Year:          e.Year(),
+		Recurrence:    string(e.Recurrence()),
+		AmountChange:  moneyDTO{Amount: e.AmountChange().Amount(), Currency: string(e.AmountChange().Currency())},
+		DurationYears: e.DurationYears(),
+		CreatedAt:     e.CreatedAt(),
+		UpdatedAt:     e.UpdatedAt(),
+	}
+}
+
+func lifeEventFromDTO(dto lifeEventDTO) (*entities.LifeEvent, error) {
+	amountChange, err := valueobjects.NewMoney(dto.AmountChange.Amount, valueobjects.Currency(dto.AmountChange.Currency))
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントの増減額の復元に失敗しました: %w", err)
+	}
+	return entities.NewLifeEventWithID(
+		entities.LifeEventID(dto.ID),
+		entities.LifeEventCategory(dto.Category),
+		dto.Name,
+		dto.Year,
+		entities.LifeEventRecurrence(dto.Recurrence),
+		amountChange,
+		dto.DurationYears,
+		dto.CreatedAt,
+		dto.UpdatedAt,
+	)
+}
+
 type financialProfileCacheDTO struct {
-	ID               string           `json:"id"`
-	UserID           string           `json:"user_id"`
-	MonthlyIncome    moneyDTO         `json:"monthly_income"`
-	MonthlyExpenses  []expenseItemDTO `json:"monthly_expenses"`
-	CurrentSavings   []savingsItemDTO `json:"current_savings"`
-	InvestmentReturn rateDTO          `json:"investment_return"`
-	InflationRate    rateDTO          `json:"inflation_rate"`
-	CreatedAt        time.Time        `json:"created_at"`
-	UpdatedAt        time.Time        `json:"updated_at"`
+	ID                        string           `json:"id"`
+	UserID                    string           `json:"user_id"`
+	MonthlyIncome             moneyDTO         `json:"monthly_income"`
+	IncomeType                string           `json:"income_type,omitempty"`
+	NetMonthlyIncome          moneyDTO         `json:"net_monthly_income"`
+	BirthDate                 *time.Time       `json:"birth_date,omitempty"`
+	ExpectedVolatility        *rateDTO         `json:"expected_volatility,omitempty"`
+	RiskTolerance             string           `json:"risk_tolerance,omitempty"`
+	MonthlyExpenses           []expenseItemDTO `json:"monthly_expenses"`
+	CurrentSavings            []savingsItemDTO `json:"current_savings"`
+	Liabilities               []liabilityDTO   `json:"liabilities,omitempty"`
+	LifeEvents                []lifeEventDTO   `json:"life_events,omitempty"`
+	InvestmentReturn          rateDTO          `json:"investment_return"`
+	InflationRate             rateDTO          `json:"inflation_rate"`
+	HighReturnAcknowledged    bool             `json:"high_return_acknowledged,omitempty"`
+	HighInflationAcknowledged bool             `json:"high_inflation_acknowledged,omitempty"`
+	CreatedAt                 time.Time        `json:"created_at"`
+	UpdatedAt                 time.Time        `json:"updated_at"`
 }
 
 // --- RetirementData DTO ---
 
 type retirementDataCacheDTO struct {
-	ID                        string    `json:"id"`
-	UserID                    string    `json:"user_id"`
-	CurrentAge                int       `json:"current_age"`
-	RetirementAge             int       `json:"retirement_age"`
-	LifeExpectancy            int       `json:"life_expectancy"`
-	MonthlyRetirementExpenses moneyDTO  `json:"monthly_retirement_expenses"`
-	PensionAmount             moneyDTO  `json:"pension_amount"`
-	CreatedAt                 time.Time `json:"created_at"`
-	UpdatedAt                 time.Time `json:"updated_at"`
+	ID                        string   `json:"id"`
+	UserID                    string   `json:"user_id"`
+	CurrentAge                int      `json:"current_age"`
+	RetirementAge             int      `json:"retirement_age"`
+	LifeExpectancy            int      `json:"life_expectancy"`
+	MonthlyRetirementExpenses moneyDTO `json:"monthly_retirement_expenses"`
+	PensionAmount             moneyDTO `json:"pension_amount"`
+	// 国民年金・介護保険料の月額（追加前にキャッシュされたデータでは nil）
+	KokuminNenkinMonthly      *moneyDTO  `json:"kokumin_nenkin_monthly,omitempty"`
+	NurseCareInsuranceMonthly *moneyDTO  `json:"nurse_care_insurance_monthly,omitempty"`
+	LastAgeUpdateDate         *time.Time `json:"last_age_update_date,omitempty"`
+	CreatedAt                 time.Time  `json:"created_at"`
+	UpdatedAt                 time.Time  `json:"updated_at"`
 }
 
-// --- EmergencyFundConfig DTO ---
+// --- EmergencyFund DTO ---
+
+type emergencyFundContributionDTO struct {
+	Amount        moneyDTO  `json:"amount"`
+	ContributedAt time.Time `json:"contributed_at"`
+}
 
 type emergencyFundConfigDTO struct {
-	TargetMonths int      `json:"target_months"`
-	CurrentFund  moneyDTO `json:"current_fund"`
+	TargetMonths        int                            `json:"target_months"`
+	CurrentFund         moneyDTO                       `json:"current_fund"`
+	MonthlyContribution *moneyDTO                      `json:"monthly_contribution,omitempty"` // 積立予定額の追加前のキャッシュには存在しない
+	Contributions       []emergencyFundContributionDTO `json:"contributions,omitempty"`
 }
 
 // --- FinancialPlan DTO ---
@@ -179,6 +365,10 @@ type financialPlanCacheDTO struct {
 	EmergencyFund  *emergencyFundConfigDTO   `json:"emergency_fund,omitempty"`
 	CreatedAt      time.Time                 `json:"created_at"`
 	UpdatedAt      time.Time                 `json:"updated_at"`
+	LastCalculatedAt     *time.Time `json:"last_calculated_at,omitempty"`
+	LastProfileUpdatedAt time.Time  `json:"last_profile_updated_at"`
+	LastReviewedAt       time.Time  `json:"last_reviewed_at"`
+	Version              int        `json:"version"`
 }
 
 func financialPlanToDTO(plan *aggregates.FinancialPlan) financialPlanCacheDTO {
@@ -206,20 +396,40 @@ func financialPlanToDTO(plan *aggregates.FinancialPlan) financialPlanCacheDTO {
 		ID:              string(profile.ID()),
 		UserID:          string(profile.UserID()),
 		MonthlyIncome:   moneyDTO{Amount: profile.MonthlyIncome().Amount(), Currency: string(profile.MonthlyIncome().Currency())},
+		IncomeType:       string(profile.IncomeType()),
+		NetMonthlyIncome: moneyDTO{Amount: profile.NetMonthlyIncome().Amount(), Currency: string(profile.NetMonthlyIncome().Currency())},
+		BirthDate:        profile.BirthDate(),
+		RiskTolerance:    string(profile.RiskTolerance()),
 		MonthlyExpenses: expenses,
 		CurrentSavings:  savings,
 		InvestmentReturn: rateDTO{Value: profile.InvestmentReturn().AsPercentage()},
 		InflationRate:    rateDTO{Value: profile.InflationRate().AsPercentage()},
+		HighReturnAcknowledged:    profile.HighReturnAcknowledged(),
+		HighInflationAcknowledged: profile.HighInflationAcknowledged(),
 		CreatedAt:       profile.CreatedAt(),
 		UpdatedAt:       profile.UpdatedAt(),
 	}
 
+	if volatility, ok := profile.ExpectedVolatility(); ok {
+		profileDTO.ExpectedVolatility = &rateDTO{Value: volatility.AsPercentage()}
+	}
+	for _, liability := range profile.Liabilities() {
+		profileDTO.Liabilities = append(profileDTO.Liabilities, liabilityToDTO(liability))
+	}
+	for _, event := range profile.LifeEvents() {
+		profileDTO.LifeEvents = append(profileDTO.LifeEvents, lifeEventToDTO(event))
+	}
+
 	dto := financialPlanCacheDTO{
 		ID:        string(plan.ID()),
 		Profile:   profileDTO,
 		Goals:     goalsToDTOs(plan.Goals()),
 		CreatedAt: plan.CreatedAt(),
 		UpdatedAt: plan.UpdatedAt(),
+		LastCalculatedAt:     plan.LastCalculatedAt(),
+		LastProfileUpdatedAt: plan.LastProfileUpdatedAt(),
+		LastReviewedAt:       plan.LastReviewedAt(),
+		Version:              plan.Version(),
 	}
 
 	if rd := plan.RetirementData(); rd != nil {
@@ -237,6 +447,15 @@ func financialPlanToDTO(plan *aggregates.FinancialPlan) financialPlanCacheDTO {
 				Amount:   rd.PensionAmount().Amount(),
 				Currency: string(rd.PensionAmount().Currency()),
 			},
+			KokuminNenkinMonthly: &moneyDTO{
+				Amount:   rd.KokuminNenkinMonthlyAmount().Amount(),
+				Currency: string(rd.KokuminNenkinMonthlyAmount().Currency()),
+			},
+			NurseCareInsuranceMonthly: &moneyDTO{
+				Amount:   rd.NurseCareInsuranceMonthly().Amount(),
+				Currency: string(rd.NurseCareInsuranceMonthly().Currency()),
+			},
+			LastAgeUpdateDate: rd.LastAgeUpdateDate(),
 			CreatedAt: rd.CreatedAt(),
 			UpdatedAt: rd.UpdatedAt(),
 		}
@@ -244,8 +463,15 @@ func financialPlanToDTO(plan *aggregates.FinancialPlan) financialPlanCacheDTO {
 
 	if ef := plan.EmergencyFund(); ef != nil {
 		dto.EmergencyFund = &emergencyFundConfigDTO{
-			TargetMonths: ef.TargetMonths,
-			CurrentFund:  moneyDTO{Amount: ef.CurrentFund.Amount(), Currency: string(ef.CurrentFund.Currency())},
+			TargetMonths:        ef.TargetMonths(),
+			CurrentFund:         moneyDTO{Amount: ef.CurrentFund().Amount(), Currency: string(ef.CurrentFund().Currency())},
+			MonthlyContribution: &moneyDTO{Amount: ef.MonthlyContribution().Amount(), Currency: string(ef.MonthlyContribution().Currency())},
+		}
+		for _, c := range ef.Contributions() {
+			dto.EmergencyFund.Contributions = append(dto.EmergencyFund.Contributions, emergencyFundContributionDTO{
+				Amount:        moneyDTO{Amount: c.Amount.Amount(), Currency: string(c.Amount.Currency())},
+				ContributedAt: c.ContributedAt,
+			})
 		}
 	}
 
@@ -253,6 +479,11 @@ func financialPlanToDTO(plan *aggregates.FinancialPlan) financialPlanCacheDTO {
 }
 
 func financialPlanFromDTO(dto financialPlanCacheDTO) (*aggregates.FinancialPlan, error) {
+	// 項目追加前のキャッシュはバージョンを持たず、古いバージョンで更新すると競合になるため復元しない
+	if dto.Version < 1 {
+		return nil, errors.New("バージョンのないキャッシュは復元できません")
+	}
+
 	// FinancialProfile を復元
 	monthlyIncome, err := valueobjects.NewMoney(dto.Profile.MonthlyIncome.Amount, valueobjects.Currency(dto.Profile.MonthlyIncome.Currency))
 	if err != nil {
@@ -309,6 +540,35 @@ func financialPlanFromDTO(dto financialPlanCacheDTO) (*aggregates.FinancialPlan,
 	if err != nil {
 		return nil, fmt.Errorf("財務プロファイルの復元に失敗しました: %w", err)
 	}
+	profile.AcknowledgeHighAssumptions(dto.Profile.HighReturnAcknowledged, dto.Profile.HighInflationAcknowledged)
+
+	incomeType, err := entities.ParseIncomeType(dto.Profile.IncomeType)
+	if err != nil {
+		return nil, fmt.Errorf("月収の入力区分の復元に失敗しました: %w", err)
+	}
+	var netMonthlyIncome valueobjects.Money
+	if incomeType == entities.IncomeTypeGross {
+		netMonthlyIncome, err = valueobjects.NewMoney(dto.Profile.NetMonthlyIncome.Amount, valueobjects.Currency(dto.Profile.NetMonthlyIncome.Currency))
+		if err != nil {
+			return nil, fmt.Errorf("手取り月収の復元に失敗しました: %w", err)
+		}
+	}
+	if err := profile.SetIncomeType(incomeType, netMonthlyIncome); err != nil {
+		return nil, fmt.Errorf("手取り月収の復元に失敗しました: %w", err)
+	}
+	if err := profile.SetBirthDate(dto.Profile.BirthDate); err != nil {
+		return nil, fmt.Errorf("生年月日の復元に失敗しました: %w", err)
+	}
+	if dto.Profile.ExpectedVolatility != nil {
+		volatility, err := valueobjects.NewRate(dto.Profile.ExpectedVolatility.Value)
+		if err != nil {
+			return nil, fmt.Errorf("想定ボラティリティの復元に失敗しました: %w", err)
+		}
+		profile.SetExpectedVolatility(&volatility)
+	}
+	if err := profile.SetRiskTolerance(entities.RiskTolerance(dto.Profile.RiskTolerance)); err != nil {
+		return nil, fmt.Errorf("リスク許容度の復元に失敗しました: %w", err)
+	}
 
 	plan, err := aggregates.NewFinancialPlanWithID(
 		aggregates.FinancialPlanID(dto.ID),
@@ -345,6 +605,20 @@ func financialPlanFromDTO(dto financialPlanCacheDTO) (*aggregates.FinancialPlan,
 		if err != nil {
 			return nil, fmt.Errorf("退職データの復元に失敗しました: %w", err)
 		}
+		if rd.KokuminNenkinMonthly != nil && rd.NurseCareInsuranceMonthly != nil {
+			kokuminNenkin, err := valueobjects.NewMoney(rd.KokuminNenkinMonthly.Amount, valueobjects.Currency(rd.KokuminNenkinMonthly.Currency))
+			if err != nil {
+				return nil, fmt.Errorf("国民年金の月額の復元に失敗しました: %w", err)
+			}
+			nurseCareInsurance, err := valueobjects.NewMoney(rd.NurseCareInsuranceMonthly.Amount, valueobjects.Currency(rd.NurseCareInsuranceMonthly.Currency))
+			if err != nil {
+				return nil, fmt.Errorf("介護保険料の月額の復元に失敗しました: %w", err)
+			}
+			if err := retirementData.RestorePublicBenefits(kokuminNenkin, nurseCareInsurance); err != nil {
+				return nil, fmt.Errorf("退職データの復元に失敗しました: %w", err)
+			}
+		}
+		retirementData.RestoreLastAgeUpdateDate(rd.LastAgeUpdateDate)
 		if err := plan.SetRetirementData(retirementData); err != nil {
 			return nil, fmt.Errorf("退職データの設定に失敗しました: %w", err)
 		}
@@ -356,10 +630,30 @@ func financialPlanFromDTO(dto financialPlanCacheDTO) (*aggregates.FinancialPlan,
 		if err != nil {
 			return nil, fmt.Errorf("緊急資金の復元に失敗しました: %w", err)
 		}
-		efConfig, err := aggregates.NewEmergencyFundConfig(dto.EmergencyFund.TargetMonths, currentFund)
+		contributions := make([]aggregates.EmergencyFundContribution, 0, len(dto.EmergencyFund.Contributions))
+		for _, c := range dto.EmergencyFund.Contributions {
+			amount, err := valueobjects.NewMoney(c.Amount.Amount, valueobjects.Currency(c.Amount.Currency))
+			if err != nil {
+				return nil, fmt.Errorf("緊急資金の積立履歴の復元に失敗しました: %w", err)
+			}
+			contributions = append(contributions, aggregates.EmergencyFundContribution{
+				Amount:        amount,
+				ContributedAt: c.ContributedAt,
+			})
+		}
+		efConfig, err := aggregates.NewEmergencyFundWithContributions(dto.EmergencyFund.TargetMonths, currentFund, contributions)
 		if err != nil {
 			return nil, fmt.Errorf("緊急資金設定の復元に失敗しました: %w", err)
 		}
+		if mc := dto.EmergencyFund.MonthlyContribution; mc != nil {
+			monthlyContribution, err := valueobjects.NewMoney(mc.Amount, valueobjects.Currency(mc.Currency))
+			if err != nil {
+				return nil, fmt.Errorf("緊急資金の月間積立額の復元に失敗しました: %w", err)
+			}
+			if err := efConfig.SetMonthlyContribution(monthlyContribution); err != nil {
+				return nil, fmt.Errorf("緊急資金の月間積立額の復元に失敗しました: %w", err)
+			}
+		}
 		if err := plan.UpdateEmergencyFund(efConfig); err != nil {
 			return nil, fmt.Errorf("緊急資金設定の適用に失敗しました: %w", err)
 		}
@@ -370,12 +664,43 @@ func financialPlanFromDTO(dto financialPlanCacheDTO) (*aggregates.FinancialPlan,
 	if err != nil {
 		return nil, fmt.Errorf("目標の復元に失敗しました: %w", err)
 	}
-	for _, goal := range goals {
-		if err := plan.AddGoal(goal); err != nil {
-			// ビジネスルール違反（達成不可能など）はキャッシュからの復元時には無視
-			continue
+	plan.RestoreGoals(goals)
+
+	// Liabilities を復元（目標の達成可能性の判定に影響しないよう、目標の復元後に設定する）
+	liabilities := make(entities.LiabilityCollection, 0, len(dto.Profile.Liabilities))
+	for _, l := range dto.Profile.Liabilities {
+		liability, err := liabilityFromDTO(l)
+		if err != nil {
+			return nil, err
+		}
+		liabilities = append(liabilities, liability)
+	}
+	profile.SetLiabilities(liabilities)
+
+	// LifeEvents を復元
+	lifeEvents := make(entities.LifeEventCollection, 0, len(dto.Profile.LifeEvents))
+	for _, e := range dto.Profile.LifeEvents {
+		event, err := lifeEventFromDTO(e)
+		if err != nil {
+			return nil, err
 		}
+		lifeEvents = append(lifeEvents, event)
+	}
+	profile.SetLifeEvents(lifeEvents)
+
+	// 計算日時とデータの最終更新日時を復元（退職データ・緊急資金の設定で更新された日時を上書きする）
+	// 項目追加前のキャッシュは最終更新日時を持たないため、更新日時で代用する
+	lastProfileUpdatedAt := dto.LastProfileUpdatedAt
+	if lastProfileUpdatedAt.IsZero() {
+		lastProfileUpdatedAt = dto.UpdatedAt
+	}
+	plan.RestoreCalculationTimestamps(dto.LastCalculatedAt, lastProfileUpdatedAt)
+	lastReviewedAt := dto.LastReviewedAt
+	if lastReviewedAt.IsZero() {
+		lastReviewedAt = dto.UpdatedAt
 	}
+	plan.RestoreLastReviewedAt(lastReviewedAt)
+	plan.RestoreVersion(dto.Version)
 
 	return plan, nil
 }
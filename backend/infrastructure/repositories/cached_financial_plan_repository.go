@@ -2,7 +2,9 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
@@ -100,14 +102,30 @@ func (r *CachedFinancialPlanRepository) Save(ctx context.Context, plan *aggregat
 }
 
 // Update は委譲後にキャッシュを無効化する
+// バージョンの競合時も、キャッシュが古いバージョンを返し続けないよう無効化する
 func (r *CachedFinancialPlanRepository) Update(ctx context.Context, plan *aggregates.FinancialPlan) error {
 	if err := r.delegate.Update(ctx, plan); err != nil {
+		if errors.Is(err, domainrepos.ErrVersionConflict) {
+			r.invalidateCache(ctx, plan)
+		}
 		return err
 	}
 	r.invalidateCache(ctx, plan)
 	return nil
 }
 
+// MarkCalculated は委譲後にキャッシュを無効化する
+func (r *CachedFinancialPlanRepository) MarkCalculated(ctx context.Context, userID entities.UserID, calculatedAt time.Time) error {
+	if err := r.delegate.MarkCalculated(ctx, userID, calculatedAt); err != nil {
+		return err
+	}
+	// ユーザーIDのみ持つため、FindByUserIDキャッシュのみ無効化（FindByIDキャッシュはTTLで失効する）
+	if err := r.redisClient.Delete(ctx, financialPlanByUserIDKey(string(userID))); err != nil {
+		slog.Warn("財務計画キャッシュの無効化に失敗しました", slog.String("key", financialPlanByUserIDKey(string(userID))), slog.Any("error", err))
+	}
+	return nil
+}
+
 // Delete は委譲後にキャッシュを無効化する
 func (r *CachedFinancialPlanRepository) Delete(ctx context.Context, id aggregates.FinancialPlanID) error {
 	if err := r.delegate.Delete(ctx, id); err != nil {
@@ -130,6 +148,11 @@ func (r *CachedFinancialPlanRepository) ExistsByUserID(ctx context.Context, user
 	return r.delegate.ExistsByUserID(ctx, userID)
 }
 
+// FindUserIDs は委譲するだけ（一覧取得はキャッシュ対象外）
+func (r *CachedFinancialPlanRepository) FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	return r.delegate.FindUserIDs(ctx, afterUserID, limit)
+}
+
 // setCache はキャッシュへの書き込みを行う（失敗はログのみ）
 func (r *CachedFinancialPlanRepository) setCache(ctx context.Context, key string, plan *aggregates.FinancialPlan) {
 	dto := financialPlanToDTO(plan)
@@ -139,10 +162,14 @@ func (r *CachedFinancialPlanRepository) setCache(ctx context.Context, key string
 }
 
 // invalidateCache は plan に関連するキャッシュキーをすべて削除する
+// 計画の保存で目標も更新・論理削除されるため、ユーザー単位のゴールキャッシュも削除する
 func (r *CachedFinancialPlanRepository) invalidateCache(ctx context.Context, plan *aggregates.FinancialPlan) {
+	userID := string(plan.Profile().UserID())
 	keys := []string{
 		financialPlanByIDKey(string(plan.ID())),
-		financialPlanByUserIDKey(string(plan.Profile().UserID())),
+		financialPlanByUserIDKey(userID),
+		goalsByUserIDKey(userID),
+		activeGoalsByUserIDKey(userID),
 	}
 	if err := r.redisClient.Delete(ctx, keys...); err != nil {
 		slog.Warn("財務計画キャッシュの無効化に失敗しました", slog.Any("error", err))
@@ -8,6 +8,7 @@ import (
 
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	domainrepos "github.com/financial-planning-calculator/backend/domain/repositories"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	redisinfra "github.com/financial-planning-calculator/backend/infrastructure/redis"
 	goredis "github.com/redis/go-redis/v9"
@@ -62,6 +63,11 @@ func (m *mockFinancialPlanRepository) Update(ctx context.Context, plan *aggregat
 	return nil
 }
 
+func (m *mockFinancialPlanRepository) MarkCalculated(ctx context.Context, userID entities.UserID, calculatedAt time.Time) error {
+	m.callCount["MarkCalculated"]++
+	return nil
+}
+
 func (m *mockFinancialPlanRepository) Delete(ctx context.Context, id aggregates.FinancialPlanID) error {
 	m.callCount["Delete"]++
 	if m.deleteFunc != nil {
@@ -86,6 +92,11 @@ func (m *mockFinancialPlanRepository) ExistsByUserID(ctx context.Context, userID
 	return false, nil
 }
 
+func (m *mockFinancialPlanRepository) FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	m.callCount["FindUserIDs"]++
+	return nil, nil
+}
+
 // --- モック: CacheClient ---
 
 type mockCacheClient struct {
@@ -316,17 +327,34 @@ func TestCachedFinancialPlanRepository_Save_InvalidatesCache(t *testing.T) {
 	if !hasUserIDKey {
 		t.Errorf("UserIDキャッシュが削除されませんでした: %s", expectedByUserID)
 	}
+
+	// 計画に含まれる目標のキャッシュも削除されることを確認
+	for _, expected := range []string{goalsByUserIDKey(string(userID)), activeGoalsByUserIDKey(string(userID))} {
+		found := false
+		for _, k := range deletedKeys {
+			if k == expected {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("ゴールキャッシュが削除されませんでした: %s", expected)
+		}
+	}
 }
 
 func TestCachedFinancialPlanRepository_DTORoundTrip(t *testing.T) {
 	userID := entities.UserID("test-user-id")
 	plan := createTestPlanForCache(t, userID)
+	plan.RestoreVersion(3)
 
 	dto := financialPlanToDTO(plan)
 	restored, err := financialPlanFromDTO(dto)
 	if err != nil {
 		t.Fatalf("DTO復元エラー: %v", err)
 	}
+	if restored.Version() != 3 {
+		t.Errorf("バージョンが一致しません: got %d, want 3", restored.Version())
+	}
 
 	if string(restored.ID()) != string(plan.ID()) {
 		t.Errorf("IDが一致しません: got %s, want %s", restored.ID(), plan.ID())
@@ -339,6 +367,68 @@ func TestCachedFinancialPlanRepository_DTORoundTrip(t *testing.T) {
 	}
 }
 
+func TestCachedFinancialPlanRepository_DTORoundTrip_EmergencyFundContributions(t *testing.T) {
+	plan := createTestPlanForCache(t, entities.UserID("test-user-id"))
+	contribution, _ := valueobjects.NewMoneyJPY(30000)
+	contributedAt := time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC)
+	if err := plan.AddEmergencyFundContribution(contribution, contributedAt); err != nil {
+		t.Fatalf("積立の記録に失敗しました: %v", err)
+	}
+	monthlyContribution, _ := valueobjects.NewMoneyJPY(20000)
+	if err := plan.UpdateEmergencyFundMonthlyContribution(monthlyContribution); err != nil {
+		t.Fatalf("月間積立額の設定に失敗しました: %v", err)
+	}
+
+	restored, err := financialPlanFromDTO(financialPlanToDTO(plan))
+	if err != nil {
+		t.Fatalf("DTO復元エラー: %v", err)
+	}
+
+	contributions := restored.EmergencyFund().Contributions()
+	if len(contributions) != 1 {
+		t.Fatalf("積立履歴の件数が一致しません: got %d, want 1", len(contributions))
+	}
+	if contributions[0].Amount.Amount() != 30000 || !contributions[0].ContributedAt.Equal(contributedAt) {
+		t.Errorf("積立履歴が一致しません: got %+v", contributions[0])
+	}
+	if restored.EmergencyFund().CurrentFund().Amount() != plan.EmergencyFund().CurrentFund().Amount() {
+		t.Errorf("緊急資金額が一致しません: got %f, want %f", restored.EmergencyFund().CurrentFund().Amount(), plan.EmergencyFund().CurrentFund().Amount())
+	}
+	if restored.EmergencyFund().MonthlyContribution().Amount() != 20000 {
+		t.Errorf("月間積立額が一致しません: got %f, want 20000", restored.EmergencyFund().MonthlyContribution().Amount())
+	}
+}
+
+func TestCachedFinancialPlanRepository_Update_VersionConflict_InvalidatesCache(t *testing.T) {
+	ctx := context.Background()
+	plan := createTestPlanForCache(t, entities.UserID("test-user-id"))
+
+	mockRepo := newMockFinancialPlanRepo()
+	mockRepo.updateFunc = func(ctx context.Context, plan *aggregates.FinancialPlan) error {
+		return &domainrepos.VersionConflictError{CurrentVersion: 2}
+	}
+	mockCache := newMockCacheClient()
+	repo := NewCachedFinancialPlanRepository(mockRepo, mockCache)
+
+	err := repo.Update(ctx, plan)
+	if !errors.Is(err, domainrepos.ErrVersionConflict) {
+		t.Fatalf("競合エラーを返すべきです: %v", err)
+	}
+	// 古いバージョンのキャッシュを返し続けないよう、競合時もキャッシュを削除する
+	if mockCache.callCount["Delete"] == 0 {
+		t.Error("競合時にキャッシュが削除されませんでした")
+	}
+}
+
+func TestCachedFinancialPlanRepository_DTOWithoutVersion_IsCacheMiss(t *testing.T) {
+	dto := financialPlanToDTO(createTestPlanForCache(t, entities.UserID("test-user-id")))
+	dto.Version = 0
+
+	if _, err := financialPlanFromDTO(dto); err == nil {
+		t.Error("バージョンのないキャッシュは復元せずエラーにするべきです")
+	}
+}
+
 // IsNil は redis.Nil エラーかどうかを判定するヘルパー（テストでインポートせずに使用）
 func isNilError(err error) bool {
 	return redisinfra.IsNil(err)
@@ -3,9 +3,11 @@ package repositories
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	domainrepos "github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/financial-planning-calculator/backend/infrastructure/monitoring"
 	redisinfra "github.com/financial-planning-calculator/backend/infrastructure/redis"
 )
@@ -124,11 +126,21 @@ func (r *CachedGoalRepository) Exists(ctx context.Context, id entities.GoalID) (
 	return r.delegate.Exists(ctx, id)
 }
 
+// FindSimilarGoal は委譲するだけ（作成前の重複確認に使うため常に最新のデータを参照する）
+func (r *CachedGoalRepository) FindSimilarGoal(ctx context.Context, userID entities.UserID, goalType entities.GoalType, title string, targetAmount valueobjects.Money) (*entities.Goal, error) {
+	return r.delegate.FindSimilarGoal(ctx, userID, goalType, title, targetAmount)
+}
+
 // CountActiveGoalsByType は委譲するだけ
 func (r *CachedGoalRepository) CountActiveGoalsByType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) (int, error) {
 	return r.delegate.CountActiveGoalsByType(ctx, userID, goalType)
 }
 
+// FindOverdueActiveGoals は委譲するだけ（全ユーザーを対象とするバッチ処理用のためキャッシュ対象外）
+func (r *CachedGoalRepository) FindOverdueActiveGoals(ctx context.Context, asOf time.Time) ([]*entities.Goal, error) {
+	return r.delegate.FindOverdueActiveGoals(ctx, asOf)
+}
+
 // setGoalsCache はキャッシュへの書き込みを行う（失敗はログのみ）
 func (r *CachedGoalRepository) setGoalsCache(ctx context.Context, key string, goals []*entities.Goal) {
 	dtos := goalsToDTOs(goals)
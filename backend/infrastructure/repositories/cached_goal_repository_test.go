@@ -94,6 +94,11 @@ func (m *mockGoalRepository) Exists(ctx context.Context, id entities.GoalID) (bo
 	return false, nil
 }
 
+func (m *mockGoalRepository) FindSimilarGoal(ctx context.Context, userID entities.UserID, goalType entities.GoalType, title string, targetAmount valueobjects.Money) (*entities.Goal, error) {
+	m.callCount["FindSimilarGoal"]++
+	return nil, nil
+}
+
 func (m *mockGoalRepository) CountActiveGoalsByType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) (int, error) {
 	m.callCount["CountActiveGoalsByType"]++
 	if m.countActiveFunc != nil {
@@ -102,6 +107,11 @@ func (m *mockGoalRepository) CountActiveGoalsByType(ctx context.Context, userID
 	return 0, nil
 }
 
+func (m *mockGoalRepository) FindOverdueActiveGoals(ctx context.Context, asOf time.Time) ([]*entities.Goal, error) {
+	m.callCount["FindOverdueActiveGoals"]++
+	return nil, nil
+}
+
 // --- テスト用ヘルパー ---
 
 func createTestGoal(t *testing.T, userID entities.UserID) *entities.Goal {
@@ -297,6 +307,86 @@ func TestCachedGoalRepository_GoalDTORoundTrip(t *testing.T) {
 	}
 }
 
+func TestCachedGoalRepository_GoalDTORoundTrip_GoalCurrency(t *testing.T) {
+	original := createTestGoal(t, entities.UserID("test-user-id"))
+	target, _ := valueobjects.NewMoney(10000, valueobjects.USD)
+	asOf := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	previousRate, _ := valueobjects.NewExchangeRate(valueobjects.JPY, valueobjects.USD, 150, "", asOf)
+	currentRate, _ := valueobjects.NewExchangeRate(valueobjects.JPY, valueobjects.USD, 125, "bank", asOf.AddDate(0, 1, 0))
+	if err := original.SetGoalCurrency(target, previousRate); err != nil {
+		t.Fatalf("目標の通貨の設定に失敗しました: %v", err)
+	}
+	if err := original.UpdateExchangeRate(currentRate); err != nil {
+		t.Fatalf("為替レートの更新に失敗しました: %v", err)
+	}
+
+	// キャッシュのDTOと goals.goal_currency 列の値のどちらからも復元できる
+	dto := goalToDTO(original)
+	restored, err := goalFromDTO(dto)
+	if err != nil {
+		t.Fatalf("DTO復元エラー: %v", err)
+	}
+	withoutCurrency := goalToDTO(original)
+	withoutCurrency.GoalCurrency = nil
+	fromColumn, err := goalFromDTO(withoutCurrency)
+	if err != nil {
+		t.Fatalf("DTO復元エラー: %v", err)
+	}
+	if err := restoreGoalCurrency(fromColumn, goalCurrencyParam(original).([]byte)); err != nil {
+		t.Fatalf("goal_currency 列の復元エラー: %v", err)
+	}
+
+	for _, goal := range []*entities.Goal{restored, fromColumn} {
+		if goal.Currency() != valueobjects.USD || goal.TargetAmountInGoalCurrency().Amount() != 10000 {
+			t.Errorf("目標の通貨での目標金額が一致しません: got %v", goal.TargetAmountInGoalCurrency())
+		}
+		if goal.TargetAmount().Amount() != 1250000 {
+			t.Errorf("目標金額が一致しません: got %f, want 1250000", goal.TargetAmount().Amount())
+		}
+		if rate := goal.ExchangeRate(); rate == nil || rate.Rate() != 125 || rate.Source() != "bank" || !rate.AsOf().Equal(currentRate.AsOf()) {
+			t.Errorf("為替レートが一致しません: got %v", goal.ExchangeRate())
+		}
+		if history := goal.ExchangeRateHistory(); len(history) != 1 || history[0].Rate() != 150 {
+			t.Errorf("為替レートの履歴が一致しません: got %v", history)
+		}
+	}
+
+	// 円建ての目標は goal_currency 列を NULL にする
+	if param := goalCurrencyParam(createTestGoal(t, entities.UserID("test-user-id"))); param != nil {
+		t.Errorf("円建ての目標の goal_currency は NULL であるべきです: got %v", param)
+	}
+}
+
+func TestCachedGoalRepository_GoalDTORoundTrip_ContributionRange(t *testing.T) {
+	original := createTestGoal(t, entities.UserID("test-user-id"))
+	minimum, _ := valueobjects.NewMoneyJPY(30000)
+	stretch, _ := valueobjects.NewMoneyJPY(70000)
+	if err := original.UpdateContributionPlan(&minimum, original.MonthlyContribution(), &stretch); err != nil {
+		t.Fatalf("月間拠出額の幅の設定に失敗しました: %v", err)
+	}
+
+	restored, err := goalFromDTO(goalToDTO(original))
+	if err != nil {
+		t.Fatalf("DTO復元エラー: %v", err)
+	}
+	if restored.MinimumMonthlyContribution().Amount() != 30000 || restored.StretchMonthlyContribution().Amount() != 70000 {
+		t.Errorf("月間拠出額の幅が一致しません: got min=%v stretch=%v", restored.ContributionMin(), restored.ContributionStretch())
+	}
+
+	// 幅を設定していない目標は contribution_min・contribution_stretch 列を NULL にする
+	plain := createTestGoal(t, entities.UserID("test-user-id"))
+	if nullMoney(plain.ContributionMin()).Valid || nullMoney(plain.ContributionStretch()).Valid {
+		t.Error("月間拠出額の幅が未設定の目標の列は NULL であるべきです")
+	}
+	restoredPlain, err := goalFromDTO(goalToDTO(plain))
+	if err != nil {
+		t.Fatalf("DTO復元エラー: %v", err)
+	}
+	if restoredPlain.HasContributionRange() {
+		t.Error("月間拠出額の幅が未設定の目標に幅が復元されました")
+	}
+}
+
 // redis.Nil 定数が使われることを確認するテスト
 func TestCacheMissDetection(t *testing.T) {
 	if !isNilError(goredis.Nil) {
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+)
+
+// circuitBreakerExecutor はサーキットブレーカーを通して *sql.DB のクエリを実行する dbExecutor
+// ブレーカーが開いている間はデータベースに接続せず database.ErrCircuitOpen を返す（breaker が nil の場合は常に実行する）
+type circuitBreakerExecutor struct {
+	db      *sql.DB
+	breaker database.CircuitBreaker
+}
+
+// newCircuitBreakerExecutor は新しいcircuitBreakerExecutorを作成する
+func newCircuitBreakerExecutor(db *sql.DB, breaker database.CircuitBreaker) *circuitBreakerExecutor {
+	return &circuitBreakerExecutor{db: db, breaker: breaker}
+}
+
+// execute はブレーカーを通して fn を実行する
+func (e *circuitBreakerExecutor) execute(fn func() error) error {
+	if e.breaker == nil {
+		return fn()
+	}
+	return e.breaker.Execute(fn)
+}
+
+// ExecContext はブレーカーを通してクエリを実行する
+func (e *circuitBreakerExecutor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	var result sql.Result
+	err := e.execute(func() error {
+		var err error
+		result, err = e.db.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+// QueryContext はブレーカーを通してクエリを実行する
+func (e *circuitBreakerExecutor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := e.execute(func() error {
+		var err error
+		rows, err = e.db.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// QueryRowContext は Scan 時にブレーカーを通してクエリを実行する行を返す
+func (e *circuitBreakerExecutor) QueryRowContext(ctx context.Context, query string, args ...any) rowScanner {
+	return &circuitBreakerRow{executor: e, ctx: ctx, query: query, args: args}
+}
+
+// BeginTx はブレーカーを通してトランザクションを開始する
+// トランザクション内のクエリは取得済みの接続を使うためブレーカーを通さない
+func (e *circuitBreakerExecutor) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	var tx *sql.Tx
+	err := e.execute(func() error {
+		var err error
+		tx, err = e.db.BeginTx(ctx, opts)
+		return err
+	})
+	return tx, err
+}
+
+// circuitBreakerRow は QueryRowContext の結果を Scan 時に取得する行
+type circuitBreakerRow struct {
+	executor *circuitBreakerExecutor
+	ctx      context.Context
+	query    string
+	args     []any
+}
+
+// Scan はブレーカーを通してクエリを実行し、結果を dest に読み込む
+func (r *circuitBreakerRow) Scan(dest ...any) error {
+	return r.executor.execute(func() error {
+		return r.executor.db.QueryRowContext(r.ctx, r.query, r.args...).Scan(dest...)
+	})
+}
+
+// txExecutor はトランザクション内でクエリを実行する dbExecutor
+type txExecutor struct {
+	tx *sql.Tx
+}
+
+// ExecContext はトランザクション内でクエリを実行する
+func (e *txExecutor) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return e.tx.ExecContext(ctx, query, args...)
+}
+
+// QueryContext はトランザクション内でクエリを実行する
+func (e *txExecutor) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return e.tx.QueryContext(ctx, query, args...)
+}
+
+// QueryRowContext はトランザクション内でクエリを実行する
+func (e *txExecutor) QueryRowContext(ctx context.Context, query string, args ...any) rowScanner {
+	return e.tx.QueryRowContext(ctx, query, args...)
+}
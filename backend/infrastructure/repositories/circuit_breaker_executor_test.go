@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeConnector は接続の成否を切り替えられるテスト用のデータベース接続
+// 接続に成功した場合は常に0行を返す
+type fakeConnector struct {
+	mu       sync.Mutex
+	failing  bool
+	attempts int
+}
+
+func (c *fakeConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attempts++
+	if c.failing {
+		return nil, errors.New("dial tcp: connection refused")
+	}
+	return &fakeConn{}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver { return fakeDriver{} }
+
+func (c *fakeConnector) setFailing(failing bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failing = failing
+}
+
+func (c *fakeConnector) connectAttempts() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.attempts
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) { return nil, errors.New("not supported") }
+
+type fakeConn struct{}
+
+func (*fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (*fakeConn) Close() error                        { return nil }
+func (*fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+func (*fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+func TestCircuitBreakerExecutor_ProtectsRepositories(t *testing.T) {
+	ctx := context.Background()
+	connector := &fakeConnector{failing: true}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	breaker := database.NewSlidingWindowCircuitBreaker(config.CircuitBreakerConfig{
+		MaxRequests:      1,
+		Interval:         time.Minute,
+		Timeout:          50 * time.Millisecond,
+		FailureThreshold: 5,
+	})
+	goalRepo := NewRepositoryFactoryWithCircuitBreaker(db, breaker).NewGoalRepository()
+	goalID := entities.GoalID("goal-001")
+
+	// データベースに接続できない状態で5回失敗する
+	for i := 0; i < 5; i++ {
+		_, err := goalRepo.FindByID(ctx, goalID)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, database.ErrCircuitOpen)
+	}
+	require.Equal(t, 5, connector.connectAttempts())
+
+	// 6回目はデータベースに接続せずに拒否される
+	_, err := goalRepo.FindByID(ctx, goalID)
+	require.ErrorIs(t, err, database.ErrCircuitOpen)
+	assert.Equal(t, 5, connector.connectAttempts())
+	assert.Equal(t, database.CircuitStateOpen, breaker.State())
+
+	// タイムアウト後、データベースが復旧していれば呼び出しが通り閉状態に戻る
+	connector.setFailing(false)
+	time.Sleep(60 * time.Millisecond)
+
+	_, err = goalRepo.FindByID(ctx, goalID)
+	require.Error(t, err) // 0行のため目標は見つからない
+	assert.NotErrorIs(t, err, database.ErrCircuitOpen)
+	assert.Equal(t, 6, connector.connectAttempts())
+	assert.Equal(t, database.CircuitStateClosed, breaker.State())
+}
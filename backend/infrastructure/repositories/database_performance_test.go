@@ -325,6 +325,8 @@ func setupBenchmarkDB(b *testing.B) *sql.DB {
 	}
 
 	dbConfig := config.NewDatabaseConfig()
+	// データベースがない環境ですぐにスキップできるよう再試行しない
+	dbConfig.Retry.MaxAttempts = 1
 	db, err := config.NewDatabaseConnection(dbConfig)
 	if err != nil {
 		b.Skipf("Database connection failed (expected in CI): %v", err)
@@ -0,0 +1,24 @@
+package inmemory
+
+import (
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories/repositorytest"
+	"github.com/google/uuid"
+)
+
+func TestInMemoryRepositories_Contract(t *testing.T) {
+	repositorytest.RunContractTests(t, func(t *testing.T) repositorytest.Fixture {
+		store := NewStore()
+		return repositorytest.Fixture{
+			FinancialPlans: NewFinancialPlanRepository(store),
+			Goals:          NewGoalRepository(store),
+			Users:          NewUserRepository(store),
+			RefreshTokens:  NewRefreshTokenRepository(store),
+			NewUserID: func(t *testing.T) entities.UserID {
+				return entities.UserID(uuid.New().String())
+			},
+		}
+	})
+}
@@ -0,0 +1,229 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// FinancialPlanRepository はメモリ上に財務計画を保持する FinancialPlanRepository の実装
+// PostgreSQL の実装と同じく、財務計画の目標は目標リポジトリと共有する
+type FinancialPlanRepository struct {
+	store *Store
+}
+
+// NewFinancialPlanRepository は新しいインメモリ財務計画リポジトリを作成する
+func NewFinancialPlanRepository(store *Store) repositories.FinancialPlanRepository {
+	return &FinancialPlanRepository{store: store}
+}
+
+// Save は財務計画を保存する（同じユーザーの財務計画がある場合は上書きする）
+// 計算日時は MarkCalculated でのみ記録し、バージョンは PostgreSQL の実装と同じく変更しない
+func (r *FinancialPlanRepository) Save(ctx context.Context, plan *aggregates.FinancialPlan) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	r.store.savePlan(plan)
+	return nil
+}
+
+// savePlan は財務計画を保存する（ロックは呼び出し元で取得する）
+// 新規の財務計画はバージョン1で保存し、同じユーザーの財務計画がある場合は保存済みのバージョンを引き継ぐ
+func (s *Store) savePlan(plan *aggregates.FinancialPlan) {
+	userID := plan.Profile().UserID()
+	record := planRecord{
+		id:                   plan.ID(),
+		profile:              *plan.Profile(),
+		createdAt:            plan.CreatedAt(),
+		updatedAt:            plan.UpdatedAt(),
+		lastProfileUpdatedAt: plan.LastProfileUpdatedAt(),
+		lastReviewedAt:       plan.LastReviewedAt(),
+		version:              1,
+	}
+	if existing, ok := s.plans[userID]; ok {
+		record.id = existing.id
+		record.createdAt = existing.createdAt
+		record.lastCalculatedAt = existing.lastCalculatedAt
+		record.version = existing.version
+	}
+	if fund := plan.EmergencyFund(); fund != nil {
+		record.emergencyFund = &emergencyFundRecord{
+			targetMonths:        fund.TargetMonths(),
+			currentFund:         fund.CurrentFund(),
+			monthlyContribution: fund.MonthlyContribution(),
+		}
+	}
+	if retirementData := plan.RetirementData(); retirementData != nil {
+		stored := *retirementData
+		record.retirementData = &stored
+	}
+	s.plans[userID] = record
+
+	// 目標は目標リポジトリと共有する（同じIDの目標は上書きし、財務計画から削除した目標は削除日時を記録する）
+	for _, goal := range append(append([]*entities.Goal{}, plan.Goals()...), plan.RemovedGoals()...) {
+		s.goals[goal.ID()] = *goal
+	}
+}
+
+// FindByID は指定されたIDの財務計画を取得する
+func (r *FinancialPlanRepository) FindByID(ctx context.Context, id aggregates.FinancialPlanID) (*aggregates.FinancialPlan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, record := range r.store.plans {
+		if record.id == id {
+			return r.store.buildPlan(record)
+		}
+	}
+	return nil, fmt.Errorf("財務計画が見つかりません: %s", id)
+}
+
+// FindByUserID は指定されたユーザーIDの財務計画を取得する
+func (r *FinancialPlanRepository) FindByUserID(ctx context.Context, userID entities.UserID) (*aggregates.FinancialPlan, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	record, ok := r.store.plans[userID]
+	if !ok {
+		return nil, fmt.Errorf("財務プロファイルの取得に失敗しました: 財務データが見つかりません: %s", userID)
+	}
+	return r.store.buildPlan(record)
+}
+
+// Update は既存の財務計画を更新する
+// 読み込んだ時点のバージョンから更新されていた場合は *repositories.VersionConflictError を返す（未保存の場合は新規に保存する）
+func (r *FinancialPlanRepository) Update(ctx context.Context, plan *aggregates.FinancialPlan) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	userID := plan.Profile().UserID()
+	existing, ok := r.store.plans[userID]
+	if !ok {
+		r.store.savePlan(plan)
+		return nil
+	}
+	if existing.version != plan.Version() {
+		return &repositories.VersionConflictError{CurrentVersion: existing.version}
+	}
+
+	r.store.savePlan(plan)
+	record := r.store.plans[userID]
+	record.version++
+	r.store.plans[userID] = record
+	plan.RestoreVersion(record.version)
+	return nil
+}
+
+// MarkCalculated は計算結果を保存した日時を記録する（より新しい日時が記録済みの場合は何もしない）
+func (r *FinancialPlanRepository) MarkCalculated(ctx context.Context, userID entities.UserID, calculatedAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	record, ok := r.store.plans[userID]
+	if !ok {
+		return nil
+	}
+	if record.lastCalculatedAt == nil || record.lastCalculatedAt.Before(calculatedAt) {
+		record.lastCalculatedAt = &calculatedAt
+		r.store.plans[userID] = record
+	}
+	return nil
+}
+
+// Delete は指定されたIDの財務計画を、ユーザーの目標とともに削除する
+func (r *FinancialPlanRepository) Delete(ctx context.Context, id aggregates.FinancialPlanID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for userID, record := range r.store.plans {
+		if record.id != id {
+			continue
+		}
+		delete(r.store.plans, userID)
+		for goalID, goal := range r.store.goals {
+			if goal.UserID() == userID {
+				delete(r.store.goals, goalID)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("財務計画が見つかりません: %s", id)
+}
+
+// Exists は指定されたIDの財務計画が存在するかチェックする
+func (r *FinancialPlanRepository) Exists(ctx context.Context, id aggregates.FinancialPlanID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, record := range r.store.plans {
+		if record.id == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ExistsByUserID は指定されたユーザーIDの財務計画が存在するかチェックする
+func (r *FinancialPlanRepository) ExistsByUserID(ctx context.Context, userID entities.UserID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.plans[userID]
+	return ok, nil
+}
+
+// FindUserIDs は財務計画を持つユーザーのIDを afterUserID より後からユーザーIDの昇順で最大 limit 件取得する
+func (r *FinancialPlanRepository) FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var userIDs []entities.UserID
+	for userID := range r.store.plans {
+		if userID > afterUserID {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+	if len(userIDs) > limit {
+		userIDs = userIDs[:limit]
+	}
+	return userIDs, nil
+}
+
+// buildPlan は保存した財務計画とユーザーの目標から財務計画を復元する（ロックは呼び出し元で取得する）
+// 復元の手順は PostgreSQL の実装と同じ
+func (s *Store) buildPlan(record planRecord) (*aggregates.FinancialPlan, error) {
+	profile := record.profile
+	plan, err := aggregates.NewFinancialPlanWithID(record.id, &profile, record.createdAt, record.updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画の作成に失敗しました: %w", err)
+	}
+
+	if fund := record.emergencyFund; fund != nil {
+		if err := plan.UpdateEmergencyFundSettings(fund.targetMonths, fund.currentFund); err != nil {
+			return nil, fmt.Errorf("緊急資金の取得に失敗しました: %w", err)
+		}
+		if err := plan.UpdateEmergencyFundMonthlyContribution(fund.monthlyContribution); err != nil {
+			return nil, fmt.Errorf("緊急資金の取得に失敗しました: %w", err)
+		}
+	}
+
+	if record.retirementData != nil {
+		retirementData := *record.retirementData
+		if err := plan.SetRetirementData(&retirementData); err != nil {
+			return nil, fmt.Errorf("退職データの設定に失敗しました: %w", err)
+		}
+	}
+
+	plan.RestoreGoals(s.goalsOf(profile.UserID(), func(*entities.Goal) bool { return true }, oldestFirst))
+
+	plan.RestoreCalculationTimestamps(record.lastCalculatedAt, record.lastProfileUpdatedAt)
+	plan.RestoreLastReviewedAt(record.lastReviewedAt)
+	plan.RestoreVersion(record.version)
+	return plan, nil
+}
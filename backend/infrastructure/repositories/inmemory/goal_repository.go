@@ -0,0 +1,178 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// GoalRepository はメモリ上に目標を保持する GoalRepository の実装
+type GoalRepository struct {
+	store *Store
+}
+
+// NewGoalRepository は新しいインメモリ目標リポジトリを作成する
+func NewGoalRepository(store *Store) repositories.GoalRepository {
+	return &GoalRepository{store: store}
+}
+
+// Save は目標を保存する（同じIDの目標が既にある場合はエラー）
+func (r *GoalRepository) Save(ctx context.Context, goal *entities.Goal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.goals[goal.ID()]; ok {
+		return fmt.Errorf("目標の保存に失敗しました: 目標が既に存在します: %s", goal.ID())
+	}
+	r.store.goals[goal.ID()] = *goal
+	return nil
+}
+
+// FindByID は指定されたIDの目標を取得する
+func (r *GoalRepository) FindByID(ctx context.Context, id entities.GoalID) (*entities.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goal, ok := r.store.goals[id]
+	if !ok || goal.IsDeleted() {
+		return nil, fmt.Errorf("目標が見つかりません: %s", id)
+	}
+	return &goal, nil
+}
+
+// FindByUserID は指定されたユーザーIDの全ての目標を作成日時の新しい順に取得する
+func (r *GoalRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.Goal, error) {
+	return r.findGoals(userID, func(*entities.Goal) bool { return true }, newestFirst), nil
+}
+
+// FindActiveGoalsByUserID は指定されたユーザーIDのアクティブな目標を作成日時の新しい順に取得する
+func (r *GoalRepository) FindActiveGoalsByUserID(ctx context.Context, userID entities.UserID) ([]*entities.Goal, error) {
+	return r.findGoals(userID, (*entities.Goal).IsActive, newestFirst), nil
+}
+
+// FindByUserIDAndType は指定されたユーザーIDと目標タイプの目標を作成日時の新しい順に取得する
+func (r *GoalRepository) FindByUserIDAndType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) ([]*entities.Goal, error) {
+	return r.findGoals(userID, func(g *entities.Goal) bool { return g.GoalType() == goalType }, newestFirst), nil
+}
+
+// Update は既存の目標を更新する
+func (r *GoalRepository) Update(ctx context.Context, goal *entities.Goal) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.goals[goal.ID()]; !ok {
+		return fmt.Errorf("更新対象の目標が見つかりません: %s", goal.ID())
+	}
+	r.store.goals[goal.ID()] = *goal
+	return nil
+}
+
+// Delete は指定されたIDの目標を削除する
+// PostgreSQL のトリガー（041_remove_deleted_goal_dependencies.sql）と同様に、他の目標の前提目標からも取り除く
+func (r *GoalRepository) Delete(ctx context.Context, id entities.GoalID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.goals[id]; !ok {
+		return fmt.Errorf("削除対象の目標が見つかりません: %s", id)
+	}
+	delete(r.store.goals, id)
+
+	for goalID, goal := range r.store.goals {
+		if goal.DependsOnGoal(id) {
+			goal.RemoveDependency(id)
+			r.store.goals[goalID] = goal
+		}
+	}
+	return nil
+}
+
+// Exists は指定されたIDの目標が存在するかチェックする
+func (r *GoalRepository) Exists(ctx context.Context, id entities.GoalID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	goal, ok := r.store.goals[id]
+	return ok && !goal.IsDeleted(), nil
+}
+
+// FindSimilarGoal は同じタイプ・同じ目標名（正規化後）で目標金額の差が1%以内のアクティブな目標を取得する
+func (r *GoalRepository) FindSimilarGoal(ctx context.Context, userID entities.UserID, goalType entities.GoalType, title string, targetAmount valueobjects.Money) (*entities.Goal, error) {
+	candidates := r.findGoals(userID, func(g *entities.Goal) bool {
+		return g.IsActive() && g.GoalType() == goalType
+	}, oldestFirst)
+	return entities.FindSimilarGoal(candidates, goalType, title, targetAmount), nil
+}
+
+// CountActiveGoalsByType は指定されたユーザーIDと目標タイプのアクティブな目標数を取得する
+func (r *GoalRepository) CountActiveGoalsByType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) (int, error) {
+	goals := r.findGoals(userID, func(g *entities.Goal) bool {
+		return g.IsActive() && g.GoalType() == goalType
+	}, oldestFirst)
+	return len(goals), nil
+}
+
+// FindOverdueActiveGoals は期限が asOf より前で未達成のアクティブな目標を全ユーザー分、期限の古い順に取得する
+func (r *GoalRepository) FindOverdueActiveGoals(ctx context.Context, asOf time.Time) ([]*entities.Goal, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var goals []*entities.Goal
+	for _, stored := range r.store.goals {
+		goal := stored
+		if goal.IsActive() && !goal.IsDeleted() && goal.TargetDate().Before(asOf) && !goal.IsCompleted() {
+			goals = append(goals, &goal)
+		}
+	}
+
+	sort.Slice(goals, func(i, j int) bool {
+		if goals[i].TargetDate().Equal(goals[j].TargetDate()) {
+			return goals[i].ID() < goals[j].ID()
+		}
+		return goals[i].TargetDate().Before(goals[j].TargetDate())
+	})
+	return goals, nil
+}
+
+// goalOrder は目標の並び順
+type goalOrder bool
+
+const (
+	oldestFirst goalOrder = false
+	newestFirst goalOrder = true
+)
+
+// findGoals は指定されたユーザーの目標のうち match を満たすものを作成日時順に複製して返す
+func (r *GoalRepository) findGoals(userID entities.UserID, match func(*entities.Goal) bool, order goalOrder) []*entities.Goal {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	return r.store.goalsOf(userID, match, order)
+}
+
+// goalsOf は指定されたユーザーの削除されていない目標のうち match を満たすものを作成日時順に複製して返す（ロックは呼び出し元で取得する）
+func (s *Store) goalsOf(userID entities.UserID, match func(*entities.Goal) bool, order goalOrder) []*entities.Goal {
+	var goals []*entities.Goal
+	for _, stored := range s.goals {
+		goal := stored
+		if goal.UserID() == userID && !goal.IsDeleted() && match(&goal) {
+			goals = append(goals, &goal)
+		}
+	}
+
+	sort.Slice(goals, func(i, j int) bool {
+		if goals[i].CreatedAt().Equal(goals[j].CreatedAt()) {
+			return goals[i].ID() < goals[j].ID()
+		}
+		if order == newestFirst {
+			return goals[i].CreatedAt().After(goals[j].CreatedAt())
+		}
+		return goals[i].CreatedAt().Before(goals[j].CreatedAt())
+	})
+	return goals
+}
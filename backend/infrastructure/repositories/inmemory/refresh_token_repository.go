@@ -0,0 +1,143 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// RefreshTokenRepository はメモリ上にリフレッシュトークンを保持する RefreshTokenRepository の実装
+type RefreshTokenRepository struct {
+	store *Store
+}
+
+// NewRefreshTokenRepository は新しいインメモリリフレッシュトークンリポジトリを作成する
+func NewRefreshTokenRepository(store *Store) repositories.RefreshTokenRepository {
+	return &RefreshTokenRepository{store: store}
+}
+
+// Save は新しいリフレッシュトークンを保存する（同じIDのトークンが既にある場合はエラー）
+func (r *RefreshTokenRepository) Save(ctx context.Context, token *entities.RefreshToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.refreshTokens[token.ID()]; ok {
+		return fmt.Errorf("リフレッシュトークンの保存に失敗しました: トークンが既に存在します: %s", token.ID())
+	}
+	r.store.refreshTokens[token.ID()] = *token
+	return nil
+}
+
+// FindByTokenHash はトークンハッシュからリフレッシュトークンを取得する
+func (r *RefreshTokenRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, token := range r.store.refreshTokens {
+		if token.TokenHash() == tokenHash {
+			return &token, nil
+		}
+	}
+	return nil, fmt.Errorf("リフレッシュトークンが見つかりません")
+}
+
+// FindByUserID は指定されたユーザーIDの有効なリフレッシュトークンを作成日時の新しい順にすべて取得する
+func (r *RefreshTokenRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.RefreshToken, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var tokens []*entities.RefreshToken
+	for _, stored := range r.store.refreshTokens {
+		token := stored
+		if token.UserID() == userID && token.IsValid() {
+			tokens = append(tokens, &token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt().After(tokens[j].CreatedAt())
+	})
+	return tokens, nil
+}
+
+// Update は既存のリフレッシュトークン情報を更新する
+func (r *RefreshTokenRepository) Update(ctx context.Context, token *entities.RefreshToken) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.refreshTokens[token.ID()]; !ok {
+		return fmt.Errorf("リフレッシュトークンが見つかりません: %s", token.ID())
+	}
+	r.store.refreshTokens[token.ID()] = *token
+	return nil
+}
+
+// Delete は指定されたIDのリフレッシュトークンを削除する
+func (r *RefreshTokenRepository) Delete(ctx context.Context, id entities.RefreshTokenID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.refreshTokens[id]; !ok {
+		return fmt.Errorf("リフレッシュトークンが見つかりません: %s", id)
+	}
+	delete(r.store.refreshTokens, id)
+	return nil
+}
+
+// CountByUserID は指定されたユーザーIDのリフレッシュトークン数を取得する（失効・期限切れを含む）
+func (r *RefreshTokenRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	count := 0
+	for _, token := range r.store.refreshTokens {
+		if token.UserID() == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDのすべてのリフレッシュトークンを削除する
+func (r *RefreshTokenRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, token := range r.store.refreshTokens {
+		if token.UserID() == userID {
+			delete(r.store.refreshTokens, id)
+		}
+	}
+	return nil
+}
+
+// DeleteExpired は期限切れのリフレッシュトークンをすべて削除する
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	now := time.Now()
+	for id, token := range r.store.refreshTokens {
+		if token.ExpiresAt().Before(now) {
+			delete(r.store.refreshTokens, id)
+		}
+	}
+	return nil
+}
+
+// RevokeByUserID は指定されたユーザーIDのすべてのリフレッシュトークンを失効させる
+func (r *RefreshTokenRepository) RevokeByUserID(ctx context.Context, userID entities.UserID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	for id, token := range r.store.refreshTokens {
+		if token.UserID() == userID && !token.IsRevoked() {
+			token.Revoke()
+			r.store.refreshTokens[id] = token
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Package inmemory はデータをメモリ上に保持するリポジトリの実装を提供する
+// DB なしでのデモやエンドツーエンドの動作確認に使う。プロセスを終了するとデータは失われる
+package inmemory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+// Store はインメモリリポジトリが共有するデータ
+// 財務計画と目標は PostgreSQL と同じく目標を共有するため、同じ Store から作成したリポジトリを組み合わせて使う
+// エンティティは保存・取得のたびに複製し、取得したエンティティを変更しても保存するまでは反映されない
+type Store struct {
+	mu            sync.RWMutex
+	plans         map[entities.UserID]planRecord
+	goals         map[entities.GoalID]entities.Goal
+	users         map[entities.UserID]entities.User
+	refreshTokens map[entities.RefreshTokenID]entities.RefreshToken
+}
+
+// planRecord は保存した財務計画（目標は goals に保存する）
+type planRecord struct {
+	id                   aggregates.FinancialPlanID
+	profile              entities.FinancialProfile
+	retirementData       *entities.RetirementData
+	emergencyFund        *emergencyFundRecord
+	createdAt            time.Time
+	updatedAt            time.Time
+	lastCalculatedAt     *time.Time
+	lastProfileUpdatedAt time.Time
+	lastReviewedAt       time.Time
+	version              int
+}
+
+// emergencyFundRecord は保存した緊急資金の設定（積立履歴は PostgreSQL と同じく保存しない）
+type emergencyFundRecord struct {
+	targetMonths        int
+	currentFund         valueobjects.Money
+	monthlyContribution valueobjects.Money
+}
+
+// NewStore は空の Store を作成する
+func NewStore() *Store {
+	return &Store{
+		plans:         make(map[entities.UserID]planRecord),
+		goals:         make(map[entities.GoalID]entities.Goal),
+		users:         make(map[entities.UserID]entities.User),
+		refreshTokens: make(map[entities.RefreshTokenID]entities.RefreshToken),
+	}
+}
+
+// storeSnapshot は UnitOfWork のロールバックに使う Store の複製
+type storeSnapshot struct {
+	plans         map[entities.UserID]planRecord
+	goals         map[entities.GoalID]entities.Goal
+	users         map[entities.UserID]entities.User
+	refreshTokens map[entities.RefreshTokenID]entities.RefreshToken
+}
+
+// snapshot は現在のデータの複製を返す
+func (s *Store) snapshot() storeSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return storeSnapshot{
+		plans:         copyMap(s.plans),
+		goals:         copyMap(s.goals),
+		users:         copyMap(s.users),
+		refreshTokens: copyMap(s.refreshTokens),
+	}
+}
+
+// restore はデータを snapshot の時点に戻す
+func (s *Store) restore(snapshot storeSnapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.plans = snapshot.plans
+	s.goals = snapshot.goals
+	s.users = snapshot.users
+	s.refreshTokens = snapshot.refreshTokens
+}
+
+// copyMap はマップの複製を返す（値は保存時に複製済みのため浅いコピーでよい）
+func copyMap[K comparable, V any](src map[K]V) map[K]V {
+	dst := make(map[K]V, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
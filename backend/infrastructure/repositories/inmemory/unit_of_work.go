@@ -0,0 +1,45 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// UnitOfWork はインメモリリポジトリへの書き込みをまとめる UnitOfWork の実装
+// トランザクションは1つずつ実行し、fn がエラーを返した場合は Store を開始時点の状態に戻す
+// トランザクション外からの同時書き込みも巻き戻されるため、デモ・テスト用途に限る
+type UnitOfWork struct {
+	store *Store
+	// インメモリ実装のないリポジトリ（パスワードリセットトークン・貯蓄率目標など）は、渡されたリポジトリをそのまま使う（ロールバック対象外）
+	external repositories.TxRepositories
+	txMu     sync.Mutex
+}
+
+// NewUnitOfWork は新しいインメモリUnitOfWorkを作成する
+// external の財務計画・目標・ユーザー・リフレッシュトークンは使わず、Store のリポジトリに置き換える
+func NewUnitOfWork(store *Store, external repositories.TxRepositories) repositories.UnitOfWork {
+	return &UnitOfWork{
+		store:    store,
+		external: external,
+	}
+}
+
+// WithinTx はfnを実行し、fnがエラーを返した場合は Store を開始時点の状態に戻してそのエラーを返す
+func (u *UnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context, repos repositories.TxRepositories) error) error {
+	u.txMu.Lock()
+	defer u.txMu.Unlock()
+
+	snapshot := u.store.snapshot()
+	repos := u.external
+	repos.FinancialPlans = NewFinancialPlanRepository(u.store)
+	repos.Goals = NewGoalRepository(u.store)
+	repos.Users = NewUserRepository(u.store)
+	repos.RefreshTokens = NewRefreshTokenRepository(u.store)
+	if err := fn(ctx, repos); err != nil {
+		u.store.restore(snapshot)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/google/uuid"
+)
+
+func TestUnitOfWork_WithinTx_RollsBackOnError(t *testing.T) {
+	ctx := context.Background()
+	store := NewStore()
+	uow := NewUnitOfWork(store, repositories.TxRepositories{})
+	users := NewUserRepository(store)
+
+	committed := newTestUser(t)
+	if err := uow.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		return repos.Users.Save(ctx, committed)
+	}); err != nil {
+		t.Fatalf("WithinTx failed: %v", err)
+	}
+
+	rolledBack := newTestUser(t)
+	errAbort := errors.New("abort")
+	err := uow.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		if err := repos.Users.Save(ctx, rolledBack); err != nil {
+			return err
+		}
+		if err := repos.Users.Delete(ctx, committed.ID()); err != nil {
+			return err
+		}
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("WithinTx error = %v, want %v", err, errAbort)
+	}
+
+	if exists, _ := users.Exists(ctx, committed.ID()); !exists {
+		t.Error("user deleted in the rolled back transaction should be restored")
+	}
+	if exists, _ := users.Exists(ctx, rolledBack.ID()); exists {
+		t.Error("user saved in the rolled back transaction should not exist")
+	}
+}
+
+func newTestUser(t *testing.T) *entities.User {
+	t.Helper()
+	id := uuid.New().String()
+	user, err := entities.NewUser(id, id+"@example.com", "Password123!")
+	if err != nil {
+		t.Fatalf("NewUser failed: %v", err)
+	}
+	return user
+}
@@ -0,0 +1,146 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// UserRepository はメモリ上にユーザーを保持する UserRepository の実装
+type UserRepository struct {
+	store *Store
+}
+
+// NewUserRepository は新しいインメモリユーザーリポジトリを作成する
+func NewUserRepository(store *Store) repositories.UserRepository {
+	return &UserRepository{store: store}
+}
+
+// Save は新しいユーザーを保存する（IDまたはメールアドレスが重複する場合はエラー）
+func (r *UserRepository) Save(ctx context.Context, user *entities.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.users[user.ID()]; ok {
+		return fmt.Errorf("ユーザーの保存に失敗しました: ユーザーが既に存在します: %s", user.ID())
+	}
+	if _, ok := r.store.userByEmail(user.Email()); ok {
+		return fmt.Errorf("ユーザーの保存に失敗しました: メールアドレスが既に使用されています: %s", user.Email())
+	}
+	r.store.users[user.ID()] = *user
+	return nil
+}
+
+// FindByID は指定されたIDのユーザーを取得する
+func (r *UserRepository) FindByID(ctx context.Context, id entities.UserID) (*entities.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	user, ok := r.store.users[id]
+	if !ok {
+		return nil, fmt.Errorf("ユーザーが見つかりません: %s", id)
+	}
+	return &user, nil
+}
+
+// FindByEmail はメールアドレスからユーザーを取得する
+func (r *UserRepository) FindByEmail(ctx context.Context, email entities.Email) (*entities.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	user, ok := r.store.userByEmail(email)
+	if !ok {
+		return nil, fmt.Errorf("ユーザーが見つかりません: %s", email)
+	}
+	return &user, nil
+}
+
+// Update は既存のユーザー情報を更新する
+func (r *UserRepository) Update(ctx context.Context, user *entities.User) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.users[user.ID()]; !ok {
+		return fmt.Errorf("ユーザーが見つかりません: %s", user.ID())
+	}
+	r.store.users[user.ID()] = *user
+	return nil
+}
+
+// Delete は指定されたIDのユーザーを削除する
+// PostgreSQL の ON DELETE CASCADE と同じく、ユーザーのリフレッシュトークンも削除する
+func (r *UserRepository) Delete(ctx context.Context, id entities.UserID) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if _, ok := r.store.users[id]; !ok {
+		return fmt.Errorf("ユーザーが見つかりません: %s", id)
+	}
+	delete(r.store.users, id)
+	for tokenID, token := range r.store.refreshTokens {
+		if token.UserID() == id {
+			delete(r.store.refreshTokens, tokenID)
+		}
+	}
+	return nil
+}
+
+// Exists は指定されたIDのユーザーが存在するか確認する
+func (r *UserRepository) Exists(ctx context.Context, id entities.UserID) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.users[id]
+	return ok, nil
+}
+
+// ExistsByEmail はメールアドレスが既に使用されているか確認する
+func (r *UserRepository) ExistsByEmail(ctx context.Context, email entities.Email) (bool, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	_, ok := r.store.userByEmail(email)
+	return ok, nil
+}
+
+// FindByProviderUserID はOAuthプロバイダーのユーザーIDからユーザーを取得する
+func (r *UserRepository) FindByProviderUserID(ctx context.Context, provider entities.AuthProvider, providerUserID string) (*entities.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	for _, user := range r.store.users {
+		if user.Provider() == provider && user.ProviderUserID() == providerUserID {
+			return &user, nil
+		}
+	}
+	return nil, fmt.Errorf("ユーザーが見つかりません: provider=%s, providerUserID=%s", provider, providerUserID)
+}
+
+// FindDigestSubscribers はダイジェストメールの配信を有効にしているユーザーをID順に取得する
+func (r *UserRepository) FindDigestSubscribers(ctx context.Context) ([]*entities.User, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var users []*entities.User
+	for _, user := range r.store.users {
+		if user.DigestPreference().IsEnabled() {
+			user := user
+			users = append(users, &user)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].ID() < users[j].ID() })
+	return users, nil
+}
+
+// userByEmail はメールアドレスが一致するユーザーを返す（ロックは呼び出し元で取得する）
+func (s *Store) userByEmail(email entities.Email) (entities.User, bool) {
+	for _, user := range s.users {
+		if user.Email() == email {
+			return user, true
+		}
+	}
+	return entities.User{}, false
+}
@@ -0,0 +1,192 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/lib/pq"
+)
+
+// apiKeyColumns はAPIキーの取得に使うカラム一覧
+const apiKeyColumns = `id, user_id, name, key_hash, last_four, scopes, last_used_at, created_at, revoked_at`
+
+// PostgreSQLAPIKeyRepository はPostgreSQLを使用したAPIキーリポジトリの実装
+type PostgreSQLAPIKeyRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLAPIKeyRepository は新しいPostgreSQL APIキーリポジトリを作成する
+func NewPostgreSQLAPIKeyRepository(db *sql.DB) repositories.APIKeyRepository {
+	return &PostgreSQLAPIKeyRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は新しいAPIキーを保存する
+func (r *PostgreSQLAPIKeyRepository) Save(ctx context.Context, apiKey *entities.APIKey) error {
+	query := `
+		INSERT INTO api_keys (id, user_id, name, key_hash, last_four, scopes, last_used_at, created_at, revoked_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		apiKey.ID().String(),
+		apiKey.UserID().String(),
+		apiKey.Name(),
+		apiKey.KeyHash(),
+		apiKey.LastFour(),
+		pq.Array(scopesToStrings(apiKey.Scopes())),
+		apiKey.LastUsedAt(),
+		apiKey.CreatedAt(),
+		apiKey.RevokedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("APIキーの保存に失敗しました: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID はIDからAPIキーを取得する
+func (r *PostgreSQLAPIKeyRepository) FindByID(ctx context.Context, id entities.APIKeyID) (*entities.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = $1`
+
+	apiKey, err := scanAPIKey(r.db.QueryRowContext(ctx, query, id.String()))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("APIキーが見つかりません")
+		}
+		return nil, fmt.Errorf("APIキーの取得に失敗しました: %w", err)
+	}
+	return apiKey, nil
+}
+
+// FindByKeyHash はキーのハッシュ値からAPIキーを取得する
+func (r *PostgreSQLAPIKeyRepository) FindByKeyHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = $1`
+
+	apiKey, err := scanAPIKey(r.db.QueryRowContext(ctx, query, keyHash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("APIキーが見つかりません")
+		}
+		return nil, fmt.Errorf("APIキーの取得に失敗しました: %w", err)
+	}
+	return apiKey, nil
+}
+
+// FindByUserID は指定されたユーザーIDのAPIキーをすべて取得する（失効済みを含む）
+func (r *PostgreSQLAPIKeyRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE user_id = $1 ORDER BY created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("APIキーの取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var apiKeys []*entities.APIKey
+	for rows.Next() {
+		apiKey, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("APIキーのスキャンに失敗しました: %w", err)
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, rows.Err()
+}
+
+// Update は既存のAPIキー情報を更新する（最終使用日時、失効状態など）
+func (r *PostgreSQLAPIKeyRepository) Update(ctx context.Context, apiKey *entities.APIKey) error {
+	query := `
+		UPDATE api_keys
+		SET name = $2, scopes = $3, last_used_at = $4, revoked_at = $5
+		WHERE id = $1`
+
+	result, err := r.db.ExecContext(ctx, query,
+		apiKey.ID().String(),
+		apiKey.Name(),
+		pq.Array(scopesToStrings(apiKey.Scopes())),
+		apiKey.LastUsedAt(),
+		apiKey.RevokedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("APIキーの更新に失敗しました: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("更新行数の取得に失敗しました: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("APIキーが見つかりません: %s", apiKey.ID().String())
+	}
+	return nil
+}
+
+// CountByUserID は指定されたユーザーIDのAPIキーの件数を取得する（失効済みを含む）
+func (r *PostgreSQLAPIKeyRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM api_keys WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID.String()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("APIキーの件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDのAPIキーをすべて削除する（失効済みを含む）
+func (r *PostgreSQLAPIKeyRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM api_keys WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, userID.String()); err != nil {
+		return fmt.Errorf("APIキーの削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// scanAPIKey は1行分のAPIキーをスキャンしてエンティティに再構築する
+func scanAPIKey(row rowScanner) (*entities.APIKey, error) {
+	var (
+		id, userID, name, keyHash, lastFour string
+		scopes                              []string
+		lastUsedAt, revokedAt               sql.NullTime
+		createdAt                           time.Time
+	)
+	if err := row.Scan(&id, &userID, &name, &keyHash, &lastFour, pq.Array(&scopes), &lastUsedAt, &createdAt, &revokedAt); err != nil {
+		return nil, err
+	}
+
+	apiKeyScopes := make([]entities.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		apiKeyScopes[i] = entities.APIKeyScope(scope)
+	}
+
+	return entities.ReconstructAPIKey(
+		id,
+		entities.UserID(userID),
+		name,
+		keyHash,
+		lastFour,
+		apiKeyScopes,
+		nullTimeToPtr(lastUsedAt),
+		createdAt,
+		nullTimeToPtr(revokedAt),
+	), nil
+}
+
+// scopesToStrings はスコープを文字列のスライスに変換する
+func scopesToStrings(scopes []entities.APIKeyScope) []string {
+	result := make([]string, len(scopes))
+	for i, scope := range scopes {
+		result[i] = string(scope)
+	}
+	return result
+}
+
+// nullTimeToPtr は sql.NullTime を *time.Time に変換する
+func nullTimeToPtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// auditLogColumns は監査ログの取得に使うカラム一覧
+const auditLogColumns = `id, action, actor_user_id, target_user_id, session_id, method, route, reason, expires_at, created_at`
+
+// PostgreSQLAuditLogRepository はPostgreSQLを使用した監査ログリポジトリの実装
+// 監査ログはユーザーの削除後も保持するため、アカウント削除の対象に含めない
+type PostgreSQLAuditLogRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLAuditLogRepository は新しいPostgreSQL監査ログリポジトリを作成する
+func NewPostgreSQLAuditLogRepository(db *sql.DB) repositories.AuditLogRepository {
+	return &PostgreSQLAuditLogRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は監査ログを追記する
+func (r *PostgreSQLAuditLogRepository) Save(ctx context.Context, entry *entities.AuditLogEntry) error {
+	query := `
+		INSERT INTO audit_logs (` + auditLogColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err := r.db.ExecContext(ctx, query,
+		entry.ID().String(),
+		string(entry.Action()),
+		entry.ActorUserID().String(),
+		entry.TargetUserID().String(),
+		entry.SessionID(),
+		entry.Method(),
+		entry.Route(),
+		entry.Reason(),
+		entry.ExpiresAt(),
+		entry.CreatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("監査ログの保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindExpiredImpersonationSessions は有効期限が now 以前で、期限切れが未記録のなりすましセッションの発行エントリを取得する
+func (r *PostgreSQLAuditLogRepository) FindExpiredImpersonationSessions(ctx context.Context, now time.Time) ([]*entities.AuditLogEntry, error) {
+	query := `
+		SELECT ` + auditLogColumns + `
+		FROM audit_logs started
+		WHERE started.action = $1
+		  AND started.expires_at <= $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM audit_logs expired
+			WHERE expired.session_id = started.session_id AND expired.action = $3
+		  )
+		ORDER BY started.expires_at`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		string(entities.AuditActionImpersonationStarted),
+		now,
+		string(entities.AuditActionImpersonationExpired),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("監査ログの取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*entities.AuditLogEntry
+	for rows.Next() {
+		entry, err := scanAuditLogEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("監査ログのスキャンに失敗しました: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// scanAuditLogEntry は1行分の監査ログをスキャンしてエンティティに再構築する
+func scanAuditLogEntry(row rowScanner) (*entities.AuditLogEntry, error) {
+	var (
+		id, action, actorUserID, targetUserID, sessionID, method, route, reason string
+		expiresAt                                                               sql.NullTime
+		createdAt                                                               time.Time
+	)
+	if err := row.Scan(&id, &action, &actorUserID, &targetUserID, &sessionID, &method, &route, &reason, &expiresAt, &createdAt); err != nil {
+		return nil, err
+	}
+
+	return entities.ReconstructAuditLogEntry(
+		id,
+		entities.AuditAction(action),
+		entities.UserID(actorUserID),
+		entities.UserID(targetUserID),
+		sessionID,
+		method,
+		route,
+		reason,
+		nullTimeToPtr(expiresAt),
+		createdAt,
+	), nil
+}
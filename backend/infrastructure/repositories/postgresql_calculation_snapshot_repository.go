@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLCalculationSnapshotRepository はPostgreSQLを使った計算結果リポジトリ
+type PostgreSQLCalculationSnapshotRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLCalculationSnapshotRepository は新しいリポジトリを作成する
+func NewPostgreSQLCalculationSnapshotRepository(db *sql.DB) repositories.CalculationSnapshotRepository {
+	return &PostgreSQLCalculationSnapshotRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は計算結果を保存する（同じユーザー・種別の計算結果は上書きする）
+func (r *PostgreSQLCalculationSnapshotRepository) Save(ctx context.Context, snapshot *entities.CalculationSnapshot) error {
+	query := `
+		INSERT INTO calculation_snapshots (user_id, kind, payload, calculation_version, recalculated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, kind)
+		DO UPDATE SET payload = EXCLUDED.payload,
+			calculation_version = EXCLUDED.calculation_version,
+			recalculated_at = EXCLUDED.recalculated_at
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(snapshot.UserID()),
+		string(snapshot.Kind()),
+		snapshot.Payload(),
+		snapshot.CalculationVersion(),
+		snapshot.RecalculatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("計算結果の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindByUserIDAndKind は指定されたユーザー・種別の計算結果を取得する（存在しない場合はnil）
+func (r *PostgreSQLCalculationSnapshotRepository) FindByUserIDAndKind(
+	ctx context.Context,
+	userID entities.UserID,
+	kind entities.CalculationSnapshotKind,
+) (*entities.CalculationSnapshot, error) {
+	query := `
+		SELECT payload, calculation_version, recalculated_at
+		FROM calculation_snapshots
+		WHERE user_id = $1 AND kind = $2
+	`
+	var (
+		payload            []byte
+		calculationVersion int
+		recalculatedAt     time.Time
+	)
+	err := r.db.QueryRowContext(ctx, query, string(userID), string(kind)).Scan(&payload, &calculationVersion, &recalculatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("計算結果の取得に失敗しました: %w", err)
+	}
+
+	snapshot, err := entities.NewCalculationSnapshot(userID, kind, payload, calculationVersion, recalculatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("計算結果の再構築に失敗しました: %w", err)
+	}
+	return snapshot, nil
+}
+
+// CountByUserID は指定されたユーザーIDの計算結果の件数を取得する
+func (r *PostgreSQLCalculationSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM calculation_snapshots WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("計算結果の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの計算結果をすべて削除する
+func (r *PostgreSQLCalculationSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM calculation_snapshots WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, string(userID)); err != nil {
+		return fmt.Errorf("計算結果の削除に失敗しました: %w", err)
+	}
+	return nil
+}
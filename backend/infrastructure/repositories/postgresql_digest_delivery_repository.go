@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/lib/pq"
+)
+
+// PostgreSQLDigestDeliveryRepository はPostgreSQLを使ったダイジェストメールの配信記録リポジトリ
+type PostgreSQLDigestDeliveryRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLDigestDeliveryRepository は新しいリポジトリを作成する
+func NewPostgreSQLDigestDeliveryRepository(db *sql.DB) repositories.DigestDeliveryRepository {
+	return &PostgreSQLDigestDeliveryRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save はダイジェストメールの配信記録を保存する
+func (r *PostgreSQLDigestDeliveryRepository) Save(ctx context.Context, delivery *entities.DigestDelivery) error {
+	goalAmounts, err := json.Marshal(delivery.GoalAmounts())
+	if err != nil {
+		return fmt.Errorf("目標の積立額のシリアライズに失敗しました: %w", err)
+	}
+
+	query := `
+		INSERT INTO digest_deliveries (user_id, frequency, health_score, warnings, goal_amounts, sent_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		string(delivery.UserID()),
+		string(delivery.Frequency()),
+		delivery.HealthScore(),
+		pq.Array(delivery.Warnings()),
+		goalAmounts,
+		delivery.SentAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("ダイジェストメールの配信記録の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindLatestByUserID は指定されたユーザーの最新の配信記録を取得する（配信記録がない場合は nil を返す）
+func (r *PostgreSQLDigestDeliveryRepository) FindLatestByUserID(ctx context.Context, userID entities.UserID) (*entities.DigestDelivery, error) {
+	query := `
+		SELECT frequency, health_score, warnings, goal_amounts, sent_at
+		FROM digest_deliveries
+		WHERE user_id = $1
+		ORDER BY sent_at DESC
+		LIMIT 1
+	`
+	var (
+		frequency       string
+		healthScore     int
+		warnings        []string
+		goalAmountsJSON []byte
+		sentAt          time.Time
+	)
+	err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&frequency, &healthScore, pq.Array(&warnings), &goalAmountsJSON, &sentAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("ダイジェストメールの配信記録の取得に失敗しました: %w", err)
+	}
+
+	var goalAmounts map[entities.GoalID]float64
+	if err := json.Unmarshal(goalAmountsJSON, &goalAmounts); err != nil {
+		return nil, fmt.Errorf("目標の積立額の読み取りに失敗しました: %w", err)
+	}
+
+	delivery, err := entities.NewDigestDelivery(userID, entities.DigestFrequency(frequency), healthScore, warnings, goalAmounts, sentAt)
+	if err != nil {
+		return nil, fmt.Errorf("ダイジェストメールの配信記録の再構築に失敗しました: %w", err)
+	}
+	return delivery, nil
+}
@@ -3,38 +3,49 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/lib/pq"
 )
 
 // PostgreSQLFinancialPlanRepository はPostgreSQLを使用した財務計画リポジトリの実装
 type PostgreSQLFinancialPlanRepository struct {
-	db *sql.DB
+	db dbExecutor
 }
 
 // NewPostgreSQLFinancialPlanRepository は新しいPostgreSQL財務計画リポジトリを作成する
 func NewPostgreSQLFinancialPlanRepository(db *sql.DB) repositories.FinancialPlanRepository {
-	return &PostgreSQLFinancialPlanRepository{db: db}
+	return &PostgreSQLFinancialPlanRepository{db: newCircuitBreakerExecutor(db, nil)}
 }
 
 // Save は財務計画を保存する
 func (r *PostgreSQLFinancialPlanRepository) Save(ctx context.Context, plan *aggregates.FinancialPlan) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
-	}
-	defer tx.Rollback()
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		return r.savePlan(ctx, tx, plan)
+	})
+}
 
+// savePlan は財務計画の各データをトランザクション内で保存する（バージョンは変更しない）
+func (r *PostgreSQLFinancialPlanRepository) savePlan(ctx context.Context, tx *sql.Tx, plan *aggregates.FinancialPlan) error {
 	// 財務プロファイルを保存
-	if err := r.saveFinancialProfile(ctx, tx, plan.Profile()); err != nil {
+	if err := r.saveFinancialProfile(ctx, tx, plan.Profile(), plan.LastProfileUpdatedAt(), plan.LastReviewedAt()); err != nil {
 		return fmt.Errorf("財務プロファイルの保存に失敗しました: %w", err)
 	}
 
+	// 緊急資金の設定を保存（存在する場合）
+	if plan.EmergencyFund() != nil {
+		if err := r.saveEmergencyFund(ctx, tx, plan.Profile().UserID(), plan.EmergencyFund()); err != nil {
+			return fmt.Errorf("緊急資金の保存に失敗しました: %w", err)
+		}
+	}
+
 	// 退職データを保存（存在する場合）
 	if plan.RetirementData() != nil {
 		if err := r.saveRetirementData(ctx, tx, plan.RetirementData()); err != nil {
@@ -42,14 +53,14 @@ func (r *PostgreSQLFinancialPlanRepository) Save(ctx context.Context, plan *aggr
 		}
 	}
 
-	// 目標を保存
-	for _, goal := range plan.Goals() {
+	// 目標を保存（計画から削除された目標は deleted_at を記録して論理削除する）
+	for _, goal := range append(plan.Goals(), plan.RemovedGoals()...) {
 		if err := r.saveGoal(ctx, tx, goal); err != nil {
 			return fmt.Errorf("目標の保存に失敗しました: %w", err)
 		}
 	}
 
-	return tx.Commit()
+	return nil
 }
 
 // FindByID は指定されたIDの財務計画を取得する
@@ -83,6 +94,11 @@ func (r *PostgreSQLFinancialPlanRepository) FindByUserID(ctx context.Context, us
 		return nil, fmt.Errorf("財務計画の作成に失敗しました: %w", err)
 	}
 
+	// 緊急資金の設定を復元（未保存の場合は既定の緊急資金のまま）
+	if err := r.loadEmergencyFund(ctx, userID, plan); err != nil {
+		return nil, fmt.Errorf("緊急資金の取得に失敗しました: %w", err)
+	}
+
 	// 退職データを取得（存在する場合）
 	retirementData, err := r.loadRetirementData(ctx, userID)
 	if err == nil && retirementData != nil {
@@ -97,22 +113,81 @@ func (r *PostgreSQLFinancialPlanRepository) FindByUserID(ctx context.Context, us
 		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
 	}
 
-	// 目標を財務計画に追加
-	for _, goal := range goals {
-		if err := plan.AddGoal(goal); err != nil {
-			// 目標の追加に失敗した場合はログに記録するが、処理は続行
-			// これにより、一部の目標に問題があっても他の目標は取得できる
-			continue
-		}
+	// 目標を財務計画に復元（保存済みの目標は業務ルールを再検証せずにすべて復元する）
+	plan.RestoreGoals(goals)
+
+	// 負債を取得（目標の達成可能性の判定に影響しないよう、目標の追加後に設定する）
+	liabilities, err := r.loadLiabilities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("負債の取得に失敗しました: %w", err)
+	}
+	plan.Profile().SetLiabilities(liabilities)
+
+	// ライフイベントを取得
+	lifeEvents, err := r.loadLifeEvents(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントの取得に失敗しました: %w", err)
 	}
+	plan.Profile().SetLifeEvents(lifeEvents)
+
+	// 計算日時・データの最終更新・最終確認日時とバージョンを復元（退職データの設定で更新された日時を上書きする）
+	metadata, err := r.loadPlanMetadata(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("計算日時の取得に失敗しました: %w", err)
+	}
+	plan.RestoreCalculationTimestamps(metadata.lastCalculatedAt, metadata.lastProfileUpdatedAt)
+	plan.RestoreLastReviewedAt(metadata.lastReviewedAt)
+	plan.RestoreVersion(metadata.version)
 
 	return plan, nil
 }
 
 // Update は既存の財務計画を更新する
+// 読み込んだ時点のバージョンのままの場合のみバージョンを進めて保存し、他のセッションで更新されていた場合は
+// *repositories.VersionConflictError を返す（財務計画が未保存の場合は Save と同じく新規に保存する）
 func (r *PostgreSQLFinancialPlanRepository) Update(ctx context.Context, plan *aggregates.FinancialPlan) error {
-	// Updateは基本的にSaveと同じ処理（UPSERT）
-	return r.Save(ctx, plan)
+	userID := plan.Profile().UserID()
+	version := plan.Version()
+	err := runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		// バージョンの確認と更新を1つの UPDATE で行い、同時に更新した他のトランザクションとの競合を防ぐ
+		err := tx.QueryRowContext(ctx,
+			`UPDATE financial_data SET version = version + 1 WHERE user_id = $1 AND version = $2 RETURNING version`,
+			string(userID), plan.Version(),
+		).Scan(&version)
+		switch {
+		case err == nil:
+		case errors.Is(err, sql.ErrNoRows):
+			var currentVersion int
+			err := tx.QueryRowContext(ctx, `SELECT version FROM financial_data WHERE user_id = $1`, string(userID)).Scan(&currentVersion)
+			if err == nil {
+				return &repositories.VersionConflictError{CurrentVersion: currentVersion}
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("財務計画のバージョンの取得に失敗しました: %w", err)
+			}
+		default:
+			return fmt.Errorf("財務計画のバージョンの更新に失敗しました: %w", err)
+		}
+
+		return r.savePlan(ctx, tx, plan)
+	})
+	if err != nil {
+		return err
+	}
+
+	plan.RestoreVersion(version)
+	return nil
+}
+
+// MarkCalculated は計算結果を保存した日時を記録する（より新しい日時が記録済みの場合は何もしない）
+func (r *PostgreSQLFinancialPlanRepository) MarkCalculated(ctx context.Context, userID entities.UserID, calculatedAt time.Time) error {
+	query := `
+		UPDATE financial_data SET last_calculated_at = $2
+		WHERE user_id = $1 AND (last_calculated_at IS NULL OR last_calculated_at < $2)`
+	if _, err := r.db.ExecContext(ctx, query, string(userID), calculatedAt); err != nil {
+		return fmt.Errorf("計算日時の記録に失敗しました: %w", err)
+	}
+	return nil
 }
 
 // Delete は指定されたIDの財務計画を削除する
@@ -128,28 +203,26 @@ func (r *PostgreSQLFinancialPlanRepository) Delete(ctx context.Context, id aggre
 		return fmt.Errorf("財務計画の検索に失敗しました: %w", err)
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
-	}
-	defer tx.Rollback()
-
-	// 関連データを削除（外部キー制約により自動削除されるが、明示的に削除）
-	queries := []string{
-		`DELETE FROM goals WHERE user_id = $1`,
-		`DELETE FROM retirement_data WHERE user_id = $1`,
-		`DELETE FROM expense_items WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)`,
-		`DELETE FROM savings_items WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)`,
-		`DELETE FROM financial_data WHERE user_id = $1`,
-	}
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		// 関連データを削除（外部キー制約により自動削除されるが、明示的に削除）
+		queries := []string{
+			`DELETE FROM goals WHERE user_id = $1`,
+			`DELETE FROM retirement_data WHERE user_id = $1`,
+			`DELETE FROM expense_items WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)`,
+			`DELETE FROM savings_items WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)`,
+			`DELETE FROM liabilities WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)`,
+			`DELETE FROM life_events WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)`,
+			`DELETE FROM financial_data WHERE user_id = $1`,
+		}
 
-	for _, query := range queries {
-		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
-			return fmt.Errorf("関連データの削除に失敗しました: %w", err)
+		for _, query := range queries {
+			if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+				return fmt.Errorf("関連データの削除に失敗しました: %w", err)
+			}
 		}
-	}
 
-	return tx.Commit()
+		return nil
+	})
 }
 
 // Exists は指定されたIDの財務計画が存在するかチェックする
@@ -163,6 +236,26 @@ func (r *PostgreSQLFinancialPlanRepository) Exists(ctx context.Context, id aggre
 	return count > 0, nil
 }
 
+// FindUserIDs は財務計画を持つユーザーのIDを afterUserID より後からユーザーIDの昇順で最大 limit 件取得する
+func (r *PostgreSQLFinancialPlanRepository) FindUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	query := `SELECT user_id FROM financial_data WHERE user_id > $1 ORDER BY user_id LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, string(afterUserID), limit)
+	if err != nil {
+		return nil, fmt.Errorf("財務計画を持つユーザーの取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []entities.UserID
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("ユーザーIDのスキャンに失敗しました: %w", err)
+		}
+		userIDs = append(userIDs, entities.UserID(userID))
+	}
+	return userIDs, rows.Err()
+}
+
 // ExistsByUserID は指定されたユーザーIDの財務計画が存在するかチェックする
 func (r *PostgreSQLFinancialPlanRepository) ExistsByUserID(ctx context.Context, userID entities.UserID) (bool, error) {
 	var count int
@@ -175,39 +268,72 @@ func (r *PostgreSQLFinancialPlanRepository) ExistsByUserID(ctx context.Context,
 }
 
 // saveFinancialProfile は財務プロファイルを保存する
-func (r *PostgreSQLFinancialPlanRepository) saveFinancialProfile(ctx context.Context, tx *sql.Tx, profile *entities.FinancialProfile) error {
+func (r *PostgreSQLFinancialPlanRepository) saveFinancialProfile(ctx context.Context, tx *sql.Tx, profile *entities.FinancialProfile, lastProfileUpdatedAt, lastReviewedAt time.Time) error {
 	// 財務データを保存（UPSERT）
+	// 最終計算日時は MarkCalculated でのみ更新する
 	query := `
-		INSERT INTO financial_data (id, user_id, monthly_income, investment_return, inflation_rate, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO financial_data (id, user_id, monthly_income, income_type, birth_date, investment_return, inflation_rate, expected_volatility, risk_tolerance, high_return_acknowledged, high_inflation_acknowledged, created_at, updated_at, last_profile_updated_at, last_reviewed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		ON CONFLICT (user_id) DO UPDATE SET
 			monthly_income = EXCLUDED.monthly_income,
+			income_type = EXCLUDED.income_type,
+			birth_date = EXCLUDED.birth_date,
 			investment_return = EXCLUDED.investment_return,
 			inflation_rate = EXCLUDED.inflation_rate,
-			updated_at = EXCLUDED.updated_at
+			expected_volatility = EXCLUDED.expected_volatility,
+			risk_tolerance = EXCLUDED.risk_tolerance,
+			high_return_acknowledged = EXCLUDED.high_return_acknowledged,
+			high_inflation_acknowledged = EXCLUDED.high_inflation_acknowledged,
+			updated_at = EXCLUDED.updated_at,
+			last_profile_updated_at = EXCLUDED.last_profile_updated_at,
+			last_reviewed_at = EXCLUDED.last_reviewed_at
 		RETURNING id`
 
+	var expectedVolatility sql.NullFloat64
+	if volatility, ok := profile.ExpectedVolatility(); ok {
+		expectedVolatility = sql.NullFloat64{Float64: volatility.AsPercentage(), Valid: true}
+	}
+
 	var financialDataID string
 	err := tx.QueryRowContext(ctx, query,
 		string(profile.ID()),
 		string(profile.UserID()),
 		profile.MonthlyIncome().Amount(),
+		string(profile.IncomeType()),
+		profile.BirthDate(),
 		profile.InvestmentReturn().AsPercentage(),
 		profile.InflationRate().AsPercentage(),
+		expectedVolatility,
+		string(profile.RiskTolerance()),
+		profile.HighReturnAcknowledged(),
+		profile.HighInflationAcknowledged(),
 		profile.CreatedAt(),
 		profile.UpdatedAt(),
+		lastProfileUpdatedAt,
+		lastReviewedAt,
 	).Scan(&financialDataID)
 	if err != nil {
 		return fmt.Errorf("財務データの保存に失敗しました: %w", err)
 	}
 
-	// 既存の支出項目と貯蓄項目を削除
+	// 財務データより先に作成された目標を財務計画に紐付ける
+	if _, err := tx.ExecContext(ctx, `UPDATE goals SET plan_id = $1 WHERE user_id = $2 AND plan_id IS NULL`, financialDataID, string(profile.UserID())); err != nil {
+		return fmt.Errorf("目標の財務計画への紐付けに失敗しました: %w", err)
+	}
+
+	// 既存の支出項目・貯蓄項目・負債・ライフイベントを削除
 	if _, err := tx.ExecContext(ctx, `DELETE FROM expense_items WHERE financial_data_id = $1`, financialDataID); err != nil {
 		return fmt.Errorf("既存支出項目の削除に失敗しました: %w", err)
 	}
 	if _, err := tx.ExecContext(ctx, `DELETE FROM savings_items WHERE financial_data_id = $1`, financialDataID); err != nil {
 		return fmt.Errorf("既存貯蓄項目の削除に失敗しました: %w", err)
 	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM liabilities WHERE financial_data_id = $1`, financialDataID); err != nil {
+		return fmt.Errorf("既存負債の削除に失敗しました: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM life_events WHERE financial_data_id = $1`, financialDataID); err != nil {
+		return fmt.Errorf("既存ライフイベントの削除に失敗しました: %w", err)
+	}
 
 	// 支出項目を保存
 	for _, expense := range profile.MonthlyExpenses() {
@@ -245,20 +371,68 @@ func (r *PostgreSQLFinancialPlanRepository) saveFinancialProfile(ctx context.Con
 		}
 	}
 
+	// 負債を保存（IDは作成時のものを維持する）
+	for _, liability := range profile.Liabilities() {
+		liabilityQuery := `
+			INSERT INTO liabilities (id, financial_data_id, type, name, principal_remaining, interest_rate, monthly_payment, end_date, included_in_expenses, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+		_, err := tx.ExecContext(ctx, liabilityQuery,
+			string(liability.ID()),
+			financialDataID,
+			string(liability.LiabilityType()),
+			liability.Name(),
+			liability.PrincipalRemaining().Amount(),
+			liability.InterestRate().AsPercentage(),
+			liability.MonthlyPayment().Amount(),
+			liability.EndDate(),
+			liability.IncludedInExpenses(),
+			liability.CreatedAt(),
+			liability.UpdatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("負債の保存に失敗しました: %w", err)
+		}
+	}
+
+	// ライフイベントを保存（IDは作成時のものを維持する）
+	for _, event := range profile.LifeEvents() {
+		lifeEventQuery := `
+			INSERT INTO life_events (id, financial_data_id, category, name, event_year, recurrence, amount_change, duration_years, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+		_, err := tx.ExecContext(ctx, lifeEventQuery,
+			string(event.ID()),
+			financialDataID,
+			string(event.Category()),
+			event.Name(),
+			event.Year(),
+			string(event.Recurrence()),
+			event.AmountChange().Amount(),
+			event.DurationYears(),
+			event.CreatedAt(),
+			event.UpdatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("ライフイベントの保存に失敗しました: %w", err)
+		}
+	}
+
 	return nil
 }
 
 // saveRetirementData は退職データを保存する
 func (r *PostgreSQLFinancialPlanRepository) saveRetirementData(ctx context.Context, tx *sql.Tx, retirementData *entities.RetirementData) error {
 	query := `
-		INSERT INTO retirement_data (id, user_id, current_age, retirement_age, life_expectancy, monthly_retirement_expenses, pension_amount, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		INSERT INTO retirement_data (id, user_id, current_age, retirement_age, life_expectancy, monthly_retirement_expenses, pension_amount, kokumin_nenkin_monthly_amount, nurse_care_insurance_monthly, last_age_update_date, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		ON CONFLICT (user_id) DO UPDATE SET
 			current_age = EXCLUDED.current_age,
 			retirement_age = EXCLUDED.retirement_age,
 			life_expectancy = EXCLUDED.life_expectancy,
 			monthly_retirement_expenses = EXCLUDED.monthly_retirement_expenses,
 			pension_amount = EXCLUDED.pension_amount,
+			kokumin_nenkin_monthly_amount = EXCLUDED.kokumin_nenkin_monthly_amount,
+			nurse_care_insurance_monthly = EXCLUDED.nurse_care_insurance_monthly,
+			last_age_update_date = EXCLUDED.last_age_update_date,
 			updated_at = EXCLUDED.updated_at`
 
 	_, err := tx.ExecContext(ctx, query,
@@ -269,6 +443,9 @@ func (r *PostgreSQLFinancialPlanRepository) saveRetirementData(ctx context.Conte
 		retirementData.LifeExpectancy(),
 		retirementData.MonthlyRetirementExpenses().Amount(),
 		retirementData.PensionAmount().Amount(),
+		retirementData.KokuminNenkinMonthlyAmount().Amount(),
+		retirementData.NurseCareInsuranceMonthly().Amount(),
+		retirementData.LastAgeUpdateDate(),
 		retirementData.CreatedAt(),
 		retirementData.UpdatedAt(),
 	)
@@ -282,8 +459,9 @@ func (r *PostgreSQLFinancialPlanRepository) saveRetirementData(ctx context.Conte
 // saveGoal は目標を保存する
 func (r *PostgreSQLFinancialPlanRepository) saveGoal(ctx context.Context, tx *sql.Tx, goal *entities.Goal) error {
 	query := `
-		INSERT INTO goals (id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		INSERT INTO goals (id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy, deleted_at, plan_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19,
+			(SELECT id FROM financial_data WHERE user_id = $2))
 		ON CONFLICT (id) DO UPDATE SET
 			type = EXCLUDED.type,
 			title = EXCLUDED.title,
@@ -292,7 +470,16 @@ func (r *PostgreSQLFinancialPlanRepository) saveGoal(ctx context.Context, tx *sq
 			current_amount = EXCLUDED.current_amount,
 			monthly_contribution = EXCLUDED.monthly_contribution,
 			is_active = EXCLUDED.is_active,
-			updated_at = EXCLUDED.updated_at`
+			updated_at = EXCLUDED.updated_at,
+			depends_on_goal_ids = EXCLUDED.depends_on_goal_ids,
+			notes = EXCLUDED.notes,
+			image_url = EXCLUDED.image_url,
+			goal_currency = EXCLUDED.goal_currency,
+			contribution_min = EXCLUDED.contribution_min,
+			contribution_stretch = EXCLUDED.contribution_stretch,
+			overdue_policy = EXCLUDED.overdue_policy,
+			deleted_at = EXCLUDED.deleted_at,
+			plan_id = EXCLUDED.plan_id`
 
 	_, err := tx.ExecContext(ctx, query,
 		string(goal.ID()),
@@ -306,6 +493,14 @@ func (r *PostgreSQLFinancialPlanRepository) saveGoal(ctx context.Context, tx *sq
 		goal.IsActive(),
 		goal.CreatedAt(),
 		goal.UpdatedAt(),
+		dependsOnGoalIDsParam(goal),
+		goal.Notes(),
+		goal.ImageURL(),
+		goalCurrencyParam(goal),
+		nullMoney(goal.ContributionMin()),
+		nullMoney(goal.ContributionStretch()),
+		string(goal.OverduePolicy()),
+		goal.DeletedAt(),
 	)
 	if err != nil {
 		return fmt.Errorf("目標の保存に失敗しました: %w", err)
@@ -317,14 +512,17 @@ func (r *PostgreSQLFinancialPlanRepository) saveGoal(ctx context.Context, tx *sq
 // loadFinancialProfile は財務プロファイルを読み込む
 func (r *PostgreSQLFinancialPlanRepository) loadFinancialProfile(ctx context.Context, userID entities.UserID) (*entities.FinancialProfile, error) {
 	// 財務データを取得
-	var financialDataID, fdUserID string
+	var financialDataID, fdUserID, incomeType, riskTolerance string
 	var monthlyIncome, investmentReturn, inflationRate float64
+	var expectedVolatility sql.NullFloat64
+	var highReturnAcknowledged, highInflationAcknowledged bool
+	var birthDate sql.NullTime
 	var createdAt, updatedAt time.Time
 
-	query := `SELECT id, user_id, monthly_income, investment_return, inflation_rate, created_at, updated_at 
+	query := `SELECT id, user_id, monthly_income, income_type, birth_date, investment_return, inflation_rate, expected_volatility, risk_tolerance, high_return_acknowledged, high_inflation_acknowledged, created_at, updated_at 
 			  FROM financial_data WHERE user_id = $1`
 	err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(
-		&financialDataID, &fdUserID, &monthlyIncome, &investmentReturn, &inflationRate, &createdAt, &updatedAt,
+		&financialDataID, &fdUserID, &monthlyIncome, &incomeType, &birthDate, &investmentReturn, &inflationRate, &expectedVolatility, &riskTolerance, &highReturnAcknowledged, &highInflationAcknowledged, &createdAt, &updatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -417,21 +615,226 @@ func (r *PostgreSQLFinancialPlanRepository) loadFinancialProfile(ctx context.Con
 	if err != nil {
 		return nil, fmt.Errorf("財務プロファイルの作成に失敗しました: %w", err)
 	}
+	profile.AcknowledgeHighAssumptions(highReturnAcknowledged, highInflationAcknowledged)
+
+	// 額面で入力された月収は現行の税率表で手取りを推定し直す
+	incomeTypeVO, err := entities.ParseIncomeType(incomeType)
+	if err != nil {
+		return nil, fmt.Errorf("月収の入力区分の作成に失敗しました: %w", err)
+	}
+	if err := services.NewDefaultTaxEstimationService().ApplyIncomeType(profile, incomeTypeVO); err != nil {
+		return nil, fmt.Errorf("手取り月収の設定に失敗しました: %w", err)
+	}
+	if birthDate.Valid {
+		if err := profile.SetBirthDate(&birthDate.Time); err != nil {
+			return nil, fmt.Errorf("生年月日の設定に失敗しました: %w", err)
+		}
+	}
+	if expectedVolatility.Valid {
+		volatility, err := valueobjects.NewRate(expectedVolatility.Float64)
+		if err != nil {
+			return nil, fmt.Errorf("想定ボラティリティの作成に失敗しました: %w", err)
+		}
+		profile.SetExpectedVolatility(&volatility)
+	}
+	if err := profile.SetRiskTolerance(entities.RiskTolerance(riskTolerance)); err != nil {
+		return nil, fmt.Errorf("リスク許容度の設定に失敗しました: %w", err)
+	}
 
 	return profile, nil
 }
 
+// loadLiabilities はユーザーの負債を登録順に読み込む
+func (r *PostgreSQLFinancialPlanRepository) loadLiabilities(ctx context.Context, userID entities.UserID) (entities.LiabilityCollection, error) {
+	query := `SELECT id, type, name, principal_remaining, interest_rate, monthly_payment, end_date, included_in_expenses, created_at, updated_at
+			  FROM liabilities
+			  WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)
+			  ORDER BY created_at`
+	rows, err := r.db.QueryContext(ctx, query, string(userID))
+	if err != nil {
+		return nil, fmt.Errorf("負債の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var liabilities entities.LiabilityCollection
+	for rows.Next() {
+		var id, liabilityType, name string
+		var principalRemaining, interestRate, monthlyPayment float64
+		var endDate, createdAt, updatedAt time.Time
+		var includedInExpenses bool
+		if err := rows.Scan(&id, &liabilityType, &name, &principalRemaining, &interestRate, &monthlyPayment, &endDate, &includedInExpenses, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("負債の読み取りに失敗しました: %w", err)
+		}
+
+		principal, err := valueobjects.NewMoneyJPY(principalRemaining)
+		if err != nil {
+			return nil, fmt.Errorf("負債残高の作成に失敗しました: %w", err)
+		}
+		rate, err := valueobjects.NewRate(interestRate)
+		if err != nil {
+			return nil, fmt.Errorf("負債の金利の作成に失敗しました: %w", err)
+		}
+		payment, err := valueobjects.NewMoneyJPY(monthlyPayment)
+		if err != nil {
+			return nil, fmt.Errorf("負債の返済額の作成に失敗しました: %w", err)
+		}
+
+		liability, err := entities.NewLiabilityWithID(
+			entities.LiabilityID(id),
+			entities.LiabilityType(liabilityType),
+			name,
+			principal,
+			rate,
+			payment,
+			endDate,
+			includedInExpenses,
+			createdAt,
+			updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("負債の作成に失敗しました: %w", err)
+		}
+		liabilities = append(liabilities, liability)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("負債の読み取りに失敗しました: %w", err)
+	}
+
+	return liabilities, nil
+}
+
+// loadLifeEvents はユーザーのライフイベントを登録順に取得する
+func (r *PostgreSQLFinancialPlanRepository) loadLifeEvents(ctx context.Context, userID entities.UserID) (entities.LifeEventCollection, error) {
+	query := `SELECT id, category, name, event_year, recurrence, amount_change, duration_years, created_at, updated_at
+			  FROM life_events
+			  WHERE financial_data_id IN (SELECT id FROM financial_data WHERE user_id = $1)
+			  ORDER BY created_at`
+	rows, err := r.db.QueryContext(ctx, query, string(userID))
+	if err != nil {
+		return nil, fmt.Errorf("ライフイベントの取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var lifeEvents entities.LifeEventCollection
+	for rows.Next() {
+		var id, category, name, recurrence string
+		var year, durationYears int
+		var amountChange float64
+		var createdAt, updatedAt time.Time
+		if err := rows.Scan(&id, &category, &name, &year, &recurrence, &amountChange, &durationYears, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("ライフイベントの読み取りに失敗しました: %w", err)
+		}
+
+		amount, err := valueobjects.NewMoneyJPY(amountChange)
+		if err != nil {
+			return nil, fmt.Errorf("ライフイベントの増減額の作成に失敗しました: %w", err)
+		}
+
+		event, err := entities.NewLifeEventWithID(
+			entities.LifeEventID(id),
+			entities.LifeEventCategory(category),
+			name,
+			year,
+			entities.LifeEventRecurrence(recurrence),
+			amount,
+			durationYears,
+			createdAt,
+			updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("ライフイベントの作成に失敗しました: %w", err)
+		}
+		lifeEvents = append(lifeEvents, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ライフイベントの読み取りに失敗しました: %w", err)
+	}
+
+	return lifeEvents, nil
+}
+
+// saveEmergencyFund は緊急資金の目標月数・現在額・毎月の積立予定額を保存する
+// 積立履歴はキャッシュにのみ保持する
+func (r *PostgreSQLFinancialPlanRepository) saveEmergencyFund(ctx context.Context, tx *sql.Tx, userID entities.UserID, emergencyFund *aggregates.EmergencyFund) error {
+	query := `
+		UPDATE financial_data SET
+			emergency_fund_target_months = $2,
+			emergency_fund_current_amount = $3,
+			emergency_fund_monthly_contribution = $4
+		WHERE user_id = $1`
+	_, err := tx.ExecContext(ctx, query,
+		string(userID),
+		emergencyFund.TargetMonths(),
+		emergencyFund.CurrentFund().Amount(),
+		emergencyFund.MonthlyContribution().Amount(),
+	)
+	return err
+}
+
+// loadEmergencyFund は保存済みの緊急資金の設定を財務計画に復元する
+func (r *PostgreSQLFinancialPlanRepository) loadEmergencyFund(ctx context.Context, userID entities.UserID, plan *aggregates.FinancialPlan) error {
+	var targetMonths sql.NullInt64
+	var currentAmount sql.NullFloat64
+	var monthlyContribution float64
+	query := `SELECT emergency_fund_target_months, emergency_fund_current_amount, emergency_fund_monthly_contribution FROM financial_data WHERE user_id = $1`
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&targetMonths, &currentAmount, &monthlyContribution); err != nil {
+		return err
+	}
+	if !targetMonths.Valid {
+		return nil
+	}
+
+	currentFund, err := valueobjects.NewMoneyJPY(currentAmount.Float64)
+	if err != nil {
+		return err
+	}
+	if err := plan.UpdateEmergencyFundSettings(int(targetMonths.Int64), currentFund); err != nil {
+		return err
+	}
+
+	contribution, err := valueobjects.NewMoneyJPY(monthlyContribution)
+	if err != nil {
+		return err
+	}
+	return plan.UpdateEmergencyFundMonthlyContribution(contribution)
+}
+
+// planMetadata は財務データの計算日時・最終更新日時・最終確認日時とバージョン
+type planMetadata struct {
+	lastCalculatedAt     *time.Time
+	lastProfileUpdatedAt time.Time
+	lastReviewedAt       time.Time
+	version              int
+}
+
+// loadPlanMetadata は最終計算日時とデータの最終更新日時・最終確認日時、バージョンを読み込む
+func (r *PostgreSQLFinancialPlanRepository) loadPlanMetadata(ctx context.Context, userID entities.UserID) (planMetadata, error) {
+	var metadata planMetadata
+	var lastCalculatedAt sql.NullTime
+	query := `SELECT last_calculated_at, last_profile_updated_at, last_reviewed_at, version FROM financial_data WHERE user_id = $1`
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&lastCalculatedAt, &metadata.lastProfileUpdatedAt, &metadata.lastReviewedAt, &metadata.version); err != nil {
+		return planMetadata{}, err
+	}
+	if lastCalculatedAt.Valid {
+		metadata.lastCalculatedAt = &lastCalculatedAt.Time
+	}
+	return metadata, nil
+}
+
 // loadRetirementData は退職データを読み込む
 func (r *PostgreSQLFinancialPlanRepository) loadRetirementData(ctx context.Context, userID entities.UserID) (*entities.RetirementData, error) {
 	var id, rdUserID string
 	var currentAge, retirementAge, lifeExpectancy int
-	var monthlyRetirementExpenses, pensionAmount float64
+	var monthlyRetirementExpenses, pensionAmount, kokuminNenkinMonthly, nurseCareInsuranceMonthly float64
+	var lastAgeUpdateDate sql.NullTime
 	var createdAt, updatedAt time.Time
 
-	query := `SELECT id, user_id, current_age, retirement_age, life_expectancy, monthly_retirement_expenses, pension_amount, created_at, updated_at 
+	query := `SELECT id, user_id, current_age, retirement_age, life_expectancy, monthly_retirement_expenses, pension_amount,
+			  kokumin_nenkin_monthly_amount, nurse_care_insurance_monthly, last_age_update_date, created_at, updated_at
 			  FROM retirement_data WHERE user_id = $1`
 	err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(
-		&id, &rdUserID, &currentAge, &retirementAge, &lifeExpectancy, &monthlyRetirementExpenses, &pensionAmount, &createdAt, &updatedAt,
+		&id, &rdUserID, &currentAge, &retirementAge, &lifeExpectancy, &monthlyRetirementExpenses, &pensionAmount,
+		&kokuminNenkinMonthly, &nurseCareInsuranceMonthly, &lastAgeUpdateDate, &createdAt, &updatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -464,13 +867,31 @@ func (r *PostgreSQLFinancialPlanRepository) loadRetirementData(ctx context.Conte
 		return nil, fmt.Errorf("退職データの作成に失敗しました: %w", err)
 	}
 
+	kokuminNenkinVO, err := valueobjects.NewMoneyJPY(kokuminNenkinMonthly)
+	if err != nil {
+		return nil, fmt.Errorf("国民年金の月額の作成に失敗しました: %w", err)
+	}
+	nurseCareInsuranceVO, err := valueobjects.NewMoneyJPY(nurseCareInsuranceMonthly)
+	if err != nil {
+		return nil, fmt.Errorf("介護保険料の月額の作成に失敗しました: %w", err)
+	}
+	if err := retirementData.RestorePublicBenefits(kokuminNenkinVO, nurseCareInsuranceVO); err != nil {
+		return nil, fmt.Errorf("退職データの作成に失敗しました: %w", err)
+	}
+	if lastAgeUpdateDate.Valid {
+		retirementData.RestoreLastAgeUpdateDate(&lastAgeUpdateDate.Time)
+	}
+
 	return retirementData, nil
 }
 
-// loadGoals は目標を読み込む
+// loadGoals は財務計画に紐付く削除されていない目標を読み込む
 func (r *PostgreSQLFinancialPlanRepository) loadGoals(ctx context.Context, userID entities.UserID) ([]*entities.Goal, error) {
-	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at 
-			  FROM goals WHERE user_id = $1 ORDER BY created_at`
+	query := `SELECT g.id, g.user_id, g.type, g.title, g.target_amount, g.target_date, g.current_amount, g.monthly_contribution, g.is_active, g.created_at, g.updated_at, g.depends_on_goal_ids, g.notes, g.image_url, g.goal_currency, g.contribution_min, g.contribution_stretch, g.overdue_policy
+			  FROM goals g
+			  JOIN financial_data fd ON fd.id = g.plan_id
+			  WHERE fd.user_id = $1 AND g.deleted_at IS NULL
+			  ORDER BY g.created_at`
 	rows, err := r.db.QueryContext(ctx, query, string(userID))
 	if err != nil {
 		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
@@ -484,8 +905,13 @@ func (r *PostgreSQLFinancialPlanRepository) loadGoals(ctx context.Context, userI
 		var targetDate time.Time
 		var isActive bool
 		var createdAt, updatedAt time.Time
+		var dependsOnGoalIDs []string
+		var notes, imageURL string
+		var goalCurrency []byte
+		var contributionMin, contributionStretch sql.NullFloat64
+		var overduePolicy string
 
-		if err := rows.Scan(&id, &gUserID, &goalType, &title, &targetAmount, &targetDate, &currentAmount, &monthlyContribution, &isActive, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&id, &gUserID, &goalType, &title, &targetAmount, &targetDate, &currentAmount, &monthlyContribution, &isActive, &createdAt, &updatedAt, pq.Array(&dependsOnGoalIDs), &notes, &imageURL, &goalCurrency, &contributionMin, &contributionStretch, &overduePolicy); err != nil {
 			return nil, fmt.Errorf("目標の読み取りに失敗しました: %w", err)
 		}
 
@@ -500,14 +926,17 @@ func (r *PostgreSQLFinancialPlanRepository) loadGoals(ctx context.Context, userI
 			return nil, fmt.Errorf("月間拠出額の作成に失敗しました: %w", err)
 		}
 
-		// 目標を作成
-		goal, err := entities.NewGoal(
+		// 目標を作成（前提目標の参照を解決できるよう保存済みのIDを維持する）
+		goal, err := entities.NewGoalWithID(
+			entities.GoalID(id),
 			entities.UserID(gUserID),
 			entities.GoalType(goalType),
 			title,
 			targetAmountVO,
 			targetDate,
 			monthlyContributionVO,
+			createdAt,
+			updatedAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("目標の作成に失敗しました: %w", err)
@@ -527,6 +956,27 @@ func (r *PostgreSQLFinancialPlanRepository) loadGoals(ctx context.Context, userI
 			goal.Deactivate()
 		}
 
+		// 前提となる目標を復元
+		if len(dependsOnGoalIDs) > 0 {
+			goal.RestoreDependencies(stringsToGoalIDs(dependsOnGoalIDs))
+		}
+
+		// メモと画像URLを復元
+		goal.RestoreMetadata(notes, imageURL)
+
+		// 外貨建ての目標の設定を復元
+		if err := restoreGoalCurrency(goal, goalCurrency); err != nil {
+			return nil, err
+		}
+
+		// 月間拠出額の幅を復元
+		if err := restoreContributionRange(goal, contributionMin, contributionStretch); err != nil {
+			return nil, err
+		}
+
+		// 期限超過時ポリシーを復元
+		goal.RestoreOverduePolicy(entities.GoalOverduePolicy(overduePolicy))
+
 		goals = append(goals, goal)
 	}
 
@@ -20,6 +20,8 @@ func setupFinancialPlanTestDB(t *testing.T) *sql.DB {
 	}
 
 	dbConfig := config.NewDatabaseConfig()
+	// データベースがない環境ですぐにスキップできるよう再試行しない
+	dbConfig.Retry.MaxAttempts = 1
 	db, err := config.NewDatabaseConnection(dbConfig)
 	if err != nil {
 		t.Skipf("Database connection failed (expected in CI): %v", err)
@@ -197,6 +199,43 @@ func TestPostgreSQLFinancialPlanRepository_FindByUserID(t *testing.T) {
 	}
 }
 
+func TestPostgreSQLFinancialPlanRepository_SaveWithEmergencyFund(t *testing.T) {
+	db := setupFinancialPlanTestDB(t)
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	userID := createTestUserForFinancialPlan(t, db)
+	repo := NewPostgreSQLFinancialPlanRepository(db)
+	plan := createTestFinancialPlan(t, userID)
+
+	currentFund, _ := valueobjects.NewMoneyJPY(420000)
+	if err := plan.UpdateEmergencyFundSettings(6, currentFund); err != nil {
+		t.Fatalf("Failed to update emergency fund settings: %v", err)
+	}
+	monthlyContribution, _ := valueobjects.NewMoneyJPY(20000)
+	if err := plan.UpdateEmergencyFundMonthlyContribution(monthlyContribution); err != nil {
+		t.Fatalf("Failed to update emergency fund monthly contribution: %v", err)
+	}
+
+	ctx := context.Background()
+	if err := repo.Save(ctx, plan); err != nil {
+		t.Fatalf("Failed to save financial plan: %v", err)
+	}
+
+	foundPlan, err := repo.FindByUserID(ctx, userID)
+	if err != nil {
+		t.Fatalf("Failed to find financial plan: %v", err)
+	}
+
+	fund := foundPlan.EmergencyFund()
+	if fund.TargetMonths() != 6 || fund.CurrentFund().Amount() != 420000 || fund.MonthlyContribution().Amount() != 20000 {
+		t.Errorf("Emergency fund was not restored: target_months=%d, current_fund=%f, monthly_contribution=%f",
+			fund.TargetMonths(), fund.CurrentFund().Amount(), fund.MonthlyContribution().Amount())
+	}
+}
+
 func TestPostgreSQLFinancialPlanRepository_SaveWithRetirementData(t *testing.T) {
 	db := setupFinancialPlanTestDB(t)
 	if db == nil {
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLGoalContributionHistoryRepository はPostgreSQLを使った目標の月間拠出額の変更履歴リポジトリ
+type PostgreSQLGoalContributionHistoryRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLGoalContributionHistoryRepository は新しいリポジトリを作成する
+func NewPostgreSQLGoalContributionHistoryRepository(db *sql.DB) repositories.GoalContributionHistoryRepository {
+	return &PostgreSQLGoalContributionHistoryRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は月間拠出額の変更を追記する
+func (r *PostgreSQLGoalContributionHistoryRepository) Save(ctx context.Context, change *entities.GoalContributionChange) error {
+	query := `
+		INSERT INTO goal_contribution_history (
+			goal_id, user_id, previous_contribution, new_contribution, required_contribution, over_budget, changed_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(change.GoalID()),
+		string(change.UserID()),
+		change.PreviousContribution(),
+		change.NewContribution(),
+		change.RequiredContribution(),
+		change.OverBudget(),
+		change.ChangedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("月間拠出額の変更の記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindByGoalID は目標の月間拠出額の変更履歴を変更日時の昇順で取得する
+func (r *PostgreSQLGoalContributionHistoryRepository) FindByGoalID(
+	ctx context.Context,
+	goalID entities.GoalID,
+) ([]*entities.GoalContributionChange, error) {
+	query := `
+		SELECT user_id, previous_contribution, new_contribution, required_contribution, over_budget, changed_at
+		FROM goal_contribution_history
+		WHERE goal_id = $1
+		ORDER BY changed_at ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query, string(goalID))
+	if err != nil {
+		return nil, fmt.Errorf("月間拠出額の変更履歴の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*entities.GoalContributionChange
+	for rows.Next() {
+		var (
+			userID               string
+			previousContribution float64
+			newContribution      float64
+			requiredContribution float64
+			overBudget           bool
+			changedAt            time.Time
+		)
+		if err := rows.Scan(&userID, &previousContribution, &newContribution, &requiredContribution, &overBudget, &changedAt); err != nil {
+			return nil, fmt.Errorf("月間拠出額の変更の読み取りに失敗しました: %w", err)
+		}
+
+		change, err := entities.NewGoalContributionChange(
+			goalID,
+			entities.UserID(userID),
+			previousContribution,
+			newContribution,
+			requiredContribution,
+			overBudget,
+			changedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("月間拠出額の変更の再構築に失敗しました: %w", err)
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("月間拠出額の変更履歴の取得に失敗しました: %w", err)
+	}
+
+	return changes, nil
+}
+
+// CountByUserID は指定されたユーザーIDの月間拠出額の変更履歴の件数を取得する
+func (r *PostgreSQLGoalContributionHistoryRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM goal_contribution_history WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("月間拠出額の変更履歴の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの月間拠出額の変更履歴をすべて削除する
+func (r *PostgreSQLGoalContributionHistoryRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM goal_contribution_history WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, string(userID)); err != nil {
+		return fmt.Errorf("月間拠出額の変更履歴の削除に失敗しました: %w", err)
+	}
+	return nil
+}
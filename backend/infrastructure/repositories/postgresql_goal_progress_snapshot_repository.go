@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLGoalProgressSnapshotRepository はPostgreSQLを使った目標の進捗履歴リポジトリ
+type PostgreSQLGoalProgressSnapshotRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLGoalProgressSnapshotRepository は新しいリポジトリを作成する
+func NewPostgreSQLGoalProgressSnapshotRepository(db *sql.DB) repositories.GoalProgressSnapshotRepository {
+	return &PostgreSQLGoalProgressSnapshotRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は目標の進捗を記録する（同じ目標・同じ日の記録は上書きする）
+func (r *PostgreSQLGoalProgressSnapshotRepository) Save(ctx context.Context, snapshot *entities.GoalProgressSnapshot) error {
+	query := `
+		INSERT INTO goal_progress_snapshots (goal_id, user_id, recorded_on, amount, recorded_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (goal_id, recorded_on)
+		DO UPDATE SET amount = EXCLUDED.amount,
+			recorded_at = EXCLUDED.recorded_at
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(snapshot.GoalID()),
+		string(snapshot.UserID()),
+		snapshot.RecordedAt().Format("2006-01-02"),
+		snapshot.Amount(),
+		snapshot.RecordedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("目標の進捗の記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindByGoalIDSince は指定日時以降の記録を記録日時の昇順で取得する
+func (r *PostgreSQLGoalProgressSnapshotRepository) FindByGoalIDSince(
+	ctx context.Context,
+	goalID entities.GoalID,
+	since time.Time,
+) ([]*entities.GoalProgressSnapshot, error) {
+	query := `
+		SELECT user_id, amount, recorded_at
+		FROM goal_progress_snapshots
+		WHERE goal_id = $1 AND recorded_at >= $2
+		ORDER BY recorded_at ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query, string(goalID), since)
+	if err != nil {
+		return nil, fmt.Errorf("目標の進捗履歴の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*entities.GoalProgressSnapshot
+	for rows.Next() {
+		var (
+			userID     string
+			amount     float64
+			recordedAt time.Time
+		)
+		if err := rows.Scan(&userID, &amount, &recordedAt); err != nil {
+			return nil, fmt.Errorf("目標の進捗の読み取りに失敗しました: %w", err)
+		}
+
+		snapshot, err := entities.NewGoalProgressSnapshot(goalID, entities.UserID(userID), amount, recordedAt)
+		if err != nil {
+			return nil, fmt.Errorf("目標の進捗の再構築に失敗しました: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("目標の進捗履歴の取得に失敗しました: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// CountByUserID は指定されたユーザーIDの目標の進捗履歴の件数を取得する
+func (r *PostgreSQLGoalProgressSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM goal_progress_snapshots WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("目標の進捗履歴の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの目標の進捗履歴をすべて削除する
+func (r *PostgreSQLGoalProgressSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM goal_progress_snapshots WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, string(userID)); err != nil {
+		return fmt.Errorf("目標の進捗履歴の削除に失敗しました: %w", err)
+	}
+	return nil
+}
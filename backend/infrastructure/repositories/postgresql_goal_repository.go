@@ -3,29 +3,32 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
 	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/lib/pq"
 )
 
 // PostgreSQLGoalRepository はPostgreSQLを使用した目標リポジトリの実装
 type PostgreSQLGoalRepository struct {
-	db *sql.DB
+	db dbExecutor
 }
 
 // NewPostgreSQLGoalRepository は新しいPostgreSQL目標リポジトリを作成する
 func NewPostgreSQLGoalRepository(db *sql.DB) repositories.GoalRepository {
-	return &PostgreSQLGoalRepository{db: db}
+	return &PostgreSQLGoalRepository{db: newCircuitBreakerExecutor(db, nil)}
 }
 
 // Save は目標を保存する
 func (r *PostgreSQLGoalRepository) Save(ctx context.Context, goal *entities.Goal) error {
 	query := `
-		INSERT INTO goals (id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+		INSERT INTO goals (id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy, deleted_at, plan_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19,
+			(SELECT id FROM financial_data WHERE user_id = $2))`
 
 	_, err := r.db.ExecContext(ctx, query,
 		string(goal.ID()),
@@ -39,6 +42,14 @@ func (r *PostgreSQLGoalRepository) Save(ctx context.Context, goal *entities.Goal
 		goal.IsActive(),
 		goal.CreatedAt(),
 		goal.UpdatedAt(),
+		dependsOnGoalIDsParam(goal),
+		goal.Notes(),
+		goal.ImageURL(),
+		goalCurrencyParam(goal),
+		nullMoney(goal.ContributionMin()),
+		nullMoney(goal.ContributionStretch()),
+		string(goal.OverduePolicy()),
+		goal.DeletedAt(),
 	)
 	if err != nil {
 		return fmt.Errorf("目標の保存に失敗しました: %w", err)
@@ -54,11 +65,16 @@ func (r *PostgreSQLGoalRepository) FindByID(ctx context.Context, id entities.Goa
 	var targetDate time.Time
 	var isActive bool
 	var createdAt, updatedAt time.Time
-
-	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at 
-			  FROM goals WHERE id = $1`
+	var dependsOnGoalIDs []string
+	var notes, imageURL string
+	var goalCurrency []byte
+	var contributionMin, contributionStretch sql.NullFloat64
+	var overduePolicy string
+
+	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy
+			  FROM goals WHERE id = $1 AND deleted_at IS NULL`
 	err := r.db.QueryRowContext(ctx, query, string(id)).Scan(
-		&goalID, &userID, &goalType, &title, &targetAmount, &targetDate, &currentAmount, &monthlyContribution, &isActive, &createdAt, &updatedAt,
+		&goalID, &userID, &goalType, &title, &targetAmount, &targetDate, &currentAmount, &monthlyContribution, &isActive, &createdAt, &updatedAt, pq.Array(&dependsOnGoalIDs), &notes, &imageURL, &goalCurrency, &contributionMin, &contributionStretch, &overduePolicy,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -67,13 +83,13 @@ func (r *PostgreSQLGoalRepository) FindByID(ctx context.Context, id entities.Goa
 		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
 	}
 
-	return r.buildGoalFromRow(goalID, userID, goalType, title, targetAmount, currentAmount, monthlyContribution, targetDate, isActive, createdAt, updatedAt)
+	return r.buildGoalFromRow(goalID, userID, goalType, title, targetAmount, currentAmount, monthlyContribution, targetDate, isActive, createdAt, updatedAt, dependsOnGoalIDs, notes, imageURL, goalCurrency, contributionMin, contributionStretch, overduePolicy)
 }
 
 // FindByUserID は指定されたユーザーIDの全ての目標を取得する
 func (r *PostgreSQLGoalRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.Goal, error) {
-	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at 
-			  FROM goals WHERE user_id = $1 ORDER BY created_at DESC`
+	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy
+			  FROM goals WHERE user_id = $1 AND deleted_at IS NULL ORDER BY created_at DESC`
 	rows, err := r.db.QueryContext(ctx, query, string(userID))
 	if err != nil {
 		return nil, fmt.Errorf("目標の取得に失敗しました: %w", err)
@@ -85,8 +101,8 @@ func (r *PostgreSQLGoalRepository) FindByUserID(ctx context.Context, userID enti
 
 // FindActiveGoalsByUserID は指定されたユーザーIDのアクティブな目標を取得する
 func (r *PostgreSQLGoalRepository) FindActiveGoalsByUserID(ctx context.Context, userID entities.UserID) ([]*entities.Goal, error) {
-	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at 
-			  FROM goals WHERE user_id = $1 AND is_active = true ORDER BY created_at DESC`
+	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy
+			  FROM goals WHERE user_id = $1 AND deleted_at IS NULL AND is_active = true ORDER BY created_at DESC`
 	rows, err := r.db.QueryContext(ctx, query, string(userID))
 	if err != nil {
 		return nil, fmt.Errorf("アクティブな目標の取得に失敗しました: %w", err)
@@ -98,8 +114,8 @@ func (r *PostgreSQLGoalRepository) FindActiveGoalsByUserID(ctx context.Context,
 
 // FindByUserIDAndType は指定されたユーザーIDと目標タイプの目標を取得する
 func (r *PostgreSQLGoalRepository) FindByUserIDAndType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) ([]*entities.Goal, error) {
-	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at 
-			  FROM goals WHERE user_id = $1 AND type = $2 ORDER BY created_at DESC`
+	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy
+			  FROM goals WHERE user_id = $1 AND deleted_at IS NULL AND type = $2 ORDER BY created_at DESC`
 	rows, err := r.db.QueryContext(ctx, query, string(userID), string(goalType))
 	if err != nil {
 		return nil, fmt.Errorf("指定タイプの目標の取得に失敗しました: %w", err)
@@ -120,7 +136,15 @@ func (r *PostgreSQLGoalRepository) Update(ctx context.Context, goal *entities.Go
 			current_amount = $6,
 			monthly_contribution = $7,
 			is_active = $8,
-			updated_at = $9
+			updated_at = $9,
+			depends_on_goal_ids = $10,
+			notes = $11,
+			image_url = $12,
+			goal_currency = $13,
+			contribution_min = $14,
+			contribution_stretch = $15,
+			overdue_policy = $16,
+			deleted_at = $17
 		WHERE id = $1`
 
 	result, err := r.db.ExecContext(ctx, query,
@@ -133,6 +157,14 @@ func (r *PostgreSQLGoalRepository) Update(ctx context.Context, goal *entities.Go
 		goal.MonthlyContribution().Amount(),
 		goal.IsActive(),
 		goal.UpdatedAt(),
+		dependsOnGoalIDsParam(goal),
+		goal.Notes(),
+		goal.ImageURL(),
+		goalCurrencyParam(goal),
+		nullMoney(goal.ContributionMin()),
+		nullMoney(goal.ContributionStretch()),
+		string(goal.OverduePolicy()),
+		goal.DeletedAt(),
 	)
 	if err != nil {
 		return fmt.Errorf("目標の更新に失敗しました: %w", err)
@@ -151,6 +183,7 @@ func (r *PostgreSQLGoalRepository) Update(ctx context.Context, goal *entities.Go
 }
 
 // Delete は指定されたIDの目標を削除する
+// 他の目標の depends_on_goal_ids からの削除はトリガー（041_remove_deleted_goal_dependencies.sql）で行う
 func (r *PostgreSQLGoalRepository) Delete(ctx context.Context, id entities.GoalID) error {
 	query := `DELETE FROM goals WHERE id = $1`
 	result, err := r.db.ExecContext(ctx, query, string(id))
@@ -173,7 +206,7 @@ func (r *PostgreSQLGoalRepository) Delete(ctx context.Context, id entities.GoalI
 // Exists は指定されたIDの目標が存在するかチェックする
 func (r *PostgreSQLGoalRepository) Exists(ctx context.Context, id entities.GoalID) (bool, error) {
 	var count int
-	query := `SELECT COUNT(*) FROM goals WHERE id = $1`
+	query := `SELECT COUNT(*) FROM goals WHERE id = $1 AND deleted_at IS NULL`
 	err := r.db.QueryRowContext(ctx, query, string(id)).Scan(&count)
 	if err != nil {
 		return false, fmt.Errorf("目標の存在確認に失敗しました: %w", err)
@@ -181,10 +214,29 @@ func (r *PostgreSQLGoalRepository) Exists(ctx context.Context, id entities.GoalI
 	return count > 0, nil
 }
 
+// FindSimilarGoal は同じタイプ・同じ目標名（正規化後）で目標金額の差が1%以内のアクティブな目標を取得する
+// 目標名の正規化はエンティティと同じ規則で行うため、候補を取得してからアプリケーション側で判定する
+func (r *PostgreSQLGoalRepository) FindSimilarGoal(ctx context.Context, userID entities.UserID, goalType entities.GoalType, title string, targetAmount valueobjects.Money) (*entities.Goal, error) {
+	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy
+			  FROM goals WHERE user_id = $1 AND deleted_at IS NULL AND type = $2 AND is_active = true ORDER BY created_at ASC`
+	rows, err := r.db.QueryContext(ctx, query, string(userID), string(goalType))
+	if err != nil {
+		return nil, fmt.Errorf("類似する目標の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	candidates, err := r.scanGoals(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.FindSimilarGoal(candidates, goalType, title, targetAmount), nil
+}
+
 // CountActiveGoalsByType は指定されたユーザーIDと目標タイプのアクティブな目標数を取得する
 func (r *PostgreSQLGoalRepository) CountActiveGoalsByType(ctx context.Context, userID entities.UserID, goalType entities.GoalType) (int, error) {
 	var count int
-	query := `SELECT COUNT(*) FROM goals WHERE user_id = $1 AND type = $2 AND is_active = true`
+	query := `SELECT COUNT(*) FROM goals WHERE user_id = $1 AND deleted_at IS NULL AND type = $2 AND is_active = true`
 	err := r.db.QueryRowContext(ctx, query, string(userID), string(goalType)).Scan(&count)
 	if err != nil {
 		return 0, fmt.Errorf("アクティブな目標数の取得に失敗しました: %w", err)
@@ -192,6 +244,19 @@ func (r *PostgreSQLGoalRepository) CountActiveGoalsByType(ctx context.Context, u
 	return count, nil
 }
 
+// FindOverdueActiveGoals は期限が asOf より前で未達成のアクティブな目標を全ユーザー分取得する
+func (r *PostgreSQLGoalRepository) FindOverdueActiveGoals(ctx context.Context, asOf time.Time) ([]*entities.Goal, error) {
+	query := `SELECT id, user_id, type, title, target_amount, target_date, current_amount, monthly_contribution, is_active, created_at, updated_at, depends_on_goal_ids, notes, image_url, goal_currency, contribution_min, contribution_stretch, overdue_policy
+			  FROM goals WHERE is_active = true AND deleted_at IS NULL AND target_date < $1 AND current_amount < target_amount ORDER BY target_date ASC, id ASC`
+	rows, err := r.db.QueryContext(ctx, query, asOf)
+	if err != nil {
+		return nil, fmt.Errorf("期限超過の目標の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanGoals(rows)
+}
+
 // scanGoals は複数の目標をスキャンする
 func (r *PostgreSQLGoalRepository) scanGoals(rows *sql.Rows) ([]*entities.Goal, error) {
 	var goals []*entities.Goal
@@ -202,12 +267,17 @@ func (r *PostgreSQLGoalRepository) scanGoals(rows *sql.Rows) ([]*entities.Goal,
 		var targetDate time.Time
 		var isActive bool
 		var createdAt, updatedAt time.Time
+		var dependsOnGoalIDs []string
+		var notes, imageURL string
+		var goalCurrency []byte
+		var contributionMin, contributionStretch sql.NullFloat64
+		var overduePolicy string
 
-		if err := rows.Scan(&goalID, &userID, &goalType, &title, &targetAmount, &targetDate, &currentAmount, &monthlyContribution, &isActive, &createdAt, &updatedAt); err != nil {
+		if err := rows.Scan(&goalID, &userID, &goalType, &title, &targetAmount, &targetDate, &currentAmount, &monthlyContribution, &isActive, &createdAt, &updatedAt, pq.Array(&dependsOnGoalIDs), &notes, &imageURL, &goalCurrency, &contributionMin, &contributionStretch, &overduePolicy); err != nil {
 			return nil, fmt.Errorf("目標の読み取りに失敗しました: %w", err)
 		}
 
-		goal, err := r.buildGoalFromRow(goalID, userID, goalType, title, targetAmount, currentAmount, monthlyContribution, targetDate, isActive, createdAt, updatedAt)
+		goal, err := r.buildGoalFromRow(goalID, userID, goalType, title, targetAmount, currentAmount, monthlyContribution, targetDate, isActive, createdAt, updatedAt, dependsOnGoalIDs, notes, imageURL, goalCurrency, contributionMin, contributionStretch, overduePolicy)
 		if err != nil {
 			return nil, fmt.Errorf("goal_id %s の目標エンティティ構築に失敗しました: %w", goalID, err)
 		}
@@ -229,6 +299,11 @@ func (r *PostgreSQLGoalRepository) buildGoalFromRow(
 	targetDate time.Time,
 	isActive bool,
 	createdAt, updatedAt time.Time,
+	dependsOnGoalIDs []string,
+	notes, imageURL string,
+	goalCurrency []byte,
+	contributionMin, contributionStretch sql.NullFloat64,
+	overduePolicy string,
 ) (*entities.Goal, error) {
 	// 値オブジェクトを作成
 	targetAmountVO, err := valueobjects.NewMoneyJPY(targetAmount)
@@ -271,5 +346,171 @@ func (r *PostgreSQLGoalRepository) buildGoalFromRow(
 		goal.Deactivate()
 	}
 
+	// 前提となる目標を復元
+	if len(dependsOnGoalIDs) > 0 {
+		goal.RestoreDependencies(stringsToGoalIDs(dependsOnGoalIDs))
+	}
+
+	// メモと画像URLを復元
+	goal.RestoreMetadata(notes, imageURL)
+
+	// 外貨建ての目標の設定を復元
+	if err := restoreGoalCurrency(goal, goalCurrency); err != nil {
+		return nil, err
+	}
+
+	// 月間拠出額の幅を復元
+	if err := restoreContributionRange(goal, contributionMin, contributionStretch); err != nil {
+		return nil, err
+	}
+
+	// 期限超過時ポリシーを復元
+	goal.RestoreOverduePolicy(entities.GoalOverduePolicy(overduePolicy))
+
 	return goal, nil
 }
+
+// dependsOnGoalIDsParam は前提となる目標IDの一覧をクエリパラメータに変換する
+func dependsOnGoalIDsParam(goal *entities.Goal) interface{} {
+	ids := goalIDsToStrings(goal.DependsOn())
+	if ids == nil {
+		ids = []string{}
+	}
+	return pq.Array(ids)
+}
+
+// stringsToGoalIDs は文字列のスライスを目標IDのスライスに変換する
+func stringsToGoalIDs(ids []string) []entities.GoalID {
+	goalIDs := make([]entities.GoalID, len(ids))
+	for i, id := range ids {
+		goalIDs[i] = entities.GoalID(id)
+	}
+	return goalIDs
+}
+
+// goalCurrencyDTO は外貨建ての目標の設定の保存形式（goals.goal_currency 列とキャッシュで共通）
+type goalCurrencyDTO struct {
+	Currency     string            `json:"currency"`
+	TargetAmount float64           `json:"target_amount"`
+	ExchangeRate exchangeRateDTO   `json:"exchange_rate"`
+	History      []exchangeRateDTO `json:"history,omitempty"`
+}
+
+type exchangeRateDTO struct {
+	Base   string    `json:"base"`
+	Quote  string    `json:"quote"`
+	Rate   float64   `json:"rate"`
+	Source string    `json:"source"`
+	AsOf   time.Time `json:"as_of"`
+}
+
+// goalCurrencyToDTO は目標の外貨建ての設定を保存形式に変換する（外貨建てでない場合はnil）
+func goalCurrencyToDTO(goal *entities.Goal) *goalCurrencyDTO {
+	rate := goal.ExchangeRate()
+	if !goal.IsForeignCurrency() || rate == nil {
+		return nil
+	}
+	dto := &goalCurrencyDTO{
+		Currency:     string(goal.Currency()),
+		TargetAmount: goal.TargetAmountInGoalCurrency().Amount(),
+		ExchangeRate: exchangeRateToDTO(*rate),
+	}
+	for _, previous := range goal.ExchangeRateHistory() {
+		dto.History = append(dto.History, exchangeRateToDTO(previous))
+	}
+	return dto
+}
+
+func exchangeRateToDTO(rate valueobjects.ExchangeRate) exchangeRateDTO {
+	return exchangeRateDTO{
+		Base:   string(rate.Base()),
+		Quote:  string(rate.Quote()),
+		Rate:   rate.Rate(),
+		Source: rate.Source(),
+		AsOf:   rate.AsOf(),
+	}
+}
+
+func exchangeRateFromDTO(dto exchangeRateDTO) (valueobjects.ExchangeRate, error) {
+	return valueobjects.NewExchangeRate(valueobjects.Currency(dto.Base), valueobjects.Currency(dto.Quote), dto.Rate, dto.Source, dto.AsOf)
+}
+
+// restoreGoalCurrencyFromDTO は保存形式から目標の外貨建ての設定を復元する（dto がnilの場合は何もしない）
+func restoreGoalCurrencyFromDTO(goal *entities.Goal, dto *goalCurrencyDTO) error {
+	if dto == nil {
+		return nil
+	}
+	target, err := valueobjects.NewMoney(dto.TargetAmount, valueobjects.Currency(dto.Currency))
+	if err != nil {
+		return fmt.Errorf("目標の通貨での目標金額の復元に失敗しました: %w", err)
+	}
+	rate, err := exchangeRateFromDTO(dto.ExchangeRate)
+	if err != nil {
+		return fmt.Errorf("為替レートの復元に失敗しました: %w", err)
+	}
+	var history []valueobjects.ExchangeRate
+	for _, previous := range dto.History {
+		restored, err := exchangeRateFromDTO(previous)
+		if err != nil {
+			return fmt.Errorf("為替レートの履歴の復元に失敗しました: %w", err)
+		}
+		history = append(history, restored)
+	}
+	goal.RestoreGoalCurrency(target, rate, history)
+	return nil
+}
+
+// goalCurrencyParam は目標の外貨建ての設定をクエリパラメータに変換する（外貨建てでない場合はNULL）
+func goalCurrencyParam(goal *entities.Goal) interface{} {
+	dto := goalCurrencyToDTO(goal)
+	if dto == nil {
+		return nil
+	}
+	data, err := json.Marshal(dto)
+	if err != nil {
+		// 数値と文字列だけの構造体のため、エンコードに失敗することはない
+		return nil
+	}
+	return data
+}
+
+// nullMoney は任意の金額をクエリパラメータに変換する（nilの場合はNULL）
+func nullMoney(money *valueobjects.Money) sql.NullFloat64 {
+	if money == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: money.Amount(), Valid: true}
+}
+
+// restoreContributionRange は goals.contribution_min・contribution_stretch 列の値から月間拠出額の幅を復元する（NULLの場合は未設定）
+func restoreContributionRange(goal *entities.Goal, minimum, stretch sql.NullFloat64) error {
+	var minimumVO, stretchVO *valueobjects.Money
+	if minimum.Valid {
+		amount, err := valueobjects.NewMoneyJPY(minimum.Float64)
+		if err != nil {
+			return fmt.Errorf("月間拠出額の最低額の作成に失敗しました: %w", err)
+		}
+		minimumVO = &amount
+	}
+	if stretch.Valid {
+		amount, err := valueobjects.NewMoneyJPY(stretch.Float64)
+		if err != nil {
+			return fmt.Errorf("月間拠出額のストレッチ額の作成に失敗しました: %w", err)
+		}
+		stretchVO = &amount
+	}
+	goal.RestoreContributionRange(minimumVO, stretchVO)
+	return nil
+}
+
+// restoreGoalCurrency は goals.goal_currency 列の値から目標の外貨建ての設定を復元する（NULLの場合は何もしない）
+func restoreGoalCurrency(goal *entities.Goal, raw []byte) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	var dto goalCurrencyDTO
+	if err := json.Unmarshal(raw, &dto); err != nil {
+		return fmt.Errorf("外貨建ての目標の設定の読み取りに失敗しました: %w", err)
+	}
+	return restoreGoalCurrencyFromDTO(goal, &dto)
+}
@@ -19,6 +19,8 @@ func setupTestDB(t *testing.T) *sql.DB {
 	}
 
 	dbConfig := config.NewDatabaseConfig()
+	// データベースがない環境ですぐにスキップできるよう再試行しない
+	dbConfig.Retry.MaxAttempts = 1
 	db, err := config.NewDatabaseConnection(dbConfig)
 	if err != nil {
 		t.Skipf("Database connection failed (expected in CI): %v", err)
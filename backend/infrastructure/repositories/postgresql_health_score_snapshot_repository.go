@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLHealthScoreSnapshotRepository はPostgreSQLを使った財務健全性スコアの履歴リポジトリ
+type PostgreSQLHealthScoreSnapshotRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLHealthScoreSnapshotRepository は新しいリポジトリを作成する
+func NewPostgreSQLHealthScoreSnapshotRepository(db *sql.DB) repositories.HealthScoreSnapshotRepository {
+	return &PostgreSQLHealthScoreSnapshotRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は財務健全性スコアを記録する（同じユーザー・同じ日の記録は上書きする）
+func (r *PostgreSQLHealthScoreSnapshotRepository) Save(ctx context.Context, snapshot *entities.HealthScoreSnapshot) error {
+	query := `
+		INSERT INTO health_score_snapshots (
+			user_id, recorded_on, score, savings_rate, emergency_fund_ratio,
+			investment_return, total_assets, recorded_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (user_id, recorded_on)
+		DO UPDATE SET score = EXCLUDED.score,
+			savings_rate = EXCLUDED.savings_rate,
+			emergency_fund_ratio = EXCLUDED.emergency_fund_ratio,
+			investment_return = EXCLUDED.investment_return,
+			total_assets = EXCLUDED.total_assets,
+			recorded_at = EXCLUDED.recorded_at
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(snapshot.UserID()),
+		snapshot.RecordedAt().Format("2006-01-02"),
+		snapshot.Score(),
+		snapshot.SavingsRate(),
+		snapshot.EmergencyFundRatio(),
+		snapshot.InvestmentReturn(),
+		snapshot.TotalAssets(),
+		snapshot.RecordedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("財務健全性スコアの記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindByUserIDSince は指定日時以降の記録を記録日時の昇順で取得する
+func (r *PostgreSQLHealthScoreSnapshotRepository) FindByUserIDSince(
+	ctx context.Context,
+	userID entities.UserID,
+	since time.Time,
+) ([]*entities.HealthScoreSnapshot, error) {
+	query := `
+		SELECT score, savings_rate, emergency_fund_ratio, investment_return, total_assets, recorded_at
+		FROM health_score_snapshots
+		WHERE user_id = $1 AND recorded_at >= $2
+		ORDER BY recorded_at ASC
+	`
+	rows, err := r.db.QueryContext(ctx, query, string(userID), since)
+	if err != nil {
+		return nil, fmt.Errorf("財務健全性スコアの履歴の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []*entities.HealthScoreSnapshot
+	for rows.Next() {
+		var (
+			score                                                          int
+			savingsRate, emergencyFundRatio, investmentReturn, totalAssets float64
+			recordedAt                                                     time.Time
+		)
+		if err := rows.Scan(&score, &savingsRate, &emergencyFundRatio, &investmentReturn, &totalAssets, &recordedAt); err != nil {
+			return nil, fmt.Errorf("財務健全性スコアの読み取りに失敗しました: %w", err)
+		}
+
+		snapshot, err := entities.NewHealthScoreSnapshot(userID, score, savingsRate, emergencyFundRatio, investmentReturn, totalAssets, recordedAt)
+		if err != nil {
+			return nil, fmt.Errorf("財務健全性スコアの再構築に失敗しました: %w", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("財務健全性スコアの履歴の取得に失敗しました: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// CountByUserID は指定されたユーザーIDの財務健全性スコアの履歴の件数を取得する
+func (r *PostgreSQLHealthScoreSnapshotRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM health_score_snapshots WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("財務健全性スコアの履歴の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの財務健全性スコアの履歴をすべて削除する
+func (r *PostgreSQLHealthScoreSnapshotRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM health_score_snapshots WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, string(userID)); err != nil {
+		return fmt.Errorf("財務健全性スコアの履歴の削除に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLNotificationPreferenceRepository はPostgreSQLを使った通知設定リポジトリ
+type PostgreSQLNotificationPreferenceRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLNotificationPreferenceRepository は新しいリポジトリを作成する
+func NewPostgreSQLNotificationPreferenceRepository(db *sql.DB) repositories.NotificationPreferenceRepository {
+	return &PostgreSQLNotificationPreferenceRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は通知設定を保存する（同じユーザー・イベント・チャネルの設定は上書きする）
+func (r *PostgreSQLNotificationPreferenceRepository) Save(ctx context.Context, preference *entities.NotificationPreference) error {
+	query := `
+		INSERT INTO notification_preferences (id, user_id, event_type, channel, enabled, threshold, destination, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id, event_type, channel)
+		DO UPDATE SET enabled = EXCLUDED.enabled, threshold = EXCLUDED.threshold,
+			destination = EXCLUDED.destination, updated_at = EXCLUDED.updated_at
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(preference.ID()),
+		string(preference.UserID()),
+		string(preference.EventType()),
+		string(preference.Channel()),
+		preference.IsEnabled(),
+		preference.Threshold(),
+		preference.Destination(),
+		preference.CreatedAt(),
+		preference.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("通知設定の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindByUserID は指定されたユーザーIDの通知設定をすべて取得する
+func (r *PostgreSQLNotificationPreferenceRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.NotificationPreference, error) {
+	query := `
+		SELECT id, user_id, event_type, channel, enabled, threshold, destination, created_at, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1
+		ORDER BY event_type, channel
+	`
+	return r.query(ctx, query, string(userID))
+}
+
+// FindEnabledByUserIDAndEventType は指定イベントの有効な通知設定を取得する
+func (r *PostgreSQLNotificationPreferenceRepository) FindEnabledByUserIDAndEventType(
+	ctx context.Context,
+	userID entities.UserID,
+	eventType entities.NotificationEventType,
+) ([]*entities.NotificationPreference, error) {
+	query := `
+		SELECT id, user_id, event_type, channel, enabled, threshold, destination, created_at, updated_at
+		FROM notification_preferences
+		WHERE user_id = $1 AND event_type = $2 AND enabled = true
+		ORDER BY channel
+	`
+	return r.query(ctx, query, string(userID), string(eventType))
+}
+
+// Delete は通知設定を削除する
+func (r *PostgreSQLNotificationPreferenceRepository) Delete(ctx context.Context, id entities.NotificationPreferenceID) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM notification_preferences WHERE id = $1`, string(id))
+	if err != nil {
+		return fmt.Errorf("通知設定の削除に失敗しました: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("削除行数の取得に失敗しました: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("通知設定が見つかりません: %s", string(id))
+	}
+	return nil
+}
+
+// CountByUserID は指定されたユーザーIDの通知設定の件数を取得する
+func (r *PostgreSQLNotificationPreferenceRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM notification_preferences WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("通知設定の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの通知設定をすべて削除する
+func (r *PostgreSQLNotificationPreferenceRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM notification_preferences WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, string(userID)); err != nil {
+		return fmt.Errorf("通知設定の削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// query は通知設定を検索してエンティティに再構築する
+func (r *PostgreSQLNotificationPreferenceRepository) query(ctx context.Context, query string, args ...any) ([]*entities.NotificationPreference, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("通知設定の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var preferences []*entities.NotificationPreference
+	for rows.Next() {
+		var (
+			id          string
+			userID      string
+			eventType   string
+			channel     string
+			enabled     bool
+			threshold   float64
+			destination string
+			createdAt   time.Time
+			updatedAt   time.Time
+		)
+		if err := rows.Scan(&id, &userID, &eventType, &channel, &enabled, &threshold, &destination, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("通知設定のスキャンに失敗しました: %w", err)
+		}
+
+		preference, err := entities.NewNotificationPreferenceWithID(
+			entities.NotificationPreferenceID(id),
+			entities.UserID(userID),
+			entities.NotificationEventType(eventType),
+			entities.NotificationChannelType(channel),
+			enabled,
+			threshold,
+			destination,
+			createdAt,
+			updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("通知設定の再構築に失敗しました: %w", err)
+		}
+		preferences = append(preferences, preference)
+	}
+	return preferences, rows.Err()
+}
@@ -13,12 +13,12 @@ import (
 
 // PostgreSQLPasswordResetTokenRepository はPostgreSQLを使ったパスワードリセットトークンリポジトリ
 type PostgreSQLPasswordResetTokenRepository struct {
-	db *sql.DB
+	db dbExecutor
 }
 
 // NewPostgreSQLPasswordResetTokenRepository は新しいリポジトリを作成する
 func NewPostgreSQLPasswordResetTokenRepository(db *sql.DB) repositories.PasswordResetTokenRepository {
-	return &PostgreSQLPasswordResetTokenRepository{db: db}
+	return &PostgreSQLPasswordResetTokenRepository{db: newCircuitBreakerExecutor(db, nil)}
 }
 
 // Save は新しいトークンを保存する
@@ -108,6 +108,16 @@ func (r *PostgreSQLPasswordResetTokenRepository) DeleteExpired(ctx context.Conte
 	return nil
 }
 
+// CountByUserID は指定ユーザーのトークン数を取得する（使用済み・期限切れを含む）
+func (r *PostgreSQLPasswordResetTokenRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM password_reset_tokens WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("トークン数の取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
 // DeleteByUserID は指定ユーザーのトークンを全て削除する
 func (r *PostgreSQLPasswordResetTokenRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
 	query := `DELETE FROM password_reset_tokens WHERE user_id = $1`
@@ -119,7 +129,7 @@ func (r *PostgreSQLPasswordResetTokenRepository) DeleteByUserID(ctx context.Cont
 }
 
 // scanPasswordResetToken は単一行をスキャンしてエンティティを返す
-func scanPasswordResetToken(row *sql.Row) (*entities.PasswordResetToken, error) {
+func scanPasswordResetToken(row rowScanner) (*entities.PasswordResetToken, error) {
 	var (
 		id        string
 		userID    string
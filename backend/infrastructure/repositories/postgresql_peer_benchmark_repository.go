@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLPeerBenchmarkRepository はPostgreSQLを使った同年代比較の匿名集計リポジトリ
+type PostgreSQLPeerBenchmarkRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLPeerBenchmarkRepository は新しいリポジトリを作成する
+func NewPostgreSQLPeerBenchmarkRepository(db *sql.DB) repositories.PeerBenchmarkRepository {
+	return &PostgreSQLPeerBenchmarkRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// ReplaceAll は保存済みの集計結果をすべて破棄し、benchmarks に置き換える
+func (r *PostgreSQLPeerBenchmarkRepository) ReplaceAll(ctx context.Context, benchmarks []entities.PeerBenchmark) error {
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM peer_benchmarks`); err != nil {
+			return fmt.Errorf("同年代比較の集計結果の削除に失敗しました: %w", err)
+		}
+
+		query := `
+			INSERT INTO peer_benchmarks (
+				age_band, income_band, metric, sample_size, p10, p25, median, p75, p90, aggregated_at
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`
+		for _, benchmark := range benchmarks {
+			_, err := tx.ExecContext(ctx, query,
+				benchmark.Segment.AgeBand,
+				benchmark.Segment.IncomeBand,
+				string(benchmark.Metric),
+				benchmark.SampleSize,
+				benchmark.P10,
+				benchmark.P25,
+				benchmark.Median,
+				benchmark.P75,
+				benchmark.P90,
+				benchmark.AggregatedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("同年代比較の集計結果の保存に失敗しました: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// FindBySegment は区分の集計結果を取得する
+func (r *PostgreSQLPeerBenchmarkRepository) FindBySegment(
+	ctx context.Context,
+	segment entities.PeerSegment,
+) ([]entities.PeerBenchmark, error) {
+	query := `
+		SELECT metric, sample_size, p10, p25, median, p75, p90, aggregated_at
+		FROM peer_benchmarks
+		WHERE age_band = $1 AND income_band = $2
+		ORDER BY metric
+	`
+	rows, err := r.db.QueryContext(ctx, query, segment.AgeBand, segment.IncomeBand)
+	if err != nil {
+		return nil, fmt.Errorf("同年代比較の集計結果の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var benchmarks []entities.PeerBenchmark
+	for rows.Next() {
+		var (
+			metric       string
+			aggregatedAt time.Time
+		)
+		benchmark := entities.PeerBenchmark{Segment: segment}
+		if err := rows.Scan(
+			&metric,
+			&benchmark.SampleSize,
+			&benchmark.P10,
+			&benchmark.P25,
+			&benchmark.Median,
+			&benchmark.P75,
+			&benchmark.P90,
+			&aggregatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("同年代比較の集計結果の読み取りに失敗しました: %w", err)
+		}
+		benchmark.Metric = entities.PeerBenchmarkMetric(metric)
+		benchmark.AggregatedAt = aggregatedAt
+		benchmarks = append(benchmarks, benchmark)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("同年代比較の集計結果の取得に失敗しました: %w", err)
+	}
+
+	return benchmarks, nil
+}
+
+// PostgreSQLPeerBenchmarkConsentRepository はPostgreSQLを使った同年代比較の匿名集計への参加リポジトリ
+type PostgreSQLPeerBenchmarkConsentRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLPeerBenchmarkConsentRepository は新しいリポジトリを作成する
+func NewPostgreSQLPeerBenchmarkConsentRepository(db *sql.DB) repositories.PeerBenchmarkConsentRepository {
+	return &PostgreSQLPeerBenchmarkConsentRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// OptIn は匿名集計への参加を記録する（参加済みの場合は最初に参加した日時を保持する）
+func (r *PostgreSQLPeerBenchmarkConsentRepository) OptIn(ctx context.Context, userID entities.UserID, optedInAt time.Time) error {
+	query := `
+		INSERT INTO peer_benchmark_consents (user_id, opted_in_at)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, query, string(userID), optedInAt); err != nil {
+		return fmt.Errorf("同年代比較への参加の記録に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// OptOut は匿名集計への参加を取り消す
+func (r *PostgreSQLPeerBenchmarkConsentRepository) OptOut(ctx context.Context, userID entities.UserID) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM peer_benchmark_consents WHERE user_id = $1`, string(userID)); err != nil {
+		return fmt.Errorf("同年代比較への参加の取り消しに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindOptedInAt は匿名集計に参加した日時を取得する（参加していない場合は nil）
+func (r *PostgreSQLPeerBenchmarkConsentRepository) FindOptedInAt(ctx context.Context, userID entities.UserID) (*time.Time, error) {
+	var optedInAt time.Time
+	err := r.db.QueryRowContext(ctx,
+		`SELECT opted_in_at FROM peer_benchmark_consents WHERE user_id = $1`,
+		string(userID),
+	).Scan(&optedInAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("同年代比較への参加状況の取得に失敗しました: %w", err)
+	}
+	return &optedInAt, nil
+}
+
+// FindOptedInUserIDs は匿名集計に参加しているユーザーのIDを afterUserID より後からユーザーIDの昇順で最大 limit 件取得する
+func (r *PostgreSQLPeerBenchmarkConsentRepository) FindOptedInUserIDs(ctx context.Context, afterUserID entities.UserID, limit int) ([]entities.UserID, error) {
+	query := `SELECT user_id FROM peer_benchmark_consents WHERE user_id > $1 ORDER BY user_id LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, string(afterUserID), limit)
+	if err != nil {
+		return nil, fmt.Errorf("同年代比較に参加しているユーザーの取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []entities.UserID
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("ユーザーIDのスキャンに失敗しました: %w", err)
+		}
+		userIDs = append(userIDs, entities.UserID(userID))
+	}
+	return userIDs, rows.Err()
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLRecalculationProgressRepository はPostgreSQLを使った一括再計算の進捗リポジトリ
+type PostgreSQLRecalculationProgressRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLRecalculationProgressRepository は新しいリポジトリを作成する
+func NewPostgreSQLRecalculationProgressRepository(db *sql.DB) repositories.RecalculationProgressRepository {
+	return &PostgreSQLRecalculationProgressRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// MarkCompleted は指定された実行でユーザーの再計算が完了したことを記録する
+func (r *PostgreSQLRecalculationProgressRepository) MarkCompleted(ctx context.Context, runID string, userID entities.UserID) error {
+	query := `
+		INSERT INTO recalculation_progress (run_id, user_id, completed_at)
+		VALUES ($1, $2, CURRENT_TIMESTAMP)
+		ON CONFLICT (run_id, user_id) DO NOTHING
+	`
+	if _, err := r.db.ExecContext(ctx, query, runID, string(userID)); err != nil {
+		return fmt.Errorf("再計算の進捗の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindCompletedUserIDs は指定された実行で再計算が完了したユーザーIDを取得する
+func (r *PostgreSQLRecalculationProgressRepository) FindCompletedUserIDs(ctx context.Context, runID string) ([]entities.UserID, error) {
+	query := `SELECT user_id FROM recalculation_progress WHERE run_id = $1`
+	rows, err := r.db.QueryContext(ctx, query, runID)
+	if err != nil {
+		return nil, fmt.Errorf("再計算の進捗の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []entities.UserID
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("再計算の進捗のスキャンに失敗しました: %w", err)
+		}
+		userIDs = append(userIDs, entities.UserID(userID))
+	}
+	return userIDs, rows.Err()
+}
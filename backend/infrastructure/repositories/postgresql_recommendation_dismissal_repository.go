@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLRecommendationDismissalRepository はPostgreSQLを使った推奨事項の却下リポジトリ
+type PostgreSQLRecommendationDismissalRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLRecommendationDismissalRepository は新しいリポジトリを作成する
+func NewPostgreSQLRecommendationDismissalRepository(db *sql.DB) repositories.RecommendationDismissalRepository {
+	return &PostgreSQLRecommendationDismissalRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は却下を保存する（同じユーザー・推奨事項の却下は上書きする）
+func (r *PostgreSQLRecommendationDismissalRepository) Save(ctx context.Context, dismissal *entities.RecommendationDismissal) error {
+	query := `
+		INSERT INTO recommendation_dismissals (user_id, recommendation_id, severity_at_dismissal, dismissed_at, snooze_until)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, recommendation_id)
+		DO UPDATE SET severity_at_dismissal = EXCLUDED.severity_at_dismissal,
+			dismissed_at = EXCLUDED.dismissed_at,
+			snooze_until = EXCLUDED.snooze_until
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(dismissal.UserID()),
+		string(dismissal.RecommendationID()),
+		dismissal.SeverityAtDismissal(),
+		dismissal.DismissedAt(),
+		dismissal.SnoozeUntil(),
+	)
+	if err != nil {
+		return fmt.Errorf("推奨事項の却下の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindByUserID は指定されたユーザーIDの却下をすべて取得する
+func (r *PostgreSQLRecommendationDismissalRepository) FindByUserID(ctx context.Context, userID entities.UserID) ([]*entities.RecommendationDismissal, error) {
+	query := `
+		SELECT recommendation_id, severity_at_dismissal, dismissed_at, snooze_until
+		FROM recommendation_dismissals
+		WHERE user_id = $1
+	`
+	rows, err := r.db.QueryContext(ctx, query, string(userID))
+	if err != nil {
+		return nil, fmt.Errorf("推奨事項の却下の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var dismissals []*entities.RecommendationDismissal
+	for rows.Next() {
+		var (
+			recommendationID string
+			severity         float64
+			dismissedAt      time.Time
+			snoozeUntil      sql.NullTime
+		)
+		if err := rows.Scan(&recommendationID, &severity, &dismissedAt, &snoozeUntil); err != nil {
+			return nil, fmt.Errorf("推奨事項の却下のスキャンに失敗しました: %w", err)
+		}
+
+		var snoozeUntilPtr *time.Time
+		if snoozeUntil.Valid {
+			snoozeUntilPtr = &snoozeUntil.Time
+		}
+		dismissals = append(dismissals, entities.ReconstructRecommendationDismissal(
+			userID,
+			entities.RecommendationID(recommendationID),
+			severity,
+			dismissedAt,
+			snoozeUntilPtr,
+		))
+	}
+	return dismissals, rows.Err()
+}
+
+// CountByUserID は指定されたユーザーIDの推奨事項の却下の件数を取得する
+func (r *PostgreSQLRecommendationDismissalRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM recommendation_dismissals WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("推奨事項の却下の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの推奨事項の却下をすべて削除する
+func (r *PostgreSQLRecommendationDismissalRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	query := `DELETE FROM recommendation_dismissals WHERE user_id = $1`
+	if _, err := r.db.ExecContext(ctx, query, string(userID)); err != nil {
+		return fmt.Errorf("推奨事項の却下の削除に失敗しました: %w", err)
+	}
+	return nil
+}
@@ -12,12 +12,12 @@ import (
 
 // PostgreSQLRefreshTokenRepository はPostgreSQLを使用したリフレッシュトークンリポジトリの実装
 type PostgreSQLRefreshTokenRepository struct {
-	db *sql.DB
+	db dbExecutor
 }
 
 // NewPostgreSQLRefreshTokenRepository は新しいPostgreSQLリフレッシュトークンリポジトリを作成する
 func NewPostgreSQLRefreshTokenRepository(db *sql.DB) repositories.RefreshTokenRepository {
-	return &PostgreSQLRefreshTokenRepository{db: db}
+	return &PostgreSQLRefreshTokenRepository{db: newCircuitBreakerExecutor(db, nil)}
 }
 
 // Save は新しいリフレッシュトークンを保存する
@@ -155,6 +155,18 @@ func (r *PostgreSQLRefreshTokenRepository) Delete(ctx context.Context, id entiti
 	return nil
 }
 
+// CountByUserID は指定されたユーザーIDのリフレッシュトークン数を取得する（失効・期限切れを含む）
+func (r *PostgreSQLRefreshTokenRepository) CountByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM refresh_tokens WHERE user_id = $1`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, userID.String()).Scan(&count); err != nil {
+		return 0, fmt.Errorf("リフレッシュトークン数の取得に失敗しました: %w", err)
+	}
+
+	return count, nil
+}
+
 // DeleteByUserID は指定されたユーザーIDのすべてのリフレッシュトークンを削除する
 func (r *PostgreSQLRefreshTokenRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
 	query := `DELETE FROM refresh_tokens WHERE user_id = $1`
@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+)
+
+// PostgreSQLSavingsRateTargetRepository はPostgreSQLを使った貯蓄率目標リポジトリ
+type PostgreSQLSavingsRateTargetRepository struct {
+	db dbExecutor
+}
+
+// NewPostgreSQLSavingsRateTargetRepository は新しいリポジトリを作成する
+func NewPostgreSQLSavingsRateTargetRepository(db *sql.DB) repositories.SavingsRateTargetRepository {
+	return &PostgreSQLSavingsRateTargetRepository{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// Save は貯蓄率目標を保存する
+func (r *PostgreSQLSavingsRateTargetRepository) Save(ctx context.Context, target *entities.SavingsRateTarget) error {
+	query := `
+		INSERT INTO savings_rate_targets (id, user_id, target_percent, period_start, period_end, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		string(target.ID()),
+		string(target.UserID()),
+		target.TargetPercent(),
+		target.PeriodStart(),
+		target.PeriodEnd(),
+		target.IsActive(),
+		target.CreatedAt(),
+		target.UpdatedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("貯蓄率目標の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindActiveByUserID は指定されたユーザーIDの有効な貯蓄率目標を取得する
+func (r *PostgreSQLSavingsRateTargetRepository) FindActiveByUserID(ctx context.Context, userID entities.UserID) (*entities.SavingsRateTarget, error) {
+	query := `
+		SELECT id, user_id, target_percent, period_start, period_end, is_active, created_at, updated_at
+		FROM savings_rate_targets
+		WHERE user_id = $1 AND is_active = true
+	`
+	var (
+		id            string
+		targetUserID  string
+		targetPercent float64
+		periodStart   time.Time
+		periodEnd     time.Time
+		isActive      bool
+		createdAt     time.Time
+		updatedAt     time.Time
+	)
+	err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(
+		&id, &targetUserID, &targetPercent, &periodStart, &periodEnd, &isActive, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("貯蓄率目標の取得に失敗しました: %w", err)
+	}
+
+	target, err := entities.NewSavingsRateTargetWithID(
+		entities.SavingsRateTargetID(id),
+		entities.UserID(targetUserID),
+		targetPercent,
+		periodStart,
+		periodEnd,
+		isActive,
+		createdAt,
+		updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("貯蓄率目標の再構築に失敗しました: %w", err)
+	}
+	return target, nil
+}
+
+// Update は既存の貯蓄率目標を更新する
+func (r *PostgreSQLSavingsRateTargetRepository) Update(ctx context.Context, target *entities.SavingsRateTarget) error {
+	query := `
+		UPDATE savings_rate_targets
+		SET target_percent = $1, period_start = $2, period_end = $3, is_active = $4, updated_at = $5
+		WHERE id = $6
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		target.TargetPercent(),
+		target.PeriodStart(),
+		target.PeriodEnd(),
+		target.IsActive(),
+		target.UpdatedAt(),
+		string(target.ID()),
+	)
+	if err != nil {
+		return fmt.Errorf("貯蓄率目標の更新に失敗しました: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("更新行数の取得に失敗しました: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("貯蓄率目標が見つかりません: %s", string(target.ID()))
+	}
+	return nil
+}
+
+// SaveMonthlyActual は月次実績を保存する（同じ月の実績は上書きする）
+func (r *PostgreSQLSavingsRateTargetRepository) SaveMonthlyActual(ctx context.Context, userID entities.UserID, actual entities.MonthlySavingsActual) error {
+	query := `
+		INSERT INTO monthly_savings_actuals (user_id, month, income, expenses)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, month)
+		DO UPDATE SET income = EXCLUDED.income, expenses = EXCLUDED.expenses
+	`
+	month := time.Date(actual.Month.Year(), actual.Month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	_, err := r.db.ExecContext(ctx, query, string(userID), month, actual.Income, actual.Expenses)
+	if err != nil {
+		return fmt.Errorf("月次実績の保存に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// FindMonthlyActuals は指定期間の月次実績を月の昇順で取得する
+// 月次実績は月初日で保存しているため、期間の開始日が月の途中でもその月の実績を含める
+func (r *PostgreSQLSavingsRateTargetRepository) FindMonthlyActuals(ctx context.Context, userID entities.UserID, from, to time.Time) ([]entities.MonthlySavingsActual, error) {
+	query := `
+		SELECT month, income, expenses
+		FROM monthly_savings_actuals
+		WHERE user_id = $1 AND month >= $2 AND month <= $3
+		ORDER BY month ASC
+	`
+	fromMonth := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	rows, err := r.db.QueryContext(ctx, query, string(userID), fromMonth, to)
+	if err != nil {
+		return nil, fmt.Errorf("月次実績の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var actuals []entities.MonthlySavingsActual
+	for rows.Next() {
+		var actual entities.MonthlySavingsActual
+		if err := rows.Scan(&actual.Month, &actual.Income, &actual.Expenses); err != nil {
+			return nil, fmt.Errorf("月次実績のスキャンに失敗しました: %w", err)
+		}
+		actuals = append(actuals, actual)
+	}
+	return actuals, rows.Err()
+}
+
+// CountMonthlyActualsByUserID は指定されたユーザーIDの月次実績の件数を取得する
+func (r *PostgreSQLSavingsRateTargetRepository) CountMonthlyActualsByUserID(ctx context.Context, userID entities.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM monthly_savings_actuals WHERE user_id = $1`
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, string(userID)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("月次実績の件数取得に失敗しました: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteByUserID は指定されたユーザーIDの貯蓄率目標と月次実績をすべて削除する
+func (r *PostgreSQLSavingsRateTargetRepository) DeleteByUserID(ctx context.Context, userID entities.UserID) error {
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		queries := []string{
+			`DELETE FROM monthly_savings_actuals WHERE user_id = $1`,
+			`DELETE FROM savings_rate_targets WHERE user_id = $1`,
+		}
+		for _, query := range queries {
+			if _, err := tx.ExecContext(ctx, query, string(userID)); err != nil {
+				return fmt.Errorf("貯蓄率目標の削除に失敗しました: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -13,19 +13,19 @@ import (
 
 // PostgreSQLUserRepository はPostgreSQLを使用したユーザーリポジトリの実装
 type PostgreSQLUserRepository struct {
-	db *sql.DB
+	db dbExecutor
 }
 
 // NewPostgreSQLUserRepository は新しいPostgreSQLユーザーリポジトリを作成する
 func NewPostgreSQLUserRepository(db *sql.DB) repositories.UserRepository {
-	return &PostgreSQLUserRepository{db: db}
+	return &PostgreSQLUserRepository{db: newCircuitBreakerExecutor(db, nil)}
 }
 
 // Save は新しいユーザーを保存する
 func (r *PostgreSQLUserRepository) Save(ctx context.Context, user *entities.User) error {
 	query := `
-		INSERT INTO users (id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`
+		INSERT INTO users (id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, two_factor_required_since, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
 
 	var passwordHash *string
 	if user.PasswordHash().String() != "" {
@@ -70,6 +70,7 @@ func (r *PostgreSQLUserRepository) Save(ctx context.Context, user *entities.User
 		user.TwoFactorEnabled(),
 		twoFactorSecret,
 		pq.Array(user.TwoFactorBackupCodes()),
+		user.TwoFactorRequiredSince(),
 		user.CreatedAt(),
 		user.UpdatedAt(),
 	)
@@ -84,14 +85,16 @@ func (r *PostgreSQLUserRepository) Save(ctx context.Context, user *entities.User
 func (r *PostgreSQLUserRepository) FindByID(ctx context.Context, id entities.UserID) (*entities.User, error) {
 	var userID, email string
 	var passwordHash, provider, providerUserID, name, avatarURL, twoFactorSecret sql.NullString
-	var emailVerified, twoFactorEnabled bool
-	var emailVerifiedAt sql.NullTime
+	var emailVerified, twoFactorEnabled, isAdmin bool
+	var emailVerifiedAt, twoFactorRequiredSince, digestLastSentAt sql.NullTime
 	var twoFactorBackupCodes []string
+	var digestFrequency string
+	var digestDeliveryHour int
 	var createdAt, updatedAt time.Time
 
-	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, created_at, updated_at FROM users WHERE id = $1`
+	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, two_factor_required_since, is_admin, digest_frequency, digest_delivery_hour, digest_last_sent_at, created_at, updated_at FROM users WHERE id = $1`
 	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
-		&userID, &email, &passwordHash, &provider, &providerUserID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &createdAt, &updatedAt,
+		&userID, &email, &passwordHash, &provider, &providerUserID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &twoFactorRequiredSince, &isAdmin, &digestFrequency, &digestDeliveryHour, &digestLastSentAt, &createdAt, &updatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -105,7 +108,7 @@ func (r *PostgreSQLUserRepository) FindByID(ctx context.Context, id entities.Use
 		emailVerifiedAtPtr = &emailVerifiedAt.Time
 	}
 
-	return entities.ReconstructUserWithOAuth(
+	user, err := entities.ReconstructUserWithOAuth(
 		userID,
 		email,
 		passwordHash.String,
@@ -121,20 +124,31 @@ func (r *PostgreSQLUserRepository) FindByID(ctx context.Context, id entities.Use
 		createdAt,
 		updatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	user.RestoreTwoFactorRequiredSince(nullTimeToPtr(twoFactorRequiredSince))
+	user.RestoreAdmin(isAdmin)
+	if err := restoreUserDigest(user, digestFrequency, digestDeliveryHour, digestLastSentAt); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
 
 // FindByEmail はメールアドレスからユーザーを取得する
 func (r *PostgreSQLUserRepository) FindByEmail(ctx context.Context, email entities.Email) (*entities.User, error) {
 	var userID, emailStr string
 	var passwordHash, provider, providerUserID, name, avatarURL, twoFactorSecret sql.NullString
-	var emailVerified, twoFactorEnabled bool
-	var emailVerifiedAt sql.NullTime
+	var emailVerified, twoFactorEnabled, isAdmin bool
+	var emailVerifiedAt, twoFactorRequiredSince, digestLastSentAt sql.NullTime
 	var twoFactorBackupCodes []string
+	var digestFrequency string
+	var digestDeliveryHour int
 	var createdAt, updatedAt time.Time
 
-	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, created_at, updated_at FROM users WHERE email = $1`
+	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, two_factor_required_since, is_admin, digest_frequency, digest_delivery_hour, digest_last_sent_at, created_at, updated_at FROM users WHERE email = $1`
 	err := r.db.QueryRowContext(ctx, query, email.String()).Scan(
-		&userID, &emailStr, &passwordHash, &provider, &providerUserID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &createdAt, &updatedAt,
+		&userID, &emailStr, &passwordHash, &provider, &providerUserID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &twoFactorRequiredSince, &isAdmin, &digestFrequency, &digestDeliveryHour, &digestLastSentAt, &createdAt, &updatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -148,7 +162,7 @@ func (r *PostgreSQLUserRepository) FindByEmail(ctx context.Context, email entiti
 		emailVerifiedAtPtr = &emailVerifiedAt.Time
 	}
 
-	return entities.ReconstructUserWithOAuth(
+	user, err := entities.ReconstructUserWithOAuth(
 		userID,
 		emailStr,
 		passwordHash.String,
@@ -164,14 +178,24 @@ func (r *PostgreSQLUserRepository) FindByEmail(ctx context.Context, email entiti
 		createdAt,
 		updatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	user.RestoreTwoFactorRequiredSince(nullTimeToPtr(twoFactorRequiredSince))
+	user.RestoreAdmin(isAdmin)
+	if err := restoreUserDigest(user, digestFrequency, digestDeliveryHour, digestLastSentAt); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
 
 // Update は既存のユーザー情報を更新する
 func (r *PostgreSQLUserRepository) Update(ctx context.Context, user *entities.User) error {
 	query := `
 		UPDATE users 
-		SET email = $1, password_hash = $2, two_factor_enabled = $3, two_factor_secret = $4, two_factor_backup_codes = $5, updated_at = $6
-		WHERE id = $7`
+		SET email = $1, password_hash = $2, two_factor_enabled = $3, two_factor_secret = $4, two_factor_backup_codes = $5, two_factor_required_since = $6,
+			digest_frequency = $7, digest_delivery_hour = $8, digest_last_sent_at = $9, updated_at = $10
+		WHERE id = $11`
 
 	var twoFactorSecret *string
 	if user.TwoFactorSecret() != "" {
@@ -185,6 +209,10 @@ func (r *PostgreSQLUserRepository) Update(ctx context.Context, user *entities.Us
 		user.TwoFactorEnabled(),
 		twoFactorSecret,
 		pq.Array(user.TwoFactorBackupCodes()),
+		user.TwoFactorRequiredSince(),
+		string(user.DigestPreference().Frequency()),
+		user.DigestPreference().DeliveryHour(),
+		user.DigestLastSentAt(),
 		user.UpdatedAt(),
 		user.ID().String(),
 	)
@@ -205,24 +233,40 @@ func (r *PostgreSQLUserRepository) Update(ctx context.Context, user *entities.Us
 }
 
 // Delete は指定されたIDのユーザーを削除する
+// usersへの外部キーを持たないテーブル（APIキー・通知設定・財務健全性スコアと目標の進捗・月間拠出額の履歴・同年代比較への参加）は明示的に削除し、
+// それ以外のトークンや認証情報は ON DELETE CASCADE で削除される
 func (r *PostgreSQLUserRepository) Delete(ctx context.Context, id entities.UserID) error {
-	query := `DELETE FROM users WHERE id = $1`
+	return runInTx(ctx, r.db, func(tx *sql.Tx) error {
+		relatedQueries := []string{
+			`DELETE FROM api_keys WHERE user_id = $1`,
+			`DELETE FROM notification_preferences WHERE user_id = $1`,
+			`DELETE FROM health_score_snapshots WHERE user_id = $1`,
+			`DELETE FROM goal_progress_snapshots WHERE user_id = $1`,
+			`DELETE FROM goal_contribution_history WHERE user_id = $1`,
+			`DELETE FROM peer_benchmark_consents WHERE user_id = $1`,
+		}
+		for _, query := range relatedQueries {
+			if _, err := tx.ExecContext(ctx, query, id.String()); err != nil {
+				return fmt.Errorf("関連データの削除に失敗しました: %w", err)
+			}
+		}
 
-	result, err := r.db.ExecContext(ctx, query, id.String())
-	if err != nil {
-		return fmt.Errorf("ユーザーの削除に失敗しました: %w", err)
-	}
+		result, err := tx.ExecContext(ctx, `DELETE FROM users WHERE id = $1`, id.String())
+		if err != nil {
+			return fmt.Errorf("ユーザーの削除に失敗しました: %w", err)
+		}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("削除結果の確認に失敗しました: %w", err)
-	}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("削除結果の確認に失敗しました: %w", err)
+		}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("ユーザーが見つかりません: %s", id)
-	}
+		if rowsAffected == 0 {
+			return fmt.Errorf("ユーザーが見つかりません: %s", id)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // Exists は指定されたIDのユーザーが存在するか確認する
@@ -255,16 +299,18 @@ func (r *PostgreSQLUserRepository) ExistsByEmail(ctx context.Context, email enti
 func (r *PostgreSQLUserRepository) FindByProviderUserID(ctx context.Context, provider entities.AuthProvider, providerUserID string) (*entities.User, error) {
 	var userID, email string
 	var passwordHash, providerStr, providerUID, name, avatarURL, twoFactorSecret sql.NullString
-	var emailVerified, twoFactorEnabled bool
-	var emailVerifiedAt sql.NullTime
+	var emailVerified, twoFactorEnabled, isAdmin bool
+	var emailVerifiedAt, twoFactorRequiredSince, digestLastSentAt sql.NullTime
 	var twoFactorBackupCodes []string
+	var digestFrequency string
+	var digestDeliveryHour int
 	var createdAt, updatedAt time.Time
 
-	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, created_at, updated_at 
+	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, two_factor_required_since, is_admin, digest_frequency, digest_delivery_hour, digest_last_sent_at, created_at, updated_at 
 			  FROM users 
 			  WHERE provider = $1 AND provider_user_id = $2`
 	err := r.db.QueryRowContext(ctx, query, string(provider), providerUserID).Scan(
-		&userID, &email, &passwordHash, &providerStr, &providerUID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &createdAt, &updatedAt,
+		&userID, &email, &passwordHash, &providerStr, &providerUID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &twoFactorRequiredSince, &isAdmin, &digestFrequency, &digestDeliveryHour, &digestLastSentAt, &createdAt, &updatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -278,7 +324,7 @@ func (r *PostgreSQLUserRepository) FindByProviderUserID(ctx context.Context, pro
 		emailVerifiedAtPtr = &emailVerifiedAt.Time
 	}
 
-	return entities.ReconstructUserWithOAuth(
+	user, err := entities.ReconstructUserWithOAuth(
 		userID,
 		email,
 		passwordHash.String,
@@ -294,4 +340,86 @@ func (r *PostgreSQLUserRepository) FindByProviderUserID(ctx context.Context, pro
 		createdAt,
 		updatedAt,
 	)
+	if err != nil {
+		return nil, err
+	}
+	user.RestoreTwoFactorRequiredSince(nullTimeToPtr(twoFactorRequiredSince))
+	user.RestoreAdmin(isAdmin)
+	if err := restoreUserDigest(user, digestFrequency, digestDeliveryHour, digestLastSentAt); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// FindDigestSubscribers はダイジェストメールの配信を有効にしているユーザーを取得する
+// 配信時刻・前回の配信日時による絞り込みは呼び出し元で User.IsDigestDue を使って行う
+func (r *PostgreSQLUserRepository) FindDigestSubscribers(ctx context.Context) ([]*entities.User, error) {
+	query := `SELECT id, email, password_hash, provider, provider_user_id, name, avatar_url, email_verified, email_verified_at, two_factor_enabled, two_factor_secret, two_factor_backup_codes, two_factor_required_since, is_admin, digest_frequency, digest_delivery_hour, digest_last_sent_at, created_at, updated_at
+			  FROM users
+			  WHERE digest_frequency <> 'off'
+			  ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("ダイジェストメールの配信対象の取得に失敗しました: %w", err)
+	}
+	defer rows.Close()
+
+	var users []*entities.User
+	for rows.Next() {
+		var userID, email string
+		var passwordHash, provider, providerUserID, name, avatarURL, twoFactorSecret sql.NullString
+		var emailVerified, twoFactorEnabled, isAdmin bool
+		var emailVerifiedAt, twoFactorRequiredSince, digestLastSentAt sql.NullTime
+		var twoFactorBackupCodes []string
+		var digestFrequency string
+		var digestDeliveryHour int
+		var createdAt, updatedAt time.Time
+
+		if err := rows.Scan(
+			&userID, &email, &passwordHash, &provider, &providerUserID, &name, &avatarURL, &emailVerified, &emailVerifiedAt, &twoFactorEnabled, &twoFactorSecret, pq.Array(&twoFactorBackupCodes), &twoFactorRequiredSince, &isAdmin, &digestFrequency, &digestDeliveryHour, &digestLastSentAt, &createdAt, &updatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ユーザーの読み取りに失敗しました: %w", err)
+		}
+
+		user, err := entities.ReconstructUserWithOAuth(
+			userID,
+			email,
+			passwordHash.String,
+			provider.String,
+			providerUserID.String,
+			name.String,
+			avatarURL.String,
+			emailVerified,
+			nullTimeToPtr(emailVerifiedAt),
+			twoFactorEnabled,
+			twoFactorSecret.String,
+			twoFactorBackupCodes,
+			createdAt,
+			updatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		user.RestoreTwoFactorRequiredSince(nullTimeToPtr(twoFactorRequiredSince))
+		user.RestoreAdmin(isAdmin)
+		if err := restoreUserDigest(user, digestFrequency, digestDeliveryHour, digestLastSentAt); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ダイジェストメールの配信対象の取得に失敗しました: %w", err)
+	}
+
+	return users, nil
+}
+
+// restoreUserDigest はダイジェストメールの配信設定と最後に配信した日時をユーザーに復元する
+func restoreUserDigest(user *entities.User, frequency string, deliveryHour int, lastSentAt sql.NullTime) error {
+	preference, err := entities.NewDigestPreference(entities.DigestFrequency(frequency), deliveryHour)
+	if err != nil {
+		return fmt.Errorf("ダイジェストメールの配信設定の復元に失敗しました: %w", err)
+	}
+	user.RestoreDigest(preference, nullTimeToPtr(lastSentAt))
+	return nil
 }
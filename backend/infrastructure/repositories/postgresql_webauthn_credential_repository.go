@@ -13,12 +13,12 @@ import (
 
 // PostgreSQLWebAuthnCredentialRepository はPostgreSQLを使用したWebAuthn認証情報リポジトリの実装
 type PostgreSQLWebAuthnCredentialRepository struct {
-	db *sql.DB
+	db dbExecutor
 }
 
 // NewPostgreSQLWebAuthnCredentialRepository は新しいPostgreSQLWebAuthn認証情報リポジトリを作成する
 func NewPostgreSQLWebAuthnCredentialRepository(db *sql.DB) repositories.WebAuthnCredentialRepository {
-	return &PostgreSQLWebAuthnCredentialRepository{db: db}
+	return &PostgreSQLWebAuthnCredentialRepository{db: newCircuitBreakerExecutor(db, nil)}
 }
 
 // Save は新しいWebAuthn認証情報を保存する
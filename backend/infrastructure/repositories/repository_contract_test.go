@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories/repositorytest"
+)
+
+func TestPostgreSQLRepositories_Contract(t *testing.T) {
+	db := setupTestDB(t)
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	repositorytest.RunContractTests(t, func(t *testing.T) repositorytest.Fixture {
+		return repositorytest.Fixture{
+			FinancialPlans: NewPostgreSQLFinancialPlanRepository(db),
+			Goals:          NewPostgreSQLGoalRepository(db),
+			Users:          NewPostgreSQLUserRepository(db),
+			RefreshTokens:  NewPostgreSQLRefreshTokenRepository(db),
+			NewUserID: func(t *testing.T) entities.UserID {
+				return createTestUser(t, db)
+			},
+		}
+	})
+}
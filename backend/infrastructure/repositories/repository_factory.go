@@ -2,13 +2,39 @@ package repositories
 
 import (
 	"database/sql"
+	"fmt"
 
 	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories/inmemory"
 )
 
+// StorageType はリポジトリのデータの保存先
+type StorageType string
+
+const (
+	// StoragePostgres はすべてのデータを PostgreSQL に保存する
+	StoragePostgres StorageType = "postgres"
+	// StorageMemory は財務計画・目標・ユーザー・リフレッシュトークンをメモリ上に保持する（DB なしでのデモ用）
+	StorageMemory StorageType = "memory"
+)
+
+// ParseStorageType は文字列から保存先を作成する
+func ParseStorageType(value string) (StorageType, error) {
+	switch StorageType(value) {
+	case StoragePostgres, StorageMemory:
+		return StorageType(value), nil
+	default:
+		return "", fmt.Errorf("保存先は postgres または memory を指定してください: %s", value)
+	}
+}
+
 // RepositoryFactory はリポジトリのファクトリー
 type RepositoryFactory struct {
-	db *sql.DB
+	db      *sql.DB
+	breaker database.CircuitBreaker
+	// memory はインメモリ実装のデータ（保存先が memory の場合のみ設定する）
+	memory *inmemory.Store
 }
 
 // NewRepositoryFactory は新しいリポジトリファクトリーを作成する
@@ -16,32 +42,146 @@ func NewRepositoryFactory(db *sql.DB) *RepositoryFactory {
 	return &RepositoryFactory{db: db}
 }
 
+// NewRepositoryFactoryWithCircuitBreaker はデータベース呼び出しをサーキットブレーカーで保護するリポジトリファクトリーを作成する
+// 作成したすべてのリポジトリが同じブレーカーを共有する
+func NewRepositoryFactoryWithCircuitBreaker(db *sql.DB, breaker database.CircuitBreaker) *RepositoryFactory {
+	return &RepositoryFactory{db: db, breaker: breaker}
+}
+
+// NewInMemoryRepositoryFactory は財務計画・目標・ユーザー・リフレッシュトークンをメモリ上に保持するリポジトリファクトリーを作成する
+// インメモリ実装のないリポジトリは db を使う。db は未接続でもよく、その場合それらの機能の呼び出しはエラーになる
+func NewInMemoryRepositoryFactory(db *sql.DB, breaker database.CircuitBreaker) *RepositoryFactory {
+	return &RepositoryFactory{db: db, breaker: breaker, memory: inmemory.NewStore()}
+}
+
+// executor はサーキットブレーカーを通してクエリを実行する dbExecutor を返す
+func (f *RepositoryFactory) executor() *circuitBreakerExecutor {
+	return newCircuitBreakerExecutor(f.db, f.breaker)
+}
+
 // NewFinancialPlanRepository は財務計画リポジトリを作成する
 func (f *RepositoryFactory) NewFinancialPlanRepository() repositories.FinancialPlanRepository {
-	return NewPostgreSQLFinancialPlanRepository(f.db)
+	if f.memory != nil {
+		return inmemory.NewFinancialPlanRepository(f.memory)
+	}
+	return &PostgreSQLFinancialPlanRepository{db: f.executor()}
 }
 
 // NewUserRepository はユーザーリポジトリを作成する
 func (f *RepositoryFactory) NewUserRepository() repositories.UserRepository {
-	return NewPostgreSQLUserRepository(f.db)
+	if f.memory != nil {
+		return inmemory.NewUserRepository(f.memory)
+	}
+	return &PostgreSQLUserRepository{db: f.executor()}
 }
 
 // NewRefreshTokenRepository はリフレッシュトークンリポジトリを作成する
 func (f *RepositoryFactory) NewRefreshTokenRepository() repositories.RefreshTokenRepository {
-	return NewPostgreSQLRefreshTokenRepository(f.db)
+	if f.memory != nil {
+		return inmemory.NewRefreshTokenRepository(f.memory)
+	}
+	return &PostgreSQLRefreshTokenRepository{db: f.executor()}
 }
 
 // NewGoalRepository は目標リポジトリを作成する
 func (f *RepositoryFactory) NewGoalRepository() repositories.GoalRepository {
-	return NewPostgreSQLGoalRepository(f.db)
+	if f.memory != nil {
+		return inmemory.NewGoalRepository(f.memory)
+	}
+	return &PostgreSQLGoalRepository{db: f.executor()}
 }
 
 // NewWebAuthnCredentialRepository はWebAuthn認証情報リポジトリを作成する
 func (f *RepositoryFactory) NewWebAuthnCredentialRepository() repositories.WebAuthnCredentialRepository {
-	return NewPostgreSQLWebAuthnCredentialRepository(f.db)
+	return &PostgreSQLWebAuthnCredentialRepository{db: f.executor()}
 }
 
 // NewPasswordResetTokenRepository はパスワードリセットトークンリポジトリを作成する
 func (f *RepositoryFactory) NewPasswordResetTokenRepository() repositories.PasswordResetTokenRepository {
-	return NewPostgreSQLPasswordResetTokenRepository(f.db)
+	return &PostgreSQLPasswordResetTokenRepository{db: f.executor()}
+}
+
+// NewSavingsRateTargetRepository は貯蓄率目標リポジトリを作成する
+func (f *RepositoryFactory) NewSavingsRateTargetRepository() repositories.SavingsRateTargetRepository {
+	return &PostgreSQLSavingsRateTargetRepository{db: f.executor()}
+}
+
+// NewNotificationPreferenceRepository は通知設定リポジトリを作成する
+func (f *RepositoryFactory) NewNotificationPreferenceRepository() repositories.NotificationPreferenceRepository {
+	return &PostgreSQLNotificationPreferenceRepository{db: f.executor()}
+}
+
+// NewRecommendationDismissalRepository は推奨事項の却下リポジトリを作成する
+func (f *RepositoryFactory) NewRecommendationDismissalRepository() repositories.RecommendationDismissalRepository {
+	return &PostgreSQLRecommendationDismissalRepository{db: f.executor()}
+}
+
+// NewAPIKeyRepository はAPIキーリポジトリを作成する
+func (f *RepositoryFactory) NewAPIKeyRepository() repositories.APIKeyRepository {
+	return &PostgreSQLAPIKeyRepository{db: f.executor()}
+}
+
+// NewAuditLogRepository は監査ログリポジトリを作成する
+func (f *RepositoryFactory) NewAuditLogRepository() repositories.AuditLogRepository {
+	return &PostgreSQLAuditLogRepository{db: f.executor()}
+}
+
+// NewCalculationSnapshotRepository は計算結果リポジトリを作成する
+func (f *RepositoryFactory) NewCalculationSnapshotRepository() repositories.CalculationSnapshotRepository {
+	return &PostgreSQLCalculationSnapshotRepository{db: f.executor()}
+}
+
+// NewHealthScoreSnapshotRepository は財務健全性スコアの履歴リポジトリを作成する
+func (f *RepositoryFactory) NewHealthScoreSnapshotRepository() repositories.HealthScoreSnapshotRepository {
+	return &PostgreSQLHealthScoreSnapshotRepository{db: f.executor()}
+}
+
+// NewGoalProgressSnapshotRepository は目標の進捗履歴リポジトリを作成する
+func (f *RepositoryFactory) NewGoalProgressSnapshotRepository() repositories.GoalProgressSnapshotRepository {
+	return &PostgreSQLGoalProgressSnapshotRepository{db: f.executor()}
+}
+
+// NewGoalContributionHistoryRepository は目標の月間拠出額の変更履歴リポジトリを作成する
+func (f *RepositoryFactory) NewGoalContributionHistoryRepository() repositories.GoalContributionHistoryRepository {
+	return &PostgreSQLGoalContributionHistoryRepository{db: f.executor()}
+}
+
+// NewPeerBenchmarkRepository は同年代比較の匿名集計リポジトリを作成する
+func (f *RepositoryFactory) NewPeerBenchmarkRepository() repositories.PeerBenchmarkRepository {
+	return &PostgreSQLPeerBenchmarkRepository{db: f.executor()}
+}
+
+// NewPeerBenchmarkConsentRepository は同年代比較の匿名集計への参加リポジトリを作成する
+func (f *RepositoryFactory) NewPeerBenchmarkConsentRepository() repositories.PeerBenchmarkConsentRepository {
+	return &PostgreSQLPeerBenchmarkConsentRepository{db: f.executor()}
+}
+
+// NewDigestDeliveryRepository はダイジェストメールの配信記録リポジトリを作成する
+func (f *RepositoryFactory) NewDigestDeliveryRepository() repositories.DigestDeliveryRepository {
+	return &PostgreSQLDigestDeliveryRepository{db: f.executor()}
+}
+
+// NewRecalculationProgressRepository は一括再計算の進捗リポジトリを作成する
+func (f *RepositoryFactory) NewRecalculationProgressRepository() repositories.RecalculationProgressRepository {
+	return &PostgreSQLRecalculationProgressRepository{db: f.executor()}
+}
+
+// NewUnitOfWork は財務計画・目標・ユーザー関連のリポジトリをまとめて扱うUnitOfWorkを作成する
+// 保存先が memory の場合、インメモリ実装のないパスワードリセットトークン・貯蓄率目標などはトランザクションに含まれない
+func (f *RepositoryFactory) NewUnitOfWork() repositories.UnitOfWork {
+	if f.memory != nil {
+		return inmemory.NewUnitOfWork(f.memory, repositories.TxRepositories{
+			PasswordResetTokens:      f.NewPasswordResetTokenRepository(),
+			SavingsRateTargets:       f.NewSavingsRateTargetRepository(),
+			APIKeys:                  f.NewAPIKeyRepository(),
+			NotificationPreferences:  f.NewNotificationPreferenceRepository(),
+			RecommendationDismissals: f.NewRecommendationDismissalRepository(),
+			HealthScoreSnapshots:     f.NewHealthScoreSnapshotRepository(),
+			CalculationSnapshots:     f.NewCalculationSnapshotRepository(),
+			PeerBenchmarkConsents:    f.NewPeerBenchmarkConsentRepository(),
+			GoalProgressSnapshots:    f.NewGoalProgressSnapshotRepository(),
+			GoalContributionHistory:  f.NewGoalContributionHistoryRepository(),
+		})
+	}
+	return &PostgreSQLUnitOfWork{db: f.executor()}
 }
@@ -0,0 +1,817 @@
+// Package repositorytest はリポジトリの実装が満たすべき契約を、実装によらず共通のテストとして提供する
+// PostgreSQL の実装とインメモリ実装の両方から同じテストを実行し、挙動が揃っていることを確認する
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/google/uuid"
+)
+
+// Fixture は契約テストの対象となるリポジトリ群
+type Fixture struct {
+	FinancialPlans repositories.FinancialPlanRepository
+	Goals          repositories.GoalRepository
+	Users          repositories.UserRepository
+	RefreshTokens  repositories.RefreshTokenRepository
+	// NewUserID はテスト用のユーザーを用意してそのIDを返す（外部キー制約のある実装ではユーザーを登録しておく）
+	NewUserID func(t *testing.T) entities.UserID
+}
+
+// RunContractTests はすべてのリポジトリの契約テストを実行する
+// setup はサブテストごとに呼び出され、他のサブテストと干渉しないリポジトリ群を返す
+func RunContractTests(t *testing.T, setup func(t *testing.T) Fixture) {
+	t.Run("GoalRepository", func(t *testing.T) { runGoalRepositoryContract(t, setup) })
+	t.Run("UserRepository", func(t *testing.T) { runUserRepositoryContract(t, setup) })
+	t.Run("RefreshTokenRepository", func(t *testing.T) { runRefreshTokenRepositoryContract(t, setup) })
+	t.Run("FinancialPlanRepository", func(t *testing.T) { runFinancialPlanRepositoryContract(t, setup) })
+}
+
+func runGoalRepositoryContract(t *testing.T, setup func(t *testing.T) Fixture) {
+	ctx := context.Background()
+
+	t.Run("保存した目標を取得できる", func(t *testing.T) {
+		f := setup(t)
+		goal := newGoal(t, f.NewUserID(t), entities.GoalTypeSavings, "旅行資金", 500000, time.Now())
+		if err := f.Goals.Save(ctx, goal); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		got, err := f.Goals.FindByID(ctx, goal.ID())
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if got.Title() != "旅行資金" || got.TargetAmount().Amount() != 500000 || got.UserID() != goal.UserID() {
+			t.Errorf("got %s / %v / %s, want 旅行資金 / 500000 / %s", got.Title(), got.TargetAmount().Amount(), got.UserID(), goal.UserID())
+		}
+		exists, err := f.Goals.Exists(ctx, goal.ID())
+		if err != nil || !exists {
+			t.Errorf("Exists = %v, %v, want true", exists, err)
+		}
+	})
+
+	t.Run("存在しない目標の取得・更新・削除はエラーになる", func(t *testing.T) {
+		f := setup(t)
+		goal := newGoal(t, f.NewUserID(t), entities.GoalTypeSavings, "未保存", 100000, time.Now())
+
+		if _, err := f.Goals.FindByID(ctx, goal.ID()); err == nil {
+			t.Error("FindByID: expected error")
+		}
+		if err := f.Goals.Update(ctx, goal); err == nil {
+			t.Error("Update: expected error")
+		}
+		if err := f.Goals.Delete(ctx, goal.ID()); err == nil {
+			t.Error("Delete: expected error")
+		}
+		exists, err := f.Goals.Exists(ctx, goal.ID())
+		if err != nil || exists {
+			t.Errorf("Exists = %v, %v, want false", exists, err)
+		}
+	})
+
+	t.Run("取得した目標を変更してもUpdateするまで保存されない", func(t *testing.T) {
+		f := setup(t)
+		goal := newGoal(t, f.NewUserID(t), entities.GoalTypeSavings, "変更前", 100000, time.Now())
+		if err := f.Goals.Save(ctx, goal); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		got, err := f.Goals.FindByID(ctx, goal.ID())
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if err := got.UpdateTitle("変更後"); err != nil {
+			t.Fatalf("UpdateTitle failed: %v", err)
+		}
+		if again, _ := f.Goals.FindByID(ctx, goal.ID()); again.Title() != "変更前" {
+			t.Errorf("title before Update = %s, want 変更前", again.Title())
+		}
+
+		if err := f.Goals.Update(ctx, got); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if again, _ := f.Goals.FindByID(ctx, goal.ID()); again.Title() != "変更後" {
+			t.Errorf("title after Update = %s, want 変更後", again.Title())
+		}
+	})
+
+	t.Run("ユーザーの目標を作成日時の新しい順に取得し絞り込める", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		otherUserID := f.NewUserID(t)
+		base := time.Now().Add(-time.Hour)
+		older := newGoal(t, userID, entities.GoalTypeSavings, "古い目標", 100000, base)
+		newer := newGoal(t, userID, entities.GoalTypeRetirement, "新しい目標", 200000, base.Add(time.Minute))
+		inactive := newGoal(t, userID, entities.GoalTypeSavings, "停止中の目標", 300000, base.Add(2*time.Minute))
+		inactive.Deactivate()
+		other := newGoal(t, otherUserID, entities.GoalTypeSavings, "他のユーザーの目標", 400000, base)
+		for _, goal := range []*entities.Goal{older, newer, inactive, other} {
+			if err := f.Goals.Save(ctx, goal); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+		}
+
+		all, err := f.Goals.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		assertGoalIDs(t, "FindByUserID", all, inactive, newer, older)
+
+		active, err := f.Goals.FindActiveGoalsByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindActiveGoalsByUserID failed: %v", err)
+		}
+		assertGoalIDs(t, "FindActiveGoalsByUserID", active, newer, older)
+
+		savings, err := f.Goals.FindByUserIDAndType(ctx, userID, entities.GoalTypeSavings)
+		if err != nil {
+			t.Fatalf("FindByUserIDAndType failed: %v", err)
+		}
+		assertGoalIDs(t, "FindByUserIDAndType", savings, inactive, older)
+
+		count, err := f.Goals.CountActiveGoalsByType(ctx, userID, entities.GoalTypeSavings)
+		if err != nil || count != 1 {
+			t.Errorf("CountActiveGoalsByType = %d, %v, want 1", count, err)
+		}
+	})
+
+	t.Run("類似するアクティブな目標を取得できる", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		goal := newGoal(t, userID, entities.GoalTypeSavings, "車の購入", 2000000, time.Now())
+		if err := f.Goals.Save(ctx, goal); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		similar, err := f.Goals.FindSimilarGoal(ctx, userID, entities.GoalTypeSavings, "車の購入", mustMoney(t, 2010000))
+		if err != nil {
+			t.Fatalf("FindSimilarGoal failed: %v", err)
+		}
+		if similar == nil || similar.ID() != goal.ID() {
+			t.Errorf("FindSimilarGoal = %v, want %s", similar, goal.ID())
+		}
+
+		notSimilar, err := f.Goals.FindSimilarGoal(ctx, userID, entities.GoalTypeSavings, "車の購入", mustMoney(t, 3000000))
+		if err != nil {
+			t.Fatalf("FindSimilarGoal failed: %v", err)
+		}
+		if notSimilar != nil {
+			t.Errorf("FindSimilarGoal = %s, want nil", notSimilar.ID())
+		}
+	})
+
+	t.Run("削除した目標は取得できない", func(t *testing.T) {
+		f := setup(t)
+		goal := newGoal(t, f.NewUserID(t), entities.GoalTypeSavings, "削除する目標", 100000, time.Now())
+		if err := f.Goals.Save(ctx, goal); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if err := f.Goals.Delete(ctx, goal.ID()); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if _, err := f.Goals.FindByID(ctx, goal.ID()); err == nil {
+			t.Error("FindByID after Delete: expected error")
+		}
+	})
+
+	t.Run("削除した目標は他の目標の前提目標から取り除かれる", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		deleted := newGoal(t, userID, entities.GoalTypeSavings, "削除する前提目標", 100000, time.Now())
+		kept := newGoal(t, userID, entities.GoalTypeSavings, "残る前提目標", 100000, time.Now())
+		dependent := newGoal(t, userID, entities.GoalTypeCustom, "依存する目標", 500000, time.Now())
+		dependent.RestoreDependencies([]entities.GoalID{deleted.ID(), kept.ID()})
+		for _, goal := range []*entities.Goal{deleted, kept, dependent} {
+			if err := f.Goals.Save(ctx, goal); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+		}
+
+		if err := f.Goals.Delete(ctx, deleted.ID()); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+
+		found, err := f.Goals.FindByID(ctx, dependent.ID())
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if got := found.DependsOn(); len(got) != 1 || got[0] != kept.ID() {
+			t.Errorf("DependsOn after Delete = %v, want [%s]", got, kept.ID())
+		}
+	})
+}
+
+func runUserRepositoryContract(t *testing.T, setup func(t *testing.T) Fixture) {
+	ctx := context.Background()
+
+	t.Run("保存したユーザーをIDとメールアドレスで取得できる", func(t *testing.T) {
+		f := setup(t)
+		user := newUser(t)
+		if err := f.Users.Save(ctx, user); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		byID, err := f.Users.FindByID(ctx, user.ID())
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if byID.Email() != user.Email() || !byID.VerifyPassword("Password123!") {
+			t.Errorf("FindByID returned a different user: %s", byID.Email())
+		}
+		byEmail, err := f.Users.FindByEmail(ctx, user.Email())
+		if err != nil {
+			t.Fatalf("FindByEmail failed: %v", err)
+		}
+		if byEmail.ID() != user.ID() {
+			t.Errorf("FindByEmail ID = %s, want %s", byEmail.ID(), user.ID())
+		}
+		if exists, err := f.Users.ExistsByEmail(ctx, user.Email()); err != nil || !exists {
+			t.Errorf("ExistsByEmail = %v, %v, want true", exists, err)
+		}
+	})
+
+	t.Run("メールアドレスが重複するユーザーは保存できない", func(t *testing.T) {
+		f := setup(t)
+		user := newUser(t)
+		if err := f.Users.Save(ctx, user); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		duplicate, err := entities.NewUser(uuid.New().String(), string(user.Email()), "Password123!")
+		if err != nil {
+			t.Fatalf("NewUser failed: %v", err)
+		}
+		if err := f.Users.Save(ctx, duplicate); err == nil {
+			t.Error("Save with duplicate email: expected error")
+		}
+	})
+
+	t.Run("存在しないユーザーの取得・更新・削除はエラーになる", func(t *testing.T) {
+		f := setup(t)
+		user := newUser(t)
+		if _, err := f.Users.FindByID(ctx, user.ID()); err == nil {
+			t.Error("FindByID: expected error")
+		}
+		if _, err := f.Users.FindByEmail(ctx, user.Email()); err == nil {
+			t.Error("FindByEmail: expected error")
+		}
+		if err := f.Users.Update(ctx, user); err == nil {
+			t.Error("Update: expected error")
+		}
+		if err := f.Users.Delete(ctx, user.ID()); err == nil {
+			t.Error("Delete: expected error")
+		}
+		if exists, err := f.Users.Exists(ctx, user.ID()); err != nil || exists {
+			t.Errorf("Exists = %v, %v, want false", exists, err)
+		}
+	})
+
+	t.Run("更新したユーザー情報を取得できる", func(t *testing.T) {
+		f := setup(t)
+		user := newUser(t)
+		if err := f.Users.Save(ctx, user); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if err := user.UpdatePassword("NewPassword456!"); err != nil {
+			t.Fatalf("UpdatePassword failed: %v", err)
+		}
+		if err := f.Users.Update(ctx, user); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		got, err := f.Users.FindByID(ctx, user.ID())
+		if err != nil {
+			t.Fatalf("FindByID failed: %v", err)
+		}
+		if !got.VerifyPassword("NewPassword456!") {
+			t.Error("password was not updated")
+		}
+	})
+
+	t.Run("ダイジェストメールの配信設定を更新すると配信対象として取得できる", func(t *testing.T) {
+		f := setup(t)
+		subscriber := newUser(t)
+		unsubscribed := newUser(t)
+		for _, user := range []*entities.User{subscriber, unsubscribed} {
+			if err := f.Users.Save(ctx, user); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+		}
+		preference, err := entities.NewDigestPreference(entities.DigestFrequencyWeekly, 7)
+		if err != nil {
+			t.Fatalf("NewDigestPreference failed: %v", err)
+		}
+		subscriber.UpdateDigestPreference(preference)
+		sentAt := time.Date(2026, 6, 1, 7, 0, 0, 0, time.UTC)
+		subscriber.MarkDigestSent(sentAt)
+		if err := f.Users.Update(ctx, subscriber); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+
+		users, err := f.Users.FindDigestSubscribers(ctx)
+		if err != nil {
+			t.Fatalf("FindDigestSubscribers failed: %v", err)
+		}
+		if len(users) != 1 || users[0].ID() != subscriber.ID() {
+			t.Fatalf("FindDigestSubscribers returned %d users, want only %s", len(users), subscriber.ID())
+		}
+		got := users[0]
+		if got.DigestPreference().Frequency() != entities.DigestFrequencyWeekly || got.DigestPreference().DeliveryHour() != 7 {
+			t.Errorf("DigestPreference = %s/%d, want weekly/7", string(got.DigestPreference().Frequency()), got.DigestPreference().DeliveryHour())
+		}
+		if got.DigestLastSentAt() == nil || !got.DigestLastSentAt().Equal(sentAt) {
+			t.Errorf("DigestLastSentAt = %v, want %v", got.DigestLastSentAt(), sentAt)
+		}
+	})
+
+	t.Run("削除したユーザーは存在しない", func(t *testing.T) {
+		f := setup(t)
+		user := newUser(t)
+		if err := f.Users.Save(ctx, user); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if err := f.Users.Delete(ctx, user.ID()); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if exists, err := f.Users.Exists(ctx, user.ID()); err != nil || exists {
+			t.Errorf("Exists after Delete = %v, %v, want false", exists, err)
+		}
+	})
+}
+
+func runRefreshTokenRepositoryContract(t *testing.T, setup func(t *testing.T) Fixture) {
+	ctx := context.Background()
+
+	t.Run("保存したトークンをハッシュで取得できる", func(t *testing.T) {
+		f := setup(t)
+		token := newRefreshToken(t, f.NewUserID(t), time.Now())
+		if err := f.RefreshTokens.Save(ctx, token); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		got, err := f.RefreshTokens.FindByTokenHash(ctx, token.TokenHash())
+		if err != nil {
+			t.Fatalf("FindByTokenHash failed: %v", err)
+		}
+		if got.ID() != token.ID() || got.UserID() != token.UserID() {
+			t.Errorf("FindByTokenHash = %s, want %s", got.ID(), token.ID())
+		}
+		if _, err := f.RefreshTokens.FindByTokenHash(ctx, "unknown"); err == nil {
+			t.Error("FindByTokenHash with unknown hash: expected error")
+		}
+	})
+
+	t.Run("有効なトークンだけを作成日時の新しい順に取得する", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		base := time.Now().Add(-time.Hour)
+		older := newRefreshToken(t, userID, base)
+		newer := newRefreshToken(t, userID, base.Add(time.Minute))
+		revoked := newRefreshToken(t, userID, base.Add(2*time.Minute))
+		revoked.Revoke()
+		expired := entities.ReconstructRefreshToken(string(entities.NewRefreshTokenID()), userID, uuid.New().String(),
+			base.Add(30*time.Minute), false, base, base)
+		for _, token := range []*entities.RefreshToken{older, newer, revoked, expired} {
+			if err := f.RefreshTokens.Save(ctx, token); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+		}
+
+		tokens, err := f.RefreshTokens.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		if len(tokens) != 2 || tokens[0].ID() != newer.ID() || tokens[1].ID() != older.ID() {
+			t.Errorf("FindByUserID returned %d tokens, want [newer, older]", len(tokens))
+		}
+		if count, err := f.RefreshTokens.CountByUserID(ctx, userID); err != nil || count != 4 {
+			t.Errorf("CountByUserID = %d, %v, want 4", count, err)
+		}
+
+		if err := f.RefreshTokens.DeleteExpired(ctx); err != nil {
+			t.Fatalf("DeleteExpired failed: %v", err)
+		}
+		if _, err := f.RefreshTokens.FindByTokenHash(ctx, expired.TokenHash()); err == nil {
+			t.Error("expired token still exists after DeleteExpired")
+		}
+	})
+
+	t.Run("ユーザーのトークンを一括で失効・削除できる", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		otherUserID := f.NewUserID(t)
+		token := newRefreshToken(t, userID, time.Now())
+		other := newRefreshToken(t, otherUserID, time.Now())
+		for _, tk := range []*entities.RefreshToken{token, other} {
+			if err := f.RefreshTokens.Save(ctx, tk); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+		}
+
+		if err := f.RefreshTokens.RevokeByUserID(ctx, userID); err != nil {
+			t.Fatalf("RevokeByUserID failed: %v", err)
+		}
+		got, err := f.RefreshTokens.FindByTokenHash(ctx, token.TokenHash())
+		if err != nil {
+			t.Fatalf("FindByTokenHash failed: %v", err)
+		}
+		if !got.IsRevoked() {
+			t.Error("token was not revoked")
+		}
+		if otherGot, _ := f.RefreshTokens.FindByTokenHash(ctx, other.TokenHash()); otherGot == nil || otherGot.IsRevoked() {
+			t.Error("another user's token was revoked")
+		}
+
+		if err := f.RefreshTokens.DeleteByUserID(ctx, userID); err != nil {
+			t.Fatalf("DeleteByUserID failed: %v", err)
+		}
+		if count, err := f.RefreshTokens.CountByUserID(ctx, userID); err != nil || count != 0 {
+			t.Errorf("CountByUserID after DeleteByUserID = %d, %v, want 0", count, err)
+		}
+		if count, err := f.RefreshTokens.CountByUserID(ctx, otherUserID); err != nil || count != 1 {
+			t.Errorf("CountByUserID of another user = %d, %v, want 1", count, err)
+		}
+	})
+
+	t.Run("存在しないトークンの更新・削除はエラーになる", func(t *testing.T) {
+		f := setup(t)
+		token := newRefreshToken(t, f.NewUserID(t), time.Now())
+		if err := f.RefreshTokens.Update(ctx, token); err == nil {
+			t.Error("Update: expected error")
+		}
+		if err := f.RefreshTokens.Delete(ctx, token.ID()); err == nil {
+			t.Error("Delete: expected error")
+		}
+	})
+}
+
+func runFinancialPlanRepositoryContract(t *testing.T, setup func(t *testing.T) Fixture) {
+	ctx := context.Background()
+
+	t.Run("保存した財務計画を目標・緊急資金とともに取得できる", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		plan := newFinancialPlan(t, userID)
+		if err := plan.UpdateEmergencyFundSettings(6, mustMoney(t, 300000)); err != nil {
+			t.Fatalf("UpdateEmergencyFundSettings failed: %v", err)
+		}
+		goal := newGoal(t, userID, entities.GoalTypeSavings, "旅行資金", 500000, time.Now())
+		if err := plan.AddGoal(goal); err != nil {
+			t.Fatalf("AddGoal failed: %v", err)
+		}
+		if err := f.FinancialPlans.Save(ctx, plan); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		got, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		if got.Profile().MonthlyIncome().Amount() != 400000 {
+			t.Errorf("monthly income = %v, want 400000", got.Profile().MonthlyIncome().Amount())
+		}
+		if len(got.Goals()) != 1 || got.Goals()[0].ID() != goal.ID() {
+			t.Errorf("goals = %d, want the saved goal", len(got.Goals()))
+		}
+		if fund := got.EmergencyFund(); fund == nil || fund.TargetMonths() != 6 || fund.CurrentFund().Amount() != 300000 {
+			t.Errorf("emergency fund was not restored: %+v", fund)
+		}
+
+		// 財務計画の目標は目標リポジトリからも取得できる
+		if _, err := f.Goals.FindByID(ctx, goal.ID()); err != nil {
+			t.Errorf("goal saved with the plan is not found: %v", err)
+		}
+		if exists, err := f.FinancialPlans.ExistsByUserID(ctx, userID); err != nil || !exists {
+			t.Errorf("ExistsByUserID = %v, %v, want true", exists, err)
+		}
+	})
+
+	t.Run("存在しない財務計画の取得・削除はエラーになる", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		if _, err := f.FinancialPlans.FindByUserID(ctx, userID); err == nil {
+			t.Error("FindByUserID: expected error")
+		}
+		id := aggregates.NewFinancialPlanID()
+		if _, err := f.FinancialPlans.FindByID(ctx, id); err == nil {
+			t.Error("FindByID: expected error")
+		}
+		if err := f.FinancialPlans.Delete(ctx, id); err == nil {
+			t.Error("Delete: expected error")
+		}
+		if exists, err := f.FinancialPlans.ExistsByUserID(ctx, userID); err != nil || exists {
+			t.Errorf("ExistsByUserID = %v, %v, want false", exists, err)
+		}
+	})
+
+	t.Run("計算日時はより新しい日時のみ記録する", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		if err := f.FinancialPlans.Save(ctx, newFinancialPlan(t, userID)); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		later := time.Now().UTC().Truncate(time.Second)
+		earlier := later.Add(-time.Hour)
+		for _, calculatedAt := range []time.Time{later, earlier} {
+			if err := f.FinancialPlans.MarkCalculated(ctx, userID, calculatedAt); err != nil {
+				t.Fatalf("MarkCalculated failed: %v", err)
+			}
+		}
+
+		got, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		if got.LastCalculatedAt() == nil || !got.LastCalculatedAt().Equal(later) {
+			t.Errorf("LastCalculatedAt = %v, want %v", got.LastCalculatedAt(), later)
+		}
+	})
+
+	t.Run("更新するとバージョンが進み、古いバージョンからの更新は競合になる", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		if err := f.FinancialPlans.Save(ctx, newFinancialPlan(t, userID)); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		stale, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		latest, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		if latest.Version() != 1 {
+			t.Fatalf("Version after Save = %d, want 1", latest.Version())
+		}
+
+		if err := latest.UpdateEmergencyFundSettings(6, mustMoney(t, 300000)); err != nil {
+			t.Fatalf("UpdateEmergencyFundSettings failed: %v", err)
+		}
+		if err := f.FinancialPlans.Update(ctx, latest); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+		if latest.Version() != 2 {
+			t.Errorf("Version after Update = %d, want 2", latest.Version())
+		}
+
+		if err := stale.UpdateEmergencyFundSettings(12, mustMoney(t, 100000)); err != nil {
+			t.Fatalf("UpdateEmergencyFundSettings failed: %v", err)
+		}
+		err = f.FinancialPlans.Update(ctx, stale)
+		var conflictErr *repositories.VersionConflictError
+		if !errors.As(err, &conflictErr) || conflictErr.CurrentVersion != 2 {
+			t.Fatalf("Update with stale version = %v, want VersionConflictError with current version 2", err)
+		}
+
+		got, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		if got.Version() != 2 || got.EmergencyFund().TargetMonths() != 6 {
+			t.Errorf("version = %d, target months = %d, want 2 and 6 (stale update must not be saved)", got.Version(), got.EmergencyFund().TargetMonths())
+		}
+	})
+
+	t.Run("同じバージョンから同時に更新すると一方のみ成功する", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		if err := f.FinancialPlans.Save(ctx, newFinancialPlan(t, userID)); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+
+		// 2つのセッションが同じバージョンの財務計画を読み込んでから、それぞれ更新する
+		const sessions = 2
+		plans := make([]*aggregates.FinancialPlan, sessions)
+		for i := range plans {
+			plan, err := f.FinancialPlans.FindByUserID(ctx, userID)
+			if err != nil {
+				t.Fatalf("FindByUserID failed: %v", err)
+			}
+			if err := plan.UpdateEmergencyFundSettings(3+i, mustMoney(t, 100000)); err != nil {
+				t.Fatalf("UpdateEmergencyFundSettings failed: %v", err)
+			}
+			plans[i] = plan
+		}
+
+		errs := make([]error, sessions)
+		var wg sync.WaitGroup
+		for i, plan := range plans {
+			wg.Add(1)
+			go func(i int, plan *aggregates.FinancialPlan) {
+				defer wg.Done()
+				errs[i] = f.FinancialPlans.Update(ctx, plan)
+			}(i, plan)
+		}
+		wg.Wait()
+
+		succeeded := 0
+		for _, err := range errs {
+			switch {
+			case err == nil:
+				succeeded++
+			case !errors.Is(err, repositories.ErrVersionConflict):
+				t.Errorf("Update failed with unexpected error: %v", err)
+			}
+		}
+		if succeeded != 1 {
+			t.Fatalf("succeeded updates = %d, want exactly 1 (errors: %v)", succeeded, errs)
+		}
+
+		got, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		if got.Version() != 2 {
+			t.Errorf("Version = %d, want 2", got.Version())
+		}
+	})
+
+	t.Run("財務計画を削除するとユーザーの目標も削除される", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		plan := newFinancialPlan(t, userID)
+		if err := f.FinancialPlans.Save(ctx, plan); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		saved, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		goal := newGoal(t, userID, entities.GoalTypeSavings, "旅行資金", 500000, time.Now())
+		if err := f.Goals.Save(ctx, goal); err != nil {
+			t.Fatalf("Save goal failed: %v", err)
+		}
+
+		if err := f.FinancialPlans.Delete(ctx, saved.ID()); err != nil {
+			t.Fatalf("Delete failed: %v", err)
+		}
+		if exists, err := f.FinancialPlans.ExistsByUserID(ctx, userID); err != nil || exists {
+			t.Errorf("ExistsByUserID after Delete = %v, %v, want false", exists, err)
+		}
+		if exists, err := f.Goals.Exists(ctx, goal.ID()); err != nil || exists {
+			t.Errorf("goal Exists after Delete = %v, %v, want false", exists, err)
+		}
+	})
+
+	t.Run("財務計画から削除した目標は論理削除され取得できない", func(t *testing.T) {
+		f := setup(t)
+		userID := f.NewUserID(t)
+		if err := f.FinancialPlans.Save(ctx, newFinancialPlan(t, userID)); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		// 目標リポジトリで保存した目標も財務計画の目標として取得できる
+		kept := newGoal(t, userID, entities.GoalTypeSavings, "旅行資金", 500000, time.Now().Add(-time.Hour))
+		removed := newGoal(t, userID, entities.GoalTypeSavings, "車の購入", 500000, time.Now())
+		for _, goal := range []*entities.Goal{kept, removed} {
+			if err := f.Goals.Save(ctx, goal); err != nil {
+				t.Fatalf("Save goal failed: %v", err)
+			}
+		}
+		plan, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		assertGoalIDs(t, "plan goals", plan.Goals(), kept, removed)
+
+		if err := plan.RemoveGoal(removed.ID()); err != nil {
+			t.Fatalf("RemoveGoal failed: %v", err)
+		}
+		if err := f.FinancialPlans.Update(ctx, plan); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+
+		got, err := f.FinancialPlans.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID failed: %v", err)
+		}
+		assertGoalIDs(t, "plan goals after RemoveGoal", got.Goals(), kept)
+		if _, err := f.Goals.FindByID(ctx, removed.ID()); err == nil {
+			t.Error("FindByID of the removed goal succeeded, want error")
+		}
+		goals, err := f.Goals.FindByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("FindByUserID goals failed: %v", err)
+		}
+		assertGoalIDs(t, "goals after RemoveGoal", goals, kept)
+	})
+
+	t.Run("財務計画を持つユーザーのIDをページングして取得できる", func(t *testing.T) {
+		f := setup(t)
+		userIDs := []entities.UserID{f.NewUserID(t), f.NewUserID(t), f.NewUserID(t)}
+		for _, userID := range userIDs {
+			if err := f.FinancialPlans.Save(ctx, newFinancialPlan(t, userID)); err != nil {
+				t.Fatalf("Save failed: %v", err)
+			}
+		}
+		smallest := userIDs[0]
+		for _, userID := range userIDs[1:] {
+			if userID < smallest {
+				smallest = userID
+			}
+		}
+
+		// 他のテストのデータが残っている実装もあるため、最小のIDの直前から取得して順序だけを確認する
+		page, err := f.FinancialPlans.FindUserIDs(ctx, smallest[:len(smallest)-1], 2)
+		if err != nil {
+			t.Fatalf("FindUserIDs failed: %v", err)
+		}
+		if len(page) != 2 || page[0] >= page[1] {
+			t.Errorf("FindUserIDs = %v, want 2 IDs in ascending order", page)
+		}
+		rest, err := f.FinancialPlans.FindUserIDs(ctx, page[len(page)-1], 100)
+		if err != nil {
+			t.Fatalf("FindUserIDs failed: %v", err)
+		}
+		for _, userID := range rest {
+			if userID <= page[len(page)-1] {
+				t.Errorf("FindUserIDs returned %s, not after the cursor", userID)
+			}
+		}
+	})
+}
+
+func newGoal(t *testing.T, userID entities.UserID, goalType entities.GoalType, title string, targetAmount float64, createdAt time.Time) *entities.Goal {
+	t.Helper()
+	goal, err := entities.NewGoalWithID(
+		entities.NewGoalID(), userID, goalType, title,
+		mustMoney(t, targetAmount), time.Now().AddDate(2, 0, 0), mustMoney(t, 10000),
+		createdAt.UTC().Truncate(time.Millisecond), createdAt.UTC().Truncate(time.Millisecond),
+	)
+	if err != nil {
+		t.Fatalf("NewGoalWithID failed: %v", err)
+	}
+	return goal
+}
+
+func newUser(t *testing.T) *entities.User {
+	t.Helper()
+	id := uuid.New().String()
+	user, err := entities.NewUser(id, id+"@example.com", "Password123!")
+	if err != nil {
+		t.Fatalf("NewUser failed: %v", err)
+	}
+	return user
+}
+
+func newRefreshToken(t *testing.T, userID entities.UserID, createdAt time.Time) *entities.RefreshToken {
+	t.Helper()
+	createdAt = createdAt.UTC().Truncate(time.Millisecond)
+	return entities.ReconstructRefreshToken(string(entities.NewRefreshTokenID()), userID, uuid.New().String(),
+		time.Now().Add(24*time.Hour), false, createdAt, createdAt)
+}
+
+func newFinancialPlan(t *testing.T, userID entities.UserID) *aggregates.FinancialPlan {
+	t.Helper()
+	investmentReturn, err := valueobjects.NewRate(5.0)
+	if err != nil {
+		t.Fatalf("NewRate failed: %v", err)
+	}
+	inflationRate, err := valueobjects.NewRate(2.0)
+	if err != nil {
+		t.Fatalf("NewRate failed: %v", err)
+	}
+	profile, err := entities.NewFinancialProfile(
+		userID,
+		mustMoney(t, 400000),
+		entities.ExpenseCollection{{Category: "住居費", Amount: mustMoney(t, 120000)}},
+		entities.SavingsCollection{{Type: "deposit", Amount: mustMoney(t, 1000000)}},
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		t.Fatalf("NewFinancialProfile failed: %v", err)
+	}
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		t.Fatalf("NewFinancialPlan failed: %v", err)
+	}
+	return plan
+}
+
+func mustMoney(t *testing.T, amount float64) valueobjects.Money {
+	t.Helper()
+	money, err := valueobjects.NewMoneyJPY(amount)
+	if err != nil {
+		t.Fatalf("NewMoneyJPY failed: %v", err)
+	}
+	return money
+}
+
+func assertGoalIDs(t *testing.T, name string, got []*entities.Goal, want ...*entities.Goal) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s returned %d goals, want %d", name, len(got), len(want))
+		return
+	}
+	for i := range want {
+		if got[i].ID() != want[i].ID() {
+			t.Errorf("%s[%d] = %s (%s), want %s (%s)", name, i, got[i].ID(), got[i].Title(), want[i].ID(), want[i].Title())
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	redisinfra "github.com/financial-planning-calculator/backend/infrastructure/redis"
+)
+
+// dbExecutor はデータベース接続とトランザクションに共通するクエリ実行インターフェース
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) rowScanner
+}
+
+// rowScanner は *sql.Row と *sql.Rows の共通インターフェース
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// runInTx はトランザクション内でfnを実行する
+// dbが既にトランザクションの場合は外側のトランザクションに参加し、コミットは呼び出し元に委ねる
+func runInTx(ctx context.Context, db dbExecutor, fn func(tx *sql.Tx) error) error {
+	if executor, ok := db.(*txExecutor); ok {
+		return fn(executor.tx)
+	}
+
+	executor, ok := db.(*circuitBreakerExecutor)
+	if !ok {
+		return errors.New("トランザクションを開始できないデータベース接続です")
+	}
+
+	tx, err := executor.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// PostgreSQLUnitOfWork はdatabase/sqlのトランザクションを使ったUnitOfWork
+type PostgreSQLUnitOfWork struct {
+	db *circuitBreakerExecutor
+}
+
+// NewPostgreSQLUnitOfWork は新しいPostgreSQLUnitOfWorkを作成する
+func NewPostgreSQLUnitOfWork(db *sql.DB) repositories.UnitOfWork {
+	return &PostgreSQLUnitOfWork{db: newCircuitBreakerExecutor(db, nil)}
+}
+
+// WithinTx はトランザクション内でfnを実行し、成功時はコミット、失敗時はロールバックする
+func (u *PostgreSQLUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context, repos repositories.TxRepositories) error) error {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("トランザクションの開始に失敗しました: %w", err)
+	}
+	defer tx.Rollback()
+
+	txDB := &txExecutor{tx: tx}
+	repos := repositories.TxRepositories{
+		FinancialPlans:           &PostgreSQLFinancialPlanRepository{db: txDB},
+		Goals:                    &PostgreSQLGoalRepository{db: txDB},
+		Users:                    &PostgreSQLUserRepository{db: txDB},
+		RefreshTokens:            &PostgreSQLRefreshTokenRepository{db: txDB},
+		PasswordResetTokens:      &PostgreSQLPasswordResetTokenRepository{db: txDB},
+		SavingsRateTargets:       &PostgreSQLSavingsRateTargetRepository{db: txDB},
+		APIKeys:                  &PostgreSQLAPIKeyRepository{db: txDB},
+		NotificationPreferences:  &PostgreSQLNotificationPreferenceRepository{db: txDB},
+		RecommendationDismissals: &PostgreSQLRecommendationDismissalRepository{db: txDB},
+		HealthScoreSnapshots:     &PostgreSQLHealthScoreSnapshotRepository{db: txDB},
+		CalculationSnapshots:     &PostgreSQLCalculationSnapshotRepository{db: txDB},
+		PeerBenchmarkConsents:    &PostgreSQLPeerBenchmarkConsentRepository{db: txDB},
+		GoalProgressSnapshots:    &PostgreSQLGoalProgressSnapshotRepository{db: txDB},
+		GoalContributionHistory:  &PostgreSQLGoalContributionHistoryRepository{db: txDB},
+	}
+	if err := fn(ctx, repos); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("トランザクションのコミットに失敗しました: %w", err)
+	}
+	return nil
+}
+
+// NoopUnitOfWork はトランザクションを持たないリポジトリ（インメモリ実装など）向けのUnitOfWork
+// 渡されたリポジトリでfnを実行するだけで、ロールバックは行わない
+type NoopUnitOfWork struct {
+	repos repositories.TxRepositories
+}
+
+// NewNoopUnitOfWork は新しいNoopUnitOfWorkを作成する
+func NewNoopUnitOfWork(repos repositories.TxRepositories) repositories.UnitOfWork {
+	return &NoopUnitOfWork{repos: repos}
+}
+
+// WithinTx は渡されたリポジトリでfnを実行する
+func (u *NoopUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context, repos repositories.TxRepositories) error) error {
+	return fn(ctx, u.repos)
+}
+
+// CachedUnitOfWork はトランザクション内のリポジトリをキャッシュデコレータでラップするUnitOfWork
+// 書き込み時のキャッシュ無効化はトランザクション内で行われるため、コミット前の短い間に
+// 古いデータが再キャッシュされる可能性があるが、TTLにより解消される
+type CachedUnitOfWork struct {
+	delegate    repositories.UnitOfWork
+	redisClient redisinfra.CacheClient
+}
+
+// NewCachedUnitOfWork は新しいCachedUnitOfWorkを作成する
+func NewCachedUnitOfWork(delegate repositories.UnitOfWork, redisClient redisinfra.CacheClient) repositories.UnitOfWork {
+	return &CachedUnitOfWork{
+		delegate:    delegate,
+		redisClient: redisClient,
+	}
+}
+
+// WithinTx は委譲先のトランザクション内で、キャッシュデコレータ付きのリポジトリを使ってfnを実行する
+func (u *CachedUnitOfWork) WithinTx(ctx context.Context, fn func(ctx context.Context, repos repositories.TxRepositories) error) error {
+	return u.delegate.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		cachedRepos := repos
+		cachedRepos.FinancialPlans = NewCachedFinancialPlanRepository(repos.FinancialPlans, u.redisClient)
+		cachedRepos.Goals = NewCachedGoalRepository(repos.Goals, u.redisClient)
+		return fn(ctx, cachedRepos)
+	})
+}
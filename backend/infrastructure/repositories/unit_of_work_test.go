@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+)
+
+func TestPostgreSQLUnitOfWork_WithinTx_RollsBackOnError(t *testing.T) {
+	db := setupTestDB(t)
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	userID := createTestUser(t, db)
+
+	targetAmount, _ := valueobjects.NewMoneyJPY(1000000)
+	monthlyContribution, _ := valueobjects.NewMoneyJPY(50000)
+	goal, err := entities.NewGoal(
+		userID,
+		entities.GoalTypeSavings,
+		"Rollback Test Goal",
+		targetAmount,
+		time.Now().AddDate(1, 0, 0),
+		monthlyContribution,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create goal: %v", err)
+	}
+
+	uow := NewPostgreSQLUnitOfWork(db)
+	secondWriteErr := errors.New("second write failed")
+	err = uow.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		if err := repos.Goals.Save(ctx, goal); err != nil {
+			return err
+		}
+		return secondWriteErr
+	})
+	if !errors.Is(err, secondWriteErr) {
+		t.Fatalf("Expected second write error, got %v", err)
+	}
+
+	exists, err := NewPostgreSQLGoalRepository(db).Exists(ctx, goal.ID())
+	if err != nil {
+		t.Fatalf("Failed to check goal existence: %v", err)
+	}
+	if exists {
+		t.Error("Expected goal save to be rolled back")
+	}
+}
+
+func TestPostgreSQLUnitOfWork_WithinTx_Commits(t *testing.T) {
+	db := setupTestDB(t)
+	if db == nil {
+		return
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	userID := createTestUser(t, db)
+
+	targetAmount, _ := valueobjects.NewMoneyJPY(1000000)
+	monthlyContribution, _ := valueobjects.NewMoneyJPY(50000)
+	goal, err := entities.NewGoal(
+		userID,
+		entities.GoalTypeSavings,
+		"Commit Test Goal",
+		targetAmount,
+		time.Now().AddDate(1, 0, 0),
+		monthlyContribution,
+	)
+	if err != nil {
+		t.Fatalf("Failed to create goal: %v", err)
+	}
+
+	uow := NewPostgreSQLUnitOfWork(db)
+	err = uow.WithinTx(ctx, func(ctx context.Context, repos repositories.TxRepositories) error {
+		return repos.Goals.Save(ctx, goal)
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	exists, err := NewPostgreSQLGoalRepository(db).Exists(ctx, goal.ID())
+	if err != nil {
+		t.Fatalf("Failed to check goal existence: %v", err)
+	}
+	if !exists {
+		t.Error("Expected goal to be committed")
+	}
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalFileStorage はローカルディスクにファイルを保存する ports.FileStorage の実装
+// 保存したファイルは baseURL 配下のURLで参照する（baseURL で baseDir を静的配信する前提）
+type LocalFileStorage struct {
+	baseDir string
+	baseURL string
+}
+
+// NewLocalFileStorage は新しいLocalFileStorageを作成する
+func NewLocalFileStorage(baseDir, baseURL string) (*LocalFileStorage, error) {
+	if baseDir == "" {
+		return nil, errors.New("保存先ディレクトリは必須です")
+	}
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return nil, fmt.Errorf("保存先ディレクトリの作成に失敗: %w", err)
+	}
+
+	return &LocalFileStorage{
+		baseDir: baseDir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+// Save はファイルを key で保存し、参照用のURLを返す
+func (s *LocalFileStorage) Save(_ context.Context, key string, _ string, data []byte) (string, error) {
+	filePath, err := s.pathFor(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return "", fmt.Errorf("保存先ディレクトリの作成に失敗: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return "", fmt.Errorf("ファイルの保存に失敗: %w", err)
+	}
+
+	return s.baseURL + "/" + filepath.ToSlash(filepath.Clean(key)), nil
+}
+
+// Delete は Save が返したURLのファイルを削除する
+// baseURL 配下でないURLや存在しないファイルの場合は何もしない
+func (s *LocalFileStorage) Delete(_ context.Context, url string) error {
+	key, ok := strings.CutPrefix(url, s.baseURL+"/")
+	if !ok {
+		return nil
+	}
+
+	filePath, err := s.pathFor(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("ファイルの削除に失敗: %w", err)
+	}
+	return nil
+}
+
+// pathFor は key に対応する保存先のパスを返す（baseDir の外を指す key は拒否する）
+func (s *LocalFileStorage) pathFor(key string) (string, error) {
+	cleaned := filepath.Clean(key)
+	if key == "" || filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("無効なファイルキーです: %s", key)
+	}
+	return filepath.Join(s.baseDir, cleaned), nil
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalFileStorage_SaveAndDelete(t *testing.T) {
+	ctx := context.Background()
+	tmpDir := t.TempDir()
+
+	storage, err := NewLocalFileStorage(tmpDir, "/uploads/")
+	if err != nil {
+		t.Fatalf("ストレージの作成に失敗: %v", err)
+	}
+
+	url, err := storage.Save(ctx, "goals/goal-001/image.png", "image/png", []byte("png"))
+	if err != nil {
+		t.Fatalf("ファイルの保存に失敗: %v", err)
+	}
+	if url != "/uploads/goals/goal-001/image.png" {
+		t.Errorf("URLが正しくありません: %s", url)
+	}
+
+	filePath := filepath.Join(tmpDir, "goals", "goal-001", "image.png")
+	if _, err := os.Stat(filePath); err != nil {
+		t.Fatalf("ファイルが保存されていません: %v", err)
+	}
+
+	if err := storage.Delete(ctx, url); err != nil {
+		t.Fatalf("ファイルの削除に失敗: %v", err)
+	}
+	if _, err := os.Stat(filePath); !os.IsNotExist(err) {
+		t.Error("ファイルが削除されていません")
+	}
+
+	// 削除済みのファイルや外部URLの削除はエラーにしない
+	if err := storage.Delete(ctx, url); err != nil {
+		t.Errorf("削除済みファイルの削除でエラー: %v", err)
+	}
+	if err := storage.Delete(ctx, "https://example.com/image.png"); err != nil {
+		t.Errorf("外部URLの削除でエラー: %v", err)
+	}
+}
+
+func TestLocalFileStorage_RejectsPathTraversal(t *testing.T) {
+	ctx := context.Background()
+	storage, err := NewLocalFileStorage(t.TempDir(), "/uploads")
+	if err != nil {
+		t.Fatalf("ストレージの作成に失敗: %v", err)
+	}
+
+	for _, key := range []string{"../outside.png", "/etc/passwd", ""} {
+		if _, err := storage.Save(ctx, key, "image/png", []byte("png")); err == nil {
+			t.Errorf("無効なキーが受け付けられました: %q", key)
+		}
+	}
+	if err := storage.Delete(ctx, "/uploads/../../outside.png"); err == nil {
+		t.Error("ディレクトリ外のファイル削除が受け付けられました")
+	}
+}
@@ -0,0 +1,143 @@
+package tokenblacklist
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+)
+
+// DefaultCleanupInterval は期限切れエントリを削除する既定の間隔
+const DefaultCleanupInterval = 10 * time.Minute
+
+// userRevocation はユーザー単位のトークンの失効
+type userRevocation struct {
+	issuedBefore time.Time // この日時より前に発行したトークンを失効させる
+	expiresAt    time.Time // 失効の対象になりうるトークンの有効期限の上限
+}
+
+// MemoryTokenBlacklist はプロセス内のメモリに保持する TokenBlacklist の実装
+// 複数インスタンスで運用する場合は Redis などの共有ストアの実装に差し替える
+type MemoryTokenBlacklist struct {
+	mu    sync.RWMutex
+	jtis  map[string]time.Time // jti → トークンの有効期限
+	users map[string]userRevocation
+	now   func() time.Time
+	stop  chan struct{}
+	once  sync.Once
+}
+
+var _ ports.TokenBlacklist = (*MemoryTokenBlacklist)(nil)
+
+// NewMemoryTokenBlacklist は cleanupInterval ごとに期限切れエントリを削除する MemoryTokenBlacklist を作成する
+func NewMemoryTokenBlacklist(cleanupInterval time.Duration) *MemoryTokenBlacklist {
+	b := newMemoryTokenBlacklist(time.Now)
+	go b.startCleanupRoutine(cleanupInterval)
+	return b
+}
+
+// newMemoryTokenBlacklist は現在時刻の取得方法を指定して MemoryTokenBlacklist を作成する（定期削除は開始しない）
+func newMemoryTokenBlacklist(now func() time.Time) *MemoryTokenBlacklist {
+	return &MemoryTokenBlacklist{
+		jtis:  make(map[string]time.Time),
+		users: make(map[string]userRevocation),
+		now:   now,
+		stop:  make(chan struct{}),
+	}
+}
+
+// Add は jti のトークンを expiresAt まで失効済みとして登録する（有効期限を過ぎている場合は登録しない）
+func (b *MemoryTokenBlacklist) Add(_ context.Context, jti string, expiresAt time.Time) error {
+	if !b.now().Before(expiresAt) {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if current, ok := b.jtis[jti]; !ok || expiresAt.After(current) {
+		b.jtis[jti] = expiresAt
+	}
+	return nil
+}
+
+// Contains は jti のトークンが失効済みかを返す
+func (b *MemoryTokenBlacklist) Contains(_ context.Context, jti string) (bool, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	expiresAt, ok := b.jtis[jti]
+	return ok && b.now().Before(expiresAt), nil
+}
+
+// RevokeUserTokens はユーザーに issuedBefore より前に発行したトークンを expiresAt まで失効させる
+// 既に失効させている場合は境界・期限とも遅い方を採用する
+func (b *MemoryTokenBlacklist) RevokeUserTokens(_ context.Context, userID string, issuedBefore, expiresAt time.Time) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	revocation := b.users[userID]
+	if issuedBefore.After(revocation.issuedBefore) {
+		revocation.issuedBefore = issuedBefore
+	}
+	if expiresAt.After(revocation.expiresAt) {
+		revocation.expiresAt = expiresAt
+	}
+	b.users[userID] = revocation
+	return nil
+}
+
+// UserTokensRevokedBefore はユーザーのトークンを失効させた発行日時の境界を返す（失効させていない場合はゼロ値）
+func (b *MemoryTokenBlacklist) UserTokensRevokedBefore(_ context.Context, userID string) (time.Time, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	revocation, ok := b.users[userID]
+	if !ok || !b.now().Before(revocation.expiresAt) {
+		return time.Time{}, nil
+	}
+	return revocation.issuedBefore, nil
+}
+
+// Close は期限切れエントリの定期削除を停止する
+func (b *MemoryTokenBlacklist) Close() {
+	b.once.Do(func() { close(b.stop) })
+}
+
+// Len は保持しているエントリ数を返す
+func (b *MemoryTokenBlacklist) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.jtis) + len(b.users)
+}
+
+// startCleanupRoutine は定期的に期限切れエントリを削除する
+func (b *MemoryTokenBlacklist) startCleanupRoutine(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.cleanupExpired()
+		case <-b.stop:
+			return
+		}
+	}
+}
+
+// cleanupExpired は有効期限を過ぎたエントリを削除する
+func (b *MemoryTokenBlacklist) cleanupExpired() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	for jti, expiresAt := range b.jtis {
+		if !now.Before(expiresAt) {
+			delete(b.jtis, jti)
+		}
+	}
+	for userID, revocation := range b.users {
+		if !now.Before(revocation.expiresAt) {
+			delete(b.users, userID)
+		}
+	}
+}
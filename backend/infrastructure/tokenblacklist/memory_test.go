@@ -0,0 +1,110 @@
+package tokenblacklist
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// fakeClock はテストで現在時刻を進めるための時計
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func TestMemoryTokenBlacklist_Add(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	b := newMemoryTokenBlacklist(clock.Now)
+
+	if err := b.Add(ctx, "jti-1", clock.now.Add(15*time.Minute)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := b.Add(ctx, "jti-expired", clock.now.Add(-time.Second)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if revoked, _ := b.Contains(ctx, "jti-1"); !revoked {
+		t.Error("登録したトークンは失効済みであるべきです")
+	}
+	if revoked, _ := b.Contains(ctx, "jti-2"); revoked {
+		t.Error("登録していないトークンは失効済みであるべきではありません")
+	}
+	if b.Len() != 1 {
+		t.Errorf("有効期限を過ぎたトークンは登録しないべきです: got %d entries", b.Len())
+	}
+
+	// 有効期限を過ぎるとトークン自体が無効になるため、ブラックリストからも外れる
+	clock.now = clock.now.Add(15 * time.Minute)
+	if revoked, _ := b.Contains(ctx, "jti-1"); revoked {
+		t.Error("有効期限を過ぎたエントリは失効済みとして扱わないべきです")
+	}
+}
+
+func TestMemoryTokenBlacklist_RevokeUserTokens(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	b := newMemoryTokenBlacklist(clock.Now)
+
+	if before, _ := b.UserTokensRevokedBefore(ctx, "user-1"); !before.IsZero() {
+		t.Errorf("失効させていないユーザーはゼロ値を返すべきです: %v", before)
+	}
+
+	revokedAt := clock.now
+	if err := b.RevokeUserTokens(ctx, "user-1", revokedAt, revokedAt.Add(15*time.Minute)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// 古い境界で再度失効させても境界は戻らない
+	if err := b.RevokeUserTokens(ctx, "user-1", revokedAt.Add(-time.Hour), revokedAt.Add(time.Minute)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if before, _ := b.UserTokensRevokedBefore(ctx, "user-1"); !before.Equal(revokedAt) {
+		t.Errorf("境界が期待値と異なります: got %v, want %v", before, revokedAt)
+	}
+	if before, _ := b.UserTokensRevokedBefore(ctx, "user-2"); !before.IsZero() {
+		t.Errorf("他のユーザーには影響しないべきです: %v", before)
+	}
+
+	clock.now = clock.now.Add(15 * time.Minute)
+	if before, _ := b.UserTokensRevokedBefore(ctx, "user-1"); !before.IsZero() {
+		t.Errorf("期限を過ぎた失効はゼロ値を返すべきです: %v", before)
+	}
+}
+
+func TestMemoryTokenBlacklist_CleanupExpired(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	b := newMemoryTokenBlacklist(clock.Now)
+
+	_ = b.Add(ctx, "short", clock.now.Add(time.Minute))
+	_ = b.Add(ctx, "long", clock.now.Add(time.Hour))
+	_ = b.RevokeUserTokens(ctx, "user-1", clock.now, clock.now.Add(time.Minute))
+
+	clock.now = clock.now.Add(2 * time.Minute)
+	b.cleanupExpired()
+
+	if b.Len() != 1 {
+		t.Fatalf("期限切れエントリは削除されるべきです: got %d entries", b.Len())
+	}
+	if revoked, _ := b.Contains(ctx, "long"); !revoked {
+		t.Error("期限内のエントリは残るべきです")
+	}
+}
+
+func TestNewMemoryTokenBlacklist_CleanupRoutine(t *testing.T) {
+	b := NewMemoryTokenBlacklist(10 * time.Millisecond)
+	defer b.Close()
+
+	ctx := context.Background()
+	_ = b.Add(ctx, "jti-1", time.Now().Add(20*time.Millisecond))
+
+	deadline := time.Now().Add(time.Second)
+	for b.Len() > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if b.Len() != 0 {
+		t.Error("期限切れエントリは定期的に自動削除されるべきです")
+	}
+}
@@ -0,0 +1,77 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHeader はAPIキーを指定するリクエストヘッダー名
+const APIKeyHeader = "X-API-Key"
+
+// apiKeyForbiddenPaths はAPIキーでは利用できないエンドポイントのパス接頭辞
+// キー自体の管理や認証設定の変更、アカウント削除、管理者向けの操作はJWT認証でのみ許可する
+var apiKeyForbiddenPaths = []string{
+	APIV1Path + "/admin",
+	APIV1Path + "/api-keys",
+	APIV1Path + "/auth",
+	APIV1Path + "/users",
+}
+
+// APIKeyOrJWTAuthMiddleware はAPIキー認証とJWT認証を併用するミドルウェア
+// X-API-Key ヘッダーがある場合はAPIキーで認証してスコープを確認し、ない場合は jwtMiddleware に委譲する
+func APIKeyOrJWTAuthMiddleware(jwtMiddleware echo.MiddlewareFunc, apiKeyUseCase usecases.ManageAPIKeysUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		jwtNext := jwtMiddleware(next)
+
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(APIKeyHeader)
+			if key == "" {
+				return jwtNext(c)
+			}
+
+			apiKey, err := apiKeyUseCase.AuthenticateAPIKey(c.Request().Context(), key)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "無効または失効したAPIキーです")
+			}
+
+			requiredScope, allowed := requiredAPIKeyScope(c.Request().Method, c.Request().URL.Path)
+			if !allowed {
+				return echo.NewHTTPError(http.StatusForbidden, "このエンドポイントはAPIキーでは利用できません")
+			}
+			if !apiKey.HasScope(requiredScope) {
+				return echo.NewHTTPError(http.StatusForbidden, "APIキーのスコープが不足しています: "+string(requiredScope))
+			}
+
+			// ユーザー情報をコンテキストに保存
+			c.Set("user_id", apiKey.UserID().String())
+			c.Set("api_key_id", apiKey.ID().String())
+
+			return next(c)
+		}
+	}
+}
+
+// requiredAPIKeyScope はリクエストに必要なAPIキーのスコープを返す
+// APIキーで利用できないエンドポイントの場合は false を返す
+func requiredAPIKeyScope(method, path string) (entities.APIKeyScope, bool) {
+	for _, prefix := range apiKeyForbiddenPaths {
+		if strings.HasPrefix(path, prefix) {
+			return "", false
+		}
+	}
+
+	if strings.HasPrefix(path, APIV1Path+"/reports") {
+		return entities.APIKeyScopeReports, true
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return entities.APIKeyScopeReadOnly, true
+	default:
+		return entities.APIKeyScopeWrite, true
+	}
+}
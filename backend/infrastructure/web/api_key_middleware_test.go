@@ -0,0 +1,90 @@
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAPIKeyUseCase は登録済みの平文キーだけを認証するテスト用ユースケース
+type stubAPIKeyUseCase struct {
+	usecases.ManageAPIKeysUseCase
+	keys map[string]*entities.APIKey
+}
+
+func (s *stubAPIKeyUseCase) AuthenticateAPIKey(_ context.Context, key string) (*entities.APIKey, error) {
+	apiKey, ok := s.keys[key]
+	if !ok || !apiKey.VerifyKey(key) {
+		return nil, usecases.ErrInvalidAPIKey
+	}
+	return apiKey, nil
+}
+
+func TestAPIKeyOrJWTAuthMiddleware(t *testing.T) {
+	readOnlyKey, readOnlyPlain, err := entities.NewAPIKey("user-001", "閲覧用", []entities.APIKeyScope{entities.APIKeyScopeReadOnly})
+	require.NoError(t, err)
+	reportsKey, reportsPlain, err := entities.NewAPIKey("user-001", "レポート用", []entities.APIKeyScope{entities.APIKeyScopeReports})
+	require.NoError(t, err)
+	revokedKey, revokedPlain, err := entities.NewAPIKey("user-001", "失効済み", []entities.APIKeyScope{entities.APIKeyScopeWrite})
+	require.NoError(t, err)
+	require.NoError(t, revokedKey.Revoke())
+
+	stub := &stubAPIKeyUseCase{keys: map[string]*entities.APIKey{
+		readOnlyPlain: readOnlyKey,
+		reportsPlain:  reportsKey,
+		revokedPlain:  revokedKey,
+	}}
+
+	e := echo.New()
+	handler := APIKeyOrJWTAuthMiddleware(JWTAuthMiddleware(nil), stub)(func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Get("user_id").(string))
+	})
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		key            string
+		expectedStatus int
+	}{
+		{"読み取り専用キーでGET", http.MethodGet, "/api/v1/financial-data/user-001", readOnlyPlain, http.StatusOK},
+		{"読み取り専用キーでPUTは拒否", http.MethodPut, "/api/v1/financial-data/user-001/profile", readOnlyPlain, http.StatusForbidden},
+		{"読み取り専用キーでレポートは拒否", http.MethodPost, "/api/v1/reports/financial-summary", readOnlyPlain, http.StatusForbidden},
+		{"レポートキーでレポート生成", http.MethodPost, "/api/v1/reports/financial-summary", reportsPlain, http.StatusOK},
+		{"レポートキーで財務データ参照は拒否", http.MethodGet, "/api/v1/financial-data/user-001", reportsPlain, http.StatusForbidden},
+		{"APIキー管理はAPIキーで利用不可", http.MethodGet, "/api/v1/api-keys", readOnlyPlain, http.StatusForbidden},
+		{"アカウント管理はAPIキーで利用不可", http.MethodGet, "/api/v1/users/user-001/deletion-preview", readOnlyPlain, http.StatusForbidden},
+		{"失効したキー", http.MethodGet, "/api/v1/financial-data/user-001", revokedPlain, http.StatusUnauthorized},
+		{"未登録のキー", http.MethodGet, "/api/v1/financial-data/user-001", "fpc_unknown", http.StatusUnauthorized},
+		{"キーなしはJWT認証に委譲", http.MethodGet, "/api/v1/financial-data/user-001", "", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.key != "" {
+				req.Header.Set(APIKeyHeader, tt.key)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := handler(c)
+			if tt.expectedStatus == http.StatusOK {
+				require.NoError(t, err)
+				assert.Equal(t, "user-001", rec.Body.String())
+				return
+			}
+
+			httpErr, ok := err.(*echo.HTTPError)
+			require.True(t, ok, "echo.HTTPError が返るべき: %v", err)
+			assert.Equal(t, tt.expectedStatus, httpErr.Code)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package web
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// APIのバージョンとパス
+// ルートはすべて APIV1Path 以下にマウントし、バージョンなしの /api 以下は移行期間中のみ転送する
+const (
+	APIBasePath       = "/api"
+	CurrentAPIVersion = "v1"
+	APIV1Path         = APIBasePath + "/" + CurrentAPIVersion
+	// APIVersionsPath はサポートしているバージョンの一覧を返すエンドポイント（バージョンに依存しないため転送しない）
+	APIVersionsPath = APIBasePath + "/versions"
+)
+
+// APIDeprecatedHeader はバージョンなしのパスから転送したレスポンスに付与するヘッダー名
+const APIDeprecatedHeader = "X-API-Deprecated"
+
+// supportedAPIVersions はサポートしているAPIのバージョン（古い順）
+var supportedAPIVersions = []string{CurrentAPIVersion}
+
+// deprecatedAPIVersions は廃止予定のAPIのバージョン
+var deprecatedAPIVersions = []string{}
+
+// APIVersionsResponse はAPIのバージョン一覧のレスポンス
+type APIVersionsResponse struct {
+	Versions   []string `json:"versions"`
+	Current    string   `json:"current"`
+	Deprecated []string `json:"deprecated"`
+}
+
+// APIVersionsHandler はサポートしているAPIのバージョンと現在のバージョン、廃止予定のバージョンを返す
+func APIVersionsHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, APIVersionsResponse{
+		Versions:   supportedAPIVersions,
+		Current:    CurrentAPIVersion,
+		Deprecated: deprecatedAPIVersions,
+	})
+}
+
+// LegacyAPIRedirectMiddleware はバージョンなしの /api 以下へのリクエストを現在のバージョンのパスへ転送する（e.Pre で登録する）
+// GET・HEAD は 301、ボディを伴うメソッドはメソッドとボディを保ったまま転送されるよう 308 で転送し、
+// いずれも X-API-Deprecated: true を付与する。CORSのプリフライトは転送するとブラウザが失敗するため転送しない
+func LegacyAPIRedirectMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if req.Method == http.MethodOptions || !isLegacyAPIPath(req.URL.Path) {
+				return next(c)
+			}
+
+			target := APIV1Path + strings.TrimPrefix(req.URL.Path, APIBasePath)
+			if req.URL.RawQuery != "" {
+				target += "?" + req.URL.RawQuery
+			}
+
+			status := http.StatusPermanentRedirect
+			if req.Method == http.MethodGet || req.Method == http.MethodHead {
+				status = http.StatusMovedPermanently
+			}
+
+			c.Response().Header().Set(APIDeprecatedHeader, "true")
+			return c.Redirect(status, target)
+		}
+	}
+}
+
+// isLegacyAPIPath はバージョンを含まない /api 以下のパスかを返す
+func isLegacyAPIPath(path string) bool {
+	if path != APIBasePath && !strings.HasPrefix(path, APIBasePath+"/") {
+		return false
+	}
+	if path == APIVersionsPath {
+		return false
+	}
+	for _, version := range supportedAPIVersions {
+		versionPath := APIBasePath + "/" + version
+		if path == versionPath || strings.HasPrefix(path, versionPath+"/") {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// newVersioningTestEcho は SetupRoutes でルートを登録したEchoを作成するヘルパー
+func newVersioningTestEcho(legacyRedirectEnabled bool) *echo.Echo {
+	e := echo.New()
+	deps := &ServerDependencies{
+		SkipAuth: true,
+		ServerConfig: &config.ServerConfig{
+			AuthRateLimitRPS:      10,
+			AuthRateLimitBurst:    5,
+			LegacyRedirectEnabled: legacyRedirectEnabled,
+		},
+	}
+	SetupRoutes(e, &Controllers{}, deps, NewCustomRateLimiterStore(100, 50, 3*time.Minute))
+	return e
+}
+
+func TestSetupRoutes_APIVersioning(t *testing.T) {
+	t.Run("正常系: /api/v1/health で応答する", func(t *testing.T) {
+		e := newVersioningTestEcho(true)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(APIDeprecatedHeader))
+	})
+
+	t.Run("正常系: 転送が有効な場合は /api/health を /api/v1/health へ301で転送する", func(t *testing.T) {
+		e := newVersioningTestEcho(true)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/health", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+		assert.Equal(t, "/api/v1/health", rec.Header().Get(echo.HeaderLocation))
+		assert.Equal(t, "true", rec.Header().Get(APIDeprecatedHeader))
+	})
+
+	t.Run("正常系: 転送が無効な場合は /api/health を転送しない", func(t *testing.T) {
+		e := newVersioningTestEcho(false)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/health", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		// CORSプリフライト用の OPTIONS /* のみに一致するため 405 になる
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		assert.Empty(t, rec.Header().Get(echo.HeaderLocation))
+		assert.Empty(t, rec.Header().Get(APIDeprecatedHeader))
+	})
+
+	t.Run("正常系: /api/versions でサポートしているバージョンを返す", func(t *testing.T) {
+		e := newVersioningTestEcho(true)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/versions", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"versions":["v1"],"current":"v1","deprecated":[]}`, rec.Body.String())
+	})
+}
+
+func TestLegacyAPIRedirectMiddleware(t *testing.T) {
+	tests := []struct {
+		name             string
+		method           string
+		target           string
+		expectedStatus   int
+		expectedLocation string
+	}{
+		{
+			name:             "正常系: GETはクエリを保って301で転送する",
+			method:           http.MethodGet,
+			target:           "/api/goals?user_id=abc",
+			expectedStatus:   http.StatusMovedPermanently,
+			expectedLocation: "/api/v1/goals?user_id=abc",
+		},
+		{
+			name:             "正常系: POSTはメソッドとボディを保つため308で転送する",
+			method:           http.MethodPost,
+			target:           "/api/financial-data",
+			expectedStatus:   http.StatusPermanentRedirect,
+			expectedLocation: "/api/v1/financial-data",
+		},
+		{
+			name:             "正常系: /api は /api/v1 へ転送する",
+			method:           http.MethodGet,
+			target:           "/api",
+			expectedStatus:   http.StatusMovedPermanently,
+			expectedLocation: "/api/v1",
+		},
+		{
+			name:           "正常系: バージョン付きのパスは転送しない",
+			method:         http.MethodGet,
+			target:         "/api/v1/goals",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "正常系: バージョン一覧は転送しない",
+			method:         http.MethodGet,
+			target:         "/api/versions",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "正常系: CORSのプリフライトは転送しない",
+			method:         http.MethodOptions,
+			target:         "/api/goals",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "正常系: /api 以外のパスは転送しない",
+			method:         http.MethodGet,
+			target:         "/apix/goals",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Pre(LegacyAPIRedirectMiddleware())
+			e.Any("/*", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedLocation, rec.Header().Get(echo.HeaderLocation))
+			if tt.expectedLocation != "" {
+				assert.Equal(t, "true", rec.Header().Get(APIDeprecatedHeader))
+			} else {
+				assert.Empty(t, rec.Header().Get(APIDeprecatedHeader))
+			}
+		})
+	}
+}
+
+func TestAPIVersionsHandler(t *testing.T) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/versions", nil)
+	rec := httptest.NewRecorder()
+
+	err := APIVersionsHandler(e.NewContext(req, rec))
+
+	assert.NoError(t, err)
+	var body APIVersionsResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, []string{"v1"}, body.Versions)
+	assert.Equal(t, "v1", body.Current)
+	assert.NotNil(t, body.Deprecated)
+}
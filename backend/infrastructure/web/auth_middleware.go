@@ -1,6 +1,7 @@
 package web
 
 import (
+	"log/slog"
 	"net/http"
 	"strings"
 
@@ -12,7 +13,14 @@ import (
 // JWTAuthMiddleware はJWT認証ミドルウェア
 // Cookieからトークンを取得し、なければAuthorizationヘッダーから取得（後方互換性のため）
 // 2FA仮トークン（Requires2FA: true）は2FA検証エンドポイントのみで許可される
+// なりすましのトークンは監査ログに記録できないため受け付けない
 func JWTAuthMiddleware(authUseCase usecases.AuthUseCase) echo.MiddlewareFunc {
+	return JWTAuthMiddlewareWithImpersonation(authUseCase, nil)
+}
+
+// JWTAuthMiddlewareWithImpersonation は管理者によるなりすましのトークンも受け付けるJWT認証ミドルウェア
+// なりすまし中のリクエストはすべて監査ログに記録し、参照系（GET）とログアウト以外は 403 を返す
+func JWTAuthMiddlewareWithImpersonation(authUseCase usecases.AuthUseCase, impersonationUseCase usecases.ImpersonationUseCase) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			var tokenString string
@@ -50,12 +58,23 @@ func JWTAuthMiddleware(authUseCase usecases.AuthUseCase) echo.MiddlewareFunc {
 			// 2FA仮トークンの場合、2FA検証エンドポイントのみ許可
 			if claims.Requires2FA || claims.TwoFactorVerify {
 				path := c.Request().URL.Path
-				// /api/auth/2fa/verify のみ許可
+				// /api/v1/auth/2fa/verify のみ許可
 				if !strings.HasSuffix(path, "/auth/2fa/verify") {
 					return echo.NewHTTPError(http.StatusUnauthorized, "2段階認証の検証が必要です")
 				}
 			}
 
+			// クレームのユーザーIDがUUID v4形式であることを確認する
+			if _, err := entities.NewUserID(claims.UserID); err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "無効または期限切れの認証トークンです")
+			}
+
+			if claims.Impersonated {
+				if err := authorizeImpersonatedRequest(c, impersonationUseCase, claims); err != nil {
+					return err
+				}
+			}
+
 			// ユーザー情報をコンテキストに保存
 			c.Set("user_id", claims.UserID)
 			c.Set("email", claims.Email)
@@ -65,6 +84,38 @@ func JWTAuthMiddleware(authUseCase usecases.AuthUseCase) echo.MiddlewareFunc {
 	}
 }
 
+// authorizeImpersonatedRequest はなりすまし中のリクエストを監査ログに記録し、更新系の操作を拒否する
+// 監査ログに記録できない場合はリクエストを処理しない
+func authorizeImpersonatedRequest(c echo.Context, impersonationUseCase usecases.ImpersonationUseCase, claims *usecases.TokenClaims) error {
+	if impersonationUseCase == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "なりすましのトークンは利用できません")
+	}
+
+	req := c.Request()
+	if err := impersonationUseCase.RecordRequest(req.Context(), claims, req.Method, req.URL.Path); err != nil {
+		slog.ErrorContext(req.Context(), "なりすまし中のリクエストを監査ログに記録できませんでした",
+			slog.String("error", err.Error()),
+		)
+		return echo.NewHTTPError(http.StatusInternalServerError, "監査ログの記録に失敗しました")
+	}
+
+	if !impersonationAllowed(req.Method, req.URL.Path) {
+		return echo.NewHTTPError(http.StatusForbidden, "なりすまし中は参照以外の操作を実行できません")
+	}
+
+	c.Set("impersonator_id", claims.ImpersonatorID)
+	return nil
+}
+
+// impersonationAllowed はなりすまし中に許可する操作かどうかを返す（参照系とログアウトのみ）
+func impersonationAllowed(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	}
+	return method == http.MethodPost && strings.HasSuffix(path, "/auth/logout")
+}
+
 // GetUserIDFromContext はコンテキストからユーザーIDを取得する
 func GetUserIDFromContext(c echo.Context) (entities.UserID, error) {
 	userID, ok := c.Get("user_id").(string)
@@ -29,7 +29,7 @@ func TestAuthRateLimiterMiddleware_AllowsWithinLimit(t *testing.T) {
 	e := echo.New()
 	authLimiter := AuthRateLimiterMiddleware(cfg)
 
-	e.POST("/api/auth/login", func(c echo.Context) error {
+	e.POST("/api/v1/auth/login", func(c echo.Context) error {
 		return c.String(http.StatusOK, "OK")
 	}, authLimiter)
 
@@ -38,7 +38,7 @@ func TestAuthRateLimiterMiddleware_AllowsWithinLimit(t *testing.T) {
 
 	// バースト内のリクエストは全て成功するはず
 	for i := 0; i < 5; i++ {
-		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 		req.Header.Set("X-Forwarded-For", clientIP)
 		rec := httptest.NewRecorder()
 		e.ServeHTTP(rec, req)
@@ -55,7 +55,7 @@ func TestAuthRateLimiterMiddleware_BlocksExcessiveRequests(t *testing.T) {
 	e := echo.New()
 	authLimiter := AuthRateLimiterMiddleware(cfg)
 
-	e.POST("/api/auth/login", func(c echo.Context) error {
+	e.POST("/api/v1/auth/login", func(c echo.Context) error {
 		return c.String(http.StatusOK, "OK")
 	}, authLimiter)
 
@@ -64,7 +64,7 @@ func TestAuthRateLimiterMiddleware_BlocksExcessiveRequests(t *testing.T) {
 
 	// バースト + レートを超えるリクエストを送信
 	for i := 0; i < 10; i++ {
-		req := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 		req.Header.Set("X-Forwarded-For", clientIP)
 		rec := httptest.NewRecorder()
 		e.ServeHTTP(rec, req)
@@ -97,7 +97,7 @@ func TestAuthRateLimiterMiddleware_DifferentIPsAreSeparate(t *testing.T) {
 	e := echo.New()
 	authLimiter := AuthRateLimiterMiddleware(cfg)
 
-	e.POST("/api/auth/login", func(c echo.Context) error {
+	e.POST("/api/v1/auth/login", func(c echo.Context) error {
 		return c.String(http.StatusOK, "OK")
 	}, authLimiter)
 
@@ -106,21 +106,21 @@ func TestAuthRateLimiterMiddleware_DifferentIPsAreSeparate(t *testing.T) {
 	ip2 := uniqueIP("sep-ip2")
 
 	// IP1: 最初のリクエストは成功
-	req1 := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	req1 := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 	req1.Header.Set("X-Forwarded-For", ip1)
 	rec1 := httptest.NewRecorder()
 	e.ServeHTTP(rec1, req1)
 	assert.Equal(t, http.StatusOK, rec1.Code)
 
 	// IP2: 別のIPも成功（別カウント）
-	req2 := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	req2 := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 	req2.Header.Set("X-Forwarded-For", ip2)
 	rec2 := httptest.NewRecorder()
 	e.ServeHTTP(rec2, req2)
 	assert.Equal(t, http.StatusOK, rec2.Code)
 
 	// IP1: 2回目は制限される
-	req3 := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	req3 := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 	req3.Header.Set("X-Forwarded-For", ip1)
 	rec3 := httptest.NewRecorder()
 	e.ServeHTTP(rec3, req3)
@@ -136,11 +136,11 @@ func TestAuthRateLimiterMiddleware_ReturnsAuthRateLimitHeaders(t *testing.T) {
 	e := echo.New()
 	authLimiter := AuthRateLimiterMiddleware(cfg)
 
-	e.POST("/api/auth/login", func(c echo.Context) error {
+	e.POST("/api/v1/auth/login", func(c echo.Context) error {
 		return c.String(http.StatusOK, "OK")
 	}, authLimiter)
 
-	req := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 	req.Header.Set("X-Forwarded-For", uniqueIP("headers"))
 	rec := httptest.NewRecorder()
 	e.ServeHTTP(rec, req)
@@ -167,26 +167,26 @@ func TestAuthRateLimiterMiddleware_NonAuthEndpointsUnaffected(t *testing.T) {
 	authLimiter := AuthRateLimiterMiddleware(cfg)
 
 	// 認証エンドポイントのみにミドルウェアを適用
-	e.POST("/api/auth/login", func(c echo.Context) error {
+	e.POST("/api/v1/auth/login", func(c echo.Context) error {
 		return c.String(http.StatusOK, "OK")
 	}, authLimiter)
 
 	// 非認証エンドポイント（ミドルウェアなし）
-	e.GET("/api/financial-data", func(c echo.Context) error {
+	e.GET("/api/v1/financial-data", func(c echo.Context) error {
 		return c.String(http.StatusOK, "Financial Data")
 	})
 
 	clientIP := uniqueIP("non-auth")
 
 	// 認証エンドポイントのバーストを使い切る
-	req1 := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	req1 := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 	req1.Header.Set("X-Forwarded-For", clientIP)
 	rec1 := httptest.NewRecorder()
 	e.ServeHTTP(rec1, req1)
 	assert.Equal(t, http.StatusOK, rec1.Code)
 
 	// 認証エンドポイントの2回目は制限される
-	req2 := httptest.NewRequest(http.MethodPost, "/api/auth/login", nil)
+	req2 := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
 	req2.Header.Set("X-Forwarded-For", clientIP)
 	rec2 := httptest.NewRecorder()
 	e.ServeHTTP(rec2, req2)
@@ -194,7 +194,7 @@ func TestAuthRateLimiterMiddleware_NonAuthEndpointsUnaffected(t *testing.T) {
 
 	// 非認証エンドポイントは影響を受けない
 	for i := 0; i < 5; i++ {
-		req := httptest.NewRequest(http.MethodGet, "/api/financial-data", nil)
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/financial-data", nil)
 		req.Header.Set("X-Forwarded-For", clientIP)
 		rec := httptest.NewRecorder()
 		e.ServeHTTP(rec, req)
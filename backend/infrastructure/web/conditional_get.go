@@ -0,0 +1,127 @@
+package web
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+
+	// etagCacheControl はETag付きレスポンスのキャッシュ方針（ユーザー固有のため共有キャッシュには載せず、利用のたびに再検証させる）
+	etagCacheControl = "private, must-revalidate"
+)
+
+// ETagger はレスポンス本文からETagを計算する
+type ETagger interface {
+	// ComputeETag は本文から引用符付きのETagを返す
+	ComputeETag(body []byte) string
+}
+
+// SHA256ETagger はレスポンス本文のSHA-256ハッシュから強いETagを作成する
+type SHA256ETagger struct{}
+
+// ComputeETag は本文のSHA-256ハッシュから強いETagを返す
+// 本文そのものから計算するため、レスポンスに含まれる値が1つでも変われば必ず変わる
+func (SHA256ETagger) ComputeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// ConditionalGetMiddleware はGETの成功レスポンスにETagを付け、If-None-Match が一致する場合は 304 Not Modified を返すミドルウェア
+// レスポンスをバッファしてから本文のETagを計算するため、ポーリングされる小さなJSONレスポンスのルートにのみ適用する
+// ハンドラーが設定した Last-Modified はそのまま返す
+func ConditionalGetMiddleware(etagger ETagger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if c.Request().Method != http.MethodGet {
+				return next(c)
+			}
+
+			res := c.Response()
+			original := res.Writer
+			buffer := &bufferedResponseWriter{header: original.Header(), status: http.StatusOK}
+			res.Writer = buffer
+			err := next(c)
+			res.Writer = original
+
+			if err != nil || buffer.status != http.StatusOK {
+				return buffer.flushTo(original, err)
+			}
+
+			etag := etagger.ComputeETag(buffer.body.Bytes())
+			header := original.Header()
+			header.Set(headerETag, etag)
+			header.Set(echo.HeaderCacheControl, etagCacheControl)
+
+			if etagMatches(c.Request().Header.Get(headerIfNoneMatch), etag) {
+				header.Del(echo.HeaderContentType)
+				header.Del(echo.HeaderContentLength)
+				res.Status = http.StatusNotModified
+				original.WriteHeader(http.StatusNotModified)
+				return nil
+			}
+			return buffer.flushTo(original, nil)
+		}
+	}
+}
+
+// bufferedResponseWriter はステータスと本文をバッファする http.ResponseWriter
+// ヘッダーは元の ResponseWriter と共有する
+type bufferedResponseWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.status = status
+	w.wroteHeader = true
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.body.Write(b)
+}
+
+// flushTo はバッファしたレスポンスを元の ResponseWriter に書き込む
+// ハンドラーがエラーを返して何も書き込んでいない場合は、エラーハンドラーに任せるため何もしない
+func (w *bufferedResponseWriter) flushTo(original http.ResponseWriter, err error) error {
+	if !w.wroteHeader {
+		return err
+	}
+	original.WriteHeader(w.status)
+	if _, writeErr := original.Write(w.body.Bytes()); writeErr != nil && err == nil {
+		return writeErr
+	}
+	return err
+}
+
+// etagMatches は If-None-Match ヘッダーが etag に一致するかを返す
+// If-None-Match は弱い比較のため W/ 付きのETagも一致とみなす（RFC 9110 13.1.2）
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEtagMatches(t *testing.T) {
+	etag := SHA256ETagger{}.ComputeETag([]byte(`{"user_id":"4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}`))
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		expected    bool
+	}{
+		{"未指定", "", false},
+		{"一致", etag, true},
+		{"弱いETagも一致とみなす", "W/" + etag, true},
+		{"複数指定のいずれかに一致", `"other", ` + etag, true},
+		{"ワイルドカード", "*", true},
+		{"不一致", `"other"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, etagMatches(tt.ifNoneMatch, etag))
+		})
+	}
+
+	t.Run("本文が変わればETagも変わる", func(t *testing.T) {
+		assert.NotEqual(t, etag, SHA256ETagger{}.ComputeETag([]byte(`{"user_id":"other"}`)))
+	})
+}
+
+func TestConditionalGetMiddleware(t *testing.T) {
+	e := echo.New()
+	body := map[string]string{"status": "ok"}
+	serve := func(method, ifNoneMatch string, handler echo.HandlerFunc) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(method, "/api/v1/resource", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set(headerIfNoneMatch, ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		err := ConditionalGetMiddleware(SHA256ETagger{})(handler)(e.NewContext(req, rec))
+		return rec, err
+	}
+	ok := func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderLastModified, "Wed, 01 Apr 2026 00:00:00 GMT")
+		return c.JSON(http.StatusOK, body)
+	}
+
+	t.Run("ETagとCache-Controlを付けて本文を返す", func(t *testing.T) {
+		rec, err := serve(http.MethodGet, "", ok)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, SHA256ETagger{}.ComputeETag(rec.Body.Bytes()), rec.Header().Get(headerETag))
+		assert.Equal(t, etagCacheControl, rec.Header().Get(echo.HeaderCacheControl))
+		assert.Equal(t, "Wed, 01 Apr 2026 00:00:00 GMT", rec.Header().Get(echo.HeaderLastModified))
+		assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+	})
+
+	t.Run("If-None-Matchが一致する場合は本文なしの304", func(t *testing.T) {
+		first, _ := serve(http.MethodGet, "", ok)
+		rec, err := serve(http.MethodGet, first.Header().Get(headerETag), ok)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.String())
+		assert.Equal(t, first.Header().Get(headerETag), rec.Header().Get(headerETag))
+		assert.Empty(t, rec.Header().Get(echo.HeaderContentType))
+	})
+
+	t.Run("エラーレスポンスにはETagを付けない", func(t *testing.T) {
+		rec, err := serve(http.MethodGet, "*", func(c echo.Context) error {
+			return c.JSON(http.StatusNotFound, body)
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, rec.Header().Get(headerETag))
+		assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+	})
+
+	t.Run("ハンドラーのエラーはそのまま返す", func(t *testing.T) {
+		rec, err := serve(http.MethodGet, "", func(c echo.Context) error {
+			return echo.NewHTTPError(http.StatusUnauthorized)
+		})
+
+		require.Error(t, err)
+		assert.False(t, rec.Flushed)
+		assert.Empty(t, rec.Header().Get(headerETag))
+	})
+
+	t.Run("GET以外は対象外", func(t *testing.T) {
+		rec, err := serve(http.MethodPost, "*", ok)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(headerETag))
+	})
+}
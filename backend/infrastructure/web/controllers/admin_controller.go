@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// AdminController は管理者向け（サポート用）のコントローラー
+type AdminController struct {
+	impersonationUseCase usecases.ImpersonationUseCase
+}
+
+// NewAdminController は新しいAdminControllerを作成する
+func NewAdminController(impersonationUseCase usecases.ImpersonationUseCase) *AdminController {
+	return &AdminController{
+		impersonationUseCase: impersonationUseCase,
+	}
+}
+
+// ImpersonateRequest はなりすましセッション発行リクエスト
+type ImpersonateRequest struct {
+	UserID string `json:"user_id" validate:"required"`
+	Reason string `json:"reason" validate:"required,safetext,notblank,max=500"`
+}
+
+// Impersonate は対象ユーザーとして閲覧するためのなりすましセッションを発行する
+// @Summary なりすましセッション発行
+// @Description サポート用に対象ユーザーとして閲覧するためのアクセストークン（15分間有効・リフレッシュ不可）を発行します。管理者権限と理由の入力が必要で、発行と以降のリクエストはすべて監査ログに記録されます。トークンでは参照系のAPIのみ利用できます
+// @Tags admin
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body ImpersonateRequest true "なりすましセッション発行リクエスト"
+// @Success 201 {object} usecases.ImpersonateOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /admin/impersonate [post]
+func (c *AdminController) Impersonate(ctx echo.Context) error {
+	adminUserID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req ImpersonateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	targetUserID, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.impersonationUseCase.Impersonate(ctx.Request().Context(), usecases.ImpersonateInput{
+		AdminUserID:  entities.UserID(adminUserID),
+		TargetUserID: targetUserID,
+		Reason:       req.Reason,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *AdminController) handleError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecases.ErrImpersonationForbidden):
+		return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, err.Error(), nil))
+	case errors.Is(err, usecases.ErrImpersonationReasonRequired), errors.Is(err, usecases.ErrImpersonationSelf):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	case strings.Contains(err.Error(), "対象ユーザーが見つかりません"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "ユーザー"))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+}
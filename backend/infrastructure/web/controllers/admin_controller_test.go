@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockImpersonationUseCase is a mock implementation of ImpersonationUseCase
+type MockImpersonationUseCase struct {
+	mock.Mock
+}
+
+func (m *MockImpersonationUseCase) Impersonate(ctx context.Context, input usecases.ImpersonateInput) (*usecases.ImpersonateOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.ImpersonateOutput), args.Error(1)
+}
+
+func (m *MockImpersonationUseCase) RecordRequest(ctx context.Context, claims *usecases.TokenClaims, method, route string) error {
+	args := m.Called(ctx, claims, method, route)
+	return args.Error(0)
+}
+
+func (m *MockImpersonationUseCase) RecordExpiredSessions(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func TestImpersonate(t *testing.T) {
+	const (
+		adminID  = "6a1f3c2e-8b4d-4e7a-9c5f-0d2b7e9a1c3f"
+		targetID = "8d2e4f6a-1b3c-4d5e-9f7a-0b1c2d3e4f5a"
+	)
+	validBody := `{"user_id":"` + targetID + `","reason":"問い合わせ #1234 の調査"}`
+	input := usecases.ImpersonateInput{
+		AdminUserID:  entities.UserID(adminID),
+		TargetUserID: entities.UserID(targetID),
+		Reason:       "問い合わせ #1234 の調査",
+	}
+
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(m *MockImpersonationUseCase)
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "Success: issues impersonation token",
+			body: validBody,
+			mockSetup: func(m *MockImpersonationUseCase) {
+				m.On("Impersonate", mock.Anything, input).
+					Return(&usecases.ImpersonateOutput{AccessToken: "token", SessionID: "session-001", TargetUserID: targetID}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Error: missing reason",
+			body:           `{"user_id":"` + targetID + `"}`,
+			mockSetup:      func(m *MockImpersonationUseCase) {},
+			expectError:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: invalid user_id",
+			body:           strings.Replace(validBody, targetID, "user-001", 1),
+			mockSetup:      func(m *MockImpersonationUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error: not an admin",
+			body: validBody,
+			mockSetup: func(m *MockImpersonationUseCase) {
+				m.On("Impersonate", mock.Anything, input).Return(nil, usecases.ErrImpersonationForbidden)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Error: target not found",
+			body: validBody,
+			mockSetup: func(m *MockImpersonationUseCase) {
+				m.On("Impersonate", mock.Anything, input).
+					Return(nil, fmt.Errorf("対象ユーザーが見つかりません: %w", errors.New("not found")))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "Error: audit log failure",
+			body: validBody,
+			mockSetup: func(m *MockImpersonationUseCase) {
+				m.On("Impersonate", mock.Anything, input).
+					Return(nil, errors.New("監査ログの記録に失敗しました: db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockImpersonationUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewAdminController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/impersonate", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.Set("user_id", adminID)
+
+			err := controller.Impersonate(c)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
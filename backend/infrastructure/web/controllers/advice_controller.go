@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/labstack/echo/v4"
+)
+
+// AdviceController は財務アドバイス関連のHTTPハンドラーを提供する
+type AdviceController struct {
+	service ports.FinancialAdvisoryService
+}
+
+// NewAdviceController はAdviceControllerを生成する
+func NewAdviceController(service ports.FinancialAdvisoryService) *AdviceController {
+	return &AdviceController{service: service}
+}
+
+// AdviceChatRequest は財務アドバイスの相談リクエスト
+type AdviceChatRequest struct {
+	UserID  string `json:"user_id" validate:"required"`
+	Message string `json:"message" validate:"required,safetext,notblank,max=2000"`
+}
+
+// AdviceChatResponse は財務アドバイスの回答
+type AdviceChatResponse struct {
+	UserID string `json:"user_id"`
+	Reply  string `json:"reply"`
+}
+
+// Chat は財務状況を踏まえたアドバイスを返す
+// @Summary 財務アドバイス相談
+// @Description 登録済みの財務サマリーを踏まえて、相談内容に対するアドバイスを返します（1ユーザーあたり1時間に10件まで）
+// @Tags advice
+// @Accept json
+// @Produce json
+// @Param request body AdviceChatRequest true "財務アドバイス相談リクエスト"
+// @Success 200 {object} AdviceChatResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /advice/chat [post]
+func (c *AdviceController) Chat(ctx echo.Context) error {
+	currentUserID, ok := ctx.Get("user_id").(string)
+	if !ok || currentUserID == "" {
+		return ctx.JSON(http.StatusUnauthorized, NewErrorResponse(ctx, ErrorCodeUnauthorized, "認証が必要です", nil))
+	}
+
+	var req AdviceChatRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	// 他のユーザーの財務データをプロンプトに含めないよう、本人の相談のみ受け付ける
+	if req.UserID != currentUserID {
+		return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, "他のユーザーとして相談することはできません", nil))
+	}
+
+	reply, err := c.service.GetAdvice(ctx.Request().Context(), req.UserID, req.Message)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, AdviceChatResponse{
+		UserID: req.UserID,
+		Reply:  reply,
+	})
+}
@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockFinancialAdvisoryService はFinancialAdvisoryServiceインタフェースのモック実装
+type MockFinancialAdvisoryService struct {
+	mock.Mock
+}
+
+func (m *MockFinancialAdvisoryService) GetAdvice(ctx context.Context, userID, message string) (string, error) {
+	args := m.Called(ctx, userID, message)
+	return args.String(0), args.Error(1)
+}
+
+const adviceTestUserID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+// newAdviceTestContext は財務アドバイスのテスト用コンテキストを作成する（userID が空の場合は未認証）
+func newAdviceTestContext(body, userID string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	e.Validator = &CustomValidator{validator: newTestValidator()}
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/advice/chat", bytes.NewBufferString(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	if userID != "" {
+		setJWTUserID(c, userID)
+	}
+	return c, rec
+}
+
+func TestAdviceController_Chat(t *testing.T) {
+	t.Run("正常系: 相談内容に対するアドバイスを返す", func(t *testing.T) {
+		service := new(MockFinancialAdvisoryService)
+		service.On("GetAdvice", mock.Anything, adviceTestUserID, "月間貯蓄を増やすにはどうすればいいですか?").
+			Return("固定費の見直しから始めましょう。", nil)
+
+		c, rec := newAdviceTestContext(`{"user_id":"`+adviceTestUserID+`","message":"月間貯蓄を増やすにはどうすればいいですか?"}`, adviceTestUserID)
+
+		require.NoError(t, NewAdviceController(service).Chat(c))
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response AdviceChatResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, adviceTestUserID, response.UserID)
+		assert.Equal(t, "固定費の見直しから始めましょう。", response.Reply)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("異常系: 未認証の場合は401", func(t *testing.T) {
+		service := new(MockFinancialAdvisoryService)
+		c, rec := newAdviceTestContext(`{"user_id":"`+adviceTestUserID+`","message":"老後資金について"}`, "")
+
+		require.NoError(t, NewAdviceController(service).Chat(c))
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		service.AssertNotCalled(t, "GetAdvice", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 他のユーザーとして相談する場合は403", func(t *testing.T) {
+		service := new(MockFinancialAdvisoryService)
+		c, rec := newAdviceTestContext(`{"user_id":"9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e","message":"老後資金について"}`, adviceTestUserID)
+
+		require.NoError(t, NewAdviceController(service).Chat(c))
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		service.AssertNotCalled(t, "GetAdvice", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: 相談内容が空の場合はバリデーションエラー", func(t *testing.T) {
+		service := new(MockFinancialAdvisoryService)
+		c, _ := newAdviceTestContext(`{"user_id":"`+adviceTestUserID+`","message":"   "}`, adviceTestUserID)
+
+		assert.Error(t, NewAdviceController(service).Chat(c))
+		service.AssertNotCalled(t, "GetAdvice", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("異常系: アドバイスの取得に失敗した場合は500", func(t *testing.T) {
+		service := new(MockFinancialAdvisoryService)
+		service.On("GetAdvice", mock.Anything, adviceTestUserID, "老後資金について").Return("", errors.New("LLM APIサーバーへの接続に失敗しました"))
+
+		c, rec := newAdviceTestContext(`{"user_id":"`+adviceTestUserID+`","message":"老後資金について"}`, adviceTestUserID)
+
+		require.NoError(t, NewAdviceController(service).Chat(c))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyController は外部ツール連携用APIキーのコントローラー
+type APIKeyController struct {
+	useCase usecases.ManageAPIKeysUseCase
+}
+
+// NewAPIKeyController は新しいAPIKeyControllerを作成する
+func NewAPIKeyController(useCase usecases.ManageAPIKeysUseCase) *APIKeyController {
+	return &APIKeyController{
+		useCase: useCase,
+	}
+}
+
+// IssueAPIKeyRequest はAPIキー発行リクエスト
+type IssueAPIKeyRequest struct {
+	Name   string   `json:"name" validate:"required,safetext,notblank,max=100"`
+	Scopes []string `json:"scopes" validate:"required,min=1,dive,oneof=read-only reports write"`
+}
+
+// IssueAPIKey は新しいAPIキーを発行する
+// @Summary APIキー発行
+// @Description 外部ツール連携用のAPIキーを発行します。平文のキーはこのレスポンスでのみ返却され、再表示できません
+// @Tags api-keys
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param request body IssueAPIKeyRequest true "APIキー発行リクエスト"
+// @Success 201 {object} usecases.IssueAPIKeyOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys [post]
+func (c *APIKeyController) IssueAPIKey(ctx echo.Context) error {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req IssueAPIKeyRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.IssueAPIKey(ctx.Request().Context(), usecases.IssueAPIKeyInput{
+		UserID: uid,
+		Name:   req.Name,
+		Scopes: req.Scopes,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+// ListAPIKeys はAPIキー一覧を取得する
+// @Summary APIキー一覧取得
+// @Description 発行済みのAPIキー一覧を取得します。キーは末尾4文字のみ表示されます
+// @Tags api-keys
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} usecases.ListAPIKeysOutput
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys [get]
+func (c *APIKeyController) ListAPIKeys(ctx echo.Context) error {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.ListAPIKeys(ctx.Request().Context(), usecases.ListAPIKeysInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// RevokeAPIKey はAPIキーを失効させる
+// @Summary APIキー失効
+// @Description 指定したAPIキーを失効させます。失効したキーでの認証は拒否されます
+// @Tags api-keys
+// @Security BearerAuth
+// @Param id path string true "APIキーID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys/{id} [delete]
+func (c *APIKeyController) RevokeAPIKey(ctx echo.Context) error {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	apiKeyID := ctx.Param("id")
+	if apiKeyID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "APIキーIDは必須です", nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	if err := c.useCase.RevokeAPIKey(ctx.Request().Context(), usecases.RevokeAPIKeyInput{
+		UserID:   uid,
+		APIKeyID: entities.APIKeyID(apiKeyID),
+	}); err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *APIKeyController) handleError(ctx echo.Context, err error) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "見つかりません"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "APIキー"))
+	case strings.Contains(errMsg, "APIキーの作成に失敗しました"),
+		strings.Contains(errMsg, "既に失効しています"),
+		strings.Contains(errMsg, "までです"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/config"
@@ -266,12 +267,22 @@ func setAccessTokenCookie(ctx echo.Context, accessToken string, config *config.S
 }
 
 // Logout はユーザーをログアウトし、認証Cookieをクリアする
+// アクセストークンはブラックリストに登録し、有効期限を待たずに失効させる
 // @Summary ログアウト
-// @Description ユーザーをログアウトし、認証Cookieをクリアします
+// @Description ユーザーをログアウトし、アクセストークンを失効させて認証Cookieをクリアします
 // @Tags auth
 // @Success 200 {object} map[string]string
+// @Failure 500 {object} ErrorResponse
 // @Router /auth/logout [post]
 func (c *AuthController) Logout(ctx echo.Context) error {
+	// 無効・期限切れのトークンは失効させる必要がないため、失効の保存に失敗した場合のみエラーとする
+	if token := accessTokenFromRequest(ctx); token != "" {
+		if err := c.authUseCase.RevokeAccessToken(ctx.Request().Context(), token); err != nil &&
+			strings.Contains(err.Error(), "トークンの失効に失敗しました") {
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, "ログアウトに失敗しました"))
+		}
+	}
+
 	// アクセストークンCookieをクリア
 	ctx.SetCookie(&http.Cookie{
 		Name:     "access_token",
@@ -299,6 +310,17 @@ func (c *AuthController) Logout(ctx echo.Context) error {
 	})
 }
 
+// accessTokenFromRequest はCookie、なければ Authorization ヘッダーからアクセストークンを取得する（ない場合は空文字）
+func accessTokenFromRequest(ctx echo.Context) string {
+	if cookie, err := ctx.Cookie("access_token"); err == nil && cookie.Value != "" {
+		return cookie.Value
+	}
+	if token, ok := strings.CutPrefix(ctx.Request().Header.Get(echo.HeaderAuthorization), "Bearer "); ok {
+		return token
+	}
+	return ""
+}
+
 // ForgotPasswordRequest はパスワードリセットメール送信リクエスト
 type ForgotPasswordRequest struct {
 	Email string `json:"email" validate:"required,email"`
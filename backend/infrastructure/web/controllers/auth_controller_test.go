@@ -12,7 +12,6 @@ import (
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/config"
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -60,6 +59,11 @@ func (m *MockAuthUseCase) RevokeRefreshToken(ctx context.Context, userID string)
 	return args.Error(0)
 }
 
+func (m *MockAuthUseCase) RevokeAccessToken(ctx context.Context, tokenString string) error {
+	args := m.Called(ctx, tokenString)
+	return args.Error(0)
+}
+
 func (m *MockAuthUseCase) GitHubOAuthLogin(ctx context.Context, input usecases.GitHubOAuthInput) (*usecases.LoginOutput, error) {
 	args := m.Called(ctx, input)
 	if args.Get(0) == nil {
@@ -147,7 +151,7 @@ func TestRegister(t *testing.T) {
 				m.On("Register", mock.Anything, mock.MatchedBy(func(input usecases.RegisterInput) bool {
 					return input.Email == "test@example.com"
 				})).Return(&usecases.RegisterOutput{
-					UserID:       "user-123",
+					UserID:       "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 					Email:        "test@example.com",
 					Token:        "access-token",
 					RefreshToken: "refresh-token",
@@ -201,7 +205,7 @@ func TestRegister(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -241,7 +245,7 @@ func TestLogin(t *testing.T) {
 			},
 			mockSetup: func(m *MockAuthUseCase) {
 				m.On("Login", mock.Anything, mock.Anything).Return(&usecases.LoginOutput{
-					UserID:       "user-123",
+					UserID:       "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 					Email:        "test@example.com",
 					Token:        "access-token",
 					RefreshToken: "refresh-token",
@@ -258,7 +262,7 @@ func TestLogin(t *testing.T) {
 			},
 			mockSetup: func(m *MockAuthUseCase) {
 				m.On("Login", mock.Anything, mock.Anything).Return(&usecases.LoginOutput{
-					UserID:       "user-123",
+					UserID:       "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 					Email:        "test@example.com",
 					Token:        "temp-token",
 					RefreshToken: "", // empty = 2FA required
@@ -302,7 +306,7 @@ func TestLogin(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -390,7 +394,7 @@ func TestRefresh(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -421,16 +425,74 @@ func TestRefresh(t *testing.T) {
 }
 
 func TestLogout(t *testing.T) {
-	e := echo.New()
-	mockUseCase := new(MockAuthUseCase)
-	controller := NewAuthController(mockUseCase, newTestServerConfig())
+	tests := []struct {
+		name           string
+		cookieToken    string
+		authHeader     string
+		mockSetup      func(m *MockAuthUseCase)
+		expectedStatus int
+	}{
+		{
+			name:        "Success: revokes access token from cookie",
+			cookieToken: "access-token",
+			mockSetup: func(m *MockAuthUseCase) {
+				m.On("RevokeAccessToken", mock.Anything, "access-token").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "Success: revokes access token from Authorization header",
+			authHeader: "Bearer header-token",
+			mockSetup: func(m *MockAuthUseCase) {
+				m.On("RevokeAccessToken", mock.Anything, "header-token").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Success: no access token",
+			mockSetup:      func(m *MockAuthUseCase) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Success: invalid access token is ignored",
+			cookieToken: "invalid-token",
+			mockSetup: func(m *MockAuthUseCase) {
+				m.On("RevokeAccessToken", mock.Anything, "invalid-token").Return(errors.New("トークンの検証に失敗しました: token is malformed"))
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Error: blacklist failure",
+			cookieToken: "access-token",
+			mockSetup: func(m *MockAuthUseCase) {
+				m.On("RevokeAccessToken", mock.Anything, "access-token").Return(errors.New("トークンの失効に失敗しました: store unavailable"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUseCase := new(MockAuthUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewAuthController(mockUseCase, newTestServerConfig())
 
-	req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
-	rec := httptest.NewRecorder()
-	c := e.NewContext(req, rec)
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", nil)
+			if tt.cookieToken != "" {
+				req.AddCookie(&http.Cookie{Name: "access_token", Value: tt.cookieToken})
+			}
+			if tt.authHeader != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authHeader)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
 
-	err := controller.Logout(c)
+			err := controller.Logout(c)
 
-	assert.NoError(t, err)
-	assert.Equal(t, http.StatusOK, rec.Code)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
 }
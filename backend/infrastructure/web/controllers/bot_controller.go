@@ -29,7 +29,7 @@ type PostMessageRequest struct {
 }
 
 // PostMessage はBotへの質問を受け取り、SSEストリームで回答を返す
-// POST /api/bot/messages
+// POST /api/v1/bot/messages
 func (c *BotController) PostMessage(ctx echo.Context) error {
 	// JWT認証済みチェック
 	userID, ok := ctx.Get("user_id").(string)
@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/financial-planning-calculator/backend/application"
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -67,7 +66,7 @@ func makeBotErrorChannel(err error) <-chan application.BotChunk {
 // newBotEcho はBotコントローラーテスト用のEchoインスタンスを作成する
 func newBotEcho() *echo.Echo {
 	e := echo.New()
-	e.Validator = &CustomValidator{validator: validator.New()}
+	e.Validator = &CustomValidator{validator: newTestValidator()}
 	return e
 }
 
@@ -126,11 +125,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"積立NISAとは何ですか？"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -154,11 +153,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"挨拶して"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -197,11 +196,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -233,11 +232,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -259,11 +258,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -279,11 +278,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		mockUseCase := new(MockBotUseCase)
 		controller := NewBotController(mockUseCase)
 
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString("{}"))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString("{}"))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -304,11 +303,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":""}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -327,11 +326,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		mockUseCase := new(MockBotUseCase)
 		controller := NewBotController(mockUseCase)
 
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString("invalid json"))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString("invalid json"))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -351,7 +350,7 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"積立NISAとは？"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
@@ -379,11 +378,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"積立NISAとは？"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -411,11 +410,11 @@ func TestBotController_PostMessage(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -459,11 +458,11 @@ func TestSSEResponseFormat(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"テスト質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -498,11 +497,11 @@ func TestSSEResponseFormat(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -535,11 +534,11 @@ func TestSSEResponseFormat(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -568,11 +567,11 @@ func TestBotController_QuestionBoundary(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"` + question + `"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -591,11 +590,11 @@ func TestBotController_QuestionBoundary(t *testing.T) {
 
 		question := strings.Repeat("あ", 2001)
 		reqBody := `{"question":"` + question + `"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// When
 		err := controller.PostMessage(c)
@@ -626,7 +625,7 @@ func TestBotController_Timeout(t *testing.T) {
 		controller := NewBotController(mockUseCase)
 
 		reqBody := `{"question":"質問"}`
-		req := httptest.NewRequest(http.MethodPost, "/api/bot/messages", bytes.NewBufferString(reqBody))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/bot/messages", bytes.NewBufferString(reqBody))
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 
 		// タイムアウトを設定したコンテキスト
@@ -636,7 +635,7 @@ func TestBotController_Timeout(t *testing.T) {
 
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		setJWTUserID(c, "user-123")
+		setJWTUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 
 		// 別ゴルーチンでPostMessageを実行
 		done := make(chan error, 1)
@@ -1,10 +1,14 @@
 package controllers
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/labstack/echo/v4"
 )
 
@@ -21,14 +25,20 @@ func NewCalculationsController(useCase usecases.CalculateProjectionUseCase) *Cal
 }
 
 // AssetProjectionRequest は資産推移計算リクエスト
+// goal_id を指定した場合はその目標の現在額と月間拠出額だけで推移を計算する
+// contribution_timing で積立を各月の期初（beginning）・期末（end）のどちらに行うかを選べる（未指定の場合は期末）
 type AssetProjectionRequest struct {
-	UserID string `json:"user_id" validate:"required"`
-	Years  int    `json:"years" validate:"required,gte=1,lte=100"`
+	UserID             string  `json:"user_id" validate:"required"`
+	Years              int     `json:"years" validate:"required,gte=1,lte=100"`
+	GoalID             *string `json:"goal_id,omitempty"`
+	ContributionTiming string  `json:"contribution_timing,omitempty" validate:"omitempty,oneof=beginning end"`
 }
 
 // RetirementCalculationRequest は退職資金計算リクエスト
+// lifestyle_expenses で生活水準（comfortable / standard / minimum）ごとの月間生活費の目安を上書きできる
 type RetirementCalculationRequest struct {
-	UserID string `json:"user_id" validate:"required"`
+	UserID            string             `json:"user_id" validate:"required"`
+	LifestyleExpenses map[string]float64 `json:"lifestyle_expenses,omitempty" validate:"omitempty,dive,keys,oneof=comfortable standard minimum,endkeys,gte=0"`
 }
 
 // EmergencyFundCalculationRequest は緊急資金計算リクエスト
@@ -44,19 +54,61 @@ type ComprehensiveProjectionRequest struct {
 
 // GoalProjectionRequest は目標達成予測計算リクエスト
 type GoalProjectionRequest struct {
-	UserID string `json:"user_id" validate:"required"`
-	GoalID string `json:"goal_id" validate:"required"`
+	UserID      string `json:"user_id" validate:"required"`
+	GoalID      string `json:"goal_id" validate:"required"`
+	Granularity string `json:"granularity,omitempty" validate:"omitempty,oneof=weekly monthly"` // 未指定の場合は残り6ヶ月以内の目標を週次、それ以外を月次で予測する
+}
+
+// PensionEstimateQueryParams は年金見込み額推定のクエリパラメータ
+type PensionEstimateQueryParams struct {
+	ContributionYears int     `query:"contribution_years" validate:"required,gte=1,lte=50"`
+	MonthlyIncome     float64 `query:"monthly_income" validate:"required,gt=0"`
+	BirthYear         int     `query:"birth_year" validate:"required,gte=1900,lte=2100"`
+}
+
+// TimeToAmountRequest は目標金額到達期間計算リクエスト
+// monthly_contribution・annual_return を省略した場合は登録済みの財務プロファイルの値を使う
+type TimeToAmountRequest struct {
+	UserID              string   `json:"user_id" validate:"required"`
+	TargetAmount        float64  `json:"target_amount" validate:"required,gt=0"`
+	MonthlyContribution *float64 `json:"monthly_contribution,omitempty"`
+	AnnualReturn        *float64 `json:"annual_return,omitempty" validate:"omitempty,gte=0,lte=100"`
+}
+
+// RequiredReturnRequest は必要利回り計算リクエスト
+type RequiredReturnRequest struct {
+	UserID               string   `json:"user_id" validate:"required"`
+	TargetAmount         float64  `json:"target_amount" validate:"required,gt=0"`
+	Years                int      `json:"years" validate:"required,gte=1,lte=100"`
+	UnrealisticThreshold *float64 `json:"unrealistic_threshold,omitempty" validate:"omitempty,gt=0,lte=100"`
+}
+
+// AmortizationRequest は返済予定計算リクエスト
+type AmortizationRequest struct {
+	LoanAmount float64 `json:"loan_amount" validate:"required,gt=0"`
+	AnnualRate float64 `json:"annual_rate" validate:"gte=0,lte=100"` // 年利（%）
+	TermMonths int     `json:"term_months" validate:"required,gte=1,lte=600"`
+}
+
+// RequiredSavingsRateQueryParams は必要貯蓄率計算のクエリパラメータ
+type RequiredSavingsRateQueryParams struct {
+	UserID string `query:"user_id" validate:"required"`
 }
 
 // CalculateAssetProjection は資産推移を計算する
 // @Summary 資産推移計算
-// @Description 指定年数の資産推移を計算します
+// @Description 指定年数の資産推移を計算します。goal_id を指定した場合はその目標の積立推移を計算し、目標金額と比較します。
+// @Description Accept: application/x-ndjson または stream=true を指定すると、1行目にサマリー（usecases.AssetProjectionStreamHeader）、2行目以降に1年ごとの推移をNDJSONで逐次返します
 // @Tags calculations
 // @Accept json
 // @Produce json
+// @Produce x-ndjson
 // @Param request body AssetProjectionRequest true "資産推移計算リクエスト"
+// @Param stream query bool false "true の場合はNDJSONで逐次返す"
 // @Success 200 {object} usecases.AssetProjectionOutput
 // @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /calculations/asset-projection [post]
 func (c *CalculationsController) CalculateAssetProjection(ctx echo.Context) error {
@@ -76,10 +128,8 @@ func (c *CalculationsController) CalculateAssetProjection(ctx echo.Context) erro
 			if req.Years < 1 || req.Years > 100 {
 				return CreateBusinessLogicError(
 					"INVALID_PROJECTION_YEARS",
-					"予測年数は1年から100年の範囲で入力してください",
-					"現実的な予測期間を入力してください",
 					req.Years,
-					"1-100年",
+					"1-100",
 				)
 			}
 			return nil
@@ -91,19 +141,76 @@ func (c *CalculationsController) CalculateAssetProjection(ctx echo.Context) erro
 	// リクエストIDをコンテキストに追加
 	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.AssetProjectionInput{
-		UserID: entities.UserID(req.UserID),
-		Years:  req.Years,
+		UserID:             uid,
+		Years:              req.Years,
+		ContributionTiming: valueobjects.ContributionTiming(req.ContributionTiming),
+	}
+	if req.GoalID != nil && *req.GoalID != "" {
+		goalID := entities.GoalID(*req.GoalID)
+		input.GoalID = &goalID
+	}
+
+	if wantsNDJSONStream(ctx) {
+		return c.streamAssetProjection(ctx, reqCtx, input)
 	}
 
 	output, err := c.useCase.CalculateAssetProjection(reqCtx, input)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+		return c.handleAssetProjectionError(ctx, err)
 	}
 
 	return ctx.JSON(http.StatusOK, output)
 }
 
+// streamAssetProjection は資産推移をNDJSONで逐次書き込む
+// 1行目にサマリー、2行目以降に1年ごとの推移を書き込む。書き込み開始後のエラーは {"error": ...} の行で通知する
+func (c *CalculationsController) streamAssetProjection(ctx echo.Context, reqCtx context.Context, input usecases.AssetProjectionInput) error {
+	var w *ndjsonWriter
+	err := c.useCase.StreamAssetProjection(reqCtx, input,
+		func(header *usecases.AssetProjectionStreamHeader) error {
+			res := ctx.Response()
+			res.Header().Set(echo.HeaderContentType, MIMEApplicationNDJSON)
+			res.Header().Set("Cache-Control", "no-cache")
+			res.Header().Set("X-Accel-Buffering", "no")
+			res.WriteHeader(http.StatusOK)
+			w = newNDJSONWriter(res)
+			return w.Write(header)
+		},
+		func(projection entities.AssetProjection) error {
+			return w.Write(projection)
+		},
+	)
+	if w == nil {
+		if err != nil {
+			return c.handleAssetProjectionError(ctx, err)
+		}
+		return nil
+	}
+	if err != nil {
+		slog.Error("資産推移のストリーミング中にエラーが発生しました", slog.Any("error", err))
+		_ = w.Write(map[string]string{"error": "資産推移の出力中にエラーが発生しました"})
+	}
+	w.Flush()
+	return nil
+}
+
+// handleAssetProjectionError は資産推移計算のエラーをHTTPレスポンスに変換する
+func (c *CalculationsController) handleAssetProjectionError(ctx echo.Context, err error) error {
+	switch {
+	case strings.Contains(err.Error(), "目標の取得に失敗しました"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "目標"))
+	case strings.Contains(err.Error(), "権限がありません"):
+		return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, err.Error(), nil))
+	}
+	return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+}
+
 // CalculateRetirementProjection は退職資金予測を計算する
 // @Summary 退職資金計算
 // @Description 退職資金の予測を計算します
@@ -128,8 +235,14 @@ func (c *CalculationsController) CalculateRetirementProjection(ctx echo.Context)
 	// リクエストIDをコンテキストに追加
 	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.RetirementProjectionInput{
-		UserID: entities.UserID(req.UserID),
+		UserID:            uid,
+		LifestyleExpenses: toLifestyleExpenses(req.LifestyleExpenses),
 	}
 
 	output, err := c.useCase.CalculateRetirementProjection(reqCtx, input)
@@ -140,6 +253,18 @@ func (c *CalculationsController) CalculateRetirementProjection(ctx echo.Context)
 	return ctx.JSON(http.StatusOK, output)
 }
 
+// toLifestyleExpenses はリクエストの生活水準ごとの月間生活費をユースケースの入力に変換する
+func toLifestyleExpenses(expenses map[string]float64) map[entities.RetirementLifestyle]float64 {
+	if len(expenses) == 0 {
+		return nil
+	}
+	converted := make(map[entities.RetirementLifestyle]float64, len(expenses))
+	for lifestyle, amount := range expenses {
+		converted[entities.RetirementLifestyle(lifestyle)] = amount
+	}
+	return converted
+}
+
 // CalculateEmergencyFundProjection は緊急資金予測を計算する
 // @Summary 緊急資金計算
 // @Description 緊急資金の予測を計算します
@@ -164,8 +289,13 @@ func (c *CalculationsController) CalculateEmergencyFundProjection(ctx echo.Conte
 	// リクエストIDをコンテキストに追加
 	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.EmergencyFundProjectionInput{
-		UserID: entities.UserID(req.UserID),
+		UserID: uid,
 	}
 
 	output, err := c.useCase.CalculateEmergencyFundProjection(reqCtx, input)
@@ -203,10 +333,8 @@ func (c *CalculationsController) CalculateComprehensiveProjection(ctx echo.Conte
 			if req.Years < 1 || req.Years > 100 {
 				return CreateBusinessLogicError(
 					"INVALID_PROJECTION_YEARS",
-					"予測年数は1年から100年の範囲で入力してください",
-					"現実的な予測期間を入力してください",
 					req.Years,
-					"1-100年",
+					"1-100",
 				)
 			}
 			return nil
@@ -218,8 +346,13 @@ func (c *CalculationsController) CalculateComprehensiveProjection(ctx echo.Conte
 	// リクエストIDをコンテキストに追加
 	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.ComprehensiveProjectionInput{
-		UserID: entities.UserID(req.UserID),
+		UserID: uid,
 		Years:  req.Years,
 	}
 
@@ -231,9 +364,49 @@ func (c *CalculationsController) CalculateComprehensiveProjection(ctx echo.Conte
 	return ctx.JSON(http.StatusOK, output)
 }
 
+// quickProjectionCacheControl は簡易予測のキャッシュ期間（財務データの変更頻度は低いため5分間キャッシュさせる）
+// ユーザー本人の財務データを含むため、共有キャッシュには保存させない
+const quickProjectionCacheControl = "private, max-age=300"
+
+// CalculateQuickProjection は1年後の資産の簡易予測を計算する
+// @Summary 簡易予測（1年後）
+// @Description ダッシュボード向けに、1年分の資産推移だけを計算して現在の資産・1年後の資産・増加見込み・貯蓄率・緊急資金の充足月数を返します。包括的予測より軽量で、Cache-Control: private, max-age=300 を付けて返します
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.QuickProjectionOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/projections/quick [get]
+func (c *CalculationsController) CalculateQuickProjection(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	// リクエストIDをコンテキストに追加
+	reqCtx := GetRequestContextWithUserID(ctx, string(uid))
+
+	output, err := c.useCase.CalculateQuickProjection(reqCtx, usecases.QuickProjectionInput{UserID: uid})
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "財務データが見つかりません") || strings.Contains(errMsg, "財務計画の取得に失敗しました") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+
+	ctx.Response().Header().Set("Cache-Control", quickProjectionCacheControl)
+	return ctx.JSON(http.StatusOK, output)
+}
+
 // CalculateGoalProjection は目標達成予測を計算する
 // @Summary 目標達成予測計算
-// @Description 目標達成の予測を計算します
+// @Description 目標達成の予測を計算します。進捗予測のデータ点数は粒度（weekly は週数、monthly は月数）に応じて変わり、最大600点で打ち切ります
 // @Tags calculations
 // @Accept json
 // @Produce json
@@ -255,9 +428,15 @@ func (c *CalculationsController) CalculateGoalProjection(ctx echo.Context) error
 	// リクエストIDをコンテキストに追加
 	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.GoalProjectionInput{
-		UserID: entities.UserID(req.UserID),
-		GoalID: entities.GoalID(req.GoalID),
+		UserID:      uid,
+		GoalID:      entities.GoalID(req.GoalID),
+		Granularity: usecases.GoalProjectionGranularity(req.Granularity),
 	}
 
 	output, err := c.useCase.CalculateGoalProjection(reqCtx, input)
@@ -267,3 +446,202 @@ func (c *CalculationsController) CalculateGoalProjection(ctx echo.Context) error
 
 	return ctx.JSON(http.StatusOK, output)
 }
+
+// EstimatePension は公的年金の見込み額を推定する
+// @Summary 年金見込み額推定
+// @Description 加入年数・平均月収・生年から厚生年金と基礎年金の見込み額を推定します
+// @Tags calculations
+// @Produce json
+// @Param contribution_years query int true "加入年数"
+// @Param monthly_income query number true "平均月収"
+// @Param birth_year query int true "生年"
+// @Success 200 {object} usecases.PensionEstimateOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /calculations/pension-estimate [get]
+func (c *CalculationsController) EstimatePension(ctx echo.Context) error {
+	var params PensionEstimateQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "クエリパラメータの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	input := usecases.PensionEstimateInput{
+		ContributionYears: params.ContributionYears,
+		MonthlyIncome:     params.MonthlyIncome,
+		BirthYear:         params.BirthYear,
+	}
+
+	output, err := c.useCase.EstimatePension(ctx.Request().Context(), input)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// CalculateTimeToAmount は目標金額に到達するまでの期間を計算する
+// @Summary 目標到達期間計算
+// @Description 現在の資産と月間積立額・投資利回りから目標金額に到達するまでの期間を計算します（精度±1ヶ月）
+// @Tags calculations
+// @Accept json
+// @Produce json
+// @Param request body TimeToAmountRequest true "目標到達期間計算リクエスト"
+// @Success 200 {object} usecases.TimeToAmountOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /calculations/time-to-amount [post]
+func (c *CalculationsController) CalculateTimeToAmount(ctx echo.Context) error {
+	var req TimeToAmountRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	// リクエストIDをコンテキストに追加
+	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
+
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	input := usecases.TimeToAmountInput{
+		UserID:              uid,
+		TargetAmount:        req.TargetAmount,
+		MonthlyContribution: req.MonthlyContribution,
+		AnnualReturn:        req.AnnualReturn,
+	}
+
+	output, err := c.useCase.CalculateTimeToAmount(reqCtx, input)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// CalculateRequiredReturn は指定年数で目標金額に到達するために必要な年利回りを計算する
+// @Summary 必要利回り計算
+// @Description 現在の資産と月間積立額から、指定年数で目標金額に到達するために必要な年利回りを計算します（精度±0.01%）
+// @Tags calculations
+// @Accept json
+// @Produce json
+// @Param request body RequiredReturnRequest true "必要利回り計算リクエスト"
+// @Success 200 {object} usecases.RequiredReturnOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /calculations/required-return [post]
+func (c *CalculationsController) CalculateRequiredReturn(ctx echo.Context) error {
+	var req RequiredReturnRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	// リクエストIDをコンテキストに追加
+	reqCtx := GetRequestContextWithUserID(ctx, req.UserID)
+
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	input := usecases.RequiredReturnInput{
+		UserID:               uid,
+		TargetAmount:         req.TargetAmount,
+		Years:                req.Years,
+		UnrealisticThreshold: req.UnrealisticThreshold,
+	}
+
+	output, err := c.useCase.CalculateRequiredReturn(reqCtx, input)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// CalculateRequiredSavingsRate はアクティブな全目標を達成するために必要な貯蓄率を計算する
+// @Summary 必要貯蓄率計算
+// @Description アクティブな全目標の月間必要貯蓄額を合計し、月収に対する必要貯蓄率を計算します。現在の純貯蓄額で賄えない場合は不足額と後回しにする目標の候補を返します
+// @Tags calculations
+// @Produce json
+// @Param user_id query string true "ユーザーID"
+// @Success 200 {object} usecases.RequiredSavingsRateOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /calculations/required-savings-rate [get]
+func (c *CalculationsController) CalculateRequiredSavingsRate(ctx echo.Context) error {
+	var params RequiredSavingsRateQueryParams
+	if err := ctx.Bind(&params); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "クエリパラメータの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&params); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	// リクエストIDをコンテキストに追加
+	reqCtx := GetRequestContextWithUserID(ctx, params.UserID)
+
+	uid, err := entities.NewUserID(params.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	input := usecases.RequiredSavingsRateInput{
+		UserID: uid,
+	}
+
+	output, err := c.useCase.CalculateRequiredSavingsRate(reqCtx, input)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// CalculateAmortization は元利均等返済の返済予定を計算する
+// @Summary 返済予定計算
+// @Description 借入額・年利・返済期間から、住宅ローンなどを元利均等返済した場合の毎月の返済額と元金・利息の内訳、残高を計算します
+// @Tags calculations
+// @Accept json
+// @Produce json
+// @Param request body AmortizationRequest true "返済予定計算リクエスト"
+// @Success 200 {object} usecases.AmortizationOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /calculations/amortization [post]
+func (c *CalculationsController) CalculateAmortization(ctx echo.Context) error {
+	var req AmortizationRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	input := usecases.AmortizationInput{
+		LoanAmount: req.LoanAmount,
+		AnnualRate: req.AnnualRate,
+		TermMonths: req.TermMonths,
+	}
+
+	output, err := c.useCase.CalculateAmortization(ctx.Request().Context(), input)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
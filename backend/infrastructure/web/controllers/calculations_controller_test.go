@@ -1,15 +1,24 @@
 package controllers
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories/inmemory"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -29,6 +38,22 @@ func (m *MockCalculateProjectionUseCase) CalculateAssetProjection(ctx context.Co
 	return args.Get(0).(*usecases.AssetProjectionOutput), args.Error(1)
 }
 
+// StreamAssetProjection はモックが返したヘッダーの後に input.Years 件の推移をコールバックに渡す
+func (m *MockCalculateProjectionUseCase) StreamAssetProjection(ctx context.Context, input usecases.AssetProjectionInput, header func(*usecases.AssetProjectionStreamHeader) error, fn func(entities.AssetProjection) error) error {
+	args := m.Called(ctx, input)
+	if h, ok := args.Get(0).(*usecases.AssetProjectionStreamHeader); ok && h != nil {
+		if err := header(h); err != nil {
+			return err
+		}
+		for year := 1; year <= input.Years; year++ {
+			if err := fn(entities.AssetProjection{Year: year}); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
 func (m *MockCalculateProjectionUseCase) CalculateRetirementProjection(ctx context.Context, input usecases.RetirementProjectionInput) (*usecases.RetirementProjectionOutput, error) {
 	args := m.Called(ctx, input)
 	if args.Get(0) == nil {
@@ -53,6 +78,14 @@ func (m *MockCalculateProjectionUseCase) CalculateComprehensiveProjection(ctx co
 	return args.Get(0).(*usecases.ComprehensiveProjectionOutput), args.Error(1)
 }
 
+func (m *MockCalculateProjectionUseCase) CalculateQuickProjection(ctx context.Context, input usecases.QuickProjectionInput) (*usecases.QuickProjectionOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.QuickProjectionOutput), args.Error(1)
+}
+
 func (m *MockCalculateProjectionUseCase) CalculateGoalProjection(ctx context.Context, input usecases.GoalProjectionInput) (*usecases.GoalProjectionOutput, error) {
 	args := m.Called(ctx, input)
 	if args.Get(0) == nil {
@@ -61,12 +94,60 @@ func (m *MockCalculateProjectionUseCase) CalculateGoalProjection(ctx context.Con
 	return args.Get(0).(*usecases.GoalProjectionOutput), args.Error(1)
 }
 
+func (m *MockCalculateProjectionUseCase) EstimatePension(ctx context.Context, input usecases.PensionEstimateInput) (*usecases.PensionEstimateOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.PensionEstimateOutput), args.Error(1)
+}
+
+func (m *MockCalculateProjectionUseCase) CalculateTimeToAmount(ctx context.Context, input usecases.TimeToAmountInput) (*usecases.TimeToAmountOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.TimeToAmountOutput), args.Error(1)
+}
+
+func (m *MockCalculateProjectionUseCase) CalculateRequiredReturn(ctx context.Context, input usecases.RequiredReturnInput) (*usecases.RequiredReturnOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.RequiredReturnOutput), args.Error(1)
+}
+
+func (m *MockCalculateProjectionUseCase) CalculateRequiredSavingsRate(ctx context.Context, input usecases.RequiredSavingsRateInput) (*usecases.RequiredSavingsRateOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.RequiredSavingsRateOutput), args.Error(1)
+}
+
+func (m *MockCalculateProjectionUseCase) CalculateAmortization(ctx context.Context, input usecases.AmortizationInput) (*usecases.AmortizationOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.AmortizationOutput), args.Error(1)
+}
+
+// newTestValidator は入力サニタイズ用のカスタムルールを登録したバリデーターを作成する
+func newTestValidator() *validator.Validate {
+	v := validator.New()
+	RegisterInputValidations(v)
+	return v
+}
+
 // CustomValidator wraps the go-playground validator
 type CustomValidator struct {
 	validator *validator.Validate
 }
 
 func (cv *CustomValidator) Validate(i interface{}) error {
+	SanitizeInput(i)
 	if err := cv.validator.Struct(i); err != nil {
 		return err
 	}
@@ -75,10 +156,11 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 
 func TestAssetProjectionValidation(t *testing.T) {
 	tests := []struct {
-		name           string
-		years          int
-		expectError    bool
-		expectedStatus int
+		name               string
+		years              int
+		contributionTiming string
+		expectError        bool
+		expectedStatus     int
 	}{
 		{
 			name:           "Valid: 1 year",
@@ -116,21 +198,36 @@ func TestAssetProjectionValidation(t *testing.T) {
 			expectError:    true,
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:               "Valid: beginning-of-month contribution",
+			years:              10,
+			contributionTiming: "beginning",
+			expectError:        false,
+			expectedStatus:     http.StatusOK,
+		},
+		{
+			name:               "Invalid: unknown contribution timing",
+			years:              10,
+			contributionTiming: "middle",
+			expectError:        true,
+			expectedStatus:     http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 			
 			mockUseCase := new(MockCalculateProjectionUseCase)
 			controller := NewCalculationsController(mockUseCase)
 
 			// Create request
 			reqBody := AssetProjectionRequest{
-				UserID: "test-user",
-				Years:  tt.years,
+				UserID:             "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				Years:              tt.years,
+				ContributionTiming: tt.contributionTiming,
 			}
 			reqJSON, _ := json.Marshal(reqBody)
 			req := httptest.NewRequest(http.MethodPost, "/calculations/asset-projection", bytes.NewBuffer(reqJSON))
@@ -141,7 +238,8 @@ func TestAssetProjectionValidation(t *testing.T) {
 			// Mock the use case only for valid cases
 			if !tt.expectError {
 				mockUseCase.On("CalculateAssetProjection", mock.Anything, mock.MatchedBy(func(input usecases.AssetProjectionInput) bool {
-					return input.UserID == entities.UserID("test-user") && input.Years == tt.years
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c") && input.Years == tt.years &&
+						string(input.ContributionTiming) == tt.contributionTiming
 				})).Return(&usecases.AssetProjectionOutput{
 					Projections: []entities.AssetProjection{},
 					Summary:     usecases.ProjectionSummary{},
@@ -187,14 +285,14 @@ func TestComprehensiveProjectionValidation(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Setup
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 			
 			mockUseCase := new(MockCalculateProjectionUseCase)
 			controller := NewCalculationsController(mockUseCase)
 
 			// Create request
 			reqBody := ComprehensiveProjectionRequest{
-				UserID: "test-user",
+				UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				Years:  tt.years,
 			}
 			reqJSON, _ := json.Marshal(reqBody)
@@ -206,7 +304,7 @@ func TestComprehensiveProjectionValidation(t *testing.T) {
 			// Mock the use case only for valid cases
 			if !tt.expectError {
 				mockUseCase.On("CalculateComprehensiveProjection", mock.Anything, mock.MatchedBy(func(input usecases.ComprehensiveProjectionInput) bool {
-					return input.UserID == entities.UserID("test-user") && input.Years == tt.years
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c") && input.Years == tt.years
 				})).Return(&usecases.ComprehensiveProjectionOutput{}, nil)
 			}
 
@@ -223,3 +321,599 @@ func TestComprehensiveProjectionValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimatePensionValidation(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:           "Valid: model case",
+			query:          "contribution_years=40&monthly_income=439000&birth_year=1980",
+			expectError:    false,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Invalid: missing contribution_years",
+			query:       "monthly_income=439000&birth_year=1980",
+			expectError: true,
+		},
+		{
+			name:        "Invalid: 51 contribution years",
+			query:       "contribution_years=51&monthly_income=439000&birth_year=1980",
+			expectError: true,
+		},
+		{
+			name:        "Invalid: negative monthly income",
+			query:       "contribution_years=40&monthly_income=-1&birth_year=1980",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			e.Validator = &CustomValidator{validator: newTestValidator()}
+
+			mockUseCase := new(MockCalculateProjectionUseCase)
+			controller := NewCalculationsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodGet, "/calculations/pension-estimate?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if !tt.expectError {
+				mockUseCase.On("EstimatePension", mock.Anything, usecases.PensionEstimateInput{
+					ContributionYears: 40,
+					MonthlyIncome:     439000,
+					BirthYear:         1980,
+				}).Return(&usecases.PensionEstimateOutput{
+					EstimatedMonthlyPension: 164246,
+					TotalLifetimePension:    39419040,
+				}, nil)
+			}
+
+			// Execute
+			err := controller.EstimatePension(c)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				mockUseCase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestRequiredReturnValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{
+			name:        "Valid: target and years",
+			body:        `{"user_id":"4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c","target_amount":20000000,"years":10}`,
+			expectError: false,
+		},
+		{
+			name:        "Invalid: missing target_amount",
+			body:        `{"user_id":"4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c","years":10}`,
+			expectError: true,
+		},
+		{
+			name:        "Invalid: 0 years",
+			body:        `{"user_id":"4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c","target_amount":20000000,"years":0}`,
+			expectError: true,
+		},
+		{
+			name:        "Invalid: threshold over 100%",
+			body:        `{"user_id":"4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c","target_amount":20000000,"years":10,"unrealistic_threshold":150}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			e.Validator = &CustomValidator{validator: newTestValidator()}
+
+			mockUseCase := new(MockCalculateProjectionUseCase)
+			controller := NewCalculationsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/calculations/required-return", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if !tt.expectError {
+				mockUseCase.On("CalculateRequiredReturn", mock.Anything, usecases.RequiredReturnInput{
+					UserID:       entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+					TargetAmount: 20000000,
+					Years:        10,
+				}).Return(&usecases.RequiredReturnOutput{
+					RequiredAnnualReturn: 12.34,
+					Reachable:            true,
+				}, nil)
+			}
+
+			// Execute
+			err := controller.CalculateRequiredReturn(c)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				mockUseCase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestAmortizationValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		expectError bool
+	}{
+		{
+			name:        "Valid: mortgage",
+			body:        `{"loan_amount":30000000,"annual_rate":1.5,"term_months":420}`,
+			expectError: false,
+		},
+		{
+			name:        "Invalid: missing loan_amount",
+			body:        `{"annual_rate":1.5,"term_months":420}`,
+			expectError: true,
+		},
+		{
+			name:        "Invalid: negative annual_rate",
+			body:        `{"loan_amount":30000000,"annual_rate":-1,"term_months":420}`,
+			expectError: true,
+		},
+		{
+			name:        "Invalid: term over 50 years",
+			body:        `{"loan_amount":30000000,"annual_rate":1.5,"term_months":601}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			e.Validator = &CustomValidator{validator: newTestValidator()}
+
+			mockUseCase := new(MockCalculateProjectionUseCase)
+			controller := NewCalculationsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/calculations/amortization", bytes.NewBufferString(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if !tt.expectError {
+				mockUseCase.On("CalculateAmortization", mock.Anything, usecases.AmortizationInput{
+					LoanAmount: 30000000,
+					AnnualRate: 1.5,
+					TermMonths: 420,
+				}).Return(&usecases.AmortizationOutput{
+					MonthlyPayment: 91855,
+				}, nil)
+			}
+
+			// Execute
+			err := controller.CalculateAmortization(c)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				mockUseCase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestRequiredSavingsRateValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expectError bool
+	}{
+		{
+			name:        "Valid: user_id",
+			query:       "user_id=4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			expectError: false,
+		},
+		{
+			name:        "Invalid: missing user_id",
+			query:       "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			e := echo.New()
+			e.Validator = &CustomValidator{validator: newTestValidator()}
+
+			mockUseCase := new(MockCalculateProjectionUseCase)
+			controller := NewCalculationsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodGet, "/calculations/required-savings-rate?"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if !tt.expectError {
+				additional := 50000.0
+				mockUseCase.On("CalculateRequiredSavingsRate", mock.Anything, usecases.RequiredSavingsRateInput{
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}).Return(&usecases.RequiredSavingsRateOutput{
+					RequiredSavingsRate:            67.5,
+					AdditionalMonthlySavingsNeeded: &additional,
+				}, nil)
+			}
+
+			// Execute
+			err := controller.CalculateRequiredSavingsRate(c)
+
+			// Assert
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				mockUseCase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
+func TestAssetProjectionStreaming(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	header := &usecases.AssetProjectionStreamHeader{
+		Years:   100,
+		Summary: usecases.ProjectionSummary{InitialAmount: 1000000, FinalAmount: 50000000},
+	}
+
+	tests := []struct {
+		name          string
+		target        string
+		accept        string
+		expectNDJSON  bool
+		expectedLines int
+	}{
+		{
+			name:          "Accept: application/x-ndjson streams summary and points",
+			target:        "/calculations/asset-projection",
+			accept:        MIMEApplicationNDJSON,
+			expectNDJSON:  true,
+			expectedLines: 101,
+		},
+		{
+			name:          "stream=true streams summary and points",
+			target:        "/calculations/asset-projection?stream=true",
+			expectNDJSON:  true,
+			expectedLines: 101,
+		},
+		{
+			name:   "Default: standard JSON",
+			target: "/calculations/asset-projection",
+			accept: echo.MIMEApplicationJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &CustomValidator{validator: newTestValidator()}
+			mockUseCase := new(MockCalculateProjectionUseCase)
+			if tt.expectNDJSON {
+				mockUseCase.On("StreamAssetProjection", mock.Anything, mock.Anything).Return(header, nil)
+			} else {
+				mockUseCase.On("CalculateAssetProjection", mock.Anything, mock.Anything).Return(&usecases.AssetProjectionOutput{}, nil)
+			}
+			controller := NewCalculationsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(`{"user_id":"`+userID+`","years":100}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.accept != "" {
+				req.Header.Set(echo.HeaderAccept, tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.CalculateAssetProjection(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			mockUseCase.AssertExpectations(t)
+			if !tt.expectNDJSON {
+				assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+				return
+			}
+
+			assert.Equal(t, MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+			assert.True(t, rec.Flushed)
+
+			// 1行ずつ読み込む（1行目はサマリー、2行目以降は年ごとの推移）
+			scanner := bufio.NewScanner(rec.Body)
+			lines := 0
+			for scanner.Scan() {
+				lines++
+				if lines == 1 {
+					var got usecases.AssetProjectionStreamHeader
+					assert.NoError(t, json.Unmarshal(scanner.Bytes(), &got))
+					assert.Equal(t, header.Summary, got.Summary)
+					continue
+				}
+				var point struct {
+					Year int `json:"year"`
+				}
+				assert.NoError(t, json.Unmarshal(scanner.Bytes(), &point))
+				assert.Equal(t, lines-1, point.Year)
+			}
+			assert.NoError(t, scanner.Err())
+			assert.Equal(t, tt.expectedLines, lines)
+		})
+	}
+}
+
+func TestAssetProjectionStreaming_Errors(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		header         *usecases.AssetProjectionStreamHeader
+		err            error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Error before streaming: goal not found",
+			err:            errors.New("目標の取得に失敗しました: not found"),
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Error while streaming: error line is appended",
+			header:         &usecases.AssetProjectionStreamHeader{Years: 3},
+			err:            errors.New("broken pipe"),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"error":"資産推移の出力中にエラーが発生しました"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			e.Validator = &CustomValidator{validator: newTestValidator()}
+			mockUseCase := new(MockCalculateProjectionUseCase)
+			mockUseCase.On("StreamAssetProjection", mock.Anything, mock.Anything).Return(tt.header, tt.err)
+			controller := NewCalculationsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/calculations/asset-projection?stream=true", strings.NewReader(`{"user_id":"`+userID+`","years":3}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.CalculateAssetProjection(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+				assert.Len(t, lines, 5)
+				assert.Equal(t, tt.expectedBody, lines[len(lines)-1])
+			}
+		})
+	}
+}
+
+func TestNDJSONWriter_AllocationsDoNotScaleWithYears(t *testing.T) {
+	// 1点あたりのアロケーション数が予測年数によらず一定であること（書き込んだ行を保持しないこと）を確認する
+	allocsPerPoint := func(years int) float64 {
+		return testing.AllocsPerRun(5, func() {
+			w := newNDJSONWriter(io.Discard)
+			for year := 1; year <= years; year++ {
+				_ = w.Write(entities.AssetProjection{Year: year})
+			}
+		}) / float64(years)
+	}
+
+	short := allocsPerPoint(100)
+	long := allocsPerPoint(1200)
+
+	assert.LessOrEqual(t, long, short)
+}
+
+func TestCalculateQuickProjection(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	newContext := func(userID string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/financial-data/"+userID+"/projections/quick", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues(userID)
+		c.Set("user_id", userID)
+		return c, rec
+	}
+
+	t.Run("Success: returns the 1-year summary with Cache-Control", func(t *testing.T) {
+		mockUseCase := new(MockCalculateProjectionUseCase)
+		mockUseCase.On("CalculateQuickProjection", mock.Anything, usecases.QuickProjectionInput{UserID: userID}).
+			Return(&usecases.QuickProjectionOutput{
+				CurrentAssets:        1000000,
+				ProjectedAssets1Year: 3750000,
+				EstimatedGrowth:      2750000,
+				SavingsRate:          55,
+				EmergencyFundStatus:  &usecases.QuickEmergencyFundStatus{MonthsOfExpensesCovered: 2.5},
+			}, nil)
+		c, rec := newContext(userID)
+
+		err := NewCalculationsController(mockUseCase).CalculateQuickProjection(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "private, max-age=300", rec.Header().Get("Cache-Control"))
+		var body map[string]interface{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, 3750000.0, body["projected_assets_1_year"])
+		assert.Equal(t, 2.5, body["emergency_fund_status"].(map[string]interface{})["months_of_expenses_covered"])
+		mockUseCase.AssertExpectations(t)
+	})
+
+	t.Run("Error: other user's ID is forbidden", func(t *testing.T) {
+		mockUseCase := new(MockCalculateProjectionUseCase)
+		c, _ := newContext(userID)
+		c.Set("user_id", "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e")
+
+		err := NewCalculationsController(mockUseCase).CalculateQuickProjection(c)
+
+		var httpErr *echo.HTTPError
+		assert.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusForbidden, httpErr.Code)
+		mockUseCase.AssertNotCalled(t, "CalculateQuickProjection", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error: financial plan not found", func(t *testing.T) {
+		mockUseCase := new(MockCalculateProjectionUseCase)
+		mockUseCase.On("CalculateQuickProjection", mock.Anything, mock.Anything).Return(nil, errors.New("財務計画の取得に失敗しました: 財務データが見つかりません"))
+		c, rec := newContext(userID)
+
+		err := NewCalculationsController(mockUseCase).CalculateQuickProjection(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Empty(t, rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("Error: use case failure is not cached", func(t *testing.T) {
+		mockUseCase := new(MockCalculateProjectionUseCase)
+		mockUseCase.On("CalculateQuickProjection", mock.Anything, mock.Anything).Return(nil, errors.New("予測の計算に失敗しました"))
+		c, rec := newContext(userID)
+
+		err := NewCalculationsController(mockUseCase).CalculateQuickProjection(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Empty(t, rec.Header().Get("Cache-Control"))
+	})
+
+	t.Run("Performance: completes within 100ms on a realistic profile", func(t *testing.T) {
+		controller := newQuickProjectionBenchmarkController(t, userID)
+
+		// 初回のみの初期化コストを除くため、1回実行してから計測する
+		c, rec := newContext(userID)
+		if err := controller.CalculateQuickProjection(c); err != nil || rec.Code != http.StatusOK {
+			t.Fatalf("unexpected response: err=%v status=%d body=%s", err, rec.Code, rec.Body.String())
+		}
+
+		const runs = 20
+		start := time.Now()
+		for i := 0; i < runs; i++ {
+			c, rec := newContext(userID)
+			if err := controller.CalculateQuickProjection(c); err != nil || rec.Code != http.StatusOK {
+				t.Fatalf("unexpected response: err=%v status=%d", err, rec.Code)
+			}
+		}
+		if average := time.Since(start) / runs; average >= 100*time.Millisecond {
+			t.Errorf("quick projection took %v on average, want < 100ms", average)
+		}
+	})
+}
+
+func BenchmarkCalculateQuickProjection(b *testing.B) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	controller := newQuickProjectionBenchmarkController(b, userID)
+	e := echo.New()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/financial-data/"+userID+"/projections/quick", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id")
+		c.SetParamValues(userID)
+		c.Set("user_id", userID)
+		if err := controller.CalculateQuickProjection(c); err != nil || rec.Code != http.StatusOK {
+			b.Fatalf("unexpected response: err=%v status=%d", err, rec.Code)
+		}
+	}
+}
+
+// newQuickProjectionBenchmarkController は実際のユースケースとインメモリリポジトリで、
+// 支出・貯蓄の内訳、住宅ローン、緊急資金を登録した現実的な財務計画を用意したコントローラーを作成する
+func newQuickProjectionBenchmarkController(tb testing.TB, userID string) *CalculationsController {
+	tb.Helper()
+	money := func(amount float64) valueobjects.Money {
+		m, err := valueobjects.NewMoneyJPY(amount)
+		if err != nil {
+			tb.Fatalf("failed to create money: %v", err)
+		}
+		return m
+	}
+
+	investmentReturn, _ := valueobjects.NewRate(4.0)
+	inflationRate, _ := valueobjects.NewRate(2.0)
+	profile, err := entities.NewFinancialProfile(
+		entities.UserID(userID),
+		money(450000),
+		entities.ExpenseCollection{
+			{Category: "住居費", Amount: money(110000)},
+			{Category: "食費", Amount: money(65000)},
+			{Category: "光熱費", Amount: money(18000)},
+			{Category: "通信費", Amount: money(12000)},
+			{Category: "保険", Amount: money(20000)},
+			{Category: "その他", Amount: money(40000)},
+		},
+		entities.SavingsCollection{
+			{Type: "deposit", Amount: money(2500000)},
+			{Type: "investment", Amount: money(4000000)},
+			{Type: "other", Amount: money(300000)},
+		},
+		investmentReturn,
+		inflationRate,
+	)
+	if err != nil {
+		tb.Fatalf("failed to create profile: %v", err)
+	}
+	mortgageRate, _ := valueobjects.NewRate(1.2)
+	mortgage, err := entities.NewLiability(entities.LiabilityTypeMortgage, "住宅ローン", money(28000000), mortgageRate, money(95000), time.Now().AddDate(30, 0, 0), false)
+	if err != nil {
+		tb.Fatalf("failed to create liability: %v", err)
+	}
+	profile.SetLiabilities(entities.LiabilityCollection{mortgage})
+
+	plan, err := aggregates.NewFinancialPlan(profile)
+	if err != nil {
+		tb.Fatalf("failed to create plan: %v", err)
+	}
+	if err := plan.UpdateEmergencyFundSettings(6, money(1200000)); err != nil {
+		tb.Fatalf("failed to set emergency fund: %v", err)
+	}
+
+	store := inmemory.NewStore()
+	planRepo := inmemory.NewFinancialPlanRepository(store)
+	if err := planRepo.Save(context.Background(), plan); err != nil {
+		tb.Fatalf("failed to save plan: %v", err)
+	}
+
+	calcService := services.NewFinancialCalculationService()
+	useCase := usecases.NewCalculateProjectionUseCase(planRepo, inmemory.NewGoalRepository(store), calcService, services.NewGoalRecommendationService(calcService))
+	return NewCalculationsController(useCase)
+}
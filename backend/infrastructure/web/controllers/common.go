@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/infrastructure/i18n"
 	"github.com/financial-planning-calculator/backend/infrastructure/log"
 	"github.com/labstack/echo/v4"
 )
@@ -17,6 +18,13 @@ type ErrorResponse struct {
 	Code      string      `json:"code,omitempty"`
 }
 
+// VersionConflictResponse は更新しようとしたデータが他のセッションで先に更新されていた場合の 409 レスポンス
+// クライアントは current_version を使って最新のデータを取得し直してから再送する
+type VersionConflictResponse struct {
+	Error          string `json:"error"` // 常に "version_conflict"
+	CurrentVersion int    `json:"current_version"`
+}
+
 // ErrorCode represents different types of errors
 type ErrorCode string
 
@@ -35,6 +43,10 @@ const (
 	ErrorCodeDataIntegrity      ErrorCode = "DATA_INTEGRITY_ERROR"
 	ErrorCodeCalculation        ErrorCode = "CALCULATION_ERROR"
 	ErrorCodeInsufficientData   ErrorCode = "INSUFFICIENT_DATA"
+	// ErrorCodeAssumptionAcknowledgementRequired は現実的な上限を超える想定値に同意が必要なことを表す
+	ErrorCodeAssumptionAcknowledgementRequired ErrorCode = "ASSUMPTION_ACKNOWLEDGEMENT_REQUIRED"
+	// ErrorCodeTwoFactorRequired は必須化ポリシーにより2段階認証の設定が必要なことを表す
+	ErrorCodeTwoFactorRequired ErrorCode = "2FA_REQUIRED"
 )
 
 // BusinessLogicError represents business logic validation errors
@@ -65,14 +77,29 @@ func NewErrorResponse(ctx echo.Context, code ErrorCode, message string, details
 	}
 }
 
+// RequestLanguage はリクエストの Accept-Language からエラーメッセージの言語を返す（未対応の言語は日本語）
+func RequestLanguage(ctx echo.Context) i18n.Language {
+	return i18n.ParseAcceptLanguage(ctx.Request().Header.Get("Accept-Language"))
+}
+
+// NewLocalizedErrorResponse はエラーコードに対応するリクエストの言語のメッセージでエラーレスポンスを作成する
+func NewLocalizedErrorResponse(ctx echo.Context, code ErrorCode, details interface{}) ErrorResponse {
+	return NewErrorResponse(ctx, code, i18n.Message(RequestLanguage(ctx), string(code)), details)
+}
+
 // NewValidationErrorResponse creates a validation error response
 func NewValidationErrorResponse(ctx echo.Context, details interface{}) ErrorResponse {
-	return NewErrorResponse(ctx, ErrorCodeValidation, "入力値が無効です", details)
+	return NewLocalizedErrorResponse(ctx, ErrorCodeValidation, details)
+}
+
+// NewInvalidUserIDErrorResponse creates an error response for a user ID that is not a UUID v4
+func NewInvalidUserIDErrorResponse(ctx echo.Context) ErrorResponse {
+	return NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDの形式が正しくありません", nil)
 }
 
 // NewBusinessLogicErrorResponse creates a business logic error response
 func NewBusinessLogicErrorResponse(ctx echo.Context, errors []BusinessLogicError) ErrorResponse {
-	return NewErrorResponse(ctx, ErrorCodeBusinessLogic, "ビジネスロジックエラーが発生しました", errors)
+	return NewLocalizedErrorResponse(ctx, ErrorCodeBusinessLogic, errors)
 }
 
 // NewNotFoundErrorResponse creates a not found error response
@@ -82,7 +109,7 @@ func NewNotFoundErrorResponse(ctx echo.Context, resource string) ErrorResponse {
 
 // NewInternalServerErrorResponse creates an internal server error response
 func NewInternalServerErrorResponse(ctx echo.Context, details string) ErrorResponse {
-	return NewErrorResponse(ctx, ErrorCodeInternalServer, "内部サーバーエラーが発生しました", details)
+	return NewLocalizedErrorResponse(ctx, ErrorCodeInternalServer, details)
 }
 
 // NewConflictErrorResponse creates a conflict error response
@@ -92,28 +119,31 @@ func NewConflictErrorResponse(ctx echo.Context, resource string) ErrorResponse {
 
 // NewCalculationErrorResponse creates a calculation error response
 func NewCalculationErrorResponse(ctx echo.Context, details string) ErrorResponse {
-	return NewErrorResponse(ctx, ErrorCodeCalculation, "計算処理でエラーが発生しました", details)
+	return NewLocalizedErrorResponse(ctx, ErrorCodeCalculation, details)
 }
 
 // NewInsufficientDataErrorResponse creates an insufficient data error response
 func NewInsufficientDataErrorResponse(ctx echo.Context, missingData string) ErrorResponse {
-	return NewErrorResponse(ctx, ErrorCodeInsufficientData, "計算に必要なデータが不足しています", map[string]string{
+	return NewLocalizedErrorResponse(ctx, ErrorCodeInsufficientData, map[string]string{
 		"missing_data": missingData,
-		"suggestion":   "必要なデータを入力してから再度お試しください",
+		"suggestion":   i18n.Message(RequestLanguage(ctx), string(ErrorCodeInsufficientData)+".suggestion"),
 	})
 }
 
 // NewDataIntegrityErrorResponse creates a data integrity error response
 func NewDataIntegrityErrorResponse(ctx echo.Context, details string) ErrorResponse {
-	return NewErrorResponse(ctx, ErrorCodeDataIntegrity, "データの整合性エラーが発生しました", details)
+	return NewLocalizedErrorResponse(ctx, ErrorCodeDataIntegrity, details)
 }
 
 // ValidateBusinessLogic validates business logic and returns errors if any
+// エラーのメッセージと提案はエラータイプをキーにリクエストの言語で設定する
 func ValidateBusinessLogic(ctx echo.Context, validations ...func() *BusinessLogicError) error {
 	var errors []BusinessLogicError
 
+	lang := RequestLanguage(ctx)
 	for _, validation := range validations {
 		if err := validation(); err != nil {
+			err.localize(lang)
 			errors = append(errors, *err)
 		}
 	}
@@ -126,12 +156,19 @@ func ValidateBusinessLogic(ctx echo.Context, validations ...func() *BusinessLogi
 	return nil
 }
 
+// localize はエラータイプに対応するメッセージと提案を指定した言語で設定する
+func (e *BusinessLogicError) localize(lang i18n.Language) {
+	e.Message = i18n.Message(lang, e.Type)
+	if suggestionKey := e.Type + ".suggestion"; i18n.Has(suggestionKey) {
+		e.Suggestion = i18n.Message(lang, suggestionKey)
+	}
+}
+
 // CreateBusinessLogicError creates a business logic error
-func CreateBusinessLogicError(errorType, message, suggestion string, currentValue, expectedValue interface{}) *BusinessLogicError {
+// メッセージと提案はエラータイプをキーにメッセージ辞書から設定されるため、期待値は言語に依存しない形式で指定する
+func CreateBusinessLogicError(errorType string, currentValue, expectedValue interface{}) *BusinessLogicError {
 	return &BusinessLogicError{
 		Type:          errorType,
-		Message:       message,
-		Suggestion:    suggestion,
 		CurrentValue:  currentValue,
 		ExpectedValue: expectedValue,
 		Severity:      "error",
@@ -139,12 +176,10 @@ func CreateBusinessLogicError(errorType, message, suggestion string, currentValu
 }
 
 // CreateBusinessLogicErrorWithField creates a business logic error with field information
-func CreateBusinessLogicErrorWithField(errorType, field, message, suggestion string, currentValue, expectedValue interface{}) *BusinessLogicError {
+func CreateBusinessLogicErrorWithField(errorType, field string, currentValue, expectedValue interface{}) *BusinessLogicError {
 	return &BusinessLogicError{
 		Type:          errorType,
 		Field:         field,
-		Message:       message,
-		Suggestion:    suggestion,
 		CurrentValue:  currentValue,
 		ExpectedValue: expectedValue,
 		Severity:      "error",
@@ -152,11 +187,9 @@ func CreateBusinessLogicErrorWithField(errorType, field, message, suggestion str
 }
 
 // CreateBusinessLogicWarning creates a business logic warning
-func CreateBusinessLogicWarning(errorType, message, suggestion string, currentValue, expectedValue interface{}) *BusinessLogicError {
+func CreateBusinessLogicWarning(errorType string, currentValue, expectedValue interface{}) *BusinessLogicError {
 	return &BusinessLogicError{
 		Type:          errorType,
-		Message:       message,
-		Suggestion:    suggestion,
 		CurrentValue:  currentValue,
 		ExpectedValue: expectedValue,
 		Severity:      "warning",
@@ -164,11 +197,9 @@ func CreateBusinessLogicWarning(errorType, message, suggestion string, currentVa
 }
 
 // CreateBusinessLogicInfo creates a business logic info message
-func CreateBusinessLogicInfo(errorType, message, suggestion string, currentValue, expectedValue interface{}) *BusinessLogicError {
+func CreateBusinessLogicInfo(errorType string, currentValue, expectedValue interface{}) *BusinessLogicError {
 	return &BusinessLogicError{
 		Type:          errorType,
-		Message:       message,
-		Suggestion:    suggestion,
 		CurrentValue:  currentValue,
 		ExpectedValue: expectedValue,
 		Severity:      "info",
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// businessLogicErrorBody はビジネスロジックエラーのレスポンス本文
+type businessLogicErrorBody struct {
+	Error   string               `json:"error"`
+	Code    string               `json:"code"`
+	Details []BusinessLogicError `json:"details"`
+}
+
+func TestValidateBusinessLogic_Localization(t *testing.T) {
+	validate := func(acceptLanguage string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/goals", nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+
+		err := ValidateBusinessLogic(c,
+			func() *BusinessLogicError {
+				return CreateBusinessLogicErrorWithField("INVALID_TARGET_AMOUNT", "TargetAmount", -1.0, "> 0")
+			},
+			func() *BusinessLogicError { return nil },
+		)
+		require.NoError(t, err)
+		return rec
+	}
+
+	t.Run("正常系: エラーコードから日本語のメッセージと提案を設定する", func(t *testing.T) {
+		rec := validate("")
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		var body businessLogicErrorBody
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "ビジネスロジックエラーが発生しました", body.Error)
+		assert.Equal(t, string(ErrorCodeBusinessLogic), body.Code)
+		require.Len(t, body.Details, 1)
+		assert.Equal(t, "INVALID_TARGET_AMOUNT", body.Details[0].Type)
+		assert.Equal(t, "TargetAmount", body.Details[0].Field)
+		assert.Equal(t, "目標金額は0より大きい値を入力してください", body.Details[0].Message)
+		assert.Equal(t, "達成したい具体的な金額を入力してください", body.Details[0].Suggestion)
+		assert.Equal(t, "> 0", body.Details[0].ExpectedValue)
+	})
+
+	t.Run("正常系: Accept-Languageが英語の場合は英語のメッセージを返す", func(t *testing.T) {
+		rec := validate("en-US,en;q=0.9,ja;q=0.8")
+
+		var body businessLogicErrorBody
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "Business rule validation failed", body.Error)
+		require.Len(t, body.Details, 1)
+		assert.Equal(t, "Target amount must be greater than 0", body.Details[0].Message)
+		assert.Equal(t, "Enter the specific amount you want to reach", body.Details[0].Suggestion)
+	})
+
+	t.Run("正常系: 未対応の言語は日本語にフォールバックする", func(t *testing.T) {
+		rec := validate("fr-FR")
+
+		var body businessLogicErrorBody
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "目標金額は0より大きい値を入力してください", body.Details[0].Message)
+	})
+
+	t.Run("正常系: エラーがない場合はレスポンスを書き込まない", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/goals", nil), rec)
+
+		assert.NoError(t, ValidateBusinessLogic(c, func() *BusinessLogicError { return nil }))
+		assert.False(t, c.Response().Committed)
+	})
+}
@@ -22,20 +22,25 @@ func NewCSVFinancialDataController(useCase usecases.CSVFinancialDataUseCase) *CS
 
 // DownloadCSV は財務データをCSVファイルとして返す
 //
-// GET /api/financial-data/csv?user_id={user_id}
+// GET /api/v1/financial-data/csv?user_id={user_id}
 //
 // レスポンスヘッダー:
 //
 //	Content-Type: text/csv; charset=utf-8
 //	Content-Disposition: attachment; filename="financial_data.csv"
 func (c *CSVFinancialDataController) DownloadCSV(ctx echo.Context) error {
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
 	data, err := c.useCase.ExportFinancialDataToCSV(ctx.Request().Context(), usecases.ExportCSVInput{
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	})
 	if err != nil {
 		errMsg := err.Error()
@@ -52,7 +57,7 @@ func (c *CSVFinancialDataController) DownloadCSV(ctx echo.Context) error {
 
 // ImportCSV はCSVファイルをアップロードして財務データを保存する
 //
-// POST /api/financial-data/csv/import
+// POST /api/v1/financial-data/csv/import
 // Content-Type: multipart/form-data
 // Form fields: file (CSV), user_id
 func (c *CSVFinancialDataController) ImportCSV(ctx echo.Context) error {
@@ -82,8 +87,13 @@ func (c *CSVFinancialDataController) ImportCSV(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	output, err := c.useCase.ImportFinancialDataFromCSV(ctx.Request().Context(), usecases.ImportCSVInput{
-		UserID:  entities.UserID(userID),
+		UserID:  uid,
 		CSVData: csvData,
 	})
 	if err != nil {
@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/i18n"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// ValidationResult は入力内容の事前検証の結果
+// 検証自体は成功しているため、違反の有無にかかわらず 200 で返す
+type ValidationResult struct {
+	Valid  bool                    `json:"valid"`
+	Errors []FieldValidationResult `json:"errors,omitempty"`
+}
+
+// FieldValidationResult は項目ごとの検証結果
+// Code はバリデーションタグ（例: gt）または業務ルールのエラータイプ（例: INSUFFICIENT_SAVINGS）
+type FieldValidationResult struct {
+	Field   string `json:"field"`
+	Valid   bool   `json:"valid"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// FieldValidationErrors は検証エラーを項目ごとの結果として返せるエラー詳細
+// web.ValidationErrorResponse が実装し、echo.HTTPError の Message として返される
+type FieldValidationErrors interface {
+	FieldResults(lang i18n.Language) []FieldValidationResult
+}
+
+// DryRunValidator はユースケースやリポジトリを呼ばずにリクエストを検証する
+// 作成時と同じバリデーションタグ・業務ルールを適用し、最初の違反で止めずに項目ごとの結果を集める
+type DryRunValidator struct{}
+
+// ValidateCreateFinancialData は財務データ作成リクエストを検証する
+// 作成時と同じく省略された項目に既定値を設定してから業務ルールを適用する
+// 項目ごとの結果に変換できない検証エラー（バリデーター未登録など）はエラーとして返す
+func (DryRunValidator) ValidateCreateFinancialData(ctx echo.Context, req *CreateFinancialDataRequest) (ValidationResult, error) {
+	lang := RequestLanguage(ctx)
+	var results []FieldValidationResult
+
+	// バリデーションタグで違反した項目は、同じ項目の業務ルールの結果を重ねて返さない
+	invalidFields := make(map[string]bool)
+	if err := ctx.Validate(req); err != nil {
+		fieldErrors, ok := fieldValidationResults(err, lang)
+		if !ok {
+			return ValidationResult{}, err
+		}
+		for _, fieldError := range fieldErrors {
+			invalidFields[fieldError.Field] = true
+		}
+		results = append(results, fieldErrors...)
+	}
+
+	if req.UserID != "" && !invalidFields["user_id"] {
+		if _, err := entities.NewUserID(req.UserID); err != nil {
+			results = append(results, FieldValidationResult{
+				Field:   "user_id",
+				Code:    "INVALID_USER_ID",
+				Message: i18n.Message(lang, "INVALID_USER_ID"),
+			})
+		}
+	}
+
+	applyCreateFinancialDataDefaults(req)
+	for _, rule := range createFinancialDataRules(req) {
+		businessErr := rule()
+		if businessErr == nil || invalidFields[businessErr.Field] {
+			continue
+		}
+		businessErr.localize(lang)
+		results = append(results, FieldValidationResult{
+			Field:   businessErr.Field,
+			Code:    businessErr.Type,
+			Message: businessErr.Message,
+		})
+	}
+
+	return ValidationResult{Valid: len(results) == 0, Errors: results}, nil
+}
+
+// fieldValidationResults はバリデーターのエラーを項目ごとの結果に変換する
+func fieldValidationResults(err error, lang i18n.Language) ([]FieldValidationResult, bool) {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		if fieldErrors, ok := httpErr.Message.(FieldValidationErrors); ok {
+			return fieldErrors.FieldResults(lang), true
+		}
+		return nil, false
+	}
+
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		results := make([]FieldValidationResult, 0, len(validationErrs))
+		for _, validationErr := range validationErrs {
+			results = append(results, FieldValidationResult{
+				Field:   validationErr.Field(),
+				Code:    validationErr.Tag(),
+				Message: validationErr.Error(),
+			})
+		}
+		return results, true
+	}
+
+	return nil, false
+}
+
+// applyCreateFinancialDataDefaults は財務データ作成リクエストで省略された項目に既定値を設定する
+func applyCreateFinancialDataDefaults(req *CreateFinancialDataRequest) {
+	if req.MonthlyIncome == 0 {
+		req.MonthlyIncome = 300000 // デフォルト: 30万円
+	}
+	if len(req.MonthlyExpenses) == 0 {
+		req.MonthlyExpenses = []ExpenseItemRequest{
+			{Category: "生活費", Amount: 100000},
+		}
+	}
+	if len(req.CurrentSavings) == 0 {
+		req.CurrentSavings = []SavingsItemRequest{
+			{Type: "deposit", Amount: 500000},
+		}
+	}
+}
+
+// createFinancialDataRules は財務データ作成時の業務ルールを返す
+// 作成（ValidateBusinessLogic）と事前検証（DryRunValidator）で共有する
+func createFinancialDataRules(req *CreateFinancialDataRequest) []func() *BusinessLogicError {
+	return []func() *BusinessLogicError{
+		func() *BusinessLogicError {
+			// 要件1.4: 入力値が無効（負の値など）の場合のエラー
+			if req.MonthlyIncome <= 0 {
+				return CreateBusinessLogicErrorWithField(
+					"INVALID_MONTHLY_INCOME",
+					"monthly_income",
+					req.MonthlyIncome,
+					"> 0",
+				)
+			}
+			return nil
+		},
+		func() *BusinessLogicError {
+			// 投資利回りの妥当性チェック
+			if req.InvestmentReturn < 0 || req.InvestmentReturn > 100 {
+				return CreateBusinessLogicErrorWithField(
+					"INVALID_INVESTMENT_RETURN",
+					"investment_return",
+					req.InvestmentReturn,
+					"0-100%",
+				)
+			}
+			return nil
+		},
+		func() *BusinessLogicError {
+			// インフレ率の妥当性チェック
+			if req.InflationRate < 0 || req.InflationRate > 50 {
+				return CreateBusinessLogicErrorWithField(
+					"INVALID_INFLATION_RATE",
+					"inflation_rate",
+					req.InflationRate,
+					"0-50%",
+				)
+			}
+			return nil
+		},
+		func() *BusinessLogicError {
+			// 支出項目の妥当性チェック
+			totalExpenses := 0.0
+			for _, expense := range req.MonthlyExpenses {
+				if expense.Amount <= 0 {
+					return CreateBusinessLogicErrorWithField(
+						"INVALID_EXPENSE_AMOUNT",
+						"monthly_expenses",
+						expense.Amount,
+						"> 0",
+					)
+				}
+				totalExpenses += expense.Amount
+			}
+
+			// 要件2.4: 貯蓄額が月間支出を下回る場合の警告
+			monthlySavings := req.MonthlyIncome - totalExpenses
+			if monthlySavings < 0 {
+				return CreateBusinessLogicErrorWithField(
+					"INSUFFICIENT_SAVINGS",
+					"monthly_expenses",
+					monthlySavings,
+					"> 0",
+				)
+			}
+
+			return nil
+		},
+	}
+}
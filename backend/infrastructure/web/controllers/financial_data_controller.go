@@ -3,14 +3,19 @@ package controllers
 import (
 	"bytes"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/labstack/echo/v4"
 )
 
@@ -209,6 +214,10 @@ func NewFinancialDataController(useCase usecases.ManageFinancialDataUseCase) *Fi
 type CreateFinancialDataRequest struct {
 	UserID                     string               `json:"user_id" validate:"required"`
 	MonthlyIncome              float64              `json:"monthly_income" validate:"omitempty,gt=0"`
+	IncomeType                 string               `json:"income_type,omitempty" validate:"omitempty,oneof=gross net"` // 省略時は手取り（net）
+	BirthDate                  *string              `json:"birth_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	ExpectedVolatility         *float64             `json:"expected_volatility,omitempty" validate:"omitempty,gte=0,lte=100"`                     // 省略時は貯蓄の内訳から推定
+	RiskTolerance              string               `json:"risk_tolerance,omitempty" validate:"omitempty,oneof=conservative moderate aggressive"` // 省略時はバランス（moderate）
 	MonthlyExpenses            []ExpenseItemRequest `json:"monthly_expenses" validate:"omitempty,dive"`
 	CurrentSavings             []SavingsItemRequest `json:"current_savings" validate:"omitempty,dive"`
 	InvestmentReturn           float64              `json:"investment_return" validate:"required,gte=0,lte=100"`
@@ -218,29 +227,42 @@ type CreateFinancialDataRequest struct {
 	PensionAmount              *float64             `json:"pension_amount,omitempty" validate:"omitempty,gte=0"`
 	EmergencyFundTargetMonths  *int                 `json:"emergency_fund_target_months,omitempty" validate:"omitempty,gte=1,lte=24"`
 	EmergencyFundCurrentAmount *float64             `json:"emergency_fund_current_amount,omitempty" validate:"omitempty,gte=0"`
+	// 現実的な上限（デフォルト: 利回り8%・インフレ率10%）を超える値を承知の上で使用する場合に true を指定する
+	AcknowledgeHighReturn    bool `json:"acknowledge_high_return"`
+	AcknowledgeHighInflation bool `json:"acknowledge_high_inflation"`
 }
 
 // ExpenseItemRequest は支出項目リクエスト
 type ExpenseItemRequest struct {
-	Category    string  `json:"category" validate:"required,min=1"`
+	Category    string  `json:"category" validate:"required,safetext,notblank,max=50"`
 	Amount      float64 `json:"amount" validate:"required,gt=0"`
-	Description *string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty" validate:"omitempty,safetext,max=500"`
 }
 
 // SavingsItemRequest は貯蓄項目リクエスト
 type SavingsItemRequest struct {
 	Type        string  `json:"type" validate:"required,oneof=deposit investment other"`
 	Amount      float64 `json:"amount" validate:"required,gte=0"`
-	Description *string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty" validate:"omitempty,safetext,max=500"`
 }
 
 // UpdateFinancialProfileRequest は財務プロファイル更新リクエスト
 type UpdateFinancialProfileRequest struct {
-	MonthlyIncome    float64              `json:"monthly_income" validate:"omitempty,gt=0"`
-	MonthlyExpenses  []ExpenseItemRequest `json:"monthly_expenses" validate:"omitempty,dive"`
-	CurrentSavings   []SavingsItemRequest `json:"current_savings" validate:"omitempty,dive"`
-	InvestmentReturn float64              `json:"investment_return" validate:"required,gte=0,lte=100"`
-	InflationRate    float64              `json:"inflation_rate" validate:"required,gte=0,lte=50"`
+	MonthlyIncome      float64              `json:"monthly_income" validate:"omitempty,gt=0"`
+	IncomeType         string               `json:"income_type,omitempty" validate:"omitempty,oneof=gross net"`                           // 省略時は手取り（net）
+	BirthDate          *string              `json:"birth_date,omitempty" validate:"omitempty,datetime=2006-01-02"`                        // 省略時は既存の値を引き継ぐ
+	ExpectedVolatility *float64             `json:"expected_volatility,omitempty" validate:"omitempty,gte=0,lte=100"`                     // 省略時は既存の値を引き継ぐ
+	RiskTolerance      string               `json:"risk_tolerance,omitempty" validate:"omitempty,oneof=conservative moderate aggressive"` // 省略時は既存の値を引き継ぐ
+	MonthlyExpenses    []ExpenseItemRequest `json:"monthly_expenses" validate:"omitempty,dive"`
+	CurrentSavings     []SavingsItemRequest `json:"current_savings" validate:"omitempty,dive"`
+	InvestmentReturn   float64              `json:"investment_return" validate:"required,gte=0,lte=100"`
+	InflationRate      float64              `json:"inflation_rate" validate:"required,gte=0,lte=50"`
+	// 現実的な上限（デフォルト: 利回り8%・インフレ率10%）を超える値を承知の上で使用する場合に true を指定する
+	AcknowledgeHighReturn    bool `json:"acknowledge_high_return"`
+	AcknowledgeHighInflation bool `json:"acknowledge_high_inflation"`
+	// 取得した財務データの version。指定した場合、他のセッションで先に更新されていれば 409 を返し更新しない
+	// version を送らない既存クライアントとの互換のため省略も受け付ける（その場合は保存時の競合のみ検出する）
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
 }
 
 // UpdateRetirementDataRequest は退職データ更新リクエスト
@@ -248,12 +270,62 @@ type UpdateRetirementDataRequest struct {
 	RetirementAge             int     `json:"retirement_age" validate:"required,gte=50,lte=100"`
 	MonthlyRetirementExpenses float64 `json:"monthly_retirement_expenses" validate:"required,gt=0"`
 	PensionAmount             float64 `json:"pension_amount" validate:"required,gte=0"`
+	// 国民年金の月額。未指定で納付年数が指定された場合は納付年数から推定し、どちらも未指定の場合は現在の値を引き継ぐ
+	KokuminNenkinMonthlyAmount     *float64 `json:"kokumin_nenkin_monthly_amount,omitempty" validate:"omitempty,gte=0"`
+	KokuminNenkinContributionYears *int     `json:"kokumin_nenkin_contribution_years,omitempty" validate:"omitempty,gte=0,lte=40"`
+	// 介護保険料の月額。未指定の場合は現在の値を引き継ぐ
+	NurseCareInsuranceMonthly *float64 `json:"nurse_care_insurance_monthly,omitempty" validate:"omitempty,gte=0"`
+	// 取得した財務データの version（省略時は既存クライアントとの互換のため確認しない）
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// UpdateCurrentAgeRequest は現在の年齢更新リクエスト
+type UpdateCurrentAgeRequest struct {
+	CurrentAge *int `json:"current_age" validate:"required,gte=0,lte=150"`
+	Version    *int `json:"version,omitempty" validate:"omitempty,gte=1"` // 取得した財務データの version（省略時は既存クライアントとの互換のため確認しない）
+}
+
+// ApplyPensionEstimateRequest は年金見込み額反映リクエスト
+type ApplyPensionEstimateRequest struct {
+	ContributionYears int     `json:"contribution_years" validate:"required,gte=1,lte=50"`
+	MonthlyIncome     float64 `json:"monthly_income" validate:"required,gt=0"`
+	BirthYear         int     `json:"birth_year" validate:"required,gte=1900,lte=2100"`
+	Version           *int    `json:"version,omitempty" validate:"omitempty,gte=1"` // 取得した財務データの version（省略時は既存クライアントとの互換のため確認しない）
 }
 
 // UpdateEmergencyFundRequest は緊急資金更新リクエスト
 type UpdateEmergencyFundRequest struct {
 	TargetMonths  int     `json:"target_months" validate:"required,gte=1,lte=24"`
 	CurrentAmount float64 `json:"current_amount" validate:"required,gte=0"`
+	// 毎月の積立予定額（省略時は現在の設定を維持する）
+	MonthlyContribution *float64 `json:"monthly_contribution,omitempty" validate:"omitempty,gte=0"`
+	// 取得した財務データの version（省略時は既存クライアントとの互換のため確認しない）
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
+}
+
+// ValidateFinancialData は財務データ作成リクエストを保存せずに検証する
+// @Summary 財務データ作成リクエストの事前検証
+// @Description 入力途中の財務データを作成時と同じルールで検証し、項目ごとの結果を返します。データは保存されず、違反があっても200を返します
+// @Tags financial-data
+// @Accept json
+// @Produce json
+// @Param request body CreateFinancialDataRequest true "財務データ作成リクエスト"
+// @Success 200 {object} ValidationResult
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/validate [post]
+func (c *FinancialDataController) ValidateFinancialData(ctx echo.Context) error {
+	var req CreateFinancialDataRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	result, err := DryRunValidator{}.ValidateCreateFinancialData(ctx, &req)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, result)
 }
 
 // CreateFinancialData は財務データを作成する
@@ -265,6 +337,7 @@ type UpdateEmergencyFundRequest struct {
 // @Param request body CreateFinancialDataRequest true "財務データ作成リクエスト"
 // @Success 201 {object} usecases.FinancialDataResponse
 // @Failure 400 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse "想定値が現実的な上限を超えており同意が必要"
 // @Failure 500 {object} ErrorResponse
 // @Router /financial-data [post]
 func (c *FinancialDataController) CreateFinancialData(ctx echo.Context) error {
@@ -277,105 +350,26 @@ func (c *FinancialDataController) CreateFinancialData(ctx echo.Context) error {
 		return err // Validator already returns proper error response
 	}
 
-	// デフォルト値を設定
-	if req.MonthlyIncome == 0 {
-		req.MonthlyIncome = 300000 // デフォルト: 30万円
-	}
-	if len(req.MonthlyExpenses) == 0 {
-		req.MonthlyExpenses = []ExpenseItemRequest{
-			{Category: "生活費", Amount: 100000},
-		}
-	}
-	if len(req.CurrentSavings) == 0 {
-		req.CurrentSavings = []SavingsItemRequest{
-			{Type: "deposit", Amount: 500000},
-		}
-	}
+	applyCreateFinancialDataDefaults(&req)
 
 	// Business logic validation
-	if err := ValidateBusinessLogic(ctx,
-		func() *BusinessLogicError {
-			// 要件1.4: 入力値が無効（負の値など）の場合のエラー
-			if req.MonthlyIncome <= 0 {
-				return CreateBusinessLogicError(
-					"INVALID_MONTHLY_INCOME",
-					"月収は0より大きい値を入力してください",
-					"正の数値を入力してください",
-					req.MonthlyIncome,
-					"正の数値",
-				)
-			}
-			return nil
-		},
-		func() *BusinessLogicError {
-			// 投資利回りの妥当性チェック
-			if req.InvestmentReturn < 0 || req.InvestmentReturn > 100 {
-				return CreateBusinessLogicError(
-					"INVALID_INVESTMENT_RETURN",
-					"投資利回りは0%から100%の範囲で入力してください",
-					"現実的な投資利回り（例：3-7%）を入力してください",
-					req.InvestmentReturn,
-					"0-100%",
-				)
-			}
-			return nil
-		},
-		func() *BusinessLogicError {
-			// インフレ率の妥当性チェック
-			if req.InflationRate < 0 || req.InflationRate > 50 {
-				return CreateBusinessLogicError(
-					"INVALID_INFLATION_RATE",
-					"インフレ率は0%から50%の範囲で入力してください",
-					"現実的なインフレ率（例：1-3%）を入力してください",
-					req.InflationRate,
-					"0-50%",
-				)
-			}
-			return nil
-		},
-		func() *BusinessLogicError {
-			// 支出項目の妥当性チェック
-			totalExpenses := 0.0
-			for _, expense := range req.MonthlyExpenses {
-				if expense.Amount <= 0 {
-					return CreateBusinessLogicError(
-						"INVALID_EXPENSE_AMOUNT",
-						"支出金額は0より大きい値を入力してください",
-						"正の数値を入力してください",
-						expense.Amount,
-						"正の数値",
-					)
-				}
-				totalExpenses += expense.Amount
-			}
-
-			// 要件2.4: 貯蓄額が月間支出を下回る場合の警告
-			totalSavings := 0.0
-			for _, saving := range req.CurrentSavings {
-				totalSavings += saving.Amount
-			}
-
-			monthlySavings := req.MonthlyIncome - totalExpenses
-			if monthlySavings < 0 {
-				return CreateBusinessLogicError(
-					"INSUFFICIENT_SAVINGS",
-					"月間支出が月収を上回っています",
-					"支出を見直すか、収入を増やすことを検討してください",
-					monthlySavings,
-					"正の数値",
-				)
-			}
-
-			return nil
-		},
-	); err != nil {
+	if err := ValidateBusinessLogic(ctx, createFinancialDataRules(&req)...); err != nil {
 		return err
 	}
 
 	// リクエストをユースケース入力に変換
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.CreateFinancialPlanInput{
-		UserID:                     entities.UserID(req.UserID),
+		UserID:                     uid,
 		MonthlyIncome:              req.MonthlyIncome,
+		IncomeType:                 req.IncomeType,
+		BirthDate:                  req.BirthDate,
+		ExpectedVolatility:         req.ExpectedVolatility,
+		RiskTolerance:              req.RiskTolerance,
 		MonthlyExpenses:            convertExpenseItems(req.MonthlyExpenses),
 		CurrentSavings:             convertSavingsItems(req.CurrentSavings),
 		InvestmentReturn:           req.InvestmentReturn,
@@ -385,6 +379,8 @@ func (c *FinancialDataController) CreateFinancialData(ctx echo.Context) error {
 		PensionAmount:              req.PensionAmount,
 		EmergencyFundTargetMonths:  req.EmergencyFundTargetMonths,
 		EmergencyFundCurrentAmount: req.EmergencyFundCurrentAmount,
+		AcknowledgeHighReturn:      req.AcknowledgeHighReturn,
+		AcknowledgeHighInflation:   req.AcknowledgeHighInflation,
 	}
 
 	// リクエストIDをコンテキストに追加
@@ -392,12 +388,15 @@ func (c *FinancialDataController) CreateFinancialData(ctx echo.Context) error {
 
 	output, err := c.useCase.CreateFinancialPlan(reqCtx, input)
 	if err != nil {
+		if handled, respErr := respondHighAssumptionError(ctx, err); handled {
+			return respErr
+		}
 		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
 	}
 
 	// 作成直後の最新データを取得してフロントエンド向けレスポンスで返す
 	getInput := usecases.GetFinancialPlanInput{
-		UserID: entities.UserID(req.UserID),
+		UserID: uid,
 	}
 	getOutput, getErr := c.useCase.GetFinancialPlan(reqCtx, getInput)
 	if getErr == nil {
@@ -411,26 +410,33 @@ func (c *FinancialDataController) CreateFinancialData(ctx echo.Context) error {
 
 // GetFinancialData は財務データを取得する
 // @Summary 財務データ取得
-// @Description ユーザーの財務計画を取得します
+// @Description ユーザーの財務計画を取得します。ETag を返し、If-None-Match が一致する場合は 304 を返します
 // @Tags financial-data
 // @Produce json
-// @Param user_id query string true "ユーザーID"
+// @Param user_id query string false "ユーザーID（廃止予定。認証済みの場合は認証トークンのユーザーが対象）"
+// @Param If-None-Match header string false "前回取得時の ETag"
 // @Success 200 {object} usecases.FinancialDataResponse
+// @Success 304 "変更なし"
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /financial-data [get]
 func (c *FinancialDataController) GetFinancialData(ctx echo.Context) error {
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
 	}
 
 	// リクエストIDをコンテキストに追加
 	reqCtx := GetRequestContextWithUserID(ctx, userID)
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.GetFinancialPlanInput{
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	}
 
 	output, err := c.useCase.GetFinancialPlan(reqCtx, input)
@@ -446,83 +452,25 @@ func (c *FinancialDataController) GetFinancialData(ctx echo.Context) error {
 	}
 
 	// GetFinancialPlanOutput をフロントエンド向けレスポンスに変換
+	// ETag はルートの ConditionalGetMiddleware が計画・退職・緊急資金を含むレスポンス全体から計算する
+	// レスポンスには更新のたびに増える version を含むため、財務データを更新すると必ず ETag が変わる
 	response := c.convertToFinancialDataResponse(output, userID)
+	if output.Plan != nil {
+		setLastModified(ctx, output.Plan.UpdatedAt())
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
 // convertToFinancialDataResponse は GetFinancialPlanOutput をフロントエンド向けレスポンスに変換
+// 更新系のレスポンスと同じ変換を使い、更新直後のレスポンスと再取得の結果を一致させる
 func (c *FinancialDataController) convertToFinancialDataResponse(
 	output *usecases.GetFinancialPlanOutput,
 	userID string,
 ) *usecases.FinancialDataResponse {
-	if output == nil || output.Plan == nil {
-		return &usecases.FinancialDataResponse{
-			UserID: userID,
-		}
-	}
-
-	response := &usecases.FinancialDataResponse{
-		UserID: userID,
-	}
-
-	// Profile を変換（値オブジェクトをプリミティブ値に変換してフロントエンド互換に）
-	if profile := output.Plan.Profile(); profile != nil {
-		// 月間支出（category, amount, description）
-		expenses := make([]map[string]interface{}, 0, len(profile.MonthlyExpenses()))
-		for _, exp := range profile.MonthlyExpenses() {
-			item := map[string]interface{}{
-				"category": exp.Category,
-				"amount":   exp.Amount.Amount(),
-			}
-			if exp.Description != "" {
-				item["description"] = exp.Description
-			}
-			expenses = append(expenses, item)
-		}
-
-		// 現在の貯蓄（type, amount, description）
-		savings := make([]map[string]interface{}, 0, len(profile.CurrentSavings()))
-		for _, saving := range profile.CurrentSavings() {
-			item := map[string]interface{}{
-				"type":   saving.Type,
-				"amount": saving.Amount.Amount(),
-			}
-			if saving.Description != "" {
-				item["description"] = saving.Description
-			}
-			savings = append(savings, item)
-		}
-
-		profileMap := map[string]interface{}{
-			"monthly_income":    profile.MonthlyIncome().Amount(),
-			"monthly_expenses":  expenses,
-			"current_savings":   savings,
-			"investment_return": profile.InvestmentReturn().AsPercentage(),
-			"inflation_rate":    profile.InflationRate().AsPercentage(),
-		}
-		response.Profile = profileMap
-	}
-
-	// RetirementData を変換（値オブジェクトをプリミティブに）
-	if retirement := output.Plan.RetirementData(); retirement != nil {
-		retirementMap := map[string]interface{}{
-			"retirement_age":              retirement.RetirementAge(),
-			"monthly_retirement_expenses": retirement.MonthlyRetirementExpenses().Amount(),
-			"pension_amount":              retirement.PensionAmount().Amount(),
-		}
-		response.Retirement = retirementMap
+	if output == nil {
+		return usecases.NewFinancialDataResponse(nil, entities.UserID(userID))
 	}
-
-	// EmergencyFund を変換（値オブジェクトをプリミティブに）
-	if emergencyFund := output.Plan.EmergencyFund(); emergencyFund != nil {
-		emergencyMap := map[string]interface{}{
-			"target_months": emergencyFund.TargetMonths,
-			"current_fund":  emergencyFund.CurrentFund.Amount(),
-		}
-		response.EmergencyFund = emergencyMap
-	}
-
-	return response
+	return usecases.NewFinancialDataResponse(output.Plan, entities.UserID(userID))
 }
 
 // UpdateFinancialProfile は財務プロファイルを更新する
@@ -536,6 +484,8 @@ func (c *FinancialDataController) convertToFinancialDataResponse(
 // @Success 200 {object} usecases.UpdateFinancialProfileOutput
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} VersionConflictResponse "他のセッションで先に更新されている"
+// @Failure 422 {object} ErrorResponse "想定値が現実的な上限を超えており同意が必要"
 // @Failure 500 {object} ErrorResponse
 // @Router /financial-data/{user_id}/profile [put]
 func (c *FinancialDataController) UpdateFinancialProfile(ctx echo.Context) error {
@@ -575,10 +525,8 @@ func (c *FinancialDataController) UpdateFinancialProfile(ctx echo.Context) error
 			if req.MonthlyIncome <= 0 {
 				return CreateBusinessLogicError(
 					"INVALID_MONTHLY_INCOME",
-					"月収は0より大きい値を入力してください",
-					"正の数値を入力してください",
 					req.MonthlyIncome,
-					"正の数値",
+					"> 0",
 				)
 			}
 			return nil
@@ -594,10 +542,8 @@ func (c *FinancialDataController) UpdateFinancialProfile(ctx echo.Context) error
 			if monthlySavings < 0 {
 				return CreateBusinessLogicError(
 					"INSUFFICIENT_SAVINGS",
-					"月間支出が月収を上回っています",
-					"支出を見直すか、収入を増やすことを検討してください",
 					monthlySavings,
-					"正の数値",
+					"> 0",
 				)
 			}
 
@@ -607,22 +553,44 @@ func (c *FinancialDataController) UpdateFinancialProfile(ctx echo.Context) error
 		return err
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.UpdateFinancialProfileInput{
-		UserID:           entities.UserID(userID),
-		MonthlyIncome:    req.MonthlyIncome,
-		MonthlyExpenses:  convertExpenseItems(req.MonthlyExpenses),
-		CurrentSavings:   convertSavingsItems(req.CurrentSavings),
-		InvestmentReturn: req.InvestmentReturn,
-		InflationRate:    req.InflationRate,
+		UserID:                   uid,
+		MonthlyIncome:            req.MonthlyIncome,
+		IncomeType:               req.IncomeType,
+		BirthDate:                req.BirthDate,
+		ExpectedVolatility:       req.ExpectedVolatility,
+		RiskTolerance:            req.RiskTolerance,
+		MonthlyExpenses:          convertExpenseItems(req.MonthlyExpenses),
+		CurrentSavings:           convertSavingsItems(req.CurrentSavings),
+		InvestmentReturn:         req.InvestmentReturn,
+		InflationRate:            req.InflationRate,
+		AcknowledgeHighReturn:    req.AcknowledgeHighReturn,
+		AcknowledgeHighInflation: req.AcknowledgeHighInflation,
+		Version:                  req.Version,
 	}
 
 	output, err := c.useCase.UpdateFinancialProfile(ctx.Request().Context(), input)
 	if err != nil {
+		if handled, respErr := respondHighAssumptionError(ctx, err); handled {
+			return respErr
+		}
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
 		// 既存データが無い場合は新規作成にフォールバック
 		if strings.Contains(err.Error(), "財務データが見つかりません") || strings.Contains(err.Error(), "財務計画の取得に失敗しました") || strings.Contains(err.Error(), "財務プロファイルの取得に失敗しました") {
 			createInput := usecases.CreateFinancialPlanInput{
-				UserID:                     entities.UserID(userID),
+				UserID:                     uid,
 				MonthlyIncome:              req.MonthlyIncome,
+				IncomeType:                 req.IncomeType,
+				BirthDate:                  req.BirthDate,
+				ExpectedVolatility:         req.ExpectedVolatility,
+				RiskTolerance:              req.RiskTolerance,
 				MonthlyExpenses:            convertExpenseItems(req.MonthlyExpenses),
 				CurrentSavings:             convertSavingsItems(req.CurrentSavings),
 				InvestmentReturn:           req.InvestmentReturn,
@@ -632,15 +600,20 @@ func (c *FinancialDataController) UpdateFinancialProfile(ctx echo.Context) error
 				PensionAmount:              nil,
 				EmergencyFundTargetMonths:  nil,
 				EmergencyFundCurrentAmount: nil,
+				AcknowledgeHighReturn:      req.AcknowledgeHighReturn,
+				AcknowledgeHighInflation:   req.AcknowledgeHighInflation,
 			}
 
 			_, createErr := c.useCase.CreateFinancialPlan(ctx.Request().Context(), createInput)
 			if createErr != nil {
+				if handled, respErr := respondHighAssumptionError(ctx, createErr); handled {
+					return respErr
+				}
 				return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, createErr.Error()))
 			}
 
 			// 作成後に最新データを取得して返す
-			getInput := usecases.GetFinancialPlanInput{UserID: entities.UserID(userID)}
+			getInput := usecases.GetFinancialPlanInput{UserID: uid}
 			getOutput, getErr := c.useCase.GetFinancialPlan(ctx.Request().Context(), getInput)
 			if getErr != nil {
 				return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, getErr.Error()))
@@ -667,6 +640,7 @@ func (c *FinancialDataController) UpdateFinancialProfile(ctx echo.Context) error
 // @Success 200 {object} usecases.UpdateRetirementDataOutput
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} VersionConflictResponse "他のセッションで先に更新されている"
 // @Failure 500 {object} ErrorResponse
 // @Router /financial-data/{user_id}/retirement [put]
 func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
@@ -691,10 +665,8 @@ func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
 			if req.RetirementAge < 50 || req.RetirementAge > 100 {
 				return CreateBusinessLogicError(
 					"INVALID_RETIREMENT_AGE",
-					"退職年齢は50歳から100歳の範囲で入力してください",
-					"現実的な退職年齢を入力してください",
 					req.RetirementAge,
-					"50-100歳",
+					"50-100",
 				)
 			}
 			return nil
@@ -704,10 +676,8 @@ func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
 			if req.MonthlyRetirementExpenses <= 0 {
 				return CreateBusinessLogicError(
 					"INVALID_RETIREMENT_EXPENSES",
-					"老後月間生活費は0より大きい値を入力してください",
-					"現実的な生活費を入力してください",
 					req.MonthlyRetirementExpenses,
-					"正の数値",
+					"> 0",
 				)
 			}
 			return nil
@@ -717,10 +687,8 @@ func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
 			if req.PensionAmount < 0 {
 				return CreateBusinessLogicError(
 					"INVALID_PENSION_AMOUNT",
-					"年金受給額は0以上の値を入力してください",
-					"予想される年金受給額を入力してください",
 					req.PensionAmount,
-					"0以上の数値",
+					">= 0",
 				)
 			}
 			return nil
@@ -729,15 +697,28 @@ func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
 		return err
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.UpdateRetirementDataInput{
-		UserID:                    entities.UserID(userID),
+		UserID:                    uid,
 		RetirementAge:             req.RetirementAge,
 		MonthlyRetirementExpenses: req.MonthlyRetirementExpenses,
 		PensionAmount:             req.PensionAmount,
+
+		KokuminNenkinMonthlyAmount:     req.KokuminNenkinMonthlyAmount,
+		KokuminNenkinContributionYears: req.KokuminNenkinContributionYears,
+		NurseCareInsuranceMonthly:      req.NurseCareInsuranceMonthly,
+		Version:                        req.Version,
 	}
 
 	output, err := c.useCase.UpdateRetirementData(ctx.Request().Context(), input)
 	if err != nil {
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
 		if strings.Contains(err.Error(), "財務データが見つかりません") || strings.Contains(err.Error(), "財務計画の取得に失敗しました") || strings.Contains(err.Error(), "財務プロファイルの取得に失敗しました") {
 			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
 		}
@@ -748,6 +729,161 @@ func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, output)
 }
 
+// ApplyPensionEstimate は年金見込み額を推定して退職データに反映する
+// @Summary 年金見込み額反映
+// @Description 加入年数・平均月収・生年から年金見込み額を推定し、退職データの年金受給額に反映します
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body ApplyPensionEstimateRequest true "年金見込み額反映リクエスト"
+// @Success 200 {object} usecases.UpdateRetirementDataOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} VersionConflictResponse "他のセッションで先に更新されている"
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/retirement/pension-estimate [post]
+func (c *FinancialDataController) ApplyPensionEstimate(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req ApplyPensionEstimateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	input := usecases.ApplyPensionEstimateInput{
+		UserID:            uid,
+		ContributionYears: req.ContributionYears,
+		MonthlyIncome:     req.MonthlyIncome,
+		BirthYear:         req.BirthYear,
+		Version:           req.Version,
+	}
+
+	output, err := c.useCase.ApplyPensionEstimate(ctx.Request().Context(), input)
+	if err != nil {
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
+		if strings.Contains(err.Error(), "財務データが見つかりません") || strings.Contains(err.Error(), "財務計画の取得に失敗しました") || strings.Contains(err.Error(), "財務プロファイルの取得に失敗しました") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		}
+		if strings.Contains(err.Error(), "退職データが設定されていません") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "退職データ"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// UpdateCurrentAge は退職データの現在の年齢を更新する
+// @Summary 現在の年齢更新
+// @Description 誕生日を迎えたときなどに退職データの現在の年齢だけを更新します。年齢は減らせず、退職年齢・平均寿命未満である必要があります。生年月日が登録されている場合は年齢を生年月日から計算するため更新できません
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Param user_id path string true "ユーザーID"
+// @Param request body UpdateCurrentAgeRequest true "現在の年齢更新リクエスト"
+// @Success 200 {object} usecases.UpdateCurrentAgeOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} VersionConflictResponse "他のセッションで先に更新されている"
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/retirement/current-age [patch]
+func (c *FinancialDataController) UpdateCurrentAge(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req UpdateCurrentAgeRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	input := usecases.UpdateCurrentAgeInput{
+		UserID:     uid,
+		CurrentAge: *req.CurrentAge,
+		Version:    req.Version,
+	}
+
+	output, err := c.useCase.UpdateCurrentAge(ctx.Request().Context(), input)
+	if err != nil {
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
+		errMsg := err.Error()
+		switch {
+		case strings.Contains(errMsg, "財務データが見つかりません"), strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		case errors.Is(err, aggregates.ErrRetirementDataNotSet):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "退職データ"))
+		case errors.Is(err, aggregates.ErrCurrentAgeDerivedFromBirthDate):
+			return ctx.JSON(http.StatusConflict, NewErrorResponse(ctx, ErrorCodeConflict, errMsg, nil))
+		case errors.Is(err, entities.ErrInvalidCurrentAge):
+			return ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeBusinessLogic, errMsg, nil))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+		}
+	}
+
+	// 更新後の財務データを返し、再取得を不要にする
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// ConfirmFinancialDataCurrent は財務データに変更がないことを確認する
+// @Summary 財務データの現状確認
+// @Description 財務データに変更がないことを確認し、最終確認日時を記録します。長期間見直されていない財務データに対するレポートの警告が解消されます
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.ConfirmFinancialDataCurrentOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} VersionConflictResponse "他のセッションで先に更新されている"
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/confirm-current [post]
+func (c *FinancialDataController) ConfirmFinancialDataCurrent(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.ConfirmFinancialDataCurrent(ctx.Request().Context(), usecases.ConfirmFinancialDataCurrentInput{UserID: uid})
+	if err != nil {
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "財務データが見つかりません") || strings.Contains(errMsg, "財務計画の取得に失敗しました") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
 // UpdateEmergencyFund は緊急資金設定を更新する
 // @Summary 緊急資金設定更新
 // @Description 緊急資金設定を更新します
@@ -759,6 +895,7 @@ func (c *FinancialDataController) UpdateRetirementData(ctx echo.Context) error {
 // @Success 200 {object} usecases.UpdateEmergencyFundOutput
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} VersionConflictResponse "他のセッションで先に更新されている"
 // @Failure 500 {object} ErrorResponse
 // @Router /financial-data/{user_id}/emergency-fund [put]
 func (c *FinancialDataController) UpdateEmergencyFund(ctx echo.Context) error {
@@ -783,10 +920,8 @@ func (c *FinancialDataController) UpdateEmergencyFund(ctx echo.Context) error {
 			if req.TargetMonths < 1 || req.TargetMonths > 24 {
 				return CreateBusinessLogicError(
 					"INVALID_TARGET_MONTHS",
-					"緊急資金目標月数は1ヶ月から24ヶ月の範囲で入力してください",
-					"一般的には3-6ヶ月分の生活費が推奨されます",
 					req.TargetMonths,
-					"1-24ヶ月",
+					"1-24",
 				)
 			}
 			return nil
@@ -795,11 +930,9 @@ func (c *FinancialDataController) UpdateEmergencyFund(ctx echo.Context) error {
 			// 現在の緊急資金額の妥当性チェック
 			if req.CurrentAmount < 0 {
 				return CreateBusinessLogicError(
-					"INVALID_CURRENT_AMOUNT",
-					"現在の緊急資金額は0以上の値を入力してください",
-					"現在保有している緊急資金の金額を入力してください",
+					"INVALID_EMERGENCY_FUND_AMOUNT",
 					req.CurrentAmount,
-					"0以上の数値",
+					">= 0",
 				)
 			}
 			return nil
@@ -808,14 +941,24 @@ func (c *FinancialDataController) UpdateEmergencyFund(ctx echo.Context) error {
 		return err
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.UpdateEmergencyFundInput{
-		UserID:        entities.UserID(userID),
-		TargetMonths:  req.TargetMonths,
-		CurrentAmount: req.CurrentAmount,
+		UserID:              uid,
+		TargetMonths:        req.TargetMonths,
+		CurrentAmount:       req.CurrentAmount,
+		MonthlyContribution: req.MonthlyContribution,
+		Version:             req.Version,
 	}
 
 	output, err := c.useCase.UpdateEmergencyFund(ctx.Request().Context(), input)
 	if err != nil {
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
 		if strings.Contains(err.Error(), "財務データが見つかりません") || strings.Contains(err.Error(), "財務計画の取得に失敗しました") || strings.Contains(err.Error(), "財務プロファイルの取得に失敗しました") {
 			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
 		}
@@ -826,6 +969,76 @@ func (c *FinancialDataController) UpdateEmergencyFund(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, output)
 }
 
+// ImportBankCSV は銀行の入出金明細CSVを取り込み、支出と貯蓄に反映する
+// @Summary 銀行CSV取込
+// @Description みずほ銀行・三菱UFJ銀行の入出金明細CSVを取り込み、出金をカテゴリ別の月平均支出、入金を預金として反映します
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept multipart/form-data
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param file formData file true "入出金明細CSV（最大1MB、UTF-8またはShift_JIS）"
+// @Param format formData string false "CSV形式（mizuho, mufg）" default(mizuho)
+// @Success 200 {object} usecases.ImportBankCSVOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/import-bank-csv [post]
+func (c *FinancialDataController) ImportBankCSV(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	format := ports.BankCSVFormat(ctx.FormValue("format"))
+	if format == "" {
+		format = ports.BankCSVFormatMizuho
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "CSVファイルが必要です", err.Error()))
+	}
+
+	// 1MB 制限
+	if fileHeader.Size > 1<<20 {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ファイルサイズは1MB以下にしてください", nil))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+	defer file.Close()
+
+	csvData, err := io.ReadAll(file)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	input := usecases.ImportBankCSVInput{
+		UserID:  uid,
+		Format:  format,
+		CSVData: csvData,
+	}
+
+	output, err := c.useCase.ImportBankCSV(ctx.Request().Context(), input)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "サポートされていないCSV形式です") || strings.Contains(errMsg, "CSVの解析に失敗しました") {
+			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+		}
+		if strings.Contains(errMsg, "財務データが見つかりません") || strings.Contains(errMsg, "財務計画の取得に失敗しました") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
 // DeleteFinancialData は財務データを削除する
 // @Summary 財務データ削除
 // @Description 財務計画を削除します
@@ -842,11 +1055,16 @@ func (c *FinancialDataController) DeleteFinancialData(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.DeleteFinancialPlanInput{
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	}
 
-	err := c.useCase.DeleteFinancialPlan(ctx.Request().Context(), input)
+	err = c.useCase.DeleteFinancialPlan(ctx.Request().Context(), input)
 	if err != nil {
 		if strings.Contains(err.Error(), "財務データが見つかりません") || strings.Contains(err.Error(), "財務計画の取得に失敗しました") || strings.Contains(err.Error(), "財務プロファイルの取得に失敗しました") {
 			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
@@ -857,14 +1075,43 @@ func (c *FinancialDataController) DeleteFinancialData(ctx echo.Context) error {
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// respondHighAssumptionError は想定値が現実的な上限を超えて同意がない場合に422を返す
+// 該当するエラーでない場合は handled=false を返す
+func respondHighAssumptionError(ctx echo.Context, err error) (bool, error) {
+	var highErr *usecases.HighAssumptionError
+	if !errors.As(err, &highErr) {
+		return false, nil
+	}
+	return true, ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeAssumptionAcknowledgementRequired, highErr.Error(), highErr.Warnings))
+}
+
+// respondVersionConflictError は財務計画のバージョンの競合であれば 409 を返す（処理した場合は true）
+// クライアントが最新のデータを取得し直せるよう、{"error":"version_conflict","current_version":N} を返す
+func respondVersionConflictError(ctx echo.Context, err error) (bool, error) {
+	var conflictErr *repositories.VersionConflictError
+	if !errors.As(err, &conflictErr) {
+		return false, nil
+	}
+	return true, ctx.JSON(http.StatusConflict, VersionConflictResponse{
+		Error:          "version_conflict",
+		CurrentVersion: conflictErr.CurrentVersion,
+	})
+}
+
 // convertExpenseItems はExpenseItemRequestをusecases.ExpenseItemに変換する
+// カテゴリと説明は保存型XSSを防ぐためHTMLタグを取り除く
 func convertExpenseItems(items []ExpenseItemRequest) []usecases.ExpenseItem {
 	result := make([]usecases.ExpenseItem, len(items))
 	for i, item := range items {
+		var description *string
+		if item.Description != nil {
+			sanitized := valueobjects.SanitizeText(*item.Description)
+			description = &sanitized
+		}
 		result[i] = usecases.ExpenseItem{
-			Category:    item.Category,
+			Category:    valueobjects.SanitizeText(item.Category),
 			Amount:      item.Amount,
-			Description: item.Description,
+			Description: description,
 		}
 	}
 	return result
@@ -893,6 +1140,7 @@ func convertSavingsItems(items []SavingsItemRequest) []usecases.SavingsItem {
 // @Success 200 {object} usecases.FinancialDataResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse "想定値が現実的な上限を超えており同意が必要"
 // @Failure 500 {object} ErrorResponse
 // @Router /financial-data/import/csv [post]
 func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) error {
@@ -938,8 +1186,13 @@ func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) e
 	reqCtx := GetRequestContextWithUserID(ctx, userID)
 
 	// プロファイル更新（データがなければ新規作成にフォールバック）
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	profileInput := usecases.UpdateFinancialProfileInput{
-		UserID:           entities.UserID(userID),
+		UserID:           uid,
 		MonthlyIncome:    *data.MonthlyIncome,
 		MonthlyExpenses:  []usecases.ExpenseItem{{Category: "生活費", Amount: 100000}},
 		CurrentSavings:   []usecases.SavingsItem{{Type: "deposit", Amount: 500000}},
@@ -949,11 +1202,14 @@ func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) e
 
 	_, profileErr := c.useCase.UpdateFinancialProfile(reqCtx, profileInput)
 	if profileErr != nil {
+		if handled, respErr := respondHighAssumptionError(ctx, profileErr); handled {
+			return respErr
+		}
 		if strings.Contains(profileErr.Error(), "財務データが見つかりません") ||
 			strings.Contains(profileErr.Error(), "財務計画の取得に失敗しました") ||
 			strings.Contains(profileErr.Error(), "財務プロファイルの取得に失敗しました") {
 			createInput := usecases.CreateFinancialPlanInput{
-				UserID:                     entities.UserID(userID),
+				UserID:                     uid,
 				MonthlyIncome:              *data.MonthlyIncome,
 				MonthlyExpenses:            []usecases.ExpenseItem{{Category: "生活費", Amount: 100000}},
 				CurrentSavings:             []usecases.SavingsItem{{Type: "deposit", Amount: 500000}},
@@ -966,6 +1222,9 @@ func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) e
 				EmergencyFundCurrentAmount: data.EmergencyFundCurrentAmount,
 			}
 			if _, err := c.useCase.CreateFinancialPlan(reqCtx, createInput); err != nil {
+				if handled, respErr := respondHighAssumptionError(ctx, err); handled {
+					return respErr
+				}
 				return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
 			}
 		} else {
@@ -975,7 +1234,7 @@ func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) e
 		// プロファイル更新成功後、退職データ・緊急資金データを追加更新
 		if data.RetirementAge != nil && data.MonthlyRetirementExpenses != nil && data.PensionAmount != nil {
 			retireInput := usecases.UpdateRetirementDataInput{
-				UserID:                    entities.UserID(userID),
+				UserID:                    uid,
 				RetirementAge:             *data.RetirementAge,
 				MonthlyRetirementExpenses: *data.MonthlyRetirementExpenses,
 				PensionAmount:             *data.PensionAmount,
@@ -987,7 +1246,7 @@ func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) e
 
 		if data.EmergencyFundTargetMonths != nil && data.EmergencyFundCurrentAmount != nil {
 			emergencyInput := usecases.UpdateEmergencyFundInput{
-				UserID:        entities.UserID(userID),
+				UserID:        uid,
 				TargetMonths:  *data.EmergencyFundTargetMonths,
 				CurrentAmount: *data.EmergencyFundCurrentAmount,
 			}
@@ -999,7 +1258,7 @@ func (c *FinancialDataController) ImportFinancialDataFromCSV(ctx echo.Context) e
 
 	// 最新データを取得してレスポンス
 	getOutput, err := c.useCase.GetFinancialPlan(reqCtx, usecases.GetFinancialPlanInput{
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	})
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
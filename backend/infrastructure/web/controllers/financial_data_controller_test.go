@@ -5,16 +5,21 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/aggregates"
 	"github.com/financial-planning-calculator/backend/domain/entities"
-	"github.com/go-playground/validator/v10"
+	"github.com/financial-planning-calculator/backend/domain/repositories"
+	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -57,6 +62,22 @@ func (m *MockManageFinancialDataUseCase) UpdateRetirementData(ctx context.Contex
 	return args.Get(0).(*usecases.UpdateRetirementDataOutput), args.Error(1)
 }
 
+func (m *MockManageFinancialDataUseCase) ApplyPensionEstimate(ctx context.Context, input usecases.ApplyPensionEstimateInput) (*usecases.UpdateRetirementDataOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.UpdateRetirementDataOutput), args.Error(1)
+}
+
+func (m *MockManageFinancialDataUseCase) UpdateCurrentAge(ctx context.Context, input usecases.UpdateCurrentAgeInput) (*usecases.UpdateCurrentAgeOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.UpdateCurrentAgeOutput), args.Error(1)
+}
+
 func (m *MockManageFinancialDataUseCase) UpdateEmergencyFund(ctx context.Context, input usecases.UpdateEmergencyFundInput) (*usecases.UpdateEmergencyFundOutput, error) {
 	args := m.Called(ctx, input)
 	if args.Get(0) == nil {
@@ -65,6 +86,22 @@ func (m *MockManageFinancialDataUseCase) UpdateEmergencyFund(ctx context.Context
 	return args.Get(0).(*usecases.UpdateEmergencyFundOutput), args.Error(1)
 }
 
+func (m *MockManageFinancialDataUseCase) ConfirmFinancialDataCurrent(ctx context.Context, input usecases.ConfirmFinancialDataCurrentInput) (*usecases.ConfirmFinancialDataCurrentOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.ConfirmFinancialDataCurrentOutput), args.Error(1)
+}
+
+func (m *MockManageFinancialDataUseCase) ImportBankCSV(ctx context.Context, input usecases.ImportBankCSVInput) (*usecases.ImportBankCSVOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.ImportBankCSVOutput), args.Error(1)
+}
+
 func (m *MockManageFinancialDataUseCase) DeleteFinancialPlan(ctx context.Context, input usecases.DeleteFinancialPlanInput) error {
 	args := m.Called(ctx, input)
 	return args.Error(0)
@@ -72,14 +109,21 @@ func (m *MockManageFinancialDataUseCase) DeleteFinancialPlan(ctx context.Context
 
 func newFinancialDataEcho() *echo.Echo {
 	e := echo.New()
-	e.Validator = &CustomValidator{validator: validator.New()}
+	e.Validator = &CustomValidator{validator: newTestValidator()}
 	return e
 }
 
+// highReturnError returns the use case error for an unacknowledged high investment return
+func highReturnError() error {
+	return &usecases.HighAssumptionError{Warnings: []services.AssumptionWarning{
+		{Field: services.AssumptionFieldInvestmentReturn, Value: 20.0, Ceiling: 8.0, Message: "想定利回りが現実的な上限を超えています"},
+	}}
+}
+
 // validFinancialDataRequest returns a valid CreateFinancialDataRequest for testing
 func validFinancialDataRequest() CreateFinancialDataRequest {
 	return CreateFinancialDataRequest{
-		UserID:           "user-123",
+		UserID:           "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 		MonthlyIncome:    400000,
 		InvestmentReturn: 5.0,
 		InflationRate:    2.0,
@@ -105,9 +149,9 @@ func TestCreateFinancialData(t *testing.T) {
 			requestBody: validFinancialDataRequest(),
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("CreateFinancialPlan", mock.Anything, mock.MatchedBy(func(input usecases.CreateFinancialPlanInput) bool {
-					return input.UserID == entities.UserID("user-123")
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 				})).Return(&usecases.CreateFinancialPlanOutput{
-					UserID:    entities.UserID("user-123"),
+					UserID:    entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 					CreatedAt: "2030-01-01T00:00:00Z",
 				}, nil)
 				m.On("GetFinancialPlan", mock.Anything, mock.Anything).Return(&usecases.GetFinancialPlanOutput{
@@ -129,7 +173,7 @@ func TestCreateFinancialData(t *testing.T) {
 		{
 			name: "Error: monthly expenses exceed income (business logic)",
 			requestBody: CreateFinancialDataRequest{
-				UserID:           "user-123",
+				UserID:           "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				MonthlyIncome:    100000,
 				InvestmentReturn: 5.0,
 				InflationRate:    2.0,
@@ -145,12 +189,40 @@ func TestCreateFinancialData(t *testing.T) {
 			// The recorder already has status 400 from the first write.
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("CreateFinancialPlan", mock.Anything, mock.Anything).Return(&usecases.CreateFinancialPlanOutput{
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}, nil)
 				m.On("GetFinancialPlan", mock.Anything, mock.Anything).Return(&usecases.GetFinancialPlanOutput{Plan: nil}, nil)
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "Success: high investment return with acknowledgement",
+			requestBody: func() CreateFinancialDataRequest {
+				req := validFinancialDataRequest()
+				req.InvestmentReturn = 20.0
+				req.AcknowledgeHighReturn = true
+				return req
+			}(),
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("CreateFinancialPlan", mock.Anything, mock.MatchedBy(func(input usecases.CreateFinancialPlanInput) bool {
+					return input.InvestmentReturn == 20.0 && input.AcknowledgeHighReturn
+				})).Return(&usecases.CreateFinancialPlanOutput{UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")}, nil)
+				m.On("GetFinancialPlan", mock.Anything, mock.Anything).Return(&usecases.GetFinancialPlanOutput{Plan: nil}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "Error: high investment return without acknowledgement",
+			requestBody: func() CreateFinancialDataRequest {
+				req := validFinancialDataRequest()
+				req.InvestmentReturn = 20.0
+				return req
+			}(),
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("CreateFinancialPlan", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("wrapped: %w", highReturnError()))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name:        "Error: internal server error",
 			requestBody: validFinancialDataRequest(),
@@ -186,6 +258,137 @@ func TestCreateFinancialData(t *testing.T) {
 	}
 }
 
+func TestCreateFinancialData_HighAssumptionResponse(t *testing.T) {
+	e := newFinancialDataEcho()
+	mockUseCase := new(MockManageFinancialDataUseCase)
+	mockUseCase.On("CreateFinancialPlan", mock.Anything, mock.Anything).Return(nil, highReturnError())
+	controller := NewFinancialDataController(mockUseCase)
+
+	body := validFinancialDataRequest()
+	body.InvestmentReturn = 20.0
+	reqJSON, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/financial-data", bytes.NewBuffer(reqJSON))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := controller.CreateFinancialData(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	var response struct {
+		Code    string                       `json:"code"`
+		Details []services.AssumptionWarning `json:"details"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, string(ErrorCodeAssumptionAcknowledgementRequired), response.Code)
+	if assert.Len(t, response.Details, 1) {
+		assert.Equal(t, services.AssumptionFieldInvestmentReturn, response.Details[0].Field)
+		assert.Equal(t, 8.0, response.Details[0].Ceiling)
+	}
+	mockUseCase.AssertNotCalled(t, "GetFinancialPlan", mock.Anything, mock.Anything)
+}
+
+func TestValidateFinancialData(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    func() CreateFinancialDataRequest
+		expectedValid  bool
+		expectedErrors map[string]string // field -> code
+	}{
+		{
+			name:          "Success: all fields valid",
+			requestBody:   validFinancialDataRequest,
+			expectedValid: true,
+		},
+		{
+			name: "Success: omitted fields are filled with create defaults",
+			requestBody: func() CreateFinancialDataRequest {
+				return CreateFinancialDataRequest{
+					UserID:           "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+					InvestmentReturn: 5.0,
+					InflationRate:    2.0,
+				}
+			},
+			expectedValid: true,
+		},
+		{
+			name: "Invalid: validation tag error only",
+			requestBody: func() CreateFinancialDataRequest {
+				req := validFinancialDataRequest()
+				req.InvestmentReturn = 0
+				return req
+			},
+			expectedErrors: map[string]string{"investment_return": "required"},
+		},
+		{
+			name: "Invalid: business rule error only",
+			requestBody: func() CreateFinancialDataRequest {
+				req := validFinancialDataRequest()
+				req.MonthlyExpenses = []ExpenseItemRequest{{Category: "生活費", Amount: 500000}}
+				return req
+			},
+			expectedErrors: map[string]string{"monthly_expenses": "INSUFFICIENT_SAVINGS"},
+		},
+		{
+			name: "Invalid: tag and business rule errors are combined per field",
+			requestBody: func() CreateFinancialDataRequest {
+				req := validFinancialDataRequest()
+				req.UserID = "not-a-uuid"
+				req.InflationRate = 60 // lte タグと INVALID_INFLATION_RATE の両方に違反するがタグの結果のみ返す
+				req.MonthlyExpenses = []ExpenseItemRequest{{Category: "生活費", Amount: 500000}}
+				return req
+			},
+			expectedErrors: map[string]string{
+				"user_id":          "INVALID_USER_ID",
+				"inflation_rate":   "lte",
+				"monthly_expenses": "INSUFFICIENT_SAVINGS",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			v := newTestValidator()
+			v.RegisterTagNameFunc(func(fld reflect.StructField) string {
+				return strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
+			})
+			e.Validator = &CustomValidator{validator: v}
+			// ユースケースは呼ばれない（呼ばれた場合はモックが panic する）
+			controller := NewFinancialDataController(new(MockManageFinancialDataUseCase))
+
+			reqJSON, _ := json.Marshal(tt.requestBody())
+			req := httptest.NewRequest(http.MethodPost, "/financial-data/validate", bytes.NewBuffer(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.ValidateFinancialData(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+
+			var result ValidationResult
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, tt.expectedValid, result.Valid)
+
+			codes := make(map[string]string)
+			for _, fieldResult := range result.Errors {
+				assert.False(t, fieldResult.Valid)
+				assert.NotEmpty(t, fieldResult.Message)
+				codes[fieldResult.Field] = fieldResult.Code
+			}
+			if tt.expectedErrors == nil {
+				assert.Empty(t, codes)
+			} else {
+				assert.Equal(t, tt.expectedErrors, codes)
+			}
+		})
+	}
+}
+
 func TestGetFinancialData(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -195,10 +398,10 @@ func TestGetFinancialData(t *testing.T) {
 	}{
 		{
 			name:   "Success: get financial data",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("GetFinancialPlan", mock.Anything, usecases.GetFinancialPlanInput{
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}).Return(&usecases.GetFinancialPlanOutput{
 					Plan: nil, // nil plan returns empty response gracefully
 				}, nil)
@@ -213,7 +416,7 @@ func TestGetFinancialData(t *testing.T) {
 		},
 		{
 			name:   "Error: financial data not found",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("GetFinancialPlan", mock.Anything, mock.Anything).Return(nil, errors.New("財務データが見つかりません"))
 			},
@@ -221,7 +424,7 @@ func TestGetFinancialData(t *testing.T) {
 		},
 		{
 			name:   "Error: internal server error",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("GetFinancialPlan", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -252,6 +455,76 @@ func TestGetFinancialData(t *testing.T) {
 	}
 }
 
+func TestUpdateFinancialProfile_InputSanitization(t *testing.T) {
+	newRequest := func(category string, description *string) UpdateFinancialProfileRequest {
+		return UpdateFinancialProfileRequest{
+			MonthlyIncome:    400000,
+			InvestmentReturn: 5.0,
+			InflationRate:    2.0,
+			MonthlyExpenses: []ExpenseItemRequest{
+				{Category: category, Amount: 200000, Description: description},
+			},
+		}
+	}
+	longDescription := strings.Repeat("x", MaxDescriptionLength+1)
+	controlDescription := "家賃\r\x7f"
+	scriptCategory := "住居費<script>alert('xss')</script>"
+	imgDescription := "<img src=x onerror=alert(1)>家賃"
+
+	tests := []struct {
+		name                string
+		requestBody         UpdateFinancialProfileRequest
+		expectedCategory    string
+		expectedDescription string
+		expectHandlerError  bool
+	}{
+		{"Error: category exceeds 50 characters", newRequest(strings.Repeat("c", MaxCategoryLength+1), nil), "", "", true},
+		{"Error: category is whitespace only", newRequest("   ", nil), "", "", true},
+		{"Error: description exceeds 500 characters", newRequest("住居費", &longDescription), "", "", true},
+		{"Success: control characters are stripped", newRequest("住居\x00費", &controlDescription), "住居費", "家賃", false},
+		{"Success: HTML tags are stripped before storing", newRequest(scriptCategory, &imgDescription), "住居費alert('xss')", "家賃", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newFinancialDataEcho()
+			mockUseCase := new(MockManageFinancialDataUseCase)
+			if !tt.expectHandlerError {
+				mockUseCase.On("UpdateFinancialProfile", mock.Anything, mock.MatchedBy(func(input usecases.UpdateFinancialProfileInput) bool {
+					expense := input.MonthlyExpenses[0]
+					description := ""
+					if expense.Description != nil {
+						description = *expense.Description
+					}
+					return expense.Category == tt.expectedCategory && description == tt.expectedDescription
+				})).Return(&usecases.UpdateFinancialProfileOutput{
+					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
+				}, nil)
+			}
+			controller := NewFinancialDataController(mockUseCase)
+
+			reqJSON, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/financial-data/4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c/profile", bytes.NewBuffer(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
+
+			err := controller.UpdateFinancialProfile(c)
+
+			if tt.expectHandlerError {
+				assert.Error(t, err)
+				mockUseCase.AssertNotCalled(t, "UpdateFinancialProfile", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, rec.Code)
+				mockUseCase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
 func TestUpdateFinancialProfile(t *testing.T) {
 	validUpdateRequest := UpdateFinancialProfileRequest{
 		MonthlyIncome:    400000,
@@ -275,13 +548,13 @@ func TestUpdateFinancialProfile(t *testing.T) {
 	}{
 		{
 			name:        "Success: update financial profile",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validUpdateRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.MatchedBy(func(input usecases.UpdateFinancialProfileInput) bool {
-					return input.UserID == entities.UserID("user-123")
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 				})).Return(&usecases.UpdateFinancialProfileOutput{
-					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "user-123"},
+					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -295,12 +568,12 @@ func TestUpdateFinancialProfile(t *testing.T) {
 		},
 		{
 			name:        "Error: not found - fallback to create",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validUpdateRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(nil, errors.New("財務データが見つかりません"))
 				m.On("CreateFinancialPlan", mock.Anything, mock.Anything).Return(&usecases.CreateFinancialPlanOutput{
-					UserID:    entities.UserID("user-123"),
+					UserID:    entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 					CreatedAt: "2030-01-01T00:00:00Z",
 				}, nil)
 				m.On("GetFinancialPlan", mock.Anything, mock.Anything).Return(&usecases.GetFinancialPlanOutput{
@@ -309,9 +582,28 @@ func TestUpdateFinancialProfile(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:        "Error: high investment return without acknowledgement",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			requestBody: validUpdateRequest,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(nil, highReturnError())
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "Error: high investment return without acknowledgement on fallback create",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			requestBody: validUpdateRequest,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(nil, errors.New("財務データが見つかりません"))
+				m.On("CreateFinancialPlan", mock.Anything, mock.Anything).Return(nil, highReturnError())
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
 		{
 			name:        "Error: internal server error",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validUpdateRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
@@ -366,13 +658,13 @@ func TestUpdateRetirementData(t *testing.T) {
 	}{
 		{
 			name:        "Success: update retirement data",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validRetirementRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateRetirementData", mock.Anything, mock.MatchedBy(func(input usecases.UpdateRetirementDataInput) bool {
-					return input.UserID == entities.UserID("user-123") && input.RetirementAge == 65
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c") && input.RetirementAge == 65
 				})).Return(&usecases.UpdateRetirementDataOutput{
-					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "user-123"},
+					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -386,7 +678,7 @@ func TestUpdateRetirementData(t *testing.T) {
 		},
 		{
 			name:   "Error: invalid retirement age (below minimum)",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: UpdateRetirementDataRequest{
 				RetirementAge:             30, // below 50
 				MonthlyRetirementExpenses: 200000,
@@ -397,7 +689,7 @@ func TestUpdateRetirementData(t *testing.T) {
 		},
 		{
 			name:        "Error: financial data not found",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validRetirementRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateRetirementData", mock.Anything, mock.Anything).Return(nil, errors.New("財務データが見つかりません"))
@@ -406,7 +698,7 @@ func TestUpdateRetirementData(t *testing.T) {
 		},
 		{
 			name:        "Error: internal server error",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validRetirementRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateRetirementData", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
@@ -444,6 +736,120 @@ func TestUpdateRetirementData(t *testing.T) {
 	}
 }
 
+func TestUpdateCurrentAge(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name               string
+		pathUserID         string
+		requestBody        string
+		mockSetup          func(m *MockManageFinancialDataUseCase)
+		expectedStatus     int
+		expectHandlerError bool
+	}{
+		{
+			name:        "Success: update current age",
+			requestBody: `{"current_age": 36}`,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateCurrentAge", mock.Anything, usecases.UpdateCurrentAgeInput{
+					UserID:     entities.UserID(userID),
+					CurrentAge: 36,
+				}).Return(&usecases.UpdateCurrentAgeOutput{
+					FinancialDataResponse: &usecases.FinancialDataResponse{
+						UserID:     userID,
+						Retirement: map[string]interface{}{"current_age": 36},
+						Version:    3,
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:               "Error: missing current_age",
+			requestBody:        `{}`,
+			mockSetup:          func(m *MockManageFinancialDataUseCase) {},
+			expectHandlerError: true,
+		},
+		{
+			name:               "Error: another user's retirement data",
+			pathUserID:         "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e",
+			requestBody:        `{"current_age": 36}`,
+			mockSetup:          func(m *MockManageFinancialDataUseCase) {},
+			expectedStatus:     http.StatusForbidden,
+			expectHandlerError: true,
+		},
+		{
+			name:        "Error: age rejected by retirement data",
+			requestBody: `{"current_age": 34}`,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateCurrentAge", mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("現在の年齢の更新に失敗しました: %w", entities.ErrInvalidCurrentAge))
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "Error: age derived from birth date",
+			requestBody: `{"current_age": 36}`,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateCurrentAge", mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("現在の年齢の更新に失敗しました: %w", aggregates.ErrCurrentAgeDerivedFromBirthDate))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "Error: retirement data not set",
+			requestBody: `{"current_age": 36}`,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateCurrentAge", mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("現在の年齢の更新に失敗しました: %w", aggregates.ErrRetirementDataNotSet))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "Error: financial data not found",
+			requestBody: `{"current_age": 36}`,
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("UpdateCurrentAge", mock.Anything, mock.Anything).Return(nil, errors.New("財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newFinancialDataEcho()
+			mockUseCase := new(MockManageFinancialDataUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewFinancialDataController(mockUseCase)
+
+			pathUserID := tt.pathUserID
+			if pathUserID == "" {
+				pathUserID = userID
+			}
+			req := httptest.NewRequest(http.MethodPatch, "/financial-data/"+pathUserID+"/retirement/current-age", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(pathUserID)
+			setTestUserID(c, userID)
+
+			err := controller.UpdateCurrentAge(c)
+
+			if tt.expectHandlerError {
+				assert.Error(t, err)
+				if tt.expectedStatus != 0 {
+					assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 func TestUpdateEmergencyFund(t *testing.T) {
 	validEmergencyFundRequest := UpdateEmergencyFundRequest{
 		TargetMonths:  6,
@@ -460,13 +866,13 @@ func TestUpdateEmergencyFund(t *testing.T) {
 	}{
 		{
 			name:        "Success: update emergency fund",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validEmergencyFundRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateEmergencyFund", mock.Anything, mock.MatchedBy(func(input usecases.UpdateEmergencyFundInput) bool {
-					return input.UserID == entities.UserID("user-123") && input.TargetMonths == 6
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c") && input.TargetMonths == 6
 				})).Return(&usecases.UpdateEmergencyFundOutput{
-					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "user-123"},
+					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -480,7 +886,7 @@ func TestUpdateEmergencyFund(t *testing.T) {
 		},
 		{
 			name:   "Error: target months exceeds maximum",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: UpdateEmergencyFundRequest{
 				TargetMonths:  25, // exceeds 24
 				CurrentAmount: 300000,
@@ -490,7 +896,7 @@ func TestUpdateEmergencyFund(t *testing.T) {
 		},
 		{
 			name:        "Error: financial data not found",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validEmergencyFundRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateEmergencyFund", mock.Anything, mock.Anything).Return(nil, errors.New("財務データが見つかりません"))
@@ -499,7 +905,7 @@ func TestUpdateEmergencyFund(t *testing.T) {
 		},
 		{
 			name:        "Error: internal server error",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: validEmergencyFundRequest,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateEmergencyFund", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
@@ -537,6 +943,59 @@ func TestUpdateEmergencyFund(t *testing.T) {
 	}
 }
 
+func TestUpdateEmergencyFund_VersionConflictResponse(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	e := newFinancialDataEcho()
+	mockUseCase := new(MockManageFinancialDataUseCase)
+	mockUseCase.On("UpdateEmergencyFund", mock.Anything, mock.MatchedBy(func(input usecases.UpdateEmergencyFundInput) bool {
+		return input.Version != nil && *input.Version == 4
+	})).Return(nil, fmt.Errorf("財務計画の保存に失敗しました: %w", &repositories.VersionConflictError{CurrentVersion: 5}))
+	controller := NewFinancialDataController(mockUseCase)
+
+	req := httptest.NewRequest(http.MethodPut, "/financial-data/"+userID+"/emergency-fund",
+		strings.NewReader(`{"target_months": 6, "current_amount": 300000, "version": 4}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("user_id")
+	c.SetParamValues(userID)
+
+	err := controller.UpdateEmergencyFund(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, map[string]interface{}{"error": "version_conflict", "current_version": float64(5)}, response)
+	mockUseCase.AssertExpectations(t)
+}
+
+func TestUpdateEmergencyFund_WithoutVersion(t *testing.T) {
+	// version を送らない既存クライアントの更新は、バージョンを確認せずにユースケースへ渡す
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	e := newFinancialDataEcho()
+	mockUseCase := new(MockManageFinancialDataUseCase)
+	mockUseCase.On("UpdateEmergencyFund", mock.Anything, mock.MatchedBy(func(input usecases.UpdateEmergencyFundInput) bool {
+		return input.Version == nil
+	})).Return(&usecases.UpdateEmergencyFundOutput{}, nil)
+	controller := NewFinancialDataController(mockUseCase)
+
+	req := httptest.NewRequest(http.MethodPut, "/financial-data/"+userID+"/emergency-fund",
+		strings.NewReader(`{"target_months": 6, "current_amount": 300000}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("user_id")
+	c.SetParamValues(userID)
+
+	err := controller.UpdateEmergencyFund(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	mockUseCase.AssertExpectations(t)
+}
+
 func TestDeleteFinancialData(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -546,10 +1005,10 @@ func TestDeleteFinancialData(t *testing.T) {
 	}{
 		{
 			name:   "Success: delete financial data",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("DeleteFinancialPlan", mock.Anything, usecases.DeleteFinancialPlanInput{
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
@@ -562,7 +1021,7 @@ func TestDeleteFinancialData(t *testing.T) {
 		},
 		{
 			name:   "Error: financial data not found",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("DeleteFinancialPlan", mock.Anything, mock.Anything).Return(errors.New("財務データが見つかりません"))
 			},
@@ -570,7 +1029,7 @@ func TestDeleteFinancialData(t *testing.T) {
 		},
 		{
 			name:   "Error: internal server error",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("DeleteFinancialPlan", mock.Anything, mock.Anything).Return(errors.New("database error"))
 			},
@@ -601,6 +1060,79 @@ func TestDeleteFinancialData(t *testing.T) {
 	}
 }
 
+func TestConfirmFinancialDataCurrent(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         string
+		mockSetup      func(m *MockManageFinancialDataUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: confirm financial data is current",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("ConfirmFinancialDataCurrent", mock.Anything, usecases.ConfirmFinancialDataCurrentInput{
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}).Return(&usecases.ConfirmFinancialDataCurrentOutput{
+					FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: missing user_id",
+			userID:         "",
+			mockSetup:      func(m *MockManageFinancialDataUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: another user's financial data",
+			userID:         "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e",
+			mockSetup:      func(m *MockManageFinancialDataUseCase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Error: financial data not found",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("ConfirmFinancialDataCurrent", mock.Anything, mock.Anything).Return(nil, errors.New("財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:   "Error: internal server error",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("ConfirmFinancialDataCurrent", mock.Anything, mock.Anything).Return(nil, errors.New("財務計画の保存に失敗しました: database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newFinancialDataEcho()
+			mockUseCase := new(MockManageFinancialDataUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewFinancialDataController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/financial-data/"+tt.userID+"/confirm-current", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tt.userID != "" {
+				c.SetParamNames("user_id")
+				c.SetParamValues(tt.userID)
+			}
+			setTestUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
+
+			err := controller.ConfirmFinancialDataCurrent(c)
+
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 // buildCSVMultipartRequest は multipart/form-data リクエストを構築するヘルパー
 func buildCSVMultipartRequest(csvContent string) (*http.Request, string) {
 	body := &bytes.Buffer{}
@@ -618,9 +1150,9 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 	const withExtraRows = "項目,値,単位,説明\n総合スコア,85,点,良好\n貯蓄率,25.00,%,\n月収,400000,円,\n投資リターン,5.00,%,\nインフレ率,2.00,%,\n"
 
 	emptyGetOutput := &usecases.GetFinancialPlanOutput{Plan: nil}
-	profileOutput := &usecases.UpdateFinancialProfileOutput{FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "user-123"}}
-	retirementOutput := &usecases.UpdateRetirementDataOutput{FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "user-123"}}
-	emergencyOutput := &usecases.UpdateEmergencyFundOutput{FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "user-123"}}
+	profileOutput := &usecases.UpdateFinancialProfileOutput{FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}}
+	retirementOutput := &usecases.UpdateRetirementDataOutput{FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}}
+	emergencyOutput := &usecases.UpdateEmergencyFundOutput{FinancialDataResponse: &usecases.FinancialDataResponse{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}}
 
 	tests := []struct {
 		name           string
@@ -631,7 +1163,7 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 	}{
 		{
 			name:       "正常: 必須項目のみ・UpdateProfile成功",
-			userID:     "user-123",
+			userID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent: validCSV,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(profileOutput, nil)
@@ -641,7 +1173,7 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 		},
 		{
 			name:       "正常: 全項目CSV・UpdateProfile後に退職・緊急資金も更新",
-			userID:     "user-123",
+			userID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent: fullCSV,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(profileOutput, nil)
@@ -653,7 +1185,7 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 		},
 		{
 			name:       "正常: UpdateProfile not-found → Createにフォールバック",
-			userID:     "user-123",
+			userID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent: validCSV,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(nil, errors.New("財務計画の取得に失敗しました"))
@@ -664,7 +1196,7 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 		},
 		{
 			name:       "正常: BOM付きCSV",
-			userID:     "user-123",
+			userID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent: "\xEF\xBB\xBF" + validCSV,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(profileOutput, nil)
@@ -674,7 +1206,7 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 		},
 		{
 			name:       "正常: ダウンロードCSVそのまま（余分な行あり）",
-			userID:     "user-123",
+			userID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent: withExtraRows,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(profileOutput, nil)
@@ -691,35 +1223,35 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 		},
 		{
 			name:           "エラー: ヘッダー行不正（項目列なし）",
-			userID:         "user-123",
+			userID:         "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent:     "name,value\n月収,400000\n",
 			mockSetup:      func(m *MockManageFinancialDataUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:           "エラー: 必須フィールド欠如（月収なし）",
-			userID:         "user-123",
+			userID:         "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent:     "項目,値\n投資リターン,5.00\nインフレ率,2.00\n",
 			mockSetup:      func(m *MockManageFinancialDataUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:           "エラー: 値のパースエラー（月収にabc）",
-			userID:         "user-123",
+			userID:         "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent:     "項目,値\n月収,abc\n投資リターン,5.00\nインフレ率,2.00\n",
 			mockSetup:      func(m *MockManageFinancialDataUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:           "エラー: 範囲外バリデーション（投資リターン101%）",
-			userID:         "user-123",
+			userID:         "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent:     "項目,値\n月収,400000\n投資リターン,101\nインフレ率,2.00\n",
 			mockSetup:      func(m *MockManageFinancialDataUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:       "エラー: UseCase内部エラー",
-			userID:     "user-123",
+			userID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			csvContent: validCSV,
 			mockSetup: func(m *MockManageFinancialDataUseCase) {
 				m.On("UpdateFinancialProfile", mock.Anything, mock.Anything).Return(nil, errors.New("DB接続エラー"))
@@ -752,6 +1284,76 @@ func TestImportFinancialDataFromCSV(t *testing.T) {
 	}
 }
 
+func TestImportBankCSV(t *testing.T) {
+	const mizuhoCSV = "日付,内容,出金金額,入金金額,残高\n2024/04/05,家賃 4月分,\"85,000\",,\"1,215,000\"\n"
+
+	tests := []struct {
+		name           string
+		format         string
+		mockSetup      func(*MockManageFinancialDataUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "正常: 形式未指定はみずほとして取り込む",
+			format: "",
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("ImportBankCSV", mock.Anything, mock.MatchedBy(func(input usecases.ImportBankCSVInput) bool {
+					return input.UserID == "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c" && input.Format == ports.BankCSVFormatMizuho && string(input.CSVData) == mizuhoCSV
+				})).Return(&usecases.ImportBankCSVOutput{TransactionCount: 1}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "エラー: 未対応の形式",
+			format: "unknown",
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("ImportBankCSV", mock.Anything, mock.Anything).Return(nil, errors.New("サポートされていないCSV形式です: unknown"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "エラー: 財務データが存在しない",
+			format: "mufg",
+			mockSetup: func(m *MockManageFinancialDataUseCase) {
+				m.On("ImportBankCSV", mock.Anything, mock.Anything).Return(nil, errors.New("財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newFinancialDataEcho()
+			mockUseCase := new(MockManageFinancialDataUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewFinancialDataController(mockUseCase)
+
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			part, _ := writer.CreateFormFile("file", "meisai.csv")
+			_, _ = io.WriteString(part, mizuhoCSV)
+			if tt.format != "" {
+				_ = writer.WriteField("format", tt.format)
+			}
+			writer.Close()
+
+			req := httptest.NewRequest(http.MethodPost, "/financial-data/4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c/import-bank-csv", body)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
+			setTestUserID(c, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
+
+			err := controller.ImportBankCSV(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
 // TestParseFinancialDataCSV はCSVパース関数の単体テスト
 func TestParseFinancialDataCSV(t *testing.T) {
 	tests := []struct {
@@ -1,12 +1,17 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/valueobjects"
 	"github.com/labstack/echo/v4"
 )
 
@@ -24,39 +29,82 @@ func NewGoalsController(useCase usecases.ManageGoalsUseCase) *GoalsController {
 
 // CreateGoalRequest は目標作成リクエスト
 type CreateGoalRequest struct {
-	UserID              string  `json:"user_id" validate:"required"`
-	GoalType            string  `json:"goal_type" validate:"required,oneof=savings retirement emergency custom"`
-	Title               string  `json:"title" validate:"required,min=1,max=100"`
-	TargetAmount        float64 `json:"target_amount" validate:"required,gt=0"`
-	TargetDate          string  `json:"target_date" validate:"required"` // RFC3339 format
-	CurrentAmount       float64 `json:"current_amount" validate:"gte=0"`
-	MonthlyContribution float64 `json:"monthly_contribution" validate:"gte=0"`
-	Description         *string `json:"description,omitempty"`
+	UserID              string   `json:"user_id" validate:"required"`
+	GoalType            string   `json:"goal_type" validate:"required,oneof=savings retirement emergency custom"`
+	Title               string   `json:"title" validate:"required,safetext,notblank,max=100"`
+	TargetAmount        float64  `json:"target_amount" validate:"required,gt=0"`
+	TargetDate          string   `json:"target_date" validate:"required"` // RFC3339 format
+	CurrentAmount       float64  `json:"current_amount" validate:"gte=0"`
+	MonthlyContribution float64  `json:"monthly_contribution" validate:"gte=0"`
+	Description         *string  `json:"description,omitempty" validate:"omitempty,safetext,max=500"`
+	Notes               string   `json:"notes,omitempty" validate:"omitempty,safetext,max=1000"`
+	ImageURL            string   `json:"image_url,omitempty" validate:"omitempty,safeurl"` // 外部の画像URL（アップロードは PUT /goals/{id}/image）
+	DependsOn           []string `json:"depends_on,omitempty"`                             // 前提となる目標のID一覧
+	AllowDuplicate      bool     `json:"allow_duplicate,omitempty"`                        // 類似する目標が既に存在しても作成する
+	// 旧形式の単一の前提目標のID（depends_on を省略した場合のみ、1件の depends_on として扱う）
+	DependsOnGoalID *string `json:"depends_on_goal_id,omitempty"`
+	// Currency は目標の通貨（JPY・USD・EUR、省略時は JPY）。JPY 以外の場合、target_amount は目標の通貨で指定し exchange_rate が必須
+	Currency     string               `json:"currency,omitempty" validate:"omitempty,len=3"`
+	ExchangeRate *ExchangeRateRequest `json:"exchange_rate,omitempty"`
+	// 月間拠出額の幅（任意、0 は未設定）。contribution_min ≤ monthly_contribution ≤ contribution_stretch である必要がある
+	ContributionMin     *float64 `json:"contribution_min,omitempty" validate:"omitempty,gte=0"`
+	ContributionStretch *float64 `json:"contribution_stretch,omitempty" validate:"omitempty,gte=0"`
+	// 期限超過時の扱い（extend: 必要な期間を再計算して延長, archive: 非アクティブ化, notify: 通知のみ。省略時は notify）
+	OverduePolicy string `json:"overdue_policy,omitempty" validate:"omitempty,oneof=extend archive notify"`
+}
+
+// ExchangeRateRequest は外貨建ての目標の為替レート（外貨1単位あたりの円の金額）
+type ExchangeRateRequest struct {
+	Rate   float64 `json:"rate" validate:"required,gt=0"`
+	Source string  `json:"source,omitempty" validate:"omitempty,safetext,max=50"` // レートの取得元（省略時は manual）
+	AsOf   string  `json:"as_of,omitempty"`                                       // レートの基準日時（RFC3339、省略時は現在日時）
+}
+
+// AdoptRequiredContributionRequest は月次必要額を月間拠出額として採用するリクエスト
+type AdoptRequiredContributionRequest struct {
+	RoundTo float64 `json:"round_to,omitempty" validate:"omitempty,gt=0,max=1000000"` // 切り上げ単位（円、省略時は1,000円）
+	Force   bool    `json:"force,omitempty"`                                          // 月間純貯蓄額を超える場合も設定する
 }
 
 // UpdateGoalRequest は目標更新リクエスト
 type UpdateGoalRequest struct {
-	Title               *string  `json:"title,omitempty" validate:"omitempty,min=1,max=100"`
-	TargetAmount        *float64 `json:"target_amount,omitempty" validate:"omitempty,gt=0"`
-	TargetDate          *string  `json:"target_date,omitempty"` // RFC3339 format
-	MonthlyContribution *float64 `json:"monthly_contribution,omitempty" validate:"omitempty,gte=0"`
-	Description         *string  `json:"description,omitempty"`
-	IsActive            *bool    `json:"is_active,omitempty"`
+	Title               *string   `json:"title,omitempty" validate:"omitempty,safetext,notblank,max=100"`
+	TargetAmount        *float64  `json:"target_amount,omitempty" validate:"omitempty,gt=0"`
+	TargetDate          *string   `json:"target_date,omitempty"` // RFC3339 format
+	MonthlyContribution *float64  `json:"monthly_contribution,omitempty" validate:"omitempty,gte=0"`
+	Description         *string   `json:"description,omitempty" validate:"omitempty,safetext,max=500"`
+	Notes               *string   `json:"notes,omitempty" validate:"omitempty,safetext,max=1000"`
+	ImageURL            *string   `json:"image_url,omitempty" validate:"omitempty,safeurl"` // 空文字で画像の紐付けを解除
+	IsActive            *bool     `json:"is_active,omitempty"`
+	DependsOn           *[]string `json:"depends_on,omitempty"` // 前提となる目標のID一覧（空配列で依存を解除）
+	// 旧形式の単一の前提目標のID（depends_on を省略した場合のみ、1件の depends_on として扱う。空文字で依存を解除）
+	DependsOnGoalID *string `json:"depends_on_goal_id,omitempty"`
+	// 月間拠出額の幅（0 で解除）。更新後に contribution_min ≤ monthly_contribution ≤ contribution_stretch である必要がある
+	ContributionMin     *float64 `json:"contribution_min,omitempty" validate:"omitempty,gte=0"`
+	ContributionStretch *float64 `json:"contribution_stretch,omitempty" validate:"omitempty,gte=0"`
+	// 期限超過時の扱い（extend・archive・notify）
+	OverduePolicy *string `json:"overdue_policy,omitempty" validate:"omitempty,oneof=extend archive notify"`
+	// 更新後の目標金額・目標日から求めた月次必要額を月間拠出額に設定する（monthly_contribution より優先）
+	AutoAdjustContribution bool `json:"auto_adjust_contribution,omitempty"`
 }
 
 // UpdateGoalProgressRequest は目標進捗更新リクエスト
 type UpdateGoalProgressRequest struct {
 	CurrentAmount float64 `json:"current_amount" validate:"required,gte=0"`
-	Note          *string `json:"note,omitempty"`
+	Note          *string `json:"note,omitempty" validate:"omitempty,safetext,max=500"`
 }
 
-// GetGoalsQueryParams は目標一覧取得のクエリパラメータ
+// GetGoalsQueryParams は目標一覧取得のクエリパラメータ（user_id は resolveUserID で解決する）
+// echo の query タグは omitempty などのオプションを解釈しないため、パラメータ名のみを指定する
+// active_only は不正な値を独自のエラーメッセージで返せるよう文字列で受け取る
 type GetGoalsQueryParams struct {
-	UserID     string `query:"user_id" validate:"required"`
-	GoalType   string `query:"goal_type,omitempty"`
-	ActiveOnly bool   `query:"active_only,omitempty"`
+	GoalType   string `query:"goal_type"`
+	ActiveOnly string `query:"active_only"`
 }
 
+// validGoalTypes は目標一覧のフィルタに指定できる目標タイプ（エラーレスポンス用）
+const validGoalTypes = "savings, retirement, emergency, custom"
+
 // CreateGoal は新しい目標を作成する
 // @Summary 目標作成
 // @Description 新しい財務目標を作成します
@@ -66,6 +114,8 @@ type GetGoalsQueryParams struct {
 // @Param request body CreateGoalRequest true "目標作成リクエスト"
 // @Success 201 {object} usecases.CreateGoalOutput
 // @Failure 400 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /goals [post]
 func (c *GoalsController) CreateGoal(ctx echo.Context) error {
@@ -85,10 +135,8 @@ func (c *GoalsController) CreateGoal(ctx echo.Context) error {
 			if req.TargetAmount <= 0 {
 				return CreateBusinessLogicError(
 					"INVALID_TARGET_AMOUNT",
-					"目標金額は0より大きい値を入力してください",
-					"達成したい具体的な金額を入力してください",
 					req.TargetAmount,
-					"正の数値",
+					"> 0",
 				)
 			}
 			return nil
@@ -98,10 +146,8 @@ func (c *GoalsController) CreateGoal(ctx echo.Context) error {
 			if req.CurrentAmount < 0 {
 				return CreateBusinessLogicError(
 					"INVALID_CURRENT_AMOUNT",
-					"現在の金額は0以上の値を入力してください",
-					"現在の達成状況を正確に入力してください",
 					req.CurrentAmount,
-					"0以上の数値",
+					">= 0",
 				)
 			}
 			return nil
@@ -111,23 +157,22 @@ func (c *GoalsController) CreateGoal(ctx echo.Context) error {
 			if req.MonthlyContribution < 0 {
 				return CreateBusinessLogicError(
 					"INVALID_MONTHLY_CONTRIBUTION",
-					"月間積立額は0以上の値を入力してください",
-					"毎月積み立て可能な金額を入力してください",
 					req.MonthlyContribution,
-					"0以上の数値",
+					">= 0",
 				)
 			}
 			return nil
 		},
 		func() *BusinessLogicError {
-			// 目標達成の実現可能性チェック
+			// 目標達成の実現可能性チェック（外貨建ての目標は目標金額と現在の金額の通貨が異なるためユースケースで判定する）
+			if isForeignCurrencyRequest(req.Currency) {
+				return nil
+			}
 			if req.CurrentAmount > req.TargetAmount {
 				return CreateBusinessLogicError(
 					"GOAL_ALREADY_ACHIEVED",
-					"現在の金額が目標金額を上回っています",
-					"目標金額を見直すか、新しい目標を設定してください",
 					req.CurrentAmount,
-					fmt.Sprintf("%.0f以下", req.TargetAmount),
+					fmt.Sprintf("<= %.0f", req.TargetAmount),
 				)
 			}
 			return nil
@@ -136,8 +181,13 @@ func (c *GoalsController) CreateGoal(ctx echo.Context) error {
 		return err
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.CreateGoalInput{
-		UserID:              entities.UserID(req.UserID),
+		UserID:              uid,
 		GoalType:            req.GoalType,
 		Title:               req.Title,
 		TargetAmount:        req.TargetAmount,
@@ -145,10 +195,40 @@ func (c *GoalsController) CreateGoal(ctx echo.Context) error {
 		CurrentAmount:       req.CurrentAmount,
 		MonthlyContribution: req.MonthlyContribution,
 		Description:         req.Description,
+		Notes:               req.Notes,
+		ImageURL:            req.ImageURL,
+		DependsOn:           req.dependsOn(),
+		AllowDuplicate:      req.AllowDuplicate,
+		Currency:            req.Currency,
+		ContributionMin:     req.ContributionMin,
+		ContributionStretch: req.ContributionStretch,
+		OverduePolicy:       req.OverduePolicy,
+	}
+	if req.ExchangeRate != nil {
+		input.ExchangeRate = req.ExchangeRate.toInput()
 	}
 
 	output, err := c.useCase.CreateGoal(ctx.Request().Context(), input)
 	if err != nil {
+		if handled, respErr := c.handleGoalDependencyError(ctx, err); handled {
+			return respErr
+		}
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
+		if handled, respErr := c.handleGoalCurrencyError(ctx, err); handled {
+			return respErr
+		}
+		if handled, respErr := c.handleContributionRangeError(ctx, err); handled {
+			return respErr
+		}
+		// 類似する目標が既に存在する場合は既存目標のIDを返す
+		var duplicateErr *usecases.DuplicateGoalError
+		if errors.As(err, &duplicateErr) {
+			return ctx.JSON(http.StatusConflict, NewErrorResponse(ctx, ErrorCodeConflict, duplicateErr.Error(), map[string]any{
+				"existing_goal_id": duplicateErr.ExistingGoalID,
+			}))
+		}
 		// Financial data missing should be reported as insufficient data / bad request
 		if strings.Contains(err.Error(), "財務データが見つかりません") || strings.Contains(err.Error(), "財務プロファイルの取得に失敗しました") {
 			return ctx.JSON(http.StatusBadRequest, NewInsufficientDataErrorResponse(ctx, "financial_data"))
@@ -169,13 +249,15 @@ func (c *GoalsController) CreateGoal(ctx echo.Context) error {
 
 // GetGoals は目標一覧を取得する
 // @Summary 目標一覧取得
-// @Description ユーザーの目標一覧を取得します
+// @Description ユーザーの目標一覧を取得します。ETag を返し、If-None-Match が一致する場合は 304 を返します
 // @Tags goals
 // @Produce json
 // @Param user_id query string true "ユーザーID"
 // @Param goal_type query string false "目標タイプ"
 // @Param active_only query bool false "アクティブな目標のみ"
+// @Param If-None-Match header string false "前回取得時の ETag"
 // @Success 200 {object} usecases.GetGoalsByUserOutput
+// @Success 304 "変更なし"
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /goals [get]
@@ -189,21 +271,37 @@ func (c *GoalsController) GetGoals(ctx echo.Context) error {
 		return err // Validator already returns proper error response
 	}
 
-	input := usecases.GetGoalsByUserInput{
-		UserID:     entities.UserID(params.UserID),
-		ActiveOnly: params.ActiveOnly,
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
 	}
 
-	// 目標タイプが指定されている場合は設定
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	input := usecases.GetGoalsByUserInput{UserID: uid}
+
+	// フィルタはすべて検証してから有効な値のみをユースケースに渡す
 	if params.GoalType != "" {
 		goalType := entities.GoalType(params.GoalType)
-		if goalType.IsValid() {
-			input.GoalType = &goalType
-		} else {
+		if !goalType.IsValid() {
 			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "無効な目標タイプです", map[string]string{
-				"valid_types": "savings, retirement, emergency, custom",
+				"valid_types": validGoalTypes,
 			}))
 		}
+		input.GoalType = &goalType
+	}
+
+	if params.ActiveOnly != "" {
+		activeOnly, err := strconv.ParseBool(params.ActiveOnly)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "active_only は true または false で指定してください", map[string]string{
+				"active_only": params.ActiveOnly,
+			}))
+		}
+		input.ActiveOnly = activeOnly
 	}
 
 	output, err := c.useCase.GetGoalsByUser(ctx.Request().Context(), input)
@@ -211,9 +309,23 @@ func (c *GoalsController) GetGoals(ctx echo.Context) error {
 		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
 	}
 
+	// ETag はルートの ConditionalGetMiddleware が全目標とサマリーを含むレスポンス全体から計算する
+	// 進捗や拠出額は財務計画からも算出されるため、目標の更新日時だけでは変更を検知できない
+	setLastModified(ctx, latestGoalUpdatedAt(output.Goals))
 	return ctx.JSON(http.StatusOK, output)
 }
 
+// latestGoalUpdatedAt は目標の中で最も新しい更新日時を返す
+func latestGoalUpdatedAt(goals []usecases.GoalWithStatus) time.Time {
+	var latest time.Time
+	for _, goal := range goals {
+		if goal.Goal != nil && goal.Goal.UpdatedAt().After(latest) {
+			latest = goal.Goal.UpdatedAt()
+		}
+	}
+	return latest
+}
+
 // GetGoal は特定の目標を取得する
 // @Summary 目標取得
 // @Description 特定の目標を取得します
@@ -232,14 +344,19 @@ func (c *GoalsController) GetGoal(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
 	}
 
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
 	input := usecases.GetGoalInput{
 		GoalID: entities.GoalID(goalID),
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	}
 
 	output, err := c.useCase.GetGoal(ctx.Request().Context(), input)
@@ -262,6 +379,7 @@ func (c *GoalsController) GetGoal(ctx echo.Context) error {
 // @Success 200 {object} usecases.UpdateGoalOutput
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /goals/{id} [put]
 func (c *GoalsController) UpdateGoal(ctx echo.Context) error {
@@ -270,9 +388,9 @@ func (c *GoalsController) UpdateGoal(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
 	}
 
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
 	}
 
 	var req UpdateGoalRequest
@@ -292,10 +410,8 @@ func (c *GoalsController) UpdateGoal(ctx echo.Context) error {
 				return CreateBusinessLogicErrorWithField(
 					"INVALID_TARGET_AMOUNT",
 					"TargetAmount",
-					"目標金額は0より大きい値を入力してください",
-					"達成したい具体的な金額を入力してください",
 					*req.TargetAmount,
-					"正の数値",
+					"> 0",
 				)
 			}
 			return nil
@@ -306,10 +422,8 @@ func (c *GoalsController) UpdateGoal(ctx echo.Context) error {
 				return CreateBusinessLogicErrorWithField(
 					"INVALID_MONTHLY_CONTRIBUTION",
 					"MonthlyContribution",
-					"月間積立額は0以上の値を入力してください",
-					"毎月積み立て可能な金額を入力してください",
 					*req.MonthlyContribution,
-					"0以上の数値",
+					">= 0",
 				)
 			}
 			return nil
@@ -318,19 +432,38 @@ func (c *GoalsController) UpdateGoal(ctx echo.Context) error {
 		return err
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.UpdateGoalInput{
 		GoalID:              entities.GoalID(goalID),
-		UserID:              entities.UserID(userID),
+		UserID:              uid,
 		Title:               req.Title,
 		TargetAmount:        req.TargetAmount,
 		TargetDate:          req.TargetDate,
 		MonthlyContribution: req.MonthlyContribution,
 		Description:         req.Description,
+		Notes:               req.Notes,
+		ImageURL:            req.ImageURL,
 		IsActive:            req.IsActive,
+		DependsOn:           req.dependsOn(),
+		ContributionMin:     req.ContributionMin,
+		ContributionStretch: req.ContributionStretch,
+		OverduePolicy:       req.OverduePolicy,
+
+		AutoAdjustContribution: req.AutoAdjustContribution,
 	}
 
 	output, err := c.useCase.UpdateGoal(ctx.Request().Context(), input)
 	if err != nil {
+		if handled, respErr := c.handleGoalDependencyError(ctx, err); handled {
+			return respErr
+		}
+		if handled, respErr := c.handleContributionRangeError(ctx, err); handled {
+			return respErr
+		}
 		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
 	}
 
@@ -357,9 +490,9 @@ func (c *GoalsController) UpdateGoalProgress(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
 	}
 
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
 	}
 
 	var req UpdateGoalProgressRequest
@@ -379,10 +512,8 @@ func (c *GoalsController) UpdateGoalProgress(ctx echo.Context) error {
 				return CreateBusinessLogicErrorWithField(
 					"INVALID_CURRENT_AMOUNT",
 					"CurrentAmount",
-					"現在の金額は0以上の値を入力してください",
-					"現在の達成状況を正確に入力してください",
 					req.CurrentAmount,
-					"0以上の数値",
+					">= 0",
 				)
 			}
 			return nil
@@ -391,9 +522,14 @@ func (c *GoalsController) UpdateGoalProgress(ctx echo.Context) error {
 		return err
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.UpdateGoalProgressInput{
 		GoalID:        entities.GoalID(goalID),
-		UserID:        entities.UserID(userID),
+		UserID:        uid,
 		CurrentAmount: req.CurrentAmount,
 		Note:          req.Note,
 	}
@@ -408,13 +544,15 @@ func (c *GoalsController) UpdateGoalProgress(ctx echo.Context) error {
 
 // DeleteGoal は目標を削除する
 // @Summary 目標削除
-// @Description 目標を削除します
+// @Description 目標を削除します。他の目標の前提になっている場合は force=true を指定すると依存を解除して削除します
 // @Tags goals
 // @Param id path string true "目標ID"
 // @Param user_id query string true "ユーザーID"
+// @Param force query bool false "依存している目標の依存を解除して削除する"
 // @Success 204
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /goals/{id} [delete]
 func (c *GoalsController) DeleteGoal(ctx echo.Context) error {
@@ -423,24 +561,282 @@ func (c *GoalsController) DeleteGoal(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
 	}
 
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
 	input := usecases.DeleteGoalInput{
 		GoalID: entities.GoalID(goalID),
-		UserID: entities.UserID(userID),
+		UserID: uid,
+		Force:  ctx.QueryParam("force") == "true",
 	}
 
-	err := c.useCase.DeleteGoal(ctx.Request().Context(), input)
+	err = c.useCase.DeleteGoal(ctx.Request().Context(), input)
 	if err != nil {
+		if errors.Is(err, usecases.ErrGoalHasDependents) {
+			return ctx.JSON(http.StatusConflict, NewErrorResponse(ctx, ErrorCodeConflict, err.Error(), nil))
+		}
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
 		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
 	}
 
 	return ctx.NoContent(http.StatusNoContent)
 }
 
+// UploadGoalImage は目標の画像をアップロードする
+// @Summary 目標画像アップロード
+// @Description 目標に画像（JPEG・PNG・GIF・WebP、5MBまで）を添付します。既存の画像は置き換えられ、以前の画像は削除されます
+// @Tags goals
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "目標ID"
+// @Param user_id query string true "ユーザーID"
+// @Param image formData file true "画像ファイル"
+// @Success 200 {object} usecases.GoalImageOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Failure 503 {object} ErrorResponse
+// @Router /goals/{id}/image [put]
+func (c *GoalsController) UploadGoalImage(ctx echo.Context) error {
+	goalID := ctx.Param("id")
+	if goalID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
+	}
+
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	fileHeader, err := ctx.FormFile("image")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "画像ファイルが必要です", err.Error()))
+	}
+
+	if fileHeader.Size > usecases.MaxGoalImageSize {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "画像のサイズは5MB以下にしてください", nil))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, usecases.MaxGoalImageSize+1))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	// クライアントが申告した Content-Type ではなく、内容から判定した形式を使う
+	output, err := c.useCase.UploadGoalImage(ctx.Request().Context(), usecases.UploadGoalImageInput{
+		GoalID:      entities.GoalID(goalID),
+		UserID:      uid,
+		ContentType: http.DetectContentType(data),
+		Data:        data,
+	})
+	if err != nil {
+		return c.handleGoalImageError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// DeleteGoalImage は目標の画像を削除する
+// @Summary 目標画像削除
+// @Description 目標に添付した画像の紐付けを解除し、ストレージから削除します
+// @Tags goals
+// @Param id path string true "目標ID"
+// @Param user_id query string true "ユーザーID"
+// @Success 204
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/{id}/image [delete]
+func (c *GoalsController) DeleteGoalImage(ctx echo.Context) error {
+	goalID := ctx.Param("id")
+	if goalID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
+	}
+
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	err = c.useCase.DeleteGoalImage(ctx.Request().Context(), usecases.DeleteGoalImageInput{
+		GoalID: entities.GoalID(goalID),
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleGoalImageError(ctx, err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// UpdateGoalExchangeRate は外貨建ての目標の為替レートを更新する
+// @Summary 目標為替レート更新
+// @Description 外貨建ての目標の為替レートを更新し、目標金額の円換算・進捗・月次必要額を再計算した目標を返します。以前の為替レートは履歴に残ります
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param id path string true "目標ID"
+// @Param user_id query string true "ユーザーID"
+// @Param request body ExchangeRateRequest true "為替レート"
+// @Success 200 {object} usecases.GetGoalOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/{id}/exchange-rate [put]
+func (c *GoalsController) UpdateGoalExchangeRate(ctx echo.Context) error {
+	goalID := ctx.Param("id")
+	if goalID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
+	}
+
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	var req ExchangeRateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.UpdateGoalExchangeRate(ctx.Request().Context(), usecases.UpdateGoalExchangeRateInput{
+		GoalID:            entities.GoalID(goalID),
+		UserID:            uid,
+		ExchangeRateInput: *req.toInput(),
+	})
+	if err != nil {
+		if handled, respErr := c.handleGoalCurrencyError(ctx, err); handled {
+			return respErr
+		}
+		errMsg := err.Error()
+		switch {
+		case strings.Contains(errMsg, "目標の取得に失敗しました"):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "目標"))
+		case strings.Contains(errMsg, "アクセスする権限がありません"):
+			return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, errMsg, nil))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// AdoptRequiredContribution は月次必要額を目標の月間拠出額として採用する
+// @Summary 月次必要額の採用
+// @Description 詳細表示と同じ月次必要額を round_to 円単位（省略時は1,000円）に切り上げて月間拠出額に設定し、更新後の目標と実現可能性を返します。他のアクティブな目標と合わせた拠出額が月間純貯蓄額を超える場合は 409 を返し、force=true を指定すると超過したまま設定します。変更は月間拠出額の変更履歴に記録されます
+// @Tags goals
+// @Accept json
+// @Produce json
+// @Param id path string true "目標ID"
+// @Param user_id query string true "ユーザーID"
+// @Param request body AdoptRequiredContributionRequest false "切り上げ単位と超過時の強制設定"
+// @Success 200 {object} usecases.AdoptRequiredContributionOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/{id}/adopt-required-contribution [post]
+func (c *GoalsController) AdoptRequiredContribution(ctx echo.Context) error {
+	goalID := ctx.Param("id")
+	if goalID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
+	}
+
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	var req AdoptRequiredContributionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.AdoptRequiredContribution(ctx.Request().Context(), usecases.AdoptRequiredContributionInput{
+		GoalID:  entities.GoalID(goalID),
+		UserID:  uid,
+		RoundTo: req.RoundTo,
+		Force:   req.Force,
+	})
+	if err != nil {
+		var overBudgetErr *usecases.ContributionOverBudgetError
+		if errors.As(err, &overBudgetErr) {
+			return ctx.JSON(http.StatusConflict, NewErrorResponse(ctx, ErrorCodeConflict, overBudgetErr.Error(), map[string]any{
+				"monthly_contribution":     overBudgetErr.MonthlyContribution,
+				"other_goals_contribution": overBudgetErr.OtherGoalsContribution,
+				"monthly_net_savings":      overBudgetErr.MonthlyNetSavings,
+				"exceeded_amount":          overBudgetErr.ExceededAmount,
+			}))
+		}
+		if handled, respErr := respondVersionConflictError(ctx, err); handled {
+			return respErr
+		}
+		errMsg := err.Error()
+		switch {
+		case errors.Is(err, usecases.ErrGoalAlreadyCompleted), errors.Is(err, entities.ErrContributionOutOfRange):
+			return ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeBusinessLogic, errMsg, nil))
+		case strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+			return ctx.JSON(http.StatusBadRequest, NewInsufficientDataErrorResponse(ctx, "financial_data"))
+		case strings.Contains(errMsg, "目標の取得に失敗しました"):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "目標"))
+		case strings.Contains(errMsg, "アクセスする権限がありません"):
+			return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, errMsg, nil))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
 // GetGoalRecommendations は目標の推奨事項を取得する
 // @Summary 目標推奨事項取得
 // @Description 目標の推奨事項を取得します
@@ -459,14 +855,19 @@ func (c *GoalsController) GetGoalRecommendations(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
 	}
 
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
 	input := usecases.GetGoalRecommendationsInput{
 		GoalID: entities.GoalID(goalID),
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	}
 
 	output, err := c.useCase.GetGoalRecommendations(ctx.Request().Context(), input)
@@ -495,14 +896,19 @@ func (c *GoalsController) AnalyzeGoalFeasibility(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
 	}
 
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ユーザーIDは必須です", nil))
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
 	input := usecases.AnalyzeGoalFeasibilityInput{
 		GoalID: entities.GoalID(goalID),
-		UserID: entities.UserID(userID),
+		UserID: uid,
 	}
 
 	output, err := c.useCase.AnalyzeGoalFeasibility(ctx.Request().Context(), input)
@@ -512,3 +918,294 @@ func (c *GoalsController) AnalyzeGoalFeasibility(ctx echo.Context) error {
 
 	return ctx.JSON(http.StatusOK, output)
 }
+
+// EstimateAchievementProbability は積立ペースの実績から目標の達成確率を推定する
+// @Summary 目標の達成確率推定
+// @Description 進捗履歴の直近の平均積立額から期限までに目標金額に到達する確率と算出根拠を返します。履歴が不足している場合は計画上の月間拠出額で推定します
+// @Tags goals
+// @Produce json
+// @Param id path string true "目標ID"
+// @Param user_id query string true "ユーザーID"
+// @Success 200 {object} usecases.EstimateAchievementProbabilityOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/{id}/achievement-probability [get]
+func (c *GoalsController) EstimateAchievementProbability(ctx echo.Context) error {
+	goalID := ctx.Param("id")
+	if goalID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
+	}
+
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	input := usecases.EstimateAchievementProbabilityInput{
+		GoalID: entities.GoalID(goalID),
+		UserID: uid,
+	}
+
+	output, err := c.useCase.EstimateAchievementProbability(ctx.Request().Context(), input)
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), "アクセスする権限がありません"):
+			return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, err.Error(), nil))
+		case strings.Contains(err.Error(), "目標の取得に失敗しました"):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "目標"))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// GetPrioritizedGoals は目標を拠出の優先順位で取得する
+// @Summary 目標の優先順位取得
+// @Description アクティブな目標を拠出1円あたりで達成できる目標金額（EffectiveROI）の高い順に返します
+// @Tags goals
+// @Produce json
+// @Param user_id query string true "ユーザーID"
+// @Success 200 {object} usecases.GetPrioritizedGoalsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/prioritized [get]
+func (c *GoalsController) GetPrioritizedGoals(ctx echo.Context) error {
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.GetPrioritizedGoals(ctx.Request().Context(), usecases.GetPrioritizedGoalsInput{UserID: uid})
+	if err != nil {
+		if strings.Contains(err.Error(), "財務計画の取得に失敗しました") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務計画"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// GetOverdueGoals は期限切れの目標を立て直しの選択肢とともに取得する
+// @Summary 期限切れ目標の取得
+// @Description 目標日を過ぎて未達成のアクティブな目標を、目標日の延長（extend_date）と目標金額の引き下げ（reduce_target）の選択肢とともに返します。期限切れの目標がない場合は空の配列を返します
+// @Tags goals
+// @Produce json
+// @Param user_id query string true "ユーザーID"
+// @Success 200 {object} usecases.OverdueGoalsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/overdue [get]
+func (c *GoalsController) GetOverdueGoals(ctx echo.Context) error {
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.GetOverdueGoals(ctx.Request().Context(), usecases.GetOverdueGoalsInput{UserID: uid})
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// ExportGoalProgress は目標の進捗履歴をCSVとしてダウンロードする
+// @Summary 目標進捗のCSVエクスポート
+// @Description 認証済みユーザーの目標の進捗履歴（日付・金額・進捗率・メモ）をBOM付きUTF-8のCSVで返します。履歴がない場合は現在の状態のみを1行出力します
+// @Tags goals
+// @Security BearerAuth
+// @Produce text/csv
+// @Param id path string true "目標ID"
+// @Param format query string false "出力形式（csv のみ対応）"
+// @Success 200 {file} binary "CSVファイル"
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/{id}/progress/export [get]
+func (c *GoalsController) ExportGoalProgress(ctx echo.Context) error {
+	goalID := ctx.Param("id")
+	if goalID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "目標IDは必須です", nil))
+	}
+
+	if !isSupportedGoalExportFormat(ctx.QueryParam("format")) {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "未対応の出力形式です", map[string]string{
+			"valid_formats": "csv",
+		}))
+	}
+
+	// エクスポートは認証済みユーザー自身の目標に限定し、目標の所有者との一致はユースケースで確認する
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := c.useCase.ExportGoalProgressCSV(ctx.Request().Context(), usecases.ExportGoalProgressInput{
+		GoalID: entities.GoalID(goalID),
+		UserID: entities.UserID(userID),
+	})
+	if err != nil {
+		errMsg := err.Error()
+		// 他のユーザーの目標は存在を明かさないよう見つからない場合と同じ応答にする
+		if strings.Contains(errMsg, "目標の取得に失敗しました") || strings.Contains(errMsg, "アクセスする権限がありません") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "目標"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+
+	ctx.Response().Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="goal_progress_%s.csv"`, goalID))
+	return ctx.Blob(http.StatusOK, "text/csv; charset=utf-8", data)
+}
+
+// ExportGoals は全目標の進捗履歴をまとめてCSVとしてダウンロードする
+// @Summary 全目標のCSVエクスポート
+// @Description 認証済みユーザーの全目標の進捗履歴をBOM付きUTF-8のCSVで返します（Excel互換）
+// @Tags goals
+// @Security BearerAuth
+// @Produce text/csv
+// @Param format query string false "出力形式（csv のみ対応）"
+// @Success 200 {file} binary "CSVファイル"
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /goals/export [get]
+func (c *GoalsController) ExportGoals(ctx echo.Context) error {
+	if !isSupportedGoalExportFormat(ctx.QueryParam("format")) {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "未対応の出力形式です", map[string]string{
+			"valid_formats": "csv",
+		}))
+	}
+
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	data, err := c.useCase.ExportGoalsCSV(ctx.Request().Context(), entities.UserID(userID))
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	ctx.Response().Header().Set("Content-Disposition", `attachment; filename="goals.csv"`)
+	return ctx.Blob(http.StatusOK, "text/csv; charset=utf-8", data)
+}
+
+// isSupportedGoalExportFormat は目標のエクスポート形式に対応しているかを返す（未指定の場合は csv）
+func isSupportedGoalExportFormat(format string) bool {
+	return format == "" || format == "csv"
+}
+
+// handleGoalDependencyError は前提となる目標の設定に関するエラーをHTTPレスポンスに変換する
+func (c *GoalsController) handleGoalDependencyError(ctx echo.Context, err error) (bool, error) {
+	switch {
+	case strings.Contains(err.Error(), "前提となる目標の取得に失敗しました"):
+		return true, ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "前提となる目標"))
+	case errors.Is(err, entities.ErrGoalDependencyCycle),
+		strings.Contains(err.Error(), "他のユーザーの目標を前提にすることはできません"):
+		return true, ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeBusinessLogic, err.Error(), nil))
+	default:
+		return false, nil
+	}
+}
+
+// handleGoalCurrencyError は外貨建ての目標の通貨・為替レートに関するエラーをHTTPレスポンスに変換する
+func (c *GoalsController) handleGoalCurrencyError(ctx echo.Context, err error) (bool, error) {
+	errMsg := err.Error()
+	switch {
+	case errors.Is(err, entities.ErrGoalNotForeignCurrency):
+		return true, ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeBusinessLogic, errMsg, nil))
+	case strings.Contains(errMsg, "対応していない通貨です"),
+		strings.Contains(errMsg, "外貨建ての目標には為替レートが必要です"),
+		strings.Contains(errMsg, "為替レートの作成に失敗しました"),
+		strings.Contains(errMsg, "為替レートの基準日時の解析に失敗しました"):
+		return true, ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return false, nil
+	}
+}
+
+// handleContributionRangeError は月間拠出額が最低額・ストレッチ額の範囲外になるエラーをHTTPレスポンスに変換する
+func (c *GoalsController) handleContributionRangeError(ctx echo.Context, err error) (bool, error) {
+	if errors.Is(err, entities.ErrContributionOutOfRange) {
+		return true, ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeBusinessLogic, err.Error(), nil))
+	}
+	return false, nil
+}
+
+// isForeignCurrencyRequest はリクエストの通貨が円以外かを返す
+func isForeignCurrencyRequest(currency string) bool {
+	currency = strings.TrimSpace(currency)
+	return currency != "" && !strings.EqualFold(currency, string(valueobjects.JPY))
+}
+
+// dependsOn は前提となる目標のID一覧を返す
+// depends_on を省略して旧形式の depends_on_goal_id を指定した場合は、その目標のみを前提とする
+func (r *CreateGoalRequest) dependsOn() []string {
+	if r.DependsOn == nil && r.DependsOnGoalID != nil && *r.DependsOnGoalID != "" {
+		return []string{*r.DependsOnGoalID}
+	}
+	return r.DependsOn
+}
+
+// dependsOn は前提となる目標のID一覧を返す（nil の場合は依存関係を変更しない）
+// depends_on を省略して旧形式の depends_on_goal_id を指定した場合は、その目標のみを前提とする（空文字は依存の解除）
+func (r *UpdateGoalRequest) dependsOn() *[]string {
+	if r.DependsOn != nil || r.DependsOnGoalID == nil {
+		return r.DependsOn
+	}
+	dependsOn := []string{}
+	if *r.DependsOnGoalID != "" {
+		dependsOn = append(dependsOn, *r.DependsOnGoalID)
+	}
+	return &dependsOn
+}
+
+// toInput は為替レートのリクエストをユースケースの入力に変換する
+func (r *ExchangeRateRequest) toInput() *usecases.ExchangeRateInput {
+	return &usecases.ExchangeRateInput{
+		Rate:   r.Rate,
+		Source: r.Source,
+		AsOf:   r.AsOf,
+	}
+}
+
+// handleGoalImageError は目標画像の操作に関するエラーをHTTPレスポンスに変換する
+func (c *GoalsController) handleGoalImageError(ctx echo.Context, err error) error {
+	errMsg := err.Error()
+	switch {
+	case errors.Is(err, usecases.ErrGoalImageStorageUnavailable):
+		return ctx.JSON(http.StatusServiceUnavailable, NewErrorResponse(ctx, ErrorCodeServiceUnavailable, errMsg, nil))
+	case strings.Contains(errMsg, "目標の取得に失敗しました"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "目標"))
+	case strings.Contains(errMsg, "アクセスする権限がありません"):
+		return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, errMsg, nil))
+	case strings.Contains(errMsg, "画像の形式が正しくありません"),
+		strings.Contains(errMsg, "画像のサイズは"),
+		strings.Contains(errMsg, "画像が空です"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
@@ -5,13 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/domain/entities"
-	"github.com/go-playground/validator/v10"
+	"github.com/financial-planning-calculator/backend/domain/services"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -83,15 +85,84 @@ func (m *MockManageGoalsUseCase) AnalyzeGoalFeasibility(ctx context.Context, inp
 	return args.Get(0).(*usecases.AnalyzeGoalFeasibilityOutput), args.Error(1)
 }
 
+func (m *MockManageGoalsUseCase) EstimateAchievementProbability(ctx context.Context, input usecases.EstimateAchievementProbabilityInput) (*usecases.EstimateAchievementProbabilityOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.EstimateAchievementProbabilityOutput), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) AdoptRequiredContribution(ctx context.Context, input usecases.AdoptRequiredContributionInput) (*usecases.AdoptRequiredContributionOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.AdoptRequiredContributionOutput), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) GetPrioritizedGoals(ctx context.Context, input usecases.GetPrioritizedGoalsInput) (*usecases.GetPrioritizedGoalsOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.GetPrioritizedGoalsOutput), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) GetOverdueGoals(ctx context.Context, input usecases.GetOverdueGoalsInput) (*usecases.OverdueGoalsOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.OverdueGoalsOutput), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) ExportGoalProgressCSV(ctx context.Context, input usecases.ExportGoalProgressInput) ([]byte, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) ExportGoalsCSV(ctx context.Context, userID entities.UserID) ([]byte, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) UploadGoalImage(ctx context.Context, input usecases.UploadGoalImageInput) (*usecases.GoalImageOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.GoalImageOutput), args.Error(1)
+}
+
+func (m *MockManageGoalsUseCase) DeleteGoalImage(ctx context.Context, input usecases.DeleteGoalImageInput) error {
+	args := m.Called(ctx, input)
+	return args.Error(0)
+}
+
+func (m *MockManageGoalsUseCase) UpdateGoalExchangeRate(ctx context.Context, input usecases.UpdateGoalExchangeRateInput) (*usecases.GetGoalOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.GetGoalOutput), args.Error(1)
+}
+
 func newGoalsEcho() *echo.Echo {
 	e := echo.New()
-	e.Validator = &CustomValidator{validator: validator.New()}
+	e.Validator = &CustomValidator{validator: newTestValidator()}
 	return e
 }
 
 func TestCreateGoal(t *testing.T) {
 	validRequest := CreateGoalRequest{
-		UserID:              "user-123",
+		UserID:              "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 		GoalType:            "savings",
 		Title:               "My Savings Goal",
 		TargetAmount:        1000000,
@@ -112,10 +183,10 @@ func TestCreateGoal(t *testing.T) {
 			requestBody: validRequest,
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("CreateGoal", mock.Anything, mock.MatchedBy(func(input usecases.CreateGoalInput) bool {
-					return input.UserID == entities.UserID("user-123") && input.GoalType == "savings"
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c") && input.GoalType == "savings"
 				})).Return(&usecases.CreateGoalOutput{
 					GoalID:    entities.GoalID("goal-123"),
-					UserID:    entities.UserID("user-123"),
+					UserID:    entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 					CreatedAt: "2030-01-01T00:00:00Z",
 				}, nil)
 			},
@@ -135,7 +206,7 @@ func TestCreateGoal(t *testing.T) {
 		{
 			name: "Error: invalid goal type",
 			requestBody: CreateGoalRequest{
-				UserID:       "user-123",
+				UserID:       "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				GoalType:     "invalid",
 				Title:        "Goal",
 				TargetAmount: 1000000,
@@ -147,7 +218,7 @@ func TestCreateGoal(t *testing.T) {
 		{
 			name: "Error: current amount exceeds target amount (business logic)",
 			requestBody: CreateGoalRequest{
-				UserID:        "user-123",
+				UserID:        "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				GoalType:      "savings",
 				Title:         "Goal",
 				TargetAmount:  100000,
@@ -159,7 +230,7 @@ func TestCreateGoal(t *testing.T) {
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("CreateGoal", mock.Anything, mock.Anything).Return(&usecases.CreateGoalOutput{
 					GoalID: entities.GoalID("goal-123"),
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}, nil)
 			},
 			expectedStatus: http.StatusBadRequest,
@@ -172,6 +243,77 @@ func TestCreateGoal(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:        "Error: similar goal already exists",
+			requestBody: validRequest,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("CreateGoal", mock.Anything, mock.MatchedBy(func(input usecases.CreateGoalInput) bool {
+					return !input.AllowDuplicate
+				})).Return(nil, &usecases.DuplicateGoalError{ExistingGoalID: entities.GoalID("goal-001")})
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name: "Success: allow_duplicate is passed to the use case",
+			requestBody: CreateGoalRequest{
+				UserID:         "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				GoalType:       "savings",
+				Title:          "My Savings Goal",
+				TargetAmount:   1000000,
+				TargetDate:     "2030-01-01T00:00:00Z",
+				AllowDuplicate: true,
+			},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("CreateGoal", mock.Anything, mock.MatchedBy(func(input usecases.CreateGoalInput) bool {
+					return input.AllowDuplicate
+				})).Return(&usecases.CreateGoalOutput{
+					GoalID: entities.GoalID("goal-124"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "Success: legacy depends_on_goal_id is passed as a single depends_on",
+			requestBody: map[string]interface{}{
+				"user_id":            "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				"goal_type":          "savings",
+				"title":              "My Savings Goal",
+				"target_amount":      1000000,
+				"target_date":        "2030-01-01T00:00:00Z",
+				"depends_on_goal_id": "goal-001",
+			},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("CreateGoal", mock.Anything, mock.MatchedBy(func(input usecases.CreateGoalInput) bool {
+					return assert.ObjectsAreEqual([]string{"goal-001"}, input.DependsOn)
+				})).Return(&usecases.CreateGoalOutput{
+					GoalID: entities.GoalID("goal-125"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "Success: depends_on takes precedence over legacy depends_on_goal_id",
+			requestBody: map[string]interface{}{
+				"user_id":            "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				"goal_type":          "savings",
+				"title":              "My Savings Goal",
+				"target_amount":      1000000,
+				"target_date":        "2030-01-01T00:00:00Z",
+				"depends_on":         []string{"goal-002", "goal-003"},
+				"depends_on_goal_id": "goal-001",
+			},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("CreateGoal", mock.Anything, mock.MatchedBy(func(input usecases.CreateGoalInput) bool {
+					return assert.ObjectsAreEqual([]string{"goal-002", "goal-003"}, input.DependsOn)
+				})).Return(&usecases.CreateGoalOutput{
+					GoalID: entities.GoalID("goal-126"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
 		{
 			name:        "Error: internal server error",
 			requestBody: validRequest,
@@ -207,6 +349,77 @@ func TestCreateGoal(t *testing.T) {
 	}
 }
 
+func TestCreateGoal_InputSanitization(t *testing.T) {
+	newRequest := func(title string, description *string) CreateGoalRequest {
+		return CreateGoalRequest{
+			UserID:       "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			GoalType:     "savings",
+			Title:        title,
+			TargetAmount: 1000000,
+			TargetDate:   "2030-01-01T00:00:00Z",
+			Description:  description,
+		}
+	}
+	withNotes := func(req CreateGoalRequest, notes string) CreateGoalRequest {
+		req.Notes = notes
+		return req
+	}
+	withImageURL := func(req CreateGoalRequest, imageURL string) CreateGoalRequest {
+		req.ImageURL = imageURL
+		return req
+	}
+	longDescription := strings.Repeat("あ", MaxDescriptionLength+1)
+	script := `<script>alert("xss")</script>`
+
+	tests := []struct {
+		name               string
+		requestBody        CreateGoalRequest
+		expectedTitle      string
+		expectHandlerError bool
+	}{
+		{"Error: title exceeds 100 characters", newRequest(strings.Repeat("長", MaxTitleLength+1), nil), "", true},
+		{"Error: title is whitespace only", newRequest(" \u3000\t ", nil), "", true},
+		{"Error: title is control characters only", newRequest("\x00\x07", nil), "", true},
+		{"Error: description exceeds 500 characters", newRequest("Goal", &longDescription), "", true},
+		{"Error: notes exceed 1000 characters", withNotes(newRequest("Goal", nil), strings.Repeat("あ", MaxNotesLength+1)), "", true},
+		{"Error: image_url points to private network", withImageURL(newRequest("Goal", nil), "http://192.168.0.1/image.png"), "", true},
+		{"Success: notes with exactly 1000 characters", withNotes(newRequest("Goal", nil), strings.Repeat("あ", MaxNotesLength)), "Goal", false},
+		{"Success: title with exactly 100 characters", newRequest(strings.Repeat("長", MaxTitleLength), nil), strings.Repeat("長", MaxTitleLength), false},
+		{"Success: control characters are stripped", newRequest("旅行\x00資金\x1b", nil), "旅行資金", false},
+		{"Success: script tags are stored as-is (escaped on output)", newRequest(script, nil), script, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			if !tt.expectHandlerError {
+				mockUseCase.On("CreateGoal", mock.Anything, mock.MatchedBy(func(input usecases.CreateGoalInput) bool {
+					return input.Title == tt.expectedTitle
+				})).Return(&usecases.CreateGoalOutput{GoalID: "goal-123", UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}, nil)
+			}
+			controller := NewGoalsController(mockUseCase)
+
+			reqJSON, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/goals", bytes.NewBuffer(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.CreateGoal(c)
+
+			if tt.expectHandlerError {
+				assert.Error(t, err)
+				mockUseCase.AssertNotCalled(t, "CreateGoal", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, rec.Code)
+				mockUseCase.AssertExpectations(t)
+			}
+		})
+	}
+}
+
 func TestGetGoals(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -217,10 +430,10 @@ func TestGetGoals(t *testing.T) {
 	}{
 		{
 			name:        "Success: get all goals",
-			queryParams: map[string]string{"user_id": "user-123"},
+			queryParams: map[string]string{"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("GetGoalsByUser", mock.Anything, mock.MatchedBy(func(input usecases.GetGoalsByUserInput) bool {
-					return input.UserID == entities.UserID("user-123")
+					return input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 				})).Return(&usecases.GetGoalsByUserOutput{
 					Goals:   []usecases.GoalWithStatus{},
 					Summary: usecases.GoalsSummary{},
@@ -230,9 +443,11 @@ func TestGetGoals(t *testing.T) {
 		},
 		{
 			name:        "Success: filter by valid goal type",
-			queryParams: map[string]string{"user_id": "user-123", "goal_type": "savings"},
+			queryParams: map[string]string{"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", "goal_type": "savings"},
 			mockSetup: func(m *MockManageGoalsUseCase) {
-				m.On("GetGoalsByUser", mock.Anything, mock.Anything).Return(&usecases.GetGoalsByUserOutput{
+				m.On("GetGoalsByUser", mock.Anything, mock.MatchedBy(func(input usecases.GetGoalsByUserInput) bool {
+					return input.GoalType != nil && *input.GoalType == entities.GoalTypeSavings
+				})).Return(&usecases.GetGoalsByUserOutput{
 					Goals:   []usecases.GoalWithStatus{},
 					Summary: usecases.GoalsSummary{},
 				}, nil)
@@ -240,27 +455,40 @@ func TestGetGoals(t *testing.T) {
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name:               "Error: missing user_id",
-			queryParams:        map[string]string{},
-			mockSetup:          func(m *MockManageGoalsUseCase) {},
-			expectHandlerError: true,
+			name:           "Error: missing user_id",
+			queryParams:    map[string]string{},
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			// Note: due to query tag `query:"goal_type,omitempty"`, Echo does not bind
-			// goal_type query param, so invalid type falls through as empty and GetGoalsByUser is called
-			name:        "Note: invalid goal type is treated as no filter (tag binding issue)",
-			queryParams: map[string]string{"user_id": "user-123", "goal_type": "invalid"},
+			name:        "Success: filter by active_only",
+			queryParams: map[string]string{"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", "active_only": "true"},
 			mockSetup: func(m *MockManageGoalsUseCase) {
-				m.On("GetGoalsByUser", mock.Anything, mock.Anything).Return(&usecases.GetGoalsByUserOutput{
+				m.On("GetGoalsByUser", mock.Anything, mock.MatchedBy(func(input usecases.GetGoalsByUserInput) bool {
+					return input.ActiveOnly && input.GoalType == nil
+				})).Return(&usecases.GetGoalsByUserOutput{
 					Goals:   []usecases.GoalWithStatus{},
 					Summary: usecases.GoalsSummary{},
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			// 無効な目標タイプはユースケースを呼ばずに 400 を返す（mockSetup が空のため呼ばれると panic する）
+			name:           "Error: invalid goal type",
+			queryParams:    map[string]string{"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", "goal_type": "invalid"},
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: invalid active_only",
+			queryParams:    map[string]string{"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", "active_only": "maybe"},
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:        "Error: internal server error",
-			queryParams: map[string]string{"user_id": "user-123"},
+			queryParams: map[string]string{"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("GetGoalsByUser", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -294,6 +522,7 @@ func TestGetGoals(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Equal(t, tt.expectedStatus, rec.Code)
 			}
+			mockUseCase.AssertExpectations(t)
 		})
 	}
 }
@@ -309,11 +538,11 @@ func TestGetGoal(t *testing.T) {
 		{
 			name:   "Success: get goal",
 			goalID: "goal-123",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("GetGoal", mock.Anything, usecases.GetGoalInput{
 					GoalID: entities.GoalID("goal-123"),
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}).Return(&usecases.GetGoalOutput{
 					Goal:     nil,
 					Progress: entities.ProgressRate{},
@@ -329,10 +558,17 @@ func TestGetGoal(t *testing.T) {
 			mockSetup:      func(m *MockManageGoalsUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:           "Error: user_id is not a UUID v4",
+			goalID:         "goal-123",
+			userID:         "1%27%20OR%20%271%27%3D%271",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:   "Error: goal not found",
 			goalID: "nonexistent-goal",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("GetGoal", mock.Anything, mock.Anything).Return(nil, errors.New("goal not found"))
 			},
@@ -379,11 +615,11 @@ func TestUpdateGoal(t *testing.T) {
 		{
 			name:        "Success: update goal",
 			goalID:      "goal-123",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: UpdateGoalRequest{Title: &title},
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("UpdateGoal", mock.Anything, mock.MatchedBy(func(input usecases.UpdateGoalInput) bool {
-					return input.GoalID == entities.GoalID("goal-123") && input.UserID == entities.UserID("user-123")
+					return input.GoalID == entities.GoalID("goal-123") && input.UserID == entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c")
 				})).Return(&usecases.UpdateGoalOutput{
 					Success:   true,
 					UpdatedAt: "2030-01-01T00:00:00Z",
@@ -391,6 +627,30 @@ func TestUpdateGoal(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:        "Success: legacy depends_on_goal_id is passed as a single depends_on",
+			goalID:      "goal-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			requestBody: map[string]interface{}{"depends_on_goal_id": "goal-001"},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("UpdateGoal", mock.Anything, mock.MatchedBy(func(input usecases.UpdateGoalInput) bool {
+					return input.DependsOn != nil && assert.ObjectsAreEqual([]string{"goal-001"}, *input.DependsOn)
+				})).Return(&usecases.UpdateGoalOutput{Success: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Success: empty legacy depends_on_goal_id removes dependencies",
+			goalID:      "goal-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			requestBody: map[string]interface{}{"depends_on_goal_id": ""},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("UpdateGoal", mock.Anything, mock.MatchedBy(func(input usecases.UpdateGoalInput) bool {
+					return input.DependsOn != nil && len(*input.DependsOn) == 0
+				})).Return(&usecases.UpdateGoalOutput{Success: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "Error: missing user_id",
 			goalID:         "goal-123",
@@ -402,7 +662,7 @@ func TestUpdateGoal(t *testing.T) {
 		{
 			name:        "Error: internal server error",
 			goalID:      "goal-123",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: UpdateGoalRequest{Title: &title},
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("UpdateGoal", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
@@ -455,7 +715,7 @@ func TestUpdateGoalProgress(t *testing.T) {
 		{
 			name:        "Success: update goal progress",
 			goalID:      "goal-123",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: UpdateGoalProgressRequest{CurrentAmount: 500000},
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("UpdateGoalProgress", mock.Anything, mock.MatchedBy(func(input usecases.UpdateGoalProgressInput) bool {
@@ -478,7 +738,7 @@ func TestUpdateGoalProgress(t *testing.T) {
 		{
 			name:        "Error: internal server error",
 			goalID:      "goal-123",
-			userID:      "user-123",
+			userID:      "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: UpdateGoalProgressRequest{CurrentAmount: 500000},
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("UpdateGoalProgress", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
@@ -529,11 +789,11 @@ func TestDeleteGoal(t *testing.T) {
 		{
 			name:   "Success: delete goal",
 			goalID: "goal-123",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("DeleteGoal", mock.Anything, usecases.DeleteGoalInput{
 					GoalID: entities.GoalID("goal-123"),
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}).Return(nil)
 			},
 			expectedStatus: http.StatusNoContent,
@@ -548,7 +808,7 @@ func TestDeleteGoal(t *testing.T) {
 		{
 			name:   "Error: internal server error",
 			goalID: "goal-123",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockManageGoalsUseCase) {
 				m.On("DeleteGoal", mock.Anything, mock.Anything).Return(errors.New("database error"))
 			},
@@ -581,41 +841,58 @@ func TestDeleteGoal(t *testing.T) {
 	}
 }
 
-func TestGetGoalRecommendations(t *testing.T) {
+func TestUploadGoalImage(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	pngData := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
 	tests := []struct {
 		name           string
-		goalID         string
-		userID         string
+		data           []byte
 		mockSetup      func(m *MockManageGoalsUseCase)
 		expectedStatus int
 	}{
 		{
-			name:   "Success: get recommendations",
-			goalID: "goal-123",
-			userID: "user-123",
+			name: "Success: uploads image with detected content type",
+			data: pngData,
 			mockSetup: func(m *MockManageGoalsUseCase) {
-				m.On("GetGoalRecommendations", mock.Anything, usecases.GetGoalRecommendationsInput{
-					GoalID: entities.GoalID("goal-123"),
-					UserID: entities.UserID("user-123"),
-				}).Return(&usecases.GetGoalRecommendationsOutput{}, nil)
+				m.On("UploadGoalImage", mock.Anything, usecases.UploadGoalImageInput{
+					GoalID:      entities.GoalID("goal-123"),
+					UserID:      entities.UserID(userID),
+					ContentType: "image/png",
+					Data:        pngData,
+				}).Return(&usecases.GoalImageOutput{ImageURL: "/uploads/goals/goal-123/image.png"}, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name:           "Error: missing user_id",
-			goalID:         "goal-123",
-			userID:         "",
-			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			name: "Error: unsupported image format",
+			data: []byte("plain text"),
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("UploadGoalImage", mock.Anything, mock.Anything).
+					Return(nil, errors.New("画像の形式が正しくありません（JPEG・PNG・GIF・WebPのみ）: text/plain; charset=utf-8"))
+			},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name:   "Error: internal server error",
-			goalID: "goal-123",
-			userID: "user-123",
+			name: "Error: storage not configured",
+			data: pngData,
 			mockSetup: func(m *MockManageGoalsUseCase) {
-				m.On("GetGoalRecommendations", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+				m.On("UploadGoalImage", mock.Anything, mock.Anything).Return(nil, usecases.ErrGoalImageStorageUnavailable)
 			},
-			expectedStatus: http.StatusInternalServerError,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name: "Error: goal not found",
+			data: pngData,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("UploadGoalImage", mock.Anything, mock.Anything).Return(nil, errors.New("目標の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Error: missing image file",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
 		},
 	}
 
@@ -626,63 +903,121 @@ func TestGetGoalRecommendations(t *testing.T) {
 			tt.mockSetup(mockUseCase)
 			controller := NewGoalsController(mockUseCase)
 
-			target := "/goals/" + tt.goalID + "/recommendations"
-			if tt.userID != "" {
-				target += "?user_id=" + tt.userID
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			if tt.data != nil {
+				part, err := writer.CreateFormFile("image", "image.png")
+				assert.NoError(t, err)
+				_, err = part.Write(tt.data)
+				assert.NoError(t, err)
 			}
-			req := httptest.NewRequest(http.MethodGet, target, nil)
+			assert.NoError(t, writer.Close())
+
+			req := httptest.NewRequest(http.MethodPut, "/goals/goal-123/image?user_id="+userID, body)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetParamNames("id")
-			c.SetParamValues(tt.goalID)
+			c.SetParamValues("goal-123")
 
-			err := controller.GetGoalRecommendations(c)
+			err := controller.UploadGoalImage(c)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
 		})
 	}
 }
 
-func TestAnalyzeGoalFeasibility(t *testing.T) {
+func TestDeleteGoalImage(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
 	tests := []struct {
 		name           string
-		goalID         string
-		userID         string
-		mockSetup      func(m *MockManageGoalsUseCase)
+		err            error
 		expectedStatus int
+	}{
+		{"Success: deletes image", nil, http.StatusNoContent},
+		{"Error: goal of another user", errors.New("指定された目標にアクセスする権限がありません"), http.StatusForbidden},
+		{"Error: save failure", errors.New("目標の保存に失敗しました: db error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			mockUseCase.On("DeleteGoalImage", mock.Anything, usecases.DeleteGoalImageInput{
+				GoalID: entities.GoalID("goal-123"),
+				UserID: entities.UserID(userID),
+			}).Return(tt.err)
+			controller := NewGoalsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodDelete, "/goals/goal-123/image?user_id="+userID, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("goal-123")
+
+			err := controller.DeleteGoalImage(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateGoalExchangeRate(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(m *MockManageGoalsUseCase)
+		expectedStatus     int
+		expectHandlerError bool
 	}{
 		{
-			name:   "Success: analyze feasibility",
-			goalID: "goal-123",
-			userID: "user-123",
+			name:        "Success: returns recalculated goal",
+			requestBody: ExchangeRateRequest{Rate: 125, Source: "bank", AsOf: "2030-01-01T00:00:00Z"},
 			mockSetup: func(m *MockManageGoalsUseCase) {
-				m.On("AnalyzeGoalFeasibility", mock.Anything, usecases.AnalyzeGoalFeasibilityInput{
-					GoalID: entities.GoalID("goal-123"),
-					UserID: entities.UserID("user-123"),
-				}).Return(&usecases.AnalyzeGoalFeasibilityOutput{
-					Achievable: true,
-					RiskLevel:  "low",
-					Insights:   []usecases.FeasibilityInsight{},
-				}, nil)
+				m.On("UpdateGoalExchangeRate", mock.Anything, usecases.UpdateGoalExchangeRateInput{
+					GoalID:            entities.GoalID("goal-123"),
+					UserID:            entities.UserID(userID),
+					ExchangeRateInput: usecases.ExchangeRateInput{Rate: 125, Source: "bank", AsOf: "2030-01-01T00:00:00Z"},
+				}).Return(&usecases.GetGoalOutput{Currency: &usecases.GoalCurrencyDetail{GoalCurrency: "USD", PlanCurrency: "JPY"}}, nil)
 			},
 			expectedStatus: http.StatusOK,
 		},
 		{
-			name:           "Error: missing user_id",
-			goalID:         "goal-123",
-			userID:         "",
-			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			name:               "Error: rate must be positive",
+			requestBody:        ExchangeRateRequest{Rate: -1},
+			mockSetup:          func(m *MockManageGoalsUseCase) {},
+			expectHandlerError: true,
+		},
+		{
+			name:        "Error: goal is not in a foreign currency",
+			requestBody: ExchangeRateRequest{Rate: 125},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("UpdateGoalExchangeRate", mock.Anything, mock.Anything).Return(nil, entities.ErrGoalNotForeignCurrency)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "Error: invalid as_of",
+			requestBody: ExchangeRateRequest{Rate: 125, AsOf: "yesterday"},
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("UpdateGoalExchangeRate", mock.Anything, mock.Anything).Return(nil, errors.New("為替レートの基準日時の解析に失敗しました: parse error"))
+			},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name:   "Error: internal server error",
-			goalID: "goal-123",
-			userID: "user-123",
+			name:        "Error: goal not found",
+			requestBody: ExchangeRateRequest{Rate: 125},
 			mockSetup: func(m *MockManageGoalsUseCase) {
-				m.On("AnalyzeGoalFeasibility", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+				m.On("UpdateGoalExchangeRate", mock.Anything, mock.Anything).Return(nil, errors.New("目標の取得に失敗しました: not found"))
 			},
-			expectedStatus: http.StatusInternalServerError,
+			expectedStatus: http.StatusNotFound,
 		},
 	}
 
@@ -693,9 +1028,253 @@ func TestAnalyzeGoalFeasibility(t *testing.T) {
 			tt.mockSetup(mockUseCase)
 			controller := NewGoalsController(mockUseCase)
 
-			target := "/goals/" + tt.goalID + "/feasibility"
-			if tt.userID != "" {
-				target += "?user_id=" + tt.userID
+			reqJSON, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/goals/goal-123/exchange-rate?user_id="+userID, bytes.NewBuffer(reqJSON))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("goal-123")
+
+			err := controller.UpdateGoalExchangeRate(c)
+
+			if tt.expectHandlerError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdoptRequiredContribution(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	overBudgetErr := &usecases.ContributionOverBudgetError{
+		MonthlyContribution:    73000,
+		OtherGoalsContribution: 160000,
+		MonthlyNetSavings:      220000,
+		ExceededAmount:         13000,
+	}
+
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(m *MockManageGoalsUseCase)
+		expectedStatus     int
+		expectedBody       []string
+		expectHandlerError bool
+	}{
+		{
+			name:        "Success: adopts the rounded required contribution",
+			requestBody: ``,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AdoptRequiredContribution", mock.Anything, usecases.AdoptRequiredContributionInput{
+					GoalID: entities.GoalID("goal-123"),
+					UserID: entities.UserID(userID),
+				}).Return(&usecases.AdoptRequiredContributionOutput{
+					PreviousMonthlyContribution: 50000,
+					AdoptedMonthlyContribution:  73000,
+					Feasibility:                 &usecases.AnalyzeGoalFeasibilityOutput{Achievable: true},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"adopted_monthly_contribution":73000`, `"previous_monthly_contribution":50000`, `"achievable":true`},
+		},
+		{
+			name:        "Success: passes round_to and force to the use case",
+			requestBody: `{"round_to":100,"force":true}`,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AdoptRequiredContribution", mock.Anything, usecases.AdoptRequiredContributionInput{
+					GoalID:  entities.GoalID("goal-123"),
+					UserID:  entities.UserID(userID),
+					RoundTo: 100,
+					Force:   true,
+				}).Return(&usecases.AdoptRequiredContributionOutput{AdoptedMonthlyContribution: 72300, OverBudget: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"over_budget":true`},
+		},
+		{
+			name:        "Error: over budget returns 409 with details",
+			requestBody: ``,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AdoptRequiredContribution", mock.Anything, mock.Anything).Return(nil, overBudgetErr)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   []string{`"exceeded_amount":13000`, `"monthly_net_savings":220000`, `"other_goals_contribution":160000`},
+		},
+		{
+			name:               "Error: round_to must be positive",
+			requestBody:        `{"round_to":-1000}`,
+			mockSetup:          func(m *MockManageGoalsUseCase) {},
+			expectHandlerError: true,
+		},
+		{
+			name:        "Error: completed goal",
+			requestBody: ``,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AdoptRequiredContribution", mock.Anything, mock.Anything).Return(nil, usecases.ErrGoalAlreadyCompleted)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "Error: goal not found",
+			requestBody: ``,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AdoptRequiredContribution", mock.Anything, mock.Anything).Return(nil, errors.New("目標の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/goals/goal-123/adopt-required-contribution?user_id="+userID, strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("goal-123")
+
+			err := controller.AdoptRequiredContribution(c)
+
+			if tt.expectHandlerError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+				for _, expected := range tt.expectedBody {
+					assert.Contains(t, rec.Body.String(), expected)
+				}
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetGoalRecommendations(t *testing.T) {
+	tests := []struct {
+		name           string
+		goalID         string
+		userID         string
+		mockSetup      func(m *MockManageGoalsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: get recommendations",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("GetGoalRecommendations", mock.Anything, usecases.GetGoalRecommendationsInput{
+					GoalID: entities.GoalID("goal-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}).Return(&usecases.GetGoalRecommendationsOutput{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: missing user_id",
+			goalID:         "goal-123",
+			userID:         "",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: internal server error",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("GetGoalRecommendations", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			target := "/goals/" + tt.goalID + "/recommendations"
+			if tt.userID != "" {
+				target += "?user_id=" + tt.userID
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.goalID)
+
+			err := controller.GetGoalRecommendations(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestAnalyzeGoalFeasibility(t *testing.T) {
+	tests := []struct {
+		name           string
+		goalID         string
+		userID         string
+		mockSetup      func(m *MockManageGoalsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: analyze feasibility",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AnalyzeGoalFeasibility", mock.Anything, usecases.AnalyzeGoalFeasibilityInput{
+					GoalID: entities.GoalID("goal-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}).Return(&usecases.AnalyzeGoalFeasibilityOutput{
+					Achievable: true,
+					RiskLevel:  "low",
+					Insights:   []usecases.FeasibilityInsight{},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: missing user_id",
+			goalID:         "goal-123",
+			userID:         "",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: internal server error",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("AnalyzeGoalFeasibility", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			target := "/goals/" + tt.goalID + "/feasibility"
+			if tt.userID != "" {
+				target += "?user_id=" + tt.userID
 			}
 			req := httptest.NewRequest(http.MethodGet, target, nil)
 			rec := httptest.NewRecorder()
@@ -710,3 +1289,308 @@ func TestAnalyzeGoalFeasibility(t *testing.T) {
 		})
 	}
 }
+
+func TestEstimateAchievementProbability(t *testing.T) {
+	tests := []struct {
+		name           string
+		goalID         string
+		userID         string
+		mockSetup      func(m *MockManageGoalsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: estimate achievement probability",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("EstimateAchievementProbability", mock.Anything, usecases.EstimateAchievementProbabilityInput{
+					GoalID: entities.GoalID("goal-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+				}).Return(&usecases.EstimateAchievementProbabilityOutput{
+					GoalID: entities.GoalID("goal-123"),
+					AchievementProbabilityEstimate: &services.AchievementProbabilityEstimate{
+						Probability: 72.5,
+						Basis:       services.AchievementBasisActualPace,
+						PaceStatus:  services.PaceOnTrack,
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: missing user_id",
+			goalID:         "goal-123",
+			userID:         "",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: goal of another user",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("EstimateAchievementProbability", mock.Anything, mock.Anything).Return(nil, errors.New("指定された目標にアクセスする権限がありません"))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "Error: goal not found",
+			goalID: "goal-999",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("EstimateAchievementProbability", mock.Anything, mock.Anything).Return(nil, errors.New("目標の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:   "Error: internal server error",
+			goalID: "goal-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("EstimateAchievementProbability", mock.Anything, mock.Anything).Return(nil, errors.New("進捗履歴の取得に失敗しました: database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			target := "/goals/" + tt.goalID + "/achievement-probability"
+			if tt.userID != "" {
+				target += "?user_id=" + tt.userID
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.goalID)
+
+			err := controller.EstimateAchievementProbability(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestGetPrioritizedGoals(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		userID         string
+		mockSetup      func(m *MockManageGoalsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: get prioritized goals",
+			userID: userID,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("GetPrioritizedGoals", mock.Anything, usecases.GetPrioritizedGoalsInput{UserID: entities.UserID(userID)}).
+					Return(&usecases.GetPrioritizedGoalsOutput{Goals: []usecases.PrioritizedGoal{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: missing user_id",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: financial plan not found",
+			userID: userID,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("GetPrioritizedGoals", mock.Anything, mock.Anything).
+					Return(nil, errors.New("財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			target := "/goals/prioritized"
+			if tt.userID != "" {
+				target += "?user_id=" + tt.userID
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.GetPrioritizedGoals(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetOverdueGoals(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		userID         string
+		mockSetup      func(m *MockManageGoalsUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Success: no overdue goals returns empty array",
+			userID: userID,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("GetOverdueGoals", mock.Anything, usecases.GetOverdueGoalsInput{UserID: entities.UserID(userID)}).
+					Return(&usecases.OverdueGoalsOutput{Goals: []usecases.OverdueGoal{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"goals":[]}`,
+		},
+		{
+			name:           "Error: missing user_id",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: use case failure",
+			userID: userID,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("GetOverdueGoals", mock.Anything, mock.Anything).
+					Return(nil, errors.New("目標の取得に失敗しました: db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			target := "/goals/overdue"
+			if tt.userID != "" {
+				target += "?user_id=" + tt.userID
+			}
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.GetOverdueGoals(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExportGoalProgress(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	csvData := []byte("\xEF\xBB\xBF日付,金額,進捗率,メモ\n2026-10-01,250000,25.0,\n")
+
+	tests := []struct {
+		name           string
+		query          string
+		userID         string
+		mockSetup      func(m *MockManageGoalsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: export progress as csv",
+			query:  "?format=csv",
+			userID: userID,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("ExportGoalProgressCSV", mock.Anything, usecases.ExportGoalProgressInput{
+					GoalID: entities.GoalID("goal-123"),
+					UserID: entities.UserID(userID),
+				}).Return(csvData, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: unsupported format",
+			query:          "?format=xlsx",
+			userID:         userID,
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: unauthenticated",
+			mockSetup:      func(m *MockManageGoalsUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "Error: another user's goal",
+			userID: userID,
+			mockSetup: func(m *MockManageGoalsUseCase) {
+				m.On("ExportGoalProgressCSV", mock.Anything, mock.Anything).
+					Return(nil, errors.New("指定された目標にアクセスする権限がありません"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageGoalsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewGoalsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodGet, "/goals/goal-123/progress/export"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("goal-123")
+			if tt.userID != "" {
+				setTestUserID(c, tt.userID)
+			}
+
+			err := controller.ExportGoalProgress(c)
+
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+				assert.Contains(t, rec.Header().Get("Content-Disposition"), "goal_progress_goal-123.csv")
+				assert.Equal(t, csvData, rec.Body.Bytes())
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExportGoals(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	e := newGoalsEcho()
+	mockUseCase := new(MockManageGoalsUseCase)
+	mockUseCase.On("ExportGoalsCSV", mock.Anything, entities.UserID(userID)).Return([]byte("\xEF\xBB\xBF目標ID\n"), nil)
+	controller := NewGoalsController(mockUseCase)
+
+	req := httptest.NewRequest(http.MethodGet, "/goals/export?format=csv", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	setTestUserID(c, userID)
+
+	err := controller.ExportGoals(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "goals.csv")
+	mockUseCase.AssertExpectations(t)
+}
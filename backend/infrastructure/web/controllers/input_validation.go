@@ -0,0 +1,155 @@
+package controllers
+
+import (
+	"net"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// 自由入力テキストの最大文字数（rune数）
+// リクエスト構造体の validate タグ（max=...）と揃えること
+const (
+	MaxTitleLength       = 100
+	MaxDescriptionLength = 500
+	MaxCategoryLength    = 50
+	MaxNotesLength       = 1000 // entities.MaxGoalNotesLength と揃えること
+)
+
+// 入力サニタイズ用のカスタムバリデーションタグ
+//
+//	safetext: 制御文字を含まないこと（SanitizeInput で事前に除去される）
+//	notblank: 空白文字のみの文字列でないこと
+//	safeurl:  http/https の外部URLであること（ループバック・プライベートIPなどは不可）
+//
+// HTMLのエスケープは入力時ではなく出力時に行う。APIのJSONレスポンスは encoding/json が
+// <, >, & をエスケープするため生の値のまま返し、PDFなどHTMLを生成する箇所で必ずエスケープする
+// ただし保存される自由入力テキスト（目標タイトル、支出項目など）は valueobjects.SanitizeText で
+// HTMLタグを取り除いてから保存する
+const (
+	tagSafeText = "safetext"
+	tagNotBlank = "notblank"
+	tagSafeURL  = "safeurl"
+)
+
+// RegisterInputValidations は入力サニタイズ用のカスタムバリデーションを登録する
+func RegisterInputValidations(v *validator.Validate) {
+	_ = v.RegisterValidation(tagSafeText, func(fl validator.FieldLevel) bool {
+		return !containsControlCharacters(fl.Field().String())
+	})
+	_ = v.RegisterValidation(tagNotBlank, func(fl validator.FieldLevel) bool {
+		return strings.TrimSpace(fl.Field().String()) != ""
+	})
+	_ = v.RegisterValidation(tagSafeURL, func(fl validator.FieldLevel) bool {
+		return IsSafeExternalURL(fl.Field().String())
+	})
+}
+
+// StripControlCharacters は改行・タブ以外の制御文字を取り除く
+func StripControlCharacters(s string) string {
+	if !containsControlCharacters(s) {
+		return s
+	}
+	return strings.Map(func(r rune) rune {
+		if isDisallowedControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// containsControlCharacters は改行・タブ以外の制御文字を含むかどうかを返す
+func containsControlCharacters(s string) bool {
+	return strings.IndexFunc(s, isDisallowedControl) >= 0
+}
+
+// isDisallowedControl は除去対象の制御文字かどうかを返す
+func isDisallowedControl(r rune) bool {
+	return unicode.IsControl(r) && r != '\n' && r != '\t'
+}
+
+// SanitizeInput は safetext タグが付いた文字列フィールドから制御文字を取り除く
+// ネストした構造体・ポインタ・スライスも対象にする。i は構造体へのポインタであること
+func SanitizeInput(i interface{}) {
+	v := reflect.ValueOf(i)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return
+	}
+	sanitizeValue(v.Elem())
+}
+
+// sanitizeValue は値を再帰的にたどり、safetext タグ付きの文字列を書き換える
+func sanitizeValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			sanitizeValue(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			sanitizeValue(v.Index(i))
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			if !field.CanSet() {
+				continue
+			}
+			if hasValidateTag(t.Field(i), tagSafeText) {
+				sanitizeString(field)
+				continue
+			}
+			sanitizeValue(field)
+		}
+	}
+}
+
+// sanitizeString は string または *string のフィールドから制御文字を取り除く
+func sanitizeString(field reflect.Value) {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return
+		}
+		field = field.Elem()
+	}
+	if field.Kind() == reflect.String {
+		field.SetString(StripControlCharacters(field.String()))
+	}
+}
+
+// hasValidateTag は validate タグに指定されたルールが含まれるかどうかを返す
+func hasValidateTag(field reflect.StructField, rule string) bool {
+	for _, r := range strings.Split(field.Tag.Get("validate"), ",") {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSafeExternalURL はURLがWebhookなどの送信先として安全かどうかを判定する
+// http/https のみ許可し、localhost やループバック・プライベート・リンクローカルなど
+// 内部ネットワークを指すIPアドレスは拒否する
+func IsSafeExternalURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.User != nil {
+		return false
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+			ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+			ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+	}
+
+	return true
+}
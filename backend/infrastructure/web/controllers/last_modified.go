@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// setLastModified はリソースの最終更新日時を Last-Modified ヘッダーに設定する（ゼロ値の場合は設定しない）
+// 条件付きGETの判定は ETag で行うため、Last-Modified は参考情報として返す
+func setLastModified(ctx echo.Context, updatedAt time.Time) {
+	if updatedAt.IsZero() {
+		return
+	}
+	ctx.Response().Header().Set(echo.HeaderLastModified, updatedAt.UTC().Format(http.TimeFormat))
+}
@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+	"github.com/labstack/echo/v4"
+)
+
+// LegacyUserIDParamPolicy は認証済みリクエストに付与された user_id クエリパラメータの扱い
+type LegacyUserIDParamPolicy string
+
+const (
+	// LegacyUserIDParamAllow は user_id パラメータを許容する（認証トークンのユーザーを優先する）
+	LegacyUserIDParamAllow LegacyUserIDParamPolicy = "allow"
+	// LegacyUserIDParamWarn は user_id パラメータを許容し、レスポンスの warnings に警告を含める
+	LegacyUserIDParamWarn LegacyUserIDParamPolicy = "warn"
+	// LegacyUserIDParamReject は user_id パラメータを付与したリクエストを 400 で拒否する
+	LegacyUserIDParamReject LegacyUserIDParamPolicy = "reject"
+)
+
+const (
+	// legacyUserIDParamPolicyKey はリクエストに適用するポリシーを保存するコンテキストのキー
+	legacyUserIDParamPolicyKey = "legacy_user_id_param_policy"
+	// ResponseWarningsKey はレスポンスの warnings に含める警告を保存するコンテキストのキー
+	ResponseWarningsKey = "response_warnings"
+	// ErrorCodeLegacyUserIDParam は廃止予定の user_id パラメータに関する警告・エラーのコード
+	ErrorCodeLegacyUserIDParam ErrorCode = "LEGACY_USER_ID_PARAM"
+)
+
+var (
+	// legacyUserIDParamDeprecatedAt は user_id パラメータを非推奨とした日時（Deprecation ヘッダー）
+	legacyUserIDParamDeprecatedAt = time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	// legacyUserIDParamSunsetAt は user_id パラメータの受け付けを終了する予定日時（Sunset ヘッダー）
+	legacyUserIDParamSunsetAt = time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// ParseLegacyUserIDParamPolicy は設定値をポリシーに変換する（空の場合は allow）
+func ParseLegacyUserIDParamPolicy(value string) (LegacyUserIDParamPolicy, error) {
+	if value == "" {
+		return LegacyUserIDParamAllow, nil
+	}
+	switch policy := LegacyUserIDParamPolicy(value); policy {
+	case LegacyUserIDParamAllow, LegacyUserIDParamWarn, LegacyUserIDParamReject:
+		return policy, nil
+	default:
+		return "", errors.New("LEGACY_USER_ID_PARAM は allow・warn・reject のいずれかである必要があります")
+	}
+}
+
+// SetLegacyUserIDParamPolicy はリクエストに適用するポリシーをコンテキストに保存する
+func SetLegacyUserIDParamPolicy(ctx echo.Context, policy LegacyUserIDParamPolicy) {
+	ctx.Set(legacyUserIDParamPolicyKey, policy)
+}
+
+// legacyUserIDParamPolicy はリクエストに適用するポリシーを返す（未設定の場合は allow）
+func legacyUserIDParamPolicy(ctx echo.Context) LegacyUserIDParamPolicy {
+	if policy, ok := ctx.Get(legacyUserIDParamPolicyKey).(LegacyUserIDParamPolicy); ok {
+		return policy
+	}
+	return LegacyUserIDParamAllow
+}
+
+// ResponseWarning はレスポンスの warnings に含める警告
+type ResponseWarning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// addResponseWarning はレスポンスの warnings に含める警告を追加する
+func addResponseWarning(ctx echo.Context, code ErrorCode, message string) {
+	warnings, _ := ctx.Get(ResponseWarningsKey).([]ResponseWarning)
+	ctx.Set(ResponseWarningsKey, append(warnings, ResponseWarning{Code: string(code), Message: message}))
+}
+
+// AppendResponseWarnings はリクエスト中に追加された警告を、JSONオブジェクトのレスポンス本文に warnings として追加する
+// 警告がない場合、本文がJSONオブジェクトでない場合、本文が既に warnings を持つ場合はそのまま返す
+func AppendResponseWarnings(ctx echo.Context, body []byte) []byte {
+	warnings, _ := ctx.Get(ResponseWarningsKey).([]ResponseWarning)
+	if len(warnings) == 0 {
+		return body
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	var fields map[string]json.RawMessage
+	if !bytes.HasPrefix(trimmed, []byte("{")) || json.Unmarshal(trimmed, &fields) != nil {
+		return body
+	}
+	if _, exists := fields["warnings"]; exists {
+		return body
+	}
+
+	encoded, err := json.Marshal(warnings)
+	if err != nil {
+		return body
+	}
+
+	// フィールドの順序を保つため、閉じ括弧の直前に warnings を追加する
+	merged := make([]byte, 0, len(trimmed)+len(encoded)+len(`,"warnings":`))
+	merged = append(merged, trimmed[:len(trimmed)-1]...)
+	if len(fields) > 0 {
+		merged = append(merged, ',')
+	}
+	merged = append(merged, `"warnings":`...)
+	merged = append(merged, encoded...)
+	return append(merged, '}')
+}
+
+// legacyUserIDParamUsage は user_id パラメータを使用したリクエストのルートごとの件数
+var legacyUserIDParamUsage = struct {
+	sync.Mutex
+	counts map[legacyUserIDParamRoute]int64
+}{counts: make(map[legacyUserIDParamRoute]int64)}
+
+// legacyUserIDParamRoute は使用件数を集計するルート（メソッドとルートのパターン）
+type legacyUserIDParamRoute struct {
+	method string
+	route  string
+}
+
+// LegacyUserIDParamRouteUsage はルートごとの user_id パラメータの使用件数
+type LegacyUserIDParamRouteUsage struct {
+	Method string `json:"method"`
+	Route  string `json:"route"`
+	Count  int64  `json:"count"`
+}
+
+// LegacyUserIDParamUsage はプロセス起動以降の user_id パラメータの使用件数をルート順に返す
+func LegacyUserIDParamUsage() []LegacyUserIDParamRouteUsage {
+	legacyUserIDParamUsage.Lock()
+	defer legacyUserIDParamUsage.Unlock()
+
+	usage := make([]LegacyUserIDParamRouteUsage, 0, len(legacyUserIDParamUsage.counts))
+	for key, count := range legacyUserIDParamUsage.counts {
+		usage = append(usage, LegacyUserIDParamRouteUsage{Method: key.method, Route: key.route, Count: count})
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Route != usage[j].Route {
+			return usage[i].Route < usage[j].Route
+		}
+		return usage[i].Method < usage[j].Method
+	})
+	return usage
+}
+
+// recordLegacyUserIDParamUsage はルートの使用件数を加算し、加算後の件数を返す
+func recordLegacyUserIDParamUsage(method, route string) int64 {
+	legacyUserIDParamUsage.Lock()
+	defer legacyUserIDParamUsage.Unlock()
+
+	key := legacyUserIDParamRoute{method: method, route: route}
+	legacyUserIDParamUsage.counts[key]++
+	return legacyUserIDParamUsage.counts[key]
+}
+
+// resolveUserID はリクエストの対象となるユーザーIDを認証トークンと user_id クエリパラメータから決定する
+//
+// 認証トークンがない場合（ゲストモードのルート）は従来どおり user_id パラメータを使用する。
+// 認証トークンがある場合は常にトークンのユーザーを使用し、user_id パラメータは廃止予定として
+// Deprecation・Sunset ヘッダーの付与と使用件数の記録を行ったうえで、ポリシーに応じて
+// 許容（allow）・warnings での警告（warn）・400 での拒否（reject）を行う。
+// エラーの場合は 400 で返すメッセージを返す
+func resolveUserID(ctx echo.Context) (string, error) {
+	param := ctx.QueryParam("user_id")
+	tokenUserID, _ := ctx.Get("user_id").(string)
+
+	if tokenUserID == "" {
+		if param == "" {
+			return "", errors.New("ユーザーIDは必須です")
+		}
+		return param, nil
+	}
+	if param == "" {
+		return tokenUserID, nil
+	}
+
+	policy := legacyUserIDParamPolicy(ctx)
+	mismatched := param != tokenUserID
+
+	header := ctx.Response().Header()
+	header.Set("Deprecation", "@"+strconv.FormatInt(legacyUserIDParamDeprecatedAt.Unix(), 10))
+	header.Set("Sunset", legacyUserIDParamSunsetAt.Format(http.TimeFormat))
+
+	method, route := ctx.Request().Method, ctx.Path()
+	count := recordLegacyUserIDParamUsage(method, route)
+	log.Info(GetRequestContext(ctx), "廃止予定の user_id パラメータが使用されました",
+		slog.String("method", method),
+		slog.String("route", route),
+		slog.String("policy", string(policy)),
+		slog.Bool("mismatched", mismatched),
+		slog.Int64("count", count),
+	)
+
+	switch policy {
+	case LegacyUserIDParamReject:
+		return "", errors.New("user_id パラメータは廃止されました。認証トークンのユーザーが対象になるため、パラメータを削除してください")
+	case LegacyUserIDParamWarn:
+		message := "user_id パラメータは廃止予定です。認証トークンのユーザーが対象になるため、パラメータを削除してください"
+		if mismatched {
+			message = "user_id パラメータが認証ユーザーと一致しないため無視しました。パラメータは廃止予定のため削除してください"
+		}
+		addResponseWarning(ctx, ErrorCodeLegacyUserIDParam, message)
+	}
+
+	return tokenUserID, nil
+}
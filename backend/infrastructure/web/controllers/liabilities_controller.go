@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// LiabilitiesController は負債（ローン）のコントローラー
+type LiabilitiesController struct {
+	useCase usecases.ManageLiabilitiesUseCase
+}
+
+// NewLiabilitiesController は新しいLiabilitiesControllerを作成する
+func NewLiabilitiesController(useCase usecases.ManageLiabilitiesUseCase) *LiabilitiesController {
+	return &LiabilitiesController{
+		useCase: useCase,
+	}
+}
+
+// LiabilityRequest は負債の登録・更新リクエスト
+// 住宅ローン（type=mortgage）で monthly_payment を省略した場合は、残高・金利・返済終了日までの期間から元利均等返済の返済額を計算する
+type LiabilityRequest struct {
+	LiabilityType      string  `json:"type" validate:"required,oneof=mortgage car_loan student_loan other"`
+	Name               string  `json:"name" validate:"max=100,safetext"`
+	PrincipalRemaining float64 `json:"principal_remaining" validate:"gte=0"`
+	InterestRate       float64 `json:"interest_rate" validate:"gte=0,lte=100"` // 年利（%）
+	MonthlyPayment     float64 `json:"monthly_payment" validate:"gte=0"`
+	EndDate            string  `json:"end_date" validate:"required"` // YYYY-MM-DD
+	IncludedInExpenses bool    `json:"included_in_expenses"`         // 返済額を支出項目に計上済みの場合は true
+}
+
+// GetLiabilities は負債の一覧と純資産を取得する
+// @Summary 負債一覧取得
+// @Description 登録済みの負債（ローン）の一覧と完済予定日、負債残高の合計、純資産を取得します
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.LiabilitiesOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/liabilities [get]
+func (c *LiabilitiesController) GetLiabilities(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.GetLiabilities(ctx.Request().Context(), usecases.GetLiabilitiesInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// CreateLiability は負債を登録する
+// @Summary 負債登録
+// @Description 負債（住宅ローン・自動車ローン・奨学金など）を登録します。返済額は純貯蓄額の計算で支出として扱われます
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body LiabilityRequest true "負債登録リクエスト"
+// @Success 201 {object} usecases.LiabilitiesOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/liabilities [post]
+func (c *LiabilitiesController) CreateLiability(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := c.bindLiabilityRequest(ctx)
+	if err != nil || attributes == nil {
+		return err
+	}
+
+	output, err := c.useCase.CreateLiability(ctx.Request().Context(), usecases.CreateLiabilityInput{
+		UserID:              uid,
+		LiabilityAttributes: *attributes,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+// UpdateLiability は負債を更新する
+// @Summary 負債更新
+// @Description 登録済みの負債を更新します
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param liability_id path string true "負債ID"
+// @Param request body LiabilityRequest true "負債更新リクエスト"
+// @Success 200 {object} usecases.LiabilitiesOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/liabilities/{liability_id} [put]
+func (c *LiabilitiesController) UpdateLiability(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	liabilityID := ctx.Param("liability_id")
+	if liabilityID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "負債IDは必須です", nil))
+	}
+
+	attributes, err := c.bindLiabilityRequest(ctx)
+	if err != nil || attributes == nil {
+		return err
+	}
+
+	output, err := c.useCase.UpdateLiability(ctx.Request().Context(), usecases.UpdateLiabilityInput{
+		UserID:              uid,
+		LiabilityID:         entities.LiabilityID(liabilityID),
+		LiabilityAttributes: *attributes,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// DeleteLiability は負債を削除する
+// @Summary 負債削除
+// @Description 登録済みの負債を削除します
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param liability_id path string true "負債ID"
+// @Success 200 {object} usecases.LiabilitiesOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/liabilities/{liability_id} [delete]
+func (c *LiabilitiesController) DeleteLiability(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	liabilityID := ctx.Param("liability_id")
+	if liabilityID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "負債IDは必須です", nil))
+	}
+
+	output, err := c.useCase.DeleteLiability(ctx.Request().Context(), usecases.DeleteLiabilityInput{
+		UserID:      uid,
+		LiabilityID: entities.LiabilityID(liabilityID),
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// bindLiabilityRequest はリクエストを解析・検証してユースケースの入力に変換する
+// 検証エラーの場合はレスポンスを書き込み、nil の属性を返す
+func (c *LiabilitiesController) bindLiabilityRequest(ctx echo.Context) (*usecases.LiabilityAttributes, error) {
+	var req LiabilityRequest
+	if err := ctx.Bind(&req); err != nil {
+		return nil, ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return nil, err // Validator already returns proper error response
+	}
+
+	endDate, err := time.Parse("2006-01-02", req.EndDate)
+	if err != nil {
+		return nil, ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "返済終了日の形式が正しくありません（YYYY-MM-DD）", err.Error()))
+	}
+
+	return &usecases.LiabilityAttributes{
+		LiabilityType:      req.LiabilityType,
+		Name:               req.Name,
+		PrincipalRemaining: req.PrincipalRemaining,
+		InterestRate:       req.InterestRate,
+		MonthlyPayment:     req.MonthlyPayment,
+		EndDate:            endDate,
+		IncludedInExpenses: req.IncludedInExpenses,
+	}, nil
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *LiabilitiesController) handleError(ctx echo.Context, err error) error {
+	if handled, respErr := respondVersionConflictError(ctx, err); handled {
+		return respErr
+	}
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "負債が見つかりません"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "負債"))
+	case strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+	case strings.Contains(errMsg, "負債の作成に失敗しました"), strings.Contains(errMsg, "負債の更新に失敗しました"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
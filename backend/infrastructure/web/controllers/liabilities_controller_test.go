@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockManageLiabilitiesUseCase is a mock implementation of ManageLiabilitiesUseCase
+type MockManageLiabilitiesUseCase struct {
+	mock.Mock
+}
+
+func (m *MockManageLiabilitiesUseCase) GetLiabilities(ctx context.Context, input usecases.GetLiabilitiesInput) (*usecases.LiabilitiesOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LiabilitiesOutput), args.Error(1)
+}
+
+func (m *MockManageLiabilitiesUseCase) CreateLiability(ctx context.Context, input usecases.CreateLiabilityInput) (*usecases.LiabilitiesOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LiabilitiesOutput), args.Error(1)
+}
+
+func (m *MockManageLiabilitiesUseCase) UpdateLiability(ctx context.Context, input usecases.UpdateLiabilityInput) (*usecases.LiabilitiesOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LiabilitiesOutput), args.Error(1)
+}
+
+func (m *MockManageLiabilitiesUseCase) DeleteLiability(ctx context.Context, input usecases.DeleteLiabilityInput) (*usecases.LiabilitiesOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LiabilitiesOutput), args.Error(1)
+}
+
+func TestCreateLiability(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	validBody := `{"type":"car_loan","name":"自動車ローン","principal_remaining":540000,"interest_rate":1.5,"monthly_payment":30000,"end_date":"2028-03-31"}`
+
+	tests := []struct {
+		name           string
+		userID         string
+		body           string
+		mockSetup      func(m *MockManageLiabilitiesUseCase)
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:   "Success: creates liability",
+			userID: userID,
+			body:   validBody,
+			mockSetup: func(m *MockManageLiabilitiesUseCase) {
+				m.On("CreateLiability", mock.Anything, usecases.CreateLiabilityInput{
+					UserID: entities.UserID(userID),
+					LiabilityAttributes: usecases.LiabilityAttributes{
+						LiabilityType:      "car_loan",
+						Name:               "自動車ローン",
+						PrincipalRemaining: 540000,
+						InterestRate:       1.5,
+						MonthlyPayment:     30000,
+						EndDate:            time.Date(2028, 3, 31, 0, 0, 0, 0, time.UTC),
+					},
+				}).Return(&usecases.LiabilitiesOutput{TotalLiabilities: 540000}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Error: another user's liabilities",
+			userID:         "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e",
+			body:           validBody,
+			mockSetup:      func(m *MockManageLiabilitiesUseCase) {},
+			expectError:    true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Error: unsupported liability type",
+			userID:         userID,
+			body:           strings.Replace(validBody, "car_loan", "credit_card", 1),
+			mockSetup:      func(m *MockManageLiabilitiesUseCase) {},
+			expectError:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: invalid end_date format",
+			userID:         userID,
+			body:           strings.Replace(validBody, "2028-03-31", "2028/03/31", 1),
+			mockSetup:      func(m *MockManageLiabilitiesUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: financial data not found",
+			userID: userID,
+			body:   validBody,
+			mockSetup: func(m *MockManageLiabilitiesUseCase) {
+				m.On("CreateLiability", mock.Anything, mock.Anything).
+					Return(nil, errors.New("財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageLiabilitiesUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewLiabilitiesController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/financial-data/"+tt.userID+"/liabilities", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tt.userID)
+			setTestUserID(c, userID)
+
+			err := controller.CreateLiability(c)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteLiability(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"Success: deletes liability", nil, http.StatusOK},
+		{"Error: liability not found", errors.New("負債が見つかりません: liability-001"), http.StatusNotFound},
+		{"Error: save failure", errors.New("負債の保存に失敗しました: db error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageLiabilitiesUseCase)
+			input := usecases.DeleteLiabilityInput{UserID: entities.UserID(userID), LiabilityID: "liability-001"}
+			if tt.err != nil {
+				mockUseCase.On("DeleteLiability", mock.Anything, input).Return(nil, tt.err)
+			} else {
+				mockUseCase.On("DeleteLiability", mock.Anything, input).Return(&usecases.LiabilitiesOutput{}, nil)
+			}
+			controller := NewLiabilitiesController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodDelete, "/financial-data/"+userID+"/liabilities/liability-001", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id", "liability_id")
+			c.SetParamValues(userID, "liability-001")
+			setTestUserID(c, userID)
+
+			err := controller.DeleteLiability(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
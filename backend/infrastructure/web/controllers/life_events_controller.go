@@ -0,0 +1,222 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// LifeEventsController はライフイベントのコントローラー
+type LifeEventsController struct {
+	useCase usecases.ManageLifeEventsUseCase
+}
+
+// NewLifeEventsController は新しいLifeEventsControllerを作成する
+func NewLifeEventsController(useCase usecases.ManageLifeEventsUseCase) *LifeEventsController {
+	return &LifeEventsController{
+		useCase: useCase,
+	}
+}
+
+// LifeEventRequest はライフイベントの登録・更新リクエスト
+type LifeEventRequest struct {
+	Category      string  `json:"category" validate:"required,oneof=marriage childbirth education child_independent housing other"`
+	Name          string  `json:"name" validate:"required,max=100,safetext"`
+	Year          int     `json:"year" validate:"required,gt=0"` // 発生年（西暦、今年以降）
+	Recurrence    string  `json:"recurrence" validate:"required,oneof=one_time recurring"`
+	AmountChange  float64 `json:"amount_change" validate:"required"`       // 支出の増減額（正: 増加, 負: 減少）。継続の場合は毎月の金額
+	DurationYears int     `json:"duration_years" validate:"gte=0,lte=100"` // 継続する年数（継続の場合のみ。0 は予測期間の終わりまで）
+}
+
+// GetLifeEvents はライフイベントの一覧を取得する
+// @Summary ライフイベント一覧取得
+// @Description 登録済みのライフイベント（結婚・出産・教育費・子供の独立など）の一覧を取得します
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.LifeEventsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/life-events [get]
+func (c *LifeEventsController) GetLifeEvents(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.GetLifeEvents(ctx.Request().Context(), usecases.GetLifeEventsInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// CreateLifeEvent はライフイベントを登録する
+// @Summary ライフイベント登録
+// @Description ライフイベントを登録します。資産推移の予測では発生年以降の支出を増減させます
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body LifeEventRequest true "ライフイベント登録リクエスト"
+// @Success 201 {object} usecases.LifeEventsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/life-events [post]
+func (c *LifeEventsController) CreateLifeEvent(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	attributes, err := c.bindLifeEventRequest(ctx)
+	if err != nil || attributes == nil {
+		return err
+	}
+
+	output, err := c.useCase.CreateLifeEvent(ctx.Request().Context(), usecases.CreateLifeEventInput{
+		UserID:              uid,
+		LifeEventAttributes: *attributes,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+// UpdateLifeEvent はライフイベントを更新する
+// @Summary ライフイベント更新
+// @Description 登録済みのライフイベントを更新します
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param life_event_id path string true "ライフイベントID"
+// @Param request body LifeEventRequest true "ライフイベント更新リクエスト"
+// @Success 200 {object} usecases.LifeEventsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/life-events/{life_event_id} [put]
+func (c *LifeEventsController) UpdateLifeEvent(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	lifeEventID := ctx.Param("life_event_id")
+	if lifeEventID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ライフイベントIDは必須です", nil))
+	}
+
+	attributes, err := c.bindLifeEventRequest(ctx)
+	if err != nil || attributes == nil {
+		return err
+	}
+
+	output, err := c.useCase.UpdateLifeEvent(ctx.Request().Context(), usecases.UpdateLifeEventInput{
+		UserID:              uid,
+		LifeEventID:         entities.LifeEventID(lifeEventID),
+		LifeEventAttributes: *attributes,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// DeleteLifeEvent はライフイベントを削除する
+// @Summary ライフイベント削除
+// @Description 登録済みのライフイベントを削除します
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param life_event_id path string true "ライフイベントID"
+// @Success 200 {object} usecases.LifeEventsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/life-events/{life_event_id} [delete]
+func (c *LifeEventsController) DeleteLifeEvent(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	lifeEventID := ctx.Param("life_event_id")
+	if lifeEventID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "ライフイベントIDは必須です", nil))
+	}
+
+	output, err := c.useCase.DeleteLifeEvent(ctx.Request().Context(), usecases.DeleteLifeEventInput{
+		UserID:      uid,
+		LifeEventID: entities.LifeEventID(lifeEventID),
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// bindLifeEventRequest はリクエストを解析・検証してユースケースの入力に変換する
+// 検証エラーの場合はレスポンスを書き込み、nil の属性を返す
+func (c *LifeEventsController) bindLifeEventRequest(ctx echo.Context) (*usecases.LifeEventAttributes, error) {
+	var req LifeEventRequest
+	if err := ctx.Bind(&req); err != nil {
+		return nil, ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return nil, err // Validator already returns proper error response
+	}
+
+	return &usecases.LifeEventAttributes{
+		Category:      req.Category,
+		Name:          req.Name,
+		Year:          req.Year,
+		Recurrence:    req.Recurrence,
+		AmountChange:  req.AmountChange,
+		DurationYears: req.DurationYears,
+	}, nil
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *LifeEventsController) handleError(ctx echo.Context, err error) error {
+	if handled, respErr := respondVersionConflictError(ctx, err); handled {
+		return respErr
+	}
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "ライフイベントが見つかりません"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "ライフイベント"))
+	case strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+	case strings.Contains(errMsg, "ライフイベントの作成に失敗しました"), strings.Contains(errMsg, "ライフイベントの更新に失敗しました"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
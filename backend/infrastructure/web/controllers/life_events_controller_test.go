@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockManageLifeEventsUseCase is a mock implementation of ManageLifeEventsUseCase
+type MockManageLifeEventsUseCase struct {
+	mock.Mock
+}
+
+func (m *MockManageLifeEventsUseCase) GetLifeEvents(ctx context.Context, input usecases.GetLifeEventsInput) (*usecases.LifeEventsOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LifeEventsOutput), args.Error(1)
+}
+
+func (m *MockManageLifeEventsUseCase) CreateLifeEvent(ctx context.Context, input usecases.CreateLifeEventInput) (*usecases.LifeEventsOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LifeEventsOutput), args.Error(1)
+}
+
+func (m *MockManageLifeEventsUseCase) UpdateLifeEvent(ctx context.Context, input usecases.UpdateLifeEventInput) (*usecases.LifeEventsOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LifeEventsOutput), args.Error(1)
+}
+
+func (m *MockManageLifeEventsUseCase) DeleteLifeEvent(ctx context.Context, input usecases.DeleteLifeEventInput) (*usecases.LifeEventsOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LifeEventsOutput), args.Error(1)
+}
+
+func TestCreateLifeEvent(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	validBody := `{"category":"education","name":"大学の学費","year":2030,"recurrence":"recurring","amount_change":80000,"duration_years":4}`
+
+	tests := []struct {
+		name           string
+		userID         string
+		body           string
+		mockSetup      func(m *MockManageLifeEventsUseCase)
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name:   "Success: creates life event",
+			userID: userID,
+			body:   validBody,
+			mockSetup: func(m *MockManageLifeEventsUseCase) {
+				m.On("CreateLifeEvent", mock.Anything, usecases.CreateLifeEventInput{
+					UserID: entities.UserID(userID),
+					LifeEventAttributes: usecases.LifeEventAttributes{
+						Category:      "education",
+						Name:          "大学の学費",
+						Year:          2030,
+						Recurrence:    "recurring",
+						AmountChange:  80000,
+						DurationYears: 4,
+					},
+				}).Return(&usecases.LifeEventsOutput{}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Error: another user's life events",
+			userID:         "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e",
+			body:           validBody,
+			mockSetup:      func(m *MockManageLifeEventsUseCase) {},
+			expectError:    true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Error: unsupported category",
+			userID:         userID,
+			body:           strings.Replace(validBody, "education", "vacation", 1),
+			mockSetup:      func(m *MockManageLifeEventsUseCase) {},
+			expectError:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: zero amount change",
+			userID:         userID,
+			body:           strings.Replace(validBody, `"amount_change":80000`, `"amount_change":0`, 1),
+			mockSetup:      func(m *MockManageLifeEventsUseCase) {},
+			expectError:    true,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Error: past event year",
+			userID: userID,
+			body:   validBody,
+			mockSetup: func(m *MockManageLifeEventsUseCase) {
+				m.On("CreateLifeEvent", mock.Anything, mock.Anything).
+					Return(nil, errors.New("ライフイベントの作成に失敗しました: ライフイベントの発生年は2026年以降である必要があります: 2020"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: financial data not found",
+			userID: userID,
+			body:   validBody,
+			mockSetup: func(m *MockManageLifeEventsUseCase) {
+				m.On("CreateLifeEvent", mock.Anything, mock.Anything).
+					Return(nil, errors.New("財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageLifeEventsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewLifeEventsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodPost, "/financial-data/"+tt.userID+"/life-events", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tt.userID)
+			setTestUserID(c, userID)
+
+			err := controller.CreateLifeEvent(c)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteLifeEvent(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"Success: deletes life event", nil, http.StatusOK},
+		{"Error: life event not found", errors.New("ライフイベントが見つかりません: event-001"), http.StatusNotFound},
+		{"Error: save failure", errors.New("ライフイベントの保存に失敗しました: db error"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newGoalsEcho()
+			mockUseCase := new(MockManageLifeEventsUseCase)
+			input := usecases.DeleteLifeEventInput{UserID: entities.UserID(userID), LifeEventID: "event-001"}
+			if tt.err != nil {
+				mockUseCase.On("DeleteLifeEvent", mock.Anything, input).Return(nil, tt.err)
+			} else {
+				mockUseCase.On("DeleteLifeEvent", mock.Anything, input).Return(&usecases.LifeEventsOutput{}, nil)
+			}
+			controller := NewLifeEventsController(mockUseCase)
+
+			req := httptest.NewRequest(http.MethodDelete, "/financial-data/"+userID+"/life-events/event-001", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id", "life_event_id")
+			c.SetParamValues(userID, "event-001")
+			setTestUserID(c, userID)
+
+			err := controller.DeleteLifeEvent(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error: another user's life event", func(t *testing.T) {
+		const otherUserID = "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e"
+		e := newGoalsEcho()
+		mockUseCase := new(MockManageLifeEventsUseCase)
+		controller := NewLifeEventsController(mockUseCase)
+
+		req := httptest.NewRequest(http.MethodDelete, "/financial-data/"+otherUserID+"/life-events/event-001", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("user_id", "life_event_id")
+		c.SetParamValues(otherUserID, "event-001")
+		setTestUserID(c, userID)
+
+		err := controller.DeleteLifeEvent(c)
+
+		assert.Equal(t, http.StatusForbidden, statusOf(err, rec))
+		mockUseCase.AssertNotCalled(t, "DeleteLifeEvent", mock.Anything, mock.Anything)
+	})
+}
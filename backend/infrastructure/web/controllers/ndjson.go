@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	// MIMEApplicationNDJSON は改行区切りJSON（NDJSON）のMIMEタイプ
+	MIMEApplicationNDJSON = "application/x-ndjson"
+	// ndjsonFlushInterval はNDJSONの出力でフラッシュする行数の間隔
+	ndjsonFlushInterval = 10
+)
+
+// wantsNDJSONStream はクライアントがNDJSONのストリーミング出力を要求しているかを返す
+// Accept: application/x-ndjson または stream=true クエリパラメータで指定する
+func wantsNDJSONStream(ctx echo.Context) bool {
+	if ctx.QueryParam("stream") == "true" {
+		return true
+	}
+	return strings.Contains(ctx.Request().Header.Get(echo.HeaderAccept), MIMEApplicationNDJSON)
+}
+
+// ndjsonWriter はレスポンスにJSONを1行ずつ書き込み、一定行数ごとにフラッシュする
+// 書き込んだ値を保持しないため、行数によらずメモリ使用量は一定になる
+type ndjsonWriter struct {
+	encoder *json.Encoder
+	flusher http.Flusher
+	lines   int
+}
+
+// newNDJSONWriter は新しいndjsonWriterを作成する（w が http.Flusher を実装しない場合はフラッシュしない）
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	flusher, _ := w.(http.Flusher)
+	return &ndjsonWriter{
+		encoder: json.NewEncoder(w),
+		flusher: flusher,
+	}
+}
+
+// Write は値を1行のJSONとして書き込む
+func (w *ndjsonWriter) Write(v any) error {
+	if err := w.encoder.Encode(v); err != nil {
+		return err
+	}
+	w.lines++
+	if w.lines%ndjsonFlushInterval == 0 {
+		w.Flush()
+	}
+	return nil
+}
+
+// Flush は書き込み済みの行をクライアントに送る
+func (w *ndjsonWriter) Flush() {
+	if w.flusher != nil {
+		w.flusher.Flush()
+	}
+}
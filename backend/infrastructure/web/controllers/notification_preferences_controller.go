@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationPreferencesController は通知設定のコントローラー
+type NotificationPreferencesController struct {
+	useCase usecases.ManageNotificationPreferencesUseCase
+}
+
+// NewNotificationPreferencesController は新しいNotificationPreferencesControllerを作成する
+func NewNotificationPreferencesController(useCase usecases.ManageNotificationPreferencesUseCase) *NotificationPreferencesController {
+	return &NotificationPreferencesController{
+		useCase: useCase,
+	}
+}
+
+// UpdateNotificationPreferenceRequest は通知設定保存リクエスト
+type UpdateNotificationPreferenceRequest struct {
+	EventType   string   `json:"event_type" validate:"required,oneof=goal_deadline_approaching goal_achieved goal_review_recommended goal_overdue"`
+	Channel     string   `json:"channel" validate:"required,oneof=email push webhook"`
+	Enabled     bool     `json:"enabled"`
+	Threshold   *float64 `json:"threshold,omitempty" validate:"omitempty,gte=0"`
+	Destination string   `json:"destination" validate:"omitempty,max=2048,safeurl"`
+}
+
+// GetNotificationPreferences はユーザーの通知設定一覧を取得する
+// @Summary 通知設定一覧取得
+// @Description 目標イベント（期限接近・達成・見直し推奨）ごとの通知チャネル設定を取得します
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.GetNotificationPreferencesOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/{user_id}/preferences [get]
+func (c *NotificationPreferencesController) GetNotificationPreferences(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.GetNotificationPreferences(ctx.Request().Context(), usecases.GetNotificationPreferencesInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// UpdateNotificationPreference はイベント・チャネルごとの通知設定を保存する
+// @Summary 通知設定保存
+// @Description イベントとチャネルの組み合わせごとに通知の有効/無効・閾値・送信先を保存します
+// @Tags notifications
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body UpdateNotificationPreferenceRequest true "通知設定保存リクエスト"
+// @Success 200 {object} usecases.UpdateNotificationPreferenceOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/{user_id}/preferences [put]
+func (c *NotificationPreferencesController) UpdateNotificationPreference(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req UpdateNotificationPreferenceRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	// Business logic validation for notification preference
+	if err := ValidateBusinessLogic(ctx,
+		func() *BusinessLogicError {
+			if req.Channel == string(entities.NotificationChannelWebhook) && req.Destination == "" {
+				return CreateBusinessLogicError(
+					"MISSING_WEBHOOK_URL",
+					req.Destination,
+					"https://example.com/webhook",
+				)
+			}
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	input := usecases.UpdateNotificationPreferenceInput{
+		UserID:      uid,
+		EventType:   req.EventType,
+		Channel:     req.Channel,
+		Enabled:     req.Enabled,
+		Threshold:   req.Threshold,
+		Destination: req.Destination,
+	}
+
+	output, err := c.useCase.UpdateNotificationPreference(ctx.Request().Context(), input)
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// NotifyGoalEvents はユーザーの目標を評価して通知を配信する
+// @Summary 目標通知の配信
+// @Description 目標の期限接近・達成・見直し推奨を判定し、通知設定に従って配信します。配信に失敗した通知はリトライされます
+// @Tags notifications
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.NotifyGoalEventsOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /notifications/{user_id}/goal-events [post]
+func (c *NotificationPreferencesController) NotifyGoalEvents(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.NotifyGoalEvents(ctx.Request().Context(), usecases.NotifyGoalEventsInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *NotificationPreferencesController) handleError(ctx echo.Context, err error) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "通知設定の作成に失敗しました"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/labstack/echo/v4"
+)
+
+// PeerBenchmarkController は同年代比較（匿名集計）のコントローラー
+type PeerBenchmarkController struct {
+	useCase usecases.PeerBenchmarkUseCase
+}
+
+// NewPeerBenchmarkController は新しいPeerBenchmarkControllerを作成する
+func NewPeerBenchmarkController(useCase usecases.PeerBenchmarkUseCase) *PeerBenchmarkController {
+	return &PeerBenchmarkController{
+		useCase: useCase,
+	}
+}
+
+// SetPeerBenchmarkOptInRequest は匿名集計への参加設定リクエスト
+type SetPeerBenchmarkOptInRequest struct {
+	OptedIn *bool `json:"opted_in" validate:"required"`
+}
+
+// GetPeerComparison は同年代・同年収帯の匿名集計と比較した位置を返す
+// @Summary 同年代比較
+// @Description 年代・年収帯が同じユーザーの匿名集計（貯蓄率・緊急資金月数・総資産のパーセンタイル）と、その中での位置を返します。集計人数が最小集計人数に満たない区分は available=false になります
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.PeerComparisonOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 422 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/peer-comparison [get]
+func (c *PeerBenchmarkController) GetPeerComparison(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.GetPeerComparison(ctx.Request().Context(), userID)
+	if err != nil {
+		errMsg := err.Error()
+		switch {
+		case errors.Is(err, services.ErrPeerAgeUnknown):
+			return ctx.JSON(http.StatusUnprocessableEntity, NewErrorResponse(ctx, ErrorCodeBusinessLogic, errMsg, nil))
+		case strings.Contains(errMsg, "財務データの取得に失敗しました"):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// GetPeerBenchmarkOptIn は匿名集計への参加状況を返す
+// @Summary 同年代比較の参加状況取得
+// @Description 自分の財務データを同年代比較の匿名集計に含めるか（オプトイン）の設定を返します
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.PeerBenchmarkOptInOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/peer-benchmark/opt-in [get]
+func (c *PeerBenchmarkController) GetPeerBenchmarkOptIn(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.GetPeerBenchmarkOptIn(ctx.Request().Context(), userID)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// SetPeerBenchmarkOptIn は匿名集計への参加・取り消しを設定する
+// @Summary 同年代比較の参加設定
+// @Description 自分の財務データを同年代比較の匿名集計に含めるかを設定します。変更は次回の集計から反映されます
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body SetPeerBenchmarkOptInRequest true "参加設定リクエスト"
+// @Success 200 {object} usecases.PeerBenchmarkOptInOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/peer-benchmark/opt-in [put]
+func (c *PeerBenchmarkController) SetPeerBenchmarkOptIn(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req SetPeerBenchmarkOptInRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	output, err := c.useCase.SetPeerBenchmarkOptIn(ctx.Request().Context(), usecases.SetPeerBenchmarkOptInInput{
+		UserID:  userID,
+		OptedIn: *req.OptedIn,
+	})
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, err.Error()))
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPeerBenchmarkUseCase is a mock implementation of PeerBenchmarkUseCase
+type MockPeerBenchmarkUseCase struct {
+	mock.Mock
+}
+
+func (m *MockPeerBenchmarkUseCase) GetPeerComparison(ctx context.Context, userID entities.UserID) (*usecases.PeerComparisonOutput, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.PeerComparisonOutput), args.Error(1)
+}
+
+func (m *MockPeerBenchmarkUseCase) GetPeerBenchmarkOptIn(ctx context.Context, userID entities.UserID) (*usecases.PeerBenchmarkOptInOutput, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.PeerBenchmarkOptInOutput), args.Error(1)
+}
+
+func (m *MockPeerBenchmarkUseCase) SetPeerBenchmarkOptIn(ctx context.Context, input usecases.SetPeerBenchmarkOptInInput) (*usecases.PeerBenchmarkOptInOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.PeerBenchmarkOptInOutput), args.Error(1)
+}
+
+func (m *MockPeerBenchmarkUseCase) AggregatePeerBenchmarks(ctx context.Context, input usecases.AggregatePeerBenchmarksInput) (*usecases.AggregatePeerBenchmarksOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.AggregatePeerBenchmarksOutput), args.Error(1)
+}
+
+func TestGetPeerComparison(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		paramUserID    string
+		mockSetup      func(m *MockPeerBenchmarkUseCase)
+		expectedStatus int
+	}{
+		{
+			name:        "Success: comparison available",
+			paramUserID: userID,
+			mockSetup: func(m *MockPeerBenchmarkUseCase) {
+				m.On("GetPeerComparison", mock.Anything, entities.UserID(userID)).
+					Return(&usecases.PeerComparisonOutput{Available: true, SampleSize: 120}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Error: age unknown",
+			paramUserID: userID,
+			mockSetup: func(m *MockPeerBenchmarkUseCase) {
+				m.On("GetPeerComparison", mock.Anything, entities.UserID(userID)).
+					Return(nil, services.ErrPeerAgeUnknown)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "Error: financial data not found",
+			paramUserID: userID,
+			mockSetup: func(m *MockPeerBenchmarkUseCase) {
+				m.On("GetPeerComparison", mock.Anything, entities.UserID(userID)).
+					Return(nil, fmt.Errorf("財務データの取得に失敗しました: %w", errors.New("財務データが見つかりません")))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Error: another user's comparison",
+			paramUserID:    "9b1e7d3f-5a2c-4c8e-b6d4-0f2a8c6e4b1d",
+			mockSetup:      func(m *MockPeerBenchmarkUseCase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockPeerBenchmarkUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewPeerBenchmarkController(mockUseCase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/users/"+tt.paramUserID+"/peer-comparison", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tt.paramUserID)
+			setTestUserID(c, userID)
+
+			err := controller.GetPeerComparison(c)
+
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSetPeerBenchmarkOptIn(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(m *MockPeerBenchmarkUseCase)
+		expectError    bool
+		expectedStatus int
+	}{
+		{
+			name: "Success: opt in",
+			body: `{"opted_in": true}`,
+			mockSetup: func(m *MockPeerBenchmarkUseCase) {
+				m.On("SetPeerBenchmarkOptIn", mock.Anything, usecases.SetPeerBenchmarkOptInInput{UserID: userID, OptedIn: true}).
+					Return(&usecases.PeerBenchmarkOptInOutput{OptedIn: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Success: opt out",
+			body: `{"opted_in": false}`,
+			mockSetup: func(m *MockPeerBenchmarkUseCase) {
+				m.On("SetPeerBenchmarkOptIn", mock.Anything, usecases.SetPeerBenchmarkOptInInput{UserID: userID, OptedIn: false}).
+					Return(&usecases.PeerBenchmarkOptInOutput{OptedIn: false}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Error: opted_in missing",
+			body:        `{}`,
+			mockSetup:   func(m *MockPeerBenchmarkUseCase) {},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockPeerBenchmarkUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewPeerBenchmarkController(mockUseCase)
+
+			e := newGoalsEcho()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/users/"+userID+"/peer-benchmark/opt-in", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(userID)
+			setTestUserID(c, userID)
+
+			err := controller.SetPeerBenchmarkOptIn(c)
+
+			if tt.expectError {
+				// バリデーションエラーはバリデーターのエラーがそのまま返される
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedStatus, rec.Code)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// RecommendationsController は推奨事項の取得・却下のコントローラー
+type RecommendationsController struct {
+	useCase usecases.ManageRecommendationsUseCase
+}
+
+// NewRecommendationsController は新しいRecommendationsControllerを作成する
+func NewRecommendationsController(useCase usecases.ManageRecommendationsUseCase) *RecommendationsController {
+	return &RecommendationsController{
+		useCase: useCase,
+	}
+}
+
+// DismissRecommendationRequest は推奨事項却下リクエスト
+// snooze_until を省略した場合は重要度が悪化するまで非表示にする
+type DismissRecommendationRequest struct {
+	SnoozeUntil string `json:"snooze_until,omitempty"` // RFC3339
+}
+
+// GetRecommendations は却下済みのものを除いた推奨事項を取得する
+// @Summary 推奨事項取得
+// @Description 現在の財務状況から生成した推奨事項と警告を取得します。却下済みのものは除外されますが、スヌーズ期限を過ぎたものや重要度が悪化したもの（re_raised）は再表示されます
+// @Tags recommendations
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} usecases.RecommendationsOutput
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /recommendations [get]
+func (c *RecommendationsController) GetRecommendations(ctx echo.Context) error {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	output, err := c.useCase.GetRecommendations(ctx.Request().Context(), usecases.GetRecommendationsInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// DismissRecommendation は推奨事項を却下する
+// @Summary 推奨事項却下
+// @Description 推奨事項・警告を却下し、レポートや推奨事項一覧に表示しないようにします。snooze_until を指定した場合はその日時まで非表示にします
+// @Tags recommendations
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param id path string true "推奨事項ID"
+// @Param request body DismissRecommendationRequest false "推奨事項却下リクエスト"
+// @Success 200 {object} usecases.DismissRecommendationOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /recommendations/{id}/dismiss [post]
+func (c *RecommendationsController) DismissRecommendation(ctx echo.Context) error {
+	userID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	recommendationID := ctx.Param("id")
+	if recommendationID == "" {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "推奨事項IDは必須です", nil))
+	}
+
+	var req DismissRecommendationRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	input := usecases.DismissRecommendationInput{
+		UserID:           uid,
+		RecommendationID: entities.RecommendationID(recommendationID),
+	}
+	if req.SnoozeUntil != "" {
+		snoozeUntil, err := time.Parse(time.RFC3339, req.SnoozeUntil)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "スヌーズ期限の形式が正しくありません（RFC3339）", err.Error()))
+		}
+		input.SnoozeUntil = &snoozeUntil
+	}
+
+	output, err := c.useCase.DismissRecommendation(ctx.Request().Context(), input)
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *RecommendationsController) handleError(ctx echo.Context, err error) error {
+	errMsg := err.Error()
+	switch {
+	case errors.Is(err, usecases.ErrRecommendationNotFound):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "推奨事項"))
+	case strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+	case strings.Contains(errMsg, "推奨事項の却下の作成に失敗しました"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
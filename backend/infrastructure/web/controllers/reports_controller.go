@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -34,6 +35,8 @@ func NewReportsController(useCase usecases.GenerateReportsUseCase, fileStorage r
 // FinancialSummaryReportRequest は財務サマリーレポート生成リクエスト
 type FinancialSummaryReportRequest struct {
 	UserID string `json:"user_id" validate:"required"`
+	// ForceRecalculate が true の場合、保存済みの計算結果を使わずに再計算する
+	ForceRecalculate bool `json:"force_recalculate"`
 }
 
 // AssetProjectionReportRequest は資産推移レポート生成リクエスト
@@ -48,8 +51,10 @@ type GoalsProgressReportRequest struct {
 }
 
 // RetirementPlanReportRequest は退職計画レポート生成リクエスト
+// lifestyle_expenses で生活水準（comfortable / standard / minimum）ごとの月間生活費の目安を上書きできる
 type RetirementPlanReportRequest struct {
-	UserID string `json:"user_id" validate:"required"`
+	UserID            string             `json:"user_id" validate:"required"`
+	LifestyleExpenses map[string]float64 `json:"lifestyle_expenses,omitempty" validate:"omitempty,dive,keys,oneof=comfortable standard minimum,endkeys,gte=0"`
 }
 
 // ComprehensiveReportRequest は包括的レポート生成リクエスト
@@ -58,12 +63,19 @@ type ComprehensiveReportRequest struct {
 	Years  int    `json:"years" validate:"required,gte=1,lte=50"`
 }
 
+// HouseholdSummaryRequest は世帯の財務サマリー取得リクエスト
+// member_user_ids にはリクエストしたユーザー自身を含めること
+type HouseholdSummaryRequest struct {
+	MemberUserIDs []string `json:"member_user_ids" validate:"required,min=1,max=10,dive,required"`
+}
+
 // ExportReportRequest はレポートエクスポートリクエスト
+// report_type・format に指定できる値は usecases.ReportTypes・usecases.ExportFormats
 type ExportReportRequest struct {
-	UserID     string      `json:"user_id" validate:"required"`
-	ReportType string      `json:"report_type" validate:"required,oneof=financial_summary asset_projection goals_progress retirement_plan comprehensive"`
-	Format     string      `json:"format" validate:"required,oneof=pdf excel csv"`
-	ReportData interface{} `json:"report_data" validate:"required"`
+	UserID     string                `json:"user_id" validate:"required"`
+	ReportType usecases.ReportType   `json:"report_type" validate:"required"`
+	Format     usecases.ExportFormat `json:"format" validate:"required"`
+	ReportData interface{}           `json:"report_data" validate:"required"`
 }
 
 // GenerateFinancialSummaryReport は財務サマリーレポートを生成する
@@ -93,8 +105,14 @@ func (c *ReportsController) GenerateFinancialSummaryReport(ctx echo.Context) err
 		})
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.FinancialSummaryReportInput{
-		UserID: entities.UserID(req.UserID),
+		UserID:           uid,
+		ForceRecalculate: req.ForceRecalculate,
 	}
 
 	output, err := c.useCase.GenerateFinancialSummaryReport(ctx.Request().Context(), input)
@@ -135,8 +153,13 @@ func (c *ReportsController) GenerateAssetProjectionReport(ctx echo.Context) erro
 		})
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.AssetProjectionReportInput{
-		UserID: entities.UserID(req.UserID),
+		UserID: uid,
 		Years:  req.Years,
 	}
 
@@ -178,8 +201,13 @@ func (c *ReportsController) GenerateGoalsProgressReport(ctx echo.Context) error
 		})
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.GoalsProgressReportInput{
-		UserID: entities.UserID(req.UserID),
+		UserID: uid,
 	}
 
 	output, err := c.useCase.GenerateGoalsProgressReport(ctx.Request().Context(), input)
@@ -220,8 +248,14 @@ func (c *ReportsController) GenerateRetirementPlanReport(ctx echo.Context) error
 		})
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
 	input := usecases.RetirementPlanReportInput{
-		UserID: entities.UserID(req.UserID),
+		UserID:            uid,
+		LifestyleExpenses: toLifestyleExpenses(req.LifestyleExpenses),
 	}
 
 	output, err := c.useCase.GenerateRetirementPlanReport(ctx.Request().Context(), input)
@@ -237,11 +271,12 @@ func (c *ReportsController) GenerateRetirementPlanReport(ctx echo.Context) error
 
 // GenerateComprehensiveReport は包括的レポートを生成する
 // @Summary 包括的レポート生成
-// @Description 包括的レポートを生成します
+// @Description 包括的レポートを生成します。財務サマリー以外のセクションの生成に失敗した場合は、そのセクションを null とし sections_failed に理由を含めて返します。fields を指定した場合は指定したセクションのみを生成し、それ以外のセクションのキーはレスポンスから省略します
 // @Tags reports
 // @Accept json
 // @Produce json
 // @Param request body ComprehensiveReportRequest true "包括的レポート生成リクエスト"
+// @Param fields query string false "含めるセクション（カンマ区切り。executive_summary, financial_summary, asset_projection, goals_progress, retirement_plan, action_plan）"
 // @Success 200 {object} usecases.ComprehensiveReportOutput
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -262,9 +297,25 @@ func (c *ReportsController) GenerateComprehensiveReport(ctx echo.Context) error
 		})
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	sections, err := usecases.ParseReportSections(ctx.QueryParam("fields"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse{
+			Error: err.Error(),
+			Details: map[string]interface{}{
+				"valid_sections": usecases.ComprehensiveReportSections,
+			},
+		})
+	}
+
 	input := usecases.ComprehensiveReportInput{
-		UserID: entities.UserID(req.UserID),
-		Years:  req.Years,
+		UserID:   uid,
+		Years:    req.Years,
+		Sections: sections,
 	}
 
 	output, err := c.useCase.GenerateComprehensiveReport(ctx.Request().Context(), input)
@@ -305,8 +356,20 @@ func (c *ReportsController) ExportReportToPDF(ctx echo.Context) error {
 		})
 	}
 
+	uid, err := entities.NewUserID(req.UserID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	if _, err := usecases.ParseReportType(string(req.ReportType)); err != nil {
+		return ctx.JSON(http.StatusBadRequest, reportExportErrorResponse(err))
+	}
+	if _, err := usecases.ParseExportFormat(string(req.Format)); err != nil {
+		return ctx.JSON(http.StatusBadRequest, reportExportErrorResponse(err))
+	}
+
 	input := usecases.ExportReportInput{
-		UserID:     entities.UserID(req.UserID),
+		UserID:     uid,
 		ReportType: req.ReportType,
 		Format:     req.Format,
 		ReportData: req.ReportData,
@@ -314,6 +377,9 @@ func (c *ReportsController) ExportReportToPDF(ctx echo.Context) error {
 
 	output, err := c.useCase.ExportReportToPDF(ctx.Request().Context(), input)
 	if err != nil {
+		if isReportExportValidationError(err) {
+			return ctx.JSON(http.StatusBadRequest, reportExportErrorResponse(err))
+		}
 		return ctx.JSON(http.StatusInternalServerError, ErrorResponse{
 			Error:   "レポートのエクスポートに失敗しました",
 			Details: err.Error(),
@@ -322,7 +388,7 @@ func (c *ReportsController) ExportReportToPDF(ctx echo.Context) error {
 
 	// ControllerでDownloadURLを構築する
 	if output.DownloadToken != "" {
-		output.DownloadURL = fmt.Sprintf("/api/reports/download/%s", output.DownloadToken)
+		output.DownloadURL = fmt.Sprintf("/api/v1/reports/download/%s", output.DownloadToken)
 	}
 
 	return ctx.JSON(http.StatusOK, output)
@@ -333,91 +399,213 @@ func (c *ReportsController) ExportReportToPDF(ctx echo.Context) error {
 // @Description PDFレポートを取得します
 // @Tags reports
 // @Produce json
-// @Param user_id query string true "ユーザーID"
-// @Param report_type query string false "レポートタイプ" Enums(financial_summary, comprehensive)
+// @Param user_id query string false "ユーザーID（廃止予定。認証済みの場合は認証トークンのユーザーが対象）"
+// @Param report_type query usecases.ReportType false "レポートタイプ" default(comprehensive)
 // @Param years query int false "予測年数" default(10)
 // @Success 200 {object} usecases.ExportReportOutput
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /reports/pdf [get]
 func (c *ReportsController) GetReportPDF(ctx echo.Context) error {
-	userID := ctx.QueryParam("user_id")
-	if userID == "" {
-		return ctx.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "ユーザーIDは必須です",
-		})
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
-	reportType := ctx.QueryParam("report_type")
-	if reportType == "" {
-		reportType = "comprehensive" // デフォルトは包括的レポート
+	reportType := usecases.ReportTypeComprehensive // デフォルトは包括的レポート
+	if value := ctx.QueryParam("report_type"); value != "" {
+		reportType, err = usecases.ParseReportType(value)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, reportExportErrorResponse(err))
+		}
 	}
 
 	yearsStr := ctx.QueryParam("years")
-	years := 10 // デフォルト値
+	years := usecases.DefaultExportReportYears
 	if yearsStr != "" {
 		if parsedYears, err := strconv.Atoi(yearsStr); err == nil && parsedYears > 0 && parsedYears <= 50 {
 			years = parsedYears
 		}
 	}
 
-	// レポートタイプに応じて適切なレポートを生成
-	var reportData interface{}
-	var err error
+	// レポートの生成とPDF化はユースケースに登録された生成処理・変換処理で行う
+	exportInput := usecases.ExportReportInput{
+		UserID:     uid,
+		ReportType: reportType,
+		Format:     usecases.ExportFormatPDF,
+		Years:      years,
+	}
 
-	switch reportType {
-	case "financial_summary":
-		input := usecases.FinancialSummaryReportInput{
-			UserID: entities.UserID(userID),
-		}
-		output, genErr := c.useCase.GenerateFinancialSummaryReport(ctx.Request().Context(), input)
-		if genErr != nil {
-			return ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-				Error:   "財務サマリーレポートの生成に失敗しました",
-				Details: genErr.Error(),
-			})
+	output, err := c.useCase.ExportReportToPDF(ctx.Request().Context(), exportInput)
+	if err != nil {
+		if isReportExportValidationError(err) {
+			return ctx.JSON(http.StatusBadRequest, reportExportErrorResponse(err))
 		}
-		reportData = output.Report
+		return ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error:   "PDFエクスポートに失敗しました",
+			Details: err.Error(),
+		})
+	}
+
+	// ControllerでDownloadURLを構築する
+	if output.DownloadToken != "" {
+		output.DownloadURL = fmt.Sprintf("/api/v1/reports/download/%s", output.DownloadToken)
+	}
 
-	case "comprehensive":
-		input := usecases.ComprehensiveReportInput{
-			UserID: entities.UserID(userID),
-			Years:  years,
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// isReportExportValidationError はレポートタイプ・ファイル形式の指定誤りによるエラーかを返す
+func isReportExportValidationError(err error) bool {
+	return errors.Is(err, usecases.ErrInvalidReportType) ||
+		errors.Is(err, usecases.ErrInvalidExportFormat) ||
+		errors.Is(err, usecases.ErrUnsupportedReportExport)
+}
+
+// reportExportErrorResponse はレポートタイプ・ファイル形式の指定誤りを、指定できる値とともに返すエラーレスポンスを作成する
+func reportExportErrorResponse(err error) ErrorResponse {
+	var unsupported *usecases.UnsupportedReportExportError
+	switch {
+	case errors.As(err, &unsupported):
+		return ErrorResponse{
+			Error: err.Error(),
+			Details: map[string]interface{}{
+				"valid_report_types": unsupported.Supported,
+			},
 		}
-		output, genErr := c.useCase.GenerateComprehensiveReport(ctx.Request().Context(), input)
-		if genErr != nil {
-			return ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-				Error:   "包括的レポートの生成に失敗しました",
-				Details: genErr.Error(),
-			})
+	case errors.Is(err, usecases.ErrInvalidExportFormat):
+		return ErrorResponse{
+			Error: err.Error(),
+			Details: map[string]interface{}{
+				"valid_formats": usecases.ExportFormats,
+			},
 		}
-		reportData = output.Report
-
 	default:
-		return ctx.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: "サポートされていないレポートタイプです",
-		})
+		return ErrorResponse{
+			Error: err.Error(),
+			Details: map[string]interface{}{
+				"valid_report_types": usecases.ReportTypes,
+			},
+		}
 	}
+}
 
-	// PDFエクスポート
-	exportInput := usecases.ExportReportInput{
-		UserID:     entities.UserID(userID),
-		ReportType: reportType,
-		Format:     "pdf",
-		ReportData: reportData,
+// GetHealthScoreTrend は財務健全性スコアの推移を取得する
+// @Summary 財務健全性スコアの推移取得
+// @Description 財務サマリーレポート生成時に記録した財務健全性スコアの月ごとの推移を返します。直近2ヶ月以上連続で低下している場合は主因を含む警告を返します
+// @Tags reports
+// @Produce json
+// @Param user_id query string false "ユーザーID（廃止予定。認証済みの場合は認証トークンのユーザーが対象）"
+// @Param months query int false "取得期間（月数、1〜60）" default(6)
+// @Success 200 {object} usecases.HealthScoreTrendOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reports/health-score-trend [get]
+func (c *ReportsController) GetHealthScoreTrend(ctx echo.Context) error {
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
 	}
 
-	output, err := c.useCase.ExportReportToPDF(ctx.Request().Context(), exportInput)
+	months := 0 // 未指定の場合はユースケースの既定値
+	if monthsStr := ctx.QueryParam("months"); monthsStr != "" {
+		parsed, err := strconv.Atoi(monthsStr)
+		if err != nil || parsed <= 0 || parsed > 60 {
+			return ctx.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: "期間は1〜60ヶ月で指定してください",
+			})
+		}
+		months = parsed
+	}
+
+	output, err := c.useCase.GetHealthScoreTrend(ctx.Request().Context(), usecases.HealthScoreTrendInput{
+		UserID: uid,
+		Months: months,
+	})
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, ErrorResponse{
-			Error:   "PDFエクスポートに失敗しました",
+			Error:   "財務健全性スコアの推移の取得に失敗しました",
 			Details: err.Error(),
 		})
 	}
 
-	// ControllerでDownloadURLを構築する
-	if output.DownloadToken != "" {
-		output.DownloadURL = fmt.Sprintf("/api/reports/download/%s", output.DownloadToken)
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// GetHouseholdFinancialSummary は世帯の財務サマリーを取得する
+// @Summary 世帯の財務サマリー取得
+// @Description 世帯のメンバー（夫婦など）の収入・支出・資産を合算し、世帯としての財務健全性とメンバーごとの内訳を返します。リクエストしたユーザーはメンバーに含まれている必要があります
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param request body HouseholdSummaryRequest true "世帯の財務サマリー取得リクエスト"
+// @Success 200 {object} usecases.HouseholdSummaryOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /reports/household-summary [post]
+func (c *ReportsController) GetHouseholdFinancialSummary(ctx echo.Context) error {
+	userID, err := resolveUserID(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, err.Error(), nil))
+	}
+	requester, err := entities.NewUserID(userID)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+	}
+
+	var req HouseholdSummaryRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "リクエストの解析に失敗しました",
+			Details: err.Error(),
+		})
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, ErrorResponse{
+			Error:   "入力値が無効です",
+			Details: err.Error(),
+		})
+	}
+
+	memberIDs := make([]entities.UserID, 0, len(req.MemberUserIDs))
+	for _, memberID := range req.MemberUserIDs {
+		uid, err := entities.NewUserID(memberID)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, NewInvalidUserIDErrorResponse(ctx))
+		}
+		memberIDs = append(memberIDs, uid)
+	}
+
+	output, err := c.useCase.GetHouseholdFinancialSummary(ctx.Request().Context(), usecases.GetHouseholdSummaryInput{
+		RequestingUserID: requester,
+		MemberUserIDs:    memberIDs,
+	})
+	if err != nil {
+		errMsg := err.Error()
+		switch {
+		case errors.Is(err, usecases.ErrRequesterNotHouseholdMember):
+			return ctx.JSON(http.StatusForbidden, NewErrorResponse(ctx, ErrorCodeForbidden, errMsg, nil))
+		case strings.Contains(errMsg, "人まで指定できます"):
+			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+		case strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error:   "世帯の財務サマリーの取得に失敗しました",
+				Details: errMsg,
+			})
+		}
 	}
 
 	return ctx.JSON(http.StatusOK, output)
@@ -511,9 +699,14 @@ func (ctrl *ReportsController) DownloadFinancialSummaryCSV(c echo.Context) error
 		})
 	}
 
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, NewErrorResponse(c, ErrorCodeUnauthorized, "無効なユーザーIDです", nil))
+	}
+
 	output, err := ctrl.useCase.GenerateFinancialSummaryReport(
 		c.Request().Context(),
-		usecases.FinancialSummaryReportInput{UserID: entities.UserID(userID)},
+		usecases.FinancialSummaryReportInput{UserID: uid},
 	)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, ErrorResponse{
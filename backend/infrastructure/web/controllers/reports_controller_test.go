@@ -11,7 +11,6 @@ import (
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/domain/entities"
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -70,9 +69,25 @@ func (m *MockGenerateReportsUseCase) ExportReportToPDF(ctx context.Context, inpu
 	return args.Get(0).(*usecases.ExportReportOutput), args.Error(1)
 }
 
+func (m *MockGenerateReportsUseCase) GetHealthScoreTrend(ctx context.Context, input usecases.HealthScoreTrendInput) (*usecases.HealthScoreTrendOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.HealthScoreTrendOutput), args.Error(1)
+}
+
+func (m *MockGenerateReportsUseCase) GetHouseholdFinancialSummary(ctx context.Context, input usecases.GetHouseholdSummaryInput) (*usecases.HouseholdSummaryOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.HouseholdSummaryOutput), args.Error(1)
+}
+
 func newReportsTestContext(method, target string, body interface{}) (echo.Context, *httptest.ResponseRecorder) {
 	e := echo.New()
-	e.Validator = &CustomValidator{validator: validator.New()}
+	e.Validator = &CustomValidator{validator: newTestValidator()}
 	var reqBody []byte
 	if body != nil {
 		reqBody, _ = json.Marshal(body)
@@ -93,10 +108,10 @@ func TestGenerateFinancialSummaryReport(t *testing.T) {
 	}{
 		{
 			name:        "Success: generate financial summary report",
-			requestBody: FinancialSummaryReportRequest{UserID: "user-123"},
+			requestBody: FinancialSummaryReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateFinancialSummaryReport", mock.Anything, usecases.FinancialSummaryReportInput{
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 				}).Return(&usecases.FinancialSummaryReportOutput{
 					Report:      usecases.FinancialSummaryReport{},
 					GeneratedAt: "2030-01-01T00:00:00Z",
@@ -112,7 +127,7 @@ func TestGenerateFinancialSummaryReport(t *testing.T) {
 		},
 		{
 			name:        "Error: internal server error",
-			requestBody: FinancialSummaryReportRequest{UserID: "user-123"},
+			requestBody: FinancialSummaryReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateFinancialSummaryReport", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -145,10 +160,10 @@ func TestGenerateAssetProjectionReport(t *testing.T) {
 	}{
 		{
 			name:        "Success: valid years",
-			requestBody: AssetProjectionReportRequest{UserID: "user-123", Years: 10},
+			requestBody: AssetProjectionReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateAssetProjectionReport", mock.Anything, usecases.AssetProjectionReportInput{
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 					Years:  10,
 				}).Return(&usecases.AssetProjectionReportOutput{
 					Report:      usecases.AssetProjectionReport{},
@@ -159,19 +174,19 @@ func TestGenerateAssetProjectionReport(t *testing.T) {
 		},
 		{
 			name:           "Error: years exceeds maximum (51)",
-			requestBody:    AssetProjectionReportRequest{UserID: "user-123", Years: 51},
+			requestBody:    AssetProjectionReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 51},
 			mockSetup:      func(m *MockGenerateReportsUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:           "Error: years is zero (fails required)",
-			requestBody:    AssetProjectionReportRequest{UserID: "user-123", Years: 0},
+			requestBody:    AssetProjectionReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 0},
 			mockSetup:      func(m *MockGenerateReportsUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:        "Error: internal server error",
-			requestBody: AssetProjectionReportRequest{UserID: "user-123", Years: 10},
+			requestBody: AssetProjectionReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateAssetProjectionReport", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -204,7 +219,7 @@ func TestGenerateGoalsProgressReport(t *testing.T) {
 	}{
 		{
 			name:        "Success: generate goals progress report",
-			requestBody: GoalsProgressReportRequest{UserID: "user-123"},
+			requestBody: GoalsProgressReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateGoalsProgressReport", mock.Anything, mock.Anything).Return(&usecases.GoalsProgressReportOutput{
 					Report:      usecases.GoalsProgressReport{},
@@ -221,7 +236,7 @@ func TestGenerateGoalsProgressReport(t *testing.T) {
 		},
 		{
 			name:        "Error: internal server error",
-			requestBody: GoalsProgressReportRequest{UserID: "user-123"},
+			requestBody: GoalsProgressReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateGoalsProgressReport", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -254,7 +269,7 @@ func TestGenerateRetirementPlanReport(t *testing.T) {
 	}{
 		{
 			name:        "Success: generate retirement plan report",
-			requestBody: RetirementPlanReportRequest{UserID: "user-123"},
+			requestBody: RetirementPlanReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateRetirementPlanReport", mock.Anything, mock.Anything).Return(&usecases.RetirementPlanReportOutput{
 					Report:      usecases.RetirementPlanReport{},
@@ -263,15 +278,50 @@ func TestGenerateRetirementPlanReport(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name: "Success: lifestyle expenses are passed to the use case",
+			requestBody: RetirementPlanReportRequest{
+				UserID:            "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				LifestyleExpenses: map[string]float64{"comfortable": 450000},
+			},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GenerateRetirementPlanReport", mock.Anything, usecases.RetirementPlanReportInput{
+					UserID:            entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+					LifestyleExpenses: map[entities.RetirementLifestyle]float64{entities.RetirementLifestyleComfortable: 450000},
+				}).Return(&usecases.RetirementPlanReportOutput{
+					Report:      usecases.RetirementPlanReport{},
+					GeneratedAt: "2030-01-01T00:00:00Z",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "Error: missing user_id",
 			requestBody:    RetirementPlanReportRequest{},
 			mockSetup:      func(m *MockGenerateReportsUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "Error: unknown lifestyle",
+			requestBody: RetirementPlanReportRequest{
+				UserID:            "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				LifestyleExpenses: map[string]float64{"luxury": 500000},
+			},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error: negative lifestyle expenses",
+			requestBody: RetirementPlanReportRequest{
+				UserID:            "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				LifestyleExpenses: map[string]float64{"minimum": -1},
+			},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:        "Error: internal server error",
-			requestBody: RetirementPlanReportRequest{UserID: "user-123"},
+			requestBody: RetirementPlanReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateRetirementPlanReport", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -298,16 +348,17 @@ func TestGenerateRetirementPlanReport(t *testing.T) {
 func TestGenerateComprehensiveReport(t *testing.T) {
 	tests := []struct {
 		name           string
+		query          string
 		requestBody    interface{}
 		mockSetup      func(m *MockGenerateReportsUseCase)
 		expectedStatus int
 	}{
 		{
 			name:        "Success: valid request",
-			requestBody: ComprehensiveReportRequest{UserID: "user-123", Years: 10},
+			requestBody: ComprehensiveReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateComprehensiveReport", mock.Anything, usecases.ComprehensiveReportInput{
-					UserID: entities.UserID("user-123"),
+					UserID: entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
 					Years:  10,
 				}).Return(&usecases.ComprehensiveReportOutput{
 					Report:      usecases.ComprehensiveReport{},
@@ -316,15 +367,38 @@ func TestGenerateComprehensiveReport(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name:        "Success: fields selects sections",
+			query:       "?fields=financial_summary,%20goals_progress",
+			requestBody: ComprehensiveReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GenerateComprehensiveReport", mock.Anything, usecases.ComprehensiveReportInput{
+					UserID:   entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+					Years:    10,
+					Sections: []usecases.ReportSection{usecases.ReportSectionFinancialSummary, usecases.ReportSectionGoalsProgress},
+				}).Return(&usecases.ComprehensiveReportOutput{
+					Report:      usecases.ComprehensiveReport{},
+					GeneratedAt: "2030-01-01T00:00:00Z",
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: unknown section in fields",
+			query:          "?fields=financial_summary,unknown",
+			requestBody:    ComprehensiveReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:           "Error: years exceeds maximum",
-			requestBody:    ComprehensiveReportRequest{UserID: "user-123", Years: 51},
+			requestBody:    ComprehensiveReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 51},
 			mockSetup:      func(m *MockGenerateReportsUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:        "Error: internal server error",
-			requestBody: ComprehensiveReportRequest{UserID: "user-123", Years: 10},
+			requestBody: ComprehensiveReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
 				m.On("GenerateComprehensiveReport", mock.Anything, mock.Anything).Return(nil, errors.New("database error"))
 			},
@@ -338,16 +412,41 @@ func TestGenerateComprehensiveReport(t *testing.T) {
 			tt.mockSetup(mockUseCase)
 			controller := NewReportsController(mockUseCase, nil)
 
-			c, rec := newReportsTestContext(http.MethodPost, "/reports/comprehensive", tt.requestBody)
+			c, rec := newReportsTestContext(http.MethodPost, "/reports/comprehensive"+tt.query, tt.requestBody)
 
 			err := controller.GenerateComprehensiveReport(c)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
 		})
 	}
 }
 
+func TestGenerateComprehensiveReport_UnknownSectionListsValidSections(t *testing.T) {
+	controller := NewReportsController(new(MockGenerateReportsUseCase), nil)
+	c, rec := newReportsTestContext(http.MethodPost, "/reports/comprehensive?fields=summary",
+		ComprehensiveReportRequest{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Years: 10})
+
+	err := controller.GenerateComprehensiveReport(c)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var body struct {
+		Error   string `json:"error"`
+		Details struct {
+			ValidSections []string `json:"valid_sections"`
+		} `json:"details"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Contains(t, body.Error, "summary")
+	assert.Equal(t, []string{
+		"executive_summary", "financial_summary", "asset_projection",
+		"goals_progress", "retirement_plan", "action_plan",
+	}, body.Details.ValidSections)
+}
+
 func TestExportReportToPDF(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -358,7 +457,7 @@ func TestExportReportToPDF(t *testing.T) {
 		{
 			name: "Success: export report",
 			requestBody: ExportReportRequest{
-				UserID:     "user-123",
+				UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				ReportType: "financial_summary",
 				Format:     "pdf",
 				ReportData: map[string]interface{}{"key": "value"},
@@ -376,7 +475,7 @@ func TestExportReportToPDF(t *testing.T) {
 		{
 			name: "Error: invalid report_type",
 			requestBody: ExportReportRequest{
-				UserID:     "user-123",
+				UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				ReportType: "invalid_type",
 				Format:     "pdf",
 				ReportData: map[string]interface{}{"key": "value"},
@@ -384,6 +483,45 @@ func TestExportReportToPDF(t *testing.T) {
 			mockSetup:      func(m *MockGenerateReportsUseCase) {},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "Error: misspelled report_type",
+			requestBody: ExportReportRequest{
+				UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				ReportType: "comprehansive",
+				Format:     "pdf",
+				ReportData: map[string]interface{}{"key": "value"},
+			},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error: format without renderer",
+			requestBody: ExportReportRequest{
+				UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				ReportType: "financial_summary",
+				Format:     "excel",
+				ReportData: map[string]interface{}{"key": "value"},
+			},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Error: unsupported combination",
+			requestBody: ExportReportRequest{
+				UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				ReportType: "comprehensive",
+				Format:     "csv",
+				ReportData: map[string]interface{}{"key": "value"},
+			},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("ExportReportToPDF", mock.Anything, mock.Anything).Return(nil, &usecases.UnsupportedReportExportError{
+					ReportType: usecases.ReportTypeComprehensive,
+					Format:     usecases.ExportFormatCSV,
+					Supported:  []usecases.ReportType{usecases.ReportTypeFinancialSummary},
+				})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "Error: missing user_id",
 			requestBody: ExportReportRequest{
@@ -397,7 +535,7 @@ func TestExportReportToPDF(t *testing.T) {
 		{
 			name: "Error: internal server error",
 			requestBody: ExportReportRequest{
-				UserID:     "user-123",
+				UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				ReportType: "financial_summary",
 				Format:     "pdf",
 				ReportData: map[string]interface{}{"key": "value"},
@@ -425,6 +563,60 @@ func TestExportReportToPDF(t *testing.T) {
 	}
 }
 
+func TestExportReportToPDF_InvalidValuesListAllowedValues(t *testing.T) {
+	tests := []struct {
+		name       string
+		reportType string
+		format     string
+		invalid    string
+		detailKey  string
+		want       []string
+	}{
+		{
+			name:       "misspelled report_type",
+			reportType: "comprehansive",
+			format:     "pdf",
+			invalid:    "comprehansive",
+			detailKey:  "valid_report_types",
+			want:       []string{"financial_summary", "asset_projection", "goals_progress", "retirement_plan", "comprehensive"},
+		},
+		{
+			name:       "format without renderer",
+			reportType: "financial_summary",
+			format:     "excel",
+			invalid:    "excel",
+			detailKey:  "valid_formats",
+			want:       []string{"pdf", "csv"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockGenerateReportsUseCase)
+			controller := NewReportsController(mockUseCase, nil)
+			c, rec := newReportsTestContext(http.MethodPost, "/reports/export", map[string]interface{}{
+				"user_id":     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				"report_type": tt.reportType,
+				"format":      tt.format,
+				"report_data": map[string]interface{}{"key": "value"},
+			})
+
+			err := controller.ExportReportToPDF(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			var body struct {
+				Error   string              `json:"error"`
+				Details map[string][]string `json:"details"`
+			}
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Contains(t, body.Error, tt.invalid)
+			assert.Equal(t, tt.want, body.Details[tt.detailKey])
+			mockUseCase.AssertNotCalled(t, "ExportReportToPDF", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestGetReportPDF(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -435,14 +627,15 @@ func TestGetReportPDF(t *testing.T) {
 		{
 			name: "Success: comprehensive report (default)",
 			queryParams: map[string]string{
-				"user_id": "user-123",
+				"user_id": "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
-				m.On("GenerateComprehensiveReport", mock.Anything, mock.Anything).Return(&usecases.ComprehensiveReportOutput{
-					Report:      usecases.ComprehensiveReport{},
-					GeneratedAt: "2030-01-01T00:00:00Z",
-				}, nil)
-				m.On("ExportReportToPDF", mock.Anything, mock.Anything).Return(&usecases.ExportReportOutput{
+				m.On("ExportReportToPDF", mock.Anything, usecases.ExportReportInput{
+					UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+					ReportType: usecases.ReportTypeComprehensive,
+					Format:     usecases.ExportFormatPDF,
+					Years:      usecases.DefaultExportReportYears,
+				}).Return(&usecases.ExportReportOutput{
 					FileName:    "report.pdf",
 					DownloadURL: "https://example.com/report.pdf",
 					ExpiresAt:   "2030-01-01T00:00:00Z",
@@ -453,15 +646,16 @@ func TestGetReportPDF(t *testing.T) {
 		{
 			name: "Success: financial_summary report",
 			queryParams: map[string]string{
-				"user_id":     "user-123",
+				"user_id":     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				"report_type": "financial_summary",
 			},
 			mockSetup: func(m *MockGenerateReportsUseCase) {
-				m.On("GenerateFinancialSummaryReport", mock.Anything, mock.Anything).Return(&usecases.FinancialSummaryReportOutput{
-					Report:      usecases.FinancialSummaryReport{},
-					GeneratedAt: "2030-01-01T00:00:00Z",
-				}, nil)
-				m.On("ExportReportToPDF", mock.Anything, mock.Anything).Return(&usecases.ExportReportOutput{
+				m.On("ExportReportToPDF", mock.Anything, usecases.ExportReportInput{
+					UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+					ReportType: usecases.ReportTypeFinancialSummary,
+					Format:     usecases.ExportFormatPDF,
+					Years:      usecases.DefaultExportReportYears,
+				}).Return(&usecases.ExportReportOutput{
 					FileName:    "report.pdf",
 					DownloadURL: "https://example.com/report.pdf",
 					ExpiresAt:   "2030-01-01T00:00:00Z",
@@ -469,6 +663,23 @@ func TestGetReportPDF(t *testing.T) {
 			},
 			expectedStatus: http.StatusOK,
 		},
+		{
+			name: "Success: goals_progress report with years",
+			queryParams: map[string]string{
+				"user_id":     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				"report_type": "goals_progress",
+				"years":       "20",
+			},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("ExportReportToPDF", mock.Anything, usecases.ExportReportInput{
+					UserID:     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+					ReportType: usecases.ReportTypeGoalsProgress,
+					Format:     usecases.ExportFormatPDF,
+					Years:      20,
+				}).Return(&usecases.ExportReportOutput{FileName: "report.pdf"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
 		{
 			name:           "Error: missing user_id",
 			queryParams:    map[string]string{},
@@ -478,7 +689,7 @@ func TestGetReportPDF(t *testing.T) {
 		{
 			name: "Error: unsupported report type",
 			queryParams: map[string]string{
-				"user_id":     "user-123",
+				"user_id":     "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 				"report_type": "unsupported_type",
 			},
 			mockSetup:      func(m *MockGenerateReportsUseCase) {},
@@ -489,7 +700,7 @@ func TestGetReportPDF(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 			mockUseCase := new(MockGenerateReportsUseCase)
 			tt.mockSetup(mockUseCase)
 			controller := NewReportsController(mockUseCase, nil)
@@ -509,6 +720,147 @@ func TestGetReportPDF(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetHealthScoreTrend(t *testing.T) {
+	tests := []struct {
+		name           string
+		target         string
+		mockSetup      func(m *MockGenerateReportsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Success: months defaults to use case value",
+			target: "/reports/health-score-trend?user_id=4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GetHealthScoreTrend", mock.Anything, usecases.HealthScoreTrendInput{
+					UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+				}).Return(&usecases.HealthScoreTrendOutput{Months: 6, Trend: "stable"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Success: explicit months",
+			target: "/reports/health-score-trend?user_id=4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c&months=12",
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GetHealthScoreTrend", mock.Anything, usecases.HealthScoreTrendInput{
+					UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+					Months: 12,
+				}).Return(&usecases.HealthScoreTrendOutput{Months: 12, Trend: "down"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: missing user_id",
+			target:         "/reports/health-score-trend",
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: months out of range",
+			target:         "/reports/health-score-trend?user_id=4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c&months=61",
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Error: use case failure",
+			target: "/reports/health-score-trend?user_id=4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GetHealthScoreTrend", mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			mockUseCase := new(MockGenerateReportsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewReportsController(mockUseCase, nil)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := controller.GetHealthScoreTrend(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetHouseholdFinancialSummary(t *testing.T) {
+	const requester = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	const partner = "9b2e7d4c-5a1f-4c3e-8d6b-1f0a2c4e6b8d"
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		mockSetup      func(m *MockGenerateReportsUseCase)
+		expectedStatus int
+	}{
+		{
+			name:        "Success: household summary",
+			requestBody: HouseholdSummaryRequest{MemberUserIDs: []string{requester, partner}},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GetHouseholdFinancialSummary", mock.Anything, usecases.GetHouseholdSummaryInput{
+					RequestingUserID: requester,
+					MemberUserIDs:    []entities.UserID{requester, partner},
+				}).Return(&usecases.HouseholdSummaryOutput{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: empty member_user_ids",
+			requestBody:    HouseholdSummaryRequest{},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: invalid member user id",
+			requestBody:    HouseholdSummaryRequest{MemberUserIDs: []string{requester, "user-002"}},
+			mockSetup:      func(m *MockGenerateReportsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Error: requester is not a member",
+			requestBody: HouseholdSummaryRequest{MemberUserIDs: []string{partner}},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GetHouseholdFinancialSummary", mock.Anything, mock.Anything).Return(nil, usecases.ErrRequesterNotHouseholdMember)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:        "Error: member has no financial data",
+			requestBody: HouseholdSummaryRequest{MemberUserIDs: []string{requester, partner}},
+			mockSetup: func(m *MockGenerateReportsUseCase) {
+				m.On("GetHouseholdFinancialSummary", mock.Anything, mock.Anything).
+					Return(nil, errors.New("メンバー "+partner+" の財務計画の取得に失敗しました: not found"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockGenerateReportsUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewReportsController(mockUseCase, nil)
+
+			c, rec := newReportsTestContext(http.MethodPost, "/reports/household-summary", tt.requestBody)
+			c.Set("user_id", requester)
+
+			err := controller.GetHouseholdFinancialSummary(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			mockUseCase.AssertExpectations(t)
 		})
 	}
 }
@@ -572,12 +924,12 @@ func TestDownloadReport_WithFileStorage(t *testing.T) {
 		{
 			name:       "正常系: 有効なトークンでPDFが返る",
 			token:      "valid-token-123",
-			authUserID: "user-123",
+			authUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			setupStorage: func() *mockFileStorage {
 				s := &mockFileStorage{}
 				s.getFileFunc = func(token string) ([]byte, string, string, error) {
 					// ownerUserIDにユーザーIDを設定
-					return pdfBytes, "user-123_report_financial_summary.pdf", "user-123", nil
+					return pdfBytes, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c_report_financial_summary.pdf", "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", nil
 				}
 				return s
 			},
@@ -590,7 +942,7 @@ func TestDownloadReport_WithFileStorage(t *testing.T) {
 		{
 			name:       "異常系: 存在しないトークンで404",
 			token:      "nonexistent-token",
-			authUserID: "user-123",
+			authUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			setupStorage: func() *mockFileStorage {
 				s := &mockFileStorage{}
 				s.getFileFunc = func(token string) ([]byte, string, string, error) {
@@ -603,7 +955,7 @@ func TestDownloadReport_WithFileStorage(t *testing.T) {
 		{
 			name:       "異常系: 期限切れトークンで410",
 			token:      "expired-token",
-			authUserID: "user-123",
+			authUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			setupStorage: func() *mockFileStorage {
 				s := &mockFileStorage{}
 				s.getFileFunc = func(token string) ([]byte, string, string, error) {
@@ -616,12 +968,12 @@ func TestDownloadReport_WithFileStorage(t *testing.T) {
 		{
 			name:       "認可エラー: 別ユーザーのトークンで403",
 			token:      "other-user-token",
-			authUserID: "user-123",
+			authUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			setupStorage: func() *mockFileStorage {
 				s := &mockFileStorage{}
 				s.getFileFunc = func(token string) ([]byte, string, string, error) {
 					// ownerUserIDに別ユーザーIDを設定
-					return pdfBytes, "user-456_report_financial_summary.pdf", "user-456", nil
+					return pdfBytes, "9b1e7d3f-5a2c-4c8e-b6d4-0f2a8c6e4b1d_report_financial_summary.pdf", "9b1e7d3f-5a2c-4c8e-b6d4-0f2a8c6e4b1d", nil
 				}
 				return s
 			},
@@ -0,0 +1,215 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// SavingsRateTargetController は貯蓄率目標のコントローラー
+type SavingsRateTargetController struct {
+	useCase usecases.ManageSavingsRateTargetUseCase
+}
+
+// NewSavingsRateTargetController は新しいSavingsRateTargetControllerを作成する
+func NewSavingsRateTargetController(useCase usecases.ManageSavingsRateTargetUseCase) *SavingsRateTargetController {
+	return &SavingsRateTargetController{
+		useCase: useCase,
+	}
+}
+
+// SetSavingsRateTargetRequest は貯蓄率目標設定リクエスト
+type SetSavingsRateTargetRequest struct {
+	TargetPercent float64 `json:"target_percent" validate:"required,gte=1,lte=80"`
+	PeriodStart   string  `json:"period_start" validate:"required"` // YYYY-MM-DD
+	PeriodEnd     string  `json:"period_end" validate:"required"`   // YYYY-MM-DD
+}
+
+// RecordMonthlySavingsActualRequest は月次実績記録リクエスト
+type RecordMonthlySavingsActualRequest struct {
+	Month    string  `json:"month" validate:"required"` // YYYY-MM
+	Income   float64 `json:"income" validate:"gte=0"`
+	Expenses float64 `json:"expenses" validate:"gte=0"`
+}
+
+// GetSavingsRateTarget は有効な貯蓄率目標と進捗を取得する
+// @Summary 貯蓄率目標取得
+// @Description 有効な貯蓄率目標と、目標に対する進捗（達成月数・期末予測・警告）を取得します
+// @Tags financial-data
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.SavingsRateTargetOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/savings-rate-target [get]
+func (c *SavingsRateTargetController) GetSavingsRateTarget(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.GetSavingsRateTarget(ctx.Request().Context(), usecases.GetSavingsRateTargetInput{
+		UserID: uid,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// SetSavingsRateTarget は貯蓄率目標を設定する
+// @Summary 貯蓄率目標設定
+// @Description 貯蓄率目標を設定します。既存の有効な目標は無効化されます
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body SetSavingsRateTargetRequest true "貯蓄率目標設定リクエスト"
+// @Success 200 {object} usecases.SavingsRateTargetOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/savings-rate-target [put]
+func (c *SavingsRateTargetController) SetSavingsRateTarget(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req SetSavingsRateTargetRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	periodStart, err := time.Parse("2006-01-02", req.PeriodStart)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "開始日の形式が正しくありません（YYYY-MM-DD）", err.Error()))
+	}
+	periodEnd, err := time.Parse("2006-01-02", req.PeriodEnd)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "終了日の形式が正しくありません（YYYY-MM-DD）", err.Error()))
+	}
+
+	// Business logic validation for savings rate target
+	if err := ValidateBusinessLogic(ctx,
+		func() *BusinessLogicError {
+			if !periodEnd.After(periodStart) {
+				return CreateBusinessLogicError(
+					"INVALID_PERIOD",
+					req.PeriodEnd,
+					"> period_start",
+				)
+			}
+			return nil
+		},
+		func() *BusinessLogicError {
+			months := (periodEnd.Year()-periodStart.Year())*12 + int(periodEnd.Month()-periodStart.Month()) + 1
+			if months > entities.MaxSavingsRateTargetPeriodMonths {
+				return CreateBusinessLogicError(
+					"INVALID_PERIOD_LENGTH",
+					months,
+					"<= 24",
+				)
+			}
+			return nil
+		},
+	); err != nil {
+		return err
+	}
+
+	input := usecases.SetSavingsRateTargetInput{
+		UserID:        uid,
+		TargetPercent: req.TargetPercent,
+		PeriodStart:   periodStart,
+		PeriodEnd:     periodEnd,
+	}
+
+	output, err := c.useCase.SetSavingsRateTarget(ctx.Request().Context(), input)
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// RecordMonthlySavingsActual は月次の収入・支出実績を記録する
+// @Summary 月次実績記録
+// @Description 月次の収入・支出実績を記録し、貯蓄率目標の進捗を返します
+// @Tags financial-data
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param request body RecordMonthlySavingsActualRequest true "月次実績記録リクエスト"
+// @Success 200 {object} usecases.SavingsRateTargetOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /financial-data/{user_id}/savings-rate-target/actuals [post]
+func (c *SavingsRateTargetController) RecordMonthlySavingsActual(ctx echo.Context) error {
+	uid, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	var req RecordMonthlySavingsActualRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "リクエストの解析に失敗しました", err.Error()))
+	}
+
+	if err := ctx.Validate(&req); err != nil {
+		return err // Validator already returns proper error response
+	}
+
+	month, err := time.Parse("2006-01", req.Month)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "対象月の形式が正しくありません（YYYY-MM）", err.Error()))
+	}
+
+	input := usecases.RecordMonthlySavingsActualInput{
+		UserID:   uid,
+		Month:    month,
+		Income:   req.Income,
+		Expenses: req.Expenses,
+	}
+
+	output, err := c.useCase.RecordMonthlySavingsActual(ctx.Request().Context(), input)
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *SavingsRateTargetController) handleError(ctx echo.Context, err error) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "貯蓄率目標が見つかりません"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "貯蓄率目標"))
+	case strings.Contains(errMsg, "財務計画の取得に失敗しました"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+	case strings.Contains(errMsg, "貯蓄率目標の作成に失敗しました"), strings.Contains(errMsg, "0以上である必要があります"):
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
@@ -226,6 +227,7 @@ func (c *TwoFactorController) Verify2FA(ctx echo.Context) error {
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} ErrorResponse
 // @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /auth/2fa [delete]
 func (c *TwoFactorController) Disable2FA(ctx echo.Context) error {
@@ -251,6 +253,9 @@ func (c *TwoFactorController) Disable2FA(ctx echo.Context) error {
 	}
 
 	if err := c.authUseCase.Disable2FA(ctx.Request().Context(), input); err != nil {
+		if errors.Is(err, usecases.ErrTwoFactorRequiredByPolicy) {
+			return ctx.JSON(http.StatusForbidden, NewLocalizedErrorResponse(ctx, ErrorCodeTwoFactorRequired, err.Error()))
+		}
 		if err.Error() == "パスワードが正しくありません" {
 			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeValidation, err.Error(), nil))
 		}
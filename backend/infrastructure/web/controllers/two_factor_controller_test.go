@@ -9,7 +9,6 @@ import (
 	"testing"
 
 	"github.com/financial-planning-calculator/backend/application/usecases"
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -29,9 +28,9 @@ func TestSetup2FA(t *testing.T) {
 	}{
 		{
 			name:   "Success: setup 2FA",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("Setup2FA", mock.Anything, "user-123").Return(&usecases.Setup2FAOutput{
+				m.On("Setup2FA", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(&usecases.Setup2FAOutput{
 					Secret:      "JBSWY3DPEHPK3PXP",
 					QRCodeURL:   "otpauth://totp/...",
 					BackupCodes: []string{"code1", "code2"},
@@ -47,17 +46,17 @@ func TestSetup2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: 2FA already enabled",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("Setup2FA", mock.Anything, "user-123").Return(nil, errors.New("2段階認証は既に有効です"))
+				m.On("Setup2FA", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(nil, errors.New("2段階認証は既に有効です"))
 			},
 			expectedStatus: http.StatusConflict,
 		},
 		{
 			name:   "Error: internal server error",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("Setup2FA", mock.Anything, "user-123").Return(nil, errors.New("database error"))
+				m.On("Setup2FA", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -66,7 +65,7 @@ func TestSetup2FA(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -98,14 +97,14 @@ func TestEnable2FA(t *testing.T) {
 	}{
 		{
 			name:   "Success: enable 2FA",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Enable2FARequest{
 				Code:   "123456",
 				Secret: "JBSWY3DPEHPK3PXP",
 			},
 			mockSetup: func(m *MockAuthUseCase) {
 				m.On("Enable2FA", mock.Anything, mock.MatchedBy(func(input usecases.Enable2FAInput) bool {
-					return input.UserID == "user-123" && input.Code == "123456"
+					return input.UserID == "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c" && input.Code == "123456"
 				})).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -119,7 +118,7 @@ func TestEnable2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: validation failure (missing code)",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Enable2FARequest{
 				Secret: "JBSWY3DPEHPK3PXP",
 			},
@@ -128,7 +127,7 @@ func TestEnable2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: invalid code",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Enable2FARequest{
 				Code:   "000000",
 				Secret: "JBSWY3DPEHPK3PXP",
@@ -140,7 +139,7 @@ func TestEnable2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: already enabled",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Enable2FARequest{
 				Code:   "123456",
 				Secret: "JBSWY3DPEHPK3PXP",
@@ -155,7 +154,7 @@ func TestEnable2FA(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -193,15 +192,15 @@ func TestVerify2FA(t *testing.T) {
 	}{
 		{
 			name:   "Success: verify 2FA",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Verify2FARequest{
 				Code: "123456",
 			},
 			mockSetup: func(m *MockAuthUseCase) {
 				m.On("Verify2FA", mock.Anything, mock.MatchedBy(func(input usecases.Verify2FAInput) bool {
-					return input.UserID == "user-123" && input.Code == "123456"
+					return input.UserID == "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c" && input.Code == "123456"
 				})).Return(&usecases.LoginOutput{
-					UserID:       "user-123",
+					UserID:       "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 					Email:        "test@example.com",
 					Token:        "access-token",
 					RefreshToken: "refresh-token",
@@ -219,7 +218,7 @@ func TestVerify2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: validation failure (missing code)",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Verify2FARequest{
 				// Code is empty → fails required
 			},
@@ -228,7 +227,7 @@ func TestVerify2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: invalid code",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Verify2FARequest{
 				Code: "000000",
 			},
@@ -242,7 +241,7 @@ func TestVerify2FA(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -280,13 +279,13 @@ func TestDisable2FA(t *testing.T) {
 	}{
 		{
 			name:   "Success: disable 2FA",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Disable2FARequest{
 				Password: "password123",
 			},
 			mockSetup: func(m *MockAuthUseCase) {
 				m.On("Disable2FA", mock.Anything, mock.MatchedBy(func(input usecases.Disable2FAInput) bool {
-					return input.UserID == "user-123"
+					return input.UserID == "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
 				})).Return(nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -300,7 +299,7 @@ func TestDisable2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: validation failure (missing password)",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Disable2FARequest{
 				// Password is empty → fails required
 			},
@@ -309,7 +308,7 @@ func TestDisable2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: wrong password",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Disable2FARequest{
 				Password: "wrongpassword",
 			},
@@ -320,7 +319,7 @@ func TestDisable2FA(t *testing.T) {
 		},
 		{
 			name:   "Error: 2FA not enabled",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			requestBody: Disable2FARequest{
 				Password: "password123",
 			},
@@ -329,12 +328,23 @@ func TestDisable2FA(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name:   "Error: 2FA required by policy",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			requestBody: Disable2FARequest{
+				Password: "password123",
+			},
+			mockSetup: func(m *MockAuthUseCase) {
+				m.On("Disable2FA", mock.Anything, mock.Anything).Return(usecases.ErrTwoFactorRequiredByPolicy)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			e := echo.New()
-			e.Validator = &CustomValidator{validator: validator.New()}
+			e.Validator = &CustomValidator{validator: newTestValidator()}
 
 			mockUseCase := new(MockAuthUseCase)
 			tt.mockSetup(mockUseCase)
@@ -370,9 +380,9 @@ func TestRegenerateBackupCodes(t *testing.T) {
 	}{
 		{
 			name:   "Success: regenerate backup codes",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("RegenerateBackupCodes", mock.Anything, "user-123").Return(&usecases.RegenerateBackupCodesOutput{
+				m.On("RegenerateBackupCodes", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(&usecases.RegenerateBackupCodesOutput{
 					BackupCodes: []string{"code1", "code2", "code3"},
 				}, nil)
 			},
@@ -386,17 +396,17 @@ func TestRegenerateBackupCodes(t *testing.T) {
 		},
 		{
 			name:   "Error: 2FA not enabled",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("RegenerateBackupCodes", mock.Anything, "user-123").Return(nil, errors.New("2段階認証が有効になっていません"))
+				m.On("RegenerateBackupCodes", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(nil, errors.New("2段階認証が有効になっていません"))
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
 			name:   "Error: internal server error",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("RegenerateBackupCodes", mock.Anything, "user-123").Return(nil, errors.New("database error"))
+				m.On("RegenerateBackupCodes", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
@@ -433,9 +443,9 @@ func TestGet2FAStatus(t *testing.T) {
 	}{
 		{
 			name:   "Success: 2FA enabled",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("Get2FAStatus", mock.Anything, "user-123").Return(&usecases.Get2FAStatusOutput{
+				m.On("Get2FAStatus", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(&usecases.Get2FAStatusOutput{
 					Enabled: true,
 				}, nil)
 			},
@@ -443,9 +453,9 @@ func TestGet2FAStatus(t *testing.T) {
 		},
 		{
 			name:   "Success: 2FA disabled",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("Get2FAStatus", mock.Anything, "user-123").Return(&usecases.Get2FAStatusOutput{
+				m.On("Get2FAStatus", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(&usecases.Get2FAStatusOutput{
 					Enabled: false,
 				}, nil)
 			},
@@ -459,9 +469,9 @@ func TestGet2FAStatus(t *testing.T) {
 		},
 		{
 			name:   "Error: internal server error",
-			userID: "user-123",
+			userID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
 			mockSetup: func(m *MockAuthUseCase) {
-				m.On("Get2FAStatus", mock.Anything, "user-123").Return(nil, errors.New("database error"))
+				m.On("Get2FAStatus", mock.Anything, "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c").Return(nil, errors.New("database error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
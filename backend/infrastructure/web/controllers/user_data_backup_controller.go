@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/labstack/echo/v4"
+)
+
+// maxUserDataBackupSize はインポートできるバックアップファイルの上限サイズ（1MB）
+const maxUserDataBackupSize = 1 << 20
+
+// UserDataBackupController は財務データのバックアップ（エクスポート/インポート）のコントローラー
+type UserDataBackupController struct {
+	useCase usecases.UserDataBackupUseCase
+}
+
+// NewUserDataBackupController は新しいUserDataBackupControllerを作成する
+func NewUserDataBackupController(useCase usecases.UserDataBackupUseCase) *UserDataBackupController {
+	return &UserDataBackupController{
+		useCase: useCase,
+	}
+}
+
+// ExportBackup は財務データをバックアップ用のJSONとして返す
+// @Summary 財務データのエクスポート
+// @Description 財務プロファイル・退職設定・緊急資金・目標をバックアップ用のJSONとして返します。認証情報は含みません
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.UserDataBackup
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/backup [get]
+func (c *UserDataBackupController) ExportBackup(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	backup, err := c.useCase.ExportUserData(ctx.Request().Context(), userID)
+	if err != nil {
+		errMsg := err.Error()
+		if strings.Contains(errMsg, "財務データの取得に失敗しました") {
+			return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "財務データ"))
+		}
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+
+	filename := fmt.Sprintf("financial_backup_%s.json", backup.ExportedAt.Format("20060102"))
+	ctx.Response().Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	return ctx.JSON(http.StatusOK, backup)
+}
+
+// ImportBackup はバックアップ用のJSONから財務データを復元する
+// @Summary 財務データのインポート
+// @Description バックアップ用のJSONから財務データを復元します。既存のデータがある場合は overwrite=true の指定が必要です
+// @Tags users
+// @Security BearerAuth
+// @Accept json
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Param overwrite query bool false "既存の財務データを上書きする"
+// @Param request body usecases.UserDataBackup true "バックアップ"
+// @Success 200 {object} usecases.ImportUserDataOutput
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 409 {object} ErrorResponse
+// @Failure 413 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/backup [post]
+func (c *UserDataBackupController) ImportBackup(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	overwrite := false
+	if param := ctx.QueryParam("overwrite"); param != "" {
+		overwrite, err = strconv.ParseBool(param)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "overwriteはtrueまたはfalseで指定してください", nil))
+		}
+	}
+
+	data, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxUserDataBackupSize+1))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, "バックアップの読み込みに失敗しました", err.Error()))
+	}
+	if len(data) > maxUserDataBackupSize {
+		return ctx.JSON(http.StatusRequestEntityTooLarge, NewErrorResponse(ctx, ErrorCodeBadRequest, "バックアップのサイズは1MB以下にしてください", nil))
+	}
+
+	output, err := c.useCase.ImportUserData(ctx.Request().Context(), userID, data, overwrite)
+	if err != nil {
+		errMsg := err.Error()
+		switch {
+		case errors.Is(err, usecases.ErrUserDataOverwriteNotConfirmed):
+			return ctx.JSON(http.StatusConflict, NewErrorResponse(ctx, ErrorCodeConflict, errMsg, nil))
+		case strings.Contains(errMsg, "バックアップの解析に失敗しました"),
+			strings.Contains(errMsg, "バックアップの復元に失敗しました"):
+			return ctx.JSON(http.StatusBadRequest, NewErrorResponse(ctx, ErrorCodeBadRequest, errMsg, nil))
+		default:
+			return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockUserDataBackupUseCase is a mock implementation of UserDataBackupUseCase
+type MockUserDataBackupUseCase struct {
+	mock.Mock
+}
+
+func (m *MockUserDataBackupUseCase) ExportUserData(ctx context.Context, userID entities.UserID) (*usecases.UserDataBackup, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.UserDataBackup), args.Error(1)
+}
+
+func (m *MockUserDataBackupUseCase) ImportUserData(ctx context.Context, userID entities.UserID, data []byte, overwrite bool) (*usecases.ImportUserDataOutput, error) {
+	args := m.Called(ctx, userID, data, overwrite)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.ImportUserDataOutput), args.Error(1)
+}
+
+func TestImportBackup(t *testing.T) {
+	const userID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	body := `{"schema_version": 1, "profile": {}}`
+
+	tests := []struct {
+		name           string
+		query          string
+		body           string
+		paramUserID    string
+		mockSetup      func(m *MockUserDataBackupUseCase)
+		expectedStatus int
+	}{
+		{
+			name:        "Success: import with overwrite",
+			query:       "?overwrite=true",
+			body:        body,
+			paramUserID: userID,
+			mockSetup: func(m *MockUserDataBackupUseCase) {
+				m.On("ImportUserData", mock.Anything, entities.UserID(userID), []byte(body), true).
+					Return(&usecases.ImportUserDataOutput{UserID: userID, SchemaVersion: 1, Overwritten: true}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Error: existing data without overwrite confirmation",
+			body:        body,
+			paramUserID: userID,
+			mockSetup: func(m *MockUserDataBackupUseCase) {
+				m.On("ImportUserData", mock.Anything, entities.UserID(userID), []byte(body), false).
+					Return(nil, usecases.ErrUserDataOverwriteNotConfirmed)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:        "Error: unsupported schema version",
+			body:        body,
+			paramUserID: userID,
+			mockSetup: func(m *MockUserDataBackupUseCase) {
+				m.On("ImportUserData", mock.Anything, entities.UserID(userID), []byte(body), false).
+					Return(nil, fmt.Errorf("バックアップの解析に失敗しました: %w", usecases.ErrUnsupportedBackupSchemaVersion))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: invalid overwrite parameter",
+			query:          "?overwrite=yes-please",
+			body:           body,
+			paramUserID:    userID,
+			mockSetup:      func(m *MockUserDataBackupUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Error: backup too large",
+			body:           strings.Repeat(" ", maxUserDataBackupSize+1),
+			paramUserID:    userID,
+			mockSetup:      func(m *MockUserDataBackupUseCase) {},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:           "Error: another user's backup",
+			body:           body,
+			paramUserID:    "9b1e7d3f-5a2c-4c8e-b6d4-0f2a8c6e4b1d",
+			mockSetup:      func(m *MockUserDataBackupUseCase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockUserDataBackupUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewUserDataBackupController(mockUseCase)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/users/"+tt.paramUserID+"/backup"+tt.query, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("user_id")
+			c.SetParamValues(tt.paramUserID)
+			setTestUserID(c, userID)
+
+			err := controller.ImportBackup(c)
+
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+)
+
+// UserManagementController はアカウント削除関連のコントローラー
+type UserManagementController struct {
+	useCase usecases.UserManagementUseCase
+}
+
+// NewUserManagementController は新しいUserManagementControllerを作成する
+func NewUserManagementController(useCase usecases.UserManagementUseCase) *UserManagementController {
+	return &UserManagementController{
+		useCase: useCase,
+	}
+}
+
+// GetDeletionPreview はアカウント削除で消去されるデータの件数を取得する
+// @Summary アカウント削除プレビュー
+// @Description アカウントを削除した場合に消去されるデータの件数を返します。削除は行いません
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.DeletionPreviewOutput
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id}/deletion-preview [get]
+func (c *UserManagementController) GetDeletionPreview(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.PreviewAccountDeletion(ctx.Request().Context(), usecases.PreviewAccountDeletionInput{
+		UserID: userID,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// DeleteAccount はアカウントと関連データをすべて削除する
+// @Summary アカウント削除
+// @Description アカウントと財務計画・目標・トークンなどの関連データをすべて削除します。削除したデータは復元できません
+// @Tags users
+// @Security BearerAuth
+// @Produce json
+// @Param user_id path string true "ユーザーID"
+// @Success 200 {object} usecases.DeleteAccountOutput
+// @Failure 401 {object} ErrorResponse
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /users/{user_id} [delete]
+func (c *UserManagementController) DeleteAccount(ctx echo.Context) error {
+	userID, err := authorizeUserPath(ctx)
+	if err != nil {
+		return err
+	}
+
+	output, err := c.useCase.DeleteAccount(ctx.Request().Context(), usecases.DeleteAccountInput{
+		UserID: userID,
+	})
+	if err != nil {
+		return c.handleError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+// authorizeUserPath はパスのユーザーIDが認証済みユーザー本人であることを確認する
+func authorizeUserPath(ctx echo.Context) (entities.UserID, error) {
+	currentUserID, err := getUserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	userID := ctx.Param("user_id")
+	if userID == "" {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "ユーザーIDは必須です")
+	}
+	if userID != currentUserID {
+		return "", echo.NewHTTPError(http.StatusForbidden, "他のユーザーのアカウントは操作できません")
+	}
+
+	uid, err := entities.NewUserID(userID)
+	if err != nil {
+		return "", echo.NewHTTPError(http.StatusBadRequest, "ユーザーIDの形式が正しくありません")
+	}
+
+	return uid, nil
+}
+
+// handleError はユースケースのエラーをHTTPレスポンスに変換する
+func (c *UserManagementController) handleError(ctx echo.Context, err error) error {
+	errMsg := err.Error()
+	switch {
+	case strings.Contains(errMsg, "ユーザーが見つかりません"):
+		return ctx.JSON(http.StatusNotFound, NewNotFoundErrorResponse(ctx, "ユーザー"))
+	default:
+		return ctx.JSON(http.StatusInternalServerError, NewInternalServerErrorResponse(ctx, errMsg))
+	}
+}
@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUserManagementUseCase is a mock implementation of UserManagementUseCase
+type MockUserManagementUseCase struct {
+	mock.Mock
+}
+
+func (m *MockUserManagementUseCase) PreviewAccountDeletion(ctx context.Context, input usecases.PreviewAccountDeletionInput) (*usecases.DeletionPreviewOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.DeletionPreviewOutput), args.Error(1)
+}
+
+func (m *MockUserManagementUseCase) DeleteAccount(ctx context.Context, input usecases.DeleteAccountInput) (*usecases.DeleteAccountOutput, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.DeleteAccountOutput), args.Error(1)
+}
+
+// newUserManagementContext creates an echo context for /users/:user_id routes
+func newUserManagementContext(method, path, paramUserID, currentUserID string) (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(method, path, nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("user_id")
+	c.SetParamValues(paramUserID)
+	if currentUserID != "" {
+		setTestUserID(c, currentUserID)
+	}
+	return c, rec
+}
+
+// statusOf returns the HTTP status for a handler result
+func statusOf(err error, rec *httptest.ResponseRecorder) int {
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	return rec.Code
+}
+
+func TestGetDeletionPreview(t *testing.T) {
+	preview := &usecases.DeletionPreviewOutput{
+		UserID:               "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+		FinancialPlans:       1,
+		Goals:                3,
+		RefreshTokens:        2,
+		GoalSharedWithOthers: []string{},
+	}
+
+	tests := []struct {
+		name           string
+		paramUserID    string
+		currentUserID  string
+		mockSetup      func(m *MockUserManagementUseCase)
+		expectedStatus int
+	}{
+		{
+			name:          "Success: preview own account",
+			paramUserID:   "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			currentUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockUserManagementUseCase) {
+				m.On("PreviewAccountDeletion", mock.Anything, usecases.PreviewAccountDeletionInput{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}).Return(preview, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: no user in context",
+			paramUserID:    "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup:      func(m *MockUserManagementUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Error: other user's account",
+			paramUserID:    "9b1e7d3f-5a2c-4c8e-b6d4-0f2a8c6e4b1d",
+			currentUserID:  "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup:      func(m *MockUserManagementUseCase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Error: user not found",
+			paramUserID:   "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			currentUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockUserManagementUseCase) {
+				m.On("PreviewAccountDeletion", mock.Anything, mock.Anything).Return(nil, errors.New("ユーザーが見つかりません: 4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"))
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockUserManagementUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewUserManagementController(mockUseCase)
+
+			c, rec := newUserManagementContext(http.MethodGet, "/users/"+tt.paramUserID+"/deletion-preview", tt.paramUserID, tt.currentUserID)
+			err := controller.GetDeletionPreview(c)
+
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			if tt.expectedStatus == http.StatusOK {
+				var body usecases.DeletionPreviewOutput
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, 3, body.Goals)
+				assert.Equal(t, 2, body.RefreshTokens)
+			}
+			mockUseCase.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteAccount(t *testing.T) {
+	tests := []struct {
+		name           string
+		paramUserID    string
+		currentUserID  string
+		mockSetup      func(m *MockUserManagementUseCase)
+		expectedStatus int
+	}{
+		{
+			name:          "Success: delete own account",
+			paramUserID:   "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			currentUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockUserManagementUseCase) {
+				m.On("DeleteAccount", mock.Anything, usecases.DeleteAccountInput{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}).Return(&usecases.DeleteAccountOutput{
+					UserID:  entities.UserID("4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"),
+					Deleted: &usecases.DeletionPreviewOutput{UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c", Goals: 1},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Error: other user's account",
+			paramUserID:    "9b1e7d3f-5a2c-4c8e-b6d4-0f2a8c6e4b1d",
+			currentUserID:  "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup:      func(m *MockUserManagementUseCase) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Error: internal server error",
+			paramUserID:   "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			currentUserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+			mockSetup: func(m *MockUserManagementUseCase) {
+				m.On("DeleteAccount", mock.Anything, mock.Anything).Return(nil, errors.New("ユーザーの削除に失敗しました: db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUseCase := new(MockUserManagementUseCase)
+			tt.mockSetup(mockUseCase)
+			controller := NewUserManagementController(mockUseCase)
+
+			c, rec := newUserManagementContext(http.MethodDelete, "/users/"+tt.paramUserID, tt.paramUserID, tt.currentUserID)
+			err := controller.DeleteAccount(c)
+
+			assert.Equal(t, tt.expectedStatus, statusOf(err, rec))
+			mockUseCase.AssertExpectations(t)
+			if tt.expectedStatus == http.StatusForbidden {
+				mockUseCase.AssertNotCalled(t, "DeleteAccount", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
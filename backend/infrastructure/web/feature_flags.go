@@ -0,0 +1,42 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/financial-planning-calculator/backend/application/ports"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/labstack/echo/v4"
+)
+
+// Features は機能フラグを返す。未設定の場合は既定値の機能フラグを返す
+func (deps *ServerDependencies) Features() ports.FeatureFlags {
+	if deps.FeatureFlags == nil {
+		return config.NewFeatureFlags(nil)
+	}
+	return deps.FeatureFlags
+}
+
+// RequireFeature は機能が無効な場合に 404 を返すミドルウェア
+// 無効な機能のエンドポイントは存在しないものとして一律に扱う
+func (deps *ServerDependencies) RequireFeature(name string) echo.MiddlewareFunc {
+	flags := deps.Features()
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !flags.IsEnabled(name) {
+				return echo.NewHTTPError(http.StatusNotFound, "この機能は現在利用できません")
+			}
+			return next(c)
+		}
+	}
+}
+
+// FeaturesHandler はフロントエンドに公開する機能フラグの状態を返す
+// 無効な機能の画面をフロントエンドで非表示にするために使用する（認証不要）
+func FeaturesHandler(flags ports.FeatureFlags) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]any{
+			"features": flags.Public(),
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeaturesEndpoint(t *testing.T) {
+	e, _, _, _, _ := setupTestServer()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/features", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Features map[string]bool `json:"features"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, config.NewFeatureFlags(nil).Public(), response.Features)
+}
+
+func TestRequireFeature(t *testing.T) {
+	deps := &ServerDependencies{
+		FeatureFlags: config.NewFeatureFlags(map[string]bool{
+			config.FeatureReportHistory: true,
+			config.FeatureHouseholdMode: false,
+		}),
+	}
+
+	e := echo.New()
+	handler := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/history", handler, deps.RequireFeature(config.FeatureReportHistory))
+	e.GET("/household", handler, deps.RequireFeature(config.FeatureHouseholdMode))
+	e.POST("/household", handler, deps.RequireFeature(config.FeatureHouseholdMode))
+	e.GET("/unknown", handler, deps.RequireFeature("unknown_feature"))
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected int
+	}{
+		{"有効な機能は処理を続行", http.MethodGet, "/history", http.StatusOK},
+		{"無効な機能は404", http.MethodGet, "/household", http.StatusNotFound},
+		{"無効な機能はメソッドに関わらず404", http.MethodPost, "/household", http.StatusNotFound},
+		{"未知の機能は404", http.MethodGet, "/unknown", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, nil))
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
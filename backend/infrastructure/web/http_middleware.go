@@ -0,0 +1,246 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/labstack/gommon/bytes"
+	"github.com/labstack/gommon/random"
+)
+
+// swaggerPathPrefix は Swagger UI のパス
+const swaggerPathPrefix = "/swagger/"
+
+// DefaultSwaggerContentSecurityPolicy は Swagger UI に適用する CSP
+// Swagger UI は同一オリジンのスクリプト・スタイルに加えてインラインの初期化スクリプトとスタイルを使うため、それらのみを許可する
+const DefaultSwaggerContentSecurityPolicy = "default-src 'self'; script-src 'self' 'unsafe-inline'; style-src 'self' 'unsafe-inline'; " +
+	"img-src 'self' data:; connect-src 'self'; frame-ancestors 'none'; form-action 'self'; base-uri 'self'"
+
+// CORSConfig は CORSMiddleware の設定
+type CORSConfig struct {
+	AllowedOrigins   []string // 許可するオリジン（config.AllowAllOrigins ですべてのオリジンを許可）
+	AllowCredentials bool     // Cookie などの認証情報の送信を許可するか
+	MaxAge           int      // プリフライトリクエストの結果をキャッシュする秒数（0 の場合はヘッダーを返さない）
+}
+
+// Validate は CORS の設定を検証する
+func (c CORSConfig) Validate() error {
+	if len(c.AllowedOrigins) == 0 {
+		return errors.New("CORS の許可オリジンを1つ以上指定する必要があります")
+	}
+	for _, origin := range c.AllowedOrigins {
+		if origin == config.AllowAllOrigins {
+			if c.AllowCredentials {
+				return errors.New("CORS の許可オリジン * は認証情報の送信の許可と併用できません")
+			}
+			continue
+		}
+		u, err := url.Parse(origin)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.Path != "" || u.RawQuery != "" {
+			return fmt.Errorf("CORS の許可オリジンは http(s)://ホスト[:ポート] の形式である必要があります: %q", origin)
+		}
+	}
+	if c.MaxAge < 0 {
+		return fmt.Errorf("CORS の MaxAge は0以上である必要があります: %d", c.MaxAge)
+	}
+	return nil
+}
+
+// CORSMiddleware は許可オリジンからのクロスオリジンリクエストを許可するミドルウェアを作成する
+// 許可していないオリジンには Access-Control-Allow-* ヘッダーを返さない（プリフライトリクエストは 204 のみを返す）
+func CORSMiddleware(cfg CORSConfig) (echo.MiddlewareFunc, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins: cfg.AllowedOrigins,
+		AllowMethods: []string{
+			http.MethodGet,
+			http.MethodPost,
+			http.MethodPut,
+			http.MethodPatch,
+			http.MethodDelete,
+			http.MethodOptions,
+		},
+		AllowHeaders: []string{
+			echo.HeaderOrigin,
+			echo.HeaderContentType,
+			echo.HeaderAccept,
+			echo.HeaderAuthorization,
+			"X-Requested-With",
+			APIVersionHeader,
+			"If-None-Match",
+		},
+		// フロントエンドが条件付きリクエストに使えるようETagを公開する
+		ExposeHeaders:    []string{"ETag"},
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           cfg.MaxAge,
+	}), nil
+}
+
+// SecurityHeadersConfig は SecurityHeadersMiddleware の設定
+type SecurityHeadersConfig struct {
+	FrameOptions                 string // X-Frame-Options（DENY または SAMEORIGIN）
+	ReferrerPolicy               string // Referrer-Policy
+	HSTSMaxAge                   int    // Strict-Transport-Security の max-age（秒）。0 の場合はヘッダーを返さない
+	HSTSPreload                  bool   // Strict-Transport-Security に preload を付けるか
+	ContentSecurityPolicy        string // API のレスポンスの Content-Security-Policy（空の場合はヘッダーを返さない）
+	SwaggerContentSecurityPolicy string // Swagger UI のレスポンスの Content-Security-Policy（空の場合はヘッダーを返さない）
+}
+
+// DefaultSecurityHeadersConfig はデフォルトのセキュリティヘッダーの設定
+var DefaultSecurityHeadersConfig = SecurityHeadersConfig{
+	FrameOptions:                 "DENY",
+	ReferrerPolicy:               "strict-origin-when-cross-origin",
+	HSTSMaxAge:                   31536000,
+	HSTSPreload:                  true,
+	ContentSecurityPolicy:        "default-src 'none'; frame-ancestors 'none'; form-action 'none'",
+	SwaggerContentSecurityPolicy: DefaultSwaggerContentSecurityPolicy,
+}
+
+// Validate はセキュリティヘッダーの設定を検証する
+func (c SecurityHeadersConfig) Validate() error {
+	if c.FrameOptions != "DENY" && c.FrameOptions != "SAMEORIGIN" {
+		return fmt.Errorf("X-Frame-Options は DENY または SAMEORIGIN である必要があります: %q", c.FrameOptions)
+	}
+	if c.ReferrerPolicy == "" {
+		return errors.New("Referrer-Policy は必須です")
+	}
+	if c.HSTSMaxAge < 0 {
+		return fmt.Errorf("HSTS の max-age は0以上である必要があります: %d", c.HSTSMaxAge)
+	}
+	return nil
+}
+
+// SecurityHeadersMiddleware はレスポンスにセキュリティヘッダーを付与するミドルウェアを作成する
+// Strict-Transport-Security は TLS（TLS終端プロキシ経由を含む）のリクエストにのみ付与する
+// Content-Security-Policy は Swagger UI のみ画面の表示に必要な範囲で緩めたポリシーを使う
+func SecurityHeadersMiddleware(cfg SecurityHeadersConfig) (echo.MiddlewareFunc, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	hsts := ""
+	if cfg.HSTSMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(cfg.HSTSMaxAge) + "; includeSubDomains"
+		if cfg.HSTSPreload {
+			hsts += "; preload"
+		}
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			h := c.Response().Header()
+			h.Set(echo.HeaderXContentTypeOptions, "nosniff")
+			h.Set(echo.HeaderXFrameOptions, cfg.FrameOptions)
+			h.Set(echo.HeaderReferrerPolicy, cfg.ReferrerPolicy)
+			if hsts != "" && c.Scheme() == "https" {
+				h.Set(echo.HeaderStrictTransportSecurity, hsts)
+			}
+
+			csp := cfg.ContentSecurityPolicy
+			if strings.HasPrefix(c.Request().URL.Path, swaggerPathPrefix) {
+				csp = cfg.SwaggerContentSecurityPolicy
+			}
+			if csp != "" {
+				h.Set(echo.HeaderContentSecurityPolicy, csp)
+			}
+
+			return next(c)
+		}
+	}, nil
+}
+
+// RequestIDConfig は RequestIDMiddleware の設定
+type RequestIDConfig struct {
+	MaxLength int // 引き継ぐクライアント指定のリクエストIDの最大文字数（超える場合は新しく生成する）
+}
+
+// DefaultRequestIDConfig はデフォルトのリクエストIDの設定
+var DefaultRequestIDConfig = RequestIDConfig{MaxLength: 64}
+
+// Validate はリクエストIDの設定を検証する
+func (c RequestIDConfig) Validate() error {
+	if c.MaxLength <= 0 {
+		return fmt.Errorf("リクエストIDの最大文字数は1以上である必要があります: %d", c.MaxLength)
+	}
+	return nil
+}
+
+// RequestIDMiddleware はリクエストごとに X-Request-ID を付与するミドルウェアを作成する
+// クライアントが指定したIDは英数字・ハイフン・アンダースコア・ピリオドのみで MaxLength 以下の場合に限り引き継ぐ（ログへの不正な値の混入を防ぐ）
+func RequestIDMiddleware(cfg RequestIDConfig) (echo.MiddlewareFunc, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			requestID := c.Request().Header.Get(echo.HeaderXRequestID)
+			if !isValidRequestID(requestID, cfg.MaxLength) {
+				requestID = random.String(32)
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+			return next(c)
+		}
+	}, nil
+}
+
+// isValidRequestID はクライアントが指定したリクエストIDを引き継げるかを返す
+func isValidRequestID(requestID string, maxLength int) bool {
+	if requestID == "" || len(requestID) > maxLength {
+		return false
+	}
+	for _, r := range requestID {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.') {
+			return false
+		}
+	}
+	return true
+}
+
+// BodyLimitConfig は BodyLimitMiddleware の設定（K・M などの単位は1000倍。例: "1K" は1,000バイト）
+type BodyLimitConfig struct {
+	Limit string // リクエストボディの上限（例: "10M"。K・M・G などの単位を指定できる）
+}
+
+// Validate はリクエストボディの上限の設定を検証する
+func (c BodyLimitConfig) Validate() error {
+	limit, err := bytes.Parse(c.Limit)
+	if err != nil || limit <= 0 {
+		return fmt.Errorf("リクエストボディの上限が不正です: %q", c.Limit)
+	}
+	return nil
+}
+
+// BodyLimitMiddleware はリクエストボディが上限を超える場合に 413 を返すミドルウェアを作成する
+func BodyLimitMiddleware(cfg BodyLimitConfig) (echo.MiddlewareFunc, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return middleware.BodyLimit(cfg.Limit), nil
+}
+
+// newCORSConfig はサーバー設定から CORS の設定を作成する
+func newCORSConfig(cfg *config.ServerConfig) CORSConfig {
+	return CORSConfig{
+		AllowedOrigins:   cfg.AllowedOrigins,
+		AllowCredentials: cfg.CORSAllowCredentials,
+		MaxAge:           cfg.CORSMaxAge,
+	}
+}
+
+// newSecurityHeadersConfig はサーバー設定からセキュリティヘッダーの設定を作成する
+func newSecurityHeadersConfig(cfg *config.ServerConfig) SecurityHeadersConfig {
+	headers := DefaultSecurityHeadersConfig
+	headers.ContentSecurityPolicy = cfg.ContentSecurityPolicy
+	return headers
+}
@@ -0,0 +1,352 @@
+package web
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMiddlewareTestEcho は指定したミドルウェアと GET/POST /api/v1/test を登録したEchoを作成するヘルパー
+func newMiddlewareTestEcho(t *testing.T, mw echo.MiddlewareFunc, err error) *echo.Echo {
+	t.Helper()
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.Use(mw)
+	e.GET("/api/v1/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	})
+	e.POST("/api/v1/test", func(c echo.Context) error {
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, string(body))
+	})
+	e.GET("/swagger/*", func(c echo.Context) error {
+		return c.HTML(http.StatusOK, "<html></html>")
+	})
+	return e
+}
+
+func TestCORSConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         CORSConfig
+		expectError string // 空の場合はエラーなし
+	}{
+		{"有効", CORSConfig{AllowedOrigins: []string{"https://example.com"}, AllowCredentials: true, MaxAge: 600}, ""},
+		{"認証情報なしのワイルドカード", CORSConfig{AllowedOrigins: []string{"*"}}, ""},
+		{"認証情報ありのワイルドカード", CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true}, "認証情報"},
+		{"オリジンが空", CORSConfig{}, "1つ以上"},
+		{"スキームがない", CORSConfig{AllowedOrigins: []string{"example.com"}}, "形式"},
+		{"末尾に/がある", CORSConfig{AllowedOrigins: []string{"https://example.com/"}}, "形式"},
+		{"MaxAgeが負の値", CORSConfig{AllowedOrigins: []string{"https://example.com"}, MaxAge: -1}, "MaxAge"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expectError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectError)
+		})
+	}
+}
+
+func TestCORSMiddleware(t *testing.T) {
+	mw, err := CORSMiddleware(CORSConfig{
+		AllowedOrigins:   []string{"https://app.example.com"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	})
+	e := newMiddlewareTestEcho(t, mw, err)
+
+	t.Run("許可オリジンの通常リクエスト", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.Equal(t, "true", rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+		assert.Equal(t, "ETag", rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+		assert.Equal(t, []string{echo.HeaderOrigin}, rec.Header().Values(echo.HeaderVary))
+		assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowMethods))
+	})
+
+	t.Run("許可していないオリジンの通常リクエストはCORSヘッダーを返さない", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://evil.example.com")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+		assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlExposeHeaders))
+	})
+
+	t.Run("許可オリジンのプリフライトリクエスト", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPatch)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.Equal(t, "true", rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+		assert.Equal(t, "GET,POST,PUT,PATCH,DELETE,OPTIONS", rec.Header().Get(echo.HeaderAccessControlAllowMethods))
+		assert.Equal(t, "Origin,Content-Type,Accept,Authorization,X-Requested-With,API-Version,If-None-Match",
+			rec.Header().Get(echo.HeaderAccessControlAllowHeaders))
+		assert.Equal(t, "600", rec.Header().Get(echo.HeaderAccessControlMaxAge))
+		assert.Equal(t, []string{echo.HeaderOrigin, echo.HeaderAccessControlRequestMethod, echo.HeaderAccessControlRequestHeaders},
+			rec.Header().Values(echo.HeaderVary))
+	})
+
+	t.Run("許可していないオリジンのプリフライトリクエストは204のみを返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://evil.example.com")
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodDelete)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		for _, header := range []string{
+			echo.HeaderAccessControlAllowOrigin,
+			echo.HeaderAccessControlAllowCredentials,
+			echo.HeaderAccessControlAllowMethods,
+			echo.HeaderAccessControlAllowHeaders,
+			echo.HeaderAccessControlMaxAge,
+		} {
+			assert.Empty(t, rec.Header().Get(header), header)
+		}
+	})
+
+	t.Run("認証情報を許可しないワイルドカードはすべてのオリジンを許可する", func(t *testing.T) {
+		mw, err := CORSMiddleware(CORSConfig{AllowedOrigins: []string{"*"}})
+		e := newMiddlewareTestEcho(t, mw, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://any.example.com")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, "*", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+	})
+
+	t.Run("設定が不正な場合はエラー", func(t *testing.T) {
+		_, err := CORSMiddleware(CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true})
+		assert.Error(t, err)
+	})
+}
+
+func TestSecurityHeadersMiddleware(t *testing.T) {
+	mw, err := SecurityHeadersMiddleware(DefaultSecurityHeadersConfig)
+	e := newMiddlewareTestEcho(t, mw, err)
+
+	t.Run("HTTPのAPIレスポンス", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, "nosniff", rec.Header().Get(echo.HeaderXContentTypeOptions))
+		assert.Equal(t, "DENY", rec.Header().Get(echo.HeaderXFrameOptions))
+		assert.Equal(t, "strict-origin-when-cross-origin", rec.Header().Get(echo.HeaderReferrerPolicy))
+		assert.Equal(t, "default-src 'none'; frame-ancestors 'none'; form-action 'none'", rec.Header().Get(echo.HeaderContentSecurityPolicy))
+		assert.Empty(t, rec.Header().Get(echo.HeaderStrictTransportSecurity))
+	})
+
+	t.Run("TLS終端プロキシ経由のHTTPSリクエストにはHSTSを付与する", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderXForwardedProto, "https")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, "max-age=31536000; includeSubDomains; preload", rec.Header().Get(echo.HeaderStrictTransportSecurity))
+	})
+
+	t.Run("Swagger UIには緩めたCSPを適用する", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/swagger/index.html", nil)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, DefaultSwaggerContentSecurityPolicy, rec.Header().Get(echo.HeaderContentSecurityPolicy))
+		assert.Equal(t, "DENY", rec.Header().Get(echo.HeaderXFrameOptions))
+	})
+
+	t.Run("CSPが空の場合はヘッダーを返さない", func(t *testing.T) {
+		cfg := DefaultSecurityHeadersConfig
+		cfg.ContentSecurityPolicy = ""
+		cfg.HSTSMaxAge = 0
+		mw, err := SecurityHeadersMiddleware(cfg)
+		e := newMiddlewareTestEcho(t, mw, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderXForwardedProto, "https")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Empty(t, rec.Header().Values(echo.HeaderContentSecurityPolicy))
+		assert.Empty(t, rec.Header().Values(echo.HeaderStrictTransportSecurity))
+	})
+
+	t.Run("X-Frame-Optionsが不正な場合はエラー", func(t *testing.T) {
+		cfg := DefaultSecurityHeadersConfig
+		cfg.FrameOptions = "ALLOW-FROM https://example.com"
+		_, err := SecurityHeadersMiddleware(cfg)
+		assert.Error(t, err)
+	})
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	mw, err := RequestIDMiddleware(DefaultRequestIDConfig)
+	e := newMiddlewareTestEcho(t, mw, err)
+
+	tests := []struct {
+		name      string
+		requestID string
+		keep      bool
+	}{
+		{"指定がない場合は生成する", "", false},
+		{"有効なIDは引き継ぐ", "req-123_abc.DEF", true},
+		{"最大文字数を超えるIDは生成し直す", strings.Repeat("a", 65), false},
+		{"不正な文字を含むIDは生成し直す", "req 123\nfake-log", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
+			if tt.requestID != "" {
+				req.Header.Set(echo.HeaderXRequestID, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(echo.HeaderXRequestID)
+			if tt.keep {
+				assert.Equal(t, tt.requestID, got)
+				return
+			}
+			assert.Len(t, got, 32)
+			assert.NotEqual(t, tt.requestID, got)
+		})
+	}
+
+	t.Run("最大文字数が0の場合はエラー", func(t *testing.T) {
+		_, err := RequestIDMiddleware(RequestIDConfig{})
+		assert.Error(t, err)
+	})
+}
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	mw, err := BodyLimitMiddleware(BodyLimitConfig{Limit: "1K"})
+	e := newMiddlewareTestEcho(t, mw, err)
+
+	t.Run("上限以内のボディは受け付ける", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/test", strings.NewReader(strings.Repeat("a", 1000)))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("上限を超えるボディは413を返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/test", strings.NewReader(strings.Repeat("a", 1001)))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("Content-Lengthを偽った場合も読み込み時に413を返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/test", strings.NewReader(strings.Repeat("a", 2048)))
+		req.ContentLength = -1
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("上限の形式が不正な場合はエラー", func(t *testing.T) {
+		for _, limit := range []string{"", "10X", "0"} {
+			_, err := BodyLimitMiddleware(BodyLimitConfig{Limit: limit})
+			assert.Error(t, err, limit)
+		}
+	})
+}
+
+func TestSetupMiddleware_Order(t *testing.T) {
+	cfg := &config.ServerConfig{
+		AllowedOrigins:        []string{"https://app.example.com"},
+		CORSAllowCredentials:  true,
+		CORSMaxAge:            600,
+		RateLimitRPS:          100,
+		RateLimitBurst:        50,
+		RequestTimeout:        30 * time.Second,
+		MaxRequestSize:        "1K",
+		EnableSecureHeaders:   true,
+		ContentSecurityPolicy: "default-src 'none'",
+	}
+
+	t.Run("プリフライトの応答にもリクエストIDとセキュリティヘッダーを付与する", func(t *testing.T) {
+		e := echo.New()
+		_, err := SetupMiddleware(e, cfg)
+		require.NoError(t, err)
+		e.POST("/api/v1/test", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+		req := httptest.NewRequest(http.MethodOptions, "/api/v1/test", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPost)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.NotEmpty(t, rec.Header().Get(echo.HeaderXRequestID))
+		assert.Equal(t, "nosniff", rec.Header().Get(echo.HeaderXContentTypeOptions))
+		assert.Equal(t, "default-src 'none'", rec.Header().Get(echo.HeaderContentSecurityPolicy))
+	})
+
+	t.Run("サイズ超過で拒否したレスポンスにもCORSヘッダーを付与する", func(t *testing.T) {
+		e := echo.New()
+		_, err := SetupMiddleware(e, cfg)
+		require.NoError(t, err)
+		e.POST("/api/v1/test", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/test", strings.NewReader(strings.Repeat("a", 1001)))
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.NotEmpty(t, rec.Header().Get(echo.HeaderXRequestID))
+	})
+
+	t.Run("設定が不正な場合はエラー", func(t *testing.T) {
+		for name, modify := range map[string]func(cfg *config.ServerConfig){
+			"ワイルドカードと認証情報": func(cfg *config.ServerConfig) { cfg.AllowedOrigins = []string{"*"} },
+			"リクエストサイズの上限":  func(cfg *config.ServerConfig) { cfg.MaxRequestSize = "10X" },
+		} {
+			invalid := *cfg
+			modify(&invalid)
+			_, err := SetupMiddleware(echo.New(), &invalid)
+			assert.Error(t, err, name)
+		}
+	})
+}
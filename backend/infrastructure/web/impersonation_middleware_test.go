@@ -0,0 +1,112 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testImpersonatedUserID = "8d2e4f6a-1b3c-4d5e-9f7a-0b1c2d3e4f5a"
+
+// stubTokenAuthUseCase は固定のクレームを返すテスト用の認証ユースケース
+type stubTokenAuthUseCase struct {
+	usecases.AuthUseCase
+	claims *usecases.TokenClaims
+}
+
+func (s *stubTokenAuthUseCase) VerifyToken(_ context.Context, _ string) (*usecases.TokenClaims, error) {
+	return s.claims, nil
+}
+
+// recordingImpersonationUseCase は記録されたリクエストを保持するテスト用のなりすましユースケース
+type recordingImpersonationUseCase struct {
+	usecases.ImpersonationUseCase
+	recorded  []string
+	recordErr error
+}
+
+func (s *recordingImpersonationUseCase) RecordRequest(_ context.Context, _ *usecases.TokenClaims, method, route string) error {
+	if s.recordErr != nil {
+		return s.recordErr
+	}
+	s.recorded = append(s.recorded, method+" "+route)
+	return nil
+}
+
+func TestJWTAuthMiddlewareWithImpersonation(t *testing.T) {
+	claims := &usecases.TokenClaims{
+		UserID:         testImpersonatedUserID,
+		ImpersonatorID: "6a1f3c2e-8b4d-4e7a-9c5f-0d2b7e9a1c3f",
+		Impersonated:   true,
+	}
+	authStub := &stubTokenAuthUseCase{claims: claims}
+	e := echo.New()
+
+	serve := func(mw echo.MiddlewareFunc, method, path string) (*httptest.ResponseRecorder, echo.Context, error) {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer impersonation-token")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		err := mw(func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		})(c)
+		return rec, c, err
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{"参照系のリクエストは許可", http.MethodGet, "/api/v1/financial-data", http.StatusOK},
+		{"ログアウトは許可", http.MethodPost, "/api/v1/auth/logout", http.StatusOK},
+		{"作成は拒否", http.MethodPost, "/api/v1/goals", http.StatusForbidden},
+		{"更新は拒否", http.MethodPut, "/api/v1/financial-data/profile", http.StatusForbidden},
+		{"削除は拒否", http.MethodDelete, "/api/v1/goals/1", http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			impersonation := &recordingImpersonationUseCase{}
+			rec, c, err := serve(JWTAuthMiddlewareWithImpersonation(authStub, impersonation), tt.method, tt.path)
+
+			status := rec.Code
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+			assert.Equal(t, tt.expectedStatus, status)
+			// 拒否したリクエストも監査ログに残す
+			assert.Equal(t, []string{tt.method + " " + tt.path}, impersonation.recorded)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, testImpersonatedUserID, c.Get("user_id"))
+				assert.Equal(t, claims.ImpersonatorID, c.Get("impersonator_id"))
+			}
+		})
+	}
+
+	t.Run("なりすましに対応しないミドルウェアではトークンを拒否", func(t *testing.T) {
+		_, _, err := serve(JWTAuthMiddleware(authStub), http.MethodGet, "/api/v1/financial-data")
+
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusUnauthorized, httpErr.Code)
+	})
+
+	t.Run("監査ログに記録できない場合は処理しない", func(t *testing.T) {
+		impersonation := &recordingImpersonationUseCase{recordErr: errors.New("db error")}
+		_, _, err := serve(JWTAuthMiddlewareWithImpersonation(authStub, impersonation), http.MethodGet, "/api/v1/financial-data")
+
+		var httpErr *echo.HTTPError
+		require.ErrorAs(t, err, &httpErr)
+		assert.Equal(t, http.StatusInternalServerError, httpErr.Code)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
 	"github.com/labstack/echo/v4"
 )
 
@@ -90,6 +91,13 @@ func checkDatabaseHealth(ctx context.Context, deps *ServerDependencies) Componen
 		}
 	}
 
+	if deps.DBCircuitBreaker != nil && deps.DBCircuitBreaker.State() == database.CircuitStateOpen {
+		return ComponentHealth{
+			Status:  "error",
+			Message: "Database circuit breaker is open",
+		}
+	}
+
 	latency := time.Since(start)
 	return ComponentHealth{
 		Status:  "ok",
@@ -145,11 +153,24 @@ func APIReadinessHandler(deps *ServerDependencies) echo.HandlerFunc {
 			})
 		}
 
-		return c.JSON(http.StatusOK, map[string]interface{}{
+		response := map[string]interface{}{
 			"ready":     true,
 			"message":   "APIは正常に動作しています",
 			"timestamp": time.Now().Format(time.RFC3339),
-		})
+		}
+
+		// データベースのサーキットブレーカーが開いている間はトラフィックを受け付けない
+		if deps.DBCircuitBreaker != nil {
+			state := deps.DBCircuitBreaker.State()
+			response["database_circuit_breaker"] = state
+			if state == database.CircuitStateOpen {
+				response["ready"] = false
+				response["message"] = "データベースが一時的に利用できません"
+				return c.JSON(http.StatusServiceUnavailable, response)
+			}
+		}
+
+		return c.JSON(http.StatusOK, response)
 	}
 }
 
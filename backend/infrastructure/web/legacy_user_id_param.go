@@ -0,0 +1,75 @@
+package web
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
+	"github.com/labstack/echo/v4"
+)
+
+// effectiveLegacyUserIDParamPolicy は LEGACY_USER_ID_PARAM の設定値から適用するポリシーを返す
+// 不正な値の場合は起動時の設定検証で警告済みのため、従来どおり許容する
+func effectiveLegacyUserIDParamPolicy(value string) controllers.LegacyUserIDParamPolicy {
+	policy, err := controllers.ParseLegacyUserIDParamPolicy(value)
+	if err != nil {
+		slog.Warn("LEGACY_USER_ID_PARAM が不正なため allow として扱います", slog.String("value", value))
+		return controllers.LegacyUserIDParamAllow
+	}
+	return policy
+}
+
+// LegacyUserIDParamMiddleware は廃止予定の user_id クエリパラメータの扱い（LEGACY_USER_ID_PARAM）をリクエストに設定するミドルウェア
+func LegacyUserIDParamMiddleware(value string) echo.MiddlewareFunc {
+	policy := effectiveLegacyUserIDParamPolicy(value)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			controllers.SetLegacyUserIDParamPolicy(c, policy)
+			return next(c)
+		}
+	}
+}
+
+// DeprecationMetricsHandler は廃止予定のパラメータのルートごとの使用件数を返す（認証不要）
+// 件数はプロセス起動以降の累計で、user_id パラメータの受け付けを終了できるかの判断に使用する
+func DeprecationMetricsHandler(value string) echo.HandlerFunc {
+	policy := effectiveLegacyUserIDParamPolicy(value)
+
+	return func(c echo.Context) error {
+		usage := controllers.LegacyUserIDParamUsage()
+
+		var total int64
+		for _, route := range usage {
+			total += route.Count
+		}
+
+		return c.JSON(http.StatusOK, map[string]any{
+			"legacy_user_id_param": map[string]any{
+				"policy": policy,
+				"total":  total,
+				"routes": usage,
+			},
+		})
+	}
+}
+
+// warningsJSONSerializer はコントローラーが追加した警告をレスポンスの warnings に含める echo.JSONSerializer
+// 警告がない場合は既定のシリアライザーと同じ出力にする
+type warningsJSONSerializer struct {
+	echo.DefaultJSONSerializer
+}
+
+// Serialize はレスポンスをJSONに変換し、警告があれば warnings フィールドとして追加する
+func (s warningsJSONSerializer) Serialize(c echo.Context, i interface{}, indent string) error {
+	if _, ok := c.Get(controllers.ResponseWarningsKey).([]controllers.ResponseWarning); !ok {
+		return s.DefaultJSONSerializer.Serialize(c, i, indent)
+	}
+
+	body, err := json.Marshal(i)
+	if err != nil {
+		return err
+	}
+	return s.DefaultJSONSerializer.Serialize(c, json.RawMessage(controllers.AppendResponseWarnings(c, body)), indent)
+}
@@ -0,0 +1,271 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	legacyParamTokenUserID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	legacyParamOtherUserID = "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e"
+	// legacyParamTestUserHeader は認証ミドルウェアの代わりに認証済みユーザーを指定するテスト用ヘッダー
+	legacyParamTestUserHeader = "X-Test-User-ID"
+)
+
+// setupLegacyUserIDParamTestServer は LEGACY_USER_ID_PARAM を指定したテストサーバーを作成する
+// 認証ミドルウェアの代わりに、テスト用ヘッダーのユーザーを認証済みユーザーとしてコンテキストに設定する
+func setupLegacyUserIDParamTestServer(policy string) (*echo.Echo, *MockManageFinancialDataUseCase, *MockManageGoalsUseCase, *MockGenerateReportsUseCase) {
+	e := echo.New()
+	e.Validator = NewCustomValidator()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if userID := c.Request().Header.Get(legacyParamTestUserHeader); userID != "" {
+				c.Set("user_id", userID)
+			}
+			return next(c)
+		}
+	})
+
+	mockFinancialUseCase := &MockManageFinancialDataUseCase{}
+	mockGoalsUseCase := &MockManageGoalsUseCase{}
+	mockReportsUseCase := &MockGenerateReportsUseCase{}
+
+	deps := &ServerDependencies{
+		SkipAuth: true,
+		ServerConfig: &config.ServerConfig{
+			AuthRateLimitRPS:   10,
+			AuthRateLimitBurst: 5,
+			LegacyUserIDParam:  policy,
+		},
+	}
+	SetupRoutes(e, &Controllers{
+		FinancialData: controllers.NewFinancialDataController(mockFinancialUseCase),
+		Calculations:  controllers.NewCalculationsController(&MockCalculateProjectionUseCase{}),
+		Goals:         controllers.NewGoalsController(mockGoalsUseCase),
+		Reports:       controllers.NewReportsController(mockReportsUseCase, nil),
+	}, deps, NewCustomRateLimiterStore(100, 50, 3*time.Minute))
+
+	return e, mockFinancialUseCase, mockGoalsUseCase, mockReportsUseCase
+}
+
+// serveLegacyUserIDParamRequest は認証済みユーザー（空の場合は未認証）として GET リクエストを送る
+func serveLegacyUserIDParamRequest(e *echo.Echo, target, tokenUserID string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if tokenUserID != "" {
+		req.Header.Set(legacyParamTestUserHeader, tokenUserID)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+// legacyParamResponseWarnings はレスポンスの warnings を返す（ない場合は nil）
+func legacyParamResponseWarnings(t *testing.T, rec *httptest.ResponseRecorder) []controllers.ResponseWarning {
+	t.Helper()
+	var body struct {
+		Warnings []controllers.ResponseWarning `json:"warnings"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Warnings
+}
+
+// expectHealthScoreTrend は認証トークンのユーザーの財務健全性スコア推移の取得を期待する
+func expectHealthScoreTrend(m *MockGenerateReportsUseCase) {
+	m.On("GetHealthScoreTrend", mock.Anything, usecases.HealthScoreTrendInput{UserID: entities.UserID(legacyParamTokenUserID)}).
+		Return(&usecases.HealthScoreTrendOutput{UserID: entities.UserID(legacyParamTokenUserID), Months: 6, Trend: "stable"}, nil)
+}
+
+// expectFinancialPlan は認証トークンのユーザーの財務データの取得を期待する
+func expectFinancialPlan(m *MockManageFinancialDataUseCase) {
+	m.On("GetFinancialPlan", mock.Anything, usecases.GetFinancialPlanInput{UserID: entities.UserID(legacyParamTokenUserID)}).
+		Return(&usecases.GetFinancialPlanOutput{}, nil)
+}
+
+func TestLegacyUserIDParam_Allow(t *testing.T) {
+	t.Run("正常系: 一致するuser_idは許容し、DeprecationとSunsetヘッダーを返す", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamAllow)
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamTokenUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Regexp(t, `^@\d+$`, rec.Header().Get("Deprecation"))
+		sunset, err := http.ParseTime(rec.Header().Get("Sunset"))
+		require.NoError(t, err)
+		assert.True(t, sunset.After(time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)))
+		assert.Nil(t, legacyParamResponseWarnings(t, rec))
+		reports.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 一致しないuser_idは無視して認証トークンのユーザーを対象にする", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamAllow)
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamOtherUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("Deprecation"))
+		reports.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 設定が空の場合はallowとして扱う", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer("")
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamOtherUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Nil(t, legacyParamResponseWarnings(t, rec))
+	})
+}
+
+func TestLegacyUserIDParam_Warn(t *testing.T) {
+	t.Run("正常系: user_idを許容し、レスポンスのwarningsで警告する", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamWarn)
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamTokenUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("Deprecation"))
+		assert.NotEmpty(t, rec.Header().Get("Sunset"))
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, legacyParamTokenUserID, body["user_id"], "元のレスポンスのフィールドは保たれる")
+		assert.Equal(t, "stable", body["trend"])
+
+		warnings := legacyParamResponseWarnings(t, rec)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, string(controllers.ErrorCodeLegacyUserIDParam), warnings[0].Code)
+		assert.Contains(t, warnings[0].Message, "廃止予定")
+	})
+
+	t.Run("正常系: 一致しないuser_idは無視した旨を警告する", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamWarn)
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamOtherUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		warnings := legacyParamResponseWarnings(t, rec)
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0].Message, "一致しないため無視しました")
+		reports.AssertExpectations(t)
+	})
+
+	t.Run("正常系: ETag付きのレスポンスにもwarningsを含める", func(t *testing.T) {
+		e, financial, _, _ := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamWarn)
+		expectFinancialPlan(financial)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/financial-data?user_id="+legacyParamTokenUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("ETag"))
+		require.Len(t, legacyParamResponseWarnings(t, rec), 1)
+		financial.AssertExpectations(t)
+	})
+
+	t.Run("正常系: user_idを指定しない場合は警告しない", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamWarn)
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend", legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Deprecation"))
+		assert.Empty(t, rec.Header().Get("Sunset"))
+		assert.Nil(t, legacyParamResponseWarnings(t, rec))
+	})
+}
+
+func TestLegacyUserIDParam_Reject(t *testing.T) {
+	t.Run("異常系: user_idを指定した場合は400", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamReject)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamTokenUserID, legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("Deprecation"))
+		assert.NotEmpty(t, rec.Header().Get("Sunset"))
+
+		var response controllers.ErrorResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Contains(t, response.Error, "user_id パラメータは廃止されました")
+		reports.AssertNotCalled(t, "GetHealthScoreTrend", mock.Anything, mock.Anything)
+	})
+
+	t.Run("正常系: user_idを指定しない場合は認証トークンのユーザーを対象にする", func(t *testing.T) {
+		e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamReject)
+		expectHealthScoreTrend(reports)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend", legacyParamTokenUserID)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		reports.AssertExpectations(t)
+	})
+
+	t.Run("正常系: 未認証のゲストモードのルートではuser_idを使用する", func(t *testing.T) {
+		e, _, goals, _ := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamReject)
+		goals.On("GetPrioritizedGoals", mock.Anything, usecases.GetPrioritizedGoalsInput{UserID: entities.UserID(legacyParamOtherUserID)}).
+			Return(&usecases.GetPrioritizedGoalsOutput{}, nil)
+
+		rec := serveLegacyUserIDParamRequest(e, "/api/v1/goals/prioritized?user_id="+legacyParamOtherUserID, "")
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Deprecation"))
+		goals.AssertExpectations(t)
+	})
+}
+
+func TestDeprecationMetricsHandler(t *testing.T) {
+	e, _, _, reports := setupLegacyUserIDParamTestServer(config.LegacyUserIDParamWarn)
+	expectHealthScoreTrend(reports)
+
+	routeCount := func() int64 {
+		for _, usage := range controllers.LegacyUserIDParamUsage() {
+			if usage.Method == http.MethodGet && usage.Route == "/api/v1/reports/health-score-trend" {
+				return usage.Count
+			}
+		}
+		return 0
+	}
+	before := routeCount()
+
+	serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamTokenUserID, legacyParamTokenUserID)
+	serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend?user_id="+legacyParamTokenUserID, legacyParamTokenUserID)
+	serveLegacyUserIDParamRequest(e, "/api/v1/reports/health-score-trend", legacyParamTokenUserID)
+
+	assert.Equal(t, before+2, routeCount(), "user_id を指定したリクエストのみ数える")
+
+	rec := serveLegacyUserIDParamRequest(e, "/api/v1/metrics/deprecations", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		LegacyUserIDParam struct {
+			Policy string                                    `json:"policy"`
+			Total  int64                                     `json:"total"`
+			Routes []controllers.LegacyUserIDParamRouteUsage `json:"routes"`
+		} `json:"legacy_user_id_param"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, config.LegacyUserIDParamWarn, response.LegacyUserIDParam.Policy)
+	assert.GreaterOrEqual(t, response.LegacyUserIDParam.Total, before+2)
+	assert.Contains(t, response.LegacyUserIDParam.Routes, controllers.LegacyUserIDParamRouteUsage{
+		Method: http.MethodGet,
+		Route:  "/api/v1/reports/health-score-trend",
+		Count:  before + 2,
+	})
+}
@@ -9,18 +9,44 @@ import (
 	"time"
 
 	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/infrastructure/i18n"
 	"github.com/financial-planning-calculator/backend/infrastructure/log"
 	"github.com/financial-planning-calculator/backend/infrastructure/monitoring"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 // botMessagesPath はBot SSEエンドポイントのパス
-const botMessagesPath = "/api/bot/messages"
+const botMessagesPath = APIV1Path + "/bot/messages"
 
 // SetupMiddleware configures all middleware for the Echo server.
 // Returns the CustomRateLimiterStore so it can be reused for the status endpoint.
-func SetupMiddleware(e *echo.Echo, cfg *config.ServerConfig) *CustomRateLimiterStore {
+//
+// ミドルウェアは次の順に登録する（前のものほど外側で実行される）:
+//
+//  1. 監視（New Relic）・リクエストログ・パニックからの復旧
+//  2. リクエストID（以降で拒否されたレスポンスやログにもIDを付けるため）
+//  3. セキュリティヘッダー（プリフライトや 413・429 のレスポンスにも付与するため）
+//  4. CORS（プリフライトリクエストはここで応答する）
+//  5. リクエストサイズ制限・旧バージョンのリクエストボディの変換
+//  6. レートリミット・タイムアウト・Gzip圧縮
+//
+// 各ミドルウェアの設定が不正な場合はエラーを返す
+func SetupMiddleware(e *echo.Echo, cfg *config.ServerConfig) (*CustomRateLimiterStore, error) {
+	requestID, err := RequestIDMiddleware(DefaultRequestIDConfig)
+	if err != nil {
+		return nil, fmt.Errorf("リクエストIDの設定が不正です: %w", err)
+	}
+	cors, err := CORSMiddleware(newCORSConfig(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("CORSの設定が不正です: %w", err)
+	}
+	bodyLimit, err := BodyLimitMiddleware(BodyLimitConfig{Limit: cfg.MaxRequestSize})
+	if err != nil {
+		return nil, fmt.Errorf("リクエストサイズ制限の設定が不正です: %w", err)
+	}
+
 	// パフォーマンス監視ミドルウェア（New Relic APM）
 	e.Use(monitoring.NewRelicMiddleware())
 
@@ -30,45 +56,29 @@ func SetupMiddleware(e *echo.Echo, cfg *config.ServerConfig) *CustomRateLimiterS
 	// リカバリーミドルウェア - パニック時の復旧とエラー追跡
 	e.Use(RecoveryMiddlewareWithErrorTracking())
 
-	// CORS設定 - フロントエンドからのアクセス許可
-	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: cfg.AllowedOrigins,
-		AllowMethods: []string{
-			http.MethodGet,
-			http.MethodPost,
-			http.MethodPut,
-			http.MethodDelete,
-			http.MethodOptions,
-		},
-		AllowHeaders: []string{
-			echo.HeaderOrigin,
-			echo.HeaderContentType,
-			echo.HeaderAccept,
-			echo.HeaderAuthorization,
-			"X-Requested-With",
-		},
-		AllowCredentials: true,
-		MaxAge:           cfg.CORSMaxAge,
-	}))
+	// リクエストID生成
+	e.Use(requestID)
 
-	// セキュリティヘッダー（本番環境: ENABLE_SECURE_HEADERS=true / 開発環境: ENABLE_SECURE_HEADERS=false）
-	// 開発環境では Swagger UI が CSP の制約で動作しなくなるため無効化する
+	// セキュリティヘッダー（ENABLE_SECURE_HEADERS=false で無効化できる）
+	// Swagger UI には表示に必要な範囲で緩めた CSP を適用する
 	if cfg.EnableSecureHeaders {
-		e.Use(middleware.SecureWithConfig(middleware.SecureConfig{
-			XSSProtection:         "1; mode=block",
-			ContentTypeNosniff:    "nosniff",
-			XFrameOptions:         "DENY",
-			HSTSMaxAge:            31536000,
-			HSTSExcludeSubdomains: false,
-			HSTSPreloadEnabled:    true,
-			ContentSecurityPolicy: cfg.ContentSecurityPolicy,
-		}))
+		securityHeaders, err := SecurityHeadersMiddleware(newSecurityHeadersConfig(cfg))
+		if err != nil {
+			return nil, fmt.Errorf("セキュリティヘッダーの設定が不正です: %w", err)
+		}
+		e.Use(securityHeaders)
 	}
 
+	// CORS設定 - フロントエンドからのアクセス許可
+	e.Use(cors)
+
 	// リクエストサイズ制限
-	e.Use(middleware.BodyLimit(cfg.MaxRequestSize))
+	e.Use(bodyLimit)
 
-	// Rate limiting - per-IP API request throttling (custom store for /api/rate-limit/status)
+	// 旧バージョンのリクエストボディを現在のスキーマに変換（API-Version ヘッダー指定時）
+	e.Use(RequestMigrator(cfg.APIVersion))
+
+	// Rate limiting - per-IP API request throttling (custom store for /api/v1/rate-limit/status)
 	extractIdentifier := newIdentifierExtractor(cfg.TrustedProxyCount)
 	rateLimitStore := NewCustomRateLimiterStore(
 		float64(cfg.RateLimitRPS),
@@ -81,7 +91,7 @@ func SetupMiddleware(e *echo.Echo, cfg *config.ServerConfig) *CustomRateLimiterS
 		Skipper: func(c echo.Context) bool {
 			// ヘルスチェック・メトリクスはレートリミット対象外
 			path := c.Path()
-			return path == "/health" || path == "/health/detailed" || path == "/ready" || path == "/metrics"
+			return path == "/health" || path == "/health/detailed" || path == "/health/ready" || path == "/ready" || path == "/metrics"
 		},
 		ErrorHandler: func(c echo.Context, err error) error {
 			return c.JSON(http.StatusTooManyRequests, map[string]any{
@@ -116,9 +126,6 @@ func SetupMiddleware(e *echo.Echo, cfg *config.ServerConfig) *CustomRateLimiterS
 		},
 	}))
 
-	// リクエストID生成
-	e.Use(middleware.RequestID())
-
 	// Gzip圧縮（SSEエンドポイントは除外）
 	if cfg.EnableGzip {
 		e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
@@ -129,7 +136,7 @@ func SetupMiddleware(e *echo.Echo, cfg *config.ServerConfig) *CustomRateLimiterS
 		}))
 	}
 
-	return rateLimitStore
+	return rateLimitStore, nil
 }
 
 // newIdentifierExtractor returns an IdentifierExtractor that resolves the client IP
@@ -229,6 +236,63 @@ func AuthRateLimiterMiddleware(cfg *config.ServerConfig) echo.MiddlewareFunc {
 	}
 }
 
+// adviceRateLimitPerHour は財務アドバイス相談の1ユーザーあたりの1時間の上限件数
+const adviceRateLimitPerHour = 10
+
+// rateLimitCounter はレートリミットのカウンター（CustomRateLimiterStore が満たす）
+type rateLimitCounter interface {
+	Allow(identifier string) (bool, error)
+	GetInfo(identifier string) RateLimitInfo
+}
+
+// AdviceRateLimiterMiddleware は財務アドバイス相談を認証済みユーザーごとに1時間10件までに制限する
+// LLM APIの利用料金を抑えるため、IPではなくユーザー単位で数える
+func AdviceRateLimiterMiddleware() echo.MiddlewareFunc {
+	return userRateLimiterMiddleware(
+		NewCustomRateLimiterStore(0, adviceRateLimitPerHour, time.Hour),
+		"advice",
+		"財務アドバイスの相談は1時間に10件までです。しばらくしてから再度お試しください。",
+	)
+}
+
+// userRateLimiterMiddleware は認証済みユーザーごとにリクエスト数を制限するミドルウェアを作成する
+// scope は他のレートリミットとカウンターを分けるための識別子の接頭辞
+// 認証情報がない場合は認証ミドルウェアに判断を委ねて制限しない
+func userRateLimiterMiddleware(store rateLimitCounter, scope, message string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, _ := c.Get("user_id").(string)
+			if userID == "" {
+				return next(c)
+			}
+			identifier := scope + ":user:" + userID
+
+			allowed, _ := store.Allow(identifier)
+			info := store.GetInfo(identifier)
+			if !allowed {
+				retryAfterSec := info.Reset - time.Now().Unix()
+				if retryAfterSec < 0 {
+					retryAfterSec = 0
+				}
+				c.Response().Header().Set("Retry-After", fmt.Sprintf("%d", retryAfterSec))
+				return c.JSON(http.StatusTooManyRequests, map[string]any{
+					"error":       "Too Many Requests",
+					"message":     message,
+					"code":        "USER_RATE_LIMIT_EXCEEDED",
+					"retry_after": fmt.Sprintf("%ds", retryAfterSec),
+				})
+			}
+
+			h := c.Response().Header()
+			h.Set("X-User-RateLimit-Limit", fmt.Sprintf("%d", info.Limit))
+			h.Set("X-User-RateLimit-Remaining", fmt.Sprintf("%d", info.Remaining))
+			h.Set("X-User-RateLimit-Reset", fmt.Sprintf("%d", info.Reset))
+
+			return next(c)
+		}
+	}
+}
+
 // CustomHTTPErrorHandler provides consistent error responses using our unified error format
 func CustomHTTPErrorHandler(err error, c echo.Context) {
 	var (
@@ -238,6 +302,8 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 
 	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
 	ctx := log.WithRequestID(c.Request().Context(), requestID)
+	// エラーメッセージは Accept-Language に応じた言語で返す（未対応の言語は日本語）
+	lang := controllers.RequestLanguage(c)
 
 	if he, ok := err.(*echo.HTTPError); ok {
 		code = he.Code
@@ -253,7 +319,8 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 			)
 
 			if !c.Response().Committed {
-				err = c.JSON(code, validationErr)
+				c.Response().Header().Set("Content-Language", string(lang))
+				err = c.JSON(code, validationErr.Localize(lang))
 				if err != nil {
 					log.Error(ctx, "レスポンス送信エラー", err)
 				}
@@ -276,8 +343,9 @@ func CustomHTTPErrorHandler(err error, c echo.Context) {
 		if c.Request().Method == http.MethodHead {
 			err = c.NoContent(code)
 		} else {
+			c.Response().Header().Set("Content-Language", string(lang))
 			errorResponse := map[string]any{
-				"error":      getErrorMessageFromStatus(code),
+				"error":      i18n.Message(lang, getErrorCodeFromStatus(code)),
 				"details":    msg,
 				"timestamp":  time.Now().UTC().Format(time.RFC3339),
 				"request_id": requestID,
@@ -319,34 +387,6 @@ func getErrorCodeFromStatus(status int) string {
 	}
 }
 
-// getErrorMessageFromStatus returns appropriate error message based on HTTP status
-func getErrorMessageFromStatus(status int) string {
-	switch status {
-	case http.StatusBadRequest:
-		return "リクエストが無効です"
-	case http.StatusUnauthorized:
-		return "認証が必要です"
-	case http.StatusForbidden:
-		return "アクセスが拒否されました"
-	case http.StatusNotFound:
-		return "リソースが見つかりません"
-	case http.StatusConflict:
-		return "リソースが競合しています"
-	case http.StatusTooManyRequests:
-		return "リクエスト数が上限を超えています"
-	case http.StatusInternalServerError:
-		return "内部サーバーエラーが発生しました"
-	case http.StatusServiceUnavailable:
-		return "サービスが利用できません"
-	case http.StatusRequestTimeout:
-		return "リクエストがタイムアウトしました"
-	case http.StatusUnprocessableEntity:
-		return "入力データを処理できません"
-	default:
-		return "エラーが発生しました"
-	}
-}
-
 // SlogRequestLogger は slog を使った構造化リクエストロガーミドルウェアを返します。
 // Echo 標準の LoggerMiddleware の代わりに使用し、JSON 構造化ログで統一します。
 func SlogRequestLogger() echo.MiddlewareFunc {
@@ -261,3 +261,139 @@ func TestSetupMiddleware_DenyHandler_RetryAfterIsDynamic(t *testing.T) {
 	assert.GreaterOrEqual(t, secs, 0)
 	assert.LessOrEqual(t, secs, 180)
 }
+
+// fakeRateLimitCounter はメモリ上で件数を数えるrateLimitCounter（Redisなしでユーザー単位の制限を検証する）
+type fakeRateLimitCounter struct {
+	limit  int
+	counts map[string]int
+}
+
+func (f *fakeRateLimitCounter) Allow(identifier string) (bool, error) {
+	f.counts[identifier]++
+	return f.counts[identifier] <= f.limit, nil
+}
+
+func (f *fakeRateLimitCounter) GetInfo(identifier string) RateLimitInfo {
+	remaining := f.limit - f.counts[identifier]
+	if remaining < 0 {
+		remaining = 0
+	}
+	reset := time.Now().Add(time.Hour)
+	return RateLimitInfo{Limit: f.limit, Remaining: remaining, Reset: reset.Unix(), ResetAt: reset}
+}
+
+func TestUserRateLimiterMiddleware(t *testing.T) {
+	counter := &fakeRateLimitCounter{limit: adviceRateLimitPerHour, counts: map[string]int{}}
+	e := echo.New()
+	e.POST("/api/v1/advice/chat", func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	}, func(next echo.HandlerFunc) echo.HandlerFunc {
+		// 認証ミドルウェアの代わりにヘッダーのユーザーIDをコンテキストに設定する
+		return func(c echo.Context) error {
+			if userID := c.Request().Header.Get("X-Test-User"); userID != "" {
+				c.Set("user_id", userID)
+			}
+			return next(c)
+		}
+	}, userRateLimiterMiddleware(counter, "advice", "財務アドバイスの相談は1時間に10件までです。"))
+
+	send := func(userID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/advice/chat", nil)
+		if userID != "" {
+			req.Header.Set("X-Test-User", userID)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("同じユーザーは1時間に10件まで", func(t *testing.T) {
+		for i := 1; i <= adviceRateLimitPerHour; i++ {
+			rec := send("user-a")
+			assert.Equal(t, http.StatusOK, rec.Code, "%d件目", i)
+			assert.Equal(t, strconv.Itoa(adviceRateLimitPerHour-i), rec.Header().Get("X-User-RateLimit-Remaining"))
+		}
+
+		rec := send("user-a")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "USER_RATE_LIMIT_EXCEEDED", body["code"])
+	})
+
+	t.Run("ユーザーごとに別々に数える", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, send("user-b").Code)
+		assert.Equal(t, 1, counter.counts["advice:user:user-b"])
+	})
+
+	t.Run("認証情報がない場合は制限しない", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, send("").Code)
+		assert.NotContains(t, counter.counts, "advice:user:")
+	})
+}
+
+func TestCustomHTTPErrorHandler_AcceptLanguage(t *testing.T) {
+	newErrorServer := func() *echo.Echo {
+		e := echo.New()
+		e.Validator = NewCustomValidator()
+		e.HTTPErrorHandler = CustomHTTPErrorHandler
+		e.GET("/unauthorized", func(c echo.Context) error {
+			return echo.NewHTTPError(http.StatusUnauthorized, "認証トークンが必要です")
+		})
+		e.POST("/validate", func(c echo.Context) error {
+			var req struct {
+				Title string `json:"title" validate:"required"`
+			}
+			return c.Validate(&req)
+		})
+		return e
+	}
+
+	tests := []struct {
+		name            string
+		acceptLanguage  string
+		expectedMessage string
+		expectedLang    string
+	}{
+		{"英語", "en-US,en;q=0.9", "Authentication required", "en"},
+		{"日本語", "ja", "認証が必要です", "ja"},
+		{"未指定の場合は日本語", "", "認証が必要です", "ja"},
+		{"未対応の言語は日本語にフォールバック", "fr-FR", "認証が必要です", "ja"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/unauthorized", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+			newErrorServer().ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+			assert.Equal(t, tt.expectedLang, rec.Header().Get("Content-Language"))
+
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, tt.expectedMessage, body["error"])
+			assert.Equal(t, "UNAUTHORIZED", body["code"])
+		})
+	}
+
+	t.Run("バリデーションエラーも指定した言語で返す", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(`{}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("Accept-Language", "en")
+		rec := httptest.NewRecorder()
+		newErrorServer().ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		var body ValidationErrorResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "Invalid input", body.Error)
+		if assert.Len(t, body.Details, 1) {
+			assert.Equal(t, "title is required", body.Details[0].Message)
+		}
+	})
+}
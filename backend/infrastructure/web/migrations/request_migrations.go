@@ -0,0 +1,86 @@
+// Package migrations は旧バージョンのAPIリクエストボディを現在のスキーマに変換する規則を定義する
+// リクエストの必須項目を追加しても、API-Version ヘッダーで旧バージョンを指定した既存のクライアントは
+// 変換後のボディで処理されるため 400 エラーにならない
+package migrations
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	// V1 は investment_return が任意だった最初のスキーマ
+	V1 = "v1"
+	// V2 は財務データ作成で investment_return を必須にしたスキーマ
+	V2 = "v2"
+	// LatestVersion は現在のスキーマのバージョン
+	LatestVersion = V2
+)
+
+// RequestMigration はあるバージョンのリクエストボディを次のバージョンのスキーマに変換する規則
+type RequestMigration interface {
+	// FromVersion は変換元のバージョンを返す
+	FromVersion() string
+	// ToVersion は変換先のバージョンを返す
+	ToVersion() string
+	// Applies は変換の対象となるリクエストかを返す
+	Applies(method, path string) bool
+	// Migrate は JSON オブジェクトのリクエストボディを変換する
+	Migrate(body map[string]any)
+}
+
+// requestMigrations は登録済みの変換規則（古いバージョンから順に並べる）
+var requestMigrations = []RequestMigration{
+	V1ToV2Migration{},
+}
+
+// Chain は from のバージョンのリクエストを to のバージョンに変換する規則を適用順に返す
+// from と to が同じ場合は空のスライスを返す。変換経路がない場合はエラーを返す
+func Chain(from, to string) ([]RequestMigration, error) {
+	var chain []RequestMigration
+	version := from
+	for version != to {
+		next := findMigrationFrom(version)
+		if next == nil {
+			return nil, fmt.Errorf("APIバージョン %s から %s への変換はサポートされていません", from, to)
+		}
+		chain = append(chain, next)
+		version = next.ToVersion()
+	}
+	return chain, nil
+}
+
+// findMigrationFrom は version を変換元とする規則を返す（ない場合は nil）
+func findMigrationFrom(version string) RequestMigration {
+	for _, migration := range requestMigrations {
+		if migration.FromVersion() == version {
+			return migration
+		}
+	}
+	return nil
+}
+
+// defaultInvestmentReturn は v1 で investment_return を省略した場合に補う想定利回り（年率%）
+const defaultInvestmentReturn = 5.0
+
+// V1ToV2Migration は v1 の財務データ作成リクエストを v2 に変換する
+// v2 で必須になった investment_return が省略されている場合は 5.0 を補う
+type V1ToV2Migration struct{}
+
+// FromVersion は変換元のバージョンを返す
+func (V1ToV2Migration) FromVersion() string { return V1 }
+
+// ToVersion は変換先のバージョンを返す
+func (V1ToV2Migration) ToVersion() string { return V2 }
+
+// Applies は財務データ作成（POST /api/v1/financial-data）のリクエストかを返す
+func (V1ToV2Migration) Applies(method, path string) bool {
+	return method == http.MethodPost && (path == "/api/v1/financial-data" || path == "/api/v1/financial-data/")
+}
+
+// Migrate は investment_return が省略されている（または null の）場合に既定値を補う
+func (V1ToV2Migration) Migrate(body map[string]any) {
+	if value, ok := body["investment_return"]; !ok || value == nil {
+		body["investment_return"] = defaultInvestmentReturn
+	}
+}
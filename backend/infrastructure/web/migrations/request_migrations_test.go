@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	t.Run("v1 から v2 への変換規則を返す", func(t *testing.T) {
+		chain, err := Chain(V1, V2)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if len(chain) != 1 {
+			t.Fatalf("Expected 1 migration, got %d", len(chain))
+		}
+		if _, ok := chain[0].(V1ToV2Migration); !ok {
+			t.Errorf("Expected V1ToV2Migration, got %T", chain[0])
+		}
+	})
+
+	t.Run("同じバージョンの場合は変換しない", func(t *testing.T) {
+		chain, err := Chain(LatestVersion, LatestVersion)
+		if err != nil || len(chain) != 0 {
+			t.Errorf("Expected empty chain, got %v, %v", chain, err)
+		}
+	})
+
+	t.Run("未知のバージョンはエラー", func(t *testing.T) {
+		if _, err := Chain("v0", LatestVersion); err == nil {
+			t.Error("Expected error for unknown version")
+		}
+		if _, err := Chain(V2, V1); err == nil {
+			t.Error("Expected error for downgrade")
+		}
+	})
+}
+
+func TestV1ToV2Migration(t *testing.T) {
+	migration := V1ToV2Migration{}
+
+	if !migration.Applies(http.MethodPost, "/api/v1/financial-data") {
+		t.Error("Expected migration to apply to financial data creation")
+	}
+	if migration.Applies(http.MethodGet, "/api/v1/financial-data") || migration.Applies(http.MethodPost, "/api/v1/goals") {
+		t.Error("Expected migration not to apply to other endpoints")
+	}
+
+	tests := []struct {
+		name     string
+		body     map[string]any
+		expected any
+	}{
+		{"省略時は既定値を補う", map[string]any{"user_id": "user-001"}, defaultInvestmentReturn},
+		{"null の場合は既定値を補う", map[string]any{"investment_return": nil}, defaultInvestmentReturn},
+		{"指定済みの値は変更しない", map[string]any{"investment_return": 3.0}, 3.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migration.Migrate(tt.body)
+			if tt.body["investment_return"] != tt.expected {
+				t.Errorf("Expected investment_return %v, got %v", tt.expected, tt.body["investment_return"])
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/financial-planning-calculator/backend/infrastructure/log"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/migrations"
+	"github.com/labstack/echo/v4"
+)
+
+// APIVersionHeader はクライアントが送信するリクエストスキーマのバージョンを指定するヘッダー名
+const APIVersionHeader = "API-Version"
+
+// RequestMigrator は API-Version ヘッダーで旧バージョンを指定したリクエストのボディを
+// currentVersion のスキーマに変換してからコントローラーに渡すミドルウェア
+// ヘッダーがない場合は現在のバージョンとして扱い、変換しない
+func RequestMigrator(currentVersion string) echo.MiddlewareFunc {
+	if currentVersion == "" {
+		currentVersion = migrations.LatestVersion
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			version := c.Request().Header.Get(APIVersionHeader)
+			if version == "" || version == currentVersion {
+				return next(c)
+			}
+
+			chain, err := migrations.Chain(version, currentVersion)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "サポートされていないAPIバージョンです: "+version)
+			}
+
+			req := c.Request()
+			var applicable []migrations.RequestMigration
+			for _, migration := range chain {
+				if migration.Applies(req.Method, req.URL.Path) {
+					applicable = append(applicable, migration)
+				}
+			}
+			if len(applicable) == 0 || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+				return next(c)
+			}
+
+			if err := migrateRequestBody(req, applicable); err != nil {
+				return err
+			}
+			log.WithContext(req.Context()).Debug("リクエストボディを現在のスキーマに変換しました",
+				slog.String("api_version", version),
+				slog.String("current_version", currentVersion),
+				slog.String("path", req.URL.Path),
+			)
+			return next(c)
+		}
+	}
+}
+
+// migrateRequestBody はリクエストボディに変換規則を順に適用して置き換える
+// ボディが JSON オブジェクトでない場合は変換せずに元のボディを戻し、検証はコントローラーに任せる
+func migrateRequestBody(req *http.Request, chain []migrations.RequestMigration) error {
+	if req.Body == nil {
+		return nil
+	}
+	original, err := io.ReadAll(req.Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "リクエストボディの読み込みに失敗しました")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(original))
+	decoder.UseNumber()
+	var body map[string]any
+	if err := decoder.Decode(&body); err != nil || body == nil {
+		req.Body = io.NopCloser(bytes.NewReader(original))
+		return nil
+	}
+
+	for _, migration := range chain {
+		migration.Migrate(body)
+	}
+
+	migrated, err := json.Marshal(body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "リクエストボディの変換に失敗しました")
+	}
+	req.Body = io.NopCloser(bytes.NewReader(migrated))
+	req.ContentLength = int64(len(migrated))
+	return nil
+}
@@ -0,0 +1,86 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newCreateFinancialDataRequest は investment_return を省略した財務データ作成リクエストを作成する
+func newCreateFinancialDataRequest(t *testing.T, apiVersion string) *http.Request {
+	t.Helper()
+	body, err := json.Marshal(map[string]interface{}{
+		"user_id":        "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+		"monthly_income": 400000,
+		"monthly_expenses": []map[string]interface{}{
+			{"category": "住居費", "amount": 120000},
+		},
+		"current_savings": []map[string]interface{}{
+			{"type": "deposit", "amount": 1000000},
+		},
+		"inflation_rate": 2.0,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/financial-data", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if apiVersion != "" {
+		req.Header.Set(APIVersionHeader, apiVersion)
+	}
+	return req
+}
+
+func TestRequestMigrator(t *testing.T) {
+	t.Run("v1 のリクエストは investment_return を補って処理する", func(t *testing.T) {
+		e, mockFinancialUseCase, _, _, _ := setupTestServer()
+		e.Use(RequestMigrator("v2"))
+
+		var received usecases.CreateFinancialPlanInput
+		mockFinancialUseCase.On("CreateFinancialPlan", mock.Anything, mock.AnythingOfType("usecases.CreateFinancialPlanInput")).
+			Run(func(args mock.Arguments) {
+				received = args.Get(1).(usecases.CreateFinancialPlanInput)
+			}).
+			Return(&usecases.CreateFinancialPlanOutput{PlanID: "plan-123", UserID: "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"}, nil)
+		mockFinancialUseCase.On("GetFinancialPlan", mock.Anything, mock.AnythingOfType("usecases.GetFinancialPlanInput")).
+			Return(&usecases.GetFinancialPlanOutput{Plan: nil}, nil).Maybe()
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, newCreateFinancialDataRequest(t, "v1"))
+
+		assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		assert.Equal(t, 5.0, received.InvestmentReturn)
+		assert.Equal(t, 2.0, received.InflationRate)
+		mockFinancialUseCase.AssertExpectations(t)
+	})
+
+	t.Run("v2 のリクエストで investment_return がない場合は 400", func(t *testing.T) {
+		e, mockFinancialUseCase, _, _, _ := setupTestServer()
+		e.Use(RequestMigrator("v2"))
+
+		for _, apiVersion := range []string{"v2", ""} {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, newCreateFinancialDataRequest(t, apiVersion))
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, "API-Version: %q", apiVersion)
+		}
+		mockFinancialUseCase.AssertNotCalled(t, "CreateFinancialPlan", mock.Anything, mock.Anything)
+	})
+
+	t.Run("未知のバージョンは 400", func(t *testing.T) {
+		e, _, _, _, _ := setupTestServer()
+		e.Use(RequestMigrator("v2"))
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, newCreateFinancialDataRequest(t, "v0"))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
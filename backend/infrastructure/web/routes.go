@@ -11,15 +11,26 @@ import (
 
 // Controllers holds all controller instances
 type Controllers struct {
-	Auth             *controllers.AuthController
-	TwoFactor        *controllers.TwoFactorController
-	WebAuthn         *controllers.WebAuthnController
-	FinancialData    *controllers.FinancialDataController
-	CSVFinancialData *controllers.CSVFinancialDataController
-	Calculations     *controllers.CalculationsController
-	Goals            *controllers.GoalsController
-	Reports          *controllers.ReportsController
-	Bot              *controllers.BotController
+	Auth              *controllers.AuthController
+	TwoFactor         *controllers.TwoFactorController
+	WebAuthn          *controllers.WebAuthnController
+	FinancialData     *controllers.FinancialDataController
+	CSVFinancialData  *controllers.CSVFinancialDataController
+	Calculations      *controllers.CalculationsController
+	Goals             *controllers.GoalsController
+	Reports           *controllers.ReportsController
+	Bot               *controllers.BotController
+	Advice            *controllers.AdviceController
+	SavingsRateTarget *controllers.SavingsRateTargetController
+	Liabilities       *controllers.LiabilitiesController
+	LifeEvents        *controllers.LifeEventsController
+	Notifications     *controllers.NotificationPreferencesController
+	Recommendations   *controllers.RecommendationsController
+	APIKeys           *controllers.APIKeyController
+	Users             *controllers.UserManagementController
+	UserDataBackup    *controllers.UserDataBackupController
+	PeerBenchmark     *controllers.PeerBenchmarkController
+	Admin             *controllers.AdminController
 }
 
 // SetupRoutes configures all routes based on OpenAPI specification
@@ -29,21 +40,39 @@ func SetupRoutes(e *echo.Echo, controllers *Controllers, deps *ServerDependencie
 
 	// New Relic はプッシュ型のためメトリクスエンドポイントは不要
 
+	// コントローラーが追加した警告（廃止予定パラメータなど）をレスポンスの warnings に含める
+	e.JSONSerializer = warningsJSONSerializer{}
+
 	// ヘルスチェック
 	e.GET("/health", HealthCheckHandler)
 	e.GET("/health/detailed", IntegrationHealthCheckHandler(deps))
 	e.GET("/ready", APIReadinessHandler(deps))
+	e.GET("/health/ready", APIReadinessHandler(deps))
 
 	// CORS preflight
 	e.OPTIONS("/*", CORSPreflightHandler)
 
+	// アップロードされた目標の画像（LocalFileStorage の保存先）
+	if deps.ServerConfig.UploadDir != "" {
+		e.Static("/uploads", deps.ServerConfig.UploadDir)
+	}
+
+	// バージョンなしの /api 以下は移行期間中のみ現在のバージョンへ転送する
+	if deps.ServerConfig.LegacyRedirectEnabled {
+		e.Pre(LegacyAPIRedirectMiddleware())
+	}
+
+	// サポートしているAPIのバージョン（バージョンに依存しないため /api 直下）
+	e.GET(APIVersionsPath, APIVersionsHandler)
+
 	// API ルートグループ
-	api := e.Group("/api")
+	api := e.Group(APIV1Path)
 
 	// Apply integration middleware
 	api.Use(ErrorRecoveryMiddleware)
 	api.Use(RequestValidationMiddleware)
 	api.Use(ResponseEnhancementMiddleware)
+	api.Use(LegacyUserIDParamMiddleware(deps.ServerConfig.LegacyUserIDParam))
 
 	// API情報エンドポイント
 	api.GET("/", APIInfoHandler)
@@ -52,6 +81,13 @@ func SetupRoutes(e *echo.Echo, controllers *Controllers, deps *ServerDependencie
 	api.GET("/health", HealthCheckHandler)
 	api.GET("/health/detailed", IntegrationHealthCheckHandler(deps))
 	api.GET("/ready", APIReadinessHandler(deps))
+	api.GET("/health/ready", APIReadinessHandler(deps))
+
+	// 廃止予定パラメータの使用状況エンドポイント（認証不要 - 受け付け終了の判断用）
+	api.GET("/metrics/deprecations", DeprecationMetricsHandler(deps.ServerConfig.LegacyUserIDParam))
+
+	// 機能フラグエンドポイント（認証不要）
+	api.GET("/features", FeaturesHandler(deps.Features()))
 
 	// レートリミットステータスエンドポイント（認証不要）
 	api.GET("/rate-limit/status", RateLimitStatusHandler(rateLimitStore, newIdentifierExtractor(deps.ServerConfig.TrustedProxyCount)))
@@ -70,9 +106,12 @@ func SetupRoutes(e *echo.Echo, controllers *Controllers, deps *ServerDependencie
 
 	// 認証が必要なエンドポイント用グループ
 	protected := api.Group("")
-	if authMiddleware := deps.JWTAuthMiddlewareFunc(); authMiddleware != nil {
+	if authMiddleware := deps.AuthMiddlewareFunc(); authMiddleware != nil {
 		protected.Use(authMiddleware)
 	}
+	if deps.TwoFactorPolicyUseCase != nil {
+		protected.Use(TwoFactorPolicyMiddleware(deps.TwoFactorPolicyUseCase))
+	}
 
 	// パスキー認証エンドポイント
 	setupPasskeyRoutes(api, protected, controllers.WebAuthn, authRateLimiter)
@@ -83,6 +122,51 @@ func SetupRoutes(e *echo.Echo, controllers *Controllers, deps *ServerDependencie
 	// 財務データ管理エンドポイント
 	setupFinancialDataRoutes(protected, controllers.FinancialData, controllers.CSVFinancialData)
 
+	// ダッシュボード向けの1年後の簡易予測（本人の財務データを参照するため認証が必要）
+	setupQuickProjectionRoutes(protected, controllers.Calculations)
+
+	// 貯蓄率目標エンドポイント
+	setupSavingsRateTargetRoutes(protected, controllers.SavingsRateTarget)
+
+	// 負債管理エンドポイント
+	setupLiabilityRoutes(protected, controllers.Liabilities)
+
+	// ライフイベント管理エンドポイント
+	setupLifeEventRoutes(protected, controllers.LifeEvents)
+
+	// 通知設定エンドポイント
+	setupNotificationRoutes(protected, controllers.Notifications)
+
+	// 推奨事項エンドポイント
+	if controllers.Recommendations != nil {
+		setupRecommendationRoutes(protected, controllers.Recommendations)
+	}
+
+	// 管理者向けエンドポイント（なりすまし）
+	if controllers.Admin != nil {
+		setupAdminRoutes(protected, controllers.Admin)
+	}
+
+	// APIキー管理エンドポイント
+	if controllers.APIKeys != nil {
+		setupAPIKeyRoutes(protected, controllers.APIKeys)
+	}
+
+	// アカウント管理エンドポイント
+	if controllers.Users != nil {
+		setupUserRoutes(protected, controllers.Users)
+	}
+
+	// 財務データのバックアップエンドポイント
+	if controllers.UserDataBackup != nil {
+		setupUserDataBackupRoutes(protected, controllers.UserDataBackup)
+	}
+
+	// 同年代比較（匿名集計）エンドポイント
+	if controllers.PeerBenchmark != nil {
+		setupPeerBenchmarkRoutes(protected, controllers.PeerBenchmark)
+	}
+
 	// レポート生成エンドポイント
 	setupReportRoutes(protected, controllers.Reports)
 
@@ -90,6 +174,11 @@ func SetupRoutes(e *echo.Echo, controllers *Controllers, deps *ServerDependencie
 	if controllers.Bot != nil {
 		setupBotRoutes(protected, controllers.Bot)
 	}
+
+	// 財務アドバイスエンドポイント（JWT認証必須、ユーザーごとに1時間10件まで）
+	if controllers.Advice != nil {
+		setupAdviceRoutes(protected, controllers.Advice, AdviceRateLimiterMiddleware())
+	}
 }
 
 // setupAuthRoutes sets up authentication routes
@@ -99,30 +188,30 @@ func setupAuthRoutes(api *echo.Group, controller *controllers.AuthController, de
 	// 認証レートリミッターをグループに適用（ブルートフォース対策）
 	auth.Use(authRateLimiter)
 
-	auth.POST("/register", controller.Register)              // POST /api/auth/register
-	auth.POST("/login", controller.Login)                    // POST /api/auth/login
-	auth.POST("/refresh", controller.Refresh)                // POST /api/auth/refresh
-	auth.POST("/logout", controller.Logout)                  // POST /api/auth/logout
-	auth.POST("/forgot-password", controller.ForgotPassword) // POST /api/auth/forgot-password
-	auth.POST("/reset-password", controller.ResetPassword)   // POST /api/auth/reset-password
+	auth.POST("/register", controller.Register)              // POST /api/v1/auth/register
+	auth.POST("/login", controller.Login)                    // POST /api/v1/auth/login
+	auth.POST("/refresh", controller.Refresh)                // POST /api/v1/auth/refresh
+	auth.POST("/logout", controller.Logout)                  // POST /api/v1/auth/logout
+	auth.POST("/forgot-password", controller.ForgotPassword) // POST /api/v1/auth/forgot-password
+	auth.POST("/reset-password", controller.ResetPassword)   // POST /api/v1/auth/reset-password
 
 	// GitHub OAuth routes with middleware (Issue: #67)
 	githubOAuth := auth.Group("/github")
 	githubOAuth.Use(GitHubOAuthMiddleware(deps.ServerConfig))
-	githubOAuth.GET("", controller.GitHubLogin)            // GET /api/auth/github
-	githubOAuth.GET("/callback", controller.GitHubCallback) // GET /api/auth/github/callback
+	githubOAuth.GET("", controller.GitHubLogin)            // GET /api/v1/auth/github
+	githubOAuth.GET("/callback", controller.GitHubCallback) // GET /api/v1/auth/github/callback
 }
 
 // setup2FARoutes sets up two-factor authentication routes
 func setup2FARoutes(api *echo.Group, controller *controllers.TwoFactorController, authRateLimiter echo.MiddlewareFunc) {
 	twoFactor := api.Group("/auth/2fa")
 
-	twoFactor.GET("/status", controller.Get2FAStatus)                   // GET /api/auth/2fa/status
-	twoFactor.POST("/setup", controller.Setup2FA)                       // POST /api/auth/2fa/setup
-	twoFactor.POST("/enable", controller.Enable2FA)                     // POST /api/auth/2fa/enable
-	twoFactor.POST("/verify", controller.Verify2FA, authRateLimiter)    // POST /api/auth/2fa/verify（レートリミット適用）
-	twoFactor.DELETE("", controller.Disable2FA)                         // DELETE /api/auth/2fa
-	twoFactor.POST("/backup-codes", controller.RegenerateBackupCodes)   // POST /api/auth/2fa/backup-codes
+	twoFactor.GET("/status", controller.Get2FAStatus)                   // GET /api/v1/auth/2fa/status
+	twoFactor.POST("/setup", controller.Setup2FA)                       // POST /api/v1/auth/2fa/setup
+	twoFactor.POST("/enable", controller.Enable2FA)                     // POST /api/v1/auth/2fa/enable
+	twoFactor.POST("/verify", controller.Verify2FA, authRateLimiter)    // POST /api/v1/auth/2fa/verify（レートリミット適用）
+	twoFactor.DELETE("", controller.Disable2FA)                         // DELETE /api/v1/auth/2fa
+	twoFactor.POST("/backup-codes", controller.RegenerateBackupCodes)   // POST /api/v1/auth/2fa/backup-codes
 }
 
 // setupPasskeyRoutes sets up passkey (WebAuthn) authentication routes
@@ -135,80 +224,200 @@ func setupPasskeyRoutes(api *echo.Group, protected *echo.Group, controller *cont
 	passkey := api.Group("/auth/passkey")
 
 	// パスキーログイン（認証不要・レートリミット適用）
-	passkey.POST("/login/begin", controller.BeginLogin, authRateLimiter)   // POST /api/auth/passkey/login/begin
-	passkey.POST("/login/finish", controller.FinishLogin, authRateLimiter) // POST /api/auth/passkey/login/finish
+	passkey.POST("/login/begin", controller.BeginLogin, authRateLimiter)   // POST /api/v1/auth/passkey/login/begin
+	passkey.POST("/login/finish", controller.FinishLogin, authRateLimiter) // POST /api/v1/auth/passkey/login/finish
 
 	// パスキー登録と管理（認証が必要）
 	passkeyProtected := protected.Group("/auth/passkey")
-	passkeyProtected.POST("/register/begin", controller.BeginRegistration)      // POST /api/auth/passkey/register/begin
-	passkeyProtected.POST("/register/finish", controller.FinishRegistration)    // POST /api/auth/passkey/register/finish
-	passkeyProtected.GET("/credentials", controller.ListCredentials)            // GET /api/auth/passkey/credentials
-	passkeyProtected.DELETE("/credentials/:credential_id", controller.DeleteCredential) // DELETE /api/auth/passkey/credentials/:credential_id
-	passkeyProtected.PUT("/credentials/:credential_id", controller.RenameCredential)    // PUT /api/auth/passkey/credentials/:credential_id
+	passkeyProtected.POST("/register/begin", controller.BeginRegistration)      // POST /api/v1/auth/passkey/register/begin
+	passkeyProtected.POST("/register/finish", controller.FinishRegistration)    // POST /api/v1/auth/passkey/register/finish
+	passkeyProtected.GET("/credentials", controller.ListCredentials)            // GET /api/v1/auth/passkey/credentials
+	passkeyProtected.DELETE("/credentials/:credential_id", controller.DeleteCredential) // DELETE /api/v1/auth/passkey/credentials/:credential_id
+	passkeyProtected.PUT("/credentials/:credential_id", controller.RenameCredential)    // PUT /api/v1/auth/passkey/credentials/:credential_id
 }
 
 // setupFinancialDataRoutes sets up financial data management routes
 func setupFinancialDataRoutes(api *echo.Group, controller *controllers.FinancialDataController, csvController *controllers.CSVFinancialDataController) {
 	financialData := api.Group("/financial-data")
 
-	financialData.POST("", controller.CreateFinancialData)                        // POST /api/financial-data
-	financialData.GET("", controller.GetFinancialData)                            // GET /api/financial-data
-	financialData.POST("/import/csv", controller.ImportFinancialDataFromCSV)      // POST /api/financial-data/import/csv
-	financialData.PUT("/:user_id/profile", controller.UpdateFinancialProfile)     // PUT /api/financial-data/:user_id/profile
-	financialData.PUT("/:user_id/retirement", controller.UpdateRetirementData)    // PUT /api/financial-data/:user_id/retirement
-	financialData.PUT("/:user_id/emergency-fund", controller.UpdateEmergencyFund) // PUT /api/financial-data/:user_id/emergency-fund
-	financialData.DELETE("/:user_id", controller.DeleteFinancialData)             // DELETE /api/financial-data/:user_id
+	financialData.POST("", controller.CreateFinancialData)                        // POST /api/v1/financial-data
+	financialData.GET("", controller.GetFinancialData, ConditionalGetMiddleware(SHA256ETagger{})) // GET /api/v1/financial-data
+	financialData.POST("/validate", controller.ValidateFinancialData)            // POST /api/v1/financial-data/validate
+	financialData.POST("/import/csv", controller.ImportFinancialDataFromCSV)      // POST /api/v1/financial-data/import/csv
+	financialData.PUT("/:user_id/profile", controller.UpdateFinancialProfile)     // PUT /api/v1/financial-data/:user_id/profile
+	financialData.PUT("/:user_id/retirement", controller.UpdateRetirementData)    // PUT /api/v1/financial-data/:user_id/retirement
+	financialData.POST("/:user_id/retirement/pension-estimate", controller.ApplyPensionEstimate) // POST /api/v1/financial-data/:user_id/retirement/pension-estimate
+	financialData.PATCH("/:user_id/retirement/current-age", controller.UpdateCurrentAge)        // PATCH /api/v1/financial-data/:user_id/retirement/current-age
+	financialData.PUT("/:user_id/emergency-fund", controller.UpdateEmergencyFund) // PUT /api/v1/financial-data/:user_id/emergency-fund
+	financialData.POST("/:user_id/confirm-current", controller.ConfirmFinancialDataCurrent)     // POST /api/v1/financial-data/:user_id/confirm-current
+	financialData.POST("/:user_id/import-bank-csv", controller.ImportBankCSV)     // POST /api/v1/financial-data/:user_id/import-bank-csv
+	financialData.DELETE("/:user_id", controller.DeleteFinancialData)             // DELETE /api/v1/financial-data/:user_id
 
 	// CSV インポート・エクスポート
-	financialData.GET("/csv", csvController.DownloadCSV)          // GET /api/financial-data/csv
-	financialData.POST("/csv/import", csvController.ImportCSV)    // POST /api/financial-data/csv/import
+	financialData.GET("/csv", csvController.DownloadCSV)          // GET /api/v1/financial-data/csv
+	financialData.POST("/csv/import", csvController.ImportCSV)    // POST /api/v1/financial-data/csv/import
+}
+
+// setupSavingsRateTargetRoutes sets up savings rate target routes
+func setupSavingsRateTargetRoutes(api *echo.Group, controller *controllers.SavingsRateTargetController) {
+	savingsRateTarget := api.Group("/financial-data/:user_id/savings-rate-target")
+
+	savingsRateTarget.GET("", controller.GetSavingsRateTarget)                // GET /api/v1/financial-data/:user_id/savings-rate-target
+	savingsRateTarget.PUT("", controller.SetSavingsRateTarget)                // PUT /api/v1/financial-data/:user_id/savings-rate-target
+	savingsRateTarget.POST("/actuals", controller.RecordMonthlySavingsActual) // POST /api/v1/financial-data/:user_id/savings-rate-target/actuals
+}
+
+// setupLiabilityRoutes sets up liability routes
+func setupLiabilityRoutes(api *echo.Group, controller *controllers.LiabilitiesController) {
+	liabilities := api.Group("/financial-data/:user_id/liabilities")
+
+	liabilities.GET("", controller.GetLiabilities)                   // GET /api/v1/financial-data/:user_id/liabilities
+	liabilities.POST("", controller.CreateLiability)                 // POST /api/v1/financial-data/:user_id/liabilities
+	liabilities.PUT("/:liability_id", controller.UpdateLiability)    // PUT /api/v1/financial-data/:user_id/liabilities/:liability_id
+	liabilities.DELETE("/:liability_id", controller.DeleteLiability) // DELETE /api/v1/financial-data/:user_id/liabilities/:liability_id
+}
+
+// setupLifeEventRoutes sets up life event routes
+func setupLifeEventRoutes(api *echo.Group, controller *controllers.LifeEventsController) {
+	lifeEvents := api.Group("/financial-data/:user_id/life-events")
+
+	lifeEvents.GET("", controller.GetLifeEvents)                     // GET /api/v1/financial-data/:user_id/life-events
+	lifeEvents.POST("", controller.CreateLifeEvent)                  // POST /api/v1/financial-data/:user_id/life-events
+	lifeEvents.PUT("/:life_event_id", controller.UpdateLifeEvent)    // PUT /api/v1/financial-data/:user_id/life-events/:life_event_id
+	lifeEvents.DELETE("/:life_event_id", controller.DeleteLifeEvent) // DELETE /api/v1/financial-data/:user_id/life-events/:life_event_id
+}
+
+// setupRecommendationRoutes sets up recommendation routes
+func setupRecommendationRoutes(api *echo.Group, controller *controllers.RecommendationsController) {
+	recommendations := api.Group("/recommendations")
+
+	recommendations.GET("", controller.GetRecommendations)                 // GET /api/v1/recommendations
+	recommendations.POST("/:id/dismiss", controller.DismissRecommendation) // POST /api/v1/recommendations/:id/dismiss
+}
+
+// setupAPIKeyRoutes sets up API key management routes
+func setupAPIKeyRoutes(api *echo.Group, controller *controllers.APIKeyController) {
+	apiKeys := api.Group("/api-keys")
+
+	apiKeys.GET("", controller.ListAPIKeys)         // GET /api/v1/api-keys
+	apiKeys.POST("", controller.IssueAPIKey)        // POST /api/v1/api-keys
+	apiKeys.DELETE("/:id", controller.RevokeAPIKey) // DELETE /api/v1/api-keys/:id
+}
+
+// setupUserRoutes sets up account management routes
+func setupUserRoutes(api *echo.Group, controller *controllers.UserManagementController) {
+	users := api.Group("/users/:user_id")
+
+	users.GET("/deletion-preview", controller.GetDeletionPreview) // GET /api/v1/users/:user_id/deletion-preview
+	users.DELETE("", controller.DeleteAccount)                    // DELETE /api/v1/users/:user_id
+}
+
+// setupUserDataBackupRoutes sets up financial data backup routes
+func setupUserDataBackupRoutes(api *echo.Group, controller *controllers.UserDataBackupController) {
+	users := api.Group("/users/:user_id")
+
+	users.GET("/backup", controller.ExportBackup)  // GET /api/v1/users/:user_id/backup
+	users.POST("/backup", controller.ImportBackup) // POST /api/v1/users/:user_id/backup?overwrite=true
+}
+
+// setupPeerBenchmarkRoutes sets up peer benchmark (anonymous comparison) routes
+func setupPeerBenchmarkRoutes(api *echo.Group, controller *controllers.PeerBenchmarkController) {
+	users := api.Group("/users/:user_id")
+
+	users.GET("/peer-comparison", controller.GetPeerComparison)           // GET /api/v1/users/:user_id/peer-comparison
+	users.GET("/peer-benchmark/opt-in", controller.GetPeerBenchmarkOptIn) // GET /api/v1/users/:user_id/peer-benchmark/opt-in
+	users.PUT("/peer-benchmark/opt-in", controller.SetPeerBenchmarkOptIn) // PUT /api/v1/users/:user_id/peer-benchmark/opt-in
+}
+
+// setupNotificationRoutes sets up notification preference routes
+func setupNotificationRoutes(api *echo.Group, controller *controllers.NotificationPreferencesController) {
+	notifications := api.Group("/notifications/:user_id")
+
+	notifications.GET("/preferences", controller.GetNotificationPreferences)   // GET /api/v1/notifications/:user_id/preferences
+	notifications.PUT("/preferences", controller.UpdateNotificationPreference) // PUT /api/v1/notifications/:user_id/preferences
+	notifications.POST("/goal-events", controller.NotifyGoalEvents)            // POST /api/v1/notifications/:user_id/goal-events
 }
 
 // setupCalculationRoutes sets up calculation routes
 func setupCalculationRoutes(api *echo.Group, controller *controllers.CalculationsController) {
 	calculations := api.Group("/calculations")
 
-	calculations.POST("/asset-projection", controller.CalculateAssetProjection)       // POST /api/calculations/asset-projection
-	calculations.POST("/retirement", controller.CalculateRetirementProjection)        // POST /api/calculations/retirement
-	calculations.POST("/emergency-fund", controller.CalculateEmergencyFundProjection) // POST /api/calculations/emergency-fund
-	calculations.POST("/comprehensive", controller.CalculateComprehensiveProjection)  // POST /api/calculations/comprehensive
-	calculations.POST("/goal-projection", controller.CalculateGoalProjection)         // POST /api/calculations/goal-projection
+	calculations.POST("/asset-projection", controller.CalculateAssetProjection)         // POST /api/v1/calculations/asset-projection
+	calculations.POST("/retirement", controller.CalculateRetirementProjection)          // POST /api/v1/calculations/retirement
+	calculations.POST("/emergency-fund", controller.CalculateEmergencyFundProjection)   // POST /api/v1/calculations/emergency-fund
+	calculations.POST("/comprehensive", controller.CalculateComprehensiveProjection)    // POST /api/v1/calculations/comprehensive
+	calculations.POST("/goal-projection", controller.CalculateGoalProjection)           // POST /api/v1/calculations/goal-projection
+	calculations.GET("/pension-estimate", controller.EstimatePension)                   // GET /api/v1/calculations/pension-estimate
+	calculations.POST("/time-to-amount", controller.CalculateTimeToAmount)              // POST /api/v1/calculations/time-to-amount
+	calculations.POST("/required-return", controller.CalculateRequiredReturn)           // POST /api/v1/calculations/required-return
+	calculations.GET("/required-savings-rate", controller.CalculateRequiredSavingsRate) // GET /api/v1/calculations/required-savings-rate
+	calculations.POST("/amortization", controller.CalculateAmortization)                // POST /api/v1/calculations/amortization
+}
+
+// setupQuickProjectionRoutes sets up the dashboard quick projection route
+func setupQuickProjectionRoutes(api *echo.Group, controller *controllers.CalculationsController) {
+	projections := api.Group("/financial-data/:user_id/projections")
+
+	projections.GET("/quick", controller.CalculateQuickProjection) // GET /api/v1/financial-data/:user_id/projections/quick
 }
 
 // setupGoalRoutes sets up goal management routes
 func setupGoalRoutes(api *echo.Group, controller *controllers.GoalsController) {
 	goals := api.Group("/goals")
 
-	goals.POST("", controller.CreateGoal)                                // POST /api/goals
-	goals.GET("", controller.GetGoals)                                   // GET /api/goals
-	goals.GET("/:id", controller.GetGoal)                                // GET /api/goals/:id
-	goals.PUT("/:id", controller.UpdateGoal)                             // PUT /api/goals/:id
-	goals.PUT("/:id/progress", controller.UpdateGoalProgress)            // PUT /api/goals/:id/progress
-	goals.DELETE("/:id", controller.DeleteGoal)                          // DELETE /api/goals/:id
-	goals.GET("/:id/recommendations", controller.GetGoalRecommendations) // GET /api/goals/:id/recommendations
-	goals.GET("/:id/feasibility", controller.AnalyzeGoalFeasibility)     // GET /api/goals/:id/feasibility
+	goals.POST("", controller.CreateGoal)                                // POST /api/v1/goals
+	goals.GET("", controller.GetGoals, ConditionalGetMiddleware(SHA256ETagger{})) // GET /api/v1/goals
+	goals.GET("/:id", controller.GetGoal)                                // GET /api/v1/goals/:id
+	goals.PUT("/:id", controller.UpdateGoal)                             // PUT /api/v1/goals/:id
+	goals.PUT("/:id/progress", controller.UpdateGoalProgress)            // PUT /api/v1/goals/:id/progress
+	goals.DELETE("/:id", controller.DeleteGoal)                          // DELETE /api/v1/goals/:id
+	goals.GET("/:id/recommendations", controller.GetGoalRecommendations) // GET /api/v1/goals/:id/recommendations
+	goals.GET("/:id/feasibility", controller.AnalyzeGoalFeasibility)     // GET /api/v1/goals/:id/feasibility
+	goals.GET("/:id/achievement-probability", controller.EstimateAchievementProbability) // GET /api/v1/goals/:id/achievement-probability
+	goals.GET("/prioritized", controller.GetPrioritizedGoals)            // GET /api/v1/goals/prioritized
+	goals.GET("/overdue", controller.GetOverdueGoals)                    // GET /api/v1/goals/overdue
+	goals.GET("/export", controller.ExportGoals)                         // GET /api/v1/goals/export
+	goals.GET("/:id/progress/export", controller.ExportGoalProgress)     // GET /api/v1/goals/:id/progress/export
+	goals.PUT("/:id/image", controller.UploadGoalImage)                  // PUT /api/v1/goals/:id/image
+	goals.DELETE("/:id/image", controller.DeleteGoalImage)               // DELETE /api/v1/goals/:id/image
+	goals.PUT("/:id/exchange-rate", controller.UpdateGoalExchangeRate)   // PUT /api/v1/goals/:id/exchange-rate
+	goals.POST("/:id/adopt-required-contribution", controller.AdoptRequiredContribution) // POST /api/v1/goals/:id/adopt-required-contribution
+}
+
+// setupAdminRoutes sets up admin (support) routes
+func setupAdminRoutes(api *echo.Group, controller *controllers.AdminController) {
+	admin := api.Group("/admin")
+	admin.POST("/impersonate", controller.Impersonate) // POST /api/v1/admin/impersonate
 }
 
 // setupBotRoutes sets up Bot SSE routes
 func setupBotRoutes(api *echo.Group, controller *controllers.BotController) {
 	bot := api.Group("/bot")
-	bot.POST("/messages", controller.PostMessage) // POST /api/bot/messages
+	bot.POST("/messages", controller.PostMessage) // POST /api/v1/bot/messages
+}
+
+// setupAdviceRoutes sets up financial advice routes
+func setupAdviceRoutes(api *echo.Group, controller *controllers.AdviceController, rateLimiter echo.MiddlewareFunc) {
+	advice := api.Group("/advice")
+	advice.POST("/chat", controller.Chat, rateLimiter) // POST /api/v1/advice/chat
 }
 
 // setupReportRoutes sets up report generation routes
 func setupReportRoutes(api *echo.Group, controller *controllers.ReportsController) {
 	reports := api.Group("/reports")
 
-	reports.POST("/financial-summary", controller.GenerateFinancialSummaryReport)     // POST /api/reports/financial-summary
-	reports.GET("/financial-summary/csv", controller.DownloadFinancialSummaryCSV) // GET /api/reports/financial-summary/csv
-	reports.POST("/asset-projection", controller.GenerateAssetProjectionReport)   // POST /api/reports/asset-projection
-	reports.POST("/goals-progress", controller.GenerateGoalsProgressReport)       // POST /api/reports/goals-progress
-	reports.POST("/retirement-plan", controller.GenerateRetirementPlanReport)     // POST /api/reports/retirement-plan
-	reports.POST("/comprehensive", controller.GenerateComprehensiveReport)        // POST /api/reports/comprehensive
-	reports.POST("/export", controller.ExportReportToPDF)                                    // POST /api/reports/export
-	reports.GET("/pdf", controller.GetReportPDF)                                             // GET /api/reports/pdf
-	reports.GET("/download/:token", controller.DownloadReport)                               // GET /api/reports/download/:token
-	reports.GET("/financial-summary/csv", controller.DownloadFinancialSummaryCSV)            // GET /api/reports/financial-summary/csv
+	reports.POST("/financial-summary", controller.GenerateFinancialSummaryReport)     // POST /api/v1/reports/financial-summary
+	reports.GET("/financial-summary/csv", controller.DownloadFinancialSummaryCSV) // GET /api/v1/reports/financial-summary/csv
+	reports.POST("/asset-projection", controller.GenerateAssetProjectionReport)   // POST /api/v1/reports/asset-projection
+	reports.POST("/goals-progress", controller.GenerateGoalsProgressReport)       // POST /api/v1/reports/goals-progress
+	reports.POST("/retirement-plan", controller.GenerateRetirementPlanReport)     // POST /api/v1/reports/retirement-plan
+	reports.POST("/comprehensive", controller.GenerateComprehensiveReport)        // POST /api/v1/reports/comprehensive
+	reports.POST("/export", controller.ExportReportToPDF)                                    // POST /api/v1/reports/export
+	reports.GET("/pdf", controller.GetReportPDF)                                             // GET /api/v1/reports/pdf
+	reports.GET("/health-score-trend", controller.GetHealthScoreTrend)                       // GET /api/v1/reports/health-score-trend
+	reports.POST("/household-summary", controller.GetHouseholdFinancialSummary)              // POST /api/v1/reports/household-summary
+	reports.GET("/download/:token", controller.DownloadReport)                               // GET /api/v1/reports/download/:token
+	reports.GET("/financial-summary/csv", controller.DownloadFinancialSummaryCSV)            // GET /api/v1/reports/financial-summary/csv
 }
 
 // Handler functions (placeholder implementations)
@@ -231,43 +440,84 @@ func APIInfoHandler(c echo.Context) error {
 		"docs":        "/swagger/index.html",
 		"endpoints": map[string]any{
 			"financial_data": map[string]any{
-				"base":              "/api/financial-data",
-				"create":            "POST /api/financial-data",
-				"get":               "GET /api/financial-data?user_id={user_id}",
-				"update_profile":    "PUT /api/financial-data/{user_id}/profile",
-				"update_retirement": "PUT /api/financial-data/{user_id}/retirement",
-				"update_emergency":  "PUT /api/financial-data/{user_id}/emergency-fund",
-				"delete":            "DELETE /api/financial-data/{user_id}",
+				"base":              "/api/v1/financial-data",
+				"create":            "POST /api/v1/financial-data",
+				"validate":          "POST /api/v1/financial-data/validate",
+				"get":               "GET /api/v1/financial-data?user_id={user_id}",
+				"update_profile":    "PUT /api/v1/financial-data/{user_id}/profile",
+				"update_retirement": "PUT /api/v1/financial-data/{user_id}/retirement",
+				"pension_estimate":  "POST /api/v1/financial-data/{user_id}/retirement/pension-estimate",
+				"current_age":       "PATCH /api/v1/financial-data/{user_id}/retirement/current-age",
+				"savings_target":    "GET|PUT /api/v1/financial-data/{user_id}/savings-rate-target",
+				"savings_actuals":   "POST /api/v1/financial-data/{user_id}/savings-rate-target/actuals",
+				"update_emergency":  "PUT /api/v1/financial-data/{user_id}/emergency-fund",
+				"import_bank_csv":   "POST /api/v1/financial-data/{user_id}/import-bank-csv",
+				"delete":            "DELETE /api/v1/financial-data/{user_id}",
 			},
 			"calculations": map[string]any{
-				"base":             "/api/calculations",
-				"asset_projection": "POST /api/calculations/asset-projection",
-				"retirement":       "POST /api/calculations/retirement",
-				"emergency_fund":   "POST /api/calculations/emergency-fund",
-				"comprehensive":    "POST /api/calculations/comprehensive",
-				"goal_projection":  "POST /api/calculations/goal-projection",
+				"base":                  "/api/v1/calculations",
+				"asset_projection":      "POST /api/v1/calculations/asset-projection",
+				"retirement":            "POST /api/v1/calculations/retirement",
+				"emergency_fund":        "POST /api/v1/calculations/emergency-fund",
+				"comprehensive":         "POST /api/v1/calculations/comprehensive",
+				"goal_projection":       "POST /api/v1/calculations/goal-projection",
+				"pension_estimate":      "GET /api/v1/calculations/pension-estimate",
+				"time_to_amount":        "POST /api/v1/calculations/time-to-amount",
+				"required_return":       "POST /api/v1/calculations/required-return",
+				"required_savings_rate": "GET /api/v1/calculations/required-savings-rate?user_id={user_id}",
+				"amortization":          "POST /api/v1/calculations/amortization",
 			},
 			"goals": map[string]any{
-				"base":            "/api/goals",
-				"create":          "POST /api/goals",
-				"list":            "GET /api/goals?user_id={user_id}",
-				"get":             "GET /api/goals/{id}?user_id={user_id}",
-				"update":          "PUT /api/goals/{id}?user_id={user_id}",
-				"update_progress": "PUT /api/goals/{id}/progress?user_id={user_id}",
-				"delete":          "DELETE /api/goals/{id}?user_id={user_id}",
-				"recommendations": "GET /api/goals/{id}/recommendations?user_id={user_id}",
-				"feasibility":     "GET /api/goals/{id}/feasibility?user_id={user_id}",
+				"base":            "/api/v1/goals",
+				"create":          "POST /api/v1/goals",
+				"list":            "GET /api/v1/goals?user_id={user_id}",
+				"get":             "GET /api/v1/goals/{id}?user_id={user_id}",
+				"update":          "PUT /api/v1/goals/{id}?user_id={user_id}",
+				"update_progress": "PUT /api/v1/goals/{id}/progress?user_id={user_id}",
+				"delete":          "DELETE /api/v1/goals/{id}?user_id={user_id}",
+				"recommendations": "GET /api/v1/goals/{id}/recommendations?user_id={user_id}",
+				"feasibility":     "GET /api/v1/goals/{id}/feasibility?user_id={user_id}",
+				"prioritized":     "GET /api/v1/goals/prioritized?user_id={user_id}",
+				"export":          "GET /api/v1/goals/export?format=csv",
+				"export_progress": "GET /api/v1/goals/{id}/progress/export?format=csv",
+				"exchange_rate":   "PUT /api/v1/goals/{id}/exchange-rate?user_id={user_id}",
+				"adopt_required":  "POST /api/v1/goals/{id}/adopt-required-contribution?user_id={user_id}",
+			},
+			"notifications": map[string]any{
+				"base":        "/api/v1/notifications",
+				"preferences": "GET|PUT /api/v1/notifications/{user_id}/preferences",
+				"goal_events": "POST /api/v1/notifications/{user_id}/goal-events",
+			},
+			"api_keys": map[string]any{
+				"base":   "/api/v1/api-keys",
+				"list":   "GET /api/v1/api-keys",
+				"issue":  "POST /api/v1/api-keys",
+				"revoke": "DELETE /api/v1/api-keys/{id}",
+				"header": APIKeyHeader,
+				"scopes": "read-only | reports | write",
+			},
+			"users": map[string]any{
+				"base":             "/api/v1/users",
+				"deletion_preview": "GET /api/v1/users/{user_id}/deletion-preview",
+				"delete":           "DELETE /api/v1/users/{user_id}",
+				"backup_export":    "GET /api/v1/users/{user_id}/backup",
+				"backup_import":    "POST /api/v1/users/{user_id}/backup?overwrite={true|false}",
+				"peer_comparison":  "GET /api/v1/users/{user_id}/peer-comparison",
+				"peer_opt_in":      "GET|PUT /api/v1/users/{user_id}/peer-benchmark/opt-in",
 			},
 			"reports": map[string]any{
-				"base":              "/api/reports",
-				"financial_summary": "POST /api/reports/financial-summary",
-				"asset_projection":  "POST /api/reports/asset-projection",
-				"goals_progress":    "POST /api/reports/goals-progress",
-				"retirement_plan":   "POST /api/reports/retirement-plan",
-				"comprehensive":     "POST /api/reports/comprehensive",
-				"export":            "POST /api/reports/export",
-				"pdf":               "GET /api/reports/pdf?user_id={user_id}",
+				"base":              "/api/v1/reports",
+				"financial_summary": "POST /api/v1/reports/financial-summary",
+				"asset_projection":  "POST /api/v1/reports/asset-projection",
+				"goals_progress":    "POST /api/v1/reports/goals-progress",
+				"retirement_plan":   "POST /api/v1/reports/retirement-plan",
+				"comprehensive":     "POST /api/v1/reports/comprehensive",
+				"export":            "POST /api/v1/reports/export",
+				"pdf":               "GET /api/v1/reports/pdf?user_id={user_id}",
+				"health_score_trend": "GET /api/v1/reports/health-score-trend?user_id={user_id}&months={months}",
 			},
+			"features": "GET /api/v1/features",
+			"versions": "GET /api/versions",
 			"health": "/health",
 		},
 		"timestamp": time.Now().Format(time.RFC3339),
@@ -276,7 +526,7 @@ func APIInfoHandler(c echo.Context) error {
 
 // RateLimitStatusHandler returns the current rate limit status for the caller's IP.
 //
-// GET /api/rate-limit/status
+// GET /api/v1/rate-limit/status
 //
 // Response:
 //
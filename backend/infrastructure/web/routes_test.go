@@ -8,6 +8,9 @@ import (
 	"time"
 
 	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
+	"github.com/financial-planning-calculator/backend/infrastructure/repositories"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,9 +28,58 @@ func TestHealthCheckHandler(t *testing.T) {
 	assert.Contains(t, rec.Body.String(), "財務計画計算機 API サーバーが正常に動作しています")
 }
 
+// stubCircuitBreaker は状態を固定したテスト用のサーキットブレーカー
+type stubCircuitBreaker struct {
+	state database.CircuitState
+}
+
+func (b *stubCircuitBreaker) Execute(fn func() error) error { return fn() }
+func (b *stubCircuitBreaker) State() database.CircuitState  { return b.state }
+
+func TestAPIReadinessHandler_ReflectsCircuitBreakerState(t *testing.T) {
+	calculationService := services.NewFinancialCalculationService()
+	repoFactory := repositories.NewRepositoryFactory(nil)
+
+	tests := []struct {
+		name         string
+		state        database.CircuitState
+		expectedCode int
+	}{
+		{name: "閉状態はレディ", state: database.CircuitStateClosed, expectedCode: http.StatusOK},
+		{name: "半開状態はレディ", state: database.CircuitStateHalfOpen, expectedCode: http.StatusOK},
+		{name: "開状態はレディではない", state: database.CircuitStateOpen, expectedCode: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps := &ServerDependencies{
+				FinancialPlanRepo:     repoFactory.NewFinancialPlanRepository(),
+				GoalRepo:              repoFactory.NewGoalRepository(),
+				CalculationService:    calculationService,
+				RecommendationService: services.NewGoalRecommendationService(calculationService),
+				DBCircuitBreaker:      &stubCircuitBreaker{state: tt.state},
+			}
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			err := APIReadinessHandler(deps)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			var body map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, string(tt.state), body["database_circuit_breaker"])
+			assert.Equal(t, tt.expectedCode == http.StatusOK, body["ready"])
+		})
+	}
+}
+
 func TestAPIInfoHandler(t *testing.T) {
 	e := echo.New()
-	req := httptest.NewRequest(http.MethodGet, "/api", nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
 
@@ -59,7 +111,7 @@ func TestSetupRoutes(t *testing.T) {
 		ServerConfig: &config.ServerConfig{
 			GitHubClientID:       "test-client-id",
 			GitHubClientSecret:   "test-client-secret",
-			GitHubCallbackURL:    "http://localhost:8080/api/auth/github/callback",
+			GitHubCallbackURL:    "http://localhost:8080/api/v1/auth/github/callback",
 			OAuthSuccessRedirect: "/auth/callback",
 			OAuthFailureRedirect: "/login?error=oauth_failed",
 			AuthRateLimitRPS:     10,
@@ -84,17 +136,18 @@ func TestSetupRoutes(t *testing.T) {
 	}
 
 	assert.Contains(t, routePaths, "/health")
-	assert.Contains(t, routePaths, "/api/health")
-	assert.Contains(t, routePaths, "/api/")
+	assert.Contains(t, routePaths, "/api/v1/health")
+	assert.Contains(t, routePaths, "/health/ready")
+	assert.Contains(t, routePaths, "/api/v1/")
 	assert.Contains(t, routePaths, "/swagger/*")
-	assert.Contains(t, routePaths, "/api/rate-limit/status")
+	assert.Contains(t, routePaths, "/api/v1/rate-limit/status")
 }
 
 func TestRateLimitStatusHandler(t *testing.T) {
 	store := NewCustomRateLimiterStore(100, 50, time.Minute)
 	e := echo.New()
 
-	req := httptest.NewRequest("GET", "/api/rate-limit/status", nil)
+	req := httptest.NewRequest("GET", "/api/v1/rate-limit/status", nil)
 	req.RemoteAddr = "203.0.113.1:1234"
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
@@ -119,7 +172,7 @@ func TestRateLimitStatusHandler_RemainingDecreases(t *testing.T) {
 	ip := "203.0.113.2"
 
 	callStatus := func() int {
-		req := httptest.NewRequest("GET", "/api/rate-limit/status", nil)
+		req := httptest.NewRequest("GET", "/api/v1/rate-limit/status", nil)
 		req.RemoteAddr = ip + ":1234"
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
@@ -7,13 +7,18 @@ import (
 	"time"
 
 	"github.com/financial-planning-calculator/backend/application"
+	"github.com/financial-planning-calculator/backend/application/ports"
 	"github.com/financial-planning-calculator/backend/application/usecases"
 	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/repositories"
 	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
 	infraemail "github.com/financial-planning-calculator/backend/infrastructure/email"
 	"github.com/financial-planning-calculator/backend/infrastructure/faq"
+	importer "github.com/financial-planning-calculator/backend/infrastructure/import"
 	"github.com/financial-planning-calculator/backend/infrastructure/llm"
+	"github.com/financial-planning-calculator/backend/infrastructure/marketdata"
 	infrapdf "github.com/financial-planning-calculator/backend/infrastructure/pdf"
 	"github.com/financial-planning-calculator/backend/infrastructure/storage"
 	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
@@ -27,15 +32,41 @@ type ServerDependencies struct {
 	UserRepo               repositories.UserRepository
 	PasswordResetTokenRepo repositories.PasswordResetTokenRepository
 	// Email service
-	EmailService           infraemail.EmailService
-	RefreshTokenRepo       repositories.RefreshTokenRepository
-	WebAuthnCredentialRepo repositories.WebAuthnCredentialRepository
-	FinancialPlanRepo      repositories.FinancialPlanRepository
-	GoalRepo               repositories.GoalRepository
+	EmailService                infraemail.EmailService
+	RefreshTokenRepo            repositories.RefreshTokenRepository
+	WebAuthnCredentialRepo      repositories.WebAuthnCredentialRepository
+	FinancialPlanRepo           repositories.FinancialPlanRepository
+	GoalRepo                    repositories.GoalRepository
+	SavingsRateTargetRepo       repositories.SavingsRateTargetRepository
+	NotificationPrefRepo        repositories.NotificationPreferenceRepository
+	RecommendationDismissalRepo repositories.RecommendationDismissalRepository
+	HealthScoreSnapshotRepo     repositories.HealthScoreSnapshotRepository
+	CalculationSnapshotRepo     repositories.CalculationSnapshotRepository
+	APIKeyRepo                  repositories.APIKeyRepository
+	// GoalProgressSnapshotRepo は目標の進捗履歴（nil の場合は記録せず、達成確率は計画上の月間拠出額で推定する）
+	GoalProgressSnapshotRepo repositories.GoalProgressSnapshotRepository
+	// GoalContributionHistoryRepo は目標の月間拠出額の変更履歴（nil の場合は記録しない）
+	GoalContributionHistoryRepo repositories.GoalContributionHistoryRepository
+	// PeerBenchmarkRepo と PeerBenchmarkConsentRepo は同年代比較の匿名集計と参加状況（いずれかが nil の場合は同年代比較を無効にする）
+	PeerBenchmarkRepo        repositories.PeerBenchmarkRepository
+	PeerBenchmarkConsentRepo repositories.PeerBenchmarkConsentRepository
+	// AuditLogRepo は監査ログ（nil の場合は管理者によるなりすましを無効にする）
+	AuditLogRepo repositories.AuditLogRepository
+	UnitOfWork   repositories.UnitOfWork
+	// DBCircuitBreaker はリポジトリが共有するデータベースのサーキットブレーカー（nil の場合はレディネスチェックで状態を報告しない）
+	DBCircuitBreaker database.CircuitBreaker
 
 	// Domain Services
 	CalculationService    *services.FinancialCalculationService
 	RecommendationService *services.GoalRecommendationService
+	// AssumptionGuardrailService は想定利回り・インフレ率の上限チェック（nil の場合はデフォルトの上限を使用）
+	AssumptionGuardrailService *services.AssumptionGuardrailService
+
+	// Notification dispatcher
+	NotificationDispatcher ports.NotificationDispatcher
+
+	// TokenBlacklist は失効させたアクセストークンのストア（nil の場合はログアウトしてもアクセストークンを失効させない）
+	TokenBlacklist ports.TokenBlacklist
 
 	// Auth Config
 	JWTSecret              string
@@ -48,17 +79,42 @@ type ServerDependencies struct {
 	// WebAuthn
 	WebAuthn *webauthn.WebAuthn
 
+	// FeatureFlags は機能フラグ（nil の場合は既定値を使用）
+	FeatureFlags ports.FeatureFlags
+
 	// AuthUseCase (ミドルウェア用、NewControllersで初期化される)
 	AuthUseCase usecases.AuthUseCase
 
+	// APIKeyUseCase (ミドルウェア用、NewControllersで初期化される。APIKeyRepo未設定の場合は nil)
+	APIKeyUseCase usecases.ManageAPIKeysUseCase
+
+	// ImpersonationUseCase (ミドルウェア用、NewControllersで初期化される。AuditLogRepo未設定の場合は nil)
+	ImpersonationUseCase usecases.ImpersonationUseCase
+
+	// TwoFactorPolicyUseCase (ミドルウェア用、NewControllersで初期化される。2FAの必須化ポリシーが無効の場合は nil)
+	TwoFactorPolicyUseCase usecases.TwoFactorPolicyUseCase
+
 	// SkipAuth テスト用：認証をスキップする
 	SkipAuth bool
 }
 
 // NewControllers creates all controller instances with their dependencies
 func NewControllers(deps *ServerDependencies) (*Controllers, error) {
+	// 2段階認証の必須化ポリシーを生成（組織ポリシー・資産額の閾値のいずれも未設定の場合は適用しない）
+	twoFactorPolicy, err := entities.NewTwoFactorPolicy(
+		deps.ServerConfig.TwoFactorRequiredForAll,
+		deps.ServerConfig.TwoFactorRequiredAssetThreshold,
+		deps.ServerConfig.TwoFactorGracePeriodDays,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("2段階認証の必須化ポリシーの初期化に失敗しました: %w", err)
+	}
+	if twoFactorPolicy.IsEnabled() {
+		deps.TwoFactorPolicyUseCase = usecases.NewTwoFactorPolicyUseCase(deps.UserRepo, deps.FinancialPlanRepo, twoFactorPolicy)
+	}
+
 	// Create use cases
-	authUseCase := usecases.NewAuthUseCase(
+	authUseCase := usecases.NewAuthUseCaseWithTokenBlacklist(
 		deps.UserRepo,
 		deps.RefreshTokenRepo,
 		deps.PasswordResetTokenRepo,
@@ -66,6 +122,8 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 		deps.JWTSecret,
 		deps.JWTExpiration,
 		deps.RefreshTokenExpiration,
+		deps.TwoFactorPolicyUseCase,
+		deps.TokenBlacklist,
 	)
 
 	// Store auth use case for middleware
@@ -73,12 +131,32 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 
 	manageFinancialDataUseCase := usecases.NewManageFinancialDataUseCase(
 		deps.FinancialPlanRepo,
+		deps.UnitOfWork,
+		importer.NewBankCSVParsers(),
+		deps.AssumptionGuardrailService,
 	)
 
-	manageGoalsUseCase := usecases.NewManageGoalsUseCase(
+	// 目標の画像を保存するストレージを生成（保存先が未設定の場合は画像のアップロードを無効にする）
+	var goalImageStorage ports.FileStorage
+	if deps.ServerConfig.UploadDir != "" {
+		localFileStorage, err := storage.NewLocalFileStorage(
+			deps.ServerConfig.UploadDir,
+			deps.ServerConfig.UploadBaseURL,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("LocalFileStorageの初期化に失敗しました: %w", err)
+		}
+		goalImageStorage = localFileStorage
+	}
+
+	manageGoalsUseCase := usecases.NewManageGoalsUseCaseWithContributionHistory(
 		deps.GoalRepo,
 		deps.FinancialPlanRepo,
+		deps.UnitOfWork,
 		deps.RecommendationService,
+		goalImageStorage,
+		deps.GoalProgressSnapshotRepo,
+		deps.GoalContributionHistoryRepo,
 	)
 
 	calculateProjectionUseCase := usecases.NewCalculateProjectionUseCase(
@@ -99,8 +177,22 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 		return nil, fmt.Errorf("TemporaryFileStorageの初期化に失敗しました: %w", err)
 	}
 
-	// HTMLGenerator を初期化して ReportPDFGenerator アダプターでラップする
-	pdfGenerator := infrapdf.NewHTMLGeneratorAdapter()
+	// レポートに埋め込むチャートの描画設定を読み込む（フォントを読み込めない場合は起動を中止する）
+	chartRenderer, err := infrapdf.NewChartRenderer(infrapdf.ChartConfig{
+		Width:    deps.ServerConfig.ChartWidth,
+		Height:   deps.ServerConfig.ChartHeight,
+		DPI:      deps.ServerConfig.ChartDPI,
+		FontPath: deps.ServerConfig.ChartFontPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ChartRendererの初期化に失敗しました: %w", err)
+	}
+	if !chartRenderer.HasFont() {
+		slog.Warn("CHART_FONT_PATH が未設定のため、レポートのチャートの日本語（凡例・軸ラベル）は表示されません")
+	}
+
+	// チャートを埋め込む HTMLGenerator を初期化して ReportPDFGenerator アダプターでラップする
+	pdfGenerator := infrapdf.NewHTMLGeneratorAdapterWithCharts(chartRenderer)
 
 	generateReportsUseCase := usecases.NewGenerateReportsUseCaseWithPDF(
 		deps.FinancialPlanRepo,
@@ -109,6 +201,36 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 		deps.RecommendationService,
 		pdfGenerator,
 		tempFileStorage,
+		deps.SavingsRateTargetRepo,
+		deps.AssumptionGuardrailService,
+		deps.RecommendationDismissalRepo,
+		// 外部のニュースフィードと連携するまでは最後に確認した市場概況を使用する
+		marketdata.NewCachedMarketContextProvider(marketdata.NewStaticMarketContextProvider()),
+		deps.HealthScoreSnapshotRepo,
+		deps.CalculationSnapshotRepo,
+	)
+
+	manageSavingsRateTargetUseCase := usecases.NewManageSavingsRateTargetUseCase(
+		deps.SavingsRateTargetRepo,
+		deps.FinancialPlanRepo,
+		deps.UnitOfWork,
+	)
+
+	// 推奨事項の却下（却下状態の保存先が未設定の場合は無効）
+	var recommendationsController *controllers.RecommendationsController
+	if deps.RecommendationDismissalRepo != nil {
+		recommendationsController = controllers.NewRecommendationsController(usecases.NewManageRecommendationsUseCase(
+			deps.FinancialPlanRepo,
+			deps.SavingsRateTargetRepo,
+			deps.RecommendationDismissalRepo,
+			deps.CalculationService,
+		))
+	}
+
+	manageNotificationPreferencesUseCase := usecases.NewManageNotificationPreferencesUseCase(
+		deps.NotificationPrefRepo,
+		deps.GoalRepo,
+		deps.NotificationDispatcher,
 	)
 
 	// WebAuthn use case
@@ -126,6 +248,20 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 		)
 	}
 
+	// APIキー管理（外部ツール連携用）
+	// 管理者によるなりすまし（監査ログを記録できる場合のみ有効）
+	var adminController *controllers.AdminController
+	if deps.AuditLogRepo != nil {
+		deps.ImpersonationUseCase = usecases.NewImpersonationUseCase(deps.UserRepo, deps.AuditLogRepo, deps.JWTSecret)
+		adminController = controllers.NewAdminController(deps.ImpersonationUseCase)
+	}
+
+	var apiKeyController *controllers.APIKeyController
+	if deps.APIKeyRepo != nil {
+		deps.APIKeyUseCase = usecases.NewManageAPIKeysUseCase(deps.APIKeyRepo)
+		apiKeyController = controllers.NewAPIKeyController(deps.APIKeyUseCase)
+	}
+
 	// BotController初期化
 	faqLoader := faq.NewFAQLoader(deps.ServerConfig.FAQDir)
 	if _, loadErr := faqLoader.Load(context.Background()); loadErr != nil {
@@ -134,6 +270,33 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 	llmClient := llm.NewGroqClient(deps.ServerConfig.GroqAPIKey, deps.ServerConfig.GroqModel)
 	botUseCase := application.NewBotUseCase(faqLoader, llmClient)
 
+	// 財務アドバイス（LLMのエンドポイントが未設定の場合、または呼び出しに失敗した場合は定型のアドバイスを返す）
+	var advisoryService ports.FinancialAdvisoryService = llm.NewStaticAdvisoryService()
+	if deps.ServerConfig.LLMEndpointURL != "" {
+		advisoryService = llm.NewLLMAdvisoryService(
+			generateReportsUseCase,
+			deps.ServerConfig.LLMEndpointURL,
+			deps.ServerConfig.LLMAPIKey,
+			deps.ServerConfig.LLMModel,
+			advisoryService,
+		)
+	}
+
+	userManagementUseCase := usecases.NewUserManagementUseCase(deps.UnitOfWork)
+	userDataBackupUseCase := usecases.NewUserDataBackupUseCase(deps.UnitOfWork)
+
+	// 同年代比較（匿名集計のリポジトリが設定されている場合のみ有効）
+	var peerBenchmarkController *controllers.PeerBenchmarkController
+	if deps.PeerBenchmarkRepo != nil && deps.PeerBenchmarkConsentRepo != nil {
+		peerBenchmarkController = controllers.NewPeerBenchmarkController(usecases.NewPeerBenchmarkUseCase(
+			deps.FinancialPlanRepo,
+			deps.PeerBenchmarkConsentRepo,
+			deps.PeerBenchmarkRepo,
+			services.NewPeerBenchmarkService(deps.ServerConfig.PeerBenchmarkMinGroupSize),
+			deps.ServerConfig.PeerBenchmarkCacheTTL,
+		))
+	}
+
 	csvFinancialDataUseCase := usecases.NewCSVFinancialDataUseCase(
 		deps.FinancialPlanRepo,
 		manageFinancialDataUseCase,
@@ -141,15 +304,26 @@ func NewControllers(deps *ServerDependencies) (*Controllers, error) {
 
 	// Create controllers
 	return &Controllers{
-		Auth:             controllers.NewAuthController(authUseCase, deps.ServerConfig),
-		TwoFactor:        controllers.NewTwoFactorController(authUseCase, deps.ServerConfig),
-		WebAuthn:         controllers.NewWebAuthnController(webAuthnUseCase),
-		FinancialData:    controllers.NewFinancialDataController(manageFinancialDataUseCase),
-		CSVFinancialData: controllers.NewCSVFinancialDataController(csvFinancialDataUseCase),
-		Calculations:     controllers.NewCalculationsController(calculateProjectionUseCase),
-		Goals:            controllers.NewGoalsController(manageGoalsUseCase),
-		Reports:          controllers.NewReportsController(generateReportsUseCase, tempFileStorage),
-		Bot:              controllers.NewBotController(botUseCase),
+		Auth:              controllers.NewAuthController(authUseCase, deps.ServerConfig),
+		TwoFactor:         controllers.NewTwoFactorController(authUseCase, deps.ServerConfig),
+		WebAuthn:          controllers.NewWebAuthnController(webAuthnUseCase),
+		FinancialData:     controllers.NewFinancialDataController(manageFinancialDataUseCase),
+		CSVFinancialData:  controllers.NewCSVFinancialDataController(csvFinancialDataUseCase),
+		Calculations:      controllers.NewCalculationsController(calculateProjectionUseCase),
+		Goals:             controllers.NewGoalsController(manageGoalsUseCase),
+		Reports:           controllers.NewReportsController(generateReportsUseCase, tempFileStorage),
+		Bot:               controllers.NewBotController(botUseCase),
+		Advice:            controllers.NewAdviceController(advisoryService),
+		SavingsRateTarget: controllers.NewSavingsRateTargetController(manageSavingsRateTargetUseCase),
+		Liabilities:       controllers.NewLiabilitiesController(usecases.NewManageLiabilitiesUseCase(deps.FinancialPlanRepo)),
+		LifeEvents:        controllers.NewLifeEventsController(usecases.NewManageLifeEventsUseCase(deps.FinancialPlanRepo)),
+		Notifications:     controllers.NewNotificationPreferencesController(manageNotificationPreferencesUseCase),
+		Recommendations:   recommendationsController,
+		APIKeys:           apiKeyController,
+		Admin:             adminController,
+		Users:             controllers.NewUserManagementController(userManagementUseCase),
+		UserDataBackup:    controllers.NewUserDataBackupController(userDataBackupUseCase),
+		PeerBenchmark:     peerBenchmarkController,
 	}, nil
 }
 
@@ -159,5 +333,19 @@ func (deps *ServerDependencies) JWTAuthMiddlewareFunc() echo.MiddlewareFunc {
 	if deps.SkipAuth {
 		return nil
 	}
-	return JWTAuthMiddleware(deps.AuthUseCase)
+	return JWTAuthMiddlewareWithImpersonation(deps.AuthUseCase, deps.ImpersonationUseCase)
+}
+
+// AuthMiddlewareFunc returns the authentication middleware for protected routes
+// APIキー認証が有効な場合はAPIキーとJWTの併用、そうでない場合はJWT認証のみ
+// Returns nil if SkipAuth is true (for testing)
+func (deps *ServerDependencies) AuthMiddlewareFunc() echo.MiddlewareFunc {
+	if deps.SkipAuth {
+		return nil
+	}
+	jwtMiddleware := JWTAuthMiddlewareWithImpersonation(deps.AuthUseCase, deps.ImpersonationUseCase)
+	if deps.APIKeyUseCase == nil {
+		return jwtMiddleware
+	}
+	return APIKeyOrJWTAuthMiddleware(jwtMiddleware, deps.APIKeyUseCase)
 }
@@ -0,0 +1,63 @@
+package web
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
+	"github.com/labstack/echo/v4"
+)
+
+// TwoFactorDeadlineHeader は猶予期間中のレスポンスに付与する2段階認証の有効化期限のヘッダー名
+const TwoFactorDeadlineHeader = "X-2FA-Deadline"
+
+// twoFactorPolicyExemptPaths は2段階認証が未設定でも利用できるエンドポイントのパス接頭辞
+// 2段階認証の設定やログアウトなどの認証関連の操作は制限しない
+var twoFactorPolicyExemptPaths = []string{
+	APIV1Path + "/auth",
+}
+
+// TwoFactorPolicyMiddleware は2段階認証の必須化ポリシーを適用するミドルウェア（認証ミドルウェアの後に適用する）
+// 必須化の対象ユーザーが猶予期間を過ぎても2段階認証を設定していない場合は 2FA_REQUIRED を返す
+// 猶予期間中は有効化の期限をレスポンスヘッダーで通知する
+func TwoFactorPolicyMiddleware(useCase usecases.TwoFactorPolicyUseCase) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			path := c.Request().URL.Path
+			for _, prefix := range twoFactorPolicyExemptPaths {
+				if strings.HasPrefix(path, prefix) {
+					return next(c)
+				}
+			}
+
+			userID, ok := c.Get("user_id").(string)
+			if !ok || userID == "" {
+				return next(c)
+			}
+
+			requirement, err := useCase.GetRequirement(c.Request().Context(), entities.UserID(userID))
+			if err != nil {
+				slog.ErrorContext(c.Request().Context(), "2段階認証の必須化状況の確認に失敗しました",
+					slog.String("error", err.Error()),
+				)
+				return c.JSON(http.StatusInternalServerError, controllers.NewInternalServerErrorResponse(c, "2段階認証の必須化状況の確認に失敗しました"))
+			}
+
+			if requirement.Enforced {
+				return c.JSON(http.StatusForbidden, controllers.NewLocalizedErrorResponse(c, controllers.ErrorCodeTwoFactorRequired, map[string]any{
+					"reason":   requirement.Reason,
+					"deadline": requirement.Deadline,
+				}))
+			}
+			if requirement.Required && !requirement.Enabled && requirement.Deadline != nil {
+				c.Response().Header().Set(TwoFactorDeadlineHeader, requirement.Deadline.UTC().Format(time.RFC3339))
+			}
+
+			return next(c)
+		}
+	}
+}
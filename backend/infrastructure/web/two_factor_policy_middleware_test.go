@@ -0,0 +1,83 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/domain/entities"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTwoFactorPolicyUseCase はユーザーごとに固定の必須化状況を返すテスト用ユースケース
+type stubTwoFactorPolicyUseCase struct {
+	requirements map[entities.UserID]*usecases.TwoFactorRequirementOutput
+}
+
+func (s *stubTwoFactorPolicyUseCase) GetRequirement(_ context.Context, userID entities.UserID) (*usecases.TwoFactorRequirementOutput, error) {
+	requirement, ok := s.requirements[userID]
+	if !ok {
+		return nil, errors.New("ユーザーが見つかりません")
+	}
+	return requirement, nil
+}
+
+func TestTwoFactorPolicyMiddleware(t *testing.T) {
+	deadline := time.Date(2026, 4, 15, 0, 0, 0, 0, time.UTC)
+	stub := &stubTwoFactorPolicyUseCase{requirements: map[entities.UserID]*usecases.TwoFactorRequirementOutput{
+		"enforced": {Required: true, Reason: entities.TwoFactorReasonHighAssets, Deadline: &deadline, Enforced: true},
+		"grace":    {Required: true, Reason: entities.TwoFactorReasonOrganizationPolicy, Deadline: &deadline},
+		"enabled":  {Required: true, Reason: entities.TwoFactorReasonOrganizationPolicy, Enabled: true, Deadline: &deadline},
+		"free":     {},
+	}}
+
+	e := echo.New()
+	handler := TwoFactorPolicyMiddleware(stub)(func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	tests := []struct {
+		name           string
+		path           string
+		userID         string
+		expectedStatus int
+		expectDeadline bool
+	}{
+		{"期限切れの未設定ユーザーは拒否", "/api/v1/financial-data", "enforced", http.StatusForbidden, false},
+		{"期限切れでも2FAの設定は利用可能", "/api/v1/auth/2fa/setup", "enforced", http.StatusOK, false},
+		{"猶予期間中は期限をヘッダーで通知", "/api/v1/financial-data", "grace", http.StatusOK, true},
+		{"設定済みのユーザーは通知しない", "/api/v1/financial-data", "enabled", http.StatusOK, false},
+		{"対象外のユーザー", "/api/v1/financial-data", "free", http.StatusOK, false},
+		{"ユーザー情報がないリクエストは素通し", "/api/v1/financial-data", "", http.StatusOK, false},
+		{"状況の確認に失敗した場合", "/api/v1/financial-data", "unknown", http.StatusInternalServerError, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			if tt.userID != "" {
+				c.Set("user_id", tt.userID)
+			}
+
+			require.NoError(t, handler(c))
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus == http.StatusForbidden {
+				assert.Contains(t, rec.Body.String(), `"2FA_REQUIRED"`)
+				assert.Contains(t, rec.Body.String(), `"high_assets"`)
+			}
+			if tt.expectDeadline {
+				assert.Equal(t, "2026-04-15T00:00:00Z", rec.Header().Get(TwoFactorDeadlineHeader))
+			} else {
+				assert.Empty(t, rec.Header().Get(TwoFactorDeadlineHeader))
+			}
+		})
+	}
+}
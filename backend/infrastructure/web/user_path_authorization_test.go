@@ -0,0 +1,183 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/financial-planning-calculator/backend/application/usecases"
+	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	userPathTokenUserID = "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c"
+	userPathOtherUserID = "9b2e7c1a-5d4f-4a3b-8c6e-1f0a2b3c4d5e"
+	// userPathValidToken は userPathTokenUserID として認証されるテスト用トークン
+	userPathValidToken = "valid-token"
+)
+
+// tokenUserAuthUseCase は userPathValidToken だけを userPathTokenUserID のトークンとして受け付けるテスト用の認証ユースケース
+type tokenUserAuthUseCase struct {
+	usecases.AuthUseCase
+}
+
+func (s *tokenUserAuthUseCase) VerifyToken(_ context.Context, token string) (*usecases.TokenClaims, error) {
+	if token != userPathValidToken {
+		return nil, errors.New("invalid token")
+	}
+	return &usecases.TokenClaims{UserID: userPathTokenUserID}, nil
+}
+
+// setupUserPathAuthorizationTestServer は実際の認証ミドルウェアを通すテストサーバーを作成する
+func setupUserPathAuthorizationTestServer(ctrls *Controllers) *echo.Echo {
+	e := echo.New()
+	e.Validator = NewCustomValidator()
+
+	deps := &ServerDependencies{
+		AuthUseCase: &tokenUserAuthUseCase{},
+		ServerConfig: &config.ServerConfig{
+			AuthRateLimitRPS:   10,
+			AuthRateLimitBurst: 5,
+		},
+	}
+	SetupRoutes(e, ctrls, deps, NewCustomRateLimiterStore(100, 50, 3*time.Minute))
+	return e
+}
+
+// serveUserPathRequest はトークン（空の場合は未認証）を付けてリクエストを送り、ステータスコードを返す
+func serveUserPathRequest(e *echo.Echo, method, target, token string) int {
+	req := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+func TestQuickProjectionRoute_RequiresOwnUser(t *testing.T) {
+	mockCalculationUseCase := &MockCalculateProjectionUseCase{}
+	mockCalculationUseCase.On("CalculateQuickProjection", mock.Anything, usecases.QuickProjectionInput{UserID: userPathTokenUserID}).
+		Return(&usecases.QuickProjectionOutput{}, nil)
+	e := setupUserPathAuthorizationTestServer(&Controllers{
+		FinancialData: controllers.NewFinancialDataController(&MockManageFinancialDataUseCase{}),
+		Calculations:  controllers.NewCalculationsController(mockCalculationUseCase),
+		Goals:         controllers.NewGoalsController(&MockManageGoalsUseCase{}),
+		Reports:       controllers.NewReportsController(&MockGenerateReportsUseCase{}, nil),
+	})
+
+	tests := []struct {
+		name           string
+		userID         string
+		token          string
+		expectedStatus int
+	}{
+		{"未認証は拒否", userPathTokenUserID, "", http.StatusUnauthorized},
+		{"無効なトークンは拒否", userPathTokenUserID, "invalid-token", http.StatusUnauthorized},
+		{"他のユーザーのIDは拒否", userPathOtherUserID, userPathValidToken, http.StatusForbidden},
+		{"本人のIDは許可", userPathTokenUserID, userPathValidToken, http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := serveUserPathRequest(e, http.MethodGet, "/api/v1/financial-data/"+tt.userID+"/projections/quick", tt.token)
+			assert.Equal(t, tt.expectedStatus, status)
+		})
+	}
+
+	mockCalculationUseCase.AssertNumberOfCalls(t, "CalculateQuickProjection", 1)
+}
+
+func TestSavingsRateTargetRoutes_RejectOtherUsers(t *testing.T) {
+	// 拒否されるリクエストはユースケースに到達しないため、ユースケースは設定しない
+	e := setupUserPathAuthorizationTestServer(&Controllers{
+		FinancialData:     controllers.NewFinancialDataController(&MockManageFinancialDataUseCase{}),
+		Calculations:      controllers.NewCalculationsController(&MockCalculateProjectionUseCase{}),
+		Goals:             controllers.NewGoalsController(&MockManageGoalsUseCase{}),
+		Reports:           controllers.NewReportsController(&MockGenerateReportsUseCase{}, nil),
+		SavingsRateTarget: controllers.NewSavingsRateTargetController(nil),
+	})
+
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/savings-rate-target"},
+		{http.MethodPut, "/savings-rate-target"},
+		{http.MethodPost, "/savings-rate-target/actuals"},
+	}
+
+	for _, route := range routes {
+		target := "/api/v1/financial-data/" + userPathOtherUserID + route.path
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			assert.Equal(t, http.StatusUnauthorized, serveUserPathRequest(e, route.method, target, ""))
+			assert.Equal(t, http.StatusForbidden, serveUserPathRequest(e, route.method, target, userPathValidToken))
+		})
+	}
+}
+
+func TestNotificationRoutes_RejectOtherUsers(t *testing.T) {
+	// 拒否されるリクエストはユースケースに到達しないため、ユースケースは設定しない
+	e := setupUserPathAuthorizationTestServer(&Controllers{
+		FinancialData: controllers.NewFinancialDataController(&MockManageFinancialDataUseCase{}),
+		Calculations:  controllers.NewCalculationsController(&MockCalculateProjectionUseCase{}),
+		Goals:         controllers.NewGoalsController(&MockManageGoalsUseCase{}),
+		Reports:       controllers.NewReportsController(&MockGenerateReportsUseCase{}, nil),
+		Notifications: controllers.NewNotificationPreferencesController(nil),
+	})
+
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/preferences"},
+		{http.MethodPut, "/preferences"},
+		{http.MethodPost, "/goal-events"},
+	}
+
+	for _, route := range routes {
+		target := "/api/v1/notifications/" + userPathOtherUserID + route.path
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			assert.Equal(t, http.StatusUnauthorized, serveUserPathRequest(e, route.method, target, ""))
+			assert.Equal(t, http.StatusForbidden, serveUserPathRequest(e, route.method, target, userPathValidToken))
+		})
+	}
+}
+
+func TestFinancialDataUserRoutes_RejectOtherUsers(t *testing.T) {
+	mockFinancialUseCase := &MockManageFinancialDataUseCase{}
+	e := setupUserPathAuthorizationTestServer(&Controllers{
+		FinancialData: controllers.NewFinancialDataController(mockFinancialUseCase),
+		Calculations:  controllers.NewCalculationsController(&MockCalculateProjectionUseCase{}),
+		Goals:         controllers.NewGoalsController(&MockManageGoalsUseCase{}),
+		Reports:       controllers.NewReportsController(&MockGenerateReportsUseCase{}, nil),
+	})
+
+	routes := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodPost, "/retirement/pension-estimate"},
+		{http.MethodPatch, "/retirement/current-age"},
+		{http.MethodPost, "/import-bank-csv"},
+		{http.MethodPost, "/confirm-current"},
+	}
+
+	for _, route := range routes {
+		target := "/api/v1/financial-data/" + userPathOtherUserID + route.path
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			assert.Equal(t, http.StatusUnauthorized, serveUserPathRequest(e, route.method, target, ""))
+			assert.Equal(t, http.StatusForbidden, serveUserPathRequest(e, route.method, target, userPathValidToken))
+		})
+	}
+
+	// 拒否されたリクエストはユースケースに到達しない
+	assert.Empty(t, mockFinancialUseCase.Calls)
+}
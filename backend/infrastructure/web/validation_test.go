@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/financial-planning-calculator/backend/infrastructure/i18n"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 )
@@ -249,3 +251,136 @@ func TestCustomErrorMessages(t *testing.T) {
 		})
 	}
 }
+
+// TestInputSanitizationValidations tests the safetext, notblank and safeurl validations
+func TestInputSanitizationValidations(t *testing.T) {
+	validator := NewCustomValidator()
+
+	type TestStruct struct {
+		Title       string `json:"title" validate:"required,safetext,notblank,max=10"`
+		Destination string `json:"destination" validate:"omitempty,safeurl"`
+	}
+
+	tests := []struct {
+		name          string
+		input         TestStruct
+		expectedTitle string
+		expectedTags  []string
+	}{
+		{
+			name:          "Valid input",
+			input:         TestStruct{Title: "旅行資金", Destination: "https://example.com/hook"},
+			expectedTitle: "旅行資金",
+		},
+		{
+			name:          "Control characters are stripped before validation",
+			input:         TestStruct{Title: "旅行\x00資金\x1b"},
+			expectedTitle: "旅行資金",
+		},
+		{
+			name:         "Whitespace-only title",
+			input:        TestStruct{Title: " 　 "},
+			expectedTags: []string{"notblank"},
+		},
+		{
+			name:         "Loopback destination",
+			input:        TestStruct{Title: "通知", Destination: "http://127.0.0.1:8080/hook"},
+			expectedTags: []string{"safeurl"},
+		},
+		{
+			name:         "Private network destination",
+			input:        TestStruct{Title: "通知", Destination: "http://10.0.0.1/hook"},
+			expectedTags: []string{"safeurl"},
+		},
+		{
+			name:         "Unsupported scheme",
+			input:        TestStruct{Title: "通知", Destination: "ftp://example.com/hook"},
+			expectedTags: []string{"safeurl"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			err := validator.Validate(&input)
+
+			if len(tt.expectedTags) == 0 {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTitle, input.Title)
+				return
+			}
+
+			httpErr, ok := err.(*echo.HTTPError)
+			if assert.True(t, ok) {
+				validationErr, ok := httpErr.Message.(ValidationErrorResponse)
+				if assert.True(t, ok) {
+					foundTags := make(map[string]bool)
+					for _, detail := range validationErr.Details {
+						foundTags[detail.Tag] = true
+						assert.NotEmpty(t, detail.Message)
+					}
+					for _, expectedTag := range tt.expectedTags {
+						assert.True(t, foundTags[expectedTag], "Expected validation tag %s not found", expectedTag)
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestValidationErrorResponse_Localize tests that validation messages are rebuilt in the requested language
+func TestValidationErrorResponse_Localize(t *testing.T) {
+	type TestRequest struct {
+		MonthlyIncome float64 `json:"monthly_income" validate:"gt=0"`
+		Category      string  `json:"category" validate:"required"`
+	}
+
+	err := NewCustomValidator().Validate(&TestRequest{MonthlyIncome: -1})
+	httpErr, ok := err.(*echo.HTTPError)
+	if !assert.True(t, ok) {
+		return
+	}
+	validationErr, ok := httpErr.Message.(ValidationErrorResponse)
+	if !assert.True(t, ok) {
+		return
+	}
+
+	t.Run("既定は日本語", func(t *testing.T) {
+		assert.Equal(t, "入力値が無効です", validationErr.Error)
+		assert.Equal(t, "月収は0より大きい値を入力してください", validationErr.Details[0].Message)
+	})
+
+	t.Run("英語では項目名をそのまま使う", func(t *testing.T) {
+		localized := validationErr.Localize(i18n.English)
+
+		assert.Equal(t, "Invalid input", localized.Error)
+		assert.Equal(t, "monthly_income must be greater than 0", localized.Details[0].Message)
+		assert.Equal(t, "category is required", localized.Details[1].Message)
+		assert.Equal(t, "gt", localized.Details[0].Tag, "メッセージ以外の項目は変えない")
+		assert.Equal(t, "月収は0より大きい値を入力してください", validationErr.Details[0].Message, "元のレスポンスは変更しない")
+	})
+}
+
+// TestDryRunValidator_FieldResults tests that the dry-run validator reports localized per-field results from the custom validator
+func TestDryRunValidator_FieldResults(t *testing.T) {
+	e := echo.New()
+	e.Validator = NewCustomValidator()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/financial-data/validate", nil)
+	req.Header.Set("Accept-Language", "en")
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	result, err := controllers.DryRunValidator{}.ValidateCreateFinancialData(c, &controllers.CreateFinancialDataRequest{
+		UserID:           "4f6c2a8e-1d3b-4e5f-9a7c-2b8d0e6f1a3c",
+		MonthlyIncome:    100000,
+		InvestmentReturn: 5.0,
+		MonthlyExpenses:  []controllers.ExpenseItemRequest{{Category: "生活費", Amount: 300000}},
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, result.Valid)
+	assert.Equal(t, []controllers.FieldValidationResult{
+		{Field: "inflation_rate", Code: "required", Message: "inflation_rate is required"},
+		{Field: "monthly_expenses", Code: "INSUFFICIENT_SAVINGS", Message: "Monthly expenses exceed monthly income"},
+	}, result.Errors)
+}
@@ -6,6 +6,8 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/financial-planning-calculator/backend/infrastructure/i18n"
+	"github.com/financial-planning-calculator/backend/infrastructure/web/controllers"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
@@ -21,6 +23,8 @@ type ValidationError struct {
 	Tag     string `json:"tag"`
 	Value   string `json:"value"`
 	Message string `json:"message"`
+
+	param string // タグのパラメータ（メッセージを他の言語で作り直すために保持する）
 }
 
 // ValidationErrorResponse represents the response for validation errors
@@ -29,6 +33,34 @@ type ValidationErrorResponse struct {
 	Details []ValidationError `json:"details"`
 }
 
+// Localize はエラーメッセージを指定した言語で作り直したレスポンスを返す
+func (r ValidationErrorResponse) Localize(lang i18n.Language) ValidationErrorResponse {
+	details := make([]ValidationError, len(r.Details))
+	for i, detail := range r.Details {
+		detail.Message = validationMessage(lang, detail.Field, detail.Tag, detail.param)
+		details[i] = detail
+	}
+
+	return ValidationErrorResponse{
+		Error:   i18n.Message(lang, string(controllers.ErrorCodeValidation)),
+		Details: details,
+	}
+}
+
+// FieldResults は検証エラーを指定した言語の項目ごとの結果として返す（controllers.FieldValidationErrors の実装）
+func (r ValidationErrorResponse) FieldResults(lang i18n.Language) []controllers.FieldValidationResult {
+	localized := r.Localize(lang)
+	results := make([]controllers.FieldValidationResult, 0, len(localized.Details))
+	for _, detail := range localized.Details {
+		results = append(results, controllers.FieldValidationResult{
+			Field:   detail.Field,
+			Code:    detail.Tag,
+			Message: detail.Message,
+		})
+	}
+	return results
+}
+
 // NewCustomValidator creates a new custom validator
 func NewCustomValidator() *CustomValidator {
 	v := validator.New()
@@ -53,7 +85,10 @@ func NewCustomValidator() *CustomValidator {
 }
 
 // Validate validates the struct
+// safetext タグ付きのフィールドは検証前に制御文字が取り除かれる
 func (cv *CustomValidator) Validate(i interface{}) error {
+	controllers.SanitizeInput(i)
+
 	if err := cv.validator.Struct(i); err != nil {
 		var validationErrors []ValidationError
 
@@ -63,7 +98,8 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 					Field:   validationErr.Field(),
 					Tag:     validationErr.Tag(),
 					Value:   fmt.Sprintf("%v", validationErr.Value()),
-					Message: getCustomErrorMessage(validationErr),
+					Message: validationMessage(i18n.DefaultLanguage, validationErr.Field(), validationErr.Tag(), validationErr.Param()),
+					param:   validationErr.Param(),
 				})
 			}
 		}
@@ -72,7 +108,7 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 		return &echo.HTTPError{
 			Code: http.StatusBadRequest,
 			Message: ValidationErrorResponse{
-				Error:   "入力値が無効です",
+				Error:   i18n.Message(i18n.DefaultLanguage, string(controllers.ErrorCodeValidation)),
 				Details: validationErrors,
 			},
 		}
@@ -82,53 +118,38 @@ func (cv *CustomValidator) Validate(i interface{}) error {
 
 // registerCustomMessages registers custom validation messages
 func registerCustomMessages(validator *validator.Validate) {
-	// Custom validation rules can be registered here if needed
+	// 自由入力テキスト・URLのサニタイズ用ルール（safetext, notblank, safeurl）
+	controllers.RegisterInputValidations(validator)
+}
+
+// validationMessagesWithParam はメッセージにタグのパラメータを含めるバリデーションタグ
+var validationMessagesWithParam = map[string]bool{
+	"gt": true, "gte": true, "lt": true, "lte": true,
+	"min": true, "max": true, "oneof": true, "len": true,
 }
 
-// getCustomErrorMessage returns a custom error message for validation errors
-func getCustomErrorMessage(fe validator.FieldError) string {
-	field := fe.Field()
-	tag := fe.Tag()
-	param := fe.Param()
-
-	switch tag {
-	case "required":
-		return fmt.Sprintf("%sは必須です", getFieldDisplayName(field))
-	case "gt":
-		return fmt.Sprintf("%sは%sより大きい値を入力してください", getFieldDisplayName(field), param)
-	case "gte":
-		return fmt.Sprintf("%sは%s以上の値を入力してください", getFieldDisplayName(field), param)
-	case "lt":
-		return fmt.Sprintf("%sは%sより小さい値を入力してください", getFieldDisplayName(field), param)
-	case "lte":
-		return fmt.Sprintf("%sは%s以下の値を入力してください", getFieldDisplayName(field), param)
-	case "min":
-		return fmt.Sprintf("%sは%s文字以上で入力してください", getFieldDisplayName(field), param)
-	case "max":
-		return fmt.Sprintf("%sは%s文字以下で入力してください", getFieldDisplayName(field), param)
-	case "oneof":
-		return fmt.Sprintf("%sは有効な値を選択してください（%s）", getFieldDisplayName(field), param)
-	case "email":
-		return fmt.Sprintf("%sは有効なメールアドレスを入力してください", getFieldDisplayName(field))
-	case "uuid":
-		return fmt.Sprintf("%sは有効なUUID形式で入力してください", getFieldDisplayName(field))
-	case "dive":
-		return fmt.Sprintf("%sの項目に無効な値が含まれています", getFieldDisplayName(field))
-	case "numeric":
-		return fmt.Sprintf("%sは数値で入力してください", getFieldDisplayName(field))
-	case "alpha":
-		return fmt.Sprintf("%sは英字のみで入力してください", getFieldDisplayName(field))
-	case "alphanum":
-		return fmt.Sprintf("%sは英数字のみで入力してください", getFieldDisplayName(field))
-	case "len":
-		return fmt.Sprintf("%sは%s文字で入力してください", getFieldDisplayName(field), param)
-	case "url":
-		return fmt.Sprintf("%sは有効なURL形式で入力してください", getFieldDisplayName(field))
-	case "datetime":
-		return fmt.Sprintf("%sは有効な日時形式で入力してください", getFieldDisplayName(field))
-	default:
-		return fmt.Sprintf("%sの値が無効です", getFieldDisplayName(field))
+// validationMessage returns a validation error message in the given language.
+// メッセージはタグ単位でメッセージ辞書から引き、辞書にないタグは汎用のメッセージを返す
+func validationMessage(lang i18n.Language, field, tag, param string) string {
+	key := "validation." + tag
+	if !i18n.Has(key) {
+		key = "validation.default"
+	}
+
+	displayName := fieldDisplayName(lang, field)
+	if validationMessagesWithParam[tag] {
+		return i18n.Message(lang, key, displayName, param)
 	}
+	return i18n.Message(lang, key, displayName)
+}
+
+// fieldDisplayName はメッセージに表示する項目名を返す
+// 日本語以外ではリクエストの項目名（json タグ名）をそのまま使用する
+func fieldDisplayName(lang i18n.Language, field string) string {
+	if lang == i18n.Japanese {
+		return getFieldDisplayName(field)
+	}
+	return field
 }
 
 // getFieldDisplayName returns a user-friendly field name in Japanese.
@@ -150,11 +171,15 @@ func getFieldDisplayName(field string) string {
 		"inflation_rate":    "インフレ率",
 
 		// Retirement fields
-		"retirement_age":              "退職年齢",
-		"monthly_retirement_expenses": "老後月間生活費",
-		"pension_amount":              "年金受給額",
-		"current_age":                 "現在の年齢",
-		"life_expectancy":             "平均寿命",
+		"retirement_age":                    "退職年齢",
+		"monthly_retirement_expenses":       "老後月間生活費",
+		"pension_amount":                    "年金受給額",
+		"kokumin_nenkin_monthly_amount":     "国民年金の月額",
+		"kokumin_nenkin_contribution_years": "国民年金の納付年数",
+		"nurse_care_insurance_monthly":      "介護保険料の月額",
+		"current_age":                       "現在の年齢",
+		"life_expectancy":                   "平均寿命",
+		"lifestyle_expenses":                "生活水準別の月間生活費",
 
 		// Emergency fund fields
 		"emergency_fund_target_months":  "緊急資金目標月数",
@@ -176,6 +201,7 @@ func getFieldDisplayName(field string) string {
 		"monthly_contribution": "月間積立額",
 		"is_active":            "アクティブ状態",
 		"note":                 "メモ",
+		"destination":          "送信先",
 
 		// Calculation fields
 		"years":      "年数",
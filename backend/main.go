@@ -2,16 +2,23 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"flag"
 	"log"
 	"net/http"
 	_ "net/http/pprof"
+	"time"
 
 	"github.com/financial-planning-calculator/backend/config"
+	"github.com/financial-planning-calculator/backend/domain/entities"
 	"github.com/financial-planning-calculator/backend/domain/services"
+	"github.com/financial-planning-calculator/backend/infrastructure/database"
 	"github.com/financial-planning-calculator/backend/infrastructure/monitoring"
 	"github.com/financial-planning-calculator/backend/infrastructure/email"
+	"github.com/financial-planning-calculator/backend/infrastructure/notification"
 	redisinfra "github.com/financial-planning-calculator/backend/infrastructure/redis"
 	"github.com/financial-planning-calculator/backend/infrastructure/repositories"
+	"github.com/financial-planning-calculator/backend/infrastructure/tokenblacklist"
 	"github.com/financial-planning-calculator/backend/infrastructure/web"
 	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/labstack/echo/v4"
@@ -23,10 +30,20 @@ import (
 // @version 1.0
 // @description 将来の資産形成と老後の財務計画を可視化するアプリケーションのAPI
 // @host localhost:8080
-// @BasePath /api
+// @BasePath /api/v1
 func main() {
+	var storageFlag string
+	flag.StringVar(&storageFlag, "storage", string(repositories.StoragePostgres), "Repository storage: postgres, memory (memory keeps users, refresh tokens, financial plans and goals in process memory)")
+	flag.Parse()
+
+	storage, err := repositories.ParseStorageType(storageFlag)
+	if err != nil {
+		log.Fatalf("起動オプションが不正です: %v", err)
+	}
+
 	// 設定読み込み
 	cfg := config.LoadServerConfig()
+	validateConfig(cfg)
 	dbConfig := config.NewDatabaseConfig()
 
 	// セキュリティ警告チェック
@@ -49,10 +66,13 @@ func main() {
 	e.HTTPErrorHandler = web.CustomHTTPErrorHandler
 
 	// ミドルウェア設定
-	rateLimitStore := web.SetupMiddleware(e, cfg)
+	rateLimitStore, err := web.SetupMiddleware(e, cfg)
+	if err != nil {
+		log.Fatalf("ミドルウェアの設定に失敗しました: %v", err)
+	}
 
 	// 依存関係の初期化
-	deps := initializeDependencies()
+	deps := initializeDependencies(storage)
 
 	// コントローラーの作成
 	controllers, err := web.NewControllers(deps)
@@ -63,6 +83,9 @@ func main() {
 	// ルーティング設定
 	web.SetupRoutes(e, controllers, deps, rateLimitStore)
 
+	// なりすましセッションの期限切れを監査ログに記録する
+	startImpersonationExpiryAudit(deps)
+
 	// pprofサーバーの起動（開発環境のみ）
 	if cfg.EnablePprof {
 		go func() {
@@ -110,16 +133,30 @@ func initMonitoring(cfg *config.ServerConfig) {
 }
 
 // initializeDependencies initializes all dependencies for the application
-func initializeDependencies() *web.ServerDependencies {
+func initializeDependencies(storage repositories.StorageType) *web.ServerDependencies {
 	// Initialize database connection
 	dbConfig := config.NewDatabaseConfig()
-	db, err := config.NewDatabaseConnection(dbConfig)
-	if err != nil {
-		log.Fatalf("データベース接続の初期化に失敗しました: %v", err)
-	}
 
 	// Initialize repositories
-	repoFactory := repositories.NewRepositoryFactory(db)
+	// データベース障害時に接続試行が殺到しないよう、全リポジトリでサーキットブレーカーを共有する
+	dbCircuitBreaker := database.NewSlidingWindowCircuitBreaker(dbConfig.CircuitBreaker)
+
+	var repoFactory *repositories.RepositoryFactory
+	if storage == repositories.StorageMemory {
+		// DB には接続せずに起動する（インメモリ実装のないリポジトリを使う機能はエラーになる）
+		db, err := sql.Open("postgres", dbConfig.ConnectionString())
+		if err != nil {
+			log.Fatalf("データベース接続の作成に失敗しました: %v", err)
+		}
+		log.Println("⚠️  インメモリストレージで起動します（ユーザー・財務計画・目標はプロセス終了時に失われます）")
+		repoFactory = repositories.NewInMemoryRepositoryFactory(db, dbCircuitBreaker)
+	} else {
+		db, err := config.NewDatabaseConnection(dbConfig)
+		if err != nil {
+			log.Fatalf("データベース接続の初期化に失敗しました: %v", err)
+		}
+		repoFactory = repositories.NewRepositoryFactoryWithCircuitBreaker(db, dbCircuitBreaker)
+	}
 
 	userRepo := repoFactory.NewUserRepository()
 	refreshTokenRepo := repoFactory.NewRefreshTokenRepository()
@@ -127,6 +164,18 @@ func initializeDependencies() *web.ServerDependencies {
 	webAuthnCredentialRepo := repoFactory.NewWebAuthnCredentialRepository()
 	financialPlanRepo := repoFactory.NewFinancialPlanRepository()
 	goalRepo := repoFactory.NewGoalRepository()
+	savingsRateTargetRepo := repoFactory.NewSavingsRateTargetRepository()
+	notificationPrefRepo := repoFactory.NewNotificationPreferenceRepository()
+	apiKeyRepo := repoFactory.NewAPIKeyRepository()
+	auditLogRepo := repoFactory.NewAuditLogRepository()
+	recommendationDismissalRepo := repoFactory.NewRecommendationDismissalRepository()
+	healthScoreSnapshotRepo := repoFactory.NewHealthScoreSnapshotRepository()
+	goalProgressSnapshotRepo := repoFactory.NewGoalProgressSnapshotRepository()
+	goalContributionHistoryRepo := repoFactory.NewGoalContributionHistoryRepository()
+	peerBenchmarkRepo := repoFactory.NewPeerBenchmarkRepository()
+	peerBenchmarkConsentRepo := repoFactory.NewPeerBenchmarkConsentRepository()
+	calculationSnapshotRepo := repoFactory.NewCalculationSnapshotRepository()
+	unitOfWork := repoFactory.NewUnitOfWork()
 
 	// Redisキャッシュの初期化（利用可能な場合はデコレータでラップ）
 	redisClient := redisinfra.NewClient()
@@ -136,14 +185,27 @@ func initializeDependencies() *web.ServerDependencies {
 		log.Println("✅ Redisキャッシュを有効化しました")
 		financialPlanRepo = repositories.NewCachedFinancialPlanRepository(financialPlanRepo, redisClient)
 		goalRepo = repositories.NewCachedGoalRepository(goalRepo, redisClient)
+		unitOfWork = repositories.NewCachedUnitOfWork(unitOfWork, redisClient)
 	}
 
+	// Load server config for JWT settings
+	serverCfg := config.LoadServerConfig()
+
 	// Initialize domain services
-	calculationService := services.NewFinancialCalculationService()
+	// 計算の前提値（退職までの想定期間・推奨貯蓄率など）は設定から読み込む
+	calculationService := services.NewFinancialCalculationServiceWithDefaults(services.CalculationDefaults{
+		RetirementPlanningYears: serverCfg.CalcRetirementPlanningYears,
+		InvestmentReturn:        serverCfg.CalcStandardInvestmentReturn,
+		RecommendedSavingsRate:  serverCfg.CalcRecommendedSavingsRate,
+		EmergencyFundMonths:     serverCfg.CalcEmergencyFundMonths,
+	})
 	recommendationService := services.NewGoalRecommendationService(calculationService)
 
-	// Load server config for JWT settings
-	serverCfg := config.LoadServerConfig()
+	// 想定利回り・インフレ率の現実的な上限は設定から読み込む
+	assumptionGuardrailService := services.NewAssumptionGuardrailService(
+		serverCfg.RealisticReturnCeiling,
+		serverCfg.RealisticInflationCeiling,
+	)
 
 	// Initialize email service
 	emailService := email.NewEmailService(
@@ -154,6 +216,16 @@ func initializeDependencies() *web.ServerDependencies {
 		serverCfg.SMTPFrom,
 	)
 
+	// Initialize notification dispatcher（メール・プッシュは配信基盤の導入まで開発用チャネル）
+	notificationDispatcher := notification.NewPreferenceDispatcher(
+		notificationPrefRepo,
+		notification.NewRetryQueue(notification.DefaultMaxRetryAttempts, notification.DefaultRetryBackoff),
+		notification.NewLogChannel(entities.NotificationChannelEmail),
+		notification.NewLogChannel(entities.NotificationChannelPush),
+		notification.NewWebhookChannel(nil),
+	)
+	notificationDispatcher.StartRetryWorker(notification.DefaultRetryBackoff)
+
 	// Initialize WebAuthn
 	webAuthn, err := initializeWebAuthn(serverCfg)
 	if err != nil {
@@ -168,16 +240,76 @@ func initializeDependencies() *web.ServerDependencies {
 		WebAuthnCredentialRepo:   webAuthnCredentialRepo,
 		FinancialPlanRepo:        financialPlanRepo,
 		GoalRepo:                 goalRepo,
+		SavingsRateTargetRepo:    savingsRateTargetRepo,
+		NotificationPrefRepo:     notificationPrefRepo,
+		APIKeyRepo:               apiKeyRepo,
+		AuditLogRepo:             auditLogRepo,
+		RecommendationDismissalRepo: recommendationDismissalRepo,
+		HealthScoreSnapshotRepo: healthScoreSnapshotRepo,
+		GoalProgressSnapshotRepo: goalProgressSnapshotRepo,
+		GoalContributionHistoryRepo: goalContributionHistoryRepo,
+		PeerBenchmarkRepo:        peerBenchmarkRepo,
+		PeerBenchmarkConsentRepo: peerBenchmarkConsentRepo,
+		CalculationSnapshotRepo: calculationSnapshotRepo,
+		UnitOfWork:               unitOfWork,
+		DBCircuitBreaker:         dbCircuitBreaker,
+		NotificationDispatcher:   notificationDispatcher,
+		// 複数インスタンスで運用する場合は Redis などの共有ストアの実装に差し替える
+		TokenBlacklist:           tokenblacklist.NewMemoryTokenBlacklist(tokenblacklist.DefaultCleanupInterval),
 		CalculationService:       calculationService,
 		RecommendationService:    recommendationService,
+		AssumptionGuardrailService: assumptionGuardrailService,
 		JWTSecret:                serverCfg.JWTSecret,
 		JWTExpiration:            serverCfg.JWTExpiration,
 		RefreshTokenExpiration:   serverCfg.RefreshTokenExpiration,
 		ServerConfig:             serverCfg, // OAuth設定用 (Issue: #67)
+		FeatureFlags:             serverCfg.Features,
 		WebAuthn:                 webAuthn,
 	}
 }
 
+// startImpersonationExpiryAudit は有効期限を過ぎたなりすましセッションを定期的に監査ログへ記録するゴルーチンを開始する
+func startImpersonationExpiryAudit(deps *web.ServerDependencies) {
+	if deps.ImpersonationUseCase == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if _, err := deps.ImpersonationUseCase.RecordExpiredSessions(context.Background()); err != nil {
+				log.Printf("⚠️  なりすましセッションの期限切れの記録に失敗しました: %v", err)
+			}
+		}
+	}()
+}
+
+// validateConfig は起動時に設定を検証し、結果を起動ログに出力する
+// 本番モードでは問題があれば起動を中止し、開発モードでは警告の出力に留める
+func validateConfig(cfg *config.ServerConfig) {
+	warnings, err := cfg.ValidateOnStartup()
+	if err != nil {
+		log.Fatalf("❌ 設定の検証に失敗しました（%s モード）:\n%v", cfg.Environment, err)
+	}
+
+	// 機能フラグの不正な設定は既定値で起動し、警告のみ出力する
+	for _, warning := range cfg.Features.Warnings() {
+		log.Printf("⚠️  %s", warning)
+	}
+
+	if len(warnings) == 0 {
+		log.Printf("✅ 設定の検証に成功しました（%s モード）", cfg.Environment)
+		return
+	}
+
+	log.Printf("⚠️  設定に%d件の問題があります（%s モードのため起動を継続します）", len(warnings), cfg.Environment)
+	for _, warning := range warnings {
+		log.Printf("⚠️  %s", warning)
+	}
+}
+
 // checkSecurityWarnings checks for insecure default values in production
 func checkSecurityWarnings(serverCfg *config.ServerConfig, dbCfg *config.DatabaseConfig) {
 	warnings := []string{}
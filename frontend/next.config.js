@@ -47,13 +47,20 @@ const nextConfig = {
   },
 
   // API rewrites for development
+  // バックエンドが返す /api/v1 以下のURL（ダウンロードURLなど）はそのまま、バージョンなしの /api 以下は /api/v1 以下へ転送する
   async rewrites() {
     return [
+      {
+        source: '/api/v1/:path*',
+        destination: process.env.NEXT_PUBLIC_API_URL
+          ? `${process.env.NEXT_PUBLIC_API_URL}/api/v1/:path*`
+          : 'http://localhost:8080/api/v1/:path*',
+      },
       {
         source: '/api/:path*',
         destination: process.env.NEXT_PUBLIC_API_URL
-          ? `${process.env.NEXT_PUBLIC_API_URL}/api/:path*`
-          : 'http://localhost:8080/api/:path*',
+          ? `${process.env.NEXT_PUBLIC_API_URL}/api/v1/:path*`
+          : 'http://localhost:8080/api/v1/:path*',
       },
     ];
   },
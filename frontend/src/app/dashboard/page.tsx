@@ -41,12 +41,17 @@ export default function DashboardPage() {
     // 月間支出の合計
     const monthlyExpenses = profile?.monthly_expenses?.reduce((sum, item) => sum + item.amount, 0) || 0;
 
-    // 月間純貯蓄
-    const monthlySavings = monthlyIncome - monthlyExpenses;
+    // 月間純貯蓄（支出に計上されていない負債の返済額を差し引く）
+    const monthlyDebtPayments = profile?.monthly_debt_payments || 0;
+    const monthlySavings = monthlyIncome - monthlyExpenses - monthlyDebtPayments;
 
     // 総資産（貯蓄の合計）
     const totalAssets = profile?.current_savings?.reduce((sum, item) => sum + item.amount, 0) || 0;
 
+    // 純資産（総資産 - 負債残高）
+    const totalLiabilities = profile?.total_liabilities || 0;
+    const netWorth = profile?.net_worth ?? totalAssets - totalLiabilities;
+
     // 老後資金充足率の計算
     let retirementSufficiency = 0;
     if (retirement) {
@@ -75,6 +80,8 @@ export default function DashboardPage() {
       monthlyExpenses,
       monthlySavings,
       totalAssets,
+      totalLiabilities,
+      netWorth,
       retirementSufficiency,
       emergencyMonths,
       investmentReturn,
@@ -159,7 +166,11 @@ export default function DashboardPage() {
           {
             label: '総資産',
             value: financialStats.hasData ? formatCurrency(financialStats.totalAssets) : '---',
-            sub: financialStats.hasData ? '現在の貯蓄合計' : '財務データを入力してください',
+            sub: !financialStats.hasData
+              ? '財務データを入力してください'
+              : financialStats.totalLiabilities > 0
+                ? `純資産 ${formatCurrency(financialStats.netWorth)}（負債 ${formatCurrency(financialStats.totalLiabilities)}）`
+                : '現在の貯蓄合計',
           },
           {
             label: '老後資金充足率',
@@ -103,8 +103,8 @@ export default function ReportsPage() {
 
         const exportData = await exportResponse.json();
         if (exportData && exportData.download_url) {
-          // download_url の検証: /api/reports/download/ で始まることを確認
-          if (!exportData.download_url.startsWith('/api/reports/download/')) {
+          // download_url の検証: /api/v1/reports/download/ で始まることを確認
+          if (!exportData.download_url.startsWith('/api/v1/reports/download/')) {
             throw new Error('無効なダウンロードURLです');
           }
           const a = document.createElement('a');
@@ -173,8 +173,8 @@ export default function ReportsPage() {
 
       const data = await response.json();
       if (data && data.download_url) {
-        // download_url の検証: /api/reports/download/ で始まることを確認
-        if (!data.download_url.startsWith('/api/reports/download/')) {
+        // download_url の検証: /api/v1/reports/download/ で始まることを確認
+        if (!data.download_url.startsWith('/api/v1/reports/download/')) {
           throw new Error('無効なダウンロードURLです');
         }
         const a = document.createElement('a');
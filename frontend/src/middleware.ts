@@ -9,11 +9,20 @@ export function middleware(request: NextRequest) {
     return NextResponse.next();
   }
 
-  // /api/* リクエストをバックエンドにリライト
-  const url = new URL(request.nextUrl.pathname + request.nextUrl.search, backendUrl);
+  // /api/* リクエストをバックエンドの /api/v1/* にリライト
+  // （バックエンドのリダイレクトを経由しないよう、バージョンなしのパスはここで /api/v1 に変換する）
+  const url = new URL(toVersionedApiPath(request.nextUrl.pathname) + request.nextUrl.search, backendUrl);
   return NextResponse.rewrite(url);
 }
 
+// toVersionedApiPath は /api 以下のパスを /api/v1 以下のパスに変換する（既に /api/v1 以下の場合はそのまま）
+function toVersionedApiPath(pathname: string): string {
+  if (pathname === '/api/v1' || pathname.startsWith('/api/v1/')) {
+    return pathname;
+  }
+  return '/api/v1' + pathname.slice('/api'.length);
+}
+
 export const config = {
   matcher: '/api/:path*',
 };
@@ -45,6 +45,10 @@ export interface FinancialProfile {
   current_savings?: SavingsItem[];
   investment_return: number;
   inflation_rate: number;
+  // 登録済みの負債から計算される値（レスポンスのみ）
+  total_liabilities?: number;
+  net_worth?: number;
+  monthly_debt_payments?: number;
 }
 
 export interface RetirementData {
@@ -84,6 +88,8 @@ export interface Goal {
   current_amount: number;
   monthly_contribution: number;
   is_active: boolean;
+  notes?: string; // メモ（1000文字以内）
+  image_url?: string; // 添付画像のURL
   created_at?: string;
   updated_at?: string;
 }